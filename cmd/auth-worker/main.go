@@ -5,6 +5,7 @@ import (
 	"go.uber.org/fx"
 
 	authiamprojection "github.com/tuannm99/podzone/internal/auth/infrastructure/messaging/iamprojection"
+	authoutbox "github.com/tuannm99/podzone/internal/auth/infrastructure/messaging/outbox"
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdkafka"
 	"github.com/tuannm99/podzone/pkg/pdlog"
@@ -16,6 +17,7 @@ var connOpts = fx.Options(
 	pdsql.ModuleFor("auth"),
 	pdkafka.ModuleFor("auth"),
 	authiamprojection.Module,
+	authoutbox.Module,
 )
 
 func main() {
@@ -7,6 +7,7 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
 	"github.com/tuannm99/podzone/pkg/pdgrpc"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdpprof"
 	"github.com/tuannm99/podzone/pkg/pdredis"
@@ -34,6 +35,7 @@ func newAppContainer(extra ...fx.Option) *fx.App {
 		pdpprof.Module,
 		pdglobalmiddleware.CommonGRPCModule,
 		pdgrpc.Module,
+		pdhttp.Module,
 
 		fx.Options(extra...),
 	)
@@ -5,9 +5,11 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/tuannm99/podzone/internal/backoffice"
+	backofficeorderplaced "github.com/tuannm99/podzone/internal/backoffice/infrastructure/messaging/orderplaced"
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdgraphql"
 	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
 	kvstores "github.com/tuannm99/podzone/pkg/pdkvstores"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdmongo"
@@ -16,7 +18,9 @@ import (
 var connOpts = fx.Options(
 	pdmongo.ModuleFor("onboarding"),
 	kvstores.ModuleFor("onboarding"),
+	pdkafka.ModuleFor("backoffice"),
 	backoffice.Module,
+	backofficeorderplaced.Module,
 )
 
 func main() {
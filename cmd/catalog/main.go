@@ -4,17 +4,20 @@ import (
 	"github.com/joho/godotenv"
 	"go.uber.org/fx"
 
+	"github.com/tuannm99/podzone/internal/catalog"
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
 	"github.com/tuannm99/podzone/pkg/pdgrpc"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdmongo"
+	"github.com/tuannm99/podzone/pkg/pdpprof"
 	"github.com/tuannm99/podzone/pkg/pdredis"
 )
 
 var connOpts = fx.Options(
 	pdredis.ModuleFor("catalog"),
 	pdmongo.ModuleFor("catalog"),
+	catalog.ServerModule,
 )
 
 func main() {
@@ -26,6 +29,7 @@ func newAppContainer(extra ...fx.Option) *fx.App {
 	return fx.New(
 		pdconfig.Module,
 		pdlog.Module,
+		pdpprof.Module,
 		pdglobalmiddleware.CommonGRPCModule,
 		pdgrpc.Module,
 
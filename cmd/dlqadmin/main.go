@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/joho/godotenv"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/dlqadmin"
+	"github.com/tuannm99/podzone/pkg/pdconfig"
+	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdpprof"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+)
+
+var connOpts = fx.Options(
+	pdsql.ModuleFor("dlqadmin"),
+	pdkafka.ModuleFor("dlqadmin"),
+	dlqadmin.Module,
+)
+
+func main() {
+	newAppContainer(connOpts).Run()
+}
+
+func newAppContainer(extra ...fx.Option) *fx.App {
+	_ = godotenv.Load()
+
+	return fx.New(
+		pdconfig.Module,
+		pdlog.Module,
+		pdpprof.Module,
+		pdglobalmiddleware.CommonGinMiddlewareModule,
+		pdhttp.Module,
+
+		fx.Options(extra...),
+	)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/dlqadmin"
+	"github.com/tuannm99/podzone/pkg/pdconfig"
+	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+)
+
+func TestAppContainerGraph(t *testing.T) {
+	err := fx.ValidateApp(
+		fx.NopLogger,
+		pdconfig.Module,
+		pdlog.Module,
+		pdglobalmiddleware.CommonGinMiddlewareModule,
+		pdhttp.Module,
+		pdsql.ModuleFor("dlqadmin"),
+		pdkafka.ModuleFor("dlqadmin"),
+		dlqadmin.Module,
+	)
+	require.NoError(t, err)
+}
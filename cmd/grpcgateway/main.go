@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/joho/godotenv"
+	"github.com/knadh/koanf/v2"
 	"go.uber.org/fx"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/tuannm99/podzone/internal/grpcgateway"
@@ -15,6 +18,7 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdgrpcgateway"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdpprof"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
@@ -66,6 +70,26 @@ var gatewayRegistrarModule = fx.Options(
 			fx.ResultTags(`group:"gateway-registrars"`),
 		),
 	),
+	fx.Provide(
+		fx.Annotate(
+			func() grpcgateway.GatewayRegistrar {
+				return &grpcgateway.CartRegistrar{
+					AddrVal: toolkit.GetEnv("CART_GRPC_ADDR", "localhost:50055"),
+				}
+			},
+			fx.ResultTags(`group:"gateway-registrars"`),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			func() grpcgateway.GatewayRegistrar {
+				return &grpcgateway.OrderRegistrar{
+					AddrVal: toolkit.GetEnv("ORDER_GRPC_ADDR", "localhost:50056"),
+				}
+			},
+			fx.ResultTags(`group:"gateway-registrars"`),
+		),
+	),
 )
 
 func newAppContainer() *fx.App {
@@ -84,6 +108,14 @@ func newAppContainer() *fx.App {
 				NewRedirectResponseModifier,
 				fx.ResultTags(`group:"gateway-options"`),
 			),
+			fx.Annotate(
+				NewTenantHeaderMatcher,
+				fx.ResultTags(`group:"gateway-options"`),
+			),
+			fx.Annotate(
+				NewClientMetadataAnnotator,
+				fx.ResultTags(`group:"gateway-options"`),
+			),
 		),
 
 		fx.Invoke(grpcgateway.RegisterGWHandlers),
@@ -94,6 +126,46 @@ func NewRedirectResponseModifier(logger pdlog.Logger) runtime.ServeMuxOption {
 	return runtime.WithForwardResponseOption(RedirectForwardFunc(logger))
 }
 
+// NewTenantHeaderMatcher forwards X-Tenant-ID so anonymous storefront calls can pick their catalog,
+// and Idempotency-Key so a retried checkout returns the order the first attempt placed.
+func NewTenantHeaderMatcher() runtime.ServeMuxOption {
+	return runtime.WithIncomingHeaderMatcher(TenantHeaderMatcher)
+}
+
+// TenantHeaderMatcher never copies client facts from the request, including through the
+// Grpc-Metadata- prefix: NewClientMetadataAnnotator sets them from the connection instead.
+func TenantHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Tenant-ID") {
+		return "x-tenant-id", true
+	}
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return "idempotency-key", true
+	}
+	if pdproxy.IsClientKey(key) {
+		return "", false
+	}
+	forwarded, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && pdproxy.IsClientKey(forwarded) {
+		return "", false
+	}
+	return forwarded, ok
+}
+
+// NewClientMetadataAnnotator sets x-real-ip and x-forwarded-proto from the HTTP connection, or
+// from the edge proxy's X-Real-IP and X-Forwarded-Proto when it is one of
+// grpcgateway.trusted_proxies. Auth throttles logins per client IP and IAM evaluates
+// auth:SourceIp and auth:SecureTransport from them.
+func NewClientMetadataAnnotator(k *koanf.Koanf) runtime.ServeMuxOption {
+	proxies := pdproxy.LoadTrustedProxies(k, "grpcgateway.trusted_proxies")
+	return runtime.WithMetadata(ClientMetadataAnnotator(proxies))
+}
+
+func ClientMetadataAnnotator(proxies pdproxy.TrustedProxies) func(context.Context, *http.Request) metadata.MD {
+	return func(_ context.Context, r *http.Request) metadata.MD {
+		return pdproxy.ClientMetadata(proxies.ClientIPFromRequest(r), proxies.SecureTransportFromRequest(r))
+	}
+}
+
 func RedirectForwardFunc(
 	logger pdlog.Logger,
 ) func(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
@@ -110,6 +182,18 @@ func RedirectForwardFunc(
 			w.WriteHeader(http.StatusTemporaryRedirect)
 			return nil
 		}
+		if loginResp, ok := resp.(*pbauthv1.OIDCLoginResponse); ok && loginResp.RedirectUrl != "" {
+			logger.Info("Redirecting to OIDC provider", "url", loginResp.RedirectUrl)
+			w.Header().Set("Location", loginResp.RedirectUrl)
+			w.WriteHeader(http.StatusTemporaryRedirect)
+			return nil
+		}
+		if callbackResp, ok := resp.(*pbauthv1.OIDCCallbackResponse); ok && callbackResp.RedirectUrl != "" {
+			logger.Info("Redirecting to app after OIDC callback", "url", callbackResp.RedirectUrl)
+			w.Header().Set("Location", callbackResp.RedirectUrl)
+			w.WriteHeader(http.StatusTemporaryRedirect)
+			return nil
+		}
 		return nil
 	}
 }
@@ -14,9 +14,11 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdgrpcgateway"
 	pdlog "github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdpprof"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 	"go.uber.org/fx/fxtest"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
@@ -36,12 +38,62 @@ func TestAppContainerGraph(t *testing.T) {
 				NewRedirectResponseModifier,
 				fx.ResultTags(`group:"gateway-options"`),
 			),
+			fx.Annotate(
+				NewTenantHeaderMatcher,
+				fx.ResultTags(`group:"gateway-options"`),
+			),
+			fx.Annotate(
+				NewClientMetadataAnnotator,
+				fx.ResultTags(`group:"gateway-options"`),
+			),
 		),
 		fx.Invoke(grpcgateway.RegisterGWHandlers),
 	)
 	require.NoError(t, err)
 }
 
+func TestClientMetadataAnnotator_TrustsEdgeProxyOnly(t *testing.T) {
+	annotate := ClientMetadataAnnotator(pdproxy.NewTrustedProxies("10.0.0.0/8"))
+
+	direct := httptest.NewRequest(http.MethodPost, "/auth/v1/login", nil)
+	direct.RemoteAddr = "203.0.113.7:4711"
+	direct.Header.Set("X-Real-IP", "198.51.100.1")
+	direct.Header.Set("X-Forwarded-Proto", "https")
+	md := annotate(context.Background(), direct)
+	require.Equal(t, []string{"203.0.113.7"}, md.Get("x-real-ip"))
+	require.Equal(t, []string{"http"}, md.Get("x-forwarded-proto"))
+
+	proxied := httptest.NewRequest(http.MethodPost, "/auth/v1/login", nil)
+	proxied.RemoteAddr = "10.1.2.3:4711"
+	proxied.Header.Set("X-Real-IP", "198.51.100.1")
+	proxied.Header.Set("X-Forwarded-Proto", "https")
+	md = annotate(context.Background(), proxied)
+	require.Equal(t, []string{"198.51.100.1"}, md.Get("x-real-ip"))
+	require.Equal(t, []string{"https"}, md.Get("x-forwarded-proto"))
+}
+
+func TestTenantHeaderMatcher(t *testing.T) {
+	key, ok := TenantHeaderMatcher("X-Tenant-Id")
+	require.True(t, ok)
+	require.Equal(t, "x-tenant-id", key)
+
+	key, ok = TenantHeaderMatcher("Idempotency-Key")
+	require.True(t, ok)
+	require.Equal(t, "idempotency-key", key)
+
+	for _, spoofed := range []string{"X-Real-Ip", "X-Forwarded-Proto", "Grpc-Metadata-X-Real-Ip"} {
+		_, ok = TenantHeaderMatcher(spoofed)
+		require.False(t, ok, spoofed)
+	}
+
+	key, ok = TenantHeaderMatcher("Authorization")
+	require.True(t, ok)
+	require.Equal(t, "grpcgateway-Authorization", key)
+
+	_, ok = TenantHeaderMatcher("X-Unrelated")
+	require.False(t, ok)
+}
+
 func TestRedirectForwardFunc(t *testing.T) {
 	var logger pdlog.Logger
 
@@ -99,6 +151,21 @@ func TestRedirectForwardFunc(t *testing.T) {
 		}
 	})
 
+	t.Run("oidc_redirects", func(t *testing.T) {
+		for _, msg := range []proto.Message{
+			&pbauthv1.OIDCLoginResponse{RedirectUrl: "https://idp.example.com/authorize"},
+			&pbauthv1.OIDCCallbackResponse{RedirectUrl: "https://example.com/app"},
+		} {
+			rr := httptest.NewRecorder()
+			if err := fn(ctx, rr, msg); err != nil {
+				t.Fatalf("fn error: %v", err)
+			}
+			if rr.Code != http.StatusTemporaryRedirect || rr.Header().Get("Location") == "" {
+				t.Fatalf("status = %d, Location = %q, want redirect", rr.Code, rr.Header().Get("Location"))
+			}
+		}
+	})
+
 	t.Run("no_redirect", func(t *testing.T) {
 		rr := httptest.NewRecorder()
 		msg := &emptypb.Empty{}
@@ -4,6 +4,7 @@ import (
 	"github.com/joho/godotenv"
 	"go.uber.org/fx"
 
+	iamaccessrequest "github.com/tuannm99/podzone/internal/iam/infrastructure/accessrequest"
 	iamworker "github.com/tuannm99/podzone/internal/iam/infrastructure/messaging/outbox"
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdkafka"
@@ -16,6 +17,7 @@ var connOpts = fx.Options(
 	pdsql.ModuleFor("iam"),
 	pdkafka.ModuleFor("iam"),
 	iamworker.Module,
+	iamaccessrequest.Module,
 )
 
 func main() {
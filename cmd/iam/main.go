@@ -8,6 +8,7 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
 	"github.com/tuannm99/podzone/pkg/pdgrpc"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdkafka"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdpprof"
@@ -33,6 +34,7 @@ func newAppContainer(extra ...fx.Option) *fx.App {
 		pdpprof.Module,
 		pdglobalmiddleware.CommonGRPCModule,
 		pdgrpc.Module,
+		pdhttp.Module,
 
 		fx.Options(extra...),
 	)
@@ -9,6 +9,7 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdconfig"
 	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
 	"github.com/tuannm99/podzone/pkg/pdgrpc"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdpprof"
 )
@@ -21,6 +22,7 @@ func TestAppContainerGraph(t *testing.T) {
 		pdpprof.Module,
 		pdglobalmiddleware.CommonGRPCModule,
 		pdgrpc.Module,
+		pdhttp.Module,
 		connOpts,
 	)
 	require.NoError(t, err)
@@ -12,10 +12,12 @@ import (
 	kvstores "github.com/tuannm99/podzone/pkg/pdkvstores"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdmongo"
+	"github.com/tuannm99/podzone/pkg/pdsql"
 )
 
 var connOpts = fx.Options(
 	pdmongo.ModuleFor("onboarding"),
+	pdsql.ModuleFor("onboarding"),
 	kvstores.ModuleFor("onboarding"),
 	pdkafka.ModuleFor("onboarding"),
 	onboarding.Module,
@@ -14,6 +14,7 @@ import (
 	kvstores "github.com/tuannm99/podzone/pkg/pdkvstores"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdmongo"
+	"github.com/tuannm99/podzone/pkg/pdsql"
 )
 
 func TestAppContainerGraph(t *testing.T) {
@@ -24,6 +25,7 @@ func TestAppContainerGraph(t *testing.T) {
 		pdglobalmiddleware.CommonGinMiddlewareModule,
 		pdhttp.Module,
 		pdmongo.ModuleFor("onboarding"),
+		pdsql.ModuleFor("onboarding"),
 		kvstores.ModuleFor("onboarding"),
 		pdkafka.ModuleFor("onboarding"),
 		onboarding.Module,
@@ -1,19 +1,38 @@
 package main
 
 import (
-	"fmt"
-	"net/http"
+	"github.com/joho/godotenv"
+	"go.uber.org/fx"
 
-	"github.com/a-h/templ"
 	"github.com/tuannm99/podzone/internal/storefront"
+	"github.com/tuannm99/podzone/pkg/pdconfig"
+	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
+	"github.com/tuannm99/podzone/pkg/pdgrpcclient"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdmongo"
+)
+
+var connOpts = fx.Options(
+	pdmongo.ModuleFor("onboarding"),
+	pdgrpcclient.ModuleFor("catalog"),
+	pdgrpcclient.ModuleFor("cart"),
+	pdgrpcclient.ModuleFor("order"),
+	storefront.Module,
 )
 
 func main() {
-	component := storefront.Hello("John")
-	// _ = component.Render(context.Background(), os.Stdout)
+	newAppContainer(connOpts).Run()
+}
 
-	http.Handle("/", templ.Handler(component))
+func newAppContainer(extra ...fx.Option) *fx.App {
+	_ = godotenv.Load()
+	return fx.New(
+		pdconfig.Module,
+		pdlog.Module,
+		pdglobalmiddleware.CommonGinMiddlewareModule,
+		pdhttp.Module,
 
-	fmt.Println("Listening on :3000")
-	_ = http.ListenAndServe(":3000", nil)
+		fx.Options(extra...),
+	)
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/storefront"
+	"github.com/tuannm99/podzone/pkg/pdconfig"
+	"github.com/tuannm99/podzone/pkg/pdglobalmiddleware"
+	"github.com/tuannm99/podzone/pkg/pdgrpcclient"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdmongo"
+)
+
+func TestAppContainerGraph(t *testing.T) {
+	err := fx.ValidateApp(
+		fx.NopLogger,
+		pdconfig.Module,
+		pdlog.Module,
+		pdglobalmiddleware.CommonGinMiddlewareModule,
+		pdhttp.Module,
+		pdmongo.ModuleFor("onboarding"),
+		pdgrpcclient.ModuleFor("catalog"),
+		pdgrpcclient.ModuleFor("cart"),
+		pdgrpcclient.ModuleFor("order"),
+		storefront.Module,
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,2 @@
+SELECT 'CREATE DATABASE dlqadmin'
+WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'dlqadmin')\gexec
@@ -0,0 +1,2 @@
+SELECT 'CREATE DATABASE onboarding'
+WHERE NOT EXISTS (SELECT FROM pg_database WHERE datname = 'onboarding')\gexec
@@ -29,6 +29,10 @@ case "$service" in
     build_cmd='go build -o ./bin/catalog ./cmd/catalog/main.go'
     build_bin='./bin/catalog'
     ;;
+  cart)
+    build_cmd='go build -o ./bin/cart ./cmd/cart/main.go'
+    build_bin='./bin/cart'
+    ;;
   partner)
     build_cmd='go build -o ./bin/partner ./cmd/partner/main.go'
     build_bin='./bin/partner'
@@ -1,20 +1,176 @@
 package config
 
 import (
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/knadh/koanf/v2"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
+const (
+	defaultSigningRotationInterval = 30 * 24 * time.Hour
+	// defaultSigningPublishLead outlasts a verifier's forced JWKS refetch (30s) plus the
+	// keyring reload of the replica serving the JWKS (1m), with margin.
+	defaultSigningPublishLead = 5 * time.Minute
+	// defaultRetiredKeyGrace keeps a retired key published until every token it signed has expired.
+	defaultRetiredKeyGrace = 25 * time.Hour
+
+	defaultMFAIssuer       = "Podzone"
+	defaultMFAChallengeTTL = 5 * time.Minute
+
+	defaultPasswordResetTTL     = time.Hour
+	defaultEmailVerificationTTL = 48 * time.Hour
+
+	MailDriverLog  = "log"
+	MailDriverFile = "file"
+	MailDriverSMTP = "smtp"
+
+	defaultMailFrom = "no-reply@podzone.local"
+	defaultMailDir  = "tmp/mail"
+
+	defaultLoginMaxFailures     = 5
+	defaultLoginIPMaxFailures   = 20
+	defaultLoginFailureWindow   = 15 * time.Minute
+	defaultLoginBackoffAfter    = 3
+	defaultLoginBaseBackoff     = time.Second
+	defaultLoginMaxBackoff      = time.Minute
+	defaultLoginLockoutDuration = 15 * time.Minute
+
+	defaultAPIKeyTTL    = 90 * 24 * time.Hour
+	defaultAPIKeyMaxTTL = 365 * 24 * time.Hour
+
+	defaultWebAuthnRPName       = "Podzone"
+	defaultWebAuthnChallengeTTL = 5 * time.Minute
+)
+
 type RPCConfig struct {
 	GRPCHost string `mapstructure:"grpc_host"`
 	GRPCPort string `mapstructure:"grpc_port"`
 }
 
+// SigningConfig selects how access tokens are signed. HS256 keeps the legacy shared secret;
+// RS256 and EdDSA sign with rotating keys published through the JWKS endpoint. A new key is
+// published PublishLead before it starts signing. KeyEncryptionKey is a base64 AES-256 key
+// that wraps the stored private keys; without it they are stored as plain PEM.
+type SigningConfig struct {
+	Algorithm        string
+	RotationInterval time.Duration
+	RetiredKeyGrace  time.Duration
+	PublishLead      time.Duration
+	KeyEncryptionKey string
+}
+
+// MFAConfig controls TOTP enrollment and the challenge issued by Login to MFA users.
+type MFAConfig struct {
+	Issuer       string
+	ChallengeTTL time.Duration
+}
+
+// AccountConfig controls the emailed password-reset and email-verification tokens.
+// The token is appended to the URLs as the "token" query parameter.
+type AccountConfig struct {
+	PasswordResetURL     string
+	EmailVerificationURL string
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+}
+
+// MailConfig selects the outbound mailer. "log" and "file" are stand-ins for local development.
+type MailConfig struct {
+	Driver       string
+	From         string
+	Dir          string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// OIDCProviderConfig registers one OpenID Connect identity provider under
+// auth.oidc.providers.<name>. Endpoints come from the issuer's discovery document.
+type OIDCProviderConfig struct {
+	Name         string
+	DisplayName  string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// TrustEmail treats the email claim as verified for IdPs that never send email_verified.
+	TrustEmail bool
+	Claims     OIDCClaimMapping
+}
+
+// OIDCClaimMapping names the ID token or userinfo claims read for each user attribute.
+type OIDCClaimMapping struct {
+	Email         string
+	EmailVerified string
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Username      string
+}
+
+// LoginThrottleConfig bounds failed password logins. Failures are counted per username and
+// per client IP within Window; after BackoffAfter failures each attempt waits BaseBackoff,
+// doubling up to MaxBackoff, and reaching MaxFailures (IPMaxFailures) locks the username
+// (IP) for LockoutDuration.
+type LoginThrottleConfig struct {
+	MaxFailures     int
+	IPMaxFailures   int
+	Window          time.Duration
+	BackoffAfter    int
+	BaseBackoff     time.Duration
+	MaxBackoff      time.Duration
+	LockoutDuration time.Duration
+}
+
+// APIKeyConfig bounds the lifetime of API keys. Keys created without an explicit expiry get
+// DefaultTTL; a requested expiry beyond MaxTTL is rejected.
+type APIKeyConfig struct {
+	DefaultTTL time.Duration
+	MaxTTL     time.Duration
+}
+
+// WebAuthnConfig identifies the relying party for passkeys and security keys. RPID is the
+// domain credentials are bound to and must be a registrable suffix of every origin; passkey
+// ceremonies are refused while it is empty. Origins defaults to https://<RPID>.
+type WebAuthnConfig struct {
+	RPID         string
+	RPName       string
+	Origins      []string
+	ChallengeTTL time.Duration
+}
+
+// SAMLConfig locates the service-provider endpoints of per-organization SAML SSO. BaseURL is
+// the public URL of the auth HTTP routes; SAML logins are refused while it is empty.
+type SAMLConfig struct {
+	BaseURL string
+}
+
 type AuthConfig struct {
 	JWTSecret      string
 	JWTKey         string
 	AppRedirectURL string
 	IAM            RPCConfig `mapstructure:"iam"`
+	Signing        SigningConfig
+	MFA            MFAConfig
+	Account        AccountConfig
+	Mail           MailConfig
+	OIDCProviders  []OIDCProviderConfig
+	LoginThrottle  LoginThrottleConfig
+	APIKeys        APIKeyConfig
+	WebAuthn       WebAuthnConfig
+	SAML           SAMLConfig
+	// LegacyHS256Until keeps accepting HS256 tokens signed with JWTSecret next to the
+	// asymmetric keys until this time; zero rejects them.
+	LegacyHS256Until time.Time
+	// TrustedProxies, normally the gateway, may set the client IP the login throttle counts.
+	TrustedProxies pdproxy.TrustedProxies
 }
 
 func NewAuthConfig(k *koanf.Koanf) AuthConfig {
@@ -39,7 +195,87 @@ func NewAuthConfig(k *koanf.Koanf) AuthConfig {
 		if cfg.IAM.GRPCPort == "" {
 			cfg.IAM.GRPCPort = k.String("auth.iam.grpc_port")
 		}
+		cfg.Signing.Algorithm = k.String("auth.signing.algorithm")
+		cfg.Signing.RotationInterval = k.Duration("auth.signing.rotation_interval")
+		cfg.Signing.RetiredKeyGrace = k.Duration("auth.signing.retired_key_grace")
+		cfg.Signing.PublishLead = k.Duration("auth.signing.publish_lead")
+		cfg.Signing.KeyEncryptionKey = k.String("auth.signing.key_encryption_key")
+		cfg.MFA.Issuer = k.String("auth.mfa.issuer")
+		cfg.MFA.ChallengeTTL = k.Duration("auth.mfa.challenge_ttl")
+		cfg.Account.PasswordResetURL = k.String("auth.account.password_reset_url")
+		cfg.Account.EmailVerificationURL = k.String("auth.account.email_verification_url")
+		cfg.Account.PasswordResetTTL = k.Duration("auth.account.password_reset_ttl")
+		cfg.Account.EmailVerificationTTL = k.Duration("auth.account.email_verification_ttl")
+		cfg.Mail.Driver = k.String("auth.mail.driver")
+		cfg.Mail.From = k.String("auth.mail.from")
+		cfg.Mail.Dir = k.String("auth.mail.dir")
+		cfg.Mail.SMTPHost = k.String("auth.mail.smtp_host")
+		cfg.Mail.SMTPPort = k.String("auth.mail.smtp_port")
+		cfg.Mail.SMTPUsername = k.String("auth.mail.smtp_username")
+		cfg.OIDCProviders = readOIDCProviders(k)
+		cfg.LoginThrottle.MaxFailures = k.Int("auth.login_throttle.max_failures")
+		cfg.LoginThrottle.IPMaxFailures = k.Int("auth.login_throttle.ip_max_failures")
+		cfg.LoginThrottle.Window = k.Duration("auth.login_throttle.window")
+		cfg.LoginThrottle.BackoffAfter = k.Int("auth.login_throttle.backoff_after")
+		cfg.LoginThrottle.BaseBackoff = k.Duration("auth.login_throttle.base_backoff")
+		cfg.LoginThrottle.MaxBackoff = k.Duration("auth.login_throttle.max_backoff")
+		cfg.LoginThrottle.LockoutDuration = k.Duration("auth.login_throttle.lockout_duration")
+		cfg.APIKeys.DefaultTTL = k.Duration("auth.api_keys.default_ttl")
+		cfg.APIKeys.MaxTTL = k.Duration("auth.api_keys.max_ttl")
+		cfg.WebAuthn.RPID = k.String("auth.webauthn.rp_id")
+		cfg.WebAuthn.RPName = k.String("auth.webauthn.rp_name")
+		cfg.WebAuthn.Origins = k.Strings("auth.webauthn.origins")
+		cfg.WebAuthn.ChallengeTTL = k.Duration("auth.webauthn.challenge_ttl")
+		cfg.SAML.BaseURL = k.String("auth.saml.base_url")
+	}
+	cfg.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "auth.legacy_hs256_until")
+	cfg.TrustedProxies = pdproxy.LoadTrustedProxies(k, "auth.trusted_proxies")
+	cfg.Signing.Algorithm = toolkit.GetEnv("JWT_SIGNING_ALGORITHM", cfg.Signing.Algorithm)
+	cfg.Signing.KeyEncryptionKey = toolkit.GetEnv("AUTH_SIGNING_KEY_ENCRYPTION_KEY", cfg.Signing.KeyEncryptionKey)
+	if strings.HasPrefix(cfg.Signing.KeyEncryptionKey, "${") {
+		cfg.Signing.KeyEncryptionKey = ""
+	}
+	if cfg.Signing.Algorithm == "" {
+		cfg.Signing.Algorithm = pdauthn.AlgorithmRS256
+	}
+	if cfg.Signing.RotationInterval <= 0 {
+		cfg.Signing.RotationInterval = defaultSigningRotationInterval
+	}
+	if cfg.Signing.RetiredKeyGrace <= 0 {
+		cfg.Signing.RetiredKeyGrace = defaultRetiredKeyGrace
+	}
+	if cfg.Signing.PublishLead <= 0 {
+		cfg.Signing.PublishLead = defaultSigningPublishLead
+	}
+	if cfg.MFA.Issuer == "" {
+		cfg.MFA.Issuer = defaultMFAIssuer
 	}
+	if cfg.MFA.ChallengeTTL <= 0 {
+		cfg.MFA.ChallengeTTL = defaultMFAChallengeTTL
+	}
+	cfg.Account.PasswordResetURL = toolkit.GetEnv("PASSWORD_RESET_URL", cfg.Account.PasswordResetURL)
+	cfg.Account.EmailVerificationURL = toolkit.GetEnv("EMAIL_VERIFICATION_URL", cfg.Account.EmailVerificationURL)
+	if cfg.Account.PasswordResetTTL <= 0 {
+		cfg.Account.PasswordResetTTL = defaultPasswordResetTTL
+	}
+	if cfg.Account.EmailVerificationTTL <= 0 {
+		cfg.Account.EmailVerificationTTL = defaultEmailVerificationTTL
+	}
+	cfg.Mail.Driver = toolkit.GetEnv("MAIL_DRIVER", cfg.Mail.Driver)
+	if cfg.Mail.Driver == "" {
+		cfg.Mail.Driver = MailDriverLog
+	}
+	if cfg.Mail.From == "" {
+		cfg.Mail.From = defaultMailFrom
+	}
+	if cfg.Mail.Dir == "" {
+		cfg.Mail.Dir = defaultMailDir
+	}
+	cfg.Mail.SMTPPassword = toolkit.GetEnv("SMTP_PASSWORD", "")
+	cfg.LoginThrottle = cfg.LoginThrottle.withDefaults()
+	cfg.APIKeys = cfg.APIKeys.withDefaults()
+	cfg.WebAuthn = cfg.WebAuthn.withDefaults()
+	cfg.SAML.BaseURL = strings.TrimRight(toolkit.GetEnv("SAML_BASE_URL", cfg.SAML.BaseURL), "/")
 	if cfg.IAM.GRPCHost == "" {
 		cfg.IAM.GRPCHost = toolkit.GetEnv("IAM_GRPC_HOST", "localhost")
 	}
@@ -48,3 +284,110 @@ func NewAuthConfig(k *koanf.Koanf) AuthConfig {
 	}
 	return cfg
 }
+
+func readOIDCProviders(k *koanf.Koanf) []OIDCProviderConfig {
+	names := k.MapKeys("auth.oidc.providers")
+	sort.Strings(names)
+	out := make([]OIDCProviderConfig, 0, len(names))
+	for _, name := range names {
+		prefix := "auth.oidc.providers." + name + "."
+		provider := OIDCProviderConfig{
+			Name:         name,
+			DisplayName:  k.String(prefix + "display_name"),
+			Issuer:       strings.TrimSuffix(k.String(prefix+"issuer"), "/"),
+			ClientID:     k.String(prefix + "client_id"),
+			ClientSecret: k.String(prefix + "client_secret"),
+			RedirectURL:  k.String(prefix + "redirect_url"),
+			Scopes:       k.Strings(prefix + "scopes"),
+			TrustEmail:   k.Bool(prefix + "trust_email"),
+			Claims: OIDCClaimMapping{
+				Email:         k.String(prefix + "claims.email"),
+				EmailVerified: k.String(prefix + "claims.email_verified"),
+				Name:          k.String(prefix + "claims.name"),
+				GivenName:     k.String(prefix + "claims.given_name"),
+				FamilyName:    k.String(prefix + "claims.family_name"),
+				Username:      k.String(prefix + "claims.username"),
+			},
+		}
+		envPrefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider.ClientID = toolkit.GetEnv(envPrefix+"CLIENT_ID", provider.ClientID)
+		provider.ClientSecret = toolkit.GetEnv(envPrefix+"CLIENT_SECRET", provider.ClientSecret)
+		if provider.DisplayName == "" {
+			provider.DisplayName = name
+		}
+		if len(provider.Scopes) == 0 {
+			provider.Scopes = []string{"openid", "email", "profile"}
+		}
+		provider.Claims = provider.Claims.withDefaults()
+		out = append(out, provider)
+	}
+	return out
+}
+
+func (m OIDCClaimMapping) withDefaults() OIDCClaimMapping {
+	defaults := map[*string]string{
+		&m.Email:         "email",
+		&m.EmailVerified: "email_verified",
+		&m.Name:          "name",
+		&m.GivenName:     "given_name",
+		&m.FamilyName:    "family_name",
+		&m.Username:      "preferred_username",
+	}
+	for field, value := range defaults {
+		if *field == "" {
+			*field = value
+		}
+	}
+	return m
+}
+
+func (c LoginThrottleConfig) withDefaults() LoginThrottleConfig {
+	if c.MaxFailures <= 0 {
+		c.MaxFailures = defaultLoginMaxFailures
+	}
+	if c.IPMaxFailures <= 0 {
+		c.IPMaxFailures = defaultLoginIPMaxFailures
+	}
+	if c.Window <= 0 {
+		c.Window = defaultLoginFailureWindow
+	}
+	if c.BackoffAfter <= 0 {
+		c.BackoffAfter = defaultLoginBackoffAfter
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = defaultLoginBaseBackoff
+	}
+	if c.MaxBackoff < c.BaseBackoff {
+		c.MaxBackoff = max(defaultLoginMaxBackoff, c.BaseBackoff)
+	}
+	if c.LockoutDuration <= 0 {
+		c.LockoutDuration = defaultLoginLockoutDuration
+	}
+	return c
+}
+
+func (c APIKeyConfig) withDefaults() APIKeyConfig {
+	if c.MaxTTL <= 0 {
+		c.MaxTTL = defaultAPIKeyMaxTTL
+	}
+	if c.DefaultTTL <= 0 {
+		c.DefaultTTL = defaultAPIKeyTTL
+	}
+	if c.DefaultTTL > c.MaxTTL {
+		c.DefaultTTL = c.MaxTTL
+	}
+	return c
+}
+
+func (c WebAuthnConfig) withDefaults() WebAuthnConfig {
+	if c.RPName == "" {
+		c.RPName = defaultWebAuthnRPName
+	}
+	if c.ChallengeTTL <= 0 {
+		c.ChallengeTTL = defaultWebAuthnChallengeTTL
+	}
+	if len(c.Origins) == 0 && c.RPID != "" {
+		c.Origins = []string{"https://" + c.RPID}
+	}
+	return c
+}
@@ -3,7 +3,11 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/rawbytes"
+	"github.com/knadh/koanf/v2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,4 +24,57 @@ func TestNewAuthConfig(t *testing.T) {
 	require.Equal(t, "redirect", cfg.AppRedirectURL)
 	require.Equal(t, "localhost", cfg.IAM.GRPCHost)
 	require.Equal(t, "50053", cfg.IAM.GRPCPort)
+	require.Equal(t, "RS256", cfg.Signing.Algorithm)
+	require.Equal(t, 30*24*time.Hour, cfg.Signing.RotationInterval)
+	require.Equal(t, 25*time.Hour, cfg.Signing.RetiredKeyGrace)
+	require.Equal(t, "Podzone", cfg.MFA.Issuer)
+	require.Equal(t, 5*time.Minute, cfg.MFA.ChallengeTTL)
+	require.Equal(t, time.Hour, cfg.Account.PasswordResetTTL)
+	require.Equal(t, 48*time.Hour, cfg.Account.EmailVerificationTTL)
+	require.Equal(t, MailDriverLog, cfg.Mail.Driver)
+	require.Equal(t, 5, cfg.LoginThrottle.MaxFailures)
+	require.Equal(t, 20, cfg.LoginThrottle.IPMaxFailures)
+	require.Equal(t, 15*time.Minute, cfg.LoginThrottle.LockoutDuration)
+}
+
+func TestNewAuthConfig_OIDCProviders(t *testing.T) {
+	t.Setenv("OIDC_KEYCLOAK_CLIENT_SECRET", "from-env")
+	k := koanf.New(".")
+	require.NoError(t, k.Load(rawbytes.Provider([]byte(`
+auth:
+  oidc:
+    providers:
+      keycloak:
+        issuer: https://sso.example.com/realms/podzone/
+        client_id: podzone
+        redirect_url: https://app.example.com/oidc/keycloak/callback
+        claims:
+          email: mail
+          username: login
+      gitlab:
+        display_name: GitLab
+        issuer: https://gitlab.com
+        client_id: gl
+        redirect_url: https://app.example.com/oidc/gitlab/callback
+        scopes: [openid, email]
+        trust_email: true
+`)), yaml.Parser()))
+
+	cfg := NewAuthConfig(k)
+	require.Len(t, cfg.OIDCProviders, 2)
+
+	gitlab := cfg.OIDCProviders[0]
+	require.Equal(t, "gitlab", gitlab.Name)
+	require.Equal(t, "GitLab", gitlab.DisplayName)
+	require.Equal(t, []string{"openid", "email"}, gitlab.Scopes)
+	require.True(t, gitlab.TrustEmail)
+	require.Equal(t, "email", gitlab.Claims.Email)
+
+	keycloak := cfg.OIDCProviders[1]
+	require.Equal(t, "https://sso.example.com/realms/podzone", keycloak.Issuer)
+	require.Equal(t, "from-env", keycloak.ClientSecret)
+	require.Equal(t, []string{"openid", "email", "profile"}, keycloak.Scopes)
+	require.Equal(t, "mail", keycloak.Claims.Email)
+	require.Equal(t, "login", keycloak.Claims.Username)
+	require.Equal(t, "email_verified", keycloak.Claims.EmailVerified)
 }
@@ -5,11 +5,13 @@ import (
 	"errors"
 
 	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
 func NewHandler(repo outputport.IAMProjectionRepository) (messaging.Handler, error) {
-	registry, err := messaging.NewRegistry(
+	registry, err := messaging.NewRegistryWithSchemas(
+		events.Schemas(),
 		NewTenantCreatedHandler(repo),
 		NewTenantMemberAddedHandler(repo),
 	)
@@ -64,3 +64,20 @@ func TestHandler_HandleTenantCreated_RepositoryFailureRetries(t *testing.T) {
 	assert.Equal(t, messaging.FailureActionRetry, classification.Action)
 	assert.Equal(t, "projection store unavailable", classification.Reason)
 }
+
+func TestHandler_HandleTenantCreated_UnknownSchemaVersionDeadLetters(t *testing.T) {
+	repo := mocks.NewMockIAMProjectionRepository(t)
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	err = handler.Handle(context.Background(), messaging.Envelope{
+		Type:          "tenant.created",
+		SchemaVersion: 9,
+		Payload:       []byte(`{"tenant_id":"tenant-1"}`),
+	})
+	require.ErrorIs(t, err, messaging.ErrUnknownSchemaVersion)
+
+	classification := messaging.DefaultErrorClassifier().Classify(context.Background(), messaging.Envelope{}, err)
+	assert.Equal(t, messaging.FailureActionDeadLetter, classification.Action)
+	assert.Equal(t, "invalid tenant.created payload", classification.Reason)
+}
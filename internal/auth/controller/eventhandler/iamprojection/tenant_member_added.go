@@ -2,10 +2,10 @@ package iamprojection
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
@@ -13,29 +13,18 @@ type TenantMemberAddedHandler struct {
 	repo outputport.IAMProjectionRepository
 }
 
-var _ messaging.TypedHandler = (*TenantMemberAddedHandler)(nil)
-
-func NewTenantMemberAddedHandler(repo outputport.IAMProjectionRepository) *TenantMemberAddedHandler {
-	return &TenantMemberAddedHandler{repo: repo}
-}
-
-func (h *TenantMemberAddedHandler) MessageType() string {
-	return "tenant.member.added"
+func NewTenantMemberAddedHandler(
+	repo outputport.IAMProjectionRepository,
+) *messaging.EventHandler[events.TenantMemberAddedV1] {
+	h := &TenantMemberAddedHandler{repo: repo}
+	return messaging.HandleEvent(events.TypeTenantMemberAdded, h.Handle)
 }
 
-func (h *TenantMemberAddedHandler) Handle(ctx context.Context, msg messaging.Envelope) error {
-	var payload struct {
-		TenantID string `json:"tenant_id"`
-		UserID   uint   `json:"user_id"`
-		RoleName string `json:"role_name"`
-		Status   string `json:"status"`
-	}
-	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
-		return messaging.DeadLetterError(
-			fmt.Errorf("decode tenant.member.added payload: %w", err),
-			"invalid tenant.member.added payload",
-		)
-	}
+func (h *TenantMemberAddedHandler) Handle(
+	ctx context.Context,
+	_ messaging.Envelope,
+	payload events.TenantMemberAddedV1,
+) error {
 	if err := h.repo.UpsertTenantMembership(
 		ctx, payload.TenantID, payload.UserID, payload.RoleName, payload.Status); err != nil {
 		return messaging.RetryableError(
@@ -0,0 +1,79 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) RequestPasswordReset(
+	ctx context.Context,
+	req *pbauthv1.RequestPasswordResetRequest,
+) (*pbauthv1.RequestPasswordResetResponse, error) {
+	if err := s.accountUC.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.RequestPasswordResetResponse{}, nil
+}
+
+func (s *AuthServer) ResetPassword(
+	ctx context.Context,
+	req *pbauthv1.ResetPasswordRequest,
+) (*pbauthv1.ResetPasswordResponse, error) {
+	userID, err := s.accountUC.ResetPassword(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, userID, "password.reset", "user", userResourceID(userID), "", nil)
+	return &pbauthv1.ResetPasswordResponse{}, nil
+}
+
+func (s *AuthServer) ChangePassword(
+	ctx context.Context,
+	req *pbauthv1.ChangePasswordRequest,
+) (*pbauthv1.ChangePasswordResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.accountUC.ChangePassword(
+		ctx,
+		actorUserID,
+		req.AccessToken,
+		req.CurrentPassword,
+		req.NewPassword,
+	); err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "password.changed", "user", userResourceID(actorUserID), "", nil)
+	return &pbauthv1.ChangePasswordResponse{}, nil
+}
+
+func (s *AuthServer) RequestEmailVerification(
+	ctx context.Context,
+	req *pbauthv1.RequestEmailVerificationRequest,
+) (*pbauthv1.RequestEmailVerificationResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.accountUC.RequestEmailVerification(ctx, actorUserID, req.AccessToken); err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.RequestEmailVerificationResponse{}, nil
+}
+
+func (s *AuthServer) VerifyEmail(
+	ctx context.Context,
+	req *pbauthv1.VerifyEmailRequest,
+) (*pbauthv1.VerifyEmailResponse, error) {
+	userID, err := s.accountUC.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, userID, "email.verified", "user", userResourceID(userID), "", nil)
+	return &pbauthv1.VerifyEmailResponse{}, nil
+}
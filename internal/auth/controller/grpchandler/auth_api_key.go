@@ -0,0 +1,72 @@
+package grpchandler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authmapper "github.com/tuannm99/podzone/internal/auth/controller/mapper"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) CreateAPIKey(
+	ctx context.Context,
+	req *pbauthv1.CreateAPIKeyRequest,
+) (*pbauthv1.CreateAPIKeyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	created, err := s.apiKeyUC.CreateAPIKey(ctx, actorUserID, req.AccessToken, inputport.CreateAPIKeyCommand{
+		Name:             req.Name,
+		TenantID:         req.TenantId,
+		ServicePrincipal: req.ServicePrincipal,
+		RoleName:         req.RoleName,
+		ExternalID:       req.ExternalId,
+		SessionPolicy:    authmapper.FromPBSessionPolicyStatements(req.SessionPolicy),
+		ExpiresIn:        time.Duration(req.ExpiresInSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.CreateAPIKeyResponse{
+		ApiKey: authmapper.ToPBAPIKey(&created.Key),
+		Key:    created.RawKey,
+	}, nil
+}
+
+func (s *AuthServer) ListAPIKeys(
+	ctx context.Context,
+	req *pbauthv1.ListAPIKeysRequest,
+) (*pbauthv1.ListAPIKeysResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	keys, err := s.apiKeyUC.ListAPIKeys(ctx, actorUserID, req.AccessToken)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	resp := &pbauthv1.ListAPIKeysResponse{ApiKeys: make([]*pbauthv1.APIKey, 0, len(keys))}
+	for i := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, authmapper.ToPBAPIKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) RevokeAPIKey(
+	ctx context.Context,
+	req *pbauthv1.RevokeAPIKeyRequest,
+) (*pbauthv1.RevokeAPIKeyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.apiKeyUC.RevokeAPIKey(ctx, actorUserID, req.AccessToken, req.ApiKeyId); err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.RevokeAPIKeyResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) UnlockLogin(
+	ctx context.Context,
+	req *pbauthv1.UnlockLoginRequest,
+) (*pbauthv1.UnlockLoginResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.loginThrottleUC.UnlockLogin(
+		ctx,
+		actorUserID,
+		req.AccessToken,
+		req.Username,
+		req.ClientIp,
+	); err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.UnlockLoginResponse{}, nil
+}
+
+// withClientIP records the client the throttle counts failures against: the gRPC peer, or the
+// x-real-ip the gateway set when the peer is one of auth.trusted_proxies.
+func (s *AuthServer) withClientIP(ctx context.Context) context.Context {
+	return entity.WithClientIP(ctx, s.proxies.ClientIPFromContext(ctx))
+}
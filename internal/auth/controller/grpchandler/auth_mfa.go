@@ -0,0 +1,78 @@
+package grpchandler
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authmapper "github.com/tuannm99/podzone/internal/auth/controller/mapper"
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) VerifyMFA(ctx context.Context, req *pbauthv1.VerifyMFARequest) (*pbauthv1.LoginResponse, error) {
+	authResp, err := s.authUC.VerifyMFA(ctx, req.MfaToken, req.Code)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	resp, err := authmapper.ToPBLoginResponse(authResp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.recordAudit(ctx, authResp.UserInfo.Id, "mfa.verified", "user", userResourceID(authResp.UserInfo.Id), "", nil)
+	return resp, nil
+}
+
+func (s *AuthServer) EnrollMFA(
+	ctx context.Context,
+	req *pbauthv1.EnrollMFARequest,
+) (*pbauthv1.EnrollMFAResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	enrollment, err := s.authUC.EnrollMFA(ctx, actorUserID, req.AccessToken)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.EnrollMFAResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}, nil
+}
+
+func (s *AuthServer) ActivateMFA(
+	ctx context.Context,
+	req *pbauthv1.ActivateMFARequest,
+) (*pbauthv1.ActivateMFAResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	recoveryCodes, err := s.authUC.ActivateMFA(ctx, actorUserID, req.AccessToken, req.Code)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "mfa.enabled", "user", userResourceID(actorUserID), "", nil)
+	return &pbauthv1.ActivateMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthServer) DisableMFA(
+	ctx context.Context,
+	req *pbauthv1.DisableMFARequest,
+) (*pbauthv1.DisableMFAResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.authUC.DisableMFA(ctx, actorUserID, req.AccessToken, req.Code); err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "mfa.disabled", "user", userResourceID(actorUserID), "", nil)
+	return &pbauthv1.DisableMFAResponse{}, nil
+}
+
+func userResourceID(userID uint) string {
+	return strconv.FormatUint(uint64(userID), 10)
+}
@@ -0,0 +1,63 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authmapper "github.com/tuannm99/podzone/internal/auth/controller/mapper"
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) ListIdentityProviders(
+	ctx context.Context,
+	req *pbauthv1.ListIdentityProvidersRequest,
+) (*pbauthv1.ListIdentityProvidersResponse, error) {
+	providers := s.authUC.ListIdentityProviders(ctx)
+	out := make([]*pbauthv1.IdentityProvider, 0, len(providers))
+	for _, provider := range providers {
+		out = append(out, &pbauthv1.IdentityProvider{Name: provider.Name, DisplayName: provider.DisplayName})
+	}
+	return &pbauthv1.ListIdentityProvidersResponse{Providers: out}, nil
+}
+
+func (s *AuthServer) OIDCLogin(ctx context.Context, req *pbauthv1.OIDCLoginRequest) (*pbauthv1.OIDCLoginResponse, error) {
+	authURL, err := s.authUC.StartOIDCLogin(ctx, req.Provider)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.OIDCLoginResponse{RedirectUrl: authURL}, nil
+}
+
+func (s *AuthServer) OIDCCallback(
+	ctx context.Context,
+	req *pbauthv1.OIDCCallbackRequest,
+) (*pbauthv1.OIDCCallbackResponse, error) {
+	result, err := s.authUC.HandleOIDCCallback(ctx, req.Provider, req.Code, req.State)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, result.UserID, "login.oidc", "user", userResourceID(result.UserID), "", map[string]any{
+		"provider": req.Provider,
+	})
+	return &pbauthv1.OIDCCallbackResponse{
+		ExchangeCode: result.ExchangeCode,
+		RedirectUrl:  result.RedirectURL,
+	}, nil
+}
+
+func (s *AuthServer) ExchangeOIDCLogin(
+	ctx context.Context,
+	req *pbauthv1.ExchangeOIDCLoginRequest,
+) (*pbauthv1.LoginResponse, error) {
+	authResp, err := s.authUC.ExchangeOIDCLogin(ctx, req.ExchangeCode)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	resp, err := authmapper.ToPBLoginResponse(authResp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/tuannm99/podzone/internal/auth/config"
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
@@ -18,34 +17,46 @@ import (
 	"google.golang.org/grpc/status"
 
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 )
 
 type AuthServer struct {
 	pbauthv1.UnimplementedAuthServiceServer
-	authUC         inputport.AuthUsecase
-	sessionRep     outputport.SessionRepository
-	auditRep       outputport.AuditLogRepository
-	userRepo       outputport.UserRepository
-	jwtSecret      string
-	jwtKey         string
-	appRedirectURL string
+	authUC          inputport.AuthUsecase
+	accountUC       inputport.AccountUsecase
+	loginThrottleUC inputport.LoginThrottleUsecase
+	apiKeyUC        inputport.APIKeyUsecase
+	sessionRep      outputport.SessionRepository
+	auditRep        outputport.AuditLogRepository
+	userRepo        outputport.UserRepository
+	verifier        *pdauthn.Verifier
+	proxies         pdproxy.TrustedProxies
+	appRedirectURL  string
 }
 
 func NewAuthServer(
 	authUC inputport.AuthUsecase,
+	accountUC inputport.AccountUsecase,
+	loginThrottleUC inputport.LoginThrottleUsecase,
+	apiKeyUC inputport.APIKeyUsecase,
 	sessionRep outputport.SessionRepository,
 	auditRep outputport.AuditLogRepository,
 	userRepo outputport.UserRepository,
 	cfg config.AuthConfig,
+	verifier *pdauthn.Verifier,
 ) *AuthServer {
 	return &AuthServer{
-		authUC:         authUC,
-		sessionRep:     sessionRep,
-		auditRep:       auditRep,
-		userRepo:       userRepo,
-		jwtSecret:      cfg.JWTSecret,
-		jwtKey:         cfg.JWTKey,
-		appRedirectURL: cfg.AppRedirectURL,
+		authUC:          authUC,
+		accountUC:       accountUC,
+		loginThrottleUC: loginThrottleUC,
+		apiKeyUC:        apiKeyUC,
+		sessionRep:      sessionRep,
+		auditRep:        auditRep,
+		userRepo:        userRepo,
+		verifier:        verifier,
+		proxies:         cfg.TrustedProxies,
+		appRedirectURL:  cfg.AppRedirectURL,
 	}
 }
 
@@ -84,7 +95,10 @@ func authStatusError(err error) error {
 	switch {
 	case err == nil:
 		return nil
-	case errors.Is(err, entity.ErrUserNotFound):
+	case errors.Is(err, entity.ErrUserNotFound),
+		errors.Is(err, entity.ErrOIDCProviderNotFound),
+		errors.Is(err, entity.ErrAPIKeyNotFound),
+		errors.Is(err, entity.ErrWebAuthnCredentialNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, entity.ErrWrongPassword):
 		return status.Error(codes.Unauthenticated, err.Error())
@@ -92,13 +106,44 @@ func authStatusError(err error) error {
 		errors.Is(err, entity.ErrUsernameExisted),
 		errors.Is(err, entity.ErrEmailExisted),
 		errors.Is(err, entity.ErrInvalidSessionPolicy),
-		errors.Is(err, entity.ErrInvalidUserID):
+		errors.Is(err, entity.ErrInvalidUserID),
+		errors.Is(err, entity.ErrPasswordTooShort),
+		errors.Is(err, entity.ErrEmailMissing),
+		errors.Is(err, entity.ErrUserTokenInvalid),
+		errors.Is(err, entity.ErrUnlockTargetMissing),
+		errors.Is(err, entity.ErrAPIKeyNameRequired),
+		errors.Is(err, entity.ErrAPIKeyTTLTooLong),
+		errors.Is(err, entity.ErrWebAuthnCredentialExists):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, entity.ErrSessionNotFound),
 		errors.Is(err, entity.ErrSessionRevoked),
 		errors.Is(err, entity.ErrRefreshTokenInvalid),
-		errors.Is(err, entity.ErrRefreshTokenExpired):
+		errors.Is(err, entity.ErrRefreshTokenExpired),
+		errors.Is(err, entity.ErrRefreshTokenReused),
+		errors.Is(err, entity.ErrMFACodeInvalid),
+		errors.Is(err, entity.ErrMFAChallengeInvalid),
+		errors.Is(err, entity.ErrOIDCStateInvalid),
+		errors.Is(err, entity.ErrOIDCTokenInvalid),
+		errors.Is(err, entity.ErrAPIKeyInvalid),
+		errors.Is(err, entity.ErrWebAuthnChallengeInvalid),
+		errors.Is(err, entity.ErrWebAuthnVerificationFailed):
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, entity.ErrPermissionDenied),
+		errors.Is(err, entity.ErrAPIKeyNotAllowed),
+		errors.Is(err, entity.ErrWebAuthnCloneDetected),
+		errors.Is(err, entity.ErrMembershipNotFound),
+		errors.Is(err, entity.ErrInactiveMembership):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, entity.ErrLoginThrottled):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, entity.ErrMFANotEnrolled),
+		errors.Is(err, entity.ErrMFAAlreadyEnabled),
+		errors.Is(err, entity.ErrEmailAlreadyVerified),
+		errors.Is(err, entity.ErrOIDCEmailNotVerified),
+		errors.Is(err, entity.ErrOIDCLinkRequiresVerifiedAccount),
+		errors.Is(err, entity.ErrWebAuthnNotConfigured),
+		errors.Is(err, entity.ErrSSORequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -156,20 +201,7 @@ func (s *AuthServer) claimsFromAccessToken(accessToken string) (*entity.JWTClaim
 }
 
 func (s *AuthServer) claimsFromTokenString(tokenString string) (*entity.JWTClaims, error) {
-	claims := &entity.JWTClaims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(tok *jwt.Token) (interface{}, error) {
-		if tok.Method != jwt.SigningMethodHS256 {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.jwtSecret), nil
-	})
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid access token")
-	}
-	if s.jwtKey != "" && claims.Key != s.jwtKey {
-		return nil, errors.New("invalid access token")
-	}
-	return claims, nil
+	return s.verifier.ClaimsFromTokenString(tokenString)
 }
 
 func toUint(v uint64) (uint, error) {
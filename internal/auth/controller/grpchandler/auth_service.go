@@ -66,9 +66,9 @@ func (s *AuthServer) Logout(ctx context.Context, req *pbauthv1.LogoutRequest) (*
 }
 
 func (s *AuthServer) Login(ctx context.Context, req *pbauthv1.LoginRequest) (*pbauthv1.LoginResponse, error) {
-	loginResp, err := s.authUC.Login(ctx, req.Username, req.Password)
+	loginResp, err := s.authUC.Login(s.withClientIP(ctx), req.Username, req.Password)
 	if err != nil {
-		return nil, err
+		return nil, authStatusError(err)
 	}
 	resp, err := authmapper.ToPBLoginResponse(loginResp)
 	if err != nil {
@@ -287,7 +287,7 @@ func (s *AuthServer) RevokeSession(
 		return nil, status.Error(codes.PermissionDenied, "cannot revoke another user's session")
 	}
 	now := time.Now().UTC()
-	if err := s.sessionRep.Revoke(ctx, session.ID, now); err != nil {
+	if err := s.sessionRep.Revoke(ctx, session.ID, entity.SessionRevokedReasonUserRevoked, now); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	s.recordAudit(ctx, actorUserID, "session.revoked", "session", session.ID, session.ActiveTenantID, map[string]any{
@@ -412,3 +412,43 @@ func (s *AuthServer) ListUsers(
 		PageInfo: authmapper.ToPBPageInfo(page),
 	}, nil
 }
+
+func (s *AuthServer) UpdateUserProfile(
+	ctx context.Context,
+	req *pbauthv1.UpdateUserProfileRequest,
+) (*pbauthv1.UpdateUserProfileResponse, error) {
+	userID, err := toUint(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.userRepo.Update(entity.User{
+		Id:        userID,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		FullName:  req.FullName,
+	}); err != nil {
+		return nil, authStatusError(err)
+	}
+	user, err := s.userRepo.GetByID(strconv.FormatUint(uint64(userID), 10))
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.UpdateUserProfileResponse{
+		UserInfo: authmapper.ToPBUserInfo(user),
+	}, nil
+}
+
+func (s *AuthServer) DeprovisionUser(
+	ctx context.Context,
+	req *pbauthv1.DeprovisionUserRequest,
+) (*pbauthv1.DeprovisionUserResponse, error) {
+	userID, err := toUint(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.accountUC.DeprovisionUser(ctx, userID, req.GetTenantIds()); err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, userID, "user.deprovisioned", "user", strconv.FormatUint(uint64(userID), 10), "", nil)
+	return &pbauthv1.DeprovisionUserResponse{}, nil
+}
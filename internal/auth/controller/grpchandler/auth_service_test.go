@@ -2,16 +2,24 @@ package grpchandler
 
 import (
 	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
 	inputport "github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
 	pbcommonv1 "github.com/tuannm99/podzone/pkg/api/proto/common/v1"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 )
 
 func TestGoogleLogin_OK(t *testing.T) {
@@ -41,6 +49,137 @@ func TestLogin_OK(t *testing.T) {
 	assert.Equal(t, "neo@mx.io", res.UserInfo.Email)
 }
 
+func TestLogin_ThrottledCarriesClientIP(t *testing.T) {
+	srv, authUC, _, _, _ := newAuthServer(t)
+	srv.proxies = pdproxy.NewTrustedProxies("10.0.0.0/8")
+	authUC.EXPECT().
+		Login(mock.MatchedBy(func(ctx context.Context) bool {
+			return entity.ClientIPFromContext(ctx) == "203.0.113.7"
+		}), "neo", "guess").
+		Return(nil, &entity.LoginThrottledError{Locked: true})
+
+	ctx := peer.NewContext(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-real-ip", "203.0.113.7")),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4711}},
+	)
+	_, err := srv.Login(ctx, &pbauthv1.LoginRequest{Username: "neo", Password: "guess"})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestLogin_IgnoresForwardedIPFromUntrustedPeer(t *testing.T) {
+	srv, authUC, _, _, _ := newAuthServer(t)
+	srv.proxies = pdproxy.NewTrustedProxies("10.0.0.0/8")
+	authUC.EXPECT().
+		Login(mock.MatchedBy(func(ctx context.Context) bool {
+			return entity.ClientIPFromContext(ctx) == "198.51.100.20"
+		}), "neo", "guess").
+		Return(nil, &entity.LoginThrottledError{Locked: true})
+
+	// Rotating x-real-ip or x-forwarded-for must not give an attacker a fresh IP budget.
+	ctx := peer.NewContext(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"x-real-ip", "192.0.2.10",
+			"x-forwarded-for", "192.0.2.11",
+		)),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.20"), Port: 4711}},
+	)
+	_, err := srv.Login(ctx, &pbauthv1.LoginRequest{Username: "neo", Password: "guess"})
+	require.Error(t, err)
+}
+
+func TestUnlockLogin_PermissionDenied(t *testing.T) {
+	srv, _, _, _, _ := newAuthServer(t)
+	throttleUC := inputmocks.NewMockLoginThrottleUsecase(t)
+	srv.loginThrottleUC = throttleUC
+	throttleUC.EXPECT().
+		UnlockLogin(mock.Anything, uint(7), "access-token", "neo", "").
+		Return(entity.ErrPermissionDenied)
+
+	_, err := srv.UnlockLogin(authContextForUser(t, 7), &pbauthv1.UnlockLoginRequest{
+		AccessToken: "access-token",
+		Username:    "neo",
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateAPIKey_ReturnsRawKeyOnce(t *testing.T) {
+	srv, _, _, _, _ := newAuthServer(t)
+	apiKeyUC := inputmocks.NewMockAPIKeyUsecase(t)
+	srv.apiKeyUC = apiKeyUC
+	createdAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	apiKeyUC.EXPECT().
+		CreateAPIKey(mock.Anything, uint(7), "access-token", inputport.CreateAPIKeyCommand{
+			Name:          "ci",
+			TenantID:      "tenant-1",
+			SessionPolicy: []entity.SessionPolicyStatement{},
+			ExpiresIn:     time.Hour,
+		}).
+		Return(&inputport.CreatedAPIKey{
+			RawKey: "pzk_abc_secret",
+			Key: entity.APIKey{
+				ID:        "key-1",
+				LookupID:  "abc",
+				KeyHash:   "hash",
+				Name:      "ci",
+				OwnerType: entity.APIKeyOwnerUser,
+				UserID:    7,
+				TenantID:  "tenant-1",
+				ExpiresAt: createdAt.Add(time.Hour),
+				CreatedAt: createdAt,
+			},
+		}, nil)
+
+	res, err := srv.CreateAPIKey(authContextForUser(t, 7), &pbauthv1.CreateAPIKeyRequest{
+		AccessToken:      "access-token",
+		Name:             "ci",
+		TenantId:         "tenant-1",
+		ExpiresInSeconds: 3600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pzk_abc_secret", res.Key)
+	assert.Equal(t, "key-1", res.ApiKey.Id)
+	assert.Equal(t, "pzk_abc", res.ApiKey.Prefix)
+	assert.Empty(t, res.ApiKey.LastUsedAt)
+
+	apiKeyUC.EXPECT().
+		RevokeAPIKey(mock.Anything, uint(7), "access-token", "missing").
+		Return(entity.ErrAPIKeyNotFound)
+	_, err = srv.RevokeAPIKey(authContextForUser(t, 7), &pbauthv1.RevokeAPIKeyRequest{
+		AccessToken: "access-token",
+		ApiKeyId:    "missing",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFinishWebAuthnLogin_MapsResult(t *testing.T) {
+	srv, authUC, _, auditRepo, _ := newAuthServer(t)
+	expectAuditMaybe(auditRepo)
+	authUC.EXPECT().
+		FinishWebAuthnLogin(mock.Anything, "challenge-1", "{}").
+		Return(&inputport.AuthResult{
+			JwtToken: "jwt-passkey",
+			UserInfo: entity.User{Id: 7, Username: "neo"},
+		}, nil)
+
+	res, err := srv.FinishWebAuthnLogin(context.Background(), &pbauthv1.FinishWebAuthnLoginRequest{
+		ChallengeId:    "challenge-1",
+		CredentialJson: "{}",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-passkey", res.JwtToken)
+
+	authUC.EXPECT().
+		FinishWebAuthnLogin(mock.Anything, "challenge-2", "{}").
+		Return(nil, entity.ErrWebAuthnCloneDetected)
+	_, err = srv.FinishWebAuthnLogin(context.Background(), &pbauthv1.FinishWebAuthnLoginRequest{
+		ChallengeId:    "challenge-2",
+		CredentialJson: "{}",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestSwitchActiveTenant_OK(t *testing.T) {
 	srv, authUC, _, auditRepo, _ := newAuthServer(t)
 	expectAuditMaybe(auditRepo)
@@ -109,6 +248,18 @@ func TestGetSession_OK(t *testing.T) {
 	assert.Equal(t, uint64(7), res.Session.UserId)
 }
 
+func TestGetSession_ShowsRevokedReason(t *testing.T) {
+	srv, _, sessionRepo, _, _ := newAuthServer(t)
+	session := sessionWithID("session-1", 7)
+	session.Status = entity.SessionStatusRevoked
+	session.RevokedReason = entity.SessionRevokedReasonRefreshTokenReuse
+	sessionRepo.EXPECT().GetByID(mock.Anything, "session-1").Return(session, nil)
+
+	res, err := srv.GetSession(context.Background(), &pbauthv1.GetSessionRequest{SessionId: "session-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "refresh_token_reuse", res.Session.RevokedReason)
+}
+
 func TestGetUserByIdentity_OK(t *testing.T) {
 	srv, _, _, _, userRepo := newAuthServer(t)
 	userRepo.EXPECT().GetByUsernameOrEmail("neo@mx.io").Return(&entity.User{
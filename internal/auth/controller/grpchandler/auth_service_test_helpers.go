@@ -36,10 +36,14 @@ func newAuthServer(t *testing.T) (
 	userRepo := outputmocks.NewMockUserRepository(t)
 	return NewAuthServer(
 		authUC,
+		inputmocks.NewMockAccountUsecase(t),
+		inputmocks.NewMockLoginThrottleUsecase(t),
+		inputmocks.NewMockAPIKeyUsecase(t),
 		sessionRepo,
 		auditRepo,
 		userRepo,
 		testAuthCfg,
+		authdomain.NewAccessTokenVerifier(testAuthCfg, nil),
 	), authUC, sessionRepo, auditRepo, userRepo
 }
 
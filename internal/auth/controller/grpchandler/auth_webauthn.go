@@ -0,0 +1,123 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authmapper "github.com/tuannm99/podzone/internal/auth/controller/mapper"
+	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+)
+
+func (s *AuthServer) BeginWebAuthnRegistration(
+	ctx context.Context,
+	req *pbauthv1.BeginWebAuthnRegistrationRequest,
+) (*pbauthv1.BeginWebAuthnRegistrationResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	ceremony, err := s.authUC.BeginWebAuthnRegistration(ctx, actorUserID, req.AccessToken)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.BeginWebAuthnRegistrationResponse{
+		ChallengeId: ceremony.ChallengeID,
+		OptionsJson: ceremony.OptionsJSON,
+	}, nil
+}
+
+func (s *AuthServer) FinishWebAuthnRegistration(
+	ctx context.Context,
+	req *pbauthv1.FinishWebAuthnRegistrationRequest,
+) (*pbauthv1.FinishWebAuthnRegistrationResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	credential, err := s.authUC.FinishWebAuthnRegistration(
+		ctx,
+		actorUserID,
+		req.AccessToken,
+		req.ChallengeId,
+		req.Name,
+		req.CredentialJson,
+	)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "webauthn.registered", "webauthn_credential", credential.ID, "", map[string]any{
+		"name":   credential.Name,
+		"aaguid": credential.AAGUID,
+	})
+	return &pbauthv1.FinishWebAuthnRegistrationResponse{
+		Credential: authmapper.ToPBWebAuthnCredential(credential),
+	}, nil
+}
+
+func (s *AuthServer) ListWebAuthnCredentials(
+	ctx context.Context,
+	req *pbauthv1.ListWebAuthnCredentialsRequest,
+) (*pbauthv1.ListWebAuthnCredentialsResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	credentials, err := s.authUC.ListWebAuthnCredentials(ctx, actorUserID, req.AccessToken)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	resp := &pbauthv1.ListWebAuthnCredentialsResponse{
+		Credentials: make([]*pbauthv1.WebAuthnCredential, 0, len(credentials)),
+	}
+	for i := range credentials {
+		resp.Credentials = append(resp.Credentials, authmapper.ToPBWebAuthnCredential(&credentials[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) DeleteWebAuthnCredential(
+	ctx context.Context,
+	req *pbauthv1.DeleteWebAuthnCredentialRequest,
+) (*pbauthv1.DeleteWebAuthnCredentialResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.authUC.DeleteWebAuthnCredential(ctx, actorUserID, req.AccessToken, req.Id); err != nil {
+		return nil, authStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "webauthn.deleted", "webauthn_credential", req.Id, "", nil)
+	return &pbauthv1.DeleteWebAuthnCredentialResponse{}, nil
+}
+
+func (s *AuthServer) BeginWebAuthnLogin(
+	ctx context.Context,
+	req *pbauthv1.BeginWebAuthnLoginRequest,
+) (*pbauthv1.BeginWebAuthnLoginResponse, error) {
+	ceremony, err := s.authUC.BeginWebAuthnLogin(ctx, req.Username, req.MfaToken)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	return &pbauthv1.BeginWebAuthnLoginResponse{
+		ChallengeId: ceremony.ChallengeID,
+		OptionsJson: ceremony.OptionsJSON,
+	}, nil
+}
+
+func (s *AuthServer) FinishWebAuthnLogin(
+	ctx context.Context,
+	req *pbauthv1.FinishWebAuthnLoginRequest,
+) (*pbauthv1.LoginResponse, error) {
+	authResp, err := s.authUC.FinishWebAuthnLogin(ctx, req.ChallengeId, req.CredentialJson)
+	if err != nil {
+		return nil, authStatusError(err)
+	}
+	resp, err := authmapper.ToPBLoginResponse(authResp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.recordAudit(ctx, authResp.UserInfo.Id, "webauthn.login", "user", userResourceID(authResp.UserInfo.Id), "", nil)
+	return resp, nil
+}
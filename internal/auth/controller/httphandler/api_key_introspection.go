@@ -0,0 +1,48 @@
+package httphandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// APIKeyIntrospectionHandler lets pdauthn verifiers in other services resolve API keys. The
+// answer reveals nothing beyond what the key holder can already do, so it needs no caller auth.
+type APIKeyIntrospectionHandler struct {
+	apiKeys inputport.APIKeyUsecase
+	logger  pdlog.Logger
+}
+
+func NewAPIKeyIntrospectionHandler(apiKeys inputport.APIKeyUsecase, logger pdlog.Logger) *APIKeyIntrospectionHandler {
+	return &APIKeyIntrospectionHandler{apiKeys: apiKeys, logger: logger}
+}
+
+func (h *APIKeyIntrospectionHandler) RegisterRoutes(r gin.IRoutes) {
+	r.POST(pdauthn.APIKeyIntrospectionPath, h.Introspect)
+}
+
+func (h *APIKeyIntrospectionHandler) Introspect(ctx *gin.Context) {
+	var req pdauthn.APIKeyIntrospectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil || req.APIKey == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": entity.ErrAPIKeyInvalid.Error()})
+		return
+	}
+	claims, err := h.apiKeys.ResolveAPIKey(ctx.Request.Context(), req.APIKey)
+	if errors.Is(err, entity.ErrAPIKeyInvalid) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to resolve api key", "err", err)
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "api key lookup unavailable"})
+		return
+	}
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, claims)
+}
@@ -0,0 +1,58 @@
+package httphandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func serveIntrospection(t *testing.T, apiKeys *inputmocks.MockAPIKeyUsecase, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewAPIKeyIntrospectionHandler(apiKeys, pdlog.NopLogger{}).RegisterRoutes(router)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, pdauthn.APIKeyIntrospectionPath, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAPIKeyIntrospection_ReturnsClaims(t *testing.T) {
+	apiKeys := inputmocks.NewMockAPIKeyUsecase(t)
+	apiKeys.EXPECT().
+		ResolveAPIKey(mock.Anything, "pzk_abc_secret").
+		Return(&pdauthn.Claims{UserID: 4, APIKeyID: "key-1", ActiveTenantID: "t1"}, nil)
+
+	recorder := serveIntrospection(t, apiKeys, `{"api_key":"pzk_abc_secret"}`)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "no-store", recorder.Header().Get("Cache-Control"))
+	var claims pdauthn.Claims
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &claims))
+	require.Equal(t, uint(4), claims.UserID)
+	require.Equal(t, "key-1", claims.APIKeyID)
+}
+
+func TestAPIKeyIntrospection_InvalidAndUnavailable(t *testing.T) {
+	apiKeys := inputmocks.NewMockAPIKeyUsecase(t)
+	apiKeys.EXPECT().ResolveAPIKey(mock.Anything, "pzk_revoked_key").Return(nil, entity.ErrAPIKeyInvalid)
+	apiKeys.EXPECT().ResolveAPIKey(mock.Anything, "pzk_any_key").Return(nil, errors.New("db down"))
+
+	require.Equal(t, http.StatusUnauthorized, serveIntrospection(t, apiKeys, `{"api_key":"pzk_revoked_key"}`).Code)
+	require.Equal(t, http.StatusUnauthorized, serveIntrospection(t, apiKeys, `{}`).Code)
+	require.Equal(t, http.StatusServiceUnavailable, serveIntrospection(t, apiKeys, `{"api_key":"pzk_any_key"}`).Code)
+}
@@ -0,0 +1,41 @@
+package httphandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// JWKSPath is where verifiers in other services fetch the access-token public keys.
+const JWKSPath = "/.well-known/jwks.json"
+
+// jwksMaxAge stays well under the retired-key grace so caches never miss a freshly rotated key
+// for long; verifiers also refetch on an unknown kid.
+const jwksMaxAge = "public, max-age=300"
+
+type JWKSHandler struct {
+	keys   inputport.SigningKeyUsecase
+	logger pdlog.Logger
+}
+
+func NewJWKSHandler(keys inputport.SigningKeyUsecase, logger pdlog.Logger) *JWKSHandler {
+	return &JWKSHandler{keys: keys, logger: logger}
+}
+
+func (h *JWKSHandler) RegisterRoutes(r gin.IRoutes) {
+	r.GET(JWKSPath, h.Get)
+}
+
+func (h *JWKSHandler) Get(ctx *gin.Context) {
+	doc, err := h.keys.JWKS(ctx.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to load JWKS", "err", err)
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "signing keys unavailable"})
+		return
+	}
+	ctx.Header("Cache-Control", jwksMaxAge)
+	ctx.JSON(http.StatusOK, doc)
+}
@@ -0,0 +1,54 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func serveJWKS(t *testing.T, keys *inputmocks.MockSigningKeyUsecase) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewJWKSHandler(keys, pdlog.NopLogger{}).RegisterRoutes(router)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, JWKSPath, nil))
+	return recorder
+}
+
+func TestJWKSHandler_ServesPublicKeys(t *testing.T) {
+	keys := inputmocks.NewMockSigningKeyUsecase(t)
+	keys.EXPECT().JWKS(mock.Anything).Return(pdauthn.JWKS{Keys: []pdauthn.JWK{
+		{Kty: "OKP", Kid: "kid-1", Use: "sig", Alg: pdauthn.AlgorithmEdDSA, Crv: "Ed25519", X: "abc"},
+	}}, nil)
+
+	recorder := serveJWKS(t, keys)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "public, max-age=300", recorder.Header().Get("Cache-Control"))
+	var doc pdauthn.JWKS
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc))
+	require.Len(t, doc.Keys, 1)
+	require.Equal(t, "kid-1", doc.Keys[0].Kid)
+}
+
+func TestJWKSHandler_KeyringUnavailable(t *testing.T) {
+	keys := inputmocks.NewMockSigningKeyUsecase(t)
+	keys.EXPECT().JWKS(mock.Anything).Return(pdauthn.JWKS{}, errors.New("db down"))
+
+	recorder := serveJWKS(t, keys)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Cache-Control"))
+}
@@ -0,0 +1,82 @@
+package httphandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// SAMLHandler serves the service-provider side of per-organization SAML SSO. The IdP POSTs
+// form-encoded responses to the ACS, which gRPC-gateway cannot bind, so these are plain
+// HTTP routes.
+type SAMLHandler struct {
+	auth   inputport.AuthUsecase
+	logger pdlog.Logger
+}
+
+func NewSAMLHandler(auth inputport.AuthUsecase, logger pdlog.Logger) *SAMLHandler {
+	return &SAMLHandler{auth: auth, logger: logger}
+}
+
+func (h *SAMLHandler) RegisterRoutes(r gin.IRoutes) {
+	r.GET("/auth/v1/saml/:org_id/login", h.Login)
+	r.POST("/auth/v1/saml/:org_id/acs", h.ACS)
+	r.GET("/auth/v1/saml/:org_id/metadata", h.Metadata)
+}
+
+func (h *SAMLHandler) Login(ctx *gin.Context) {
+	redirectURL, err := h.auth.StartSAMLLogin(ctx.Request.Context(), ctx.Param("org_id"))
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+	ctx.Redirect(http.StatusFound, redirectURL)
+}
+
+// ACS consumes the IdP's HTTP-POST binding response and sends the browser on to the app
+// with an exchange code.
+func (h *SAMLHandler) ACS(ctx *gin.Context) {
+	result, err := h.auth.HandleSAMLResponse(
+		ctx.Request.Context(),
+		ctx.Param("org_id"),
+		ctx.PostForm("SAMLResponse"),
+		ctx.PostForm("RelayState"),
+	)
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Redirect(http.StatusSeeOther, result.RedirectURL)
+}
+
+func (h *SAMLHandler) Metadata(ctx *gin.Context) {
+	metadata, err := h.auth.SAMLServiceProviderMetadata(ctx.Request.Context(), ctx.Param("org_id"))
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+	ctx.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+func (h *SAMLHandler) writeError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, entity.ErrSAMLConnectionNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, entity.ErrSAMLLoginInvalid):
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, entity.ErrSAMLAccountConflict),
+		errors.Is(err, entity.ErrUserAlreadyExists):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, entity.ErrSAMLNotConfigured):
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error("SAML login failed", "err", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "saml login failed"})
+	}
+}
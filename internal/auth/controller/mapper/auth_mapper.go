@@ -7,6 +7,7 @@ import (
 	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
 	pbcommonv1 "github.com/tuannm99/podzone/pkg/api/proto/common/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
@@ -48,6 +49,8 @@ func ToPBUserInfo(in *entity.User) *pbauthv1.UserInfo {
 		InitialFrom: in.InitialFrom,
 		Age:         int32(in.Age),
 		Dob:         in.Dob.Format(time.RFC3339),
+
+		EmailVerified: in.EmailVerifiedAt != nil,
 	}
 }
 
@@ -109,6 +112,8 @@ func ToPBSession(s *entity.Session) *pbauthv1.Session {
 		AssumedRoleSessionName:      s.AssumedRoleSessionName,
 		AssumedRoleSourceIdentity:   s.AssumedRoleSourceIdentity,
 		SessionTags:                 cloneStringMap(s.SessionTags),
+		IdentityProvider:            s.IdentityProvider,
+		RevokedReason:               s.RevokedReason,
 	}
 	if s.AssumedRoleExpiresAt != nil {
 		resp.AssumedRoleExpiresAt = s.AssumedRoleExpiresAt.Format(time.RFC3339)
@@ -116,6 +121,9 @@ func ToPBSession(s *entity.Session) *pbauthv1.Session {
 	if s.RevokedAt != nil {
 		resp.RevokedAt = s.RevokedAt.Format(time.RFC3339)
 	}
+	if s.MFAAuthenticatedAt != nil {
+		resp.MfaAuthenticatedAt = s.MFAAuthenticatedAt.Format(time.RFC3339)
+	}
 	return resp
 }
 
@@ -131,11 +139,61 @@ func ToPBAuditLog(a *entity.AuditLog) *pbauthv1.AuditLog {
 		ResourceId:   a.ResourceID,
 		TenantId:     a.TenantID,
 		Status:       a.Status,
+		Severity:     a.Severity,
 		PayloadJson:  a.PayloadJSON,
 		CreatedAt:    a.CreatedAt.Format(time.RFC3339),
 	}
 }
 
+func ToPBAPIKey(k *entity.APIKey) *pbauthv1.APIKey {
+	if k == nil {
+		return nil
+	}
+	resp := &pbauthv1.APIKey{
+		Id:               k.ID,
+		Name:             k.Name,
+		OwnerType:        k.OwnerType,
+		UserId:           uint64(k.UserID),
+		TenantId:         k.TenantID,
+		ServicePrincipal: k.ServicePrincipal,
+		RoleName:         k.RoleName,
+		SessionPolicy:    ToPBSessionPolicyStatements(k.SessionPolicy),
+		Prefix:           pdauthn.APIKeyTokenPrefix + k.LookupID,
+		ExpiresAt:        k.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:        k.CreatedAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt != nil {
+		resp.LastUsedAt = k.LastUsedAt.Format(time.RFC3339)
+	}
+	if k.RevokedAt != nil {
+		resp.RevokedAt = k.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func ToPBWebAuthnCredential(c *entity.WebAuthnCredential) *pbauthv1.WebAuthnCredential {
+	if c == nil {
+		return nil
+	}
+	resp := &pbauthv1.WebAuthnCredential{
+		Id:             c.ID,
+		Name:           c.Name,
+		CredentialId:   c.CredentialID,
+		Aaguid:         c.AAGUID,
+		Transports:     c.Transports,
+		BackupEligible: c.BackupEligible,
+		SignCount:      c.SignCount,
+		CreatedAt:      c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.CloneDetectedAt != nil {
+		resp.CloneDetectedAt = c.CloneDetectedAt.Format(time.RFC3339)
+	}
+	if c.LastUsedAt != nil {
+		resp.LastUsedAt = c.LastUsedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
 func ToPBSessionPolicyStatements(items []entity.SessionPolicyStatement) []*pbcommonv1.PolicyStatement {
 	out := make([]*pbcommonv1.PolicyStatement, 0, len(items))
 	for _, item := range items {
@@ -0,0 +1,306 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+var _ inputport.AccountUsecase = (*accountInteractorImpl)(nil)
+
+func NewAccountUsecase(
+	userRepository outputport.UserRepository,
+	sessionRepository outputport.SessionRepository,
+	refreshTokenRepository outputport.RefreshTokenRepository,
+	userTokenRepository outputport.UserTokenRepository,
+	apiKeyRepository outputport.APIKeyRepository,
+	mailer outputport.Mailer,
+	cfg config.AuthConfig,
+	verifier *pdauthn.Verifier,
+) *accountInteractorImpl {
+	return &accountInteractorImpl{
+		cfg:                 cfg.Account,
+		verifier:            verifier,
+		userRepository:      userRepository,
+		sessionRepository:   sessionRepository,
+		refreshTokenRepo:    refreshTokenRepository,
+		userTokenRepository: userTokenRepository,
+		apiKeyRepository:    apiKeyRepository,
+		mailer:              mailer,
+	}
+}
+
+type accountInteractorImpl struct {
+	cfg      config.AccountConfig
+	verifier *pdauthn.Verifier
+
+	userRepository      outputport.UserRepository
+	sessionRepository   outputport.SessionRepository
+	refreshTokenRepo    outputport.RefreshTokenRepository
+	userTokenRepository outputport.UserTokenRepository
+	apiKeyRepository    outputport.APIKeyRepository
+	mailer              outputport.Mailer
+}
+
+func (u *accountInteractorImpl) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return entity.ErrEmailMissing
+	}
+	user, err := u.userRepository.GetByUsernameOrEmail(email)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Email != email {
+		// Matched a username; never mail a reset link to an address the caller did not type.
+		return nil
+	}
+	token, err := u.issueUserToken(ctx, user, entity.UserTokenPurposePasswordReset, u.cfg.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+	return u.mailer.Send(ctx, entity.MailMessage{
+		To:      user.Email,
+		Subject: "Reset your Podzone password",
+		Body: fmt.Sprintf(
+			"Someone asked to reset the password for your Podzone account.\n\n"+
+				"Use this link within %s to choose a new password:\n%s\n\n"+
+				"If this was not you, ignore this email; your password is unchanged.\n",
+			u.cfg.PasswordResetTTL, tokenLink(u.cfg.PasswordResetURL, token),
+		),
+	})
+}
+
+func (u *accountInteractorImpl) ResetPassword(ctx context.Context, token, newPassword string) (uint, error) {
+	if err := entity.ValidateNewPassword(newPassword); err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	stored, err := u.consumeUserToken(ctx, entity.UserTokenPurposePasswordReset, token, now)
+	if err != nil {
+		return 0, err
+	}
+	if err := u.userRepository.Update(entity.User{Id: stored.UserID, Password: newPassword}); err != nil {
+		return 0, err
+	}
+	if err := u.revokeSessions(ctx, stored.UserID, "", entity.SessionRevokedReasonPasswordReset, now); err != nil {
+		return 0, err
+	}
+	return stored.UserID, nil
+}
+
+func (u *accountInteractorImpl) DeprovisionUser(ctx context.Context, userID uint, tenantIDs []string) error {
+	if userID == 0 {
+		return entity.ErrInvalidUserID
+	}
+	if _, err := u.userRepository.GetByID(fmt.Sprintf("%d", userID)); err != nil {
+		return err
+	}
+	if len(tenantIDs) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	// API keys outlive sessions, so a deprovisioned user would otherwise keep programmatic access.
+	if err := u.apiKeyRepository.RevokeByUserInTenants(ctx, userID, tenantIDs, now); err != nil {
+		return err
+	}
+	sessionIDs, err := u.sessionRepository.RevokeByUserInTenants(
+		ctx,
+		userID,
+		tenantIDs,
+		entity.SessionRevokedReasonDeprovisioned,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	for _, sessionID := range sessionIDs {
+		if err := u.refreshTokenRepo.RevokeBySession(ctx, sessionID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *accountInteractorImpl) ChangePassword(
+	ctx context.Context,
+	userID uint,
+	accessToken, currentPassword, newPassword string,
+) error {
+	session, err := u.ownedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return err
+	}
+	if err := entity.ValidateNewPassword(newPassword); err != nil {
+		return err
+	}
+	user, err := u.userRepository.GetByID(fmt.Sprintf("%d", userID))
+	if err != nil {
+		return err
+	}
+	if err := entity.CheckPassword(user.Password, currentPassword); err != nil {
+		return err
+	}
+	if err := u.userRepository.Update(entity.User{Id: userID, Password: newPassword}); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	if err := u.userTokenRepository.InvalidateByUser(ctx, userID, entity.UserTokenPurposePasswordReset, now); err != nil {
+		return err
+	}
+	return u.revokeSessions(ctx, userID, session.ID, entity.SessionRevokedReasonPasswordChanged, now)
+}
+
+func (u *accountInteractorImpl) RequestEmailVerification(ctx context.Context, userID uint, accessToken string) error {
+	if _, err := u.ownedActiveSession(ctx, userID, accessToken); err != nil {
+		return err
+	}
+	user, err := u.userRepository.GetByID(fmt.Sprintf("%d", userID))
+	if err != nil {
+		return err
+	}
+	if user.Email == "" {
+		return entity.ErrEmailMissing
+	}
+	if user.EmailVerifiedAt != nil {
+		return entity.ErrEmailAlreadyVerified
+	}
+	token, err := u.issueUserToken(ctx, user, entity.UserTokenPurposeEmailVerification, u.cfg.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	return u.mailer.Send(ctx, entity.MailMessage{
+		To:      user.Email,
+		Subject: "Verify your Podzone email address",
+		Body: fmt.Sprintf(
+			"Confirm that %s belongs to your Podzone account:\n%s\n\nThe link expires in %s.\n",
+			user.Email, tokenLink(u.cfg.EmailVerificationURL, token), u.cfg.EmailVerificationTTL,
+		),
+	})
+}
+
+func (u *accountInteractorImpl) VerifyEmail(ctx context.Context, token string) (uint, error) {
+	now := time.Now().UTC()
+	stored, err := u.consumeUserToken(ctx, entity.UserTokenPurposeEmailVerification, token, now)
+	if err != nil {
+		return 0, err
+	}
+	// The token is bound to the address it was sent to, so a changed email stays unverified.
+	if err := u.userRepository.MarkEmailVerified(ctx, stored.UserID, stored.Email, now); err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return 0, entity.ErrUserTokenInvalid
+		}
+		return 0, err
+	}
+	return stored.UserID, nil
+}
+
+// issueUserToken replaces any outstanding token of the same purpose with a fresh one.
+func (u *accountInteractorImpl) issueUserToken(
+	ctx context.Context,
+	user *entity.User,
+	purpose string,
+	ttl time.Duration,
+) (string, error) {
+	raw, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s token: %w", purpose, err)
+	}
+	now := time.Now().UTC()
+	if err := u.userTokenRepository.InvalidateByUser(ctx, user.Id, purpose, now); err != nil {
+		return "", err
+	}
+	if err := u.userTokenRepository.Create(ctx, entity.UserToken{
+		ID:        uuid.NewString(),
+		UserID:    user.Id,
+		Purpose:   purpose,
+		TokenHash: entity.HashToken(raw),
+		Email:     user.Email,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}); err != nil {
+		return "", err
+	}
+	return raw, nil
+}
+
+func (u *accountInteractorImpl) consumeUserToken(
+	ctx context.Context,
+	purpose, raw string,
+	now time.Time,
+) (*entity.UserToken, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, entity.ErrUserTokenInvalid
+	}
+	return u.userTokenRepository.Consume(ctx, purpose, entity.HashToken(raw), now)
+}
+
+func (u *accountInteractorImpl) revokeSessions(
+	ctx context.Context,
+	userID uint,
+	exceptSessionID, reason string,
+	now time.Time,
+) error {
+	sessionIDs, err := u.sessionRepository.RevokeByUser(ctx, userID, exceptSessionID, reason, now)
+	if err != nil {
+		return err
+	}
+	for _, sessionID := range sessionIDs {
+		if err := u.refreshTokenRepo.RevokeBySession(ctx, sessionID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *accountInteractorImpl) ownedActiveSession(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+) (*entity.Session, error) {
+	if userID == 0 {
+		return nil, entity.ErrInvalidUserID
+	}
+	claims, err := u.verifier.ClaimsFromTokenString(accessToken)
+	if err != nil || claims.SessionID == "" {
+		return nil, entity.ErrSessionNotFound
+	}
+	session, err := u.sessionRepository.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if session.UserID != userID || session.Status != entity.SessionStatusActive ||
+		session.RevokedAt != nil || now.After(session.ExpiresAt) {
+		return nil, entity.ErrSessionRevoked
+	}
+	return session, nil
+}
+
+func tokenLink(base, token string) string {
+	if base == "" {
+		return token
+	}
+	link, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+)
+
+type accountTestDeps struct {
+	users     *outputmocks.MockUserRepository
+	sessions  *outputmocks.MockSessionRepository
+	refresh   *outputmocks.MockRefreshTokenRepository
+	tokens    *outputmocks.MockUserTokenRepository
+	apiKeys   *outputmocks.MockAPIKeyRepository
+	mailer    *outputmocks.MockMailer
+	stored    map[string]entity.UserToken
+	sentMails []entity.MailMessage
+}
+
+var testAccountCfg = config.AuthConfig{
+	JWTSecret: "secret",
+	JWTKey:    "app-key",
+	Account: config.AccountConfig{
+		PasswordResetURL:     "https://app.example.com/reset-password",
+		EmailVerificationURL: "https://app.example.com/verify-email",
+		PasswordResetTTL:     time.Hour,
+		EmailVerificationTTL: time.Hour,
+	},
+}
+
+func newAccountUC(t *testing.T) (*accountInteractorImpl, *accountTestDeps) {
+	t.Helper()
+	deps := &accountTestDeps{
+		users:    outputmocks.NewMockUserRepository(t),
+		sessions: outputmocks.NewMockSessionRepository(t),
+		refresh:  outputmocks.NewMockRefreshTokenRepository(t),
+		tokens:   outputmocks.NewMockUserTokenRepository(t),
+		apiKeys:  outputmocks.NewMockAPIKeyRepository(t),
+		mailer:   outputmocks.NewMockMailer(t),
+		stored:   map[string]entity.UserToken{},
+	}
+	deps.tokens.EXPECT().
+		InvalidateByUser(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Maybe()
+	deps.tokens.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, token entity.UserToken) error {
+			deps.stored[token.TokenHash] = token
+			return nil
+		}).
+		Maybe()
+	deps.tokens.EXPECT().
+		Consume(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, purpose, hash string, usedAt time.Time) (*entity.UserToken, error) {
+			item, ok := deps.stored[hash]
+			if !ok || item.Purpose != purpose || item.UsedAt != nil || !usedAt.Before(item.ExpiresAt) {
+				return nil, entity.ErrUserTokenInvalid
+			}
+			item.UsedAt = &usedAt
+			deps.stored[hash] = item
+			return &item, nil
+		}).
+		Maybe()
+	deps.mailer.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, message entity.MailMessage) error {
+			deps.sentMails = append(deps.sentMails, message)
+			return nil
+		}).
+		Maybe()
+
+	uc := NewAccountUsecase(
+		deps.users,
+		deps.sessions,
+		deps.refresh,
+		deps.tokens,
+		deps.apiKeys,
+		deps.mailer,
+		testAccountCfg,
+		NewAccessTokenVerifier(testAccountCfg, nil),
+	)
+	return uc, deps
+}
+
+// tokenFromMail pulls the token query parameter out of the link in a sent message.
+func tokenFromMail(t *testing.T, message entity.MailMessage) string {
+	t.Helper()
+	for _, field := range strings.Fields(message.Body) {
+		if !strings.HasPrefix(field, "https://") {
+			continue
+		}
+		link, err := url.Parse(field)
+		require.NoError(t, err)
+		return link.Query().Get("token")
+	}
+	t.Fatalf("no link in mail body: %q", message.Body)
+	return ""
+}
+
+func TestResetPassword_RevokesAllSessions(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := newAccountUC(t)
+	user := &entity.User{Id: 4, Username: "neo", Email: "neo@mx.io"}
+	deps.users.EXPECT().GetByUsernameOrEmail("neo@mx.io").Return(user, nil)
+
+	require.NoError(t, uc.RequestPasswordReset(ctx, "neo@mx.io"))
+	require.Len(t, deps.sentMails, 1)
+	assert.Equal(t, "neo@mx.io", deps.sentMails[0].To)
+	raw := tokenFromMail(t, deps.sentMails[0])
+	require.NotEmpty(t, raw)
+	_, storedRaw := deps.stored[raw]
+	assert.False(t, storedRaw, "only the token hash is persisted")
+
+	deps.users.EXPECT().Update(entity.User{Id: 4, Password: "n3w-passw0rd"}).Return(nil)
+	deps.sessions.EXPECT().
+		RevokeByUser(mock.Anything, uint(4), "", entity.SessionRevokedReasonPasswordReset, mock.Anything).
+		Return([]string{"s1", "s2"}, nil)
+	deps.refresh.EXPECT().RevokeBySession(mock.Anything, "s1", mock.Anything).Return(nil)
+	deps.refresh.EXPECT().RevokeBySession(mock.Anything, "s2", mock.Anything).Return(nil)
+
+	userID, err := uc.ResetPassword(ctx, raw, "n3w-passw0rd")
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), userID)
+
+	_, err = uc.ResetPassword(ctx, raw, "another-passw0rd")
+	require.ErrorIs(t, err, entity.ErrUserTokenInvalid, "reset tokens are single use")
+}
+
+func TestRequestPasswordReset_UnknownEmailIsSilent(t *testing.T) {
+	uc, deps := newAccountUC(t)
+	deps.users.EXPECT().GetByUsernameOrEmail("ghost@mx.io").Return(nil, entity.ErrUserNotFound)
+
+	require.NoError(t, uc.RequestPasswordReset(context.Background(), "ghost@mx.io"))
+	assert.Empty(t, deps.sentMails)
+}
+
+func TestResetPassword_RejectsShortPassword(t *testing.T) {
+	uc, _ := newAccountUC(t)
+	_, err := uc.ResetPassword(context.Background(), "token", "short")
+	require.ErrorIs(t, err, entity.ErrPasswordTooShort)
+}
+
+func TestChangePassword_KeepsCurrentSession(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := newAccountUC(t)
+	hashed, err := entity.GeneratePasswordHash("old-passw0rd")
+	require.NoError(t, err)
+	deps.users.EXPECT().GetByID("4").Return(&entity.User{Id: 4, Password: hashed}, nil)
+	deps.sessions.EXPECT().GetByID(mock.Anything, "current").Return(&entity.Session{
+		ID:        "current",
+		UserID:    4,
+		Status:    entity.SessionStatusActive,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	accessToken, err := NewTokenUsecase(testAccountCfg).CreateJwtTokenForSession(entity.User{Id: 4}, "", "current")
+	require.NoError(t, err)
+
+	err = uc.ChangePassword(ctx, 4, accessToken, "wrong", "n3w-passw0rd")
+	require.ErrorIs(t, err, entity.ErrWrongPassword)
+
+	deps.users.EXPECT().Update(entity.User{Id: 4, Password: "n3w-passw0rd"}).Return(nil)
+	deps.sessions.EXPECT().
+		RevokeByUser(mock.Anything, uint(4), "current", entity.SessionRevokedReasonPasswordChanged, mock.Anything).
+		Return([]string{"other"}, nil)
+	deps.refresh.EXPECT().RevokeBySession(mock.Anything, "other", mock.Anything).Return(nil)
+
+	require.NoError(t, uc.ChangePassword(ctx, 4, accessToken, "old-passw0rd", "n3w-passw0rd"))
+}
+
+func TestVerifyEmail_BindsTokenToAddress(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := newAccountUC(t)
+	deps.users.EXPECT().GetByID("4").Return(&entity.User{Id: 4, Email: "neo@mx.io"}, nil)
+	deps.sessions.EXPECT().GetByID(mock.Anything, "current").Return(&entity.Session{
+		ID:        "current",
+		UserID:    4,
+		Status:    entity.SessionStatusActive,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	accessToken, err := NewTokenUsecase(testAccountCfg).CreateJwtTokenForSession(entity.User{Id: 4}, "", "current")
+	require.NoError(t, err)
+
+	require.NoError(t, uc.RequestEmailVerification(ctx, 4, accessToken))
+	require.Len(t, deps.sentMails, 1)
+	raw := tokenFromMail(t, deps.sentMails[0])
+
+	deps.users.EXPECT().MarkEmailVerified(mock.Anything, uint(4), "neo@mx.io", mock.Anything).Return(nil)
+	userID, err := uc.VerifyEmail(ctx, raw)
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), userID)
+
+	_, err = uc.VerifyEmail(ctx, raw)
+	require.ErrorIs(t, err, entity.ErrUserTokenInvalid)
+}
+
+func TestDeprovisionUser_RevokesSessionsAndAPIKeysInTenants(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := newAccountUC(t)
+	tenants := []string{"t1", "t2"}
+	deps.users.EXPECT().GetByID("4").Return(&entity.User{Id: 4, Username: "neo"}, nil)
+	deps.apiKeys.EXPECT().RevokeByUserInTenants(mock.Anything, uint(4), tenants, mock.Anything).Return(nil)
+	deps.sessions.EXPECT().
+		RevokeByUserInTenants(mock.Anything, uint(4), tenants, entity.SessionRevokedReasonDeprovisioned, mock.Anything).
+		Return([]string{"s1"}, nil)
+	deps.refresh.EXPECT().RevokeBySession(mock.Anything, "s1", mock.Anything).Return(nil)
+
+	require.NoError(t, uc.DeprovisionUser(ctx, 4, tenants))
+}
+
+func TestDeprovisionUser_WithoutTenantsKeepsAccess(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := newAccountUC(t)
+	deps.users.EXPECT().GetByID("4").Return(&entity.User{Id: 4, Username: "neo"}, nil)
+
+	require.NoError(t, uc.DeprovisionUser(ctx, 4, nil))
+}
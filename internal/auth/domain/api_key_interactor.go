@@ -0,0 +1,315 @@
+package domain
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+const (
+	apiKeyCreatedEventType = events.TypeAPIKeyCreated
+	apiKeyRevokedEventType = events.TypeAPIKeyRevoked
+
+	// apiKeyLastUsedGranularity bounds how often a busy key writes last_used_at.
+	apiKeyLastUsedGranularity = time.Minute
+)
+
+var _ inputport.APIKeyUsecase = (*apiKeyInteractorImpl)(nil)
+
+func NewAPIKeyUsecase(
+	apiKeyRepository outputport.APIKeyRepository,
+	sessionRepository outputport.SessionRepository,
+	userRepository outputport.UserRepository,
+	tenantAccessChecker outputport.TenantAccessChecker,
+	roleAssumer outputport.RoleAssumer,
+	securityEvents *SecurityEvents,
+	cfg config.AuthConfig,
+	verifier *pdauthn.Verifier,
+) *apiKeyInteractorImpl {
+	return &apiKeyInteractorImpl{
+		cfg:                 cfg.APIKeys,
+		verifier:            verifier,
+		apiKeyRepository:    apiKeyRepository,
+		sessionRepository:   sessionRepository,
+		userRepository:      userRepository,
+		tenantAccessChecker: tenantAccessChecker,
+		roleAssumer:         roleAssumer,
+		securityEvents:      securityEvents,
+		now:                 func() time.Time { return time.Now().UTC() },
+	}
+}
+
+type apiKeyInteractorImpl struct {
+	cfg      config.APIKeyConfig
+	verifier *pdauthn.Verifier
+
+	apiKeyRepository    outputport.APIKeyRepository
+	sessionRepository   outputport.SessionRepository
+	userRepository      outputport.UserRepository
+	tenantAccessChecker outputport.TenantAccessChecker
+	roleAssumer         outputport.RoleAssumer
+	securityEvents      *SecurityEvents
+	now                 func() time.Time
+}
+
+func (u *apiKeyInteractorImpl) CreateAPIKey(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+	cmd inputport.CreateAPIKeyCommand,
+) (*inputport.CreatedAPIKey, error) {
+	if err := u.requireSession(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return nil, entity.ErrAPIKeyNameRequired
+	}
+	ttl := cmd.ExpiresIn
+	if ttl <= 0 {
+		ttl = u.cfg.DefaultTTL
+	}
+	if ttl > u.cfg.MaxTTL {
+		return nil, entity.ErrAPIKeyTTLTooLong
+	}
+	var policy []entity.SessionPolicyStatement
+	if len(cmd.SessionPolicy) > 0 {
+		policy = normalizeSessionPolicyStatements(cmd.SessionPolicy)
+		if len(policy) == 0 {
+			return nil, entity.ErrInvalidSessionPolicy
+		}
+	}
+
+	now := u.now()
+	key := entity.APIKey{
+		ID:            uuid.NewString(),
+		Name:          name,
+		OwnerType:     entity.APIKeyOwnerUser,
+		UserID:        userID,
+		TenantID:      strings.TrimSpace(cmd.TenantID),
+		SessionPolicy: policy,
+		ExpiresAt:     now.Add(ttl),
+		CreatedAt:     now,
+	}
+	servicePrincipal := strings.TrimSpace(cmd.ServicePrincipal)
+	if servicePrincipal != "" {
+		// The role is assumed once here so IAM checks its trust policy against the creator.
+		// The key carries the resolved role but lives no longer than the role session IAM
+		// granted, so AssumeRole's duration cap bounds it like any other role session.
+		assumed, err := u.roleAssumer.AssumeRole(ctx, outputport.AssumeRoleInput{
+			AccessToken:      accessToken,
+			UserID:           userID,
+			RoleName:         strings.TrimSpace(cmd.RoleName),
+			TenantID:         key.TenantID,
+			ExternalID:       cmd.ExternalID,
+			SessionName:      "api-key-" + key.ID,
+			DurationSeconds:  uint32(ttl / time.Second),
+			ServicePrincipal: servicePrincipal,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if assumed.ExpiresAt.Before(key.ExpiresAt) {
+			key.ExpiresAt = assumed.ExpiresAt
+		}
+		key.OwnerType = entity.APIKeyOwnerServicePrincipal
+		key.ServicePrincipal = assumed.ServicePrincipal
+		key.RoleID = assumed.RoleID
+		key.RoleScope = assumed.RoleScope
+		key.RoleName = assumed.RoleName
+		key.RoleTenantID = assumed.TenantID
+		if assumed.RoleScope == outputport.PolicyScopeTenant {
+			key.TenantID = assumed.TenantID
+		}
+	} else if key.TenantID != "" {
+		if err := u.tenantAccessChecker.EnsureActiveMembership(ctx, key.TenantID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, lookupID, err := pdauthn.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+	key.LookupID = lookupID
+	key.KeyHash = entity.HashToken(raw)
+	if err := u.apiKeyRepository.Create(ctx, key); err != nil {
+		return nil, err
+	}
+	if err := u.securityEvents.record(ctx, securityEvent{
+		At:           now,
+		ActorUserID:  userID,
+		Action:       "api_key.created",
+		Severity:     entity.AuditSeverityInfo,
+		ResourceType: "api_key",
+		ResourceID:   key.ID,
+		TenantID:     key.TenantID,
+		EventType:    apiKeyCreatedEventType,
+		MessageKey:   key.ID,
+		Payload:      apiKeyEventPayload(key),
+	}); err != nil {
+		return nil, err
+	}
+	return &inputport.CreatedAPIKey{RawKey: raw, Key: key}, nil
+}
+
+func (u *apiKeyInteractorImpl) ListAPIKeys(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+) ([]entity.APIKey, error) {
+	if err := u.requireSession(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+	return u.apiKeyRepository.ListByUser(ctx, userID)
+}
+
+func (u *apiKeyInteractorImpl) RevokeAPIKey(
+	ctx context.Context,
+	userID uint,
+	accessToken, keyID string,
+) error {
+	if err := u.requireSession(ctx, userID, accessToken); err != nil {
+		return err
+	}
+	keyID = strings.TrimSpace(keyID)
+	if keyID == "" {
+		return entity.ErrAPIKeyNotFound
+	}
+	now := u.now()
+	if err := u.apiKeyRepository.Revoke(ctx, keyID, userID, now); err != nil {
+		return err
+	}
+	return u.securityEvents.record(ctx, securityEvent{
+		At:           now,
+		ActorUserID:  userID,
+		Action:       "api_key.revoked",
+		Severity:     entity.AuditSeverityInfo,
+		ResourceType: "api_key",
+		ResourceID:   keyID,
+		EventType:    apiKeyRevokedEventType,
+		MessageKey:   keyID,
+		Payload:      events.APIKeyRevokedV1{APIKeyID: keyID, UserID: userID},
+	})
+}
+
+func (u *apiKeyInteractorImpl) ResolveAPIKey(ctx context.Context, rawKey string) (*pdauthn.Claims, error) {
+	rawKey = strings.TrimSpace(rawKey)
+	lookupID, ok := pdauthn.ParseAPIKey(rawKey)
+	if !ok {
+		return nil, entity.ErrAPIKeyInvalid
+	}
+	key, err := u.apiKeyRepository.GetByLookupID(ctx, lookupID)
+	if errors.Is(err, entity.ErrAPIKeyNotFound) {
+		return nil, entity.ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := u.now()
+	if subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(entity.HashToken(rawKey))) != 1 ||
+		!key.Active(now) {
+		return nil, entity.ErrAPIKeyInvalid
+	}
+	user, err := u.userRepository.GetByID(fmt.Sprintf("%d", key.UserID))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, entity.ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	memberOf := key.TenantID
+	if key.OwnerType == entity.APIKeyOwnerServicePrincipal {
+		memberOf = key.RoleTenantID
+	}
+	if memberOf != "" {
+		// A key stops working for a tenant its creator has left, whether it acts as the creator
+		// or as a role the creator assumed there.
+		if err := u.tenantAccessChecker.EnsureActiveMembership(ctx, memberOf, key.UserID); err != nil {
+			if errors.Is(err, entity.ErrMembershipNotFound) || errors.Is(err, entity.ErrInactiveMembership) {
+				return nil, entity.ErrAPIKeyInvalid
+			}
+			return nil, err
+		}
+	}
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyLastUsedGranularity {
+		if err := u.apiKeyRepository.TouchLastUsed(ctx, key.ID, now); err != nil {
+			return nil, err
+		}
+	}
+	claims := &pdauthn.Claims{
+		UserID:         user.Id,
+		Email:          user.Email,
+		Username:       user.Username,
+		IdentitySource: entity.IdentitySourceAPIKey,
+		ActiveTenantID: key.TenantID,
+		SessionPolicy:  key.SessionPolicy,
+		APIKeyID:       key.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(key.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(key.CreatedAt),
+		},
+	}
+	if key.OwnerType == entity.APIKeyOwnerServicePrincipal {
+		claims.AssumedRoleID = key.RoleID
+		claims.AssumedRoleScope = key.RoleScope
+		claims.AssumedRoleName = key.RoleName
+		claims.AssumedRoleTenantID = key.RoleTenantID
+		claims.AssumedRoleServicePrincipal = key.ServicePrincipal
+		claims.AssumedRoleSessionName = "api-key-" + key.ID
+		claims.AssumedRoleSourceIdentity = user.Username
+	}
+	return claims, nil
+}
+
+// requireSession accepts only interactive sessions, so a leaked key cannot mint new keys.
+func (u *apiKeyInteractorImpl) requireSession(ctx context.Context, userID uint, accessToken string) error {
+	if userID == 0 {
+		return entity.ErrInvalidUserID
+	}
+	if pdauthn.IsAPIKey(strings.TrimSpace(accessToken)) {
+		return entity.ErrAPIKeyNotAllowed
+	}
+	claims, err := u.verifier.ClaimsFromTokenString(accessToken)
+	if err != nil || claims.SessionID == "" {
+		return entity.ErrSessionNotFound
+	}
+	if claims.APIKeyID != "" {
+		return entity.ErrAPIKeyNotAllowed
+	}
+	session, err := u.sessionRepository.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != userID || session.Status != entity.SessionStatusActive ||
+		session.RevokedAt != nil || u.now().After(session.ExpiresAt) {
+		return entity.ErrSessionRevoked
+	}
+	return nil
+}
+
+func apiKeyEventPayload(key entity.APIKey) events.APIKeyCreatedV1 {
+	return events.APIKeyCreatedV1{
+		APIKeyID:         key.ID,
+		Name:             key.Name,
+		OwnerType:        key.OwnerType,
+		UserID:           key.UserID,
+		TenantID:         key.TenantID,
+		ServicePrincipal: key.ServicePrincipal,
+		RoleName:         key.RoleName,
+		ExpiresAt:        key.ExpiresAt,
+	}
+}
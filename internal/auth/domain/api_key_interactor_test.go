@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+)
+
+type apiKeyTestDeps struct {
+	keys     *outputmocks.MockAPIKeyRepository
+	sessions *outputmocks.MockSessionRepository
+	users    *outputmocks.MockUserRepository
+	tenants  *outputmocks.MockTenantAccessChecker
+	roles    *outputmocks.MockRoleAssumer
+	stored   map[string]entity.APIKey
+	touched  []string
+	now      time.Time
+}
+
+var testAPIKeyCfg = config.AuthConfig{
+	JWTSecret: "secret",
+	JWTKey:    "app-key",
+	APIKeys: config.APIKeyConfig{
+		DefaultTTL: 24 * time.Hour,
+		MaxTTL:     48 * time.Hour,
+	},
+}
+
+func newAPIKeyUC(t *testing.T) (*apiKeyInteractorImpl, *apiKeyTestDeps, string) {
+	t.Helper()
+	deps := &apiKeyTestDeps{
+		keys:     outputmocks.NewMockAPIKeyRepository(t),
+		sessions: outputmocks.NewMockSessionRepository(t),
+		users:    outputmocks.NewMockUserRepository(t),
+		tenants:  outputmocks.NewMockTenantAccessChecker(t),
+		roles:    outputmocks.NewMockRoleAssumer(t),
+		stored:   map[string]entity.APIKey{},
+		now:      time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+	deps.keys.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, key entity.APIKey) error {
+			deps.stored[key.LookupID] = key
+			return nil
+		}).
+		Maybe()
+	deps.keys.EXPECT().
+		GetByLookupID(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, lookupID string) (*entity.APIKey, error) {
+			key, ok := deps.stored[lookupID]
+			if !ok {
+				return nil, entity.ErrAPIKeyNotFound
+			}
+			return &key, nil
+		}).
+		Maybe()
+	deps.keys.EXPECT().
+		Revoke(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, id string, userID uint, at time.Time) error {
+			for lookupID, key := range deps.stored {
+				if key.ID == id && key.UserID == userID && key.RevokedAt == nil {
+					key.RevokedAt = &at
+					deps.stored[lookupID] = key
+					return nil
+				}
+			}
+			return entity.ErrAPIKeyNotFound
+		}).
+		Maybe()
+	deps.keys.EXPECT().
+		TouchLastUsed(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, id string, at time.Time) error {
+			deps.touched = append(deps.touched, id)
+			for lookupID, key := range deps.stored {
+				if key.ID == id {
+					key.LastUsedAt = &at
+					deps.stored[lookupID] = key
+				}
+			}
+			return nil
+		}).
+		Maybe()
+	deps.sessions.EXPECT().GetByID(mock.Anything, "current").Return(&entity.Session{
+		ID:        "current",
+		UserID:    4,
+		Status:    entity.SessionStatusActive,
+		ExpiresAt: deps.now.Add(time.Hour),
+	}, nil).Maybe()
+	deps.users.EXPECT().GetByID("4").Return(&entity.User{Id: 4, Username: "neo", Email: "neo@mx.io"}, nil).Maybe()
+
+	uc := NewAPIKeyUsecase(
+		deps.keys,
+		deps.sessions,
+		deps.users,
+		deps.tenants,
+		deps.roles,
+		nil,
+		testAPIKeyCfg,
+		NewAccessTokenVerifier(testAPIKeyCfg, nil),
+	)
+	uc.now = func() time.Time { return deps.now }
+	accessToken, err := NewTokenUsecase(testAPIKeyCfg).CreateJwtTokenForSession(entity.User{Id: 4}, "", "current")
+	require.NoError(t, err)
+	return uc, deps, accessToken
+}
+
+func TestCreateAPIKey_ResolvesToScopedClaims(t *testing.T) {
+	ctx := context.Background()
+	uc, deps, accessToken := newAPIKeyUC(t)
+	deps.tenants.EXPECT().EnsureActiveMembership(mock.Anything, "t1", uint(4)).Return(nil)
+
+	created, err := uc.CreateAPIKey(ctx, 4, accessToken, inputport.CreateAPIKeyCommand{
+		Name:     " ci deploy ",
+		TenantID: "t1",
+		SessionPolicy: []entity.SessionPolicyStatement{
+			{ActionPattern: "catalog:read_product"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ci deploy", created.Key.Name)
+	assert.Equal(t, entity.APIKeyOwnerUser, created.Key.OwnerType)
+	assert.Equal(t, deps.now.Add(24*time.Hour), created.Key.ExpiresAt)
+	stored := deps.stored[created.Key.LookupID]
+	assert.Equal(t, entity.HashToken(created.RawKey), stored.KeyHash)
+	assert.NotEqual(t, created.RawKey, stored.KeyHash, "only the key hash is persisted")
+
+	claims, err := uc.ResolveAPIKey(ctx, created.RawKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), claims.UserID)
+	assert.Equal(t, "neo", claims.Username)
+	assert.Equal(t, created.Key.ID, claims.APIKeyID)
+	assert.Equal(t, "t1", claims.ActiveTenantID)
+	assert.Equal(t, entity.IdentitySourceAPIKey, claims.IdentitySource)
+	assert.Empty(t, claims.SessionID)
+	require.Len(t, claims.SessionPolicy, 1)
+	assert.Equal(t, "allow", claims.SessionPolicy[0].Effect)
+	assert.Equal(t, "*", claims.SessionPolicy[0].ResourcePattern)
+	assert.Equal(t, deps.now.Add(24*time.Hour), claims.ExpiresAt.Time)
+
+	// last_used_at is refreshed at most once per minute.
+	deps.now = deps.now.Add(10 * time.Second)
+	_, err = uc.ResolveAPIKey(ctx, created.RawKey)
+	require.NoError(t, err)
+	assert.Equal(t, []string{created.Key.ID}, deps.touched)
+
+	_, err = uc.ResolveAPIKey(ctx, created.RawKey+"x")
+	require.ErrorIs(t, err, entity.ErrAPIKeyInvalid)
+
+	require.NoError(t, uc.RevokeAPIKey(ctx, 4, accessToken, created.Key.ID))
+	_, err = uc.ResolveAPIKey(ctx, created.RawKey)
+	require.ErrorIs(t, err, entity.ErrAPIKeyInvalid)
+}
+
+func TestCreateAPIKey_ServicePrincipalCarriesAssumedRole(t *testing.T) {
+	ctx := context.Background()
+	uc, deps, accessToken := newAPIKeyUC(t)
+	deps.roles.EXPECT().
+		AssumeRole(mock.Anything, mock.MatchedBy(func(in outputport.AssumeRoleInput) bool {
+			return in.UserID == 4 && in.RoleName == "deployer" && in.ServicePrincipal == "ci.podzone.io" &&
+				in.DurationSeconds == uint32((48*time.Hour)/time.Second)
+		})).
+		Return(&outputport.AssumedRole{
+			RoleID:           9,
+			RoleScope:        outputport.PolicyScopeTenant,
+			RoleName:         "deployer",
+			TenantID:         "t2",
+			ServicePrincipal: "ci.podzone.io",
+			ExpiresAt:        deps.now.Add(12 * time.Hour),
+		}, nil)
+	deps.tenants.EXPECT().EnsureActiveMembership(mock.Anything, "t2", uint(4)).Return(nil).Once()
+
+	created, err := uc.CreateAPIKey(ctx, 4, accessToken, inputport.CreateAPIKeyCommand{
+		Name:             "pipeline",
+		ServicePrincipal: "ci.podzone.io",
+		RoleName:         "deployer",
+		ExpiresIn:        48 * time.Hour,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.APIKeyOwnerServicePrincipal, created.Key.OwnerType)
+	assert.Equal(t, "t2", created.Key.TenantID)
+	assert.Equal(t, deps.now.Add(12*time.Hour), created.Key.ExpiresAt, "the key ends with the role session")
+
+	claims, err := uc.ResolveAPIKey(ctx, created.RawKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), claims.AssumedRoleID)
+	assert.Equal(t, "deployer", claims.AssumedRoleName)
+	assert.Equal(t, "t2", claims.AssumedRoleTenantID)
+	assert.Equal(t, "ci.podzone.io", claims.AssumedRoleServicePrincipal)
+	assert.Equal(t, "t2", claims.ActiveTenantID)
+
+	deps.tenants.EXPECT().EnsureActiveMembership(mock.Anything, "t2", uint(4)).Return(entity.ErrMembershipNotFound).Once()
+	_, err = uc.ResolveAPIKey(ctx, created.RawKey)
+	require.ErrorIs(t, err, entity.ErrAPIKeyInvalid, "the key stops when its creator leaves the tenant")
+
+	deps.now = deps.now.Add(12 * time.Hour)
+	_, err = uc.ResolveAPIKey(ctx, created.RawKey)
+	require.ErrorIs(t, err, entity.ErrAPIKeyInvalid, "expired keys stop resolving")
+}
+
+func TestCreateAPIKey_Rejections(t *testing.T) {
+	ctx := context.Background()
+	uc, deps, accessToken := newAPIKeyUC(t)
+
+	_, err := uc.CreateAPIKey(ctx, 4, accessToken, inputport.CreateAPIKeyCommand{Name: " "})
+	require.ErrorIs(t, err, entity.ErrAPIKeyNameRequired)
+
+	_, err = uc.CreateAPIKey(ctx, 4, accessToken, inputport.CreateAPIKeyCommand{
+		Name:      "forever",
+		ExpiresIn: 72 * time.Hour,
+	})
+	require.ErrorIs(t, err, entity.ErrAPIKeyTTLTooLong)
+
+	deps.tenants.EXPECT().
+		EnsureActiveMembership(mock.Anything, "other", uint(4)).
+		Return(entity.ErrMembershipNotFound)
+	_, err = uc.CreateAPIKey(ctx, 4, accessToken, inputport.CreateAPIKeyCommand{Name: "x", TenantID: "other"})
+	require.ErrorIs(t, err, entity.ErrMembershipNotFound)
+
+	_, err = uc.CreateAPIKey(ctx, 4, "pzk_abc_def", inputport.CreateAPIKeyCommand{Name: "nested"})
+	require.ErrorIs(t, err, entity.ErrAPIKeyNotAllowed, "keys cannot mint keys")
+	assert.Empty(t, deps.stored)
+}
@@ -100,7 +100,15 @@ func TestAssumeRole_Success(t *testing.T) {
 		tenantAccessChecker,
 		roleAssumer,
 		outputmocks.NewMockAccountBootstrapper(t),
+		outputmocks.NewMockMFARepository(t),
+		outputmocks.NewMockWebAuthnCredentialRepository(t),
+		outputmocks.NewMockOIDCProviderRegistry(t),
+		outputmocks.NewMockUserIdentityRepository(t),
+		outputmocks.NewMockSAMLConnectionResolver(t),
+		nil,
+		nil,
 		cfg,
+		NewAccessTokenVerifier(cfg, nil),
 	)
 	state.sessions["session-1"] = entity.Session{
 		ID:             "session-1",
@@ -180,7 +188,15 @@ func TestAssumeRole_IAMDeny(t *testing.T) {
 		tenantAccessChecker,
 		roleAssumer,
 		outputmocks.NewMockAccountBootstrapper(t),
+		outputmocks.NewMockMFARepository(t),
+		outputmocks.NewMockWebAuthnCredentialRepository(t),
+		outputmocks.NewMockOIDCProviderRegistry(t),
+		outputmocks.NewMockUserIdentityRepository(t),
+		outputmocks.NewMockSAMLConnectionResolver(t),
+		nil,
+		nil,
 		cfg,
+		NewAccessTokenVerifier(cfg, nil),
 	)
 	state.sessions["session-1"] = entity.Session{
 		ID:             "session-1",
@@ -266,7 +282,15 @@ func TestClearAssumedRole_PreservesSessionPolicy(t *testing.T) {
 		tenantAccessChecker,
 		roleAssumer,
 		outputmocks.NewMockAccountBootstrapper(t),
+		outputmocks.NewMockMFARepository(t),
+		outputmocks.NewMockWebAuthnCredentialRepository(t),
+		outputmocks.NewMockOIDCProviderRegistry(t),
+		outputmocks.NewMockUserIdentityRepository(t),
+		outputmocks.NewMockSAMLConnectionResolver(t),
+		nil,
+		nil,
 		cfg,
+		NewAccessTokenVerifier(cfg, nil),
 	)
 	state.sessions["session-1"] = entity.Session{
 		ID:                  "session-1",
@@ -2,25 +2,69 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
 	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
 )
 
+const sessionCompromisedEventType = events.TypeSessionCompromised
+
 func (u *authInteractorImpl) Login(ctx context.Context, username, password string) (*inputport.AuthResult, error) {
+	clientIP := entity.ClientIPFromContext(ctx)
 	user, err := u.userRepository.GetByUsernameOrEmail(username)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		// Unknown names are throttled too, so lockouts do not reveal which accounts exist.
+		if err := u.loginThrottle.Check(ctx, username, clientIP); err != nil {
+			return nil, err
+		}
+		if recordErr := u.loginThrottle.RecordFailure(ctx, username, clientIP, 0); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
 	if err != nil {
 		return nil, err
 	}
+	// Username and email logins of one account share its username counter.
+	if err := u.loginThrottle.Check(ctx, user.Username, clientIP); err != nil {
+		return nil, err
+	}
 
 	err = entity.CheckPassword(user.Password, password)
+	if err != nil {
+		if recordErr := u.loginThrottle.RecordFailure(ctx, user.Username, clientIP, user.Id); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
+	// Checked only after the password matched, so the response does not reveal which accounts
+	// belong to an SSO organization. A failed lookup refuses the login rather than skip the rule.
+	ssoOrgs, err := u.samlConnections.SSORequiredOrganizations(ctx, user.Id)
+	if err != nil {
+		return nil, fmt.Errorf("check sso requirement: %w", err)
+	}
+	if len(ssoOrgs) > 0 {
+		return nil, entity.ErrSSORequired
+	}
+
+	mfaMethods, err := u.mfaMethods(ctx, user.Id)
 	if err != nil {
 		return nil, err
 	}
+	if len(mfaMethods) > 0 {
+		// The username counter is cleared once the second factor passes, so a known password
+		// does not reset the count of wrong codes.
+		return u.newMFAChallenge(user.Id, entity.IdentityProviderPodzone, mfaMethods)
+	}
+	if err := u.loginThrottle.RecordSuccess(ctx, user.Username); err != nil {
+		return nil, err
+	}
 
-	result, err := u.newSessionAuthResult(ctx, user, "")
+	result, err := u.newSessionAuthResult(ctx, user, "", entity.IdentityProviderPodzone, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +92,7 @@ func (u *authInteractorImpl) Register(ctx context.Context, req inputport.Registe
 	}
 	user.InitialFrom = "podzone"
 
-	result, err := u.newSessionAuthResult(ctx, user, "")
+	result, err := u.newSessionAuthResult(ctx, user, "", entity.IdentityProviderPodzone, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +174,9 @@ func (u *authInteractorImpl) RefreshAccessToken(
 	}
 	now := time.Now().UTC()
 	if stored.RevokedAt != nil {
+		if stored.ReplacedByTokenID != nil {
+			return nil, u.revokeCompromisedSession(ctx, stored, now)
+		}
 		return nil, entity.ErrRefreshTokenInvalid
 	}
 	if now.After(stored.ExpiresAt) {
@@ -154,6 +201,9 @@ func (u *authInteractorImpl) RefreshAccessToken(
 		return nil, err
 	}
 	if err := u.refreshTokenRepo.Revoke(ctx, stored.ID, now, &refreshEntity.ID); err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenRotated) {
+			return nil, u.lostRotation(ctx, hashed, now)
+		}
 		return nil, err
 	}
 	if err := u.refreshTokenRepo.Create(ctx, refreshEntity); err != nil {
@@ -170,13 +220,71 @@ func (u *authInteractorImpl) RefreshAccessToken(
 	}, nil
 }
 
+// lostRotation handles a refresh that read the token as live but found it revoked when
+// rotating it: a concurrent request presented the same token and won. Two clients using
+// one token at once is reuse like any other.
+func (u *authInteractorImpl) lostRotation(ctx context.Context, tokenHash string, now time.Time) error {
+	current, err := u.refreshTokenRepo.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+	if current.ReplacedByTokenID == nil {
+		return entity.ErrRefreshTokenInvalid
+	}
+	return u.revokeCompromisedSession(ctx, current, now)
+}
+
+// revokeCompromisedSession handles a rotated refresh token presented again. Either the
+// legitimate client or an attacker holds a copy, and there is no telling which, so the
+// whole session family is revoked.
+func (u *authInteractorImpl) revokeCompromisedSession(
+	ctx context.Context,
+	reused *entity.RefreshToken,
+	now time.Time,
+) error {
+	session, err := u.sessionRepository.GetByID(ctx, reused.SessionID)
+	if err != nil {
+		return err
+	}
+	if session.Status != entity.SessionStatusActive || session.RevokedAt != nil {
+		return entity.ErrRefreshTokenReused
+	}
+	if err := u.sessionRepository.Revoke(ctx, session.ID, entity.SessionRevokedReasonRefreshTokenReuse, now); err != nil {
+		return err
+	}
+	if err := u.refreshTokenRepo.RevokeBySession(ctx, session.ID, now); err != nil {
+		return err
+	}
+	if err := u.securityEvents.record(ctx, securityEvent{
+		At:           now,
+		ActorUserID:  session.UserID,
+		Action:       "session.compromised",
+		Severity:     entity.AuditSeverityHigh,
+		ResourceType: "session",
+		ResourceID:   session.ID,
+		TenantID:     session.ActiveTenantID,
+		EventType:    sessionCompromisedEventType,
+		MessageKey:   session.ID,
+		Payload: events.SessionCompromisedV1{
+			SessionID:         session.ID,
+			UserID:            session.UserID,
+			RefreshTokenID:    reused.ID,
+			ReplacedByTokenID: *reused.ReplacedByTokenID,
+			ReusedAt:          now,
+		},
+	}); err != nil {
+		return err
+	}
+	return entity.ErrRefreshTokenReused
+}
+
 func (u *authInteractorImpl) Logout(ctx context.Context, accessToken string) (string, error) {
 	session, err := u.sessionFromAccessToken(accessToken)
 	if err != nil {
 		return "/", err
 	}
 	now := time.Now().UTC()
-	if err := u.sessionRepository.Revoke(ctx, session.ID, now); err != nil {
+	if err := u.sessionRepository.Revoke(ctx, session.ID, entity.SessionRevokedReasonLogout, now); err != nil {
 		return "/", err
 	}
 	if err := u.refreshTokenRepo.RevokeBySession(ctx, session.ID, now); err != nil {
@@ -14,6 +14,8 @@ import (
 	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
 	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
 	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingmocks "github.com/tuannm99/podzone/pkg/messaging/mocks"
 )
 
 func TestLogin_Success(t *testing.T) {
@@ -31,7 +33,13 @@ func TestLogin_Success(t *testing.T) {
 	}
 
 	ur.On("GetByUsernameOrEmail", "jdoe").Return(user, nil)
-	tuc.On("CreateJwtTokenForSession", *user, "", mock.AnythingOfType("string")).Return("jwt-token", nil)
+	tuc.On(
+		"CreateJwtTokenForSessionState",
+		*user,
+		mock.MatchedBy(func(session entity.Session) bool {
+			return session.ID != "" && !session.CreatedAt.IsZero()
+		}),
+	).Return("jwt-token", nil)
 
 	uc := newUC(t, uuc, tuc, ext, ur, sr)
 
@@ -54,12 +62,11 @@ func TestRegister_Success(t *testing.T) {
 	ur.On("Create", entity.User{Username: "neo", Password: "TheOne!", Email: "neo@mx.io"}).Return(created, nil)
 	ur.On("UpdateById", uint(10), entity.User{InitialFrom: "podzone"}).Return(nil)
 	tuc.On(
-		"CreateJwtTokenForSession",
+		"CreateJwtTokenForSessionState",
 		mock.MatchedBy(func(user entity.User) bool {
 			return user.Id == created.Id && user.InitialFrom == "podzone"
 		}),
-		"",
-		mock.AnythingOfType("string"),
+		mock.AnythingOfType("entity.Session"),
 	).Return("jwt-register", nil)
 
 	uc := newUC(t, uuc, tuc, ext, ur, sr)
@@ -236,3 +243,132 @@ func TestRefreshAccessToken_Success(t *testing.T) {
 	assert.NotNil(t, storedOld.RevokedAt)
 	userRepo.AssertExpectations(t)
 }
+
+func TestRefreshAccessToken_ConcurrentRefreshRevokesSessionFamily(t *testing.T) {
+	cfg := config.AuthConfig{JWTSecret: "secret", JWTKey: "app-key"}
+	now := time.Now().UTC()
+	rawRefresh := "refresh-raw-token"
+	userRepo := &outputmocks.MockUserRepository{}
+	userRepo.On("GetByID", "9").Return(&entity.User{Id: 9, Username: "neo"}, nil)
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		&outputmocks.MockOauthStateRepository{},
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	var events []messaging.OutboxRecord
+	outbox := messagingmocks.NewMockOutboxStore(t)
+	outbox.EXPECT().
+		Append(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
+			events = append(events, record)
+			return nil
+		})
+	auditRepo := outputmocks.NewMockAuditLogRepository(t)
+	auditRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	uc.securityEvents = NewSecurityEvents(auditRepo, outbox)
+	state.sessions["session-1"] = entity.Session{
+		ID:             "session-1",
+		UserID:         9,
+		ActiveTenantID: "tenant-1",
+		Status:         entity.SessionStatusActive,
+		ExpiresAt:      now.Add(time.Hour),
+	}
+	state.refreshTokens[entity.HashToken(rawRefresh)] = entity.RefreshToken{
+		ID:        "refresh-1",
+		SessionID: "session-1",
+		TokenHash: entity.HashToken(rawRefresh),
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	// The second request reads the token as live too, then rotates it first.
+	var winner *inputport.AuthResult
+	var winnerErr error
+	state.beforeRefreshRevoke = func() {
+		winner, winnerErr = uc.RefreshAccessToken(context.Background(), rawRefresh)
+	}
+
+	_, err := uc.RefreshAccessToken(context.Background(), rawRefresh)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenReused)
+	require.NoError(t, winnerErr)
+	assert.Equal(t, entity.SessionStatusRevoked, state.sessions["session-1"].Status)
+	require.Len(t, events, 1)
+	assert.Equal(t, "auth.session.compromised", events[0].Envelope.Type)
+	assert.Len(t, state.refreshTokens, 2, "the losing request persists no refresh token")
+
+	_, err = uc.RefreshAccessToken(context.Background(), winner.RefreshToken)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenInvalid, "the winner's token dies with the family")
+}
+
+func TestRefreshAccessToken_ReuseRevokesSessionFamily(t *testing.T) {
+	cfg := config.AuthConfig{JWTSecret: "secret", JWTKey: "app-key"}
+	now := time.Now().UTC()
+	rawRefresh := "refresh-raw-token"
+	userRepo := &outputmocks.MockUserRepository{}
+	userRepo.On("GetByID", "9").Return(&entity.User{Id: 9, Username: "neo"}, nil)
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		&outputmocks.MockOauthStateRepository{},
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	var audits []entity.AuditLog
+	auditRepo := outputmocks.NewMockAuditLogRepository(t)
+	auditRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, log entity.AuditLog) error {
+			audits = append(audits, log)
+			return nil
+		})
+	var events []messaging.OutboxRecord
+	outbox := messagingmocks.NewMockOutboxStore(t)
+	outbox.EXPECT().
+		Append(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
+			events = append(events, record)
+			return nil
+		})
+	uc.securityEvents = NewSecurityEvents(auditRepo, outbox)
+	state.sessions["session-1"] = entity.Session{
+		ID:             "session-1",
+		UserID:         9,
+		ActiveTenantID: "tenant-1",
+		Status:         entity.SessionStatusActive,
+		ExpiresAt:      now.Add(time.Hour),
+	}
+	state.refreshTokens[entity.HashToken(rawRefresh)] = entity.RefreshToken{
+		ID:        "refresh-1",
+		SessionID: "session-1",
+		TokenHash: entity.HashToken(rawRefresh),
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	rotated, err := uc.RefreshAccessToken(context.Background(), rawRefresh)
+	require.NoError(t, err)
+
+	_, err = uc.RefreshAccessToken(context.Background(), rawRefresh)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenReused)
+	session := state.sessions["session-1"]
+	assert.Equal(t, entity.SessionStatusRevoked, session.Status)
+	assert.Equal(t, entity.SessionRevokedReasonRefreshTokenReuse, session.RevokedReason)
+	require.Len(t, audits, 1)
+	assert.Equal(t, "session.compromised", audits[0].Action)
+	assert.Equal(t, entity.AuditSeverityHigh, audits[0].Severity)
+	require.Len(t, events, 1)
+	assert.Equal(t, "auth.session.compromised", events[0].Envelope.Type)
+	assert.Equal(t, "tenant-1", events[0].Envelope.TenantID)
+
+	_, err = uc.RefreshAccessToken(context.Background(), rotated.RefreshToken)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenInvalid, "the rotated token dies with the family")
+	_, err = uc.RefreshAccessToken(context.Background(), rawRefresh)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenReused)
+	assert.Len(t, events, 1, "an already revoked family is not reported twice")
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -67,6 +68,19 @@ func newUC(
 type authRepoState struct {
 	sessions      map[string]entity.Session
 	refreshTokens map[string]entity.RefreshToken
+	mfaFactors    map[uint]entity.MFAFactor
+	recoveryCodes map[string]bool
+	identities    map[string]entity.UserIdentity
+	oidcProviders map[string]outputport.OIDCProvider
+	webAuthn      map[string]entity.WebAuthnCredential
+	ssoRequired   map[uint][]string
+	saml          map[string]entity.SAMLConnection
+	orgMembers    map[string]bool
+	samlLogins    []string
+
+	// beforeRefreshRevoke runs once inside the next refresh token Revoke, to interleave a
+	// concurrent refresh between its read and its conditional update.
+	beforeRefreshRevoke func()
 }
 
 func newStatefulAuthUC(
@@ -84,12 +98,62 @@ func newStatefulAuthUC(
 	state := &authRepoState{
 		sessions:      map[string]entity.Session{},
 		refreshTokens: map[string]entity.RefreshToken{},
+		mfaFactors:    map[uint]entity.MFAFactor{},
+		recoveryCodes: map[string]bool{},
+		identities:    map[string]entity.UserIdentity{},
+		oidcProviders: map[string]outputport.OIDCProvider{},
+		webAuthn:      map[string]entity.WebAuthnCredential{},
+		ssoRequired:   map[uint][]string{},
+		saml:          map[string]entity.SAMLConnection{},
+		orgMembers:    map[string]bool{},
 	}
 	sessionRepo := outputmocks.NewMockSessionRepository(t)
 	refreshRepo := outputmocks.NewMockRefreshTokenRepository(t)
 	tenantAccessChecker := outputmocks.NewMockTenantAccessChecker(t)
 	roleAssumer := outputmocks.NewMockRoleAssumer(t)
 	accountBootstrapper := outputmocks.NewMockAccountBootstrapper(t)
+	mfaRepo := outputmocks.NewMockMFARepository(t)
+	webAuthnRepo := outputmocks.NewMockWebAuthnCredentialRepository(t)
+	oidcRegistry := outputmocks.NewMockOIDCProviderRegistry(t)
+	identityRepo := outputmocks.NewMockUserIdentityRepository(t)
+	samlConnections := outputmocks.NewMockSAMLConnectionResolver(t)
+
+	oidcRegistry.EXPECT().
+		Provider(mock.Anything).
+		RunAndReturn(func(name string) (outputport.OIDCProvider, error) {
+			provider, ok := state.oidcProviders[name]
+			if !ok {
+				return nil, entity.ErrOIDCProviderNotFound
+			}
+			return provider, nil
+		}).
+		Maybe()
+	identityRepo.EXPECT().
+		GetByProviderSubject(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+			item, ok := state.identities[provider+"|"+subject]
+			if !ok {
+				return nil, entity.ErrIdentityNotLinked
+			}
+			return &item, nil
+		}).
+		Maybe()
+	identityRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, identity entity.UserIdentity) error {
+			state.identities[identity.Provider+"|"+identity.Subject] = identity
+			return nil
+		}).
+		Maybe()
+	identityRepo.EXPECT().
+		TouchLastLogin(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, provider, subject string, at time.Time) error {
+			item := state.identities[provider+"|"+subject]
+			item.LastLoginAt = at
+			state.identities[provider+"|"+subject] = item
+			return nil
+		}).
+		Maybe()
 
 	sessionRepo.EXPECT().
 		Create(mock.Anything, mock.Anything).
@@ -157,14 +221,15 @@ func newStatefulAuthUC(
 		}).
 		Maybe()
 	sessionRepo.EXPECT().
-		Revoke(mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(ctx context.Context, id string, revokedAt time.Time) error {
+		Revoke(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, id, reason string, revokedAt time.Time) error {
 			item, ok := state.sessions[id]
 			if !ok {
 				return entity.ErrSessionNotFound
 			}
 			item.Status = entity.SessionStatusRevoked
 			item.RevokedAt = &revokedAt
+			item.RevokedReason = reason
 			state.sessions[id] = item
 			return nil
 		}).
@@ -191,8 +256,15 @@ func newStatefulAuthUC(
 	refreshRepo.EXPECT().
 		Revoke(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string, revokedAt time.Time, replacedByTokenID *string) error {
+			if hook := state.beforeRefreshRevoke; hook != nil {
+				state.beforeRefreshRevoke = nil
+				hook()
+			}
 			for key, item := range state.refreshTokens {
 				if item.ID == id {
+					if item.RevokedAt != nil {
+						return entity.ErrRefreshTokenRotated
+					}
 					item.RevokedAt = &revokedAt
 					item.ReplacedByTokenID = replacedByTokenID
 					state.refreshTokens[key] = item
@@ -215,6 +287,107 @@ func newStatefulAuthUC(
 		}).
 		Maybe()
 
+	mfaRepo.EXPECT().
+		GetByUserID(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID uint) (*entity.MFAFactor, error) {
+			item, ok := state.mfaFactors[userID]
+			if !ok {
+				return nil, entity.ErrMFANotEnrolled
+			}
+			copyItem := item
+			return &copyItem, nil
+		}).
+		Maybe()
+	mfaRepo.EXPECT().
+		ClaimStep(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID uint, step int64, usedAt time.Time) (bool, error) {
+			item, ok := state.mfaFactors[userID]
+			if !ok || step <= item.LastUsedStep {
+				return false, nil
+			}
+			item.LastUsedStep = step
+			state.mfaFactors[userID] = item
+			return true, nil
+		}).
+		Maybe()
+	mfaRepo.EXPECT().
+		ConsumeRecoveryCode(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID uint, codeHash string, usedAt time.Time) (bool, error) {
+			key := fmt.Sprintf("%d:%s", userID, codeHash)
+			if !state.recoveryCodes[key] {
+				return false, nil
+			}
+			delete(state.recoveryCodes, key)
+			return true, nil
+		}).
+		Maybe()
+
+	webAuthnRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, credential entity.WebAuthnCredential) error {
+			for _, item := range state.webAuthn {
+				if item.CredentialID == credential.CredentialID {
+					return entity.ErrWebAuthnCredentialExists
+				}
+			}
+			state.webAuthn[credential.ID] = credential
+			return nil
+		}).
+		Maybe()
+	webAuthnRepo.EXPECT().
+		GetByCredentialID(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, credentialID string) (*entity.WebAuthnCredential, error) {
+			for _, item := range state.webAuthn {
+				if item.CredentialID == credentialID {
+					copyItem := item
+					return &copyItem, nil
+				}
+			}
+			return nil, entity.ErrWebAuthnCredentialNotFound
+		}).
+		Maybe()
+	webAuthnRepo.EXPECT().
+		ListByUser(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID uint) ([]entity.WebAuthnCredential, error) {
+			out := []entity.WebAuthnCredential{}
+			for _, item := range state.webAuthn {
+				if item.UserID == userID {
+					out = append(out, item)
+				}
+			}
+			return out, nil
+		}).
+		Maybe()
+	webAuthnRepo.EXPECT().
+		UpdateUsage(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			ctx context.Context,
+			id string,
+			previousSignCount, signCount uint32,
+			backupState bool,
+			usedAt time.Time,
+		) (bool, error) {
+			item, ok := state.webAuthn[id]
+			if !ok || item.SignCount != previousSignCount || item.CloneDetectedAt != nil {
+				return false, nil
+			}
+			item.SignCount = signCount
+			item.BackupState = backupState
+			item.LastUsedAt = &usedAt
+			state.webAuthn[id] = item
+			return true, nil
+		}).
+		Maybe()
+	webAuthnRepo.EXPECT().
+		MarkCloneDetected(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, id string, detectedAt time.Time) error {
+			item := state.webAuthn[id]
+			item.CloneDetectedAt = &detectedAt
+			state.webAuthn[id] = item
+			return nil
+		}).
+		Maybe()
+
 	tenantAccessChecker.EXPECT().
 		EnsureActiveMembership(mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(tenantAccessFn).
@@ -223,6 +396,35 @@ func newStatefulAuthUC(
 		EnsureRootOrganization(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Maybe()
+	samlConnections.EXPECT().
+		SSORequiredOrganizations(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID uint) ([]string, error) {
+			return state.ssoRequired[userID], nil
+		}).
+		Maybe()
+	samlConnections.EXPECT().
+		ResolveSAMLConnection(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, orgID string) (*entity.SAMLConnection, error) {
+			item, ok := state.saml[orgID]
+			if !ok {
+				return nil, entity.ErrSAMLConnectionNotFound
+			}
+			return &item, nil
+		}).
+		Maybe()
+	samlConnections.EXPECT().
+		IsActiveOrganizationMember(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, orgID string, userID uint) (bool, error) {
+			return state.orgMembers[fmt.Sprintf("%s|%d", orgID, userID)], nil
+		}).
+		Maybe()
+	samlConnections.EXPECT().
+		ApplySAMLLogin(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, accessToken, orgID string, userID uint, groups []string) error {
+			state.samlLogins = append(state.samlLogins, fmt.Sprintf("%s|%d|%v", orgID, userID, groups))
+			return nil
+		}).
+		Maybe()
 
 	return NewAuthUsecase(
 		uuc,
@@ -235,7 +437,15 @@ func newStatefulAuthUC(
 		tenantAccessChecker,
 		roleAssumer,
 		accountBootstrapper,
+		mfaRepo,
+		webAuthnRepo,
+		oidcRegistry,
+		identityRepo,
+		samlConnections,
+		nil,
+		nil,
 		cfg,
+		NewAccessTokenVerifier(cfg, nil),
 	), state, sessionRepo, refreshRepo
 }
 
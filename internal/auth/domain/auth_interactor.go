@@ -8,12 +8,12 @@ import (
 	"maps"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/tuannm99/podzone/internal/auth/config"
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
 	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
 	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
 )
 
 var _ inputport.AuthUsecase = (*authInteractorImpl)(nil)
@@ -29,12 +29,22 @@ func NewAuthUsecase(
 	tenantAccessChecker outputport.TenantAccessChecker,
 	roleAssumer outputport.RoleAssumer,
 	accountBootstrapper outputport.AccountBootstrapper,
+	mfaRepository outputport.MFARepository,
+	webAuthnRepository outputport.WebAuthnCredentialRepository,
+	oidcProviders outputport.OIDCProviderRegistry,
+	identityRepository outputport.UserIdentityRepository,
+	samlConnections outputport.SAMLConnectionResolver,
+	loginThrottle *LoginThrottle,
+	securityEvents *SecurityEvents,
 	cfg config.AuthConfig,
+	verifier *pdauthn.Verifier,
 ) *authInteractorImpl {
 	return &authInteractorImpl{
-		jwtSecret:            cfg.JWTSecret,
-		jwtKey:               cfg.JWTKey,
+		verifier:             verifier,
 		appRedirectURL:       cfg.AppRedirectURL,
+		mfaCfg:               cfg.MFA,
+		webAuthnCfg:          cfg.WebAuthn,
+		samlCfg:              cfg.SAML,
 		userUC:               userUC,
 		tokenUC:              tokenUC,
 		oauthExternal:        oauthExternal,
@@ -45,13 +55,22 @@ func NewAuthUsecase(
 		tenantAccessChecker:  tenantAccessChecker,
 		roleAssumer:          roleAssumer,
 		accountBootstrapper:  accountBootstrapper,
+		mfaRepository:        mfaRepository,
+		webAuthnRepository:   webAuthnRepository,
+		oidcProviders:        oidcProviders,
+		identityRepository:   identityRepository,
+		samlConnections:      samlConnections,
+		loginThrottle:        loginThrottle,
+		securityEvents:       securityEvents,
 	}
 }
 
 type authInteractorImpl struct {
-	jwtSecret      string
-	jwtKey         string
+	verifier       *pdauthn.Verifier
 	appRedirectURL string
+	mfaCfg         config.MFAConfig
+	webAuthnCfg    config.WebAuthnConfig
+	samlCfg        config.SAMLConfig
 
 	userUC  inputport.UserUsecase
 	tokenUC inputport.TokenUsecase
@@ -64,23 +83,34 @@ type authInteractorImpl struct {
 	tenantAccessChecker  outputport.TenantAccessChecker
 	roleAssumer          outputport.RoleAssumer
 	accountBootstrapper  outputport.AccountBootstrapper
+	mfaRepository        outputport.MFARepository
+	webAuthnRepository   outputport.WebAuthnCredentialRepository
+	oidcProviders        outputport.OIDCProviderRegistry
+	identityRepository   outputport.UserIdentityRepository
+	samlConnections      outputport.SAMLConnectionResolver
+	loginThrottle        *LoginThrottle
+	securityEvents       *SecurityEvents
 }
 
 func (u *authInteractorImpl) newSessionAuthResult(
 	ctx context.Context,
 	user *entity.User,
 	tenantID string,
+	identityProvider string,
+	mfaAuthenticatedAt *time.Time,
 ) (*inputport.AuthResult, error) {
 	now := time.Now().UTC()
 	session := entity.Session{
-		ID:             uuid.NewString(),
-		UserID:         user.Id,
-		ActiveTenantID: tenantID,
-		SessionPolicy:  nil,
-		Status:         entity.SessionStatusActive,
-		CreatedAt:      now,
-		UpdatedAt:      now,
-		ExpiresAt:      now.Add(30 * 24 * time.Hour),
+		ID:                 uuid.NewString(),
+		UserID:             user.Id,
+		ActiveTenantID:     tenantID,
+		SessionPolicy:      nil,
+		MFAAuthenticatedAt: mfaAuthenticatedAt,
+		IdentityProvider:   identityProvider,
+		Status:             entity.SessionStatusActive,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		ExpiresAt:          now.Add(30 * 24 * time.Hour),
 	}
 	if err := u.sessionRepository.Create(ctx, session); err != nil {
 		return nil, err
@@ -129,7 +159,16 @@ func (u *authInteractorImpl) loadOwnedActiveSession(
 }
 
 func (u *authInteractorImpl) issueSessionAccessToken(user entity.User, session entity.Session) (string, error) {
-	if session.AssumedRoleID == 0 && session.AssumedRoleName == "" {
+	// Accounts predating initial_from signed in locally, so their base claims already fit.
+	initialFrom := user.InitialFrom
+	if initialFrom == "" {
+		initialFrom = entity.IdentityProviderPodzone
+	}
+	// Sessions with a creation time carry auth_time, which the base token helpers cannot set.
+	baseClaimsOnly := session.AssumedRoleID == 0 && session.AssumedRoleName == "" &&
+		session.MFAAuthenticatedAt == nil && session.CreatedAt.IsZero() &&
+		(session.IdentityProvider == "" || session.IdentityProvider == initialFrom)
+	if baseClaimsOnly {
 		if len(session.SessionPolicy) == 0 {
 			return u.tokenUC.CreateJwtTokenForSession(user, session.ActiveTenantID, session.ID)
 		}
@@ -175,17 +214,8 @@ func (u *authInteractorImpl) sessionFromAccessToken(raw string) (*entity.Session
 	if raw == "" {
 		return nil, entity.ErrSessionNotFound
 	}
-	claims := &entity.JWTClaims{}
-	token, err := jwt.ParseWithClaims(raw, claims, func(tok *jwt.Token) (any, error) {
-		if tok.Method != jwt.SigningMethodHS256 {
-			return nil, fmt.Errorf("unexpected signing method")
-		}
-		return []byte(u.jwtSecret), nil
-	})
-	if err != nil || !token.Valid {
-		return nil, entity.ErrSessionNotFound
-	}
-	if u.jwtKey != "" && claims.Key != u.jwtKey {
+	claims, err := u.verifier.ClaimsFromTokenString(raw)
+	if err != nil {
 		return nil, entity.ErrSessionNotFound
 	}
 	if claims.SessionID == "" {
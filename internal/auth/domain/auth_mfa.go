@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+)
+
+// mfaChallengeMaxAttempts bounds guesses per challenge; a fresh password login is needed after that.
+const mfaChallengeMaxAttempts = 5
+
+type mfaChallenge struct {
+	UserID           uint      `json:"user_id"`
+	IdentityProvider string    `json:"identity_provider"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+func mfaChallengeKey(token string) string {
+	return "auth:mfa:challenge:" + entity.HashToken(token)
+}
+
+// mfaAttemptsKey counts the guesses against a challenge. It is a separate counter so that
+// concurrent guesses cannot each read the same attempt count before writing it back.
+func mfaAttemptsKey(challengeKey string) string {
+	return challengeKey + ":attempts"
+}
+
+func (u *authInteractorImpl) mfaEnabled(ctx context.Context, userID uint) (bool, error) {
+	factor, err := u.mfaRepository.GetByUserID(ctx, userID)
+	if errors.Is(err, entity.ErrMFANotEnrolled) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return factor.Enabled(), nil
+}
+
+// mfaMethods lists the second factors the user has set up; Login requires one when it is non-empty.
+func (u *authInteractorImpl) mfaMethods(ctx context.Context, userID uint) ([]string, error) {
+	var methods []string
+	totp, err := u.mfaEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if totp {
+		methods = append(methods, inputport.MFAMethodTOTP)
+	}
+	credentials, err := u.usableWebAuthnCredentials(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) > 0 {
+		methods = append(methods, inputport.MFAMethodWebAuthn)
+	}
+	return methods, nil
+}
+
+func (u *authInteractorImpl) newMFAChallenge(
+	userID uint,
+	identityProvider string,
+	methods []string,
+) (*inputport.AuthResult, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+	challenge := mfaChallenge{
+		UserID:           userID,
+		IdentityProvider: identityProvider,
+		ExpiresAt:        time.Now().UTC().Add(u.mfaCfg.ChallengeTTL),
+	}
+	if err := u.saveMFAChallenge(token, challenge); err != nil {
+		return nil, err
+	}
+	return &inputport.AuthResult{MFARequired: true, MFAToken: token, MFAMethods: methods}, nil
+}
+
+func (u *authInteractorImpl) saveMFAChallenge(token string, challenge mfaChallenge) error {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return entity.ErrMFAChallengeInvalid
+	}
+	payload, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("failed to encode mfa challenge: %w", err)
+	}
+	if err := u.oauthStateRepository.SetValue(mfaChallengeKey(token), string(payload), ttl); err != nil {
+		return fmt.Errorf("failed to persist mfa challenge: %w", err)
+	}
+	return nil
+}
+
+// VerifyMFA completes a Login that returned an MFA challenge. The session it opens is
+// marked MFA-authenticated, which IAM exposes as the auth:MultiFactorAuthPresent condition key.
+func (u *authInteractorImpl) VerifyMFA(ctx context.Context, mfaToken, code string) (*inputport.AuthResult, error) {
+	mfaToken = strings.TrimSpace(mfaToken)
+	if mfaToken == "" {
+		return nil, entity.ErrMFAChallengeInvalid
+	}
+	key := mfaChallengeKey(mfaToken)
+	raw, err := u.oauthStateRepository.Get(key)
+	if err != nil {
+		return nil, entity.ErrMFAChallengeInvalid
+	}
+	var challenge mfaChallenge
+	if err := json.Unmarshal([]byte(raw), &challenge); err != nil || challenge.UserID == 0 {
+		_ = u.oauthStateRepository.Del(key)
+		return nil, entity.ErrMFAChallengeInvalid
+	}
+
+	user, err := u.userRepository.GetByID(fmt.Sprintf("%d", challenge.UserID))
+	if err != nil {
+		return nil, err
+	}
+	// Wrong codes count against the same counters as wrong passwords; otherwise a known
+	// password would buy unthrottled guesses through fresh challenges.
+	clientIP := entity.ClientIPFromContext(ctx)
+	if err := u.loginThrottle.Check(ctx, user.Username, clientIP); err != nil {
+		return nil, err
+	}
+
+	// The attempt is taken before the code is checked, so parallel guesses past the limit
+	// are refused rather than checked.
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		u.dropMFAChallenge(key)
+		return nil, entity.ErrMFAChallengeInvalid
+	}
+	attempts, err := u.oauthStateRepository.Incr(mfaAttemptsKey(key), ttl)
+	if err != nil {
+		return nil, err
+	}
+	if attempts > mfaChallengeMaxAttempts {
+		u.dropMFAChallenge(key)
+		return nil, entity.ErrMFAChallengeInvalid
+	}
+
+	now := time.Now().UTC()
+	if err := u.checkMFACode(ctx, challenge.UserID, code, now); err != nil {
+		if !errors.Is(err, entity.ErrMFACodeInvalid) {
+			return nil, err
+		}
+		if attempts == mfaChallengeMaxAttempts {
+			u.dropMFAChallenge(key)
+		}
+		if recordErr := u.loginThrottle.RecordFailure(ctx, user.Username, clientIP, user.Id); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
+	u.dropMFAChallenge(key)
+	if err := u.loginThrottle.RecordSuccess(ctx, user.Username); err != nil {
+		return nil, err
+	}
+
+	result, err := u.newSessionAuthResult(ctx, user, "", challenge.IdentityProvider, &now)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.ensureRootOrganization(ctx, user, result.JwtToken); err != nil {
+		return nil, fmt.Errorf("bootstrap organization account: %w", err)
+	}
+	return result, nil
+}
+
+func (u *authInteractorImpl) dropMFAChallenge(key string) {
+	_ = u.oauthStateRepository.Del(key)
+	_ = u.oauthStateRepository.Del(mfaAttemptsKey(key))
+}
+
+// checkMFACode accepts a TOTP code or an unused recovery code for an enabled factor.
+func (u *authInteractorImpl) checkMFACode(ctx context.Context, userID uint, code string, now time.Time) error {
+	factor, err := u.mfaRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !factor.Enabled() {
+		return entity.ErrMFANotEnrolled
+	}
+	if step, ok := entity.MatchTOTP(factor.Secret, code, now, factor.LastUsedStep); ok {
+		claimed, err := u.mfaRepository.ClaimStep(ctx, userID, step, now)
+		if err != nil {
+			return err
+		}
+		if claimed {
+			return nil
+		}
+		return entity.ErrMFACodeInvalid
+	}
+	if strings.TrimSpace(code) == "" {
+		return entity.ErrMFACodeInvalid
+	}
+	consumed, err := u.mfaRepository.ConsumeRecoveryCode(ctx, userID, entity.HashRecoveryCode(code), now)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return entity.ErrMFACodeInvalid
+	}
+	return nil
+}
+
+// EnrollMFA starts (or restarts) a pending TOTP enrollment; it takes effect after ActivateMFA.
+func (u *authInteractorImpl) EnrollMFA(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+) (*inputport.MFAEnrollment, error) {
+	_, user, now, err := u.loadOwnedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := entity.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.mfaRepository.SavePending(ctx, entity.MFAFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+	return &inputport.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: entity.TOTPProvisioningURI(u.mfaCfg.Issuer, account, secret),
+	}, nil
+}
+
+// ActivateMFA confirms the pending factor with a first code and returns the recovery codes,
+// which are only ever shown here.
+func (u *authInteractorImpl) ActivateMFA(ctx context.Context, userID uint, accessToken, code string) ([]string, error) {
+	_, _, now, err := u.loadOwnedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	factor, err := u.mfaRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if factor.Enabled() {
+		return nil, entity.ErrMFAAlreadyEnabled
+	}
+	step, ok := entity.MatchTOTP(factor.Secret, code, now, factor.LastUsedStep)
+	if !ok {
+		return nil, entity.ErrMFACodeInvalid
+	}
+	codes, err := entity.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, item := range codes {
+		hashes = append(hashes, entity.HashRecoveryCode(item))
+	}
+	if err := u.mfaRepository.Enable(ctx, userID, step, now); err != nil {
+		return nil, err
+	}
+	if err := u.mfaRepository.ReplaceRecoveryCodes(ctx, userID, hashes, now); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableMFA removes the factor and its recovery codes after checking a current code.
+func (u *authInteractorImpl) DisableMFA(ctx context.Context, userID uint, accessToken, code string) error {
+	_, _, now, err := u.loadOwnedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return err
+	}
+	if err := u.checkMFACode(ctx, userID, code, now); err != nil {
+		return err
+	}
+	return u.mfaRepository.Delete(ctx, userID)
+}
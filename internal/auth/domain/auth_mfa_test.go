@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+)
+
+func TestTOTPCode_RFC6238Vector(t *testing.T) {
+	// Base32 of the RFC 6238 SHA1 seed "12345678901234567890".
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	at := time.Unix(59, 0)
+
+	code, err := entity.TOTPCode(secret, entity.TOTPStep(at))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+
+	step, ok := entity.MatchTOTP(secret, code, at, 0)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), step)
+
+	_, ok = entity.MatchTOTP(secret, code, at, step)
+	assert.False(t, ok, "a used step must not match again")
+}
+
+// newMemoryStateRepo backs the oauth state mock with a map so MFA challenges round-trip.
+func newMemoryStateRepo(t *testing.T) *outputmocks.MockOauthStateRepository {
+	t.Helper()
+	values := map[string]string{}
+	repo := outputmocks.NewMockOauthStateRepository(t)
+	repo.EXPECT().
+		SetValue(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(key, value string, duration time.Duration) error {
+			values[key] = value
+			return nil
+		}).
+		Maybe()
+	repo.EXPECT().
+		Get(mock.Anything).
+		RunAndReturn(func(key string) (string, error) {
+			value, ok := values[key]
+			if !ok {
+				return "", fmt.Errorf("key %s not found", key)
+			}
+			return value, nil
+		}).
+		Maybe()
+	repo.EXPECT().
+		Incr(mock.Anything, mock.Anything).
+		RunAndReturn(func(key string, duration time.Duration) (int64, error) {
+			count, _ := strconv.ParseInt(values[key], 10, 64)
+			count++
+			values[key] = strconv.FormatInt(count, 10)
+			return count, nil
+		}).
+		Maybe()
+	repo.EXPECT().
+		Del(mock.Anything).
+		RunAndReturn(func(key string) error {
+			delete(values, key)
+			return nil
+		}).
+		Maybe()
+	return repo
+}
+
+func newMFAAuthUC(t *testing.T, user *entity.User) (*authInteractorImpl, *authRepoState) {
+	t.Helper()
+	cfg := config.AuthConfig{
+		JWTSecret:      "secret",
+		JWTKey:         "app-key",
+		AppRedirectURL: "https://app.example.com/after-auth",
+		MFA:            config.MFAConfig{Issuer: "Podzone", ChallengeTTL: time.Minute},
+	}
+	userRepo := &outputmocks.MockUserRepository{}
+	userRepo.On("GetByUsernameOrEmail", user.Username).Return(user, nil).Maybe()
+	userRepo.On("GetByID", fmt.Sprintf("%d", user.Id)).Return(user, nil).Maybe()
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		newMemoryStateRepo(t),
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	return uc, state
+}
+
+func TestLogin_MFAChallengeThenVerify(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 7, Username: "trinity", Email: "trinity@mx.io", Password: hashed}
+
+	uc, state := newMFAAuthUC(t, user)
+	secret, err := entity.GenerateTOTPSecret()
+	require.NoError(t, err)
+	enabledAt := time.Now().UTC()
+	state.mfaFactors[user.Id] = entity.MFAFactor{UserID: user.Id, Secret: secret, EnabledAt: &enabledAt}
+
+	challenge, err := uc.Login(ctx, "trinity", "pass123")
+	require.NoError(t, err)
+	assert.True(t, challenge.MFARequired)
+	assert.NotEmpty(t, challenge.MFAToken)
+	assert.Empty(t, challenge.JwtToken)
+	assert.Empty(t, state.sessions)
+
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, "000000x")
+	require.ErrorIs(t, err, entity.ErrMFACodeInvalid)
+
+	code, err := entity.TOTPCode(secret, entity.TOTPStep(time.Now()))
+	require.NoError(t, err)
+	resp, err := uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JwtToken)
+	assert.False(t, resp.MFARequired)
+
+	claims, err := uc.verifier.ClaimsFromTokenString(resp.JwtToken)
+	require.NoError(t, err)
+	assert.True(t, claims.MultiFactorAuthPresent)
+	session := state.sessions[claims.SessionID]
+	require.NotNil(t, session.MFAAuthenticatedAt)
+
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.ErrorIs(t, err, entity.ErrMFAChallengeInvalid, "challenges are single use")
+}
+
+func TestVerifyMFA_RecoveryCodeSingleUse(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 8, Username: "morpheus", Email: "morpheus@mx.io", Password: hashed}
+
+	uc, state := newMFAAuthUC(t, user)
+	enabledAt := time.Now().UTC()
+	state.mfaFactors[user.Id] = entity.MFAFactor{UserID: user.Id, Secret: "GEZDGNBVGY3TQOJQ", EnabledAt: &enabledAt}
+	state.recoveryCodes[fmt.Sprintf("%d:%s", user.Id, entity.HashRecoveryCode("abcd-efgh"))] = true
+
+	first, err := uc.Login(ctx, "morpheus", "pass123")
+	require.NoError(t, err)
+	resp, err := uc.VerifyMFA(ctx, first.MFAToken, "abcd-efgh")
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JwtToken)
+
+	second, err := uc.Login(ctx, "morpheus", "pass123")
+	require.NoError(t, err)
+	_, err = uc.VerifyMFA(ctx, second.MFAToken, "abcd-efgh")
+	require.ErrorIs(t, err, entity.ErrMFACodeInvalid)
+}
+
+func TestVerifyMFA_AttemptLimitHoldsAcrossConcurrentGuesses(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 10, Username: "switch", Email: "switch@mx.io", Password: hashed}
+
+	uc, state := newMFAAuthUC(t, user)
+	secret, err := entity.GenerateTOTPSecret()
+	require.NoError(t, err)
+	enabledAt := time.Now().UTC()
+	state.mfaFactors[user.Id] = entity.MFAFactor{UserID: user.Id, Secret: secret, EnabledAt: &enabledAt}
+
+	challenge, err := uc.Login(ctx, "switch", "pass123")
+	require.NoError(t, err)
+	// Guesses still in flight have taken their attempts even though none has failed yet.
+	attemptsKey := mfaAttemptsKey(mfaChallengeKey(challenge.MFAToken))
+	for range mfaChallengeMaxAttempts {
+		_, err := uc.oauthStateRepository.Incr(attemptsKey, time.Minute)
+		require.NoError(t, err)
+	}
+
+	code, err := entity.TOTPCode(secret, entity.TOTPStep(time.Now()))
+	require.NoError(t, err)
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.ErrorIs(t, err, entity.ErrMFAChallengeInvalid)
+	_, err = uc.oauthStateRepository.Get(mfaChallengeKey(challenge.MFAToken))
+	require.Error(t, err, "an exhausted challenge is dropped")
+}
+
+func TestVerifyMFA_LastAttemptDropsChallenge(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 11, Username: "apoc", Email: "apoc@mx.io", Password: hashed}
+
+	uc, state := newMFAAuthUC(t, user)
+	secret, err := entity.GenerateTOTPSecret()
+	require.NoError(t, err)
+	enabledAt := time.Now().UTC()
+	state.mfaFactors[user.Id] = entity.MFAFactor{UserID: user.Id, Secret: secret, EnabledAt: &enabledAt}
+
+	challenge, err := uc.Login(ctx, "apoc", "pass123")
+	require.NoError(t, err)
+	for range mfaChallengeMaxAttempts {
+		_, err := uc.VerifyMFA(ctx, challenge.MFAToken, "000000x")
+		require.ErrorIs(t, err, entity.ErrMFACodeInvalid)
+	}
+
+	code, err := entity.TOTPCode(secret, entity.TOTPStep(time.Now()))
+	require.NoError(t, err)
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.ErrorIs(t, err, entity.ErrMFAChallengeInvalid)
+}
@@ -58,7 +58,7 @@ func (u *authInteractorImpl) HandleOAuthCallback(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new user: %w", err)
 	}
-	authResult, err := u.newSessionAuthResult(ctx, usr, "")
+	authResult, err := u.newSessionAuthResult(ctx, usr, "", entity.IdentityProviderGoogle, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth session: %w", err)
 	}
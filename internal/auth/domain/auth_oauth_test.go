@@ -66,7 +66,8 @@ func TestHandleOAuthCallback_HappyPath(t *testing.T) {
 	uuc.On("CreateNewAfterAuthCallback", mock.MatchedBy(func(e entity.User) bool { return e.Email == "jdoe@example.com" })).
 		Return(&entity.User{Id: 7, Email: "jdoe@example.com"}, nil)
 
-	tuc.On("CreateJwtTokenForSession", mock.MatchedBy(func(e entity.User) bool { return e.Email == "jdoe@example.com" }), "", mock.AnythingOfType("string")).
+	tuc.On("CreateJwtTokenForSessionState", mock.MatchedBy(func(e entity.User) bool { return e.Email == "jdoe@example.com" }),
+		mock.MatchedBy(func(s entity.Session) bool { return s.IdentityProvider == entity.IdentityProviderGoogle })).
 		Return("jwt-ok", nil)
 
 	state := "ST"
@@ -176,7 +177,7 @@ func TestHandleOAuthCallback_PersistExchangeError(t *testing.T) {
 	user := entity.User{Id: 1, Email: "a@b.com"}
 	uuc.On("CreateNewAfterAuthCallback", mock.MatchedBy(func(e entity.User) bool { return e.Email == "a@b.com" })).
 		Return(&user, nil)
-	tuc.On("CreateJwtTokenForSession", user, "", mock.AnythingOfType("string")).Return("jwt-ok", nil)
+	tuc.On("CreateJwtTokenForSessionState", user, mock.AnythingOfType("entity.Session")).Return("jwt-ok", nil)
 
 	state := "ST"
 	key := "oauth:google:" + state
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+)
+
+const (
+	oidcStateTTL    = 10 * time.Minute
+	oidcExchangeTTL = 2 * time.Minute
+)
+
+// oidcLoginState is kept server-side between StartOIDCLogin and the provider callback.
+type oidcLoginState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+func oidcStateKey(state string) string {
+	return "oauth:oidc:" + state
+}
+
+func oidcExchangeKey(code string) string {
+	return "oauth:oidc:exchange:" + code
+}
+
+func (u *authInteractorImpl) ListIdentityProviders(ctx context.Context) []inputport.IdentityProvider {
+	providers := u.oidcProviders.List()
+	out := make([]inputport.IdentityProvider, 0, len(providers))
+	for _, item := range providers {
+		out = append(out, inputport.IdentityProvider{Name: item.Name, DisplayName: item.DisplayName})
+	}
+	return out
+}
+
+func (u *authInteractorImpl) StartOIDCLogin(ctx context.Context, providerName string) (string, error) {
+	provider, err := u.oidcProviders.Provider(providerName)
+	if err != nil {
+		return "", err
+	}
+	state, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("error generating state: %w", err)
+	}
+	nonce, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("error generating nonce: %w", err)
+	}
+	codeVerifier, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("error generating pkce verifier: %w", err)
+	}
+	payload, err := json.Marshal(oidcLoginState{Provider: providerName, Nonce: nonce, CodeVerifier: codeVerifier})
+	if err != nil {
+		return "", err
+	}
+	if err := u.oauthStateRepository.SetValue(oidcStateKey(state), string(payload), oidcStateTTL); err != nil {
+		return "", err
+	}
+	return provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+func (u *authInteractorImpl) HandleOIDCCallback(
+	ctx context.Context,
+	providerName, code, state string,
+) (*inputport.OIDCCallbackResult, error) {
+	if state == "" {
+		return nil, entity.ErrOIDCStateInvalid
+	}
+	key := oidcStateKey(state)
+	raw, err := u.oauthStateRepository.Get(key)
+	if err != nil {
+		return nil, entity.ErrOIDCStateInvalid
+	}
+	_ = u.oauthStateRepository.Del(key)
+	var loginState oidcLoginState
+	if err := json.Unmarshal([]byte(raw), &loginState); err != nil || loginState.Provider != providerName {
+		return nil, entity.ErrOIDCStateInvalid
+	}
+
+	provider, err := u.oidcProviders.Provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userForIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	return u.finishExternalLogin(ctx, user, providerName)
+}
+
+// finishExternalLogin opens the session (or MFA challenge) for a user signed in through an
+// external identity provider and parks the result behind a short-lived exchange code, so
+// tokens never travel in the browser redirect. The frontend redeems it through
+// ExchangeOIDCLogin.
+func (u *authInteractorImpl) finishExternalLogin(
+	ctx context.Context,
+	user *entity.User,
+	identityProvider string,
+) (*inputport.OIDCCallbackResult, error) {
+	mfaMethods, err := u.mfaMethods(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	var authResult *inputport.AuthResult
+	if len(mfaMethods) > 0 {
+		authResult, err = u.newMFAChallenge(user.Id, identityProvider, mfaMethods)
+	} else {
+		authResult, err = u.newSessionAuthResult(ctx, user, "", identityProvider, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auth session: %w", err)
+	}
+
+	exchangeCode, err := randomToken(24)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exchange code: %w", err)
+	}
+	payload, err := json.Marshal(authResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode auth result: %w", err)
+	}
+	if err := u.oauthStateRepository.SetValue(oidcExchangeKey(exchangeCode), string(payload), oidcExchangeTTL); err != nil {
+		return nil, fmt.Errorf("failed to persist exchange code: %w", err)
+	}
+	return &inputport.OIDCCallbackResult{
+		ExchangeCode: exchangeCode,
+		RedirectURL:  fmt.Sprintf("%s?exchange_code=%s", u.appRedirectURL, exchangeCode),
+		UserID:       user.Id,
+	}, nil
+}
+
+func (u *authInteractorImpl) ExchangeOIDCLogin(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error) {
+	if exchangeCode == "" {
+		return nil, entity.ErrOIDCStateInvalid
+	}
+	key := oidcExchangeKey(exchangeCode)
+	raw, err := u.oauthStateRepository.Get(key)
+	if err != nil {
+		return nil, entity.ErrOIDCStateInvalid
+	}
+	_ = u.oauthStateRepository.Del(key)
+	var result inputport.AuthResult
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, fmt.Errorf("failed to decode oidc exchange payload: %w", err)
+	}
+	return &result, nil
+}
+
+// userForIdentity resolves a linked user, or links the identity by verified email: to the
+// existing user with that (verified) address, else to a newly created user.
+func (u *authInteractorImpl) userForIdentity(
+	ctx context.Context,
+	identity *entity.OIDCIdentity,
+) (*entity.User, error) {
+	now := time.Now().UTC()
+	link, err := u.identityRepository.GetByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		user, err := u.userRepository.GetByID(fmt.Sprintf("%d", link.UserID))
+		if err != nil {
+			return nil, err
+		}
+		if err := u.identityRepository.TouchLastLogin(ctx, identity.Provider, identity.Subject, now); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, entity.ErrIdentityNotLinked) {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(identity.Email)
+	if email == "" || !identity.EmailVerified {
+		return nil, entity.ErrOIDCEmailNotVerified
+	}
+	user, err := u.userRepository.GetByUsernameOrEmail(email)
+	switch {
+	case err == nil && user.Email == email:
+		if user.EmailVerifiedAt == nil {
+			return nil, entity.ErrOIDCLinkRequiresVerifiedAccount
+		}
+	case err == nil, errors.Is(err, entity.ErrUserNotFound):
+		user, err = u.userRepository.Create(entity.User{
+			Username:    email,
+			Email:       email,
+			FullName:    identity.Name,
+			FirstName:   identity.GivenName,
+			LastName:    identity.FamilyName,
+			InitialFrom: identity.Provider,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := u.userRepository.MarkEmailVerified(ctx, user.Id, email, now); err != nil {
+			return nil, err
+		}
+		user.EmailVerifiedAt = &now
+	default:
+		return nil, err
+	}
+
+	if err := u.identityRepository.Create(ctx, entity.UserIdentity{
+		UserID:      user.Id,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+)
+
+// fakeOIDCProvider asserts whatever identity it holds once the nonce round-trips.
+type fakeOIDCProvider struct {
+	name     string
+	identity entity.OIDCIdentity
+}
+
+func (p *fakeOIDCProvider) Info() outputport.OIDCProviderInfo {
+	return outputport.OIDCProviderInfo{Name: p.name, DisplayName: p.name}
+}
+
+func (p *fakeOIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	q := url.Values{"state": {state}, "nonce": {nonce}, "code": {"code-for-" + nonce}}
+	return "https://idp.example.com/authorize?" + q.Encode(), nil
+}
+
+func (p *fakeOIDCProvider) Exchange(
+	ctx context.Context,
+	code, codeVerifier, nonce string,
+) (*entity.OIDCIdentity, error) {
+	if code != "code-for-"+nonce || codeVerifier == "" {
+		return nil, entity.ErrOIDCTokenInvalid
+	}
+	identity := p.identity
+	identity.Provider = p.name
+	return &identity, nil
+}
+
+func newOIDCAuthUC(t *testing.T, userRepo *outputmocks.MockUserRepository) (*authInteractorImpl, *authRepoState) {
+	t.Helper()
+	cfg := config.AuthConfig{
+		JWTSecret:      "secret",
+		JWTKey:         "app-key",
+		AppRedirectURL: "https://app.example.com/after-auth",
+	}
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		newMemoryStateRepo(t),
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	return uc, state
+}
+
+// loginWithOIDC drives start, callback and exchange the way the browser would.
+func loginWithOIDC(t *testing.T, uc *authInteractorImpl, provider string) (string, error) {
+	t.Helper()
+	ctx := context.Background()
+	authURL, err := uc.StartOIDCLogin(ctx, provider)
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := parsed.Query()
+
+	callback, err := uc.HandleOIDCCallback(ctx, provider, query.Get("code"), query.Get("state"))
+	if err != nil {
+		return "", err
+	}
+	assert.Equal(t, "https://app.example.com/after-auth?exchange_code="+callback.ExchangeCode, callback.RedirectURL)
+	result, err := uc.ExchangeOIDCLogin(ctx, callback.ExchangeCode)
+	require.NoError(t, err)
+	return result.JwtToken, nil
+}
+
+func TestOIDCLogin_LinksVerifiedEmailThenUsesLink(t *testing.T) {
+	verifiedAt := time.Now().UTC()
+	user := &entity.User{Id: 11, Username: "neo", Email: "neo@mx.io", InitialFrom: "podzone", EmailVerifiedAt: &verifiedAt}
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().GetByUsernameOrEmail("neo@mx.io").Return(user, nil).Once()
+	userRepo.EXPECT().GetByID(fmt.Sprintf("%d", user.Id)).Return(user, nil).Maybe()
+
+	uc, state := newOIDCAuthUC(t, userRepo)
+	state.oidcProviders["keycloak"] = &fakeOIDCProvider{
+		name:     "keycloak",
+		identity: entity.OIDCIdentity{Subject: "kc-1", Email: "neo@mx.io", EmailVerified: true},
+	}
+
+	token, err := loginWithOIDC(t, uc, "keycloak")
+	require.NoError(t, err)
+	claims, err := uc.verifier.ClaimsFromTokenString(token)
+	require.NoError(t, err)
+	assert.Equal(t, user.Id, claims.UserID)
+	assert.Equal(t, "keycloak", claims.IdentitySource)
+	assert.Equal(t, "keycloak", state.sessions[claims.SessionID].IdentityProvider)
+	require.Contains(t, state.identities, "keycloak|kc-1")
+
+	// The second login resolves through the stored link without an email lookup.
+	_, err = loginWithOIDC(t, uc, "keycloak")
+	require.NoError(t, err)
+}
+
+func TestOIDCLogin_CreatesUserForUnknownEmail(t *testing.T) {
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().GetByUsernameOrEmail("new@mx.io").Return(nil, entity.ErrUserNotFound)
+	userRepo.EXPECT().
+		Create(mock.MatchedBy(func(u entity.User) bool {
+			return u.Email == "new@mx.io" && u.InitialFrom == "gitlab" && u.FullName == "New User"
+		})).
+		Return(&entity.User{Id: 12, Username: "new@mx.io", Email: "new@mx.io", InitialFrom: "gitlab"}, nil)
+	userRepo.EXPECT().MarkEmailVerified(mock.Anything, uint(12), "new@mx.io", mock.Anything).Return(nil)
+
+	uc, state := newOIDCAuthUC(t, userRepo)
+	state.oidcProviders["gitlab"] = &fakeOIDCProvider{
+		name:     "gitlab",
+		identity: entity.OIDCIdentity{Subject: "gl-1", Email: "new@mx.io", EmailVerified: true, Name: "New User"},
+	}
+
+	token, err := loginWithOIDC(t, uc, "gitlab")
+	require.NoError(t, err)
+	claims, err := uc.verifier.ClaimsFromTokenString(token)
+	require.NoError(t, err)
+	assert.Equal(t, uint(12), claims.UserID)
+	assert.Equal(t, "gitlab", claims.IdentitySource)
+}
+
+func TestOIDCCallback_RejectsUnsafeLinks(t *testing.T) {
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().
+		GetByUsernameOrEmail("trinity@mx.io").
+		Return(&entity.User{Id: 13, Username: "trinity", Email: "trinity@mx.io"}, nil)
+
+	uc, state := newOIDCAuthUC(t, userRepo)
+	state.oidcProviders["unverified"] = &fakeOIDCProvider{
+		name:     "unverified",
+		identity: entity.OIDCIdentity{Subject: "u-1", Email: "trinity@mx.io"},
+	}
+	state.oidcProviders["azure"] = &fakeOIDCProvider{
+		name:     "azure",
+		identity: entity.OIDCIdentity{Subject: "az-1", Email: "trinity@mx.io", EmailVerified: true},
+	}
+
+	_, err := loginWithOIDC(t, uc, "unverified")
+	require.ErrorIs(t, err, entity.ErrOIDCEmailNotVerified)
+
+	_, err = loginWithOIDC(t, uc, "azure")
+	require.ErrorIs(t, err, entity.ErrOIDCLinkRequiresVerifiedAccount, "unverified local accounts cannot be claimed")
+	assert.Empty(t, state.identities)
+}
+
+func TestOIDCCallback_StateIsSingleUseAndBoundToProvider(t *testing.T) {
+	ctx := context.Background()
+	uc, state := newOIDCAuthUC(t, outputmocks.NewMockUserRepository(t))
+	state.oidcProviders["keycloak"] = &fakeOIDCProvider{name: "keycloak"}
+	state.oidcProviders["gitlab"] = &fakeOIDCProvider{name: "gitlab"}
+
+	authURL, err := uc.StartOIDCLogin(ctx, "keycloak")
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := parsed.Query()
+
+	_, err = uc.HandleOIDCCallback(ctx, "gitlab", query.Get("code"), query.Get("state"))
+	require.ErrorIs(t, err, entity.ErrOIDCStateInvalid)
+	_, err = uc.HandleOIDCCallback(ctx, "keycloak", query.Get("code"), query.Get("state"))
+	require.ErrorIs(t, err, entity.ErrOIDCStateInvalid, "a rejected callback consumes the state")
+
+	_, err = uc.StartOIDCLogin(ctx, "missing")
+	require.ErrorIs(t, err, entity.ErrOIDCProviderNotFound)
+}
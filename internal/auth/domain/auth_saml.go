@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/pdsaml"
+)
+
+// samlApplyTokenTTL bounds the token minted to apply a SAML login to IAM. It is never
+// returned to the client.
+const samlApplyTokenTTL = time.Minute
+
+// samlLoginState is kept server-side between StartSAMLLogin and the IdP's POST to the ACS;
+// the relay state is its key.
+type samlLoginState struct {
+	OrgID     string `json:"org_id"`
+	RequestID string `json:"request_id"`
+}
+
+func samlStateKey(relayState string) string {
+	return "oauth:saml:" + relayState
+}
+
+func (u *authInteractorImpl) serviceProvider(orgID string) (pdsaml.ServiceProvider, error) {
+	if u.samlCfg.BaseURL == "" {
+		return pdsaml.ServiceProvider{}, entity.ErrSAMLNotConfigured
+	}
+	orgID = strings.TrimSpace(orgID)
+	if orgID == "" {
+		return pdsaml.ServiceProvider{}, entity.ErrSAMLConnectionNotFound
+	}
+	base := u.samlCfg.BaseURL + "/auth/v1/saml/" + url.PathEscape(orgID)
+	return pdsaml.ServiceProvider{EntityID: base + "/metadata", ACSURL: base + "/acs"}, nil
+}
+
+func (u *authInteractorImpl) SAMLServiceProviderMetadata(ctx context.Context, orgID string) ([]byte, error) {
+	sp, err := u.serviceProvider(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return sp.Metadata()
+}
+
+func (u *authInteractorImpl) StartSAMLLogin(ctx context.Context, orgID string) (string, error) {
+	sp, err := u.serviceProvider(orgID)
+	if err != nil {
+		return "", err
+	}
+	connection, err := u.samlConnections.ResolveSAMLConnection(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+	idp, err := pdsaml.ParseIdPMetadata([]byte(connection.MetadataXML))
+	if err != nil {
+		return "", fmt.Errorf("parse idp metadata: %w", err)
+	}
+	relayState, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("error generating relay state: %w", err)
+	}
+	redirectURL, requestID, err := sp.AuthnRequestURL(idp, relayState, time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(samlLoginState{OrgID: connection.OrgID, RequestID: requestID})
+	if err != nil {
+		return "", err
+	}
+	if err := u.oauthStateRepository.SetValue(samlStateKey(relayState), string(payload), oidcStateTTL); err != nil {
+		return "", err
+	}
+	return redirectURL, nil
+}
+
+func (u *authInteractorImpl) HandleSAMLResponse(
+	ctx context.Context,
+	orgID, samlResponse, relayState string,
+) (*inputport.OIDCCallbackResult, error) {
+	if relayState == "" {
+		return nil, entity.ErrSAMLLoginInvalid
+	}
+	key := samlStateKey(relayState)
+	raw, err := u.oauthStateRepository.Get(key)
+	if err != nil {
+		return nil, entity.ErrSAMLLoginInvalid
+	}
+	_ = u.oauthStateRepository.Del(key)
+	var loginState samlLoginState
+	if err := json.Unmarshal([]byte(raw), &loginState); err != nil || loginState.OrgID != orgID {
+		return nil, entity.ErrSAMLLoginInvalid
+	}
+
+	sp, err := u.serviceProvider(orgID)
+	if err != nil {
+		return nil, err
+	}
+	connection, err := u.samlConnections.ResolveSAMLConnection(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	idp, err := pdsaml.ParseIdPMetadata([]byte(connection.MetadataXML))
+	if err != nil {
+		return nil, fmt.Errorf("parse idp metadata: %w", err)
+	}
+	assertion, err := pdsaml.ParseResponse(samlResponse, pdsaml.ValidateOptions{
+		SP:        sp,
+		IdP:       idp,
+		RequestID: loginState.RequestID,
+		Now:       time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrSAMLLoginInvalid, err)
+	}
+
+	user, err := u.userForSAMLAssertion(ctx, connection, assertion)
+	if err != nil {
+		return nil, err
+	}
+	identityProvider := entity.SAMLIdentityProvider(connection.OrgID)
+	if err := u.applySAMLLogin(ctx, user, identityProvider, connection, assertion); err != nil {
+		return nil, fmt.Errorf("apply saml login: %w", err)
+	}
+	return u.finishExternalLogin(ctx, user, identityProvider)
+}
+
+// applySAMLLogin hands the login to IAM with a token sourced from this SAML login, which is
+// the only token IAM accepts for granting membership of the organization.
+func (u *authInteractorImpl) applySAMLLogin(
+	ctx context.Context,
+	user *entity.User,
+	identityProvider string,
+	connection *entity.SAMLConnection,
+	assertion *pdsaml.Assertion,
+) error {
+	accessToken, err := u.tokenUC.CreateJwtTokenForSessionState(*user, entity.Session{
+		UserID:           user.Id,
+		IdentityProvider: identityProvider,
+		ExpiresAt:        time.Now().UTC().Add(samlApplyTokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+	var groups []string
+	if connection.GroupsAttribute != "" {
+		groups = assertion.Attribute(connection.GroupsAttribute)
+	}
+	return u.samlConnections.ApplySAMLLogin(ctx, accessToken, connection.OrgID, user.Id, groups)
+}
+
+// userForSAMLAssertion resolves the user linked to the assertion's NameID. On first login the
+// identity is linked to the local account holding the asserted email only when that account
+// is already an active member of the organization; otherwise a new user is created.
+func (u *authInteractorImpl) userForSAMLAssertion(
+	ctx context.Context,
+	connection *entity.SAMLConnection,
+	assertion *pdsaml.Assertion,
+) (*entity.User, error) {
+	now := time.Now().UTC()
+	provider := entity.SAMLIdentityProvider(connection.OrgID)
+	link, err := u.identityRepository.GetByProviderSubject(ctx, provider, assertion.NameID)
+	if err == nil {
+		user, err := u.userRepository.GetByID(fmt.Sprintf("%d", link.UserID))
+		if err != nil {
+			return nil, err
+		}
+		if err := u.identityRepository.TouchLastLogin(ctx, provider, assertion.NameID, now); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, entity.ErrIdentityNotLinked) {
+		return nil, err
+	}
+
+	email := samlEmail(connection, assertion)
+	if email == "" {
+		return nil, fmt.Errorf("%w: assertion carries no email address", entity.ErrSAMLLoginInvalid)
+	}
+	username := assertion.NameID
+	if connection.UsernameAttribute != "" {
+		username = strings.TrimSpace(assertion.FirstAttribute(connection.UsernameAttribute))
+	}
+	if username == "" {
+		username = email
+	}
+
+	user, err := u.userRepository.GetByUsernameOrEmail(email)
+	switch {
+	case err == nil && user.Email == email:
+		member, err := u.samlConnections.IsActiveOrganizationMember(ctx, connection.OrgID, user.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !member {
+			return nil, entity.ErrSAMLAccountConflict
+		}
+	case err == nil:
+		return nil, entity.ErrSAMLAccountConflict
+	case errors.Is(err, entity.ErrUserNotFound):
+		user, err = u.userRepository.Create(entity.User{
+			Username:    username,
+			Email:       email,
+			InitialFrom: provider,
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err := u.identityRepository.Create(ctx, entity.UserIdentity{
+		UserID:      user.Id,
+		Provider:    provider,
+		Subject:     assertion.NameID,
+		Email:       email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// samlEmail reads the mapped email attribute, falling back to an email-format NameID.
+func samlEmail(connection *entity.SAMLConnection, assertion *pdsaml.Assertion) string {
+	if connection.EmailAttribute != "" {
+		return strings.TrimSpace(assertion.FirstAttribute(connection.EmailAttribute))
+	}
+	if assertion.NameIDFormat == pdsaml.NameIDFormatEmail {
+		return strings.TrimSpace(assertion.NameID)
+	}
+	return ""
+}
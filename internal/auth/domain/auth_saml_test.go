@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdsaml"
+)
+
+func newSAMLAuthUC(t *testing.T, userRepo *outputmocks.MockUserRepository) (*authInteractorImpl, *authRepoState) {
+	t.Helper()
+	cfg := config.AuthConfig{
+		JWTSecret:      "secret",
+		JWTKey:         "app-key",
+		AppRedirectURL: "https://app.example.com/after-auth",
+		SAML:           config.SAMLConfig{BaseURL: "https://auth.example.com"},
+	}
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		newMemoryStateRepo(t),
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	return uc, state
+}
+
+func testIdPMetadata(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, base64.StdEncoding.EncodeToString(der))
+}
+
+func TestLogin_SSORequiredBlocksPassword(t *testing.T) {
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().
+		GetByUsernameOrEmail("jdoe").
+		Return(&entity.User{Id: 1, Username: "jdoe", Password: hashed}, nil)
+
+	uc, state := newSAMLAuthUC(t, userRepo)
+	state.ssoRequired[1] = []string{"org-1"}
+
+	_, err = uc.Login(context.Background(), "jdoe", "pass123")
+	require.ErrorIs(t, err, entity.ErrSSORequired)
+	assert.Empty(t, state.sessions)
+}
+
+func TestStartSAMLLogin_RedirectsToIdPAndBindsRelayState(t *testing.T) {
+	uc, state := newSAMLAuthUC(t, outputmocks.NewMockUserRepository(t))
+	state.saml["org-1"] = entity.SAMLConnection{OrgID: "org-1", MetadataXML: testIdPMetadata(t)}
+	ctx := context.Background()
+
+	redirectURL, err := uc.StartSAMLLogin(ctx, "org-1")
+	require.NoError(t, err)
+	parsed, err := url.Parse(redirectURL)
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", parsed.Host)
+	assert.NotEmpty(t, parsed.Query().Get("SAMLRequest"))
+	relayState := parsed.Query().Get("RelayState")
+	require.NotEmpty(t, relayState)
+
+	// The relay state is bound to the organization it was issued for and is single use.
+	_, err = uc.HandleSAMLResponse(ctx, "org-2", "response", relayState)
+	require.ErrorIs(t, err, entity.ErrSAMLLoginInvalid)
+	_, err = uc.HandleSAMLResponse(ctx, "org-1", "response", relayState)
+	require.ErrorIs(t, err, entity.ErrSAMLLoginInvalid)
+
+	_, err = uc.StartSAMLLogin(ctx, "org-3")
+	require.ErrorIs(t, err, entity.ErrSAMLConnectionNotFound)
+}
+
+func TestSAMLServiceProviderMetadata_RequiresBaseURL(t *testing.T) {
+	uc, _ := newSAMLAuthUC(t, outputmocks.NewMockUserRepository(t))
+	metadata, err := uc.SAMLServiceProviderMetadata(context.Background(), "org-1")
+	require.NoError(t, err)
+	assert.Contains(t, string(metadata), `entityID="https://auth.example.com/auth/v1/saml/org-1/metadata"`)
+	assert.Contains(t, string(metadata), `Location="https://auth.example.com/auth/v1/saml/org-1/acs"`)
+
+	uc.samlCfg.BaseURL = ""
+	_, err = uc.SAMLServiceProviderMetadata(context.Background(), "org-1")
+	require.ErrorIs(t, err, entity.ErrSAMLNotConfigured)
+}
+
+func TestSAMLAssertion_LinksExistingAccountOnlyForOrganizationMembers(t *testing.T) {
+	verifiedAt := time.Now().UTC()
+	user := &entity.User{Id: 11, Username: "neo", Email: "neo@mx.io", EmailVerifiedAt: &verifiedAt}
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().GetByUsernameOrEmail("neo@mx.io").Return(user, nil)
+
+	uc, state := newSAMLAuthUC(t, userRepo)
+	connection := &entity.SAMLConnection{OrgID: "org-1", EmailAttribute: "mail", GroupsAttribute: "groups"}
+	assertion := &pdsaml.Assertion{
+		NameID: "neo-at-idp",
+		Attributes: map[string][]string{
+			"mail":   {"neo@mx.io"},
+			"groups": {"Engineering"},
+		},
+	}
+	ctx := context.Background()
+
+	_, err := uc.userForSAMLAssertion(ctx, connection, assertion)
+	require.ErrorIs(t, err, entity.ErrSAMLAccountConflict)
+	require.Empty(t, state.identities)
+
+	state.orgMembers["org-1|11"] = true
+	linked, err := uc.userForSAMLAssertion(ctx, connection, assertion)
+	require.NoError(t, err)
+	assert.Equal(t, user.Id, linked.Id)
+	require.Contains(t, state.identities, "saml:org-1|neo-at-idp")
+
+	require.NoError(t, uc.applySAMLLogin(ctx, linked, "saml:org-1", connection, assertion))
+	assert.Equal(t, []string{"org-1|11|[Engineering]"}, state.samlLogins)
+}
+
+func TestSAMLAssertion_CreatesUserFromMappedAttributes(t *testing.T) {
+	userRepo := outputmocks.NewMockUserRepository(t)
+	userRepo.EXPECT().GetByUsernameOrEmail("trinity@mx.io").Return(nil, entity.ErrUserNotFound)
+	userRepo.EXPECT().
+		Create(mock.MatchedBy(func(u entity.User) bool {
+			return u.Username == "trinity" && u.Email == "trinity@mx.io" && u.InitialFrom == "saml:org-1"
+		})).
+		Return(&entity.User{Id: 12, Username: "trinity", Email: "trinity@mx.io", InitialFrom: "saml:org-1"}, nil)
+
+	uc, state := newSAMLAuthUC(t, userRepo)
+	connection := &entity.SAMLConnection{OrgID: "org-1", UsernameAttribute: "uid"}
+	assertion := &pdsaml.Assertion{
+		NameID:       "trinity@mx.io",
+		NameIDFormat: pdsaml.NameIDFormatEmail,
+		Attributes:   map[string][]string{"uid": {"trinity"}},
+	}
+
+	user, err := uc.userForSAMLAssertion(context.Background(), connection, assertion)
+	require.NoError(t, err)
+	assert.Equal(t, uint(12), user.Id)
+	require.Contains(t, state.identities, "saml:org-1|trinity@mx.io")
+
+	_, err = uc.userForSAMLAssertion(context.Background(), connection, &pdsaml.Assertion{NameID: "opaque-id"})
+	require.ErrorIs(t, err, entity.ErrSAMLLoginInvalid)
+}
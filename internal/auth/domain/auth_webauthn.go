@@ -0,0 +1,478 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/pdwebauthn"
+)
+
+const (
+	webAuthnPurposeRegister = "register"
+	webAuthnPurposeLogin    = "login"
+
+	webAuthnCloneDetectedEventType = events.TypeWebAuthnCloneDetected
+
+	defaultWebAuthnCredentialName = "Passkey"
+	maxWebAuthnCredentialName     = 64
+)
+
+// webAuthnChallenge is stored per ceremony and consumed by the matching Finish call.
+// MFAChallengeKey is set when the login completes a password (or OIDC) login as second factor.
+type webAuthnChallenge struct {
+	Purpose                 string    `json:"purpose"`
+	UserID                  uint      `json:"user_id,omitempty"`
+	Challenge               string    `json:"challenge"`
+	RequireUserVerification bool      `json:"require_user_verification"`
+	MFAChallengeKey         string    `json:"mfa_challenge_key,omitempty"`
+	ExpiresAt               time.Time `json:"expires_at"`
+}
+
+func webAuthnChallengeKey(challengeID string) string {
+	return "auth:webauthn:challenge:" + entity.HashToken(challengeID)
+}
+
+// webAuthnUserHandle is the opaque user.id given to authenticators and returned as userHandle.
+func webAuthnUserHandle(userID uint) string {
+	return pdwebauthn.URLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(userID), 10)))
+}
+
+func (u *authInteractorImpl) relyingParty() (pdwebauthn.RelyingParty, error) {
+	if u.webAuthnCfg.RPID == "" {
+		return pdwebauthn.RelyingParty{}, entity.ErrWebAuthnNotConfigured
+	}
+	return pdwebauthn.RelyingParty{
+		ID:      u.webAuthnCfg.RPID,
+		Name:    u.webAuthnCfg.RPName,
+		Origins: u.webAuthnCfg.Origins,
+		Timeout: u.webAuthnCfg.ChallengeTTL,
+	}, nil
+}
+
+// usableWebAuthnCredentials lists the credentials that can still sign in; ones flagged as
+// cloned are left out. It is empty while WebAuthn is not configured.
+func (u *authInteractorImpl) usableWebAuthnCredentials(
+	ctx context.Context,
+	userID uint,
+) ([]entity.WebAuthnCredential, error) {
+	if u.webAuthnCfg.RPID == "" {
+		return nil, nil
+	}
+	credentials, err := u.webAuthnRepository.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]entity.WebAuthnCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		if credential.CloneDetectedAt == nil {
+			out = append(out, credential)
+		}
+	}
+	return out, nil
+}
+
+func webAuthnDescriptors(credentials []entity.WebAuthnCredential) []pdwebauthn.CredentialDescriptor {
+	out := make([]pdwebauthn.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		out = append(out, pdwebauthn.CredentialDescriptor{
+			Type:       "public-key",
+			ID:         credential.CredentialID,
+			Transports: credential.Transports,
+		})
+	}
+	return out
+}
+
+func (u *authInteractorImpl) newWebAuthnCeremony(
+	challenge webAuthnChallenge,
+	options any,
+) (*inputport.WebAuthnCeremony, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webauthn options: %w", err)
+	}
+	challengeID, err := randomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webauthn challenge: %w", err)
+	}
+	payload, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webauthn challenge: %w", err)
+	}
+	if err := u.oauthStateRepository.SetValue(
+		webAuthnChallengeKey(challengeID),
+		string(payload),
+		time.Until(challenge.ExpiresAt),
+	); err != nil {
+		return nil, fmt.Errorf("failed to persist webauthn challenge: %w", err)
+	}
+	return &inputport.WebAuthnCeremony{ChallengeID: challengeID, OptionsJSON: string(optionsJSON)}, nil
+}
+
+// takeWebAuthnChallenge loads and deletes a challenge, so each one completes at most one ceremony.
+func (u *authInteractorImpl) takeWebAuthnChallenge(challengeID, purpose string) (*webAuthnChallenge, error) {
+	challengeID = strings.TrimSpace(challengeID)
+	if challengeID == "" {
+		return nil, entity.ErrWebAuthnChallengeInvalid
+	}
+	key := webAuthnChallengeKey(challengeID)
+	raw, err := u.oauthStateRepository.Get(key)
+	if err != nil {
+		return nil, entity.ErrWebAuthnChallengeInvalid
+	}
+	_ = u.oauthStateRepository.Del(key)
+	var challenge webAuthnChallenge
+	if err := json.Unmarshal([]byte(raw), &challenge); err != nil {
+		return nil, entity.ErrWebAuthnChallengeInvalid
+	}
+	if challenge.Purpose != purpose || !time.Now().UTC().Before(challenge.ExpiresAt) {
+		return nil, entity.ErrWebAuthnChallengeInvalid
+	}
+	return &challenge, nil
+}
+
+// BeginWebAuthnRegistration returns creation options for a new passkey on the caller's account.
+// Credentials already registered are excluded so an authenticator is not enrolled twice.
+func (u *authInteractorImpl) BeginWebAuthnRegistration(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+) (*inputport.WebAuthnCeremony, error) {
+	rp, err := u.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	_, user, now, err := u.loadOwnedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := u.webAuthnRepository.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := pdwebauthn.NewChallenge()
+	if err != nil {
+		return nil, err
+	}
+	displayName := user.FullName
+	if displayName == "" {
+		displayName = user.Username
+	}
+	options := rp.NewCreationOptions(challenge, pdwebauthn.UserEntity{
+		ID:          webAuthnUserHandle(user.Id),
+		Name:        user.Username,
+		DisplayName: displayName,
+	}, webAuthnDescriptors(existing))
+	return u.newWebAuthnCeremony(webAuthnChallenge{
+		Purpose:   webAuthnPurposeRegister,
+		UserID:    userID,
+		Challenge: challenge,
+		ExpiresAt: now.Add(u.webAuthnCfg.ChallengeTTL),
+	}, options)
+}
+
+// FinishWebAuthnRegistration verifies the authenticator's response and stores the credential.
+func (u *authInteractorImpl) FinishWebAuthnRegistration(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+	challengeID string,
+	name string,
+	credentialJSON string,
+) (*entity.WebAuthnCredential, error) {
+	rp, err := u.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	_, _, now, err := u.loadOwnedActiveSession(ctx, userID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := u.takeWebAuthnChallenge(challengeID, webAuthnPurposeRegister)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UserID != userID {
+		return nil, entity.ErrWebAuthnChallengeInvalid
+	}
+	resp, err := pdwebauthn.ParseRegistrationResponse(credentialJSON)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrWebAuthnVerificationFailed, err)
+	}
+	verified, err := rp.VerifyRegistration(challenge.Challenge, resp, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrWebAuthnVerificationFailed, err)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = defaultWebAuthnCredentialName
+	}
+	if runes := []rune(name); len(runes) > maxWebAuthnCredentialName {
+		name = string(runes[:maxWebAuthnCredentialName])
+	}
+	credential := entity.WebAuthnCredential{
+		ID:             uuid.NewString(),
+		UserID:         userID,
+		CredentialID:   verified.ID,
+		PublicKey:      verified.PublicKey,
+		Algorithm:      verified.Algorithm,
+		SignCount:      verified.SignCount,
+		AAGUID:         formatAAGUID(verified.AAGUID),
+		Transports:     verified.Transports,
+		Name:           name,
+		BackupEligible: verified.BackupEligible,
+		BackupState:    verified.BackupState,
+		CreatedAt:      now,
+	}
+	if err := u.webAuthnRepository.Create(ctx, credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+func formatAAGUID(raw []byte) string {
+	id, err := uuid.FromBytes(raw)
+	if err != nil || id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+func (u *authInteractorImpl) ListWebAuthnCredentials(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+) ([]entity.WebAuthnCredential, error) {
+	if _, _, _, err := u.loadOwnedActiveSession(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+	return u.webAuthnRepository.ListByUser(ctx, userID)
+}
+
+func (u *authInteractorImpl) DeleteWebAuthnCredential(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+	id string,
+) error {
+	if _, _, _, err := u.loadOwnedActiveSession(ctx, userID, accessToken); err != nil {
+		return err
+	}
+	return u.webAuthnRepository.Delete(ctx, strings.TrimSpace(id), userID)
+}
+
+// BeginWebAuthnLogin returns request options for a passkey login. With an mfaToken from Login
+// the passkey completes that login as its second factor; otherwise the login is passwordless
+// and requires user verification. A username narrows the allowed credentials to that user's;
+// without one (or for unknown names) any discoverable credential may answer.
+func (u *authInteractorImpl) BeginWebAuthnLogin(
+	ctx context.Context,
+	username string,
+	mfaToken string,
+) (*inputport.WebAuthnCeremony, error) {
+	rp, err := u.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	challenge := webAuthnChallenge{
+		Purpose:                 webAuthnPurposeLogin,
+		RequireUserVerification: true,
+		ExpiresAt:               time.Now().UTC().Add(u.webAuthnCfg.ChallengeTTL),
+	}
+
+	mfaToken = strings.TrimSpace(mfaToken)
+	username = strings.TrimSpace(username)
+	switch {
+	case mfaToken != "":
+		key := mfaChallengeKey(mfaToken)
+		raw, err := u.oauthStateRepository.Get(key)
+		if err != nil {
+			return nil, entity.ErrMFAChallengeInvalid
+		}
+		var pending mfaChallenge
+		if err := json.Unmarshal([]byte(raw), &pending); err != nil || pending.UserID == 0 {
+			return nil, entity.ErrMFAChallengeInvalid
+		}
+		challenge.UserID = pending.UserID
+		challenge.MFAChallengeKey = key
+		// The password (or IdP) already stands for "something you know".
+		challenge.RequireUserVerification = false
+	case username != "":
+		user, err := u.userRepository.GetByUsernameOrEmail(username)
+		if err != nil && !errors.Is(err, entity.ErrUserNotFound) {
+			return nil, err
+		}
+		if user != nil {
+			challenge.UserID = user.Id
+		}
+	}
+
+	var allow []pdwebauthn.CredentialDescriptor
+	if challenge.UserID != 0 {
+		credentials, err := u.usableWebAuthnCredentials(ctx, challenge.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if challenge.MFAChallengeKey != "" && len(credentials) == 0 {
+			return nil, entity.ErrWebAuthnCredentialNotFound
+		}
+		allow = webAuthnDescriptors(credentials)
+	}
+	if challenge.MFAChallengeKey == "" && len(allow) == 0 {
+		// Fall back to a discoverable login rather than reveal that the account has no passkey.
+		challenge.UserID = 0
+	}
+
+	nonce, err := pdwebauthn.NewChallenge()
+	if err != nil {
+		return nil, err
+	}
+	challenge.Challenge = nonce
+	userVerification := pdwebauthn.UserVerificationPreferred
+	if challenge.RequireUserVerification {
+		userVerification = pdwebauthn.UserVerificationRequired
+	}
+	return u.newWebAuthnCeremony(challenge, rp.NewRequestOptions(nonce, allow, userVerification))
+}
+
+// FinishWebAuthnLogin verifies the assertion and opens a session. Passwordless sessions carry
+// the webauthn identity provider; second-factor logins keep the provider of the first factor.
+// Both are MFA-authenticated.
+func (u *authInteractorImpl) FinishWebAuthnLogin(
+	ctx context.Context,
+	challengeID string,
+	credentialJSON string,
+) (*inputport.AuthResult, error) {
+	rp, err := u.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := u.takeWebAuthnChallenge(challengeID, webAuthnPurposeLogin)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := pdwebauthn.ParseAssertionResponse(credentialJSON)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrWebAuthnVerificationFailed, err)
+	}
+	credential, err := u.webAuthnRepository.GetByCredentialID(ctx, resp.ID)
+	if errors.Is(err, entity.ErrWebAuthnCredentialNotFound) {
+		return nil, entity.ErrWebAuthnVerificationFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UserID != 0 && credential.UserID != challenge.UserID {
+		return nil, entity.ErrWebAuthnVerificationFailed
+	}
+	if credential.CloneDetectedAt != nil {
+		return nil, entity.ErrWebAuthnCloneDetected
+	}
+	assertion, err := rp.VerifyAssertion(
+		challenge.Challenge,
+		resp,
+		credential.PublicKey,
+		challenge.RequireUserVerification,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrWebAuthnVerificationFailed, err)
+	}
+	if assertion.UserHandle != "" && assertion.UserHandle != webAuthnUserHandle(credential.UserID) {
+		return nil, entity.ErrWebAuthnVerificationFailed
+	}
+
+	now := time.Now().UTC()
+	if credential.CounterRegressed(assertion.SignCount) {
+		if err := u.flagClonedWebAuthnCredential(ctx, credential, assertion.SignCount, now); err != nil {
+			return nil, err
+		}
+		return nil, entity.ErrWebAuthnCloneDetected
+	}
+	updated, err := u.webAuthnRepository.UpdateUsage(
+		ctx,
+		credential.ID,
+		credential.SignCount,
+		assertion.SignCount,
+		assertion.BackupState,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		// Another login moved the counter first; this assertion is stale.
+		return nil, entity.ErrWebAuthnVerificationFailed
+	}
+
+	identityProvider := entity.IdentityProviderWebAuthn
+	if challenge.MFAChallengeKey != "" {
+		raw, err := u.oauthStateRepository.Get(challenge.MFAChallengeKey)
+		if err != nil {
+			return nil, entity.ErrMFAChallengeInvalid
+		}
+		u.dropMFAChallenge(challenge.MFAChallengeKey)
+		var pending mfaChallenge
+		if err := json.Unmarshal([]byte(raw), &pending); err != nil || pending.UserID != credential.UserID {
+			return nil, entity.ErrMFAChallengeInvalid
+		}
+		identityProvider = pending.IdentityProvider
+	}
+
+	user, err := u.userRepository.GetByID(fmt.Sprintf("%d", credential.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if challenge.MFAChallengeKey != "" {
+		if err := u.loginThrottle.RecordSuccess(ctx, user.Username); err != nil {
+			return nil, err
+		}
+	}
+	result, err := u.newSessionAuthResult(ctx, user, "", identityProvider, &now)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.ensureRootOrganization(ctx, user, result.JwtToken); err != nil {
+		return nil, fmt.Errorf("bootstrap organization account: %w", err)
+	}
+	return result, nil
+}
+
+// flagClonedWebAuthnCredential disables a credential whose counter went backwards, which
+// means two authenticators share its private key.
+func (u *authInteractorImpl) flagClonedWebAuthnCredential(
+	ctx context.Context,
+	credential *entity.WebAuthnCredential,
+	signCount uint32,
+	now time.Time,
+) error {
+	if err := u.webAuthnRepository.MarkCloneDetected(ctx, credential.ID, now); err != nil {
+		return err
+	}
+	return u.securityEvents.record(ctx, securityEvent{
+		At:           now,
+		ActorUserID:  credential.UserID,
+		Action:       "webauthn.clone_detected",
+		Severity:     entity.AuditSeverityHigh,
+		ResourceType: "webauthn_credential",
+		ResourceID:   credential.ID,
+		EventType:    webAuthnCloneDetectedEventType,
+		MessageKey:   credential.ID,
+		Payload: events.WebAuthnCloneDetectedV1{
+			CredentialID:    credential.ID,
+			UserID:          credential.UserID,
+			StoredSignCount: credential.SignCount,
+			SignCount:       signCount,
+		},
+	})
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	inputmocks "github.com/tuannm99/podzone/internal/auth/domain/inputport/mocks"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdwebauthn/webauthntest"
+)
+
+const webAuthnTestOrigin = "https://app.example.com"
+
+func newWebAuthnAuthUC(t *testing.T, user *entity.User) (*authInteractorImpl, *authRepoState) {
+	t.Helper()
+	cfg := config.AuthConfig{
+		JWTSecret:      "secret",
+		JWTKey:         "app-key",
+		AppRedirectURL: "https://app.example.com/after-auth",
+		MFA:            config.MFAConfig{Issuer: "Podzone", ChallengeTTL: time.Minute},
+		WebAuthn: config.WebAuthnConfig{
+			RPID:         "example.com",
+			RPName:       "Podzone",
+			Origins:      []string{webAuthnTestOrigin},
+			ChallengeTTL: time.Minute,
+		},
+	}
+	userRepo := &outputmocks.MockUserRepository{}
+	userRepo.On("GetByUsernameOrEmail", user.Username).Return(user, nil).Maybe()
+	userRepo.On("GetByID", fmt.Sprintf("%d", user.Id)).Return(user, nil).Maybe()
+	uc, state, _, _ := newStatefulAuthUC(
+		t,
+		cfg,
+		&inputmocks.MockUserUsecase{},
+		NewTokenUsecase(cfg),
+		&outputmocks.MockGoogleOauthExternal{},
+		newMemoryStateRepo(t),
+		userRepo,
+		func(ctx context.Context, tenantID string, userID uint) error { return nil },
+	)
+	return uc, state
+}
+
+// registerPasskey signs in with the password and enrolls a software authenticator.
+func registerPasskey(t *testing.T, uc *authInteractorImpl, user *entity.User) *webauthntest.Authenticator {
+	t.Helper()
+	ctx := context.Background()
+	login, err := uc.Login(ctx, user.Username, "pass123")
+	require.NoError(t, err)
+	require.NotEmpty(t, login.JwtToken)
+
+	authenticator, err := webauthntest.New("example.com", webAuthnTestOrigin)
+	require.NoError(t, err)
+	ceremony, err := uc.BeginWebAuthnRegistration(ctx, user.Id, login.JwtToken)
+	require.NoError(t, err)
+	credentialJSON, err := authenticator.Register(ceremony.OptionsJSON)
+	require.NoError(t, err)
+	credential, err := uc.FinishWebAuthnRegistration(
+		ctx, user.Id, login.JwtToken, ceremony.ChallengeID, "YubiKey", credentialJSON,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, authenticator.ID(), credential.CredentialID)
+	assert.Equal(t, "YubiKey", credential.Name)
+	return authenticator
+}
+
+func assertWithPasskey(
+	t *testing.T,
+	uc *authInteractorImpl,
+	authenticator *webauthntest.Authenticator,
+	username, mfaToken string,
+) (*inputport.AuthResult, error) {
+	t.Helper()
+	ceremony, err := uc.BeginWebAuthnLogin(context.Background(), username, mfaToken)
+	require.NoError(t, err)
+	assertionJSON, err := authenticator.Assert(ceremony.OptionsJSON)
+	require.NoError(t, err)
+	return uc.FinishWebAuthnLogin(context.Background(), ceremony.ChallengeID, assertionJSON)
+}
+
+func TestWebAuthn_PasswordlessLogin(t *testing.T) {
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 7, Username: "trinity", Password: hashed}
+	uc, state := newWebAuthnAuthUC(t, user)
+	authenticator := registerPasskey(t, uc, user)
+
+	resp, err := assertWithPasskey(t, uc, authenticator, "", "")
+	require.NoError(t, err)
+	claims, err := uc.verifier.ClaimsFromTokenString(resp.JwtToken)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IdentityProviderWebAuthn, claims.IdentitySource)
+	assert.True(t, claims.MultiFactorAuthPresent)
+	for _, credential := range state.webAuthn {
+		assert.Equal(t, uint32(1), credential.SignCount)
+		assert.NotNil(t, credential.LastUsedAt)
+	}
+
+	unverified := authenticator.Clone()
+	unverified.UserVerified = false
+	_, err = assertWithPasskey(t, uc, unverified, "trinity", "")
+	require.ErrorIs(t, err, entity.ErrWebAuthnVerificationFailed, "passwordless login needs user verification")
+
+	ceremony, err := uc.BeginWebAuthnLogin(context.Background(), "trinity", "")
+	require.NoError(t, err)
+	assertionJSON, err := authenticator.Assert(ceremony.OptionsJSON)
+	require.NoError(t, err)
+	_, err = uc.FinishWebAuthnLogin(context.Background(), ceremony.ChallengeID, assertionJSON)
+	require.NoError(t, err)
+	_, err = uc.FinishWebAuthnLogin(context.Background(), ceremony.ChallengeID, assertionJSON)
+	require.ErrorIs(t, err, entity.ErrWebAuthnChallengeInvalid, "challenges are single use")
+}
+
+func TestWebAuthn_SecondFactorAfterPassword(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 7, Username: "trinity", Password: hashed}
+	uc, _ := newWebAuthnAuthUC(t, user)
+	authenticator := registerPasskey(t, uc, user)
+
+	challenge, err := uc.Login(ctx, "trinity", "pass123")
+	require.NoError(t, err)
+	require.True(t, challenge.MFARequired)
+	assert.Equal(t, []string{inputport.MFAMethodWebAuthn}, challenge.MFAMethods)
+
+	// User presence is enough once the password has been checked.
+	authenticator.UserVerified = false
+	resp, err := assertWithPasskey(t, uc, authenticator, "", challenge.MFAToken)
+	require.NoError(t, err)
+	claims, err := uc.verifier.ClaimsFromTokenString(resp.JwtToken)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IdentityProviderPodzone, claims.IdentitySource)
+	assert.True(t, claims.MultiFactorAuthPresent)
+
+	_, err = uc.BeginWebAuthnLogin(ctx, "", challenge.MFAToken)
+	require.ErrorIs(t, err, entity.ErrMFAChallengeInvalid, "the password step is consumed")
+}
+
+func TestWebAuthn_CloneDetectionDisablesCredential(t *testing.T) {
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 7, Username: "trinity", Password: hashed}
+	uc, state := newWebAuthnAuthUC(t, user)
+	authenticator := registerPasskey(t, uc, user)
+	clone := authenticator.Clone()
+
+	_, err = assertWithPasskey(t, uc, authenticator, "", "")
+	require.NoError(t, err)
+	_, err = assertWithPasskey(t, uc, clone, "", "")
+	require.ErrorIs(t, err, entity.ErrWebAuthnCloneDetected)
+
+	for _, credential := range state.webAuthn {
+		require.NotNil(t, credential.CloneDetectedAt)
+	}
+	_, err = assertWithPasskey(t, uc, authenticator, "", "")
+	require.ErrorIs(t, err, entity.ErrWebAuthnCloneDetected, "a flagged credential stays disabled")
+
+	login, err := uc.Login(context.Background(), "trinity", "pass123")
+	require.NoError(t, err)
+	assert.False(t, login.MFARequired, "a disabled passkey is not offered as a second factor")
+}
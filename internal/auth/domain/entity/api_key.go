@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// APIKeyOwnerUser keys act as the user who created them (personal access tokens).
+	APIKeyOwnerUser = "user"
+	// APIKeyOwnerServicePrincipal keys act as an IAM role assumed for a service principal.
+	APIKeyOwnerServicePrincipal = "service_principal"
+
+	// IdentitySourceAPIKey is stamped on claims resolved from an API key.
+	IdentitySourceAPIKey = "api_key"
+)
+
+// APIKey is a long-lived credential for machine clients. Only LookupID and a hash of the raw
+// key are stored; the raw key is shown once at creation.
+type APIKey struct {
+	ID               string                   `json:"id"`
+	LookupID         string                   `json:"lookup_id"`
+	KeyHash          string                   `json:"key_hash"`
+	Name             string                   `json:"name"`
+	OwnerType        string                   `json:"owner_type"`
+	UserID           uint                     `json:"user_id"`
+	TenantID         string                   `json:"tenant_id,omitempty"`
+	ServicePrincipal string                   `json:"service_principal,omitempty"`
+	RoleID           uint64                   `json:"role_id,omitempty"`
+	RoleScope        string                   `json:"role_scope,omitempty"`
+	RoleName         string                   `json:"role_name,omitempty"`
+	RoleTenantID     string                   `json:"role_tenant_id,omitempty"`
+	SessionPolicy    []SessionPolicyStatement `json:"session_policy,omitempty"`
+	ExpiresAt        time.Time                `json:"expires_at"`
+	LastUsedAt       *time.Time               `json:"last_used_at"`
+	CreatedAt        time.Time                `json:"created_at"`
+	RevokedAt        *time.Time               `json:"revoked_at"`
+}
+
+func (k APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && now.Before(k.ExpiresAt)
+}
+
+var (
+	ErrAPIKeyInvalid      = errors.New("api key is invalid, revoked or expired")
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrAPIKeyNameRequired = errors.New("api key name is required")
+	ErrAPIKeyTTLTooLong   = errors.New("api key expiry exceeds the allowed maximum")
+	ErrAPIKeyNotAllowed   = errors.New("api keys cannot manage api keys")
+)
@@ -4,6 +4,10 @@ import "time"
 
 const (
 	AuditStatusSuccess = "success"
+
+	AuditSeverityInfo    = "info"
+	AuditSeverityWarning = "warning"
+	AuditSeverityHigh    = "high"
 )
 
 type AuditLog struct {
@@ -14,6 +18,7 @@ type AuditLog struct {
 	ResourceID   string
 	TenantID     string
 	Status       string
+	Severity     string
 	PayloadJSON  string
 	CreatedAt    time.Time
 }
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// UserIdentity links a subject at an external OIDC provider to a local user.
+type UserIdentity struct {
+	UserID      uint      `json:"user_id"`
+	Provider    string    `json:"provider"`
+	Subject     string    `json:"subject"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+// OIDCIdentity is what a provider asserted about the user after the ID token was validated
+// and its claims mapped through the provider's claim mapping.
+type OIDCIdentity struct {
+	Provider      string `json:"provider"`
+	Subject       string `json:"subject"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Username      string `json:"username"`
+}
+
+var (
+	ErrOIDCProviderNotFound = errors.New("identity provider not found")
+	ErrOIDCStateInvalid     = errors.New("oidc login state is invalid or expired")
+	ErrOIDCTokenInvalid     = errors.New("oidc id token is invalid")
+	ErrIdentityNotLinked    = errors.New("identity not linked")
+	// ErrOIDCEmailNotVerified rejects first logins whose email the provider has not verified.
+	ErrOIDCEmailNotVerified = errors.New("identity provider did not verify the email address")
+	// ErrOIDCLinkRequiresVerifiedAccount protects unverified local accounts from being claimed
+	// through an IdP; the owner must verify the email first.
+	ErrOIDCLinkRequiresVerifiedAccount = errors.New("verify the account email before signing in with this provider")
+)
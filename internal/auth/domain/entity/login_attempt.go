@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	LoginThrottleSubjectUsername = "username"
+	LoginThrottleSubjectClientIP = "client_ip"
+)
+
+// LoginAttempts is the failure counter kept for one username or client IP.
+type LoginAttempts struct {
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
+
+// LoginLockout describes a username or client IP that crossed its failure threshold.
+type LoginLockout struct {
+	Subject     string    `json:"subject"`
+	Value       string    `json:"value"`
+	UserID      uint      `json:"user_id,omitempty"`
+	Failures    int       `json:"failures"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+var (
+	ErrLoginThrottled      = errors.New("too many failed login attempts")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrUnlockTargetMissing = errors.New("username or client_ip is required")
+)
+
+// LoginThrottledError carries how long the caller has to wait; it matches ErrLoginThrottled.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+	Locked     bool
+}
+
+func (e *LoginThrottledError) Error() string {
+	seconds := int(e.RetryAfter.Round(time.Second) / time.Second)
+	if e.Locked {
+		return fmt.Sprintf("%s: login locked, retry in %ds", ErrLoginThrottled, seconds)
+	}
+	return fmt.Sprintf("%s: retry in %ds", ErrLoginThrottled, seconds)
+}
+
+func (e *LoginThrottledError) Is(target error) bool {
+	return target == ErrLoginThrottled
+}
+
+type clientIPKey struct{}
+
+// WithClientIP records the caller address the transport observed for this request.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "errors"
+
+// SAMLIdentityProviderPrefix prefixes the organization ID in the identity provider of SAML
+// sessions and in the provider of the linked identities.
+const SAMLIdentityProviderPrefix = "saml:"
+
+// SAMLConnection is the part of an organization's IAM SAML connection the login flow needs.
+type SAMLConnection struct {
+	OrgID             string `json:"org_id"`
+	MetadataXML       string `json:"metadata_xml"`
+	UsernameAttribute string `json:"username_attribute"`
+	EmailAttribute    string `json:"email_attribute"`
+	GroupsAttribute   string `json:"groups_attribute"`
+	SSORequired       bool   `json:"sso_required"`
+}
+
+// SAMLIdentityProvider returns the identity provider recorded for logins through orgID's IdP.
+func SAMLIdentityProvider(orgID string) string {
+	return SAMLIdentityProviderPrefix + orgID
+}
+
+var (
+	ErrSAMLConnectionNotFound = errors.New("organization has no saml connection")
+	ErrSAMLLoginInvalid       = errors.New("saml login is invalid or expired")
+	// ErrSAMLNotConfigured refuses SAML while auth.saml.base_url is unset.
+	ErrSAMLNotConfigured = errors.New("saml is not configured")
+	// ErrSAMLAccountConflict protects local accounts outside the organization from being
+	// claimed by an IdP that asserts their email address.
+	ErrSAMLAccountConflict = errors.New("an account with this email exists outside the organization")
+	// ErrSSORequired blocks password login for members of an organization that enforces SSO.
+	ErrSSORequired = errors.New("organization requires single sign-on")
+)
@@ -12,6 +12,20 @@ import (
 const (
 	SessionStatusActive  = "active"
 	SessionStatusRevoked = "revoked"
+
+	// IdentityProviderPodzone marks sessions opened with a local username and password.
+	IdentityProviderPodzone = "podzone"
+	IdentityProviderGoogle  = "google"
+)
+
+// Reasons recorded when a session is revoked.
+const (
+	SessionRevokedReasonLogout            = "logout"
+	SessionRevokedReasonUserRevoked       = "revoked_by_user"
+	SessionRevokedReasonPasswordReset     = "password_reset"
+	SessionRevokedReasonPasswordChanged   = "password_changed"
+	SessionRevokedReasonRefreshTokenReuse = "refresh_token_reuse"
+	SessionRevokedReasonDeprovisioned     = "deprovisioned"
 )
 
 type Session struct {
@@ -28,11 +42,14 @@ type Session struct {
 	AssumedRoleSessionName      string                   `json:"assumed_role_session_name,omitempty"`
 	AssumedRoleSourceIdentity   string                   `json:"assumed_role_source_identity,omitempty"`
 	AssumedRoleExpiresAt        *time.Time               `json:"assumed_role_expires_at,omitempty"`
+	MFAAuthenticatedAt          *time.Time               `json:"mfa_authenticated_at,omitempty"`
+	IdentityProvider            string                   `json:"identity_provider,omitempty"`
 	Status                      string                   `json:"status"`
 	CreatedAt                   time.Time                `json:"created_at"`
 	UpdatedAt                   time.Time                `json:"updated_at"`
 	ExpiresAt                   time.Time                `json:"expires_at"`
 	RevokedAt                   *time.Time               `json:"revoked_at"`
+	RevokedReason               string                   `json:"revoked_reason,omitempty"`
 }
 
 type SessionPolicyStatement = pdauthn.PolicyStatement
@@ -60,5 +77,7 @@ var (
 	ErrSessionRevoked       = errors.New("session revoked")
 	ErrRefreshTokenInvalid  = errors.New("refresh token invalid")
 	ErrRefreshTokenExpired  = errors.New("refresh token expired")
+	ErrRefreshTokenReused   = errors.New("refresh token reused; session revoked")
+	ErrRefreshTokenRotated  = errors.New("refresh token already revoked")
 	ErrInvalidSessionPolicy = errors.New("session policy must include at least one statement")
 )
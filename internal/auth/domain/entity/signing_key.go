@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// SigningKey is a persisted access-token signing key. Retired keys no longer sign but stay
+// published in the JWKS document until the grace window passes.
+type SigningKey struct {
+	ID            string
+	Algorithm     string
+	PrivateKeyPEM []byte
+	CreatedAt     time.Time
+	RetiredAt     *time.Time
+}
@@ -23,6 +23,18 @@ type User struct {
 	Dob         time.Time `json:"dob"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+}
+
+// MinPasswordLength applies to passwords set through reset or change; Register is unchanged.
+const MinPasswordLength = 8
+
+func ValidateNewPassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+	return nil
 }
 
 func CheckPassword(hashedPassword, plainPassword string) error {
@@ -49,4 +61,5 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrWrongPassword     = errors.New("wrong password")
 	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrPasswordTooShort  = fmt.Errorf("password must be at least %d characters", MinPasswordLength)
 )
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	UserTokenPurposePasswordReset     = "password_reset"
+	UserTokenPurposeEmailVerification = "email_verification"
+)
+
+// UserToken is a single-use, emailed token. Only its hash is stored, as with refresh tokens.
+type UserToken struct {
+	ID        string     `json:"id"`
+	UserID    uint       `json:"user_id"`
+	Purpose   string     `json:"purpose"`
+	TokenHash string     `json:"token_hash"`
+	Email     string     `json:"email"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UsedAt    *time.Time `json:"used_at"`
+}
+
+// MailMessage is a plain-text email handed to the outbound Mailer.
+type MailMessage struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+var (
+	ErrUserTokenInvalid     = errors.New("token is invalid or expired")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrEmailMissing         = errors.New("user has no email address")
+)
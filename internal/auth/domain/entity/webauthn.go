@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// IdentityProviderWebAuthn marks sessions opened with a passkey instead of a password.
+const IdentityProviderWebAuthn = "webauthn"
+
+// WebAuthnCredential is a registered passkey or security key. PublicKey holds the COSE key
+// from registration; SignCount is the last counter the authenticator reported.
+type WebAuthnCredential struct {
+	ID           string `json:"id"`
+	UserID       uint   `json:"user_id"`
+	CredentialID string `json:"credential_id"`
+	PublicKey    []byte `json:"-"`
+	Algorithm    int64  `json:"algorithm"`
+	SignCount    uint32 `json:"sign_count"`
+	AAGUID       string `json:"aaguid,omitempty"`
+	// Transports are hints (usb, nfc, ble, internal, hybrid) echoed back in allow lists.
+	Transports     []string `json:"transports,omitempty"`
+	Name           string   `json:"name"`
+	BackupEligible bool     `json:"backup_eligible"`
+	BackupState    bool     `json:"backup_state"`
+	// CloneDetectedAt is set when the counter went backwards; the credential is refused after that.
+	CloneDetectedAt *time.Time `json:"clone_detected_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+}
+
+// CounterRegressed reports whether an assertion's sign count did not advance past the stored
+// one. Authenticators without counters (synced passkeys) always report zero, which passes.
+func (c WebAuthnCredential) CounterRegressed(signCount uint32) bool {
+	if c.SignCount == 0 && signCount == 0 {
+		return false
+	}
+	return signCount <= c.SignCount
+}
+
+var (
+	ErrWebAuthnNotConfigured      = errors.New("webauthn is not configured")
+	ErrWebAuthnCredentialNotFound = errors.New("webauthn credential not found")
+	ErrWebAuthnCredentialExists   = errors.New("webauthn credential is already registered")
+	ErrWebAuthnChallengeInvalid   = errors.New("webauthn challenge invalid or expired")
+	ErrWebAuthnVerificationFailed = errors.New("webauthn verification failed")
+	ErrWebAuthnCloneDetected      = errors.New("webauthn credential counter regressed; credential disabled")
+)
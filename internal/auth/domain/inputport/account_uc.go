@@ -0,0 +1,18 @@
+package inputport
+
+import "context"
+
+// AccountUsecase covers password and email self-service outside of login.
+type AccountUsecase interface {
+	// RequestPasswordReset emails a reset link; it succeeds silently for unknown emails.
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword sets a new password and revokes every session of the user.
+	ResetPassword(ctx context.Context, token, newPassword string) (uint, error)
+	// ChangePassword revokes every other session of the user; the caller's session stays active.
+	ChangePassword(ctx context.Context, userID uint, accessToken, currentPassword, newPassword string) error
+	RequestEmailVerification(ctx context.Context, userID uint, accessToken string) error
+	VerifyEmail(ctx context.Context, token string) (uint, error)
+	// DeprovisionUser revokes the sessions and API keys a deprovisioned user holds in tenantIDs.
+	// The identity provider only speaks for its own organization, so access elsewhere is kept.
+	DeprovisionUser(ctx context.Context, userID uint, tenantIDs []string) error
+}
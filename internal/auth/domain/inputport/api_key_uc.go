@@ -0,0 +1,37 @@
+package inputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+// CreateAPIKeyCommand describes a new key. A key with ServicePrincipal and RoleName acts as
+// that IAM role; otherwise it acts as the caller, optionally pinned to TenantID. A non-empty
+// SessionPolicy further limits what the key may do. A zero ExpiresIn uses the configured default.
+type CreateAPIKeyCommand struct {
+	Name             string
+	TenantID         string
+	ServicePrincipal string
+	RoleName         string
+	ExternalID       string
+	SessionPolicy    []entity.SessionPolicyStatement
+	ExpiresIn        time.Duration
+}
+
+type CreatedAPIKey struct {
+	// RawKey is returned only once; the server keeps a hash.
+	RawKey string
+	Key    entity.APIKey
+}
+
+type APIKeyUsecase interface {
+	CreateAPIKey(ctx context.Context, userID uint, accessToken string, cmd CreateAPIKeyCommand) (*CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID uint, accessToken string) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID uint, accessToken, keyID string) error
+	// ResolveAPIKey returns the claims a resource service should act on for a raw key, or
+	// entity.ErrAPIKeyInvalid.
+	ResolveAPIKey(ctx context.Context, rawKey string) (*pdauthn.Claims, error)
+}
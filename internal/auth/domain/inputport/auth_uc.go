@@ -16,6 +16,40 @@ type AuthResult struct {
 	JwtToken     string      `json:"jwt_token"`
 	RefreshToken string      `json:"refresh_token"`
 	UserInfo     entity.User `json:"user_info"`
+	// MFARequired replaces the tokens with MFAToken, to be completed through VerifyMFA.
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
+	// MFAMethods lists the second factors that can complete the challenge.
+	MFAMethods []string `json:"mfa_methods,omitempty"`
+}
+
+// Second factors offered in AuthResult.MFAMethods.
+const (
+	MFAMethodTOTP     = "totp"
+	MFAMethodWebAuthn = "webauthn"
+)
+
+// WebAuthnCeremony carries the options JSON for navigator.credentials.create or .get and the
+// ID that pairs the browser's response with the issued challenge.
+type WebAuthnCeremony struct {
+	ChallengeID string `json:"challenge_id"`
+	OptionsJSON string `json:"options_json"`
+}
+
+type MFAEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type IdentityProvider struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type OIDCCallbackResult struct {
+	ExchangeCode string `json:"exchange_code"`
+	RedirectURL  string `json:"redirect_url"`
+	UserID       uint   `json:"user_id"`
 }
 
 type GoogleCallbackResult struct {
@@ -38,7 +72,34 @@ type AuthUsecase interface {
 	GenerateOAuthURL(ctx context.Context) (string, error)
 	HandleOAuthCallback(ctx context.Context, code, state string) (*GoogleCallbackResult, error)
 	ExchangeOAuthLogin(ctx context.Context, exchangeCode string) (*AuthResult, error)
+	ListIdentityProviders(ctx context.Context) []IdentityProvider
+	StartOIDCLogin(ctx context.Context, provider string) (string, error)
+	HandleOIDCCallback(ctx context.Context, provider, code, state string) (*OIDCCallbackResult, error)
+	ExchangeOIDCLogin(ctx context.Context, exchangeCode string) (*AuthResult, error)
+	// StartSAMLLogin returns the organization IdP's SSO URL carrying an AuthnRequest.
+	StartSAMLLogin(ctx context.Context, orgID string) (string, error)
+	// HandleSAMLResponse verifies the POSTed assertion and, like HandleOIDCCallback, parks the
+	// login behind an exchange code redeemed through ExchangeOIDCLogin.
+	HandleSAMLResponse(ctx context.Context, orgID, samlResponse, relayState string) (*OIDCCallbackResult, error)
+	SAMLServiceProviderMetadata(ctx context.Context, orgID string) ([]byte, error)
 	Login(ctx context.Context, username, password string) (*AuthResult, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string) (*AuthResult, error)
+	EnrollMFA(ctx context.Context, userID uint, accessToken string) (*MFAEnrollment, error)
+	ActivateMFA(ctx context.Context, userID uint, accessToken, code string) ([]string, error)
+	DisableMFA(ctx context.Context, userID uint, accessToken, code string) error
+	BeginWebAuthnRegistration(ctx context.Context, userID uint, accessToken string) (*WebAuthnCeremony, error)
+	FinishWebAuthnRegistration(
+		ctx context.Context,
+		userID uint,
+		accessToken string,
+		challengeID string,
+		name string,
+		credentialJSON string,
+	) (*entity.WebAuthnCredential, error)
+	ListWebAuthnCredentials(ctx context.Context, userID uint, accessToken string) ([]entity.WebAuthnCredential, error)
+	DeleteWebAuthnCredential(ctx context.Context, userID uint, accessToken, id string) error
+	BeginWebAuthnLogin(ctx context.Context, username, mfaToken string) (*WebAuthnCeremony, error)
+	FinishWebAuthnLogin(ctx context.Context, challengeID, credentialJSON string) (*AuthResult, error)
 	Register(ctx context.Context, req RegisterCmd) (*AuthResult, error)
 	RefreshAccessToken(ctx context.Context, refreshToken string) (*AuthResult, error)
 	SwitchActiveTenant(ctx context.Context, userID uint, tenantID, accessToken string) (*AuthResult, error)
@@ -0,0 +1,8 @@
+package inputport
+
+import "context"
+
+type LoginThrottleUsecase interface {
+	// UnlockLogin clears the failure counters and lockout of a username, a client IP, or both.
+	UnlockLogin(ctx context.Context, actorUserID uint, accessToken, username, clientIP string) error
+}
@@ -39,6 +39,86 @@ func (_m *MockAuthUsecase) EXPECT() *MockAuthUsecase_Expecter {
 	return &MockAuthUsecase_Expecter{mock: &_m.Mock}
 }
 
+// ActivateMFA provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ActivateMFA(ctx context.Context, userID uint, accessToken string, code string) ([]string, error) {
+	ret := _mock.Called(ctx, userID, accessToken, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActivateMFA")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID, accessToken, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string) []string); ok {
+		r0 = returnFunc(ctx, userID, accessToken, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_ActivateMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActivateMFA'
+type MockAuthUsecase_ActivateMFA_Call struct {
+	*mock.Call
+}
+
+// ActivateMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - accessToken string
+//   - code string
+func (_e *MockAuthUsecase_Expecter) ActivateMFA(ctx interface{}, userID interface{}, accessToken interface{}, code interface{}) *MockAuthUsecase_ActivateMFA_Call {
+	return &MockAuthUsecase_ActivateMFA_Call{Call: _e.mock.On("ActivateMFA", ctx, userID, accessToken, code)}
+}
+
+func (_c *MockAuthUsecase_ActivateMFA_Call) Run(run func(ctx context.Context, userID uint, accessToken string, code string)) *MockAuthUsecase_ActivateMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_ActivateMFA_Call) Return(strings []string, err error) *MockAuthUsecase_ActivateMFA_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_ActivateMFA_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string, code string) ([]string, error)) *MockAuthUsecase_ActivateMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssumeRole provides a mock function for the type MockAuthUsecase
 func (_mock *MockAuthUsecase) AssumeRole(ctx context.Context, userID uint, accessToken string, roleName string, tenantID string, sessionPolicy []entity.SessionPolicyStatement, externalID string, sessionName string, sourceIdentity string, durationSeconds uint32, servicePrincipal string, sessionTags map[string]string) (*inputport.AuthResult, error) {
 	ret := _mock.Called(ctx, userID, accessToken, roleName, tenantID, sessionPolicy, externalID, sessionName, sourceIdentity, durationSeconds, servicePrincipal, sessionTags)
@@ -247,56 +327,56 @@ func (_c *MockAuthUsecase_AssumeSessionPolicy_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
-// ClearAssumedRole provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) ClearAssumedRole(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error) {
-	ret := _mock.Called(ctx, userID, accessToken)
+// BeginWebAuthnLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) BeginWebAuthnLogin(ctx context.Context, username string, mfaToken string) (*inputport.WebAuthnCeremony, error) {
+	ret := _mock.Called(ctx, username, mfaToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClearAssumedRole")
+		panic("no return value specified for BeginWebAuthnLogin")
 	}
 
-	var r0 *inputport.AuthResult
+	var r0 *inputport.WebAuthnCeremony
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.AuthResult, error)); ok {
-		return returnFunc(ctx, userID, accessToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.WebAuthnCeremony, error)); ok {
+		return returnFunc(ctx, username, mfaToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.AuthResult); ok {
-		r0 = returnFunc(ctx, userID, accessToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *inputport.WebAuthnCeremony); ok {
+		r0 = returnFunc(ctx, username, mfaToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inputport.AuthResult)
+			r0 = ret.Get(0).(*inputport.WebAuthnCeremony)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
-		r1 = returnFunc(ctx, userID, accessToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, username, mfaToken)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthUsecase_ClearAssumedRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearAssumedRole'
-type MockAuthUsecase_ClearAssumedRole_Call struct {
+// MockAuthUsecase_BeginWebAuthnLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginWebAuthnLogin'
+type MockAuthUsecase_BeginWebAuthnLogin_Call struct {
 	*mock.Call
 }
 
-// ClearAssumedRole is a helper method to define mock.On call
+// BeginWebAuthnLogin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uint
-//   - accessToken string
-func (_e *MockAuthUsecase_Expecter) ClearAssumedRole(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_ClearAssumedRole_Call {
-	return &MockAuthUsecase_ClearAssumedRole_Call{Call: _e.mock.On("ClearAssumedRole", ctx, userID, accessToken)}
+//   - username string
+//   - mfaToken string
+func (_e *MockAuthUsecase_Expecter) BeginWebAuthnLogin(ctx interface{}, username interface{}, mfaToken interface{}) *MockAuthUsecase_BeginWebAuthnLogin_Call {
+	return &MockAuthUsecase_BeginWebAuthnLogin_Call{Call: _e.mock.On("BeginWebAuthnLogin", ctx, username, mfaToken)}
 }
 
-func (_c *MockAuthUsecase_ClearAssumedRole_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_ClearAssumedRole_Call {
+func (_c *MockAuthUsecase_BeginWebAuthnLogin_Call) Run(run func(ctx context.Context, username string, mfaToken string)) *MockAuthUsecase_BeginWebAuthnLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uint
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(uint)
+			arg1 = args[1].(string)
 		}
 		var arg2 string
 		if args[2] != nil {
@@ -311,34 +391,34 @@ func (_c *MockAuthUsecase_ClearAssumedRole_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockAuthUsecase_ClearAssumedRole_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ClearAssumedRole_Call {
-	_c.Call.Return(authResult, err)
+func (_c *MockAuthUsecase_BeginWebAuthnLogin_Call) Return(webAuthnCeremony *inputport.WebAuthnCeremony, err error) *MockAuthUsecase_BeginWebAuthnLogin_Call {
+	_c.Call.Return(webAuthnCeremony, err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_ClearAssumedRole_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error)) *MockAuthUsecase_ClearAssumedRole_Call {
+func (_c *MockAuthUsecase_BeginWebAuthnLogin_Call) RunAndReturn(run func(ctx context.Context, username string, mfaToken string) (*inputport.WebAuthnCeremony, error)) *MockAuthUsecase_BeginWebAuthnLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ClearSessionPolicy provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) ClearSessionPolicy(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error) {
+// BeginWebAuthnRegistration provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) BeginWebAuthnRegistration(ctx context.Context, userID uint, accessToken string) (*inputport.WebAuthnCeremony, error) {
 	ret := _mock.Called(ctx, userID, accessToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClearSessionPolicy")
+		panic("no return value specified for BeginWebAuthnRegistration")
 	}
 
-	var r0 *inputport.AuthResult
+	var r0 *inputport.WebAuthnCeremony
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.AuthResult, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.WebAuthnCeremony, error)); ok {
 		return returnFunc(ctx, userID, accessToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.AuthResult); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.WebAuthnCeremony); ok {
 		r0 = returnFunc(ctx, userID, accessToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inputport.AuthResult)
+			r0 = ret.Get(0).(*inputport.WebAuthnCeremony)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
@@ -349,20 +429,20 @@ func (_mock *MockAuthUsecase) ClearSessionPolicy(ctx context.Context, userID uin
 	return r0, r1
 }
 
-// MockAuthUsecase_ClearSessionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearSessionPolicy'
-type MockAuthUsecase_ClearSessionPolicy_Call struct {
+// MockAuthUsecase_BeginWebAuthnRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginWebAuthnRegistration'
+type MockAuthUsecase_BeginWebAuthnRegistration_Call struct {
 	*mock.Call
 }
 
-// ClearSessionPolicy is a helper method to define mock.On call
+// BeginWebAuthnRegistration is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint
 //   - accessToken string
-func (_e *MockAuthUsecase_Expecter) ClearSessionPolicy(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_ClearSessionPolicy_Call {
-	return &MockAuthUsecase_ClearSessionPolicy_Call{Call: _e.mock.On("ClearSessionPolicy", ctx, userID, accessToken)}
+func (_e *MockAuthUsecase_Expecter) BeginWebAuthnRegistration(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_BeginWebAuthnRegistration_Call {
+	return &MockAuthUsecase_BeginWebAuthnRegistration_Call{Call: _e.mock.On("BeginWebAuthnRegistration", ctx, userID, accessToken)}
 }
 
-func (_c *MockAuthUsecase_ClearSessionPolicy_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_ClearSessionPolicy_Call {
+func (_c *MockAuthUsecase_BeginWebAuthnRegistration_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_BeginWebAuthnRegistration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -385,227 +465,1108 @@ func (_c *MockAuthUsecase_ClearSessionPolicy_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockAuthUsecase_ClearSessionPolicy_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ClearSessionPolicy_Call {
-	_c.Call.Return(authResult, err)
+func (_c *MockAuthUsecase_BeginWebAuthnRegistration_Call) Return(webAuthnCeremony *inputport.WebAuthnCeremony, err error) *MockAuthUsecase_BeginWebAuthnRegistration_Call {
+	_c.Call.Return(webAuthnCeremony, err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_ClearSessionPolicy_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error)) *MockAuthUsecase_ClearSessionPolicy_Call {
+func (_c *MockAuthUsecase_BeginWebAuthnRegistration_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.WebAuthnCeremony, error)) *MockAuthUsecase_BeginWebAuthnRegistration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExchangeOAuthLogin provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) ExchangeOAuthLogin(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error) {
-	ret := _mock.Called(ctx, exchangeCode)
+// ClearAssumedRole provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ClearAssumedRole(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, userID, accessToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExchangeOAuthLogin")
+		panic("no return value specified for ClearAssumedRole")
 	}
 
 	var r0 *inputport.AuthResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*inputport.AuthResult, error)); ok {
-		return returnFunc(ctx, exchangeCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, userID, accessToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *inputport.AuthResult); ok {
-		r0 = returnFunc(ctx, exchangeCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, userID, accessToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*inputport.AuthResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, exchangeCode)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthUsecase_ExchangeOAuthLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeOAuthLogin'
-type MockAuthUsecase_ExchangeOAuthLogin_Call struct {
+// MockAuthUsecase_ClearAssumedRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearAssumedRole'
+type MockAuthUsecase_ClearAssumedRole_Call struct {
 	*mock.Call
 }
 
-// ExchangeOAuthLogin is a helper method to define mock.On call
+// ClearAssumedRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - exchangeCode string
-func (_e *MockAuthUsecase_Expecter) ExchangeOAuthLogin(ctx interface{}, exchangeCode interface{}) *MockAuthUsecase_ExchangeOAuthLogin_Call {
-	return &MockAuthUsecase_ExchangeOAuthLogin_Call{Call: _e.mock.On("ExchangeOAuthLogin", ctx, exchangeCode)}
+//   - userID uint
+//   - accessToken string
+func (_e *MockAuthUsecase_Expecter) ClearAssumedRole(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_ClearAssumedRole_Call {
+	return &MockAuthUsecase_ClearAssumedRole_Call{Call: _e.mock.On("ClearAssumedRole", ctx, userID, accessToken)}
 }
 
-func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) Run(run func(ctx context.Context, exchangeCode string)) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+func (_c *MockAuthUsecase_ClearAssumedRole_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_ClearAssumedRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uint
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+func (_c *MockAuthUsecase_ClearAssumedRole_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ClearAssumedRole_Call {
 	_c.Call.Return(authResult, err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) RunAndReturn(run func(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error)) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+func (_c *MockAuthUsecase_ClearAssumedRole_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error)) *MockAuthUsecase_ClearAssumedRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenerateOAuthURL provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) GenerateOAuthURL(ctx context.Context) (string, error) {
-	ret := _mock.Called(ctx)
+// ClearSessionPolicy provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ClearSessionPolicy(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, userID, accessToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateOAuthURL")
+		panic("no return value specified for ClearSessionPolicy")
 	}
 
-	var r0 string
+	var r0 *inputport.AuthResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, userID, accessToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, userID, accessToken)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.AuthResult)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthUsecase_GenerateOAuthURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateOAuthURL'
-type MockAuthUsecase_GenerateOAuthURL_Call struct {
+// MockAuthUsecase_ClearSessionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearSessionPolicy'
+type MockAuthUsecase_ClearSessionPolicy_Call struct {
 	*mock.Call
 }
 
-// GenerateOAuthURL is a helper method to define mock.On call
+// ClearSessionPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockAuthUsecase_Expecter) GenerateOAuthURL(ctx interface{}) *MockAuthUsecase_GenerateOAuthURL_Call {
-	return &MockAuthUsecase_GenerateOAuthURL_Call{Call: _e.mock.On("GenerateOAuthURL", ctx)}
+//   - userID uint
+//   - accessToken string
+func (_e *MockAuthUsecase_Expecter) ClearSessionPolicy(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_ClearSessionPolicy_Call {
+	return &MockAuthUsecase_ClearSessionPolicy_Call{Call: _e.mock.On("ClearSessionPolicy", ctx, userID, accessToken)}
 }
 
-func (_c *MockAuthUsecase_GenerateOAuthURL_Call) Run(run func(ctx context.Context)) *MockAuthUsecase_GenerateOAuthURL_Call {
+func (_c *MockAuthUsecase_ClearSessionPolicy_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_ClearSessionPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthUsecase_GenerateOAuthURL_Call) Return(s string, err error) *MockAuthUsecase_GenerateOAuthURL_Call {
-	_c.Call.Return(s, err)
+func (_c *MockAuthUsecase_ClearSessionPolicy_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ClearSessionPolicy_Call {
+	_c.Call.Return(authResult, err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_GenerateOAuthURL_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *MockAuthUsecase_GenerateOAuthURL_Call {
+func (_c *MockAuthUsecase_ClearSessionPolicy_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.AuthResult, error)) *MockAuthUsecase_ClearSessionPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HandleOAuthCallback provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) HandleOAuthCallback(ctx context.Context, code string, state string) (*inputport.GoogleCallbackResult, error) {
-	ret := _mock.Called(ctx, code, state)
+// DeleteWebAuthnCredential provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) DeleteWebAuthnCredential(ctx context.Context, userID uint, accessToken string, id string) error {
+	ret := _mock.Called(ctx, userID, accessToken, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HandleOAuthCallback")
+		panic("no return value specified for DeleteWebAuthnCredential")
 	}
 
-	var r0 *inputport.GoogleCallbackResult
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.GoogleCallbackResult, error)); ok {
-		return returnFunc(ctx, code, state)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *inputport.GoogleCallbackResult); ok {
-		r0 = returnFunc(ctx, code, state)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inputport.GoogleCallbackResult)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, code, state)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, accessToken, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthUsecase_HandleOAuthCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleOAuthCallback'
-type MockAuthUsecase_HandleOAuthCallback_Call struct {
+// MockAuthUsecase_DeleteWebAuthnCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebAuthnCredential'
+type MockAuthUsecase_DeleteWebAuthnCredential_Call struct {
 	*mock.Call
 }
 
-// HandleOAuthCallback is a helper method to define mock.On call
+// DeleteWebAuthnCredential is a helper method to define mock.On call
 //   - ctx context.Context
-//   - code string
-//   - state string
-func (_e *MockAuthUsecase_Expecter) HandleOAuthCallback(ctx interface{}, code interface{}, state interface{}) *MockAuthUsecase_HandleOAuthCallback_Call {
-	return &MockAuthUsecase_HandleOAuthCallback_Call{Call: _e.mock.On("HandleOAuthCallback", ctx, code, state)}
+//   - userID uint
+//   - accessToken string
+//   - id string
+func (_e *MockAuthUsecase_Expecter) DeleteWebAuthnCredential(ctx interface{}, userID interface{}, accessToken interface{}, id interface{}) *MockAuthUsecase_DeleteWebAuthnCredential_Call {
+	return &MockAuthUsecase_DeleteWebAuthnCredential_Call{Call: _e.mock.On("DeleteWebAuthnCredential", ctx, userID, accessToken, id)}
 }
 
-func (_c *MockAuthUsecase_HandleOAuthCallback_Call) Run(run func(ctx context.Context, code string, state string)) *MockAuthUsecase_HandleOAuthCallback_Call {
+func (_c *MockAuthUsecase_DeleteWebAuthnCredential_Call) Run(run func(ctx context.Context, userID uint, accessToken string, id string)) *MockAuthUsecase_DeleteWebAuthnCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uint
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uint)
 		}
 		var arg2 string
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthUsecase_HandleOAuthCallback_Call) Return(googleCallbackResult *inputport.GoogleCallbackResult, err error) *MockAuthUsecase_HandleOAuthCallback_Call {
-	_c.Call.Return(googleCallbackResult, err)
+func (_c *MockAuthUsecase_DeleteWebAuthnCredential_Call) Return(err error) *MockAuthUsecase_DeleteWebAuthnCredential_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_HandleOAuthCallback_Call) RunAndReturn(run func(ctx context.Context, code string, state string) (*inputport.GoogleCallbackResult, error)) *MockAuthUsecase_HandleOAuthCallback_Call {
+func (_c *MockAuthUsecase_DeleteWebAuthnCredential_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string, id string) error) *MockAuthUsecase_DeleteWebAuthnCredential_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) Login(ctx context.Context, username string, password string) (*inputport.AuthResult, error) {
-	ret := _mock.Called(ctx, username, password)
+// DisableMFA provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) DisableMFA(ctx context.Context, userID uint, accessToken string, code string) error {
+	ret := _mock.Called(ctx, userID, accessToken, code)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for DisableMFA")
 	}
 
-	var r0 *inputport.AuthResult
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, accessToken, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthUsecase_DisableMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableMFA'
+type MockAuthUsecase_DisableMFA_Call struct {
+	*mock.Call
+}
+
+// DisableMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - accessToken string
+//   - code string
+func (_e *MockAuthUsecase_Expecter) DisableMFA(ctx interface{}, userID interface{}, accessToken interface{}, code interface{}) *MockAuthUsecase_DisableMFA_Call {
+	return &MockAuthUsecase_DisableMFA_Call{Call: _e.mock.On("DisableMFA", ctx, userID, accessToken, code)}
+}
+
+func (_c *MockAuthUsecase_DisableMFA_Call) Run(run func(ctx context.Context, userID uint, accessToken string, code string)) *MockAuthUsecase_DisableMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_DisableMFA_Call) Return(err error) *MockAuthUsecase_DisableMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_DisableMFA_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string, code string) error) *MockAuthUsecase_DisableMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollMFA provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) EnrollMFA(ctx context.Context, userID uint, accessToken string) (*inputport.MFAEnrollment, error) {
+	ret := _mock.Called(ctx, userID, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFA")
+	}
+
+	var r0 *inputport.MFAEnrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) (*inputport.MFAEnrollment, error)); ok {
+		return returnFunc(ctx, userID, accessToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) *inputport.MFAEnrollment); ok {
+		r0 = returnFunc(ctx, userID, accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.MFAEnrollment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_EnrollMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFA'
+type MockAuthUsecase_EnrollMFA_Call struct {
+	*mock.Call
+}
+
+// EnrollMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - accessToken string
+func (_e *MockAuthUsecase_Expecter) EnrollMFA(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_EnrollMFA_Call {
+	return &MockAuthUsecase_EnrollMFA_Call{Call: _e.mock.On("EnrollMFA", ctx, userID, accessToken)}
+}
+
+func (_c *MockAuthUsecase_EnrollMFA_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_EnrollMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_EnrollMFA_Call) Return(mFAEnrollment *inputport.MFAEnrollment, err error) *MockAuthUsecase_EnrollMFA_Call {
+	_c.Call.Return(mFAEnrollment, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_EnrollMFA_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) (*inputport.MFAEnrollment, error)) *MockAuthUsecase_EnrollMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeOAuthLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ExchangeOAuthLogin(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, exchangeCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeOAuthLogin")
+	}
+
+	var r0 *inputport.AuthResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, exchangeCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, exchangeCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.AuthResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, exchangeCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_ExchangeOAuthLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeOAuthLogin'
+type MockAuthUsecase_ExchangeOAuthLogin_Call struct {
+	*mock.Call
+}
+
+// ExchangeOAuthLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exchangeCode string
+func (_e *MockAuthUsecase_Expecter) ExchangeOAuthLogin(ctx interface{}, exchangeCode interface{}) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+	return &MockAuthUsecase_ExchangeOAuthLogin_Call{Call: _e.mock.On("ExchangeOAuthLogin", ctx, exchangeCode)}
+}
+
+func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) Run(run func(ctx context.Context, exchangeCode string)) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+	_c.Call.Return(authResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_ExchangeOAuthLogin_Call) RunAndReturn(run func(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error)) *MockAuthUsecase_ExchangeOAuthLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeOIDCLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ExchangeOIDCLogin(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, exchangeCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeOIDCLogin")
+	}
+
+	var r0 *inputport.AuthResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, exchangeCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, exchangeCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.AuthResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, exchangeCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_ExchangeOIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeOIDCLogin'
+type MockAuthUsecase_ExchangeOIDCLogin_Call struct {
+	*mock.Call
+}
+
+// ExchangeOIDCLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exchangeCode string
+func (_e *MockAuthUsecase_Expecter) ExchangeOIDCLogin(ctx interface{}, exchangeCode interface{}) *MockAuthUsecase_ExchangeOIDCLogin_Call {
+	return &MockAuthUsecase_ExchangeOIDCLogin_Call{Call: _e.mock.On("ExchangeOIDCLogin", ctx, exchangeCode)}
+}
+
+func (_c *MockAuthUsecase_ExchangeOIDCLogin_Call) Run(run func(ctx context.Context, exchangeCode string)) *MockAuthUsecase_ExchangeOIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_ExchangeOIDCLogin_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_ExchangeOIDCLogin_Call {
+	_c.Call.Return(authResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_ExchangeOIDCLogin_Call) RunAndReturn(run func(ctx context.Context, exchangeCode string) (*inputport.AuthResult, error)) *MockAuthUsecase_ExchangeOIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishWebAuthnLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) FinishWebAuthnLogin(ctx context.Context, challengeID string, credentialJSON string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, challengeID, credentialJSON)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishWebAuthnLogin")
+	}
+
+	var r0 *inputport.AuthResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, challengeID, credentialJSON)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, challengeID, credentialJSON)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.AuthResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, challengeID, credentialJSON)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_FinishWebAuthnLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishWebAuthnLogin'
+type MockAuthUsecase_FinishWebAuthnLogin_Call struct {
+	*mock.Call
+}
+
+// FinishWebAuthnLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeID string
+//   - credentialJSON string
+func (_e *MockAuthUsecase_Expecter) FinishWebAuthnLogin(ctx interface{}, challengeID interface{}, credentialJSON interface{}) *MockAuthUsecase_FinishWebAuthnLogin_Call {
+	return &MockAuthUsecase_FinishWebAuthnLogin_Call{Call: _e.mock.On("FinishWebAuthnLogin", ctx, challengeID, credentialJSON)}
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnLogin_Call) Run(run func(ctx context.Context, challengeID string, credentialJSON string)) *MockAuthUsecase_FinishWebAuthnLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnLogin_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_FinishWebAuthnLogin_Call {
+	_c.Call.Return(authResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnLogin_Call) RunAndReturn(run func(ctx context.Context, challengeID string, credentialJSON string) (*inputport.AuthResult, error)) *MockAuthUsecase_FinishWebAuthnLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishWebAuthnRegistration provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) FinishWebAuthnRegistration(ctx context.Context, userID uint, accessToken string, challengeID string, name string, credentialJSON string) (*entity.WebAuthnCredential, error) {
+	ret := _mock.Called(ctx, userID, accessToken, challengeID, name, credentialJSON)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishWebAuthnRegistration")
+	}
+
+	var r0 *entity.WebAuthnCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, string, string) (*entity.WebAuthnCredential, error)); ok {
+		return returnFunc(ctx, userID, accessToken, challengeID, name, credentialJSON)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, string, string) *entity.WebAuthnCredential); ok {
+		r0 = returnFunc(ctx, userID, accessToken, challengeID, name, credentialJSON)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WebAuthnCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken, challengeID, name, credentialJSON)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_FinishWebAuthnRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishWebAuthnRegistration'
+type MockAuthUsecase_FinishWebAuthnRegistration_Call struct {
+	*mock.Call
+}
+
+// FinishWebAuthnRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - accessToken string
+//   - challengeID string
+//   - name string
+//   - credentialJSON string
+func (_e *MockAuthUsecase_Expecter) FinishWebAuthnRegistration(ctx interface{}, userID interface{}, accessToken interface{}, challengeID interface{}, name interface{}, credentialJSON interface{}) *MockAuthUsecase_FinishWebAuthnRegistration_Call {
+	return &MockAuthUsecase_FinishWebAuthnRegistration_Call{Call: _e.mock.On("FinishWebAuthnRegistration", ctx, userID, accessToken, challengeID, name, credentialJSON)}
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnRegistration_Call) Run(run func(ctx context.Context, userID uint, accessToken string, challengeID string, name string, credentialJSON string)) *MockAuthUsecase_FinishWebAuthnRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnRegistration_Call) Return(webAuthnCredential *entity.WebAuthnCredential, err error) *MockAuthUsecase_FinishWebAuthnRegistration_Call {
+	_c.Call.Return(webAuthnCredential, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_FinishWebAuthnRegistration_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string, challengeID string, name string, credentialJSON string) (*entity.WebAuthnCredential, error)) *MockAuthUsecase_FinishWebAuthnRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateOAuthURL provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) GenerateOAuthURL(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateOAuthURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_GenerateOAuthURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateOAuthURL'
+type MockAuthUsecase_GenerateOAuthURL_Call struct {
+	*mock.Call
+}
+
+// GenerateOAuthURL is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthUsecase_Expecter) GenerateOAuthURL(ctx interface{}) *MockAuthUsecase_GenerateOAuthURL_Call {
+	return &MockAuthUsecase_GenerateOAuthURL_Call{Call: _e.mock.On("GenerateOAuthURL", ctx)}
+}
+
+func (_c *MockAuthUsecase_GenerateOAuthURL_Call) Run(run func(ctx context.Context)) *MockAuthUsecase_GenerateOAuthURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_GenerateOAuthURL_Call) Return(s string, err error) *MockAuthUsecase_GenerateOAuthURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_GenerateOAuthURL_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *MockAuthUsecase_GenerateOAuthURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleOAuthCallback provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) HandleOAuthCallback(ctx context.Context, code string, state string) (*inputport.GoogleCallbackResult, error) {
+	ret := _mock.Called(ctx, code, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleOAuthCallback")
+	}
+
+	var r0 *inputport.GoogleCallbackResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.GoogleCallbackResult, error)); ok {
+		return returnFunc(ctx, code, state)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *inputport.GoogleCallbackResult); ok {
+		r0 = returnFunc(ctx, code, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.GoogleCallbackResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, code, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_HandleOAuthCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleOAuthCallback'
+type MockAuthUsecase_HandleOAuthCallback_Call struct {
+	*mock.Call
+}
+
+// HandleOAuthCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - state string
+func (_e *MockAuthUsecase_Expecter) HandleOAuthCallback(ctx interface{}, code interface{}, state interface{}) *MockAuthUsecase_HandleOAuthCallback_Call {
+	return &MockAuthUsecase_HandleOAuthCallback_Call{Call: _e.mock.On("HandleOAuthCallback", ctx, code, state)}
+}
+
+func (_c *MockAuthUsecase_HandleOAuthCallback_Call) Run(run func(ctx context.Context, code string, state string)) *MockAuthUsecase_HandleOAuthCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleOAuthCallback_Call) Return(googleCallbackResult *inputport.GoogleCallbackResult, err error) *MockAuthUsecase_HandleOAuthCallback_Call {
+	_c.Call.Return(googleCallbackResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleOAuthCallback_Call) RunAndReturn(run func(ctx context.Context, code string, state string) (*inputport.GoogleCallbackResult, error)) *MockAuthUsecase_HandleOAuthCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleOIDCCallback provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) HandleOIDCCallback(ctx context.Context, provider string, code string, state string) (*inputport.OIDCCallbackResult, error) {
+	ret := _mock.Called(ctx, provider, code, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleOIDCCallback")
+	}
+
+	var r0 *inputport.OIDCCallbackResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*inputport.OIDCCallbackResult, error)); ok {
+		return returnFunc(ctx, provider, code, state)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *inputport.OIDCCallbackResult); ok {
+		r0 = returnFunc(ctx, provider, code, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.OIDCCallbackResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, code, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_HandleOIDCCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleOIDCCallback'
+type MockAuthUsecase_HandleOIDCCallback_Call struct {
+	*mock.Call
+}
+
+// HandleOIDCCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - code string
+//   - state string
+func (_e *MockAuthUsecase_Expecter) HandleOIDCCallback(ctx interface{}, provider interface{}, code interface{}, state interface{}) *MockAuthUsecase_HandleOIDCCallback_Call {
+	return &MockAuthUsecase_HandleOIDCCallback_Call{Call: _e.mock.On("HandleOIDCCallback", ctx, provider, code, state)}
+}
+
+func (_c *MockAuthUsecase_HandleOIDCCallback_Call) Run(run func(ctx context.Context, provider string, code string, state string)) *MockAuthUsecase_HandleOIDCCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleOIDCCallback_Call) Return(oIDCCallbackResult *inputport.OIDCCallbackResult, err error) *MockAuthUsecase_HandleOIDCCallback_Call {
+	_c.Call.Return(oIDCCallbackResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleOIDCCallback_Call) RunAndReturn(run func(ctx context.Context, provider string, code string, state string) (*inputport.OIDCCallbackResult, error)) *MockAuthUsecase_HandleOIDCCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleSAMLResponse provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) HandleSAMLResponse(ctx context.Context, orgID string, samlResponse string, relayState string) (*inputport.OIDCCallbackResult, error) {
+	ret := _mock.Called(ctx, orgID, samlResponse, relayState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleSAMLResponse")
+	}
+
+	var r0 *inputport.OIDCCallbackResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*inputport.OIDCCallbackResult, error)); ok {
+		return returnFunc(ctx, orgID, samlResponse, relayState)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *inputport.OIDCCallbackResult); ok {
+		r0 = returnFunc(ctx, orgID, samlResponse, relayState)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.OIDCCallbackResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, orgID, samlResponse, relayState)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_HandleSAMLResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleSAMLResponse'
+type MockAuthUsecase_HandleSAMLResponse_Call struct {
+	*mock.Call
+}
+
+// HandleSAMLResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - samlResponse string
+//   - relayState string
+func (_e *MockAuthUsecase_Expecter) HandleSAMLResponse(ctx interface{}, orgID interface{}, samlResponse interface{}, relayState interface{}) *MockAuthUsecase_HandleSAMLResponse_Call {
+	return &MockAuthUsecase_HandleSAMLResponse_Call{Call: _e.mock.On("HandleSAMLResponse", ctx, orgID, samlResponse, relayState)}
+}
+
+func (_c *MockAuthUsecase_HandleSAMLResponse_Call) Run(run func(ctx context.Context, orgID string, samlResponse string, relayState string)) *MockAuthUsecase_HandleSAMLResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleSAMLResponse_Call) Return(oIDCCallbackResult *inputport.OIDCCallbackResult, err error) *MockAuthUsecase_HandleSAMLResponse_Call {
+	_c.Call.Return(oIDCCallbackResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_HandleSAMLResponse_Call) RunAndReturn(run func(ctx context.Context, orgID string, samlResponse string, relayState string) (*inputport.OIDCCallbackResult, error)) *MockAuthUsecase_HandleSAMLResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentityProviders provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ListIdentityProviders(ctx context.Context) []inputport.IdentityProvider {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentityProviders")
+	}
+
+	var r0 []inputport.IdentityProvider
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []inputport.IdentityProvider); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]inputport.IdentityProvider)
+		}
+	}
+	return r0
+}
+
+// MockAuthUsecase_ListIdentityProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentityProviders'
+type MockAuthUsecase_ListIdentityProviders_Call struct {
+	*mock.Call
+}
+
+// ListIdentityProviders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthUsecase_Expecter) ListIdentityProviders(ctx interface{}) *MockAuthUsecase_ListIdentityProviders_Call {
+	return &MockAuthUsecase_ListIdentityProviders_Call{Call: _e.mock.On("ListIdentityProviders", ctx)}
+}
+
+func (_c *MockAuthUsecase_ListIdentityProviders_Call) Run(run func(ctx context.Context)) *MockAuthUsecase_ListIdentityProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_ListIdentityProviders_Call) Return(identityProviders []inputport.IdentityProvider) *MockAuthUsecase_ListIdentityProviders_Call {
+	_c.Call.Return(identityProviders)
+	return _c
+}
+
+func (_c *MockAuthUsecase_ListIdentityProviders_Call) RunAndReturn(run func(ctx context.Context) []inputport.IdentityProvider) *MockAuthUsecase_ListIdentityProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebAuthnCredentials provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) ListWebAuthnCredentials(ctx context.Context, userID uint, accessToken string) ([]entity.WebAuthnCredential, error) {
+	ret := _mock.Called(ctx, userID, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebAuthnCredentials")
+	}
+
+	var r0 []entity.WebAuthnCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) ([]entity.WebAuthnCredential, error)); ok {
+		return returnFunc(ctx, userID, accessToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string) []entity.WebAuthnCredential); ok {
+		r0 = returnFunc(ctx, userID, accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WebAuthnCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = returnFunc(ctx, userID, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_ListWebAuthnCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebAuthnCredentials'
+type MockAuthUsecase_ListWebAuthnCredentials_Call struct {
+	*mock.Call
+}
+
+// ListWebAuthnCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - accessToken string
+func (_e *MockAuthUsecase_Expecter) ListWebAuthnCredentials(ctx interface{}, userID interface{}, accessToken interface{}) *MockAuthUsecase_ListWebAuthnCredentials_Call {
+	return &MockAuthUsecase_ListWebAuthnCredentials_Call{Call: _e.mock.On("ListWebAuthnCredentials", ctx, userID, accessToken)}
+}
+
+func (_c *MockAuthUsecase_ListWebAuthnCredentials_Call) Run(run func(ctx context.Context, userID uint, accessToken string)) *MockAuthUsecase_ListWebAuthnCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_ListWebAuthnCredentials_Call) Return(webAuthnCredentials []entity.WebAuthnCredential, err error) *MockAuthUsecase_ListWebAuthnCredentials_Call {
+	_c.Call.Return(webAuthnCredentials, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_ListWebAuthnCredentials_Call) RunAndReturn(run func(ctx context.Context, userID uint, accessToken string) ([]entity.WebAuthnCredential, error)) *MockAuthUsecase_ListWebAuthnCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) Login(ctx context.Context, username string, password string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *inputport.AuthResult
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.AuthResult, error)); ok {
 		return returnFunc(ctx, username, password)
@@ -873,6 +1834,206 @@ func (_c *MockAuthUsecase_Register_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// SAMLServiceProviderMetadata provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) SAMLServiceProviderMetadata(ctx context.Context, orgID string) ([]byte, error) {
+	ret := _mock.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SAMLServiceProviderMetadata")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return returnFunc(ctx, orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = returnFunc(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_SAMLServiceProviderMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SAMLServiceProviderMetadata'
+type MockAuthUsecase_SAMLServiceProviderMetadata_Call struct {
+	*mock.Call
+}
+
+// SAMLServiceProviderMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+func (_e *MockAuthUsecase_Expecter) SAMLServiceProviderMetadata(ctx interface{}, orgID interface{}) *MockAuthUsecase_SAMLServiceProviderMetadata_Call {
+	return &MockAuthUsecase_SAMLServiceProviderMetadata_Call{Call: _e.mock.On("SAMLServiceProviderMetadata", ctx, orgID)}
+}
+
+func (_c *MockAuthUsecase_SAMLServiceProviderMetadata_Call) Run(run func(ctx context.Context, orgID string)) *MockAuthUsecase_SAMLServiceProviderMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_SAMLServiceProviderMetadata_Call) Return(bytes []byte, err error) *MockAuthUsecase_SAMLServiceProviderMetadata_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_SAMLServiceProviderMetadata_Call) RunAndReturn(run func(ctx context.Context, orgID string) ([]byte, error)) *MockAuthUsecase_SAMLServiceProviderMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartOIDCLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) StartOIDCLogin(ctx context.Context, provider string) (string, error) {
+	ret := _mock.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOIDCLogin")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, provider)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, provider)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_StartOIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOIDCLogin'
+type MockAuthUsecase_StartOIDCLogin_Call struct {
+	*mock.Call
+}
+
+// StartOIDCLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+func (_e *MockAuthUsecase_Expecter) StartOIDCLogin(ctx interface{}, provider interface{}) *MockAuthUsecase_StartOIDCLogin_Call {
+	return &MockAuthUsecase_StartOIDCLogin_Call{Call: _e.mock.On("StartOIDCLogin", ctx, provider)}
+}
+
+func (_c *MockAuthUsecase_StartOIDCLogin_Call) Run(run func(ctx context.Context, provider string)) *MockAuthUsecase_StartOIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_StartOIDCLogin_Call) Return(s string, err error) *MockAuthUsecase_StartOIDCLogin_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_StartOIDCLogin_Call) RunAndReturn(run func(ctx context.Context, provider string) (string, error)) *MockAuthUsecase_StartOIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartSAMLLogin provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) StartSAMLLogin(ctx context.Context, orgID string) (string, error) {
+	ret := _mock.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartSAMLLogin")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, orgID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_StartSAMLLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartSAMLLogin'
+type MockAuthUsecase_StartSAMLLogin_Call struct {
+	*mock.Call
+}
+
+// StartSAMLLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+func (_e *MockAuthUsecase_Expecter) StartSAMLLogin(ctx interface{}, orgID interface{}) *MockAuthUsecase_StartSAMLLogin_Call {
+	return &MockAuthUsecase_StartSAMLLogin_Call{Call: _e.mock.On("StartSAMLLogin", ctx, orgID)}
+}
+
+func (_c *MockAuthUsecase_StartSAMLLogin_Call) Run(run func(ctx context.Context, orgID string)) *MockAuthUsecase_StartSAMLLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_StartSAMLLogin_Call) Return(s string, err error) *MockAuthUsecase_StartSAMLLogin_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_StartSAMLLogin_Call) RunAndReturn(run func(ctx context.Context, orgID string) (string, error)) *MockAuthUsecase_StartSAMLLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SwitchActiveTenant provides a mock function for the type MockAuthUsecase
 func (_mock *MockAuthUsecase) SwitchActiveTenant(ctx context.Context, userID uint, tenantID string, accessToken string) (*inputport.AuthResult, error) {
 	ret := _mock.Called(ctx, userID, tenantID, accessToken)
@@ -952,3 +2113,77 @@ func (_c *MockAuthUsecase_SwitchActiveTenant_Call) RunAndReturn(run func(ctx con
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifyMFA provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) VerifyMFA(ctx context.Context, mfaToken string, code string) (*inputport.AuthResult, error) {
+	ret := _mock.Called(ctx, mfaToken, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFA")
+	}
+
+	var r0 *inputport.AuthResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*inputport.AuthResult, error)); ok {
+		return returnFunc(ctx, mfaToken, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *inputport.AuthResult); ok {
+		r0 = returnFunc(ctx, mfaToken, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inputport.AuthResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mfaToken, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_VerifyMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFA'
+type MockAuthUsecase_VerifyMFA_Call struct {
+	*mock.Call
+}
+
+// VerifyMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+//   - code string
+func (_e *MockAuthUsecase_Expecter) VerifyMFA(ctx interface{}, mfaToken interface{}, code interface{}) *MockAuthUsecase_VerifyMFA_Call {
+	return &MockAuthUsecase_VerifyMFA_Call{Call: _e.mock.On("VerifyMFA", ctx, mfaToken, code)}
+}
+
+func (_c *MockAuthUsecase_VerifyMFA_Call) Run(run func(ctx context.Context, mfaToken string, code string)) *MockAuthUsecase_VerifyMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_VerifyMFA_Call) Return(authResult *inputport.AuthResult, err error) *MockAuthUsecase_VerifyMFA_Call {
+	_c.Call.Return(authResult, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_VerifyMFA_Call) RunAndReturn(run func(ctx context.Context, mfaToken string, code string) (*inputport.AuthResult, error)) *MockAuthUsecase_VerifyMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,12 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+type SigningKeyUsecase interface {
+	// JWKS lists the public keys verifiers should trust: the signing key plus recently retired ones.
+	JWKS(ctx context.Context) (pdauthn.JWKS, error)
+}
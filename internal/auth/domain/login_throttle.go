@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
+)
+
+const (
+	unlockLoginPermission = "platform:manage_users"
+
+	loginLockedEventType   = events.TypeLoginLocked
+	loginUnlockedEventType = events.TypeLoginUnlocked
+)
+
+var _ inputport.LoginThrottleUsecase = (*LoginThrottle)(nil)
+
+// LoginThrottle keeps failed-login counters per username and per client IP. A nil
+// *LoginThrottle allows every attempt.
+type LoginThrottle struct {
+	attempts   outputport.LoginAttemptRepository
+	events     *SecurityEvents
+	authorizer outputport.PlatformAuthorizer
+	cfg        config.LoginThrottleConfig
+	now        func() time.Time
+}
+
+func NewLoginThrottle(
+	attempts outputport.LoginAttemptRepository,
+	events *SecurityEvents,
+	authorizer outputport.PlatformAuthorizer,
+	cfg config.AuthConfig,
+) *LoginThrottle {
+	return &LoginThrottle{
+		attempts:   attempts,
+		events:     events,
+		authorizer: authorizer,
+		cfg:        cfg.LoginThrottle,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+type throttleKey struct {
+	subject     string
+	value       string
+	maxFailures int
+}
+
+func (k throttleKey) id() string {
+	return k.subject + ":" + k.value
+}
+
+func (t *LoginThrottle) keys(loginName, clientIP string) []throttleKey {
+	keys := make([]throttleKey, 0, 2)
+	if name := strings.ToLower(strings.TrimSpace(loginName)); name != "" {
+		keys = append(keys, throttleKey{entity.LoginThrottleSubjectUsername, name, t.cfg.MaxFailures})
+	}
+	if clientIP != "" {
+		keys = append(keys, throttleKey{entity.LoginThrottleSubjectClientIP, clientIP, t.cfg.IPMaxFailures})
+	}
+	return keys
+}
+
+// Check rejects the attempt while either counter is locked or backing off, reporting the
+// longer of the two waits.
+func (t *LoginThrottle) Check(ctx context.Context, loginName, clientIP string) error {
+	if t == nil {
+		return nil
+	}
+	now := t.now()
+	var longest *entity.LoginThrottledError
+	for _, key := range t.keys(loginName, clientIP) {
+		state, err := t.attempts.Get(ctx, key.id())
+		if err != nil {
+			return err
+		}
+		if wait := t.wait(state, now); wait != nil && (longest == nil || wait.RetryAfter > longest.RetryAfter) {
+			longest = wait
+		}
+	}
+	if longest != nil {
+		return longest
+	}
+	return nil
+}
+
+func (t *LoginThrottle) wait(state entity.LoginAttempts, now time.Time) *entity.LoginThrottledError {
+	if state.LockedUntil.After(now) {
+		return &entity.LoginThrottledError{RetryAfter: state.LockedUntil.Sub(now), Locked: true}
+	}
+	if state.Failures < t.cfg.BackoffAfter {
+		return nil
+	}
+	delay := t.cfg.BaseBackoff
+	for i := t.cfg.BackoffAfter; i < state.Failures && delay < t.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.cfg.MaxBackoff)
+	if until := state.LastFailureAt.Add(delay); until.After(now) {
+		return &entity.LoginThrottledError{RetryAfter: until.Sub(now)}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt and locks any counter that reached its threshold.
+// userID is zero when the login name matched no account.
+func (t *LoginThrottle) RecordFailure(ctx context.Context, loginName, clientIP string, userID uint) error {
+	if t == nil {
+		return nil
+	}
+	now := t.now()
+	for _, key := range t.keys(loginName, clientIP) {
+		state, err := t.attempts.RecordFailure(ctx, key.id(), now, t.cfg.Window)
+		if err != nil {
+			return err
+		}
+		if state.Failures < key.maxFailures || state.LockedUntil.After(now) {
+			continue
+		}
+		lockout := entity.LoginLockout{
+			Subject:     key.subject,
+			Value:       key.value,
+			Failures:    state.Failures,
+			LockedUntil: now.Add(t.cfg.LockoutDuration),
+		}
+		if key.subject == entity.LoginThrottleSubjectUsername {
+			lockout.UserID = userID
+		}
+		if err := t.attempts.Lock(ctx, key.id(), lockout.LockedUntil); err != nil {
+			return err
+		}
+		if err := t.recordLockChange(ctx, now, lockout.UserID, "login.locked", loginLockedEventType, lockout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordSuccess clears the username counter. The client IP counter keeps running so that
+// one valid account does not reset a spraying source.
+func (t *LoginThrottle) RecordSuccess(ctx context.Context, loginName string) error {
+	if t == nil {
+		return nil
+	}
+	for _, key := range t.keys(loginName, "") {
+		if err := t.attempts.Reset(ctx, key.id()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *LoginThrottle) UnlockLogin(
+	ctx context.Context,
+	actorUserID uint,
+	accessToken, username, clientIP string,
+) error {
+	keys := t.keys(username, strings.TrimSpace(clientIP))
+	if len(keys) == 0 {
+		return entity.ErrUnlockTargetMissing
+	}
+	allowed, err := t.authorizer.CheckPlatformPermission(ctx, accessToken, actorUserID, unlockLoginPermission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return entity.ErrPermissionDenied
+	}
+	now := t.now()
+	for _, key := range keys {
+		if err := t.attempts.Reset(ctx, key.id()); err != nil {
+			return err
+		}
+		unlock := entity.LoginLockout{Subject: key.subject, Value: key.value}
+		if err := t.recordLockChange(ctx, now, actorUserID, "login.unlocked", loginUnlockedEventType, unlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *LoginThrottle) recordLockChange(
+	ctx context.Context,
+	now time.Time,
+	actorUserID uint,
+	action, eventType string,
+	lockout entity.LoginLockout,
+) error {
+	severity := entity.AuditSeverityInfo
+	var payload any = events.LoginUnlockedV1{Subject: lockout.Subject, Value: lockout.Value}
+	if eventType == loginLockedEventType {
+		severity = entity.AuditSeverityWarning
+		payload = events.LoginLockedV1{
+			Subject:     lockout.Subject,
+			Value:       lockout.Value,
+			UserID:      lockout.UserID,
+			Failures:    lockout.Failures,
+			LockedUntil: lockout.LockedUntil,
+		}
+	}
+	return t.events.record(ctx, securityEvent{
+		At:           now,
+		ActorUserID:  actorUserID,
+		Action:       action,
+		Severity:     severity,
+		ResourceType: lockout.Subject,
+		ResourceID:   lockout.Value,
+		EventType:    eventType,
+		MessageKey:   lockout.Subject + ":" + lockout.Value,
+		Payload:      payload,
+	})
+}
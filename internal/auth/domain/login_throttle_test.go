@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingmocks "github.com/tuannm99/podzone/pkg/messaging/mocks"
+)
+
+var testThrottleCfg = config.LoginThrottleConfig{
+	MaxFailures:     4,
+	IPMaxFailures:   10,
+	Window:          15 * time.Minute,
+	BackoffAfter:    2,
+	BaseBackoff:     time.Second,
+	MaxBackoff:      4 * time.Second,
+	LockoutDuration: 15 * time.Minute,
+}
+
+type throttleTestDeps struct {
+	attempts   map[string]entity.LoginAttempts
+	audits     []entity.AuditLog
+	events     []messaging.OutboxRecord
+	authorizer *outputmocks.MockPlatformAuthorizer
+	now        time.Time
+}
+
+// newTestLoginThrottle backs every port with memory and a clock the test moves by hand.
+func newTestLoginThrottle(t *testing.T) (*LoginThrottle, *throttleTestDeps) {
+	t.Helper()
+	deps := &throttleTestDeps{
+		attempts:   map[string]entity.LoginAttempts{},
+		authorizer: outputmocks.NewMockPlatformAuthorizer(t),
+		now:        time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	attempts := outputmocks.NewMockLoginAttemptRepository(t)
+	attempts.EXPECT().
+		Get(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, key string) (entity.LoginAttempts, error) {
+			return deps.attempts[key], nil
+		}).
+		Maybe()
+	attempts.EXPECT().
+		RecordFailure(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, key string, at time.Time, window time.Duration) (entity.LoginAttempts, error) {
+			state := deps.attempts[key]
+			state.Failures++
+			state.LastFailureAt = at
+			deps.attempts[key] = state
+			return state, nil
+		}).
+		Maybe()
+	attempts.EXPECT().
+		Lock(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, key string, until time.Time) error {
+			state := deps.attempts[key]
+			state.LockedUntil = until
+			deps.attempts[key] = state
+			return nil
+		}).
+		Maybe()
+	attempts.EXPECT().
+		Reset(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, key string) error {
+			delete(deps.attempts, key)
+			return nil
+		}).
+		Maybe()
+
+	auditRepo := outputmocks.NewMockAuditLogRepository(t)
+	auditRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, log entity.AuditLog) error {
+			deps.audits = append(deps.audits, log)
+			return nil
+		}).
+		Maybe()
+	outbox := messagingmocks.NewMockOutboxStore(t)
+	outbox.EXPECT().
+		Append(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
+			deps.events = append(deps.events, record)
+			return nil
+		}).
+		Maybe()
+
+	throttle := NewLoginThrottle(
+		attempts,
+		NewSecurityEvents(auditRepo, outbox),
+		deps.authorizer,
+		config.AuthConfig{LoginThrottle: testThrottleCfg},
+	)
+	throttle.now = func() time.Time { return deps.now }
+	return throttle, deps
+}
+
+func TestLoginThrottle_BacksOffThenLocks(t *testing.T) {
+	ctx := context.Background()
+	throttle, deps := newTestLoginThrottle(t)
+
+	for range 2 {
+		require.NoError(t, throttle.RecordFailure(ctx, "Neo", "203.0.113.7", 4))
+	}
+	var throttled *entity.LoginThrottledError
+	require.ErrorAs(t, throttle.Check(ctx, "neo", "198.51.100.1"), &throttled)
+	assert.False(t, throttled.Locked)
+	assert.Equal(t, time.Second, throttled.RetryAfter)
+
+	deps.now = deps.now.Add(time.Second)
+	require.NoError(t, throttle.Check(ctx, "neo", "198.51.100.1"))
+
+	require.NoError(t, throttle.RecordFailure(ctx, "neo", "203.0.113.7", 4))
+	require.ErrorAs(t, throttle.Check(ctx, "neo", ""), &throttled)
+	assert.Equal(t, 2*time.Second, throttled.RetryAfter, "backoff doubles per extra failure")
+	assert.Empty(t, deps.events)
+
+	require.NoError(t, throttle.RecordFailure(ctx, "neo", "203.0.113.7", 4))
+	require.ErrorAs(t, throttle.Check(ctx, "neo", ""), &throttled)
+	assert.True(t, throttled.Locked)
+	assert.Equal(t, testThrottleCfg.LockoutDuration, throttled.RetryAfter)
+
+	require.Len(t, deps.audits, 1)
+	assert.Equal(t, "login.locked", deps.audits[0].Action)
+	assert.Equal(t, uint(4), deps.audits[0].ActorUserID)
+	require.Len(t, deps.events, 1)
+	assert.Equal(t, "podzone.auth.events", deps.events[0].Topic)
+	assert.Equal(t, "username:neo", deps.events[0].MessageKey)
+	assert.Equal(t, "auth.login.locked", deps.events[0].Envelope.Type)
+
+	assert.Equal(t, 4, deps.attempts["client_ip:203.0.113.7"].Failures)
+	assert.True(t, deps.attempts["client_ip:203.0.113.7"].LockedUntil.IsZero(), "the IP threshold is higher")
+}
+
+func TestLoginThrottle_UnlockRequiresPermission(t *testing.T) {
+	ctx := context.Background()
+	throttle, deps := newTestLoginThrottle(t)
+	deps.attempts["username:neo"] = entity.LoginAttempts{Failures: 4, LockedUntil: deps.now.Add(time.Hour)}
+
+	require.ErrorIs(t, throttle.UnlockLogin(ctx, 9, "token", " ", ""), entity.ErrUnlockTargetMissing)
+
+	deps.authorizer.EXPECT().
+		CheckPlatformPermission(mock.Anything, "token", uint(9), "platform:manage_users").
+		Return(false, nil).
+		Once()
+	require.ErrorIs(t, throttle.UnlockLogin(ctx, 9, "token", "neo", ""), entity.ErrPermissionDenied)
+	assert.Contains(t, deps.attempts, "username:neo")
+
+	deps.authorizer.EXPECT().
+		CheckPlatformPermission(mock.Anything, "token", uint(1), "platform:manage_users").
+		Return(true, nil).
+		Once()
+	require.NoError(t, throttle.UnlockLogin(ctx, 1, "token", "NEO", ""))
+	assert.NotContains(t, deps.attempts, "username:neo")
+	require.NoError(t, throttle.Check(ctx, "neo", ""))
+	require.Len(t, deps.events, 1)
+	assert.Equal(t, "auth.login.unlocked", deps.events[0].Envelope.Type)
+	assert.Equal(t, uint(1), deps.audits[0].ActorUserID)
+}
+
+func TestLogin_ThrottlesUnknownAndKnownUsers(t *testing.T) {
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 3, Username: "neo", Email: "neo@mx.io", Password: hashed}
+	uc, _ := newMFAAuthUC(t, user)
+	uc.userRepository.(*outputmocks.MockUserRepository).
+		On("GetByUsernameOrEmail", "ghost").Return(nil, entity.ErrUserNotFound).Maybe()
+	throttle, deps := newTestLoginThrottle(t)
+	uc.loginThrottle = throttle
+	ctx := entity.WithClientIP(context.Background(), "203.0.113.7")
+
+	for range 2 {
+		_, err := uc.Login(ctx, "ghost", "nope")
+		require.ErrorIs(t, err, entity.ErrUserNotFound)
+	}
+	_, err = uc.Login(ctx, "ghost", "nope")
+	require.ErrorIs(t, err, entity.ErrLoginThrottled, "unknown names back off like real ones")
+
+	deps.now = deps.now.Add(time.Second)
+	_, err = uc.Login(ctx, "neo", "wrong")
+	require.ErrorIs(t, err, entity.ErrWrongPassword)
+	deps.now = deps.now.Add(time.Minute)
+	resp, err := uc.Login(ctx, "neo", "pass123")
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JwtToken)
+	assert.NotContains(t, deps.attempts, "username:neo")
+	assert.Equal(t, 3, deps.attempts["client_ip:203.0.113.7"].Failures, "success keeps the IP counter")
+}
+
+func TestVerifyMFA_CountsWrongCodesAsLoginFailures(t *testing.T) {
+	hashed, err := entity.GeneratePasswordHash("pass123")
+	require.NoError(t, err)
+	user := &entity.User{Id: 9, Username: "niobe", Email: "niobe@mx.io", Password: hashed}
+	uc, state := newMFAAuthUC(t, user)
+	secret, err := entity.GenerateTOTPSecret()
+	require.NoError(t, err)
+	enabledAt := time.Now().UTC()
+	state.mfaFactors[user.Id] = entity.MFAFactor{UserID: user.Id, Secret: secret, EnabledAt: &enabledAt}
+	throttle, deps := newTestLoginThrottle(t)
+	uc.loginThrottle = throttle
+	ctx := entity.WithClientIP(context.Background(), "203.0.113.9")
+
+	_, err = uc.Login(ctx, "niobe", "wrong")
+	require.ErrorIs(t, err, entity.ErrWrongPassword)
+	challenge, err := uc.Login(ctx, "niobe", "pass123")
+	require.NoError(t, err)
+	require.True(t, challenge.MFARequired)
+	assert.Equal(t, 1, deps.attempts["username:niobe"].Failures, "the password alone does not clear the counter")
+
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, "000000x")
+	require.ErrorIs(t, err, entity.ErrMFACodeInvalid)
+	assert.Equal(t, 2, deps.attempts["username:niobe"].Failures)
+	assert.Equal(t, 2, deps.attempts["client_ip:203.0.113.9"].Failures)
+
+	code, err := entity.TOTPCode(secret, entity.TOTPStep(time.Now()))
+	require.NoError(t, err)
+	_, err = uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.ErrorIs(t, err, entity.ErrLoginThrottled, "wrong codes back off like wrong passwords")
+
+	deps.now = deps.now.Add(time.Minute)
+	resp, err := uc.VerifyMFA(ctx, challenge.MFAToken, code)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JwtToken)
+	assert.NotContains(t, deps.attempts, "username:niobe")
+	assert.Equal(t, 2, deps.attempts["client_ip:203.0.113.9"].Failures)
+}
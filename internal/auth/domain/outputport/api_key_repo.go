@@ -0,0 +1,22 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key entity.APIKey) error
+	// GetByLookupID returns entity.ErrAPIKeyNotFound for unknown lookup IDs.
+	GetByLookupID(ctx context.Context, lookupID string) (*entity.APIKey, error)
+	// ListByUser returns the user's keys, newest first, including revoked and expired ones.
+	ListByUser(ctx context.Context, userID uint) ([]entity.APIKey, error)
+	// Revoke revokes an active key owned by userID; anything else is entity.ErrAPIKeyNotFound.
+	Revoke(ctx context.Context, id string, userID uint, revokedAt time.Time) error
+	// RevokeByUserInTenants revokes every active key of userID scoped to, or assuming a role
+	// in, one of tenantIDs.
+	RevokeByUserInTenants(ctx context.Context, userID uint, tenantIDs []string, revokedAt time.Time) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
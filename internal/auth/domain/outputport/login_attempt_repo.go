@@ -0,0 +1,22 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type LoginAttemptRepository interface {
+	// Get returns a zero value for keys without recent failures.
+	Get(ctx context.Context, key string) (entity.LoginAttempts, error)
+	// RecordFailure counts one failure; the counter expires window after the latest one.
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (entity.LoginAttempts, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
+
+// PlatformAuthorizer asks IAM whether the caller holds a platform-wide permission.
+type PlatformAuthorizer interface {
+	CheckPlatformPermission(ctx context.Context, accessToken string, userID uint, permission string) (bool, error)
+}
@@ -0,0 +1,23 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type MFARepository interface {
+	// GetByUserID returns entity.ErrMFANotEnrolled when the user has no factor.
+	GetByUserID(ctx context.Context, userID uint) (*entity.MFAFactor, error)
+	// SavePending replaces any pending factor; it never overwrites an enabled one.
+	SavePending(ctx context.Context, factor entity.MFAFactor) error
+	Enable(ctx context.Context, userID uint, step int64, enabledAt time.Time) error
+	// ClaimStep records a used TOTP step and reports false if it, or a later one, was already used.
+	ClaimStep(ctx context.Context, userID uint, step int64, usedAt time.Time) (bool, error)
+	Delete(ctx context.Context, userID uint) error
+
+	ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string, createdAt time.Time) error
+	// ConsumeRecoveryCode marks the code used and reports false if it was unknown or already used.
+	ConsumeRecoveryCode(ctx context.Context, userID uint, codeHash string, usedAt time.Time) (bool, error)
+}
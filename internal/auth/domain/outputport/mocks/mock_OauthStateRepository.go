@@ -148,6 +148,72 @@ func (_c *MockOauthStateRepository_Get_Call) RunAndReturn(run func(key string) (
 	return _c
 }
 
+// Incr provides a mock function for the type MockOauthStateRepository
+func (_mock *MockOauthStateRepository) Incr(key string, duration time.Duration) (int64, error) {
+	ret := _mock.Called(key, duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Incr")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, time.Duration) (int64, error)); ok {
+		return returnFunc(key, duration)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, time.Duration) int64); ok {
+		r0 = returnFunc(key, duration)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, time.Duration) error); ok {
+		r1 = returnFunc(key, duration)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOauthStateRepository_Incr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Incr'
+type MockOauthStateRepository_Incr_Call struct {
+	*mock.Call
+}
+
+// Incr is a helper method to define mock.On call
+//   - key string
+//   - duration time.Duration
+func (_e *MockOauthStateRepository_Expecter) Incr(key interface{}, duration interface{}) *MockOauthStateRepository_Incr_Call {
+	return &MockOauthStateRepository_Incr_Call{Call: _e.mock.On("Incr", key, duration)}
+}
+
+func (_c *MockOauthStateRepository_Incr_Call) Run(run func(key string, duration time.Duration)) *MockOauthStateRepository_Incr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOauthStateRepository_Incr_Call) Return(n int64, err error) *MockOauthStateRepository_Incr_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockOauthStateRepository_Incr_Call) RunAndReturn(run func(key string, duration time.Duration) (int64, error)) *MockOauthStateRepository_Incr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function for the type MockOauthStateRepository
 func (_mock *MockOauthStateRepository) Set(key string, duration time.Duration) error {
 	ret := _mock.Called(key, duration)
@@ -238,16 +238,16 @@ func (_c *MockSessionRepository_ListByUser_Call) RunAndReturn(run func(ctx conte
 }
 
 // Revoke provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
-	ret := _mock.Called(ctx, id, revokedAt)
+func (_mock *MockSessionRepository) Revoke(ctx context.Context, id string, reason string, revokedAt time.Time) error {
+	ret := _mock.Called(ctx, id, reason, revokedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Revoke")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
-		r0 = returnFunc(ctx, id, revokedAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, reason, revokedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -262,12 +262,13 @@ type MockSessionRepository_Revoke_Call struct {
 // Revoke is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
+//   - reason string
 //   - revokedAt time.Time
-func (_e *MockSessionRepository_Expecter) Revoke(ctx interface{}, id interface{}, revokedAt interface{}) *MockSessionRepository_Revoke_Call {
-	return &MockSessionRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id, revokedAt)}
+func (_e *MockSessionRepository_Expecter) Revoke(ctx interface{}, id interface{}, reason interface{}, revokedAt interface{}) *MockSessionRepository_Revoke_Call {
+	return &MockSessionRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id, reason, revokedAt)}
 }
 
-func (_c *MockSessionRepository_Revoke_Call) Run(run func(ctx context.Context, id string, revokedAt time.Time)) *MockSessionRepository_Revoke_Call {
+func (_c *MockSessionRepository_Revoke_Call) Run(run func(ctx context.Context, id string, reason string, revokedAt time.Time)) *MockSessionRepository_Revoke_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -277,14 +278,19 @@ func (_c *MockSessionRepository_Revoke_Call) Run(run func(ctx context.Context, i
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 time.Time
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(time.Time)
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -295,7 +301,179 @@ func (_c *MockSessionRepository_Revoke_Call) Return(err error) *MockSessionRepos
 	return _c
 }
 
-func (_c *MockSessionRepository_Revoke_Call) RunAndReturn(run func(ctx context.Context, id string, revokedAt time.Time) error) *MockSessionRepository_Revoke_Call {
+func (_c *MockSessionRepository_Revoke_Call) RunAndReturn(run func(ctx context.Context, id string, reason string, revokedAt time.Time) error) *MockSessionRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByUser provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) RevokeByUser(ctx context.Context, userID uint, exceptSessionID string, reason string, revokedAt time.Time) ([]string, error) {
+	ret := _mock.Called(ctx, userID, exceptSessionID, reason, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByUser")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, time.Time) ([]string, error)); ok {
+		return returnFunc(ctx, userID, exceptSessionID, reason, revokedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, time.Time) []string); ok {
+		r0 = returnFunc(ctx, userID, exceptSessionID, reason, revokedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, exceptSessionID, reason, revokedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_RevokeByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByUser'
+type MockSessionRepository_RevokeByUser_Call struct {
+	*mock.Call
+}
+
+// RevokeByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - exceptSessionID string
+//   - reason string
+//   - revokedAt time.Time
+func (_e *MockSessionRepository_Expecter) RevokeByUser(ctx interface{}, userID interface{}, exceptSessionID interface{}, reason interface{}, revokedAt interface{}) *MockSessionRepository_RevokeByUser_Call {
+	return &MockSessionRepository_RevokeByUser_Call{Call: _e.mock.On("RevokeByUser", ctx, userID, exceptSessionID, reason, revokedAt)}
+}
+
+func (_c *MockSessionRepository_RevokeByUser_Call) Run(run func(ctx context.Context, userID uint, exceptSessionID string, reason string, revokedAt time.Time)) *MockSessionRepository_RevokeByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeByUser_Call) Return(strings []string, err error) *MockSessionRepository_RevokeByUser_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeByUser_Call) RunAndReturn(run func(ctx context.Context, userID uint, exceptSessionID string, reason string, revokedAt time.Time) ([]string, error)) *MockSessionRepository_RevokeByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByUserInTenants provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) RevokeByUserInTenants(ctx context.Context, userID uint, tenantIDs []string, reason string, revokedAt time.Time) ([]string, error) {
+	ret := _mock.Called(ctx, userID, tenantIDs, reason, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByUserInTenants")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, []string, string, time.Time) ([]string, error)); ok {
+		return returnFunc(ctx, userID, tenantIDs, reason, revokedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, []string, string, time.Time) []string); ok {
+		r0 = returnFunc(ctx, userID, tenantIDs, reason, revokedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, []string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, tenantIDs, reason, revokedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_RevokeByUserInTenants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByUserInTenants'
+type MockSessionRepository_RevokeByUserInTenants_Call struct {
+	*mock.Call
+}
+
+// RevokeByUserInTenants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - tenantIDs []string
+//   - reason string
+//   - revokedAt time.Time
+func (_e *MockSessionRepository_Expecter) RevokeByUserInTenants(ctx interface{}, userID interface{}, tenantIDs interface{}, reason interface{}, revokedAt interface{}) *MockSessionRepository_RevokeByUserInTenants_Call {
+	return &MockSessionRepository_RevokeByUserInTenants_Call{Call: _e.mock.On("RevokeByUserInTenants", ctx, userID, tenantIDs, reason, revokedAt)}
+}
+
+func (_c *MockSessionRepository_RevokeByUserInTenants_Call) Run(run func(ctx context.Context, userID uint, tenantIDs []string, reason string, revokedAt time.Time)) *MockSessionRepository_RevokeByUserInTenants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeByUserInTenants_Call) Return(strings []string, err error) *MockSessionRepository_RevokeByUserInTenants_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeByUserInTenants_Call) RunAndReturn(run func(ctx context.Context, userID uint, tenantIDs []string, reason string, revokedAt time.Time) ([]string, error)) *MockSessionRepository_RevokeByUserInTenants_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
@@ -353,6 +354,75 @@ func (_c *MockUserRepository_List_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// MarkEmailVerified provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) MarkEmailVerified(ctx context.Context, id uint, email string, verifiedAt time.Time) error {
+	ret := _mock.Called(ctx, id, email, verifiedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, email, verifiedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockUserRepository_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - email string
+//   - verifiedAt time.Time
+func (_e *MockUserRepository_Expecter) MarkEmailVerified(ctx interface{}, id interface{}, email interface{}, verifiedAt interface{}) *MockUserRepository_MarkEmailVerified_Call {
+	return &MockUserRepository_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, id, email, verifiedAt)}
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Run(run func(ctx context.Context, id uint, email string, verifiedAt time.Time)) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Return(err error) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) RunAndReturn(run func(ctx context.Context, id uint, email string, verifiedAt time.Time) error) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) Update(e entity.User) error {
 	ret := _mock.Called(e)
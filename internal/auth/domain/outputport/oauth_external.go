@@ -29,5 +29,8 @@ type OauthStateRepository interface {
 	Get(key string) (string, error)
 	Set(key string, duration time.Duration) error
 	SetValue(key, value string, duration time.Duration) error
+	// Incr atomically increments the counter at key, expiring it after duration, and returns
+	// the new value.
+	Incr(key string, duration time.Duration) (int64, error)
 	Del(key string) error
 }
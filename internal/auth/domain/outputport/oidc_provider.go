@@ -0,0 +1,36 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type OIDCProviderInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type OIDCProvider interface {
+	Info() OIDCProviderInfo
+	// AuthCodeURL builds the authorization request carrying nonce and the PKCE S256
+	// challenge derived from codeVerifier.
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems code and validates the ID token's signature, issuer, audience,
+	// expiry and nonce before mapping its claims.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*entity.OIDCIdentity, error)
+}
+
+type OIDCProviderRegistry interface {
+	// Provider returns entity.ErrOIDCProviderNotFound for unknown names.
+	Provider(name string) (OIDCProvider, error)
+	List() []OIDCProviderInfo
+}
+
+type UserIdentityRepository interface {
+	// GetByProviderSubject returns entity.ErrIdentityNotLinked when no user is linked.
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+	Create(ctx context.Context, identity entity.UserIdentity) error
+	TouchLastLogin(ctx context.Context, provider, subject string, at time.Time) error
+}
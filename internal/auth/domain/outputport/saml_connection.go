@@ -0,0 +1,20 @@
+package outputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+// SAMLConnectionResolver reads per-organization SAML configuration from IAM and applies
+// SAML logins to organization membership.
+type SAMLConnectionResolver interface {
+	// ResolveSAMLConnection returns entity.ErrSAMLConnectionNotFound when the organization
+	// has no connection.
+	ResolveSAMLConnection(ctx context.Context, orgID string) (*entity.SAMLConnection, error)
+	// SSORequiredOrganizations lists the user's organizations that block password login.
+	SSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error)
+	IsActiveOrganizationMember(ctx context.Context, orgID string, userID uint) (bool, error)
+	// ApplySAMLLogin must be called with an access token minted for the SAML login.
+	ApplySAMLLogin(ctx context.Context, accessToken, orgID string, userID uint, groups []string) error
+}
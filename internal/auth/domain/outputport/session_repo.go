@@ -24,12 +24,31 @@ type SessionRepository interface {
 		updatedAt time.Time,
 	) error
 	UpdateAssumedRole(ctx context.Context, session entity.Session, updatedAt time.Time) error
-	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+	Revoke(ctx context.Context, id, reason string, revokedAt time.Time) error
+	// RevokeByUser revokes every active session of the user except exceptSessionID and
+	// returns the revoked IDs so their refresh tokens can be revoked too.
+	RevokeByUser(
+		ctx context.Context,
+		userID uint,
+		exceptSessionID, reason string,
+		revokedAt time.Time,
+	) ([]string, error)
+	// RevokeByUserInTenants revokes every active session of the user whose active tenant or
+	// assumed role tenant is one of tenantIDs and returns the revoked IDs.
+	RevokeByUserInTenants(
+		ctx context.Context,
+		userID uint,
+		tenantIDs []string,
+		reason string,
+		revokedAt time.Time,
+	) ([]string, error)
 }
 
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, token entity.RefreshToken) error
 	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	// Revoke revokes a token that is still live and returns entity.ErrRefreshTokenRotated when
+	// it was revoked already, e.g. by a concurrent refresh that won the rotation.
 	Revoke(ctx context.Context, id string, revokedAt time.Time, replacedByTokenID *string) error
 	RevokeBySession(ctx context.Context, sessionID string, revokedAt time.Time) error
 }
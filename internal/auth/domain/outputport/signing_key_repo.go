@@ -0,0 +1,16 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, key entity.SigningKey) error
+	// ListPublishable returns active keys and keys retired after retiredAfter, newest first.
+	ListPublishable(ctx context.Context, retiredAfter time.Time) ([]entity.SigningKey, error)
+	// RetireBefore retires every active key created before createdBefore.
+	RetireBefore(ctx context.Context, createdBefore, retiredAt time.Time) error
+}
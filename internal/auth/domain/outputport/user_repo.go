@@ -2,6 +2,7 @@ package outputport
 
 import (
 	"context"
+	"time"
 
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
 	"github.com/tuannm99/podzone/pkg/collection"
@@ -15,4 +16,6 @@ type UserRepository interface {
 	CreateByEmailIfNotExisted(email string) (*entity.User, error)
 	GetByUsernameOrEmail(identity string) (*entity.User, error)
 	List(ctx context.Context, query collection.Query) (collection.Page[entity.User], error)
+	// MarkEmailVerified returns entity.ErrUserNotFound when the user's email is no longer email.
+	MarkEmailVerified(ctx context.Context, id uint, email string, verifiedAt time.Time) error
 }
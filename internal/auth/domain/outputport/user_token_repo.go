@@ -0,0 +1,22 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type UserTokenRepository interface {
+	Create(ctx context.Context, token entity.UserToken) error
+	// Consume marks an unused, unexpired token as used and returns it; anything else is
+	// entity.ErrUserTokenInvalid.
+	Consume(ctx context.Context, purpose, tokenHash string, usedAt time.Time) (*entity.UserToken, error)
+	// InvalidateByUser marks the user's outstanding tokens for purpose as used.
+	InvalidateByUser(ctx context.Context, userID uint, purpose string, usedAt time.Time) error
+}
+
+// Mailer sends transactional email such as password reset and verification links.
+type Mailer interface {
+	Send(ctx context.Context, message entity.MailMessage) error
+}
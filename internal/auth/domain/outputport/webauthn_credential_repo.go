@@ -0,0 +1,29 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type WebAuthnCredentialRepository interface {
+	// Create returns entity.ErrWebAuthnCredentialExists when the credential ID is taken.
+	Create(ctx context.Context, credential entity.WebAuthnCredential) error
+	// GetByCredentialID returns entity.ErrWebAuthnCredentialNotFound for unknown credentials.
+	GetByCredentialID(ctx context.Context, credentialID string) (*entity.WebAuthnCredential, error)
+	// ListByUser returns the user's credentials, oldest first.
+	ListByUser(ctx context.Context, userID uint) ([]entity.WebAuthnCredential, error)
+	// UpdateUsage stores the counter from a successful assertion only if it still matches
+	// previousSignCount, and reports false when a concurrent login already moved it.
+	UpdateUsage(
+		ctx context.Context,
+		id string,
+		previousSignCount, signCount uint32,
+		backupState bool,
+		usedAt time.Time,
+	) (bool, error)
+	MarkCloneDetected(ctx context.Context, id string, detectedAt time.Time) error
+	// Delete removes a credential owned by userID; anything else is entity.ErrWebAuthnCredentialNotFound.
+	Delete(ctx context.Context, id string, userID uint) error
+}
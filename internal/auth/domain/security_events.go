@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// SecurityEvents writes an audit entry and appends the matching integration event to the
+// auth outbox. A nil *SecurityEvents records nothing.
+type SecurityEvents struct {
+	auditRepo outputport.AuditLogRepository
+	outbox    messaging.OutboxStore
+}
+
+func NewSecurityEvents(auditRepo outputport.AuditLogRepository, outbox messaging.OutboxStore) *SecurityEvents {
+	return &SecurityEvents{auditRepo: auditRepo, outbox: outbox}
+}
+
+// securityEvent is one audited state change. Action names the audit entry, EventType the
+// envelope published on the auth topic, keyed by MessageKey. Payload must match the schema
+// registered for EventType in pkg/api/events.
+type securityEvent struct {
+	At           time.Time
+	ActorUserID  uint
+	Action       string
+	Severity     string
+	ResourceType string
+	ResourceID   string
+	TenantID     string
+	EventType    string
+	MessageKey   string
+	Payload      any
+}
+
+func (e *SecurityEvents) record(ctx context.Context, event securityEvent) error {
+	if e == nil {
+		return nil
+	}
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+	envelope := messaging.Envelope{
+		ID:            uuid.NewString(),
+		Type:          event.EventType,
+		Source:        "auth",
+		TenantID:      event.TenantID,
+		EntityID:      event.ResourceID,
+		OccurredAt:    event.At,
+		SchemaVersion: 1,
+		Payload:       payload,
+	}
+	if err := events.Schemas().ValidateRegistered(envelope); err != nil {
+		return err
+	}
+	if e.auditRepo != nil {
+		if err := e.auditRepo.Create(ctx, entity.AuditLog{
+			ID:           uuid.NewString(),
+			ActorUserID:  event.ActorUserID,
+			Action:       event.Action,
+			ResourceType: event.ResourceType,
+			ResourceID:   event.ResourceID,
+			TenantID:     event.TenantID,
+			Status:       entity.AuditStatusSuccess,
+			Severity:     event.Severity,
+			PayloadJSON:  string(payload),
+			CreatedAt:    event.At,
+		}); err != nil {
+			return err
+		}
+	}
+	if e.outbox == nil {
+		return nil
+	}
+	return e.outbox.Append(ctx, nil, messaging.OutboxRecord{
+		ID:            uuid.NewString(),
+		Topic:         messaging.EventTopic("auth"),
+		MessageKey:    event.MessageKey,
+		Envelope:      envelope,
+		Status:        "pending",
+		NextAttemptAt: event.At,
+		CreatedAt:     event.At,
+		UpdatedAt:     event.At,
+	})
+}
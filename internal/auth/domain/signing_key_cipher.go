@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sealedKeyPrefix marks a private key stored as base64(nonce || AES-256-GCM ciphertext).
+// Rows without it are legacy plain PEM and stay readable.
+const sealedKeyPrefix = "enc:v1:"
+
+// signingKeyCipher wraps signing private keys at rest. A nil cipher stores plain PEM.
+type signingKeyCipher struct {
+	aead cipher.AEAD
+}
+
+func newSigningKeyCipher(encodedKey string) (*signingKeyCipher, error) {
+	if encodedKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode signing key encryption key: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("signing key encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &signingKeyCipher{aead: aead}, nil
+}
+
+// seal binds the ciphertext to the key id so a row's key cannot be swapped into another row.
+func (c *signingKeyCipher) seal(kid string, privatePEM []byte) ([]byte, error) {
+	if c == nil {
+		return privatePEM, nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := c.aead.Seal(nonce, nonce, privatePEM, []byte(kid))
+	return []byte(sealedKeyPrefix + base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func (c *signingKeyCipher) open(kid string, stored []byte) ([]byte, error) {
+	encoded, ok := strings.CutPrefix(string(stored), sealedKeyPrefix)
+	if !ok {
+		return stored, nil
+	}
+	if c == nil {
+		return nil, errors.New("signing key is encrypted but no key encryption key is configured")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode signing key %s: %w", kid, err)
+	}
+	size := c.aead.NonceSize()
+	if len(sealed) < size {
+		return nil, fmt.Errorf("signing key %s is truncated", kid)
+	}
+	plain, err := c.aead.Open(nil, sealed[:size], sealed[size:], []byte(kid))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt signing key %s: %w", kid, err)
+	}
+	return plain, nil
+}
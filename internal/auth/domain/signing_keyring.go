@@ -0,0 +1,334 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+const (
+	// keyringReloadInterval bounds how long a replica keeps signing with a key another
+	// replica already rotated away from.
+	keyringReloadInterval = time.Minute
+	// keyringMinReloadInterval rate-limits reloads triggered by unknown kids, which anyone can
+	// put in a token header.
+	keyringMinReloadInterval = 10 * time.Second
+	keyringLookupTimeout     = 5 * time.Second
+)
+
+var (
+	_ inputport.SigningKeyUsecase = (*SigningKeyring)(nil)
+	_ pdauthn.KeySource           = (*SigningKeyring)(nil)
+)
+
+// SigningKeyring owns the asymmetric access-token keys. A new key is published in the JWKS
+// PublishLead before it signs anything, so verifiers have refreshed past it by the time its
+// first token arrives. The newest active key old enough to sign is the current one; once it
+// takes over, older keys are retired but stay published for the grace window. Replicas that
+// pre-publish at the same moment both publish their key and converge on the newer one.
+//
+// Verification reloads run under reloadMu rather than mu, so signing and verification with
+// cached keys never wait on the database. generation counts installed loads; a verification
+// reload only installs its result if nothing newer was installed while it queried.
+type SigningKeyring struct {
+	repo   outputport.SigningKeyRepository
+	cfg    config.SigningConfig
+	cipher *signingKeyCipher
+	now    func() time.Time
+
+	reloadMu sync.Mutex
+
+	mu          sync.Mutex
+	keys        []keyringEntry
+	loadedAt    time.Time
+	attemptedAt time.Time
+	generation  uint64
+}
+
+type keyringEntry struct {
+	key       pdauthn.SigningKey
+	createdAt time.Time
+	retiredAt *time.Time
+}
+
+func NewSigningKeyring(repo outputport.SigningKeyRepository, cfg config.AuthConfig) (*SigningKeyring, error) {
+	keyCipher, err := newSigningKeyCipher(cfg.Signing.KeyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	signing := cfg.Signing
+	if signing.RotationInterval > 0 && signing.PublishLead >= signing.RotationInterval {
+		signing.PublishLead = signing.RotationInterval / 2
+	}
+	return &SigningKeyring{
+		repo:   repo,
+		cfg:    signing,
+		cipher: keyCipher,
+		now:    time.Now,
+	}, nil
+}
+
+// CurrentKey returns the key new tokens are signed with. It pre-publishes the next key when
+// rotation is near and retires older keys once the next one takes over.
+func (k *SigningKeyring) CurrentKey(ctx context.Context) (pdauthn.SigningKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	if err := k.loadLocked(ctx, now, false); err != nil {
+		return pdauthn.SigningKey{}, err
+	}
+	current, pending, ok := k.currentLocked(now)
+	if !ok {
+		// Nothing was ever published, so no verifier can be ahead of us: sign right away.
+		return k.createLocked(ctx, now)
+	}
+	if !pending && k.rotationDue(current, now) {
+		if _, err := k.createLocked(ctx, now); err != nil {
+			return pdauthn.SigningKey{}, err
+		}
+	}
+	if k.hasActiveBefore(current.createdAt) {
+		if err := k.repo.RetireBefore(ctx, current.createdAt, now); err != nil {
+			return pdauthn.SigningKey{}, fmt.Errorf("retire signing keys: %w", err)
+		}
+		if err := k.loadLocked(ctx, now, true); err != nil {
+			return pdauthn.SigningKey{}, err
+		}
+	}
+	return current.key, nil
+}
+
+// currentLocked picks the newest active key published at least PublishLead ago, falling back
+// to the oldest active key while only freshly published ones exist. pending reports whether a
+// newer key is already waiting to take over.
+func (k *SigningKeyring) currentLocked(now time.Time) (keyringEntry, bool, bool) {
+	var oldest keyringEntry
+	found := false
+	for i, entry := range k.keys {
+		if entry.retiredAt != nil {
+			continue
+		}
+		if now.Sub(entry.createdAt) >= k.cfg.PublishLead {
+			return entry, k.hasActiveAfter(i), true
+		}
+		oldest, found = entry, true
+	}
+	return oldest, false, found
+}
+
+func (k *SigningKeyring) hasActiveAfter(index int) bool {
+	for _, entry := range k.keys[:index] {
+		if entry.retiredAt == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *SigningKeyring) hasActiveBefore(createdAt time.Time) bool {
+	for _, entry := range k.keys {
+		if entry.retiredAt == nil && entry.createdAt.Before(createdAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// rotationDue reports whether the next key must be published now so it can take over when
+// the current one reaches the rotation interval.
+func (k *SigningKeyring) rotationDue(current keyringEntry, now time.Time) bool {
+	if current.key.Algorithm != k.cfg.Algorithm {
+		return true
+	}
+	return now.Sub(current.createdAt) >= k.cfg.RotationInterval-k.cfg.PublishLead
+}
+
+func (k *SigningKeyring) JWKS(ctx context.Context) (pdauthn.JWKS, error) {
+	if k.cfg.Algorithm == pdauthn.AlgorithmHS256 {
+		return pdauthn.JWKS{Keys: []pdauthn.JWK{}}, nil
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	if err := k.loadLocked(ctx, now, false); err != nil {
+		return pdauthn.JWKS{}, err
+	}
+	doc := pdauthn.JWKS{Keys: make([]pdauthn.JWK, 0, len(k.keys))}
+	for _, entry := range k.keys {
+		if !k.publishable(entry, now) {
+			continue
+		}
+		jwk, err := entry.key.PublicJWK()
+		if err != nil {
+			return pdauthn.JWKS{}, err
+		}
+		doc.Keys = append(doc.Keys, jwk)
+	}
+	return doc, nil
+}
+
+// VerificationKey lets the auth service verify its own tokens without fetching its JWKS.
+func (k *SigningKeyring) VerificationKey(kid string) (pdauthn.VerificationKey, error) {
+	now := k.now()
+	k.mu.Lock()
+	_, known := k.find(kid)
+	stale := !k.fresh(now)
+	k.mu.Unlock()
+	if !known || stale {
+		// Another replica may have rotated since the last load.
+		if err := k.reload(now, !known); err != nil {
+			return pdauthn.VerificationKey{}, err
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	entry, ok := k.find(kid)
+	if !ok || !k.publishable(entry, now) {
+		return pdauthn.VerificationKey{}, pdauthn.ErrUnknownSigningKey
+	}
+	return pdauthn.VerificationKey{Algorithm: entry.key.Algorithm, PublicKey: entry.key.PrivateKey.Public()}, nil
+}
+
+// reload queries the keys without holding mu. Concurrent misses share one query, and misses
+// within keyringMinReloadInterval of the last attempt answer from the cached keys.
+func (k *SigningKeyring) reload(now time.Time, unknownKid bool) error {
+	k.reloadMu.Lock()
+	defer k.reloadMu.Unlock()
+
+	k.mu.Lock()
+	if now.Sub(k.attemptedAt) < keyringMinReloadInterval || (k.fresh(now) && !unknownKid) {
+		k.mu.Unlock()
+		return nil
+	}
+	k.attemptedAt = now
+	generation := k.generation
+	k.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyringLookupTimeout)
+	defer cancel()
+	keys, err := k.fetch(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.generation == generation {
+		k.installLocked(keys, now)
+	}
+	return nil
+}
+
+func (k *SigningKeyring) find(kid string) (keyringEntry, bool) {
+	for _, entry := range k.keys {
+		if entry.key.ID == kid {
+			return entry, true
+		}
+	}
+	return keyringEntry{}, false
+}
+
+func (k *SigningKeyring) publishable(entry keyringEntry, now time.Time) bool {
+	return entry.retiredAt == nil || now.Sub(*entry.retiredAt) <= k.cfg.RetiredKeyGrace
+}
+
+func (k *SigningKeyring) fresh(now time.Time) bool {
+	return !k.loadedAt.IsZero() && now.Sub(k.loadedAt) < keyringReloadInterval
+}
+
+func (k *SigningKeyring) loadLocked(ctx context.Context, now time.Time, force bool) error {
+	if !force && k.fresh(now) {
+		return nil
+	}
+	k.attemptedAt = now
+	keys, err := k.fetch(ctx, now)
+	if err != nil {
+		return err
+	}
+	k.installLocked(keys, now)
+	return nil
+}
+
+func (k *SigningKeyring) installLocked(keys []keyringEntry, now time.Time) {
+	k.keys = keys
+	k.loadedAt = now
+	k.generation++
+}
+
+// fetch reads and decrypts the publishable keys. It touches no keyring state, so it may run
+// without mu.
+func (k *SigningKeyring) fetch(ctx context.Context, now time.Time) ([]keyringEntry, error) {
+	stored, err := k.repo.ListPublishable(ctx, now.Add(-k.cfg.RetiredKeyGrace))
+	if err != nil {
+		return nil, fmt.Errorf("load signing keys: %w", err)
+	}
+	keys := make([]keyringEntry, 0, len(stored))
+	for _, item := range stored {
+		privatePEM, err := k.cipher.open(item.ID, item.PrivateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		key, err := pdauthn.ParseSigningKey(item.ID, item.Algorithm, privatePEM)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, keyringEntry{key: key, createdAt: item.CreatedAt, retiredAt: item.RetiredAt})
+	}
+	return keys, nil
+}
+
+// createLocked stores and publishes a new key without retiring any other.
+func (k *SigningKeyring) createLocked(ctx context.Context, now time.Time) (pdauthn.SigningKey, error) {
+	key, err := pdauthn.GenerateSigningKey(uuid.NewString(), k.cfg.Algorithm)
+	if err != nil {
+		return pdauthn.SigningKey{}, err
+	}
+	privatePEM, err := key.MarshalPrivateKey()
+	if err != nil {
+		return pdauthn.SigningKey{}, err
+	}
+	stored, err := k.cipher.seal(key.ID, privatePEM)
+	if err != nil {
+		return pdauthn.SigningKey{}, fmt.Errorf("encrypt signing key: %w", err)
+	}
+	if err := k.repo.Create(ctx, entity.SigningKey{
+		ID:            key.ID,
+		Algorithm:     key.Algorithm,
+		PrivateKeyPEM: stored,
+		CreatedAt:     now,
+	}); err != nil {
+		return pdauthn.SigningKey{}, fmt.Errorf("store signing key: %w", err)
+	}
+	if err := k.loadLocked(ctx, now, true); err != nil {
+		return pdauthn.SigningKey{}, err
+	}
+	return key, nil
+}
+
+// NewAccessTokenVerifier verifies tokens issued by this service. With asymmetric signing it
+// checks them against the keyring and accepts legacy HS256 ones only until
+// LegacyHS256Until; with HS256 signing it checks the shared secret alone.
+func NewAccessTokenVerifier(cfg config.AuthConfig, keyring *SigningKeyring) *pdauthn.Verifier {
+	var keys pdauthn.KeySource
+	if keyring != nil && cfg.Signing.Algorithm != pdauthn.AlgorithmHS256 {
+		keys = keyring
+	}
+	return pdauthn.NewVerifierWithKeySource(pdauthn.Config{
+		JWTSecret:        cfg.JWTSecret,
+		JWTKey:           cfg.JWTKey,
+		LegacyHS256Until: cfg.LegacyHS256Until,
+	}, keys)
+}
@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	outputmocks "github.com/tuannm99/podzone/internal/auth/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+// newKeyringWithStore backs the mocked repository with an in-memory table.
+func newKeyringWithStore(t *testing.T, now *time.Time) (*SigningKeyring, *[]entity.SigningKey) {
+	t.Helper()
+	stored := []entity.SigningKey{}
+	return newKeyringOnStore(t, now, &stored, ""), &stored
+}
+
+func newKeyringOnStore(
+	t *testing.T,
+	now *time.Time,
+	stored *[]entity.SigningKey,
+	keyEncryptionKey string,
+) *SigningKeyring {
+	t.Helper()
+	repo := outputmocks.NewMockSigningKeyRepository(t)
+	repo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, key entity.SigningKey) error {
+			*stored = append(*stored, key)
+			return nil
+		}).Maybe()
+	repo.EXPECT().RetireBefore(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, createdBefore, retiredAt time.Time) error {
+			for i := range *stored {
+				key := &(*stored)[i]
+				if key.CreatedAt.Before(createdBefore) && key.RetiredAt == nil {
+					at := retiredAt
+					key.RetiredAt = &at
+				}
+			}
+			return nil
+		}).Maybe()
+	repo.EXPECT().ListPublishable(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, retiredAfter time.Time) ([]entity.SigningKey, error) {
+			out := []entity.SigningKey{}
+			for _, key := range *stored {
+				if key.RetiredAt == nil || key.RetiredAt.After(retiredAfter) {
+					out = append(out, key)
+				}
+			}
+			sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+			return out, nil
+		}).Maybe()
+
+	keyring, err := NewSigningKeyring(repo, config.AuthConfig{Signing: config.SigningConfig{
+		Algorithm:        pdauthn.AlgorithmEdDSA,
+		RotationInterval: 24 * time.Hour,
+		RetiredKeyGrace:  2 * time.Hour,
+		PublishLead:      10 * time.Minute,
+		KeyEncryptionKey: keyEncryptionKey,
+	}})
+	require.NoError(t, err)
+	keyring.now = func() time.Time { return *now }
+	return keyring
+}
+
+func TestSigningKeyring_CreatesKeyOnFirstUse(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keyring, stored := newKeyringWithStore(t, &now)
+
+	key, err := keyring.CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.Len(t, *stored, 1)
+	require.Equal(t, key.ID, (*stored)[0].ID)
+
+	again, err := keyring.CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, key.ID, again.ID)
+	require.Len(t, *stored, 1)
+}
+
+func TestSigningKeyring_PublishesNextKeyBeforeSigningWithIt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keyring, stored := newKeyringWithStore(t, &now)
+	ctx := context.Background()
+
+	first, err := keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+
+	now = now.Add(24*time.Hour - 10*time.Minute)
+	current, err := keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, current.ID, "the next key must not sign before verifiers can see it")
+	require.Len(t, *stored, 2)
+	doc, err := keyring.JWKS(ctx)
+	require.NoError(t, err)
+	require.Len(t, doc.Keys, 2)
+
+	now = now.Add(9 * time.Minute)
+	current, err = keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, current.ID)
+	require.Len(t, *stored, 2, "a pending key is not published twice")
+
+	now = now.Add(time.Minute)
+	current, err = keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, current.ID)
+	require.NotNil(t, (*stored)[0].RetiredAt)
+}
+
+func TestSigningKeyring_RotatesAndKeepsRetiredKeyForGrace(t *testing.T) {
+	// Token expiry is checked against the wall clock, so start from it.
+	now := time.Now()
+	keyring, _ := newKeyringWithStore(t, &now)
+	ctx := context.Background()
+
+	first, err := keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	oldToken, err := first.Sign(pdauthn.Claims{UserID: 7, RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(now.Add(72 * time.Hour)),
+	}})
+	require.NoError(t, err)
+
+	now = now.Add(25 * time.Hour)
+	pending, err := keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, pending.ID)
+
+	now = now.Add(10 * time.Minute)
+	second, err := keyring.CurrentKey(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, second.ID)
+
+	doc, err := keyring.JWKS(ctx)
+	require.NoError(t, err)
+	require.Len(t, doc.Keys, 2)
+	require.Equal(t, second.ID, doc.Keys[0].Kid)
+
+	verifier := NewAccessTokenVerifier(config.AuthConfig{}, keyring)
+	claims, err := verifier.ClaimsFromTokenString(oldToken)
+	require.NoError(t, err)
+	require.Equal(t, uint(7), claims.UserID)
+
+	now = now.Add(3 * time.Hour)
+	doc, err = keyring.JWKS(ctx)
+	require.NoError(t, err)
+	require.Len(t, doc.Keys, 1)
+	_, err = verifier.ClaimsFromTokenString(oldToken)
+	require.Error(t, err)
+}
+
+func TestSigningKeyring_RateLimitsReloadsForUnknownKids(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keyring, stored := newKeyringWithStore(t, &now)
+	current, err := keyring.CurrentKey(context.Background())
+	require.NoError(t, err)
+
+	repo := outputmocks.NewMockSigningKeyRepository(t)
+	repo.EXPECT().ListPublishable(mock.Anything, mock.Anything).Return(*stored, nil).Times(2)
+	keyring.repo = repo
+
+	now = now.Add(keyringMinReloadInterval)
+	for range 3 {
+		_, err = keyring.VerificationKey("forged")
+		require.ErrorIs(t, err, pdauthn.ErrUnknownSigningKey)
+	}
+	_, err = keyring.VerificationKey(current.ID)
+	require.NoError(t, err)
+
+	now = now.Add(keyringMinReloadInterval)
+	_, err = keyring.VerificationKey("forged")
+	require.ErrorIs(t, err, pdauthn.ErrUnknownSigningKey)
+}
+
+func TestSigningKeyring_VerifiesCachedKeysWhileReloading(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keyring, stored := newKeyringWithStore(t, &now)
+	current, err := keyring.CurrentKey(context.Background())
+	require.NoError(t, err)
+
+	querying := make(chan struct{})
+	release := make(chan struct{})
+	repo := outputmocks.NewMockSigningKeyRepository(t)
+	repo.EXPECT().ListPublishable(mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, time.Time) ([]entity.SigningKey, error) {
+			close(querying)
+			<-release
+			return *stored, nil
+		}).Once()
+	keyring.repo = repo
+	now = now.Add(keyringMinReloadInterval)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := keyring.VerificationKey("forged")
+		done <- err
+	}()
+	<-querying
+	_, err = keyring.VerificationKey(current.ID)
+	require.NoError(t, err)
+	close(release)
+	require.ErrorIs(t, <-done, pdauthn.ErrUnknownSigningKey)
+}
+
+func TestSigningKeyring_EncryptsStoredPrivateKeys(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := []entity.SigningKey{}
+	kek := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	key, err := newKeyringOnStore(t, &now, &stored, kek).CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(stored[0].PrivateKeyPEM), sealedKeyPrefix))
+	require.NotContains(t, string(stored[0].PrivateKeyPEM), "PRIVATE KEY")
+
+	reloaded, err := newKeyringOnStore(t, &now, &stored, kek).CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, key.ID, reloaded.ID)
+
+	wrongKEK := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+	_, err = newKeyringOnStore(t, &now, &stored, wrongKEK).CurrentKey(context.Background())
+	require.Error(t, err)
+}
+
+func TestAccessTokenVerifier_RejectsHS256NextToKeyringAfterLegacyDeadline(t *testing.T) {
+	now := time.Now()
+	keyring, _ := newKeyringWithStore(t, &now)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, pdauthn.Claims{UserID: 7}).SignedString([]byte("shared"))
+	require.NoError(t, err)
+
+	cfg := config.AuthConfig{JWTSecret: "shared", Signing: config.SigningConfig{Algorithm: pdauthn.AlgorithmEdDSA}}
+	_, err = NewAccessTokenVerifier(cfg, keyring).ClaimsFromTokenString(token)
+	require.Error(t, err)
+
+	cfg.LegacyHS256Until = now.Add(time.Hour)
+	_, err = NewAccessTokenVerifier(cfg, keyring).ClaimsFromTokenString(token)
+	require.NoError(t, err)
+
+	cfg = config.AuthConfig{JWTSecret: "shared", Signing: config.SigningConfig{Algorithm: pdauthn.AlgorithmHS256}}
+	_, err = NewAccessTokenVerifier(cfg, keyring).ClaimsFromTokenString(token)
+	require.NoError(t, err)
+}
+
+func TestSigningKeyring_HS256PublishesNoKeys(t *testing.T) {
+	keyring, err := NewSigningKeyring(outputmocks.NewMockSigningKeyRepository(t), config.AuthConfig{
+		Signing: config.SigningConfig{Algorithm: pdauthn.AlgorithmHS256},
+	})
+	require.NoError(t, err)
+
+	doc, err := keyring.JWKS(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, doc.Keys)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,14 +13,31 @@ import (
 
 var _ inputport.TokenUsecase = (*tokenUCImpl)(nil)
 
+const signingKeyTimeout = 5 * time.Second
+
+type signingKeyProvider interface {
+	CurrentKey(ctx context.Context) (pdauthn.SigningKey, error)
+}
+
+// NewTokenUsecase signs access tokens with the shared HS256 secret.
 func NewTokenUsecase(cfg config.AuthConfig) *tokenUCImpl {
 	return &tokenUCImpl{
 		cfg: cfg,
 	}
 }
 
+// NewTokenUsecaseFor signs with the keyring's current key unless the configured algorithm is HS256.
+func NewTokenUsecaseFor(cfg config.AuthConfig, keyring *SigningKeyring) *tokenUCImpl {
+	uc := NewTokenUsecase(cfg)
+	if cfg.Signing.Algorithm != pdauthn.AlgorithmHS256 && keyring != nil {
+		uc.keys = keyring
+	}
+	return uc
+}
+
 type tokenUCImpl struct {
-	cfg config.AuthConfig
+	cfg  config.AuthConfig
+	keys signingKeyProvider
 }
 
 // CreateJwtToken implements inputport.TokenUsecase.
@@ -58,11 +76,15 @@ func (t *tokenUCImpl) CreateJwtTokenForSessionState(
 	if session.AssumedRoleExpiresAt != nil && session.AssumedRoleExpiresAt.Before(expiresAt) {
 		expiresAt = *session.AssumedRoleExpiresAt
 	}
+	identitySource := session.IdentityProvider
+	if identitySource == "" {
+		identitySource = user.InitialFrom
+	}
 	claims := pdauthn.Claims{
 		UserID:                      user.Id,
 		Email:                       user.Email,
 		Username:                    user.Username,
-		IdentitySource:              user.InitialFrom,
+		IdentitySource:              identitySource,
 		ActiveTenantID:              session.ActiveTenantID,
 		SessionID:                   session.ID,
 		SessionPolicy:               session.SessionPolicy,
@@ -74,6 +96,8 @@ func (t *tokenUCImpl) CreateJwtTokenForSessionState(
 		AssumedRoleServicePrincipal: session.AssumedRoleServicePrincipal,
 		AssumedRoleSessionName:      session.AssumedRoleSessionName,
 		AssumedRoleSourceIdentity:   session.AssumedRoleSourceIdentity,
+		MultiFactorAuthPresent:      session.MFAAuthenticatedAt != nil,
+		AuthTime:                    authTime(session.CreatedAt),
 		Key:                         t.cfg.JWTKey,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
@@ -81,7 +105,24 @@ func (t *tokenUCImpl) CreateJwtTokenForSessionState(
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	secret := []byte(t.cfg.JWTSecret)
-	return token.SignedString(secret)
+	if t.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(t.cfg.JWTSecret))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signingKeyTimeout)
+	defer cancel()
+	key, err := t.keys.CurrentKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	return key.Sign(claims)
+}
+
+// authTime reports when the session was created so IAM can evaluate auth:SessionAge.
+func authTime(createdAt time.Time) *jwt.NumericDate {
+	if createdAt.IsZero() {
+		return nil
+	}
+	return jwt.NewNumericDate(createdAt)
 }
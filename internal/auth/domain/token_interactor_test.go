@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/tuannm99/podzone/internal/auth/config"
 	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
 )
 
 func TestTokenUsecase_CreateJwtToken_Success(t *testing.T) {
@@ -88,3 +90,60 @@ func TestTokenUsecase_CreateJwtTokenForTenant_SetsActiveTenantClaim(t *testing.T
 	require.True(t, parsed.Valid)
 	assert.Equal(t, "tenant-123", claims.ActiveTenantID)
 }
+
+func TestTokenUsecase_CreateJwtTokenForSessionState_SetsAuthTime(t *testing.T) {
+	cfg := config.AuthConfig{
+		JWTSecret: "secret",
+		JWTKey:    "session-key",
+	}
+	uc := NewTokenUsecase(cfg)
+	createdAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	tokenStr, err := uc.CreateJwtTokenForSessionState(
+		entity.User{Id: 4, Email: "s@podzone.io", Username: "s"},
+		entity.Session{ID: "session-1", CreatedAt: createdAt},
+	)
+	require.NoError(t, err)
+
+	var claims entity.JWTClaims
+	_, err = jwt.ParseWithClaims(tokenStr, &claims, func(tok *jwt.Token) (interface{}, error) {
+		return []byte(cfg.JWTSecret), nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, claims.AuthTime)
+	assert.True(t, createdAt.Equal(claims.AuthTime.Time))
+}
+
+type staticSigningKey struct{ key pdauthn.SigningKey }
+
+func (s staticSigningKey) CurrentKey(context.Context) (pdauthn.SigningKey, error) { return s.key, nil }
+
+func (s staticSigningKey) VerificationKey(kid string) (pdauthn.VerificationKey, error) {
+	if kid != s.key.ID {
+		return pdauthn.VerificationKey{}, pdauthn.ErrUnknownSigningKey
+	}
+	return pdauthn.VerificationKey{Algorithm: s.key.Algorithm, PublicKey: s.key.PrivateKey.Public()}, nil
+}
+
+func TestTokenUsecase_SignsWithAsymmetricKey(t *testing.T) {
+	key, err := pdauthn.GenerateSigningKey("kid-1", pdauthn.AlgorithmRS256)
+	require.NoError(t, err)
+	keys := staticSigningKey{key: key}
+	uc := &tokenUCImpl{cfg: config.AuthConfig{JWTKey: "app-key"}, keys: keys}
+
+	tokenStr, err := uc.CreateJwtToken(entity.User{Id: 5, Email: "a@x.io"})
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(tokenStr, &entity.JWTClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "kid-1", parsed.Header["kid"])
+	assert.Equal(t, pdauthn.AlgorithmRS256, parsed.Method.Alg())
+
+	claims, err := pdauthn.NewVerifierWithKeySource(pdauthn.Config{JWTKey: "app-key"}, keys).
+		ClaimsFromTokenString(tokenStr)
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), claims.UserID)
+
+	_, err = pdauthn.NewVerifier(pdauthn.Config{JWTSecret: "secret"}).ClaimsFromTokenString(tokenStr)
+	require.Error(t, err)
+}
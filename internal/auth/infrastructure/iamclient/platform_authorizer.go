@@ -0,0 +1,62 @@
+package iamclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type PlatformAuthorizer struct {
+	client pbiamv1.IAMQueryServiceClient
+}
+
+var _ outputport.PlatformAuthorizer = (*PlatformAuthorizer)(nil)
+
+type PlatformAuthorizerParams struct {
+	fx.In
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    config.AuthConfig
+}
+
+func NewPlatformAuthorizer(p PlatformAuthorizerParams) (*PlatformAuthorizer, error) {
+	addr := fmt.Sprintf("%s:%s", p.Config.IAM.GRPCHost, p.Config.IAM.GRPCPort)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	p.Lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			p.Logger.Info("Closing IAM platform-authorizer gRPC client connection")
+			return conn.Close()
+		},
+	})
+	return &PlatformAuthorizer{
+		client: pbiamv1.NewIAMQueryServiceClient(conn),
+	}, nil
+}
+
+func (a *PlatformAuthorizer) CheckPlatformPermission(
+	ctx context.Context,
+	accessToken string,
+	userID uint,
+	permission string,
+) (bool, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+	resp, err := a.client.CheckPlatformPermission(ctx, &pbiamv1.CheckPlatformPermissionRequest{
+		UserId:     uint64(userID),
+		Permission: permission,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetAllowed(), nil
+}
@@ -0,0 +1,118 @@
+package iamclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type SAMLConnectionResolver struct {
+	queries  pbiamv1.IAMQueryServiceClient
+	commands pbiamv1.IAMCommandServiceClient
+}
+
+var _ outputport.SAMLConnectionResolver = (*SAMLConnectionResolver)(nil)
+
+type SAMLConnectionResolverParams struct {
+	fx.In
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    config.AuthConfig
+}
+
+func NewSAMLConnectionResolver(p SAMLConnectionResolverParams) (*SAMLConnectionResolver, error) {
+	addr := fmt.Sprintf("%s:%s", p.Config.IAM.GRPCHost, p.Config.IAM.GRPCPort)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	p.Lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			p.Logger.Info("Closing IAM saml gRPC client connection")
+			return conn.Close()
+		},
+	})
+	return &SAMLConnectionResolver{
+		queries:  pbiamv1.NewIAMQueryServiceClient(conn),
+		commands: pbiamv1.NewIAMCommandServiceClient(conn),
+	}, nil
+}
+
+func (r *SAMLConnectionResolver) ResolveSAMLConnection(
+	ctx context.Context,
+	orgID string,
+) (*entity.SAMLConnection, error) {
+	resp, err := r.queries.ResolveSAMLConnection(ctx, &pbiamv1.ResolveSAMLConnectionRequest{OrgId: orgID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, entity.ErrSAMLConnectionNotFound
+		}
+		return nil, err
+	}
+	connection := resp.GetConnection()
+	if connection == nil {
+		return nil, entity.ErrSAMLConnectionNotFound
+	}
+	return &entity.SAMLConnection{
+		OrgID:             connection.OrgId,
+		MetadataXML:       connection.MetadataXml,
+		UsernameAttribute: connection.UsernameAttribute,
+		EmailAttribute:    connection.EmailAttribute,
+		GroupsAttribute:   connection.GroupsAttribute,
+		SSORequired:       connection.SsoRequired,
+	}, nil
+}
+
+func (r *SAMLConnectionResolver) SSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error) {
+	resp, err := r.queries.ListSSORequiredOrganizations(ctx, &pbiamv1.ListSSORequiredOrganizationsRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrgIds(), nil
+}
+
+func (r *SAMLConnectionResolver) IsActiveOrganizationMember(
+	ctx context.Context,
+	orgID string,
+	userID uint,
+) (bool, error) {
+	resp, err := r.queries.GetOrganizationMembership(ctx, &pbiamv1.GetOrganizationMembershipRequest{
+		OrgId:  orgID,
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return resp.GetMembership().GetStatus() == entity.MembershipStatusActive, nil
+}
+
+func (r *SAMLConnectionResolver) ApplySAMLLogin(
+	ctx context.Context,
+	accessToken, orgID string,
+	userID uint,
+	groups []string,
+) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+	_, err := r.commands.ApplySAMLLogin(ctx, &pbiamv1.ApplySAMLLoginRequest{
+		OrgId:  orgID,
+		UserId: uint64(userID),
+		Groups: groups,
+	})
+	return err
+}
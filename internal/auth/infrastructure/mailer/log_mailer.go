@@ -0,0 +1,25 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// LogMailer writes messages to the service log instead of delivering them.
+type LogMailer struct {
+	logger pdlog.Logger
+}
+
+func NewLogMailer(logger pdlog.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+func (m *LogMailer) Send(_ context.Context, message entity.MailMessage) error {
+	if err := validateMessage(message); err != nil {
+		return err
+	}
+	m.logger.Info("Outbound mail", "to", message.To, "subject", message.Subject, "body", message.Body)
+	return nil
+}
@@ -0,0 +1,50 @@
+package mailer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// NewMailer returns the outbound Mailer selected by auth.mail.driver.
+func NewMailer(cfg config.AuthConfig, logger pdlog.Logger) (outputport.Mailer, error) {
+	switch cfg.Mail.Driver {
+	case config.MailDriverLog:
+		return NewLogMailer(logger), nil
+	case config.MailDriverFile:
+		return NewFileMailer(cfg.Mail.Dir, cfg.Mail.From), nil
+	case config.MailDriverSMTP:
+		return NewSMTPMailer(cfg.Mail), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver %q", cfg.Mail.Driver)
+	}
+}
+
+// formatMessage renders a plain-text RFC 5322 message.
+func formatMessage(from string, message entity.MailMessage, at time.Time) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + message.Subject + "\r\n")
+	b.WriteString("Date: " + at.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+func validateMessage(message entity.MailMessage) error {
+	if message.To == "" {
+		return entity.ErrEmailMissing
+	}
+	if strings.ContainsAny(message.To+message.Subject, "\r\n") {
+		return fmt.Errorf("mail header contains a line break")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func TestFileMailer_WritesMessage(t *testing.T) {
+	dir := t.TempDir()
+	m := NewFileMailer(dir, "no-reply@podzone.local")
+
+	err := m.Send(context.Background(), entity.MailMessage{
+		To:      "neo@mx.io",
+		Subject: "Reset your password",
+		Body:    "line one\nline two",
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "To: neo@mx.io\r\n")
+	require.Contains(t, string(raw), "Subject: Reset your password\r\n")
+	require.Contains(t, string(raw), "\r\n\r\nline one\r\nline two")
+}
+
+func TestMailer_RejectsHeaderInjection(t *testing.T) {
+	m := NewFileMailer(t.TempDir(), "no-reply@podzone.local")
+	err := m.Send(context.Background(), entity.MailMessage{To: "neo@mx.io\r\nBcc: x@y.z", Subject: "hi"})
+	require.Error(t, err)
+}
+
+func TestNewMailer_Driver(t *testing.T) {
+	m, err := NewMailer(config.AuthConfig{Mail: config.MailConfig{Driver: config.MailDriverLog}}, pdlog.NopLogger{})
+	require.NoError(t, err)
+	require.IsType(t, &LogMailer{}, m)
+
+	_, err = NewMailer(config.AuthConfig{Mail: config.MailConfig{Driver: "pigeon"}}, pdlog.NopLogger{})
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package iamprojection
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// InboxJanitor prunes completed message_inbox rows past the idempotency retention window.
+type InboxJanitor struct {
+	log      pdlog.Logger
+	janitor  *messaging.InboxJanitor
+	interval time.Duration
+	enabled  bool
+}
+
+func NewInboxJanitor(
+	log pdlog.Logger,
+	pruner messaging.InboxPruner,
+	cfg messaging.ConsumerRuntimeConfig,
+) *InboxJanitor {
+	return &InboxJanitor{
+		log:      log,
+		janitor:  messaging.NewInboxJanitor(pruner, cfg.Idempotency.Retention, nil),
+		interval: cfg.Idempotency.PruneInterval,
+		enabled:  cfg.Enabled && cfg.Idempotency.Enabled,
+	}
+}
+
+func (w *InboxJanitor) Run(ctx context.Context) {
+	if !w.enabled {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.tick(ctx)
+		}
+	}
+}
+
+func (w *InboxJanitor) tick(ctx context.Context) {
+	deleted, err := w.janitor.RunOnce(ctx)
+	if err != nil {
+		w.log.Error("auth inbox prune failed", "error", err, "deleted", deleted)
+		return
+	}
+	if deleted > 0 {
+		w.log.Info("auth inbox pruned", "deleted", deleted)
+	}
+}
@@ -41,6 +41,12 @@ var Module = fx.Options(
 			},
 			fx.ParamTags(`name:"sql-auth"`, `name:"auth-iam-projection-runtime"`),
 		),
+		fx.Annotate(
+			func(db *sqlx.DB, cfg messaging.ConsumerRuntimeConfig) (messaging.InboxPruner, error) {
+				return messagingsql.NewInboxStore(db, cfg.Idempotency.TableName)
+			},
+			fx.ParamTags(`name:"sql-auth"`, `name:"auth-iam-projection-runtime"`),
+		),
 		fx.Annotate(
 			func(log pdlog.Logger, cfg messaging.ConsumerRuntimeConfig) messaging.Observer {
 				return messaging.NewLoggingObserver(log, consumerName, cfg)
@@ -52,9 +58,11 @@ var Module = fx.Options(
 			NewWorker,
 			fx.ParamTags(``, ``, ``, ``, ``, `name:"auth-iam-projection-runtime"`, ``),
 		),
+		fx.Annotate(NewInboxJanitor, fx.ParamTags(``, ``, `name:"auth-iam-projection-runtime"`)),
 	),
-	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *Worker) {
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *Worker, janitor *InboxJanitor) {
 		pdworker.StartWorker(lc, logger, w)
+		pdworker.StartWorker(lc, logger, janitor)
 	}),
 )
 
@@ -28,7 +28,14 @@ func NewWorker(
 ) *Worker {
 	middlewares := make([]messaging.Middleware, 0, 1)
 	if cfg.Idempotency.Enabled {
-		middlewares = append(middlewares, messaging.IdempotentConsumer(inbox, cfg.Idempotency.ConsumerName, nil))
+		middlewares = append(middlewares, messaging.IdempotentConsumerWithOptions(
+			inbox,
+			cfg.Idempotency.ConsumerName,
+			messaging.IdempotencyOptions{
+				LeaseDuration:     cfg.Idempotency.LeaseDuration,
+				HeartbeatInterval: cfg.Idempotency.HeartbeatInterval,
+			},
+		))
 	}
 	return &Worker{
 		log:     log,
@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	authrepository "github.com/tuannm99/podzone/internal/auth/infrastructure/repository"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+const relayConfigPath = "messaging.auth.outbox"
+
+type RelayParams struct {
+	fx.In
+	Repo     authrepository.UserRepoParams
+	Producer pdkafka.Producer `name:"kafka-auth-producer"`
+	Config   messaging.OutboxRelayConfig
+}
+
+// NewRelay builds its own publisher so the module does not clash with the
+// messaging.Publisher already provided by iamprojection.
+func NewRelay(p RelayParams) (*messagingkafka.Relay, error) {
+	store, err := authrepository.NewOutboxStore(p.Repo)
+	if err != nil {
+		return nil, err
+	}
+	publisher := messaging.NewStrictSchemaValidatingPublisher(messagingkafka.NewPublisher(p.Producer), events.Schemas())
+	return messagingkafka.NewRelayFromConfig(store, publisher, p.Config), nil
+}
+
+func NewRelayConfig(k *koanf.Koanf) messaging.OutboxRelayConfig {
+	return messaging.LoadOutboxRelayConfig(k, relayConfigPath, messaging.DefaultOutboxRelayConfig())
+}
+
+var Module = fx.Options(
+	fx.Provide(
+		NewRelayConfig,
+		NewRelay,
+		fx.Annotate(
+			func(lc fx.Lifecycle, log pdlog.Logger, sqlCfg *pdsql.Config, cfg messaging.OutboxRelayConfig) (
+				messaging.OutboxWakeup,
+				error,
+			) {
+				return messagingsql.ListenForOutbox(lc, log, sqlCfg.URI, cfg)
+			},
+			fx.ParamTags(``, ``, `name:"sql-auth-config"`, ``),
+		),
+		NewOutboxWorker,
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *OutboxWorker) {
+		pdworker.StartWorker(lc, logger, w)
+	}),
+)
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+type OutboxWorker struct {
+	log      pdlog.Logger
+	relay    *messagingkafka.Relay
+	wakeup   messaging.OutboxWakeup
+	interval time.Duration
+}
+
+// NewOutboxWorker polls every cfg.PollInterval and, when wakeup is not nil, also drains the
+// outbox as soon as it signals an insert.
+func NewOutboxWorker(
+	log pdlog.Logger,
+	relay *messagingkafka.Relay,
+	cfg messaging.OutboxRelayConfig,
+	wakeup messaging.OutboxWakeup,
+) *OutboxWorker {
+	interval := cfg.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &OutboxWorker{
+		log:      log,
+		relay:    relay,
+		wakeup:   wakeup,
+		interval: interval,
+	}
+}
+
+func (w *OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var wakeups <-chan struct{}
+	if w.wakeup != nil {
+		wakeups = w.wakeup.Wakeups()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.tick(ctx)
+		case <-wakeups:
+			w.tick(ctx)
+		}
+	}
+}
+
+func (w *OutboxWorker) tick(ctx context.Context) {
+	if err := w.relay.Drain(ctx); err != nil {
+		if errors.Is(err, messaging.ErrNoMessages) {
+			return
+		}
+		w.log.Error("auth outbox relay tick failed", "error", err)
+	}
+}
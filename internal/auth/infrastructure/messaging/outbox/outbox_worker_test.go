@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	messagingmocks "github.com/tuannm99/podzone/pkg/messaging/mocks"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func TestOutboxWorkerTick_PublishesLoginLockedKeyedBySubject(t *testing.T) {
+	store := messagingmocks.NewMockOutboxStore(t)
+	publisher := messagingmocks.NewMockPublisher(t)
+	record := messaging.OutboxRecord{
+		ID:         "m1",
+		Topic:      messaging.EventTopic("auth"),
+		MessageKey: "username:neo",
+		Envelope:   messaging.Envelope{ID: "e1", Type: "auth.login.locked", Source: "auth", SchemaVersion: 1},
+	}
+	store.EXPECT().ClaimPending(mock.Anything, mock.Anything, 10, mock.Anything).Return([]messaging.OutboxRecord{record}, nil)
+	publisher.EXPECT().Publish(mock.Anything, "podzone.auth.events", "username:neo", record.Envelope).Return(nil)
+	store.EXPECT().MarkPublished(mock.Anything, mock.Anything, []string{"m1"}, mock.Anything).Return(nil)
+
+	worker := NewOutboxWorker(
+		pdlog.NopLogger{},
+		messagingkafka.NewRelay(store, publisher, 10),
+		messaging.DefaultOutboxRelayConfig(),
+		nil,
+	)
+	worker.tick(context.Background())
+}
+
+func TestOutboxWorkerTick_SwallowsRelayFailures(t *testing.T) {
+	store := messagingmocks.NewMockOutboxStore(t)
+	store.EXPECT().ClaimPending(mock.Anything, mock.Anything, 10, mock.Anything).Return(nil, errors.New("db down"))
+
+	worker := NewOutboxWorker(
+		pdlog.NopLogger{},
+		messagingkafka.NewRelay(store, messagingmocks.NewMockPublisher(t), 10),
+		messaging.DefaultOutboxRelayConfig(),
+		nil,
+	)
+	require.NotPanics(t, func() {
+		worker.tick(context.Background())
+	})
+}
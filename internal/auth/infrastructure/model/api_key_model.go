@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type APIKey struct {
+	ID                string     `db:"id"`
+	LookupID          string     `db:"lookup_id"`
+	KeyHash           string     `db:"key_hash"`
+	Name              string     `db:"name"`
+	OwnerType         string     `db:"owner_type"`
+	UserID            uint       `db:"user_id"`
+	TenantID          string     `db:"tenant_id"`
+	ServicePrincipal  string     `db:"service_principal"`
+	RoleID            uint64     `db:"role_id"`
+	RoleScope         string     `db:"role_scope"`
+	RoleName          string     `db:"role_name"`
+	RoleTenantID      string     `db:"role_tenant_id"`
+	SessionPolicyJSON string     `db:"session_policy_json"`
+	ExpiresAt         time.Time  `db:"expires_at"`
+	LastUsedAt        *time.Time `db:"last_used_at"`
+	CreatedAt         time.Time  `db:"created_at"`
+	RevokedAt         *time.Time `db:"revoked_at"`
+}
+
+func (k APIKey) ToEntity() *entity.APIKey {
+	statements := make([]entity.SessionPolicyStatement, 0)
+	if k.SessionPolicyJSON != "" {
+		_ = json.Unmarshal([]byte(k.SessionPolicyJSON), &statements)
+	}
+	return &entity.APIKey{
+		ID:               k.ID,
+		LookupID:         k.LookupID,
+		KeyHash:          k.KeyHash,
+		Name:             k.Name,
+		OwnerType:        k.OwnerType,
+		UserID:           k.UserID,
+		TenantID:         k.TenantID,
+		ServicePrincipal: k.ServicePrincipal,
+		RoleID:           k.RoleID,
+		RoleScope:        k.RoleScope,
+		RoleName:         k.RoleName,
+		RoleTenantID:     k.RoleTenantID,
+		SessionPolicy:    statements,
+		ExpiresAt:        k.ExpiresAt,
+		LastUsedAt:       k.LastUsedAt,
+		CreatedAt:        k.CreatedAt,
+		RevokedAt:        k.RevokedAt,
+	}
+}
@@ -14,6 +14,7 @@ type AuditLog struct {
 	ResourceID   string    `db:"resource_id"`
 	TenantID     string    `db:"tenant_id"`
 	Status       string    `db:"status"`
+	Severity     string    `db:"severity"`
 	PayloadJSON  string    `db:"payload_json"`
 	CreatedAt    time.Time `db:"created_at"`
 }
@@ -27,6 +28,7 @@ func (a AuditLog) ToEntity() *entity.AuditLog {
 		ResourceID:   a.ResourceID,
 		TenantID:     a.TenantID,
 		Status:       a.Status,
+		Severity:     a.Severity,
 		PayloadJSON:  a.PayloadJSON,
 		CreatedAt:    a.CreatedAt,
 	}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type MFAFactor struct {
+	UserID       uint       `db:"user_id"`
+	TOTPSecret   string     `db:"totp_secret"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+	LastUsedStep int64      `db:"last_used_step"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+}
+
+func (f MFAFactor) ToEntity() *entity.MFAFactor {
+	return &entity.MFAFactor{
+		UserID:       f.UserID,
+		Secret:       f.TOTPSecret,
+		EnabledAt:    f.EnabledAt,
+		LastUsedStep: f.LastUsedStep,
+		CreatedAt:    f.CreatedAt,
+		UpdatedAt:    f.UpdatedAt,
+	}
+}
@@ -21,11 +21,14 @@ type Session struct {
 	AssumedRoleSessionName      string     `db:"assumed_role_session_name"`
 	AssumedRoleSourceIdentity   string     `db:"assumed_role_source_identity"`
 	AssumedRoleExpiresAt        *time.Time `db:"assumed_role_expires_at"`
+	MFAAuthenticatedAt          *time.Time `db:"mfa_authenticated_at"`
+	IdentityProvider            string     `db:"identity_provider"`
 	Status                      string     `db:"status"`
 	CreatedAt                   time.Time  `db:"created_at"`
 	UpdatedAt                   time.Time  `db:"updated_at"`
 	ExpiresAt                   time.Time  `db:"expires_at"`
 	RevokedAt                   *time.Time `db:"revoked_at"`
+	RevokedReason               string     `db:"revoked_reason"`
 }
 
 func (s Session) ToEntity() *entity.Session {
@@ -51,11 +54,14 @@ func (s Session) ToEntity() *entity.Session {
 		AssumedRoleSessionName:      s.AssumedRoleSessionName,
 		AssumedRoleSourceIdentity:   s.AssumedRoleSourceIdentity,
 		AssumedRoleExpiresAt:        s.AssumedRoleExpiresAt,
+		MFAAuthenticatedAt:          s.MFAAuthenticatedAt,
+		IdentityProvider:            s.IdentityProvider,
 		Status:                      s.Status,
 		CreatedAt:                   s.CreatedAt,
 		UpdatedAt:                   s.UpdatedAt,
 		ExpiresAt:                   s.ExpiresAt,
 		RevokedAt:                   s.RevokedAt,
+		RevokedReason:               s.RevokedReason,
 	}
 }
 
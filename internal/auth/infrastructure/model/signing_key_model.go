@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type SigningKey struct {
+	ID            string     `db:"id"`
+	Algorithm     string     `db:"algorithm"`
+	PrivateKeyPEM string     `db:"private_key_pem"`
+	CreatedAt     time.Time  `db:"created_at"`
+	RetiredAt     *time.Time `db:"retired_at"`
+}
+
+func (k SigningKey) ToEntity() entity.SigningKey {
+	return entity.SigningKey{
+		ID:            k.ID,
+		Algorithm:     k.Algorithm,
+		PrivateKeyPEM: []byte(k.PrivateKeyPEM),
+		CreatedAt:     k.CreatedAt,
+		RetiredAt:     k.RetiredAt,
+	}
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type UserIdentity struct {
+	Provider    string    `db:"provider"`
+	Subject     string    `db:"subject"`
+	UserID      uint      `db:"user_id"`
+	Email       string    `db:"email"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastLoginAt time.Time `db:"last_login_at"`
+}
+
+func (i UserIdentity) ToEntity() *entity.UserIdentity {
+	return &entity.UserIdentity{
+		UserID:      i.UserID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		Email:       i.Email,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+}
@@ -22,6 +22,8 @@ type User struct {
 	Dob         time.Time `db:"dob"          json:"dob"`
 	CreatedAt   time.Time `db:"created_at"   json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"   json:"updated_at"`
+
+	EmailVerifiedAt *time.Time `db:"email_verified_at" json:"email_verified_at,omitempty"`
 }
 
 func (u *User) ToEntity() (*entity.User, error) {
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+type WebAuthnCredential struct {
+	ID              string     `db:"id"`
+	UserID          uint       `db:"user_id"`
+	CredentialID    string     `db:"credential_id"`
+	PublicKey       []byte     `db:"public_key"`
+	Algorithm       int64      `db:"algorithm"`
+	SignCount       int64      `db:"sign_count"`
+	AAGUID          string     `db:"aaguid"`
+	TransportsJSON  string     `db:"transports_json"`
+	Name            string     `db:"name"`
+	BackupEligible  bool       `db:"backup_eligible"`
+	BackupState     bool       `db:"backup_state"`
+	CloneDetectedAt *time.Time `db:"clone_detected_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	LastUsedAt      *time.Time `db:"last_used_at"`
+}
+
+func (c WebAuthnCredential) ToEntity() *entity.WebAuthnCredential {
+	var transports []string
+	if c.TransportsJSON != "" {
+		_ = json.Unmarshal([]byte(c.TransportsJSON), &transports)
+	}
+	return &entity.WebAuthnCredential{
+		ID:              c.ID,
+		UserID:          c.UserID,
+		CredentialID:    c.CredentialID,
+		PublicKey:       c.PublicKey,
+		Algorithm:       c.Algorithm,
+		SignCount:       uint32(c.SignCount),
+		AAGUID:          c.AAGUID,
+		Transports:      transports,
+		Name:            c.Name,
+		BackupEligible:  c.BackupEligible,
+		BackupState:     c.BackupState,
+		CloneDetectedAt: c.CloneDetectedAt,
+		CreatedAt:       c.CreatedAt,
+		LastUsedAt:      c.LastUsedAt,
+	}
+}
@@ -0,0 +1,247 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+const (
+	httpTimeout      = 10 * time.Second
+	maxResponseBytes = 1 << 20
+	clockSkew        = time.Minute
+)
+
+var _ outputport.OIDCProvider = (*Provider)(nil)
+
+// discovery is the subset of the OpenID Provider Metadata the login flow needs.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a generic OpenID Connect relying party. Discovery runs on first use and
+// is cached; signing keys are fetched and rotated through a pdauthn JWKS key source.
+type Provider struct {
+	cfg    config.OIDCProviderConfig
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *discovery
+	keys pdauthn.KeySource
+}
+
+func NewProvider(cfg config.OIDCProviderConfig) *Provider {
+	return &Provider{cfg: cfg, client: &http.Client{Timeout: httpTimeout}}
+}
+
+func (p *Provider) Info() outputport.OIDCProviderInfo {
+	return outputport.OIDCProviderInfo{Name: p.cfg.Name, DisplayName: p.cfg.DisplayName}
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	meta, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauthConfig(meta).AuthCodeURL(
+		state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.S256ChallengeOption(codeVerifier),
+	), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*entity.OIDCIdentity, error) {
+	meta, keys, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.oauthConfig(meta).Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("oidc %s: code exchange failed: %w", p.cfg.Name, err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", entity.ErrOIDCTokenInvalid)
+	}
+	claims, err := p.verifyIDToken(meta, keys, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if stringClaim(claims, p.cfg.Claims.Email) == "" && meta.UserinfoEndpoint != "" {
+		userinfo, err := p.userinfo(ctx, meta.UserinfoEndpoint, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		// Userinfo is only trusted for the subject the ID token was issued to.
+		if stringClaim(userinfo, "sub") != stringClaim(claims, "sub") {
+			return nil, fmt.Errorf("%w: userinfo subject mismatch", entity.ErrOIDCTokenInvalid)
+		}
+		for key, value := range userinfo {
+			if _, ok := claims[key]; !ok {
+				claims[key] = value
+			}
+		}
+	}
+	return p.identity(claims), nil
+}
+
+func (p *Provider) verifyIDToken(
+	meta *discovery,
+	keys pdauthn.KeySource,
+	rawIDToken, nonce string,
+) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(tok *jwt.Token) (any, error) {
+		kid, _ := tok.Header["kid"].(string)
+		key, err := keys.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != tok.Method.Alg() {
+			return nil, pdauthn.ErrUnknownSigningKey
+		}
+		return key.PublicKey, nil
+	},
+		jwt.WithValidMethods([]string{pdauthn.AlgorithmRS256, pdauthn.AlgorithmEdDSA}),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrOIDCTokenInvalid, err)
+	}
+	gotNonce := stringClaim(claims, "nonce")
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(gotNonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", entity.ErrOIDCTokenInvalid)
+	}
+	if stringClaim(claims, "sub") == "" {
+		return nil, fmt.Errorf("%w: missing sub", entity.ErrOIDCTokenInvalid)
+	}
+	// With several audiences the token must name us as its authorized party.
+	if aud, _ := claims.GetAudience(); len(aud) > 1 && stringClaim(claims, "azp") != p.cfg.ClientID {
+		return nil, fmt.Errorf("%w: azp mismatch", entity.ErrOIDCTokenInvalid)
+	}
+	return claims, nil
+}
+
+func (p *Provider) identity(claims jwt.MapClaims) *entity.OIDCIdentity {
+	mapping := p.cfg.Claims
+	return &entity.OIDCIdentity{
+		Provider:      p.cfg.Name,
+		Subject:       stringClaim(claims, "sub"),
+		Email:         stringClaim(claims, mapping.Email),
+		EmailVerified: p.cfg.TrustEmail || boolClaim(claims, mapping.EmailVerified),
+		Name:          stringClaim(claims, mapping.Name),
+		GivenName:     stringClaim(claims, mapping.GivenName),
+		FamilyName:    stringClaim(claims, mapping.FamilyName),
+		Username:      stringClaim(claims, mapping.Username),
+	}
+}
+
+func (p *Provider) oauthConfig(meta *discovery) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}
+}
+
+// discover loads the issuer's metadata once; failures are not cached so a later login retries.
+func (p *Provider) discover(ctx context.Context) (*discovery, pdauthn.KeySource, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, p.keys, nil
+	}
+
+	var meta discovery
+	if err := p.getJSON(ctx, p.cfg.Issuer+"/.well-known/openid-configuration", "", &meta); err != nil {
+		return nil, nil, fmt.Errorf("oidc %s: discovery failed: %w", p.cfg.Name, err)
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.cfg.Issuer {
+		return nil, nil, fmt.Errorf("oidc %s: discovery issuer %q does not match %q", p.cfg.Name, meta.Issuer, p.cfg.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, nil, fmt.Errorf("oidc %s: discovery document is missing endpoints", p.cfg.Name)
+	}
+	p.meta = &meta
+	p.keys = pdauthn.NewJWKSKeySource(pdauthn.Config{JWKSURL: meta.JWKSURI})
+	return p.meta, p.keys, nil
+}
+
+func (p *Provider) userinfo(ctx context.Context, endpoint, accessToken string) (map[string]any, error) {
+	out := map[string]any{}
+	if err := p.getJSON(ctx, endpoint, accessToken, &out); err != nil {
+		return nil, fmt.Errorf("oidc %s: userinfo failed: %w", p.cfg.Name, err)
+	}
+	return out, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url, bearer string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	if name == "" {
+		return ""
+	}
+	value, _ := claims[name].(string)
+	return value
+}
+
+// boolClaim accepts both JSON booleans and the "true" strings some providers emit.
+func boolClaim(claims map[string]any, name string) bool {
+	switch value := claims[name].(type) {
+	case bool:
+		return value
+	case string:
+		return strings.EqualFold(value, "true")
+	default:
+		return false
+	}
+}
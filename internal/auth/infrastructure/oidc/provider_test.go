@@ -0,0 +1,171 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+// fakeIdP serves discovery, JWKS and a token endpoint that signs whatever idClaims holds.
+type fakeIdP struct {
+	server   *httptest.Server
+	key      pdauthn.SigningKey
+	idClaims jwt.MapClaims
+	verifier string
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	t.Helper()
+	key, err := pdauthn.GenerateSigningKey("idp-key", pdauthn.AlgorithmRS256)
+	require.NoError(t, err)
+	idp := &fakeIdP{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"userinfo_endpoint":      idp.server.URL + "/userinfo",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		jwk, err := idp.key.PublicJWK()
+		require.NoError(t, err)
+		_ = json.NewEncoder(w).Encode(pdauthn.JWKS{Keys: []pdauthn.JWK{jwk}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		idp.verifier = r.PostForm.Get("code_verifier")
+		idToken, err := idp.key.Sign(idp.idClaims)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-1",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"sub":            "user-1",
+			"mail":           "neo@mx.io",
+			"email_verified": "true",
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *fakeIdP) provider(mapping config.OIDCClaimMapping) *Provider {
+	return NewProvider(config.OIDCProviderConfig{
+		Name:        "keycloak",
+		DisplayName: "Keycloak",
+		Issuer:      idp.server.URL,
+		ClientID:    "podzone",
+		RedirectURL: "https://auth.example.com/auth/v1/oidc/keycloak/callback",
+		Scopes:      []string{"openid", "email"},
+		Claims:      mapping,
+	})
+}
+
+func (idp *fakeIdP) claims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   "podzone",
+		"sub":   "user-1",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": nonce,
+	}
+}
+
+func TestProvider_AuthCodeURLCarriesNonceAndPKCE(t *testing.T) {
+	idp := newFakeIdP(t)
+	authURL, err := idp.provider(config.OIDCClaimMapping{}).AuthCodeURL(context.Background(), "st", "no", "verifier")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, idp.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	query := parsed.Query()
+	assert.Equal(t, "st", query.Get("state"))
+	assert.Equal(t, "no", query.Get("nonce"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.NotEmpty(t, query.Get("code_challenge"))
+	assert.NotEqual(t, "verifier", query.Get("code_challenge"))
+}
+
+func TestProvider_ExchangeMapsClaimsAndFallsBackToUserinfo(t *testing.T) {
+	idp := newFakeIdP(t)
+	idp.idClaims = idp.claims("nonce-1")
+	idp.idClaims["preferred_username"] = "neo"
+	provider := idp.provider(config.OIDCClaimMapping{
+		Email:         "mail",
+		EmailVerified: "email_verified",
+		Username:      "preferred_username",
+	})
+
+	identity, err := provider.Exchange(context.Background(), "code", "verifier-1", "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, "verifier-1", idp.verifier)
+	assert.Equal(t, &entity.OIDCIdentity{
+		Provider:      "keycloak",
+		Subject:       "user-1",
+		Email:         "neo@mx.io",
+		EmailVerified: true,
+		Username:      "neo",
+	}, identity)
+}
+
+func TestProvider_ExchangeRejectsInvalidIDTokens(t *testing.T) {
+	idp := newFakeIdP(t)
+	provider := idp.provider(config.OIDCClaimMapping{Email: "email"})
+
+	cases := map[string]func(jwt.MapClaims){
+		"nonce mismatch": func(c jwt.MapClaims) { c["nonce"] = "other" },
+		"wrong audience": func(c jwt.MapClaims) { c["aud"] = "someone-else" },
+		"wrong issuer":   func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"expired":        func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"foreign azp":    func(c jwt.MapClaims) { c["aud"] = []string{"podzone", "other"}; c["azp"] = "other" },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			idp.idClaims = idp.claims("nonce-1")
+			idp.idClaims["email"] = "neo@mx.io"
+			mutate(idp.idClaims)
+			_, err := provider.Exchange(context.Background(), "code", "verifier", "nonce-1")
+			require.ErrorIs(t, err, entity.ErrOIDCTokenInvalid)
+		})
+	}
+}
+
+func TestNewRegistry_ValidatesProviders(t *testing.T) {
+	_, err := NewRegistry(config.AuthConfig{OIDCProviders: []config.OIDCProviderConfig{{Name: "google", Issuer: "x"}}})
+	require.Error(t, err)
+	_, err = NewRegistry(config.AuthConfig{OIDCProviders: []config.OIDCProviderConfig{{Name: "gitlab"}}})
+	require.Error(t, err)
+
+	registry, err := NewRegistry(config.AuthConfig{OIDCProviders: []config.OIDCProviderConfig{{
+		Name: "gitlab", DisplayName: "GitLab", Issuer: "https://gitlab.com", ClientID: "id", RedirectURL: "https://cb",
+	}}})
+	require.NoError(t, err)
+	assert.Equal(t, "GitLab", registry.List()[0].DisplayName)
+	_, err = registry.Provider("missing")
+	require.ErrorIs(t, err, entity.ErrOIDCProviderNotFound)
+}
@@ -0,0 +1,45 @@
+package oidc
+
+import (
+	"fmt"
+
+	"github.com/tuannm99/podzone/internal/auth/config"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+)
+
+var _ outputport.OIDCProviderRegistry = (*Registry)(nil)
+
+// Registry holds the providers configured under auth.oidc.providers, in config order.
+type Registry struct {
+	providers map[string]*Provider
+	infos     []outputport.OIDCProviderInfo
+}
+
+func NewRegistry(cfg config.AuthConfig) (*Registry, error) {
+	r := &Registry{providers: make(map[string]*Provider, len(cfg.OIDCProviders))}
+	for _, providerCfg := range cfg.OIDCProviders {
+		if providerCfg.Name == entity.IdentityProviderPodzone || providerCfg.Name == entity.IdentityProviderGoogle {
+			return nil, fmt.Errorf("oidc provider name %q is reserved", providerCfg.Name)
+		}
+		if providerCfg.Issuer == "" || providerCfg.ClientID == "" || providerCfg.RedirectURL == "" {
+			return nil, fmt.Errorf("oidc provider %q needs issuer, client_id and redirect_url", providerCfg.Name)
+		}
+		provider := NewProvider(providerCfg)
+		r.providers[providerCfg.Name] = provider
+		r.infos = append(r.infos, provider.Info())
+	}
+	return r, nil
+}
+
+func (r *Registry) Provider(name string) (outputport.OIDCProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, entity.ErrOIDCProviderNotFound
+	}
+	return provider, nil
+}
+
+func (r *Registry) List() []outputport.OIDCProviderInfo {
+	return append([]outputport.OIDCProviderInfo(nil), r.infos...)
+}
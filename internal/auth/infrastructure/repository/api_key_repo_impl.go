@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.APIKeyRepository = (*APIKeyRepositoryImpl)(nil)
+
+type APIKeyRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewAPIKeyRepositoryImpl(p UserRepoParams) *APIKeyRepositoryImpl {
+	return &APIKeyRepositoryImpl{db: p.DB}
+}
+
+var apiKeyColumns = []string{
+	"id", "lookup_id", "key_hash", "name", "owner_type", "user_id", "tenant_id",
+	"service_principal", "role_id", "role_scope", "role_name", "role_tenant_id",
+	"session_policy_json", "expires_at", "last_used_at", "created_at", "revoked_at",
+}
+
+func (r *APIKeyRepositoryImpl) Create(ctx context.Context, key entity.APIKey) error {
+	policyJSON, err := json.Marshal(key.SessionPolicy)
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_api_keys").
+		Columns(apiKeyColumns...).
+		Values(key.ID, key.LookupID, key.KeyHash, key.Name, key.OwnerType, key.UserID, key.TenantID,
+			key.ServicePrincipal, key.RoleID, key.RoleScope, key.RoleName, key.RoleTenantID,
+			string(policyJSON), key.ExpiresAt, key.LastUsedAt, key.CreatedAt, key.RevokedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *APIKeyRepositoryImpl) GetByLookupID(ctx context.Context, lookupID string) (*entity.APIKey, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apiKeyColumns...).
+		From("auth_api_keys").
+		Where(sq.Eq{"lookup_id": lookupID}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out model.APIKey
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return out.ToEntity(), nil
+}
+
+func (r *APIKeyRepositoryImpl) ListByUser(ctx context.Context, userID uint) ([]entity.APIKey, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apiKeyColumns...).
+		From("auth_api_keys").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC", "id DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var rows []model.APIKey
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	out := make([]entity.APIKey, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *row.ToEntity())
+	}
+	return out, nil
+}
+
+func (r *APIKeyRepositoryImpl) Revoke(ctx context.Context, id string, userID uint, revokedAt time.Time) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_api_keys").
+		Set("revoked_at", revokedAt).
+		Where(sq.Eq{"id": id, "user_id": userID, "revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return entity.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyRepositoryImpl) RevokeByUserInTenants(
+	ctx context.Context,
+	userID uint,
+	tenantIDs []string,
+	revokedAt time.Time,
+) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_api_keys").
+		Set("revoked_at", revokedAt).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).
+		Where(sq.Or{sq.Eq{"tenant_id": tenantIDs}, sq.Eq{"role_tenant_id": tenantIDs}}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *APIKeyRepositoryImpl) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_api_keys").
+		Set("last_used_at", usedAt).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
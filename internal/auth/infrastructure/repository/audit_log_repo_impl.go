@@ -26,12 +26,15 @@ func NewIAMAuditLogRepositoryImpl(p IAMUserRepoParams) *AuditLogRepositoryImpl {
 }
 
 func (r *AuditLogRepositoryImpl) Create(ctx context.Context, log entity.AuditLog) error {
+	if log.Severity == "" {
+		log.Severity = entity.AuditSeverityInfo
+	}
 	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("auth_audit_logs").
 		Columns("id", "actor_user_id", "action", "resource_type", "resource_id",
-			"tenant_id", "status", "payload_json", "created_at").
+			"tenant_id", "status", "severity", "payload_json", "created_at").
 		Values(log.ID, log.ActorUserID, log.Action, log.ResourceType, log.ResourceID,
-			log.TenantID, log.Status, log.PayloadJSON, log.CreatedAt).
+			log.TenantID, log.Status, log.Severity, log.PayloadJSON, log.CreatedAt).
 		ToSql()
 	if err != nil {
 		return err
@@ -79,6 +82,10 @@ func (r *AuditLogRepositoryImpl) ListByActor(
 				column:    "status",
 				operators: operators(collection.FilterEqual, collection.FilterNotEqual, collection.FilterIn),
 			},
+			"severity": {
+				column:    "severity",
+				operators: operators(collection.FilterEqual, collection.FilterNotEqual, collection.FilterIn),
+			},
 			"created_at": {
 				column: "created_at",
 				operators: operators(
@@ -93,6 +100,7 @@ func (r *AuditLogRepositoryImpl) ListByActor(
 			"action":        "action",
 			"resource_type": "resource_type",
 			"status":        "status",
+			"severity":      "severity",
 			"tenant_id":     "tenant_id",
 			"created_at":    "created_at",
 		},
@@ -121,7 +129,7 @@ func (r *AuditLogRepositoryImpl) ListByActor(
 
 	queryBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("id", "actor_user_id", "action", "resource_type", "resource_id",
-			"tenant_id", "status", "payload_json", "created_at").
+			"tenant_id", "status", "severity", "payload_json", "created_at").
 		From("auth_audit_logs").
 		Where(sq.Eq{"actor_user_id": actorUserID}).
 		OrderBy(orderBy).
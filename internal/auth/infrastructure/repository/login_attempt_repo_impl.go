@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+)
+
+const loginAttemptKeyPrefix = "auth:login_attempts:"
+
+var _ outputport.LoginAttemptRepository = (*LoginAttemptRepositoryImpl)(nil)
+
+// LoginAttemptRepositoryImpl keeps each counter in a Redis hash that expires on its own.
+type LoginAttemptRepositoryImpl struct {
+	redisClient redis.Cmdable
+}
+
+func NewLoginAttemptRepositoryImpl(p OauthStateRepoParams) *LoginAttemptRepositoryImpl {
+	return &LoginAttemptRepositoryImpl{redisClient: p.RedisClient}
+}
+
+func (r *LoginAttemptRepositoryImpl) Get(ctx context.Context, key string) (entity.LoginAttempts, error) {
+	fields, err := r.redisClient.HGetAll(ctx, loginAttemptKeyPrefix+key).Result()
+	if err != nil {
+		return entity.LoginAttempts{}, err
+	}
+	return loginAttemptsFromHash(fields), nil
+}
+
+func (r *LoginAttemptRepositoryImpl) RecordFailure(
+	ctx context.Context,
+	key string,
+	at time.Time,
+	window time.Duration,
+) (entity.LoginAttempts, error) {
+	redisKey := loginAttemptKeyPrefix + key
+	var fields *redis.MapStringStringCmd
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, redisKey, "failures", 1)
+		pipe.HSet(ctx, redisKey, "last_failure_at", at.UnixMilli())
+		pipe.PExpire(ctx, redisKey, window)
+		fields = pipe.HGetAll(ctx, redisKey)
+		return nil
+	})
+	if err != nil {
+		return entity.LoginAttempts{}, err
+	}
+	return loginAttemptsFromHash(fields.Val()), nil
+}
+
+func (r *LoginAttemptRepositoryImpl) Lock(ctx context.Context, key string, until time.Time) error {
+	redisKey := loginAttemptKeyPrefix + key
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, redisKey, "locked_until", until.UnixMilli())
+		pipe.PExpireAt(ctx, redisKey, until)
+		return nil
+	})
+	return err
+}
+
+func (r *LoginAttemptRepositoryImpl) Reset(ctx context.Context, key string) error {
+	return r.redisClient.Del(ctx, loginAttemptKeyPrefix+key).Err()
+}
+
+func loginAttemptsFromHash(fields map[string]string) entity.LoginAttempts {
+	var out entity.LoginAttempts
+	out.Failures, _ = strconv.Atoi(fields["failures"])
+	out.LastFailureAt = unixMilliField(fields["last_failure_at"])
+	out.LockedUntil = unixMilliField(fields["locked_until"])
+	return out
+}
+
+func unixMilliField(raw string) time.Time {
+	ms, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms).UTC()
+}
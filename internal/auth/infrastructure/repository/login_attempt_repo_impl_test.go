@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/testkit"
+)
+
+func setupLoginAttemptRepo(t *testing.T) (*LoginAttemptRepositoryImpl, *redis.Client) {
+	t.Helper()
+	var client *redis.Client
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				t.Skipf("skipping redis-backed login attempt repo test: %v", recovered)
+			}
+		}()
+		client = testkit.RedisClient(t)
+	}()
+	if client == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.FlushDB(ctx).Err())
+
+	repo := NewLoginAttemptRepositoryImpl(OauthStateRepoParams{
+		RedisClient: client,
+		Logger:      pdlog.NopLogger{},
+	})
+	return repo, client
+}
+
+func TestLoginAttemptRepository_RecordLockReset(t *testing.T) {
+	repo, client := setupLoginAttemptRepo(t)
+	ctx := context.Background()
+	at := time.Now().UTC().Truncate(time.Millisecond)
+
+	state, err := repo.Get(ctx, "username:neo")
+	require.NoError(t, err)
+	require.Zero(t, state.Failures)
+
+	_, err = repo.RecordFailure(ctx, "username:neo", at, time.Minute)
+	require.NoError(t, err)
+	state, err = repo.RecordFailure(ctx, "username:neo", at, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, state.Failures)
+	require.Equal(t, at, state.LastFailureAt)
+
+	until := at.Add(10 * time.Minute)
+	require.NoError(t, repo.Lock(ctx, "username:neo", until))
+	state, err = repo.Get(ctx, "username:neo")
+	require.NoError(t, err)
+	require.Equal(t, until, state.LockedUntil)
+	ttl, err := client.PTTL(ctx, loginAttemptKeyPrefix+"username:neo").Result()
+	require.NoError(t, err)
+	require.Greater(t, ttl, time.Minute, "a lock outlives the failure window")
+
+	require.NoError(t, repo.Reset(ctx, "username:neo"))
+	state, err = repo.Get(ctx, "username:neo")
+	require.NoError(t, err)
+	require.Zero(t, state)
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.MFARepository = (*MFARepositoryImpl)(nil)
+
+type MFARepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewMFARepositoryImpl(p UserRepoParams) *MFARepositoryImpl {
+	return &MFARepositoryImpl{db: p.DB}
+}
+
+func (r *MFARepositoryImpl) GetByUserID(ctx context.Context, userID uint) (*entity.MFAFactor, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("user_id", "totp_secret", "enabled_at", "last_used_step", "created_at", "updated_at").
+		From("auth_user_mfa").
+		Where(sq.Eq{"user_id": userID}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out model.MFAFactor
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrMFANotEnrolled
+		}
+		return nil, err
+	}
+	return out.ToEntity(), nil
+}
+
+func (r *MFARepositoryImpl) SavePending(ctx context.Context, factor entity.MFAFactor) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_user_mfa").
+		Columns("user_id", "totp_secret", "enabled_at", "last_used_step", "created_at", "updated_at").
+		Values(factor.UserID, factor.Secret, nil, 0, factor.CreatedAt, factor.UpdatedAt).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE
+			SET totp_secret = EXCLUDED.totp_secret,
+				last_used_step = 0,
+				created_at = EXCLUDED.created_at,
+				updated_at = EXCLUDED.updated_at
+			WHERE auth_user_mfa.enabled_at IS NULL`).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return entity.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *MFARepositoryImpl) Enable(ctx context.Context, userID uint, step int64, enabledAt time.Time) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_user_mfa").
+		Set("enabled_at", enabledAt).
+		Set("last_used_step", step).
+		Set("updated_at", enabledAt).
+		Where(sq.Eq{"user_id": userID, "enabled_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return entity.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *MFARepositoryImpl) ClaimStep(ctx context.Context, userID uint, step int64, usedAt time.Time) (bool, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_user_mfa").
+		Set("last_used_step", step).
+		Set("updated_at", usedAt).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Lt{"last_used_step": step}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *MFARepositoryImpl) Delete(ctx context.Context, userID uint) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range []string{"auth_mfa_recovery_codes", "auth_user_mfa"} {
+		query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Delete(table).
+			Where(sq.Eq{"user_id": userID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *MFARepositoryImpl) ReplaceRecoveryCodes(
+	ctx context.Context,
+	userID uint,
+	codeHashes []string,
+	createdAt time.Time,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("auth_mfa_recovery_codes").
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	if len(codeHashes) > 0 {
+		insert := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Insert("auth_mfa_recovery_codes").
+			Columns("id", "user_id", "code_hash", "created_at")
+		for _, hash := range codeHashes {
+			insert = insert.Values(uuid.NewString(), userID, hash, createdAt)
+		}
+		query, args, err = insert.ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *MFARepositoryImpl) ConsumeRecoveryCode(
+	ctx context.Context,
+	userID uint,
+	codeHash string,
+	usedAt time.Time,
+) (bool, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_mfa_recovery_codes").
+		Set("used_at", usedAt).
+		Where(sq.Eq{"user_id": userID, "code_hash": codeHash, "used_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
@@ -57,6 +57,20 @@ func (o *OauthStateRepositoryImpl) Set(key string, duration time.Duration) error
 	return o.SetValue(key, time.Now().String(), duration)
 }
 
+func (o *OauthStateRepositoryImpl) Incr(key string, duration time.Duration) (int64, error) {
+	ctx := context.Background()
+	var count *redis.IntCmd
+	_, err := o.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Incr(ctx, key)
+		pipe.PExpire(ctx, key, duration)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error incrementing state: %w", err)
+	}
+	return count.Val(), nil
+}
+
 func (o *OauthStateRepositoryImpl) SetValue(key, value string, duration time.Duration) error {
 	if err := o.redisClient.Set(context.Background(), key, value, duration).Err(); err != nil {
 		return fmt.Errorf("error storing state: %w", err)
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	messagingsqlstore "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+)
+
+const outboxTableName = "message_outbox"
+
+// NewOutboxStore exposes the auth outbox table to the domain and the relay worker.
+func NewOutboxStore(p UserRepoParams) (*messagingsqlstore.OutboxStore, error) {
+	return messagingsqlstore.NewOutboxStore(p.DB, outboxTableName)
+}
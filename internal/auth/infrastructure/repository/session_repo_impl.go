@@ -55,9 +55,12 @@ func (r *SessionRepositoryImpl) Create(ctx context.Context, session entity.Sessi
 			"assumed_role_session_name",
 			"assumed_role_source_identity",
 			"assumed_role_expires_at",
+			"mfa_authenticated_at",
+			"identity_provider",
 			"updated_at",
 			"expires_at",
 			"revoked_at",
+			"revoked_reason",
 		).
 		Values(
 			session.ID,
@@ -75,9 +78,12 @@ func (r *SessionRepositoryImpl) Create(ctx context.Context, session entity.Sessi
 			session.AssumedRoleSessionName,
 			session.AssumedRoleSourceIdentity,
 			session.AssumedRoleExpiresAt,
+			session.MFAAuthenticatedAt,
+			session.IdentityProvider,
 			session.UpdatedAt,
 			session.ExpiresAt,
 			session.RevokedAt,
+			session.RevokedReason,
 		).
 		ToSql()
 	if err != nil {
@@ -105,9 +111,12 @@ func (r *SessionRepositoryImpl) GetByID(ctx context.Context, id string) (*entity
 			"assumed_role_session_name",
 			"assumed_role_source_identity",
 			"assumed_role_expires_at",
+			"mfa_authenticated_at",
+			"identity_provider",
 			"updated_at",
 			"expires_at",
 			"revoked_at",
+			"revoked_reason",
 		).
 		From("auth_sessions").
 		Where(sq.Eq{"id": id}).
@@ -214,9 +223,12 @@ func (r *SessionRepositoryImpl) ListByUser(
 			"assumed_role_session_name",
 			"assumed_role_source_identity",
 			"assumed_role_expires_at",
+			"mfa_authenticated_at",
+			"identity_provider",
 			"updated_at",
 			"expires_at",
 			"revoked_at",
+			"revoked_reason",
 		).
 		From("auth_sessions").
 		Where(sq.Eq{"user_id": userID}).
@@ -310,11 +322,12 @@ func (r *SessionRepositoryImpl) UpdateAssumedRole(
 	return err
 }
 
-func (r *SessionRepositoryImpl) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+func (r *SessionRepositoryImpl) Revoke(ctx context.Context, id, reason string, revokedAt time.Time) error {
 	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update("auth_sessions").
 		Set("status", entity.SessionStatusRevoked).
 		Set("revoked_at", revokedAt).
+		Set("revoked_reason", reason).
 		Set("updated_at", revokedAt).
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -325,6 +338,61 @@ func (r *SessionRepositoryImpl) Revoke(ctx context.Context, id string, revokedAt
 	return err
 }
 
+func (r *SessionRepositoryImpl) RevokeByUser(
+	ctx context.Context,
+	userID uint,
+	exceptSessionID string,
+	reason string,
+	revokedAt time.Time,
+) ([]string, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_sessions").
+		Set("status", entity.SessionStatusRevoked).
+		Set("revoked_at", revokedAt).
+		Set("revoked_reason", reason).
+		Set("updated_at", revokedAt).
+		Where(sq.Eq{"user_id": userID, "status": entity.SessionStatusActive})
+	if exceptSessionID != "" {
+		builder = builder.Where(sq.NotEq{"id": exceptSessionID})
+	}
+	query, args, err := builder.Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	if err := r.db.SelectContext(ctx, &ids, query, args...); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *SessionRepositoryImpl) RevokeByUserInTenants(
+	ctx context.Context,
+	userID uint,
+	tenantIDs []string,
+	reason string,
+	revokedAt time.Time,
+) ([]string, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_sessions").
+		Set("status", entity.SessionStatusRevoked).
+		Set("revoked_at", revokedAt).
+		Set("revoked_reason", reason).
+		Set("updated_at", revokedAt).
+		Where(sq.Eq{"user_id": userID, "status": entity.SessionStatusActive}).
+		Where(sq.Or{sq.Eq{"active_tenant_id": tenantIDs}, sq.Eq{"assumed_role_tenant_id": tenantIDs}}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	if err := r.db.SelectContext(ctx, &ids, query, args...); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 type RefreshTokenRepositoryImpl struct {
 	db *sqlx.DB
 }
@@ -383,13 +451,23 @@ func (r *RefreshTokenRepositoryImpl) Revoke(
 		Set("revoked_at", revokedAt).
 		Set("updated_at", revokedAt).
 		Set("replaced_by_token_id", replacedByTokenID).
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
 		ToSql()
 	if err != nil {
 		return err
 	}
-	_, err = r.db.ExecContext(ctx, query, args...)
-	return err
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return entity.ErrRefreshTokenRotated
+	}
+	return nil
 }
 
 func (r *RefreshTokenRepositoryImpl) RevokeBySession(ctx context.Context, sessionID string, revokedAt time.Time) error {
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+)
+
+func TestRefreshTokenRepository_RevokeIsWonByOneConcurrentRotation(t *testing.T) {
+	_, db := setupRepo(t)
+	ctx := context.Background()
+	params := UserRepoParams{Logger: nopLogger{}, DB: db}
+	sessions := NewSessionRepositoryImpl(params)
+	tokens := NewRefreshTokenRepositoryImpl(params)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	userID := insertUser(t, db, entity.User{Username: "neo", Email: "neo@mx.io"})
+	require.NoError(t, sessions.Create(ctx, entity.Session{
+		ID:        "session-1",
+		UserID:    userID,
+		Status:    entity.SessionStatusActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	require.NoError(t, tokens.Create(ctx, entity.RefreshToken{
+		ID:        "refresh-1",
+		SessionID: "session-1",
+		TokenHash: entity.HashToken("refresh-raw-token"),
+		ExpiresAt: now.Add(time.Hour),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
+	replacements := []string{"refresh-2", "refresh-3", "refresh-4", "refresh-5"}
+	errs := make([]error, len(replacements))
+	var wg sync.WaitGroup
+	for i := range replacements {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = tokens.Revoke(ctx, "refresh-1", now, &replacements[i])
+		}()
+	}
+	wg.Wait()
+
+	won := ""
+	for i, err := range errs {
+		if err == nil {
+			require.Empty(t, won, "only one rotation may revoke the token")
+			won = replacements[i]
+			continue
+		}
+		require.ErrorIs(t, err, entity.ErrRefreshTokenRotated)
+	}
+	require.NotEmpty(t, won)
+
+	stored, err := tokens.GetByTokenHash(ctx, entity.HashToken("refresh-raw-token"))
+	require.NoError(t, err)
+	require.NotNil(t, stored.ReplacedByTokenID)
+	assert.Equal(t, won, *stored.ReplacedByTokenID)
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.SigningKeyRepository = (*SigningKeyRepositoryImpl)(nil)
+
+type SigningKeyRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewSigningKeyRepositoryImpl(p UserRepoParams) *SigningKeyRepositoryImpl {
+	return &SigningKeyRepositoryImpl{db: p.DB}
+}
+
+func (r *SigningKeyRepositoryImpl) Create(ctx context.Context, key entity.SigningKey) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_signing_keys").
+		Columns("id", "algorithm", "private_key_pem", "created_at", "retired_at").
+		Values(key.ID, key.Algorithm, string(key.PrivateKeyPEM), key.CreatedAt, key.RetiredAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *SigningKeyRepositoryImpl) ListPublishable(
+	ctx context.Context,
+	retiredAfter time.Time,
+) ([]entity.SigningKey, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "algorithm", "private_key_pem", "created_at", "retired_at").
+		From("auth_signing_keys").
+		Where(sq.Or{
+			sq.Eq{"retired_at": nil},
+			sq.Gt{"retired_at": retiredAfter},
+		}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var rows []model.SigningKey
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	out := make([]entity.SigningKey, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.ToEntity())
+	}
+	return out, nil
+}
+
+func (r *SigningKeyRepositoryImpl) RetireBefore(ctx context.Context, createdBefore, retiredAt time.Time) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_signing_keys").
+		Set("retired_at", retiredAt).
+		Where(sq.Eq{"retired_at": nil}).
+		Where(sq.Lt{"created_at": createdBefore}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.UserIdentityRepository = (*UserIdentityRepositoryImpl)(nil)
+
+type UserIdentityRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewUserIdentityRepositoryImpl(p UserRepoParams) *UserIdentityRepositoryImpl {
+	return &UserIdentityRepositoryImpl{db: p.DB}
+}
+
+var userIdentityColumns = []string{
+	"provider", "subject", "user_id", "email", "created_at", "last_login_at",
+}
+
+func (r *UserIdentityRepositoryImpl) GetByProviderSubject(
+	ctx context.Context,
+	provider, subject string,
+) (*entity.UserIdentity, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(userIdentityColumns...).
+		From("auth_user_identities").
+		Where(sq.Eq{"provider": provider, "subject": subject}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out model.UserIdentity
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrIdentityNotLinked
+		}
+		return nil, err
+	}
+	return out.ToEntity(), nil
+}
+
+func (r *UserIdentityRepositoryImpl) Create(ctx context.Context, identity entity.UserIdentity) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_user_identities").
+		Columns(userIdentityColumns...).
+		Values(identity.Provider, identity.Subject, identity.UserID, identity.Email,
+			identity.CreatedAt, identity.LastLoginAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *UserIdentityRepositoryImpl) TouchLastLogin(
+	ctx context.Context,
+	provider, subject string,
+	at time.Time,
+) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_user_identities").
+		Set("last_login_at", at).
+		Where(sq.Eq{"provider": provider, "subject": subject}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
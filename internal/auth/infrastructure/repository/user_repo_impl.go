@@ -70,6 +70,7 @@ var userColumns = []string{
 	"dob",
 	"created_at",
 	"updated_at",
+	"email_verified_at",
 }
 
 var userDirectoryColumns = []string{
@@ -86,6 +87,7 @@ var userDirectoryColumns = []string{
 	"dob",
 	"created_at",
 	"updated_at",
+	"email_verified_at",
 }
 
 // -------------------- helpers --------------------
@@ -414,3 +416,27 @@ func (u *UserRepositoryImpl) UpdateById(id uint, user entity.User) error {
 	user.Id = id
 	return u.Update(user)
 }
+
+// MarkEmailVerified implements outputport.UserRepository.
+func (u *UserRepositoryImpl) MarkEmailVerified(ctx context.Context, id uint, email string, verifiedAt time.Time) error {
+	qb := psql.
+		Update("users").
+		Set("email_verified_at", verifiedAt).
+		Set("updated_at", verifiedAt).
+		Where(sq.Eq{"id": id, "email": email})
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := u.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
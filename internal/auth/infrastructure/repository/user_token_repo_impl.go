@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.UserTokenRepository = (*UserTokenRepositoryImpl)(nil)
+
+type UserTokenRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewUserTokenRepositoryImpl(p UserRepoParams) *UserTokenRepositoryImpl {
+	return &UserTokenRepositoryImpl{db: p.DB}
+}
+
+var userTokenColumns = []string{
+	"id", "user_id", "purpose", "token_hash", "email", "expires_at", "created_at", "used_at",
+}
+
+func (r *UserTokenRepositoryImpl) Create(ctx context.Context, token entity.UserToken) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_user_tokens").
+		Columns(userTokenColumns...).
+		Values(token.ID, token.UserID, token.Purpose, token.TokenHash, token.Email,
+			token.ExpiresAt, token.CreatedAt, token.UsedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *UserTokenRepositoryImpl) Consume(
+	ctx context.Context,
+	purpose, tokenHash string,
+	usedAt time.Time,
+) (*entity.UserToken, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_user_tokens").
+		Set("used_at", usedAt).
+		Where(sq.Eq{"purpose": purpose, "token_hash": tokenHash, "used_at": nil}).
+		Where(sq.Gt{"expires_at": usedAt}).
+		Suffix("RETURNING id, user_id, purpose, token_hash, email, expires_at, created_at, used_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out model.UserToken
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrUserTokenInvalid
+		}
+		return nil, err
+	}
+	return out.ToEntity(), nil
+}
+
+func (r *UserTokenRepositoryImpl) InvalidateByUser(
+	ctx context.Context,
+	userID uint,
+	purpose string,
+	usedAt time.Time,
+) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_user_tokens").
+		Set("used_at", usedAt).
+		Where(sq.Eq{"user_id": userID, "purpose": purpose, "used_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuannm99/podzone/internal/auth/domain/entity"
+	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/model"
+)
+
+var _ outputport.WebAuthnCredentialRepository = (*WebAuthnCredentialRepositoryImpl)(nil)
+
+type WebAuthnCredentialRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+func NewWebAuthnCredentialRepositoryImpl(p UserRepoParams) *WebAuthnCredentialRepositoryImpl {
+	return &WebAuthnCredentialRepositoryImpl{db: p.DB}
+}
+
+var webAuthnCredentialColumns = []string{
+	"id", "user_id", "credential_id", "public_key", "algorithm", "sign_count", "aaguid",
+	"transports_json", "name", "backup_eligible", "backup_state", "clone_detected_at",
+	"created_at", "last_used_at",
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) Create(ctx context.Context, credential entity.WebAuthnCredential) error {
+	transports := credential.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+	transportsJSON, err := json.Marshal(transports)
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("auth_webauthn_credentials").
+		Columns(webAuthnCredentialColumns...).
+		Values(credential.ID, credential.UserID, credential.CredentialID, credential.PublicKey,
+			credential.Algorithm, int64(credential.SignCount), credential.AAGUID, string(transportsJSON),
+			credential.Name, credential.BackupEligible, credential.BackupState, credential.CloneDetectedAt,
+			credential.CreatedAt, credential.LastUsedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrWebAuthnCredentialExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) GetByCredentialID(
+	ctx context.Context,
+	credentialID string,
+) (*entity.WebAuthnCredential, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(webAuthnCredentialColumns...).
+		From("auth_webauthn_credentials").
+		Where(sq.Eq{"credential_id": credentialID}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out model.WebAuthnCredential
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrWebAuthnCredentialNotFound
+		}
+		return nil, err
+	}
+	return out.ToEntity(), nil
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) ListByUser(
+	ctx context.Context,
+	userID uint,
+) ([]entity.WebAuthnCredential, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(webAuthnCredentialColumns...).
+		From("auth_webauthn_credentials").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var rows []model.WebAuthnCredential
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	out := make([]entity.WebAuthnCredential, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *row.ToEntity())
+	}
+	return out, nil
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) UpdateUsage(
+	ctx context.Context,
+	id string,
+	previousSignCount, signCount uint32,
+	backupState bool,
+	usedAt time.Time,
+) (bool, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_webauthn_credentials").
+		Set("sign_count", int64(signCount)).
+		Set("backup_state", backupState).
+		Set("last_used_at", usedAt).
+		Where(sq.Eq{"id": id, "sign_count": int64(previousSignCount), "clone_detected_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) MarkCloneDetected(ctx context.Context, id string, detectedAt time.Time) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("auth_webauthn_credentials").
+		Set("clone_detected_at", detectedAt).
+		Where(sq.Eq{"id": id, "clone_detected_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *WebAuthnCredentialRepositoryImpl) Delete(ctx context.Context, id string, userID uint) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("auth_webauthn_credentials").
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return entity.ErrWebAuthnCredentialNotFound
+	}
+	return nil
+}
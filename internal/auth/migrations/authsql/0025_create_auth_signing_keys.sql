@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS auth_signing_keys (
+  id TEXT PRIMARY KEY,
+  algorithm TEXT NOT NULL,
+  private_key_pem TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  retired_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_signing_keys_retired_at
+  ON auth_signing_keys(retired_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_auth_signing_keys_retired_at;
+DROP TABLE IF EXISTS auth_signing_keys;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS auth_user_identities (
+  provider TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  email TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_login_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_user_identities_user ON auth_user_identities (user_id);
+
+ALTER TABLE auth_sessions
+ADD COLUMN IF NOT EXISTS identity_provider TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auth_sessions
+DROP COLUMN IF EXISTS identity_provider;
+
+DROP INDEX IF EXISTS idx_auth_user_identities_user;
+DROP TABLE IF EXISTS auth_user_identities;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth_sessions
+ADD COLUMN IF NOT EXISTS revoked_reason TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE auth_audit_logs
+ADD COLUMN IF NOT EXISTS severity TEXT NOT NULL DEFAULT 'info';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auth_audit_logs
+DROP COLUMN IF EXISTS severity;
+
+ALTER TABLE auth_sessions
+DROP COLUMN IF EXISTS revoked_reason;
+-- +goose StatementEnd
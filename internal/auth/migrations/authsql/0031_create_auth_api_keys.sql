@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS auth_api_keys (
+  id TEXT PRIMARY KEY,
+  lookup_id TEXT NOT NULL UNIQUE,
+  key_hash TEXT NOT NULL,
+  name TEXT NOT NULL,
+  owner_type TEXT NOT NULL,
+  user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  tenant_id TEXT NOT NULL DEFAULT '',
+  service_principal TEXT NOT NULL DEFAULT '',
+  role_id BIGINT NOT NULL DEFAULT 0,
+  role_scope TEXT NOT NULL DEFAULT '',
+  role_name TEXT NOT NULL DEFAULT '',
+  role_tenant_id TEXT NOT NULL DEFAULT '',
+  session_policy_json TEXT NOT NULL DEFAULT '[]',
+  expires_at TIMESTAMPTZ NOT NULL,
+  last_used_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  revoked_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_api_keys_user ON auth_api_keys (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_auth_api_keys_user;
+DROP TABLE IF EXISTS auth_api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS auth_webauthn_credentials (
+  id TEXT PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  credential_id TEXT NOT NULL UNIQUE,
+  public_key BYTEA NOT NULL,
+  algorithm BIGINT NOT NULL,
+  sign_count BIGINT NOT NULL DEFAULT 0,
+  aaguid TEXT NOT NULL DEFAULT '',
+  transports_json TEXT NOT NULL DEFAULT '[]',
+  name TEXT NOT NULL DEFAULT '',
+  backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+  backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+  clone_detected_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_used_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_webauthn_credentials_user ON auth_webauthn_credentials (user_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_auth_webauthn_credentials_user;
+DROP TABLE IF EXISTS auth_webauthn_credentials;
+-- +goose StatementEnd
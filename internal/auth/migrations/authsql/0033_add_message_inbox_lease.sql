@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message_inbox
+ADD COLUMN IF NOT EXISTS lease_owner TEXT NOT NULL DEFAULT '';
+
+-- NULL on processing rows written before leases existed; Begin treats them as expired.
+ALTER TABLE message_inbox
+ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_message_inbox_completed_processed_at
+  ON message_inbox(processed_at)
+  WHERE status = 'completed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_message_inbox_completed_processed_at;
+
+ALTER TABLE message_inbox
+DROP COLUMN IF EXISTS lease_expires_at;
+
+ALTER TABLE message_inbox
+DROP COLUMN IF EXISTS lease_owner;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A dead row holds back later rows with its message key until it is redriven, so the per-key
+-- ordering check in ClaimPending looks at dead rows too.
+DROP INDEX IF EXISTS idx_message_outbox_pending_key;
+
+CREATE INDEX IF NOT EXISTS idx_message_outbox_blocking_key
+  ON message_outbox (message_key, created_at, id)
+  WHERE status IN ('pending', 'dead');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_message_outbox_blocking_key;
+
+CREATE INDEX IF NOT EXISTS idx_message_outbox_pending_key
+  ON message_outbox (message_key, created_at, id)
+  WHERE status = 'pending';
+-- +goose StatementEnd
@@ -14,8 +14,11 @@ import (
 	"github.com/tuannm99/podzone/internal/auth/domain/inputport"
 	"github.com/tuannm99/podzone/internal/auth/domain/outputport"
 	"github.com/tuannm99/podzone/internal/auth/infrastructure/iamclient"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/mailer"
+	"github.com/tuannm99/podzone/internal/auth/infrastructure/oidc"
 	"github.com/tuannm99/podzone/internal/auth/infrastructure/repository"
 	"github.com/tuannm99/podzone/internal/auth/migrations"
+	"github.com/tuannm99/podzone/pkg/messaging"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdsql"
 )
@@ -33,10 +36,30 @@ var Module = fx.Options(
 		fx.Annotate(iamclient.NewTenantAccessChecker, fx.As(new(outputport.TenantAccessChecker))),
 		fx.Annotate(iamclient.NewRoleAssumer, fx.As(new(outputport.RoleAssumer))),
 		fx.Annotate(iamclient.NewAccountBootstrapper, fx.As(new(outputport.AccountBootstrapper))),
+		fx.Annotate(iamclient.NewSAMLConnectionResolver, fx.As(new(outputport.SAMLConnectionResolver))),
+		fx.Annotate(repository.NewSigningKeyRepositoryImpl, fx.As(new(outputport.SigningKeyRepository))),
+		fx.Annotate(repository.NewMFARepositoryImpl, fx.As(new(outputport.MFARepository))),
+		fx.Annotate(repository.NewWebAuthnCredentialRepositoryImpl, fx.As(new(outputport.WebAuthnCredentialRepository))),
+		fx.Annotate(repository.NewUserTokenRepositoryImpl, fx.As(new(outputport.UserTokenRepository))),
+		fx.Annotate(repository.NewAPIKeyRepositoryImpl, fx.As(new(outputport.APIKeyRepository))),
+		fx.Annotate(repository.NewUserIdentityRepositoryImpl, fx.As(new(outputport.UserIdentityRepository))),
+		fx.Annotate(oidc.NewRegistry, fx.As(new(outputport.OIDCProviderRegistry))),
+		fx.Annotate(repository.NewLoginAttemptRepositoryImpl, fx.As(new(outputport.LoginAttemptRepository))),
+		fx.Annotate(iamclient.NewPlatformAuthorizer, fx.As(new(outputport.PlatformAuthorizer))),
+		fx.Annotate(repository.NewOutboxStore, fx.As(new(messaging.OutboxStore))),
+		mailer.NewMailer,
 
-		fx.Annotate(domain.NewTokenUsecase, fx.As(new(inputport.TokenUsecase))),
+		domain.NewSigningKeyring,
+		domain.NewAccessTokenVerifier,
+		func(k *domain.SigningKeyring) inputport.SigningKeyUsecase { return k },
+		fx.Annotate(domain.NewTokenUsecaseFor, fx.As(new(inputport.TokenUsecase))),
 		fx.Annotate(domain.NewUserUsecase, fx.As(new(inputport.UserUsecase))),
 		fx.Annotate(domain.NewAuthUsecase, fx.As(new(inputport.AuthUsecase))),
+		fx.Annotate(domain.NewAccountUsecase, fx.As(new(inputport.AccountUsecase))),
+		fx.Annotate(domain.NewAPIKeyUsecase, fx.As(new(inputport.APIKeyUsecase))),
+		domain.NewSecurityEvents,
+		domain.NewLoginThrottle,
+		func(t *domain.LoginThrottle) inputport.LoginThrottleUsecase { return t },
 	),
 )
 
@@ -1,11 +1,19 @@
 package auth
 
 import (
+	"context"
+
+	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 
+	"github.com/tuannm99/podzone/internal/auth/config"
 	"github.com/tuannm99/podzone/internal/auth/controller/grpchandler"
+	"github.com/tuannm99/podzone/internal/auth/controller/httphandler"
+	"github.com/tuannm99/podzone/internal/auth/domain"
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 )
 
@@ -13,10 +21,15 @@ var ServerModule = fx.Options(
 	Module,
 	fx.Provide(
 		grpchandler.NewAuthServer,
+		httphandler.NewJWKSHandler,
+		httphandler.NewAPIKeyIntrospectionHandler,
+		httphandler.NewSAMLHandler,
+		fx.Annotate(RegisterHTTPRoutes, fx.ResultTags(`group:"gin-routes"`)),
 	),
 	fx.Invoke(
 		RegisterGRPCServer,
 		RegisterMigration,
+		WarmSigningKeyring,
 	),
 )
 
@@ -24,3 +37,33 @@ func RegisterGRPCServer(server *grpc.Server, authServer *grpchandler.AuthServer,
 	logger.Info("Registering Auth GRPC handler")
 	pbauthv1.RegisterAuthServiceServer(server, authServer)
 }
+
+func RegisterHTTPRoutes(
+	jwks *httphandler.JWKSHandler,
+	apiKeys *httphandler.APIKeyIntrospectionHandler,
+	saml *httphandler.SAMLHandler,
+	logger pdlog.Logger,
+) pdhttp.RouteRegistrar {
+	logger.Info("Registering Auth HTTP handler")
+	return func(r *gin.Engine) {
+		jwks.RegisterRoutes(r)
+		apiKeys.RegisterRoutes(r)
+		saml.RegisterRoutes(r)
+	}
+}
+
+// WarmSigningKeyring creates the first signing key at startup so the JWKS document is never
+// empty when verifiers first fetch it. Failure is not fatal: signing retries on first use.
+func WarmSigningKeyring(lc fx.Lifecycle, keyring *domain.SigningKeyring, cfg config.AuthConfig, logger pdlog.Logger) {
+	if cfg.Signing.Algorithm == pdauthn.AlgorithmHS256 {
+		return
+	}
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if _, err := keyring.CurrentKey(ctx); err != nil {
+				logger.Warn("Failed to load access-token signing key", "err", err)
+			}
+			return nil
+		},
+	})
+}
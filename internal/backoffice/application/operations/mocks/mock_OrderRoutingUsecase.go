@@ -861,6 +861,74 @@ func (_c *MockOrderRoutingUsecase_OpenOrderException_Call) RunAndReturn(run func
 	return _c
 }
 
+// ReceivePlacedOrder provides a mock function for the type MockOrderRoutingUsecase
+func (_mock *MockOrderRoutingUsecase) ReceivePlacedOrder(ctx context.Context, cmd order.ReceivePlacedOrderCmd) ([]routing.RoutedOrder, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceivePlacedOrder")
+	}
+
+	var r0 []routing.RoutedOrder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, order.ReceivePlacedOrderCmd) ([]routing.RoutedOrder, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, order.ReceivePlacedOrderCmd) []routing.RoutedOrder); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]routing.RoutedOrder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, order.ReceivePlacedOrderCmd) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRoutingUsecase_ReceivePlacedOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceivePlacedOrder'
+type MockOrderRoutingUsecase_ReceivePlacedOrder_Call struct {
+	*mock.Call
+}
+
+// ReceivePlacedOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd order.ReceivePlacedOrderCmd
+func (_e *MockOrderRoutingUsecase_Expecter) ReceivePlacedOrder(ctx interface{}, cmd interface{}) *MockOrderRoutingUsecase_ReceivePlacedOrder_Call {
+	return &MockOrderRoutingUsecase_ReceivePlacedOrder_Call{Call: _e.mock.On("ReceivePlacedOrder", ctx, cmd)}
+}
+
+func (_c *MockOrderRoutingUsecase_ReceivePlacedOrder_Call) Run(run func(ctx context.Context, cmd order.ReceivePlacedOrderCmd)) *MockOrderRoutingUsecase_ReceivePlacedOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 order.ReceivePlacedOrderCmd
+		if args[1] != nil {
+			arg1 = args[1].(order.ReceivePlacedOrderCmd)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRoutingUsecase_ReceivePlacedOrder_Call) Return(routedOrders []routing.RoutedOrder, err error) *MockOrderRoutingUsecase_ReceivePlacedOrder_Call {
+	_c.Call.Return(routedOrders, err)
+	return _c
+}
+
+func (_c *MockOrderRoutingUsecase_ReceivePlacedOrder_Call) RunAndReturn(run func(ctx context.Context, cmd order.ReceivePlacedOrderCmd) ([]routing.RoutedOrder, error)) *MockOrderRoutingUsecase_ReceivePlacedOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecommendRoutedOrderPartner provides a mock function for the type MockOrderRoutingUsecase
 func (_mock *MockOrderRoutingUsecase) RecommendRoutedOrderPartner(ctx context.Context, query routing.RecommendRoutedOrderPartnerQuery) (*routing.RoutedOrderRecommendation, error) {
 	ret := _mock.Called(ctx, query)
@@ -2,6 +2,7 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -92,17 +93,142 @@ func (i *OrderInteractor) CreateCustomerOrder(
 	if err != nil {
 		return nil, err
 	}
+	orderID, err := i.ids.NewID("order")
+	if err != nil {
+		return nil, err
+	}
+	return i.receiveCustomerOrder(ctx, partners, customerOrderLine{
+		ID:               orderID.String(),
+		StoreID:          storeID,
+		Candidate:        candidate,
+		Quantity:         cmd.Quantity,
+		CustomerName:     cmd.CustomerName,
+		ProductType:      cmd.ProductType,
+		ShipRegion:       cmd.ShipRegion,
+		PreferredPartner: cmd.PreferredPartner,
+	})
+}
+
+// ReceivePlacedOrder routes every line of a storefront order as its own routed order.
+// Lines are keyed by source order and item id, so a redelivered event skips lines
+// that were already routed instead of duplicating them.
+func (i *OrderInteractor) ReceivePlacedOrder(
+	ctx context.Context,
+	cmd orderctx.ReceivePlacedOrderCmd,
+) ([]routingctx.RoutedOrder, error) {
+	storeID, err := routingctx.RequiredStoreScope(ctx, cmd.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	sourceOrderID := strings.TrimSpace(cmd.SourceOrderID)
+	if sourceOrderID == "" {
+		return nil, orderctx.ErrOrderIDRequired
+	}
+	if len(cmd.Items) == 0 {
+		return nil, orderctx.ErrPlacedOrderItemsRequired
+	}
+	// Totals are booked as BookingCurrency strings; another currency would be relabelled, not converted.
+	if !strings.EqualFold(strings.TrimSpace(cmd.Currency), routingctx.BookingCurrency) {
+		return nil, fmt.Errorf("%w: %q", orderctx.ErrPlacedOrderCurrencyUnsupported, cmd.Currency)
+	}
+	candidates, err := i.products.ListCandidates(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	bySKU := make(map[string]*catalogctx.ProductSetupCandidate, len(candidates))
+	for idx := range candidates {
+		candidate := &candidates[idx]
+		sku := strings.ToLower(strings.TrimSpace(candidate.SKU))
+		if sku == "" || candidate.Status != catalogctx.ProductSetupCandidateStatusPublishedMock {
+			continue
+		}
+		bySKU[sku] = candidate
+	}
+	// Match every line before routing any so an unknown SKU does not leave the order half routed.
+	lines := make([]customerOrderLine, 0, len(cmd.Items))
+	for _, item := range cmd.Items {
+		candidate, ok := bySKU[strings.ToLower(strings.TrimSpace(item.SKU))]
+		if !ok {
+			return nil, fmt.Errorf("%w: sku %q", orderctx.ErrPlacedOrderSKUUnmatched, item.SKU)
+		}
+		lines = append(lines, customerOrderLine{
+			ID:               placedOrderLineID(sourceOrderID, item.ItemID),
+			StoreID:          storeID,
+			Candidate:        candidate,
+			Quantity:         item.Quantity,
+			Total:            routingctx.FormatMoney(item.Total),
+			CustomerName:     cmd.CustomerName,
+			ProductType:      item.ProductType,
+			ShipRegion:       cmd.ShipRegion,
+			PreferredPartner: cmd.PreferredPartner,
+			SourceOrder:      strings.TrimSpace(cmd.OrderNumber),
+		})
+	}
+
+	tenantID, err := toolkit.GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	partners, err := i.partners.ListActivePartners(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	routed := make([]routingctx.RoutedOrder, 0, len(lines))
+	for _, line := range lines {
+		existing, err := i.orders.GetByID(ctx, line.ID)
+		switch {
+		case err == nil:
+			routed = append(routed, *existing)
+			continue
+		case !errors.Is(err, routingctx.ErrRoutedOrderNotFound):
+			return nil, err
+		}
+		order, err := i.receiveCustomerOrder(ctx, partners, line)
+		if err != nil {
+			return nil, err
+		}
+		routed = append(routed, *order)
+	}
+	return routed, nil
+}
+
+func placedOrderLineID(sourceOrderID, itemID string) string {
+	return "order_" + sourceOrderID + "_" + strings.TrimSpace(itemID)
+}
+
+type customerOrderLine struct {
+	ID        string
+	StoreID   string
+	Candidate *catalogctx.ProductSetupCandidate
+	Quantity  int
+	// Total is the charged line total; empty prices the line at the candidate retail price.
+	Total            string
+	CustomerName     string
+	ProductType      string
+	ShipRegion       string
+	PreferredPartner string
+	// SourceOrder is the storefront order number the line was placed under, if any.
+	SourceOrder string
+}
+
+func (i *OrderInteractor) receiveCustomerOrder(
+	ctx context.Context,
+	partners []routingctx.PartnerRoutingProfile,
+	line customerOrderLine,
+) (*routingctx.RoutedOrder, error) {
+	candidate := line.Candidate
+	storeID := line.StoreID
 	recommendation := routingctx.BuildRoutingRecommendation(
 		candidate,
 		partners,
-		routingctx.NormalizeRoutingLabel(cmd.ProductType),
-		routingctx.NormalizeRoutingLabel(cmd.ShipRegion),
-		strings.TrimSpace(cmd.PreferredPartner),
+		routingctx.NormalizeRoutingLabel(line.ProductType),
+		routingctx.NormalizeRoutingLabel(line.ShipRegion),
+		strings.TrimSpace(line.PreferredPartner),
 		i.clock.Now(),
 	)
 	selectedOption := routingctx.FindSelectedRoutingOption(recommendation)
 
-	qty := cmd.Quantity
+	qty := line.Quantity
 	if qty < 1 {
 		qty = 1
 	}
@@ -114,11 +240,15 @@ func (i *OrderInteractor) CreateCustomerOrder(
 	fulfillmentCost := "TBD"
 	shippingCost := "TBD"
 	estimatedMargin := "TBD"
+	total := strings.TrimSpace(line.Total)
+	if total == "" {
+		total = routingctx.MultiplyMoney(candidate.RetailPrice, qty)
+	}
 	if selectedOption != nil {
 		fulfillmentCost = routingctx.MultiplyMoney(selectedOption.EstimatedFulfillmentCost, qty)
 		shippingCost = selectedOption.EstimatedShippingCost
 		estimatedMargin = routingctx.CalculateMargin(
-			routingctx.MultiplyMoney(candidate.RetailPrice, qty),
+			total,
 			fulfillmentCost,
 			shippingCost,
 			"$0.00",
@@ -129,19 +259,14 @@ func (i *OrderInteractor) CreateCustomerOrder(
 		routingBlockCode = recommendation.BlockedReasonCode
 		routingBlockReason = recommendation.BlockedReason
 	}
-	total := routingctx.MultiplyMoney(candidate.RetailPrice, qty)
-	orderID, err := i.ids.NewID("order")
-	if err != nil {
-		return nil, err
-	}
 	customerOrder, changes, err := orderctx.ReceiveCustomerOrder(orderctx.ReceiveCustomerOrderInput{
-		ID:                 orderID.String(),
+		ID:                 line.ID,
 		StoreID:            storeID,
 		CandidateID:        candidate.ID,
 		ProductTitle:       candidate.Title,
 		Quantity:           qty,
 		Total:              total,
-		CustomerName:       cmd.CustomerName,
+		CustomerName:       line.CustomerName,
 		Partner:            partner,
 		RoutingBlockCode:   routingBlockCode,
 		RoutingBlockReason: routingBlockReason,
@@ -206,6 +331,7 @@ func (i *OrderInteractor) CreateCustomerOrder(
 					"status", orderSnapshot.Status,
 					"product_type", recommendation.ProductType,
 					"ship_region", recommendation.ShipRegion,
+					"source_order", line.SourceOrder,
 				),
 			),
 			routingctx.NewActivity(
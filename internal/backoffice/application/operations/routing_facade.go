@@ -87,6 +87,13 @@ func (i *OrderRoutingInteractor) CreateCustomerOrder(
 	return i.orderOperations.CreateCustomerOrder(ctx, cmd)
 }
 
+func (i *OrderRoutingInteractor) ReceivePlacedOrder(
+	ctx context.Context,
+	cmd orderctx.ReceivePlacedOrderCmd,
+) ([]routingctx.RoutedOrder, error) {
+	return i.orderOperations.ReceivePlacedOrder(ctx, cmd)
+}
+
 func (i *OrderRoutingInteractor) CreateRoutedOrder(
 	ctx context.Context,
 	cmd CreateRoutedOrderCmd,
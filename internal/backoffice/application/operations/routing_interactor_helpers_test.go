@@ -41,6 +41,8 @@ type (
 	RecommendRoutedOrderPartnerQuery = routingctx.RecommendRoutedOrderPartnerQuery
 	BulkUpdateRoutedOrdersCmd        = operations.BulkUpdateRoutedOrdersCmd
 	CreateRoutedOrderCmd             = operations.CreateRoutedOrderCmd
+	ReceivePlacedOrderCmd            = orderctx.ReceivePlacedOrderCmd
+	PlacedOrderItem                  = orderctx.PlacedOrderItem
 	ForceRerouteBlockedOrderCmd      = routingctx.ForceRerouteBlockedOrderCmd
 	OpenOrderExceptionCmd            = exceptionctx.OpenOrderExceptionCmd
 	UpdateOrderExceptionStatusCmd    = operations.UpdateOrderExceptionStatusCmd
@@ -243,7 +245,7 @@ func newOrderRoutingTestInteractor(
 		RunAndReturn(func(_ context.Context, id string) (*RoutedOrder, error) {
 			order, ok := orderState.orders[id]
 			if !ok {
-				return nil, nil
+				return nil, routingctx.ErrRoutedOrderNotFound
 			}
 			cloned := cloneOrder(order)
 			return &cloned, nil
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	catalogentity "github.com/tuannm99/podzone/internal/backoffice/domain/catalog"
+	orderctx "github.com/tuannm99/podzone/internal/backoffice/domain/order"
 )
 
 func TestCreateRoutedOrderSnapshotsCostsAndMargin(t *testing.T) {
@@ -182,6 +183,99 @@ func TestCreateRoutedOrderCreatesBlockedQueueItemWhenNoViablePartner(t *testing.
 	require.True(t, hasActivityDetail(lastActivity.Details, "routing_block_code", "negative_margin"))
 }
 
+func TestReceivePlacedOrderRoutesEachLineBySKUAndSkipsRedelivery(t *testing.T) {
+	t.Parallel()
+
+	interactor, orders := newOrderRoutingTestInteractor(t, map[string]catalogentity.ProductSetupCandidate{
+		"cand-1": {
+			ID:          "cand-1",
+			Title:       "Vintage Tee",
+			SKU:         "TEE-001",
+			Partner:     "Print Partner A",
+			BaseCost:    "$8.00",
+			RetailPrice: "$20.00",
+			Status:      catalogentity.ProductSetupCandidateStatusPublishedMock,
+		},
+	})
+
+	ctx := testTenantRoutingContext()
+	cmd := ReceivePlacedOrderCmd{
+		SourceOrderID: "src-1",
+		OrderNumber:   "PZ-20261018-ABCDEFGH",
+		CustomerName:  "Ada Lovelace",
+		ShipRegion:    "us",
+		Currency:      "USD",
+		Items: []PlacedOrderItem{
+			{ItemID: "item-1", SKU: "tee-001", Name: "Vintage Tee", ProductType: "tshirt", Quantity: 2, Total: 36},
+		},
+	}
+	routed, err := interactor.ReceivePlacedOrder(ctx, cmd)
+	require.NoError(t, err)
+	require.Len(t, routed, 1)
+	require.Equal(t, "order_src-1_item-1", routed[0].ID)
+	require.Equal(t, "cand-1", routed[0].CandidateID)
+	require.Equal(t, "Ada Lovelace", routed[0].CustomerName)
+	require.Equal(t, "$36.00", routed[0].Total)
+	require.Equal(t, "$20.00", routed[0].RealizedMargin)
+	require.True(t, hasActivityDetail(routed[0].ActivityLog[0].Details, "source_order", "PZ-20261018-ABCDEFGH"))
+
+	routed[0].OperatorAssignee = "ops-1"
+	orders.mustSeed(routed[0])
+	again, err := interactor.ReceivePlacedOrder(ctx, cmd)
+	require.NoError(t, err)
+	require.Len(t, again, 1)
+	require.Equal(t, "ops-1", again[0].OperatorAssignee)
+}
+
+func TestReceivePlacedOrderRejectsUnmatchedSKUBeforeRouting(t *testing.T) {
+	t.Parallel()
+
+	interactor, orders := newOrderRoutingTestInteractor(t, map[string]catalogentity.ProductSetupCandidate{
+		"cand-1": {
+			ID:          "cand-1",
+			Title:       "Vintage Tee",
+			SKU:         "TEE-001",
+			BaseCost:    "$8.00",
+			RetailPrice: "$20.00",
+			Status:      catalogentity.ProductSetupCandidateStatusPublishedMock,
+		},
+	})
+
+	_, err := interactor.ReceivePlacedOrder(testTenantRoutingContext(), ReceivePlacedOrderCmd{
+		SourceOrderID: "src-2",
+		Currency:      "usd",
+		Items: []PlacedOrderItem{
+			{ItemID: "item-1", SKU: "TEE-001", Quantity: 1, Total: 20},
+			{ItemID: "item-2", SKU: "MUG-404", Quantity: 1, Total: 12},
+		},
+	})
+	require.ErrorIs(t, err, orderctx.ErrPlacedOrderSKUUnmatched)
+	require.Empty(t, orders.orders)
+}
+
+func TestReceivePlacedOrderRejectsOtherCurrencyBeforeRouting(t *testing.T) {
+	t.Parallel()
+
+	interactor, orders := newOrderRoutingTestInteractor(t, map[string]catalogentity.ProductSetupCandidate{
+		"cand-1": {
+			ID:          "cand-1",
+			Title:       "Vintage Tee",
+			SKU:         "TEE-001",
+			BaseCost:    "$8.00",
+			RetailPrice: "$20.00",
+			Status:      catalogentity.ProductSetupCandidateStatusPublishedMock,
+		},
+	})
+
+	_, err := interactor.ReceivePlacedOrder(testTenantRoutingContext(), ReceivePlacedOrderCmd{
+		SourceOrderID: "src-3",
+		Currency:      "EUR",
+		Items:         []PlacedOrderItem{{ItemID: "item-1", SKU: "TEE-001", Quantity: 1, Total: 20}},
+	})
+	require.ErrorIs(t, err, orderctx.ErrPlacedOrderCurrencyUnsupported)
+	require.Empty(t, orders.orders)
+}
+
 func TestForceRerouteBlockedOrderClearsBlockAndQueuesOrder(t *testing.T) {
 	t.Parallel()
 
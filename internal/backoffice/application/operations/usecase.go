@@ -46,6 +46,10 @@ type OrderRoutingUsecase interface {
 		cmd orderctx.CreateCustomerOrderCmd,
 	) (*routingctx.RoutedOrder, error)
 	CreateRoutedOrder(ctx context.Context, cmd CreateRoutedOrderCmd) (*routingctx.RoutedOrder, error)
+	ReceivePlacedOrder(
+		ctx context.Context,
+		cmd orderctx.ReceivePlacedOrderCmd,
+	) ([]routingctx.RoutedOrder, error)
 	AdvanceCustomerOrder(
 		ctx context.Context,
 		cmd orderctx.AdvanceCustomerOrderCmd,
@@ -3,9 +3,12 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/knadh/koanf/v2"
 
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
@@ -14,13 +17,29 @@ type Config struct {
 	IAM                  RPCConfig `mapstructure:"iam"`
 	Partner              RPCConfig `mapstructure:"partner"`
 	InternalServiceToken string    `mapstructure:"internal_service_token"`
+	// TrustedProxies, normally the edge proxy, may forward the client IP and transport that
+	// backoffice passes on to IAM permission checks. Loaded by pdproxy.LoadTrustedProxies.
+	TrustedProxies pdproxy.TrustedProxies `mapstructure:"-"`
 }
 
 type RPCConfig struct {
 	JWTSecret string `mapstructure:"jwt_secret"`
 	JWTKey    string `mapstructure:"jwt_key"`
+	JWKSURL   string `mapstructure:"jwks_url"`
 	GRPCHost  string `mapstructure:"grpc_host"`
 	GRPCPort  string `mapstructure:"grpc_port"`
+	// LegacyHS256Until is loaded by pdauthn.LoadLegacyHS256Until, not unmarshaled.
+	LegacyHS256Until time.Time `mapstructure:"-"`
+}
+
+// Authn returns the access-token verification settings carried by this client config.
+func (c RPCConfig) Authn() pdauthn.Config {
+	return pdauthn.Config{
+		JWTSecret:        c.JWTSecret,
+		JWTKey:           c.JWTKey,
+		JWKSURL:          c.JWKSURL,
+		LegacyHS256Until: c.LegacyHS256Until,
+	}
 }
 
 func NewConfigFromKoanf(k *koanf.Koanf) (Config, error) {
@@ -42,6 +61,12 @@ func NewConfigFromKoanf(k *koanf.Koanf) (Config, error) {
 	if cfg.Auth.JWTKey == "" {
 		cfg.Auth.JWTKey = k.String("backoffice.auth.jwt_key")
 	}
+	if url := toolkit.GetEnv("JWKS_URL", ""); url != "" {
+		cfg.Auth.JWKSURL = url
+	} else if cfg.Auth.JWKSURL == "" {
+		cfg.Auth.JWKSURL = k.String("backoffice.auth.jwks_url")
+	}
+	cfg.Auth.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "backoffice.auth.legacy_hs256_until")
 	if cfg.Auth.GRPCHost == "" {
 		cfg.Auth.GRPCHost = k.String("backoffice.auth.grpc_host")
 	}
@@ -72,8 +97,9 @@ func NewConfigFromKoanf(k *koanf.Koanf) (Config, error) {
 	if cfg.Partner.GRPCPort == "" {
 		cfg.Partner.GRPCPort = k.String("backoffice.partner.grpc_port")
 	}
-	if cfg.Auth.JWTSecret == "" {
-		return cfg, fmt.Errorf("missing config: backoffice.auth.jwt_secret")
+	cfg.TrustedProxies = pdproxy.LoadTrustedProxies(k, "backoffice.trusted_proxies")
+	if cfg.Auth.JWTSecret == "" && cfg.Auth.JWKSURL == "" {
+		return cfg, fmt.Errorf("missing config: backoffice.auth.jwt_secret or backoffice.auth.jwks_url")
 	}
 	if cfg.Auth.GRPCHost == "" {
 		cfg.Auth.GRPCHost = "localhost"
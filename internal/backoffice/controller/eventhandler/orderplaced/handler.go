@@ -0,0 +1,34 @@
+package orderplaced
+
+import (
+	"context"
+	"errors"
+
+	backofficeoperations "github.com/tuannm99/podzone/internal/backoffice/application/operations"
+	"github.com/tuannm99/podzone/internal/backoffice/runtime/tenancy"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+func NewHandler(
+	orders backofficeoperations.OrderRoutingUsecase,
+	runtime tenancy.Runtime,
+) (messaging.Handler, error) {
+	registry, err := messaging.NewRegistryWithSchemas(
+		events.Schemas(),
+		NewOrderPlacedHandler(orders, runtime),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Routing only reacts to order.placed; the rest of order.* is expected on
+	// the same topic and must not error/retry/dead-letter.
+	return messaging.HandlerFunc(func(ctx context.Context, msg messaging.Envelope) error {
+		err := registry.Handle(ctx, msg)
+		if errors.Is(err, messaging.ErrHandlerNotFound) {
+			return nil
+		}
+		return err
+	}), nil
+}
@@ -0,0 +1,149 @@
+package orderplaced
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	operationsmocks "github.com/tuannm99/podzone/internal/backoffice/application/operations/mocks"
+	orderctx "github.com/tuannm99/podzone/internal/backoffice/domain/order"
+	routingctx "github.com/tuannm99/podzone/internal/backoffice/domain/routing"
+	storectx "github.com/tuannm99/podzone/internal/backoffice/domain/store"
+	"github.com/tuannm99/podzone/internal/backoffice/runtime/scope"
+	"github.com/tuannm99/podzone/internal/backoffice/runtime/tenancy"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type fakeRuntime struct {
+	err error
+}
+
+func (f fakeRuntime) ResolveRequestScope(_ context.Context, tenantID, storeID string) (tenancy.RequestScope, error) {
+	if f.err != nil {
+		return tenancy.RequestScope{}, f.err
+	}
+	return tenancy.RequestScope{TenantID: tenantID, Store: &storectx.Store{ID: storeID}}, nil
+}
+
+func orderPlacedEnvelope(t *testing.T) messaging.Envelope {
+	t.Helper()
+	payload, err := json.Marshal(map[string]any{
+		"order_id":              "order-1",
+		"order_number":          "PZ-20261018-ABCDEFGH",
+		"tenant_id":             "tenant-1",
+		"store_id":              "store-1",
+		"customer_name":         "Ada Lovelace",
+		"ship_region":           "us",
+		"shipping_partner_code": "GHN",
+		"currency":              "USD",
+		"items": []map[string]any{{
+			"item_id":    "item-1",
+			"sku":        "TEE-001",
+			"name":       "Vintage Tee",
+			"quantity":   2,
+			"total":      36,
+			"attributes": map[string]string{"product_type": "tshirt"},
+		}},
+	})
+	require.NoError(t, err)
+	return messaging.Envelope{Type: "order.placed", TenantID: "tenant-1", Payload: payload}
+}
+
+func TestHandler_HandleUnknownEvent_NoOp(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{})
+	require.NoError(t, err)
+
+	err = handler.Handle(context.Background(), messaging.Envelope{Type: "order.cancelled"})
+	require.NoError(t, err)
+}
+
+func TestHandler_HandleOrderPlaced_InvalidPayloadDeadLetters(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{})
+	require.NoError(t, err)
+
+	err = handler.Handle(context.Background(), messaging.Envelope{
+		Type:    "order.placed",
+		Payload: []byte("{"),
+	})
+	require.Error(t, err)
+
+	classification := messaging.DefaultErrorClassifier().Classify(context.Background(), messaging.Envelope{}, err)
+	assert.Equal(t, messaging.FailureActionDeadLetter, classification.Action)
+	assert.Equal(t, "invalid order.placed payload", classification.Reason)
+}
+
+func TestHandler_HandleOrderPlaced_RoutesInStoreScope(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{})
+	require.NoError(t, err)
+
+	orders.EXPECT().
+		ReceivePlacedOrder(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cmd orderctx.ReceivePlacedOrderCmd) ([]routingctx.RoutedOrder, error) {
+			tenantID, err := toolkit.GetTenantID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "tenant-1", tenantID)
+			assert.Equal(t, "store-1", scope.CurrentStoreID(ctx))
+
+			assert.Equal(t, "order-1", cmd.SourceOrderID)
+			assert.Equal(t, "GHN", cmd.PreferredPartner)
+			assert.Equal(t, "USD", cmd.Currency)
+			require.Len(t, cmd.Items, 1)
+			assert.Equal(t, "TEE-001", cmd.Items[0].SKU)
+			assert.Equal(t, "tshirt", cmd.Items[0].ProductType)
+			assert.Equal(t, 2, cmd.Items[0].Quantity)
+			return []routingctx.RoutedOrder{{ID: "order_order-1_item-1"}}, nil
+		}).
+		Once()
+
+	require.NoError(t, handler.Handle(context.Background(), orderPlacedEnvelope(t)))
+}
+
+func TestHandler_HandleOrderPlaced_UnmatchedSKUDeadLetters(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{})
+	require.NoError(t, err)
+
+	orders.EXPECT().
+		ReceivePlacedOrder(mock.Anything, mock.Anything).
+		Return(nil, orderctx.ErrPlacedOrderSKUUnmatched).
+		Once()
+
+	err = handler.Handle(context.Background(), orderPlacedEnvelope(t))
+	classification := messaging.DefaultErrorClassifier().Classify(context.Background(), messaging.Envelope{}, err)
+	assert.Equal(t, messaging.FailureActionDeadLetter, classification.Action)
+}
+
+func TestHandler_HandleOrderPlaced_UnsupportedCurrencyDeadLetters(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{})
+	require.NoError(t, err)
+
+	orders.EXPECT().
+		ReceivePlacedOrder(mock.Anything, mock.Anything).
+		Return(nil, orderctx.ErrPlacedOrderCurrencyUnsupported).
+		Once()
+
+	err = handler.Handle(context.Background(), orderPlacedEnvelope(t))
+	classification := messaging.DefaultErrorClassifier().Classify(context.Background(), messaging.Envelope{}, err)
+	assert.Equal(t, messaging.FailureActionDeadLetter, classification.Action)
+}
+
+func TestHandler_HandleOrderPlaced_ScopeFailureRetries(t *testing.T) {
+	orders := operationsmocks.NewMockOrderRoutingUsecase(t)
+	handler, err := NewHandler(orders, fakeRuntime{err: errors.New("tenant db down")})
+	require.NoError(t, err)
+
+	err = handler.Handle(context.Background(), orderPlacedEnvelope(t))
+	classification := messaging.DefaultErrorClassifier().Classify(context.Background(), messaging.Envelope{}, err)
+	assert.Equal(t, messaging.FailureActionRetry, classification.Action)
+	assert.Equal(t, "tenant scope unavailable", classification.Reason)
+}
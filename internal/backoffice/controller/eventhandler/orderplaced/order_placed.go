@@ -0,0 +1,112 @@
+package orderplaced
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	backofficeoperations "github.com/tuannm99/podzone/internal/backoffice/application/operations"
+	orderctx "github.com/tuannm99/podzone/internal/backoffice/domain/order"
+	"github.com/tuannm99/podzone/internal/backoffice/runtime/scope"
+	"github.com/tuannm99/podzone/internal/backoffice/runtime/tenancy"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type OrderPlacedHandler struct {
+	orders  backofficeoperations.OrderRoutingUsecase
+	runtime tenancy.Runtime
+}
+
+func NewOrderPlacedHandler(
+	orders backofficeoperations.OrderRoutingUsecase,
+	runtime tenancy.Runtime,
+) *messaging.EventHandler[events.OrderPlacedV1] {
+	h := &OrderPlacedHandler{orders: orders, runtime: runtime}
+	return messaging.HandleEvent(events.TypeOrderPlaced, h.Handle)
+}
+
+func (h *OrderPlacedHandler) Handle(ctx context.Context, msg messaging.Envelope, payload events.OrderPlacedV1) error {
+	tenantID := strings.TrimSpace(payload.TenantID)
+	if tenantID == "" {
+		tenantID = strings.TrimSpace(msg.TenantID)
+	}
+	if tenantID == "" || strings.TrimSpace(payload.StoreID) == "" {
+		return messaging.DeadLetterError(
+			fmt.Errorf("order.placed %s has no tenant or store", payload.OrderID),
+			"order.placed without tenant or store",
+		)
+	}
+
+	ctx, err := h.scopedContext(ctx, tenantID, payload.StoreID)
+	if err != nil {
+		return messaging.RetryableError(
+			fmt.Errorf("resolve order.placed scope: %w", err),
+			"tenant scope unavailable",
+		)
+	}
+
+	cmd := orderctx.ReceivePlacedOrderCmd{
+		StoreID:          payload.StoreID,
+		SourceOrderID:    payload.OrderID,
+		OrderNumber:      payload.OrderNumber,
+		CustomerName:     payload.CustomerName,
+		ShipRegion:       payload.ShipRegion,
+		PreferredPartner: payload.ShippingPartnerCode,
+		Currency:         payload.Currency,
+		Items:            make([]orderctx.PlacedOrderItem, 0, len(payload.Items)),
+	}
+	for _, item := range payload.Items {
+		cmd.Items = append(cmd.Items, orderctx.PlacedOrderItem{
+			ItemID:      item.ItemID,
+			SKU:         item.SKU,
+			Name:        item.Name,
+			ProductType: item.Attributes["product_type"],
+			Quantity:    int(item.Quantity),
+			Total:       item.Total,
+		})
+	}
+	if _, err := h.orders.ReceivePlacedOrder(ctx, cmd); err != nil {
+		if errors.Is(err, orderctx.ErrPlacedOrderSKUUnmatched) ||
+			errors.Is(err, orderctx.ErrPlacedOrderItemsRequired) ||
+			errors.Is(err, orderctx.ErrPlacedOrderCurrencyUnsupported) ||
+			errors.Is(err, orderctx.ErrOrderIDRequired) {
+			return messaging.DeadLetterError(
+				fmt.Errorf("route order.placed: %w", err),
+				"order.placed cannot be routed",
+			)
+		}
+		return messaging.RetryableError(
+			fmt.Errorf("route order.placed: %w", err),
+			"routing store unavailable",
+		)
+	}
+	return nil
+}
+
+// scopedContext gives the consumer the same tenant and store scope the GraphQL
+// tenant middleware builds for an operator request.
+func (h *OrderPlacedHandler) scopedContext(
+	ctx context.Context,
+	tenantID, storeID string,
+) (context.Context, error) {
+	ctx = toolkit.WithTenantID(ctx, tenantID)
+	requestScope, err := h.runtime.ResolveRequestScope(ctx, tenantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	tenantCtx := scope.TenantContext{TenantID: requestScope.TenantID}
+	if requestScope.Placement != nil {
+		tenantCtx.ClusterName = requestScope.Placement.ClusterName
+		tenantCtx.DBName = requestScope.Placement.DBName
+		tenantCtx.SchemaName = requestScope.Placement.SchemaName
+		tenantCtx.PlacementMode = string(requestScope.Placement.Mode)
+	}
+	ctx = scope.WithTenantContext(ctx, tenantCtx)
+	if requestScope.Store != nil {
+		ctx = scope.WithStoreContext(ctx, scope.StoreContext{StoreID: requestScope.Store.ID})
+	}
+	return ctx, nil
+}
@@ -14,6 +14,28 @@ type CreateCustomerOrderCmd struct {
 	PreferredPartner string
 }
 
+// ReceivePlacedOrderCmd routes a storefront order placed through the order service.
+type ReceivePlacedOrderCmd struct {
+	StoreID          string
+	SourceOrderID    string
+	OrderNumber      string
+	CustomerName     string
+	ShipRegion       string
+	PreferredPartner string
+	// Currency is the ISO 4217 code the item totals are in.
+	Currency string
+	Items    []PlacedOrderItem
+}
+
+type PlacedOrderItem struct {
+	ItemID      string
+	SKU         string
+	Name        string
+	ProductType string
+	Quantity    int
+	Total       float64
+}
+
 type AdvanceCustomerOrderCmd struct {
 	StoreID string
 	OrderID string
@@ -13,7 +13,19 @@ var (
 		"ORDER_PRODUCT_TITLE_REQUIRED",
 		"customer order product title is required",
 	)
-	ErrQuantityInvalid       = ddd.NewDomainError("ORDER_QUANTITY_INVALID", "customer order quantity is invalid")
+	ErrQuantityInvalid          = ddd.NewDomainError("ORDER_QUANTITY_INVALID", "customer order quantity is invalid")
+	ErrPlacedOrderItemsRequired = ddd.NewDomainError(
+		"ORDER_PLACED_ITEMS_REQUIRED",
+		"placed order has no items to route",
+	)
+	ErrPlacedOrderSKUUnmatched = ddd.NewDomainError(
+		"ORDER_PLACED_SKU_UNMATCHED",
+		"placed order item does not match a published mock product candidate",
+	)
+	ErrPlacedOrderCurrencyUnsupported = ddd.NewDomainError(
+		"ORDER_PLACED_CURRENCY_UNSUPPORTED",
+		"placed order currency is not the backoffice booking currency",
+	)
 	ErrRoutingReasonRequired = ddd.NewDomainError(
 		"ORDER_ROUTING_REASON_REQUIRED",
 		"routing block reason is required when partner is empty",
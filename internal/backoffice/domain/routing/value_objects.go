@@ -5,6 +5,14 @@ import (
 	"strings"
 )
 
+// BookingCurrency is the currency every backoffice money string is denominated in.
+const BookingCurrency = "USD"
+
+// FormatMoney renders an amount in BookingCurrency.
+func FormatMoney(value float64) string {
+	return formatMoney(value)
+}
+
 func MultiplyMoney(raw string, qty int) string {
 	value, ok := parseMoney(raw)
 	if !ok {
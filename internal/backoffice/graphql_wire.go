@@ -64,7 +64,8 @@ func graphQLRegistrar(p gqlRegistrarParams) pdhttp.RouteRegistrar {
 		srv.Use(NewTenantMiddleware(p.BOCfg, p.Authz, p.Tenancy))
 
 		r.POST(p.Cfg.QueryPath, gin.HandlerFunc(func(c *gin.Context) {
-			srv.ServeHTTP(c.Writer, c.Request)
+			ctx := p.BOCfg.TrustedProxies.ForwardRequestClient(c.Request.Context(), c.Request)
+			srv.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
 		}))
 
 		if p.Cfg.Playground.Enabled {
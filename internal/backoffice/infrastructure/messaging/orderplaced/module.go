@@ -0,0 +1,61 @@
+package orderplaced
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	controller "github.com/tuannm99/podzone/internal/backoffice/controller/eventhandler/orderplaced"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+const (
+	runtimeConfigPath = "messaging.backoffice.consumers.order_placed"
+	consumerName      = "backoffice.order-placed"
+)
+
+// Module consumes order.placed into routed orders. Backoffice has no shared
+// SQL database for an inbox table; redelivery is absorbed by the deterministic
+// routed order ids instead.
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewRuntimeConfig, fx.ResultTags(`name:"backoffice-order-placed-runtime"`)),
+		fx.Annotate(
+			NewConsumerGroupRunner,
+			fx.ParamTags(`name:"kafka-backoffice-consumer-group-factory"`, `name:"kafka-backoffice-config"`),
+		),
+		fx.Annotate(
+			func(producer pdkafka.Producer) messaging.Publisher {
+				return messagingkafka.NewPublisher(producer)
+			},
+			fx.ParamTags(`name:"kafka-backoffice-producer"`),
+		),
+		fx.Annotate(
+			func(log pdlog.Logger, cfg messaging.ConsumerRuntimeConfig) messaging.Observer {
+				return messaging.NewLoggingObserver(log, consumerName, cfg)
+			},
+			fx.ParamTags(``, `name:"backoffice-order-placed-runtime"`),
+		),
+		controller.NewHandler,
+		fx.Annotate(
+			NewWorker,
+			fx.ParamTags(``, ``, ``, ``, `name:"backoffice-order-placed-runtime"`, ``),
+		),
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *Worker) {
+		pdworker.StartWorker(lc, logger, w)
+	}),
+)
+
+func NewRuntimeConfig(k *koanf.Koanf) messaging.ConsumerRuntimeConfig {
+	cfg := messaging.LoadConsumerRuntimeConfig(
+		k,
+		runtimeConfigPath,
+		messaging.DefaultConsumerRuntimeConfig(consumerName),
+	)
+	cfg.Idempotency.ConsumerName = consumerName
+	return cfg
+}
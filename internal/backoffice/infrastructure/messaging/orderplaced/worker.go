@@ -0,0 +1,77 @@
+package orderplaced
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+type Worker struct {
+	log      pdlog.Logger
+	runner   pdkafka.ConsumerGroupRunner
+	consumer *messagingkafka.Consumer
+	enabled  bool
+}
+
+func NewWorker(
+	log pdlog.Logger,
+	runner pdkafka.ConsumerGroupRunner,
+	publisher messaging.Publisher,
+	observer messaging.Observer,
+	cfg messaging.ConsumerRuntimeConfig,
+	handler messaging.Handler,
+) *Worker {
+	return &Worker{
+		log:     log,
+		runner:  runner,
+		enabled: cfg.Enabled,
+		consumer: messagingkafka.NewConsumerWithOptions(
+			runner,
+			messaging.TopicsWithRetry(messaging.EventTopic("order"), cfg.MaxAttempts),
+			handler,
+			messagingkafka.ConsumerOptions{
+				Publisher:        publisher,
+				RetryPolicy:      messaging.RetryPolicy{MaxAttempts: cfg.MaxAttempts, BaseDelay: cfg.BaseDelay},
+				DeadLetterPolicy: messaging.DeadLetterPolicy{Strategy: messaging.DefaultTopicStrategy()},
+				Classifier:       messaging.DefaultErrorClassifier(),
+				Observer:         observer,
+				ConsumerName:     cfg.Idempotency.ConsumerName,
+			},
+		),
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	if !w.enabled {
+		w.log.Info("Backoffice order placed worker disabled")
+		return
+	}
+
+	defer func() {
+		if err := w.runner.Close(); err != nil {
+			w.log.Error("Close backoffice order placed consumer failed", "error", err)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		if err := w.consumer.Run(ctx); err != nil && ctx.Err() == nil {
+			w.log.Error("Backoffice order placed consumer failed", "error", err)
+		}
+	}
+}
+
+func NewConsumerGroupRunner(
+	factory pdkafka.ConsumerGroupFactory,
+	cfg *pdkafka.Config,
+) (pdkafka.ConsumerGroupRunner, error) {
+	groupID := cfg.ConsumerGroupPrefix + ".order-placed"
+	group, err := factory.New(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("create backoffice order placed consumer group: %w", err)
+	}
+	return pdkafka.NewConsumerGroupRunner(group), nil
+}
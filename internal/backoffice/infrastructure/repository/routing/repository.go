@@ -6,7 +6,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -414,7 +413,7 @@ func (r *OrderRoutingRepositoryImpl) GetByID(ctx context.Context, id string) (*r
 		}
 		if err := tx.GetContext(ctx, &row, query, args...); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("routed order not found")
+				return routingctx.ErrRoutedOrderNotFound
 			}
 			return err
 		}
@@ -709,7 +708,7 @@ func (r *OrderRoutingRepositoryImpl) Update(
 		var existingActivityCount int
 		if err := tx.GetContext(ctx, &existingActivityCount, `SELECT COUNT(*) FROM routed_order_activities WHERE order_id = $1`, order.ID); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("routed order not found")
+				return routingctx.ErrRoutedOrderNotFound
 			}
 			return err
 		}
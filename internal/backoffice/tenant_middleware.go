@@ -7,36 +7,28 @@ import (
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/golang-jwt/jwt/v5"
 	boconfig "github.com/tuannm99/podzone/internal/backoffice/config"
 	"github.com/tuannm99/podzone/internal/backoffice/runtime/scope"
 	"github.com/tuannm99/podzone/internal/backoffice/runtime/tenancy"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 	"google.golang.org/grpc/metadata"
 )
 
 // TenantMiddleware is an app-level GraphQL extension.
 type TenantMiddleware struct {
-	authCfg    boconfig.RPCConfig
+	verifier   *pdauthn.Verifier
 	authorizer TenantAuthorizer
 	tenancy    tenancy.Runtime
 }
 
-type backofficeJWTClaims struct {
-	UserID         uint   `json:"user_id"`
-	ActiveTenantID string `json:"active_tenant_id"`
-	SessionID      string `json:"session_id"`
-	Key            string `json:"key"`
-	jwt.RegisteredClaims
-}
-
 func NewTenantMiddleware(
 	cfg boconfig.Config,
 	authorizer TenantAuthorizer,
 	tenancyRuntime tenancy.Runtime,
 ) *TenantMiddleware {
 	return &TenantMiddleware{
-		authCfg:    cfg.Auth,
+		verifier:   pdauthn.NewVerifier(cfg.Auth.Authn()),
 		authorizer: authorizer,
 		tenancy:    tenancyRuntime,
 	}
@@ -162,17 +154,8 @@ func (m *TenantMiddleware) identityFromAuthorization(header string) (string, str
 		return "", "", "", fmt.Errorf("authorization bearer token is required")
 	}
 
-	claims := &backofficeJWTClaims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(tok *jwt.Token) (interface{}, error) {
-		if tok.Method != jwt.SigningMethodHS256 {
-			return nil, fmt.Errorf("unexpected signing method")
-		}
-		return []byte(m.authCfg.JWTSecret), nil
-	})
-	if err != nil || !token.Valid {
-		return "", "", "", fmt.Errorf("invalid authorization token")
-	}
-	if m.authCfg.JWTKey != "" && claims.Key != m.authCfg.JWTKey {
+	claims, err := m.verifier.ClaimsFromTokenString(tokenStr)
+	if err != nil {
 		return "", "", "", fmt.Errorf("invalid authorization token")
 	}
 	if claims.UserID == 0 {
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+const (
+	defaultCartTTL         = 30 * 24 * time.Hour
+	defaultGuestCartTTL    = 7 * 24 * time.Hour
+	defaultCurrency        = "USD"
+	defaultMaxLineQuantity = 99
+)
+
+type GRPCConfig struct {
+	GRPCHost string `koanf:"grpc_host"`
+	GRPCPort string `koanf:"grpc_port"`
+}
+
+func (c GRPCConfig) Addr() string {
+	return c.GRPCHost + ":" + c.GRPCPort
+}
+
+// CouponConfig declares a coupon code accepted by the cart. An empty TenantID makes it valid in every store.
+type CouponConfig struct {
+	Code        string  `koanf:"code"`
+	TenantID    string  `koanf:"tenant_id"`
+	Type        string  `koanf:"type"`
+	Value       float64 `koanf:"value"`
+	Description string  `koanf:"description"`
+	MinSubtotal float64 `koanf:"min_subtotal"`
+}
+
+type Config struct {
+	Authn           pdauthn.Config
+	Catalog         GRPCConfig
+	Partner         GRPCConfig
+	CartTTL         time.Duration
+	GuestCartTTL    time.Duration
+	Currency        string
+	MaxLineQuantity int32
+	Coupons         []CouponConfig
+}
+
+func NewConfig(k *koanf.Koanf) (Config, error) {
+	cfg := Config{
+		Authn: pdauthn.Config{
+			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
+			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
+		},
+	}
+	var catalog, partner GRPCConfig
+	if k != nil {
+		if cfg.Authn.JWTSecret == "" {
+			cfg.Authn.JWTSecret = k.String("cart.auth.jwt_secret")
+		}
+		if cfg.Authn.JWTKey == "" {
+			cfg.Authn.JWTKey = k.String("cart.auth.jwt_key")
+		}
+		if cfg.Authn.JWKSURL == "" {
+			cfg.Authn.JWKSURL = k.String("cart.auth.jwks_url")
+		}
+		cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "cart.auth.legacy_hs256_until")
+		catalog = GRPCConfig{GRPCHost: k.String("cart.catalog.grpc_host"), GRPCPort: k.String("cart.catalog.grpc_port")}
+		partner = GRPCConfig{GRPCHost: k.String("cart.partner.grpc_host"), GRPCPort: k.String("cart.partner.grpc_port")}
+		cfg.CartTTL = k.Duration("cart.ttl")
+		cfg.GuestCartTTL = k.Duration("cart.guest_ttl")
+		cfg.Currency = k.String("cart.currency")
+		cfg.MaxLineQuantity = int32(k.Int("cart.max_line_quantity"))
+		if err := k.Unmarshal("cart.coupons", &cfg.Coupons); err != nil {
+			return cfg, fmt.Errorf("unmarshal cart coupons failed: %w", err)
+		}
+	}
+	cfg.Catalog = GRPCConfig{
+		GRPCHost: toolkit.GetEnv("CATALOG_GRPC_HOST", firstNonEmpty(catalog.GRPCHost, "catalog-service")),
+		GRPCPort: toolkit.GetEnv("CATALOG_GRPC_PORT", firstNonEmpty(catalog.GRPCPort, "50052")),
+	}
+	cfg.Partner = GRPCConfig{
+		GRPCHost: toolkit.GetEnv("PARTNER_GRPC_HOST", firstNonEmpty(partner.GRPCHost, "partner-service")),
+		GRPCPort: toolkit.GetEnv("PARTNER_GRPC_PORT", firstNonEmpty(partner.GRPCPort, "50054")),
+	}
+	if cfg.CartTTL <= 0 {
+		cfg.CartTTL = defaultCartTTL
+	}
+	if cfg.GuestCartTTL <= 0 {
+		cfg.GuestCartTTL = defaultGuestCartTTL
+	}
+	if cfg.Currency == "" {
+		cfg.Currency = defaultCurrency
+	}
+	if cfg.MaxLineQuantity <= 0 {
+		cfg.MaxLineQuantity = defaultMaxLineQuantity
+	}
+	return cfg, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package grpchandler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// TenantHeader is the metadata key storefront callers use to pick the store they shop in.
+const TenantHeader = "x-tenant-id"
+
+// Authentication resolves the store and optional shopper for a cart call.
+// Guests shop anonymously with only TenantHeader; signed-in shoppers also carry a bearer token.
+type Authentication struct {
+	verifier *pdauthn.Verifier
+}
+
+func NewAuthentication(cfg cartconfig.Config) *Authentication {
+	return &Authentication{verifier: pdauthn.NewVerifier(cfg.Authn)}
+}
+
+func (a *Authentication) Scope(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tenantID := strings.TrimSpace(firstMetadataValue(md, TenantHeader))
+
+	if strings.TrimSpace(firstMetadataValue(md, "authorization")) != "" {
+		claims, err := a.verifier.ClaimsFromContext(ctx)
+		if err != nil {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if claims.UserID == 0 {
+			return ctx, status.Error(codes.Unauthenticated, "authorization token missing user_id")
+		}
+		if tenantID == "" {
+			tenantID = strings.TrimSpace(claims.ActiveTenantID)
+		}
+		ctx = toolkit.WithUserID(ctx, strconv.FormatUint(uint64(claims.UserID), 10))
+	}
+	if tenantID == "" {
+		return ctx, status.Error(codes.InvalidArgument, "tenant id is required ("+TenantHeader+" or active session)")
+	}
+	return toolkit.WithTenantID(ctx, tenantID), nil
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if md == nil {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,187 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cartmapper "github.com/tuannm99/podzone/internal/cart/controller/mapper"
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartinputport "github.com/tuannm99/podzone/internal/cart/domain/cart/inputport"
+	pbcartv1 "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+)
+
+type CartServer struct {
+	pbcartv1.UnimplementedCartServiceServer
+	uc   cartinputport.Usecase
+	auth *Authentication
+}
+
+func NewCartServer(uc cartinputport.Usecase, auth *Authentication) *CartServer {
+	return &CartServer{uc: uc, auth: auth}
+}
+
+func (s *CartServer) GetCart(ctx context.Context, req *pbcartv1.GetCartRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.GetCart(ctx, req.GetCreateIfNotExists())
+	})
+}
+
+func (s *CartServer) GetCartByID(ctx context.Context, req *pbcartv1.GetCartByIDRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.GetCartByID(ctx, req.GetId())
+	})
+}
+
+func (s *CartServer) AddItem(ctx context.Context, req *pbcartv1.AddItemRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.AddItem(ctx, cartinputport.AddItemCommand{
+			CartID:     req.GetCartId(),
+			ProductID:  req.GetProductId(),
+			Quantity:   req.GetQuantity(),
+			Attributes: req.GetAttributes(),
+		})
+	})
+}
+
+func (s *CartServer) UpdateItem(ctx context.Context, req *pbcartv1.UpdateItemRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.UpdateItem(ctx, cartinputport.UpdateItemCommand{
+			CartID:   req.GetCartId(),
+			ItemID:   req.GetItemId(),
+			Quantity: req.GetQuantity(),
+		})
+	})
+}
+
+func (s *CartServer) RemoveItem(ctx context.Context, req *pbcartv1.RemoveItemRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.RemoveItem(ctx, req.GetCartId(), req.GetItemId())
+	})
+}
+
+func (s *CartServer) ClearCart(ctx context.Context, req *pbcartv1.ClearCartRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.ClearCart(ctx, req.GetCartId())
+	})
+}
+
+func (s *CartServer) ApplyCoupon(ctx context.Context, req *pbcartv1.ApplyCouponRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.ApplyCoupon(ctx, req.GetCartId(), req.GetCode())
+	})
+}
+
+func (s *CartServer) RemoveCoupon(ctx context.Context, req *pbcartv1.RemoveCouponRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.RemoveCoupon(ctx, req.GetCartId(), req.GetCode())
+	})
+}
+
+func (s *CartServer) MergeCart(ctx context.Context, req *pbcartv1.MergeCartRequest) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.MergeCart(ctx, cartinputport.MergeCartCommand{
+			GuestCartID: req.GetGuestCartId(),
+			UserCartID:  req.GetUserCartId(),
+		})
+	})
+}
+
+func (s *CartServer) GetShippingOptions(
+	ctx context.Context,
+	req *pbcartv1.GetShippingOptionsRequest,
+) (*pbcartv1.GetShippingOptionsResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options, err := s.uc.GetShippingOptions(ctx, cartinputport.ShippingOptionsQuery{
+		CartID:     req.GetCartId(),
+		Country:    req.GetCountry(),
+		PostalCode: req.GetPostalCode(),
+	})
+	if err != nil {
+		return nil, cartStatusError(err)
+	}
+	out := make([]*pbcartv1.ShippingOption, 0, len(options))
+	for i := range options {
+		out = append(out, cartmapper.ToProtoShippingOption(&options[i]))
+	}
+	return &pbcartv1.GetShippingOptionsResponse{Options: out}, nil
+}
+
+func (s *CartServer) SetShippingOption(
+	ctx context.Context,
+	req *pbcartv1.SetShippingOptionRequest,
+) (*pbcartv1.Cart, error) {
+	return s.cart(ctx, func(ctx context.Context) (*entity.Cart, error) {
+		return s.uc.SetShippingOption(ctx, req.GetCartId(), req.GetShippingOptionId())
+	})
+}
+
+func (s *CartServer) GetCartSummary(
+	ctx context.Context,
+	req *pbcartv1.GetCartSummaryRequest,
+) (*pbcartv1.CartSummary, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.GetCartSummary(ctx, req.GetCartId())
+	if err != nil {
+		return nil, cartStatusError(err)
+	}
+	return cartmapper.ToProtoCartSummary(out), nil
+}
+
+// cart scopes the call and maps the resulting cart, which is the shape most cart RPCs share.
+func (s *CartServer) cart(
+	ctx context.Context,
+	call func(ctx context.Context) (*entity.Cart, error),
+) (*pbcartv1.Cart, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := call(ctx)
+	if err != nil {
+		return nil, cartStatusError(err)
+	}
+	return cartmapper.ToProtoCart(out), nil
+}
+
+func cartStatusError(err error) error {
+	switch {
+	case errors.Is(err, cartdomain.ErrCartNotFound),
+		errors.Is(err, cartdomain.ErrItemNotFound),
+		errors.Is(err, cartdomain.ErrProductNotFound),
+		errors.Is(err, cartdomain.ErrCouponNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, cartdomain.ErrAuthenticationRequired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, cartdomain.ErrConcurrentUpdate):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, cartdomain.ErrProductUnavailable),
+		errors.Is(err, cartdomain.ErrInsufficientStock),
+		errors.Is(err, cartdomain.ErrCouponNotApplicable),
+		errors.Is(err, cartdomain.ErrCouponAlreadyApplied),
+		errors.Is(err, cartdomain.ErrCartNotGuest),
+		errors.Is(err, cartdomain.ErrShippingRegionRequired),
+		errors.Is(err, cartdomain.ErrShippingOptionUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, cartdomain.ErrTenantRequired),
+		errors.Is(err, cartdomain.ErrCartIDRequired),
+		errors.Is(err, cartdomain.ErrProductIDRequired),
+		errors.Is(err, cartdomain.ErrItemIDRequired),
+		errors.Is(err, cartdomain.ErrInvalidQuantity),
+		errors.Is(err, cartdomain.ErrCouponCodeRequired),
+		errors.Is(err, cartdomain.ErrCountryRequired),
+		errors.Is(err, cartdomain.ErrShippingOptionIDRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,110 @@
+package mapper
+
+import (
+	"maps"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	pbcartv1 "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+)
+
+func ToProtoCart(in *entity.Cart) *pbcartv1.Cart {
+	if in == nil {
+		return nil
+	}
+	totals := in.Totals()
+	out := &pbcartv1.Cart{
+		Id:               in.ID,
+		UserId:           in.UserID,
+		Items:            ToProtoItems(in.Items, in.Currency),
+		Coupons:          ToProtoCoupons(in.Coupons),
+		Totals:           ToProtoTotals(totals),
+		SelectedShipping: ToProtoShippingOption(in.SelectedShipping),
+		Currency:         in.Currency,
+		IsGuestCart:      in.IsGuest(),
+		CreatedAt:        timestamppb.New(in.CreatedAt),
+		UpdatedAt:        timestamppb.New(in.UpdatedAt),
+	}
+	if !in.ExpiresAt.IsZero() {
+		out.ExpiresAt = timestamppb.New(in.ExpiresAt)
+	}
+	return out
+}
+
+func ToProtoCartSummary(in *entity.Cart) *pbcartv1.CartSummary {
+	if in == nil {
+		return nil
+	}
+	totals := in.Totals()
+	return &pbcartv1.CartSummary{
+		CartId:           in.ID,
+		Items:            ToProtoItems(in.Items, in.Currency),
+		Totals:           ToProtoTotals(totals),
+		SelectedShipping: ToProtoShippingOption(in.SelectedShipping),
+		Coupons:          ToProtoCoupons(in.Coupons),
+		Currency:         in.Currency,
+	}
+}
+
+func ToProtoItems(items []entity.Item, currency string) []*pbcartv1.CartItem {
+	out := make([]*pbcartv1.CartItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbcartv1.CartItem{
+			Id:         item.ID,
+			ProductId:  item.ProductID,
+			Name:       item.Name,
+			Sku:        item.SKU,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice,
+			Subtotal:   item.Subtotal(),
+			ImageUrl:   item.ImageURL,
+			Attributes: maps.Clone(item.Attributes),
+			InStock:    item.InStock,
+			Currency:   currency,
+		})
+	}
+	return out
+}
+
+// ToProtoCoupons expects coupons whose DiscountAmount was filled in by Cart.Totals.
+func ToProtoCoupons(items []entity.AppliedCoupon) []*pbcartv1.AppliedCoupon {
+	out := make([]*pbcartv1.AppliedCoupon, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbcartv1.AppliedCoupon{
+			Code:           item.Code,
+			Type:           item.Type,
+			Value:          item.Value,
+			DiscountAmount: item.DiscountAmount,
+			Description:    item.Description,
+		})
+	}
+	return out
+}
+
+func ToProtoTotals(in entity.Totals) *pbcartv1.CartTotals {
+	return &pbcartv1.CartTotals{
+		Subtotal:      in.Subtotal,
+		DiscountTotal: in.DiscountTotal,
+		TaxTotal:      in.TaxTotal,
+		ShippingTotal: in.ShippingTotal,
+		GrandTotal:    in.GrandTotal,
+		ItemsCount:    in.ItemsCount,
+		ItemsQuantity: in.ItemsQuantity,
+	}
+}
+
+func ToProtoShippingOption(in *entity.ShippingOption) *pbcartv1.ShippingOption {
+	if in == nil {
+		return nil
+	}
+	return &pbcartv1.ShippingOption{
+		Id:                in.ID,
+		Name:              in.Name,
+		Carrier:           in.Carrier,
+		Price:             in.Price,
+		Currency:          in.Currency,
+		EstimatedDelivery: in.EstimatedDelivery,
+		Description:       in.Description,
+	}
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"maps"
+	"math"
+	"time"
+)
+
+const (
+	CouponTypePercentage = "percentage"
+	CouponTypeFixed      = "fixed"
+)
+
+type Cart struct {
+	ID               string          `json:"id"`
+	TenantID         string          `json:"tenant_id"`
+	UserID           string          `json:"user_id,omitempty"`
+	Items            []Item          `json:"items"`
+	Coupons          []AppliedCoupon `json:"coupons,omitempty"`
+	ShippingRegion   string          `json:"shipping_region,omitempty"`
+	SelectedShipping *ShippingOption `json:"selected_shipping,omitempty"`
+	Currency         string          `json:"currency"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	ExpiresAt        time.Time       `json:"expires_at"`
+	Version          int64           `json:"version"`
+}
+
+type Item struct {
+	ID         string            `json:"id"`
+	ProductID  string            `json:"product_id"`
+	Name       string            `json:"name"`
+	SKU        string            `json:"sku"`
+	Quantity   int32             `json:"quantity"`
+	UnitPrice  float64           `json:"unit_price"`
+	ImageURL   string            `json:"image_url,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	InStock    bool              `json:"in_stock"`
+}
+
+type AppliedCoupon struct {
+	Code           string  `json:"code"`
+	Type           string  `json:"type"`
+	Value          float64 `json:"value"`
+	Description    string  `json:"description,omitempty"`
+	MinSubtotal    float64 `json:"min_subtotal,omitempty"`
+	DiscountAmount float64 `json:"-"`
+}
+
+type ShippingOption struct {
+	ID                string  `json:"id"`
+	PartnerID         string  `json:"partner_id"`
+	Name              string  `json:"name"`
+	Carrier           string  `json:"carrier"`
+	Price             float64 `json:"price"`
+	Currency          string  `json:"currency"`
+	EstimatedDelivery string  `json:"estimated_delivery,omitempty"`
+	Description       string  `json:"description,omitempty"`
+}
+
+type Totals struct {
+	Subtotal      float64
+	DiscountTotal float64
+	TaxTotal      float64
+	ShippingTotal float64
+	GrandTotal    float64
+	ItemsCount    int32
+	ItemsQuantity int32
+}
+
+// ProductSnapshot is the slice of a catalog product the cart needs to price a line.
+type ProductSnapshot struct {
+	ID             string
+	Name           string
+	SKU            string
+	Price          float64
+	ImageURL       string
+	InventoryCount int32
+	Active         bool
+}
+
+// ShippingRate is one partner's quote for delivering into a region.
+type ShippingRate struct {
+	PartnerID   string
+	PartnerCode string
+	PartnerName string
+	Region      string
+	Cost        float64
+	SLADays     int32
+}
+
+type Coupon struct {
+	Code        string
+	Type        string
+	Value       float64
+	Description string
+	MinSubtotal float64
+}
+
+func (c *Cart) IsGuest() bool {
+	return c.UserID == ""
+}
+
+func (i Item) Subtotal() float64 {
+	return RoundMoney(i.UnitPrice * float64(i.Quantity))
+}
+
+// SameLine reports whether two lines describe the same product variant.
+func (i Item) SameLine(productID string, attributes map[string]string) bool {
+	return i.ProductID == productID && maps.Equal(i.Attributes, attributes)
+}
+
+// Totals recalculates coupon discounts against the current lines and returns the cart totals.
+// Coupons below their minimum subtotal stay attached but discount nothing until the cart qualifies.
+func (c *Cart) Totals() Totals {
+	totals := Totals{ItemsCount: int32(len(c.Items))}
+	for _, item := range c.Items {
+		totals.Subtotal += item.Subtotal()
+		totals.ItemsQuantity += item.Quantity
+	}
+	totals.Subtotal = RoundMoney(totals.Subtotal)
+
+	remaining := totals.Subtotal
+	for i := range c.Coupons {
+		coupon := &c.Coupons[i]
+		coupon.DiscountAmount = 0
+		if totals.Subtotal < coupon.MinSubtotal {
+			continue
+		}
+		switch coupon.Type {
+		case CouponTypePercentage:
+			coupon.DiscountAmount = RoundMoney(totals.Subtotal * coupon.Value / 100)
+		case CouponTypeFixed:
+			coupon.DiscountAmount = coupon.Value
+		}
+		coupon.DiscountAmount = math.Min(coupon.DiscountAmount, remaining)
+		remaining = RoundMoney(remaining - coupon.DiscountAmount)
+		totals.DiscountTotal += coupon.DiscountAmount
+	}
+	totals.DiscountTotal = RoundMoney(totals.DiscountTotal)
+
+	if c.SelectedShipping != nil && len(c.Items) > 0 {
+		totals.ShippingTotal = c.SelectedShipping.Price
+	}
+	totals.GrandTotal = RoundMoney(totals.Subtotal - totals.DiscountTotal + totals.TaxTotal + totals.ShippingTotal)
+	return totals
+}
+
+func RoundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
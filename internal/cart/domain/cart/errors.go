@@ -0,0 +1,27 @@
+package cart
+
+import "errors"
+
+var (
+	ErrTenantRequired            = errors.New("tenant id is required")
+	ErrAuthenticationRequired    = errors.New("authentication is required")
+	ErrCartIDRequired            = errors.New("cart id is required")
+	ErrCartNotFound              = errors.New("cart not found")
+	ErrCartNotGuest              = errors.New("only guest carts can be merged")
+	ErrProductIDRequired         = errors.New("product id is required")
+	ErrProductNotFound           = errors.New("product not found")
+	ErrProductUnavailable        = errors.New("product is not available")
+	ErrItemIDRequired            = errors.New("cart item id is required")
+	ErrItemNotFound              = errors.New("cart item not found")
+	ErrInvalidQuantity           = errors.New("invalid quantity")
+	ErrInsufficientStock         = errors.New("insufficient stock")
+	ErrCouponCodeRequired        = errors.New("coupon code is required")
+	ErrCouponNotFound            = errors.New("coupon not found")
+	ErrCouponNotApplicable       = errors.New("coupon is not applicable to this cart")
+	ErrCouponAlreadyApplied      = errors.New("cart already has a coupon")
+	ErrCountryRequired           = errors.New("country is required")
+	ErrShippingRegionRequired    = errors.New("request shipping options before selecting one")
+	ErrShippingOptionIDRequired  = errors.New("shipping option id is required")
+	ErrShippingOptionUnavailable = errors.New("shipping option is not available")
+	ErrConcurrentUpdate          = errors.New("cart was changed concurrently, retry")
+)
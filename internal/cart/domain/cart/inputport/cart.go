@@ -0,0 +1,48 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+)
+
+type AddItemCommand struct {
+	CartID     string
+	ProductID  string
+	Quantity   int32
+	Attributes map[string]string
+}
+
+type UpdateItemCommand struct {
+	CartID   string
+	ItemID   string
+	Quantity int32
+}
+
+type MergeCartCommand struct {
+	GuestCartID string
+	UserCartID  string
+}
+
+type ShippingOptionsQuery struct {
+	CartID     string
+	Country    string
+	PostalCode string
+}
+
+// Usecase operates on the caller's cart. An empty cart id resolves to the signed-in user's cart;
+// guests address their cart by the id returned when it was created.
+type Usecase interface {
+	GetCart(ctx context.Context, createIfMissing bool) (*entity.Cart, error)
+	GetCartByID(ctx context.Context, id string) (*entity.Cart, error)
+	AddItem(ctx context.Context, cmd AddItemCommand) (*entity.Cart, error)
+	UpdateItem(ctx context.Context, cmd UpdateItemCommand) (*entity.Cart, error)
+	RemoveItem(ctx context.Context, cartID, itemID string) (*entity.Cart, error)
+	ClearCart(ctx context.Context, cartID string) (*entity.Cart, error)
+	ApplyCoupon(ctx context.Context, cartID, code string) (*entity.Cart, error)
+	RemoveCoupon(ctx context.Context, cartID, code string) (*entity.Cart, error)
+	MergeCart(ctx context.Context, cmd MergeCartCommand) (*entity.Cart, error)
+	GetShippingOptions(ctx context.Context, query ShippingOptionsQuery) ([]entity.ShippingOption, error)
+	SetShippingOption(ctx context.Context, cartID, optionID string) (*entity.Cart, error)
+	GetCartSummary(ctx context.Context, cartID string) (*entity.Cart, error)
+}
@@ -0,0 +1,497 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartinputport "github.com/tuannm99/podzone/internal/cart/domain/cart/inputport"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+var _ cartinputport.Usecase = (*CartInteractor)(nil)
+
+type CartInteractor struct {
+	carts    cartoutputport.CartRepository
+	catalog  cartoutputport.ProductCatalog
+	shipping cartoutputport.ShippingRates
+	coupons  cartoutputport.CouponCatalog
+	cfg      cartconfig.Config
+	now      func() time.Time
+}
+
+type CartInteractorParams struct {
+	fx.In
+
+	Carts    cartoutputport.CartRepository
+	Catalog  cartoutputport.ProductCatalog
+	Shipping cartoutputport.ShippingRates
+	Coupons  cartoutputport.CouponCatalog
+	Config   cartconfig.Config
+}
+
+func NewCartInteractor(params CartInteractorParams) *CartInteractor {
+	return &CartInteractor{
+		carts:    params.Carts,
+		catalog:  params.Catalog,
+		shipping: params.Shipping,
+		coupons:  params.Coupons,
+		cfg:      params.Config,
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *CartInteractor) GetCart(ctx context.Context, createIfMissing bool) (*entity.Cart, error) {
+	cart, created, err := s.resolveCart(ctx, "", createIfMissing)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return s.save(ctx, cart)
+	}
+	return cart, nil
+}
+
+func (s *CartInteractor) GetCartByID(ctx context.Context, id string) (*entity.Cart, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrCartIDRequired
+	}
+	cart, _, err := s.resolveCart(ctx, id, false)
+	return cart, err
+}
+
+func (s *CartInteractor) AddItem(ctx context.Context, cmd cartinputport.AddItemCommand) (*entity.Cart, error) {
+	productID := strings.TrimSpace(cmd.ProductID)
+	if productID == "" {
+		return nil, ErrProductIDRequired
+	}
+	if cmd.Quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	cart, _, err := s.resolveCart(ctx, cmd.CartID, true)
+	if err != nil {
+		return nil, err
+	}
+	attributes := normalizeAttributes(cmd.Attributes)
+
+	index := -1
+	quantity := cmd.Quantity
+	for i, item := range cart.Items {
+		if item.SameLine(productID, attributes) {
+			index = i
+			quantity += item.Quantity
+			break
+		}
+	}
+	if quantity > s.cfg.MaxLineQuantity {
+		return nil, ErrInvalidQuantity
+	}
+	product, err := s.priceableProduct(ctx, cart.TenantID, productID, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 {
+		cart.Items = append(cart.Items, entity.Item{
+			ID:         uuid.NewString(),
+			ProductID:  productID,
+			Attributes: attributes,
+		})
+		index = len(cart.Items) - 1
+	}
+	cart.Items[index].Quantity = quantity
+	applyProduct(&cart.Items[index], product)
+	return s.save(ctx, cart)
+}
+
+func (s *CartInteractor) UpdateItem(ctx context.Context, cmd cartinputport.UpdateItemCommand) (*entity.Cart, error) {
+	if cmd.Quantity == 0 {
+		return s.RemoveItem(ctx, cmd.CartID, cmd.ItemID)
+	}
+	if cmd.Quantity < 0 || cmd.Quantity > s.cfg.MaxLineQuantity {
+		return nil, ErrInvalidQuantity
+	}
+	cart, index, err := s.resolveItem(ctx, cmd.CartID, cmd.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	item := &cart.Items[index]
+	product, err := s.priceableProduct(ctx, cart.TenantID, item.ProductID, cmd.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	item.Quantity = cmd.Quantity
+	applyProduct(item, product)
+	return s.save(ctx, cart)
+}
+
+func (s *CartInteractor) RemoveItem(ctx context.Context, cartID, itemID string) (*entity.Cart, error) {
+	cart, index, err := s.resolveItem(ctx, cartID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	cart.Items = append(cart.Items[:index], cart.Items[index+1:]...)
+	return s.save(ctx, cart)
+}
+
+func (s *CartInteractor) ClearCart(ctx context.Context, cartID string) (*entity.Cart, error) {
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, err
+	}
+	cart.Items = nil
+	cart.Coupons = nil
+	cart.SelectedShipping = nil
+	return s.save(ctx, cart)
+}
+
+func (s *CartInteractor) ApplyCoupon(ctx context.Context, cartID, code string) (*entity.Cart, error) {
+	code = normalizeCouponCode(code)
+	if code == "" {
+		return nil, ErrCouponCodeRequired
+	}
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, applied := range cart.Coupons {
+		if applied.Code == code {
+			return cart, nil
+		}
+	}
+	if len(cart.Coupons) > 0 {
+		return nil, ErrCouponAlreadyApplied
+	}
+	coupon, err := s.coupons.FindCoupon(ctx, cart.TenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if cart.Totals().Subtotal < coupon.MinSubtotal {
+		return nil, ErrCouponNotApplicable
+	}
+	cart.Coupons = append(cart.Coupons, entity.AppliedCoupon{
+		Code:        coupon.Code,
+		Type:        coupon.Type,
+		Value:       coupon.Value,
+		Description: coupon.Description,
+		MinSubtotal: coupon.MinSubtotal,
+	})
+	return s.save(ctx, cart)
+}
+
+func (s *CartInteractor) RemoveCoupon(ctx context.Context, cartID, code string) (*entity.Cart, error) {
+	code = normalizeCouponCode(code)
+	if code == "" {
+		return nil, ErrCouponCodeRequired
+	}
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, err
+	}
+	kept := cart.Coupons[:0]
+	for _, applied := range cart.Coupons {
+		if applied.Code != code {
+			kept = append(kept, applied)
+		}
+	}
+	if len(kept) == len(cart.Coupons) {
+		return nil, ErrCouponNotFound
+	}
+	cart.Coupons = kept
+	return s.save(ctx, cart)
+}
+
+// MergeCart folds a guest cart into the signed-in user's cart and drops the guest cart.
+// Matching lines add up (capped at the line limit); the user's coupon and shipping choice win over the guest's.
+func (s *CartInteractor) MergeCart(ctx context.Context, cmd cartinputport.MergeCartCommand) (*entity.Cart, error) {
+	if _, err := toolkit.GetUserID(ctx); err != nil {
+		return nil, ErrAuthenticationRequired
+	}
+	if strings.TrimSpace(cmd.GuestCartID) == "" {
+		return nil, ErrCartIDRequired
+	}
+	guest, _, err := s.resolveCart(ctx, cmd.GuestCartID, false)
+	if err != nil {
+		return nil, err
+	}
+	if !guest.IsGuest() {
+		return nil, ErrCartNotGuest
+	}
+	target, _, err := s.resolveCart(ctx, cmd.UserCartID, true)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsGuest() {
+		return nil, ErrCartNotFound
+	}
+
+	for _, item := range guest.Items {
+		merged := false
+		for i := range target.Items {
+			if target.Items[i].SameLine(item.ProductID, item.Attributes) {
+				target.Items[i].Quantity = min(target.Items[i].Quantity+item.Quantity, s.cfg.MaxLineQuantity)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			target.Items = append(target.Items, item)
+		}
+	}
+	if len(target.Coupons) == 0 {
+		target.Coupons = guest.Coupons
+	}
+	if target.SelectedShipping == nil && guest.SelectedShipping != nil {
+		target.ShippingRegion = guest.ShippingRegion
+		target.SelectedShipping = guest.SelectedShipping
+	}
+	s.reprice(ctx, target)
+
+	out, err := s.save(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.carts.Delete(ctx, *guest); err != nil {
+		return nil, fmt.Errorf("delete merged guest cart: %w", err)
+	}
+	return out, nil
+}
+
+func (s *CartInteractor) GetShippingOptions(
+	ctx context.Context,
+	query cartinputport.ShippingOptionsQuery,
+) ([]entity.ShippingOption, error) {
+	region := strings.ToLower(strings.TrimSpace(query.Country))
+	if region == "" {
+		return nil, ErrCountryRequired
+	}
+	cart, _, err := s.resolveCart(ctx, query.CartID, false)
+	if err != nil {
+		return nil, err
+	}
+	options, err := s.quote(ctx, cart, region)
+	if err != nil {
+		return nil, err
+	}
+	if cart.ShippingRegion != region {
+		cart.ShippingRegion = region
+		cart.SelectedShipping = nil
+		if _, err := s.save(ctx, cart); err != nil {
+			return nil, err
+		}
+	}
+	return options, nil
+}
+
+func (s *CartInteractor) SetShippingOption(ctx context.Context, cartID, optionID string) (*entity.Cart, error) {
+	optionID = strings.TrimSpace(optionID)
+	if optionID == "" {
+		return nil, ErrShippingOptionIDRequired
+	}
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, err
+	}
+	if cart.ShippingRegion == "" {
+		return nil, ErrShippingRegionRequired
+	}
+	// Re-quote instead of trusting a cached price: partner rules may have changed since the shopper looked.
+	options, err := s.quote(ctx, cart, cart.ShippingRegion)
+	if err != nil {
+		return nil, err
+	}
+	for i := range options {
+		if options[i].ID == optionID {
+			cart.SelectedShipping = &options[i]
+			return s.save(ctx, cart)
+		}
+	}
+	return nil, ErrShippingOptionUnavailable
+}
+
+// GetCartSummary re-prices every line against the catalog so checkout sees current prices and stock.
+func (s *CartInteractor) GetCartSummary(ctx context.Context, cartID string) (*entity.Cart, error) {
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, err
+	}
+	s.reprice(ctx, cart)
+	return s.save(ctx, cart)
+}
+
+// resolveCart loads the cart addressed by cartID, or the signed-in user's cart when cartID is empty.
+// A user-owned cart is reported as missing to everyone but its owner.
+func (s *CartInteractor) resolveCart(ctx context.Context, cartID string, create bool) (*entity.Cart, bool, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	userID, _ := toolkit.GetUserID(ctx)
+
+	cartID = strings.TrimSpace(cartID)
+	if cartID != "" {
+		cart, err := s.carts.Get(ctx, tenantID, cartID)
+		if err != nil {
+			return nil, false, err
+		}
+		if !cart.IsGuest() && cart.UserID != userID {
+			return nil, false, ErrCartNotFound
+		}
+		return cart, false, nil
+	}
+
+	if userID != "" {
+		cart, err := s.carts.FindByUser(ctx, tenantID, userID)
+		if err == nil {
+			return cart, false, nil
+		}
+		if !errors.Is(err, ErrCartNotFound) || !create {
+			return nil, false, err
+		}
+	} else if !create {
+		return nil, false, ErrCartIDRequired
+	}
+
+	now := s.now()
+	return &entity.Cart{
+		ID:        uuid.NewString(),
+		TenantID:  tenantID,
+		UserID:    userID,
+		Currency:  s.cfg.Currency,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, true, nil
+}
+
+func (s *CartInteractor) resolveItem(ctx context.Context, cartID, itemID string) (*entity.Cart, int, error) {
+	itemID = strings.TrimSpace(itemID)
+	if itemID == "" {
+		return nil, -1, ErrItemIDRequired
+	}
+	cart, _, err := s.resolveCart(ctx, cartID, false)
+	if err != nil {
+		return nil, -1, err
+	}
+	for i, item := range cart.Items {
+		if item.ID == itemID {
+			return cart, i, nil
+		}
+	}
+	return nil, -1, ErrItemNotFound
+}
+
+func (s *CartInteractor) priceableProduct(
+	ctx context.Context,
+	tenantID, productID string,
+	quantity int32,
+) (*entity.ProductSnapshot, error) {
+	product, err := s.catalog.GetProduct(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !product.Active {
+		return nil, ErrProductUnavailable
+	}
+	if product.InventoryCount < quantity {
+		return nil, ErrInsufficientStock
+	}
+	return product, nil
+}
+
+// reprice refreshes each line from the catalog. Lines whose product vanished or went inactive
+// are kept but flagged out of stock so the shopper can see why checkout is blocked.
+func (s *CartInteractor) reprice(ctx context.Context, cart *entity.Cart) {
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		product, err := s.catalog.GetProduct(ctx, cart.TenantID, item.ProductID)
+		if err != nil || !product.Active {
+			item.InStock = false
+			continue
+		}
+		applyProduct(item, product)
+	}
+}
+
+func (s *CartInteractor) quote(ctx context.Context, cart *entity.Cart, region string) ([]entity.ShippingOption, error) {
+	rates, err := s.shipping.ListShippingRates(ctx, cart.TenantID, region)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]entity.ShippingOption, 0, len(rates))
+	for _, rate := range rates {
+		option := entity.ShippingOption{
+			ID:          rate.PartnerID,
+			PartnerID:   rate.PartnerID,
+			Name:        rate.PartnerName,
+			Carrier:     rate.PartnerCode,
+			Price:       entity.RoundMoney(rate.Cost),
+			Currency:    cart.Currency,
+			Description: "Fulfilled by " + rate.PartnerName,
+		}
+		if rate.SLADays > 0 {
+			option.EstimatedDelivery = fmt.Sprintf("%d business days", rate.SLADays)
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+func (s *CartInteractor) save(ctx context.Context, cart *entity.Cart) (*entity.Cart, error) {
+	ttl := s.cfg.CartTTL
+	if cart.IsGuest() {
+		ttl = s.cfg.GuestCartTTL
+	}
+	cart.UpdatedAt = s.now()
+	cart.ExpiresAt = cart.UpdatedAt.Add(ttl)
+	if err := s.carts.Save(ctx, *cart, ttl); err != nil {
+		return nil, err
+	}
+	cart.Version++
+	return cart, nil
+}
+
+func applyProduct(item *entity.Item, product *entity.ProductSnapshot) {
+	item.Name = product.Name
+	item.SKU = product.SKU
+	item.UnitPrice = product.Price
+	item.ImageURL = product.ImageURL
+	item.InStock = product.InventoryCount >= item.Quantity
+}
+
+func tenantFromContext(ctx context.Context) (string, error) {
+	tenantID, err := toolkit.GetTenantID(ctx)
+	if err != nil || strings.TrimSpace(tenantID) == "" {
+		return "", ErrTenantRequired
+	}
+	return strings.TrimSpace(tenantID), nil
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func normalizeAttributes(items map[string]string) map[string]string {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(items))
+	for key, value := range items {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		out[key] = strings.TrimSpace(value)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,248 @@
+package cart
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartinputport "github.com/tuannm99/podzone/internal/cart/domain/cart/inputport"
+	cartmocks "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+var fixedNow = time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+type cartFixture struct {
+	svc      *CartInteractor
+	carts    *cartmocks.MockCartRepository
+	catalog  *cartmocks.MockProductCatalog
+	shipping *cartmocks.MockShippingRates
+	coupons  *cartmocks.MockCouponCatalog
+}
+
+func setupCartInteractor(t *testing.T) cartFixture {
+	t.Helper()
+	f := cartFixture{
+		carts:    cartmocks.NewMockCartRepository(t),
+		catalog:  cartmocks.NewMockProductCatalog(t),
+		shipping: cartmocks.NewMockShippingRates(t),
+		coupons:  cartmocks.NewMockCouponCatalog(t),
+	}
+	f.svc = NewCartInteractor(CartInteractorParams{
+		Carts:    f.carts,
+		Catalog:  f.catalog,
+		Shipping: f.shipping,
+		Coupons:  f.coupons,
+		Config: cartconfig.Config{
+			CartTTL:         30 * 24 * time.Hour,
+			GuestCartTTL:    7 * 24 * time.Hour,
+			Currency:        "USD",
+			MaxLineQuantity: 10,
+		},
+	})
+	f.svc.now = func() time.Time { return fixedNow }
+	return f
+}
+
+func guestContext(tenantID string) context.Context {
+	return toolkit.WithTenantID(context.Background(), tenantID)
+}
+
+func shopperContext(tenantID, userID string) context.Context {
+	return toolkit.WithUserID(guestContext(tenantID), userID)
+}
+
+func shirt(inventory int32) *entity.ProductSnapshot {
+	return &entity.ProductSnapshot{
+		ID:             "p-1",
+		Name:           "Shirt",
+		SKU:            "SKU-1",
+		Price:          12.5,
+		InventoryCount: inventory,
+		Active:         true,
+	}
+}
+
+func TestAddItem_CreatesGuestCartPricedFromCatalog(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	f.catalog.EXPECT().GetProduct(mock.Anything, "tenant-1", "p-1").Return(shirt(5), nil)
+	f.carts.EXPECT().Save(mock.Anything, mock.AnythingOfType("entity.Cart"), 7*24*time.Hour).Return(nil)
+
+	cart, err := f.svc.AddItem(guestContext("tenant-1"), cartinputport.AddItemCommand{
+		ProductID: "p-1",
+		Quantity:  2,
+	})
+	require.NoError(t, err)
+	require.True(t, cart.IsGuest())
+	require.Len(t, cart.Items, 1)
+	require.Equal(t, 12.5, cart.Items[0].UnitPrice)
+	require.True(t, cart.Items[0].InStock)
+	require.Equal(t, fixedNow.Add(7*24*time.Hour), cart.ExpiresAt)
+	require.Equal(t, 25.0, cart.Totals().Subtotal)
+}
+
+func TestAddItem_AddsToExistingLineAndChecksStock(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "cart-1").Return(&entity.Cart{
+		ID:       "cart-1",
+		TenantID: "tenant-1",
+		Items:    []entity.Item{{ID: "line-1", ProductID: "p-1", Quantity: 2}},
+	}, nil)
+	f.catalog.EXPECT().GetProduct(mock.Anything, "tenant-1", "p-1").Return(shirt(3), nil)
+
+	_, err := f.svc.AddItem(guestContext("tenant-1"), cartinputport.AddItemCommand{
+		CartID:    "cart-1",
+		ProductID: "p-1",
+		Quantity:  2,
+	})
+	require.ErrorIs(t, err, ErrInsufficientStock)
+}
+
+func TestGetCartByID_HidesAnotherShoppersCart(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "cart-1").
+		Return(&entity.Cart{ID: "cart-1", TenantID: "tenant-1", UserID: "42"}, nil)
+
+	_, err := f.svc.GetCartByID(shopperContext("tenant-1", "7"), "cart-1")
+	require.ErrorIs(t, err, ErrCartNotFound)
+}
+
+func TestGetCart_GuestWithoutCreateNeedsCartID(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+
+	_, err := f.svc.GetCart(guestContext("tenant-1"), false)
+	require.ErrorIs(t, err, ErrCartIDRequired)
+}
+
+func TestMergeCart_FoldsGuestLinesIntoUserCart(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	guest := &entity.Cart{
+		ID:       "guest-1",
+		TenantID: "tenant-1",
+		Items: []entity.Item{
+			{ID: "g-1", ProductID: "p-1", Quantity: 9},
+			{ID: "g-2", ProductID: "p-2", Quantity: 1},
+		},
+		Coupons: []entity.AppliedCoupon{{Code: "WELCOME10", Type: entity.CouponTypePercentage, Value: 10}},
+	}
+	user := &entity.Cart{
+		ID:       "user-1",
+		TenantID: "tenant-1",
+		UserID:   "7",
+		Items:    []entity.Item{{ID: "u-1", ProductID: "p-1", Quantity: 3}},
+	}
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "guest-1").Return(guest, nil)
+	f.carts.EXPECT().FindByUser(mock.Anything, "tenant-1", "7").Return(user, nil)
+	f.catalog.EXPECT().GetProduct(mock.Anything, "tenant-1", "p-1").Return(shirt(100), nil)
+	f.catalog.EXPECT().GetProduct(mock.Anything, "tenant-1", "p-2").Return(nil, ErrProductNotFound)
+	f.carts.EXPECT().Save(mock.Anything, mock.MatchedBy(func(cart entity.Cart) bool {
+		return cart.ID == "user-1"
+	}), 30*24*time.Hour).Return(nil)
+	f.carts.EXPECT().Delete(mock.Anything, *guest).Return(nil)
+
+	cart, err := f.svc.MergeCart(shopperContext("tenant-1", "7"), cartinputport.MergeCartCommand{GuestCartID: "guest-1"})
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 2)
+	require.EqualValues(t, 10, cart.Items[0].Quantity, "merged quantity is capped at the line limit")
+	require.False(t, cart.Items[1].InStock, "lines for missing products are flagged, not dropped")
+	require.Len(t, cart.Coupons, 1)
+}
+
+func TestMergeCart_RequiresSignedInShopper(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+
+	_, err := f.svc.MergeCart(guestContext("tenant-1"), cartinputport.MergeCartCommand{GuestCartID: "guest-1"})
+	require.ErrorIs(t, err, ErrAuthenticationRequired)
+}
+
+func TestShippingOptions_QuoteFromPartnerRatesAndRequoteOnSelect(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	stored := &entity.Cart{
+		ID:       "cart-1",
+		TenantID: "tenant-1",
+		Currency: "USD",
+		Items:    []entity.Item{{ID: "line-1", ProductID: "p-1", Quantity: 1, UnitPrice: 20}},
+	}
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "cart-1").Return(stored, nil)
+	f.shipping.EXPECT().ListShippingRates(mock.Anything, "tenant-1", "vn").Return([]entity.ShippingRate{
+		{PartnerID: "prt-1", PartnerCode: "acme", PartnerName: "Acme", Region: "vn", Cost: 3.5, SLADays: 2},
+	}, nil)
+	f.carts.EXPECT().Save(mock.Anything, mock.AnythingOfType("entity.Cart"), 7*24*time.Hour).Return(nil)
+
+	options, err := f.svc.GetShippingOptions(guestContext("tenant-1"), cartinputport.ShippingOptionsQuery{
+		CartID:  "cart-1",
+		Country: "VN",
+	})
+	require.NoError(t, err)
+	require.Len(t, options, 1)
+	require.Equal(t, "2 business days", options[0].EstimatedDelivery)
+	require.Equal(t, "vn", stored.ShippingRegion)
+
+	cart, err := f.svc.SetShippingOption(guestContext("tenant-1"), "cart-1", "prt-1")
+	require.NoError(t, err)
+	require.Equal(t, 23.5, cart.Totals().GrandTotal)
+
+	_, err = f.svc.SetShippingOption(guestContext("tenant-1"), "cart-1", "prt-unknown")
+	require.ErrorIs(t, err, ErrShippingOptionUnavailable)
+}
+
+func TestSetShippingOption_RequiresQuotedRegion(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "cart-1").
+		Return(&entity.Cart{ID: "cart-1", TenantID: "tenant-1"}, nil)
+
+	_, err := f.svc.SetShippingOption(guestContext("tenant-1"), "cart-1", "prt-1")
+	require.ErrorIs(t, err, ErrShippingRegionRequired)
+}
+
+func TestApplyCoupon_RejectsCartBelowMinimumSubtotal(t *testing.T) {
+	t.Parallel()
+	f := setupCartInteractor(t)
+	f.carts.EXPECT().Get(mock.Anything, "tenant-1", "cart-1").Return(&entity.Cart{
+		ID:       "cart-1",
+		TenantID: "tenant-1",
+		Items:    []entity.Item{{ID: "line-1", Quantity: 1, UnitPrice: 10}},
+	}, nil)
+	f.coupons.EXPECT().FindCoupon(mock.Anything, "tenant-1", "WELCOME10").Return(&entity.Coupon{
+		Code:        "WELCOME10",
+		Type:        entity.CouponTypePercentage,
+		Value:       10,
+		MinSubtotal: 20,
+	}, nil)
+
+	_, err := f.svc.ApplyCoupon(guestContext("tenant-1"), "cart-1", " welcome10 ")
+	require.ErrorIs(t, err, ErrCouponNotApplicable)
+}
+
+func TestCartTotals_CapsDiscountsAtSubtotal(t *testing.T) {
+	t.Parallel()
+	cart := entity.Cart{
+		Items: []entity.Item{{Quantity: 3, UnitPrice: 3.33}},
+		Coupons: []entity.AppliedCoupon{
+			{Code: "HALF", Type: entity.CouponTypePercentage, Value: 50},
+			{Code: "FIVE", Type: entity.CouponTypeFixed, Value: 50},
+		},
+		SelectedShipping: &entity.ShippingOption{Price: 4},
+	}
+
+	totals := cart.Totals()
+	require.Equal(t, 9.99, totals.Subtotal)
+	require.Equal(t, 9.99, totals.DiscountTotal)
+	require.Equal(t, 4.0, totals.GrandTotal)
+	require.EqualValues(t, 3, totals.ItemsQuantity)
+	require.Equal(t, 5.0, cart.Coupons[0].DiscountAmount)
+	require.Equal(t, 4.99, cart.Coupons[1].DiscountAmount)
+}
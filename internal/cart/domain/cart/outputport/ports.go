@@ -0,0 +1,30 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+)
+
+type CartRepository interface {
+	Get(ctx context.Context, tenantID, id string) (*entity.Cart, error)
+	FindByUser(ctx context.Context, tenantID, userID string) (*entity.Cart, error)
+	// Save stores the cart as version cart.Version+1 and refreshes its expiry to ttl from now.
+	// It returns cart.ErrConcurrentUpdate unless the stored cart is still at cart.Version, or
+	// absent for a cart at version zero.
+	Save(ctx context.Context, cart entity.Cart, ttl time.Duration) error
+	Delete(ctx context.Context, cart entity.Cart) error
+}
+
+type ProductCatalog interface {
+	GetProduct(ctx context.Context, tenantID, productID string) (*entity.ProductSnapshot, error)
+}
+
+type ShippingRates interface {
+	ListShippingRates(ctx context.Context, tenantID, region string) ([]entity.ShippingRate, error)
+}
+
+type CouponCatalog interface {
+	FindCoupon(ctx context.Context, tenantID, code string) (*entity.Coupon, error)
+}
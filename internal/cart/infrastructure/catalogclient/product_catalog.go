@@ -0,0 +1,82 @@
+package catalogclient
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+	pbcatalogv1 "github.com/tuannm99/podzone/pkg/api/proto/catalog/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// tenantHeader mirrors the catalog service's anonymous tenant selector.
+const tenantHeader = "x-tenant-id"
+
+var _ cartoutputport.ProductCatalog = (*ProductCatalog)(nil)
+
+type ProductCatalog struct {
+	client pbcatalogv1.CatalogServiceClient
+}
+
+type Params struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    cartconfig.Config
+}
+
+func NewProductCatalog(params Params) (*ProductCatalog, error) {
+	addr := params.Config.Catalog.Addr()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect cart catalog client %s: %w", addr, err)
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	params.Logger.Info("cart catalog gRPC client connected", "addr", addr)
+	return &ProductCatalog{client: pbcatalogv1.NewCatalogServiceClient(conn)}, nil
+}
+
+// GetProduct reads the product as an anonymous storefront caller, so inactive products read as missing.
+func (c *ProductCatalog) GetProduct(ctx context.Context, tenantID, productID string) (*entity.ProductSnapshot, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(tenantHeader, tenantID))
+	product, err := c.client.GetProduct(ctx, &pbcatalogv1.GetProductRequest{Id: productID})
+	if status.Code(err) == codes.NotFound {
+		return nil, cartdomain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get catalog product %s: %w", productID, err)
+	}
+
+	price := product.GetPrice()
+	if sale := product.GetSalePrice(); sale > 0 && sale < price {
+		price = sale
+	}
+	var imageURL string
+	if images := product.GetImageUrls(); len(images) > 0 {
+		imageURL = images[0]
+	}
+	return &entity.ProductSnapshot{
+		ID:             product.GetId(),
+		Name:           product.GetName(),
+		SKU:            product.GetSku(),
+		Price:          price,
+		ImageURL:       imageURL,
+		InventoryCount: product.GetInventoryCount(),
+		Active:         product.GetActive(),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package coupon
+
+import (
+	"context"
+	"strings"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+)
+
+var _ cartoutputport.CouponCatalog = (*ConfigCatalog)(nil)
+
+// ConfigCatalog serves the coupons declared under cart.coupons until promotions get their own service.
+type ConfigCatalog struct {
+	coupons []cartconfig.CouponConfig
+}
+
+func NewConfigCatalog(cfg cartconfig.Config) *ConfigCatalog {
+	return &ConfigCatalog{coupons: cfg.Coupons}
+}
+
+func (c *ConfigCatalog) FindCoupon(_ context.Context, tenantID, code string) (*entity.Coupon, error) {
+	for _, item := range c.coupons {
+		if !strings.EqualFold(strings.TrimSpace(item.Code), code) {
+			continue
+		}
+		if item.TenantID != "" && item.TenantID != tenantID {
+			continue
+		}
+		couponType := strings.ToLower(strings.TrimSpace(item.Type))
+		if couponType != entity.CouponTypePercentage && couponType != entity.CouponTypeFixed {
+			continue
+		}
+		if item.Value <= 0 || (couponType == entity.CouponTypePercentage && item.Value > 100) {
+			continue
+		}
+		return &entity.Coupon{
+			Code:        strings.ToUpper(strings.TrimSpace(item.Code)),
+			Type:        couponType,
+			Value:       item.Value,
+			Description: item.Description,
+			MinSubtotal: item.MinSubtotal,
+		}, nil
+	}
+	return nil, cartdomain.ErrCouponNotFound
+}
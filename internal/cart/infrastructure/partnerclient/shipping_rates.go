@@ -0,0 +1,80 @@
+package partnerclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+	pbpartnerv1 "github.com/tuannm99/podzone/pkg/api/proto/partner/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+var _ cartoutputport.ShippingRates = (*ShippingRates)(nil)
+
+type ShippingRates struct {
+	client pbpartnerv1.PartnerServiceClient
+	logger pdlog.Logger
+}
+
+type Params struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    cartconfig.Config
+}
+
+func NewShippingRates(params Params) (*ShippingRates, error) {
+	addr := params.Config.Partner.Addr()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect cart partner client %s: %w", addr, err)
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	params.Logger.Info("cart partner gRPC client connected", "addr", addr)
+	return &ShippingRates{client: pbpartnerv1.NewPartnerServiceClient(conn), logger: params.Logger}, nil
+}
+
+func (s *ShippingRates) ListShippingRates(
+	ctx context.Context,
+	tenantID, region string,
+) ([]entity.ShippingRate, error) {
+	resp, err := s.client.ListShippingRates(ctx, &pbpartnerv1.ListShippingRatesRequest{
+		TenantId: tenantID,
+		Region:   region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list partner shipping rates: %w", err)
+	}
+	rates := make([]entity.ShippingRate, 0, len(resp.GetRates()))
+	for _, rate := range resp.GetRates() {
+		// Partners store costs as free-form strings; a rule we cannot price is skipped rather than quoted as free.
+		cost, err := strconv.ParseFloat(strings.TrimSpace(rate.GetCost()), 64)
+		if err != nil || cost < 0 {
+			s.logger.Warn("skipping unparseable partner shipping cost",
+				"partner_id", rate.GetPartnerId(), "cost", rate.GetCost())
+			continue
+		}
+		rates = append(rates, entity.ShippingRate{
+			PartnerID:   rate.GetPartnerId(),
+			PartnerCode: rate.GetPartnerCode(),
+			PartnerName: rate.GetPartnerName(),
+			Region:      rate.GetRegion(),
+			Cost:        cost,
+			SLADays:     rate.GetSlaDays(),
+		})
+	}
+	return rates, nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+)
+
+var _ cartoutputport.CartRepository = (*RedisRepository)(nil)
+
+// saveCartScript writes the cart (KEYS[1]) and the owner's pointer (KEYS[2], if any) only
+// while the stored cart is still at version ARGV[1], so a load-modify-save that raced another
+// one fails instead of overwriting it.
+var saveCartScript = redis.NewScript(`
+local stored = redis.call('GET', KEYS[1])
+local version = 0
+if stored then
+	version = cjson.decode(stored).version or 0
+end
+if version ~= tonumber(ARGV[1]) then
+	return 0
+end
+local ttl = tonumber(ARGV[3])
+for i, key in ipairs(KEYS) do
+	local value = ARGV[2]
+	if i > 1 then
+		value = ARGV[4]
+	end
+	if ttl > 0 then
+		redis.call('SET', key, value, 'PX', ttl)
+	else
+		redis.call('SET', key, value)
+	end
+end
+return 1
+`)
+
+type Params struct {
+	fx.In
+	RedisClient redis.Cmdable `name:"redis-cart"`
+}
+
+// RedisRepository keeps each cart as one JSON value plus a per-user pointer to the user's cart id.
+// Both keys share the cart TTL so an abandoned cart disappears together with its index. The
+// pointers live under their own prefix so no cart id can name one.
+type RedisRepository struct {
+	client redis.Cmdable
+}
+
+func New(p Params) *RedisRepository {
+	return &RedisRepository{client: p.RedisClient}
+}
+
+func (r *RedisRepository) Get(ctx context.Context, tenantID, id string) (*entity.Cart, error) {
+	raw, err := r.client.Get(ctx, cartKey(tenantID, id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, cartdomain.ErrCartNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get cart: %w", err)
+	}
+	var cart entity.Cart
+	if err := json.Unmarshal(raw, &cart); err != nil {
+		return nil, fmt.Errorf("decode cart: %w", err)
+	}
+	return &cart, nil
+}
+
+func (r *RedisRepository) FindByUser(ctx context.Context, tenantID, userID string) (*entity.Cart, error) {
+	id, err := r.client.Get(ctx, userCartKey(tenantID, userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, cartdomain.ErrCartNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user cart id: %w", err)
+	}
+	return r.Get(ctx, tenantID, id)
+}
+
+func (r *RedisRepository) Save(ctx context.Context, cart entity.Cart, ttl time.Duration) error {
+	expected := cart.Version
+	cart.Version++
+	raw, err := json.Marshal(cart)
+	if err != nil {
+		return fmt.Errorf("encode cart: %w", err)
+	}
+	keys := []string{cartKey(cart.TenantID, cart.ID)}
+	if !cart.IsGuest() {
+		keys = append(keys, userCartKey(cart.TenantID, cart.UserID))
+	}
+	saved, err := saveCartScript.Run(ctx, r.client, keys, expected, raw, ttl.Milliseconds(), cart.ID).Int()
+	if err != nil {
+		return fmt.Errorf("save cart: %w", err)
+	}
+	if saved == 0 {
+		return cartdomain.ErrConcurrentUpdate
+	}
+	return nil
+}
+
+func (r *RedisRepository) Delete(ctx context.Context, cart entity.Cart) error {
+	keys := []string{cartKey(cart.TenantID, cart.ID)}
+	if !cart.IsGuest() {
+		keys = append(keys, userCartKey(cart.TenantID, cart.UserID))
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("delete cart: %w", err)
+	}
+	return nil
+}
+
+func cartKey(tenantID, id string) string {
+	return "cart:" + tenantID + ":" + id
+}
+
+func userCartKey(tenantID, userID string) string {
+	return "cart-user:" + tenantID + ":" + userID
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	"github.com/tuannm99/podzone/internal/cart/domain/cart/entity"
+	"github.com/tuannm99/podzone/pkg/testkit"
+)
+
+func TestRedisRepository_SaveRejectsStaleVersion(t *testing.T) {
+	client := testkit.RedisClient(t)
+	ctx := context.Background()
+	require.NoError(t, client.FlushDB(ctx).Err())
+	repo := New(Params{RedisClient: client})
+
+	cart := entity.Cart{ID: "cart-1", TenantID: "tenant-1", UserID: "7"}
+	require.NoError(t, repo.Save(ctx, cart, time.Hour))
+
+	// A second writer that loaded the cart before the first save lost the race.
+	require.ErrorIs(t, repo.Save(ctx, cart, time.Hour), cartdomain.ErrConcurrentUpdate)
+
+	stored, err := repo.FindByUser(ctx, "tenant-1", "7")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stored.Version)
+	stored.Items = []entity.Item{{ID: "line-1", ProductID: "p-1", Quantity: 1}}
+	require.NoError(t, repo.Save(ctx, *stored, time.Hour))
+
+	stored, err = repo.Get(ctx, "tenant-1", "cart-1")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, stored.Version)
+	require.Len(t, stored.Items, 1)
+
+	// The user pointer is not addressable as a cart.
+	_, err = repo.Get(ctx, "tenant-1", "user:7")
+	require.ErrorIs(t, err, cartdomain.ErrCartNotFound)
+}
@@ -0,0 +1,45 @@
+package cart
+
+import (
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+
+	cartconfig "github.com/tuannm99/podzone/internal/cart/config"
+	"github.com/tuannm99/podzone/internal/cart/controller/grpchandler"
+	cartdomain "github.com/tuannm99/podzone/internal/cart/domain/cart"
+	cartinputport "github.com/tuannm99/podzone/internal/cart/domain/cart/inputport"
+	cartoutputport "github.com/tuannm99/podzone/internal/cart/domain/cart/outputport"
+	"github.com/tuannm99/podzone/internal/cart/infrastructure/catalogclient"
+	"github.com/tuannm99/podzone/internal/cart/infrastructure/coupon"
+	"github.com/tuannm99/podzone/internal/cart/infrastructure/partnerclient"
+	cartrepository "github.com/tuannm99/podzone/internal/cart/infrastructure/repository/cart"
+	pbcartv1 "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		cartconfig.NewConfig,
+		fx.Annotate(cartrepository.New, fx.As(new(cartoutputport.CartRepository))),
+		fx.Annotate(catalogclient.NewProductCatalog, fx.As(new(cartoutputport.ProductCatalog))),
+		fx.Annotate(partnerclient.NewShippingRates, fx.As(new(cartoutputport.ShippingRates))),
+		fx.Annotate(coupon.NewConfigCatalog, fx.As(new(cartoutputport.CouponCatalog))),
+		fx.Annotate(cartdomain.NewCartInteractor, fx.As(new(cartinputport.Usecase))),
+		grpchandler.NewAuthentication,
+		grpchandler.NewCartServer,
+	),
+)
+
+var ServerModule = fx.Options(
+	Module,
+	fx.Invoke(RegisterGRPCServer),
+)
+
+func RegisterGRPCServer(
+	server *grpc.Server,
+	cartServer *grpchandler.CartServer,
+	logger pdlog.Logger,
+) {
+	logger.Info("Registering Cart GRPC handler")
+	pbcartv1.RegisterCartServiceServer(server, cartServer)
+}
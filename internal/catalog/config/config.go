@@ -0,0 +1,67 @@
+package config
+
+import (
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type IAMConfig struct {
+	GRPCHost string `koanf:"grpc_host"`
+	GRPCPort string `koanf:"grpc_port"`
+}
+
+type Config struct {
+	Authn    pdauthn.Config
+	IAM      IAMConfig
+	Database string
+	// TrustedProxies, normally the gateway, may forward the client IP and transport that
+	// catalog passes on to IAM permission checks.
+	TrustedProxies pdproxy.TrustedProxies
+}
+
+func NewConfig(k *koanf.Koanf) Config {
+	cfg := Config{
+		Authn: pdauthn.Config{
+			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
+			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
+		},
+	}
+	var iamHost, iamPort string
+	if k != nil {
+		if cfg.Authn.JWTSecret == "" {
+			cfg.Authn.JWTSecret = k.String("catalog.auth.jwt_secret")
+		}
+		if cfg.Authn.JWTKey == "" {
+			cfg.Authn.JWTKey = k.String("catalog.auth.jwt_key")
+		}
+		if cfg.Authn.JWKSURL == "" {
+			cfg.Authn.JWKSURL = k.String("catalog.auth.jwks_url")
+		}
+		cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "catalog.auth.legacy_hs256_until")
+		iamHost = k.String("catalog.iam.grpc_host")
+		iamPort = k.String("catalog.iam.grpc_port")
+		cfg.Database = k.String("mongo.catalog.database")
+	}
+	cfg.TrustedProxies = pdproxy.LoadTrustedProxies(k, "catalog.trusted_proxies")
+	cfg.IAM = IAMConfig{
+		GRPCHost: toolkit.GetEnv("IAM_GRPC_HOST", firstNonEmpty(iamHost, "iam-service")),
+		GRPCPort: toolkit.GetEnv("IAM_GRPC_PORT", firstNonEmpty(iamPort, "50053")),
+	}
+	if cfg.Database == "" {
+		cfg.Database = "catalog"
+	}
+	return cfg
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
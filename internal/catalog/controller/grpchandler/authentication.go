@@ -0,0 +1,72 @@
+package grpchandler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	catalogconfig "github.com/tuannm99/podzone/internal/catalog/config"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// TenantHeader is the metadata key anonymous storefront callers use to pick the catalog they browse.
+const TenantHeader = "x-tenant-id"
+
+// Authentication resolves the tenant and optional user for a catalog call.
+// Storefront reads are anonymous and scoped by TenantHeader; admin writes carry a bearer token.
+type Authentication struct {
+	verifier *pdauthn.Verifier
+	proxies  pdproxy.TrustedProxies
+}
+
+func NewAuthentication(cfg catalogconfig.Config) *Authentication {
+	return &Authentication{verifier: pdauthn.NewVerifier(cfg.Authn), proxies: cfg.TrustedProxies}
+}
+
+func (a *Authentication) Scope(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestedTenantID := strings.TrimSpace(firstMetadataValue(md, TenantHeader))
+
+	tenantID := requestedTenantID
+	header := strings.TrimSpace(firstMetadataValue(md, "authorization"))
+	if header != "" {
+		claims, err := a.verifier.ClaimsFromContext(ctx)
+		if err != nil {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if claims.UserID == 0 {
+			return ctx, status.Error(codes.Unauthenticated, "authorization token missing user_id")
+		}
+		activeTenantID := strings.TrimSpace(claims.ActiveTenantID)
+		if activeTenantID != "" && requestedTenantID != "" && activeTenantID != requestedTenantID {
+			return ctx, status.Error(codes.PermissionDenied, "tenant header does not match active session")
+		}
+		if activeTenantID != "" {
+			tenantID = activeTenantID
+		}
+		ctx = toolkit.WithUserID(ctx, strconv.FormatUint(uint64(claims.UserID), 10))
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", header)
+		ctx = a.proxies.ForwardIncomingClient(ctx)
+	}
+	if tenantID == "" {
+		return ctx, status.Error(codes.InvalidArgument, "tenant id is required ("+TenantHeader+" or active session)")
+	}
+	return toolkit.WithTenantID(ctx, tenantID), nil
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if md == nil {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
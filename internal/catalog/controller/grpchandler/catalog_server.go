@@ -0,0 +1,311 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	catalogmapper "github.com/tuannm99/podzone/internal/catalog/controller/mapper"
+	catalogdomain "github.com/tuannm99/podzone/internal/catalog/domain/catalog"
+	cataloginputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/inputport"
+	pbcatalogv1 "github.com/tuannm99/podzone/pkg/api/proto/catalog/v1"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+type CatalogServer struct {
+	pbcatalogv1.UnimplementedCatalogServiceServer
+	uc   cataloginputport.Usecase
+	auth *Authentication
+}
+
+func NewCatalogServer(uc cataloginputport.Usecase, auth *Authentication) *CatalogServer {
+	return &CatalogServer{uc: uc, auth: auth}
+}
+
+func (s *CatalogServer) GetProduct(
+	ctx context.Context,
+	req *pbcatalogv1.GetProductRequest,
+) (*pbcatalogv1.Product, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.GetProduct(ctx, req.GetId())
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProduct(out), nil
+}
+
+func (s *CatalogServer) ListProducts(
+	ctx context.Context,
+	req *pbcatalogv1.ListProductsRequest,
+) (*pbcatalogv1.ListProductsResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.uc.ListProducts(ctx, cataloginputport.ListProductsQuery{
+		CategoryID:      req.GetCategoryId(),
+		MinPrice:        req.GetMinPrice(),
+		MaxPrice:        req.GetMaxPrice(),
+		Tags:            req.GetTags(),
+		IncludeInactive: req.GetIncludeInactive(),
+		Collection: catalogmapper.ToCollectionQuery(
+			req.GetPage(),
+			req.GetPageSize(),
+			req.GetSortBy(),
+			req.GetSortDesc(),
+		),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProductPage(page), nil
+}
+
+func (s *CatalogServer) SearchProducts(
+	ctx context.Context,
+	req *pbcatalogv1.SearchProductsRequest,
+) (*pbcatalogv1.ListProductsResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.uc.SearchProducts(ctx, cataloginputport.ListProductsQuery{
+		Search:     req.GetQuery(),
+		CategoryID: req.GetCategoryId(),
+		MinPrice:   req.GetMinPrice(),
+		MaxPrice:   req.GetMaxPrice(),
+		Tags:       req.GetTags(),
+		Collection: catalogmapper.ToCollectionQuery(req.GetPage(), req.GetPageSize(), "", true),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProductPage(page), nil
+}
+
+func (s *CatalogServer) CreateProduct(
+	ctx context.Context,
+	req *pbcatalogv1.CreateProductRequest,
+) (*pbcatalogv1.Product, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.CreateProduct(ctx, cataloginputport.CreateProductCommand{
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		SKU:            req.GetSku(),
+		Slug:           req.GetSlug(),
+		Price:          req.GetPrice(),
+		SalePrice:      req.GetSalePrice(),
+		InventoryCount: req.GetInventoryCount(),
+		CategoryID:     req.GetCategoryId(),
+		ImageURLs:      req.GetImageUrls(),
+		Attributes:     req.GetAttributes(),
+		Active:         req.GetActive(),
+		Weight:         req.GetWeight(),
+		Dimensions:     catalogmapper.FromProtoDimensions(req.GetDimensions()),
+		Tags:           req.GetTags(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProduct(out), nil
+}
+
+func (s *CatalogServer) UpdateProduct(
+	ctx context.Context,
+	req *pbcatalogv1.UpdateProductRequest,
+) (*pbcatalogv1.Product, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.UpdateProduct(ctx, cataloginputport.UpdateProductCommand{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Slug:        req.GetSlug(),
+		Price:       req.GetPrice(),
+		SalePrice:   req.GetSalePrice(),
+		CategoryID:  req.GetCategoryId(),
+		ImageURLs:   req.GetImageUrls(),
+		Attributes:  req.GetAttributes(),
+		Active:      req.GetActive(),
+		Weight:      req.GetWeight(),
+		Dimensions:  catalogmapper.FromProtoDimensions(req.GetDimensions()),
+		Tags:        req.GetTags(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProduct(out), nil
+}
+
+func (s *CatalogServer) DeleteProduct(
+	ctx context.Context,
+	req *pbcatalogv1.DeleteProductRequest,
+) (*emptypb.Empty, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteProduct(ctx, req.GetId()); err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CatalogServer) GetCategory(
+	ctx context.Context,
+	req *pbcatalogv1.GetCategoryRequest,
+) (*pbcatalogv1.Category, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.GetCategory(ctx, req.GetId())
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoCategory(out), nil
+}
+
+func (s *CatalogServer) ListCategories(
+	ctx context.Context,
+	req *pbcatalogv1.ListCategoriesRequest,
+) (*pbcatalogv1.ListCategoriesResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListCategories(ctx, cataloginputport.ListCategoriesQuery{
+		ParentID:        req.GetParentId(),
+		IncludeInactive: req.GetIncludeInactive(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	categories := make([]*pbcatalogv1.Category, 0, len(items))
+	for i := range items {
+		categories = append(categories, catalogmapper.ToProtoCategory(&items[i]))
+	}
+	return &pbcatalogv1.ListCategoriesResponse{
+		Categories: categories,
+		Total:      int32(len(categories)),
+	}, nil
+}
+
+func (s *CatalogServer) CreateCategory(
+	ctx context.Context,
+	req *pbcatalogv1.CreateCategoryRequest,
+) (*pbcatalogv1.Category, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.CreateCategory(ctx, cataloginputport.CreateCategoryCommand{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Slug:        req.GetSlug(),
+		ParentID:    req.GetParentId(),
+		ImageURL:    req.GetImageUrl(),
+		Active:      req.GetActive(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoCategory(out), nil
+}
+
+func (s *CatalogServer) UpdateCategory(
+	ctx context.Context,
+	req *pbcatalogv1.UpdateCategoryRequest,
+) (*pbcatalogv1.Category, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.UpdateCategory(ctx, cataloginputport.UpdateCategoryCommand{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Slug:        req.GetSlug(),
+		ParentID:    req.GetParentId(),
+		ImageURL:    req.GetImageUrl(),
+		Active:      req.GetActive(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoCategory(out), nil
+}
+
+func (s *CatalogServer) DeleteCategory(
+	ctx context.Context,
+	req *pbcatalogv1.DeleteCategoryRequest,
+) (*emptypb.Empty, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteCategory(ctx, req.GetId()); err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CatalogServer) UpdateInventory(
+	ctx context.Context,
+	req *pbcatalogv1.UpdateInventoryRequest,
+) (*pbcatalogv1.Product, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.uc.UpdateInventory(ctx, cataloginputport.UpdateInventoryCommand{
+		ProductID:      req.GetId(),
+		InventoryCount: req.GetInventoryCount(),
+		Reason:         req.GetReason(),
+	})
+	if err != nil {
+		return nil, catalogStatusError(err)
+	}
+	return catalogmapper.ToProtoProduct(out), nil
+}
+
+func catalogStatusError(err error) error {
+	switch {
+	case errors.Is(err, catalogdomain.ErrProductNotFound),
+		errors.Is(err, catalogdomain.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, catalogdomain.ErrSKUTaken),
+		errors.Is(err, catalogdomain.ErrSlugTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, catalogdomain.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, catalogdomain.ErrCategoryInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, catalogdomain.ErrTenantRequired),
+		errors.Is(err, catalogdomain.ErrProductIDRequired),
+		errors.Is(err, catalogdomain.ErrCategoryIDRequired),
+		errors.Is(err, catalogdomain.ErrNameRequired),
+		errors.Is(err, catalogdomain.ErrSKURequired),
+		errors.Is(err, catalogdomain.ErrInvalidSlug),
+		errors.Is(err, catalogdomain.ErrInvalidPrice),
+		errors.Is(err, catalogdomain.ErrInvalidPriceRange),
+		errors.Is(err, catalogdomain.ErrInvalidInventory),
+		errors.Is(err, catalogdomain.ErrCategoryCycle),
+		errors.Is(err, catalogdomain.ErrSearchQueryRequired),
+		errors.Is(err, collection.ErrInvalidQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,104 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	pbcatalogv1 "github.com/tuannm99/podzone/pkg/api/proto/catalog/v1"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+func ToProtoProduct(in *entity.Product) *pbcatalogv1.Product {
+	if in == nil {
+		return nil
+	}
+	return &pbcatalogv1.Product{
+		Id:             in.ID,
+		Name:           in.Name,
+		Description:    in.Description,
+		Sku:            in.SKU,
+		Price:          in.Price,
+		SalePrice:      in.SalePrice,
+		InventoryCount: in.InventoryCount,
+		CategoryId:     in.CategoryID,
+		ImageUrls:      append([]string(nil), in.ImageURLs...),
+		Attributes:     in.Attributes,
+		Active:         in.Active,
+		Slug:           in.Slug,
+		Weight:         in.Weight,
+		Dimensions:     ToProtoDimensions(in.Dimensions),
+		Tags:           append([]string(nil), in.Tags...),
+		CreatedAt:      timestamppb.New(in.CreatedAt),
+		UpdatedAt:      timestamppb.New(in.UpdatedAt),
+	}
+}
+
+func ToProtoProductPage(page collection.Page[entity.Product]) *pbcatalogv1.ListProductsResponse {
+	products := make([]*pbcatalogv1.Product, 0, len(page.Items))
+	for i := range page.Items {
+		products = append(products, ToProtoProduct(&page.Items[i]))
+	}
+	return &pbcatalogv1.ListProductsResponse{
+		Products:   products,
+		Total:      int32(page.Total),
+		Page:       int32(page.Page),
+		PageSize:   int32(page.PageSize),
+		TotalPages: int32(page.TotalPages),
+	}
+}
+
+func ToProtoCategory(in *entity.Category) *pbcatalogv1.Category {
+	if in == nil {
+		return nil
+	}
+	return &pbcatalogv1.Category{
+		Id:           in.ID,
+		Name:         in.Name,
+		Description:  in.Description,
+		Slug:         in.Slug,
+		ParentId:     in.ParentID,
+		ImageUrl:     in.ImageURL,
+		ProductCount: in.ProductCount,
+		Active:       in.Active,
+		CreatedAt:    timestamppb.New(in.CreatedAt),
+		UpdatedAt:    timestamppb.New(in.UpdatedAt),
+	}
+}
+
+func ToProtoDimensions(in *entity.Dimensions) *pbcatalogv1.Dimensions {
+	if in == nil {
+		return nil
+	}
+	return &pbcatalogv1.Dimensions{
+		Length: in.Length,
+		Width:  in.Width,
+		Height: in.Height,
+		Unit:   in.Unit,
+	}
+}
+
+func FromProtoDimensions(in *pbcatalogv1.Dimensions) *entity.Dimensions {
+	if in == nil {
+		return nil
+	}
+	return &entity.Dimensions{
+		Length: in.GetLength(),
+		Width:  in.GetWidth(),
+		Height: in.GetHeight(),
+		Unit:   in.GetUnit(),
+	}
+}
+
+// ToCollectionQuery adapts the catalog's flat paging fields to the shared collection contract.
+func ToCollectionQuery(page, pageSize int32, sortBy string, sortDesc bool) collection.Query {
+	direction := collection.SortAscending
+	if sortDesc || sortBy == "" {
+		direction = collection.SortDescending
+	}
+	return collection.Query{
+		Page:          int(page),
+		PageSize:      int(pageSize),
+		SortBy:        sortBy,
+		SortDirection: direction,
+	}.Normalize()
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Category struct {
+	ID           string    `bson:"_id"         json:"id"`
+	TenantID     string    `bson:"tenant_id"   json:"tenant_id"`
+	Name         string    `bson:"name"        json:"name"`
+	Description  string    `bson:"description" json:"description"`
+	Slug         string    `bson:"slug"        json:"slug"`
+	ParentID     string    `bson:"parent_id"   json:"parent_id"`
+	ImageURL     string    `bson:"image_url"   json:"image_url"`
+	Active       bool      `bson:"active"      json:"active"`
+	ProductCount int32     `bson:"-"           json:"product_count"`
+	CreatedAt    time.Time `bson:"created_at"  json:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at"  json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// InventoryAdjustment is the append-only ledger entry written for every stock change.
+type InventoryAdjustment struct {
+	ID            string    `bson:"_id"            json:"id"`
+	TenantID      string    `bson:"tenant_id"      json:"tenant_id"`
+	ProductID     string    `bson:"product_id"     json:"product_id"`
+	PreviousCount int32     `bson:"previous_count" json:"previous_count"`
+	NewCount      int32     `bson:"new_count"      json:"new_count"`
+	Delta         int32     `bson:"delta"          json:"delta"`
+	Reason        string    `bson:"reason"         json:"reason"`
+	ActorID       string    `bson:"actor_id"       json:"actor_id"`
+	CreatedAt     time.Time `bson:"created_at"     json:"created_at"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type Dimensions struct {
+	Length float64 `bson:"length" json:"length"`
+	Width  float64 `bson:"width"  json:"width"`
+	Height float64 `bson:"height" json:"height"`
+	Unit   string  `bson:"unit"   json:"unit"`
+}
+
+type Product struct {
+	ID             string            `bson:"_id"                  json:"id"`
+	TenantID       string            `bson:"tenant_id"            json:"tenant_id"`
+	Name           string            `bson:"name"                 json:"name"`
+	Description    string            `bson:"description"          json:"description"`
+	SKU            string            `bson:"sku"                  json:"sku"`
+	Slug           string            `bson:"slug"                 json:"slug"`
+	Price          float64           `bson:"price"                json:"price"`
+	SalePrice      float64           `bson:"sale_price"           json:"sale_price"`
+	InventoryCount int32             `bson:"inventory_count"      json:"inventory_count"`
+	CategoryID     string            `bson:"category_id"          json:"category_id"`
+	ImageURLs      []string          `bson:"image_urls"           json:"image_urls"`
+	Attributes     map[string]string `bson:"attributes,omitempty" json:"attributes,omitempty"`
+	Active         bool              `bson:"active"               json:"active"`
+	Weight         float64           `bson:"weight"               json:"weight"`
+	Dimensions     *Dimensions       `bson:"dimensions,omitempty" json:"dimensions,omitempty"`
+	Tags           []string          `bson:"tags"                 json:"tags"`
+	CreatedAt      time.Time         `bson:"created_at"           json:"created_at"`
+	UpdatedAt      time.Time         `bson:"updated_at"           json:"updated_at"`
+}
+
+// EffectivePrice is the price a customer pays today: the sale price when one is set below the list price.
+func (p Product) EffectivePrice() float64 {
+	if p.SalePrice > 0 && p.SalePrice < p.Price {
+		return p.SalePrice
+	}
+	return p.Price
+}
+
+// InStock reports whether at least quantity units can be sold.
+func (p Product) InStock(quantity int32) bool {
+	return quantity > 0 && p.InventoryCount >= quantity
+}
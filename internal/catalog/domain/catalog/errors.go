@@ -0,0 +1,23 @@
+package catalog
+
+import "errors"
+
+var (
+	ErrTenantRequired      = errors.New("tenant_id is required")
+	ErrAccessDenied        = errors.New("catalog access denied")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrProductIDRequired   = errors.New("product id is required")
+	ErrCategoryIDRequired  = errors.New("category id is required")
+	ErrNameRequired        = errors.New("name is required")
+	ErrSKURequired         = errors.New("sku is required")
+	ErrInvalidSlug         = errors.New("invalid slug")
+	ErrInvalidPrice        = errors.New("invalid price")
+	ErrInvalidInventory    = errors.New("inventory count must not be negative")
+	ErrInvalidPriceRange   = errors.New("min_price must not exceed max_price")
+	ErrSKUTaken            = errors.New("sku already exists in tenant catalog")
+	ErrSlugTaken           = errors.New("slug already exists in tenant catalog")
+	ErrCategoryCycle       = errors.New("category cannot be its own ancestor")
+	ErrCategoryInUse       = errors.New("category still has products or subcategories")
+	ErrSearchQueryRequired = errors.New("search query is required")
+)
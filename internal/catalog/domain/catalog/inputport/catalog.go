@@ -0,0 +1,98 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+type CreateProductCommand struct {
+	Name           string
+	Description    string
+	SKU            string
+	Slug           string
+	Price          float64
+	SalePrice      float64
+	InventoryCount int32
+	CategoryID     string
+	ImageURLs      []string
+	Attributes     map[string]string
+	Active         bool
+	Weight         float64
+	Dimensions     *entity.Dimensions
+	Tags           []string
+}
+
+type UpdateProductCommand struct {
+	ID          string
+	Name        string
+	Description string
+	Slug        string
+	Price       float64
+	SalePrice   float64
+	CategoryID  string
+	ImageURLs   []string
+	Attributes  map[string]string
+	Active      bool
+	Weight      float64
+	Dimensions  *entity.Dimensions
+	Tags        []string
+}
+
+type ListProductsQuery struct {
+	CategoryID      string
+	MinPrice        float64
+	MaxPrice        float64
+	Tags            []string
+	IncludeInactive bool
+	Search          string
+	Collection      collection.Query
+}
+
+type CreateCategoryCommand struct {
+	Name        string
+	Description string
+	Slug        string
+	ParentID    string
+	ImageURL    string
+	Active      bool
+}
+
+type UpdateCategoryCommand struct {
+	ID          string
+	Name        string
+	Description string
+	Slug        string
+	ParentID    string
+	ImageURL    string
+	Active      bool
+}
+
+type ListCategoriesQuery struct {
+	ParentID        string
+	IncludeInactive bool
+}
+
+type UpdateInventoryCommand struct {
+	ProductID      string
+	InventoryCount int32
+	Reason         string
+}
+
+type Usecase interface {
+	GetProduct(ctx context.Context, id string) (*entity.Product, error)
+	ListProducts(ctx context.Context, query ListProductsQuery) (collection.Page[entity.Product], error)
+	SearchProducts(ctx context.Context, query ListProductsQuery) (collection.Page[entity.Product], error)
+	CreateProduct(ctx context.Context, cmd CreateProductCommand) (*entity.Product, error)
+	UpdateProduct(ctx context.Context, cmd UpdateProductCommand) (*entity.Product, error)
+	DeleteProduct(ctx context.Context, id string) error
+
+	GetCategory(ctx context.Context, id string) (*entity.Category, error)
+	ListCategories(ctx context.Context, query ListCategoriesQuery) ([]entity.Category, error)
+	CreateCategory(ctx context.Context, cmd CreateCategoryCommand) (*entity.Category, error)
+	UpdateCategory(ctx context.Context, cmd UpdateCategoryCommand) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, id string) error
+
+	UpdateInventory(ctx context.Context, cmd UpdateInventoryCommand) (*entity.Product, error)
+}
@@ -0,0 +1,587 @@
+package catalog
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	cataloginputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/inputport"
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// maxCategoryDepth bounds the parent walk used for cycle detection.
+const maxCategoryDepth = 32
+
+var _ cataloginputport.Usecase = (*CatalogInteractor)(nil)
+
+type CatalogInteractor struct {
+	products   catalogoutputport.ProductRepository
+	categories catalogoutputport.CategoryRepository
+	ledger     catalogoutputport.InventoryLedger
+	authorizer catalogoutputport.AccessAuthorizer
+	now        func() time.Time
+}
+
+type CatalogInteractorParams struct {
+	fx.In
+
+	Products   catalogoutputport.ProductRepository
+	Categories catalogoutputport.CategoryRepository
+	Ledger     catalogoutputport.InventoryLedger
+	Authorizer catalogoutputport.AccessAuthorizer
+}
+
+func NewCatalogInteractor(params CatalogInteractorParams) *CatalogInteractor {
+	return &CatalogInteractor{
+		products:   params.Products,
+		categories: params.Categories,
+		ledger:     params.Ledger,
+		authorizer: params.Authorizer,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *CatalogInteractor) GetProduct(ctx context.Context, id string) (*entity.Product, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrProductIDRequired
+	}
+	product, err := s.products.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !product.Active && s.authorizeRead(ctx, tenantID) != nil {
+		// Hidden products look exactly like missing ones to storefront callers.
+		return nil, ErrProductNotFound
+	}
+	return product, nil
+}
+
+func (s *CatalogInteractor) ListProducts(
+	ctx context.Context,
+	query cataloginputport.ListProductsQuery,
+) (collection.Page[entity.Product], error) {
+	query.Search = ""
+	return s.listProducts(ctx, query)
+}
+
+func (s *CatalogInteractor) SearchProducts(
+	ctx context.Context,
+	query cataloginputport.ListProductsQuery,
+) (collection.Page[entity.Product], error) {
+	query.Search = strings.TrimSpace(query.Search)
+	if query.Search == "" {
+		return collection.Page[entity.Product]{}, ErrSearchQueryRequired
+	}
+	query.IncludeInactive = false
+	return s.listProducts(ctx, query)
+}
+
+func (s *CatalogInteractor) listProducts(
+	ctx context.Context,
+	query cataloginputport.ListProductsQuery,
+) (collection.Page[entity.Product], error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return collection.Page[entity.Product]{}, err
+	}
+	if query.MinPrice < 0 || query.MaxPrice < 0 {
+		return collection.Page[entity.Product]{}, ErrInvalidPrice
+	}
+	if query.MaxPrice > 0 && query.MinPrice > query.MaxPrice {
+		return collection.Page[entity.Product]{}, ErrInvalidPriceRange
+	}
+	if query.IncludeInactive {
+		if err := s.authorizeRead(ctx, tenantID); err != nil {
+			return collection.Page[entity.Product]{}, err
+		}
+	}
+	return s.products.List(ctx, catalogoutputport.ProductFilter{
+		TenantID:        tenantID,
+		CategoryID:      strings.TrimSpace(query.CategoryID),
+		MinPrice:        query.MinPrice,
+		MaxPrice:        query.MaxPrice,
+		Tags:            normalizeTags(query.Tags),
+		IncludeInactive: query.IncludeInactive,
+		Search:          query.Search,
+	}, query.Collection.Normalize())
+}
+
+func (s *CatalogInteractor) CreateProduct(
+	ctx context.Context,
+	cmd cataloginputport.CreateProductCommand,
+) (*entity.Product, error) {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	sku := strings.ToUpper(strings.TrimSpace(cmd.SKU))
+	if sku == "" {
+		return nil, ErrSKURequired
+	}
+	slug, err := resolveSlug(cmd.Slug, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePricing(cmd.Price, cmd.SalePrice); err != nil {
+		return nil, err
+	}
+	if cmd.InventoryCount < 0 {
+		return nil, ErrInvalidInventory
+	}
+	categoryID := strings.TrimSpace(cmd.CategoryID)
+	if categoryID != "" {
+		if _, err := s.categories.FindByID(ctx, tenantID, categoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.now()
+	return s.products.Create(ctx, entity.Product{
+		ID:             uuid.NewString(),
+		TenantID:       tenantID,
+		Name:           name,
+		Description:    strings.TrimSpace(cmd.Description),
+		SKU:            sku,
+		Slug:           slug,
+		Price:          cmd.Price,
+		SalePrice:      cmd.SalePrice,
+		InventoryCount: cmd.InventoryCount,
+		CategoryID:     categoryID,
+		ImageURLs:      normalizeURLs(cmd.ImageURLs),
+		Attributes:     normalizeAttributes(cmd.Attributes),
+		Active:         cmd.Active,
+		Weight:         math.Max(cmd.Weight, 0),
+		Dimensions:     cmd.Dimensions,
+		Tags:           normalizeTags(cmd.Tags),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+}
+
+func (s *CatalogInteractor) UpdateProduct(
+	ctx context.Context,
+	cmd cataloginputport.UpdateProductCommand,
+) (*entity.Product, error) {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := strings.TrimSpace(cmd.ID)
+	if id == "" {
+		return nil, ErrProductIDRequired
+	}
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	if err := validatePricing(cmd.Price, cmd.SalePrice); err != nil {
+		return nil, err
+	}
+	current, err := s.products.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	slug := current.Slug
+	if strings.TrimSpace(cmd.Slug) != "" {
+		if slug, err = resolveSlug(cmd.Slug, name); err != nil {
+			return nil, err
+		}
+	}
+	categoryID := strings.TrimSpace(cmd.CategoryID)
+	if categoryID != "" && categoryID != current.CategoryID {
+		if _, err := s.categories.FindByID(ctx, tenantID, categoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	current.Name = name
+	current.Description = strings.TrimSpace(cmd.Description)
+	current.Slug = slug
+	current.Price = cmd.Price
+	current.SalePrice = cmd.SalePrice
+	current.CategoryID = categoryID
+	current.ImageURLs = normalizeURLs(cmd.ImageURLs)
+	current.Attributes = normalizeAttributes(cmd.Attributes)
+	current.Active = cmd.Active
+	current.Weight = math.Max(cmd.Weight, 0)
+	current.Dimensions = cmd.Dimensions
+	current.Tags = normalizeTags(cmd.Tags)
+	current.UpdatedAt = s.now()
+	return s.products.Update(ctx, *current)
+}
+
+func (s *CatalogInteractor) DeleteProduct(ctx context.Context, id string) error {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return err
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrProductIDRequired
+	}
+	return s.products.Delete(ctx, tenantID, id)
+}
+
+func (s *CatalogInteractor) GetCategory(ctx context.Context, id string) (*entity.Category, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrCategoryIDRequired
+	}
+	category, err := s.categories.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !category.Active && s.authorizeRead(ctx, tenantID) != nil {
+		return nil, ErrCategoryNotFound
+	}
+	categories := []entity.Category{*category}
+	if err := s.attachProductCounts(ctx, tenantID, categories); err != nil {
+		return nil, err
+	}
+	return &categories[0], nil
+}
+
+func (s *CatalogInteractor) ListCategories(
+	ctx context.Context,
+	query cataloginputport.ListCategoriesQuery,
+) ([]entity.Category, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.IncludeInactive {
+		if err := s.authorizeRead(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	}
+	categories, err := s.categories.List(
+		ctx,
+		tenantID,
+		strings.TrimSpace(query.ParentID),
+		query.IncludeInactive,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachProductCounts(ctx, tenantID, categories); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (s *CatalogInteractor) CreateCategory(
+	ctx context.Context,
+	cmd cataloginputport.CreateCategoryCommand,
+) (*entity.Category, error) {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	slug, err := resolveSlug(cmd.Slug, name)
+	if err != nil {
+		return nil, err
+	}
+	parentID := strings.TrimSpace(cmd.ParentID)
+	if parentID != "" {
+		if _, err := s.categories.FindByID(ctx, tenantID, parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.now()
+	return s.categories.Create(ctx, entity.Category{
+		ID:          uuid.NewString(),
+		TenantID:    tenantID,
+		Name:        name,
+		Description: strings.TrimSpace(cmd.Description),
+		Slug:        slug,
+		ParentID:    parentID,
+		ImageURL:    strings.TrimSpace(cmd.ImageURL),
+		Active:      cmd.Active,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+}
+
+func (s *CatalogInteractor) UpdateCategory(
+	ctx context.Context,
+	cmd cataloginputport.UpdateCategoryCommand,
+) (*entity.Category, error) {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := strings.TrimSpace(cmd.ID)
+	if id == "" {
+		return nil, ErrCategoryIDRequired
+	}
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	current, err := s.categories.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	slug := current.Slug
+	if strings.TrimSpace(cmd.Slug) != "" {
+		if slug, err = resolveSlug(cmd.Slug, name); err != nil {
+			return nil, err
+		}
+	}
+	parentID := strings.TrimSpace(cmd.ParentID)
+	if parentID != "" && parentID != current.ParentID {
+		if err := s.ensureNoCategoryCycle(ctx, tenantID, id, parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	current.Name = name
+	current.Description = strings.TrimSpace(cmd.Description)
+	current.Slug = slug
+	current.ParentID = parentID
+	current.ImageURL = strings.TrimSpace(cmd.ImageURL)
+	current.Active = cmd.Active
+	current.UpdatedAt = s.now()
+	return s.categories.Update(ctx, *current)
+}
+
+func (s *CatalogInteractor) DeleteCategory(ctx context.Context, id string) error {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return err
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ErrCategoryIDRequired
+	}
+	if _, err := s.categories.FindByID(ctx, tenantID, id); err != nil {
+		return err
+	}
+	children, err := s.categories.CountChildren(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	counts, err := s.products.CountByCategory(ctx, tenantID, []string{id})
+	if err != nil {
+		return err
+	}
+	if children > 0 || counts[id] > 0 {
+		return ErrCategoryInUse
+	}
+	return s.categories.Delete(ctx, tenantID, id)
+}
+
+func (s *CatalogInteractor) UpdateInventory(
+	ctx context.Context,
+	cmd cataloginputport.UpdateInventoryCommand,
+) (*entity.Product, error) {
+	tenantID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := strings.TrimSpace(cmd.ProductID)
+	if id == "" {
+		return nil, ErrProductIDRequired
+	}
+	if cmd.InventoryCount < 0 {
+		return nil, ErrInvalidInventory
+	}
+	previous, err := s.products.SetInventory(ctx, tenantID, id, cmd.InventoryCount)
+	if err != nil {
+		return nil, err
+	}
+	actorID, _ := toolkit.GetUserID(ctx)
+	if err := s.ledger.Record(ctx, entity.InventoryAdjustment{
+		ID:            uuid.NewString(),
+		TenantID:      tenantID,
+		ProductID:     id,
+		PreviousCount: previous,
+		NewCount:      cmd.InventoryCount,
+		Delta:         cmd.InventoryCount - previous,
+		Reason:        strings.TrimSpace(cmd.Reason),
+		ActorID:       actorID,
+		CreatedAt:     s.now(),
+	}); err != nil {
+		return nil, err
+	}
+	return s.products.FindByID(ctx, tenantID, id)
+}
+
+func (s *CatalogInteractor) attachProductCounts(
+	ctx context.Context,
+	tenantID string,
+	categories []entity.Category,
+) error {
+	if len(categories) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+	counts, err := s.products.CountByCategory(ctx, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	for i := range categories {
+		categories[i].ProductCount = counts[categories[i].ID]
+	}
+	return nil
+}
+
+func (s *CatalogInteractor) ensureNoCategoryCycle(ctx context.Context, tenantID, id, parentID string) error {
+	cursor := parentID
+	for depth := 0; cursor != "" && depth < maxCategoryDepth; depth++ {
+		if cursor == id {
+			return ErrCategoryCycle
+		}
+		parent, err := s.categories.FindByID(ctx, tenantID, cursor)
+		if err != nil {
+			return err
+		}
+		cursor = parent.ParentID
+	}
+	if cursor != "" {
+		return ErrCategoryCycle
+	}
+	return nil
+}
+
+func (s *CatalogInteractor) authorizeManage(ctx context.Context) (string, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return "", ErrAccessDenied
+	}
+	if err := s.authorizer.AuthorizeCatalogManage(ctx, tenantID, userID); err != nil {
+		return "", err
+	}
+	return tenantID, nil
+}
+
+func (s *CatalogInteractor) authorizeRead(ctx context.Context, tenantID string) error {
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return ErrAccessDenied
+	}
+	return s.authorizer.AuthorizeCatalogRead(ctx, tenantID, userID)
+}
+
+func tenantFromContext(ctx context.Context) (string, error) {
+	tenantID, err := toolkit.GetTenantID(ctx)
+	if err != nil || strings.TrimSpace(tenantID) == "" {
+		return "", ErrTenantRequired
+	}
+	return strings.TrimSpace(tenantID), nil
+}
+
+func validatePricing(price, salePrice float64) error {
+	if price < 0 || salePrice < 0 || math.IsNaN(price) || math.IsNaN(salePrice) {
+		return ErrInvalidPrice
+	}
+	if salePrice > price {
+		return ErrInvalidPrice
+	}
+	return nil
+}
+
+func resolveSlug(raw, fallback string) (string, error) {
+	slug := NormalizeSlug(raw)
+	if slug == "" && strings.TrimSpace(raw) == "" {
+		slug = NormalizeSlug(fallback)
+	}
+	if slug == "" {
+		return "", ErrInvalidSlug
+	}
+	return slug, nil
+}
+
+// NormalizeSlug lowercases raw and collapses every run of non-alphanumerics into a single dash.
+func NormalizeSlug(raw string) string {
+	raw = strings.TrimSpace(strings.ToLower(raw))
+	var b strings.Builder
+	lastDash := false
+	for _, r := range raw {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastDash = false
+		default:
+			if !lastDash && b.Len() > 0 {
+				b.WriteByte('-')
+				lastDash = true
+			}
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+func normalizeTags(items []string) []string {
+	seen := make(map[string]struct{}, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		tag := strings.TrimSpace(strings.ToLower(item))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	return out
+}
+
+func normalizeURLs(items []string) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if url := strings.TrimSpace(item); url != "" {
+			out = append(out, url)
+		}
+	}
+	return out
+}
+
+func normalizeAttributes(items map[string]string) map[string]string {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(items))
+	for key, value := range items {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		out[key] = strings.TrimSpace(value)
+	}
+	return out
+}
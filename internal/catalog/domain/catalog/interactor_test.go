@@ -0,0 +1,253 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	cataloginputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/inputport"
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	catalogmocks "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type catalogFixture struct {
+	svc        *CatalogInteractor
+	products   *catalogmocks.MockProductRepository
+	categories *catalogmocks.MockCategoryRepository
+	ledger     *catalogmocks.MockInventoryLedger
+	authorizer *catalogmocks.MockAccessAuthorizer
+}
+
+func setupCatalogInteractor(t *testing.T) catalogFixture {
+	t.Helper()
+	f := catalogFixture{
+		products:   catalogmocks.NewMockProductRepository(t),
+		categories: catalogmocks.NewMockCategoryRepository(t),
+		ledger:     catalogmocks.NewMockInventoryLedger(t),
+		authorizer: catalogmocks.NewMockAccessAuthorizer(t),
+	}
+	f.svc = NewCatalogInteractor(CatalogInteractorParams{
+		Products:   f.products,
+		Categories: f.categories,
+		Ledger:     f.ledger,
+		Authorizer: f.authorizer,
+	})
+	f.svc.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return f
+}
+
+func storefrontContext(tenantID string) context.Context {
+	return toolkit.WithTenantID(context.Background(), tenantID)
+}
+
+func adminContext(tenantID, userID string) context.Context {
+	return toolkit.WithUserID(storefrontContext(tenantID), userID)
+}
+
+func TestGetProduct_RequiresTenant(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+
+	_, err := f.svc.GetProduct(context.Background(), "p-1")
+	require.ErrorIs(t, err, ErrTenantRequired)
+}
+
+func TestGetProduct_HidesInactiveProductFromAnonymousCaller(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.products.EXPECT().FindByID(mock.Anything, "tenant-1", "p-1").
+		Return(&entity.Product{ID: "p-1", TenantID: "tenant-1", Active: false}, nil)
+
+	_, err := f.svc.GetProduct(storefrontContext("tenant-1"), "p-1")
+	require.ErrorIs(t, err, ErrProductNotFound)
+}
+
+func TestGetProduct_ReturnsInactiveProductToCatalogReader(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.products.EXPECT().FindByID(mock.Anything, "tenant-1", "p-1").
+		Return(&entity.Product{ID: "p-1", TenantID: "tenant-1", Active: false}, nil)
+	f.authorizer.EXPECT().AuthorizeCatalogRead(mock.Anything, "tenant-1", "7").Return(nil)
+
+	product, err := f.svc.GetProduct(adminContext("tenant-1", "7"), "p-1")
+	require.NoError(t, err)
+	require.Equal(t, "p-1", product.ID)
+}
+
+func TestListProducts_ScopesFilterToTenant(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.products.EXPECT().List(
+		mock.Anything,
+		catalogoutputport.ProductFilter{
+			TenantID:   "tenant-1",
+			CategoryID: "cat-1",
+			MinPrice:   10,
+			MaxPrice:   50,
+			Tags:       []string{"summer"},
+		},
+		mock.AnythingOfType("collection.Query"),
+	).Return(collection.NewPage([]entity.Product{{ID: "p-1"}}, 1, collection.Query{}.Normalize()), nil)
+
+	page, err := f.svc.ListProducts(storefrontContext("tenant-1"), cataloginputport.ListProductsQuery{
+		CategoryID: " cat-1 ",
+		MinPrice:   10,
+		MaxPrice:   50,
+		Tags:       []string{"Summer", "summer", " "},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+}
+
+func TestListProducts_RejectsInvertedPriceRange(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+
+	_, err := f.svc.ListProducts(storefrontContext("tenant-1"), cataloginputport.ListProductsQuery{
+		MinPrice: 50,
+		MaxPrice: 10,
+	})
+	require.ErrorIs(t, err, ErrInvalidPriceRange)
+}
+
+func TestListProducts_IncludeInactiveRequiresAuthenticatedReader(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+
+	_, err := f.svc.ListProducts(storefrontContext("tenant-1"), cataloginputport.ListProductsQuery{
+		IncludeInactive: true,
+	})
+	require.ErrorIs(t, err, ErrAccessDenied)
+}
+
+func TestSearchProducts_RequiresQuery(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+
+	_, err := f.svc.SearchProducts(storefrontContext("tenant-1"), cataloginputport.ListProductsQuery{Search: "  "})
+	require.ErrorIs(t, err, ErrSearchQueryRequired)
+}
+
+func TestCreateProduct_RequiresManagePermission(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+
+	_, err := f.svc.CreateProduct(storefrontContext("tenant-1"), cataloginputport.CreateProductCommand{
+		Name: "Shirt",
+		SKU:  "sku-1",
+	})
+	require.ErrorIs(t, err, ErrAccessDenied)
+}
+
+func TestCreateProduct_NormalizesAndPersists(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.authorizer.EXPECT().AuthorizeCatalogManage(mock.Anything, "tenant-1", "7").Return(nil)
+	f.categories.EXPECT().FindByID(mock.Anything, "tenant-1", "cat-1").
+		Return(&entity.Category{ID: "cat-1"}, nil)
+	f.products.EXPECT().Create(mock.Anything, mock.MatchedBy(func(product entity.Product) bool {
+		return product.TenantID == "tenant-1" &&
+			product.SKU == "SKU-1" &&
+			product.Slug == "summer-shirt" &&
+			product.CategoryID == "cat-1" &&
+			product.ID != "" &&
+			len(product.Tags) == 1
+	})).RunAndReturn(func(_ context.Context, product entity.Product) (*entity.Product, error) {
+		return &product, nil
+	})
+
+	product, err := f.svc.CreateProduct(adminContext("tenant-1", "7"), cataloginputport.CreateProductCommand{
+		Name:       " Summer Shirt ",
+		SKU:        " sku-1 ",
+		Price:      20,
+		SalePrice:  15,
+		CategoryID: "cat-1",
+		Tags:       []string{"Cotton", "cotton"},
+		Active:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Summer Shirt", product.Name)
+	require.Equal(t, 15.0, product.EffectivePrice())
+}
+
+func TestCreateProduct_RejectsSalePriceAboveListPrice(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.authorizer.EXPECT().AuthorizeCatalogManage(mock.Anything, "tenant-1", "7").Return(nil)
+
+	_, err := f.svc.CreateProduct(adminContext("tenant-1", "7"), cataloginputport.CreateProductCommand{
+		Name:      "Shirt",
+		SKU:       "SKU-1",
+		Price:     10,
+		SalePrice: 12,
+	})
+	require.ErrorIs(t, err, ErrInvalidPrice)
+}
+
+func TestUpdateCategory_RejectsParentCycle(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.authorizer.EXPECT().AuthorizeCatalogManage(mock.Anything, "tenant-1", "7").Return(nil)
+	f.categories.EXPECT().FindByID(mock.Anything, "tenant-1", "root").
+		Return(&entity.Category{ID: "root", Slug: "root"}, nil)
+	f.categories.EXPECT().FindByID(mock.Anything, "tenant-1", "child").
+		Return(&entity.Category{ID: "child", ParentID: "root"}, nil)
+
+	_, err := f.svc.UpdateCategory(adminContext("tenant-1", "7"), cataloginputport.UpdateCategoryCommand{
+		ID:       "root",
+		Name:     "Root",
+		ParentID: "child",
+	})
+	require.ErrorIs(t, err, ErrCategoryCycle)
+}
+
+func TestDeleteCategory_RejectsCategoryWithProducts(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.authorizer.EXPECT().AuthorizeCatalogManage(mock.Anything, "tenant-1", "7").Return(nil)
+	f.categories.EXPECT().FindByID(mock.Anything, "tenant-1", "cat-1").
+		Return(&entity.Category{ID: "cat-1"}, nil)
+	f.categories.EXPECT().CountChildren(mock.Anything, "tenant-1", "cat-1").Return(0, nil)
+	f.products.EXPECT().CountByCategory(mock.Anything, "tenant-1", []string{"cat-1"}).
+		Return(map[string]int32{"cat-1": 3}, nil)
+
+	err := f.svc.DeleteCategory(adminContext("tenant-1", "7"), "cat-1")
+	require.ErrorIs(t, err, ErrCategoryInUse)
+}
+
+func TestUpdateInventory_RecordsLedgerEntry(t *testing.T) {
+	t.Parallel()
+	f := setupCatalogInteractor(t)
+	f.authorizer.EXPECT().AuthorizeCatalogManage(mock.Anything, "tenant-1", "7").Return(nil)
+	f.products.EXPECT().SetInventory(mock.Anything, "tenant-1", "p-1", int32(4)).Return(int32(10), nil)
+	f.ledger.EXPECT().Record(mock.Anything, mock.MatchedBy(func(entry entity.InventoryAdjustment) bool {
+		return entry.ProductID == "p-1" &&
+			entry.PreviousCount == 10 &&
+			entry.NewCount == 4 &&
+			entry.Delta == -6 &&
+			entry.ActorID == "7" &&
+			entry.Reason == "stock count"
+	})).Return(nil)
+	f.products.EXPECT().FindByID(mock.Anything, "tenant-1", "p-1").
+		Return(&entity.Product{ID: "p-1", InventoryCount: 4}, nil)
+
+	product, err := f.svc.UpdateInventory(adminContext("tenant-1", "7"), cataloginputport.UpdateInventoryCommand{
+		ProductID:      "p-1",
+		InventoryCount: 4,
+		Reason:         " stock count ",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 4, product.InventoryCount)
+}
+
+func TestNormalizeSlug(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "summer-sale-2026", NormalizeSlug("  Summer Sale -- 2026!! "))
+	require.Empty(t, NormalizeSlug("!!!"))
+}
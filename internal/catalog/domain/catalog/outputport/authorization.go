@@ -0,0 +1,8 @@
+package outputport
+
+import "context"
+
+type AccessAuthorizer interface {
+	AuthorizeCatalogRead(ctx context.Context, tenantID string, requestedBy string) error
+	AuthorizeCatalogManage(ctx context.Context, tenantID string, requestedBy string) error
+}
@@ -0,0 +1,51 @@
+package outputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+type ProductFilter struct {
+	TenantID        string
+	CategoryID      string
+	MinPrice        float64
+	MaxPrice        float64
+	Tags            []string
+	IncludeInactive bool
+	Search          string
+}
+
+type ProductRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, product entity.Product) (*entity.Product, error)
+	FindByID(ctx context.Context, tenantID, id string) (*entity.Product, error)
+	List(
+		ctx context.Context,
+		filter ProductFilter,
+		query collection.Query,
+	) (collection.Page[entity.Product], error)
+	// Update writes the fields a product edit owns and returns the stored product. The stock
+	// count is left alone so an edit cannot undo a concurrent inventory change.
+	Update(ctx context.Context, product entity.Product) (*entity.Product, error)
+	Delete(ctx context.Context, tenantID, id string) error
+	// SetInventory overwrites the stock count and returns the count it replaced.
+	SetInventory(ctx context.Context, tenantID, id string, count int32) (int32, error)
+	CountByCategory(ctx context.Context, tenantID string, categoryIDs []string) (map[string]int32, error)
+}
+
+type CategoryRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, category entity.Category) (*entity.Category, error)
+	FindByID(ctx context.Context, tenantID, id string) (*entity.Category, error)
+	List(ctx context.Context, tenantID, parentID string, includeInactive bool) ([]entity.Category, error)
+	Update(ctx context.Context, category entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, tenantID, id string) error
+	CountChildren(ctx context.Context, tenantID, id string) (int64, error)
+}
+
+type InventoryLedger interface {
+	EnsureIndexes(ctx context.Context) error
+	Record(ctx context.Context, adjustment entity.InventoryAdjustment) error
+}
@@ -0,0 +1,79 @@
+package iamclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	catalogconfig "github.com/tuannm99/podzone/internal/catalog/config"
+	catalogdomain "github.com/tuannm99/podzone/internal/catalog/domain/catalog"
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+const (
+	catalogReadPermission   = "catalog:read"
+	catalogManagePermission = "catalog:manage"
+)
+
+var _ catalogoutputport.AccessAuthorizer = (*AccessAuthorizer)(nil)
+
+type AccessAuthorizer struct {
+	client pbiamv1.IAMQueryServiceClient
+}
+
+type AccessAuthorizerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    catalogconfig.Config
+}
+
+func NewAccessAuthorizer(params AccessAuthorizerParams) (*AccessAuthorizer, error) {
+	addr := params.Config.IAM.GRPCHost + ":" + params.Config.IAM.GRPCPort
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect catalog IAM client %s: %w", addr, err)
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	params.Logger.Info("catalog IAM gRPC client connected", "addr", addr)
+	return &AccessAuthorizer{client: pbiamv1.NewIAMQueryServiceClient(conn)}, nil
+}
+
+func (a *AccessAuthorizer) AuthorizeCatalogRead(ctx context.Context, tenantID string, requestedBy string) error {
+	return a.authorize(ctx, tenantID, requestedBy, catalogReadPermission)
+}
+
+func (a *AccessAuthorizer) AuthorizeCatalogManage(ctx context.Context, tenantID string, requestedBy string) error {
+	return a.authorize(ctx, tenantID, requestedBy, catalogManagePermission)
+}
+
+func (a *AccessAuthorizer) authorize(ctx context.Context, tenantID, requestedBy, permission string) error {
+	userID, err := strconv.ParseUint(requestedBy, 10, 64)
+	if err != nil || userID == 0 {
+		return fmt.Errorf("%w: invalid user_id", catalogdomain.ErrAccessDenied)
+	}
+	response, err := a.client.CheckPermission(ctx, &pbiamv1.CheckPermissionRequest{
+		TenantId:   tenantID,
+		UserId:     userID,
+		Permission: permission,
+		Resource:   "tenant/" + tenantID + "/catalog/*",
+	})
+	if err != nil {
+		return fmt.Errorf("check %s permission: %w", permission, err)
+	}
+	if !response.GetAllowed() {
+		return fmt.Errorf("%w: %s", catalogdomain.ErrAccessDenied, permission)
+	}
+	return nil
+}
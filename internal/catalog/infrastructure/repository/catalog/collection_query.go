@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+// productSortFields maps the public sort_by names onto product document paths.
+var productSortFields = map[string]string{
+	"name":           "name",
+	"price":          "price",
+	"createdAt":      "created_at",
+	"updatedAt":      "updated_at",
+	"inventoryCount": "inventory_count",
+}
+
+func buildProductCollection(
+	filter catalogoutputport.ProductFilter,
+	query collection.Query,
+) (collection.Query, bson.M, bson.D, error) {
+	normalized := query.Normalize()
+	clauses := bson.A{bson.M{"tenant_id": filter.TenantID}}
+	if !filter.IncludeInactive {
+		clauses = append(clauses, bson.M{"active": true})
+	}
+	if filter.CategoryID != "" {
+		clauses = append(clauses, bson.M{"category_id": filter.CategoryID})
+	}
+	if filter.MinPrice > 0 {
+		clauses = append(clauses, bson.M{"price": bson.M{"$gte": filter.MinPrice}})
+	}
+	if filter.MaxPrice > 0 {
+		clauses = append(clauses, bson.M{"price": bson.M{"$lte": filter.MaxPrice}})
+	}
+	if len(filter.Tags) > 0 {
+		clauses = append(clauses, bson.M{"tags": bson.M{"$all": filter.Tags}})
+	}
+	search := strings.TrimSpace(filter.Search)
+	if search == "" {
+		search = strings.TrimSpace(normalized.Search)
+	}
+	if search != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(search), Options: "i"}
+		clauses = append(clauses, bson.M{"$or": bson.A{
+			bson.M{"name": pattern},
+			bson.M{"description": pattern},
+			bson.M{"sku": pattern},
+			bson.M{"tags": pattern},
+		}})
+	}
+
+	sortPath := "created_at"
+	if normalized.SortBy != "" {
+		var ok bool
+		sortPath, ok = productSortFields[normalized.SortBy]
+		if !ok {
+			return collection.Query{}, nil, nil, fmt.Errorf(
+				"%w: unsupported product sort field %q",
+				collection.ErrInvalidQuery,
+				normalized.SortBy,
+			)
+		}
+	}
+	direction := -1
+	if normalized.SortDirection == collection.SortAscending {
+		direction = 1
+	}
+	return normalized, bson.M{"$and": clauses}, bson.D{
+		{Key: sortPath, Value: direction},
+		{Key: "_id", Value: 1},
+	}, nil
+}
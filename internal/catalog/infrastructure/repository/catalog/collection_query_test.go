@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+func TestBuildProductCollection(t *testing.T) {
+	t.Parallel()
+
+	query, filter, sort, err := buildProductCollection(catalogoutputport.ProductFilter{
+		TenantID:   "tenant-1",
+		CategoryID: "cat-1",
+		MinPrice:   5,
+		MaxPrice:   50,
+		Tags:       []string{"summer"},
+		Search:     `tee.*`,
+	}, collection.Query{
+		Page:          2,
+		PageSize:      10,
+		SortBy:        "price",
+		SortDirection: collection.SortAscending,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, query.Page)
+	clauses, ok := filter["$and"].(bson.A)
+	require.True(t, ok)
+	assert.Contains(t, clauses, bson.M{"tenant_id": "tenant-1"})
+	assert.Contains(t, clauses, bson.M{"active": true})
+	assert.Contains(t, clauses, bson.M{"category_id": "cat-1"})
+	require.Len(t, sort, 2)
+	assert.Equal(t, "price", sort[0].Key)
+	assert.Equal(t, 1, sort[0].Value)
+}
+
+func TestBuildProductCollectionIncludesInactiveWhenRequested(t *testing.T) {
+	t.Parallel()
+
+	_, filter, _, err := buildProductCollection(catalogoutputport.ProductFilter{
+		TenantID:        "tenant-1",
+		IncludeInactive: true,
+	}, collection.Query{})
+
+	require.NoError(t, err)
+	assert.NotContains(t, filter["$and"], bson.M{"active": true})
+}
+
+func TestBuildProductCollectionRejectsUnsupportedSort(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, err := buildProductCollection(catalogoutputport.ProductFilter{TenantID: "tenant-1"}, collection.Query{
+		SortBy: "raw",
+	})
+
+	require.ErrorIs(t, err, collection.ErrInvalidQuery)
+}
@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/fx"
+
+	catalogdomain "github.com/tuannm99/podzone/internal/catalog/domain/catalog"
+	"github.com/tuannm99/podzone/internal/catalog/domain/catalog/entity"
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+const (
+	productSKUIndex   = "tenant_sku_unique"
+	productSlugIndex  = "tenant_slug_unique"
+	categorySlugIndex = "tenant_category_slug_unique"
+)
+
+var (
+	_ catalogoutputport.ProductRepository  = (*MongoRepository)(nil)
+	_ catalogoutputport.CategoryRepository = (*CategoryRepository)(nil)
+	_ catalogoutputport.InventoryLedger    = (*InventoryLedger)(nil)
+)
+
+type Params struct {
+	fx.In
+
+	MongoClient *mongo.Client `name:"mongo-catalog"`
+	DB          string        `name:"mongo-catalog-db"`
+}
+
+// MongoRepository stores products; categories and inventory adjustments live in sibling collections.
+type MongoRepository struct {
+	products *mongo.Collection
+}
+
+type CategoryRepository struct {
+	categories *mongo.Collection
+}
+
+type InventoryLedger struct {
+	adjustments *mongo.Collection
+}
+
+func New(p Params) *MongoRepository {
+	return &MongoRepository{products: p.MongoClient.Database(p.DB).Collection("products")}
+}
+
+func NewCategoryRepository(p Params) *CategoryRepository {
+	return &CategoryRepository{categories: p.MongoClient.Database(p.DB).Collection("categories")}
+}
+
+func NewInventoryLedger(p Params) *InventoryLedger {
+	return &InventoryLedger{adjustments: p.MongoClient.Database(p.DB).Collection("inventory_adjustments")}
+}
+
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.products.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "sku", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName(productSKUIndex),
+		},
+		{
+			Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "slug", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName(productSlugIndex),
+		},
+		{
+			Keys: bson.D{
+				{Key: "tenant_id", Value: 1},
+				{Key: "active", Value: 1},
+				{Key: "category_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "tags", Value: 1}},
+		},
+	})
+	return err
+}
+
+func (r *MongoRepository) Create(ctx context.Context, product entity.Product) (*entity.Product, error) {
+	if _, err := r.products.InsertOne(ctx, product); err != nil {
+		return nil, mapProductWriteError(err)
+	}
+	return &product, nil
+}
+
+func (r *MongoRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.Product, error) {
+	var product entity.Product
+	err := r.products.FindOne(ctx, bson.M{"_id": id, "tenant_id": tenantID}).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, catalogdomain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (r *MongoRepository) List(
+	ctx context.Context,
+	filter catalogoutputport.ProductFilter,
+	query collection.Query,
+) (collection.Page[entity.Product], error) {
+	normalized, mongoFilter, sort, err := buildProductCollection(filter, query)
+	if err != nil {
+		return collection.Page[entity.Product]{}, err
+	}
+	total, err := r.products.CountDocuments(ctx, mongoFilter)
+	if err != nil {
+		return collection.Page[entity.Product]{}, err
+	}
+	cursor, err := r.products.Find(
+		ctx,
+		mongoFilter,
+		options.Find().
+			SetSort(sort).
+			SetSkip(int64(normalized.Offset())).
+			SetLimit(int64(normalized.PageSize)),
+	)
+	if err != nil {
+		return collection.Page[entity.Product]{}, err
+	}
+	defer cursor.Close(ctx)
+
+	items := make([]entity.Product, 0, normalized.PageSize)
+	if err := cursor.All(ctx, &items); err != nil {
+		return collection.Page[entity.Product]{}, err
+	}
+	return collection.NewPage(items, total, normalized), nil
+}
+
+func (r *MongoRepository) Update(ctx context.Context, product entity.Product) (*entity.Product, error) {
+	set := bson.M{
+		"name":        product.Name,
+		"description": product.Description,
+		"slug":        product.Slug,
+		"price":       product.Price,
+		"sale_price":  product.SalePrice,
+		"category_id": product.CategoryID,
+		"image_urls":  product.ImageURLs,
+		"active":      product.Active,
+		"weight":      product.Weight,
+		"tags":        product.Tags,
+		"updated_at":  product.UpdatedAt,
+	}
+	unset := bson.M{}
+	if len(product.Attributes) > 0 {
+		set["attributes"] = product.Attributes
+	} else {
+		unset["attributes"] = ""
+	}
+	if product.Dimensions != nil {
+		set["dimensions"] = product.Dimensions
+	} else {
+		unset["dimensions"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	var updated entity.Product
+	err := r.products.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": product.ID, "tenant_id": product.TenantID},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, catalogdomain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, mapProductWriteError(err)
+	}
+	return &updated, nil
+}
+
+func (r *MongoRepository) Delete(ctx context.Context, tenantID, id string) error {
+	result, err := r.products.DeleteOne(ctx, bson.M{"_id": id, "tenant_id": tenantID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return catalogdomain.ErrProductNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepository) SetInventory(ctx context.Context, tenantID, id string, count int32) (int32, error) {
+	var previous entity.Product
+	err := r.products.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id, "tenant_id": tenantID},
+		bson.M{"$set": bson.M{"inventory_count": count}, "$currentDate": bson.M{"updated_at": true}},
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.Before).
+			SetProjection(bson.M{"inventory_count": 1}),
+	).Decode(&previous)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, catalogdomain.ErrProductNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return previous.InventoryCount, nil
+}
+
+func (r *MongoRepository) CountByCategory(
+	ctx context.Context,
+	tenantID string,
+	categoryIDs []string,
+) (map[string]int32, error) {
+	counts := make(map[string]int32, len(categoryIDs))
+	if len(categoryIDs) == 0 {
+		return counts, nil
+	}
+	cursor, err := r.products.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"tenant_id": tenantID, "category_id": bson.M{"$in": categoryIDs}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$category_id", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		CategoryID string `bson:"_id"`
+		Count      int32  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *CategoryRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.categories.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "slug", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName(categorySlugIndex),
+		},
+		{
+			Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "parent_id", Value: 1}, {Key: "name", Value: 1}},
+		},
+	})
+	return err
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category entity.Category) (*entity.Category, error) {
+	if _, err := r.categories.InsertOne(ctx, category); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, catalogdomain.ErrSlugTaken
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.Category, error) {
+	var category entity.Category
+	err := r.categories.FindOne(ctx, bson.M{"_id": id, "tenant_id": tenantID}).Decode(&category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, catalogdomain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) List(
+	ctx context.Context,
+	tenantID string,
+	parentID string,
+	includeInactive bool,
+) ([]entity.Category, error) {
+	filter := bson.M{"tenant_id": tenantID}
+	if parentID != "" {
+		filter["parent_id"] = parentID
+	}
+	if !includeInactive {
+		filter["active"] = true
+	}
+	cursor, err := r.categories.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	items := make([]entity.Category, 0)
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category entity.Category) (*entity.Category, error) {
+	result, err := r.categories.ReplaceOne(
+		ctx,
+		bson.M{"_id": category.ID, "tenant_id": category.TenantID},
+		category,
+	)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, catalogdomain.ErrSlugTaken
+		}
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, catalogdomain.ErrCategoryNotFound
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, tenantID, id string) error {
+	result, err := r.categories.DeleteOne(ctx, bson.M{"_id": id, "tenant_id": tenantID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return catalogdomain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *CategoryRepository) CountChildren(ctx context.Context, tenantID, id string) (int64, error) {
+	return r.categories.CountDocuments(ctx, bson.M{"tenant_id": tenantID, "parent_id": id})
+}
+
+func (l *InventoryLedger) EnsureIndexes(ctx context.Context) error {
+	_, err := l.adjustments.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "product_id", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	return err
+}
+
+func (l *InventoryLedger) Record(ctx context.Context, adjustment entity.InventoryAdjustment) error {
+	_, err := l.adjustments.InsertOne(ctx, adjustment)
+	return err
+}
+
+func mapProductWriteError(err error) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	if strings.Contains(err.Error(), productSlugIndex) {
+		return catalogdomain.ErrSlugTaken
+	}
+	return catalogdomain.ErrSKUTaken
+}
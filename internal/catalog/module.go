@@ -1 +1,78 @@
 package catalog
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+
+	catalogconfig "github.com/tuannm99/podzone/internal/catalog/config"
+	"github.com/tuannm99/podzone/internal/catalog/controller/grpchandler"
+	catalogdomain "github.com/tuannm99/podzone/internal/catalog/domain/catalog"
+	cataloginputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/inputport"
+	catalogoutputport "github.com/tuannm99/podzone/internal/catalog/domain/catalog/outputport"
+	"github.com/tuannm99/podzone/internal/catalog/infrastructure/iamclient"
+	catalogrepository "github.com/tuannm99/podzone/internal/catalog/infrastructure/repository/catalog"
+	pbcatalogv1 "github.com/tuannm99/podzone/pkg/api/proto/catalog/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		catalogconfig.NewConfig,
+		fx.Annotate(
+			func(cfg catalogconfig.Config) string {
+				return cfg.Database
+			},
+			fx.ResultTags(`name:"mongo-catalog-db"`),
+		),
+		fx.Annotate(catalogrepository.New, fx.As(new(catalogoutputport.ProductRepository))),
+		fx.Annotate(catalogrepository.NewCategoryRepository, fx.As(new(catalogoutputport.CategoryRepository))),
+		fx.Annotate(catalogrepository.NewInventoryLedger, fx.As(new(catalogoutputport.InventoryLedger))),
+		fx.Annotate(iamclient.NewAccessAuthorizer, fx.As(new(catalogoutputport.AccessAuthorizer))),
+		fx.Annotate(catalogdomain.NewCatalogInteractor, fx.As(new(cataloginputport.Usecase))),
+		grpchandler.NewAuthentication,
+		grpchandler.NewCatalogServer,
+	),
+)
+
+var ServerModule = fx.Options(
+	Module,
+	fx.Invoke(
+		RegisterGRPCServer,
+		RegisterIndexes,
+	),
+)
+
+func RegisterGRPCServer(
+	server *grpc.Server,
+	catalogServer *grpchandler.CatalogServer,
+	logger pdlog.Logger,
+) {
+	logger.Info("Registering Catalog GRPC handler")
+	pbcatalogv1.RegisterCatalogServiceServer(server, catalogServer)
+}
+
+type IndexParams struct {
+	fx.In
+	Lifecycle  fx.Lifecycle
+	Logger     pdlog.Logger
+	Products   catalogoutputport.ProductRepository
+	Categories catalogoutputport.CategoryRepository
+	Ledger     catalogoutputport.InventoryLedger
+}
+
+func RegisterIndexes(p IndexParams) {
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			p.Logger.Info("Ensuring catalog mongo indexes...")
+			if err := p.Products.EnsureIndexes(ctx); err != nil {
+				return err
+			}
+			if err := p.Categories.EnsureIndexes(ctx); err != nil {
+				return err
+			}
+			return p.Ledger.EnsureIndexes(ctx)
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package dlqadmin
+
+import (
+	"strings"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type IAMConfig struct {
+	GRPCHost string `koanf:"grpc_host"`
+	GRPCPort string `koanf:"grpc_port"`
+}
+
+// OutboxSource is a service database whose dead outbox records operators redrive through
+// dlqadmin. URI should bypass the service's PgBouncer like its outbox listen_uri.
+type OutboxSource struct {
+	URI       string `koanf:"uri"`
+	TableName string `koanf:"table_name"`
+}
+
+// Config lists the dead-letter topics collected into the store. By default these are the
+// dead-letter topics of every service event stream that has a consumer. Outboxes maps a
+// service name to the database holding its outbox.
+type Config struct {
+	Authn     pdauthn.Config
+	IAM       IAMConfig
+	Topics    []string
+	TableName string
+	Outboxes  map[string]OutboxSource
+	// TrustedProxies, normally the edge proxy, may forward the client IP and transport that
+	// the operator check passes on to IAM.
+	TrustedProxies pdproxy.TrustedProxies
+}
+
+func NewConfig(k *koanf.Koanf) Config {
+	cfg := Config{
+		Authn: pdauthn.Config{
+			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
+			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
+		},
+		IAM: IAMConfig{
+			GRPCHost: toolkit.GetEnv("IAM_GRPC_HOST", "iam-service"),
+			GRPCPort: toolkit.GetEnv("IAM_GRPC_PORT", "50053"),
+		},
+		Topics: []string{
+			messaging.DeadLetterTopic(messaging.EventTopic("iam")),
+			messaging.DeadLetterTopic(messaging.EventTopic("order")),
+			messaging.DeadLetterTopic(messaging.EventTopic("onboarding")),
+		},
+		TableName: "dead_letters",
+	}
+	cfg.TrustedProxies = pdproxy.LoadTrustedProxies(k, "dlqadmin.trusted_proxies")
+	if k == nil {
+		return cfg
+	}
+	if cfg.Authn.JWTSecret == "" {
+		cfg.Authn.JWTSecret = k.String("dlqadmin.auth.jwt_secret")
+	}
+	if cfg.Authn.JWTKey == "" {
+		cfg.Authn.JWTKey = k.String("dlqadmin.auth.jwt_key")
+	}
+	if cfg.Authn.JWKSURL == "" {
+		cfg.Authn.JWKSURL = k.String("dlqadmin.auth.jwks_url")
+	}
+	cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "dlqadmin.auth.legacy_hs256_until")
+	if host := k.String("dlqadmin.iam.grpc_host"); host != "" && !strings.HasPrefix(host, "${") {
+		cfg.IAM.GRPCHost = host
+	}
+	if port := k.String("dlqadmin.iam.grpc_port"); port != "" && !strings.HasPrefix(port, "${") {
+		cfg.IAM.GRPCPort = port
+	}
+	if topics := k.Strings("dlqadmin.topics"); len(topics) > 0 {
+		cfg.Topics = topics
+	}
+	if table := k.String("dlqadmin.table_name"); table != "" {
+		cfg.TableName = table
+	}
+	_ = k.Unmarshal("dlqadmin.outboxes", &cfg.Outboxes)
+	for name, source := range cfg.Outboxes {
+		// An unset environment variable leaves the placeholder; skip that outbox.
+		if strings.TrimSpace(source.URI) == "" || strings.HasPrefix(source.URI, "${") {
+			delete(cfg.Outboxes, name)
+		}
+	}
+	return cfg
+}
@@ -0,0 +1,21 @@
+package dlqadmin
+
+import (
+	"testing"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfig_LoadsOutboxesWithURI(t *testing.T) {
+	k := koanf.New(".")
+	k.Set("dlqadmin.outboxes.auth.uri", "postgres://postgres@postgres:5432/auth")
+	k.Set("dlqadmin.outboxes.iam.uri", "${IAM_OUTBOX_DSN}")
+	k.Set("dlqadmin.outboxes.order.table_name", "message_outbox")
+
+	cfg := NewConfig(k)
+
+	assert.Equal(t, map[string]OutboxSource{
+		"auth": {URI: "postgres://postgres@postgres:5432/auth"},
+	}, cfg.Outboxes)
+}
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letters (
+  id TEXT PRIMARY KEY,
+  topic TEXT NOT NULL,
+  record_partition INT NOT NULL,
+  record_offset BIGINT NOT NULL,
+  message_key TEXT NOT NULL DEFAULT '',
+  original_topic TEXT NOT NULL DEFAULT '',
+  message_type TEXT NOT NULL DEFAULT '',
+  tenant_id TEXT NOT NULL DEFAULT '',
+  consumer_name TEXT NOT NULL DEFAULT '',
+  reason TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  attempt INT NOT NULL DEFAULT 0,
+  redrive_count INT NOT NULL DEFAULT 0,
+  envelope_json JSONB NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending',
+  received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  resolved_at TIMESTAMPTZ NULL,
+  resolved_by TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_status_received
+  ON dead_letters (status, received_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_type
+  ON dead_letters (message_type, received_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_tenant
+  ON dead_letters (tenant_id, received_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_consumer
+  ON dead_letters (consumer_name, received_at DESC);
+
+CREATE TABLE IF NOT EXISTS dead_letters_audit (
+  id BIGSERIAL PRIMARY KEY,
+  dead_letter_id TEXT NOT NULL REFERENCES dead_letters (id) ON DELETE CASCADE,
+  action TEXT NOT NULL,
+  actor TEXT NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_audit_dead_letter
+  ON dead_letters_audit (dead_letter_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dead_letters_audit_dead_letter;
+DROP TABLE IF EXISTS dead_letters_audit;
+DROP INDEX IF EXISTS idx_dead_letters_consumer;
+DROP INDEX IF EXISTS idx_dead_letters_tenant;
+DROP INDEX IF EXISTS idx_dead_letters_type;
+DROP INDEX IF EXISTS idx_dead_letters_status_received;
+DROP TABLE IF EXISTS dead_letters;
+-- +goose StatementEnd
@@ -0,0 +1,121 @@
+package dlqadmin
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/dlqadmin/migrations"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/messaging/dlqadmin"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/messaging/outboxadmin"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+const (
+	runtimeConfigPath = "messaging.dlqadmin.consumers.collector"
+	consumerName      = "dlqadmin.collector"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		NewConfig,
+		NewRuntimeConfig,
+		NewIAMClient,
+		NewOperator,
+		NewOutboxStores,
+		fx.Annotate(
+			func(db *sqlx.DB, cfg Config) (messaging.DeadLetterStore, error) {
+				return messagingsql.NewDeadLetterStore(db, cfg.TableName)
+			},
+			fx.ParamTags(`name:"sql-dlqadmin"`, ``),
+		),
+		fx.Annotate(
+			func(producer pdkafka.Producer) *messaging.Redriver {
+				return messaging.NewRedriver(messagingkafka.NewPublisher(producer))
+			},
+			fx.ParamTags(`name:"kafka-dlqadmin-producer"`),
+		),
+		func(store messaging.DeadLetterStore, redriver *messaging.Redriver) *messaging.DeadLetterBrowser {
+			return messaging.NewDeadLetterBrowser(store, redriver, messaging.DeadLetterBrowserOptions{})
+		},
+		func(log pdlog.Logger, cfg messaging.ConsumerRuntimeConfig) messaging.Observer {
+			return messaging.NewLoggingObserver(log, consumerName, cfg)
+		},
+		fx.Annotate(
+			NewConsumerGroupRunner,
+			fx.ParamTags(`name:"kafka-dlqadmin-consumer-group-factory"`, `name:"kafka-dlqadmin-config"`),
+		),
+		NewWorker,
+		fx.Annotate(RegisterHTTPRoutes, fx.ResultTags(`group:"gin-routes"`)),
+	),
+	fx.Invoke(
+		RegisterMigration,
+		func(lc fx.Lifecycle, logger pdlog.Logger, w *Worker) {
+			pdworker.StartWorker(lc, logger, w)
+		},
+	),
+)
+
+func NewRuntimeConfig(k *koanf.Koanf) messaging.ConsumerRuntimeConfig {
+	cfg := messaging.LoadConsumerRuntimeConfig(
+		k,
+		runtimeConfigPath,
+		messaging.DefaultConsumerRuntimeConfig(consumerName),
+	)
+	cfg.Idempotency.ConsumerName = consumerName
+	return cfg
+}
+
+// RegisterHTTPRoutes mounts each service's outbox admin under /services/<name>.
+func RegisterHTTPRoutes(
+	logger pdlog.Logger,
+	operator *Operator,
+	browser *messaging.DeadLetterBrowser,
+	outboxes OutboxStores,
+) pdhttp.RouteRegistrar {
+	logger.Info("Registering dead-letter admin HTTP handler")
+	handler := dlqadmin.NewHandler(browser, Actor)
+	return func(r *gin.Engine) {
+		v1 := r.Group("/dlqadmin/v1")
+		v1.Use(operator.Require)
+		handler.RegisterRoutes(v1)
+		for name, store := range outboxes {
+			outboxadmin.NewHandler(store).RegisterRoutes(v1.Group("/services/" + name))
+		}
+	}
+}
+
+type MigrateParams struct {
+	fx.In
+	Logger   pdlog.Logger
+	DBConfig *pdsql.Config `name:"sql-dlqadmin-config"`
+	DB       *sqlx.DB      `name:"sql-dlqadmin"`
+}
+
+func RegisterMigration(p MigrateParams) {
+	if !p.DBConfig.ShouldRunMigration {
+		p.Logger.Info("Disabled migration ...")
+		return
+	}
+
+	p.Logger.Info("Migrating dlqadmin database with goose...")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := migrations.Apply(ctx, p.DB.DB, "postgres"); err != nil {
+		p.Logger.Error("Migration failed", "err", err)
+		return
+	}
+	p.Logger.Info("Migration completed")
+}
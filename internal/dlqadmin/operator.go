@@ -0,0 +1,109 @@
+package dlqadmin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
+)
+
+// ManageMessagingPermission is the platform permission required to browse, redrive and drop
+// dead letters.
+const ManageMessagingPermission = "platform:manage_messaging"
+
+const operatorKey = "dlqadmin.operator"
+
+// PlatformPermissionChecker is the part of the IAM query client the operator check needs.
+type PlatformPermissionChecker interface {
+	CheckPlatformPermission(
+		ctx context.Context,
+		in *pbiamv1.CheckPlatformPermissionRequest,
+		opts ...grpc.CallOption,
+	) (*pbiamv1.CheckPermissionResponse, error)
+}
+
+type IAMClientParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    Config
+}
+
+func NewIAMClient(p IAMClientParams) (PlatformPermissionChecker, error) {
+	addr := p.Config.IAM.GRPCHost + ":" + p.Config.IAM.GRPCPort
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect dlqadmin IAM client %s: %w", addr, err)
+	}
+	p.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	p.Logger.Info("dlqadmin IAM gRPC client connected", "addr", addr)
+	return pbiamv1.NewIAMQueryServiceClient(conn), nil
+}
+
+// Operator admits requests from users holding ManageMessagingPermission and records who they
+// are, so redrives and drops are audited under the operator's user id.
+type Operator struct {
+	verifier *pdauthn.Verifier
+	proxies  pdproxy.TrustedProxies
+	iam      PlatformPermissionChecker
+}
+
+func NewOperator(cfg Config, iam PlatformPermissionChecker) *Operator {
+	return &Operator{verifier: pdauthn.NewVerifier(cfg.Authn), proxies: cfg.TrustedProxies, iam: iam}
+}
+
+func (o *Operator) Require(ctx *gin.Context) {
+	header := strings.TrimSpace(ctx.GetHeader("Authorization"))
+	if !strings.HasPrefix(strings.ToLower(header), "bearer ") {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization bearer token is required"})
+		return
+	}
+	claims, err := o.verifier.ClaimsFromTokenString(strings.TrimSpace(header[len("Bearer "):]))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if claims.UserID == 0 {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization token missing user_id"})
+		return
+	}
+
+	requestCtx := o.proxies.ForwardRequestClient(ctx.Request.Context(), ctx.Request)
+	requestCtx = metadata.AppendToOutgoingContext(requestCtx, "authorization", header)
+	resp, err := o.iam.CheckPlatformPermission(requestCtx, &pbiamv1.CheckPlatformPermissionRequest{
+		UserId:     uint64(claims.UserID),
+		Permission: ManageMessagingPermission,
+	})
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "check platform permission failed"})
+		return
+	}
+	if !resp.GetAllowed() {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing permission " + ManageMessagingPermission})
+		return
+	}
+	ctx.Set(operatorKey, strconv.FormatUint(uint64(claims.UserID), 10))
+	ctx.Next()
+}
+
+// Actor returns the user id Require admitted, for dlqadmin.NewHandler.
+func Actor(ctx *gin.Context) string {
+	return ctx.GetString(operatorKey)
+}
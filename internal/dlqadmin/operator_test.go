@@ -0,0 +1,121 @@
+package dlqadmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+const testSecret = "test-secret"
+
+type fakePermissionChecker struct {
+	allowed bool
+	err     error
+	request *pbiamv1.CheckPlatformPermissionRequest
+	auth    []string
+	client  []string
+}
+
+func (f *fakePermissionChecker) CheckPlatformPermission(
+	ctx context.Context,
+	in *pbiamv1.CheckPlatformPermissionRequest,
+	_ ...grpc.CallOption,
+) (*pbiamv1.CheckPermissionResponse, error) {
+	f.request = in
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.auth = md.Get("authorization")
+	f.client = md.Get("x-real-ip")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &pbiamv1.CheckPermissionResponse{Allowed: f.allowed}, nil
+}
+
+func signedToken(t *testing.T, userID uint) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, pdauthn.Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	return signed
+}
+
+func performOperatorRequest(
+	t *testing.T,
+	iam PlatformPermissionChecker,
+	authorization string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	operator := NewOperator(Config{Authn: pdauthn.Config{JWTSecret: testSecret}}, iam)
+	router := gin.New()
+	router.Use(operator.Require)
+	router.GET("/dead-letters", func(ctx *gin.Context) {
+		ctx.Header("X-Test-Actor", Actor(ctx))
+		ctx.Status(http.StatusNoContent)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/dead-letters", nil)
+	request.RemoteAddr = "203.0.113.7:4711"
+	request.Header.Set("X-Real-IP", "198.51.100.1")
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestOperator_AdmitsUserWithPermission(t *testing.T) {
+	iam := &fakePermissionChecker{allowed: true}
+	token := signedToken(t, 7)
+
+	recorder := performOperatorRequest(t, iam, "Bearer "+token)
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "7", recorder.Header().Get("X-Test-Actor"))
+	assert.Equal(t, uint64(7), iam.request.GetUserId())
+	assert.Equal(t, ManageMessagingPermission, iam.request.GetPermission())
+	assert.Equal(t, []string{"Bearer " + token}, iam.auth)
+	assert.Equal(t, []string{"203.0.113.7"}, iam.client, "an untrusted peer's X-Real-IP is not forwarded")
+}
+
+func TestOperator_RejectsUserWithoutPermission(t *testing.T) {
+	recorder := performOperatorRequest(t, &fakePermissionChecker{}, "Bearer "+signedToken(t, 7))
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestOperator_RequiresBearerToken(t *testing.T) {
+	iam := &fakePermissionChecker{allowed: true}
+
+	recorder := performOperatorRequest(t, iam, "")
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Nil(t, iam.request)
+}
+
+func TestOperator_ReportsIAMFailure(t *testing.T) {
+	iam := &fakePermissionChecker{err: errors.New("iam unavailable")}
+
+	recorder := performOperatorRequest(t, iam, "Bearer "+signedToken(t, 7))
+
+	assert.Equal(t, http.StatusBadGateway, recorder.Code)
+}
@@ -0,0 +1,45 @@
+package dlqadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+)
+
+// OutboxStores holds the outbox of each service in Config.Outboxes, keyed by service name.
+type OutboxStores map[string]messaging.OutboxRedriveStore
+
+// NewOutboxStores opens the service databases lazily, so an unreachable one fails its
+// requests instead of dlqadmin's startup.
+func NewOutboxStores(lc fx.Lifecycle, cfg Config) (OutboxStores, error) {
+	stores := make(OutboxStores, len(cfg.Outboxes))
+	dbs := make([]*sqlx.DB, 0, len(cfg.Outboxes))
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			var errs []error
+			for _, db := range dbs {
+				errs = append(errs, db.Close())
+			}
+			return errors.Join(errs...)
+		},
+	})
+	for name, source := range cfg.Outboxes {
+		db, err := sqlx.Open("postgres", source.URI)
+		if err != nil {
+			return nil, fmt.Errorf("open %s outbox database: %w", name, err)
+		}
+		dbs = append(dbs, db)
+		store, err := messagingsql.NewOutboxStore(db, source.TableName)
+		if err != nil {
+			return nil, fmt.Errorf("%s outbox: %w", name, err)
+		}
+		stores[name] = store
+	}
+	return stores, nil
+}
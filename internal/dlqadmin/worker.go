@@ -0,0 +1,75 @@
+package dlqadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// Worker copies messages from the configured dead-letter topics into the store. It has no
+// retry publisher, so a failed store write ends the session and the record is read again.
+type Worker struct {
+	log      pdlog.Logger
+	runner   pdkafka.ConsumerGroupRunner
+	consumer *messagingkafka.Consumer
+	enabled  bool
+}
+
+func NewWorker(
+	log pdlog.Logger,
+	runner pdkafka.ConsumerGroupRunner,
+	observer messaging.Observer,
+	runtime messaging.ConsumerRuntimeConfig,
+	cfg Config,
+	browser *messaging.DeadLetterBrowser,
+) *Worker {
+	return &Worker{
+		log:     log,
+		runner:  runner,
+		enabled: runtime.Enabled && len(cfg.Topics) > 0,
+		consumer: messagingkafka.NewConsumerWithOptions(
+			runner,
+			cfg.Topics,
+			browser,
+			messagingkafka.ConsumerOptions{
+				Observer:     observer,
+				ConsumerName: runtime.Idempotency.ConsumerName,
+			},
+		),
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	if !w.enabled {
+		w.log.Info("Dead-letter collector disabled")
+		return
+	}
+
+	defer func() {
+		if err := w.runner.Close(); err != nil {
+			w.log.Error("Close dead-letter collector consumer failed", "error", err)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		if err := w.consumer.Run(ctx); err != nil && ctx.Err() == nil {
+			w.log.Error("Dead-letter collector consumer failed", "error", err)
+		}
+	}
+}
+
+func NewConsumerGroupRunner(
+	factory pdkafka.ConsumerGroupFactory,
+	cfg *pdkafka.Config,
+) (pdkafka.ConsumerGroupRunner, error) {
+	groupID := cfg.ConsumerGroupPrefix + ".dead-letter-collector"
+	group, err := factory.New(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("create dead-letter collector consumer group: %w", err)
+	}
+	return pdkafka.NewConsumerGroupRunner(group), nil
+}
@@ -0,0 +1,20 @@
+package grpcgateway
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	pb "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+)
+
+type CartRegistrar struct {
+	AddrVal string
+}
+
+func (r *CartRegistrar) Register(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterCartServiceHandler(ctx, mux, conn)
+}
+func (r *CartRegistrar) Addr() string { return r.AddrVal }
+func (r *CartRegistrar) Name() string { return "cart" }
@@ -0,0 +1,20 @@
+package grpcgateway
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	pb "github.com/tuannm99/podzone/pkg/api/proto/order/v1"
+)
+
+type OrderRegistrar struct {
+	AddrVal string
+}
+
+func (r *OrderRegistrar) Register(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterOrderServiceHandler(ctx, mux, conn)
+}
+func (r *OrderRegistrar) Addr() string { return r.AddrVal }
+func (r *OrderRegistrar) Name() string { return "order" }
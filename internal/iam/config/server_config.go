@@ -1,8 +1,11 @@
 package config
 
 import (
+	"time"
+
 	"github.com/knadh/koanf/v2"
 	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
@@ -15,6 +18,9 @@ type ServerConfig struct {
 	Authn          pdauthn.Config
 	AppRedirectURL string
 	Auth           RPCConfig `mapstructure:"auth"`
+	// TrustedProxies may forward a caller's client IP and transport: the gateway and the
+	// services checking permissions on a user's behalf.
+	TrustedProxies pdproxy.TrustedProxies
 }
 
 func NewServerConfig(k *koanf.Koanf) ServerConfig {
@@ -22,6 +28,7 @@ func NewServerConfig(k *koanf.Koanf) ServerConfig {
 		Authn: pdauthn.Config{
 			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
 			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
 		},
 		AppRedirectURL: toolkit.GetEnv("APP_REDIRECT_URL", ""),
 	}
@@ -35,6 +42,10 @@ func NewServerConfig(k *koanf.Koanf) ServerConfig {
 		if cfg.Authn.JWTKey == "" {
 			cfg.Authn.JWTKey = k.String("iam.authn.jwt_key")
 		}
+		if cfg.Authn.JWKSURL == "" {
+			cfg.Authn.JWKSURL = k.String("iam.authn.jwks_url")
+		}
+		cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "iam.authn.legacy_hs256_until")
 		if cfg.Authn.JWTKey == "" {
 			cfg.Authn.JWTKey = k.String("auth.jwt_key")
 		}
@@ -51,6 +62,7 @@ func NewServerConfig(k *koanf.Koanf) ServerConfig {
 			cfg.Auth.GRPCPort = k.String("iam.auth.grpc_port")
 		}
 	}
+	cfg.TrustedProxies = pdproxy.LoadTrustedProxies(k, "iam.trusted_proxies")
 	if cfg.Auth.GRPCHost == "" {
 		cfg.Auth.GRPCHost = toolkit.GetEnv("AUTH_GRPC_HOST", "localhost")
 	}
@@ -59,3 +71,53 @@ func NewServerConfig(k *koanf.Koanf) ServerConfig {
 	}
 	return cfg
 }
+
+// DecisionCacheConfig controls the in-process authorization decision cache. TTL bounds how
+// long an entry survives if its invalidation event is missed.
+type DecisionCacheConfig struct {
+	Enabled    bool
+	TTL        time.Duration
+	MaxEntries int
+}
+
+func NewDecisionCacheConfig(k *koanf.Koanf) DecisionCacheConfig {
+	cfg := DecisionCacheConfig{Enabled: true, TTL: time.Minute, MaxEntries: 100_000}
+	if k == nil {
+		return cfg
+	}
+	if k.Exists("iam.decision_cache.enabled") {
+		cfg.Enabled = k.Bool("iam.decision_cache.enabled")
+	}
+	if ttl := k.Duration("iam.decision_cache.ttl"); ttl > 0 {
+		cfg.TTL = ttl
+	}
+	if maxEntries := k.Int("iam.decision_cache.max_entries"); maxEntries > 0 {
+		cfg.MaxEntries = maxEntries
+	}
+	return cfg
+}
+
+// AccessActivityConfig controls the batching writer behind the access analyzer's last-used
+// tracking. MaxPending caps the distinct rows buffered between flushes.
+type AccessActivityConfig struct {
+	Enabled       bool
+	FlushInterval time.Duration
+	MaxPending    int
+}
+
+func NewAccessActivityConfig(k *koanf.Koanf) AccessActivityConfig {
+	cfg := AccessActivityConfig{Enabled: true, FlushInterval: 10 * time.Second, MaxPending: 50_000}
+	if k == nil {
+		return cfg
+	}
+	if k.Exists("iam.access_activity.enabled") {
+		cfg.Enabled = k.Bool("iam.access_activity.enabled")
+	}
+	if interval := k.Duration("iam.access_activity.flush_interval"); interval > 0 {
+		cfg.FlushInterval = interval
+	}
+	if maxPending := k.Int("iam.access_activity.max_pending"); maxPending > 0 {
+		cfg.MaxPending = maxPending
+	}
+	return cfg
+}
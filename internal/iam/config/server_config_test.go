@@ -2,7 +2,9 @@ package config
 
 import (
 	"testing"
+	"time"
 
+	"github.com/knadh/koanf/v2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,3 +17,37 @@ func TestNewServerConfigDefaultsToAuthGRPCPort(t *testing.T) {
 	require.Equal(t, "localhost", cfg.Auth.GRPCHost)
 	require.Equal(t, "50051", cfg.Auth.GRPCPort)
 }
+
+func TestNewDecisionCacheConfig(t *testing.T) {
+	cfg := NewDecisionCacheConfig(nil)
+	require.True(t, cfg.Enabled)
+	require.Equal(t, time.Minute, cfg.TTL)
+	require.Equal(t, 100_000, cfg.MaxEntries)
+
+	k := koanf.New(".")
+	require.NoError(t, k.Set("iam.decision_cache.enabled", false))
+	require.NoError(t, k.Set("iam.decision_cache.ttl", "30s"))
+	require.NoError(t, k.Set("iam.decision_cache.max_entries", 10))
+
+	cfg = NewDecisionCacheConfig(k)
+	require.False(t, cfg.Enabled)
+	require.Equal(t, 30*time.Second, cfg.TTL)
+	require.Equal(t, 10, cfg.MaxEntries)
+}
+
+func TestNewAccessActivityConfig(t *testing.T) {
+	cfg := NewAccessActivityConfig(nil)
+	require.True(t, cfg.Enabled)
+	require.Equal(t, 10*time.Second, cfg.FlushInterval)
+	require.Equal(t, 50_000, cfg.MaxPending)
+
+	k := koanf.New(".")
+	require.NoError(t, k.Set("iam.access_activity.enabled", false))
+	require.NoError(t, k.Set("iam.access_activity.flush_interval", "1m"))
+	require.NoError(t, k.Set("iam.access_activity.max_pending", 100))
+
+	cfg = NewAccessActivityConfig(k)
+	require.False(t, cfg.Enabled)
+	require.Equal(t, time.Minute, cfg.FlushInterval)
+	require.Equal(t, 100, cfg.MaxPending)
+}
@@ -0,0 +1,18 @@
+package decisioncache
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// NewHandler drops cached IAM decisions for the tenant of every iam.* event. Any event on the
+// topic can follow a membership or policy write, so the handler does not filter by type; an
+// event without a tenant clears the whole cache.
+func NewHandler(cache inputport.DecisionCacheUsecase) messaging.Handler {
+	return messaging.HandlerFunc(func(_ context.Context, msg messaging.Envelope) error {
+		cache.Invalidate(msg.TenantID)
+		return nil
+	})
+}
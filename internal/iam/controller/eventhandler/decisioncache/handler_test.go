@@ -0,0 +1,26 @@
+package decisioncache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+func TestHandler_InvalidatesEventTenant(t *testing.T) {
+	cache := mocks.NewMockDecisionCacheUsecase(t)
+	cache.EXPECT().Invalidate("t1").Once()
+	cache.EXPECT().Invalidate("").Once()
+	handler := NewHandler(cache)
+
+	require.NoError(t, handler.Handle(context.Background(), messaging.Envelope{
+		Type:     "authorization.changed",
+		TenantID: "t1",
+	}))
+	require.NoError(t, handler.Handle(context.Background(), messaging.Envelope{
+		Type: "policy.attached",
+	}))
+}
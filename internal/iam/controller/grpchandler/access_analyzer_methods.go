@@ -0,0 +1,133 @@
+package grpchandler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	iammapper "github.com/tuannm99/podzone/internal/iam/controller/mapper"
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+)
+
+func (s *IAMQueryServer) ListUnusedPermissions(
+	ctx context.Context,
+	req *pbiamv1.ListUnusedPermissionsRequest,
+) (*pbiamv1.ListUnusedPermissionsResponse, error) {
+	ctx, query, err := s.authorizeAccessAnalyzer(ctx, req.TenantId, req.OrgId, req.UnusedForSeconds)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := s.analyzer.ListUnusedPermissions(ctx, query)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ListUnusedPermissionsResponse{
+		Permissions: iammapper.ToPBUnusedPermissions(permissions),
+	}, nil
+}
+
+func (s *IAMQueryServer) ListUnusedRoles(
+	ctx context.Context,
+	req *pbiamv1.ListUnusedRolesRequest,
+) (*pbiamv1.ListUnusedRolesResponse, error) {
+	ctx, query, err := s.authorizeAccessAnalyzer(ctx, req.TenantId, req.OrgId, req.UnusedForSeconds)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.analyzer.ListUnusedRoles(ctx, query)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ListUnusedRolesResponse{Roles: iammapper.ToPBUnusedRoles(roles)}, nil
+}
+
+func (s *IAMQueryServer) ListStaleGroupMemberships(
+	ctx context.Context,
+	req *pbiamv1.ListStaleGroupMembershipsRequest,
+) (*pbiamv1.ListStaleGroupMembershipsResponse, error) {
+	ctx, query, err := s.authorizeAccessAnalyzer(ctx, req.TenantId, req.OrgId, req.UnusedForSeconds)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.analyzer.ListStaleGroupMemberships(ctx, query)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ListStaleGroupMembershipsResponse{
+		Memberships: iammapper.ToPBStaleGroupMemberships(memberships),
+	}, nil
+}
+
+func (s *IAMQueryServer) GenerateLeastPrivilegePolicy(
+	ctx context.Context,
+	req *pbiamv1.GenerateLeastPrivilegePolicyRequest,
+) (*pbiamv1.GenerateLeastPrivilegePolicyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if req.SinceSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "since_seconds must not be negative")
+	}
+	tenantID := strings.TrimSpace(req.TenantId)
+	if tenantID == "" {
+		return nil, iamStatusError(iamdomain.ErrInvalidAnalyzerScope)
+	}
+	if err := s.queries.RequirePermission(ctx, tenantID, actorUserID, "tenant:manage_members"); err != nil {
+		return nil, iamStatusError(err)
+	}
+	statements, err := s.analyzer.GenerateLeastPrivilegePolicy(ctx, iamdomain.GenerateLeastPrivilegePolicyInput{
+		TenantID: tenantID,
+		UserID:   uint(req.UserId),
+		Since:    time.Duration(req.SinceSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.GenerateLeastPrivilegePolicyResponse{
+		Statements: iammapper.ToPBPolicyStatements(statements),
+	}, nil
+}
+
+// authorizeAccessAnalyzer checks the caller may manage access in the requested scope: tenant
+// members for a tenant report, organization IAM for an organization-wide one.
+func (s *IAMQueryServer) authorizeAccessAnalyzer(
+	ctx context.Context,
+	tenantID string,
+	orgID string,
+	unusedForSeconds int64,
+) (context.Context, iamdomain.AccessAnalyzerQuery, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return ctx, iamdomain.AccessAnalyzerQuery{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if unusedForSeconds < 0 {
+		return ctx, iamdomain.AccessAnalyzerQuery{}, status.Error(
+			codes.InvalidArgument,
+			"unused_for_seconds must not be negative",
+		)
+	}
+	query := iamdomain.AccessAnalyzerQuery{
+		Scope: iamdomain.AccessAnalyzerScope{
+			TenantID: strings.TrimSpace(tenantID),
+			OrgID:    strings.TrimSpace(orgID),
+		},
+		UnusedFor: time.Duration(unusedForSeconds) * time.Second,
+	}
+	switch {
+	case (query.Scope.TenantID == "") == (query.Scope.OrgID == ""):
+		err = iamdomain.ErrInvalidAnalyzerScope
+	case query.Scope.TenantID != "":
+		err = s.queries.RequirePermission(ctx, query.Scope.TenantID, actorUserID, "tenant:manage_members")
+	default:
+		err = s.queries.RequireOrganizationPermission(ctx, query.Scope.OrgID, actorUserID, "organization:manage_iam")
+	}
+	if err != nil {
+		return ctx, iamdomain.AccessAnalyzerQuery{}, iamStatusError(err)
+	}
+	return ctx, query, nil
+}
@@ -0,0 +1,213 @@
+package grpchandler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	iammapper "github.com/tuannm99/podzone/internal/iam/controller/mapper"
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+)
+
+func (s *IAMCommandServer) PutRoleAccessPolicy(
+	ctx context.Context,
+	req *pbiamv1.PutRoleAccessPolicyRequest,
+) (*pbiamv1.PutRoleAccessPolicyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequirePlatformPermission(ctx, actorUserID, "platform:manage_roles"); err != nil {
+		return nil, iamStatusError(err)
+	}
+	policy, err := s.access.PutRoleAccessPolicy(ctx, iamdomain.PutRoleAccessPolicyInput{
+		RoleName:             req.RoleName,
+		ApproverGroupID:      req.ApproverGroupId,
+		ApproverPlatformRole: req.ApproverPlatformRole,
+		MaxDurationSeconds:   req.MaxDurationSeconds,
+	})
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.role_access_policy.put", "iam_role", policy.RoleName, "", map[string]any{
+		"approver_group_id":      policy.ApproverGroupID,
+		"approver_platform_role": policy.ApproverPlatformRole,
+		"max_duration_seconds":   policy.MaxDurationSeconds,
+	})
+	return &pbiamv1.PutRoleAccessPolicyResponse{Policy: iammapper.ToPBRoleAccessPolicy(policy)}, nil
+}
+
+func (s *IAMCommandServer) DeleteRoleAccessPolicy(
+	ctx context.Context,
+	req *pbiamv1.DeleteRoleAccessPolicyRequest,
+) (*pbiamv1.DeleteRoleAccessPolicyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequirePlatformPermission(ctx, actorUserID, "platform:manage_roles"); err != nil {
+		return nil, iamStatusError(err)
+	}
+	if err := s.access.DeleteRoleAccessPolicy(ctx, req.RoleName); err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.role_access_policy.deleted", "iam_role", req.RoleName, "", nil)
+	return &pbiamv1.DeleteRoleAccessPolicyResponse{}, nil
+}
+
+func (s *IAMCommandServer) CreateAccessRequest(
+	ctx context.Context,
+	req *pbiamv1.CreateAccessRequestRequest,
+) (*pbiamv1.CreateAccessRequestResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	var startsAt time.Time
+	if raw := strings.TrimSpace(req.StartsAt); raw != "" {
+		startsAt, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "starts_at must be an RFC3339 timestamp")
+		}
+	}
+	request, err := s.access.CreateAccessRequest(ctx, iamdomain.CreateRoleAccessRequestInput{
+		RequesterUserID: actorUserID,
+		RoleName:        req.RoleName,
+		TenantID:        req.TenantId,
+		Justification:   req.Justification,
+		StartsAt:        startsAt,
+		DurationSeconds: req.DurationSeconds,
+	})
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAccessRequestAudit(ctx, actorUserID, "iam.access_request.created", request)
+	return &pbiamv1.CreateAccessRequestResponse{Request: iammapper.ToPBAccessRequest(request)}, nil
+}
+
+func (s *IAMCommandServer) ApproveAccessRequest(
+	ctx context.Context,
+	req *pbiamv1.ApproveAccessRequestRequest,
+) (*pbiamv1.ApproveAccessRequestResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	request, err := s.access.ApproveAccessRequest(ctx, req.RequestId, actorUserID, req.Reason)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAccessRequestAudit(ctx, actorUserID, "iam.access_request.approved", request)
+	return &pbiamv1.ApproveAccessRequestResponse{Request: iammapper.ToPBAccessRequest(request)}, nil
+}
+
+func (s *IAMCommandServer) DenyAccessRequest(
+	ctx context.Context,
+	req *pbiamv1.DenyAccessRequestRequest,
+) (*pbiamv1.DenyAccessRequestResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	request, err := s.access.DenyAccessRequest(ctx, req.RequestId, actorUserID, req.Reason)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAccessRequestAudit(ctx, actorUserID, "iam.access_request.denied", request)
+	return &pbiamv1.DenyAccessRequestResponse{Request: iammapper.ToPBAccessRequest(request)}, nil
+}
+
+func (s *IAMCommandServer) CancelAccessRequest(
+	ctx context.Context,
+	req *pbiamv1.CancelAccessRequestRequest,
+) (*pbiamv1.CancelAccessRequestResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	request, err := s.access.CancelAccessRequest(ctx, req.RequestId, actorUserID)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAccessRequestAudit(ctx, actorUserID, "iam.access_request.cancelled", request)
+	return &pbiamv1.CancelAccessRequestResponse{Request: iammapper.ToPBAccessRequest(request)}, nil
+}
+
+func (s *IAMQueryServer) GetRoleAccessPolicy(
+	ctx context.Context,
+	req *pbiamv1.GetRoleAccessPolicyRequest,
+) (*pbiamv1.GetRoleAccessPolicyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequirePlatformPermission(ctx, actorUserID, "platform:manage_roles"); err != nil {
+		return nil, iamStatusError(err)
+	}
+	policy, err := s.access.GetRoleAccessPolicy(ctx, req.RoleName)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.GetRoleAccessPolicyResponse{Policy: iammapper.ToPBRoleAccessPolicy(policy)}, nil
+}
+
+func (s *IAMQueryServer) GetAccessRequest(
+	ctx context.Context,
+	req *pbiamv1.GetAccessRequestRequest,
+) (*pbiamv1.GetAccessRequestResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	request, err := s.access.GetAccessRequest(ctx, req.RequestId, actorUserID)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.GetAccessRequestResponse{Request: iammapper.ToPBAccessRequest(request)}, nil
+}
+
+func (s *IAMQueryServer) ListAccessRequests(
+	ctx context.Context,
+	req *pbiamv1.ListAccessRequestsRequest,
+) (*pbiamv1.ListAccessRequestsResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	filter := iamdomain.RoleAccessRequestFilter{
+		TenantID: req.TenantId,
+		Status:   req.Status,
+	}
+	if req.Mine {
+		filter.RequesterUserID = actorUserID
+	}
+	requests, err := s.access.ListAccessRequests(ctx, actorUserID, filter)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	items := make([]*pbiamv1.AccessRequest, 0, len(requests))
+	for i := range requests {
+		items = append(items, iammapper.ToPBAccessRequest(&requests[i]))
+	}
+	return &pbiamv1.ListAccessRequestsResponse{Requests: items}, nil
+}
+
+func (s *iamHandlerBase) recordAccessRequestAudit(
+	ctx context.Context,
+	actorUserID uint,
+	action string,
+	request *iamdomain.RoleAccessRequest,
+) {
+	s.recordAudit(ctx, actorUserID, action, "iam_access_request", request.ID, request.TenantID, map[string]any{
+		"requester_user_id": request.RequesterUserID,
+		"role_name":         request.RoleName,
+		"status":            request.Status,
+		"starts_at":         request.StartsAt,
+		"expires_at":        request.ExpiresAt,
+		"decision_reason":   request.DecisionReason,
+	})
+}
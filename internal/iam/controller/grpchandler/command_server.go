@@ -12,13 +12,21 @@ var _ pbiamv1.IAMCommandServiceServer = (*IAMCommandServer)(nil)
 type IAMCommandServer struct {
 	pbiamv1.UnimplementedIAMCommandServiceServer
 	*iamHandlerBase
-	commands iaminputport.IAMCommandUsecase
-	queries  iaminputport.IAMQueryUsecase
+	commands   iaminputport.IAMCommandUsecase
+	queries    iaminputport.IAMQueryUsecase
+	scimTokens iaminputport.SCIMTokenUsecase
+	samlConns  iaminputport.SAMLConnectionUsecase
+	samlLogins iaminputport.SAMLLoginUsecase
+	access     iaminputport.AccessRequestCommandUsecase
 }
 
 func NewIAMCommandServer(
 	commands iaminputport.IAMCommandUsecase,
 	queries iaminputport.IAMQueryUsecase,
+	scimTokens iaminputport.SCIMTokenUsecase,
+	samlConns iaminputport.SAMLConnectionUsecase,
+	samlLogins iaminputport.SAMLLoginUsecase,
+	access iaminputport.AccessRequestCommandUsecase,
 	auditRep iamoutputport.AuditLogRepository,
 	userDirectory iamoutputport.UserDirectory,
 	cfg iamconfig.ServerConfig,
@@ -27,5 +35,9 @@ func NewIAMCommandServer(
 		iamHandlerBase: newIAMHandlerBase(auditRep, userDirectory, cfg),
 		commands:       commands,
 		queries:        queries,
+		scimTokens:     scimTokens,
+		samlConns:      samlConns,
+		samlLogins:     samlLogins,
+		access:         access,
 	}
 }
@@ -70,8 +70,9 @@ func (s *IAMQueryServer) ListPermissions(
 		permissions = append(permissions, iammapper.ToPBPermission(permission))
 	}
 	return &pbiamv1.ListPermissionsResponse{
-		Permissions: permissions,
-		PageInfo:    iammapper.ToPBPageInfo(page),
+		Permissions:   permissions,
+		PageInfo:      iammapper.ToPBPageInfo(page),
+		ConditionKeys: iammapper.ToPBConditionKeys(entity.GlobalConditionKeys),
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	iamconfig "github.com/tuannm99/podzone/internal/iam/config"
 	iamoutputport "github.com/tuannm99/podzone/internal/iam/domain/outputport"
 	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 )
 
 type iamHandlerBase struct {
@@ -11,6 +12,7 @@ type iamHandlerBase struct {
 	userDirectory  iamoutputport.UserDirectory
 	appRedirectURL string
 	verifier       *pdauthn.Verifier
+	proxies        pdproxy.TrustedProxies
 }
 
 func newIAMHandlerBase(
@@ -23,5 +25,6 @@ func newIAMHandlerBase(
 		userDirectory:  userDirectory,
 		appRedirectURL: cfg.AppRedirectURL,
 		verifier:       pdauthn.NewVerifier(cfg.Authn),
+		proxies:        cfg.TrustedProxies,
 	}
 }
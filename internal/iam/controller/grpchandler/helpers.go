@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"strings"
 	"time"
@@ -105,7 +106,13 @@ func iamStatusError(err error) error {
 		errors.Is(err, iamdomain.ErrInvalidPolicyOwner),
 		errors.Is(err, iamdomain.ErrInvalidAssumeRole),
 		errors.Is(err, iamdomain.ErrInvalidServicePrincipal),
-		errors.Is(err, iamdomain.ErrInvalidPolicyStatement):
+		errors.Is(err, iamdomain.ErrInvalidPolicyStatement),
+		errors.Is(err, iamdomain.ErrSCIMTokenNameRequired),
+		errors.Is(err, iamdomain.ErrSAMLMetadataInvalid),
+		errors.Is(err, iamdomain.ErrInvalidAccessPolicy),
+		errors.Is(err, iamdomain.ErrInvalidAccessRequest),
+		errors.Is(err, iamdomain.ErrAccessJustificationRequired),
+		errors.Is(err, iamdomain.ErrInvalidAnalyzerScope):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, iamdomain.ErrTenantNotFound),
 		errors.Is(err, iamdomain.ErrOrganizationNotFound),
@@ -114,14 +121,24 @@ func iamStatusError(err error) error {
 		errors.Is(err, iamdomain.ErrRoleNotFound),
 		errors.Is(err, iamdomain.ErrPolicyNotFound),
 		errors.Is(err, iamdomain.ErrPolicyVersionNotFound),
-		errors.Is(err, iamdomain.ErrGroupNotFound):
+		errors.Is(err, iamdomain.ErrGroupNotFound),
+		errors.Is(err, iamdomain.ErrSCIMTokenNotFound),
+		errors.Is(err, iamdomain.ErrSAMLConnectionNotFound),
+		errors.Is(err, iamdomain.ErrAccessPolicyNotFound),
+		errors.Is(err, iamdomain.ErrAccessRequestNotFound),
+		errors.Is(err, iamdomain.ErrNoAccessActivity):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, iamdomain.ErrPolicyValidationFailed):
+		return policyValidationStatus(err)
 	case errors.Is(err, iamdomain.ErrTenantSlugTaken):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, iamdomain.ErrPermissionDenied),
-		errors.Is(err, iamdomain.ErrAssumeRoleDenied):
+		errors.Is(err, iamdomain.ErrAssumeRoleDenied),
+		errors.Is(err, iamdomain.ErrAccessRequestForbidden):
 		return permissionDeniedStatus(err)
-	case errors.Is(err, iamdomain.ErrInactiveMembership):
+	case errors.Is(err, iamdomain.ErrInactiveMembership),
+		errors.Is(err, iamdomain.ErrAccessRequestClosed),
+		errors.Is(err, iamdomain.ErrAccessRequestInUse):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, iamdomain.ErrImmutablePolicy),
 		errors.Is(err, iamdomain.ErrImmutableGroup),
@@ -158,6 +175,27 @@ func permissionDeniedStatus(err error) error {
 	return detailedStatus.Err()
 }
 
+// policyValidationStatus lists the error findings of a strict-mode write as field violations.
+func policyValidationStatus(err error) error {
+	baseStatus := status.New(codes.InvalidArgument, err.Error())
+	var validationError *iamdomain.PolicyValidationError
+	if !errors.As(err, &validationError) {
+		return baseStatus.Err()
+	}
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationError.Findings))
+	for _, finding := range validationError.Findings {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("statements[%d]", finding.StatementIndex),
+			Description: finding.Code + ": " + finding.Message,
+		})
+	}
+	detailedStatus, detailErr := baseStatus.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailErr != nil {
+		return baseStatus.Err()
+	}
+	return detailedStatus.Err()
+}
+
 func (s *iamHandlerBase) authorizedContext(ctx context.Context) (context.Context, uint, error) {
 	claims, err := s.claimsFromContext(ctx)
 	if err != nil {
@@ -165,6 +203,8 @@ func (s *iamHandlerBase) authorizedContext(ctx context.Context) (context.Context
 	}
 	ctx = iamdomain.WithSessionPolicyStatements(ctx, iammapper.ToIAMSessionPolicyStatements(claims.SessionPolicy))
 	ctx = iamdomain.WithSessionTags(ctx, claims.SessionTags)
+	ctx = iamdomain.WithMultiFactorAuthPresent(ctx, claims.MultiFactorAuthPresent)
+	ctx = iamdomain.WithRequestContext(ctx, requestContextFromClaims(ctx, s.proxies, claims))
 	if claims.AssumedRoleID != 0 && claims.AssumedRoleName != "" {
 		ctx = iamdomain.WithAssumedRole(ctx, iamdomain.AssumedRole{
 			RoleID:           claims.AssumedRoleID,
@@ -2,19 +2,25 @@ package grpchandler
 
 import (
 	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	iamentity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	iammocks "github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
 	pbcommonv1 "github.com/tuannm99/podzone/pkg/api/proto/common/v1"
 	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
 	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 )
 
 func TestIAMStatusErrorIncludesMissingPermissionDetails(t *testing.T) {
@@ -127,6 +133,43 @@ func TestCheckPermission_MissingMembershipReturnsNotAllowed(t *testing.T) {
 	assert.False(t, res.Allowed)
 }
 
+func TestCheckPermission_CarriesTrustedProxyRequestContext(t *testing.T) {
+	var request iamentity.RequestContext
+	usecase := newIAMUsecaseMock(t, iamUsecaseMockConfig{
+		checkPermissionFunc: func(ctx context.Context, tenantID string, userID uint, permission string) (bool, error) {
+			request, _ = iamentity.GetRequestContext(ctx)
+			return true, nil
+		},
+	})
+	cfg := testIAMServerCfg
+	cfg.TrustedProxies = pdproxy.NewTrustedProxies("10.0.0.0/8")
+	srv := newIAMServerForTestWithConfig(t, usecase, cfg)
+	md, _ := metadata.FromIncomingContext(authContextForIAMUser(t, 9))
+	md = metadata.Join(md, metadata.Pairs(
+		"x-real-ip", "203.0.113.7",
+		"x-forwarded-for", "198.51.100.1",
+		"x-forwarded-proto", "https",
+	))
+	req := &pbiamv1.CheckPermissionRequest{TenantId: "tenant-1", UserId: 9, Permission: "store:read"}
+
+	proxied := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4711},
+	})
+	_, err := srv.CheckPermission(proxied, req)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", request.SourceIP)
+	assert.True(t, request.SecureTransport)
+	assert.Equal(t, "podzone", request.IdentitySource)
+
+	direct := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.50"), Port: 4711},
+	})
+	_, err = srv.CheckPermission(direct, req)
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.50", request.SourceIP, "forwarded facts from an untrusted peer are ignored")
+	assert.False(t, request.SecureTransport)
+}
+
 func TestCheckPermission_RequiresAuthenticatedActor(t *testing.T) {
 	srv := newIAMServerForTest(t, newIAMUsecaseMock(t, iamUsecaseMockConfig{}))
 
@@ -564,3 +607,255 @@ func TestEnsureRootOrganization_RejectsNonSelfServiceUser(t *testing.T) {
 	)
 	require.Equal(t, codes.PermissionDenied, status.Code(err))
 }
+
+func TestApplySAMLLogin_RequiresTokenFromThatLogin(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{})
+	samlLogins := iammocks.NewMockSAMLLoginUsecase(t)
+	samlLogins.EXPECT().ApplySAMLLogin(mock.Anything, "org-1", uint(7), []string{"Engineering"}).Return(nil)
+	usecases.samlLogins = samlLogins
+	srv := newIAMServerForTest(t, usecases)
+	req := &pbiamv1.ApplySAMLLoginRequest{OrgId: "org-1", UserId: 7, Groups: []string{"Engineering"}}
+
+	for _, source := range []string{"podzone", "saml:org-2"} {
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "Bearer "+rawAccessTokenForIAMUserSource(t, 7, source)),
+		)
+		_, err := srv.ApplySAMLLogin(ctx, req)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), source)
+	}
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs("authorization", "Bearer "+rawAccessTokenForIAMUserSource(t, 7, "saml:org-1")),
+	)
+	_, err := srv.ApplySAMLLogin(ctx, req)
+	require.NoError(t, err)
+}
+
+func TestCreateAccessRequest_UsesAuthenticatedRequester(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{})
+	access := iammocks.NewMockAccessRequestCommandUsecase(t)
+	startsAt := nowPlusHour().Truncate(time.Second)
+	access.EXPECT().
+		CreateAccessRequest(mock.Anything, iamentity.CreateRoleAccessRequestInput{
+			RequesterUserID: 7,
+			RoleName:        iamentity.RoleTenantAdmin,
+			TenantID:        "tenant-1",
+			Justification:   "incident 42",
+			StartsAt:        startsAt,
+			DurationSeconds: 1800,
+		}).
+		Return(&iamentity.RoleAccessRequest{
+			ID:              "ar-1",
+			RequesterUserID: 7,
+			RoleName:        iamentity.RoleTenantAdmin,
+			TenantID:        "tenant-1",
+			Status:          iamentity.AccessRequestStatusPending,
+			StartsAt:        startsAt,
+			ExpiresAt:       startsAt.Add(30 * time.Minute),
+		}, nil)
+	usecases.access = access
+	srv := newIAMServerForTest(t, usecases)
+
+	res, err := srv.CreateAccessRequest(authContextForIAMUser(t, 7), &pbiamv1.CreateAccessRequestRequest{
+		RoleName:        iamentity.RoleTenantAdmin,
+		TenantId:        "tenant-1",
+		Justification:   "incident 42",
+		StartsAt:        startsAt.Format(time.RFC3339),
+		DurationSeconds: 1800,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ar-1", res.Request.Id)
+	assert.Equal(t, uint64(7), res.Request.RequesterUserId)
+	assert.Equal(t, iamentity.AccessRequestStatusPending, res.Request.Status)
+}
+
+func TestCreateAccessRequest_RejectsInvalidStartsAt(t *testing.T) {
+	srv := newIAMServerForTest(t, newIAMUsecaseMock(t, iamUsecaseMockConfig{}))
+
+	_, err := srv.CreateAccessRequest(authContextForIAMUser(t, 7), &pbiamv1.CreateAccessRequestRequest{
+		RoleName: iamentity.RoleTenantAdmin,
+		StartsAt: "tomorrow",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestApproveAccessRequest_MapsDecisionErrors(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{})
+	access := iammocks.NewMockAccessRequestCommandUsecase(t)
+	access.EXPECT().
+		ApproveAccessRequest(mock.Anything, "ar-1", uint(7), "").
+		Return(nil, iamentity.ErrAccessRequestForbidden).
+		Once()
+	access.EXPECT().
+		ApproveAccessRequest(mock.Anything, "ar-2", uint(7), "").
+		Return(nil, iamentity.ErrAccessRequestClosed).
+		Once()
+	usecases.access = access
+	srv := newIAMServerForTest(t, usecases)
+
+	ctx := authContextForIAMUser(t, 7)
+	_, err := srv.ApproveAccessRequest(ctx, &pbiamv1.ApproveAccessRequestRequest{RequestId: "ar-1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.ApproveAccessRequest(ctx, &pbiamv1.ApproveAccessRequestRequest{RequestId: "ar-2"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListAccessRequests_MineFiltersByActor(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{})
+	accessView := iammocks.NewMockAccessRequestQueryUsecase(t)
+	accessView.EXPECT().
+		ListAccessRequests(mock.Anything, uint(7), iamentity.RoleAccessRequestFilter{
+			RequesterUserID: 7,
+			Status:          iamentity.AccessRequestStatusApproved,
+		}).
+		Return([]iamentity.RoleAccessRequest{{ID: "ar-1", RequesterUserID: 7}}, nil)
+	usecases.accessView = accessView
+	srv := newIAMServerForTest(t, usecases)
+
+	res, err := srv.ListAccessRequests(authContextForIAMUser(t, 7), &pbiamv1.ListAccessRequestsRequest{
+		Status: iamentity.AccessRequestStatusApproved,
+		Mine:   true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Requests, 1)
+	assert.Equal(t, "ar-1", res.Requests[0].Id)
+}
+
+func TestListUnusedRoles_OrganizationScopeRequiresOrganizationIAM(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{
+		requireOrganizationPermissionFunc: func(_ context.Context, orgID string, userID uint, permission string) error {
+			assert.Equal(t, "org-1", orgID)
+			assert.Equal(t, uint(7), userID)
+			assert.Equal(t, "organization:manage_iam", permission)
+			return nil
+		},
+	})
+	analyzer := iammocks.NewMockAccessAnalyzerUsecase(t)
+	analyzer.EXPECT().
+		ListUnusedRoles(mock.Anything, iamentity.AccessAnalyzerQuery{
+			Scope:     iamentity.AccessAnalyzerScope{OrgID: "org-1"},
+			UnusedFor: 30 * 24 * time.Hour,
+		}).
+		Return([]iamentity.UnusedRole{{TenantID: "tenant-1", UserID: 9, RoleID: 3, RoleName: "tenant_viewer"}}, nil)
+	usecases.analyzer = analyzer
+	srv := newIAMServerForTest(t, usecases)
+
+	res, err := srv.ListUnusedRoles(authContextForIAMUser(t, 7), &pbiamv1.ListUnusedRolesRequest{
+		OrgId:            "org-1",
+		UnusedForSeconds: 30 * 24 * 3600,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Roles, 1)
+	assert.Equal(t, "tenant_viewer", res.Roles[0].RoleName)
+	assert.Empty(t, res.Roles[0].LastAccessedAt)
+}
+
+func TestListUnusedPermissions_RejectsAmbiguousScope(t *testing.T) {
+	srv := newIAMServerForTest(t, newIAMUsecaseMock(t, iamUsecaseMockConfig{}))
+
+	_, err := srv.ListUnusedPermissions(authContextForIAMUser(t, 7), &pbiamv1.ListUnusedPermissionsRequest{
+		TenantId: "tenant-1",
+		OrgId:    "org-1",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenerateLeastPrivilegePolicy_MapsMissingActivityToNotFound(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{})
+	usecases.queries.(*iammocks.MockIAMQueryUsecase).EXPECT().
+		RequirePermission(mock.Anything, "tenant-1", uint(7), "tenant:manage_members").
+		Return(nil)
+	analyzer := iammocks.NewMockAccessAnalyzerUsecase(t)
+	analyzer.EXPECT().
+		GenerateLeastPrivilegePolicy(mock.Anything, iamentity.GenerateLeastPrivilegePolicyInput{
+			TenantID: "tenant-1",
+			UserID:   9,
+		}).
+		Return(nil, iamentity.ErrNoAccessActivity)
+	usecases.analyzer = analyzer
+	srv := newIAMServerForTest(t, usecases)
+
+	_, err := srv.GenerateLeastPrivilegePolicy(authContextForIAMUser(t, 7), &pbiamv1.GenerateLeastPrivilegePolicyRequest{
+		TenantId: "tenant-1",
+		UserId:   9,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestValidatePolicy_ReportsFindings(t *testing.T) {
+	usecases := newIAMUsecaseMock(t, iamUsecaseMockConfig{
+		requirePlatformPermissionFunc: func(context.Context, uint, string) error { return nil },
+	})
+	usecases.queries.(*iammocks.MockIAMQueryUsecase).EXPECT().
+		ValidatePolicy(mock.Anything, iamentity.ValidatePolicyInput{
+			Scope:      "platform",
+			PolicyName: "managed/test",
+			Statements: []iamentity.PolicyStatement{{
+				Effect:          "allow",
+				ActionPattern:   "tenant:frobnicate",
+				ResourcePattern: "*",
+				Conditions:      []iamentity.PolicyCondition{},
+			}},
+		}).
+		Return([]iamentity.PolicyFinding{{
+			Code:     iamentity.PolicyFindingUnknownAction,
+			Severity: iamentity.PolicyFindingSeverityError,
+			Message:  `action "tenant:frobnicate" matches no known permission`,
+		}}, nil)
+	srv := newIAMServerForTest(t, usecases)
+
+	res, err := srv.ValidatePolicy(authContextForIAMUser(t, 7), &pbiamv1.ValidatePolicyRequest{
+		Scope: "platform",
+		Name:  "managed/test",
+		Statements: []*pbcommonv1.PolicyStatement{{
+			Effect:          "allow",
+			ActionPattern:   "tenant:frobnicate",
+			ResourcePattern: "*",
+		}},
+	})
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	require.Len(t, res.Findings, 1)
+	assert.Equal(t, iamentity.PolicyFindingUnknownAction, res.Findings[0].Code)
+	assert.Equal(t, iamentity.PolicyFindingSeverityError, res.Findings[0].Severity)
+}
+
+func TestCreatePolicy_StrictMapsFindingsToBadRequest(t *testing.T) {
+	srv := newIAMServerForTest(t, newIAMUsecaseMock(t, iamUsecaseMockConfig{
+		requirePlatformPermissionFunc: func(context.Context, uint, string) error { return nil },
+		createPolicyFunc: func(
+			ctx context.Context,
+			input iamentity.CreatePolicyInput,
+		) (*iamentity.Policy, []iamentity.PolicyStatement, error) {
+			require.True(t, input.Strict)
+			return nil, nil, &iamentity.PolicyValidationError{Findings: []iamentity.PolicyFinding{{
+				Code:           iamentity.PolicyFindingUnknownAction,
+				Severity:       iamentity.PolicyFindingSeverityError,
+				StatementIndex: 0,
+				Message:        `action "tenant:frobnicate" matches no known permission`,
+			}}}
+		},
+	}))
+
+	_, err := srv.CreatePolicy(authContextForIAMUser(t, 7), &pbiamv1.CreatePolicyRequest{
+		Scope:  "platform",
+		Name:   "managed/test",
+		Strict: true,
+		Statements: []*pbcommonv1.PolicyStatement{{
+			Effect:          "allow",
+			ActionPattern:   "tenant:frobnicate",
+			ResourcePattern: "*",
+		}},
+	})
+	grpcStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, grpcStatus.Code())
+	require.Len(t, grpcStatus.Details(), 1)
+	detail, ok := grpcStatus.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, detail.FieldViolations, 1)
+	assert.Equal(t, "statements[0]", detail.FieldViolations[0].Field)
+	assert.Contains(t, detail.FieldViolations[0].Description, iamentity.PolicyFindingUnknownAction)
+}
@@ -113,8 +113,14 @@ type iamUsecaseMockConfig struct {
 }
 
 type iamUsecaseMocks struct {
-	commands iaminputport.IAMCommandUsecase
-	queries  iaminputport.IAMQueryUsecase
+	commands   iaminputport.IAMCommandUsecase
+	queries    iaminputport.IAMQueryUsecase
+	scimTokens iaminputport.SCIMTokenUsecase
+	samlConns  iaminputport.SAMLConnectionUsecase
+	samlLogins iaminputport.SAMLLoginUsecase
+	access     iaminputport.AccessRequestCommandUsecase
+	accessView iaminputport.AccessRequestQueryUsecase
+	analyzer   iaminputport.AccessAnalyzerUsecase
 }
 
 func newIAMUsecaseMock(t *testing.T, cfg iamUsecaseMockConfig) iamUsecaseMocks {
@@ -232,10 +238,24 @@ func newIAMUsecaseMock(t *testing.T, cfg iamUsecaseMockConfig) iamUsecaseMocks {
 			RunAndReturn(cfg.listPermissionsFunc).
 			Maybe()
 	}
-	return iamUsecaseMocks{commands: commands, queries: queries}
+	return iamUsecaseMocks{
+		commands:   commands,
+		queries:    queries,
+		scimTokens: iammocks.NewMockSCIMTokenUsecase(t),
+		samlConns:  iammocks.NewMockSAMLConnectionUsecase(t),
+		samlLogins: iammocks.NewMockSAMLLoginUsecase(t),
+		access:     iammocks.NewMockAccessRequestCommandUsecase(t),
+		accessView: iammocks.NewMockAccessRequestQueryUsecase(t),
+		analyzer:   iammocks.NewMockAccessAnalyzerUsecase(t),
+	}
 }
 
 func newIAMServerForTest(t *testing.T, usecases iamUsecaseMocks) *IAMServer {
+	t.Helper()
+	return newIAMServerForTestWithConfig(t, usecases, testIAMServerCfg)
+}
+
+func newIAMServerForTestWithConfig(t *testing.T, usecases iamUsecaseMocks, cfg iamconfig.ServerConfig) *IAMServer {
 	t.Helper()
 	auditRepo := iamoutputmocks.NewMockAuditLogRepository(t)
 	auditRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Maybe()
@@ -243,15 +263,23 @@ func newIAMServerForTest(t *testing.T, usecases iamUsecaseMocks) *IAMServer {
 	commandServer := NewIAMCommandServer(
 		usecases.commands,
 		usecases.queries,
+		usecases.scimTokens,
+		usecases.samlConns,
+		usecases.samlLogins,
+		usecases.access,
 		auditRepo,
 		userDirectory,
-		testIAMServerCfg,
+		cfg,
 	)
 	queryServer := NewIAMQueryServer(
 		usecases.queries,
+		usecases.scimTokens,
+		usecases.samlConns,
+		usecases.accessView,
+		usecases.analyzer,
 		auditRepo,
 		userDirectory,
-		testIAMServerCfg,
+		cfg,
 	)
 	return NewIAMServer(commandServer, queryServer)
 }
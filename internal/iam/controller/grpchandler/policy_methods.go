@@ -28,6 +28,7 @@ func (s *IAMCommandServer) CreatePolicy(
 		Name:        req.Name,
 		Description: req.Description,
 		Statements:  iammapper.FromPBPolicyStatements(req.Statements),
+		Strict:      req.Strict,
 	})
 	if err != nil {
 		return nil, iamStatusError(err)
@@ -59,6 +60,7 @@ func (s *IAMCommandServer) CreatePolicyVersion(
 		PolicyName:   req.Name,
 		Statements:   iammapper.FromPBPolicyStatements(req.Statements),
 		SetAsDefault: req.SetAsDefault,
+		Strict:       req.Strict,
 	})
 	if err != nil {
 		return nil, iamStatusError(err)
@@ -246,6 +248,32 @@ func (s *IAMQueryServer) ListPolicyAttachments(
 	}, nil
 }
 
+func (s *IAMQueryServer) ValidatePolicy(
+	ctx context.Context,
+	req *pbiamv1.ValidatePolicyRequest,
+) (*pbiamv1.ValidatePolicyResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := requirePolicyAccess(ctx, s.queries, actorUserID, req.Scope, req.OrgId, false); err != nil {
+		return nil, iamStatusError(err)
+	}
+	findings, err := s.queries.ValidatePolicy(ctx, iamdomain.ValidatePolicyInput{
+		Scope:      req.Scope,
+		OrgID:      req.OrgId,
+		PolicyName: req.Name,
+		Statements: iammapper.FromPBPolicyStatements(req.Statements),
+	})
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ValidatePolicyResponse{
+		Valid:    len(iamdomain.PolicyFindingErrors(findings)) == 0,
+		Findings: iammapper.ToPBPolicyFindings(findings),
+	}, nil
+}
+
 func policyRefFromRequest(scope string, orgID string, name string) iamdomain.PolicyRef {
 	return iamdomain.PolicyRef{Scope: scope, OrgID: orgID, Name: name}
 }
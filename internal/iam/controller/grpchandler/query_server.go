@@ -12,11 +12,19 @@ var _ pbiamv1.IAMQueryServiceServer = (*IAMQueryServer)(nil)
 type IAMQueryServer struct {
 	pbiamv1.UnimplementedIAMQueryServiceServer
 	*iamHandlerBase
-	queries iaminputport.IAMQueryUsecase
+	queries    iaminputport.IAMQueryUsecase
+	scimTokens iaminputport.SCIMTokenUsecase
+	samlConns  iaminputport.SAMLConnectionUsecase
+	access     iaminputport.AccessRequestQueryUsecase
+	analyzer   iaminputport.AccessAnalyzerUsecase
 }
 
 func NewIAMQueryServer(
 	queries iaminputport.IAMQueryUsecase,
+	scimTokens iaminputport.SCIMTokenUsecase,
+	samlConns iaminputport.SAMLConnectionUsecase,
+	access iaminputport.AccessRequestQueryUsecase,
+	analyzer iaminputport.AccessAnalyzerUsecase,
 	auditRep iamoutputport.AuditLogRepository,
 	userDirectory iamoutputport.UserDirectory,
 	cfg iamconfig.ServerConfig,
@@ -24,5 +32,9 @@ func NewIAMQueryServer(
 	return &IAMQueryServer{
 		iamHandlerBase: newIAMHandlerBase(auditRep, userDirectory, cfg),
 		queries:        queries,
+		scimTokens:     scimTokens,
+		samlConns:      samlConns,
+		access:         access,
+		analyzer:       analyzer,
 	}
 }
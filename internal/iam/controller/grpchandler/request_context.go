@@ -0,0 +1,28 @@
+package grpchandler
+
+import (
+	"context"
+
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
+)
+
+// requestContextFromClaims collects the facts behind the global condition keys. x-real-ip and
+// x-forwarded-proto are believed only from iam.trusted_proxies: the gateway, and services that
+// forward their caller's client facts on CheckPermission. Other callers are judged by the peer.
+func requestContextFromClaims(
+	ctx context.Context,
+	proxies pdproxy.TrustedProxies,
+	claims *pdauthn.Claims,
+) iamdomain.RequestContext {
+	request := iamdomain.RequestContext{
+		SourceIP:        proxies.ClientIPFromContext(ctx),
+		SecureTransport: proxies.SecureTransportFromContext(ctx),
+		IdentitySource:  claims.IdentitySource,
+	}
+	if claims.AuthTime != nil {
+		request.AuthTime = claims.AuthTime.Time
+	}
+	return request
+}
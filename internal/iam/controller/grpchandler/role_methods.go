@@ -39,6 +39,21 @@ func (s *IAMCommandServer) AssumeRole(
 	if err != nil {
 		return nil, iamStatusError(err)
 	}
+	if assumedRole.AccessRequestID != "" {
+		s.recordAudit(
+			ctx,
+			claims.UserID,
+			"iam.access_request.used",
+			"iam_access_request",
+			assumedRole.AccessRequestID,
+			assumedRole.TenantID,
+			map[string]any{
+				"role_name":    assumedRole.RoleName,
+				"session_name": assumedRole.SessionName,
+				"expires_at":   assumedRole.ExpiresAt,
+			},
+		)
+	}
 	return &pbiamv1.IAMAssumeRoleResponse{
 		AssumedRole: iammapper.ToPBIAMAssumedRole(assumedRole),
 	}, nil
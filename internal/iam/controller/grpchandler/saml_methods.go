@@ -0,0 +1,165 @@
+package grpchandler
+
+import (
+	"context"
+
+	iammapper "github.com/tuannm99/podzone/internal/iam/controller/mapper"
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+)
+
+func (s *IAMCommandServer) PutOrganizationSAMLConnection(
+	ctx context.Context,
+	req *pbiamv1.PutOrganizationSAMLConnectionRequest,
+) (*pbiamv1.PutOrganizationSAMLConnectionResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	connection, err := s.samlConns.PutSAMLConnection(ctx, req.OrgId, iamdomain.PutSAMLConnectionInput{
+		MetadataXML:       req.MetadataXml,
+		UsernameAttribute: req.UsernameAttribute,
+		EmailAttribute:    req.EmailAttribute,
+		GroupsAttribute:   req.GroupsAttribute,
+		SSORequired:       req.SsoRequired,
+	})
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.saml_connection.updated", "organization", connection.OrgID, "", map[string]any{
+		"idp_entity_id": connection.IdPEntityID,
+		"sso_required":  connection.SSORequired,
+	})
+	return &pbiamv1.PutOrganizationSAMLConnectionResponse{
+		Connection: iammapper.ToPBSAMLConnection(connection),
+	}, nil
+}
+
+func (s *IAMCommandServer) DeleteOrganizationSAMLConnection(
+	ctx context.Context,
+	req *pbiamv1.DeleteOrganizationSAMLConnectionRequest,
+) (*pbiamv1.DeleteOrganizationSAMLConnectionResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	if err := s.samlConns.DeleteSAMLConnection(ctx, req.OrgId); err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.saml_connection.deleted", "organization", req.OrgId, "", nil)
+	return &pbiamv1.DeleteOrganizationSAMLConnectionResponse{}, nil
+}
+
+// ApplySAMLLogin only accepts the token minted for the SAML login itself, so a user cannot
+// grant themselves membership of an organization they did not authenticate into.
+func (s *IAMCommandServer) ApplySAMLLogin(
+	ctx context.Context,
+	req *pbiamv1.ApplySAMLLoginRequest,
+) (*pbiamv1.ApplySAMLLoginResponse, error) {
+	claims, err := s.claimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if claims.IdentitySource != iamdomain.SAMLIdentitySource(req.OrgId) || uint64(claims.UserID) != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "saml login must be applied with the token it produced")
+	}
+	if err := s.samlLogins.ApplySAMLLogin(ctx, req.OrgId, claims.UserID, req.Groups); err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, claims.UserID, "iam.saml_login.applied", "organization", req.OrgId, "", map[string]any{
+		"groups": req.Groups,
+	})
+	return &pbiamv1.ApplySAMLLoginResponse{}, nil
+}
+
+func (s *IAMQueryServer) GetOrganizationSAMLConnection(
+	ctx context.Context,
+	req *pbiamv1.GetOrganizationSAMLConnectionRequest,
+) (*pbiamv1.GetOrganizationSAMLConnectionResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	connection, err := s.samlConns.GetSAMLConnection(ctx, req.OrgId)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.GetOrganizationSAMLConnectionResponse{
+		Connection: iammapper.ToPBSAMLConnection(connection),
+	}, nil
+}
+
+// ResolveSAMLConnection serves the auth service before any user is authenticated. IdP
+// metadata is public, so the connection is returned as stored. Like GetTenantMembership, the
+// lookups below are internal and unauthenticated.
+func (s *IAMQueryServer) ResolveSAMLConnection(
+	ctx context.Context,
+	req *pbiamv1.ResolveSAMLConnectionRequest,
+) (*pbiamv1.ResolveSAMLConnectionResponse, error) {
+	connection, err := s.samlConns.GetSAMLConnection(ctx, req.OrgId)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ResolveSAMLConnectionResponse{
+		Connection: iammapper.ToPBSAMLConnection(connection),
+	}, nil
+}
+
+func (s *IAMQueryServer) ListSSORequiredOrganizations(
+	ctx context.Context,
+	req *pbiamv1.ListSSORequiredOrganizationsRequest,
+) (*pbiamv1.ListSSORequiredOrganizationsResponse, error) {
+	userID, err := toUint(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.samlConns.SSORequiredOrganizations(ctx, userID)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.ListSSORequiredOrganizationsResponse{OrgIds: orgIDs}, nil
+}
+
+func (s *IAMQueryServer) GetOrganizationMembership(
+	ctx context.Context,
+	req *pbiamv1.GetOrganizationMembershipRequest,
+) (*pbiamv1.GetOrganizationMembershipResponse, error) {
+	userID, err := toUint(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	membership, err := s.samlConns.OrganizationMembership(ctx, req.OrgId, userID)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	return &pbiamv1.GetOrganizationMembershipResponse{
+		Membership: iammapper.ToPBOrganizationMembership(membership),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package grpchandler
+
+import (
+	"context"
+
+	iammapper "github.com/tuannm99/podzone/internal/iam/controller/mapper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+)
+
+func (s *IAMCommandServer) CreateSCIMToken(
+	ctx context.Context,
+	req *pbiamv1.CreateSCIMTokenRequest,
+) (*pbiamv1.CreateSCIMTokenResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	token, rawToken, err := s.scimTokens.CreateSCIMToken(ctx, req.OrgId, req.Name, actorUserID)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.scim_token.created", "scim_token", token.ID, "", map[string]any{
+		"org_id":       token.OrgID,
+		"name":         token.Name,
+		"token_prefix": token.TokenPrefix,
+	})
+	return &pbiamv1.CreateSCIMTokenResponse{
+		Token:     iammapper.ToPBSCIMToken(token),
+		ScimToken: rawToken,
+	}, nil
+}
+
+func (s *IAMCommandServer) RevokeSCIMToken(
+	ctx context.Context,
+	req *pbiamv1.RevokeSCIMTokenRequest,
+) (*pbiamv1.RevokeSCIMTokenResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	if err := s.scimTokens.RevokeSCIMToken(ctx, req.OrgId, req.TokenId); err != nil {
+		return nil, iamStatusError(err)
+	}
+	s.recordAudit(ctx, actorUserID, "iam.scim_token.revoked", "scim_token", req.TokenId, "", map[string]any{
+		"org_id": req.OrgId,
+	})
+	return &pbiamv1.RevokeSCIMTokenResponse{}, nil
+}
+
+func (s *IAMQueryServer) ListSCIMTokens(
+	ctx context.Context,
+	req *pbiamv1.ListSCIMTokensRequest,
+) (*pbiamv1.ListSCIMTokensResponse, error) {
+	ctx, actorUserID, err := s.authorizedContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.queries.RequireOrganizationPermission(
+		ctx,
+		req.OrgId,
+		actorUserID,
+		"organization:manage_iam",
+	); err != nil {
+		return nil, iamStatusError(err)
+	}
+	tokens, err := s.scimTokens.ListSCIMTokens(ctx, req.OrgId)
+	if err != nil {
+		return nil, iamStatusError(err)
+	}
+	items := make([]*pbiamv1.SCIMToken, 0, len(tokens))
+	for i := range tokens {
+		items = append(items, iammapper.ToPBSCIMToken(&tokens[i]))
+	}
+	return &pbiamv1.ListSCIMTokensResponse{Tokens: items}, nil
+}
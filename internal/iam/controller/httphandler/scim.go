@@ -0,0 +1,464 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	iaminputport "github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdscim"
+)
+
+const (
+	scimBasePath = "/scim/v2"
+	// scimDefaultCount and scimMaxResults bound list pages; ServiceProviderConfig advertises the max.
+	scimDefaultCount = 100
+	scimMaxResults   = 200
+	scimOrgIDKey     = "scim_org_id"
+)
+
+// SCIMHandler serves the SCIM 2.0 provisioning endpoint. Every resource request is scoped to
+// the organization that owns the bearer token.
+type SCIMHandler struct {
+	tokens iaminputport.SCIMTokenUsecase
+	scim   iaminputport.SCIMUsecase
+}
+
+func NewSCIMHandler(tokens iaminputport.SCIMTokenUsecase, scim iaminputport.SCIMUsecase) *SCIMHandler {
+	return &SCIMHandler{tokens: tokens, scim: scim}
+}
+
+func (h *SCIMHandler) RegisterRoutes() pdhttp.RouteRegistrar {
+	return func(router *gin.Engine) {
+		discovery := router.Group(scimBasePath)
+		discovery.GET("/ServiceProviderConfig", h.ServiceProviderConfig)
+		discovery.GET("/ResourceTypes", h.ResourceTypes)
+		discovery.GET("/Schemas", h.Schemas)
+
+		resources := router.Group(scimBasePath, h.authenticate)
+		resources.GET("/Users", h.ListUsers)
+		resources.POST("/Users", h.CreateUser)
+		resources.GET("/Users/:id", h.GetUser)
+		resources.PUT("/Users/:id", h.ReplaceUser)
+		resources.PATCH("/Users/:id", h.PatchUser)
+		resources.DELETE("/Users/:id", h.DeleteUser)
+		resources.GET("/Groups", h.ListGroups)
+		resources.POST("/Groups", h.CreateGroup)
+		resources.GET("/Groups/:id", h.GetGroup)
+		resources.PUT("/Groups/:id", h.ReplaceGroup)
+		resources.PATCH("/Groups/:id", h.PatchGroup)
+		resources.DELETE("/Groups/:id", h.DeleteGroup)
+	}
+}
+
+func (h *SCIMHandler) authenticate(ctx *gin.Context) {
+	scheme, rawToken, _ := strings.Cut(ctx.GetHeader("Authorization"), " ")
+	if !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(rawToken) == "" {
+		h.unauthorized(ctx)
+		return
+	}
+	token, err := h.tokens.AuthenticateSCIMToken(ctx.Request.Context(), rawToken)
+	if errors.Is(err, iamdomain.ErrSCIMTokenInvalid) {
+		h.unauthorized(ctx)
+		return
+	}
+	if err != nil {
+		writeSCIMError(ctx, err)
+		ctx.Abort()
+		return
+	}
+	ctx.Set(scimOrgIDKey, token.OrgID)
+	ctx.Next()
+}
+
+func (h *SCIMHandler) unauthorized(ctx *gin.Context) {
+	ctx.Header("WWW-Authenticate", `Bearer realm="scim"`)
+	writeSCIMError(ctx, pdscim.NewError(http.StatusUnauthorized, "", "a valid SCIM bearer token is required"))
+	ctx.Abort()
+}
+
+func (h *SCIMHandler) ServiceProviderConfig(ctx *gin.Context) {
+	writeSCIM(ctx, http.StatusOK, scimServiceProviderConfig)
+}
+
+func (h *SCIMHandler) ResourceTypes(ctx *gin.Context) {
+	types := scimResourceTypes()
+	writeSCIM(ctx, http.StatusOK, pdscim.Page(types, 1, len(types)))
+}
+
+func (h *SCIMHandler) Schemas(ctx *gin.Context) {
+	schemas := scimSchemas()
+	writeSCIM(ctx, http.StatusOK, pdscim.Page(schemas, 1, len(schemas)))
+}
+
+func (h *SCIMHandler) ListUsers(ctx *gin.Context) {
+	users, err := h.scim.ListSCIMUsers(ctx.Request.Context(), scimOrgID(ctx))
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	baseURL := scimBaseURL(ctx)
+	resources := make([]any, 0, len(users))
+	for i := range users {
+		resources = append(resources, newSCIMUserResource(&users[i], baseURL))
+	}
+	writeSCIMList(ctx, resources)
+}
+
+func (h *SCIMHandler) CreateUser(ctx *gin.Context) {
+	var resource scimUserResource
+	if !bindSCIM(ctx, &resource) {
+		return
+	}
+	user, err := h.scim.CreateSCIMUser(ctx.Request.Context(), scimOrgID(ctx), resource.toInput())
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusCreated, newSCIMUserResource(user, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) GetUser(ctx *gin.Context) {
+	userID, ok := scimUserIDParam(ctx)
+	if !ok {
+		return
+	}
+	user, err := h.scim.GetSCIMUser(ctx.Request.Context(), scimOrgID(ctx), userID)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusOK, newSCIMUserResource(user, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) ReplaceUser(ctx *gin.Context) {
+	userID, ok := scimUserIDParam(ctx)
+	if !ok {
+		return
+	}
+	var resource scimUserResource
+	if !bindSCIM(ctx, &resource) {
+		return
+	}
+	h.replaceUser(ctx, userID, resource)
+}
+
+func (h *SCIMHandler) PatchUser(ctx *gin.Context) {
+	userID, ok := scimUserIDParam(ctx)
+	if !ok {
+		return
+	}
+	patch, ok := bindSCIMPatch(ctx)
+	if !ok {
+		return
+	}
+	user, err := h.scim.GetSCIMUser(ctx.Request.Context(), scimOrgID(ctx), userID)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	var resource scimUserResource
+	if !applySCIMPatch(ctx, newSCIMUserResource(user, scimBaseURL(ctx)), patch, &resource) {
+		return
+	}
+	h.replaceUser(ctx, userID, resource)
+}
+
+func (h *SCIMHandler) replaceUser(ctx *gin.Context, userID uint, resource scimUserResource) {
+	user, err := h.scim.ReplaceSCIMUser(ctx.Request.Context(), scimOrgID(ctx), userID, resource.toInput())
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusOK, newSCIMUserResource(user, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) DeleteUser(ctx *gin.Context) {
+	userID, ok := scimUserIDParam(ctx)
+	if !ok {
+		return
+	}
+	if err := h.scim.DeleteSCIMUser(ctx.Request.Context(), scimOrgID(ctx), userID); err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) ListGroups(ctx *gin.Context) {
+	groups, err := h.scim.ListSCIMGroups(ctx.Request.Context(), scimOrgID(ctx))
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	baseURL := scimBaseURL(ctx)
+	resources := make([]any, 0, len(groups))
+	for i := range groups {
+		resources = append(resources, newSCIMGroupResource(&groups[i], baseURL))
+	}
+	writeSCIMList(ctx, resources)
+}
+
+func (h *SCIMHandler) CreateGroup(ctx *gin.Context) {
+	var resource scimGroupResource
+	if !bindSCIM(ctx, &resource) {
+		return
+	}
+	input, err := resource.toInput()
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	group, err := h.scim.CreateSCIMGroup(ctx.Request.Context(), scimOrgID(ctx), input)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusCreated, newSCIMGroupResource(group, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) GetGroup(ctx *gin.Context) {
+	groupID, ok := scimGroupIDParam(ctx)
+	if !ok {
+		return
+	}
+	group, err := h.scim.GetSCIMGroup(ctx.Request.Context(), scimOrgID(ctx), groupID)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusOK, newSCIMGroupResource(group, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) ReplaceGroup(ctx *gin.Context) {
+	groupID, ok := scimGroupIDParam(ctx)
+	if !ok {
+		return
+	}
+	var resource scimGroupResource
+	if !bindSCIM(ctx, &resource) {
+		return
+	}
+	h.replaceGroup(ctx, groupID, resource)
+}
+
+func (h *SCIMHandler) PatchGroup(ctx *gin.Context) {
+	groupID, ok := scimGroupIDParam(ctx)
+	if !ok {
+		return
+	}
+	patch, ok := bindSCIMPatch(ctx)
+	if !ok {
+		return
+	}
+	group, err := h.scim.GetSCIMGroup(ctx.Request.Context(), scimOrgID(ctx), groupID)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	var resource scimGroupResource
+	if !applySCIMPatch(ctx, newSCIMGroupResource(group, scimBaseURL(ctx)), patch, &resource) {
+		return
+	}
+	h.replaceGroup(ctx, groupID, resource)
+}
+
+func (h *SCIMHandler) replaceGroup(ctx *gin.Context, groupID uint64, resource scimGroupResource) {
+	input, err := resource.toInput()
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	group, err := h.scim.ReplaceSCIMGroup(ctx.Request.Context(), scimOrgID(ctx), groupID, input)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	writeSCIM(ctx, http.StatusOK, newSCIMGroupResource(group, scimBaseURL(ctx)))
+}
+
+func (h *SCIMHandler) DeleteGroup(ctx *gin.Context) {
+	groupID, ok := scimGroupIDParam(ctx)
+	if !ok {
+		return
+	}
+	if err := h.scim.DeleteSCIMGroup(ctx.Request.Context(), scimOrgID(ctx), groupID); err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func scimOrgID(ctx *gin.Context) string {
+	return ctx.GetString(scimOrgIDKey)
+}
+
+// scimBaseURL is the absolute endpoint URL used for meta.location and member $ref values.
+func scimBaseURL(ctx *gin.Context) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := strings.TrimSpace(ctx.GetHeader("X-Forwarded-Proto")); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + ctx.Request.Host + scimBasePath
+}
+
+func scimUserIDParam(ctx *gin.Context) (uint, bool) {
+	userID, err := parseSCIMUserID(ctx.Param("id"))
+	if err != nil {
+		writeSCIMError(ctx, iamdomain.ErrSCIMUserNotFound)
+		return 0, false
+	}
+	return userID, true
+}
+
+func scimGroupIDParam(ctx *gin.Context) (uint64, bool) {
+	groupID, err := parseSCIMGroupID(ctx.Param("id"))
+	if err != nil {
+		writeSCIMError(ctx, iamdomain.ErrSCIMGroupNotFound)
+		return 0, false
+	}
+	return groupID, true
+}
+
+func bindSCIM(ctx *gin.Context, v any) bool {
+	body, err := ctx.GetRawData()
+	if err == nil {
+		err = json.Unmarshal(body, v)
+	}
+	if err != nil {
+		writeSCIMError(ctx, pdscim.BadRequest(pdscim.ScimTypeInvalidSyntax, err.Error()))
+		return false
+	}
+	return true
+}
+
+func bindSCIMPatch(ctx *gin.Context) (*pdscim.PatchRequest, bool) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		writeSCIMError(ctx, pdscim.BadRequest(pdscim.ScimTypeInvalidSyntax, err.Error()))
+		return nil, false
+	}
+	patch, err := pdscim.ParsePatchRequest(body)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return nil, false
+	}
+	return patch, true
+}
+
+// applySCIMPatch patches the current representation of a resource and decodes the result
+// into out, which then goes through the same path as a PUT.
+func applySCIMPatch(ctx *gin.Context, current any, patch *pdscim.PatchRequest, out any) bool {
+	resource, err := pdscim.ToResource(current)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return false
+	}
+	if err := pdscim.ApplyPatch(resource, patch.Operations); err != nil {
+		writeSCIMError(ctx, err)
+		return false
+	}
+	if err := pdscim.FromResource(resource, out); err != nil {
+		writeSCIMError(ctx, pdscim.BadRequest(pdscim.ScimTypeInvalidValue, err.Error()))
+		return false
+	}
+	return true
+}
+
+// writeSCIMList filters resources with the filter query parameter and pages them with
+// startIndex and count.
+func writeSCIMList(ctx *gin.Context, items []any) {
+	startIndex, err := scimIntQuery(ctx, "startIndex", 1)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	count, err := scimIntQuery(ctx, "count", scimDefaultCount)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	var filter *pdscim.Filter
+	if raw := strings.TrimSpace(ctx.Query("filter")); raw != "" {
+		if filter, err = pdscim.ParseFilter(raw); err != nil {
+			writeSCIMError(ctx, err)
+			return
+		}
+	}
+	resources := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		resource, err := pdscim.ToResource(item)
+		if err != nil {
+			writeSCIMError(ctx, err)
+			return
+		}
+		if filter == nil || filter.Match(resource) {
+			resources = append(resources, resource)
+		}
+	}
+	writeSCIM(ctx, http.StatusOK, pdscim.Page(resources, startIndex, min(count, scimMaxResults)))
+}
+
+func scimIntQuery(ctx *gin.Context, name string, fallback int) (int, error) {
+	raw := strings.TrimSpace(ctx.Query(name))
+	if raw == "" {
+		return fallback, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, pdscim.BadRequest(pdscim.ScimTypeInvalidValue, name+" must be an integer")
+	}
+	return value, nil
+}
+
+func writeSCIM(ctx *gin.Context, status int, body any) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		writeSCIMError(ctx, err)
+		return
+	}
+	ctx.Data(status, pdscim.ContentType, payload)
+}
+
+func writeSCIMError(ctx *gin.Context, err error) {
+	scimErr := toSCIMError(err)
+	payload, _ := json.Marshal(scimErr.Response())
+	ctx.Data(scimErr.Status, pdscim.ContentType, payload)
+}
+
+func toSCIMError(err error) *pdscim.Error {
+	var scimErr *pdscim.Error
+	switch {
+	case errors.As(err, &scimErr):
+		return scimErr
+	case errors.Is(err, iamdomain.ErrSCIMUserNotFound),
+		errors.Is(err, iamdomain.ErrSCIMGroupNotFound),
+		errors.Is(err, iamdomain.ErrOrganizationNotFound):
+		return pdscim.NewError(http.StatusNotFound, "", err.Error())
+	case errors.Is(err, iamdomain.ErrSCIMUserExists),
+		errors.Is(err, iamdomain.ErrSCIMUserNotOwned),
+		errors.Is(err, iamdomain.ErrSCIMGroupExists):
+		return pdscim.NewError(http.StatusConflict, pdscim.ScimTypeUniqueness, err.Error())
+	case errors.Is(err, iamdomain.ErrSCIMUserNameImmutable),
+		errors.Is(err, iamdomain.ErrImmutableOrganizationRoot),
+		errors.Is(err, iamdomain.ErrImmutableGroup):
+		return pdscim.BadRequest(pdscim.ScimTypeMutability, err.Error())
+	case errors.Is(err, iamdomain.ErrSCIMInvalidUserName),
+		errors.Is(err, iamdomain.ErrSCIMInvalidGroupName),
+		errors.Is(err, iamdomain.ErrSCIMInvalidMember):
+		return pdscim.BadRequest(pdscim.ScimTypeInvalidValue, err.Error())
+	case errors.Is(err, iamdomain.ErrSCIMTokenInvalid):
+		return pdscim.NewError(http.StatusUnauthorized, "", err.Error())
+	default:
+		return pdscim.NewError(http.StatusInternalServerError, "", err.Error())
+	}
+}
@@ -0,0 +1,269 @@
+package httphandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/pdscim"
+)
+
+// scimBool accepts JSON booleans and the "True"/"False" strings Entra ID sends.
+type scimBool bool
+
+func (b *scimBool) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		value, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		*b = scimBool(value)
+		return nil
+	}
+	var value bool
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = scimBool(value)
+	return nil
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimMultiValue struct {
+	Value   string   `json:"value"`
+	Display string   `json:"display,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Primary scimBool `json:"primary,omitempty"`
+	Ref     string   `json:"$ref,omitempty"`
+}
+
+type scimUserResource struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	UserName    string           `json:"userName"`
+	Name        *scimName        `json:"name,omitempty"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Emails      []scimMultiValue `json:"emails,omitempty"`
+	Active      *scimBool        `json:"active,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimGroupResource struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []scimMultiValue `json:"members"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+func newSCIMUserResource(user *iamdomain.SCIMUser, baseURL string) scimUserResource {
+	id := strconv.FormatUint(uint64(user.UserID), 10)
+	active := scimBool(user.Active)
+	resource := scimUserResource{
+		Schemas:     []string{pdscim.SchemaUser},
+		ID:          id,
+		ExternalID:  user.ExternalID,
+		UserName:    user.UserName,
+		DisplayName: user.DisplayName,
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt.Format(time.RFC3339),
+			LastModified: user.UpdatedAt.Format(time.RFC3339),
+			Location:     baseURL + "/Users/" + id,
+		},
+	}
+	if user.GivenName != "" || user.FamilyName != "" {
+		resource.Name = &scimName{
+			Formatted:  strings.TrimSpace(user.GivenName + " " + user.FamilyName),
+			GivenName:  user.GivenName,
+			FamilyName: user.FamilyName,
+		}
+	}
+	if user.Email != "" {
+		resource.Emails = []scimMultiValue{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	return resource
+}
+
+func (r scimUserResource) toInput() iamdomain.SCIMUserInput {
+	input := iamdomain.SCIMUserInput{
+		ExternalID:  r.ExternalID,
+		UserName:    r.UserName,
+		DisplayName: r.DisplayName,
+		Email:       primaryEmail(r.Emails),
+		// RFC 7643 leaves active to the service provider; a user created without it is active.
+		Active: r.Active == nil || bool(*r.Active),
+	}
+	if r.Name != nil {
+		input.GivenName = r.Name.GivenName
+		input.FamilyName = r.Name.FamilyName
+	}
+	return input
+}
+
+func primaryEmail(emails []scimMultiValue) string {
+	for _, email := range emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+	return ""
+}
+
+func newSCIMGroupResource(group *iamdomain.SCIMGroup, baseURL string) scimGroupResource {
+	id := strconv.FormatUint(group.GroupID, 10)
+	members := make([]scimMultiValue, 0, len(group.Members))
+	for _, userID := range group.Members {
+		memberID := strconv.FormatUint(uint64(userID), 10)
+		members = append(members, scimMultiValue{Value: memberID, Ref: baseURL + "/Users/" + memberID})
+	}
+	return scimGroupResource{
+		Schemas:     []string{pdscim.SchemaGroup},
+		ID:          id,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: &scimMeta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt.Format(time.RFC3339),
+			LastModified: group.UpdatedAt.Format(time.RFC3339),
+			Location:     baseURL + "/Groups/" + id,
+		},
+	}
+}
+
+func (r scimGroupResource) toInput() (iamdomain.SCIMGroupInput, error) {
+	members := make([]uint, 0, len(r.Members))
+	for _, member := range r.Members {
+		userID, err := parseSCIMUserID(member.Value)
+		if err != nil {
+			return iamdomain.SCIMGroupInput{}, pdscim.BadRequest(
+				pdscim.ScimTypeInvalidValue,
+				fmt.Sprintf("member %q is not a provisioned user id", member.Value),
+			)
+		}
+		members = append(members, userID)
+	}
+	return iamdomain.SCIMGroupInput{
+		ExternalID:  r.ExternalID,
+		DisplayName: r.DisplayName,
+		Members:     members,
+	}, nil
+}
+
+func parseSCIMUserID(raw string) (uint, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 0)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid user id %q", raw)
+	}
+	return uint(id), nil
+}
+
+func parseSCIMGroupID(raw string) (uint64, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 64)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid group id %q", raw)
+	}
+	return id, nil
+}
+
+var scimServiceProviderConfig = map[string]any{
+	"schemas":          []string{pdscim.SchemaServiceProviderConfig},
+	"documentationUri": "https://datatracker.ietf.org/doc/html/rfc7644",
+	"patch":            map[string]any{"supported": true},
+	"bulk":             map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+	"filter":           map[string]any{"supported": true, "maxResults": scimMaxResults},
+	"changePassword":   map[string]any{"supported": false},
+	"sort":             map[string]any{"supported": false},
+	"etag":             map[string]any{"supported": false},
+	"authenticationSchemes": []map[string]any{{
+		"type":        "oauthbearertoken",
+		"name":        "OAuth Bearer Token",
+		"description": "Organization SCIM token sent as a bearer token",
+		"primary":     true,
+	}},
+}
+
+func scimResourceTypes() []map[string]any {
+	return []map[string]any{
+		{
+			"schemas":  []string{pdscim.SchemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   pdscim.SchemaUser,
+			"meta":     map[string]any{"resourceType": "ResourceType"},
+		},
+		{
+			"schemas":  []string{pdscim.SchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   pdscim.SchemaGroup,
+			"meta":     map[string]any{"resourceType": "ResourceType"},
+		},
+	}
+}
+
+func scimSchemas() []map[string]any {
+	attribute := func(name, typ string, required bool, mutability string) map[string]any {
+		return map[string]any{
+			"name":       name,
+			"type":       typ,
+			"required":   required,
+			"mutability": mutability,
+		}
+	}
+	return []map[string]any{
+		{
+			"id":   pdscim.SchemaUser,
+			"name": "User",
+			"attributes": []map[string]any{
+				attribute("userName", "string", true, "immutable"),
+				attribute("externalId", "string", false, "readWrite"),
+				attribute("name", "complex", false, "readWrite"),
+				attribute("displayName", "string", false, "readWrite"),
+				attribute("emails", "complex", false, "readWrite"),
+				attribute("active", "boolean", false, "readWrite"),
+			},
+			"meta": map[string]any{"resourceType": "Schema"},
+		},
+		{
+			"id":   pdscim.SchemaGroup,
+			"name": "Group",
+			"attributes": []map[string]any{
+				attribute("displayName", "string", true, "readWrite"),
+				attribute("externalId", "string", false, "readWrite"),
+				attribute("members", "complex", false, "readWrite"),
+			},
+			"meta": map[string]any{"resourceType": "Schema"},
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package httphandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	iammocks "github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdscim"
+)
+
+const testSCIMToken = "pzscim_test-token"
+
+func newSCIMTestRouter(t *testing.T) (*gin.Engine, *iammocks.MockSCIMUsecase) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	tokens := iammocks.NewMockSCIMTokenUsecase(t)
+	tokens.EXPECT().
+		AuthenticateSCIMToken(mock.Anything, testSCIMToken).
+		Return(&iamdomain.SCIMToken{ID: "token-1", OrgID: "org-1"}, nil).
+		Maybe()
+	tokens.EXPECT().
+		AuthenticateSCIMToken(mock.Anything, mock.Anything).
+		Return(nil, iamdomain.ErrSCIMTokenInvalid).
+		Maybe()
+	scim := iammocks.NewMockSCIMUsecase(t)
+	router := gin.New()
+	NewSCIMHandler(tokens, scim).RegisterRoutes()(router)
+	return router, scim
+}
+
+func serveSCIM(router *gin.Engine, method, target, token, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+	request.Header.Set("Content-Type", pdscim.ContentType)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestSCIMHandler_RejectsUnknownToken(t *testing.T) {
+	router, _ := newSCIMTestRouter(t)
+
+	recorder := serveSCIM(router, http.MethodGet, "/scim/v2/Users", "pzscim_unknown", "")
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Equal(t, pdscim.ContentType, recorder.Header().Get("Content-Type"))
+	require.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+
+	recorder = serveSCIM(router, http.MethodGet, "/scim/v2/ServiceProviderConfig", "", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestSCIMHandler_ListUsersAppliesFilter(t *testing.T) {
+	router, scim := newSCIMTestRouter(t)
+	now := time.Now().UTC()
+	scim.EXPECT().ListSCIMUsers(mock.Anything, "org-1").Return([]iamdomain.SCIMUser{
+		{OrgID: "org-1", UserID: 1, UserName: "alice@example.com", Email: "alice@example.com", Active: true, CreatedAt: now, UpdatedAt: now},
+		{OrgID: "org-1", UserID: 2, UserName: "bob@example.com", Email: "bob@example.com", Active: true, CreatedAt: now, UpdatedAt: now},
+	}, nil)
+
+	recorder := serveSCIM(
+		router,
+		http.MethodGet,
+		`/scim/v2/Users?filter=userName+eq+%22Bob@example.com%22`,
+		testSCIMToken,
+		"",
+	)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var list pdscim.ListResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, "2", list.Resources[0]["id"])
+	require.Equal(t, "http://example.com/scim/v2/Users/2", list.Resources[0]["meta"].(map[string]any)["location"])
+}
+
+func TestSCIMHandler_PatchUserDeactivates(t *testing.T) {
+	router, scim := newSCIMTestRouter(t)
+	user := &iamdomain.SCIMUser{
+		OrgID:     "org-1",
+		UserID:    7,
+		UserName:  "carol@example.com",
+		Email:     "carol@example.com",
+		GivenName: "Carol",
+		Active:    true,
+	}
+	scim.EXPECT().GetSCIMUser(mock.Anything, "org-1", uint(7)).Return(user, nil)
+	scim.EXPECT().
+		ReplaceSCIMUser(mock.Anything, "org-1", uint(7), iamdomain.SCIMUserInput{
+			UserName:  "carol@example.com",
+			Email:     "carol@example.com",
+			GivenName: "Carol",
+			Active:    false,
+		}).
+		Return(&iamdomain.SCIMUser{OrgID: "org-1", UserID: 7, UserName: "carol@example.com", Active: false}, nil)
+
+	recorder := serveSCIM(router, http.MethodPatch, "/scim/v2/Users/7", testSCIMToken, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+	}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var resource map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resource))
+	require.Equal(t, false, resource["active"])
+}
+
+func TestSCIMHandler_MapsDomainErrors(t *testing.T) {
+	router, scim := newSCIMTestRouter(t)
+	scim.EXPECT().
+		CreateSCIMGroup(mock.Anything, "org-1", iamdomain.SCIMGroupInput{DisplayName: "Ops", Members: []uint{}}).
+		Return(nil, iamdomain.ErrSCIMGroupExists)
+
+	recorder := serveSCIM(router, http.MethodPost, "/scim/v2/Groups", testSCIMToken, `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+		"displayName": "Ops"
+	}`)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+	var body pdscim.ErrorResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Equal(t, pdscim.ScimTypeUniqueness, body.ScimType)
+
+	recorder = serveSCIM(router, http.MethodPost, "/scim/v2/Groups", testSCIMToken, `{
+		"displayName": "Ops",
+		"members": [{"value": "not-a-user"}]
+	}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serveSCIM(router, http.MethodGet, "/scim/v2/Groups/abc", testSCIMToken, "")
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -298,6 +298,19 @@ func ToPBPolicyStatements(items []iamdomain.PolicyStatement) []*pbcommonv1.Polic
 	return out
 }
 
+func ToPBPolicyFindings(items []iamdomain.PolicyFinding) []*pbiamv1.PolicyFinding {
+	out := make([]*pbiamv1.PolicyFinding, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbiamv1.PolicyFinding{
+			Code:           item.Code,
+			Severity:       item.Severity,
+			StatementIndex: int32(item.StatementIndex),
+			Message:        item.Message,
+		})
+	}
+	return out
+}
+
 func FromPBPolicyStatements(items []*pbcommonv1.PolicyStatement) []iamdomain.PolicyStatement {
 	out := make([]iamdomain.PolicyStatement, 0, len(items))
 	for _, item := range items {
@@ -359,6 +372,132 @@ func ToPBTenantInvite(invite *iamdomain.TenantInvite) *pbiamv1.TenantInvite {
 	return resp
 }
 
+func ToPBSCIMToken(token *iamdomain.SCIMToken) *pbiamv1.SCIMToken {
+	if token == nil {
+		return nil
+	}
+	resp := &pbiamv1.SCIMToken{
+		Id:              token.ID,
+		OrgId:           token.OrgID,
+		Name:            token.Name,
+		TokenPrefix:     token.TokenPrefix,
+		CreatedByUserId: uint64(token.CreatedByUserID),
+		CreatedAt:       token.CreatedAt.Format(time.RFC3339),
+	}
+	if token.LastUsedAt != nil {
+		resp.LastUsedAt = token.LastUsedAt.Format(time.RFC3339)
+	}
+	if token.RevokedAt != nil {
+		resp.RevokedAt = token.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func ToPBRoleAccessPolicy(policy *iamdomain.RoleAccessPolicy) *pbiamv1.RoleAccessPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &pbiamv1.RoleAccessPolicy{
+		RoleName:             policy.RoleName,
+		ApproverGroupId:      policy.ApproverGroupID,
+		ApproverPlatformRole: policy.ApproverPlatformRole,
+		MaxDurationSeconds:   policy.MaxDurationSeconds,
+		CreatedAt:            policy.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            policy.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToPBAccessRequest(request *iamdomain.RoleAccessRequest) *pbiamv1.AccessRequest {
+	if request == nil {
+		return nil
+	}
+	resp := &pbiamv1.AccessRequest{
+		Id:              request.ID,
+		RequesterUserId: uint64(request.RequesterUserID),
+		RoleName:        request.RoleName,
+		RoleScope:       request.RoleScope,
+		TenantId:        request.TenantID,
+		Justification:   request.Justification,
+		Status:          request.Status,
+		StartsAt:        request.StartsAt.Format(time.RFC3339),
+		ExpiresAt:       request.ExpiresAt.Format(time.RFC3339),
+		DecidedByUserId: uint64(request.DecidedByUserID),
+		DecisionReason:  request.DecisionReason,
+		CreatedAt:       request.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       request.UpdatedAt.Format(time.RFC3339),
+	}
+	if request.DecidedAt != nil {
+		resp.DecidedAt = request.DecidedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func ToPBUnusedPermissions(items []iamdomain.UnusedPermission) []*pbiamv1.UnusedPermission {
+	out := make([]*pbiamv1.UnusedPermission, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbiamv1.UnusedPermission{
+			TenantId:       item.TenantID,
+			UserId:         uint64(item.UserID),
+			Namespace:      item.Namespace,
+			LastAccessedAt: formatOptionalTime(item.LastAccessedAt),
+		})
+	}
+	return out
+}
+
+func ToPBUnusedRoles(items []iamdomain.UnusedRole) []*pbiamv1.UnusedRole {
+	out := make([]*pbiamv1.UnusedRole, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbiamv1.UnusedRole{
+			TenantId:       item.TenantID,
+			UserId:         uint64(item.UserID),
+			RoleId:         item.RoleID,
+			RoleName:       item.RoleName,
+			LastAccessedAt: formatOptionalTime(item.LastAccessedAt),
+		})
+	}
+	return out
+}
+
+func ToPBStaleGroupMemberships(items []iamdomain.StaleGroupMembership) []*pbiamv1.StaleGroupMembership {
+	out := make([]*pbiamv1.StaleGroupMembership, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbiamv1.StaleGroupMembership{
+			GroupId:        item.GroupID,
+			GroupName:      item.GroupName,
+			TenantId:       item.TenantID,
+			UserId:         uint64(item.UserID),
+			LastAccessedAt: formatOptionalTime(item.LastAccessedAt),
+		})
+	}
+	return out
+}
+
+func formatOptionalTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func ToPBSAMLConnection(connection *iamdomain.SAMLConnection) *pbiamv1.SAMLConnection {
+	if connection == nil {
+		return nil
+	}
+	return &pbiamv1.SAMLConnection{
+		OrgId:             connection.OrgID,
+		IdpEntityId:       connection.IdPEntityID,
+		SsoUrl:            connection.SSOURL,
+		MetadataXml:       connection.MetadataXML,
+		UsernameAttribute: connection.UsernameAttribute,
+		EmailAttribute:    connection.EmailAttribute,
+		GroupsAttribute:   connection.GroupsAttribute,
+		SsoRequired:       connection.SSORequired,
+		CreatedAt:         connection.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         connection.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
 func ToIAMSessionPolicyStatements(items []pdauthn.PolicyStatement) []iamdomain.PolicyStatement {
 	out := make([]iamdomain.PolicyStatement, 0, len(items))
 	for _, item := range items {
@@ -398,6 +537,7 @@ func ToPBIAMAssumedRole(item *iamdomain.AssumedRole) *pbiamv1.IAMAssumedRole {
 		SourceIdentity:   item.SourceIdentity,
 		SessionTags:      CloneStringMap(item.SessionTags),
 		ExpiresAt:        item.ExpiresAt.Format(time.RFC3339),
+		AccessRequestId:  item.AccessRequestID,
 	}
 }
 
@@ -419,6 +559,18 @@ func ToPBPermission(item iamdomain.Permission) *pbiamv1.Permission {
 	}
 }
 
+func ToPBConditionKeys(items []iamdomain.ConditionKey) []*pbiamv1.ConditionKey {
+	out := make([]*pbiamv1.ConditionKey, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pbiamv1.ConditionKey{
+			Key:         item.Key,
+			Type:        item.Type,
+			Description: item.Description,
+		})
+	}
+	return out
+}
+
 func CloneStringMap(src map[string]string) map[string]string {
 	if len(src) == 0 {
 		return nil
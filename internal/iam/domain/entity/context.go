@@ -1,11 +1,16 @@
 package entity
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	sessionPolicyContextKey struct{}
 	assumedRoleContextKey   struct{}
 	sessionTagsContextKey   struct{}
+	mfaPresentContextKey    struct{}
+	requestContextKey       struct{}
 )
 
 func WithSessionPolicyStatements(ctx context.Context, statements []PolicyStatement) context.Context {
@@ -61,3 +66,33 @@ func GetSessionTags(ctx context.Context) map[string]string {
 	}
 	return cloned
 }
+
+// WithMultiFactorAuthPresent records that the caller's session completed MFA.
+func WithMultiFactorAuthPresent(ctx context.Context, present bool) context.Context {
+	if !present {
+		return ctx
+	}
+	return context.WithValue(ctx, mfaPresentContextKey{}, true)
+}
+
+func GetMultiFactorAuthPresent(ctx context.Context) bool {
+	present, _ := ctx.Value(mfaPresentContextKey{}).(bool)
+	return present
+}
+
+// RequestContext carries the request-derived facts behind the global condition keys.
+type RequestContext struct {
+	SourceIP        string
+	SecureTransport bool
+	IdentitySource  string
+	AuthTime        time.Time
+}
+
+func WithRequestContext(ctx context.Context, request RequestContext) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, request)
+}
+
+func GetRequestContext(ctx context.Context) (RequestContext, bool) {
+	request, ok := ctx.Value(requestContextKey{}).(RequestContext)
+	return request, ok
+}
@@ -39,8 +39,24 @@ const (
 	ConditionDateGreaterThan          = "DateGreaterThan"
 	ConditionDateLessThan             = "DateLessThan"
 	ConditionIpAddress                = "IpAddress"
+	ConditionNotIpAddress             = "NotIpAddress"
 	ConditionNull                     = "Null"
 
+	// ConditionKeyMultiFactorAuthPresent is "true" when the caller's session completed MFA.
+	ConditionKeyMultiFactorAuthPresent = "auth:MultiFactorAuthPresent"
+	// ConditionKeySourceIp is the caller's client IP as seen by the gateway or the gRPC peer.
+	ConditionKeySourceIp = "auth:SourceIp"
+	// ConditionKeyCurrentTime is the evaluation time in RFC3339, for DateGreaterThan/DateLessThan.
+	ConditionKeyCurrentTime = "auth:CurrentTime"
+	// ConditionKeyEpochTime is the evaluation time in Unix seconds, for the Numeric operators.
+	ConditionKeyEpochTime = "auth:EpochTime"
+	// ConditionKeySecureTransport is "true" when the request reached the platform over TLS.
+	ConditionKeySecureTransport = "auth:SecureTransport"
+	// ConditionKeyIdentitySource names how the caller signed in, e.g. "google" or "saml:<org>".
+	ConditionKeyIdentitySource = "auth:IdentitySource"
+	// ConditionKeySessionAge is the number of seconds since the caller's session was created.
+	ConditionKeySessionAge = "auth:SessionAge"
+
 	TrustPrincipalUser         = "user"
 	TrustPrincipalPlatformRole = "platform_role"
 	TrustPrincipalTenantRole   = "tenant_role"
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// AccessAnalyzerDefaultUnusedFor is how long a grant may go without activity before the
+// access analyzer reports it, when the caller names no period.
+const AccessAnalyzerDefaultUnusedFor = 90 * 24 * time.Hour
+
+// AccessActivity is the last time a tenant principal was allowed an action in Namespace,
+// the part of the action before ':'. RoleID is the membership or assumed role the decision
+// went through.
+type AccessActivity struct {
+	TenantID       string    `json:"tenant_id"`
+	UserID         uint      `json:"user_id"`
+	RoleID         uint64    `json:"role_id"`
+	Namespace      string    `json:"namespace"`
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+}
+
+// AccessAnalyzerScope selects the tenants a report covers: one tenant, or every tenant of an
+// organization. Exactly one field is set.
+type AccessAnalyzerScope struct {
+	TenantID string `json:"tenant_id,omitempty"`
+	OrgID    string `json:"org_id,omitempty"`
+}
+
+// AccessAnalyzerQuery asks for the grants in Scope that saw no activity during UnusedFor.
+type AccessAnalyzerQuery struct {
+	Scope     AccessAnalyzerScope `json:"scope"`
+	UnusedFor time.Duration       `json:"unused_for"`
+}
+
+// GroupMember is one membership of a tenant group, as the access analyzer reads it.
+type GroupMember struct {
+	GroupID   uint64    `json:"group_id"`
+	GroupName string    `json:"group_name"`
+	TenantID  string    `json:"tenant_id"`
+	UserID    uint      `json:"user_id"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// UnusedPermission is an action namespace a member's policies allow but the member has not
+// exercised in the tenant. Namespace may be a pattern such as "*"; LastAccessedAt is nil when
+// it was never used.
+type UnusedPermission struct {
+	TenantID       string     `json:"tenant_id"`
+	UserID         uint       `json:"user_id"`
+	Namespace      string     `json:"namespace"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+type UnusedRole struct {
+	TenantID       string     `json:"tenant_id"`
+	UserID         uint       `json:"user_id"`
+	RoleID         uint64     `json:"role_id"`
+	RoleName       string     `json:"role_name"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+// StaleGroupMembership is a tenant group member who has not exercised anything the group's
+// policies allow.
+type StaleGroupMembership struct {
+	GroupID        uint64     `json:"group_id"`
+	GroupName      string     `json:"group_name"`
+	TenantID       string     `json:"tenant_id"`
+	UserID         uint       `json:"user_id"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+type GenerateLeastPrivilegePolicyInput struct {
+	TenantID string        `json:"tenant_id"`
+	UserID   uint          `json:"user_id"`
+	Since    time.Duration `json:"since"`
+}
+
+var (
+	ErrInvalidAnalyzerScope = errors.New("iam: exactly one of tenant_id or org_id is required")
+	ErrNoAccessActivity     = errors.New("iam: no recorded activity to generate a policy from")
+)
+
+// ActionNamespace returns the part of an action or action pattern before ':', or the whole
+// value when it has none.
+func ActionNamespace(action string) string {
+	action = strings.TrimSpace(action)
+	namespace, _, _ := strings.Cut(action, ":")
+	return namespace
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	AccessRequestStatusPending   = "pending"
+	AccessRequestStatusApproved  = "approved"
+	AccessRequestStatusDenied    = "denied"
+	AccessRequestStatusCancelled = "cancelled"
+	AccessRequestStatusExpired   = "expired"
+
+	// AccessRequestDefaultDuration applies when a request names no duration.
+	AccessRequestDefaultDuration = time.Hour
+	// AccessRequestMaxDuration caps RoleAccessPolicy.MaxDurationSeconds, matching the
+	// longest session AssumeRole issues.
+	AccessRequestMaxDuration = 12 * time.Hour
+)
+
+// RoleAccessPolicy makes a role requestable just in time. Approvers are the members of
+// ApproverGroupID or the holders of the platform role ApproverPlatformRole; exactly one is set.
+type RoleAccessPolicy struct {
+	RoleID               uint64    `json:"role_id"`
+	RoleName             string    `json:"role_name"`
+	ApproverGroupID      uint64    `json:"approver_group_id,omitempty"`
+	ApproverRoleID       uint64    `json:"approver_role_id,omitempty"`
+	ApproverPlatformRole string    `json:"approver_platform_role,omitempty"`
+	MaxDurationSeconds   int64     `json:"max_duration_seconds"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+type PutRoleAccessPolicyInput struct {
+	RoleName             string `json:"role_name"`
+	ApproverGroupID      uint64 `json:"approver_group_id,omitempty"`
+	ApproverPlatformRole string `json:"approver_platform_role,omitempty"`
+	MaxDurationSeconds   int64  `json:"max_duration_seconds"`
+}
+
+// RoleAccessRequest asks for a role over [StartsAt, ExpiresAt). Once approved it is the grant
+// AssumeRole honours for that window; after ExpiresAt it is moved to expired. UsedAt is set
+// when the first role session is assumed under the grant. Sessions are not checked against
+// the grant again, so a used grant can no longer be cancelled and instead runs until
+// ExpiresAt, which no session outlives.
+type RoleAccessRequest struct {
+	ID              string     `json:"id"`
+	RequesterUserID uint       `json:"requester_user_id"`
+	RoleID          uint64     `json:"role_id"`
+	RoleName        string     `json:"role_name"`
+	RoleScope       string     `json:"role_scope"`
+	TenantID        string     `json:"tenant_id,omitempty"`
+	Justification   string     `json:"justification"`
+	Status          string     `json:"status"`
+	StartsAt        time.Time  `json:"starts_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	DecidedByUserID uint       `json:"decided_by_user_id,omitempty"`
+	DecisionReason  string     `json:"decision_reason,omitempty"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+	UsedAt          *time.Time `json:"used_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// ActiveAt reports whether the request is an approved grant covering at.
+func (r RoleAccessRequest) ActiveAt(at time.Time) bool {
+	return r.Status == AccessRequestStatusApproved && !at.Before(r.StartsAt) && at.Before(r.ExpiresAt)
+}
+
+type CreateRoleAccessRequestInput struct {
+	RequesterUserID uint      `json:"requester_user_id"`
+	RoleName        string    `json:"role_name"`
+	TenantID        string    `json:"tenant_id,omitempty"`
+	Justification   string    `json:"justification"`
+	StartsAt        time.Time `json:"starts_at"`
+	DurationSeconds int64     `json:"duration_seconds"`
+}
+
+// RoleAccessRequestFilter narrows a listing; zero fields match everything.
+type RoleAccessRequestFilter struct {
+	RequesterUserID uint   `json:"requester_user_id,omitempty"`
+	TenantID        string `json:"tenant_id,omitempty"`
+	Status          string `json:"status,omitempty"`
+}
+
+var (
+	ErrAccessPolicyNotFound        = errors.New("iam: role does not accept access requests")
+	ErrInvalidAccessPolicy         = errors.New("iam: access policy needs exactly one approver group or platform role")
+	ErrAccessRequestNotFound       = errors.New("iam: access request not found")
+	ErrInvalidAccessRequest        = errors.New("iam: invalid access request")
+	ErrAccessJustificationRequired = errors.New("iam: access request justification is required")
+	ErrAccessRequestClosed         = errors.New("iam: access request is already decided or closed")
+	ErrAccessRequestForbidden      = errors.New("iam: caller may not act on this access request")
+	ErrAccessRequestInUse          = errors.New("iam: access request was already used to assume the role")
+)
@@ -58,12 +58,14 @@ type PolicyAttachment struct {
 	CreatedAt      time.Time `json:"created_at"`
 }
 
+// CreatePolicyInput creates a policy. Strict rejects statements LintPolicy reports errors for.
 type CreatePolicyInput struct {
 	Scope       string            `json:"scope"`
 	OrgID       string            `json:"org_id,omitempty"`
 	Name        string            `json:"name"`
 	Description string            `json:"description"`
 	Statements  []PolicyStatement `json:"statements"`
+	Strict      bool              `json:"strict,omitempty"`
 }
 
 type CreatePolicyVersionInput struct {
@@ -72,6 +74,7 @@ type CreatePolicyVersionInput struct {
 	PolicyName   string            `json:"policy_name"`
 	Statements   []PolicyStatement `json:"statements"`
 	SetAsDefault bool              `json:"set_as_default"`
+	Strict       bool              `json:"strict,omitempty"`
 }
 
 type PermissionBoundary struct {
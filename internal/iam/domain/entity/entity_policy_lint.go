@@ -0,0 +1,355 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	PolicyFindingSeverityError   = "error"
+	PolicyFindingSeverityWarning = "warning"
+	PolicyFindingSeverityInfo    = "info"
+
+	PolicyFindingInvalidStatement         = "INVALID_STATEMENT"
+	PolicyFindingUnknownAction            = "UNKNOWN_ACTION"
+	PolicyFindingUnknownConditionOperator = "UNKNOWN_CONDITION_OPERATOR"
+	PolicyFindingUnknownConditionKey      = "UNKNOWN_CONDITION_KEY"
+	PolicyFindingInvalidConditionValue    = "INVALID_CONDITION_VALUE"
+	PolicyFindingConditionTypeMismatch    = "CONDITION_TYPE_MISMATCH"
+	PolicyFindingBroadGrant               = "BROAD_GRANT"
+	PolicyFindingShadowedAllow            = "SHADOWED_ALLOW"
+	PolicyFindingBoundaryConflict         = "BOUNDARY_CONFLICT"
+)
+
+var ErrPolicyValidationFailed = errors.New("iam: policy failed validation")
+
+// PolicyFinding is one problem LintPolicy found in a policy document. StatementIndex is the
+// position of the statement in the document.
+type PolicyFinding struct {
+	Code           string `json:"code"`
+	Severity       string `json:"severity"`
+	StatementIndex int    `json:"statement_index"`
+	Message        string `json:"message"`
+}
+
+// PolicyLintBoundary is a permission boundary of a principal the policy is attached to. Name
+// identifies the principal in findings.
+type PolicyLintBoundary struct {
+	Name       string            `json:"name"`
+	Statements []PolicyStatement `json:"statements"`
+}
+
+// PolicyLintInput is a policy document and what LintPolicy checks it against. With no Actions,
+// action patterns are not checked against the catalog.
+type PolicyLintInput struct {
+	Statements []PolicyStatement    `json:"statements"`
+	Actions    []string             `json:"actions,omitempty"`
+	Boundaries []PolicyLintBoundary `json:"boundaries,omitempty"`
+}
+
+type ValidatePolicyInput struct {
+	Scope      string            `json:"scope"`
+	OrgID      string            `json:"org_id,omitempty"`
+	PolicyName string            `json:"policy_name,omitempty"`
+	Statements []PolicyStatement `json:"statements"`
+}
+
+// PolicyValidationError rejects a policy written in strict mode. It carries the error findings.
+type PolicyValidationError struct {
+	Findings []PolicyFinding
+}
+
+func (e *PolicyValidationError) Error() string {
+	if e == nil || len(e.Findings) == 0 {
+		return ErrPolicyValidationFailed.Error()
+	}
+	first := e.Findings[0]
+	return fmt.Sprintf("%s: statement %d: %s", ErrPolicyValidationFailed, first.StatementIndex, first.Message)
+}
+
+func (e *PolicyValidationError) Unwrap() error {
+	return ErrPolicyValidationFailed
+}
+
+// PolicyFindingErrors returns the error-severity findings.
+func PolicyFindingErrors(findings []PolicyFinding) []PolicyFinding {
+	errs := make([]PolicyFinding, 0)
+	for _, finding := range findings {
+		if finding.Severity == PolicyFindingSeverityError {
+			errs = append(errs, finding)
+		}
+	}
+	return errs
+}
+
+var policyConditionKeyPrefixes = []string{"principal_tag:", "request_tag:", "aws:PrincipalTag/", "aws:RequestTag/"}
+
+// policyRequestKeys are the request fields conditions can read besides the global keys.
+var policyRequestKeys = []string{"tenant_id", "org_id", "user_id", "action", "resource"}
+
+// LintPolicy checks a policy document without touching storage: statement shape, actions
+// against the catalog, condition operators, keys and values, broad grants, allows an
+// unconditional deny of the same document shadows, and allows the boundaries do not permit.
+// Findings come in statement order.
+func LintPolicy(input PolicyLintInput) []PolicyFinding {
+	findings := make([]PolicyFinding, 0)
+	add := func(index int, code, severity, format string, args ...any) {
+		findings = append(findings, PolicyFinding{
+			Code:           code,
+			Severity:       severity,
+			StatementIndex: index,
+			Message:        fmt.Sprintf(format, args...),
+		})
+	}
+	statements := make([]PolicyStatement, len(input.Statements))
+	valid := make([]bool, len(input.Statements))
+	for i, statement := range input.Statements {
+		statement = lintNormalizeStatement(statement)
+		statements[i] = statement
+		switch {
+		case statement.Effect != PolicyEffectAllow && statement.Effect != PolicyEffectDeny:
+			add(i, PolicyFindingInvalidStatement, PolicyFindingSeverityError, "effect %q is not allow or deny",
+				statement.Effect)
+			continue
+		case !strings.Contains(statement.ActionPattern, ":") || len(statement.ActionPattern) > 128:
+			add(i, PolicyFindingInvalidStatement, PolicyFindingSeverityError,
+				"action %q must be <namespace>:<action>, at most 128 characters", statement.ActionPattern)
+			continue
+		case len(statement.ResourcePattern) > 256:
+			add(i, PolicyFindingInvalidStatement, PolicyFindingSeverityError,
+				"resource pattern is longer than 256 characters")
+			continue
+		case len(statement.Conditions) > 16:
+			add(i, PolicyFindingInvalidStatement, PolicyFindingSeverityError, "more than 16 conditions")
+			continue
+		}
+		valid[i] = true
+		if len(input.Actions) > 0 && !actionPatternKnown(statement.ActionPattern, input.Actions) {
+			add(i, PolicyFindingUnknownAction, PolicyFindingSeverityError,
+				"action %q matches no permission in the catalog", statement.ActionPattern)
+		}
+		for _, condition := range statement.Conditions {
+			lintCondition(condition, func(code, severity, format string, args ...any) {
+				add(i, code, severity, format, args...)
+			})
+		}
+		if statement.Effect != PolicyEffectAllow {
+			continue
+		}
+		switch {
+		case ActionNamespace(statement.ActionPattern) == "*":
+			add(i, PolicyFindingBroadGrant, PolicyFindingSeverityWarning,
+				"allows every namespace with action %q", statement.ActionPattern)
+		case strings.HasSuffix(statement.ActionPattern, ":*") && statement.ResourcePattern == "*" &&
+			len(statement.Conditions) == 0:
+			add(i, PolicyFindingBroadGrant, PolicyFindingSeverityInfo,
+				"allows every %s action on every resource", ActionNamespace(statement.ActionPattern))
+		}
+	}
+	for i, statement := range statements {
+		if !valid[i] || statement.Effect != PolicyEffectAllow {
+			continue
+		}
+		for j, deny := range statements {
+			if valid[j] && deny.Effect == PolicyEffectDeny && len(deny.Conditions) == 0 &&
+				statementCovers(deny, statement) {
+				add(i, PolicyFindingShadowedAllow, PolicyFindingSeverityWarning,
+					"never applies: statement %d denies %q on %q", j, deny.ActionPattern, deny.ResourcePattern)
+				break
+			}
+		}
+		for _, boundary := range input.Boundaries {
+			if !boundaryPermits(boundary.Statements, statement) {
+				add(i, PolicyFindingBoundaryConflict, PolicyFindingSeverityWarning,
+					"%q on %q is not fully within the permission boundary of %s",
+					statement.ActionPattern, statement.ResourcePattern, boundary.Name)
+			}
+		}
+	}
+	slices.SortStableFunc(findings, func(a, b PolicyFinding) int {
+		return cmp.Compare(a.StatementIndex, b.StatementIndex)
+	})
+	return findings
+}
+
+// PatternMatches reports whether a policy action or resource pattern matches value. "*" and
+// "" match everything; other patterns use path.Match, so "*" stops at "/".
+func PatternMatches(pattern string, value string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+	ok, err := path.Match(pattern, value)
+	if err != nil {
+		return pattern == value
+	}
+	return ok
+}
+
+func lintNormalizeStatement(statement PolicyStatement) PolicyStatement {
+	statement.Effect = strings.ToLower(strings.TrimSpace(statement.Effect))
+	if statement.Effect == "" {
+		statement.Effect = PolicyEffectAllow
+	}
+	statement.ActionPattern = strings.TrimSpace(statement.ActionPattern)
+	statement.ResourcePattern = strings.TrimSpace(statement.ResourcePattern)
+	if statement.ResourcePattern == "" {
+		statement.ResourcePattern = "*"
+	}
+	return statement
+}
+
+func actionPatternKnown(pattern string, actions []string) bool {
+	for _, action := range actions {
+		if PatternMatches(pattern, action) {
+			return true
+		}
+	}
+	return false
+}
+
+func lintCondition(condition PolicyCondition, add func(code, severity, format string, args ...any)) {
+	operator := strings.TrimSpace(condition.Operator)
+	key := strings.TrimSpace(condition.Key)
+	value := strings.TrimSpace(condition.Value)
+	if key == "" {
+		add(PolicyFindingInvalidStatement, PolicyFindingSeverityError, "condition has no key and is ignored")
+		return
+	}
+	valueType, known := conditionOperatorType(operator)
+	if !known {
+		if operator == "" {
+			add(PolicyFindingUnknownConditionOperator, PolicyFindingSeverityError,
+				"condition on %q has no operator and is ignored", key)
+		} else {
+			add(PolicyFindingUnknownConditionOperator, PolicyFindingSeverityError,
+				"condition operator %q is not supported and never matches", operator)
+		}
+		return
+	}
+	keyType, keyKnown := conditionKeyType(key)
+	if !keyKnown {
+		add(PolicyFindingUnknownConditionKey, PolicyFindingSeverityWarning,
+			"condition key %q is not a global or request key", key)
+	}
+	if !conditionValueValid(valueType, value) {
+		add(PolicyFindingInvalidConditionValue, PolicyFindingSeverityError,
+			"%s value %q is not a valid %s and never matches", operator, value, valueType)
+	}
+	if keyType != "" && valueType != "String" && valueType != "Null" && keyType != valueType {
+		add(PolicyFindingConditionTypeMismatch, PolicyFindingSeverityWarning,
+			"%s compares %q, which holds a %s value", operator, key, keyType)
+	}
+}
+
+// conditionOperatorType returns the value type an operator compares, in the Type vocabulary
+// of GlobalConditionKeys.
+func conditionOperatorType(operator string) (string, bool) {
+	switch operator {
+	case ConditionStringEquals, ConditionStringLike, ConditionStringNotEquals, ConditionStringNotLike:
+		return "String", true
+	case ConditionBool:
+		return "Bool", true
+	case ConditionNumericEquals, ConditionNumericGreaterThanEquals, ConditionNumericLessThanEquals:
+		return "Numeric", true
+	case ConditionDateGreaterThan, ConditionDateLessThan:
+		return "Date", true
+	case ConditionIpAddress, ConditionNotIpAddress:
+		return "IpAddress", true
+	case ConditionNull:
+		return "Null", true
+	default:
+		return "", false
+	}
+}
+
+// conditionKeyType returns the type of a global key, "" for other known keys.
+func conditionKeyType(key string) (string, bool) {
+	for _, global := range GlobalConditionKeys {
+		if global.Key == key {
+			return global.Type, true
+		}
+	}
+	for _, requestKey := range policyRequestKeys {
+		if requestKey == key {
+			return "", true
+		}
+	}
+	for _, prefix := range policyConditionKeyPrefixes {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return "", true
+		}
+	}
+	return "", false
+}
+
+func conditionValueValid(valueType string, value string) bool {
+	switch valueType {
+	case "Bool", "Null":
+		return strings.EqualFold(value, "true") || strings.EqualFold(value, "false")
+	case "Numeric":
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case "Date":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "IpAddress":
+		_, _, err := net.ParseCIDR(value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// statementCovers reports whether outer matches every action and resource inner does.
+func statementCovers(outer PolicyStatement, inner PolicyStatement) bool {
+	return patternCovers(outer.ActionPattern, inner.ActionPattern) &&
+		patternCovers(outer.ResourcePattern, inner.ResourcePattern)
+}
+
+// boundaryPermits reports whether the boundary allows everything the statement does. Boundary
+// conditions are ignored: a conditional boundary allow still counts as permitting.
+func boundaryPermits(boundary []PolicyStatement, statement PolicyStatement) bool {
+	permitted := false
+	for _, candidate := range boundary {
+		candidate = lintNormalizeStatement(candidate)
+		switch {
+		case candidate.Effect == PolicyEffectDeny && len(candidate.Conditions) == 0 &&
+			patternsOverlap(candidate, statement):
+			return false
+		case candidate.Effect == PolicyEffectAllow && statementCovers(candidate, statement):
+			permitted = true
+		}
+	}
+	return permitted
+}
+
+func patternsOverlap(a PolicyStatement, b PolicyStatement) bool {
+	return statementCovers(a, b) || statementCovers(b, a)
+}
+
+// patternCovers reports whether outer matches every value inner matches. It answers false
+// when it cannot tell, so findings built on it stay conservative.
+func patternCovers(outer string, inner string) bool {
+	if outer == "" || outer == "*" || outer == inner {
+		return true
+	}
+	if inner == "" || inner == "*" {
+		return false
+	}
+	if !strings.ContainsAny(inner, "*?[") {
+		return PatternMatches(outer, inner)
+	}
+	prefix, ok := strings.CutSuffix(outer, "*")
+	if !ok || strings.ContainsAny(prefix, "*?[\\") {
+		return false
+	}
+	rest, ok := strings.CutPrefix(inner, prefix)
+	return ok && !strings.Contains(rest, "/")
+}
@@ -111,6 +111,9 @@ type AssumedRole struct {
 	SessionName      string            `json:"session_name,omitempty"`
 	SourceIdentity   string            `json:"source_identity,omitempty"`
 	SessionTags      map[string]string `json:"session_tags,omitempty"`
-	ExpiresAt        time.Time         `json:"expires_at"`
-	CreatedAt        time.Time         `json:"created_at"`
+	// AccessRequestID names the approved access request that allowed the assumption when
+	// the role's trust policy alone did not.
+	AccessRequestID string    `json:"access_request_id,omitempty"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	CreatedAt       time.Time `json:"created_at"`
 }
@@ -27,3 +27,50 @@ type Permission struct {
 	Resource string
 	Action   string
 }
+
+// ConditionKey documents a global condition key that every authorization request carries.
+type ConditionKey struct {
+	Key         string
+	Type        string
+	Description string
+}
+
+// GlobalConditionKeys lists the request-derived keys policies can reference in conditions.
+// Keys that the request cannot supply are absent, so Null tests them for presence.
+var GlobalConditionKeys = []ConditionKey{
+	{
+		Key:         ConditionKeySourceIp,
+		Type:        "IpAddress",
+		Description: "Client IP of the caller, forwarded by the gateway or taken from the gRPC peer.",
+	},
+	{
+		Key:         ConditionKeyCurrentTime,
+		Type:        "Date",
+		Description: "Time the request was evaluated, in RFC3339.",
+	},
+	{
+		Key:         ConditionKeyEpochTime,
+		Type:        "Numeric",
+		Description: "Time the request was evaluated, in Unix seconds.",
+	},
+	{
+		Key:         ConditionKeySecureTransport,
+		Type:        "Bool",
+		Description: "Whether the request reached the platform over TLS. Defaults to false.",
+	},
+	{
+		Key:         ConditionKeyMultiFactorAuthPresent,
+		Type:        "Bool",
+		Description: "Whether the caller's session completed MFA. Defaults to false.",
+	},
+	{
+		Key:         ConditionKeyIdentitySource,
+		Type:        "String",
+		Description: "How the caller signed in, for example password, google or saml:<org_id>.",
+	},
+	{
+		Key:         ConditionKeySessionAge,
+		Type:        "Numeric",
+		Description: "Seconds since the caller's session was created.",
+	},
+}
@@ -165,6 +165,7 @@ type PolicyQueryUsecase interface {
 	) (collection.Page[entity.PolicyAttachment], error)
 	GetRoleTrustPolicy(ctx context.Context, roleName string) ([]entity.RoleTrustStatement, error)
 	GetRolePermissionBoundary(ctx context.Context, roleName string) (*entity.RolePermissionBoundary, error)
+	ValidatePolicy(ctx context.Context, input entity.ValidatePolicyInput) ([]entity.PolicyFinding, error)
 }
 
 type GroupCommandUsecase interface {
@@ -5988,3 +5988,71 @@ func (_c *MockIAMUsecase_SimulateAccess_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidatePolicy provides a mock function for the type MockIAMUsecase
+func (_mock *MockIAMUsecase) ValidatePolicy(ctx context.Context, input entity.ValidatePolicyInput) ([]entity.PolicyFinding, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatePolicy")
+	}
+
+	var r0 []entity.PolicyFinding
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.ValidatePolicyInput) ([]entity.PolicyFinding, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.ValidatePolicyInput) []entity.PolicyFinding); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PolicyFinding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.ValidatePolicyInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIAMUsecase_ValidatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePolicy'
+type MockIAMUsecase_ValidatePolicy_Call struct {
+	*mock.Call
+}
+
+// ValidatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input entity.ValidatePolicyInput
+func (_e *MockIAMUsecase_Expecter) ValidatePolicy(ctx interface{}, input interface{}) *MockIAMUsecase_ValidatePolicy_Call {
+	return &MockIAMUsecase_ValidatePolicy_Call{Call: _e.mock.On("ValidatePolicy", ctx, input)}
+}
+
+func (_c *MockIAMUsecase_ValidatePolicy_Call) Run(run func(ctx context.Context, input entity.ValidatePolicyInput)) *MockIAMUsecase_ValidatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.ValidatePolicyInput
+		if args[1] != nil {
+			arg1 = args[1].(entity.ValidatePolicyInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAMUsecase_ValidatePolicy_Call) Return(policyFindings []entity.PolicyFinding, err error) *MockIAMUsecase_ValidatePolicy_Call {
+	_c.Call.Return(policyFindings, err)
+	return _c
+}
+
+func (_c *MockIAMUsecase_ValidatePolicy_Call) RunAndReturn(run func(ctx context.Context, input entity.ValidatePolicyInput) ([]entity.PolicyFinding, error)) *MockIAMUsecase_ValidatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,24 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+// AccessAnalyzerUsecase compares what tenant principals are granted with what they were
+// recorded using through CheckPermissionForResource.
+type AccessAnalyzerUsecase interface {
+	ListUnusedPermissions(ctx context.Context, query entity.AccessAnalyzerQuery) ([]entity.UnusedPermission, error)
+	ListUnusedRoles(ctx context.Context, query entity.AccessAnalyzerQuery) ([]entity.UnusedRole, error)
+	ListStaleGroupMemberships(
+		ctx context.Context,
+		query entity.AccessAnalyzerQuery,
+	) ([]entity.StaleGroupMembership, error)
+	// GenerateLeastPrivilegePolicy returns one allow statement per action namespace the user
+	// exercised in the tenant during input.Since, or entity.ErrNoAccessActivity.
+	GenerateLeastPrivilegePolicy(
+		ctx context.Context,
+		input entity.GenerateLeastPrivilegePolicyInput,
+	) ([]entity.PolicyStatement, error)
+}
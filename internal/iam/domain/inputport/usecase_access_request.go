@@ -0,0 +1,56 @@
+package inputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+// AccessRequestCommandUsecase runs the just-in-time access workflow: a user requests a role
+// for a window, an approver named by the role's access policy approves or denies it, and an
+// approved request lets AssumeRole issue sessions for that role until the window ends.
+type AccessRequestCommandUsecase interface {
+	PutRoleAccessPolicy(ctx context.Context, input entity.PutRoleAccessPolicyInput) (*entity.RoleAccessPolicy, error)
+	DeleteRoleAccessPolicy(ctx context.Context, roleName string) error
+	CreateAccessRequest(
+		ctx context.Context,
+		input entity.CreateRoleAccessRequestInput,
+	) (*entity.RoleAccessRequest, error)
+	ApproveAccessRequest(
+		ctx context.Context,
+		requestID string,
+		approverUserID uint,
+		reason string,
+	) (*entity.RoleAccessRequest, error)
+	DenyAccessRequest(
+		ctx context.Context,
+		requestID string,
+		approverUserID uint,
+		reason string,
+	) (*entity.RoleAccessRequest, error)
+	// CancelAccessRequest withdraws a pending request or ends an approved grant early. Only
+	// the requester may cancel, and only until a session is assumed under the grant; after
+	// that it returns entity.ErrAccessRequestInUse.
+	CancelAccessRequest(ctx context.Context, requestID string, userID uint) (*entity.RoleAccessRequest, error)
+	// ExpireAccessRequests moves up to limit requests whose window ended before now to expired
+	// and returns them.
+	ExpireAccessRequests(ctx context.Context, now time.Time, limit int) ([]entity.RoleAccessRequest, error)
+}
+
+type AccessRequestQueryUsecase interface {
+	GetRoleAccessPolicy(ctx context.Context, roleName string) (*entity.RoleAccessPolicy, error)
+	// GetAccessRequest returns entity.ErrAccessRequestNotFound unless viewerUserID is the
+	// requester or an approver of the request.
+	GetAccessRequest(
+		ctx context.Context,
+		requestID string,
+		viewerUserID uint,
+	) (*entity.RoleAccessRequest, error)
+	// ListAccessRequests returns the matching requests viewerUserID made or may decide.
+	ListAccessRequests(
+		ctx context.Context,
+		viewerUserID uint,
+		filter entity.RoleAccessRequestFilter,
+	) ([]entity.RoleAccessRequest, error)
+}
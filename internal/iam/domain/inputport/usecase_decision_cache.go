@@ -0,0 +1,7 @@
+package inputport
+
+// DecisionCacheUsecase drops cached authorization decisions after IAM state changes.
+type DecisionCacheUsecase interface {
+	// Invalidate drops what is cached for tenantID; an empty tenantID drops everything.
+	Invalidate(tenantID string)
+}
@@ -0,0 +1,31 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+// SAMLConnectionUsecase manages per-organization SAML SSO configuration.
+type SAMLConnectionUsecase interface {
+	// PutSAMLConnection validates the IdP metadata and stores the connection.
+	PutSAMLConnection(
+		ctx context.Context,
+		orgID string,
+		input entity.PutSAMLConnectionInput,
+	) (*entity.SAMLConnection, error)
+	GetSAMLConnection(ctx context.Context, orgID string) (*entity.SAMLConnection, error)
+	DeleteSAMLConnection(ctx context.Context, orgID string) error
+	// SSORequiredOrganizations lists the user's organizations that block password login.
+	SSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error)
+	// OrganizationMembership lets the auth service decide whether a SAML login may claim an
+	// existing local account.
+	OrganizationMembership(ctx context.Context, orgID string, userID uint) (*entity.OrganizationMembership, error)
+}
+
+// SAMLLoginUsecase applies the outcome of a verified SAML login to IAM state.
+type SAMLLoginUsecase interface {
+	// ApplySAMLLogin makes the user an active organization member and, when the connection
+	// maps a groups attribute, syncs their organization groups to the asserted names.
+	ApplySAMLLogin(ctx context.Context, orgID string, userID uint, groups []string) error
+}
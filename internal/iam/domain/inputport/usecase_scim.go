@@ -0,0 +1,44 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+// SCIMTokenUsecase manages the per-organization bearer tokens SCIM clients authenticate with.
+type SCIMTokenUsecase interface {
+	// CreateSCIMToken returns the stored token and the raw bearer token, shown only once.
+	CreateSCIMToken(ctx context.Context, orgID, name string, createdByUserID uint) (*entity.SCIMToken, string, error)
+	ListSCIMTokens(ctx context.Context, orgID string) ([]entity.SCIMToken, error)
+	RevokeSCIMToken(ctx context.Context, orgID, tokenID string) error
+	AuthenticateSCIMToken(ctx context.Context, rawToken string) (*entity.SCIMToken, error)
+}
+
+// SCIMUsecase provisions organization users and groups for an external identity provider.
+// Every call is scoped to the organization that owns the authenticating token.
+type SCIMUsecase interface {
+	CreateSCIMUser(ctx context.Context, orgID string, input entity.SCIMUserInput) (*entity.SCIMUser, error)
+	GetSCIMUser(ctx context.Context, orgID string, userID uint) (*entity.SCIMUser, error)
+	ListSCIMUsers(ctx context.Context, orgID string) ([]entity.SCIMUser, error)
+	// ReplaceSCIMUser applies the desired state; turning Active off deprovisions the user.
+	ReplaceSCIMUser(
+		ctx context.Context,
+		orgID string,
+		userID uint,
+		input entity.SCIMUserInput,
+	) (*entity.SCIMUser, error)
+	// DeleteSCIMUser deprovisions the user and forgets the SCIM link.
+	DeleteSCIMUser(ctx context.Context, orgID string, userID uint) error
+
+	CreateSCIMGroup(ctx context.Context, orgID string, input entity.SCIMGroupInput) (*entity.SCIMGroup, error)
+	GetSCIMGroup(ctx context.Context, orgID string, groupID uint64) (*entity.SCIMGroup, error)
+	ListSCIMGroups(ctx context.Context, orgID string) ([]entity.SCIMGroup, error)
+	ReplaceSCIMGroup(
+		ctx context.Context,
+		orgID string,
+		groupID uint64,
+		input entity.SCIMGroupInput,
+	) (*entity.SCIMGroup, error)
+	DeleteSCIMGroup(ctx context.Context, orgID string, groupID uint64) error
+}
@@ -0,0 +1,316 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+type accessAnalyzerInteractor struct {
+	analyzer      outputport.AccessAnalyzerQueryRepository
+	policyQueries outputport.PolicyQueryRepository
+	now           func() time.Time
+}
+
+var _ inputport.AccessAnalyzerUsecase = (*accessAnalyzerInteractor)(nil)
+
+func NewAccessAnalyzerInteractor(
+	analyzer outputport.AccessAnalyzerQueryRepository,
+	policyQueries outputport.PolicyQueryRepository,
+) inputport.AccessAnalyzerUsecase {
+	return &accessAnalyzerInteractor{
+		analyzer:      analyzer,
+		policyQueries: policyQueries,
+		now:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *accessAnalyzerInteractor) ListUnusedPermissions(
+	ctx context.Context,
+	query entity.AccessAnalyzerQuery,
+) ([]entity.UnusedPermission, error) {
+	scope, cutoff, err := s.normalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.analyzer.ListActiveMemberships(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.loadActivity(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	unused := make([]entity.UnusedPermission, 0)
+	for _, membership := range memberships {
+		if !membership.CreatedAt.Before(cutoff) {
+			continue
+		}
+		statements, err := s.principalStatements(ctx, membership)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range allowedNamespaces(statements) {
+			last := activity.lastAccess(membership.TenantID, membership.UserID, 0, namespace)
+			if last != nil && !last.Before(cutoff) {
+				continue
+			}
+			unused = append(unused, entity.UnusedPermission{
+				TenantID:       membership.TenantID,
+				UserID:         membership.UserID,
+				Namespace:      namespace,
+				LastAccessedAt: last,
+			})
+		}
+	}
+	return unused, nil
+}
+
+func (s *accessAnalyzerInteractor) ListUnusedRoles(
+	ctx context.Context,
+	query entity.AccessAnalyzerQuery,
+) ([]entity.UnusedRole, error) {
+	scope, cutoff, err := s.normalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.analyzer.ListActiveMemberships(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.loadActivity(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	unused := make([]entity.UnusedRole, 0)
+	for _, membership := range memberships {
+		if !membership.CreatedAt.Before(cutoff) {
+			continue
+		}
+		last := activity.lastAccess(membership.TenantID, membership.UserID, membership.RoleID, "*")
+		if last != nil && !last.Before(cutoff) {
+			continue
+		}
+		unused = append(unused, entity.UnusedRole{
+			TenantID:       membership.TenantID,
+			UserID:         membership.UserID,
+			RoleID:         membership.RoleID,
+			RoleName:       membership.RoleName,
+			LastAccessedAt: last,
+		})
+	}
+	return unused, nil
+}
+
+func (s *accessAnalyzerInteractor) ListStaleGroupMemberships(
+	ctx context.Context,
+	query entity.AccessAnalyzerQuery,
+) ([]entity.StaleGroupMembership, error) {
+	scope, cutoff, err := s.normalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.analyzer.ListGroupMembers(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.loadActivity(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	groupNamespaces := make(map[uint64][]string)
+	stale := make([]entity.StaleGroupMembership, 0)
+	for _, member := range members {
+		if !member.AddedAt.Before(cutoff) {
+			continue
+		}
+		namespaces, ok := groupNamespaces[member.GroupID]
+		if !ok {
+			statements, err := s.analyzer.ListGroupStatements(ctx, member.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			namespaces = allowedNamespaces(statements)
+			groupNamespaces[member.GroupID] = namespaces
+		}
+		// A group that allows nothing does not make its members stale.
+		if len(namespaces) == 0 {
+			continue
+		}
+		var last *time.Time
+		for _, namespace := range namespaces {
+			last = latest(last, activity.lastAccess(member.TenantID, member.UserID, 0, namespace))
+		}
+		if last != nil && !last.Before(cutoff) {
+			continue
+		}
+		stale = append(stale, entity.StaleGroupMembership{
+			GroupID:        member.GroupID,
+			GroupName:      member.GroupName,
+			TenantID:       member.TenantID,
+			UserID:         member.UserID,
+			LastAccessedAt: last,
+		})
+	}
+	return stale, nil
+}
+
+func (s *accessAnalyzerInteractor) GenerateLeastPrivilegePolicy(
+	ctx context.Context,
+	input entity.GenerateLeastPrivilegePolicyInput,
+) ([]entity.PolicyStatement, error) {
+	tenantID := strings.TrimSpace(input.TenantID)
+	if tenantID == "" {
+		return nil, entity.ErrInvalidAnalyzerScope
+	}
+	if input.UserID == 0 {
+		return nil, entity.ErrInvalidUserID
+	}
+	since := input.Since
+	if since <= 0 {
+		since = entity.AccessAnalyzerDefaultUnusedFor
+	}
+	cutoff := s.now().Add(-since)
+	activities, err := s.analyzer.ListAccessActivity(ctx, entity.AccessAnalyzerScope{TenantID: tenantID})
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]string, 0)
+	for _, activity := range activities {
+		if activity.UserID != input.UserID || activity.LastAccessedAt.Before(cutoff) {
+			continue
+		}
+		if !slices.Contains(namespaces, activity.Namespace) {
+			namespaces = append(namespaces, activity.Namespace)
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil, entity.ErrNoAccessActivity
+	}
+	slices.Sort(namespaces)
+	statements := make([]entity.PolicyStatement, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		statements = append(statements, entity.PolicyStatement{
+			Effect:          entity.PolicyEffectAllow,
+			ActionPattern:   namespace + ":*",
+			ResourcePattern: "*",
+		})
+	}
+	return statements, nil
+}
+
+func (s *accessAnalyzerInteractor) normalizeQuery(
+	query entity.AccessAnalyzerQuery,
+) (entity.AccessAnalyzerScope, time.Time, error) {
+	scope := entity.AccessAnalyzerScope{
+		TenantID: strings.TrimSpace(query.Scope.TenantID),
+		OrgID:    strings.TrimSpace(query.Scope.OrgID),
+	}
+	if (scope.TenantID == "") == (scope.OrgID == "") {
+		return scope, time.Time{}, entity.ErrInvalidAnalyzerScope
+	}
+	unusedFor := query.UnusedFor
+	if unusedFor <= 0 {
+		unusedFor = entity.AccessAnalyzerDefaultUnusedFor
+	}
+	return scope, s.now().Add(-unusedFor), nil
+}
+
+// principalStatements loads the identity and role statements a tenant decision for the
+// member allows from. Boundaries and SCPs only narrow them, so they are left out.
+func (s *accessAnalyzerInteractor) principalStatements(
+	ctx context.Context,
+	membership entity.Membership,
+) ([]entity.PolicyStatement, error) {
+	statements, err := s.policyQueries.ListTenantUserStatements(ctx, membership.TenantID, membership.UserID)
+	if err != nil {
+		return nil, err
+	}
+	groupStatements, err := s.policyQueries.ListTenantGroupStatements(ctx, membership.TenantID, membership.UserID)
+	if err != nil {
+		return nil, err
+	}
+	roleStatements, err := s.policyQueries.ListRoleStatements(ctx, membership.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	statements = append(statements, groupStatements...)
+	return append(statements, roleStatements...), nil
+}
+
+func (s *accessAnalyzerInteractor) loadActivity(
+	ctx context.Context,
+	scope entity.AccessAnalyzerScope,
+) (accessActivityIndex, error) {
+	activities, err := s.analyzer.ListAccessActivity(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	index := make(accessActivityIndex)
+	for _, activity := range activities {
+		key := accessActivityPrincipalKey(activity.TenantID, activity.UserID)
+		index[key] = append(index[key], activity)
+	}
+	return index, nil
+}
+
+// accessActivityIndex groups recorded activity by tenant principal.
+type accessActivityIndex map[string][]entity.AccessActivity
+
+// lastAccess returns the latest activity of the principal in a namespace matching the
+// pattern, through roleID when it is not zero.
+func (idx accessActivityIndex) lastAccess(
+	tenantID string,
+	userID uint,
+	roleID uint64,
+	namespacePattern string,
+) *time.Time {
+	var last *time.Time
+	for _, activity := range idx[accessActivityPrincipalKey(tenantID, userID)] {
+		if roleID != 0 && activity.RoleID != roleID {
+			continue
+		}
+		if !matchesPattern(namespacePattern, activity.Namespace) {
+			continue
+		}
+		at := activity.LastAccessedAt
+		last = latest(last, &at)
+	}
+	return last
+}
+
+func accessActivityPrincipalKey(tenantID string, userID uint) string {
+	return fmt.Sprintf("%s:%d", tenantID, userID)
+}
+
+// allowedNamespaces returns the sorted, distinct action namespaces the allow statements grant.
+func allowedNamespaces(statements []entity.PolicyStatement) []string {
+	namespaces := make([]string, 0)
+	for _, statement := range statements {
+		if statement.Effect != entity.PolicyEffectAllow {
+			continue
+		}
+		namespace := entity.ActionNamespace(statement.ActionPattern)
+		if namespace == "" {
+			namespace = "*"
+		}
+		if !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	slices.Sort(namespaces)
+	return namespaces
+}
+
+func latest(a *time.Time, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
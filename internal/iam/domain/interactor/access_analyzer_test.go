@@ -0,0 +1,168 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+)
+
+type accessAnalyzerMocks struct {
+	analyzer *outputportmocks.MockAccessAnalyzerQueryRepository
+	policies *outputportmocks.MockPolicyQueryRepository
+}
+
+func newAccessAnalyzerUsecase(t *testing.T) (inputport.AccessAnalyzerUsecase, *accessAnalyzerMocks) {
+	t.Helper()
+
+	m := &accessAnalyzerMocks{
+		analyzer: outputportmocks.NewMockAccessAnalyzerQueryRepository(t),
+		policies: outputportmocks.NewMockPolicyQueryRepository(t),
+	}
+	return iaminteractor.NewAccessAnalyzerInteractor(m.analyzer, m.policies), m
+}
+
+func daysAgo(days int) time.Time {
+	return time.Now().UTC().Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+func allowStatement(action string) entity.PolicyStatement {
+	return entity.PolicyStatement{Effect: entity.PolicyEffectAllow, ActionPattern: action, ResourcePattern: "*"}
+}
+
+func TestAccessAnalyzer_RequiresExactlyOneScope(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newAccessAnalyzerUsecase(t)
+	_, err := svc.ListUnusedPermissions(context.Background(), entity.AccessAnalyzerQuery{})
+	require.ErrorIs(t, err, entity.ErrInvalidAnalyzerScope)
+	_, err = svc.ListUnusedRoles(context.Background(), entity.AccessAnalyzerQuery{
+		Scope: entity.AccessAnalyzerScope{TenantID: "t1", OrgID: "o1"},
+	})
+	require.ErrorIs(t, err, entity.ErrInvalidAnalyzerScope)
+}
+
+func TestAccessAnalyzer_ListUnusedPermissions(t *testing.T) {
+	t.Parallel()
+
+	svc, m := newAccessAnalyzerUsecase(t)
+	scope := entity.AccessAnalyzerScope{TenantID: "t1"}
+	m.analyzer.EXPECT().ListActiveMemberships(mock.Anything, scope).Return([]entity.Membership{
+		{TenantID: "t1", UserID: 9, RoleID: 3, CreatedAt: daysAgo(200)},
+		// Joined inside the window: too new to judge.
+		{TenantID: "t1", UserID: 10, RoleID: 3, CreatedAt: daysAgo(5)},
+	}, nil)
+	m.analyzer.EXPECT().ListAccessActivity(mock.Anything, scope).Return([]entity.AccessActivity{
+		{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "store", LastAccessedAt: daysAgo(1)},
+		{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "order", LastAccessedAt: daysAgo(120)},
+	}, nil)
+	m.policies.EXPECT().ListTenantUserStatements(mock.Anything, "t1", uint(9)).
+		Return([]entity.PolicyStatement{allowStatement("store:read")}, nil)
+	m.policies.EXPECT().ListTenantGroupStatements(mock.Anything, "t1", uint(9)).
+		Return([]entity.PolicyStatement{allowStatement("order:*")}, nil)
+	m.policies.EXPECT().ListRoleStatements(mock.Anything, uint64(3)).Return([]entity.PolicyStatement{
+		allowStatement("billing:read"),
+		{Effect: entity.PolicyEffectDeny, ActionPattern: "tenant:delete", ResourcePattern: "*"},
+	}, nil)
+
+	unused, err := svc.ListUnusedPermissions(context.Background(), entity.AccessAnalyzerQuery{Scope: scope})
+	require.NoError(t, err)
+	require.Len(t, unused, 2)
+	require.Equal(t, "billing", unused[0].Namespace)
+	require.Nil(t, unused[0].LastAccessedAt)
+	require.Equal(t, "order", unused[1].Namespace)
+	require.NotNil(t, unused[1].LastAccessedAt)
+}
+
+func TestAccessAnalyzer_ListUnusedRolesCountsOnlyActivityThroughTheRole(t *testing.T) {
+	t.Parallel()
+
+	svc, m := newAccessAnalyzerUsecase(t)
+	scope := entity.AccessAnalyzerScope{OrgID: "o1"}
+	m.analyzer.EXPECT().ListActiveMemberships(mock.Anything, scope).Return([]entity.Membership{
+		{TenantID: "t1", UserID: 9, RoleID: 3, RoleName: "tenant_viewer", CreatedAt: daysAgo(200)},
+		{TenantID: "t2", UserID: 9, RoleID: 4, RoleName: "tenant_editor", CreatedAt: daysAgo(200)},
+	}, nil)
+	m.analyzer.EXPECT().ListAccessActivity(mock.Anything, scope).Return([]entity.AccessActivity{
+		{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "store", LastAccessedAt: daysAgo(2)},
+		// Activity through an assumed role does not keep the membership role in use.
+		{TenantID: "t2", UserID: 9, RoleID: 77, Namespace: "store", LastAccessedAt: daysAgo(2)},
+	}, nil)
+
+	unused, err := svc.ListUnusedRoles(context.Background(), entity.AccessAnalyzerQuery{Scope: scope})
+	require.NoError(t, err)
+	require.Len(t, unused, 1)
+	require.Equal(t, "t2", unused[0].TenantID)
+	require.Equal(t, "tenant_editor", unused[0].RoleName)
+	require.Nil(t, unused[0].LastAccessedAt)
+}
+
+func TestAccessAnalyzer_ListStaleGroupMemberships(t *testing.T) {
+	t.Parallel()
+
+	svc, m := newAccessAnalyzerUsecase(t)
+	scope := entity.AccessAnalyzerScope{TenantID: "t1"}
+	m.analyzer.EXPECT().ListGroupMembers(mock.Anything, scope).Return([]entity.GroupMember{
+		{GroupID: 1, GroupName: "store-admins", TenantID: "t1", UserID: 9, AddedAt: daysAgo(200)},
+		{GroupID: 1, GroupName: "store-admins", TenantID: "t1", UserID: 10, AddedAt: daysAgo(200)},
+		{GroupID: 2, GroupName: "empty", TenantID: "t1", UserID: 10, AddedAt: daysAgo(200)},
+	}, nil)
+	m.analyzer.EXPECT().ListAccessActivity(mock.Anything, scope).Return([]entity.AccessActivity{
+		{TenantID: "t1", UserID: 9, Namespace: "store", LastAccessedAt: daysAgo(3)},
+		{TenantID: "t1", UserID: 10, Namespace: "order", LastAccessedAt: daysAgo(3)},
+	}, nil)
+	m.analyzer.EXPECT().ListGroupStatements(mock.Anything, uint64(1)).
+		Return([]entity.PolicyStatement{allowStatement("store:*")}, nil).Once()
+	m.analyzer.EXPECT().ListGroupStatements(mock.Anything, uint64(2)).Return(nil, nil).Once()
+
+	stale, err := svc.ListStaleGroupMemberships(context.Background(), entity.AccessAnalyzerQuery{Scope: scope})
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, uint64(1), stale[0].GroupID)
+	require.Equal(t, uint(10), stale[0].UserID)
+}
+
+func TestAccessAnalyzer_GenerateLeastPrivilegePolicy(t *testing.T) {
+	t.Parallel()
+
+	svc, m := newAccessAnalyzerUsecase(t)
+	m.analyzer.EXPECT().
+		ListAccessActivity(mock.Anything, entity.AccessAnalyzerScope{TenantID: "t1"}).
+		Return([]entity.AccessActivity{
+			{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "store", LastAccessedAt: daysAgo(1)},
+			{TenantID: "t1", UserID: 9, RoleID: 4, Namespace: "store", LastAccessedAt: daysAgo(2)},
+			{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "billing", LastAccessedAt: daysAgo(5)},
+			{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: "order", LastAccessedAt: daysAgo(200)},
+			{TenantID: "t1", UserID: 10, RoleID: 3, Namespace: "partner", LastAccessedAt: daysAgo(1)},
+		}, nil)
+
+	statements, err := svc.GenerateLeastPrivilegePolicy(context.Background(), entity.GenerateLeastPrivilegePolicyInput{
+		TenantID: "t1",
+		UserID:   9,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []entity.PolicyStatement{allowStatement("billing:*"), allowStatement("store:*")}, statements)
+}
+
+func TestAccessAnalyzer_GenerateLeastPrivilegePolicyWithoutActivity(t *testing.T) {
+	t.Parallel()
+
+	svc, m := newAccessAnalyzerUsecase(t)
+	m.analyzer.EXPECT().
+		ListAccessActivity(mock.Anything, entity.AccessAnalyzerScope{TenantID: "t1"}).
+		Return(nil, nil)
+
+	_, err := svc.GenerateLeastPrivilegePolicy(context.Background(), entity.GenerateLeastPrivilegePolicyInput{
+		TenantID: "t1",
+		UserID:   9,
+		Since:    time.Hour,
+	})
+	require.ErrorIs(t, err, entity.ErrNoAccessActivity)
+}
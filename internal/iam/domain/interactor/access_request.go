@@ -0,0 +1,478 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
+)
+
+const (
+	EventAccessRequestRequested = events.TypeAccessRequestRequested
+	EventAccessRequestApproved  = events.TypeAccessRequestApproved
+	EventAccessRequestDenied    = events.TypeAccessRequestDenied
+	EventAccessRequestCancelled = events.TypeAccessRequestCancelled
+	EventAccessRequestExpired   = events.TypeAccessRequestExpired
+)
+
+type accessRequestInteractor struct {
+	requestCommands           outputport.AccessRequestCommandRepository
+	requestQueries            outputport.AccessRequestQueryRepository
+	roleQueries               outputport.RoleQueryRepository
+	groupQueries              outputport.GroupQueryRepository
+	tenantQueries             outputport.TenantQueryRepository
+	platformMembershipQueries outputport.PlatformMembershipQueryRepository
+	outbox                    outputport.OutboxRepository
+	uow                       outputport.UnitOfWork
+}
+
+var (
+	_ inputport.AccessRequestCommandUsecase = (*accessRequestInteractor)(nil)
+	_ inputport.AccessRequestQueryUsecase   = (*accessRequestInteractor)(nil)
+)
+
+func NewAccessRequestCommandInteractor(
+	requestCommands outputport.AccessRequestCommandRepository,
+	requestQueries outputport.AccessRequestQueryRepository,
+	roleQueries outputport.RoleQueryRepository,
+	groupQueries outputport.GroupQueryRepository,
+	tenantQueries outputport.TenantQueryRepository,
+	platformMembershipQueries outputport.PlatformMembershipQueryRepository,
+	outbox outputport.OutboxRepository,
+	uow outputport.UnitOfWork,
+) inputport.AccessRequestCommandUsecase {
+	return &accessRequestInteractor{
+		requestCommands:           requestCommands,
+		requestQueries:            requestQueries,
+		roleQueries:               roleQueries,
+		groupQueries:              groupQueries,
+		tenantQueries:             tenantQueries,
+		platformMembershipQueries: platformMembershipQueries,
+		outbox:                    outbox,
+		uow:                       uow,
+	}
+}
+
+func NewAccessRequestQueryInteractor(
+	requestQueries outputport.AccessRequestQueryRepository,
+	roleQueries outputport.RoleQueryRepository,
+	platformMembershipQueries outputport.PlatformMembershipQueryRepository,
+) inputport.AccessRequestQueryUsecase {
+	return &accessRequestInteractor{
+		requestQueries:            requestQueries,
+		roleQueries:               roleQueries,
+		platformMembershipQueries: platformMembershipQueries,
+	}
+}
+
+func (s *accessRequestInteractor) PutRoleAccessPolicy(
+	ctx context.Context,
+	input entity.PutRoleAccessPolicyInput,
+) (*entity.RoleAccessPolicy, error) {
+	approverRoleName := strings.TrimSpace(input.ApproverPlatformRole)
+	if (input.ApproverGroupID == 0) == (approverRoleName == "") {
+		return nil, entity.ErrInvalidAccessPolicy
+	}
+	maxDuration := time.Duration(input.MaxDurationSeconds) * time.Second
+	if maxDuration <= 0 {
+		maxDuration = entity.AccessRequestDefaultDuration
+	}
+	if maxDuration > entity.AccessRequestMaxDuration {
+		return nil, entity.ErrInvalidAccessPolicy
+	}
+	role, err := s.roleQueries.GetByName(ctx, strings.TrimSpace(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	policy := entity.RoleAccessPolicy{
+		RoleID:             role.ID,
+		RoleName:           role.Name,
+		MaxDurationSeconds: int64(maxDuration / time.Second),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if input.ApproverGroupID != 0 {
+		group, err := s.groupQueries.GetByID(ctx, input.ApproverGroupID)
+		if err != nil {
+			return nil, err
+		}
+		policy.ApproverGroupID = group.ID
+	} else {
+		approverRole, err := s.roleQueries.GetByName(ctx, approverRoleName)
+		if err != nil {
+			return nil, err
+		}
+		if approverRole.Scope != entity.PolicyScopePlatform {
+			return nil, entity.ErrInvalidAccessPolicy
+		}
+		policy.ApproverRoleID = approverRole.ID
+		policy.ApproverPlatformRole = approverRole.Name
+	}
+	if err := s.requestCommands.PutRoleAccessPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (s *accessRequestInteractor) DeleteRoleAccessPolicy(ctx context.Context, roleName string) error {
+	role, err := s.roleQueries.GetByName(ctx, strings.TrimSpace(roleName))
+	if err != nil {
+		return err
+	}
+	return s.requestCommands.DeleteRoleAccessPolicy(ctx, role.ID)
+}
+
+func (s *accessRequestInteractor) GetRoleAccessPolicy(
+	ctx context.Context,
+	roleName string,
+) (*entity.RoleAccessPolicy, error) {
+	role, err := s.roleQueries.GetByName(ctx, strings.TrimSpace(roleName))
+	if err != nil {
+		return nil, err
+	}
+	return s.requestQueries.GetRoleAccessPolicy(ctx, role.ID)
+}
+
+func (s *accessRequestInteractor) CreateAccessRequest(
+	ctx context.Context,
+	input entity.CreateRoleAccessRequestInput,
+) (*entity.RoleAccessRequest, error) {
+	if input.RequesterUserID == 0 {
+		return nil, entity.ErrInvalidUserID
+	}
+	justification := strings.TrimSpace(input.Justification)
+	if justification == "" {
+		return nil, entity.ErrAccessJustificationRequired
+	}
+	role, err := s.roleQueries.GetByName(ctx, strings.TrimSpace(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+	tenantID := strings.TrimSpace(input.TenantID)
+	switch role.Scope {
+	case entity.PolicyScopeTenant:
+		if tenantID == "" {
+			return nil, entity.ErrInvalidAccessRequest
+		}
+		if _, err := s.tenantQueries.GetByID(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	case entity.PolicyScopePlatform:
+		if tenantID != "" {
+			return nil, entity.ErrInvalidAccessRequest
+		}
+	default:
+		return nil, entity.ErrInvalidAccessRequest
+	}
+	policy, err := s.requestQueries.GetRoleAccessPolicy(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDuration := time.Duration(policy.MaxDurationSeconds) * time.Second
+	duration := time.Duration(input.DurationSeconds) * time.Second
+	if duration <= 0 {
+		duration = min(entity.AccessRequestDefaultDuration, maxDuration)
+	}
+	if duration > maxDuration {
+		return nil, entity.ErrInvalidAccessRequest
+	}
+	now := time.Now().UTC()
+	startsAt := input.StartsAt.UTC()
+	if startsAt.Before(now) {
+		startsAt = now
+	}
+
+	request := entity.RoleAccessRequest{
+		ID:              uuid.NewString(),
+		RequesterUserID: input.RequesterUserID,
+		RoleID:          role.ID,
+		RoleName:        role.Name,
+		RoleScope:       role.Scope,
+		TenantID:        tenantID,
+		Justification:   justification,
+		Status:          entity.AccessRequestStatusPending,
+		StartsAt:        startsAt,
+		ExpiresAt:       startsAt.Add(duration),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.requestCommands.CreateAccessRequest(ctx, request); err != nil {
+			return err
+		}
+		return s.recordAccessRequestEvent(ctx, now, EventAccessRequestRequested, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (s *accessRequestInteractor) ApproveAccessRequest(
+	ctx context.Context,
+	requestID string,
+	approverUserID uint,
+	reason string,
+) (*entity.RoleAccessRequest, error) {
+	return s.decide(ctx, requestID, approverUserID, reason, entity.AccessRequestStatusApproved)
+}
+
+func (s *accessRequestInteractor) DenyAccessRequest(
+	ctx context.Context,
+	requestID string,
+	approverUserID uint,
+	reason string,
+) (*entity.RoleAccessRequest, error) {
+	return s.decide(ctx, requestID, approverUserID, reason, entity.AccessRequestStatusDenied)
+}
+
+// decide records an approver's decision. Requesters never approve their own requests, and a
+// request whose window has already ended can only expire.
+func (s *accessRequestInteractor) decide(
+	ctx context.Context,
+	requestID string,
+	approverUserID uint,
+	reason string,
+	status string,
+) (*entity.RoleAccessRequest, error) {
+	request, err := s.requestQueries.GetAccessRequest(ctx, strings.TrimSpace(requestID))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if request.Status != entity.AccessRequestStatusPending || !now.Before(request.ExpiresAt) {
+		return nil, entity.ErrAccessRequestClosed
+	}
+	if approverUserID == 0 || approverUserID == request.RequesterUserID {
+		return nil, entity.ErrAccessRequestForbidden
+	}
+	approver, err := s.isApprover(ctx, request.RoleID, approverUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !approver {
+		return nil, entity.ErrAccessRequestForbidden
+	}
+
+	request.Status = status
+	request.DecidedByUserID = approverUserID
+	request.DecisionReason = strings.TrimSpace(reason)
+	request.DecidedAt = &now
+	request.UpdatedAt = now
+	eventType := EventAccessRequestApproved
+	if status == entity.AccessRequestStatusDenied {
+		eventType = EventAccessRequestDenied
+	}
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.requestCommands.TransitionAccessRequest(
+			ctx,
+			*request,
+			entity.AccessRequestStatusPending,
+		); err != nil {
+			return err
+		}
+		return s.recordAccessRequestEvent(ctx, now, eventType, *request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *accessRequestInteractor) CancelAccessRequest(
+	ctx context.Context,
+	requestID string,
+	userID uint,
+) (*entity.RoleAccessRequest, error) {
+	request, err := s.requestQueries.GetAccessRequest(ctx, strings.TrimSpace(requestID))
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 || request.RequesterUserID != userID {
+		return nil, entity.ErrAccessRequestForbidden
+	}
+	now := time.Now().UTC()
+	fromStatus := request.Status
+	if fromStatus != entity.AccessRequestStatusPending && fromStatus != entity.AccessRequestStatusApproved ||
+		!now.Before(request.ExpiresAt) {
+		return nil, entity.ErrAccessRequestClosed
+	}
+
+	if request.UsedAt != nil {
+		// Sessions assumed under the grant are not rechecked against it, so cancelling now
+		// would not end them.
+		return nil, entity.ErrAccessRequestInUse
+	}
+
+	request.Status = entity.AccessRequestStatusCancelled
+	request.UpdatedAt = now
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.requestCommands.CancelUnusedAccessRequest(ctx, *request, fromStatus); err != nil {
+			return err
+		}
+		return s.recordAccessRequestEvent(ctx, now, EventAccessRequestCancelled, *request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *accessRequestInteractor) ExpireAccessRequests(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]entity.RoleAccessRequest, error) {
+	lapsed, err := s.requestQueries.ListLapsedAccessRequests(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	expired := make([]entity.RoleAccessRequest, 0, len(lapsed))
+	for _, request := range lapsed {
+		fromStatus := request.Status
+		request.Status = entity.AccessRequestStatusExpired
+		request.UpdatedAt = now
+		err := s.inUnitOfWork(ctx, func(ctx context.Context) error {
+			if err := s.requestCommands.TransitionAccessRequest(ctx, request, fromStatus); err != nil {
+				return err
+			}
+			return s.recordAccessRequestEvent(ctx, now, EventAccessRequestExpired, request)
+		})
+		// Another worker, or the requester cancelling, got there first.
+		if errors.Is(err, entity.ErrAccessRequestClosed) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired = append(expired, request)
+	}
+	return expired, nil
+}
+
+func (s *accessRequestInteractor) GetAccessRequest(
+	ctx context.Context,
+	requestID string,
+	viewerUserID uint,
+) (*entity.RoleAccessRequest, error) {
+	request, err := s.requestQueries.GetAccessRequest(ctx, strings.TrimSpace(requestID))
+	if err != nil {
+		return nil, err
+	}
+	if request.RequesterUserID == viewerUserID {
+		return request, nil
+	}
+	approver, err := s.isApprover(ctx, request.RoleID, viewerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !approver {
+		return nil, entity.ErrAccessRequestNotFound
+	}
+	return request, nil
+}
+
+func (s *accessRequestInteractor) ListAccessRequests(
+	ctx context.Context,
+	viewerUserID uint,
+	filter entity.RoleAccessRequestFilter,
+) ([]entity.RoleAccessRequest, error) {
+	requests, err := s.requestQueries.ListAccessRequests(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	approverOf := map[uint64]bool{}
+	visible := make([]entity.RoleAccessRequest, 0, len(requests))
+	for _, request := range requests {
+		if request.RequesterUserID != viewerUserID {
+			approver, seen := approverOf[request.RoleID]
+			if !seen {
+				approver, err = s.isApprover(ctx, request.RoleID, viewerUserID)
+				if err != nil {
+					return nil, err
+				}
+				approverOf[request.RoleID] = approver
+			}
+			if !approver {
+				continue
+			}
+		}
+		visible = append(visible, request)
+	}
+	return visible, nil
+}
+
+// isApprover reports whether userID is named as an approver by the role's current access
+// policy. A role whose policy was deleted has no approvers.
+func (s *accessRequestInteractor) isApprover(ctx context.Context, roleID uint64, userID uint) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+	policy, err := s.requestQueries.GetRoleAccessPolicy(ctx, roleID)
+	if errors.Is(err, entity.ErrAccessPolicyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if policy.ApproverGroupID != 0 {
+		return s.requestQueries.IsGroupMember(ctx, policy.ApproverGroupID, userID)
+	}
+	memberships, err := s.platformMembershipQueries.ListByUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.RoleID == policy.ApproverRoleID && membership.Status == entity.MembershipStatusActive {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *accessRequestInteractor) inUnitOfWork(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.uow == nil {
+		return fn(ctx)
+	}
+	return s.uow.Do(ctx, fn)
+}
+
+// recordAccessRequestEvent keys every event of a request by its ID, so consumers see the
+// steps of one request in order.
+func (s *accessRequestInteractor) recordAccessRequestEvent(
+	ctx context.Context,
+	now time.Time,
+	eventType string,
+	request entity.RoleAccessRequest,
+) error {
+	payload := events.AccessRequestV1{
+		RequestID:       request.ID,
+		RequesterUserID: request.RequesterUserID,
+		RoleID:          request.RoleID,
+		RoleName:        request.RoleName,
+		TenantID:        request.TenantID,
+		Status:          request.Status,
+		Justification:   request.Justification,
+		StartsAt:        request.StartsAt,
+		ExpiresAt:       request.ExpiresAt,
+	}
+	if request.DecidedAt != nil {
+		payload.DecidedByUserID = request.DecidedByUserID
+		payload.DecisionReason = request.DecisionReason
+		payload.DecidedAt = request.DecidedAt
+	}
+	record, err := newIAMEventOutboxRecord(now, eventType, request.TenantID, request.ID, request.ID, payload)
+	if err != nil {
+		return err
+	}
+	return appendPendingOutboxRecord(ctx, s.outbox, now, record)
+}
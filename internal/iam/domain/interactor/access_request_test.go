@@ -0,0 +1,372 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type accessRequestMocks struct {
+	requests            *outputportmocks.MockAccessRequestRepository
+	roles               *outputportmocks.MockRoleQueryRepository
+	groups              *outputportmocks.MockGroupQueryRepository
+	tenants             *outputportmocks.MockTenantQueryRepository
+	platformMemberships *outputportmocks.MockPlatformMembershipQueryRepository
+	outbox              *outputportmocks.MockOutboxRepository
+	eventTypes          []string
+}
+
+func newAccessRequestUsecase(t *testing.T) (inputport.AccessRequestCommandUsecase, *accessRequestMocks) {
+	t.Helper()
+
+	m := &accessRequestMocks{
+		requests:            outputportmocks.NewMockAccessRequestRepository(t),
+		roles:               outputportmocks.NewMockRoleQueryRepository(t),
+		groups:              outputportmocks.NewMockGroupQueryRepository(t),
+		tenants:             outputportmocks.NewMockTenantQueryRepository(t),
+		platformMemberships: outputportmocks.NewMockPlatformMembershipQueryRepository(t),
+		outbox:              outputportmocks.NewMockOutboxRepository(t),
+	}
+	m.outbox.EXPECT().
+		Append(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
+			m.eventTypes = append(m.eventTypes, record.Envelope.Type)
+			return nil
+		}).
+		Maybe()
+	svc := iaminteractor.NewAccessRequestCommandInteractor(
+		m.requests,
+		m.requests,
+		m.roles,
+		m.groups,
+		m.tenants,
+		m.platformMemberships,
+		m.outbox,
+		nil,
+	)
+	return svc, m
+}
+
+func pendingAccessRequest() *entity.RoleAccessRequest {
+	now := time.Now().UTC()
+	return &entity.RoleAccessRequest{
+		ID:              "ar-1",
+		RequesterUserID: 9,
+		RoleID:          2,
+		RoleName:        entity.RoleTenantAdmin,
+		TenantID:        "t1",
+		Justification:   "incident 42",
+		Status:          entity.AccessRequestStatusPending,
+		StartsAt:        now,
+		ExpiresAt:       now.Add(time.Hour),
+	}
+}
+
+func TestAccessRequest_CreateEmitsRequestedEvent(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	m.roles.EXPECT().
+		GetByName(ctx, entity.RoleTenantAdmin).
+		Return(&entity.Role{ID: 2, Name: entity.RoleTenantAdmin, Scope: entity.PolicyScopeTenant}, nil)
+	m.tenants.EXPECT().GetByID(ctx, "t1").Return(&entity.Tenant{ID: "t1"}, nil)
+	m.requests.EXPECT().
+		GetRoleAccessPolicy(ctx, uint64(2)).
+		Return(&entity.RoleAccessPolicy{RoleID: 2, ApproverGroupID: 5, MaxDurationSeconds: 4 * 3600}, nil)
+	m.requests.EXPECT().CreateAccessRequest(ctx, mock.Anything).Return(nil)
+
+	request, err := svc.CreateAccessRequest(ctx, entity.CreateRoleAccessRequestInput{
+		RequesterUserID: 9,
+		RoleName:        entity.RoleTenantAdmin,
+		TenantID:        "t1",
+		Justification:   " incident 42 ",
+		DurationSeconds: 2 * 3600,
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.AccessRequestStatusPending, request.Status)
+	require.Equal(t, "incident 42", request.Justification)
+	require.Equal(t, 2*time.Hour, request.ExpiresAt.Sub(request.StartsAt))
+	require.Equal(t, []string{iaminteractor.EventAccessRequestRequested}, m.eventTypes)
+}
+
+func TestAccessRequest_CreateRejectsDurationOverPolicyMax(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	m.roles.EXPECT().
+		GetByName(ctx, entity.RolePlatformAdmin).
+		Return(&entity.Role{ID: 4, Name: entity.RolePlatformAdmin, Scope: entity.PolicyScopePlatform}, nil)
+	m.requests.EXPECT().
+		GetRoleAccessPolicy(ctx, uint64(4)).
+		Return(&entity.RoleAccessPolicy{RoleID: 4, ApproverRoleID: 1, MaxDurationSeconds: 3600}, nil)
+
+	_, err := svc.CreateAccessRequest(ctx, entity.CreateRoleAccessRequestInput{
+		RequesterUserID: 9,
+		RoleName:        entity.RolePlatformAdmin,
+		Justification:   "maintenance",
+		DurationSeconds: 2 * 3600,
+	})
+	require.ErrorIs(t, err, entity.ErrInvalidAccessRequest)
+	require.Empty(t, m.eventTypes)
+}
+
+func TestAccessRequest_CreateRequiresJustification(t *testing.T) {
+	svc, _ := newAccessRequestUsecase(t)
+
+	_, err := svc.CreateAccessRequest(context.Background(), entity.CreateRoleAccessRequestInput{
+		RequesterUserID: 9,
+		RoleName:        entity.RoleTenantAdmin,
+		TenantID:        "t1",
+		Justification:   "  ",
+	})
+	require.ErrorIs(t, err, entity.ErrAccessJustificationRequired)
+}
+
+func TestAccessRequest_RequesterCannotApproveOwnRequest(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(pendingAccessRequest(), nil)
+
+	_, err := svc.ApproveAccessRequest(ctx, "ar-1", 9, "")
+	require.ErrorIs(t, err, entity.ErrAccessRequestForbidden)
+}
+
+func TestAccessRequest_NonApproverIsForbidden(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(pendingAccessRequest(), nil)
+	m.requests.EXPECT().
+		GetRoleAccessPolicy(ctx, uint64(2)).
+		Return(&entity.RoleAccessPolicy{RoleID: 2, ApproverRoleID: 1}, nil)
+	m.platformMemberships.EXPECT().ListByUser(ctx, uint(11)).Return([]entity.PlatformMembership{
+		{UserID: 11, RoleID: 1, Status: "suspended"},
+	}, nil)
+
+	_, err := svc.ApproveAccessRequest(ctx, "ar-1", 11, "")
+	require.ErrorIs(t, err, entity.ErrAccessRequestForbidden)
+	require.Empty(t, m.eventTypes)
+}
+
+func TestAccessRequest_GroupApproverApproves(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(pendingAccessRequest(), nil)
+	m.requests.EXPECT().
+		GetRoleAccessPolicy(ctx, uint64(2)).
+		Return(&entity.RoleAccessPolicy{RoleID: 2, ApproverGroupID: 5}, nil)
+	m.requests.EXPECT().IsGroupMember(ctx, uint64(5), uint(11)).Return(true, nil)
+	m.requests.EXPECT().
+		TransitionAccessRequest(ctx, mock.Anything, entity.AccessRequestStatusPending).
+		Return(nil)
+
+	request, err := svc.ApproveAccessRequest(ctx, "ar-1", 11, " on call ")
+	require.NoError(t, err)
+	require.Equal(t, entity.AccessRequestStatusApproved, request.Status)
+	require.Equal(t, uint(11), request.DecidedByUserID)
+	require.Equal(t, "on call", request.DecisionReason)
+	require.NotNil(t, request.DecidedAt)
+	require.Equal(t, []string{iaminteractor.EventAccessRequestApproved}, m.eventTypes)
+}
+
+func TestAccessRequest_ClosedRequestCannotBeDecided(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	lapsed := pendingAccessRequest()
+	lapsed.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(lapsed, nil)
+
+	_, err := svc.DenyAccessRequest(ctx, "ar-1", 11, "")
+	require.ErrorIs(t, err, entity.ErrAccessRequestClosed)
+}
+
+func TestAccessRequest_CancelEndsUnusedGrant(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	approved := pendingAccessRequest()
+	approved.Status = entity.AccessRequestStatusApproved
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(approved, nil)
+	m.requests.EXPECT().
+		CancelUnusedAccessRequest(ctx, mock.AnythingOfType("entity.RoleAccessRequest"), entity.AccessRequestStatusApproved).
+		Return(nil)
+
+	cancelled, err := svc.CancelAccessRequest(ctx, "ar-1", 9)
+	require.NoError(t, err)
+	require.Equal(t, entity.AccessRequestStatusCancelled, cancelled.Status)
+	require.Equal(t, []string{iaminteractor.EventAccessRequestCancelled}, m.eventTypes)
+}
+
+func TestAccessRequest_CancelRefusesUsedGrant(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+
+	used := pendingAccessRequest()
+	used.Status = entity.AccessRequestStatusApproved
+	usedAt := used.StartsAt.Add(time.Minute)
+	used.UsedAt = &usedAt
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-1").Return(used, nil)
+
+	_, err := svc.CancelAccessRequest(ctx, "ar-1", 9)
+	require.ErrorIs(t, err, entity.ErrAccessRequestInUse)
+
+	// A session assumed between the read and the write is caught by the repository.
+	unused := pendingAccessRequest()
+	unused.Status = entity.AccessRequestStatusApproved
+	m.requests.EXPECT().GetAccessRequest(ctx, "ar-2").Return(unused, nil)
+	m.requests.EXPECT().
+		CancelUnusedAccessRequest(ctx, mock.AnythingOfType("entity.RoleAccessRequest"), entity.AccessRequestStatusApproved).
+		Return(entity.ErrAccessRequestInUse)
+
+	_, err = svc.CancelAccessRequest(ctx, "ar-2", 9)
+	require.ErrorIs(t, err, entity.ErrAccessRequestInUse)
+	require.Empty(t, m.eventTypes)
+}
+
+func TestAccessRequest_ExpireSkipsRequestsClosedConcurrently(t *testing.T) {
+	svc, m := newAccessRequestUsecase(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	m.requests.EXPECT().ListLapsedAccessRequests(ctx, now, 10).Return([]entity.RoleAccessRequest{
+		{ID: "ar-1", Status: entity.AccessRequestStatusApproved},
+		{ID: "ar-2", Status: entity.AccessRequestStatusPending},
+	}, nil)
+	m.requests.EXPECT().
+		TransitionAccessRequest(ctx, mock.MatchedBy(func(r entity.RoleAccessRequest) bool { return r.ID == "ar-1" }),
+			entity.AccessRequestStatusApproved).
+		Return(entity.ErrAccessRequestClosed)
+	m.requests.EXPECT().
+		TransitionAccessRequest(ctx, mock.MatchedBy(func(r entity.RoleAccessRequest) bool { return r.ID == "ar-2" }),
+			entity.AccessRequestStatusPending).
+		Return(nil)
+
+	expired, err := svc.ExpireAccessRequests(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, "ar-2", expired[0].ID)
+	require.Equal(t, entity.AccessRequestStatusExpired, expired[0].Status)
+	require.Equal(t, []string{iaminteractor.EventAccessRequestExpired}, m.eventTypes)
+}
+
+func TestAccessRequest_ListHidesRequestsViewerCannotDecide(t *testing.T) {
+	requests := outputportmocks.NewMockAccessRequestQueryRepository(t)
+	roles := outputportmocks.NewMockRoleQueryRepository(t)
+	platformMemberships := outputportmocks.NewMockPlatformMembershipQueryRepository(t)
+	svc := iaminteractor.NewAccessRequestQueryInteractor(requests, roles, platformMemberships)
+	ctx := context.Background()
+
+	requests.EXPECT().ListAccessRequests(ctx, entity.RoleAccessRequestFilter{}).Return([]entity.RoleAccessRequest{
+		{ID: "own", RequesterUserID: 11, RoleID: 2},
+		{ID: "approvable-1", RequesterUserID: 9, RoleID: 2},
+		{ID: "approvable-2", RequesterUserID: 8, RoleID: 2},
+		{ID: "hidden", RequesterUserID: 9, RoleID: 3},
+	}, nil)
+	requests.EXPECT().
+		GetRoleAccessPolicy(ctx, uint64(2)).
+		Return(&entity.RoleAccessPolicy{RoleID: 2, ApproverGroupID: 5}, nil).
+		Once()
+	requests.EXPECT().IsGroupMember(ctx, uint64(5), uint(11)).Return(true, nil).Once()
+	requests.EXPECT().GetRoleAccessPolicy(ctx, uint64(3)).Return(nil, entity.ErrAccessPolicyNotFound).Once()
+
+	visible, err := svc.ListAccessRequests(ctx, 11, entity.RoleAccessRequestFilter{})
+	require.NoError(t, err)
+	ids := make([]string, 0, len(visible))
+	for _, request := range visible {
+		ids = append(ids, request.ID)
+	}
+	require.Equal(t, []string{"own", "approvable-1", "approvable-2"}, ids)
+}
+
+func TestIAMService_AssumeRole_ApprovedAccessRequestGrantsSession(t *testing.T) {
+	t.Parallel()
+
+	grantExpiresAt := time.Now().UTC().Add(10 * time.Minute).Truncate(time.Second)
+	accessGrants := outputportmocks.NewMockAccessGrantRepository(t)
+	accessGrants.EXPECT().
+		FindActiveAccessGrant(mock.Anything, uint(9), uint64(2), "t1", mock.Anything).
+		Return(&entity.RoleAccessRequest{
+			ID:        "ar-1",
+			Status:    entity.AccessRequestStatusApproved,
+			ExpiresAt: grantExpiresAt,
+		}, nil)
+	accessGrants.EXPECT().MarkAccessGrantUsed(mock.Anything, "ar-1", mock.Anything).Return(nil)
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{accessGrants: accessGrants})
+	state.roleByName[entity.RoleTenantAdmin] = entity.Role{
+		ID:    2,
+		Scope: entity.PolicyScopeTenant,
+		Name:  entity.RoleTenantAdmin,
+	}
+
+	assumedRole, err := svc.AssumeRole(context.Background(), entity.AssumeRoleInput{
+		UserID:   9,
+		RoleName: entity.RoleTenantAdmin,
+		TenantID: "t1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ar-1", assumedRole.AccessRequestID)
+	require.Equal(t, grantExpiresAt, assumedRole.ExpiresAt)
+}
+
+func TestIAMService_AssumeRole_DeniedWithoutTrustOrGrant(t *testing.T) {
+	t.Parallel()
+
+	accessGrants := outputportmocks.NewMockAccessGrantRepository(t)
+	accessGrants.EXPECT().
+		FindActiveAccessGrant(mock.Anything, uint(9), uint64(2), "t1", mock.Anything).
+		Return(nil, entity.ErrAccessRequestNotFound)
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{accessGrants: accessGrants})
+	state.roleByName[entity.RoleTenantAdmin] = entity.Role{
+		ID:    2,
+		Scope: entity.PolicyScopeTenant,
+		Name:  entity.RoleTenantAdmin,
+	}
+
+	_, err := svc.AssumeRole(context.Background(), entity.AssumeRoleInput{
+		UserID:   9,
+		RoleName: entity.RoleTenantAdmin,
+		TenantID: "t1",
+	})
+	require.ErrorIs(t, err, entity.ErrAssumeRoleDenied)
+}
+
+func TestIAMService_AssumeRole_DeniedWhenGrantIsCancelledConcurrently(t *testing.T) {
+	t.Parallel()
+
+	accessGrants := outputportmocks.NewMockAccessGrantRepository(t)
+	accessGrants.EXPECT().
+		FindActiveAccessGrant(mock.Anything, uint(9), uint64(2), "t1", mock.Anything).
+		Return(&entity.RoleAccessRequest{
+			ID:        "ar-1",
+			Status:    entity.AccessRequestStatusApproved,
+			ExpiresAt: time.Now().UTC().Add(time.Hour),
+		}, nil)
+	accessGrants.EXPECT().
+		MarkAccessGrantUsed(mock.Anything, "ar-1", mock.Anything).
+		Return(entity.ErrAccessRequestClosed)
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{accessGrants: accessGrants})
+	state.roleByName[entity.RoleTenantAdmin] = entity.Role{
+		ID:    2,
+		Scope: entity.PolicyScopeTenant,
+		Name:  entity.RoleTenantAdmin,
+	}
+
+	_, err := svc.AssumeRole(context.Background(), entity.AssumeRoleInput{
+		UserID:   9,
+		RoleName: entity.RoleTenantAdmin,
+		TenantID: "t1",
+	})
+	require.ErrorIs(t, err, entity.ErrAssumeRoleDenied)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
 )
@@ -18,6 +19,9 @@ func (s *interactor) CheckPermission(
 	return s.CheckPermissionForResource(ctx, tenantID, userID, permission, "*")
 }
 
+// CheckPermissionForResource evaluates the principal's compiled policy set. With a decision
+// cache configured, the compiled set and the decision are reused until an IAM change for the
+// tenant invalidates them.
 func (s *interactor) CheckPermissionForResource(
 	ctx context.Context,
 	tenantID string,
@@ -33,104 +37,170 @@ func (s *interactor) CheckPermissionForResource(
 		if assumedRole.RoleScope == entity.PolicyScopeTenant && assumedRole.TenantID != strings.TrimSpace(tenantID) {
 			return false, nil
 		}
-		return s.evaluateAssumedRolePermission(ctx, entity.AccessRequest{
+		allowed, err := s.evaluateAssumedRolePermission(ctx, entity.AccessRequest{
 			TenantID:   tenantID,
 			UserID:     userID,
 			Action:     permission,
 			Resource:   resource,
 			Attributes: requestAttributesFromContext(ctx),
 		}, assumedRole.RoleID, permission)
+		if allowed {
+			s.recordAccessActivity(tenantID, userID, assumedRole.RoleID, permission)
+		}
+		return allowed, err
 	}
-	tenant, err := s.tenantQueries.GetByID(ctx, tenantID)
-	if err != nil {
-		return false, err
-	}
-	membership, err := s.membershipForAuthorization(ctx, tenant, userID)
-	if err != nil {
-		return false, err
-	}
-	if membership.Status != entity.MembershipStatusActive {
-		return false, entity.ErrInactiveMembership
+	epoch := s.decisions.currentEpoch()
+	principalKey := fmt.Sprintf("tenant:%s:%d", tenantID, userID)
+	principal, ok := s.decisions.principal(principalKey)
+	if !ok {
+		var err error
+		principal, err = s.compileTenantPrincipal(ctx, tenantID, userID)
+		if err != nil {
+			return false, err
+		}
+		s.decisions.storePrincipal(epoch, principalKey, tenantID, principal)
 	}
 	request := entity.AccessRequest{
 		TenantID:   tenantID,
-		OrgID:      tenant.OrgID,
+		OrgID:      principal.orgID,
 		UserID:     userID,
 		Action:     permission,
 		Resource:   resource,
 		Attributes: requestAttributesFromContext(ctx),
 	}
-	statements, err := s.policyQueries.ListTenantUserStatements(ctx, tenantID, userID)
+	sessionStatements := entity.GetSessionPolicyStatements(ctx)
+	key, cacheable := s.decisionKey(principalKey, principal, request, sessionStatements)
+	if cacheable {
+		if allowed, hit := s.decisions.decision(key); hit {
+			if allowed {
+				s.recordAccessActivity(tenantID, userID, principal.roleID, permission)
+			}
+			return allowed, nil
+		}
+	}
+	allowed, err := s.decideTenantPermission(ctx, principal, request, sessionStatements)
 	if err != nil {
 		return false, err
 	}
+	if cacheable {
+		s.decisions.storeDecision(epoch, key, tenantID, allowed)
+	}
+	if allowed {
+		s.recordAccessActivity(tenantID, userID, principal.roleID, permission)
+	}
+	return allowed, nil
+}
+
+// recordAccessActivity hands an allowed decision to the access analyzer's recorder, which
+// writes it in the background.
+func (s *interactor) recordAccessActivity(tenantID string, userID uint, roleID uint64, action string) {
+	if s.activity == nil {
+		return
+	}
+	s.activity.Record(entity.AccessActivity{
+		TenantID:       strings.TrimSpace(tenantID),
+		UserID:         userID,
+		RoleID:         roleID,
+		Namespace:      entity.ActionNamespace(action),
+		LastAccessedAt: time.Now().UTC(),
+	})
+}
+
+// compileTenantPrincipal loads every statement that can take part in a tenant decision for
+// the user: identity (direct and group), role, both boundaries and the organization SCPs.
+func (s *interactor) compileTenantPrincipal(
+	ctx context.Context,
+	tenantID string,
+	userID uint,
+) (*compiledPrincipal, error) {
+	tenant, err := s.tenantQueries.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	membership, err := s.membershipForAuthorization(ctx, tenant, userID)
+	if err != nil {
+		return nil, err
+	}
+	if membership.Status != entity.MembershipStatusActive {
+		return nil, entity.ErrInactiveMembership
+	}
+	statements, err := s.policyQueries.ListTenantUserStatements(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
 	groupStatements, err := s.policyQueries.ListTenantGroupStatements(ctx, tenantID, userID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	statements = append(statements, groupStatements...)
-	if len(statements) > 0 {
-		result := explainPolicyStatements("identity", request, statements)
-		if !result.Allowed {
-			if result.Reason == "explicit deny matched" {
-				return false, nil
-			}
-			goto tenantRoleEvaluation
+	roleStatements, err := s.policyQueries.ListRoleStatements(ctx, membership.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	roleBoundary, err := s.roleQueries.GetPermissionBoundaryStatements(ctx, membership.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	userBoundary, err := s.policyQueries.GetTenantUserPermissionBoundaryStatements(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	var scpStatements []entity.PolicyStatement
+	if orgID := strings.TrimSpace(tenant.OrgID); orgID != "" {
+		scpStatements, err = s.orgQueries.ListServiceControlPolicyStatements(ctx, orgID)
+		if err != nil {
+			return nil, err
 		}
-		if !s.evaluateTenantUserBoundary(ctx, request, tenantID, userID) {
-			return false, nil
+	}
+	principal := &compiledPrincipal{
+		orgID:        tenant.OrgID,
+		roleID:       membership.RoleID,
+		identity:     compilePolicySet(append(statements, groupStatements...)),
+		role:         compilePolicySet(roleStatements),
+		roleBoundary: compilePolicySet(roleBoundary),
+		userBoundary: compilePolicySet(userBoundary),
+		scp:          compilePolicySet(scpStatements),
+	}
+	principal.collectConditionKeys()
+	return principal, nil
+}
+
+// decideTenantPermission applies the layers in order: identity statements (an explicit deny
+// ends evaluation), then role statements, then the role's plain permissions. Whatever allows
+// must also pass the boundaries, the organization SCPs and the session policy.
+func (s *interactor) decideTenantPermission(
+	ctx context.Context,
+	principal *compiledPrincipal,
+	request entity.AccessRequest,
+	sessionStatements []entity.PolicyStatement,
+) (bool, error) {
+	guardrails := func(roleGranted bool) bool {
+		if roleGranted && !principal.roleBoundary.permits(request) {
+			return false
+		}
+		if !principal.userBoundary.permits(request) || !principal.scp.permits(request) {
+			return false
 		}
-		if !s.evaluateOrganizationSCP(ctx, request, tenant.OrgID) {
+		return len(sessionStatements) == 0 || evaluatePolicyStatements(request, sessionStatements)
+	}
+	if !principal.identity.empty() {
+		allowed, denied := principal.identity.evaluate(request)
+		if denied {
 			return false, nil
 		}
-		sessionStatements := entity.GetSessionPolicyStatements(ctx)
-		if len(sessionStatements) > 0 {
-			return evaluatePolicyStatements(request, sessionStatements), nil
+		if allowed {
+			return guardrails(false), nil
 		}
-		return true, nil
 	}
-tenantRoleEvaluation:
-	roleStatements, err := s.policyQueries.ListRoleStatements(ctx, membership.RoleID)
-	if err != nil {
-		return false, err
-	}
-	if len(roleStatements) > 0 {
-		allowed := evaluatePolicyStatements(request, roleStatements)
-		if allowed {
-			if !s.evaluateRoleBoundary(ctx, request, membership.RoleID) {
-				return false, nil
-			}
-			if !s.evaluateTenantUserBoundary(ctx, request, tenantID, userID) {
-				return false, nil
-			}
-			if !s.evaluateOrganizationSCP(ctx, request, tenant.OrgID) {
-				return false, nil
-			}
-			sessionStatements := entity.GetSessionPolicyStatements(ctx)
-			if len(sessionStatements) > 0 {
-				return evaluatePolicyStatements(request, sessionStatements), nil
-			}
-			return true, nil
+	if !principal.role.empty() {
+		if allowed, _ := principal.role.evaluate(request); allowed {
+			return guardrails(true), nil
 		}
 	}
-	allowed, err := s.roleQueries.RoleHasPermission(ctx, membership.RoleID, permission)
+	allowed, err := s.roleQueries.RoleHasPermission(ctx, principal.roleID, request.Action)
 	if err != nil || !allowed {
 		return allowed, err
 	}
-	if !s.evaluateRoleBoundary(ctx, request, membership.RoleID) {
-		return false, nil
-	}
-	if !s.evaluateTenantUserBoundary(ctx, request, tenantID, userID) {
-		return false, nil
-	}
-	if !s.evaluateOrganizationSCP(ctx, request, tenant.OrgID) {
-		return false, nil
-	}
-	sessionStatements := entity.GetSessionPolicyStatements(ctx)
-	if len(sessionStatements) > 0 {
-		return evaluatePolicyStatements(request, sessionStatements), nil
-	}
-	return true, nil
+	return guardrails(true), nil
 }
 
 func (s *interactor) membershipForAuthorization(
@@ -383,36 +453,52 @@ func (s *interactor) evaluateAssumedRolePermission(
 	roleID uint64,
 	permission string,
 ) (bool, error) {
-	statements, err := s.policyQueries.ListRoleStatements(ctx, roleID)
-	if err != nil {
-		return false, err
-	}
-	if len(statements) > 0 {
-		allowed := evaluatePolicyStatements(request, statements)
-		if !allowed {
-			return false, nil
+	epoch := s.decisions.currentEpoch()
+	principalKey := fmt.Sprintf("role:%d", roleID)
+	principal, ok := s.decisions.principal(principalKey)
+	if !ok {
+		statements, err := s.policyQueries.ListRoleStatements(ctx, roleID)
+		if err != nil {
+			return false, err
 		}
-		if !s.evaluateRoleBoundary(ctx, request, roleID) {
-			return false, nil
+		boundary, err := s.roleQueries.GetPermissionBoundaryStatements(ctx, roleID)
+		if err != nil {
+			return false, err
 		}
-		sessionStatements := entity.GetSessionPolicyStatements(ctx)
-		if len(sessionStatements) > 0 {
-			return evaluatePolicyStatements(request, sessionStatements), nil
+		principal = (&compiledPrincipal{
+			roleID:       roleID,
+			role:         compilePolicySet(statements),
+			roleBoundary: compilePolicySet(boundary),
+		}).collectConditionKeys()
+		s.decisions.storePrincipal(epoch, principalKey, "", principal)
+	}
+	sessionStatements := entity.GetSessionPolicyStatements(ctx)
+	key, cacheable := s.decisionKey(principalKey+":"+request.TenantID, principal, request, sessionStatements)
+	if cacheable {
+		if allowed, hit := s.decisions.decision(key); hit {
+			return allowed, nil
 		}
-		return true, nil
 	}
-	allowed, err := s.roleQueries.RoleHasPermission(ctx, roleID, permission)
-	if err != nil || !allowed {
-		return allowed, err
+	allowed := false
+	if !principal.role.empty() {
+		allowed, _ = principal.role.evaluate(request)
+	} else {
+		var err error
+		allowed, err = s.roleQueries.RoleHasPermission(ctx, roleID, permission)
+		if err != nil {
+			return false, err
+		}
 	}
-	if !s.evaluateRoleBoundary(ctx, request, roleID) {
-		return false, nil
+	if allowed && !principal.roleBoundary.permits(request) {
+		allowed = false
 	}
-	sessionStatements := entity.GetSessionPolicyStatements(ctx)
-	if len(sessionStatements) > 0 {
-		return evaluatePolicyStatements(request, sessionStatements), nil
+	if allowed && len(sessionStatements) > 0 {
+		allowed = evaluatePolicyStatements(request, sessionStatements)
+	}
+	if cacheable {
+		s.decisions.storeDecision(epoch, key, "", allowed)
 	}
-	return true, nil
+	return allowed, nil
 }
 
 func (s *interactor) evaluatePlatformUserBoundary(ctx context.Context, request entity.AccessRequest, userID uint) bool {
@@ -3,10 +3,13 @@ package interactor_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
 )
 
 func TestIAMService_RequirePermission(t *testing.T) {
@@ -119,6 +122,43 @@ func TestIAMService_CheckPermissionForResource(t *testing.T) {
 	require.False(t, allowed)
 }
 
+func TestIAMService_CheckPermissionForResource_RecordsAllowedActivity(t *testing.T) {
+	t.Parallel()
+
+	recorder := outputportmocks.NewMockAccessActivityRecorder(t)
+	var recorded []entity.AccessActivity
+	recorder.EXPECT().
+		Record(mock.Anything).
+		Run(func(activity entity.AccessActivity) { recorded = append(recorded, activity) })
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{activity: recorder})
+	state.tenants["t1"] = entity.Tenant{ID: "t1", Name: "Tenant", Slug: "tenant"}
+	state.roleByName[entity.RoleTenantViewer] = entity.Role{ID: 3, Name: entity.RoleTenantViewer}
+	state.memberships.items[membershipKey("t1", 9)] = entity.Membership{
+		TenantID: "t1",
+		UserID:   9,
+		RoleID:   3,
+		RoleName: entity.RoleTenantViewer,
+		Status:   entity.MembershipStatusActive,
+	}
+	state.tenantDirect[membershipKey("t1", 9)] = []entity.PolicyStatement{
+		{Effect: entity.PolicyEffectAllow, ActionPattern: "store:read", ResourcePattern: "*"},
+	}
+
+	allowed, err := svc.CheckPermissionForResource(context.Background(), "t1", 9, "store:read", "*")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = svc.CheckPermissionForResource(context.Background(), "t1", 9, "order:update", "*")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	require.Len(t, recorded, 1)
+	require.Equal(t, "t1", recorded[0].TenantID)
+	require.Equal(t, uint(9), recorded[0].UserID)
+	require.Equal(t, uint64(3), recorded[0].RoleID)
+	require.Equal(t, "store", recorded[0].Namespace)
+	require.False(t, recorded[0].LastAccessedAt.IsZero())
+}
+
 func TestIAMService_RequirePlatformPermission(t *testing.T) {
 	t.Parallel()
 
@@ -340,3 +380,103 @@ func TestIAMService_SimulateAccess_WithConditionAndBoundary(t *testing.T) {
 	require.True(t, result.Allowed)
 	require.NotEmpty(t, result.MatchedStatements)
 }
+
+func TestIAMService_RequirePermission_MultiFactorAuthCondition(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+	state.tenants["t1"] = entity.Tenant{ID: "t1", Name: "Tenant", Slug: "tenant"}
+	state.roleByName[entity.RoleTenantEditor] = entity.Role{ID: 2, Name: entity.RoleTenantEditor}
+	state.memberships.items[membershipKey("t1", 9)] = entity.Membership{
+		TenantID: "t1", UserID: 9, RoleID: 2, RoleName: entity.RoleTenantEditor, Status: entity.MembershipStatusActive,
+	}
+	state.tenantDirect[membershipKey("t1", 9)] = []entity.PolicyStatement{
+		{
+			PolicyName:      "inline/payouts",
+			Effect:          entity.PolicyEffectAllow,
+			ActionPattern:   "payout:update",
+			ResourcePattern: "*",
+		},
+		{
+			PolicyName:      "inline/payouts-require-mfa",
+			Effect:          entity.PolicyEffectDeny,
+			ActionPattern:   "payout:update",
+			ResourcePattern: "*",
+			Conditions: []entity.PolicyCondition{{
+				Operator: entity.ConditionBool,
+				Key:      entity.ConditionKeyMultiFactorAuthPresent,
+				Value:    "false",
+			}},
+		},
+	}
+
+	require.ErrorIs(
+		t,
+		svc.RequirePermission(context.Background(), "t1", 9, "payout:update"),
+		entity.ErrPermissionDenied,
+	)
+	mfaCtx := entity.WithMultiFactorAuthPresent(context.Background(), true)
+	require.NoError(t, svc.RequirePermission(mfaCtx, "t1", 9, "payout:update"))
+}
+
+func TestIAMService_RequirePermission_GlobalRequestConditions(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+	state.tenants["t1"] = entity.Tenant{ID: "t1", Name: "Tenant", Slug: "tenant"}
+	state.roleByName[entity.RoleTenantEditor] = entity.Role{ID: 2, Name: entity.RoleTenantEditor}
+	state.memberships.items[membershipKey("t1", 9)] = entity.Membership{
+		TenantID: "t1", UserID: 9, RoleID: 2, RoleName: entity.RoleTenantEditor, Status: entity.MembershipStatusActive,
+	}
+	state.tenantDirect[membershipKey("t1", 9)] = []entity.PolicyStatement{
+		{
+			PolicyName:      "inline/payouts",
+			Effect:          entity.PolicyEffectAllow,
+			ActionPattern:   "payout:update",
+			ResourcePattern: "*",
+			Conditions: []entity.PolicyCondition{
+				{Operator: entity.ConditionBool, Key: entity.ConditionKeySecureTransport, Value: "true"},
+				{Operator: entity.ConditionNumericLessThanEquals, Key: entity.ConditionKeySessionAge, Value: "3600"},
+				{Operator: entity.ConditionDateGreaterThan, Key: entity.ConditionKeyCurrentTime, Value: "2020-01-01T00:00:00Z"},
+			},
+		},
+		{
+			PolicyName:      "inline/office-only",
+			Effect:          entity.PolicyEffectDeny,
+			ActionPattern:   "*",
+			ResourcePattern: "*",
+			Conditions: []entity.PolicyCondition{{
+				Operator: entity.ConditionNotIpAddress,
+				Key:      entity.ConditionKeySourceIp,
+				Value:    "203.0.113.0/24",
+			}},
+		},
+	}
+	office := entity.RequestContext{
+		SourceIP:        "203.0.113.7",
+		SecureTransport: true,
+		IdentitySource:  "podzone",
+		AuthTime:        time.Now().Add(-10 * time.Minute),
+	}
+
+	require.NoError(t, svc.RequirePermission(entity.WithRequestContext(context.Background(), office), "t1", 9, "payout:update"))
+
+	outside := office
+	outside.SourceIP = "198.51.100.1"
+	require.ErrorIs(t, svc.RequirePermission(
+		entity.WithRequestContext(context.Background(), outside), "t1", 9, "payout:update",
+	), entity.ErrPermissionDenied)
+
+	stale := office
+	stale.AuthTime = time.Now().Add(-2 * time.Hour)
+	require.ErrorIs(t, svc.RequirePermission(
+		entity.WithRequestContext(context.Background(), stale), "t1", 9, "payout:update",
+	), entity.ErrPermissionDenied)
+
+	require.ErrorIs(
+		t,
+		svc.RequirePermission(context.Background(), "t1", 9, "payout:update"),
+		entity.ErrPermissionDenied,
+		"missing request context must not satisfy numeric or IP conditions",
+	)
+}
@@ -0,0 +1,315 @@
+package interactor
+
+import (
+	"crypto/sha256"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+)
+
+const (
+	defaultDecisionCacheTTL        = time.Minute
+	defaultDecisionCacheMaxEntries = 100_000
+)
+
+// DecisionCache keeps each principal's compiled policy set and the decisions evaluated
+// against it. Entries are dropped when an IAM change for their tenant is observed, either
+// locally through the outbox or from iam.events, and expire after the TTL regardless.
+type DecisionCache struct {
+	mu         sync.RWMutex
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	// epoch advances on every invalidation so a compile that raced with one is not stored.
+	epoch      uint64
+	principals map[string]cachedPrincipal
+	decisions  map[string]cachedDecision
+}
+
+var _ inputport.DecisionCacheUsecase = (*DecisionCache)(nil)
+
+type cachedPrincipal struct {
+	tenantID  string
+	principal *compiledPrincipal
+	expiresAt time.Time
+}
+
+type cachedDecision struct {
+	tenantID  string
+	allowed   bool
+	expiresAt time.Time
+}
+
+func NewDecisionCache(ttl time.Duration, maxEntries int) *DecisionCache {
+	if ttl <= 0 {
+		ttl = defaultDecisionCacheTTL
+	}
+	if maxEntries <= 0 {
+		maxEntries = defaultDecisionCacheMaxEntries
+	}
+	return &DecisionCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		principals: map[string]cachedPrincipal{},
+		decisions:  map[string]cachedDecision{},
+	}
+}
+
+// Invalidate drops everything cached for tenantID. Entries that are not tenant-bound, such
+// as assumed-role sessions, are dropped with every tenant. An empty tenantID drops all.
+func (c *DecisionCache) Invalidate(tenantID string) {
+	if c == nil {
+		return
+	}
+	tenantID = strings.TrimSpace(tenantID)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epoch++
+	if tenantID == "" {
+		clear(c.principals)
+		clear(c.decisions)
+		return
+	}
+	for key, entry := range c.principals {
+		if entry.tenantID == tenantID || entry.tenantID == "" {
+			delete(c.principals, key)
+		}
+	}
+	for key, entry := range c.decisions {
+		if entry.tenantID == tenantID || entry.tenantID == "" {
+			delete(c.decisions, key)
+		}
+	}
+}
+
+func (c *DecisionCache) currentEpoch() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.epoch
+}
+
+func (c *DecisionCache) principal(key string) (*compiledPrincipal, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.principals[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.principal, true
+}
+
+func (c *DecisionCache) storePrincipal(epoch uint64, key string, tenantID string, principal *compiledPrincipal) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.epoch != epoch {
+		return
+	}
+	if len(c.principals) >= c.maxEntries {
+		clear(c.principals)
+	}
+	c.principals[key] = cachedPrincipal{tenantID: tenantID, principal: principal, expiresAt: c.now().Add(c.ttl)}
+}
+
+func (c *DecisionCache) decision(key string) (bool, bool) {
+	if c == nil {
+		return false, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.decisions[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.allowed, true
+}
+
+func (c *DecisionCache) storeDecision(epoch uint64, key string, tenantID string, allowed bool) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.epoch != epoch {
+		return
+	}
+	if len(c.decisions) >= c.maxEntries {
+		clear(c.decisions)
+	}
+	c.decisions[key] = cachedDecision{tenantID: tenantID, allowed: allowed, expiresAt: c.now().Add(c.ttl)}
+}
+
+// compiledPrincipal is everything CheckPermissionForResource reads from the repositories for
+// one principal, so a decision can be evaluated without further round trips. Only the
+// role-permission fallback for roles without statements still reaches the database.
+type compiledPrincipal struct {
+	orgID        string
+	roleID       uint64
+	identity     compiledPolicySet
+	role         compiledPolicySet
+	roleBoundary compiledPolicySet
+	userBoundary compiledPolicySet
+	scp          compiledPolicySet
+	// conditionKeys are the request attributes any statement reads; only they vary a decision.
+	conditionKeys []string
+	// volatile is set when a condition reads the clock, so decisions must not be reused.
+	volatile bool
+}
+
+// collectConditionKeys records which request attributes the compiled statements read.
+func (p *compiledPrincipal) collectConditionKeys() *compiledPrincipal {
+	keys := map[string]struct{}{}
+	for _, set := range []compiledPolicySet{p.identity, p.role, p.roleBoundary, p.userBoundary, p.scp} {
+		for _, statement := range set.statements {
+			for _, condition := range statement.Conditions {
+				keys[normalizedConditionKey(condition.Key)] = struct{}{}
+			}
+		}
+	}
+	p.conditionKeys = make([]string, 0, len(keys))
+	for key := range keys {
+		p.conditionKeys = append(p.conditionKeys, key)
+		if isVolatileConditionKey(key) {
+			p.volatile = true
+		}
+	}
+	sort.Strings(p.conditionKeys)
+	return p
+}
+
+// compiledPolicySet indexes statements by action so an evaluation only visits statements
+// whose action pattern can match. Deny still wins regardless of order.
+type compiledPolicySet struct {
+	statements []entity.PolicyStatement
+	byAction   map[string][]int
+	wildcard   []int
+}
+
+func compilePolicySet(statements []entity.PolicyStatement) compiledPolicySet {
+	set := compiledPolicySet{statements: statements, byAction: map[string][]int{}}
+	for i, statement := range statements {
+		if statement.ActionPattern == "" || strings.ContainsAny(statement.ActionPattern, `*?[\`) {
+			set.wildcard = append(set.wildcard, i)
+			continue
+		}
+		set.byAction[statement.ActionPattern] = append(set.byAction[statement.ActionPattern], i)
+	}
+	return set
+}
+
+func (s compiledPolicySet) empty() bool {
+	return len(s.statements) == 0
+}
+
+// evaluate matches explainPolicyStatements: an explicit deny wins, otherwise any allow.
+func (s compiledPolicySet) evaluate(request entity.AccessRequest) (allowed bool, denied bool) {
+	for _, candidates := range [][]int{s.byAction[request.Action], s.wildcard} {
+		for _, i := range candidates {
+			statement := s.statements[i]
+			if !matchesPattern(statement.ResourcePattern, resourceOrWildcard(request.Resource)) {
+				continue
+			}
+			if !matchesPattern(statement.ActionPattern, request.Action) {
+				continue
+			}
+			if !matchesConditions(statement.Conditions, request) {
+				continue
+			}
+			switch statement.Effect {
+			case entity.PolicyEffectDeny:
+				return false, true
+			case entity.PolicyEffectAllow:
+				allowed = true
+			}
+		}
+	}
+	return allowed, false
+}
+
+// permits treats an empty set as no restriction, the way boundaries and SCPs behave.
+func (s compiledPolicySet) permits(request entity.AccessRequest) bool {
+	if s.empty() {
+		return true
+	}
+	allowed, _ := s.evaluate(request)
+	return allowed
+}
+
+func resourceOrWildcard(resource string) string {
+	if resource == "" {
+		return "*"
+	}
+	return resource
+}
+
+func normalizedConditionKey(key string) string {
+	switch {
+	case strings.HasPrefix(key, "aws:PrincipalTag/"):
+		return "principal_tag:" + strings.TrimPrefix(key, "aws:PrincipalTag/")
+	case strings.HasPrefix(key, "aws:RequestTag/"):
+		return "request_tag:" + strings.TrimPrefix(key, "aws:RequestTag/")
+	default:
+		return key
+	}
+}
+
+func isVolatileConditionKey(key string) bool {
+	switch key {
+	case entity.ConditionKeyCurrentTime, entity.ConditionKeyEpochTime, entity.ConditionKeySessionAge:
+		return true
+	default:
+		return false
+	}
+}
+
+// decisionKey identifies a decision by principal, action, resource and the hash of the
+// attributes and session policy that can influence it. ok is false without a cache, or when
+// the decision reads the clock and must be evaluated every time.
+func (s *interactor) decisionKey(
+	principalKey string,
+	principal *compiledPrincipal,
+	request entity.AccessRequest,
+	sessionStatements []entity.PolicyStatement,
+) (string, bool) {
+	if s.decisions == nil || principal.volatile {
+		return "", false
+	}
+	hash := sha256.New()
+	write := func(parts ...string) {
+		for _, part := range parts {
+			hash.Write([]byte(part))
+			hash.Write([]byte{0})
+		}
+	}
+	keys := principal.conditionKeys[:len(principal.conditionKeys):len(principal.conditionKeys)]
+	for _, statement := range sessionStatements {
+		write("session", statement.Effect, statement.ActionPattern, statement.ResourcePattern)
+		for _, condition := range statement.Conditions {
+			key := normalizedConditionKey(condition.Key)
+			if isVolatileConditionKey(key) {
+				return "", false
+			}
+			write(condition.Operator, condition.Key, condition.Value)
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		value, present := request.Attributes[key]
+		write(key, strconv.FormatBool(present), value)
+	}
+	return principalKey + "\x00" + request.Action + "\x00" + request.Resource + "\x00" + string(hash.Sum(nil)), true
+}
@@ -0,0 +1,164 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+)
+
+func seedDecisionCacheTenant(state *iamTestState) {
+	state.tenants["t1"] = entity.Tenant{ID: "t1", Name: "Tenant", Slug: "tenant"}
+	state.roleByName[entity.RoleTenantEditor] = entity.Role{ID: 2, Name: entity.RoleTenantEditor}
+	state.rolePermissions[2] = map[string]bool{"store:update": true}
+	state.memberships.items[membershipKey("t1", 9)] = entity.Membership{
+		TenantID: "t1",
+		UserID:   9,
+		RoleID:   2,
+		RoleName: entity.RoleTenantEditor,
+		Status:   entity.MembershipStatusActive,
+	}
+	state.roleStatements[2] = []entity.PolicyStatement{
+		{
+			PolicyID:        10,
+			PolicyName:      "managed/tenant_editor",
+			Effect:          entity.PolicyEffectAllow,
+			ActionPattern:   "order:*",
+			ResourcePattern: "*",
+		},
+	}
+}
+
+func TestIAMService_DecisionCache_InvalidatedByLocalWrite(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecaseWithDecisionCache(t, iaminteractor.NewDecisionCache(time.Minute, 100))
+	seedDecisionCacheTenant(state)
+
+	require.NoError(t, svc.RequirePermission(context.Background(), "t1", 9, "order:update"))
+
+	// A change that bypasses the usecase is not observed until the cache is invalidated.
+	state.roleStatements[2] = nil
+	require.NoError(t, svc.RequirePermission(context.Background(), "t1", 9, "order:update"))
+
+	require.NoError(t, svc.PutTenantUserInlinePolicy(context.Background(), entity.PutTenantUserInlinePolicyInput{
+		TenantID: "t1",
+		UserID:   9,
+		Name:     "deny-order-update",
+		Statements: []entity.PolicyStatement{{
+			Effect:          entity.PolicyEffectDeny,
+			ActionPattern:   "order:update",
+			ResourcePattern: "*",
+		}},
+	}))
+	require.ErrorIs(
+		t,
+		svc.RequirePermission(context.Background(), "t1", 9, "order:update"),
+		entity.ErrPermissionDenied,
+	)
+	require.Len(t, state.outboxRecords, 1)
+	require.Equal(t, iaminteractor.EventAuthorizationChanged, state.outboxRecords[0].Envelope.Type)
+	require.Equal(t, "t1", state.outboxRecords[0].Envelope.TenantID)
+}
+
+func TestIAMService_DecisionCache_InvalidatedByEvent(t *testing.T) {
+	t.Parallel()
+
+	cache := iaminteractor.NewDecisionCache(time.Minute, 100)
+	svc, state := newIAMTestUsecaseWithDecisionCache(t, cache)
+	seedDecisionCacheTenant(state)
+
+	require.NoError(t, svc.RequirePermission(context.Background(), "t1", 9, "order:update"))
+	state.roleStatements[2] = nil
+
+	cache.Invalidate("t2")
+	require.NoError(t, svc.RequirePermission(context.Background(), "t1", 9, "order:update"))
+
+	cache.Invalidate("t1")
+	require.ErrorIs(
+		t,
+		svc.RequirePermission(context.Background(), "t1", 9, "order:update"),
+		entity.ErrPermissionDenied,
+	)
+}
+
+func TestIAMService_DecisionCache_KeysOnConditionAttributes(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecaseWithDecisionCache(t, iaminteractor.NewDecisionCache(time.Minute, 100))
+	seedDecisionCacheTenant(state)
+	state.tenantDirect[membershipKey("t1", 9)] = []entity.PolicyStatement{{
+		PolicyName:      "inline/office-only",
+		Effect:          entity.PolicyEffectDeny,
+		ActionPattern:   "*",
+		ResourcePattern: "*",
+		Conditions: []entity.PolicyCondition{{
+			Operator: entity.ConditionNotIpAddress,
+			Key:      entity.ConditionKeySourceIp,
+			Value:    "203.0.113.0/24",
+		}},
+	}}
+	office := entity.WithRequestContext(context.Background(), entity.RequestContext{SourceIP: "203.0.113.7"})
+	outside := entity.WithRequestContext(context.Background(), entity.RequestContext{SourceIP: "198.51.100.1"})
+
+	for range 2 {
+		require.NoError(t, svc.RequirePermission(office, "t1", 9, "order:update"))
+		require.ErrorIs(t, svc.RequirePermission(outside, "t1", 9, "order:update"), entity.ErrPermissionDenied)
+	}
+}
+
+func TestIAMService_DecisionCache_SkipsClockConditions(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecaseWithDecisionCache(t, iaminteractor.NewDecisionCache(time.Minute, 100))
+	seedDecisionCacheTenant(state)
+	state.tenantDirect[membershipKey("t1", 9)] = []entity.PolicyStatement{{
+		PolicyName:      "inline/fresh-sessions",
+		Effect:          entity.PolicyEffectDeny,
+		ActionPattern:   "*",
+		ResourcePattern: "*",
+		Conditions: []entity.PolicyCondition{{
+			Operator: entity.ConditionNumericGreaterThanEquals,
+			Key:      entity.ConditionKeySessionAge,
+			Value:    "3600",
+		}},
+	}}
+	authTime := time.Now().Add(-59*time.Minute - 59*time.Second)
+	ctx := entity.WithRequestContext(context.Background(), entity.RequestContext{AuthTime: authTime})
+
+	require.NoError(t, svc.RequirePermission(ctx, "t1", 9, "order:update"))
+	require.Eventually(t, func() bool {
+		return svc.RequirePermission(ctx, "t1", 9, "order:update") != nil
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+// BenchmarkCheckPermissionForResource compares evaluating against the repositories on every
+// call with serving repeated checks from the decision cache.
+func BenchmarkCheckPermissionForResource(b *testing.B) {
+	for _, bench := range []struct {
+		name  string
+		cache *iaminteractor.DecisionCache
+	}{
+		{name: "repository"},
+		{name: "cached", cache: iaminteractor.NewDecisionCache(time.Minute, 100_000)},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			svc, state := newIAMTestUsecaseWithDecisionCache(b, bench.cache)
+			seedDecisionCacheTenant(state)
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				allowed, err := svc.CheckPermissionForResource(ctx, "t1", 9, "order:update", "order:o-1")
+				if err != nil || !allowed {
+					b.Fatalf("CheckPermissionForResource() = %v, %v", allowed, err)
+				}
+			}
+		})
+	}
+}
@@ -7,11 +7,56 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
+// EventAuthorizationChanged is published for IAM writes that change authorization but have
+// no more specific event. Decision caches in every IAM replica drop the tenant, or everything
+// when the event carries no tenant.
+const EventAuthorizationChanged = events.TypeAuthorizationChanged
+
+type pendingInvalidationsKey struct{}
+
+// inUnitOfWork runs fn in one transaction, so the repository writes made with the ctx it is
+// given and the outbox records appended for them are committed together or not at all. The
+// tenants of those records are invalidated again once the transaction ends, because a check
+// that ran while it was open may have cached the state before the write.
+func (s *interactor) inUnitOfWork(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.uow == nil {
+		return fn(ctx)
+	}
+	if _, nested := ctx.Value(pendingInvalidationsKey{}).(*[]string); nested {
+		return s.uow.Do(ctx, fn)
+	}
+	var tenants []string
+	err := s.uow.Do(context.WithValue(ctx, pendingInvalidationsKey{}, &tenants), fn)
+	for _, tenantID := range tenants {
+		s.decisions.Invalidate(tenantID)
+	}
+	return err
+}
+
+// appendOutboxRecord writes record in the transaction carried by ctx, if any. It also drops
+// this runtime's cached decisions for the event's tenant, so a write is visible to the next
+// check here before the event reaches other replicas.
 func (s *interactor) appendOutboxRecord(ctx context.Context, now time.Time, record messaging.OutboxRecord) error {
-	if s.outbox == nil {
+	s.decisions.Invalidate(record.Envelope.TenantID)
+	if pending, ok := ctx.Value(pendingInvalidationsKey{}).(*[]string); ok {
+		*pending = append(*pending, record.Envelope.TenantID)
+	}
+	return appendPendingOutboxRecord(ctx, s.outbox, now, record)
+}
+
+// appendPendingOutboxRecord queues record for the relay in the transaction carried by ctx.
+func appendPendingOutboxRecord(
+	ctx context.Context,
+	outbox outputport.OutboxRepository,
+	now time.Time,
+	record messaging.OutboxRecord,
+) error {
+	if outbox == nil {
 		return nil
 	}
 	record.Status = "pending"
@@ -19,7 +64,28 @@ func (s *interactor) appendOutboxRecord(ctx context.Context, now time.Time, reco
 	record.NextAttemptAt = now
 	record.CreatedAt = now
 	record.UpdatedAt = now
-	return s.outbox.Append(ctx, nil, record)
+	return outbox.Append(ctx, messaging.TxFromContext(ctx), record)
+}
+
+// recordAuthorizationChanged publishes EventAuthorizationChanged. An empty tenantID marks a
+// change that can affect any tenant, such as a new policy version or an organization SCP.
+func (s *interactor) recordAuthorizationChanged(
+	ctx context.Context,
+	tenantID string,
+	change string,
+	payload events.AuthorizationChangedV1,
+) error {
+	now := time.Now().UTC()
+	payload.Change = change
+	entityID := tenantID
+	if entityID == "" {
+		entityID = change
+	}
+	record, err := newIAMEventOutboxRecord(now, EventAuthorizationChanged, tenantID, entityID, entityID, payload)
+	if err != nil {
+		return err
+	}
+	return s.appendOutboxRecord(ctx, now, record)
 }
 
 func newIAMEventOutboxRecord(
@@ -28,7 +94,7 @@ func newIAMEventOutboxRecord(
 	tenantID string,
 	entityID string,
 	messageKey string,
-	payload map[string]any,
+	payload any,
 ) (messaging.OutboxRecord, error) {
 	rawPayload, err := json.Marshal(payload)
 	if err != nil {
@@ -39,7 +105,7 @@ func newIAMEventOutboxRecord(
 		messageKey = entityID
 	}
 
-	return messaging.OutboxRecord{
+	record := messaging.OutboxRecord{
 		ID:         uuid.NewString(),
 		Topic:      messaging.Topic("iam", "events"),
 		MessageKey: messageKey,
@@ -53,5 +119,9 @@ func newIAMEventOutboxRecord(
 			SchemaVersion: 1,
 			Payload:       rawPayload,
 		},
-	}, nil
+	}
+	if err := events.Schemas().ValidateRegistered(record.Envelope); err != nil {
+		return messaging.OutboxRecord{}, err
+	}
+	return record, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -67,7 +68,14 @@ func (s *interactor) DeleteGroup(ctx context.Context, groupID uint64) error {
 	if groupID == 0 {
 		return entity.ErrGroupNotFound
 	}
-	return s.groupCommands.DeleteGroup(ctx, groupID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.DeleteGroup(ctx, groupID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "group.deleted", events.AuthorizationChangedV1{
+			GroupID: groupID,
+		})
+	})
 }
 
 func (s *interactor) PutGroupInlinePolicy(ctx context.Context, input entity.PutGroupInlinePolicyInput) error {
@@ -89,11 +97,19 @@ func (s *interactor) PutGroupInlinePolicy(ctx context.Context, input entity.PutG
 		}
 		statements = append(statements, normalized)
 	}
-	return s.groupCommands.PutInlinePolicy(ctx, entity.PutGroupInlinePolicyInput{
-		GroupID:     input.GroupID,
-		Name:        strings.TrimSpace(input.Name),
-		Description: strings.TrimSpace(input.Description),
-		Statements:  statements,
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.PutInlinePolicy(ctx, entity.PutGroupInlinePolicyInput{
+			GroupID:     input.GroupID,
+			Name:        strings.TrimSpace(input.Name),
+			Description: strings.TrimSpace(input.Description),
+			Statements:  statements,
+		}); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "group.inline_policy.put", events.AuthorizationChangedV1{
+			GroupID:    input.GroupID,
+			PolicyName: strings.TrimSpace(input.Name),
+		})
 	})
 }
 
@@ -129,7 +145,15 @@ func (s *interactor) DeleteGroupInlinePolicy(ctx context.Context, groupID uint64
 	if strings.TrimSpace(name) == "" {
 		return entity.ErrInvalidPolicyName
 	}
-	return s.groupCommands.DeleteInlinePolicy(ctx, groupID, strings.TrimSpace(name))
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.DeleteInlinePolicy(ctx, groupID, strings.TrimSpace(name)); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "group.inline_policy.deleted", events.AuthorizationChangedV1{
+			GroupID:    groupID,
+			PolicyName: strings.TrimSpace(name),
+		})
+	})
 }
 
 func (s *interactor) AddGroupMember(ctx context.Context, groupID uint64, userID uint) error {
@@ -152,7 +176,15 @@ func (s *interactor) AddGroupMember(ctx context.Context, groupID uint64, userID
 			return entity.ErrInactiveMembership
 		}
 	}
-	return s.groupCommands.AddMember(ctx, groupID, userID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.AddMember(ctx, groupID, userID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, group.TenantID, "group.member.added", events.AuthorizationChangedV1{
+			GroupID: groupID,
+			UserID:  userID,
+		})
+	})
 }
 
 func (s *interactor) RemoveGroupMember(ctx context.Context, groupID uint64, userID uint) error {
@@ -162,7 +194,15 @@ func (s *interactor) RemoveGroupMember(ctx context.Context, groupID uint64, user
 	if userID == 0 {
 		return entity.ErrInvalidUserID
 	}
-	return s.groupCommands.RemoveMember(ctx, groupID, userID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.RemoveMember(ctx, groupID, userID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "group.member.removed", events.AuthorizationChangedV1{
+			GroupID: groupID,
+			UserID:  userID,
+		})
+	})
 }
 
 func (s *interactor) ListGroupMembers(
@@ -192,31 +232,33 @@ func (s *interactor) AttachGroupPolicy(ctx context.Context, groupID uint64, poli
 	if err != nil {
 		return err
 	}
-	if err := s.groupCommands.AttachPolicy(ctx, groupID, policy.ID); err != nil {
-		return err
-	}
-	now := time.Now().UTC()
-	record, err := newIAMEventOutboxRecord(
-		now,
-		"policy.attached",
-		group.TenantID,
-		group.Name,
-		group.Name,
-		map[string]any{
-			"tenant_id":        group.TenantID,
-			"group_id":         group.ID,
-			"group_name":       group.Name,
-			"policy_id":        policy.ID,
-			"policy_name":      policy.Name,
-			"policy_scope":     policy.Scope,
-			"attachment_type":  "group",
-			"attachment_scope": group.Scope,
-		},
-	)
-	if err != nil {
-		return err
-	}
-	return s.appendOutboxRecord(ctx, now, record)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.AttachPolicy(ctx, groupID, policy.ID); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		record, err := newIAMEventOutboxRecord(
+			now,
+			events.TypePolicyAttached,
+			group.TenantID,
+			group.Name,
+			group.Name,
+			events.PolicyAttachedV1{
+				TenantID:        group.TenantID,
+				GroupID:         group.ID,
+				GroupName:       group.Name,
+				PolicyID:        policy.ID,
+				PolicyName:      policy.Name,
+				PolicyScope:     policy.Scope,
+				AttachmentType:  "group",
+				AttachmentScope: group.Scope,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.appendOutboxRecord(ctx, now, record)
+	})
 }
 
 func (s *interactor) DetachGroupPolicy(ctx context.Context, groupID uint64, policyName string) error {
@@ -235,7 +277,16 @@ func (s *interactor) DetachGroupPolicy(ctx context.Context, groupID uint64, poli
 	if err != nil {
 		return err
 	}
-	return s.groupCommands.DetachPolicy(ctx, groupID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.groupCommands.DetachPolicy(ctx, groupID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, group.TenantID, "group.policy.detached", events.AuthorizationChangedV1{
+			GroupID:    groupID,
+			PolicyID:   policy.ID,
+			PolicyName: policy.Name,
+		})
+	})
 }
 
 func validateGroupOwner(group entity.Group) error {
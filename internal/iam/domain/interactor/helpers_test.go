@@ -11,6 +11,7 @@ import (
 	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
 	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
 	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
 	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
 	"github.com/tuannm99/podzone/pkg/collection"
 	"github.com/tuannm99/podzone/pkg/messaging"
@@ -21,6 +22,7 @@ type iamTestState struct {
 	roleByName              map[string]entity.Role
 	roleTrustStatements     map[uint64][]entity.RoleTrustStatement
 	rolePermissions         map[uint64]map[string]bool
+	permissions             []entity.Permission
 	policiesByName          map[string]entity.Policy
 	policiesByID            map[uint64]entity.Policy
 	policyStatements        map[uint64][]entity.PolicyStatement
@@ -46,6 +48,7 @@ type iamTestState struct {
 	memberships             *membershipState
 	invites                 *inviteState
 	outboxRecords           []messaging.OutboxRecord
+	outboxTxs               []messaging.Tx
 }
 
 type membershipState struct {
@@ -88,8 +91,28 @@ func (r *inviteState) GetByID(_ context.Context, inviteID string) (*entity.Tenan
 	return &copyItem, nil
 }
 
+type iamTestOptions struct {
+	decisions    *iaminteractor.DecisionCache
+	uow          outputport.UnitOfWork
+	accessGrants outputport.AccessGrantRepository
+	activity     outputport.AccessActivityRecorder
+}
+
 func newIAMTestUsecase(t *testing.T) (inputport.IAMUsecase, *iamTestState) {
 	t.Helper()
+	return newIAMTestUsecaseWithOptions(t, iamTestOptions{})
+}
+
+func newIAMTestUsecaseWithDecisionCache(
+	t testing.TB,
+	decisions *iaminteractor.DecisionCache,
+) (inputport.IAMUsecase, *iamTestState) {
+	t.Helper()
+	return newIAMTestUsecaseWithOptions(t, iamTestOptions{decisions: decisions})
+}
+
+func newIAMTestUsecaseWithOptions(t testing.TB, opts iamTestOptions) (inputport.IAMUsecase, *iamTestState) {
+	t.Helper()
 
 	tenantRepo := outputportmocks.NewMockTenantRepository(t)
 	roleRepo := outputportmocks.NewMockRoleRepository(t)
@@ -136,6 +159,7 @@ func newIAMTestUsecase(t *testing.T) (inputport.IAMUsecase, *iamTestState) {
 		Append(mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
 			state.outboxRecords = append(state.outboxRecords, record)
+			state.outboxTxs = append(state.outboxTxs, tx)
 			return nil
 		}).
 		Maybe()
@@ -245,6 +269,13 @@ func newIAMTestUsecase(t *testing.T) (inputport.IAMUsecase, *iamTestState) {
 			return state.rolePermissions[roleID][permission], nil
 		}).
 		Maybe()
+	roleRepo.EXPECT().
+		ListPermissions(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, query collection.Query) (collection.Page[entity.Permission], error) {
+			items := append([]entity.Permission(nil), state.permissions...)
+			return collection.NewPage(items, int64(len(items)), query), nil
+		}).
+		Maybe()
 	roleRepo.EXPECT().
 		PutTrustPolicy(mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, roleID uint64, statements []entity.RoleTrustStatement) error {
@@ -328,7 +359,11 @@ func newIAMTestUsecase(t *testing.T) (inputport.IAMUsecase, *iamTestState) {
 		membershipRepo,
 		inviteRepo,
 		outboxRepo,
+		opts.uow,
+		opts.accessGrants,
 		nil,
+		opts.activity,
+		opts.decisions,
 	), state
 }
 
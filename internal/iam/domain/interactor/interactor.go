@@ -3,6 +3,7 @@ package interactor
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
 	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
 	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
@@ -32,6 +34,10 @@ type interactor struct {
 	inviteQueries              outputport.InviteQueryRepository
 	userDirectory              outputport.UserDirectory
 	outbox                     outputport.OutboxRepository
+	uow                        outputport.UnitOfWork
+	accessGrants               outputport.AccessGrantRepository
+	activity                   outputport.AccessActivityRecorder
+	decisions                  *DecisionCache
 }
 
 var (
@@ -100,8 +106,12 @@ func NewCommandInteractor(
 	inviteCommands outputport.InviteCommandRepository,
 	inviteQueries outputport.InviteQueryRepository,
 	outbox outputport.OutboxRepository,
+	uow outputport.UnitOfWork,
+	accessGrants outputport.AccessGrantRepository,
+	activity outputport.AccessActivityRecorder,
+	decisions *DecisionCache,
 ) inputport.IAMCommandUsecase {
-	return NewInteractor(
+	s := NewInteractor(
 		tenantCommands,
 		tenantQueries,
 		roleCommands,
@@ -121,6 +131,11 @@ func NewCommandInteractor(
 		outbox,
 		nil,
 	)
+	s.uow = uow
+	s.accessGrants = accessGrants
+	s.activity = activity
+	s.decisions = decisions
+	return s
 }
 
 func NewQueryInteractor(
@@ -133,6 +148,8 @@ func NewQueryInteractor(
 	membershipQueries outputport.MembershipQueryRepository,
 	inviteQueries outputport.InviteQueryRepository,
 	userDirectory outputport.UserDirectory,
+	activity outputport.AccessActivityRecorder,
+	decisions *DecisionCache,
 ) inputport.IAMQueryUsecase {
 	return &interactor{
 		tenantQueries:             tenantQueries,
@@ -144,6 +161,8 @@ func NewQueryInteractor(
 		membershipQueries:         membershipQueries,
 		inviteQueries:             inviteQueries,
 		userDirectory:             userDirectory,
+		activity:                  activity,
+		decisions:                 decisions,
 	}
 }
 
@@ -157,9 +176,13 @@ func NewIAMUsecase(
 	memberships outputport.MembershipRepository,
 	invites outputport.InviteRepository,
 	outbox outputport.OutboxRepository,
+	uow outputport.UnitOfWork,
+	accessGrants outputport.AccessGrantRepository,
 	userDirectory outputport.UserDirectory,
+	activity outputport.AccessActivityRecorder,
+	decisions *DecisionCache,
 ) inputport.IAMUsecase {
-	return NewInteractor(
+	s := NewInteractor(
 		tenants,
 		tenants,
 		roles,
@@ -179,6 +202,11 @@ func NewIAMUsecase(
 		outbox,
 		userDirectory,
 	)
+	s.uow = uow
+	s.accessGrants = accessGrants
+	s.activity = activity
+	s.decisions = decisions
+	return s
 }
 
 func (s *interactor) CreateTenant(
@@ -207,43 +235,49 @@ func (s *interactor) CreateTenant(
 	case !errors.Is(orgErr, entity.ErrOrganizationNotFound):
 		return nil, orgErr
 	}
-	tenant, err := s.tenantCommands.Create(ctx, entity.Tenant{
-		ID:        uuid.NewString(),
-		Name:      name,
-		Slug:      slug,
-		OrgID:     orgID,
-		CreatedAt: now,
-		UpdatedAt: now,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	role, err := s.roleQueries.GetByName(ctx, entity.RoleTenantOwner)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.membershipCommands.Upsert(ctx, entity.Membership{
-		TenantID:  tenant.ID,
-		UserID:    ownerUserID,
-		RoleID:    role.ID,
-		RoleName:  role.Name,
-		Status:    entity.MembershipStatusActive,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}); err != nil {
-		return nil, err
-	}
+	var tenant *entity.Tenant
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		var createErr error
+		tenant, createErr = s.tenantCommands.Create(ctx, entity.Tenant{
+			ID:        uuid.NewString(),
+			Name:      name,
+			Slug:      slug,
+			OrgID:     orgID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if createErr != nil {
+			return createErr
+		}
 
-	if s.outbox != nil {
+		if err := s.membershipCommands.Upsert(ctx, entity.Membership{
+			TenantID:  tenant.ID,
+			UserID:    ownerUserID,
+			RoleID:    role.ID,
+			RoleName:  role.Name,
+			Status:    entity.MembershipStatusActive,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}); err != nil {
+			return err
+		}
+
+		if s.outbox == nil {
+			return nil
+		}
 		record, err := newTenantCreatedOutboxRecord(*tenant, ownerUserID, now)
 		if err != nil {
-			return nil, err
-		}
-		if err := s.appendOutboxRecord(ctx, now, record); err != nil {
-			return nil, err
+			return err
 		}
+		return s.appendOutboxRecord(ctx, now, record)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return tenant, nil
@@ -254,12 +288,13 @@ func newTenantCreatedOutboxRecord(
 	ownerUserID uint,
 	now time.Time,
 ) (messaging.OutboxRecord, error) {
-	return newIAMEventOutboxRecord(now, "tenant.created", tenant.ID, tenant.ID, tenant.ID, map[string]any{
-		"tenant_id":     tenant.ID,
-		"tenant_slug":   tenant.Slug,
-		"tenant_name":   tenant.Name,
-		"owner_user_id": ownerUserID,
-	})
+	payload := events.TenantCreatedV1{
+		TenantID:    tenant.ID,
+		TenantSlug:  tenant.Slug,
+		TenantName:  tenant.Name,
+		OwnerUserID: ownerUserID,
+	}
+	return newIAMEventOutboxRecord(now, events.TypeTenantCreated, tenant.ID, tenant.ID, tenant.ID, payload)
 }
 
 func (s *interactor) AssumeRole(ctx context.Context, input entity.AssumeRoleInput) (*entity.AssumedRole, error) {
@@ -284,6 +319,8 @@ func (s *interactor) AssumeRole(ctx context.Context, input entity.AssumeRoleInpu
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now().UTC()
+	var grant *entity.RoleAccessRequest
 	if !s.canAssumeRole(
 		ctx,
 		input.UserID,
@@ -292,9 +329,24 @@ func (s *interactor) AssumeRole(ctx context.Context, input entity.AssumeRoleInpu
 		strings.TrimSpace(input.ServicePrincipal),
 		trustStatements,
 	) {
-		return nil, entity.ErrAssumeRoleDenied
+		if input.ServicePrincipal != "" {
+			return nil, entity.ErrAssumeRoleDenied
+		}
+		grant, err = s.activeAccessGrant(ctx, input.UserID, role.ID, tenantID, now)
+		if err != nil {
+			return nil, err
+		}
+		if grant == nil {
+			return nil, entity.ErrAssumeRoleDenied
+		}
+		// Marking the grant used is what stops it being cancelled under a live session.
+		if err := s.accessGrants.MarkAccessGrantUsed(ctx, grant.ID, now); err != nil {
+			if errors.Is(err, entity.ErrAccessRequestClosed) {
+				return nil, entity.ErrAssumeRoleDenied
+			}
+			return nil, err
+		}
 	}
-	now := time.Now().UTC()
 	duration := time.Duration(input.DurationSeconds) * time.Second
 	if duration <= 0 {
 		duration = time.Hour
@@ -302,6 +354,15 @@ func (s *interactor) AssumeRole(ctx context.Context, input entity.AssumeRoleInpu
 	if duration > 12*time.Hour {
 		duration = 12 * time.Hour
 	}
+	expiresAt := now.Add(duration)
+	accessRequestID := ""
+	if grant != nil {
+		// A session never outlives the approved window that allowed it.
+		if grant.ExpiresAt.Before(expiresAt) {
+			expiresAt = grant.ExpiresAt
+		}
+		accessRequestID = grant.ID
+	}
 	return &entity.AssumedRole{
 		RoleID:           role.ID,
 		RoleScope:        role.Scope,
@@ -311,11 +372,34 @@ func (s *interactor) AssumeRole(ctx context.Context, input entity.AssumeRoleInpu
 		SessionName:      strings.TrimSpace(input.SessionName),
 		SourceIdentity:   strings.TrimSpace(input.SourceIdentity),
 		SessionTags:      cloneStringMap(input.SessionTags),
-		ExpiresAt:        now.Add(duration),
+		AccessRequestID:  accessRequestID,
+		ExpiresAt:        expiresAt,
 		CreatedAt:        now,
 	}, nil
 }
 
+// activeAccessGrant returns the approved access request that lets userID assume the role in
+// tenantID at now, or nil when there is none.
+func (s *interactor) activeAccessGrant(
+	ctx context.Context,
+	userID uint,
+	roleID uint64,
+	tenantID string,
+	now time.Time,
+) (*entity.RoleAccessRequest, error) {
+	if s.accessGrants == nil {
+		return nil, nil
+	}
+	grant, err := s.accessGrants.FindActiveAccessGrant(ctx, userID, roleID, tenantID, now)
+	if errors.Is(err, entity.ErrAccessRequestNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
 func normalizePolicyConditions(conditions []entity.PolicyCondition) []entity.PolicyCondition {
 	if len(conditions) == 0 {
 		return nil
@@ -390,13 +474,32 @@ func validServicePrincipal(value string) bool {
 
 func requestAttributesFromContext(ctx context.Context) map[string]string {
 	tags := entity.GetSessionTags(ctx)
-	if len(tags) == 0 {
+	mfaPresent := entity.GetMultiFactorAuthPresent(ctx)
+	request, hasRequest := entity.GetRequestContext(ctx)
+	if len(tags) == 0 && !mfaPresent && !hasRequest {
 		return nil
 	}
-	out := make(map[string]string, len(tags))
+	out := make(map[string]string, len(tags)*2+5)
 	for k, v := range tags {
 		out["principal_tag:"+k] = v
 		out["request_tag:"+k] = v
 	}
+	if mfaPresent {
+		out[entity.ConditionKeyMultiFactorAuthPresent] = "true"
+	}
+	if !hasRequest {
+		return out
+	}
+	if request.SourceIP != "" {
+		out[entity.ConditionKeySourceIp] = request.SourceIP
+	}
+	out[entity.ConditionKeySecureTransport] = strconv.FormatBool(request.SecureTransport)
+	if request.IdentitySource != "" {
+		out[entity.ConditionKeyIdentitySource] = request.IdentitySource
+	}
+	if !request.AuthTime.IsZero() {
+		age := time.Since(request.AuthTime) / time.Second
+		out[entity.ConditionKeySessionAge] = strconv.FormatInt(int64(max(age, 0)), 10)
+	}
 	return out
 }
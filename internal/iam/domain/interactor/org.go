@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -118,14 +119,23 @@ func (s *interactor) AddOrganizationMember(
 		return entity.ErrInvalidRoleName
 	}
 	now := time.Now().UTC()
-	return s.orgCommands.UpsertMembership(ctx, entity.OrganizationMembership{
-		OrgID:     orgID,
-		UserID:    userID,
-		RoleID:    role.ID,
-		RoleName:  role.Name,
-		Status:    entity.MembershipStatusActive,
-		CreatedAt: now,
-		UpdatedAt: now,
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.orgCommands.UpsertMembership(ctx, entity.OrganizationMembership{
+			OrgID:     orgID,
+			UserID:    userID,
+			RoleID:    role.ID,
+			RoleName:  role.Name,
+			Status:    entity.MembershipStatusActive,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "organization.member.added", events.AuthorizationChangedV1{
+			OrgID:    orgID,
+			UserID:   userID,
+			RoleName: role.Name,
+		})
 	})
 }
 
@@ -140,7 +150,15 @@ func (s *interactor) RemoveOrganizationMember(ctx context.Context, orgID string,
 	if org.RootUserID == userID {
 		return entity.ErrImmutableOrganizationRoot
 	}
-	return s.orgCommands.DeleteMembership(ctx, org.ID, userID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.orgCommands.DeleteMembership(ctx, org.ID, userID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "organization.member.removed", events.AuthorizationChangedV1{
+			OrgID:  org.ID,
+			UserID: userID,
+		})
+	})
 }
 
 func (s *interactor) CheckOrganizationPermission(
@@ -238,7 +256,19 @@ func (s *interactor) AttachTenantToOrganization(ctx context.Context, tenantID st
 	if _, err := s.orgQueries.GetByID(ctx, orgID); err != nil {
 		return err
 	}
-	return s.tenantCommands.AttachOrganization(ctx, tenantID, orgID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.tenantCommands.AttachOrganization(ctx, tenantID, orgID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			tenantID,
+			"tenant.organization.attached",
+			events.AuthorizationChangedV1{
+				OrgID: orgID,
+			},
+		)
+	})
 }
 
 func (s *interactor) DetachTenantFromOrganization(ctx context.Context, tenantID string) error {
@@ -246,7 +276,12 @@ func (s *interactor) DetachTenantFromOrganization(ctx context.Context, tenantID
 	if tenantID == "" {
 		return entity.ErrTenantNotFound
 	}
-	return s.tenantCommands.DetachOrganization(ctx, tenantID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.tenantCommands.DetachOrganization(ctx, tenantID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, tenantID, "tenant.organization.detached", events.AuthorizationChangedV1{})
+	})
 }
 
 func (s *interactor) AttachServiceControlPolicy(ctx context.Context, orgID string, policyName string) error {
@@ -261,7 +296,15 @@ func (s *interactor) AttachServiceControlPolicy(ctx context.Context, orgID strin
 	if err != nil {
 		return err
 	}
-	return s.orgCommands.AttachServiceControlPolicy(ctx, orgID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.orgCommands.AttachServiceControlPolicy(ctx, orgID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "organization.scp.attached", events.AuthorizationChangedV1{
+			OrgID:      orgID,
+			PolicyName: policy.Name,
+		})
+	})
 }
 
 func (s *interactor) DetachServiceControlPolicy(ctx context.Context, orgID string, policyName string) error {
@@ -276,7 +319,15 @@ func (s *interactor) DetachServiceControlPolicy(ctx context.Context, orgID strin
 	if err != nil {
 		return err
 	}
-	return s.orgCommands.DetachServiceControlPolicy(ctx, orgID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.orgCommands.DetachServiceControlPolicy(ctx, orgID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "organization.scp.detached", events.AuthorizationChangedV1{
+			OrgID:      orgID,
+			PolicyName: policy.Name,
+		})
+	})
 }
 
 func (s *interactor) ListServiceControlPolicies(ctx context.Context, orgID string) ([]entity.Policy, error) {
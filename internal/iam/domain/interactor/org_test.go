@@ -107,6 +107,8 @@ func TestCheckOrganizationPermission(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			allowed, err := usecase.CheckOrganizationPermission(
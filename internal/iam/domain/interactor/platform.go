@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -233,22 +234,31 @@ func (s *interactor) AttachPlatformUserPolicy(ctx context.Context, userID uint,
 	if err != nil {
 		return err
 	}
-	if err := s.policyCommands.AttachPlatformUserPolicy(ctx, userID, policy.ID); err != nil {
-		return err
-	}
-	now := time.Now().UTC()
-	record, err := newIAMEventOutboxRecord(now, "policy.attached", "", policy.Name, policy.Name, map[string]any{
-		"user_id":          userID,
-		"policy_id":        policy.ID,
-		"policy_name":      policy.Name,
-		"policy_scope":     policy.Scope,
-		"attachment_type":  "platform_user",
-		"attachment_scope": entity.PolicyScopePlatform,
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.AttachPlatformUserPolicy(ctx, userID, policy.ID); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		record, err := newIAMEventOutboxRecord(
+			now,
+			events.TypePolicyAttached,
+			"",
+			policy.Name,
+			policy.Name,
+			events.PolicyAttachedV1{
+				UserID:          userID,
+				PolicyID:        policy.ID,
+				PolicyName:      policy.Name,
+				PolicyScope:     policy.Scope,
+				AttachmentType:  "platform_user",
+				AttachmentScope: entity.PolicyScopePlatform,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.appendOutboxRecord(ctx, now, record)
 	})
-	if err != nil {
-		return err
-	}
-	return s.appendOutboxRecord(ctx, now, record)
 }
 
 func (s *interactor) DetachPlatformUserPolicy(ctx context.Context, userID uint, policyName string) error {
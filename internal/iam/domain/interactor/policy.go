@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -48,6 +49,11 @@ func (s *interactor) CreatePolicy(
 		}
 		statements = append(statements, normalized)
 	}
+	if input.Strict {
+		if err := s.requireValidPolicy(ctx, input.Statements, 0); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	return s.policyCommands.CreatePolicy(ctx, policy, statements)
 }
@@ -84,7 +90,39 @@ func (s *interactor) CreatePolicyVersion(
 		}
 		statements = append(statements, normalized)
 	}
-	return s.policyCommands.CreatePolicyVersion(ctx, policy.ID, policy.Name, statements, input.SetAsDefault)
+	if input.Strict {
+		if err := s.requireValidPolicy(ctx, input.Statements, policy.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	var (
+		version           *entity.PolicyVersion
+		versionStatements []entity.PolicyStatement
+	)
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		var createErr error
+		version, versionStatements, createErr = s.policyCommands.CreatePolicyVersion(
+			ctx,
+			policy.ID,
+			policy.Name,
+			statements,
+			input.SetAsDefault,
+		)
+		if createErr != nil {
+			return createErr
+		}
+		if !input.SetAsDefault {
+			return nil
+		}
+		return s.recordAuthorizationChanged(ctx, "", "policy.default_version.changed", events.AuthorizationChangedV1{
+			PolicyID:   policy.ID,
+			PolicyName: policy.Name,
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return version, versionStatements, nil
 }
 
 func (s *interactor) DeletePolicyVersion(ctx context.Context, ref entity.PolicyRef, version string) error {
@@ -169,7 +207,16 @@ func (s *interactor) SetDefaultPolicyVersion(
 	if version == "" {
 		return entity.ErrInvalidPolicyName
 	}
-	return s.policyCommands.SetDefaultPolicyVersion(ctx, policy.ID, version)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.SetDefaultPolicyVersion(ctx, policy.ID, version); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "policy.default_version.changed", events.AuthorizationChangedV1{
+			PolicyID:   policy.ID,
+			PolicyName: policy.Name,
+			Version:    version,
+		})
+	})
 }
 
 func (s *interactor) ListPolicyAttachments(
@@ -268,7 +315,15 @@ func (s *interactor) PutRolePermissionBoundary(ctx context.Context, roleName str
 	if role.Scope != policy.Scope {
 		return entity.ErrPermissionDenied
 	}
-	return s.roleCommands.PutPermissionBoundary(ctx, role.ID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.roleCommands.PutPermissionBoundary(ctx, role.ID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "role.permission_boundary.put", events.AuthorizationChangedV1{
+			RoleName:   role.Name,
+			PolicyName: policy.Name,
+		})
+	})
 }
 
 func (s *interactor) GetRolePermissionBoundary(
@@ -287,7 +342,14 @@ func (s *interactor) DeleteRolePermissionBoundary(ctx context.Context, roleName
 	if err != nil {
 		return err
 	}
-	return s.roleCommands.DeletePermissionBoundary(ctx, role.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.roleCommands.DeletePermissionBoundary(ctx, role.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(ctx, "", "role.permission_boundary.deleted", events.AuthorizationChangedV1{
+			RoleName: role.Name,
+		})
+	})
 }
 
 func normalizePolicyRef(ref entity.PolicyRef) (entity.PolicyRef, error) {
@@ -2,7 +2,6 @@ package interactor
 
 import (
 	"net"
-	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -104,17 +103,25 @@ func matchesCondition(condition entity.PolicyCondition, request entity.AccessReq
 	case entity.ConditionBool:
 		return strings.EqualFold(actual, condition.Value)
 	case entity.ConditionNumericEquals:
-		return compareNumeric(actual, condition.Value) == 0
+		cmp, ok := compareNumeric(actual, condition.Value)
+		return ok && cmp == 0
 	case entity.ConditionNumericGreaterThanEquals:
-		return compareNumeric(actual, condition.Value) >= 0
+		cmp, ok := compareNumeric(actual, condition.Value)
+		return ok && cmp >= 0
 	case entity.ConditionNumericLessThanEquals:
-		return compareNumeric(actual, condition.Value) <= 0
+		cmp, ok := compareNumeric(actual, condition.Value)
+		return ok && cmp <= 0
 	case entity.ConditionDateGreaterThan:
-		return compareTime(actual, condition.Value) > 0
+		cmp, ok := compareTime(actual, condition.Value)
+		return ok && cmp > 0
 	case entity.ConditionDateLessThan:
-		return compareTime(actual, condition.Value) < 0
+		cmp, ok := compareTime(actual, condition.Value)
+		return ok && cmp < 0
 	case entity.ConditionIpAddress:
 		return matchesIPNet(actual, condition.Value)
+	case entity.ConditionNotIpAddress:
+		// Like the other negated operators, a missing source IP counts as outside the range.
+		return !matchesIPNet(actual, condition.Value)
 	case entity.ConditionNull:
 		wantNull := strings.EqualFold(condition.Value, "true")
 		return (actual == "") == wantNull
@@ -143,6 +150,12 @@ func requestAttribute(request entity.AccessRequest, key string) string {
 		return request.Action
 	case "resource":
 		return request.Resource
+	case entity.ConditionKeyMultiFactorAuthPresent, entity.ConditionKeySecureTransport:
+		return "false"
+	case entity.ConditionKeyCurrentTime:
+		return time.Now().UTC().Format(time.RFC3339)
+	case entity.ConditionKeyEpochTime:
+		return strconv.FormatInt(time.Now().Unix(), 10)
 	default:
 		if strings.HasPrefix(key, "aws:PrincipalTag/") || strings.HasPrefix(key, "principal_tag:") {
 			tagKey := strings.TrimPrefix(strings.TrimPrefix(key, "aws:PrincipalTag/"), "principal_tag:")
@@ -161,45 +174,40 @@ func requestAttribute(request entity.AccessRequest, key string) string {
 }
 
 func matchesPattern(pattern string, value string) bool {
-	if pattern == "" || pattern == "*" {
-		return true
-	}
-	ok, err := path.Match(pattern, value)
-	if err != nil {
-		return pattern == value
-	}
-	return ok
+	return entity.PatternMatches(pattern, value)
 }
 
-func compareNumeric(actual string, expected string) int {
+// compareNumeric reports false when either side is not a number, so a missing key never
+// satisfies a numeric condition.
+func compareNumeric(actual string, expected string) (int, bool) {
 	a, errA := strconv.ParseFloat(actual, 64)
 	b, errB := strconv.ParseFloat(expected, 64)
 	if errA != nil || errB != nil {
-		return -2
+		return 0, false
 	}
 	switch {
 	case a < b:
-		return -1
+		return -1, true
 	case a > b:
-		return 1
+		return 1, true
 	default:
-		return 0
+		return 0, true
 	}
 }
 
-func compareTime(actual string, expected string) int {
+func compareTime(actual string, expected string) (int, bool) {
 	a, errA := time.Parse(time.RFC3339, actual)
 	b, errB := time.Parse(time.RFC3339, expected)
 	if errA != nil || errB != nil {
-		return -2
+		return 0, false
 	}
 	switch {
 	case a.Before(b):
-		return -1
+		return -1, true
 	case a.After(b):
-		return 1
+		return 1, true
 	default:
-		return 0
+		return 0, true
 	}
 }
 
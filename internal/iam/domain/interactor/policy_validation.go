@@ -0,0 +1,140 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+// ValidatePolicy lints a policy document against the permission catalog and, when it names
+// an existing policy, the permission boundaries of the principals that policy is attached to.
+func (s *interactor) ValidatePolicy(
+	ctx context.Context,
+	input entity.ValidatePolicyInput,
+) ([]entity.PolicyFinding, error) {
+	if len(input.Statements) == 0 {
+		return nil, fmt.Errorf("%w: at least one policy statement is required", entity.ErrInvalidPolicyStatement)
+	}
+	var policyID uint64
+	if name := strings.TrimSpace(input.PolicyName); name != "" {
+		ref, err := normalizePolicyRef(entity.PolicyRef{Scope: input.Scope, OrgID: input.OrgID, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		policy, err := s.policyQueries.GetPolicy(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		policyID = policy.ID
+	}
+	return s.lintPolicy(ctx, input.Statements, policyID)
+}
+
+// requireValidPolicy backs strict mode: it rejects a document with error findings.
+func (s *interactor) requireValidPolicy(
+	ctx context.Context,
+	statements []entity.PolicyStatement,
+	policyID uint64,
+) error {
+	findings, err := s.lintPolicy(ctx, statements, policyID)
+	if err != nil {
+		return err
+	}
+	if errs := entity.PolicyFindingErrors(findings); len(errs) > 0 {
+		return &entity.PolicyValidationError{Findings: errs}
+	}
+	return nil
+}
+
+func (s *interactor) lintPolicy(
+	ctx context.Context,
+	statements []entity.PolicyStatement,
+	policyID uint64,
+) ([]entity.PolicyFinding, error) {
+	actions, err := s.permissionCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var boundaries []entity.PolicyLintBoundary
+	if policyID != 0 {
+		boundaries, err = s.attachedPrincipalBoundaries(ctx, policyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entity.LintPolicy(entity.PolicyLintInput{
+		Statements: statements,
+		Actions:    actions,
+		Boundaries: boundaries,
+	}), nil
+}
+
+func (s *interactor) permissionCatalog(ctx context.Context) ([]string, error) {
+	actions := make([]string, 0)
+	query := collection.Query{Page: collection.DefaultPage, PageSize: collection.MaxPageSize}
+	for {
+		page, err := s.roleQueries.ListPermissions(ctx, query.Normalize())
+		if err != nil {
+			return nil, err
+		}
+		for _, permission := range page.Items {
+			actions = append(actions, permission.Name)
+		}
+		if !page.HasNext {
+			return actions, nil
+		}
+		query.Page++
+	}
+}
+
+// attachedPrincipalBoundaries loads the permission boundaries of the roles and users the
+// policy is attached to. Groups have no boundary of their own.
+func (s *interactor) attachedPrincipalBoundaries(
+	ctx context.Context,
+	policyID uint64,
+) ([]entity.PolicyLintBoundary, error) {
+	boundaries := make([]entity.PolicyLintBoundary, 0)
+	query := collection.Query{Page: collection.DefaultPage, PageSize: collection.MaxPageSize}
+	for {
+		page, err := s.policyQueries.ListPolicyAttachments(ctx, policyID, query.Normalize())
+		if err != nil {
+			return nil, err
+		}
+		for _, attachment := range page.Items {
+			var (
+				name       string
+				statements []entity.PolicyStatement
+			)
+			switch attachment.AttachmentType {
+			case "role":
+				name = "role " + attachment.RoleName
+				statements, err = s.roleQueries.GetPermissionBoundaryStatements(ctx, attachment.RoleID)
+			case "platform_user":
+				name = fmt.Sprintf("platform user %d", attachment.UserID)
+				statements, err = s.policyQueries.GetPlatformUserPermissionBoundaryStatements(ctx, attachment.UserID)
+			case "tenant_user":
+				name = fmt.Sprintf("user %d in tenant %s", attachment.UserID, attachment.TenantID)
+				statements, err = s.policyQueries.GetTenantUserPermissionBoundaryStatements(
+					ctx,
+					attachment.TenantID,
+					attachment.UserID,
+				)
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if len(statements) > 0 {
+				boundaries = append(boundaries, entity.PolicyLintBoundary{Name: name, Statements: statements})
+			}
+		}
+		if !page.HasNext {
+			return boundaries, nil
+		}
+		query.Page++
+	}
+}
@@ -0,0 +1,135 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+func policyFindingCodes(findings []entity.PolicyFinding) []string {
+	codes := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		codes = append(codes, finding.Code)
+	}
+	return codes
+}
+
+func TestIAMService_ValidatePolicyReportsFindings(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+	state.permissions = []entity.Permission{
+		{ID: 1, Name: "order:read"},
+		{ID: 2, Name: "order:update"},
+	}
+
+	findings, err := svc.ValidatePolicy(context.Background(), entity.ValidatePolicyInput{
+		Scope: entity.PolicyScopeTenant,
+		Statements: []entity.PolicyStatement{
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:read", ResourcePattern: "*"},
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:refund", ResourcePattern: "*"},
+			{
+				Effect:          entity.PolicyEffectAllow,
+				ActionPattern:   "order:read",
+				ResourcePattern: "*",
+				Conditions: []entity.PolicyCondition{
+					{Operator: "StringMaybe", Key: "tenant_id", Value: "t-1"},
+					{Operator: entity.ConditionIpAddress, Key: entity.ConditionKeySourceIp, Value: "not-a-cidr"},
+				},
+			},
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "*:*", ResourcePattern: "*"},
+			{Effect: entity.PolicyEffectDeny, ActionPattern: "order:*", ResourcePattern: "*"},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, policyFindingCodes(findings), entity.PolicyFindingUnknownAction)
+	require.Contains(t, policyFindingCodes(findings), entity.PolicyFindingUnknownConditionOperator)
+	require.Contains(t, policyFindingCodes(findings), entity.PolicyFindingInvalidConditionValue)
+	require.Contains(t, policyFindingCodes(findings), entity.PolicyFindingBroadGrant)
+	require.Contains(t, policyFindingCodes(findings), entity.PolicyFindingShadowedAllow)
+	for i := 1; i < len(findings); i++ {
+		require.LessOrEqual(t, findings[i-1].StatementIndex, findings[i].StatementIndex)
+	}
+
+	_, err = svc.ValidatePolicy(context.Background(), entity.ValidatePolicyInput{Scope: entity.PolicyScopeTenant})
+	require.ErrorIs(t, err, entity.ErrInvalidPolicyStatement)
+}
+
+func TestIAMService_ValidatePolicyChecksAttachedBoundaries(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+	state.permissions = []entity.Permission{
+		{ID: 1, Name: "order:read"},
+		{ID: 2, Name: "order:update"},
+	}
+	policy, _, err := svc.CreatePolicy(context.Background(), entity.CreatePolicyInput{
+		Scope: entity.PolicyScopeTenant,
+		Name:  "tenant/orders",
+		Statements: []entity.PolicyStatement{
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:read", ResourcePattern: "*"},
+		},
+	})
+	require.NoError(t, err)
+	state.policyAttachments[policy.ID] = []entity.PolicyAttachment{
+		{AttachmentType: "role", RoleID: 2, RoleName: "support"},
+		{AttachmentType: "group", GroupID: 5, GroupName: "ops"},
+	}
+	state.roleBoundaryStmts[2] = []entity.PolicyStatement{
+		{Effect: entity.PolicyEffectAllow, ActionPattern: "order:read", ResourcePattern: "*"},
+	}
+
+	findings, err := svc.ValidatePolicy(context.Background(), entity.ValidatePolicyInput{
+		Scope:      entity.PolicyScopeTenant,
+		PolicyName: "tenant/orders",
+		Statements: []entity.PolicyStatement{
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:read", ResourcePattern: "*"},
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:update", ResourcePattern: "*"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, entity.PolicyFindingBoundaryConflict, findings[0].Code)
+	require.Equal(t, entity.PolicyFindingSeverityWarning, findings[0].Severity)
+	require.Equal(t, 1, findings[0].StatementIndex)
+	require.Contains(t, findings[0].Message, "role support")
+}
+
+func TestIAMService_CreatePolicyStrictRejectsErrorFindings(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+	state.permissions = []entity.Permission{{ID: 1, Name: "order:read"}}
+	input := entity.CreatePolicyInput{
+		Scope:  entity.PolicyScopeTenant,
+		Name:   "tenant/orders",
+		Strict: true,
+		Statements: []entity.PolicyStatement{
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:refund", ResourcePattern: "*"},
+		},
+	}
+
+	_, _, err := svc.CreatePolicy(context.Background(), input)
+	require.ErrorIs(t, err, entity.ErrPolicyValidationFailed)
+	var validationErr *entity.PolicyValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Equal(t, []string{entity.PolicyFindingUnknownAction}, policyFindingCodes(validationErr.Findings))
+	require.NotContains(t, state.policiesByName, "tenant/orders")
+
+	input.Statements[0].ActionPattern = "order:*"
+	policy, _, err := svc.CreatePolicy(context.Background(), input)
+	require.NoError(t, err)
+
+	_, _, err = svc.CreatePolicyVersion(context.Background(), entity.CreatePolicyVersionInput{
+		Scope:      entity.PolicyScopeTenant,
+		PolicyName: policy.Name,
+		Strict:     true,
+		Statements: []entity.PolicyStatement{
+			{Effect: entity.PolicyEffectAllow, ActionPattern: "order:refund", ResourcePattern: "*"},
+		},
+	})
+	require.ErrorIs(t, err, entity.ErrPolicyValidationFailed)
+}
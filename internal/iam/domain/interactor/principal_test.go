@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -164,12 +165,14 @@ func TestIAMService_GroupPoliciesAffectPermission(t *testing.T) {
 	require.NotNil(t, group)
 	require.NoError(t, svc.AddGroupMember(context.Background(), group.ID, 9))
 	require.NoError(t, svc.AttachGroupPolicy(context.Background(), group.ID, "tenant/orders_editor"))
-	require.Len(t, state.outboxRecords, 1)
-	require.Equal(t, "policy.attached", state.outboxRecords[0].Envelope.Type)
+	require.Len(t, state.outboxRecords, 2)
+	require.Equal(t, iaminteractor.EventAuthorizationChanged, state.outboxRecords[0].Envelope.Type)
+	require.Equal(t, "t1", state.outboxRecords[0].Envelope.TenantID)
+	require.Equal(t, "policy.attached", state.outboxRecords[1].Envelope.Type)
 	require.NoError(t, svc.RequirePermission(context.Background(), "t1", 9, "order:update"))
 
 	var payload map[string]any
-	require.NoError(t, json.Unmarshal(state.outboxRecords[0].Envelope.Payload, &payload))
+	require.NoError(t, json.Unmarshal(state.outboxRecords[1].Envelope.Payload, &payload))
 	require.Equal(t, "group", payload["attachment_type"])
 	require.Equal(t, "tenant/orders_editor", payload["policy_name"])
 	require.Equal(t, "ops-team", payload["group_name"])
@@ -0,0 +1,156 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdsaml"
+)
+
+type samlConnectionInteractor struct {
+	connections outputport.SAMLConnectionRepository
+	orgQueries  outputport.OrganizationQueryRepository
+}
+
+var _ inputport.SAMLConnectionUsecase = (*samlConnectionInteractor)(nil)
+
+func NewSAMLConnectionInteractor(
+	connections outputport.SAMLConnectionRepository,
+	orgQueries outputport.OrganizationQueryRepository,
+) inputport.SAMLConnectionUsecase {
+	return &samlConnectionInteractor{connections: connections, orgQueries: orgQueries}
+}
+
+func (s *samlConnectionInteractor) PutSAMLConnection(
+	ctx context.Context,
+	orgID string,
+	input entity.PutSAMLConnectionInput,
+) (*entity.SAMLConnection, error) {
+	org, err := s.orgQueries.GetByID(ctx, strings.TrimSpace(orgID))
+	if err != nil {
+		return nil, err
+	}
+	metadataXML := strings.TrimSpace(input.MetadataXML)
+	metadata, err := pdsaml.ParseIdPMetadata([]byte(metadataXML))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrSAMLMetadataInvalid, err)
+	}
+	now := time.Now().UTC()
+	return s.connections.PutConnection(ctx, entity.SAMLConnection{
+		OrgID:             org.ID,
+		IdPEntityID:       metadata.EntityID,
+		SSOURL:            metadata.SSOURL,
+		MetadataXML:       metadataXML,
+		UsernameAttribute: strings.TrimSpace(input.UsernameAttribute),
+		EmailAttribute:    strings.TrimSpace(input.EmailAttribute),
+		GroupsAttribute:   strings.TrimSpace(input.GroupsAttribute),
+		SSORequired:       input.SSORequired,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+}
+
+func (s *samlConnectionInteractor) GetSAMLConnection(ctx context.Context, orgID string) (*entity.SAMLConnection, error) {
+	return s.connections.GetConnection(ctx, strings.TrimSpace(orgID))
+}
+
+func (s *samlConnectionInteractor) DeleteSAMLConnection(ctx context.Context, orgID string) error {
+	return s.connections.DeleteConnection(ctx, strings.TrimSpace(orgID))
+}
+
+func (s *samlConnectionInteractor) SSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error) {
+	return s.connections.ListSSORequiredOrganizations(ctx, userID)
+}
+
+func (s *samlConnectionInteractor) OrganizationMembership(
+	ctx context.Context,
+	orgID string,
+	userID uint,
+) (*entity.OrganizationMembership, error) {
+	return s.orgQueries.GetMembership(ctx, strings.TrimSpace(orgID), userID)
+}
+
+type samlLoginInteractor struct {
+	connections outputport.SAMLConnectionRepository
+	orgQueries  outputport.OrganizationQueryRepository
+	commands    inputport.IAMCommandUsecase
+}
+
+var _ inputport.SAMLLoginUsecase = (*samlLoginInteractor)(nil)
+
+// NewSAMLLoginInteractor builds the login usecase. Membership and group changes go through
+// the IAM command usecase so SSO follows the same rules as the admin API.
+func NewSAMLLoginInteractor(
+	connections outputport.SAMLConnectionRepository,
+	orgQueries outputport.OrganizationQueryRepository,
+	commands inputport.IAMCommandUsecase,
+) inputport.SAMLLoginUsecase {
+	return &samlLoginInteractor{connections: connections, orgQueries: orgQueries, commands: commands}
+}
+
+func (s *samlLoginInteractor) ApplySAMLLogin(ctx context.Context, orgID string, userID uint, groups []string) error {
+	connection, err := s.connections.GetConnection(ctx, strings.TrimSpace(orgID))
+	if err != nil {
+		return err
+	}
+	if err := ensureActiveOrganizationMember(
+		ctx,
+		s.orgQueries,
+		s.commands,
+		connection.OrgID,
+		userID,
+		entity.SAMLDefaultOrganizationRole,
+	); err != nil {
+		return err
+	}
+	if connection.GroupsAttribute == "" {
+		return nil
+	}
+	return s.syncGroups(ctx, connection.OrgID, userID, groups)
+}
+
+// syncGroups treats the asserted group names as the user's complete set of organization
+// groups. Names without a matching organization group are ignored; SSO never creates groups.
+func (s *samlLoginInteractor) syncGroups(ctx context.Context, orgID string, userID uint, groups []string) error {
+	asserted := make(map[string]bool, len(groups))
+	for _, name := range groups {
+		asserted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	orgGroups, err := s.connections.ListOrganizationGroups(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	desired := make(map[uint64]bool)
+	for _, group := range orgGroups {
+		if asserted[strings.ToLower(group.Name)] {
+			desired[group.ID] = true
+		}
+	}
+	current, err := s.connections.ListUserOrganizationGroupIDs(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	for _, groupID := range current {
+		if desired[groupID] {
+			delete(desired, groupID)
+			continue
+		}
+		if err := s.commands.RemoveGroupMember(ctx, groupID, userID); err != nil {
+			return err
+		}
+	}
+	for _, group := range orgGroups {
+		if !desired[group.ID] {
+			continue
+		}
+		if err := s.commands.AddGroupMember(ctx, group.ID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
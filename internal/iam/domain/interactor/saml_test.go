@@ -0,0 +1,70 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	inputportmocks "github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+)
+
+func TestPutSAMLConnectionRejectsInvalidMetadata(t *testing.T) {
+	connections := outputportmocks.NewMockSAMLConnectionRepository(t)
+	orgQueries := outputportmocks.NewMockOrganizationQueryRepository(t)
+	ctx := context.Background()
+
+	orgQueries.EXPECT().GetByID(ctx, "org-1").Return(&entity.Organization{ID: "org-1"}, nil)
+
+	_, err := iaminteractor.NewSAMLConnectionInteractor(connections, orgQueries).
+		PutSAMLConnection(ctx, "org-1", entity.PutSAMLConnectionInput{MetadataXML: "<EntityDescriptor/>"})
+	require.ErrorIs(t, err, entity.ErrSAMLMetadataInvalid)
+}
+
+func TestApplySAMLLoginAddsMemberAndSyncsGroups(t *testing.T) {
+	connections := outputportmocks.NewMockSAMLConnectionRepository(t)
+	orgQueries := outputportmocks.NewMockOrganizationQueryRepository(t)
+	commands := inputportmocks.NewMockIAMCommandUsecase(t)
+	ctx := context.Background()
+
+	connections.EXPECT().
+		GetConnection(ctx, "org-1").
+		Return(&entity.SAMLConnection{OrgID: "org-1", GroupsAttribute: "groups"}, nil)
+	orgQueries.EXPECT().
+		GetMembership(ctx, "org-1", uint(7)).
+		Return(nil, entity.ErrOrganizationMembershipNotFound)
+	commands.EXPECT().
+		AddOrganizationMember(ctx, "org-1", uint(7), entity.SAMLDefaultOrganizationRole).
+		Return(nil)
+	connections.EXPECT().ListOrganizationGroups(ctx, "org-1").Return([]entity.Group{
+		{ID: 1, Name: "Engineering"},
+		{ID: 2, Name: "Finance"},
+		{ID: 3, Name: "Support"},
+	}, nil)
+	connections.EXPECT().ListUserOrganizationGroupIDs(ctx, "org-1", uint(7)).Return([]uint64{2, 3}, nil)
+	commands.EXPECT().RemoveGroupMember(ctx, uint64(2), uint(7)).Return(nil)
+	commands.EXPECT().AddGroupMember(ctx, uint64(1), uint(7)).Return(nil)
+
+	err := iaminteractor.NewSAMLLoginInteractor(connections, orgQueries, commands).
+		ApplySAMLLogin(ctx, "org-1", 7, []string{"engineering", " Support", "Unknown"})
+	require.NoError(t, err)
+}
+
+func TestApplySAMLLoginSkipsGroupsWithoutMapping(t *testing.T) {
+	connections := outputportmocks.NewMockSAMLConnectionRepository(t)
+	orgQueries := outputportmocks.NewMockOrganizationQueryRepository(t)
+	commands := inputportmocks.NewMockIAMCommandUsecase(t)
+	ctx := context.Background()
+
+	connections.EXPECT().GetConnection(ctx, "org-1").Return(&entity.SAMLConnection{OrgID: "org-1"}, nil)
+	orgQueries.EXPECT().
+		GetMembership(ctx, "org-1", uint(7)).
+		Return(&entity.OrganizationMembership{Status: entity.MembershipStatusActive}, nil)
+
+	err := iaminteractor.NewSAMLLoginInteractor(connections, orgQueries, commands).
+		ApplySAMLLogin(ctx, "org-1", 7, []string{"Engineering"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,441 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+// scimTokenPrefixLength is how much of a raw token is kept to tell tokens apart in listings.
+const scimTokenPrefixLength = len(entity.SCIMTokenPrefix) + 6
+
+type scimTokenInteractor struct {
+	tokens     outputport.SCIMTokenRepository
+	orgQueries outputport.OrganizationQueryRepository
+}
+
+var _ inputport.SCIMTokenUsecase = (*scimTokenInteractor)(nil)
+
+func NewSCIMTokenInteractor(
+	tokens outputport.SCIMTokenRepository,
+	orgQueries outputport.OrganizationQueryRepository,
+) inputport.SCIMTokenUsecase {
+	return &scimTokenInteractor{tokens: tokens, orgQueries: orgQueries}
+}
+
+func (s *scimTokenInteractor) CreateSCIMToken(
+	ctx context.Context,
+	orgID, name string,
+	createdByUserID uint,
+) (*entity.SCIMToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", entity.ErrSCIMTokenNameRequired
+	}
+	org, err := s.orgQueries.GetByID(ctx, strings.TrimSpace(orgID))
+	if err != nil {
+		return nil, "", err
+	}
+	raw, err := entity.NewSCIMToken()
+	if err != nil {
+		return nil, "", err
+	}
+	token := entity.SCIMToken{
+		ID:              uuid.NewString(),
+		OrgID:           org.ID,
+		Name:            name,
+		TokenHash:       entity.HashSCIMToken(raw),
+		TokenPrefix:     raw[:scimTokenPrefixLength],
+		CreatedByUserID: createdByUserID,
+		CreatedAt:       time.Now().UTC(),
+	}
+	if err := s.tokens.CreateToken(ctx, token); err != nil {
+		return nil, "", err
+	}
+	return &token, raw, nil
+}
+
+func (s *scimTokenInteractor) ListSCIMTokens(ctx context.Context, orgID string) ([]entity.SCIMToken, error) {
+	return s.tokens.ListTokens(ctx, strings.TrimSpace(orgID))
+}
+
+func (s *scimTokenInteractor) RevokeSCIMToken(ctx context.Context, orgID, tokenID string) error {
+	return s.tokens.RevokeToken(ctx, strings.TrimSpace(orgID), strings.TrimSpace(tokenID), time.Now().UTC())
+}
+
+func (s *scimTokenInteractor) AuthenticateSCIMToken(ctx context.Context, rawToken string) (*entity.SCIMToken, error) {
+	rawToken = strings.TrimSpace(rawToken)
+	if !strings.HasPrefix(rawToken, entity.SCIMTokenPrefix) {
+		return nil, entity.ErrSCIMTokenInvalid
+	}
+	token, err := s.tokens.GetTokenByHash(ctx, entity.HashSCIMToken(rawToken))
+	if errors.Is(err, entity.ErrSCIMTokenNotFound) {
+		return nil, entity.ErrSCIMTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token.RevokedAt != nil {
+		return nil, entity.ErrSCIMTokenInvalid
+	}
+	// Last-used tracking is informational; a failed write must not reject the request.
+	_ = s.tokens.TouchToken(ctx, token.ID, time.Now().UTC())
+	return token, nil
+}
+
+type scimInteractor struct {
+	scim          outputport.SCIMRepository
+	orgQueries    outputport.OrganizationQueryRepository
+	commands      inputport.IAMCommandUsecase
+	userDirectory outputport.UserDirectory
+}
+
+var _ inputport.SCIMUsecase = (*scimInteractor)(nil)
+
+// NewSCIMInteractor builds the provisioning usecase. Organization and group membership
+// changes go through the IAM command usecase so SCIM follows the same rules as the admin API.
+func NewSCIMInteractor(
+	scim outputport.SCIMRepository,
+	orgQueries outputport.OrganizationQueryRepository,
+	commands inputport.IAMCommandUsecase,
+	userDirectory outputport.UserDirectory,
+) inputport.SCIMUsecase {
+	return &scimInteractor{
+		scim:          scim,
+		orgQueries:    orgQueries,
+		commands:      commands,
+		userDirectory: userDirectory,
+	}
+}
+
+func (s *scimInteractor) CreateSCIMUser(
+	ctx context.Context,
+	orgID string,
+	input entity.SCIMUserInput,
+) (*entity.SCIMUser, error) {
+	userName := strings.TrimSpace(input.UserName)
+	email := entity.NormalizeInviteEmail(input.Email)
+	if email == "" {
+		email = entity.NormalizeInviteEmail(userName)
+	}
+	if userName == "" || !strings.Contains(email, "@") {
+		return nil, entity.ErrSCIMInvalidUserName
+	}
+	if _, err := s.orgQueries.GetByID(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if _, err := s.scim.GetUserByUserName(ctx, orgID, userName); err == nil {
+		return nil, entity.ErrSCIMUserExists
+	} else if !errors.Is(err, entity.ErrSCIMUserNotFound) {
+		return nil, err
+	}
+	user, created, err := s.userDirectory.EnsureByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// The account belongs to whoever registered it. Linking it would let this organization's
+		// identity provider rename it and end its access.
+		return nil, entity.ErrSCIMUserNotOwned
+	}
+	if input.Active {
+		if err := s.activate(ctx, orgID, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.updateProfile(ctx, user.ID, entity.SCIMUser{OwnsAccount: true}, input); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	link := entity.SCIMUser{
+		OrgID:       orgID,
+		UserID:      user.ID,
+		ExternalID:  strings.TrimSpace(input.ExternalID),
+		UserName:    userName,
+		Email:       email,
+		GivenName:   strings.TrimSpace(input.GivenName),
+		FamilyName:  strings.TrimSpace(input.FamilyName),
+		DisplayName: strings.TrimSpace(input.DisplayName),
+		Active:      input.Active,
+		OwnsAccount: true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.scim.CreateUser(ctx, link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (s *scimInteractor) GetSCIMUser(ctx context.Context, orgID string, userID uint) (*entity.SCIMUser, error) {
+	return s.scim.GetUser(ctx, orgID, userID)
+}
+
+func (s *scimInteractor) ListSCIMUsers(ctx context.Context, orgID string) ([]entity.SCIMUser, error) {
+	return s.scim.ListUsers(ctx, orgID)
+}
+
+func (s *scimInteractor) ReplaceSCIMUser(
+	ctx context.Context,
+	orgID string,
+	userID uint,
+	input entity.SCIMUserInput,
+) (*entity.SCIMUser, error) {
+	link, err := s.scim.GetUser(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	userName := strings.TrimSpace(input.UserName)
+	if userName != "" && entity.NormalizeSCIMUserName(userName) != entity.NormalizeSCIMUserName(link.UserName) {
+		// userName is the email the directory account was matched on; re-pointing it would
+		// hand this account to whoever owns the new address.
+		return nil, entity.ErrSCIMUserNameImmutable
+	}
+	switch {
+	case input.Active && !link.Active:
+		if err := s.activate(ctx, orgID, userID); err != nil {
+			return nil, err
+		}
+	case !input.Active && link.Active:
+		if err := s.deprovision(ctx, orgID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.updateProfile(ctx, userID, *link, input); err != nil {
+		return nil, err
+	}
+	if userName != "" {
+		link.UserName = userName
+	}
+	link.ExternalID = strings.TrimSpace(input.ExternalID)
+	link.GivenName = strings.TrimSpace(input.GivenName)
+	link.FamilyName = strings.TrimSpace(input.FamilyName)
+	link.DisplayName = strings.TrimSpace(input.DisplayName)
+	link.Active = input.Active
+	link.UpdatedAt = time.Now().UTC()
+	if err := s.scim.UpdateUser(ctx, *link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (s *scimInteractor) DeleteSCIMUser(ctx context.Context, orgID string, userID uint) error {
+	link, err := s.scim.GetUser(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if link.Active {
+		if err := s.deprovision(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+	return s.scim.DeleteUser(ctx, orgID, userID)
+}
+
+func (s *scimInteractor) activate(ctx context.Context, orgID string, userID uint) error {
+	return ensureActiveOrganizationMember(
+		ctx,
+		s.orgQueries,
+		s.commands,
+		orgID,
+		userID,
+		entity.SCIMDefaultOrganizationRole,
+	)
+}
+
+// ensureActiveOrganizationMember grants organization membership with defaultRole, keeping
+// the role of an existing membership.
+func ensureActiveOrganizationMember(
+	ctx context.Context,
+	orgQueries outputport.OrganizationQueryRepository,
+	commands inputport.IAMCommandUsecase,
+	orgID string,
+	userID uint,
+	defaultRole string,
+) error {
+	roleName := defaultRole
+	membership, err := orgQueries.GetMembership(ctx, orgID, userID)
+	switch {
+	case err == nil && membership.Status == entity.MembershipStatusActive:
+		return nil
+	case err == nil:
+		roleName = membership.RoleName
+	case !errors.Is(err, entity.ErrOrganizationMembershipNotFound):
+		return err
+	}
+	return commands.AddOrganizationMember(ctx, orgID, userID, roleName)
+}
+
+// deprovision removes every organization grant of the user and revokes the sessions and API
+// keys bound to the organization's tenants so access ends now rather than when the current
+// access token expires. Access the account holds outside the organization is kept.
+func (s *scimInteractor) deprovision(ctx context.Context, orgID string, userID uint) error {
+	err := s.commands.RemoveOrganizationMember(ctx, orgID, userID)
+	if err != nil && !errors.Is(err, entity.ErrOrganizationMembershipNotFound) {
+		return err
+	}
+	if err := s.scim.RemoveOrganizationGroupMemberships(ctx, orgID, userID); err != nil {
+		return err
+	}
+	tenantIDs, err := s.orgQueries.ListTenantIDs(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	err = s.userDirectory.Deprovision(ctx, userID, tenantIDs)
+	if err != nil && !errors.Is(err, entity.ErrUserNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *scimInteractor) updateProfile(
+	ctx context.Context,
+	userID uint,
+	current entity.SCIMUser,
+	input entity.SCIMUserInput,
+) error {
+	if !current.OwnsAccount {
+		return nil
+	}
+	givenName := strings.TrimSpace(input.GivenName)
+	familyName := strings.TrimSpace(input.FamilyName)
+	displayName := strings.TrimSpace(input.DisplayName)
+	if givenName == current.GivenName && familyName == current.FamilyName && displayName == current.DisplayName {
+		return nil
+	}
+	_, err := s.userDirectory.UpdateProfile(ctx, userID, givenName, familyName, displayName)
+	return err
+}
+
+func (s *scimInteractor) CreateSCIMGroup(
+	ctx context.Context,
+	orgID string,
+	input entity.SCIMGroupInput,
+) (*entity.SCIMGroup, error) {
+	name := strings.TrimSpace(input.DisplayName)
+	if name == "" {
+		return nil, entity.ErrSCIMInvalidGroupName
+	}
+	if _, err := s.orgQueries.GetByID(ctx, orgID); err != nil {
+		return nil, err
+	}
+	members := uniqueMembers(input.Members)
+	if err := s.requireActiveMembers(ctx, orgID, members); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	group, err := s.scim.CreateGroup(ctx, entity.SCIMGroup{
+		OrgID:       orgID,
+		ExternalID:  strings.TrimSpace(input.ExternalID),
+		DisplayName: name,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range members {
+		if err := s.commands.AddGroupMember(ctx, group.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.scim.GetGroup(ctx, orgID, group.GroupID)
+}
+
+func (s *scimInteractor) GetSCIMGroup(ctx context.Context, orgID string, groupID uint64) (*entity.SCIMGroup, error) {
+	return s.scim.GetGroup(ctx, orgID, groupID)
+}
+
+func (s *scimInteractor) ListSCIMGroups(ctx context.Context, orgID string) ([]entity.SCIMGroup, error) {
+	return s.scim.ListGroups(ctx, orgID)
+}
+
+func (s *scimInteractor) ReplaceSCIMGroup(
+	ctx context.Context,
+	orgID string,
+	groupID uint64,
+	input entity.SCIMGroupInput,
+) (*entity.SCIMGroup, error) {
+	name := strings.TrimSpace(input.DisplayName)
+	if name == "" {
+		return nil, entity.ErrSCIMInvalidGroupName
+	}
+	group, err := s.scim.GetGroup(ctx, orgID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	desired := uniqueMembers(input.Members)
+	var added []uint
+	for _, userID := range desired {
+		if !slices.Contains(group.Members, userID) {
+			added = append(added, userID)
+		}
+	}
+	if err := s.requireActiveMembers(ctx, orgID, added); err != nil {
+		return nil, err
+	}
+	externalID := strings.TrimSpace(input.ExternalID)
+	if name != group.DisplayName || externalID != group.ExternalID {
+		group.DisplayName = name
+		group.ExternalID = externalID
+		group.UpdatedAt = time.Now().UTC()
+		if err := s.scim.UpdateGroup(ctx, *group); err != nil {
+			return nil, err
+		}
+	}
+	for _, userID := range group.Members {
+		if !slices.Contains(desired, userID) {
+			if err := s.commands.RemoveGroupMember(ctx, groupID, userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, userID := range added {
+		if err := s.commands.AddGroupMember(ctx, groupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.scim.GetGroup(ctx, orgID, groupID)
+}
+
+func (s *scimInteractor) DeleteSCIMGroup(ctx context.Context, orgID string, groupID uint64) error {
+	if _, err := s.scim.GetGroup(ctx, orgID, groupID); err != nil {
+		return err
+	}
+	return s.commands.DeleteGroup(ctx, groupID)
+}
+
+// requireActiveMembers checks up front that every new member is an active member of the
+// organization, so a bad member reference fails the request before anything changes.
+func (s *scimInteractor) requireActiveMembers(ctx context.Context, orgID string, userIDs []uint) error {
+	for _, userID := range userIDs {
+		membership, err := s.orgQueries.GetMembership(ctx, orgID, userID)
+		if errors.Is(err, entity.ErrOrganizationMembershipNotFound) {
+			return entity.ErrSCIMInvalidMember
+		}
+		if err != nil {
+			return err
+		}
+		if membership.Status != entity.MembershipStatusActive {
+			return entity.ErrSCIMInvalidMember
+		}
+	}
+	return nil
+}
+
+func uniqueMembers(userIDs []uint) []uint {
+	out := make([]uint, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID != 0 && !slices.Contains(out, userID) {
+			out = append(out, userID)
+		}
+	}
+	return out
+}
@@ -0,0 +1,220 @@
+package interactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	inputportmocks "github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
+	iaminteractor "github.com/tuannm99/podzone/internal/iam/domain/interactor"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+)
+
+type scimTestDeps struct {
+	scim          *outputportmocks.MockSCIMRepository
+	orgQueries    *outputportmocks.MockOrganizationQueryRepository
+	commands      *inputportmocks.MockIAMCommandUsecase
+	userDirectory *outputportmocks.MockUserDirectory
+}
+
+func newSCIMTestDeps(t *testing.T) scimTestDeps {
+	t.Helper()
+	return scimTestDeps{
+		scim:          outputportmocks.NewMockSCIMRepository(t),
+		orgQueries:    outputportmocks.NewMockOrganizationQueryRepository(t),
+		commands:      inputportmocks.NewMockIAMCommandUsecase(t),
+		userDirectory: outputportmocks.NewMockUserDirectory(t),
+	}
+}
+
+func (d scimTestDeps) usecase() inputport.SCIMUsecase {
+	return iaminteractor.NewSCIMInteractor(d.scim, d.orgQueries, d.commands, d.userDirectory)
+}
+
+func TestCreateSCIMUserProvisionsDirectoryUserAndMembership(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.orgQueries.EXPECT().GetByID(ctx, "org-1").Return(&entity.Organization{ID: "org-1"}, nil)
+	deps.scim.EXPECT().
+		GetUserByUserName(ctx, "org-1", "Bjensen@Example.com").
+		Return(nil, entity.ErrSCIMUserNotFound)
+	deps.userDirectory.EXPECT().
+		EnsureByEmail(ctx, "bjensen@example.com").
+		Return(&entity.User{ID: 42, Email: "bjensen@example.com"}, true, nil)
+	deps.orgQueries.EXPECT().
+		GetMembership(ctx, "org-1", uint(42)).
+		Return(nil, entity.ErrOrganizationMembershipNotFound)
+	deps.commands.EXPECT().
+		AddOrganizationMember(ctx, "org-1", uint(42), entity.SCIMDefaultOrganizationRole).
+		Return(nil)
+	deps.userDirectory.EXPECT().
+		UpdateProfile(ctx, uint(42), "Barbara", "Jensen", "").
+		Return(&entity.User{ID: 42}, nil)
+	deps.scim.EXPECT().
+		CreateUser(ctx, mock.MatchedBy(func(user entity.SCIMUser) bool {
+			return user.OrgID == "org-1" && user.UserID == 42 && user.Active && user.ExternalID == "00u1" &&
+				user.OwnsAccount
+		})).
+		Return(nil)
+
+	user, err := deps.usecase().CreateSCIMUser(ctx, "org-1", entity.SCIMUserInput{
+		ExternalID: "00u1",
+		UserName:   "Bjensen@Example.com",
+		GivenName:  "Barbara",
+		FamilyName: "Jensen",
+		Active:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint(42), user.UserID)
+	require.Equal(t, "bjensen@example.com", user.Email)
+}
+
+func TestCreateSCIMUserRejectsDuplicateUserName(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.orgQueries.EXPECT().GetByID(ctx, "org-1").Return(&entity.Organization{ID: "org-1"}, nil)
+	deps.scim.EXPECT().
+		GetUserByUserName(ctx, "org-1", "bjensen@example.com").
+		Return(&entity.SCIMUser{OrgID: "org-1", UserID: 42}, nil)
+
+	_, err := deps.usecase().CreateSCIMUser(ctx, "org-1", entity.SCIMUserInput{
+		UserName: "bjensen@example.com",
+		Active:   true,
+	})
+	require.ErrorIs(t, err, entity.ErrSCIMUserExists)
+}
+
+func TestCreateSCIMUserRejectsExistingAccount(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.orgQueries.EXPECT().GetByID(ctx, "org-1").Return(&entity.Organization{ID: "org-1"}, nil)
+	deps.scim.EXPECT().
+		GetUserByUserName(ctx, "org-1", "bjensen@example.com").
+		Return(nil, entity.ErrSCIMUserNotFound)
+	deps.userDirectory.EXPECT().
+		EnsureByEmail(ctx, "bjensen@example.com").
+		Return(&entity.User{ID: 42, Email: "bjensen@example.com"}, false, nil)
+
+	_, err := deps.usecase().CreateSCIMUser(ctx, "org-1", entity.SCIMUserInput{
+		UserName:  "bjensen@example.com",
+		GivenName: "Mallory",
+		Active:    true,
+	})
+	require.ErrorIs(t, err, entity.ErrSCIMUserNotOwned)
+}
+
+func TestReplaceSCIMUserDeactivationDeprovisions(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+	link := &entity.SCIMUser{
+		OrgID:     "org-1",
+		UserID:    42,
+		UserName:  "bjensen@example.com",
+		GivenName: "Barbara",
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	deps.scim.EXPECT().GetUser(ctx, "org-1", uint(42)).Return(link, nil)
+	deps.commands.EXPECT().
+		RemoveOrganizationMember(ctx, "org-1", uint(42)).
+		Return(entity.ErrOrganizationMembershipNotFound)
+	deps.scim.EXPECT().RemoveOrganizationGroupMemberships(ctx, "org-1", uint(42)).Return(nil)
+	deps.orgQueries.EXPECT().ListTenantIDs(ctx, "org-1").Return([]string{"tenant-1"}, nil)
+	deps.userDirectory.EXPECT().Deprovision(ctx, uint(42), []string{"tenant-1"}).Return(nil)
+	deps.scim.EXPECT().
+		UpdateUser(ctx, mock.MatchedBy(func(user entity.SCIMUser) bool { return !user.Active })).
+		Return(nil)
+
+	user, err := deps.usecase().ReplaceSCIMUser(ctx, "org-1", 42, entity.SCIMUserInput{
+		UserName:  "bjensen@example.com",
+		GivenName: "Barbara",
+		Active:    false,
+	})
+	require.NoError(t, err)
+	require.False(t, user.Active)
+}
+
+func TestReplaceSCIMUserKeepsProfileOfAccountItDoesNotOwn(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.scim.EXPECT().
+		GetUser(ctx, "org-1", uint(42)).
+		Return(&entity.SCIMUser{OrgID: "org-1", UserID: 42, UserName: "bjensen@example.com", Active: true}, nil)
+	deps.scim.EXPECT().
+		UpdateUser(ctx, mock.MatchedBy(func(user entity.SCIMUser) bool { return user.GivenName == "Mallory" })).
+		Return(nil)
+
+	_, err := deps.usecase().ReplaceSCIMUser(ctx, "org-1", 42, entity.SCIMUserInput{
+		UserName:  "bjensen@example.com",
+		GivenName: "Mallory",
+		Active:    true,
+	})
+	require.NoError(t, err)
+}
+
+func TestReplaceSCIMUserRejectsUserNameChange(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.scim.EXPECT().
+		GetUser(ctx, "org-1", uint(42)).
+		Return(&entity.SCIMUser{OrgID: "org-1", UserID: 42, UserName: "bjensen@example.com", Active: true}, nil)
+
+	_, err := deps.usecase().ReplaceSCIMUser(ctx, "org-1", 42, entity.SCIMUserInput{
+		UserName: "someone-else@example.com",
+		Active:   true,
+	})
+	require.ErrorIs(t, err, entity.ErrSCIMUserNameImmutable)
+}
+
+func TestReplaceSCIMGroupDiffsMembers(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+	group := &entity.SCIMGroup{OrgID: "org-1", GroupID: 5, DisplayName: "Engineering", Members: []uint{1, 2}}
+
+	deps.scim.EXPECT().GetGroup(ctx, "org-1", uint64(5)).Return(group, nil).Once()
+	deps.orgQueries.EXPECT().
+		GetMembership(ctx, "org-1", uint(3)).
+		Return(&entity.OrganizationMembership{OrgID: "org-1", UserID: 3, Status: entity.MembershipStatusActive}, nil)
+	deps.commands.EXPECT().RemoveGroupMember(ctx, uint64(5), uint(1)).Return(nil)
+	deps.commands.EXPECT().AddGroupMember(ctx, uint64(5), uint(3)).Return(nil)
+	deps.scim.EXPECT().
+		GetGroup(ctx, "org-1", uint64(5)).
+		Return(&entity.SCIMGroup{OrgID: "org-1", GroupID: 5, DisplayName: "Engineering", Members: []uint{2, 3}}, nil).
+		Once()
+
+	updated, err := deps.usecase().ReplaceSCIMGroup(ctx, "org-1", 5, entity.SCIMGroupInput{
+		DisplayName: "Engineering",
+		Members:     []uint{2, 3, 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint{2, 3}, updated.Members)
+}
+
+func TestReplaceSCIMGroupRejectsInactiveMember(t *testing.T) {
+	deps := newSCIMTestDeps(t)
+	ctx := context.Background()
+
+	deps.scim.EXPECT().
+		GetGroup(ctx, "org-1", uint64(5)).
+		Return(&entity.SCIMGroup{OrgID: "org-1", GroupID: 5, DisplayName: "Engineering", Members: []uint{}}, nil)
+	deps.orgQueries.EXPECT().
+		GetMembership(ctx, "org-1", uint(9)).
+		Return(nil, entity.ErrOrganizationMembershipNotFound)
+
+	_, err := deps.usecase().ReplaceSCIMGroup(ctx, "org-1", 5, entity.SCIMGroupInput{
+		DisplayName: "Engineering",
+		Members:     []uint{9},
+	})
+	require.ErrorIs(t, err, entity.ErrSCIMInvalidMember)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/collection"
 )
 
@@ -41,19 +42,23 @@ func (s *interactor) AddMember(ctx context.Context, tenantID string, userID uint
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
-	if err := s.membershipCommands.Upsert(ctx, membership); err != nil {
-		return err
-	}
-	record, err := newIAMEventOutboxRecord(now, "tenant.member.added", tenantID, tenantID, tenantID, map[string]any{
-		"tenant_id": tenantID,
-		"user_id":   userID,
-		"role_name": role.Name,
-		"status":    membership.Status,
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.membershipCommands.Upsert(ctx, membership); err != nil {
+			return err
+		}
+		record, err := newIAMEventOutboxRecord(
+			now, events.TypeTenantMemberAdded, tenantID, tenantID, tenantID, events.TenantMemberAddedV1{
+				TenantID: tenantID,
+				UserID:   userID,
+				RoleName: role.Name,
+				Status:   membership.Status,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.appendOutboxRecord(ctx, now, record)
 	})
-	if err != nil {
-		return err
-	}
-	return s.appendOutboxRecord(ctx, now, record)
 }
 
 func (s *interactor) PutTenantUserInlinePolicy(ctx context.Context, input entity.PutTenantUserInlinePolicyInput) error {
@@ -78,12 +83,26 @@ func (s *interactor) PutTenantUserInlinePolicy(ctx context.Context, input entity
 		}
 		statements = append(statements, normalized)
 	}
-	return s.policyCommands.PutTenantUserInlinePolicy(ctx, entity.PutTenantUserInlinePolicyInput{
-		TenantID:    strings.TrimSpace(input.TenantID),
-		UserID:      input.UserID,
-		Name:        strings.TrimSpace(input.Name),
-		Description: strings.TrimSpace(input.Description),
-		Statements:  statements,
+	tenantID := strings.TrimSpace(input.TenantID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.PutTenantUserInlinePolicy(ctx, entity.PutTenantUserInlinePolicyInput{
+			TenantID:    tenantID,
+			UserID:      input.UserID,
+			Name:        strings.TrimSpace(input.Name),
+			Description: strings.TrimSpace(input.Description),
+			Statements:  statements,
+		}); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			tenantID,
+			"tenant_user.inline_policy.put",
+			events.AuthorizationChangedV1{
+				UserID:     input.UserID,
+				PolicyName: strings.TrimSpace(input.Name),
+			},
+		)
 	})
 }
 
@@ -140,12 +159,25 @@ func (s *interactor) DeleteTenantUserInlinePolicy(
 	if strings.TrimSpace(name) == "" {
 		return entity.ErrInvalidPolicyName
 	}
-	return s.policyCommands.DeleteTenantUserInlinePolicy(
-		ctx,
-		strings.TrimSpace(tenantID),
-		userID,
-		strings.TrimSpace(name),
-	)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.DeleteTenantUserInlinePolicy(
+			ctx,
+			strings.TrimSpace(tenantID),
+			userID,
+			strings.TrimSpace(name),
+		); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			strings.TrimSpace(tenantID),
+			"tenant_user.inline_policy.deleted",
+			events.AuthorizationChangedV1{
+				UserID:     userID,
+				PolicyName: strings.TrimSpace(name),
+			},
+		)
+	})
 }
 
 func (s *interactor) AttachTenantUserPolicy(
@@ -167,23 +199,32 @@ func (s *interactor) AttachTenantUserPolicy(
 	if err != nil {
 		return err
 	}
-	if err := s.policyCommands.AttachTenantUserPolicy(ctx, tenantID, userID, policy.ID); err != nil {
-		return err
-	}
-	now := time.Now().UTC()
-	record, err := newIAMEventOutboxRecord(now, "policy.attached", tenantID, tenantID, tenantID, map[string]any{
-		"tenant_id":        tenantID,
-		"user_id":          userID,
-		"policy_id":        policy.ID,
-		"policy_name":      policy.Name,
-		"policy_scope":     policy.Scope,
-		"attachment_type":  "tenant_user",
-		"attachment_scope": entity.PolicyScopeTenant,
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.AttachTenantUserPolicy(ctx, tenantID, userID, policy.ID); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		record, err := newIAMEventOutboxRecord(
+			now,
+			events.TypePolicyAttached,
+			tenantID,
+			tenantID,
+			tenantID,
+			events.PolicyAttachedV1{
+				TenantID:        tenantID,
+				UserID:          userID,
+				PolicyID:        policy.ID,
+				PolicyName:      policy.Name,
+				PolicyScope:     policy.Scope,
+				AttachmentType:  "tenant_user",
+				AttachmentScope: entity.PolicyScopeTenant,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.appendOutboxRecord(ctx, now, record)
 	})
-	if err != nil {
-		return err
-	}
-	return s.appendOutboxRecord(ctx, now, record)
 }
 
 func (s *interactor) DetachTenantUserPolicy(
@@ -205,7 +246,21 @@ func (s *interactor) DetachTenantUserPolicy(
 	if err != nil {
 		return err
 	}
-	return s.policyCommands.DetachTenantUserPolicy(ctx, tenantID, userID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.DetachTenantUserPolicy(ctx, tenantID, userID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			strings.TrimSpace(tenantID),
+			"tenant_user.policy.detached",
+			events.AuthorizationChangedV1{
+				UserID:     userID,
+				PolicyID:   policy.ID,
+				PolicyName: policy.Name,
+			},
+		)
+	})
 }
 
 func (s *interactor) ListTenantUserPolicies(
@@ -246,7 +301,20 @@ func (s *interactor) PutTenantUserPermissionBoundary(
 	if policy.Scope != entity.PolicyScopeTenant {
 		return entity.ErrPermissionDenied
 	}
-	return s.policyCommands.PutTenantUserPermissionBoundary(ctx, tenantID, userID, policy.ID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.PutTenantUserPermissionBoundary(ctx, tenantID, userID, policy.ID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			tenantID,
+			"tenant_user.permission_boundary.put",
+			events.AuthorizationChangedV1{
+				UserID:     userID,
+				PolicyName: policy.Name,
+			},
+		)
+	})
 }
 
 func (s *interactor) GetTenantUserPermissionBoundary(
@@ -276,7 +344,19 @@ func (s *interactor) DeleteTenantUserPermissionBoundary(
 	if userID == 0 {
 		return entity.ErrInvalidUserID
 	}
-	return s.policyCommands.DeleteTenantUserPermissionBoundary(ctx, tenantID, userID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.policyCommands.DeleteTenantUserPermissionBoundary(ctx, tenantID, userID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			tenantID,
+			"tenant_user.permission_boundary.deleted",
+			events.AuthorizationChangedV1{
+				UserID: userID,
+			},
+		)
+	})
 }
 
 func (s *interactor) CreateInvite(
@@ -405,10 +485,24 @@ func (s *interactor) AcceptInvite(
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
-	if err := s.membershipCommands.Upsert(ctx, membership); err != nil {
-		return nil, err
-	}
-	if err := s.inviteCommands.MarkAccepted(ctx, invite.ID, userID, now); err != nil {
+	err = s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.membershipCommands.Upsert(ctx, membership); err != nil {
+			return err
+		}
+		if err := s.inviteCommands.MarkAccepted(ctx, invite.ID, userID, now); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			invite.TenantID,
+			"tenant.invite.accepted",
+			events.AuthorizationChangedV1{
+				UserID:   userID,
+				RoleName: invite.RoleName,
+			},
+		)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &membership, nil
@@ -450,5 +544,17 @@ func (s *interactor) RemoveMember(ctx context.Context, tenantID string, userID u
 	if userID == 0 {
 		return entity.ErrInvalidUserID
 	}
-	return s.membershipCommands.Delete(ctx, tenantID, userID)
+	return s.inUnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.membershipCommands.Delete(ctx, tenantID, userID); err != nil {
+			return err
+		}
+		return s.recordAuthorizationChanged(
+			ctx,
+			strings.TrimSpace(tenantID),
+			"tenant.member.removed",
+			events.AuthorizationChangedV1{
+				UserID: userID,
+			},
+		)
+	})
 }
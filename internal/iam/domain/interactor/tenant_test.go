@@ -3,11 +3,15 @@ package interactor_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	outputportmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
 func TestIAMService_CreateTenant_AssignsOwnerRole(t *testing.T) {
@@ -80,6 +84,58 @@ func TestIAMService_AddMember_AppendsOutboxEvent(t *testing.T) {
 	require.Equal(t, entity.RoleTenantViewer, payload["role_name"])
 }
 
+func TestIAMService_AddMember_AppendsOutboxEventInUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	tx := &struct{ name string }{name: "tx"}
+	uow := outputportmocks.NewMockUnitOfWork(t)
+	uow.EXPECT().
+		Do(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(messaging.ContextWithTx(ctx, tx))
+		}).
+		Once()
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{uow: uow})
+	state.tenants["tenant-1"] = entity.Tenant{ID: "tenant-1", Name: "Tenant One", Slug: "tenant-one"}
+	state.roleByName[entity.RoleTenantViewer] = entity.Role{ID: 3, Name: entity.RoleTenantViewer}
+
+	require.NoError(t, svc.AddMember(context.Background(), "tenant-1", 11, entity.RoleTenantViewer))
+	require.Len(t, state.outboxRecords, 1)
+	require.Same(t, tx, state.outboxTxs[0])
+}
+
+func TestIAMService_AddMember_ReturnsCommitFailure(t *testing.T) {
+	t.Parallel()
+
+	commitErr := errors.New("commit failed")
+	uow := outputportmocks.NewMockUnitOfWork(t)
+	uow.EXPECT().
+		Do(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+			return commitErr
+		}).
+		Once()
+	svc, state := newIAMTestUsecaseWithOptions(t, iamTestOptions{uow: uow})
+	state.tenants["tenant-1"] = entity.Tenant{ID: "tenant-1", Name: "Tenant One", Slug: "tenant-one"}
+	state.roleByName[entity.RoleTenantViewer] = entity.Role{ID: 3, Name: entity.RoleTenantViewer}
+
+	require.ErrorIs(t, svc.AddMember(context.Background(), "tenant-1", 11, entity.RoleTenantViewer), commitErr)
+}
+
+func TestIAMService_CreateTenant_MissingOwnerRoleCreatesNothing(t *testing.T) {
+	t.Parallel()
+
+	svc, state := newIAMTestUsecase(t)
+
+	_, err := svc.CreateTenant(context.Background(), 7, entity.CreateTenantCmd{Name: "Acme", Slug: "acme"})
+	require.ErrorIs(t, err, entity.ErrRoleNotFound)
+	require.Empty(t, state.tenants)
+	require.Empty(t, state.outboxRecords)
+}
+
 func TestIAMService_AcceptInvite_EmailMismatch(t *testing.T) {
 	t.Parallel()
 
@@ -666,3 +666,71 @@ func (_c *MockOrganizationQueryRepository_ListServiceControlPolicyStatements_Cal
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListTenantIDs provides a mock function for the type MockOrganizationQueryRepository
+func (_mock *MockOrganizationQueryRepository) ListTenantIDs(ctx context.Context, orgID string) ([]string, error) {
+	ret := _mock.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenantIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrganizationQueryRepository_ListTenantIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTenantIDs'
+type MockOrganizationQueryRepository_ListTenantIDs_Call struct {
+	*mock.Call
+}
+
+// ListTenantIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+func (_e *MockOrganizationQueryRepository_Expecter) ListTenantIDs(ctx interface{}, orgID interface{}) *MockOrganizationQueryRepository_ListTenantIDs_Call {
+	return &MockOrganizationQueryRepository_ListTenantIDs_Call{Call: _e.mock.On("ListTenantIDs", ctx, orgID)}
+}
+
+func (_c *MockOrganizationQueryRepository_ListTenantIDs_Call) Run(run func(ctx context.Context, orgID string)) *MockOrganizationQueryRepository_ListTenantIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrganizationQueryRepository_ListTenantIDs_Call) Return(strings []string, err error) *MockOrganizationQueryRepository_ListTenantIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockOrganizationQueryRepository_ListTenantIDs_Call) RunAndReturn(run func(ctx context.Context, orgID string) ([]string, error)) *MockOrganizationQueryRepository_ListTenantIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -102,85 +102,172 @@ func (_c *MockOutboxRepository_Append_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
-// ListPending provides a mock function for the type MockOutboxRepository
-func (_mock *MockOutboxRepository) ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
-	ret := _mock.Called(ctx, limit)
+// ClaimPending provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) ClaimPending(ctx context.Context, lease messaging.OutboxLease, limit int, now time.Time) ([]messaging.OutboxRecord, error) {
+	ret := _mock.Called(ctx, lease, limit, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListPending")
+		panic("no return value specified for ClaimPending")
 	}
 
 	var r0 []messaging.OutboxRecord
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]messaging.OutboxRecord, error)); ok {
-		return returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, messaging.OutboxLease, int, time.Time) ([]messaging.OutboxRecord, error)); ok {
+		return returnFunc(ctx, lease, limit, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []messaging.OutboxRecord); ok {
-		r0 = returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, messaging.OutboxLease, int, time.Time) []messaging.OutboxRecord); ok {
+		r0 = returnFunc(ctx, lease, limit, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]messaging.OutboxRecord)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, messaging.OutboxLease, int, time.Time) error); ok {
+		r1 = returnFunc(ctx, lease, limit, now)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockOutboxRepository_ListPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPending'
-type MockOutboxRepository_ListPending_Call struct {
+// MockOutboxRepository_ClaimPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPending'
+type MockOutboxRepository_ClaimPending_Call struct {
 	*mock.Call
 }
 
-// ListPending is a helper method to define mock.On call
+// ClaimPending is a helper method to define mock.On call
 //   - ctx context.Context
+//   - lease messaging.OutboxLease
 //   - limit int
-func (_e *MockOutboxRepository_Expecter) ListPending(ctx interface{}, limit interface{}) *MockOutboxRepository_ListPending_Call {
-	return &MockOutboxRepository_ListPending_Call{Call: _e.mock.On("ListPending", ctx, limit)}
+//   - now time.Time
+func (_e *MockOutboxRepository_Expecter) ClaimPending(ctx interface{}, lease interface{}, limit interface{}, now interface{}) *MockOutboxRepository_ClaimPending_Call {
+	return &MockOutboxRepository_ClaimPending_Call{Call: _e.mock.On("ClaimPending", ctx, lease, limit, now)}
 }
 
-func (_c *MockOutboxRepository_ListPending_Call) Run(run func(ctx context.Context, limit int)) *MockOutboxRepository_ListPending_Call {
+func (_c *MockOutboxRepository_ClaimPending_Call) Run(run func(ctx context.Context, lease messaging.OutboxLease, limit int, now time.Time)) *MockOutboxRepository_ClaimPending_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 messaging.OutboxLease
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(messaging.OutboxLease)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockOutboxRepository_ListPending_Call) Return(outboxRecords []messaging.OutboxRecord, err error) *MockOutboxRepository_ListPending_Call {
+func (_c *MockOutboxRepository_ClaimPending_Call) Return(outboxRecords []messaging.OutboxRecord, err error) *MockOutboxRepository_ClaimPending_Call {
 	_c.Call.Return(outboxRecords, err)
 	return _c
 }
 
-func (_c *MockOutboxRepository_ListPending_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error)) *MockOutboxRepository_ListPending_Call {
+func (_c *MockOutboxRepository_ClaimPending_Call) RunAndReturn(run func(ctx context.Context, lease messaging.OutboxLease, limit int, now time.Time) ([]messaging.OutboxRecord, error)) *MockOutboxRepository_ClaimPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDead provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) MarkDead(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error {
+	ret := _mock.Called(ctx, owner, id, errText, deadAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, owner, id, errText, deadAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_MarkDead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDead'
+type MockOutboxRepository_MarkDead_Call struct {
+	*mock.Call
+}
+
+// MarkDead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - id string
+//   - errText string
+//   - deadAt time.Time
+func (_e *MockOutboxRepository_Expecter) MarkDead(ctx interface{}, owner interface{}, id interface{}, errText interface{}, deadAt interface{}) *MockOutboxRepository_MarkDead_Call {
+	return &MockOutboxRepository_MarkDead_Call{Call: _e.mock.On("MarkDead", ctx, owner, id, errText, deadAt)}
+}
+
+func (_c *MockOutboxRepository_MarkDead_Call) Run(run func(ctx context.Context, owner string, id string, errText string, deadAt time.Time)) *MockOutboxRepository_MarkDead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkDead_Call) Return(err error) *MockOutboxRepository_MarkDead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkDead_Call) RunAndReturn(run func(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error) *MockOutboxRepository_MarkDead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // MarkFailed provides a mock function for the type MockOutboxRepository
-func (_mock *MockOutboxRepository) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
-	ret := _mock.Called(ctx, id, errText, nextAttemptAt)
+func (_mock *MockOutboxRepository) MarkFailed(ctx context.Context, owner string, id string, errText string, failedAt time.Time, nextAttemptAt time.Time) error {
+	ret := _mock.Called(ctx, owner, id, errText, failedAt, nextAttemptAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MarkFailed")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
-		r0 = returnFunc(ctx, id, errText, nextAttemptAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, owner, id, errText, failedAt, nextAttemptAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -194,14 +281,16 @@ type MockOutboxRepository_MarkFailed_Call struct {
 
 // MarkFailed is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner string
 //   - id string
 //   - errText string
+//   - failedAt time.Time
 //   - nextAttemptAt time.Time
-func (_e *MockOutboxRepository_Expecter) MarkFailed(ctx interface{}, id interface{}, errText interface{}, nextAttemptAt interface{}) *MockOutboxRepository_MarkFailed_Call {
-	return &MockOutboxRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, id, errText, nextAttemptAt)}
+func (_e *MockOutboxRepository_Expecter) MarkFailed(ctx interface{}, owner interface{}, id interface{}, errText interface{}, failedAt interface{}, nextAttemptAt interface{}) *MockOutboxRepository_MarkFailed_Call {
+	return &MockOutboxRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, owner, id, errText, failedAt, nextAttemptAt)}
 }
 
-func (_c *MockOutboxRepository_MarkFailed_Call) Run(run func(ctx context.Context, id string, errText string, nextAttemptAt time.Time)) *MockOutboxRepository_MarkFailed_Call {
+func (_c *MockOutboxRepository_MarkFailed_Call) Run(run func(ctx context.Context, owner string, id string, errText string, failedAt time.Time, nextAttemptAt time.Time)) *MockOutboxRepository_MarkFailed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -215,15 +304,25 @@ func (_c *MockOutboxRepository_MarkFailed_Call) Run(run func(ctx context.Context
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 time.Time
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(time.Time)
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -234,22 +333,22 @@ func (_c *MockOutboxRepository_MarkFailed_Call) Return(err error) *MockOutboxRep
 	return _c
 }
 
-func (_c *MockOutboxRepository_MarkFailed_Call) RunAndReturn(run func(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error) *MockOutboxRepository_MarkFailed_Call {
+func (_c *MockOutboxRepository_MarkFailed_Call) RunAndReturn(run func(ctx context.Context, owner string, id string, errText string, failedAt time.Time, nextAttemptAt time.Time) error) *MockOutboxRepository_MarkFailed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // MarkPublished provides a mock function for the type MockOutboxRepository
-func (_mock *MockOutboxRepository) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
-	ret := _mock.Called(ctx, ids, publishedAt)
+func (_mock *MockOutboxRepository) MarkPublished(ctx context.Context, owner string, ids []string, publishedAt time.Time) error {
+	ret := _mock.Called(ctx, owner, ids, publishedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MarkPublished")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time) error); ok {
-		r0 = returnFunc(ctx, ids, publishedAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, time.Time) error); ok {
+		r0 = returnFunc(ctx, owner, ids, publishedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -263,30 +362,36 @@ type MockOutboxRepository_MarkPublished_Call struct {
 
 // MarkPublished is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner string
 //   - ids []string
 //   - publishedAt time.Time
-func (_e *MockOutboxRepository_Expecter) MarkPublished(ctx interface{}, ids interface{}, publishedAt interface{}) *MockOutboxRepository_MarkPublished_Call {
-	return &MockOutboxRepository_MarkPublished_Call{Call: _e.mock.On("MarkPublished", ctx, ids, publishedAt)}
+func (_e *MockOutboxRepository_Expecter) MarkPublished(ctx interface{}, owner interface{}, ids interface{}, publishedAt interface{}) *MockOutboxRepository_MarkPublished_Call {
+	return &MockOutboxRepository_MarkPublished_Call{Call: _e.mock.On("MarkPublished", ctx, owner, ids, publishedAt)}
 }
 
-func (_c *MockOutboxRepository_MarkPublished_Call) Run(run func(ctx context.Context, ids []string, publishedAt time.Time)) *MockOutboxRepository_MarkPublished_Call {
+func (_c *MockOutboxRepository_MarkPublished_Call) Run(run func(ctx context.Context, owner string, ids []string, publishedAt time.Time)) *MockOutboxRepository_MarkPublished_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(string)
 		}
-		var arg2 time.Time
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(time.Time)
+			arg2 = args[2].([]string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -297,7 +402,7 @@ func (_c *MockOutboxRepository_MarkPublished_Call) Return(err error) *MockOutbox
 	return _c
 }
 
-func (_c *MockOutboxRepository_MarkPublished_Call) RunAndReturn(run func(ctx context.Context, ids []string, publishedAt time.Time) error) *MockOutboxRepository_MarkPublished_Call {
+func (_c *MockOutboxRepository_MarkPublished_Call) RunAndReturn(run func(ctx context.Context, owner string, ids []string, publishedAt time.Time) error) *MockOutboxRepository_MarkPublished_Call {
 	_c.Call.Return(run)
 	return _c
 }
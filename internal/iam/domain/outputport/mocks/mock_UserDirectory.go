@@ -39,6 +39,69 @@ func (_m *MockUserDirectory) EXPECT() *MockUserDirectory_Expecter {
 	return &MockUserDirectory_Expecter{mock: &_m.Mock}
 }
 
+// Deprovision provides a mock function for the type MockUserDirectory
+func (_mock *MockUserDirectory) Deprovision(ctx context.Context, userID uint, tenantIDs []string) error {
+	ret := _mock.Called(ctx, userID, tenantIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deprovision")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, []string) error); ok {
+		r0 = returnFunc(ctx, userID, tenantIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDirectory_Deprovision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deprovision'
+type MockUserDirectory_Deprovision_Call struct {
+	*mock.Call
+}
+
+// Deprovision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - tenantIDs []string
+func (_e *MockUserDirectory_Expecter) Deprovision(ctx interface{}, userID interface{}, tenantIDs interface{}) *MockUserDirectory_Deprovision_Call {
+	return &MockUserDirectory_Deprovision_Call{Call: _e.mock.On("Deprovision", ctx, userID, tenantIDs)}
+}
+
+func (_c *MockUserDirectory_Deprovision_Call) Run(run func(ctx context.Context, userID uint, tenantIDs []string)) *MockUserDirectory_Deprovision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDirectory_Deprovision_Call) Return(err error) *MockUserDirectory_Deprovision_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDirectory_Deprovision_Call) RunAndReturn(run func(ctx context.Context, userID uint, tenantIDs []string) error) *MockUserDirectory_Deprovision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnsureByEmail provides a mock function for the type MockUserDirectory
 func (_mock *MockUserDirectory) EnsureByEmail(ctx context.Context, email string) (*entity.User, bool, error) {
 	ret := _mock.Called(ctx, email)
@@ -314,3 +377,89 @@ func (_c *MockUserDirectory_List_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateProfile provides a mock function for the type MockUserDirectory
+func (_mock *MockUserDirectory) UpdateProfile(ctx context.Context, userID uint, givenName string, familyName string, displayName string) (*entity.User, error) {
+	ret := _mock.Called(ctx, userID, givenName, familyName, displayName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, string) (*entity.User, error)); ok {
+		return returnFunc(ctx, userID, givenName, familyName, displayName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint, string, string, string) *entity.User); ok {
+		r0 = returnFunc(ctx, userID, givenName, familyName, displayName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, givenName, familyName, displayName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDirectory_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserDirectory_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - givenName string
+//   - familyName string
+//   - displayName string
+func (_e *MockUserDirectory_Expecter) UpdateProfile(ctx interface{}, userID interface{}, givenName interface{}, familyName interface{}, displayName interface{}) *MockUserDirectory_UpdateProfile_Call {
+	return &MockUserDirectory_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, userID, givenName, familyName, displayName)}
+}
+
+func (_c *MockUserDirectory_UpdateProfile_Call) Run(run func(ctx context.Context, userID uint, givenName string, familyName string, displayName string)) *MockUserDirectory_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDirectory_UpdateProfile_Call) Return(user *entity.User, err error) *MockUserDirectory_UpdateProfile_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserDirectory_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, userID uint, givenName string, familyName string, displayName string) (*entity.User, error)) *MockUserDirectory_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
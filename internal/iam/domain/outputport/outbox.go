@@ -9,7 +9,28 @@ import (
 
 type OutboxRepository interface {
 	Append(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error
-	ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error)
-	MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error
-	MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error
+	ClaimPending(
+		ctx context.Context,
+		lease messaging.OutboxLease,
+		limit int,
+		now time.Time,
+	) ([]messaging.OutboxRecord, error)
+	MarkPublished(ctx context.Context, owner string, ids []string, publishedAt time.Time) error
+	MarkFailed(
+		ctx context.Context,
+		owner string,
+		id string,
+		errText string,
+		failedAt time.Time,
+		nextAttemptAt time.Time,
+	) error
+	MarkDead(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error
+}
+
+// UnitOfWork runs fn in one database transaction. Repository calls made with the context
+// passed to fn join it, and messaging.TxFromContext returns the transaction to hand to
+// OutboxRepository.Append, so a write and its events commit or roll back together. Do joins
+// the surrounding transaction when ctx already carries one.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
 }
@@ -0,0 +1,32 @@
+package outputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+// AccessActivityRecorder takes note of an allowed authorization decision. Record must not
+// block: implementations buffer and write in the background.
+type AccessActivityRecorder interface {
+	Record(activity entity.AccessActivity)
+}
+
+type AccessAnalyzerCommandRepository interface {
+	// UpsertAccessActivity keeps the latest LastAccessedAt per tenant, user, role and namespace.
+	UpsertAccessActivity(ctx context.Context, activities []entity.AccessActivity) error
+}
+
+type AccessAnalyzerQueryRepository interface {
+	ListAccessActivity(ctx context.Context, scope entity.AccessAnalyzerScope) ([]entity.AccessActivity, error)
+	// ListActiveMemberships returns the active tenant memberships of the tenants in scope.
+	ListActiveMemberships(ctx context.Context, scope entity.AccessAnalyzerScope) ([]entity.Membership, error)
+	// ListGroupMembers returns the members of the groups of the tenants in scope.
+	ListGroupMembers(ctx context.Context, scope entity.AccessAnalyzerScope) ([]entity.GroupMember, error)
+	ListGroupStatements(ctx context.Context, groupID uint64) ([]entity.PolicyStatement, error)
+}
+
+type AccessAnalyzerRepository interface {
+	AccessAnalyzerCommandRepository
+	AccessAnalyzerQueryRepository
+}
@@ -0,0 +1,62 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+type AccessRequestCommandRepository interface {
+	PutRoleAccessPolicy(ctx context.Context, policy entity.RoleAccessPolicy) error
+	// DeleteRoleAccessPolicy returns entity.ErrAccessPolicyNotFound when the role has none.
+	DeleteRoleAccessPolicy(ctx context.Context, roleID uint64) error
+	CreateAccessRequest(ctx context.Context, request entity.RoleAccessRequest) error
+	// TransitionAccessRequest stores request's status and decision only while the stored
+	// request is still in fromStatus, and returns entity.ErrAccessRequestClosed otherwise.
+	TransitionAccessRequest(ctx context.Context, request entity.RoleAccessRequest, fromStatus string) error
+	// CancelUnusedAccessRequest stores request's cancellation only while the stored request is
+	// still in fromStatus and unused. It returns entity.ErrAccessRequestInUse once a session was
+	// assumed under it and entity.ErrAccessRequestClosed otherwise.
+	CancelUnusedAccessRequest(ctx context.Context, request entity.RoleAccessRequest, fromStatus string) error
+	// MarkAccessGrantUsed records the first session assumed under the approved request and
+	// returns entity.ErrAccessRequestClosed once it is no longer approved.
+	MarkAccessGrantUsed(ctx context.Context, requestID string, at time.Time) error
+}
+
+type AccessRequestQueryRepository interface {
+	// GetRoleAccessPolicy returns entity.ErrAccessPolicyNotFound when the role has none.
+	GetRoleAccessPolicy(ctx context.Context, roleID uint64) (*entity.RoleAccessPolicy, error)
+	GetAccessRequest(ctx context.Context, requestID string) (*entity.RoleAccessRequest, error)
+	ListAccessRequests(ctx context.Context, filter entity.RoleAccessRequestFilter) ([]entity.RoleAccessRequest, error)
+	// FindActiveAccessGrant returns the approved request of userID for the role and tenant
+	// whose window covers at, or entity.ErrAccessRequestNotFound.
+	FindActiveAccessGrant(
+		ctx context.Context,
+		userID uint,
+		roleID uint64,
+		tenantID string,
+		at time.Time,
+	) (*entity.RoleAccessRequest, error)
+	// ListLapsedAccessRequests returns pending and approved requests whose window ended
+	// before at, oldest first.
+	ListLapsedAccessRequests(ctx context.Context, at time.Time, limit int) ([]entity.RoleAccessRequest, error)
+	IsGroupMember(ctx context.Context, groupID uint64, userID uint) (bool, error)
+}
+
+// AccessGrantRepository is what AssumeRole needs to issue sessions from approved requests.
+type AccessGrantRepository interface {
+	FindActiveAccessGrant(
+		ctx context.Context,
+		userID uint,
+		roleID uint64,
+		tenantID string,
+		at time.Time,
+	) (*entity.RoleAccessRequest, error)
+	MarkAccessGrantUsed(ctx context.Context, requestID string, at time.Time) error
+}
+
+type AccessRequestRepository interface {
+	AccessRequestCommandRepository
+	AccessRequestQueryRepository
+}
@@ -34,6 +34,8 @@ type OrganizationQueryRepository interface {
 	) (collection.Page[entity.OrganizationMembership], error)
 	ListServiceControlPolicies(ctx context.Context, orgID string) ([]entity.Policy, error)
 	ListServiceControlPolicyStatements(ctx context.Context, orgID string) ([]entity.PolicyStatement, error)
+	// ListTenantIDs returns the IDs of the tenants attached to the organization.
+	ListTenantIDs(ctx context.Context, orgID string) ([]string, error)
 }
 
 type OrganizationRepository interface {
@@ -16,4 +16,8 @@ type UserDirectory interface {
 	EnsureByEmail(ctx context.Context, email string) (*entity.User, bool, error)
 	GetByID(ctx context.Context, userID uint) (*entity.User, error)
 	List(ctx context.Context, query collection.Query) (collection.Page[entity.User], error)
+	// UpdateProfile overwrites the non-empty name fields of the user.
+	UpdateProfile(ctx context.Context, userID uint, givenName, familyName, displayName string) (*entity.User, error)
+	// Deprovision revokes the sessions and API keys the user holds in tenantIDs.
+	Deprovision(ctx context.Context, userID uint, tenantIDs []string) error
 }
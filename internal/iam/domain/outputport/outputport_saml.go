@@ -0,0 +1,20 @@
+package outputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+type SAMLConnectionRepository interface {
+	// PutConnection creates or replaces the organization's connection, keeping CreatedAt.
+	PutConnection(ctx context.Context, connection entity.SAMLConnection) (*entity.SAMLConnection, error)
+	GetConnection(ctx context.Context, orgID string) (*entity.SAMLConnection, error)
+	// DeleteConnection returns entity.ErrSAMLConnectionNotFound when the org has none.
+	DeleteConnection(ctx context.Context, orgID string) error
+	// ListSSORequiredOrganizations returns the organizations the user is an active member of
+	// whose connection requires SSO.
+	ListSSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error)
+	ListOrganizationGroups(ctx context.Context, orgID string) ([]entity.Group, error)
+	ListUserOrganizationGroupIDs(ctx context.Context, orgID string, userID uint) ([]uint64, error)
+}
@@ -0,0 +1,45 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+)
+
+type SCIMTokenRepository interface {
+	CreateToken(ctx context.Context, token entity.SCIMToken) error
+	GetTokenByHash(ctx context.Context, tokenHash string) (*entity.SCIMToken, error)
+	ListTokens(ctx context.Context, orgID string) ([]entity.SCIMToken, error)
+	// RevokeToken returns entity.ErrSCIMTokenNotFound when the org has no such active token.
+	RevokeToken(ctx context.Context, orgID, tokenID string, revokedAt time.Time) error
+	TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error
+}
+
+type SCIMUserRepository interface {
+	// CreateUser returns entity.ErrSCIMUserExists when the user or userName is already linked.
+	CreateUser(ctx context.Context, user entity.SCIMUser) error
+	GetUser(ctx context.Context, orgID string, userID uint) (*entity.SCIMUser, error)
+	GetUserByUserName(ctx context.Context, orgID, userName string) (*entity.SCIMUser, error)
+	ListUsers(ctx context.Context, orgID string) ([]entity.SCIMUser, error)
+	UpdateUser(ctx context.Context, user entity.SCIMUser) error
+	DeleteUser(ctx context.Context, orgID string, userID uint) error
+	// RemoveOrganizationGroupMemberships drops the user from every organization-scoped group.
+	RemoveOrganizationGroupMemberships(ctx context.Context, orgID string, userID uint) error
+}
+
+type SCIMGroupRepository interface {
+	// CreateGroup creates the organization-scoped IAM group and its SCIM link together and
+	// returns entity.ErrSCIMGroupExists when the organization already has a group by that name.
+	CreateGroup(ctx context.Context, group entity.SCIMGroup) (*entity.SCIMGroup, error)
+	GetGroup(ctx context.Context, orgID string, groupID uint64) (*entity.SCIMGroup, error)
+	ListGroups(ctx context.Context, orgID string) ([]entity.SCIMGroup, error)
+	// UpdateGroup stores the external ID and renames the underlying IAM group.
+	UpdateGroup(ctx context.Context, group entity.SCIMGroup) error
+}
+
+type SCIMRepository interface {
+	SCIMTokenRepository
+	SCIMUserRepository
+	SCIMGroupRepository
+}
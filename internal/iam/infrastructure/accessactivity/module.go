@@ -0,0 +1,45 @@
+package accessactivity
+
+import (
+	"go.uber.org/fx"
+
+	iamconfig "github.com/tuannm99/podzone/internal/iam/config"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+// Module records the activity of allowed authorization checks for the access analyzer. It
+// expects an outputport.AccessAnalyzerCommandRepository in the graph.
+var Module = fx.Options(
+	fx.Provide(
+		iamconfig.NewAccessActivityConfig,
+		newBatchWriter,
+		newRecorder,
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *BatchWriter) {
+		if w != nil {
+			pdworker.StartWorker(lc, logger, w)
+		}
+	}),
+)
+
+// newBatchWriter returns nil when tracking is disabled.
+func newBatchWriter(
+	cfg iamconfig.AccessActivityConfig,
+	log pdlog.Logger,
+	repo outputport.AccessAnalyzerCommandRepository,
+) *BatchWriter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return NewBatchWriter(log, repo, cfg.FlushInterval, cfg.MaxPending)
+}
+
+// newRecorder keeps a disabled writer a nil interface, which the interactors skip.
+func newRecorder(w *BatchWriter) outputport.AccessActivityRecorder {
+	if w == nil {
+		return nil
+	}
+	return w
+}
@@ -0,0 +1,122 @@
+package accessactivity
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// flushTimeout bounds the final flush when the runtime stops.
+const flushTimeout = 5 * time.Second
+
+type activityKey struct {
+	tenantID  string
+	userID    uint
+	roleID    uint64
+	namespace string
+}
+
+// BatchWriter collects access activity in memory and writes it in batches, so authorization
+// checks never wait on the database. Repeated activity of one principal, role and namespace
+// between flushes collapses into a single row. When MaxPending distinct rows are waiting, new
+// ones are dropped until the next flush; the analyzer then reports at worst a slightly older
+// last-accessed time.
+type BatchWriter struct {
+	log        pdlog.Logger
+	repo       outputport.AccessAnalyzerCommandRepository
+	interval   time.Duration
+	maxPending int
+
+	mu      sync.Mutex
+	pending map[activityKey]time.Time
+	dropped int
+}
+
+var _ outputport.AccessActivityRecorder = (*BatchWriter)(nil)
+
+func NewBatchWriter(
+	log pdlog.Logger,
+	repo outputport.AccessAnalyzerCommandRepository,
+	interval time.Duration,
+	maxPending int,
+) *BatchWriter {
+	return &BatchWriter{
+		log:        log,
+		repo:       repo,
+		interval:   interval,
+		maxPending: maxPending,
+		pending:    make(map[activityKey]time.Time),
+	}
+}
+
+func (w *BatchWriter) Record(activity entity.AccessActivity) {
+	key := activityKey{
+		tenantID:  activity.TenantID,
+		userID:    activity.UserID,
+		roleID:    activity.RoleID,
+		namespace: activity.Namespace,
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	last, ok := w.pending[key]
+	if !ok && len(w.pending) >= w.maxPending {
+		w.dropped++
+		return
+	}
+	if !ok || activity.LastAccessedAt.After(last) {
+		w.pending[key] = activity.LastAccessedAt
+	}
+}
+
+func (w *BatchWriter) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+			w.flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			w.flush(ctx)
+		}
+	}
+}
+
+func (w *BatchWriter) flush(ctx context.Context) {
+	w.mu.Lock()
+	pending, dropped := w.pending, w.dropped
+	w.pending = make(map[activityKey]time.Time, len(pending))
+	w.dropped = 0
+	w.mu.Unlock()
+
+	if dropped > 0 {
+		w.log.Warn("IAM access activity buffer full, dropped activity", "dropped", dropped)
+	}
+	if len(pending) == 0 {
+		return
+	}
+	activities := make([]entity.AccessActivity, 0, len(pending))
+	for key, at := range pending {
+		activities = append(activities, entity.AccessActivity{
+			TenantID:       key.tenantID,
+			UserID:         key.userID,
+			RoleID:         key.roleID,
+			Namespace:      key.namespace,
+			LastAccessedAt: at,
+		})
+	}
+	if err := w.repo.UpsertAccessActivity(ctx, activities); err != nil {
+		w.log.Error("IAM access activity flush failed", "rows", len(activities), "error", err)
+		// Keep the rows for the next flush; activity recorded since then merges with them.
+		for _, activity := range activities {
+			w.Record(activity)
+		}
+	}
+}
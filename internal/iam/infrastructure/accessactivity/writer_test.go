@@ -0,0 +1,83 @@
+package accessactivity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	outputmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func activityAt(namespace string, at time.Time) entity.AccessActivity {
+	return entity.AccessActivity{TenantID: "t1", UserID: 9, RoleID: 3, Namespace: namespace, LastAccessedAt: at}
+}
+
+func TestBatchWriterFlush_CoalescesToLatestAccess(t *testing.T) {
+	first := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := outputmocks.NewMockAccessAnalyzerCommandRepository(t)
+	writer := NewBatchWriter(pdlog.NopLogger{}, repo, time.Minute, 10)
+
+	writer.Record(activityAt("store", first.Add(time.Minute)))
+	writer.Record(activityAt("store", first))
+	repo.EXPECT().
+		UpsertAccessActivity(mock.Anything, []entity.AccessActivity{activityAt("store", first.Add(time.Minute))}).
+		Return(nil).
+		Once()
+
+	writer.flush(context.Background())
+	// Nothing pending: the second flush does not reach the repository.
+	writer.flush(context.Background())
+}
+
+func TestBatchWriterRecord_DropsNewRowsWhenFull(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := outputmocks.NewMockAccessAnalyzerCommandRepository(t)
+	writer := NewBatchWriter(pdlog.NopLogger{}, repo, time.Minute, 1)
+
+	writer.Record(activityAt("store", now))
+	writer.Record(activityAt("order", now))
+	// A pending row still advances when the buffer is full.
+	writer.Record(activityAt("store", now.Add(time.Second)))
+
+	assert.Len(t, writer.pending, 1)
+	assert.Equal(t, 1, writer.dropped)
+	repo.EXPECT().
+		UpsertAccessActivity(mock.Anything, []entity.AccessActivity{activityAt("store", now.Add(time.Second))}).
+		Return(nil).
+		Once()
+	writer.flush(context.Background())
+	assert.Zero(t, writer.dropped)
+}
+
+func TestBatchWriterFlush_KeepsRowsOnError(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := outputmocks.NewMockAccessAnalyzerCommandRepository(t)
+	writer := NewBatchWriter(pdlog.NopLogger{}, repo, time.Minute, 10)
+
+	writer.Record(activityAt("store", now))
+	repo.EXPECT().UpsertAccessActivity(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+	writer.flush(context.Background())
+
+	assert.Equal(t, now, writer.pending[activityKey{tenantID: "t1", userID: 9, roleID: 3, namespace: "store"}])
+}
+
+func TestBatchWriterRun_FlushesOnShutdown(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := outputmocks.NewMockAccessAnalyzerCommandRepository(t)
+	writer := NewBatchWriter(pdlog.NopLogger{}, repo, time.Hour, 10)
+	writer.Record(activityAt("store", now))
+	repo.EXPECT().
+		UpsertAccessActivity(mock.Anything, []entity.AccessActivity{activityAt("store", now)}).
+		Return(nil).
+		Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	writer.Run(ctx)
+}
@@ -0,0 +1,89 @@
+package accessrequest
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+const expiryBatchSize = 100
+
+// ExpiryWorker moves access requests whose window has ended to expired. AssumeRole already
+// ignores such grants, so the sweep only keeps the stored status, events and audit trail in
+// step with time.
+type ExpiryWorker struct {
+	log      pdlog.Logger
+	requests inputport.AccessRequestCommandUsecase
+	auditRep outputport.AuditLogRepository
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewExpiryWorker(
+	log pdlog.Logger,
+	requests inputport.AccessRequestCommandUsecase,
+	auditRep outputport.AuditLogRepository,
+) *ExpiryWorker {
+	return &ExpiryWorker{
+		log:      log,
+		requests: requests,
+		auditRep: auditRep,
+		interval: time.Minute,
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.tick(ctx)
+		}
+	}
+}
+
+func (w *ExpiryWorker) tick(ctx context.Context) {
+	now := w.now()
+	expired, err := w.requests.ExpireAccessRequests(ctx, now, expiryBatchSize)
+	if err != nil {
+		w.log.Error("IAM access request expiry tick failed", "error", err)
+	}
+	for i := range expired {
+		w.recordAudit(ctx, expired[i], now)
+	}
+}
+
+func (w *ExpiryWorker) recordAudit(ctx context.Context, request entity.RoleAccessRequest, now time.Time) {
+	payloadJSON, err := json.Marshal(map[string]any{
+		"requester_user_id": request.RequesterUserID,
+		"role_name":         request.RoleName,
+		"expires_at":        request.ExpiresAt,
+	})
+	if err != nil {
+		payloadJSON = []byte("{}")
+	}
+	if err := w.auditRep.Create(ctx, entity.AuditLog{
+		ID:           uuid.NewString(),
+		Action:       "iam.access_request.expired",
+		ResourceType: "iam_access_request",
+		ResourceID:   request.ID,
+		TenantID:     request.TenantID,
+		Status:       "success",
+		PayloadJSON:  string(payloadJSON),
+		CreatedAt:    now,
+	}); err != nil {
+		w.log.Error("IAM access request expiry audit failed", "request_id", request.ID, "error", err)
+	}
+}
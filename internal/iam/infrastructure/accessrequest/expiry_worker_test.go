@@ -0,0 +1,56 @@
+package accessrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/entity"
+	inputmocks "github.com/tuannm99/podzone/internal/iam/domain/inputport/mocks"
+	outputmocks "github.com/tuannm99/podzone/internal/iam/domain/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func TestExpiryWorkerTick_AuditsExpiredRequests(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	requests := inputmocks.NewMockAccessRequestCommandUsecase(t)
+	auditRep := outputmocks.NewMockAuditLogRepository(t)
+	worker := NewExpiryWorker(pdlog.NopLogger{}, requests, auditRep)
+	worker.now = func() time.Time { return now }
+
+	requests.EXPECT().
+		ExpireAccessRequests(mock.Anything, now, expiryBatchSize).
+		Return([]entity.RoleAccessRequest{{ID: "ar-1", TenantID: "t1", RoleName: "tenant_admin"}}, nil).
+		Once()
+	auditRep.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(log entity.AuditLog) bool {
+			return log.Action == "iam.access_request.expired" &&
+				log.ResourceID == "ar-1" &&
+				log.TenantID == "t1" &&
+				log.ActorUserID == 0
+		})).
+		Return(nil).
+		Once()
+
+	worker.tick(context.Background())
+}
+
+func TestExpiryWorkerTick_AuditsPartialBatchOnError(t *testing.T) {
+	requests := inputmocks.NewMockAccessRequestCommandUsecase(t)
+	auditRep := outputmocks.NewMockAuditLogRepository(t)
+	worker := NewExpiryWorker(pdlog.NopLogger{}, requests, auditRep)
+
+	requests.EXPECT().
+		ExpireAccessRequests(mock.Anything, mock.Anything, expiryBatchSize).
+		Return([]entity.RoleAccessRequest{{ID: "ar-1"}}, errors.New("db down")).
+		Once()
+	auditRep.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+
+	assert.NotPanics(t, func() {
+		worker.tick(context.Background())
+	})
+}
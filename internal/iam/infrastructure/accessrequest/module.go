@@ -0,0 +1,22 @@
+package accessrequest
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	iamrepo "github.com/tuannm99/podzone/internal/iam/infrastructure/repository"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+// Module expires lapsed access requests on a timer. It expects iam.Module and
+// pdsql.ModuleFor("iam") in the graph.
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotate(iamrepo.NewAuditLogRepository, fx.As(new(outputport.AuditLogRepository))),
+		NewExpiryWorker,
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *ExpiryWorker) {
+		pdworker.StartWorker(lc, logger, w)
+	}),
+)
@@ -104,6 +104,37 @@ func (d *UserDirectory) List(
 	}, nil
 }
 
+func (d *UserDirectory) UpdateProfile(
+	ctx context.Context,
+	userID uint,
+	givenName, familyName, displayName string,
+) (*entity.User, error) {
+	resp, err := d.client.UpdateUserProfile(ctx, &pbauthv1.UpdateUserProfileRequest{
+		UserId:    uint64(userID),
+		FirstName: givenName,
+		LastName:  familyName,
+		FullName:  displayName,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, entity.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return toIAMUser(resp.GetUserInfo()), nil
+}
+
+func (d *UserDirectory) Deprovision(ctx context.Context, userID uint, tenantIDs []string) error {
+	_, err := d.client.DeprovisionUser(ctx, &pbauthv1.DeprovisionUserRequest{
+		UserId:    uint64(userID),
+		TenantIds: tenantIDs,
+	})
+	if status.Code(err) == codes.NotFound {
+		return entity.ErrUserNotFound
+	}
+	return err
+}
+
 func toIAMUser(in *pbauthv1.UserInfo) *entity.User {
 	if in == nil {
 		return nil
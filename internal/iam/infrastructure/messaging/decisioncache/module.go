@@ -0,0 +1,58 @@
+package decisioncache
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	controller "github.com/tuannm99/podzone/internal/iam/controller/eventhandler/decisioncache"
+	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+const (
+	runtimeConfigPath = "messaging.iam.consumers.decision_cache"
+	consumerName      = "iam.decision-cache"
+)
+
+// Module keeps the decision cache of an IAM API runtime in step with writes made by its
+// peers. It expects iam.DecisionCacheModule and pdkafka.ModuleFor("iam") in the graph.
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewRuntimeConfig, fx.ResultTags(`name:"iam-decision-cache-runtime"`)),
+		fx.Annotate(
+			NewConsumerGroupRunner,
+			fx.ParamTags(`name:"kafka-iam-consumer-group-factory"`, `name:"kafka-iam-config"`),
+			fx.ResultTags(`name:"iam-decision-cache-runner"`),
+		),
+		fx.Annotate(
+			func(cache inputport.DecisionCacheUsecase) messaging.Handler {
+				return controller.NewHandler(cache)
+			},
+			fx.ResultTags(`name:"iam-decision-cache-handler"`),
+		),
+		fx.Annotate(
+			NewWorker,
+			fx.ParamTags(
+				``,
+				`name:"iam-decision-cache-runner"`,
+				`name:"iam-decision-cache-runtime"`,
+				`name:"iam-decision-cache-handler"`,
+			),
+		),
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *Worker) {
+		pdworker.StartWorker(lc, logger, w)
+	}),
+)
+
+func NewRuntimeConfig(k *koanf.Koanf) messaging.ConsumerRuntimeConfig {
+	cfg := messaging.LoadConsumerRuntimeConfig(
+		k,
+		runtimeConfigPath,
+		messaging.DefaultConsumerRuntimeConfig(consumerName),
+	)
+	cfg.Idempotency.ConsumerName = consumerName
+	return cfg
+}
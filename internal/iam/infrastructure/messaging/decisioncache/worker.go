@@ -0,0 +1,82 @@
+package decisioncache
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+type Worker struct {
+	log      pdlog.Logger
+	runner   pdkafka.ConsumerGroupRunner
+	consumer *messagingkafka.Consumer
+	enabled  bool
+}
+
+// NewWorker consumes iam.events without retries, dead letters or an inbox: invalidation is
+// idempotent and a missed event is bounded by the cache TTL.
+func NewWorker(
+	log pdlog.Logger,
+	runner pdkafka.ConsumerGroupRunner,
+	cfg messaging.ConsumerRuntimeConfig,
+	handler messaging.Handler,
+) *Worker {
+	return &Worker{
+		log:     log,
+		runner:  runner,
+		enabled: cfg.Enabled,
+		consumer: messagingkafka.NewConsumerWithOptions(
+			runner,
+			[]string{messaging.EventTopic("iam")},
+			handler,
+			messagingkafka.ConsumerOptions{ConsumerName: cfg.Idempotency.ConsumerName},
+		),
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	if !w.enabled {
+		w.log.Info("IAM decision cache invalidation worker disabled")
+		return
+	}
+
+	defer func() {
+		if err := w.runner.Close(); err != nil {
+			w.log.Error("Close IAM decision cache consumer failed", "error", err)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		if err := w.consumer.Run(ctx); err != nil && ctx.Err() == nil {
+			w.log.Error("IAM decision cache consumer failed", "error", err)
+		}
+	}
+}
+
+// NewConsumerGroupRunner joins a consumer group of its own so every IAM instance sees every
+// event, rather than sharing partitions with its peers.
+func NewConsumerGroupRunner(
+	factory pdkafka.ConsumerGroupFactory,
+	cfg *pdkafka.Config,
+) (pdkafka.ConsumerGroupRunner, error) {
+	groupID := cfg.ConsumerGroupPrefix + ".decision-cache." + instanceID()
+	group, err := factory.New(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("create IAM decision cache consumer group: %w", err)
+	}
+	return pdkafka.NewConsumerGroupRunner(group), nil
+}
+
+func instanceID() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return uuid.NewString()
+}
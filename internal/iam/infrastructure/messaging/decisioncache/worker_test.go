@@ -0,0 +1,53 @@
+package decisioncache
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+)
+
+type fakeConsumerGroupFactory struct {
+	groupID string
+}
+
+func (f *fakeConsumerGroupFactory) New(groupID string) (sarama.ConsumerGroup, error) {
+	f.groupID = groupID
+	return &fakeSaramaConsumerGroup{}, nil
+}
+
+type fakeSaramaConsumerGroup struct{}
+
+func (f *fakeSaramaConsumerGroup) Consume(
+	ctx context.Context,
+	topics []string,
+	handler sarama.ConsumerGroupHandler,
+) error {
+	return nil
+}
+func (f *fakeSaramaConsumerGroup) Errors() <-chan error      { return nil }
+func (f *fakeSaramaConsumerGroup) Pause(map[string][]int32)  {}
+func (f *fakeSaramaConsumerGroup) Resume(map[string][]int32) {}
+func (f *fakeSaramaConsumerGroup) PauseAll()                 {}
+func (f *fakeSaramaConsumerGroup) ResumeAll()                {}
+func (f *fakeSaramaConsumerGroup) Close() error              { return nil }
+
+func TestNewConsumerGroupRunner_UsesGroupPerInstance(t *testing.T) {
+	factory := &fakeConsumerGroupFactory{}
+	runner, err := NewConsumerGroupRunner(factory, &pdkafka.Config{ConsumerGroupPrefix: "podzone.iam"})
+	require.NoError(t, err)
+	require.NotNil(t, runner)
+	assert.True(t, strings.HasPrefix(factory.groupID, "podzone.iam.decision-cache."))
+	assert.Greater(t, len(factory.groupID), len("podzone.iam.decision-cache."))
+}
+
+func TestNewRuntimeConfig_Defaults(t *testing.T) {
+	cfg := NewRuntimeConfig(nil)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, consumerName, cfg.Idempotency.ConsumerName)
+}
@@ -1,30 +1,48 @@
 package outbox
 
 import (
+	"github.com/knadh/koanf/v2"
 	"github.com/tuannm99/podzone/internal/iam"
+	"github.com/tuannm99/podzone/pkg/api/events"
 	"github.com/tuannm99/podzone/pkg/messaging"
 	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
 	"github.com/tuannm99/podzone/pkg/pdkafka"
 	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
 	"github.com/tuannm99/podzone/pkg/pdworker"
 	"go.uber.org/fx"
 )
 
+const relayConfigPath = "messaging.iam.outbox"
+
 var Module = fx.Options(
 	iam.Module,
 	fx.Provide(
 		fx.Annotate(
 			func(producer pdkafka.Producer) messaging.Publisher {
-				return messagingkafka.NewPublisher(producer)
+				return messaging.NewStrictSchemaValidatingPublisher(messagingkafka.NewPublisher(producer), events.Schemas())
 			},
 			fx.ParamTags(`name:"kafka-iam-producer"`),
 		),
-		func(store messaging.OutboxStore, publisher messaging.Publisher) *messagingkafka.Relay {
-			return messagingkafka.NewRelay(store, publisher, 100)
-		},
+		NewRelayConfig,
+		messagingkafka.NewRelayFromConfig,
+		fx.Annotate(
+			func(lc fx.Lifecycle, log pdlog.Logger, sqlCfg *pdsql.Config, cfg messaging.OutboxRelayConfig) (
+				messaging.OutboxWakeup,
+				error,
+			) {
+				return messagingsql.ListenForOutbox(lc, log, sqlCfg.URI, cfg)
+			},
+			fx.ParamTags(``, ``, `name:"sql-iam-config"`, ``),
+		),
 		NewOutboxWorker,
 	),
 	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *OutboxWorker) {
 		pdworker.StartWorker(lc, logger, w)
 	}),
 )
+
+func NewRelayConfig(k *koanf.Koanf) messaging.OutboxRelayConfig {
+	return messaging.LoadOutboxRelayConfig(k, relayConfigPath, messaging.DefaultOutboxRelayConfig())
+}
@@ -15,36 +15,63 @@ import (
 )
 
 type fakeOutboxStore struct {
-	listPending func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error)
-	markPub     func(ctx context.Context, ids []string, publishedAt time.Time) error
-	markFail    func(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error
+	claimPending func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error)
+	markPub      func(ctx context.Context, ids []string, publishedAt time.Time) error
+	markFail     func(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error
 }
 
 func (f *fakeOutboxStore) Append(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
 	return nil
 }
 
-func (f *fakeOutboxStore) ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
-	if f.listPending != nil {
-		return f.listPending(ctx, limit)
+func (f *fakeOutboxStore) ClaimPending(
+	ctx context.Context,
+	lease messaging.OutboxLease,
+	limit int,
+	now time.Time,
+) ([]messaging.OutboxRecord, error) {
+	if f.claimPending != nil {
+		return f.claimPending(ctx, limit)
 	}
 	return nil, nil
 }
 
-func (f *fakeOutboxStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
+func (f *fakeOutboxStore) MarkPublished(
+	ctx context.Context,
+	owner string,
+	ids []string,
+	publishedAt time.Time,
+) error {
 	if f.markPub != nil {
 		return f.markPub(ctx, ids, publishedAt)
 	}
 	return nil
 }
 
-func (f *fakeOutboxStore) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
+func (f *fakeOutboxStore) MarkFailed(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	failedAt time.Time,
+	nextAttemptAt time.Time,
+) error {
 	if f.markFail != nil {
 		return f.markFail(ctx, id, errText, nextAttemptAt)
 	}
 	return nil
 }
 
+func (f *fakeOutboxStore) MarkDead(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	deadAt time.Time,
+) error {
+	return nil
+}
+
 type fakePublisher struct {
 	publish func(ctx context.Context, topic string, key string, msg messaging.Envelope) error
 }
@@ -62,7 +89,7 @@ func (f *fakePublisher) PublishBatch(ctx context.Context, topic string, msgs []m
 
 func TestOutboxWorkerTick_IgnoresNoMessages(t *testing.T) {
 	relay := messagingkafka.NewRelay(&fakeOutboxStore{}, &fakePublisher{}, 10)
-	worker := NewOutboxWorker(pdlog.NopLogger{}, relay)
+	worker := NewOutboxWorker(pdlog.NopLogger{}, relay, messaging.DefaultOutboxRelayConfig(), nil)
 
 	require.NotPanics(t, func() {
 		worker.tick(context.Background())
@@ -72,7 +99,7 @@ func TestOutboxWorkerTick_IgnoresNoMessages(t *testing.T) {
 func TestOutboxWorkerTick_RunsRelay(t *testing.T) {
 	called := false
 	relay := messagingkafka.NewRelay(&fakeOutboxStore{
-		listPending: func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
+		claimPending: func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
 			return []messaging.OutboxRecord{{
 				ID:    "m1",
 				Topic: "podzone.iam.events",
@@ -97,7 +124,7 @@ func TestOutboxWorkerTick_RunsRelay(t *testing.T) {
 			return nil
 		},
 	}, 10)
-	worker := NewOutboxWorker(pdlog.NopLogger{}, relay)
+	worker := NewOutboxWorker(pdlog.NopLogger{}, relay, messaging.DefaultOutboxRelayConfig(), nil)
 
 	worker.tick(context.Background())
 
@@ -107,11 +134,11 @@ func TestOutboxWorkerTick_RunsRelay(t *testing.T) {
 func TestOutboxWorkerTick_SwallowsRelayFailures(t *testing.T) {
 	expectedErr := errors.New("boom")
 	relay := messagingkafka.NewRelay(&fakeOutboxStore{
-		listPending: func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
+		claimPending: func(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
 			return nil, expectedErr
 		},
 	}, &fakePublisher{}, 10)
-	worker := NewOutboxWorker(pdlog.NopLogger{}, relay)
+	worker := NewOutboxWorker(pdlog.NopLogger{}, relay, messaging.DefaultOutboxRelayConfig(), nil)
 
 	require.NotPanics(t, func() {
 		worker.tick(context.Background())
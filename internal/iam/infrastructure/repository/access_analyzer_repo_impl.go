@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+type AccessAnalyzerRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+var _ outputport.AccessAnalyzerRepository = (*AccessAnalyzerRepositoryImpl)(nil)
+
+func NewAccessAnalyzerRepository(p repoParams) outputport.AccessAnalyzerRepository {
+	return &AccessAnalyzerRepositoryImpl{db: p.DB}
+}
+
+// accessAnalyzerScopeFilter matches the tenants of an entity.AccessAnalyzerScope bound to $1
+// (tenant ID) and $2 (organization ID), for a query that names the tenant column t.
+const accessAnalyzerScopeFilter = `(($1 <> '' AND t.id = $1) OR ($2 <> '' AND t.org_id = $2))`
+
+func (r *AccessAnalyzerRepositoryImpl) UpsertAccessActivity(
+	ctx context.Context,
+	activities []entity.AccessActivity,
+) error {
+	if len(activities) == 0 {
+		return nil
+	}
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, activity := range activities {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO iam_access_activity (tenant_id, user_id, role_id, namespace, last_accessed_at)
+			 VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT (tenant_id, user_id, role_id, namespace) DO UPDATE SET
+			   last_accessed_at = GREATEST(iam_access_activity.last_accessed_at, EXCLUDED.last_accessed_at)`,
+			activity.TenantID,
+			activity.UserID,
+			activity.RoleID,
+			activity.Namespace,
+			activity.LastAccessedAt,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *AccessAnalyzerRepositoryImpl) ListAccessActivity(
+	ctx context.Context,
+	scope entity.AccessAnalyzerScope,
+) ([]entity.AccessActivity, error) {
+	var rows []accessActivityModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT a.tenant_id, a.user_id, a.role_id, a.namespace, a.last_accessed_at
+		 FROM iam_access_activity a
+		 JOIN tenants t ON t.id = a.tenant_id
+		 WHERE `+accessAnalyzerScopeFilter+`
+		 ORDER BY a.tenant_id, a.user_id, a.namespace`,
+		scope.TenantID,
+		scope.OrgID,
+	); err != nil {
+		return nil, err
+	}
+	out := make([]entity.AccessActivity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.toEntity())
+	}
+	return out, nil
+}
+
+func (r *AccessAnalyzerRepositoryImpl) ListActiveMemberships(
+	ctx context.Context,
+	scope entity.AccessAnalyzerScope,
+) ([]entity.Membership, error) {
+	var rows []membershipModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT tm.tenant_id, tm.user_id, tm.role_id, role.name AS role_name, tm.status,
+		        tm.created_at, tm.updated_at
+		 FROM tenant_memberships tm
+		 JOIN tenants t ON t.id = tm.tenant_id
+		 JOIN iam_roles role ON role.id = tm.role_id
+		 WHERE tm.status = 'active' AND `+accessAnalyzerScopeFilter+`
+		 ORDER BY tm.tenant_id, tm.user_id`,
+		scope.TenantID,
+		scope.OrgID,
+	); err != nil {
+		return nil, err
+	}
+	out := make([]entity.Membership, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, entity.Membership{
+			TenantID:  row.TenantID,
+			UserID:    row.UserID,
+			RoleID:    row.RoleID,
+			RoleName:  row.RoleName,
+			Status:    row.Status,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+
+func (r *AccessAnalyzerRepositoryImpl) ListGroupMembers(
+	ctx context.Context,
+	scope entity.AccessAnalyzerScope,
+) ([]entity.GroupMember, error) {
+	var rows []groupMemberModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT g.id AS group_id, g.name AS group_name, g.tenant_id, gm.user_id, gm.created_at AS added_at
+		 FROM iam_group_members gm
+		 JOIN iam_groups g ON g.id = gm.group_id
+		 JOIN tenants t ON t.id = g.tenant_id
+		 WHERE g.scope = 'tenant' AND `+accessAnalyzerScopeFilter+`
+		 ORDER BY g.tenant_id, g.id, gm.user_id`,
+		scope.TenantID,
+		scope.OrgID,
+	); err != nil {
+		return nil, err
+	}
+	out := make([]entity.GroupMember, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, entity.GroupMember{
+			GroupID:   row.GroupID,
+			GroupName: row.GroupName,
+			TenantID:  row.TenantID,
+			UserID:    row.UserID,
+			AddedAt:   row.AddedAt,
+		})
+	}
+	return out, nil
+}
+
+func (r *AccessAnalyzerRepositoryImpl) ListGroupStatements(
+	ctx context.Context,
+	groupID uint64,
+) ([]entity.PolicyStatement, error) {
+	var rows []policyStatementModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern,
+		        ps.resource_pattern, ps.conditions_json, ps.created_at
+		 FROM iam_policy_statements ps
+		 JOIN iam_policies p ON p.id = ps.policy_id
+		 JOIN iam_group_policy_attachments gpa ON gpa.policy_id = p.id
+		 WHERE gpa.group_id = $1
+		 UNION ALL
+		 SELECT 0 AS id, 0 AS policy_id, gps.policy_name, gps.effect, gps.action_pattern,
+		        gps.resource_pattern, '[]' AS conditions_json, gps.created_at
+		 FROM iam_group_inline_policy_statements gps
+		 WHERE gps.group_id = $1`,
+		groupID,
+	); err != nil {
+		return nil, err
+	}
+	return toPolicyStatements(rows), nil
+}
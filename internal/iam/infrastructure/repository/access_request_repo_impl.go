@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+type AccessRequestRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+var _ outputport.AccessRequestRepository = (*AccessRequestRepositoryImpl)(nil)
+
+func NewAccessRequestRepository(p repoParams) outputport.AccessRequestRepository {
+	return &AccessRequestRepositoryImpl{db: p.DB}
+}
+
+const roleAccessPolicySelect = `SELECT policy.role_id, role.name AS role_name,
+	        COALESCE(policy.approver_group_id, 0) AS approver_group_id,
+	        COALESCE(policy.approver_role_id, 0) AS approver_role_id,
+	        COALESCE(approver.name, '') AS approver_platform_role,
+	        policy.max_duration_seconds, policy.created_at, policy.updated_at
+	 FROM iam_role_access_policies policy
+	 JOIN iam_roles role ON role.id = policy.role_id
+	 LEFT JOIN iam_roles approver ON approver.id = policy.approver_role_id`
+
+const accessRequestSelect = `SELECT request.id, request.requester_user_id, request.role_id,
+	        role.name AS role_name, role.scope AS role_scope, request.tenant_id,
+	        request.justification, request.status, request.starts_at, request.expires_at,
+	        request.decided_by_user_id, request.decision_reason, request.decided_at,
+	        request.used_at, request.created_at, request.updated_at
+	 FROM iam_access_requests request
+	 JOIN iam_roles role ON role.id = request.role_id`
+
+func (r *AccessRequestRepositoryImpl) PutRoleAccessPolicy(ctx context.Context, policy entity.RoleAccessPolicy) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO iam_role_access_policies
+		 (role_id, approver_group_id, approver_role_id, max_duration_seconds, created_at, updated_at)
+		 VALUES ($1, NULLIF($2, 0), NULLIF($3, 0), $4, $5, $6)
+		 ON CONFLICT (role_id) DO UPDATE SET
+		   approver_group_id = EXCLUDED.approver_group_id,
+		   approver_role_id = EXCLUDED.approver_role_id,
+		   max_duration_seconds = EXCLUDED.max_duration_seconds,
+		   updated_at = EXCLUDED.updated_at`,
+		policy.RoleID,
+		int64(policy.ApproverGroupID),
+		int64(policy.ApproverRoleID),
+		policy.MaxDurationSeconds,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+	)
+	return err
+}
+
+func (r *AccessRequestRepositoryImpl) DeleteRoleAccessPolicy(ctx context.Context, roleID uint64) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`DELETE FROM iam_role_access_policies WHERE role_id = $1`,
+		roleID,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrAccessPolicyNotFound
+	}
+	return nil
+}
+
+func (r *AccessRequestRepositoryImpl) GetRoleAccessPolicy(
+	ctx context.Context,
+	roleID uint64,
+) (*entity.RoleAccessPolicy, error) {
+	var row roleAccessPolicyModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		roleAccessPolicySelect+` WHERE policy.role_id = $1`,
+		roleID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrAccessPolicyNotFound
+		}
+		return nil, err
+	}
+	out := row.toEntity()
+	return &out, nil
+}
+
+func (r *AccessRequestRepositoryImpl) CreateAccessRequest(ctx context.Context, request entity.RoleAccessRequest) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO iam_access_requests
+		 (id, requester_user_id, role_id, tenant_id, justification, status,
+		  starts_at, expires_at, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		request.ID,
+		request.RequesterUserID,
+		request.RoleID,
+		request.TenantID,
+		request.Justification,
+		request.Status,
+		request.StartsAt,
+		request.ExpiresAt,
+		request.CreatedAt,
+		request.UpdatedAt,
+	)
+	return err
+}
+
+func (r *AccessRequestRepositoryImpl) TransitionAccessRequest(
+	ctx context.Context,
+	request entity.RoleAccessRequest,
+	fromStatus string,
+) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_access_requests
+		 SET status = $3, decided_by_user_id = $4, decision_reason = $5, decided_at = $6, updated_at = $7
+		 WHERE id = $1 AND status = $2`,
+		request.ID,
+		fromStatus,
+		request.Status,
+		request.DecidedByUserID,
+		request.DecisionReason,
+		request.DecidedAt,
+		request.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrAccessRequestClosed
+	}
+	return nil
+}
+
+func (r *AccessRequestRepositoryImpl) CancelUnusedAccessRequest(
+	ctx context.Context,
+	request entity.RoleAccessRequest,
+	fromStatus string,
+) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_access_requests SET status = $3, updated_at = $4
+		 WHERE id = $1 AND status = $2 AND used_at IS NULL`,
+		request.ID,
+		fromStatus,
+		request.Status,
+		request.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+	var used bool
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&used,
+		`SELECT used_at IS NOT NULL FROM iam_access_requests WHERE id = $1`,
+		request.ID,
+	); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if used {
+		return entity.ErrAccessRequestInUse
+	}
+	return entity.ErrAccessRequestClosed
+}
+
+func (r *AccessRequestRepositoryImpl) MarkAccessGrantUsed(ctx context.Context, requestID string, at time.Time) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_access_requests SET used_at = COALESCE(used_at, $3)
+		 WHERE id = $1 AND status = $2`,
+		requestID,
+		entity.AccessRequestStatusApproved,
+		at,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrAccessRequestClosed
+	}
+	return nil
+}
+
+func (r *AccessRequestRepositoryImpl) GetAccessRequest(
+	ctx context.Context,
+	requestID string,
+) (*entity.RoleAccessRequest, error) {
+	var row accessRequestModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		accessRequestSelect+` WHERE request.id = $1`,
+		requestID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrAccessRequestNotFound
+		}
+		return nil, err
+	}
+	out := row.toEntity()
+	return &out, nil
+}
+
+func (r *AccessRequestRepositoryImpl) ListAccessRequests(
+	ctx context.Context,
+	filter entity.RoleAccessRequestFilter,
+) ([]entity.RoleAccessRequest, error) {
+	var rows []accessRequestModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		accessRequestSelect+`
+		 WHERE ($1 = 0 OR request.requester_user_id = $1)
+		   AND ($2 = '' OR request.tenant_id = $2)
+		   AND ($3 = '' OR request.status = $3)
+		 ORDER BY request.created_at DESC, request.id`,
+		filter.RequesterUserID,
+		filter.TenantID,
+		filter.Status,
+	); err != nil {
+		return nil, err
+	}
+	return accessRequestsFromRows(rows), nil
+}
+
+func (r *AccessRequestRepositoryImpl) FindActiveAccessGrant(
+	ctx context.Context,
+	userID uint,
+	roleID uint64,
+	tenantID string,
+	at time.Time,
+) (*entity.RoleAccessRequest, error) {
+	var row accessRequestModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		accessRequestSelect+`
+		 WHERE request.requester_user_id = $1 AND request.role_id = $2 AND request.tenant_id = $3
+		   AND request.status = $4 AND request.starts_at <= $5 AND request.expires_at > $5
+		 ORDER BY request.expires_at DESC
+		 LIMIT 1`,
+		userID,
+		roleID,
+		tenantID,
+		entity.AccessRequestStatusApproved,
+		at,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrAccessRequestNotFound
+		}
+		return nil, err
+	}
+	out := row.toEntity()
+	return &out, nil
+}
+
+func (r *AccessRequestRepositoryImpl) ListLapsedAccessRequests(
+	ctx context.Context,
+	at time.Time,
+	limit int,
+) ([]entity.RoleAccessRequest, error) {
+	var rows []accessRequestModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		accessRequestSelect+`
+		 WHERE request.status IN ($1, $2) AND request.expires_at <= $3
+		 ORDER BY request.expires_at, request.id
+		 LIMIT $4`,
+		entity.AccessRequestStatusPending,
+		entity.AccessRequestStatusApproved,
+		at,
+		limit,
+	); err != nil {
+		return nil, err
+	}
+	return accessRequestsFromRows(rows), nil
+}
+
+func (r *AccessRequestRepositoryImpl) IsGroupMember(ctx context.Context, groupID uint64, userID uint) (bool, error) {
+	var member bool
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&member,
+		`SELECT EXISTS (SELECT 1 FROM iam_group_members WHERE group_id = $1 AND user_id = $2)`,
+		groupID,
+		userID,
+	); err != nil {
+		return false, err
+	}
+	return member, nil
+}
+
+func accessRequestsFromRows(rows []accessRequestModel) []entity.RoleAccessRequest {
+	requests := make([]entity.RoleAccessRequest, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, row.toEntity())
+	}
+	return requests
+}
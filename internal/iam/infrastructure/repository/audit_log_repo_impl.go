@@ -36,6 +36,6 @@ func (r *AuditLogRepositoryImpl) Create(ctx context.Context, log entity.AuditLog
 	if err != nil {
 		return err
 	}
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = runner(ctx, r.db).ExecContext(ctx, query, args...)
 	return err
 }
@@ -25,7 +25,7 @@ func NewGroupRepository(p repoParams) outputport.GroupRepository {
 
 func (r *GroupRepositoryImpl) CreateGroup(ctx context.Context, group entity.Group) (*entity.Group, error) {
 	var out groupModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`INSERT INTO iam_groups (
@@ -52,7 +52,7 @@ func (r *GroupRepositoryImpl) CreateGroup(ctx context.Context, group entity.Grou
 
 func (r *GroupRepositoryImpl) GetByID(ctx context.Context, groupID uint64) (*entity.Group, error) {
 	var out groupModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`SELECT id, scope, COALESCE(org_id, '') AS org_id,
@@ -105,7 +105,7 @@ func (r *GroupRepositoryImpl) ListGroups(
 		return collection.Page[entity.Group]{}, err
 	}
 	var total int64
-	if err := r.db.GetContext(ctx, &total, countSQL, countArgs...); err != nil {
+	if err := runner(ctx, r.db).GetContext(ctx, &total, countSQL, countArgs...); err != nil {
 		return collection.Page[entity.Group]{}, err
 	}
 
@@ -133,7 +133,7 @@ func (r *GroupRepositoryImpl) ListGroups(
 		return collection.Page[entity.Group]{}, err
 	}
 	var rows []groupModel
-	if err := r.db.SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
 		return collection.Page[entity.Group]{}, err
 	}
 	out := make([]entity.Group, 0, len(rows))
@@ -145,7 +145,7 @@ func (r *GroupRepositoryImpl) ListGroups(
 
 func (r *GroupRepositoryImpl) DeleteGroup(ctx context.Context, groupID uint64) error {
 	var group groupModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&group,
 		`SELECT id, scope, COALESCE(org_id, '') AS org_id,
@@ -163,12 +163,12 @@ func (r *GroupRepositoryImpl) DeleteGroup(ctx context.Context, groupID uint64) e
 	if group.IsSystem {
 		return entity.ErrImmutableGroup
 	}
-	_, err := r.db.ExecContext(ctx, `DELETE FROM iam_groups WHERE id = $1`, groupID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `DELETE FROM iam_groups WHERE id = $1`, groupID)
 	return err
 }
 
 func (r *GroupRepositoryImpl) PutInlinePolicy(ctx context.Context, input entity.PutGroupInlinePolicyInput) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -223,7 +223,7 @@ func (r *GroupRepositoryImpl) GetInlinePolicy(
 	name string,
 ) (*entity.GroupInlinePolicy, error) {
 	var policy groupInlinePolicyModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&policy,
 		`SELECT group_id, name, description, created_at, updated_at
@@ -280,7 +280,7 @@ func (r *GroupRepositoryImpl) ListInlinePolicies(
 }
 
 func (r *GroupRepositoryImpl) DeleteInlinePolicy(ctx context.Context, groupID uint64, name string) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_group_inline_policies WHERE group_id = $1 AND name = $2`,
 		groupID,
@@ -290,7 +290,7 @@ func (r *GroupRepositoryImpl) DeleteInlinePolicy(ctx context.Context, groupID ui
 }
 
 func (r *GroupRepositoryImpl) AddMember(ctx context.Context, groupID uint64, userID uint) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_group_members (group_id, user_id, created_at)
 		 VALUES ($1, $2, now())
@@ -302,7 +302,7 @@ func (r *GroupRepositoryImpl) AddMember(ctx context.Context, groupID uint64, use
 }
 
 func (r *GroupRepositoryImpl) RemoveMember(ctx context.Context, groupID uint64, userID uint) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_group_members WHERE group_id = $1 AND user_id = $2`,
 		groupID,
@@ -345,7 +345,7 @@ func (r *GroupRepositoryImpl) ListMembers(
 }
 
 func (r *GroupRepositoryImpl) AttachPolicy(ctx context.Context, groupID uint64, policyID uint64) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_group_policy_attachments (group_id, policy_id, created_at)
 		 VALUES ($1, $2, now())
@@ -357,7 +357,7 @@ func (r *GroupRepositoryImpl) AttachPolicy(ctx context.Context, groupID uint64,
 }
 
 func (r *GroupRepositoryImpl) DetachPolicy(ctx context.Context, groupID uint64, policyID uint64) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_group_policy_attachments WHERE group_id = $1 AND policy_id = $2`,
 		groupID,
@@ -414,7 +414,7 @@ func (r *GroupRepositoryImpl) listInlinePolicyStatements(
 		ConditionsJSON  string    `db:"conditions_json"`
 		CreatedAt       time.Time `db:"created_at"`
 	}
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT effect, action_pattern, resource_pattern, '[]' AS conditions_json, created_at
@@ -24,7 +24,7 @@ func NewInviteRepository(p repoParams) outputport.InviteRepository {
 }
 
 func (r *InviteRepositoryImpl) Create(ctx context.Context, invite entity.TenantInvite) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO tenant_invites
 		 (id, tenant_id, email, role_id, status, invited_by_user_id, token_hash,
@@ -50,7 +50,7 @@ func (r *InviteRepositoryImpl) Create(ctx context.Context, invite entity.TenantI
 
 func (r *InviteRepositoryImpl) GetByID(ctx context.Context, inviteID string) (*entity.TenantInvite, error) {
 	var out inviteModel
-	if err := r.db.GetContext(ctx, &out, `
+	if err := runner(ctx, r.db).GetContext(ctx, &out, `
 		SELECT ti.id, ti.tenant_id, ti.email, ti.role_id, r.name AS role_name, 
 			ti.status, ti.invited_by_user_id, ti.accepted_by_user_id, 
 			ti.token_hash, ti.created_at, ti.updated_at, ti.expires_at, 
@@ -69,7 +69,7 @@ func (r *InviteRepositoryImpl) GetByID(ctx context.Context, inviteID string) (*e
 
 func (r *InviteRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.TenantInvite, error) {
 	var out inviteModel
-	if err := r.db.GetContext(ctx, &out, `
+	if err := runner(ctx, r.db).GetContext(ctx, &out, `
 		SELECT ti.id, ti.tenant_id, ti.email, ti.role_id, r.name AS role_name, ti.status, ti.invited_by_user_id,
 		       ti.accepted_by_user_id, ti.token_hash, ti.created_at, ti.updated_at, ti.expires_at, ti.accepted_at, ti.revoked_at
 		FROM tenant_invites ti
@@ -132,7 +132,7 @@ func (r *InviteRepositoryImpl) MarkAccepted(
 	acceptedByUserID uint,
 	acceptedAt time.Time,
 ) error {
-	res, err := r.db.ExecContext(ctx, `
+	res, err := runner(ctx, r.db).ExecContext(ctx, `
 		UPDATE tenant_invites
 		SET status = $2, accepted_by_user_id = $3, accepted_at = $4, updated_at = $4
 		WHERE id = $1
@@ -148,7 +148,7 @@ func (r *InviteRepositoryImpl) MarkAccepted(
 }
 
 func (r *InviteRepositoryImpl) MarkRevoked(ctx context.Context, inviteID string, revokedAt time.Time) error {
-	res, err := r.db.ExecContext(ctx, `
+	res, err := runner(ctx, r.db).ExecContext(ctx, `
 		UPDATE tenant_invites
 		SET status = $2, revoked_at = $3, updated_at = $3
 		WHERE id = $1
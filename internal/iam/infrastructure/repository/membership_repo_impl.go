@@ -23,7 +23,7 @@ func NewMembershipRepository(p repoParams) outputport.MembershipRepository {
 }
 
 func (r *MembershipRepositoryImpl) Upsert(ctx context.Context, membership entity.Membership) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO tenant_memberships (tenant_id, user_id, role_id, status, created_at, updated_at)
 		 VALUES ($1, $2, $3, $4, $5, $6)
@@ -47,7 +47,7 @@ func (r *MembershipRepositoryImpl) GetByTenantAndUser(
 	userID uint,
 ) (*entity.Membership, error) {
 	var out membershipModel
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`SELECT tm.tenant_id, tm.user_id, tm.role_id, r.name AS role_name, tm.status, tm.created_at, tm.updated_at
@@ -119,7 +119,7 @@ func (r *MembershipRepositoryImpl) ListPageByTenant(
 
 func (r *MembershipRepositoryImpl) ListByUser(ctx context.Context, userID uint) ([]entity.Membership, error) {
 	var rows []membershipModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT memberships.tenant_id,
@@ -179,7 +179,7 @@ func (r *MembershipRepositoryImpl) ListByUser(ctx context.Context, userID uint)
 }
 
 func (r *MembershipRepositoryImpl) Delete(ctx context.Context, tenantID string, userID uint) error {
-	res, err := r.db.ExecContext(
+	res, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM tenant_memberships WHERE tenant_id = $1 AND user_id = $2`,
 		tenantID,
@@ -321,3 +321,203 @@ func (m roleTrustStatementModel) toEntity() entity.RoleTrustStatement {
 		CreatedAt:         m.CreatedAt,
 	}
 }
+
+type scimTokenModel struct {
+	ID              string     `db:"id"`
+	OrgID           string     `db:"org_id"`
+	Name            string     `db:"name"`
+	TokenHash       string     `db:"token_hash"`
+	TokenPrefix     string     `db:"token_prefix"`
+	CreatedByUserID uint       `db:"created_by_user_id"`
+	CreatedAt       time.Time  `db:"created_at"`
+	LastUsedAt      *time.Time `db:"last_used_at"`
+	RevokedAt       *time.Time `db:"revoked_at"`
+}
+
+type scimUserModel struct {
+	OrgID       string    `db:"org_id"`
+	UserID      uint      `db:"user_id"`
+	ExternalID  string    `db:"external_id"`
+	UserName    string    `db:"user_name"`
+	Email       string    `db:"email"`
+	GivenName   string    `db:"given_name"`
+	FamilyName  string    `db:"family_name"`
+	DisplayName string    `db:"display_name"`
+	Active      bool      `db:"active"`
+	OwnsAccount bool      `db:"owns_account"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type scimGroupModel struct {
+	GroupID     uint64    `db:"group_id"`
+	OrgID       string    `db:"org_id"`
+	ExternalID  string    `db:"external_id"`
+	DisplayName string    `db:"display_name"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (m scimTokenModel) toEntity() entity.SCIMToken {
+	return entity.SCIMToken{
+		ID:              m.ID,
+		OrgID:           m.OrgID,
+		Name:            m.Name,
+		TokenHash:       m.TokenHash,
+		TokenPrefix:     m.TokenPrefix,
+		CreatedByUserID: m.CreatedByUserID,
+		CreatedAt:       m.CreatedAt,
+		LastUsedAt:      m.LastUsedAt,
+		RevokedAt:       m.RevokedAt,
+	}
+}
+
+func (m scimUserModel) toEntity() entity.SCIMUser {
+	return entity.SCIMUser{
+		OrgID:       m.OrgID,
+		UserID:      m.UserID,
+		ExternalID:  m.ExternalID,
+		UserName:    m.UserName,
+		Email:       m.Email,
+		GivenName:   m.GivenName,
+		FamilyName:  m.FamilyName,
+		DisplayName: m.DisplayName,
+		Active:      m.Active,
+		OwnsAccount: m.OwnsAccount,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (m scimGroupModel) toEntity(members []uint) entity.SCIMGroup {
+	if members == nil {
+		members = []uint{}
+	}
+	return entity.SCIMGroup{
+		OrgID:       m.OrgID,
+		GroupID:     m.GroupID,
+		ExternalID:  m.ExternalID,
+		DisplayName: m.DisplayName,
+		Members:     members,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+type samlConnectionModel struct {
+	OrgID             string    `db:"org_id"`
+	IdPEntityID       string    `db:"idp_entity_id"`
+	SSOURL            string    `db:"sso_url"`
+	MetadataXML       string    `db:"metadata_xml"`
+	UsernameAttribute string    `db:"username_attribute"`
+	EmailAttribute    string    `db:"email_attribute"`
+	GroupsAttribute   string    `db:"groups_attribute"`
+	SSORequired       bool      `db:"sso_required"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+func (m samlConnectionModel) toEntity() entity.SAMLConnection {
+	return entity.SAMLConnection{
+		OrgID:             m.OrgID,
+		IdPEntityID:       m.IdPEntityID,
+		SSOURL:            m.SSOURL,
+		MetadataXML:       m.MetadataXML,
+		UsernameAttribute: m.UsernameAttribute,
+		EmailAttribute:    m.EmailAttribute,
+		GroupsAttribute:   m.GroupsAttribute,
+		SSORequired:       m.SSORequired,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+type roleAccessPolicyModel struct {
+	RoleID               uint64    `db:"role_id"`
+	RoleName             string    `db:"role_name"`
+	ApproverGroupID      uint64    `db:"approver_group_id"`
+	ApproverRoleID       uint64    `db:"approver_role_id"`
+	ApproverPlatformRole string    `db:"approver_platform_role"`
+	MaxDurationSeconds   int64     `db:"max_duration_seconds"`
+	CreatedAt            time.Time `db:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at"`
+}
+
+func (m roleAccessPolicyModel) toEntity() entity.RoleAccessPolicy {
+	return entity.RoleAccessPolicy{
+		RoleID:               m.RoleID,
+		RoleName:             m.RoleName,
+		ApproverGroupID:      m.ApproverGroupID,
+		ApproverRoleID:       m.ApproverRoleID,
+		ApproverPlatformRole: m.ApproverPlatformRole,
+		MaxDurationSeconds:   m.MaxDurationSeconds,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+	}
+}
+
+type accessRequestModel struct {
+	ID              string     `db:"id"`
+	RequesterUserID uint       `db:"requester_user_id"`
+	RoleID          uint64     `db:"role_id"`
+	RoleName        string     `db:"role_name"`
+	RoleScope       string     `db:"role_scope"`
+	TenantID        string     `db:"tenant_id"`
+	Justification   string     `db:"justification"`
+	Status          string     `db:"status"`
+	StartsAt        time.Time  `db:"starts_at"`
+	ExpiresAt       time.Time  `db:"expires_at"`
+	DecidedByUserID uint       `db:"decided_by_user_id"`
+	DecisionReason  string     `db:"decision_reason"`
+	DecidedAt       *time.Time `db:"decided_at"`
+	UsedAt          *time.Time `db:"used_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+}
+
+func (m accessRequestModel) toEntity() entity.RoleAccessRequest {
+	return entity.RoleAccessRequest{
+		ID:              m.ID,
+		RequesterUserID: m.RequesterUserID,
+		RoleID:          m.RoleID,
+		RoleName:        m.RoleName,
+		RoleScope:       m.RoleScope,
+		TenantID:        m.TenantID,
+		Justification:   m.Justification,
+		Status:          m.Status,
+		StartsAt:        m.StartsAt,
+		ExpiresAt:       m.ExpiresAt,
+		DecidedByUserID: m.DecidedByUserID,
+		DecisionReason:  m.DecisionReason,
+		DecidedAt:       m.DecidedAt,
+		UsedAt:          m.UsedAt,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+type accessActivityModel struct {
+	TenantID       string    `db:"tenant_id"`
+	UserID         uint      `db:"user_id"`
+	RoleID         uint64    `db:"role_id"`
+	Namespace      string    `db:"namespace"`
+	LastAccessedAt time.Time `db:"last_accessed_at"`
+}
+
+func (m accessActivityModel) toEntity() entity.AccessActivity {
+	return entity.AccessActivity{
+		TenantID:       m.TenantID,
+		UserID:         m.UserID,
+		RoleID:         m.RoleID,
+		Namespace:      m.Namespace,
+		LastAccessedAt: m.LastAccessedAt,
+	}
+}
+
+type groupMemberModel struct {
+	GroupID   uint64    `db:"group_id"`
+	GroupName string    `db:"group_name"`
+	TenantID  string    `db:"tenant_id"`
+	UserID    uint      `db:"user_id"`
+	AddedAt   time.Time `db:"added_at"`
+}
@@ -27,7 +27,7 @@ func (r *OrganizationRepositoryImpl) Create(
 	org entity.Organization,
 ) (*entity.Organization, error) {
 	var out organizationModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`INSERT INTO iam_organizations (id, slug, name, root_user_id, created_at, updated_at)
@@ -52,7 +52,7 @@ func (r *OrganizationRepositoryImpl) EnsureRoot(
 	org entity.Organization,
 ) (*entity.Organization, error) {
 	var out organizationModel
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`WITH root_org AS (
@@ -105,7 +105,7 @@ func (r *OrganizationRepositoryImpl) UpsertMembership(
 	ctx context.Context,
 	membership entity.OrganizationMembership,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_organization_memberships (
 			org_id, user_id, role_id, status, created_at, updated_at
@@ -126,7 +126,7 @@ func (r *OrganizationRepositoryImpl) UpsertMembership(
 }
 
 func (r *OrganizationRepositoryImpl) DeleteMembership(ctx context.Context, orgID string, userID uint) error {
-	result, err := r.db.ExecContext(
+	result, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_organization_memberships WHERE org_id = $1 AND user_id = $2`,
 		orgID,
@@ -151,7 +151,7 @@ func (r *OrganizationRepositoryImpl) GetMembership(
 	userID uint,
 ) (*entity.OrganizationMembership, error) {
 	var row organizationMembershipModel
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT om.org_id, om.user_id, om.role_id, r.name AS role_name,
@@ -267,7 +267,7 @@ func (r *OrganizationRepositoryImpl) list(
 		return collection.Page[entity.Organization]{}, err
 	}
 	var total int64
-	if err := r.db.GetContext(ctx, &total, countSQL, countArgs...); err != nil {
+	if err := runner(ctx, r.db).GetContext(ctx, &total, countSQL, countArgs...); err != nil {
 		return collection.Page[entity.Organization]{}, err
 	}
 
@@ -292,7 +292,7 @@ func (r *OrganizationRepositoryImpl) list(
 		return collection.Page[entity.Organization]{}, err
 	}
 	var rows []organizationModel
-	if err := r.db.SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
 		return collection.Page[entity.Organization]{}, err
 	}
 	out := make([]entity.Organization, 0, len(rows))
@@ -311,7 +311,7 @@ func (r *OrganizationRepositoryImpl) list(
 
 func (r *OrganizationRepositoryImpl) GetByID(ctx context.Context, orgID string) (*entity.Organization, error) {
 	var row organizationModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT id, slug, name, root_user_id, created_at, updated_at
@@ -332,7 +332,7 @@ func (r *OrganizationRepositoryImpl) GetByRootUserID(
 	userID uint,
 ) (*entity.Organization, error) {
 	var row organizationModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT id, slug, name, root_user_id, created_at, updated_at
@@ -350,7 +350,7 @@ func (r *OrganizationRepositoryImpl) GetByRootUserID(
 
 func (r *OrganizationRepositoryImpl) IsRoot(ctx context.Context, orgID string, userID uint) (bool, error) {
 	var exists bool
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&exists,
 		`SELECT EXISTS(
@@ -378,7 +378,7 @@ func (r *OrganizationRepositoryImpl) AttachServiceControlPolicy(
 	orgID string,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(ctx,
+	_, err := runner(ctx, r.db).ExecContext(ctx,
 		`INSERT INTO iam_org_service_control_policies (org_id, policy_id, created_at)
 		 VALUES ($1, $2, now())
 		 ON CONFLICT (org_id, policy_id) DO NOTHING`,
@@ -392,7 +392,7 @@ func (r *OrganizationRepositoryImpl) DetachServiceControlPolicy(
 	orgID string,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_org_service_control_policies WHERE org_id = $1 AND policy_id = $2`,
 		orgID,
@@ -406,7 +406,7 @@ func (r *OrganizationRepositoryImpl) ListServiceControlPolicies(
 	orgID string,
 ) ([]entity.Policy, error) {
 	var rows []policyModel
-	if err := r.db.SelectContext(ctx, &rows,
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows,
 		`SELECT p.id, p.scope, p.name, p.description, p.is_system, p.default_version, p.created_at, p.updated_at
 		 FROM iam_org_service_control_policies osp
 		 JOIN iam_policies p ON p.id = osp.policy_id
@@ -428,7 +428,7 @@ func (r *OrganizationRepositoryImpl) ListServiceControlPolicyStatements(
 	orgID string,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(ctx, &rows,
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
 		 FROM iam_org_service_control_policies osp
 		 JOIN iam_policies p ON p.id = osp.policy_id
@@ -441,3 +441,16 @@ func (r *OrganizationRepositoryImpl) ListServiceControlPolicyStatements(
 	}
 	return toPolicyStatements(rows), nil
 }
+
+func (r *OrganizationRepositoryImpl) ListTenantIDs(ctx context.Context, orgID string) ([]string, error) {
+	var ids []string
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&ids,
+		`SELECT id FROM tenants WHERE org_id = $1 ORDER BY id`,
+		orgID,
+	); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
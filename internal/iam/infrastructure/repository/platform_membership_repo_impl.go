@@ -27,7 +27,7 @@ func (r *PlatformMembershipRepositoryImpl) Upsert(
 	roleID uint64,
 	status string,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO user_platform_roles (user_id, role_id, status, created_at, updated_at)
 		 VALUES ($1, $2, $3, now(), now())
@@ -43,7 +43,7 @@ func (r *PlatformMembershipRepositoryImpl) Upsert(
 
 func (r *PlatformMembershipRepositoryImpl) ListRoleIDsByUser(ctx context.Context, userID uint) ([]uint64, error) {
 	var rows []platformMembershipModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT user_id, role_id, status, created_at, updated_at
@@ -73,7 +73,7 @@ func (r *PlatformMembershipRepositoryImpl) ListByUser(
 		CreatedAt time.Time `db:"created_at"`
 		UpdatedAt time.Time `db:"updated_at"`
 	}
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT upr.user_id, upr.role_id, r.name AS role_name, upr.status, upr.created_at, upr.updated_at
@@ -141,7 +141,7 @@ func (r *PlatformMembershipRepositoryImpl) ListPageByUser(
 }
 
 func (r *PlatformMembershipRepositoryImpl) Delete(ctx context.Context, userID uint, roleID uint64) error {
-	res, err := r.db.ExecContext(
+	res, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM user_platform_roles WHERE user_id = $1 AND role_id = $2`,
 		userID,
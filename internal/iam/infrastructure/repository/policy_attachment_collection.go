@@ -98,7 +98,7 @@ func (r *PolicyRepositoryImpl) listPolicyAttachmentPage(
 		return collection.Page[entity.PolicyAttachment]{}, err
 	}
 	var total int64
-	if err := r.db.GetContext(ctx, &total, countSQL, countArgs...); err != nil {
+	if err := runner(ctx, r.db).GetContext(ctx, &total, countSQL, countArgs...); err != nil {
 		return collection.Page[entity.PolicyAttachment]{}, err
 	}
 
@@ -126,7 +126,7 @@ func (r *PolicyRepositoryImpl) listPolicyAttachmentPage(
 		return collection.Page[entity.PolicyAttachment]{}, err
 	}
 	var rows []policyAttachmentModel
-	if err := r.db.SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
 		return collection.Page[entity.PolicyAttachment]{}, err
 	}
 	items := make([]entity.PolicyAttachment, 0, len(rows))
@@ -29,7 +29,7 @@ func (r *PolicyRepositoryImpl) CreatePolicy(
 	policy entity.Policy,
 	statements []entity.PolicyStatement,
 ) (*entity.Policy, []entity.PolicyStatement, error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -114,7 +114,7 @@ func (r *PolicyRepositoryImpl) GetPolicy(
 	ref entity.PolicyRef,
 ) (*entity.Policy, error) {
 	var out policyModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`SELECT id, scope, COALESCE(org_id, '') AS org_id, name, description,
@@ -139,7 +139,7 @@ func (r *PolicyRepositoryImpl) GetPolicyStatements(
 	policyID uint64,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
@@ -182,7 +182,7 @@ func (r *PolicyRepositoryImpl) ListPolicies(
 		return collection.Page[entity.Policy]{}, err
 	}
 	var total int64
-	if err := r.db.GetContext(ctx, &total, countSQL, countArgs...); err != nil {
+	if err := runner(ctx, r.db).GetContext(ctx, &total, countSQL, countArgs...); err != nil {
 		return collection.Page[entity.Policy]{}, err
 	}
 
@@ -210,7 +210,7 @@ func (r *PolicyRepositoryImpl) ListPolicies(
 		return collection.Page[entity.Policy]{}, err
 	}
 	var rows []policyModel
-	if err := r.db.SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
+	if err := runner(ctx, r.db).SelectContext(ctx, &rows, listSQL, listArgs...); err != nil {
 		return collection.Page[entity.Policy]{}, err
 	}
 	out := make([]entity.Policy, 0, len(rows))
@@ -229,7 +229,7 @@ func (r *PolicyRepositoryImpl) ListPolicyAttachments(
 }
 
 func (r *PolicyRepositoryImpl) DeletePolicy(ctx context.Context, policyID uint64) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -287,7 +287,7 @@ func (r *PolicyRepositoryImpl) CreatePolicyVersion(
 	statements []entity.PolicyStatement,
 	setAsDefault bool,
 ) (*entity.PolicyVersion, []entity.PolicyStatement, error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -395,7 +395,7 @@ func (r *PolicyRepositoryImpl) ListPolicyVersions(
 }
 
 func (r *PolicyRepositoryImpl) DeletePolicyVersion(ctx context.Context, policyID uint64, version string) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -429,7 +429,7 @@ func (r *PolicyRepositoryImpl) DeletePolicyVersion(ctx context.Context, policyID
 }
 
 func (r *PolicyRepositoryImpl) SetDefaultPolicyVersion(ctx context.Context, policyID uint64, version string) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -464,7 +464,7 @@ func (r *PolicyRepositoryImpl) ListRoleStatements(
 	roleID uint64,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
@@ -485,7 +485,7 @@ func (r *PolicyRepositoryImpl) ListPlatformUserStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, policy_id, policy_name, effect, action_pattern, resource_pattern, conditions_json, created_at
@@ -513,7 +513,7 @@ func (r *PolicyRepositoryImpl) PutPlatformUserPermissionBoundary(
 	userID uint,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_platform_user_permission_boundaries (user_id, policy_id, created_at, updated_at)
 		 VALUES ($1, $2, now(), now())
@@ -530,7 +530,7 @@ func (r *PolicyRepositoryImpl) GetPlatformUserPermissionBoundary(
 	userID uint,
 ) (*entity.PermissionBoundary, error) {
 	var row permissionBoundaryModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT 'platform' AS scope, '' AS tenant_id, pub.user_id, pub.policy_id, p.name AS policy_name, pub.created_at
@@ -552,7 +552,7 @@ func (r *PolicyRepositoryImpl) GetPlatformUserPermissionBoundaryStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
@@ -569,7 +569,7 @@ func (r *PolicyRepositoryImpl) GetPlatformUserPermissionBoundaryStatements(
 }
 
 func (r *PolicyRepositoryImpl) DeletePlatformUserPermissionBoundary(ctx context.Context, userID uint) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM iam_platform_user_permission_boundaries WHERE user_id = $1`, userID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `DELETE FROM iam_platform_user_permission_boundaries WHERE user_id = $1`, userID)
 	return err
 }
 
@@ -579,7 +579,7 @@ func (r *PolicyRepositoryImpl) ListTenantUserStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, policy_id, policy_name, effect, action_pattern, resource_pattern, conditions_json, created_at
@@ -609,7 +609,7 @@ func (r *PolicyRepositoryImpl) PutTenantUserPermissionBoundary(
 	userID uint,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_tenant_user_permission_boundaries (tenant_id, user_id, policy_id, created_at, updated_at)
 		 VALUES ($1, $2, $3, now(), now())
@@ -628,7 +628,7 @@ func (r *PolicyRepositoryImpl) GetTenantUserPermissionBoundary(
 	userID uint,
 ) (*entity.PermissionBoundary, error) {
 	var row permissionBoundaryModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT 'tenant' AS scope, tub.tenant_id, tub.user_id, tub.policy_id, p.name AS policy_name, tub.created_at
@@ -652,7 +652,7 @@ func (r *PolicyRepositoryImpl) GetTenantUserPermissionBoundaryStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
@@ -674,7 +674,7 @@ func (r *PolicyRepositoryImpl) DeleteTenantUserPermissionBoundary(
 	tenantID string,
 	userID uint,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_tenant_user_permission_boundaries WHERE tenant_id = $1 AND user_id = $2`,
 		tenantID,
@@ -685,7 +685,7 @@ func (r *PolicyRepositoryImpl) DeleteTenantUserPermissionBoundary(
 
 func (r *PolicyRepositoryImpl) syncDefaultPolicyVersionTx(
 	ctx context.Context,
-	tx *sqlx.Tx,
+	tx sqlx.ExecerContext,
 	policyID uint64,
 	version string,
 ) error {
@@ -717,7 +717,7 @@ func (r *PolicyRepositoryImpl) ListPlatformGroupStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, policy_id, policy_name, effect, action_pattern, resource_pattern, conditions_json, created_at
@@ -750,7 +750,7 @@ func (r *PolicyRepositoryImpl) ListOrganizationGroupStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, policy_id, policy_name, effect, action_pattern,
@@ -788,7 +788,7 @@ func (r *PolicyRepositoryImpl) ListTenantGroupStatements(
 	userID uint,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, policy_id, policy_name, effect, action_pattern, resource_pattern, conditions_json, created_at
@@ -817,7 +817,7 @@ func (r *PolicyRepositoryImpl) ListTenantGroupStatements(
 }
 
 func (r *PolicyRepositoryImpl) AttachPlatformUserPolicy(ctx context.Context, userID uint, policyID uint64) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_user_policy_attachments (user_id, scope, policy_id, created_at)
 		 VALUES ($1, 'platform', $2, now())
@@ -829,7 +829,7 @@ func (r *PolicyRepositoryImpl) AttachPlatformUserPolicy(ctx context.Context, use
 }
 
 func (r *PolicyRepositoryImpl) DetachPlatformUserPolicy(ctx context.Context, userID uint, policyID uint64) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_user_policy_attachments
 		 WHERE user_id = $1 AND scope = 'platform' AND policy_id = $2`,
@@ -896,7 +896,7 @@ func (r *PolicyRepositoryImpl) ListPlatformUserInlinePolicies(
 }
 
 func (r *PolicyRepositoryImpl) DeletePlatformUserInlinePolicy(ctx context.Context, userID uint, name string) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_platform_user_inline_policies WHERE user_id = $1 AND name = $2`,
 		userID,
@@ -911,7 +911,7 @@ func (r *PolicyRepositoryImpl) AttachTenantUserPolicy(
 	userID uint,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_tenant_user_policy_attachments (tenant_id, user_id, policy_id, created_at)
 		 VALUES ($1, $2, $3, now())
@@ -929,7 +929,7 @@ func (r *PolicyRepositoryImpl) DetachTenantUserPolicy(
 	userID uint,
 	policyID uint64,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_tenant_user_policy_attachments
 		 WHERE tenant_id = $1 AND user_id = $2 AND policy_id = $3`,
@@ -1006,7 +1006,7 @@ func (r *PolicyRepositoryImpl) DeleteTenantUserInlinePolicy(
 	userID uint,
 	name string,
 ) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM iam_tenant_user_inline_policies WHERE tenant_id = $1 AND user_id = $2 AND name = $3`,
 		tenantID,
@@ -1021,7 +1021,7 @@ func (r *PolicyRepositoryImpl) putUserInlinePolicy(
 	policy userInlinePolicyModel,
 	statements []entity.PolicyStatement,
 ) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -1116,7 +1116,7 @@ func (r *PolicyRepositoryImpl) getPlatformUserInlinePolicy(
 	name string,
 ) (*entity.UserInlinePolicy, error) {
 	var policy userInlinePolicyModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&policy,
 		`SELECT 'platform' AS scope, '' AS tenant_id, user_id, name, description, created_at, updated_at
@@ -1181,7 +1181,7 @@ func (r *PolicyRepositoryImpl) getTenantUserInlinePolicy(
 	name string,
 ) (*entity.UserInlinePolicy, error) {
 	var policy userInlinePolicyModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&policy,
 		`SELECT 'tenant' AS scope, tenant_id, user_id, name, description, created_at, updated_at
@@ -1247,7 +1247,7 @@ func (r *PolicyRepositoryImpl) listPlatformUserInlinePolicyStatements(
 	name string,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT 0 AS id, 0 AS policy_id, policy_name, effect, action_pattern, resource_pattern, '[]' AS conditions_json, created_at
@@ -1269,7 +1269,7 @@ func (r *PolicyRepositoryImpl) listTenantUserInlinePolicyStatements(
 	name string,
 ) ([]entity.PolicyStatement, error) {
 	var rows []policyStatementModel
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT 0 AS id, 0 AS policy_id, policy_name, effect, action_pattern, resource_pattern, '[]' AS conditions_json, created_at
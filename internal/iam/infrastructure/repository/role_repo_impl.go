@@ -24,7 +24,7 @@ func NewRoleRepository(p repoParams) outputport.RoleRepository {
 
 func (r *RoleRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Role, error) {
 	var out roleModel
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`SELECT id, scope, name, description, is_system, created_at, updated_at FROM iam_roles WHERE name = $1`,
@@ -79,7 +79,7 @@ func (r *RoleRepositoryImpl) ListPermissions(
 
 func (r *RoleRepositoryImpl) RoleHasPermission(ctx context.Context, roleID uint64, permission string) (bool, error) {
 	var exists bool
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&exists,
 		`SELECT EXISTS (
@@ -99,7 +99,7 @@ func (r *RoleRepositoryImpl) PutTrustPolicy(
 	roleID uint64,
 	statements []entity.RoleTrustStatement,
 ) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -130,7 +130,7 @@ func (r *RoleRepositoryImpl) PutTrustPolicy(
 
 func (r *RoleRepositoryImpl) GetTrustPolicy(ctx context.Context, roleID uint64) ([]entity.RoleTrustStatement, error) {
 	rows := make([]roleTrustStatementModel, 0)
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT id, role_id, effect, principal_type, principal_pattern, tenant_pattern, external_id_pattern, created_at
@@ -149,12 +149,12 @@ func (r *RoleRepositoryImpl) GetTrustPolicy(ctx context.Context, roleID uint64)
 }
 
 func (r *RoleRepositoryImpl) DeleteTrustPolicy(ctx context.Context, roleID uint64) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM iam_role_trust_statements WHERE role_id = $1`, roleID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `DELETE FROM iam_role_trust_statements WHERE role_id = $1`, roleID)
 	return err
 }
 
 func (r *RoleRepositoryImpl) PutPermissionBoundary(ctx context.Context, roleID uint64, policyID uint64) error {
-	_, err := r.db.ExecContext(
+	_, err := runner(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO iam_role_permission_boundaries (role_id, policy_id, created_at, updated_at)
 		 VALUES ($1, $2, now(), now())
@@ -177,7 +177,7 @@ func (r *RoleRepositoryImpl) GetPermissionBoundary(
 		PolicyName string    `db:"policy_name"`
 		CreatedAt  time.Time `db:"created_at"`
 	}
-	if err := r.db.GetContext(
+	if err := runner(ctx, r.db).GetContext(
 		ctx,
 		&row,
 		`SELECT rpb.role_id, r.name AS role_name, rpb.policy_id, p.name AS policy_name, rpb.created_at
@@ -206,7 +206,7 @@ func (r *RoleRepositoryImpl) GetPermissionBoundaryStatements(
 	roleID uint64,
 ) ([]entity.PolicyStatement, error) {
 	rows := make([]policyStatementModel, 0)
-	if err := r.db.SelectContext(
+	if err := runner(ctx, r.db).SelectContext(
 		ctx,
 		&rows,
 		`SELECT ps.id, ps.policy_id, p.name AS policy_name, ps.effect, ps.action_pattern, ps.resource_pattern, ps.conditions_json, ps.created_at
@@ -223,6 +223,6 @@ func (r *RoleRepositoryImpl) GetPermissionBoundaryStatements(
 }
 
 func (r *RoleRepositoryImpl) DeletePermissionBoundary(ctx context.Context, roleID uint64) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM iam_role_permission_boundaries WHERE role_id = $1`, roleID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `DELETE FROM iam_role_permission_boundaries WHERE role_id = $1`, roleID)
 	return err
 }
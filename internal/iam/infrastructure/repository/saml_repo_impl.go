@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+type SAMLConnectionRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+var _ outputport.SAMLConnectionRepository = (*SAMLConnectionRepositoryImpl)(nil)
+
+func NewSAMLConnectionRepository(p repoParams) outputport.SAMLConnectionRepository {
+	return &SAMLConnectionRepositoryImpl{db: p.DB}
+}
+
+const samlConnectionColumns = `org_id, idp_entity_id, sso_url, metadata_xml, username_attribute,
+	email_attribute, groups_attribute, sso_required, created_at, updated_at`
+
+func (r *SAMLConnectionRepositoryImpl) PutConnection(
+	ctx context.Context,
+	connection entity.SAMLConnection,
+) (*entity.SAMLConnection, error) {
+	var row samlConnectionModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		`INSERT INTO iam_saml_connections (`+samlConnectionColumns+`)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		 ON CONFLICT (org_id) DO UPDATE SET
+		   idp_entity_id = EXCLUDED.idp_entity_id,
+		   sso_url = EXCLUDED.sso_url,
+		   metadata_xml = EXCLUDED.metadata_xml,
+		   username_attribute = EXCLUDED.username_attribute,
+		   email_attribute = EXCLUDED.email_attribute,
+		   groups_attribute = EXCLUDED.groups_attribute,
+		   sso_required = EXCLUDED.sso_required,
+		   updated_at = EXCLUDED.updated_at
+		 RETURNING `+samlConnectionColumns,
+		connection.OrgID,
+		connection.IdPEntityID,
+		connection.SSOURL,
+		connection.MetadataXML,
+		connection.UsernameAttribute,
+		connection.EmailAttribute,
+		connection.GroupsAttribute,
+		connection.SSORequired,
+		connection.CreatedAt,
+		connection.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	out := row.toEntity()
+	return &out, nil
+}
+
+func (r *SAMLConnectionRepositoryImpl) GetConnection(ctx context.Context, orgID string) (*entity.SAMLConnection, error) {
+	var row samlConnectionModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		`SELECT `+samlConnectionColumns+` FROM iam_saml_connections WHERE org_id = $1`,
+		orgID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrSAMLConnectionNotFound
+		}
+		return nil, err
+	}
+	out := row.toEntity()
+	return &out, nil
+}
+
+func (r *SAMLConnectionRepositoryImpl) DeleteConnection(ctx context.Context, orgID string) error {
+	result, err := runner(ctx, r.db).ExecContext(ctx, `DELETE FROM iam_saml_connections WHERE org_id = $1`, orgID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrSAMLConnectionNotFound
+	}
+	return nil
+}
+
+func (r *SAMLConnectionRepositoryImpl) ListSSORequiredOrganizations(ctx context.Context, userID uint) ([]string, error) {
+	orgIDs := []string{}
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&orgIDs,
+		`SELECT conn.org_id
+		 FROM iam_saml_connections conn
+		 JOIN iam_organization_memberships membership ON membership.org_id = conn.org_id
+		 WHERE conn.sso_required AND membership.user_id = $1 AND membership.status = $2
+		 ORDER BY conn.org_id`,
+		userID,
+		entity.MembershipStatusActive,
+	); err != nil {
+		return nil, err
+	}
+	return orgIDs, nil
+}
+
+func (r *SAMLConnectionRepositoryImpl) ListOrganizationGroups(ctx context.Context, orgID string) ([]entity.Group, error) {
+	var rows []groupModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT id, scope, COALESCE(org_id, '') AS org_id,
+		        COALESCE(tenant_id, '') AS tenant_id, name, description,
+		        is_system, created_at, updated_at
+		 FROM iam_groups
+		 WHERE scope = $2 AND org_id = $1
+		 ORDER BY id`,
+		orgID,
+		entity.PolicyScopeOrganization,
+	); err != nil {
+		return nil, err
+	}
+	groups := make([]entity.Group, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, row.toEntity())
+	}
+	return groups, nil
+}
+
+func (r *SAMLConnectionRepositoryImpl) ListUserOrganizationGroupIDs(
+	ctx context.Context,
+	orgID string,
+	userID uint,
+) ([]uint64, error) {
+	groupIDs := []uint64{}
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&groupIDs,
+		`SELECT member.group_id
+		 FROM iam_group_members member
+		 JOIN iam_groups grp ON grp.id = member.group_id
+		 WHERE grp.scope = $3 AND grp.org_id = $1 AND member.user_id = $2
+		 ORDER BY member.group_id`,
+		orgID,
+		userID,
+		entity.PolicyScopeOrganization,
+	); err != nil {
+		return nil, err
+	}
+	return groupIDs, nil
+}
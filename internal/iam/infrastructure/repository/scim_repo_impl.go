@@ -0,0 +1,405 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+)
+
+type SCIMRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+var _ outputport.SCIMRepository = (*SCIMRepositoryImpl)(nil)
+
+func NewSCIMRepository(p repoParams) outputport.SCIMRepository {
+	return &SCIMRepositoryImpl{db: p.DB}
+}
+
+const scimTokenColumns = `id, org_id, name, token_hash, token_prefix, created_by_user_id,
+	created_at, last_used_at, revoked_at`
+
+func (r *SCIMRepositoryImpl) CreateToken(ctx context.Context, token entity.SCIMToken) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO iam_scim_tokens (
+		   id, org_id, name, token_hash, token_prefix, created_by_user_id, created_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		token.ID,
+		token.OrgID,
+		token.Name,
+		token.TokenHash,
+		token.TokenPrefix,
+		token.CreatedByUserID,
+		token.CreatedAt,
+	)
+	return err
+}
+
+func (r *SCIMRepositoryImpl) GetTokenByHash(ctx context.Context, tokenHash string) (*entity.SCIMToken, error) {
+	var row scimTokenModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		`SELECT `+scimTokenColumns+` FROM iam_scim_tokens WHERE token_hash = $1`,
+		tokenHash,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrSCIMTokenNotFound
+		}
+		return nil, err
+	}
+	token := row.toEntity()
+	return &token, nil
+}
+
+func (r *SCIMRepositoryImpl) ListTokens(ctx context.Context, orgID string) ([]entity.SCIMToken, error) {
+	var rows []scimTokenModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT `+scimTokenColumns+` FROM iam_scim_tokens WHERE org_id = $1 ORDER BY created_at DESC`,
+		orgID,
+	); err != nil {
+		return nil, err
+	}
+	tokens := make([]entity.SCIMToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, row.toEntity())
+	}
+	return tokens, nil
+}
+
+func (r *SCIMRepositoryImpl) RevokeToken(ctx context.Context, orgID, tokenID string, revokedAt time.Time) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_scim_tokens SET revoked_at = $3
+		 WHERE org_id = $1 AND id = $2 AND revoked_at IS NULL`,
+		orgID,
+		tokenID,
+		revokedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrSCIMTokenNotFound
+	}
+	return nil
+}
+
+func (r *SCIMRepositoryImpl) TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_scim_tokens SET last_used_at = $2 WHERE id = $1`,
+		tokenID,
+		usedAt,
+	)
+	return err
+}
+
+const scimUserColumns = `org_id, user_id, external_id, user_name, email, given_name, family_name,
+	display_name, active, owns_account, created_at, updated_at`
+
+func (r *SCIMRepositoryImpl) CreateUser(ctx context.Context, user entity.SCIMUser) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO iam_scim_users (`+scimUserColumns+`)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		user.OrgID,
+		user.UserID,
+		user.ExternalID,
+		user.UserName,
+		user.Email,
+		user.GivenName,
+		user.FamilyName,
+		user.DisplayName,
+		user.Active,
+		user.OwnsAccount,
+		user.CreatedAt,
+		user.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return entity.ErrSCIMUserExists
+	}
+	return err
+}
+
+func (r *SCIMRepositoryImpl) GetUser(ctx context.Context, orgID string, userID uint) (*entity.SCIMUser, error) {
+	return r.getUser(
+		ctx,
+		`SELECT `+scimUserColumns+` FROM iam_scim_users WHERE org_id = $1 AND user_id = $2`,
+		orgID,
+		userID,
+	)
+}
+
+func (r *SCIMRepositoryImpl) GetUserByUserName(
+	ctx context.Context,
+	orgID, userName string,
+) (*entity.SCIMUser, error) {
+	return r.getUser(
+		ctx,
+		`SELECT `+scimUserColumns+` FROM iam_scim_users WHERE org_id = $1 AND lower(user_name) = $2`,
+		orgID,
+		entity.NormalizeSCIMUserName(userName),
+	)
+}
+
+func (r *SCIMRepositoryImpl) getUser(ctx context.Context, query string, args ...any) (*entity.SCIMUser, error) {
+	var row scimUserModel
+	if err := runner(ctx, r.db).GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrSCIMUserNotFound
+		}
+		return nil, err
+	}
+	user := row.toEntity()
+	return &user, nil
+}
+
+func (r *SCIMRepositoryImpl) ListUsers(ctx context.Context, orgID string) ([]entity.SCIMUser, error) {
+	var rows []scimUserModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		`SELECT `+scimUserColumns+` FROM iam_scim_users WHERE org_id = $1 ORDER BY created_at, user_id`,
+		orgID,
+	); err != nil {
+		return nil, err
+	}
+	users := make([]entity.SCIMUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, row.toEntity())
+	}
+	return users, nil
+}
+
+func (r *SCIMRepositoryImpl) UpdateUser(ctx context.Context, user entity.SCIMUser) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE iam_scim_users
+		 SET external_id = $3, user_name = $4, given_name = $5, family_name = $6,
+		     display_name = $7, active = $8, updated_at = $9
+		 WHERE org_id = $1 AND user_id = $2`,
+		user.OrgID,
+		user.UserID,
+		user.ExternalID,
+		user.UserName,
+		user.GivenName,
+		user.FamilyName,
+		user.DisplayName,
+		user.Active,
+		user.UpdatedAt,
+	)
+	return scimRowsAffected(result, err, entity.ErrSCIMUserNotFound)
+}
+
+func (r *SCIMRepositoryImpl) DeleteUser(ctx context.Context, orgID string, userID uint) error {
+	result, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`DELETE FROM iam_scim_users WHERE org_id = $1 AND user_id = $2`,
+		orgID,
+		userID,
+	)
+	return scimRowsAffected(result, err, entity.ErrSCIMUserNotFound)
+}
+
+func (r *SCIMRepositoryImpl) RemoveOrganizationGroupMemberships(
+	ctx context.Context,
+	orgID string,
+	userID uint,
+) error {
+	_, err := runner(ctx, r.db).ExecContext(
+		ctx,
+		`DELETE FROM iam_group_members
+		 WHERE user_id = $2
+		   AND group_id IN (
+		     SELECT id FROM iam_groups WHERE scope = $3 AND org_id = $1
+		   )`,
+		orgID,
+		userID,
+		entity.PolicyScopeOrganization,
+	)
+	return err
+}
+
+const scimGroupSelect = `SELECT sg.group_id, sg.org_id, sg.external_id, g.name AS display_name,
+	       sg.created_at, sg.updated_at
+	FROM iam_scim_groups sg
+	JOIN iam_groups g ON g.id = sg.group_id`
+
+func (r *SCIMRepositoryImpl) CreateGroup(ctx context.Context, group entity.SCIMGroup) (*entity.SCIMGroup, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var groupID uint64
+	if err := tx.GetContext(
+		ctx,
+		&groupID,
+		`INSERT INTO iam_groups (scope, org_id, name, description, is_system, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, FALSE, $5, $5)
+		 RETURNING id`,
+		entity.PolicyScopeOrganization,
+		group.OrgID,
+		group.DisplayName,
+		"Provisioned by SCIM",
+		group.CreatedAt,
+	); err != nil {
+		if isUniqueViolation(err) {
+			return nil, entity.ErrSCIMGroupExists
+		}
+		return nil, err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO iam_scim_groups (group_id, org_id, external_id, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $4)`,
+		groupID,
+		group.OrgID,
+		group.ExternalID,
+		group.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	group.GroupID = groupID
+	group.UpdatedAt = group.CreatedAt
+	group.Members = []uint{}
+	return &group, nil
+}
+
+func (r *SCIMRepositoryImpl) GetGroup(ctx context.Context, orgID string, groupID uint64) (*entity.SCIMGroup, error) {
+	var row scimGroupModel
+	if err := runner(ctx, r.db).GetContext(
+		ctx,
+		&row,
+		scimGroupSelect+` WHERE sg.org_id = $1 AND sg.group_id = $2`,
+		orgID,
+		groupID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrSCIMGroupNotFound
+		}
+		return nil, err
+	}
+	var members []uint
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&members,
+		`SELECT user_id FROM iam_group_members WHERE group_id = $1 ORDER BY user_id`,
+		groupID,
+	); err != nil {
+		return nil, err
+	}
+	group := row.toEntity(members)
+	return &group, nil
+}
+
+func (r *SCIMRepositoryImpl) ListGroups(ctx context.Context, orgID string) ([]entity.SCIMGroup, error) {
+	var rows []scimGroupModel
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&rows,
+		scimGroupSelect+` WHERE sg.org_id = $1 ORDER BY sg.created_at, sg.group_id`,
+		orgID,
+	); err != nil {
+		return nil, err
+	}
+	var memberRows []struct {
+		GroupID uint64 `db:"group_id"`
+		UserID  uint   `db:"user_id"`
+	}
+	if err := runner(ctx, r.db).SelectContext(
+		ctx,
+		&memberRows,
+		`SELECT m.group_id, m.user_id
+		 FROM iam_group_members m
+		 JOIN iam_scim_groups sg ON sg.group_id = m.group_id
+		 WHERE sg.org_id = $1
+		 ORDER BY m.user_id`,
+		orgID,
+	); err != nil {
+		return nil, err
+	}
+	members := make(map[uint64][]uint, len(rows))
+	for _, row := range memberRows {
+		members[row.GroupID] = append(members[row.GroupID], row.UserID)
+	}
+	groups := make([]entity.SCIMGroup, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, row.toEntity(members[row.GroupID]))
+	}
+	return groups, nil
+}
+
+func (r *SCIMRepositoryImpl) UpdateGroup(ctx context.Context, group entity.SCIMGroup) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE iam_scim_groups SET external_id = $3, updated_at = $4
+		 WHERE org_id = $1 AND group_id = $2`,
+		group.OrgID,
+		group.GroupID,
+		group.ExternalID,
+		group.UpdatedAt,
+	)
+	if err := scimRowsAffected(result, err, entity.ErrSCIMGroupNotFound); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE iam_groups SET name = $2, updated_at = $3 WHERE id = $1`,
+		group.GroupID,
+		group.DisplayName,
+		group.UpdatedAt,
+	); err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrSCIMGroupExists
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func scimRowsAffected(result sql.Result, err error, notFound error) error {
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique constraint")
+}
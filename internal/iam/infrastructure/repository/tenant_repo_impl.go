@@ -30,7 +30,7 @@ func NewTenantRepository(p repoParams) outputport.TenantRepository {
 
 func (r *TenantRepositoryImpl) Create(ctx context.Context, tenant entity.Tenant) (*entity.Tenant, error) {
 	var out tenantModel
-	err := r.db.GetContext(
+	err := runner(ctx, r.db).GetContext(
 		ctx,
 		&out,
 		`INSERT INTO tenants (id, slug, name, org_id, created_at, updated_at)
@@ -61,7 +61,7 @@ func (r *TenantRepositoryImpl) Create(ctx context.Context, tenant entity.Tenant)
 
 func (r *TenantRepositoryImpl) GetByID(ctx context.Context, tenantID string) (*entity.Tenant, error) {
 	var out tenantModel
-	if err := r.db.GetContext(ctx, &out, `SELECT id, slug, name, org_id, created_at, updated_at FROM tenants WHERE id = $1`, tenantID); err != nil {
+	if err := runner(ctx, r.db).GetContext(ctx, &out, `SELECT id, slug, name, org_id, created_at, updated_at FROM tenants WHERE id = $1`, tenantID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, entity.ErrTenantNotFound
 		}
@@ -78,11 +78,11 @@ func (r *TenantRepositoryImpl) GetByID(ctx context.Context, tenantID string) (*e
 }
 
 func (r *TenantRepositoryImpl) AttachOrganization(ctx context.Context, tenantID string, orgID string) error {
-	_, err := r.db.ExecContext(ctx, `UPDATE tenants SET org_id = $2, updated_at = now() WHERE id = $1`, tenantID, orgID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `UPDATE tenants SET org_id = $2, updated_at = now() WHERE id = $1`, tenantID, orgID)
 	return err
 }
 
 func (r *TenantRepositoryImpl) DetachOrganization(ctx context.Context, tenantID string) error {
-	_, err := r.db.ExecContext(ctx, `UPDATE tenants SET org_id = '', updated_at = now() WHERE id = $1`, tenantID)
+	_, err := runner(ctx, r.db).ExecContext(ctx, `UPDATE tenants SET org_id = '', updated_at = now() WHERE id = $1`, tenantID)
 	return err
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type UnitOfWorkImpl struct {
+	db *sqlx.DB
+}
+
+var _ outputport.UnitOfWork = (*UnitOfWorkImpl)(nil)
+
+func NewUnitOfWork(p repoParams) outputport.UnitOfWork {
+	return &UnitOfWorkImpl{db: p.DB}
+}
+
+func (u *UnitOfWorkImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(messaging.ContextWithTx(ctx, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// sqlRunner is what the repositories need from either *sqlx.DB or *sqlx.Tx.
+type sqlRunner interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+// runner returns the unit of work's transaction when ctx carries one, otherwise db.
+func runner(ctx context.Context, db *sqlx.DB) sqlRunner {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx
+	}
+	return db
+}
+
+func txFromContext(ctx context.Context) *sqlx.Tx {
+	tx, _ := messaging.TxFromContext(ctx).(*sqlx.Tx)
+	return tx
+}
+
+// repoTx is a transaction a repository method needs for its own multi-statement writes. Inside
+// a unit of work it is the surrounding transaction, and Commit and Rollback are left to
+// UnitOfWork.Do.
+type repoTx struct {
+	*sqlx.Tx
+	joined bool
+}
+
+func beginTx(ctx context.Context, db *sqlx.DB) (*repoTx, error) {
+	if tx := txFromContext(ctx); tx != nil {
+		return &repoTx{Tx: tx, joined: true}, nil
+	}
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &repoTx{Tx: tx}, nil
+}
+
+func (t *repoTx) Commit() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *repoTx) Rollback() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/tuannm99/podzone/internal/iam/domain/entity"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingsqlstore "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+)
+
+var errInjected = errors.New("injected failure")
+
+// fakeDatabase keeps the statements it has committed. Statements run in a transaction are
+// buffered until Commit, so a failure or crash before it leaves nothing behind, as in Postgres.
+type fakeDatabase struct {
+	mu         sync.Mutex
+	committed  []string
+	execs      int
+	failExecAt int
+	failCommit bool
+}
+
+func newFakeDB(t *testing.T, fake *fakeDatabase) *sqlx.DB {
+	t.Helper()
+	db := sqlx.NewDb(sql.OpenDB(fake), "postgres")
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func (d *fakeDatabase) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: d}, nil }
+func (d *fakeDatabase) Driver() driver.Driver                        { return fakeDriver{} }
+
+// committedTables lists the table written by each committed statement, in order.
+func (d *fakeDatabase) committedTables() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tables := make([]string, 0, len(d.committed))
+	for _, query := range d.committed {
+		tables = append(tables, statementTable(query))
+	}
+	return tables
+}
+
+func (d *fakeDatabase) exec(query string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.execs++
+	if d.execs == d.failExecAt {
+		return errInjected
+	}
+	return nil
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return nil, errors.New("use sql.OpenDB") }
+
+type fakeConn struct {
+	db      *fakeDatabase
+	pending []string
+	inTx    bool
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.inTx = true
+	c.pending = nil
+	return c, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.db.exec(query); err != nil {
+		return nil, err
+	}
+	if c.inTx {
+		c.pending = append(c.pending, query)
+	} else {
+		c.db.mu.Lock()
+		c.db.committed = append(c.db.committed, query)
+		c.db.mu.Unlock()
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+func (c *fakeConn) Commit() error {
+	c.inTx = false
+	if c.db.failCommit {
+		c.pending = nil
+		return errInjected
+	}
+	c.db.mu.Lock()
+	c.db.committed = append(c.db.committed, c.pending...)
+	c.db.mu.Unlock()
+	c.pending = nil
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.inTx = false
+	c.pending = nil
+	return nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func statementTable(query string) string {
+	fields := strings.Fields(query)
+	for i, field := range fields {
+		switch strings.ToUpper(field) {
+		case "INTO", "UPDATE", "FROM":
+			if i+1 < len(fields) {
+				return fields[i+1]
+			}
+		}
+	}
+	return ""
+}
+
+type addMemberStep int
+
+const (
+	crashNone addMemberStep = iota
+	crashAfterMembership
+)
+
+// addMember mirrors IAMCommandUsecase.AddMember: the membership write and its
+// tenant.member.added record in one unit of work.
+func addMember(
+	ctx context.Context,
+	uow *UnitOfWorkImpl,
+	memberships *MembershipRepositoryImpl,
+	outbox *messagingsqlstore.OutboxStore,
+	crash addMemberStep,
+) error {
+	return uow.Do(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+		if err := memberships.Upsert(ctx, entity.Membership{
+			TenantID:  "t1",
+			UserID:    9,
+			RoleID:    2,
+			Status:    entity.MembershipStatusActive,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}); err != nil {
+			return err
+		}
+		if crash == crashAfterMembership {
+			panic("process crashed before the outbox append")
+		}
+		return outbox.Append(ctx, messaging.TxFromContext(ctx), messaging.OutboxRecord{
+			ID:            "evt-1",
+			Topic:         messaging.EventTopic("iam"),
+			MessageKey:    "t1",
+			Envelope:      messaging.Envelope{ID: "evt-1", Type: "tenant.member.added", TenantID: "t1"},
+			Status:        "pending",
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	})
+}
+
+func TestUnitOfWork_CrashInjection_NoLostOrPhantomEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		failExecAt    int
+		failCommit    bool
+		crash         addMemberStep
+		wantCommitted []string
+	}{
+		{name: "commits both", wantCommitted: []string{"tenant_memberships", iamOutboxTableName}},
+		{name: "membership write fails", failExecAt: 1},
+		{name: "outbox append fails", failExecAt: 2},
+		{name: "process crashes between writes", crash: crashAfterMembership},
+		{name: "commit fails", failCommit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeDatabase{failExecAt: tt.failExecAt, failCommit: tt.failCommit}
+			db := newFakeDB(t, fake)
+			outbox, err := messagingsqlstore.NewOutboxStore(db, iamOutboxTableName)
+			require.NoError(t, err)
+			uow := &UnitOfWorkImpl{db: db}
+			memberships := &MembershipRepositoryImpl{db: db}
+
+			run := func() error { return addMember(context.Background(), uow, memberships, outbox, tt.crash) }
+			if tt.crash != crashNone {
+				require.Panics(t, func() { _ = run() })
+			} else if tt.wantCommitted == nil {
+				require.ErrorIs(t, run(), errInjected)
+			} else {
+				require.NoError(t, run())
+			}
+
+			assert.Equal(t, tt.wantCommitted, nilIfEmpty(fake.committedTables()))
+		})
+	}
+}
+
+func TestUnitOfWork_RepositoryTransactionsJoin(t *testing.T) {
+	fake := &fakeDatabase{failExecAt: 6}
+	db := newFakeDB(t, fake)
+	outbox, err := messagingsqlstore.NewOutboxStore(db, iamOutboxTableName)
+	require.NoError(t, err)
+	policies := &PolicyRepositoryImpl{db: db}
+	uow := &UnitOfWorkImpl{db: db}
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		// SetDefaultPolicyVersion runs five statements in what would otherwise be its own
+		// transaction; here they wait for the outbox append, which fails.
+		if err := policies.SetDefaultPolicyVersion(ctx, 1, "v2"); err != nil {
+			return err
+		}
+		return outbox.Append(ctx, messaging.TxFromContext(ctx), messaging.OutboxRecord{ID: "evt-1"})
+	})
+
+	require.ErrorIs(t, err, errInjected)
+	assert.Empty(t, fake.committedTables())
+}
+
+func TestUnitOfWork_WithoutTransactionWritesDirectly(t *testing.T) {
+	fake := &fakeDatabase{}
+	db := newFakeDB(t, fake)
+	memberships := &MembershipRepositoryImpl{db: db}
+
+	require.NoError(t, memberships.Upsert(context.Background(), entity.Membership{TenantID: "t1", UserID: 9}))
+	assert.Equal(t, []string{"tenant_memberships"}, fake.committedTables())
+}
+
+func nilIfEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO iam_permissions (name, resource, action)
+VALUES
+  ('catalog:read', 'catalog', 'read'),
+  ('catalog:manage', 'catalog', 'manage')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO iam_role_permissions (role_id, permission_id)
+SELECT role.id, permission.id
+FROM iam_roles role
+JOIN iam_permissions permission ON permission.name IN ('catalog:read', 'catalog:manage')
+WHERE role.name IN ('tenant_owner', 'tenant_admin', 'tenant_editor')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO iam_role_permissions (role_id, permission_id)
+SELECT role.id, permission.id
+FROM iam_roles role
+JOIN iam_permissions permission ON permission.name = 'catalog:read'
+WHERE role.name = 'tenant_viewer'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO iam_policy_statements (policy_id, effect, action_pattern, resource_pattern)
+SELECT policy.id, 'allow', permission.name, '*'
+FROM iam_policies policy
+JOIN iam_permissions permission ON (
+  (policy.name IN ('managed/tenant_owner', 'managed/tenant_admin', 'managed/tenant_editor')
+    AND permission.name IN ('catalog:read', 'catalog:manage')) OR
+  (policy.name = 'managed/tenant_viewer' AND permission.name = 'catalog:read')
+)
+WHERE NOT EXISTS (
+  SELECT 1
+  FROM iam_policy_statements statement
+  WHERE statement.policy_id = policy.id
+    AND statement.effect = 'allow'
+    AND statement.action_pattern = permission.name
+    AND statement.resource_pattern = '*'
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM iam_policy_statements statement
+USING iam_policies policy
+WHERE statement.policy_id = policy.id
+  AND policy.name IN (
+    'managed/tenant_owner',
+    'managed/tenant_admin',
+    'managed/tenant_editor',
+    'managed/tenant_viewer'
+  )
+  AND statement.action_pattern IN ('catalog:read', 'catalog:manage');
+
+DELETE FROM iam_role_permissions
+WHERE permission_id IN (
+  SELECT id FROM iam_permissions WHERE name IN ('catalog:read', 'catalog:manage')
+);
+
+DELETE FROM iam_permissions
+WHERE name IN ('catalog:read', 'catalog:manage');
+-- +goose StatementEnd
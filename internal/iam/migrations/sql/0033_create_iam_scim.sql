@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS iam_scim_tokens (
+  id TEXT PRIMARY KEY,
+  org_id TEXT NOT NULL REFERENCES iam_organizations(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  token_hash TEXT NOT NULL UNIQUE,
+  token_prefix TEXT NOT NULL,
+  created_by_user_id BIGINT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_used_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_iam_scim_tokens_org_id
+ON iam_scim_tokens(org_id);
+
+CREATE TABLE IF NOT EXISTS iam_scim_users (
+  org_id TEXT NOT NULL REFERENCES iam_organizations(id) ON DELETE CASCADE,
+  user_id BIGINT NOT NULL,
+  external_id TEXT NOT NULL DEFAULT '',
+  user_name TEXT NOT NULL,
+  email TEXT NOT NULL,
+  given_name TEXT NOT NULL DEFAULT '',
+  family_name TEXT NOT NULL DEFAULT '',
+  display_name TEXT NOT NULL DEFAULT '',
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (org_id, user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_iam_scim_users_user_name
+ON iam_scim_users(org_id, lower(user_name));
+
+CREATE TABLE IF NOT EXISTS iam_scim_groups (
+  group_id BIGINT PRIMARY KEY REFERENCES iam_groups(id) ON DELETE CASCADE,
+  org_id TEXT NOT NULL REFERENCES iam_organizations(id) ON DELETE CASCADE,
+  external_id TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_iam_scim_groups_org_id
+ON iam_scim_groups(org_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_iam_scim_groups_org_id;
+DROP TABLE IF EXISTS iam_scim_groups;
+DROP INDEX IF EXISTS idx_iam_scim_users_user_name;
+DROP TABLE IF EXISTS iam_scim_users;
+DROP INDEX IF EXISTS idx_iam_scim_tokens_org_id;
+DROP TABLE IF EXISTS iam_scim_tokens;
+-- +goose StatementEnd
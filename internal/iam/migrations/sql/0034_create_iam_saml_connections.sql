@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS iam_saml_connections (
+  org_id TEXT PRIMARY KEY REFERENCES iam_organizations(id) ON DELETE CASCADE,
+  idp_entity_id TEXT NOT NULL,
+  sso_url TEXT NOT NULL,
+  metadata_xml TEXT NOT NULL,
+  username_attribute TEXT NOT NULL DEFAULT '',
+  email_attribute TEXT NOT NULL DEFAULT '',
+  groups_attribute TEXT NOT NULL DEFAULT '',
+  sso_required BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS iam_saml_connections;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS iam_role_access_policies (
+  role_id BIGINT PRIMARY KEY REFERENCES iam_roles(id) ON DELETE CASCADE,
+  approver_group_id BIGINT REFERENCES iam_groups(id) ON DELETE CASCADE,
+  approver_role_id BIGINT REFERENCES iam_roles(id) ON DELETE CASCADE,
+  max_duration_seconds BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK ((approver_group_id IS NULL) <> (approver_role_id IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS iam_access_requests (
+  id TEXT PRIMARY KEY,
+  requester_user_id BIGINT NOT NULL,
+  role_id BIGINT NOT NULL REFERENCES iam_roles(id) ON DELETE CASCADE,
+  tenant_id TEXT NOT NULL DEFAULT '',
+  justification TEXT NOT NULL,
+  status TEXT NOT NULL,
+  starts_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  decided_by_user_id BIGINT NOT NULL DEFAULT 0,
+  decision_reason TEXT NOT NULL DEFAULT '',
+  decided_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_iam_access_requests_grant
+  ON iam_access_requests(requester_user_id, role_id, tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_iam_access_requests_status_expires_at
+  ON iam_access_requests(status, expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS iam_access_requests;
+DROP TABLE IF EXISTS iam_role_access_policies;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS iam_access_activity (
+  tenant_id TEXT NOT NULL,
+  user_id BIGINT NOT NULL,
+  role_id BIGINT NOT NULL DEFAULT 0,
+  namespace TEXT NOT NULL,
+  last_accessed_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (tenant_id, user_id, role_id, namespace)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS iam_access_activity;
+-- +goose StatementEnd
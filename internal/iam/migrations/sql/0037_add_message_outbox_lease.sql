@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message_outbox
+ADD COLUMN IF NOT EXISTS lease_owner TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE message_outbox
+ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMPTZ NULL;
+
+-- Backs the per-key ordering check in ClaimPending.
+CREATE INDEX IF NOT EXISTS idx_message_outbox_pending_key
+  ON message_outbox (message_key, created_at, id)
+  WHERE status = 'pending';
+
+-- Wakes relays listening on message_outbox once the inserting transaction commits.
+CREATE OR REPLACE FUNCTION notify_message_outbox() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('message_outbox', '');
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_message_outbox_notify ON message_outbox;
+CREATE TRIGGER trg_message_outbox_notify
+  AFTER INSERT ON message_outbox
+  FOR EACH STATEMENT
+  EXECUTE FUNCTION notify_message_outbox();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_message_outbox_notify ON message_outbox;
+DROP FUNCTION IF EXISTS notify_message_outbox();
+DROP INDEX IF EXISTS idx_message_outbox_pending_key;
+
+ALTER TABLE message_outbox
+DROP COLUMN IF EXISTS lease_expires_at;
+
+ALTER TABLE message_outbox
+DROP COLUMN IF EXISTS lease_owner;
+-- +goose StatementEnd
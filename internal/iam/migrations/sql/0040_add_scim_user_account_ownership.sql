@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Links made before ownership was tracked may point at accounts registered outside the
+-- organization, so they default to not owning the account.
+ALTER TABLE iam_scim_users
+ADD COLUMN IF NOT EXISTS owns_account BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE iam_scim_users
+DROP COLUMN IF EXISTS owns_account;
+-- +goose StatementEnd
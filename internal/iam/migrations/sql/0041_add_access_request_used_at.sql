@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Grants used before this column existed read as unused and stay cancellable.
+ALTER TABLE iam_access_requests
+ADD COLUMN IF NOT EXISTS used_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE iam_access_requests
+DROP COLUMN IF EXISTS used_at;
+-- +goose StatementEnd
@@ -3,34 +3,70 @@ package iam
 import (
 	"go.uber.org/fx"
 
+	iamconfig "github.com/tuannm99/podzone/internal/iam/config"
 	"github.com/tuannm99/podzone/internal/iam/domain/inputport"
 	"github.com/tuannm99/podzone/internal/iam/domain/interactor"
 	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
+	"github.com/tuannm99/podzone/internal/iam/infrastructure/accessactivity"
 	"github.com/tuannm99/podzone/internal/iam/infrastructure/repository"
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
 var Module = fx.Options(
 	RepositoryModule,
+	DecisionCacheModule,
+	accessactivity.Module,
 	CommandUsecaseModule,
 	QueryUsecaseModule,
+	SCIMTokenUsecaseModule,
+	SCIMUsecaseModule,
+	SAMLConnectionUsecaseModule,
+	SAMLLoginUsecaseModule,
+	AccessRequestCommandUsecaseModule,
+	AccessRequestQueryUsecaseModule,
+	AccessAnalyzerUsecaseModule,
 )
 
 var CommandModule = fx.Options(
 	CommandRepositoryModule,
+	DecisionCacheModule,
+	accessactivity.Module,
 	CommandUsecaseModule,
+	SCIMTokenUsecaseModule,
+	SAMLConnectionUsecaseModule,
+	SAMLLoginUsecaseModule,
+	AccessRequestCommandUsecaseModule,
 )
 
 var QueryModule = fx.Options(
 	QueryRepositoryModule,
+	DecisionCacheModule,
+	accessactivity.Module,
 	QueryUsecaseModule,
+	SCIMTokenUsecaseModule,
+	SAMLConnectionUsecaseModule,
+	AccessRequestQueryUsecaseModule,
+	AccessAnalyzerUsecaseModule,
 )
 
 var UsecaseModule = fx.Options(
+	DecisionCacheModule,
+	accessactivity.Module,
 	CommandUsecaseModule,
 	QueryUsecaseModule,
 )
 
+// DecisionCacheModule shares one authorization decision cache between the command and query
+// usecases of a runtime, so local writes invalidate what local reads cached.
+var DecisionCacheModule = fx.Provide(
+	iamconfig.NewDecisionCacheConfig,
+	newDecisionCache,
+	fx.Annotate(
+		func(cache *interactor.DecisionCache) *interactor.DecisionCache { return cache },
+		fx.As(new(inputport.DecisionCacheUsecase)),
+	),
+)
+
 var CommandUsecaseModule = fx.Provide(
 	fx.Annotate(interactor.NewCommandInteractor, fx.As(new(inputport.IAMCommandUsecase))),
 )
@@ -39,6 +75,47 @@ var QueryUsecaseModule = fx.Provide(
 	fx.Annotate(interactor.NewQueryInteractor, fx.As(new(inputport.IAMQueryUsecase))),
 )
 
+// SCIMTokenUsecaseModule backs the SCIM token management RPCs on every IAM runtime.
+var SCIMTokenUsecaseModule = fx.Provide(
+	fx.Annotate(interactor.NewSCIMTokenInteractor, fx.As(new(inputport.SCIMTokenUsecase))),
+)
+
+// SCIMUsecaseModule backs the SCIM provisioning HTTP endpoint. It needs the command usecase.
+var SCIMUsecaseModule = fx.Provide(
+	fx.Annotate(interactor.NewSCIMInteractor, fx.As(new(inputport.SCIMUsecase))),
+)
+
+// SAMLConnectionUsecaseModule backs SAML connection management and SSO enforcement lookups
+// on every IAM runtime.
+var SAMLConnectionUsecaseModule = fx.Provide(
+	fx.Annotate(interactor.NewSAMLConnectionInteractor, fx.As(new(inputport.SAMLConnectionUsecase))),
+)
+
+// SAMLLoginUsecaseModule applies SAML logins to memberships and groups. It needs the command usecase.
+var SAMLLoginUsecaseModule = fx.Provide(
+	fx.Annotate(interactor.NewSAMLLoginInteractor, fx.As(new(inputport.SAMLLoginUsecase))),
+)
+
+// AccessRequestCommandUsecaseModule runs the just-in-time access request workflow.
+var AccessRequestCommandUsecaseModule = fx.Provide(
+	fx.Annotate(
+		interactor.NewAccessRequestCommandInteractor,
+		fx.As(new(inputport.AccessRequestCommandUsecase)),
+	),
+)
+
+var AccessRequestQueryUsecaseModule = fx.Provide(
+	fx.Annotate(
+		interactor.NewAccessRequestQueryInteractor,
+		fx.As(new(inputport.AccessRequestQueryUsecase)),
+	),
+)
+
+// AccessAnalyzerUsecaseModule reports unused access from the recorded activity.
+var AccessAnalyzerUsecaseModule = fx.Provide(
+	fx.Annotate(interactor.NewAccessAnalyzerInteractor, fx.As(new(inputport.AccessAnalyzerUsecase))),
+)
+
 var RepositoryModule = fx.Provide(
 	tenantRepositoryProvider(new(outputport.TenantCommandRepository)),
 	tenantRepositoryProvider(new(outputport.TenantQueryRepository)),
@@ -58,6 +135,15 @@ var RepositoryModule = fx.Provide(
 	inviteRepositoryProvider(new(outputport.InviteQueryRepository)),
 	outboxRepositoryProvider(new(outputport.OutboxRepository)),
 	outboxRepositoryProvider(new(messaging.OutboxStore)),
+	repository.NewUnitOfWork,
+	scimRepositoryProvider(new(outputport.SCIMRepository)),
+	scimRepositoryProvider(new(outputport.SCIMTokenRepository)),
+	samlRepositoryProvider(new(outputport.SAMLConnectionRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessRequestCommandRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessRequestQueryRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessGrantRepository)),
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerCommandRepository)),
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerQueryRepository)),
 )
 
 var CommandRepositoryModule = fx.Provide(
@@ -79,6 +165,15 @@ var CommandRepositoryModule = fx.Provide(
 	inviteRepositoryProvider(new(outputport.InviteQueryRepository)),
 	outboxRepositoryProvider(new(outputport.OutboxRepository)),
 	outboxRepositoryProvider(new(messaging.OutboxStore)),
+	repository.NewUnitOfWork,
+	scimRepositoryProvider(new(outputport.SCIMRepository)),
+	scimRepositoryProvider(new(outputport.SCIMTokenRepository)),
+	samlRepositoryProvider(new(outputport.SAMLConnectionRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessRequestCommandRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessRequestQueryRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessGrantRepository)),
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerCommandRepository)),
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerQueryRepository)),
 )
 
 var QueryRepositoryModule = fx.Provide(
@@ -90,6 +185,12 @@ var QueryRepositoryModule = fx.Provide(
 	platformMembershipRepositoryProvider(new(outputport.PlatformMembershipQueryRepository)),
 	membershipRepositoryProvider(new(outputport.MembershipQueryRepository)),
 	inviteRepositoryProvider(new(outputport.InviteQueryRepository)),
+	scimRepositoryProvider(new(outputport.SCIMTokenRepository)),
+	samlRepositoryProvider(new(outputport.SAMLConnectionRepository)),
+	accessRequestRepositoryProvider(new(outputport.AccessRequestQueryRepository)),
+	// Query runtimes evaluate permission checks, so they also write access activity.
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerCommandRepository)),
+	accessAnalyzerRepositoryProvider(new(outputport.AccessAnalyzerQueryRepository)),
 )
 
 func tenantRepositoryProvider(interfaces ...any) any {
@@ -127,3 +228,28 @@ func inviteRepositoryProvider(interfaces ...any) any {
 func outboxRepositoryProvider(interfaces ...any) any {
 	return fx.Annotate(repository.NewOutboxRepository, fx.As(interfaces...))
 }
+
+func scimRepositoryProvider(interfaces ...any) any {
+	return fx.Annotate(repository.NewSCIMRepository, fx.As(interfaces...))
+}
+
+func samlRepositoryProvider(interfaces ...any) any {
+	return fx.Annotate(repository.NewSAMLConnectionRepository, fx.As(interfaces...))
+}
+
+func accessRequestRepositoryProvider(interfaces ...any) any {
+	return fx.Annotate(repository.NewAccessRequestRepository, fx.As(interfaces...))
+}
+
+func accessAnalyzerRepositoryProvider(interfaces ...any) any {
+	return fx.Annotate(repository.NewAccessAnalyzerRepository, fx.As(interfaces...))
+}
+
+// newDecisionCache returns nil when the cache is disabled; the interactors then evaluate
+// every check against the repositories.
+func newDecisionCache(cfg iamconfig.DecisionCacheConfig) *interactor.DecisionCache {
+	if !cfg.Enabled {
+		return nil
+	}
+	return interactor.NewDecisionCache(cfg.TTL, cfg.MaxEntries)
+}
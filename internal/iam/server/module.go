@@ -8,11 +8,14 @@ import (
 	"github.com/tuannm99/podzone/internal/iam"
 	iamconfig "github.com/tuannm99/podzone/internal/iam/config"
 	iamgrpchandler "github.com/tuannm99/podzone/internal/iam/controller/grpchandler"
+	iamhttphandler "github.com/tuannm99/podzone/internal/iam/controller/httphandler"
 	"github.com/tuannm99/podzone/internal/iam/domain/outputport"
 	"github.com/tuannm99/podzone/internal/iam/infrastructure/authclient"
+	iamdecisioncache "github.com/tuannm99/podzone/internal/iam/infrastructure/messaging/decisioncache"
 	iamrepo "github.com/tuannm99/podzone/internal/iam/infrastructure/repository"
 	iammigrations "github.com/tuannm99/podzone/internal/iam/migrations"
 	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 	"github.com/tuannm99/podzone/pkg/pdsql"
 	"go.uber.org/fx"
@@ -22,6 +25,8 @@ import (
 var Module = fx.Options(
 	iam.Module,
 	SharedModule,
+	SCIMModule,
+	iamdecisioncache.Module,
 	fx.Provide(
 		iamgrpchandler.NewIAMCommandServer,
 		iamgrpchandler.NewIAMQueryServer,
@@ -73,6 +78,17 @@ var SharedModule = fx.Provide(
 	fx.Annotate(authclient.NewUserDirectory, fx.As(new(outputport.UserDirectory))),
 )
 
+// SCIMModule contributes the SCIM 2.0 provisioning endpoint to the pdhttp router.
+var SCIMModule = fx.Provide(
+	iamhttphandler.NewSCIMHandler,
+	fx.Annotate(
+		func(handler *iamhttphandler.SCIMHandler) pdhttp.RouteRegistrar {
+			return handler.RegisterRoutes()
+		},
+		fx.ResultTags(`group:"gin-routes"`),
+	),
+)
+
 // Module currently exposes the legacy IAM service plus the CQRS command/query
 // services from one runtime. The registration functions below are intentionally
 // split so a future cmd/iam-command or cmd/iam-query binary can reuse the same
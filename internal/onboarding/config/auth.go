@@ -27,6 +27,7 @@ func NewAuthConfig(k *koanf.Koanf) AuthConfig {
 		Authn: pdauthn.Config{
 			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
 			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
 		},
 		IAM: IAMConfig{
 			GRPCHost: toolkit.GetEnv("IAM_GRPC_HOST", "iam-service"),
@@ -43,6 +44,10 @@ func NewAuthConfig(k *koanf.Koanf) AuthConfig {
 		if cfg.Authn.JWTKey == "" {
 			cfg.Authn.JWTKey = k.String("onboarding.auth.jwt_key")
 		}
+		if cfg.Authn.JWKSURL == "" {
+			cfg.Authn.JWKSURL = k.String("onboarding.auth.jwks_url")
+		}
+		cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "onboarding.auth.legacy_hs256_until")
 		if host := k.String("onboarding.iam.grpc_host"); host != "" {
 			cfg.IAM.GRPCHost = host
 		}
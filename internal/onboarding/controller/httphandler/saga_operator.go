@@ -0,0 +1,51 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	storedomain "github.com/tuannm99/podzone/internal/onboarding/domain/store"
+	"github.com/tuannm99/podzone/internal/onboarding/runtime/identity"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// SagaAdminAuthorizer checks the platform permission that admits a user to the saga admin
+// routes. It returns storedomain.ErrAccessDenied when the user lacks it.
+type SagaAdminAuthorizer interface {
+	AuthorizeSagaAdmin(ctx context.Context, requestedBy string) error
+}
+
+// SagaOperator guards the admin routes, which can resume or abort any tenant's provisioning
+// saga and redrive the outbox. It runs after Authentication.RequireUser.
+type SagaOperator struct {
+	authorizer SagaAdminAuthorizer
+}
+
+func NewSagaOperator(authorizer SagaAdminAuthorizer) *SagaOperator {
+	return &SagaOperator{authorizer: authorizer}
+}
+
+func (o *SagaOperator) Require(ctx *gin.Context) {
+	requestCtx := ctx.Request.Context()
+	if identity.IsTrustedService(requestCtx) {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "saga admin requires an operator token"})
+		return
+	}
+	userID, err := toolkit.GetUserID(requestCtx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization token missing user_id"})
+		return
+	}
+	if err := o.authorizer.AuthorizeSagaAdmin(requestCtx, userID); err != nil {
+		if errors.Is(err, storedomain.ErrAccessDenied) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "check platform permission failed"})
+		return
+	}
+	ctx.Next()
+}
@@ -0,0 +1,115 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	onboardingconfig "github.com/tuannm99/podzone/internal/onboarding/config"
+	storedomain "github.com/tuannm99/podzone/internal/onboarding/domain/store"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+)
+
+type fakeSagaAdminAuthorizer struct {
+	err   error
+	calls []string
+}
+
+func (f *fakeSagaAdminAuthorizer) AuthorizeSagaAdmin(_ context.Context, requestedBy string) error {
+	f.calls = append(f.calls, requestedBy)
+	return f.err
+}
+
+func TestSagaOperator_AdmitsUserWithPermission(t *testing.T) {
+	authorizer := &fakeSagaAdminAuthorizer{}
+
+	recorder := performSagaAdminRequest(t, authorizer, func(request *http.Request) {
+		request.Header.Set("Authorization", "Bearer "+signedUserToken(t, 7))
+	})
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Equal(t, []string{"7"}, authorizer.calls)
+}
+
+func TestSagaOperator_RejectsUserWithoutPermission(t *testing.T) {
+	authorizer := &fakeSagaAdminAuthorizer{
+		err: fmt.Errorf("%w: platform:manage_messaging", storedomain.ErrAccessDenied),
+	}
+
+	recorder := performSagaAdminRequest(t, authorizer, func(request *http.Request) {
+		request.Header.Set("Authorization", "Bearer "+signedUserToken(t, 7))
+	})
+
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.JSONEq(t, `{"error":"store access denied: platform:manage_messaging"}`, recorder.Body.String())
+}
+
+func TestSagaOperator_ReportsPermissionCheckFailure(t *testing.T) {
+	authorizer := &fakeSagaAdminAuthorizer{err: errors.New("iam unavailable")}
+
+	recorder := performSagaAdminRequest(t, authorizer, func(request *http.Request) {
+		request.Header.Set("Authorization", "Bearer "+signedUserToken(t, 7))
+	})
+
+	require.Equal(t, http.StatusBadGateway, recorder.Code)
+}
+
+func TestSagaOperator_RejectsServiceToken(t *testing.T) {
+	authorizer := &fakeSagaAdminAuthorizer{}
+
+	recorder := performSagaAdminRequest(t, authorizer, func(request *http.Request) {
+		request.Header.Set("X-Onboarding-Service-Token", "service-token")
+		request.Header.Set("X-User-ID", "7")
+		request.Header.Set("X-Tenant-ID", "tenant-1")
+	})
+
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Empty(t, authorizer.calls)
+}
+
+func performSagaAdminRequest(
+	t *testing.T,
+	authorizer SagaAdminAuthorizer,
+	prepare func(request *http.Request),
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	auth := NewAuthentication(onboardingconfig.AuthConfig{
+		Authn:        pdauthn.Config{JWTSecret: testJWTSecret},
+		ServiceToken: "service-token",
+	})
+	router := gin.New()
+	router.Use(auth.RequireUser, NewSagaOperator(authorizer).Require)
+	router.GET("/sagas", func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/sagas", nil)
+	prepare(request)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+const testJWTSecret = "test-secret"
+
+func signedUserToken(t *testing.T, userID uint) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, pdauthn.Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	signed, err := token.SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return signed
+}
@@ -87,8 +87,9 @@ type OutboxMessage struct {
 	InfraType InfraType
 	Name      string
 
-	Status     string // pending|done|failed
+	Status     string // pending|done|failed|dead
 	RetryCount int
+	LastError  string
 	NextRetry  time.Time
 
 	CreatedAt time.Time
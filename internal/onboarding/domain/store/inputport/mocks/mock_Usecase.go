@@ -164,74 +164,6 @@ func (_c *MockUsecase_CreateStoreRequest_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
-// FinalizeNextStoreRequest provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) FinalizeNextStoreRequest(ctx context.Context, workerID string) (*inputport.Request, error) {
-	ret := _mock.Called(ctx, workerID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for FinalizeNextStoreRequest")
-	}
-
-	var r0 *inputport.Request
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*inputport.Request, error)); ok {
-		return returnFunc(ctx, workerID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *inputport.Request); ok {
-		r0 = returnFunc(ctx, workerID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inputport.Request)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, workerID)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
-}
-
-// MockUsecase_FinalizeNextStoreRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeNextStoreRequest'
-type MockUsecase_FinalizeNextStoreRequest_Call struct {
-	*mock.Call
-}
-
-// FinalizeNextStoreRequest is a helper method to define mock.On call
-//   - ctx context.Context
-//   - workerID string
-func (_e *MockUsecase_Expecter) FinalizeNextStoreRequest(ctx interface{}, workerID interface{}) *MockUsecase_FinalizeNextStoreRequest_Call {
-	return &MockUsecase_FinalizeNextStoreRequest_Call{Call: _e.mock.On("FinalizeNextStoreRequest", ctx, workerID)}
-}
-
-func (_c *MockUsecase_FinalizeNextStoreRequest_Call) Run(run func(ctx context.Context, workerID string)) *MockUsecase_FinalizeNextStoreRequest_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		run(
-			arg0,
-			arg1,
-		)
-	})
-	return _c
-}
-
-func (_c *MockUsecase_FinalizeNextStoreRequest_Call) Return(request *inputport.Request, err error) *MockUsecase_FinalizeNextStoreRequest_Call {
-	_c.Call.Return(request, err)
-	return _c
-}
-
-func (_c *MockUsecase_FinalizeNextStoreRequest_Call) RunAndReturn(run func(ctx context.Context, workerID string) (*inputport.Request, error)) *MockUsecase_FinalizeNextStoreRequest_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // GetStoreReadiness provides a mock function for the type MockUsecase
 func (_mock *MockUsecase) GetStoreReadiness(ctx context.Context, id string) (*inputport.ReadinessResponse, error) {
 	ret := _mock.Called(ctx, id)
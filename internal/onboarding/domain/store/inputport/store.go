@@ -79,7 +79,6 @@ type Usecase interface {
 	RejectStoreRequest(ctx context.Context, id string) error
 	UpdateStoreRequestStatus(ctx context.Context, id string, status RequestStatus) error
 	ProcessNextStoreRequest(ctx context.Context, workerID string) (*Request, error)
-	FinalizeNextStoreRequest(ctx context.Context, workerID string) (*Request, error)
 	GetStoreReadiness(ctx context.Context, id string) (*ReadinessResponse, error)
 }
 
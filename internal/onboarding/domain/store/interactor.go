@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	storeinputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/inputport"
 	storeoutputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/outputport"
 	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
@@ -20,8 +22,8 @@ var _ storeinputport.Usecase = (*StoreInteractor)(nil)
 type StoreInteractor struct {
 	repo        storeoutputport.StoreRepository
 	authorizer  storeoutputport.AccessAuthorizer
-	finalizer   storeoutputport.OperationalStoreFinalizer
 	infra       infrasinputport.Usecase
+	sagas       storeoutputport.ProvisioningSagas
 	provisioner storeentity.ProvisioningConfig
 }
 
@@ -30,8 +32,8 @@ type StoreInteractorParams struct {
 
 	StoreRepo  storeoutputport.StoreRepository
 	Authorizer storeoutputport.AccessAuthorizer
-	Finalizer  storeoutputport.OperationalStoreFinalizer
 	Infra      infrasinputport.Usecase
+	Sagas      storeoutputport.ProvisioningSagas
 	Config     storeentity.ProvisioningConfig
 }
 
@@ -39,8 +41,8 @@ func NewStoreInteractor(params StoreInteractorParams) *StoreInteractor {
 	return &StoreInteractor{
 		repo:        params.StoreRepo,
 		authorizer:  params.Authorizer,
-		finalizer:   params.Finalizer,
 		infra:       params.Infra,
+		sagas:       params.Sagas,
 		provisioner: params.Config,
 	}
 }
@@ -356,6 +358,8 @@ func isValidStatusTransition(current, next storeinputport.RequestStatus) bool {
 	}
 }
 
+// ProcessNextStoreRequest claims the oldest queued request and starts its provisioning saga,
+// which the store provisioning worker then drives step by step.
 func (s *StoreInteractor) ProcessNextStoreRequest(
 	ctx context.Context,
 	workerID string,
@@ -363,7 +367,7 @@ func (s *StoreInteractor) ProcessNextStoreRequest(
 	if !s.provisioner.Enabled {
 		return nil, ErrProvisionerDisabled
 	}
-	if s.infra == nil {
+	if s.sagas == nil {
 		return nil, ErrProvisionerMissing
 	}
 
@@ -386,130 +390,22 @@ func (s *StoreInteractor) ProcessNextStoreRequest(
 		"",
 	)
 
-	storeID := request.ID.Hex()
-	_, err = s.infra.ProvisionStorePlacement(
-		ctx,
-		infrasinputport.ProvisionStorePlacementRequest{
-			RequestID:   request.ID.Hex(),
-			TenantID:    request.WorkspaceID,
-			StoreID:     storeID,
-			Subdomain:   request.Subdomain,
-			RequestedBy: request.RequestedBy,
-		},
-		map[string]string{
-			"service": "onboarding",
-			"worker":  "store-provisioner",
-		},
-	)
-	if err != nil {
-		status, errorCode := classifyProvisioningFailure(err)
-		_ = s.repo.MarkBlocked(ctx, request.ID.Hex(), status, err.Error())
+	_, err = s.sagas.Start(ctx, ProvisionStoreSagaName, newProvisionStoreSagaStart(request, time.Now().UTC()))
+	if err != nil && !errors.Is(err, messaging.ErrSagaExists) {
+		_ = s.repo.MarkBlocked(ctx, request.ID.Hex(), storeentity.RequestStatusFailedRetryable, err.Error())
 		s.recordTransition(
 			ctx,
 			request.ID.Hex(),
 			storeentity.RequestStatusPlanning,
-			status,
+			storeentity.RequestStatusFailedRetryable,
 			map[string]string{"service": "onboarding", "worker": "store-provisioner"},
 			"provisioning.failed",
 			err.Error(),
-			errorCode,
-		)
-		return nil, err
-	}
-
-	if err := s.repo.UpdateStatus(ctx, request.ID.Hex(), storeentity.RequestStatusPlanned); err != nil {
-		return nil, err
-	}
-	s.recordTransition(
-		ctx,
-		request.ID.Hex(),
-		storeentity.RequestStatusPlanning,
-		storeentity.RequestStatusPlanned,
-		map[string]string{"service": "onboarding", "worker": "store-provisioner"},
-		"planning.completed",
-		"placement plan persisted and provisioning completed",
-		"",
-	)
-	if err := s.repo.UpdateStatus(ctx, request.ID.Hex(), storeentity.RequestStatusProvisioning); err != nil {
-		return nil, err
-	}
-	s.recordTransition(
-		ctx,
-		request.ID.Hex(),
-		storeentity.RequestStatusPlanned,
-		storeentity.RequestStatusProvisioning,
-		map[string]string{"service": "onboarding", "worker": "store-provisioner"},
-		"provisioning.started",
-		"waiting for route readiness and store finalization",
-		"",
-	)
-	request.Status = storeentity.RequestStatusProvisioning
-	return toInputPortRequest(request), nil
-}
-
-func (s *StoreInteractor) FinalizeNextStoreRequest(
-	ctx context.Context,
-	workerID string,
-) (*storeinputport.Request, error) {
-	if s.finalizer == nil {
-		return nil, ErrProvisionerMissing
-	}
-	workerID = normalizeWorkerID(workerID)
-	request, err := s.repo.ClaimNextProvisioning(ctx, workerID, s.leaseTTL())
-	if err != nil || request == nil {
-		return nil, err
-	}
-	if s.infra != nil {
-		ready, err := s.infra.EnsurePlacementRoute(ctx, request.WorkspaceID)
-		if err != nil {
-			return nil, err
-		}
-		if !ready {
-			if err := s.repo.ReleaseLease(ctx, request.ID.Hex(), workerID); err != nil {
-				return nil, err
-			}
-			return nil, ErrRouteNotReady
-		}
-		s.recordTransition(
-			ctx,
-			request.ID.Hex(),
-			storeentity.RequestStatusProvisioning,
-			storeentity.RequestStatusProvisioning,
-			map[string]string{"service": "onboarding", "worker": "store-provisioner"},
-			"route.ready",
-			"tenant placement route is ready",
-			"",
+			"retryable",
 		)
-	}
-	if err := s.finalizer.FinalizeStore(ctx, *request); err != nil {
-		return nil, err
-	}
-	s.recordTransition(
-		ctx,
-		request.ID.Hex(),
-		storeentity.RequestStatusProvisioning,
-		storeentity.RequestStatusProvisioning,
-		map[string]string{"service": "onboarding", "worker": "store-provisioner"},
-		"store.finalized",
-		"operational store finalized",
-		"",
-	)
-	storeID := request.ID.Hex()
-	if err := s.repo.MarkReady(ctx, request.ID.Hex(), storeID); err != nil {
 		return nil, err
 	}
-	s.recordTransition(
-		ctx,
-		request.ID.Hex(),
-		storeentity.RequestStatusProvisioning,
-		storeentity.RequestStatusReady,
-		map[string]string{"service": "onboarding", "worker": "store-provisioner"},
-		"request.ready",
-		"store provisioning completed",
-		"",
-	)
-	request.Status = storeentity.RequestStatusReady
-	request.StoreID = &request.ID
+	request.Status = storeentity.RequestStatusPlanning
 	return toInputPortRequest(request), nil
 }
 
@@ -576,10 +472,24 @@ func (s *StoreInteractor) recordTransition(
 	reason string,
 	errorCode string,
 ) {
-	if s.repo == nil {
+	recordTransition(ctx, s.repo, requestID, from, to, actor, step, reason, errorCode)
+}
+
+func recordTransition(
+	ctx context.Context,
+	repo storeoutputport.StoreRepository,
+	requestID string,
+	from storeentity.RequestStatus,
+	to storeentity.RequestStatus,
+	actor map[string]string,
+	step string,
+	reason string,
+	errorCode string,
+) {
+	if repo == nil {
 		return
 	}
-	_ = s.repo.RecordTransition(ctx, storeentity.StoreRequestTransition{
+	_ = repo.RecordTransition(ctx, storeentity.StoreRequestTransition{
 		RequestID: requestID,
 		From:      from,
 		To:        to,
@@ -16,6 +16,7 @@ import (
 	storeinputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/inputport"
 	storemocks "github.com/tuannm99/podzone/internal/onboarding/domain/store/outputport/mocks"
 	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
 	"github.com/tuannm99/podzone/pkg/toolkit"
 )
 
@@ -287,20 +288,14 @@ func TestRetryStoreRequest_RejectsNonFailedRequest(t *testing.T) {
 	require.ErrorIs(t, err, ErrInvalidStatus)
 }
 
-func TestProcessNextStoreRequest_ProvisionsQueuedRequest(t *testing.T) {
+func TestProcessNextStoreRequest_StartsProvisioningSaga(t *testing.T) {
 	repo := storemocks.NewMockStoreRepository(t)
 	allowTransitionLog(repo)
-	infra := infrasmocks.NewMockUsecase(t)
+	sagas := storemocks.NewMockProvisioningSagas(t)
 	svc := &StoreInteractor{
-		repo:  repo,
-		infra: infra,
-		provisioner: storeentity.ProvisioningConfig{
-			Enabled:      true,
-			ClusterName:  "pg-default",
-			Mode:         "schema",
-			DBName:       "podzone_tenants",
-			SchemaPrefix: "t_",
-		},
+		repo:        repo,
+		sagas:       sagas,
+		provisioner: storeentity.ProvisioningConfig{Enabled: true},
 	}
 
 	id := primitive.NewObjectID()
@@ -308,162 +303,63 @@ func TestProcessNextStoreRequest_ProvisionsQueuedRequest(t *testing.T) {
 		ID:          id,
 		Name:        "Urban Finds",
 		Subdomain:   "urban-finds",
-		WorkspaceID: "2e0df8f6-4964-447d-a287-67eabd0e65c9",
+		WorkspaceID: "workspace-1",
 		RequestedBy: "user-1",
-		Status:      storeentity.RequestStatusPlanning,
+		Status:      storeentity.RequestStatusQueued,
+		Attempt:     1,
 	}
 	repo.EXPECT().
 		ClaimNextQueued(mock.Anything, "worker-1", 2*time.Minute).
 		Return(request, nil)
-	infra.EXPECT().
-		ProvisionStorePlacement(
+	sagas.EXPECT().
+		Start(
 			mock.Anything,
-			mock.MatchedBy(func(req infrasinputport.ProvisionStorePlacementRequest) bool {
-				return req.RequestID == id.Hex() &&
-					req.TenantID == request.WorkspaceID &&
-					req.StoreID == id.Hex() &&
-					req.Subdomain == "urban-finds" &&
-					req.RequestedBy == "user-1"
-			}),
-			mock.MatchedBy(func(actor map[string]string) bool {
-				return actor["service"] == "onboarding" && actor["worker"] == "store-provisioner"
+			ProvisionStoreSagaName,
+			mock.MatchedBy(func(start messaging.SagaStart) bool {
+				return start.ID == id.Hex()+":2" &&
+					start.Envelope.EntityID == id.Hex() &&
+					start.Envelope.TenantID == "workspace-1"
 			}),
 		).
-		Return(&infrasinputport.ProvisionStorePlacementResponse{
-			CorrelationID: "correlation-1",
-			AllocationID:  "allocation-1",
-			Status:        "ready",
-			Queued:        true,
-		}, nil)
-	repo.EXPECT().UpdateStatus(mock.Anything, id.Hex(), storeentity.RequestStatusPlanned).Return(nil)
-	repo.EXPECT().UpdateStatus(mock.Anything, id.Hex(), storeentity.RequestStatusProvisioning).Return(nil)
+		Return(messaging.SagaInstance{ID: id.Hex() + ":2"}, nil)
+
 	processed, err := svc.ProcessNextStoreRequest(context.Background(), "worker-1")
 	require.NoError(t, err)
 	require.NotNil(t, processed)
-	require.Equal(t, storeinputport.RequestStatusProvisioning, processed.Status)
-	require.Empty(t, processed.StoreID)
+	require.Equal(t, storeinputport.RequestStatusPlanning, processed.Status)
 }
 
-func TestProcessNextStoreRequest_MarksPlatformSetupWhenProviderUnavailable(t *testing.T) {
+func TestProcessNextStoreRequest_BlocksRequestWhenSagaCannotStart(t *testing.T) {
 	repo := storemocks.NewMockStoreRepository(t)
 	allowTransitionLog(repo)
-	infra := infrasmocks.NewMockUsecase(t)
+	sagas := storemocks.NewMockProvisioningSagas(t)
 	svc := &StoreInteractor{
-		repo:  repo,
-		infra: infra,
-		provisioner: storeentity.ProvisioningConfig{
-			Enabled: true,
-		},
+		repo:        repo,
+		sagas:       sagas,
+		provisioner: storeentity.ProvisioningConfig{Enabled: true},
 	}
 
-	id := primitive.NewObjectID()
 	request := &storeentity.StoreRequest{
-		ID:          id,
-		Name:        "Urban Finds",
-		Subdomain:   "urban-finds",
+		ID:          primitive.NewObjectID(),
 		WorkspaceID: "workspace-1",
-		RequestedBy: "user-1",
-		Status:      storeentity.RequestStatusPlanning,
+		Status:      storeentity.RequestStatusQueued,
 	}
-	providerErr := errors.New("kubernetes placement provider is declared but not implemented")
-
+	storeErr := errors.New("saga store unavailable")
 	repo.EXPECT().
 		ClaimNextQueued(mock.Anything, "worker-1", 2*time.Minute).
 		Return(request, nil)
-	infra.EXPECT().
-		ProvisionStorePlacement(mock.Anything, mock.Anything, mock.Anything).
-		Return(nil, providerErr)
+	sagas.EXPECT().
+		Start(mock.Anything, ProvisionStoreSagaName, mock.Anything).
+		Return(messaging.SagaInstance{}, storeErr)
 	repo.EXPECT().
-		MarkBlocked(
-			mock.Anything,
-			id.Hex(),
-			storeentity.RequestStatusPendingPlatformSetup,
-			providerErr.Error(),
-		).
+		MarkBlocked(mock.Anything, request.ID.Hex(), storeentity.RequestStatusFailedRetryable, storeErr.Error()).
 		Return(nil)
 
 	processed, err := svc.ProcessNextStoreRequest(context.Background(), "worker-1")
-
-	require.ErrorIs(t, err, providerErr)
+	require.ErrorIs(t, err, storeErr)
 	require.Nil(t, processed)
 }
 
-func TestFinalizeNextStoreRequest_BootstrapsOperationalStoreBeforeReady(t *testing.T) {
-	repo := storemocks.NewMockStoreRepository(t)
-	transitions := make([]storeentity.StoreRequestTransition, 0, 3)
-	repo.EXPECT().
-		RecordTransition(mock.Anything, mock.Anything).
-		RunAndReturn(func(_ context.Context, transition storeentity.StoreRequestTransition) error {
-			transitions = append(transitions, transition)
-			return nil
-		}).
-		Times(3)
-	infra := infrasmocks.NewMockUsecase(t)
-	finalizer := storemocks.NewMockOperationalStoreFinalizer(t)
-	svc := &StoreInteractor{
-		repo:      repo,
-		infra:     infra,
-		finalizer: finalizer,
-	}
-
-	id := primitive.NewObjectID()
-	request := &storeentity.StoreRequest{
-		ID:          id,
-		Name:        "Urban Finds",
-		Subdomain:   "urban-finds",
-		WorkspaceID: "workspace-1",
-		RequestedBy: "user-1",
-		Status:      storeentity.RequestStatusProvisioning,
-	}
-	repo.EXPECT().
-		ClaimNextProvisioning(mock.Anything, "worker-1", 2*time.Minute).
-		Return(request, nil)
-	infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(true, nil)
-	finalizer.EXPECT().FinalizeStore(mock.Anything, *request).Return(nil)
-	repo.EXPECT().MarkReady(mock.Anything, id.Hex(), id.Hex()).Return(nil)
-
-	finalized, err := svc.FinalizeNextStoreRequest(context.Background(), "worker-1")
-	require.NoError(t, err)
-	require.NotNil(t, finalized)
-	require.Equal(t, storeinputport.RequestStatusReady, finalized.Status)
-	require.Equal(t, id.Hex(), finalized.StoreID)
-	require.Equal(t, []string{"route.ready", "store.finalized", "request.ready"}, []string{
-		transitions[0].Step,
-		transitions[1].Step,
-		transitions[2].Step,
-	})
-}
-
-func TestFinalizeNextStoreRequest_WaitsForPlacementRoute(t *testing.T) {
-	repo := storemocks.NewMockStoreRepository(t)
-	allowTransitionLog(repo)
-	infra := infrasmocks.NewMockUsecase(t)
-	finalizer := storemocks.NewMockOperationalStoreFinalizer(t)
-	svc := &StoreInteractor{
-		repo:      repo,
-		infra:     infra,
-		finalizer: finalizer,
-	}
-
-	request := &storeentity.StoreRequest{
-		ID:          primitive.NewObjectID(),
-		Name:        "Urban Finds",
-		Subdomain:   "urban-finds",
-		WorkspaceID: "workspace-1",
-		RequestedBy: "user-1",
-		Status:      storeentity.RequestStatusProvisioning,
-	}
-	repo.EXPECT().
-		ClaimNextProvisioning(mock.Anything, "worker-1", 2*time.Minute).
-		Return(request, nil)
-	infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(false, nil)
-	repo.EXPECT().ReleaseLease(mock.Anything, request.ID.Hex(), "worker-1").Return(nil)
-
-	finalized, err := svc.FinalizeNextStoreRequest(context.Background(), "worker-1")
-	require.ErrorIs(t, err, ErrRouteNotReady)
-	require.Nil(t, finalized)
-}
-
 func makeReadinessRequest(workspaceID string) *storeentity.StoreRequest {
 	return &storeentity.StoreRequest{
 		ID:          primitive.NewObjectID(),
@@ -40,80 +40,6 @@ func (_m *MockStoreRepository) EXPECT() *MockStoreRepository_Expecter {
 	return &MockStoreRepository_Expecter{mock: &_m.Mock}
 }
 
-// ClaimNextProvisioning provides a mock function for the type MockStoreRepository
-func (_mock *MockStoreRepository) ClaimNextProvisioning(ctx context.Context, leaseOwner string, leaseTTL time.Duration) (*entity.StoreRequest, error) {
-	ret := _mock.Called(ctx, leaseOwner, leaseTTL)
-
-	if len(ret) == 0 {
-		panic("no return value specified for ClaimNextProvisioning")
-	}
-
-	var r0 *entity.StoreRequest
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (*entity.StoreRequest, error)); ok {
-		return returnFunc(ctx, leaseOwner, leaseTTL)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) *entity.StoreRequest); ok {
-		r0 = returnFunc(ctx, leaseOwner, leaseTTL)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.StoreRequest)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
-		r1 = returnFunc(ctx, leaseOwner, leaseTTL)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
-}
-
-// MockStoreRepository_ClaimNextProvisioning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNextProvisioning'
-type MockStoreRepository_ClaimNextProvisioning_Call struct {
-	*mock.Call
-}
-
-// ClaimNextProvisioning is a helper method to define mock.On call
-//   - ctx context.Context
-//   - leaseOwner string
-//   - leaseTTL time.Duration
-func (_e *MockStoreRepository_Expecter) ClaimNextProvisioning(ctx interface{}, leaseOwner interface{}, leaseTTL interface{}) *MockStoreRepository_ClaimNextProvisioning_Call {
-	return &MockStoreRepository_ClaimNextProvisioning_Call{Call: _e.mock.On("ClaimNextProvisioning", ctx, leaseOwner, leaseTTL)}
-}
-
-func (_c *MockStoreRepository_ClaimNextProvisioning_Call) Run(run func(ctx context.Context, leaseOwner string, leaseTTL time.Duration)) *MockStoreRepository_ClaimNextProvisioning_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 time.Duration
-		if args[2] != nil {
-			arg2 = args[2].(time.Duration)
-		}
-		run(
-			arg0,
-			arg1,
-			arg2,
-		)
-	})
-	return _c
-}
-
-func (_c *MockStoreRepository_ClaimNextProvisioning_Call) Return(storeRequest *entity.StoreRequest, err error) *MockStoreRepository_ClaimNextProvisioning_Call {
-	_c.Call.Return(storeRequest, err)
-	return _c
-}
-
-func (_c *MockStoreRepository_ClaimNextProvisioning_Call) RunAndReturn(run func(ctx context.Context, leaseOwner string, leaseTTL time.Duration) (*entity.StoreRequest, error)) *MockStoreRepository_ClaimNextProvisioning_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // ClaimNextQueued provides a mock function for the type MockStoreRepository
 func (_mock *MockStoreRepository) ClaimNextQueued(ctx context.Context, leaseOwner string, leaseTTL time.Duration) (*entity.StoreRequest, error) {
 	ret := _mock.Called(ctx, leaseOwner, leaseTTL)
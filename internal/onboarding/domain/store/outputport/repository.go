@@ -6,6 +6,7 @@ import (
 
 	storeentity "github.com/tuannm99/podzone/internal/onboarding/domain/store/entity"
 	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
 type AccessAuthorizer interface {
@@ -18,6 +19,12 @@ type OperationalStoreFinalizer interface {
 	FinalizeStore(ctx context.Context, request storeentity.StoreRequest) error
 }
 
+// ProvisioningSagas starts the durable saga that provisions a claimed store request. It returns
+// messaging.ErrSagaExists when the saga for that claim was already started.
+type ProvisioningSagas interface {
+	Start(ctx context.Context, definition string, start messaging.SagaStart) (messaging.SagaInstance, error)
+}
+
 type StoreRepository interface {
 	EnsureIndexes(ctx context.Context) error
 	FindBySubdomain(ctx context.Context, subdomain string) (*storeentity.StoreRequest, error)
@@ -34,11 +41,6 @@ type StoreRepository interface {
 		query collection.Query,
 	) (collection.Page[storeentity.StoreRequestTransition], error)
 	ClaimNextQueued(ctx context.Context, leaseOwner string, leaseTTL time.Duration) (*storeentity.StoreRequest, error)
-	ClaimNextProvisioning(
-		ctx context.Context,
-		leaseOwner string,
-		leaseTTL time.Duration,
-	) (*storeentity.StoreRequest, error)
 	ReleaseLease(ctx context.Context, id string, leaseOwner string) error
 	UpdateStatus(ctx context.Context, id string, status storeentity.RequestStatus) error
 	MarkReady(ctx context.Context, id string, storeID string) error
@@ -0,0 +1,297 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/fx"
+
+	infrasinputport "github.com/tuannm99/podzone/internal/onboarding/domain/infrasmanager/inputport"
+	storeentity "github.com/tuannm99/podzone/internal/onboarding/domain/store/entity"
+	storeoutputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/outputport"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// ProvisionStoreSagaName provisions a claimed store request: placement, route readiness, then
+// the operational store in backoffice. Each claim of a request runs its own saga.
+const ProvisionStoreSagaName = "onboarding.provision-store"
+
+const provisionStoreSagaStarted = "store.provisioning.started"
+
+func provisioningActor() map[string]string {
+	return map[string]string{"service": "onboarding", "worker": "store-provisioner"}
+}
+
+type ProvisionStoreSagaParams struct {
+	fx.In
+
+	StoreRepo storeoutputport.StoreRepository
+	Finalizer storeoutputport.OperationalStoreFinalizer
+	Infra     infrasinputport.Usecase
+}
+
+type provisionStoreSteps struct {
+	repo      storeoutputport.StoreRepository
+	finalizer storeoutputport.OperationalStoreFinalizer
+	infra     infrasinputport.Usecase
+}
+
+// NewProvisionStoreSaga defines the provisioning saga. Placement has no undo in the
+// infrastructure manager, so the only compensation marks the request blocked; the request can
+// then be retried, which claims it again and starts a new saga.
+func NewProvisionStoreSaga(params ProvisionStoreSagaParams) messaging.SagaDefinition {
+	steps := &provisionStoreSteps{
+		repo:      params.StoreRepo,
+		finalizer: params.Finalizer,
+		infra:     params.Infra,
+	}
+	return messaging.SagaDefinition{
+		Name:    ProvisionStoreSagaName,
+		Timeout: 2 * time.Hour,
+		Steps: []messaging.SagaStepDefinition{
+			{Name: "claim", Execute: steps.claim, Compensate: steps.block},
+			{
+				Name:    "placement",
+				Execute: steps.placement,
+				Timeout: 5 * time.Minute,
+				Retry:   messaging.RetryPolicy{MaxAttempts: 5, BaseDelay: 10 * time.Second, MaxDelay: 5 * time.Minute},
+			},
+			{
+				// The router picks new placements up on its own schedule, so readiness is polled.
+				Name:    "route",
+				Execute: steps.route,
+				Timeout: 30 * time.Second,
+				Retry:   messaging.RetryPolicy{MaxAttempts: 60, BaseDelay: 5 * time.Second, MaxDelay: time.Minute},
+			},
+			{
+				Name:    "finalize",
+				Execute: steps.finalize,
+				Timeout: 2 * time.Minute,
+				Retry:   messaging.RetryPolicy{MaxAttempts: 10, BaseDelay: 5 * time.Second, MaxDelay: 5 * time.Minute},
+			},
+		},
+	}
+}
+
+// newProvisionStoreSagaStart keys the saga by request and claim, so a retried request gets a
+// fresh saga while a duplicate start of the same claim returns messaging.ErrSagaExists.
+func newProvisionStoreSagaStart(request *storeentity.StoreRequest, now time.Time) messaging.SagaStart {
+	requestID := request.ID.Hex()
+	id := requestID + ":" + strconv.Itoa(request.Attempt+1)
+	return messaging.SagaStart{
+		ID: id,
+		Envelope: messaging.Envelope{
+			ID:            id,
+			Type:          provisionStoreSagaStarted,
+			Source:        "onboarding",
+			TenantID:      request.WorkspaceID,
+			EntityID:      requestID,
+			CorrelationID: requestID,
+			OccurredAt:    now,
+			SchemaVersion: 1,
+		},
+	}
+}
+
+// claim marks the point the request was claimed at; its compensation runs whenever a later
+// step gives up.
+func (p *provisionStoreSteps) claim(ctx context.Context, state *messaging.SagaState) error {
+	_, err := p.request(ctx, state)
+	return err
+}
+
+// block moves a request the saga could not provision to failed_retryable, unless a step
+// already blocked it with a more specific status.
+func (p *provisionStoreSteps) block(ctx context.Context, state *messaging.SagaState) error {
+	request, err := p.request(ctx, state)
+	if err != nil {
+		return err
+	}
+	if !isProvisioningStatus(request.Status) {
+		return nil
+	}
+	if err := p.repo.MarkBlocked(
+		ctx,
+		request.ID.Hex(),
+		storeentity.RequestStatusFailedRetryable,
+		state.Failure,
+	); err != nil {
+		return err
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		request.ID.Hex(),
+		request.Status,
+		storeentity.RequestStatusFailedRetryable,
+		provisioningActor(),
+		"provisioning.failed",
+		state.Failure,
+		"retryable",
+	)
+	return nil
+}
+
+func (p *provisionStoreSteps) placement(ctx context.Context, state *messaging.SagaState) error {
+	request, err := p.request(ctx, state)
+	if err != nil {
+		return err
+	}
+	requestID := request.ID.Hex()
+	_, err = p.infra.ProvisionStorePlacement(
+		ctx,
+		infrasinputport.ProvisionStorePlacementRequest{
+			RequestID:   requestID,
+			TenantID:    request.WorkspaceID,
+			StoreID:     requestID,
+			Subdomain:   request.Subdomain,
+			RequestedBy: request.RequestedBy,
+		},
+		provisioningActor(),
+	)
+	if err != nil {
+		status, errorCode := classifyProvisioningFailure(err)
+		if status == storeentity.RequestStatusFailedRetryable {
+			return err
+		}
+		// Retrying cannot fix the platform or the request, so block it now with its status.
+		if markErr := p.repo.MarkBlocked(ctx, requestID, status, err.Error()); markErr != nil {
+			return markErr
+		}
+		recordTransition(
+			ctx,
+			p.repo,
+			requestID,
+			storeentity.RequestStatusPlanning,
+			status,
+			provisioningActor(),
+			"provisioning.failed",
+			err.Error(),
+			errorCode,
+		)
+		return messaging.DeadLetterError(err, "")
+	}
+
+	if err := p.repo.UpdateStatus(ctx, requestID, storeentity.RequestStatusPlanned); err != nil {
+		return err
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		requestID,
+		storeentity.RequestStatusPlanning,
+		storeentity.RequestStatusPlanned,
+		provisioningActor(),
+		"planning.completed",
+		"placement plan persisted and provisioning completed",
+		"",
+	)
+	if err := p.repo.UpdateStatus(ctx, requestID, storeentity.RequestStatusProvisioning); err != nil {
+		return err
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		requestID,
+		storeentity.RequestStatusPlanned,
+		storeentity.RequestStatusProvisioning,
+		provisioningActor(),
+		"provisioning.started",
+		"waiting for route readiness and store finalization",
+		"",
+	)
+	return nil
+}
+
+func (p *provisionStoreSteps) route(ctx context.Context, state *messaging.SagaState) error {
+	request, err := p.request(ctx, state)
+	if err != nil {
+		return err
+	}
+	ready, err := p.infra.EnsurePlacementRoute(ctx, request.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if !ready {
+		return ErrRouteNotReady
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		request.ID.Hex(),
+		storeentity.RequestStatusProvisioning,
+		storeentity.RequestStatusProvisioning,
+		provisioningActor(),
+		"route.ready",
+		"tenant placement route is ready",
+		"",
+	)
+	return nil
+}
+
+func (p *provisionStoreSteps) finalize(ctx context.Context, state *messaging.SagaState) error {
+	request, err := p.request(ctx, state)
+	if err != nil {
+		return err
+	}
+	requestID := request.ID.Hex()
+	if err := p.finalizer.FinalizeStore(ctx, *request); err != nil {
+		return err
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		requestID,
+		storeentity.RequestStatusProvisioning,
+		storeentity.RequestStatusProvisioning,
+		provisioningActor(),
+		"store.finalized",
+		"operational store finalized",
+		"",
+	)
+	if err := p.repo.MarkReady(ctx, requestID, requestID); err != nil {
+		return err
+	}
+	recordTransition(
+		ctx,
+		p.repo,
+		requestID,
+		storeentity.RequestStatusProvisioning,
+		storeentity.RequestStatusReady,
+		provisioningActor(),
+		"request.ready",
+		"store provisioning completed",
+		"",
+	)
+	return nil
+}
+
+func (p *provisionStoreSteps) request(
+	ctx context.Context,
+	state *messaging.SagaState,
+) (*storeentity.StoreRequest, error) {
+	request, err := p.repo.FindByID(ctx, state.Envelope.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, messaging.DeadLetterError(
+			fmt.Errorf("%w: %s", ErrStoreNotFound, state.Envelope.EntityID),
+			"",
+		)
+	}
+	return request, nil
+}
+
+func isProvisioningStatus(status storeentity.RequestStatus) bool {
+	switch status {
+	case storeentity.RequestStatusPlanning,
+		storeentity.RequestStatusPlanned,
+		storeentity.RequestStatusProvisioning:
+		return true
+	default:
+		return false
+	}
+}
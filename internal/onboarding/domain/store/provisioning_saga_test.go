@@ -0,0 +1,332 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	infrasinputport "github.com/tuannm99/podzone/internal/onboarding/domain/infrasmanager/inputport"
+	infrasmocks "github.com/tuannm99/podzone/internal/onboarding/domain/infrasmanager/inputport/mocks"
+	storeentity "github.com/tuannm99/podzone/internal/onboarding/domain/store/entity"
+	storemocks "github.com/tuannm99/podzone/internal/onboarding/domain/store/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type provisioningSagaHarness struct {
+	repo      *storemocks.MockStoreRepository
+	infra     *infrasmocks.MockUsecase
+	finalizer *storemocks.MockOperationalStoreFinalizer
+	store     *memorySagaStore
+	now       time.Time
+	sagas     *messaging.SagaOrchestrator
+	request   *storeentity.StoreRequest
+	steps     []string
+}
+
+func newProvisioningSagaHarness(t *testing.T) *provisioningSagaHarness {
+	t.Helper()
+	h := &provisioningSagaHarness{
+		repo:      storemocks.NewMockStoreRepository(t),
+		infra:     infrasmocks.NewMockUsecase(t),
+		finalizer: storemocks.NewMockOperationalStoreFinalizer(t),
+		store:     &memorySagaStore{sagas: map[string]messaging.SagaInstance{}},
+		now:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		request: &storeentity.StoreRequest{
+			ID:          primitive.NewObjectID(),
+			Name:        "Urban Finds",
+			Subdomain:   "urban-finds",
+			WorkspaceID: "workspace-1",
+			RequestedBy: "user-1",
+			Status:      storeentity.RequestStatusPlanning,
+		},
+	}
+	h.repo.EXPECT().
+		FindByID(mock.Anything, h.request.ID.Hex()).
+		RunAndReturn(func(context.Context, string) (*storeentity.StoreRequest, error) {
+			request := *h.request
+			return &request, nil
+		}).
+		Maybe()
+	h.repo.EXPECT().
+		RecordTransition(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, transition storeentity.StoreRequestTransition) error {
+			h.steps = append(h.steps, transition.Step)
+			return nil
+		}).
+		Maybe()
+
+	definition := NewProvisionStoreSaga(ProvisionStoreSagaParams{
+		StoreRepo: h.repo,
+		Finalizer: h.finalizer,
+		Infra:     h.infra,
+	})
+	sagas, err := messaging.NewSagaOrchestrator(
+		h.store,
+		nil,
+		messaging.SagaOrchestratorOptions{Source: "onboarding", Now: func() time.Time { return h.now }},
+		definition,
+	)
+	require.NoError(t, err)
+	h.sagas = sagas
+	return h
+}
+
+func (h *provisioningSagaHarness) expectStatus(status storeentity.RequestStatus) {
+	h.repo.EXPECT().
+		UpdateStatus(mock.Anything, h.request.ID.Hex(), status).
+		RunAndReturn(func(context.Context, string, storeentity.RequestStatus) error {
+			h.request.Status = status
+			return nil
+		}).
+		Once()
+}
+
+func (h *provisioningSagaHarness) start(t *testing.T) string {
+	t.Helper()
+	start := newProvisionStoreSagaStart(h.request, h.now)
+	_, err := h.sagas.Start(context.Background(), ProvisionStoreSagaName, start)
+	require.NoError(t, err)
+	return start.ID
+}
+
+// run moves the clock to each retry until no saga is active.
+func (h *provisioningSagaHarness) run(t *testing.T) {
+	t.Helper()
+	for range 500 {
+		err := h.sagas.RunDue(context.Background())
+		if !errors.Is(err, messaging.ErrNoMessages) {
+			require.NoError(t, err)
+			continue
+		}
+		next, active := h.store.nextAttempt()
+		if !active {
+			return
+		}
+		h.now = next
+	}
+	t.Fatal("saga did not settle")
+}
+
+func (h *provisioningSagaHarness) saga(t *testing.T, id string) messaging.SagaInstance {
+	t.Helper()
+	instance, err := h.sagas.GetSaga(context.Background(), id)
+	require.NoError(t, err)
+	return instance
+}
+
+func TestProvisionStoreSaga_ProvisionsRequestToReady(t *testing.T) {
+	h := newProvisioningSagaHarness(t)
+	requestID := h.request.ID.Hex()
+	h.infra.EXPECT().
+		ProvisionStorePlacement(
+			mock.Anything,
+			mock.MatchedBy(func(req infrasinputport.ProvisionStorePlacementRequest) bool {
+				return req.RequestID == requestID &&
+					req.TenantID == "workspace-1" &&
+					req.StoreID == requestID &&
+					req.Subdomain == "urban-finds" &&
+					req.RequestedBy == "user-1"
+			}),
+			mock.MatchedBy(func(actor map[string]string) bool {
+				return actor["service"] == "onboarding" && actor["worker"] == "store-provisioner"
+			}),
+		).
+		Return(&infrasinputport.ProvisionStorePlacementResponse{AllocationID: "allocation-1"}, nil).
+		Once()
+	h.expectStatus(storeentity.RequestStatusPlanned)
+	h.expectStatus(storeentity.RequestStatusProvisioning)
+	h.infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(false, nil).Twice()
+	h.infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(true, nil).Once()
+	h.finalizer.EXPECT().
+		FinalizeStore(mock.Anything, mock.MatchedBy(func(request storeentity.StoreRequest) bool {
+			return request.ID == h.request.ID
+		})).
+		Return(nil).
+		Once()
+	h.repo.EXPECT().MarkReady(mock.Anything, requestID, requestID).Return(nil).Once()
+
+	id := h.start(t)
+	h.run(t)
+
+	require.Equal(t, messaging.SagaStatusCompleted, h.saga(t, id).Status)
+	require.Equal(t, []string{
+		"planning.completed",
+		"provisioning.started",
+		"route.ready",
+		"store.finalized",
+		"request.ready",
+	}, h.steps)
+}
+
+func TestProvisionStoreSaga_BlocksForPlatformSetupWithoutRetrying(t *testing.T) {
+	h := newProvisioningSagaHarness(t)
+	providerErr := errors.New("kubernetes placement provider is declared but not implemented")
+	h.infra.EXPECT().
+		ProvisionStorePlacement(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, providerErr).
+		Once()
+	h.repo.EXPECT().
+		MarkBlocked(
+			mock.Anything,
+			h.request.ID.Hex(),
+			storeentity.RequestStatusPendingPlatformSetup,
+			providerErr.Error(),
+		).
+		RunAndReturn(func(_ context.Context, _ string, status storeentity.RequestStatus, _ string) error {
+			h.request.Status = status
+			return nil
+		}).
+		Once()
+
+	id := h.start(t)
+	h.run(t)
+
+	instance := h.saga(t, id)
+	require.Equal(t, messaging.SagaStatusCompensated, instance.Status)
+	require.Equal(t, "placement: "+providerErr.Error(), instance.ErrorText)
+	require.Equal(t, []string{"provisioning.failed"}, h.steps, "compensation keeps the specific status")
+}
+
+func TestProvisionStoreSaga_BlocksRetryableAfterRouteNeverBecomesReady(t *testing.T) {
+	h := newProvisioningSagaHarness(t)
+	h.infra.EXPECT().
+		ProvisionStorePlacement(mock.Anything, mock.Anything, mock.Anything).
+		Return(&infrasinputport.ProvisionStorePlacementResponse{}, nil).
+		Once()
+	h.expectStatus(storeentity.RequestStatusPlanned)
+	h.expectStatus(storeentity.RequestStatusProvisioning)
+	h.infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(false, nil)
+	h.repo.EXPECT().
+		MarkBlocked(
+			mock.Anything,
+			h.request.ID.Hex(),
+			storeentity.RequestStatusFailedRetryable,
+			"route: "+ErrRouteNotReady.Error(),
+		).
+		Return(nil).
+		Once()
+
+	id := h.start(t)
+	h.run(t)
+
+	require.Equal(t, messaging.SagaStatusCompensated, h.saga(t, id).Status)
+	require.Equal(t, "provisioning.failed", h.steps[len(h.steps)-1])
+}
+
+func TestProvisionStoreSaga_FailingFinalizationBlocksRequest(t *testing.T) {
+	h := newProvisioningSagaHarness(t)
+	backofficeErr := errors.New("backoffice unavailable")
+	h.infra.EXPECT().
+		ProvisionStorePlacement(mock.Anything, mock.Anything, mock.Anything).
+		Return(&infrasinputport.ProvisionStorePlacementResponse{}, nil).
+		Once()
+	h.expectStatus(storeentity.RequestStatusPlanned)
+	h.expectStatus(storeentity.RequestStatusProvisioning)
+	h.infra.EXPECT().EnsurePlacementRoute(mock.Anything, "workspace-1").Return(true, nil).Once()
+	h.finalizer.EXPECT().
+		FinalizeStore(mock.Anything, mock.Anything).
+		Return(backofficeErr).
+		Times(10)
+	h.repo.EXPECT().
+		MarkBlocked(
+			mock.Anything,
+			h.request.ID.Hex(),
+			storeentity.RequestStatusFailedRetryable,
+			"finalize: "+backofficeErr.Error(),
+		).
+		Return(nil).
+		Once()
+
+	id := h.start(t)
+	h.run(t)
+
+	require.Equal(t, messaging.SagaStatusCompensated, h.saga(t, id).Status)
+}
+
+// memorySagaStore keeps sagas in memory for driving a real orchestrator in tests.
+type memorySagaStore struct {
+	sagas map[string]messaging.SagaInstance
+}
+
+func (m *memorySagaStore) Create(_ context.Context, instance messaging.SagaInstance) error {
+	if _, exists := m.sagas[instance.ID]; exists {
+		return messaging.ErrSagaExists
+	}
+	m.sagas[instance.ID] = instance
+	return nil
+}
+
+func (m *memorySagaStore) Get(_ context.Context, id string) (messaging.SagaInstance, error) {
+	instance, ok := m.sagas[id]
+	if !ok {
+		return messaging.SagaInstance{}, messaging.ErrSagaNotFound
+	}
+	return instance, nil
+}
+
+func (m *memorySagaStore) List(context.Context, messaging.SagaFilter) ([]messaging.SagaInstance, error) {
+	out := make([]messaging.SagaInstance, 0, len(m.sagas))
+	for _, instance := range m.sagas {
+		out = append(out, instance)
+	}
+	return out, nil
+}
+
+func (m *memorySagaStore) ListDue(_ context.Context, now time.Time, _ int) ([]string, error) {
+	var ids []string
+	for id, instance := range m.sagas {
+		if claimable(instance, now) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (m *memorySagaStore) Claim(
+	_ context.Context,
+	id string,
+	lease messaging.SagaLease,
+	now time.Time,
+) (messaging.SagaInstance, error) {
+	instance, ok := m.sagas[id]
+	if !ok || !claimable(instance, now) {
+		return messaging.SagaInstance{}, messaging.ErrSagaNotClaimable
+	}
+	instance.LeaseOwner = lease.Owner
+	instance.LeaseExpiresAt = &lease.ExpiresAt
+	instance.Attempts++
+	instance.Version++
+	m.sagas[id] = instance
+	return instance, nil
+}
+
+func (m *memorySagaStore) Save(_ context.Context, instance messaging.SagaInstance) error {
+	current, ok := m.sagas[instance.ID]
+	if !ok || current.Version != instance.Version {
+		return messaging.ErrSagaConflict
+	}
+	instance.Version++
+	m.sagas[instance.ID] = instance
+	return nil
+}
+
+func (m *memorySagaStore) nextAttempt() (time.Time, bool) {
+	var next time.Time
+	active := false
+	for _, instance := range m.sagas {
+		if instance.Status.Active() && (!active || instance.NextAttemptAt.Before(next)) {
+			next, active = instance.NextAttemptAt, true
+		}
+	}
+	return next, active
+}
+
+func claimable(instance messaging.SagaInstance, now time.Time) bool {
+	leased := instance.LeaseOwner != "" && instance.LeaseExpiresAt != nil && instance.LeaseExpiresAt.After(now)
+	return instance.Status.Active() && !instance.NextAttemptAt.After(now) && !leased
+}
@@ -19,7 +19,10 @@ import (
 	"github.com/tuannm99/podzone/pkg/pdlog"
 )
 
-const storeApprovalPermission = "store:approve"
+const (
+	storeApprovalPermission   = "store:approve"
+	manageMessagingPermission = "platform:manage_messaging"
+)
 
 type AccessAuthorizer struct {
 	client pbiamv1.IAMQueryServiceClient
@@ -146,6 +149,12 @@ func (a *AccessAuthorizer) AuthorizeInfrastructureManage(ctx context.Context, re
 	)
 }
 
+// AuthorizeSagaAdmin has no trusted-service bypass: the service token acts for tenant users,
+// never for a platform operator.
+func (a *AccessAuthorizer) AuthorizeSagaAdmin(ctx context.Context, requestedBy string) error {
+	return a.checkPlatform(ctx, requestedBy, manageMessagingPermission, storedomain.ErrAccessDenied)
+}
+
 func (a *AccessAuthorizer) authorizePlatform(
 	ctx context.Context,
 	requestedBy string,
@@ -155,6 +164,15 @@ func (a *AccessAuthorizer) authorizePlatform(
 	if identity.IsTrustedService(ctx) {
 		return nil
 	}
+	return a.checkPlatform(ctx, requestedBy, permission, deniedError)
+}
+
+func (a *AccessAuthorizer) checkPlatform(
+	ctx context.Context,
+	requestedBy string,
+	permission string,
+	deniedError error,
+) error {
 	userID, err := parseUserID(requestedBy)
 	if err != nil {
 		return err
@@ -16,11 +16,22 @@ type OutboxWorker struct {
 	interval time.Duration
 }
 
-func NewOutboxWorker(log pdlog.Logger, store messaging.OutboxStore, publisher messaging.Publisher) *OutboxWorker {
+// NewOutboxWorker polls the Mongo outbox every cfg.PollInterval. Mongo has no insert
+// notifications here, so cfg.Notify is ignored.
+func NewOutboxWorker(
+	log pdlog.Logger,
+	store messaging.OutboxStore,
+	publisher messaging.Publisher,
+	cfg messaging.OutboxRelayConfig,
+) *OutboxWorker {
+	interval := cfg.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
 	return &OutboxWorker{
 		log:      log,
-		relay:    messagingkafka.NewRelay(store, publisher, 100),
-		interval: 5 * time.Second,
+		relay:    messagingkafka.NewRelayFromConfig(store, publisher, cfg),
+		interval: interval,
 	}
 }
 
@@ -39,7 +50,7 @@ func (w *OutboxWorker) Run(ctx context.Context) {
 }
 
 func (w *OutboxWorker) tick(ctx context.Context) {
-	if err := w.relay.RunOnce(ctx); err != nil {
+	if err := w.relay.Drain(ctx); err != nil {
 		if errors.Is(err, messaging.ErrNoMessages) {
 			return
 		}
@@ -8,14 +8,23 @@ import (
 	"time"
 
 	onboardingconfig "github.com/tuannm99/podzone/internal/onboarding/config"
-	storedomain "github.com/tuannm99/podzone/internal/onboarding/domain/store"
 	storeinputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/inputport"
+	"github.com/tuannm99/podzone/pkg/messaging"
 	"github.com/tuannm99/podzone/pkg/pdlog"
 )
 
+// SagaRunner advances provisioning sagas that are due. *messaging.SagaOrchestrator implements it.
+type SagaRunner interface {
+	RunDue(ctx context.Context) error
+}
+
+// StoreProvisioningWorker claims queued store requests, which starts their provisioning sagas,
+// and then drives those sagas through RunDue. Onboarding publishes no saga.advance commands, so
+// every step attempt starts from this loop.
 type StoreProvisioningWorker struct {
 	log      pdlog.Logger
 	store    storeinputport.Usecase
+	sagas    SagaRunner
 	enabled  bool
 	interval time.Duration
 	batch    int
@@ -25,11 +34,13 @@ type StoreProvisioningWorker struct {
 func NewStoreProvisioningWorker(
 	log pdlog.Logger,
 	store storeinputport.Usecase,
+	sagas SagaRunner,
 	cfg onboardingconfig.StoreProvisioningConfig,
 ) *StoreProvisioningWorker {
 	return &StoreProvisioningWorker{
 		log:      log,
 		store:    store,
+		sagas:    sagas,
 		enabled:  cfg.Enabled,
 		interval: cfg.Interval,
 		batch:    cfg.BatchSize,
@@ -83,27 +94,16 @@ func (w *StoreProvisioningWorker) tick(ctx context.Context) {
 		)
 	}
 
+	// A saga takes one step per RunDue, so a few rounds let new sagas progress in one tick.
 	for i := 0; i < w.batch; i++ {
-		request, err := w.store.FinalizeNextStoreRequest(ctx, w.workerID)
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				return
-			}
-			if errors.Is(err, storedomain.ErrRouteNotReady) {
-				continue
-			}
-			w.log.Error("onboarding store finalization tick failed", "error", err)
+		err := w.sagas.RunDue(ctx)
+		if errors.Is(err, messaging.ErrNoMessages) || errors.Is(err, context.Canceled) {
 			return
 		}
-		if request == nil {
-			break
+		if err != nil {
+			w.log.Error("onboarding store provisioning saga tick failed", "error", err)
+			return
 		}
-		w.log.Info(
-			"onboarding store request ready",
-			"request_id", request.ID,
-			"workspace_id", request.WorkspaceID,
-			"store_id", request.StoreID,
-		)
 	}
 }
 
@@ -5,18 +5,22 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	storeinputport "github.com/tuannm99/podzone/internal/onboarding/domain/store/inputport"
 	storemocks "github.com/tuannm99/podzone/internal/onboarding/domain/store/inputport/mocks"
+	"github.com/tuannm99/podzone/pkg/messaging"
 	logmocks "github.com/tuannm99/podzone/pkg/pdlog/mocks"
 )
 
 func TestStoreProvisioningWorkerTickProcessesBatchesWithWorkerLease(t *testing.T) {
 	log := logmocks.NewMockLogger(t)
 	store := storemocks.NewMockUsecase(t)
+	sagas := &stubSagaRunner{results: []error{nil, messaging.ErrNoMessages}}
 	worker := &StoreProvisioningWorker{
 		log:      log,
 		store:    store,
+		sagas:    sagas,
 		batch:    3,
 		workerID: "worker-test",
 	}
@@ -29,11 +33,40 @@ func TestStoreProvisioningWorkerTickProcessesBatchesWithWorkerLease(t *testing.T
 		ProcessNextStoreRequest(mock.Anything, "worker-test").
 		Return(nil, nil).
 		Once()
-	store.EXPECT().
-		FinalizeNextStoreRequest(mock.Anything, "worker-test").
-		Return(nil, nil).
-		Once()
 	log.EXPECT().Info("onboarding store placement requested", mock.Anything).Return().Once()
 
 	worker.tick(context.Background())
+	require.Equal(t, 2, sagas.calls, "sagas run until none is due")
+}
+
+func TestStoreProvisioningWorkerTickBoundsSagaRounds(t *testing.T) {
+	log := logmocks.NewMockLogger(t)
+	store := storemocks.NewMockUsecase(t)
+	sagas := &stubSagaRunner{}
+	worker := &StoreProvisioningWorker{
+		log:      log,
+		store:    store,
+		sagas:    sagas,
+		batch:    3,
+		workerID: "worker-test",
+	}
+	store.EXPECT().ProcessNextStoreRequest(mock.Anything, "worker-test").Return(nil, nil).Once()
+
+	worker.tick(context.Background())
+	require.Equal(t, 3, sagas.calls)
+}
+
+type stubSagaRunner struct {
+	results []error
+	calls   int
+}
+
+func (s *stubSagaRunner) RunDue(context.Context) error {
+	s.calls++
+	if len(s.results) == 0 {
+		return nil
+	}
+	err := s.results[0]
+	s.results = s.results[1:]
+	return err
 }
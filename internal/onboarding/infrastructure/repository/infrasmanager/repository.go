@@ -24,6 +24,7 @@ var (
 	_ storeoutputport.PlacementPlanRepository     = (*MongoStore)(nil)
 	_ storeoutputport.ResourceInventoryRepository = (*MongoStore)(nil)
 	_ messaging.OutboxStore                       = (*MongoStore)(nil)
+	_ messaging.OutboxRedriveStore                = (*MongoStore)(nil)
 )
 
 type MongoStore struct {
@@ -109,6 +110,15 @@ func (s *MongoStore) EnsureIndexes(ctx context.Context) error {
 			Keys:    bson.D{{Key: "event_id", Value: 1}},
 			Options: options.Index().SetUnique(true).SetName("uniq_connection_outbox_event"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "tenant_id", Value: 1},
+				{Key: "infra_type", Value: 1},
+				{Key: "name", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("connection_outbox_key_created_at"),
+		},
 	})
 	if err != nil {
 		return err
@@ -603,35 +613,218 @@ func (s *MongoStore) Append(ctx context.Context, tx messaging.Tx, record messagi
 	return s.EnqueueOutbox(ctx, msg)
 }
 
-func (s *MongoStore) ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
-	msgs, err := s.FindDueOutbox(ctx, limit)
+// ClaimPending leases due outbox documents one at a time with FindOneAndUpdate, so concurrent
+// relays never claim the same document. A document is skipped while an earlier one for the same
+// tenant, infra type and name (its message key) is still pending, failed or dead.
+func (s *MongoStore) ClaimPending(
+	ctx context.Context,
+	lease messaging.OutboxLease,
+	limit int,
+	now time.Time,
+) ([]messaging.OutboxRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = 50
+	}
+
+	claimable := bson.M{
+		"status":     bson.M{"$in": outboxUnpublishedStatuses},
+		"next_retry": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lease_expires_at": bson.M{"$exists": false}},
+			bson.M{"lease_expires_at": nil},
+			bson.M{"lease_expires_at": bson.M{"$lte": now}},
+		},
+	}
+	cur, err := s.outboxCol.Find(
+		ctx,
+		claimable,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := decodeOutboxMessages(ctx, cur)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]messaging.OutboxRecord, 0, len(candidates))
+	for _, msg := range candidates {
+		heldBack, err := s.outboxCol.CountDocuments(ctx, bson.M{
+			"tenant_id":  msg.TenantID,
+			"infra_type": string(msg.InfraType),
+			"name":       msg.Name,
+			"status":     bson.M{"$in": outboxBlockingStatuses},
+			"created_at": bson.M{"$lt": msg.CreatedAt},
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return nil, err
+		}
+		if heldBack > 0 {
+			continue
+		}
+
+		filter := bson.M{"event_id": msg.EventID}
+		for key, value := range claimable {
+			filter[key] = value
+		}
+		err = s.outboxCol.FindOneAndUpdate(ctx, filter, bson.M{
+			"$set": bson.M{"lease_owner": lease.Owner, "lease_expires_at": lease.ExpiresAt, "updated_at": now},
+		}).Err()
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Another relay claimed it between the find and the update.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		record, err := outboxMessageToRecord(msg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, nil
+}
+
+func (s *MongoStore) MarkPublished(ctx context.Context, owner string, ids []string, publishedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := s.outboxCol.UpdateMany(ctx, bson.M{"event_id": bson.M{"$in": ids}, "lease_owner": owner}, bson.M{
+		"$set":   bson.M{"status": "done", "updated_at": publishedAt},
+		"$unset": outboxLeaseFields,
+	})
+	if err != nil {
+		return err
+	}
+	return requireOutboxLease(res, len(ids))
+}
+
+func (s *MongoStore) MarkFailed(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	failedAt time.Time,
+	nextAttemptAt time.Time,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := s.outboxCol.UpdateOne(ctx, bson.M{"event_id": id, "lease_owner": owner}, bson.M{
+		"$set": bson.M{
+			"status":     "failed",
+			"next_retry": nextAttemptAt,
+			"last_error": errText,
+			"updated_at": failedAt,
+		},
+		"$inc":   bson.M{"retry_count": 1},
+		"$unset": outboxLeaseFields,
+	})
+	if err != nil {
+		return err
+	}
+	return requireOutboxLease(res, 1)
+}
+
+func (s *MongoStore) MarkDead(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := s.outboxCol.UpdateOne(ctx, bson.M{"event_id": id, "lease_owner": owner}, bson.M{
+		"$set":   bson.M{"status": "dead", "last_error": errText, "updated_at": deadAt},
+		"$inc":   bson.M{"retry_count": 1},
+		"$unset": outboxLeaseFields,
+	})
+	if err != nil {
+		return err
+	}
+	return requireOutboxLease(res, 1)
+}
+
+// ListDead returns dead documents oldest first. Message keys are derived from tenant, infra
+// type and name, so the key filter runs after the query; dead documents are few.
+func (s *MongoStore) ListDead(
+	ctx context.Context,
+	filter messaging.DeadOutboxFilter,
+) ([]messaging.OutboxRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if filter.Topic != "" && filter.Topic != messaging.EventTopic("onboarding") {
+		return []messaging.OutboxRecord{}, nil
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	cur, err := s.outboxCol.Find(
+		ctx,
+		bson.M{"status": "dead"},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "event_id", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := decodeOutboxMessages(ctx, cur)
 	if err != nil {
 		return nil, err
 	}
-	out := make([]messaging.OutboxRecord, 0, len(msgs))
-	for _, msg := range msgs {
+
+	out := make([]messaging.OutboxRecord, 0, limit)
+	skipped := 0
+	for _, msg := range messages {
+		if filter.MessageKey != "" && outboxMessageKey(msg) != filter.MessageKey {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
 		record, err := outboxMessageToRecord(msg)
 		if err != nil {
 			return nil, err
 		}
 		out = append(out, record)
+		if len(out) == limit {
+			break
+		}
 	}
 	return out, nil
 }
 
-func (s *MongoStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
-	_ = publishedAt
+// RedriveDead keeps last_error so the last failure stays visible until the document publishes.
+func (s *MongoStore) RedriveDead(ctx context.Context, ids []string, now time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	redriven := make([]string, 0, len(ids))
 	for _, id := range ids {
-		if err := s.MarkOutboxDone(ctx, id); err != nil {
-			return err
+		res, err := s.outboxCol.UpdateOne(ctx, bson.M{"event_id": id, "status": "dead"}, bson.M{
+			"$set": bson.M{"status": "pending", "retry_count": 0, "next_retry": now, "updated_at": now},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res.ModifiedCount > 0 {
+			redriven = append(redriven, id)
 		}
 	}
-	return nil
+	return redriven, nil
 }
 
-func (s *MongoStore) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
-	_ = errText
-	return s.MarkOutboxFailed(ctx, id, nextAttemptAt)
+func requireOutboxLease(res *mongo.UpdateResult, want int) error {
+	if res.MatchedCount < int64(want) {
+		return fmt.Errorf("%w: %d of %d documents", messaging.ErrOutboxLeaseLost, want-int(res.MatchedCount), want)
+	}
+	return nil
 }
 
 func (s *MongoStore) FindDueOutbox(ctx context.Context, limit int) ([]entity.OutboxMessage, error) {
@@ -649,6 +842,17 @@ func (s *MongoStore) FindDueOutbox(ctx context.Context, limit int) ([]entity.Out
 	if err != nil {
 		return nil, err
 	}
+	return decodeOutboxMessages(ctx, cur)
+}
+
+var (
+	outboxUnpublishedStatuses = []string{"pending", "failed"}
+	// A dead document holds back later ones for the same key until it is redriven.
+	outboxBlockingStatuses = []string{"pending", "failed", "dead"}
+	outboxLeaseFields      = bson.M{"lease_owner": "", "lease_expires_at": ""}
+)
+
+func decodeOutboxMessages(ctx context.Context, cur *mongo.Cursor) ([]entity.OutboxMessage, error) {
 	defer cur.Close(ctx)
 
 	var out []entity.OutboxMessage
@@ -663,6 +867,7 @@ func (s *MongoStore) FindDueOutbox(ctx context.Context, limit int) ([]entity.Out
 			Name          string                 `bson:"name"`
 			Status        string                 `bson:"status"`
 			RetryCount    int                    `bson:"retry_count"`
+			LastError     string                 `bson:"last_error"`
 			NextRetry     time.Time              `bson:"next_retry"`
 			CreatedAt     time.Time              `bson:"created_at"`
 			UpdatedAt     time.Time              `bson:"updated_at"`
@@ -680,6 +885,7 @@ func (s *MongoStore) FindDueOutbox(ctx context.Context, limit int) ([]entity.Out
 			Name:          doc.Name,
 			Status:        doc.Status,
 			RetryCount:    doc.RetryCount,
+			LastError:     doc.LastError,
 			NextRetry:     doc.NextRetry,
 			CreatedAt:     doc.CreatedAt,
 			UpdatedAt:     doc.UpdatedAt,
@@ -693,7 +899,8 @@ func (s *MongoStore) MarkOutboxDone(ctx context.Context, eventID string) error {
 	defer cancel()
 
 	_, err := s.outboxCol.UpdateOne(ctx, bson.M{"event_id": eventID}, bson.M{
-		"$set": bson.M{"status": "done", "updated_at": time.Now()},
+		"$set":   bson.M{"status": "done", "updated_at": time.Now()},
+		"$unset": outboxLeaseFields,
 	})
 	return err
 }
@@ -703,8 +910,9 @@ func (s *MongoStore) MarkOutboxFailed(ctx context.Context, eventID string, nextR
 	defer cancel()
 
 	_, err := s.outboxCol.UpdateOne(ctx, bson.M{"event_id": eventID}, bson.M{
-		"$set": bson.M{"status": "failed", "next_retry": nextRetry, "updated_at": time.Now()},
-		"$inc": bson.M{"retry_count": 1},
+		"$set":   bson.M{"status": "failed", "next_retry": nextRetry, "updated_at": time.Now()},
+		"$inc":   bson.M{"retry_count": 1},
+		"$unset": outboxLeaseFields,
 	})
 	return err
 }
@@ -842,6 +1050,7 @@ func outboxRecordToCore(record messaging.OutboxRecord) (entity.OutboxMessage, er
 		Name:          record.Envelope.EntityID,
 		Status:        record.Status,
 		RetryCount:    record.Attempts,
+		LastError:     record.ErrorText,
 		NextRetry:     record.NextAttemptAt,
 		CreatedAt:     record.CreatedAt,
 		UpdatedAt:     record.UpdatedAt,
@@ -878,6 +1087,7 @@ func outboxMessageToRecord(msg entity.OutboxMessage) (messaging.OutboxRecord, er
 		Status:        msg.Status,
 		Attempts:      msg.RetryCount,
 		NextAttemptAt: msg.NextRetry,
+		ErrorText:     msg.LastError,
 		CreatedAt:     msg.CreatedAt,
 		UpdatedAt:     msg.UpdatedAt,
 	}, nil
@@ -199,20 +199,6 @@ func (r *MongoRepository) ClaimNextQueued(
 	)
 }
 
-func (r *MongoRepository) ClaimNextProvisioning(
-	ctx context.Context,
-	leaseOwner string,
-	leaseTTL time.Duration,
-) (*storeentity.StoreRequest, error) {
-	return r.claimNext(
-		ctx,
-		[]storeentity.RequestStatus{storeentity.RequestStatusProvisioning},
-		storeentity.RequestStatusProvisioning,
-		leaseOwner,
-		leaseTTL,
-	)
-}
-
 func (r *MongoRepository) claimNext(
 	ctx context.Context,
 	current []storeentity.RequestStatus,
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS saga_instances (
+  id TEXT PRIMARY KEY,
+  definition TEXT NOT NULL,
+  status TEXT NOT NULL,
+  current_step INT NOT NULL DEFAULT 0,
+  attempts INT NOT NULL DEFAULT 0,
+  envelope_json JSONB NOT NULL,
+  data_json JSONB NULL,
+  error_text TEXT NOT NULL DEFAULT '',
+  next_attempt_at TIMESTAMPTZ NOT NULL,
+  deadline_at TIMESTAMPTZ NULL,
+  lease_owner TEXT NOT NULL DEFAULT '',
+  lease_expires_at TIMESTAMPTZ NULL,
+  version INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_saga_instances_due
+  ON saga_instances (next_attempt_at)
+  WHERE status IN ('running', 'compensating');
+
+CREATE INDEX IF NOT EXISTS idx_saga_instances_definition_status
+  ON saga_instances (definition, status, updated_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saga_instances;
+-- +goose StatementEnd
@@ -2,8 +2,10 @@ package onboarding
 
 import (
 	"context"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
 	"github.com/knadh/koanf/v2"
 	"go.uber.org/fx"
 
@@ -26,17 +28,23 @@ import (
 	placementrouter "github.com/tuannm99/podzone/internal/onboarding/infrastructure/provisioning/router"
 	infrarepository "github.com/tuannm99/podzone/internal/onboarding/infrastructure/repository/infrasmanager"
 	storerepository "github.com/tuannm99/podzone/internal/onboarding/infrastructure/repository/store"
+	"github.com/tuannm99/podzone/internal/onboarding/migrations"
 	"github.com/tuannm99/podzone/pkg/messaging"
 	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	"github.com/tuannm99/podzone/pkg/messaging/outboxadmin"
+	"github.com/tuannm99/podzone/pkg/messaging/sagaadmin"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
 	"github.com/tuannm99/podzone/pkg/pdhttp"
 	"github.com/tuannm99/podzone/pkg/pdkafka"
 	pdlog "github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
 	"github.com/tuannm99/podzone/pkg/pdworker"
 )
 
 const (
 	onboardingConsumerRuntimePath = "messaging.onboarding.consumers.kv_store_bridge"
 	onboardingConsumerName        = "onboarding.kv-store-bridge"
+	onboardingOutboxRelayPath     = "messaging.onboarding.outbox"
 )
 
 var Module = fx.Options(
@@ -47,6 +55,10 @@ var Module = fx.Options(
 		onboardingconfig.NewAuthConfig,
 		httphandler.NewAuthentication,
 		iamclient.NewAccessAuthorizer,
+		func(authorizer *iamclient.AccessAuthorizer) httphandler.SagaAdminAuthorizer {
+			return authorizer
+		},
+		httphandler.NewSagaOperator,
 		fx.Annotate(provideCORSMiddleware, fx.ResultTags(`group:"gin-middleware"`)),
 		fx.Annotate(
 			RegisterHTTPRoutes,
@@ -54,6 +66,8 @@ var Module = fx.Options(
 		),
 	),
 
+	fx.Invoke(RegisterMigration),
+
 	fx.Invoke(func(lc fx.Lifecycle, store storeoutputport.StoreRepository, log pdlog.Logger) {
 		lc.Append(fx.Hook{
 			OnStart: func(ctx context.Context) error {
@@ -143,6 +157,9 @@ var (
 		func(store *infrarepository.MongoStore) messaging.OutboxStore {
 			return store
 		},
+		func(store *infrarepository.MongoStore) messaging.OutboxRedriveStore {
+			return store
+		},
 		placementprovider.NewProvider,
 		func(provider *placementprovider.Provider) infrasoutputport.PlacementPlanner {
 			return provider
@@ -158,6 +175,7 @@ var (
 			fx.As(new(infrasoutputport.PlacementRouteReader)),
 			fx.As(new(infrasoutputport.PlacementRouteWriter)),
 		),
+		NewOutboxRelayConfig,
 		worker.NewOutboxWorker,
 		fx.Annotate(
 			worker.NewConsumerWorker,
@@ -193,6 +211,20 @@ var (
 			storerepository.New,
 			fx.As(new(storeoutputport.StoreRepository)),
 		),
+		fx.Annotate(
+			func(db *sqlx.DB) (messaging.SagaStore, error) {
+				return messagingsql.NewSagaStore(db, "")
+			},
+			fx.ParamTags(`name:"sql-onboarding"`),
+		),
+		store.NewProvisionStoreSaga,
+		NewSagaOrchestrator,
+		func(orchestrator *messaging.SagaOrchestrator) storeoutputport.ProvisioningSagas {
+			return orchestrator
+		},
+		func(orchestrator *messaging.SagaOrchestrator) worker.SagaRunner {
+			return orchestrator
+		},
 		worker.NewStoreProvisioningWorker,
 
 		// --- Domain layer ---
@@ -214,22 +246,71 @@ type Controller interface {
 type RegisterRoutesParams struct {
 	fx.In
 
-	Logger      pdlog.Logger
-	Auth        *httphandler.Authentication
-	Controllers []Controller `group:"onboarding-controllers"`
+	Logger       pdlog.Logger
+	Auth         *httphandler.Authentication
+	SagaOperator *httphandler.SagaOperator
+	Sagas        *messaging.SagaOrchestrator
+	Outbox       messaging.OutboxRedriveStore
+	Controllers  []Controller `group:"onboarding-controllers"`
 }
 
 func RegisterHTTPRoutes(p RegisterRoutesParams) pdhttp.RouteRegistrar {
 	p.Logger.Info("Registering Onboarding HTTP handler")
+	sagas := sagaadmin.NewHandler(p.Sagas)
+	outbox := outboxadmin.NewHandler(p.Outbox)
 	return func(r *gin.Engine) {
 		v1 := r.Group("/onboarding/v1")
 		v1.Use(p.Auth.RequireUser)
 		for _, ctrl := range p.Controllers {
 			ctrl.RegisterRoutes(v1)
 		}
+
+		admin := r.Group("/onboarding/admin/v1")
+		admin.Use(p.Auth.RequireUser, p.SagaOperator.Require)
+		sagas.RegisterRoutes(admin)
+		outbox.RegisterRoutes(admin)
 	}
 }
 
+// NewSagaOrchestrator runs the store provisioning sagas. Onboarding has no saga topic, so it
+// publishes no saga.advance commands and the store provisioning worker drives every step
+// through RunDue.
+func NewSagaOrchestrator(
+	store messaging.SagaStore,
+	provisioning messaging.SagaDefinition,
+) (*messaging.SagaOrchestrator, error) {
+	return messaging.NewSagaOrchestrator(
+		store,
+		nil,
+		messaging.SagaOrchestratorOptions{Source: "onboarding"},
+		provisioning,
+	)
+}
+
+type MigrateParams struct {
+	fx.In
+	Logger   pdlog.Logger
+	DBConfig *pdsql.Config `name:"sql-onboarding-config"`
+	DB       *sqlx.DB      `name:"sql-onboarding"`
+}
+
+func RegisterMigration(p MigrateParams) {
+	if !p.DBConfig.ShouldRunMigration {
+		p.Logger.Info("Disabled migration ...")
+		return
+	}
+
+	p.Logger.Info("Migrating onboarding database with goose...")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := migrations.Apply(ctx, p.DB.DB, "postgres"); err != nil {
+		p.Logger.Error("Migration failed", "err", err)
+		return
+	}
+	p.Logger.Info("Migration completed")
+}
+
 func NewRuntimeConfig(k *koanf.Koanf) messaging.ConsumerRuntimeConfig {
 	cfg := messaging.LoadConsumerRuntimeConfig(
 		k,
@@ -239,3 +320,7 @@ func NewRuntimeConfig(k *koanf.Koanf) messaging.ConsumerRuntimeConfig {
 	cfg.Idempotency.ConsumerName = onboardingConsumerName
 	return cfg
 }
+
+func NewOutboxRelayConfig(k *koanf.Koanf) messaging.OutboxRelayConfig {
+	return messaging.LoadOutboxRelayConfig(k, onboardingOutboxRelayPath, messaging.DefaultOutboxRelayConfig())
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/pdauthn"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type GRPCConfig struct {
+	GRPCHost string `koanf:"grpc_host"`
+	GRPCPort string `koanf:"grpc_port"`
+}
+
+func (c GRPCConfig) Addr() string {
+	return c.GRPCHost + ":" + c.GRPCPort
+}
+
+type Config struct {
+	Authn pdauthn.Config
+	IAM   GRPCConfig
+	Cart  GRPCConfig
+}
+
+func NewConfig(k *koanf.Koanf) Config {
+	cfg := Config{
+		Authn: pdauthn.Config{
+			JWTSecret: toolkit.GetEnv("JWT_SECRET", ""),
+			JWTKey:    toolkit.GetEnv("JWT_KEY", ""),
+			JWKSURL:   toolkit.GetEnv("JWKS_URL", ""),
+		},
+	}
+	var iam, cart GRPCConfig
+	if k != nil {
+		if cfg.Authn.JWTSecret == "" {
+			cfg.Authn.JWTSecret = k.String("order.auth.jwt_secret")
+		}
+		if cfg.Authn.JWTKey == "" {
+			cfg.Authn.JWTKey = k.String("order.auth.jwt_key")
+		}
+		if cfg.Authn.JWKSURL == "" {
+			cfg.Authn.JWKSURL = k.String("order.auth.jwks_url")
+		}
+		cfg.Authn.LegacyHS256Until = pdauthn.LoadLegacyHS256Until(k, "order.auth.legacy_hs256_until")
+		iam = GRPCConfig{GRPCHost: k.String("order.iam.grpc_host"), GRPCPort: k.String("order.iam.grpc_port")}
+		cart = GRPCConfig{GRPCHost: k.String("order.cart.grpc_host"), GRPCPort: k.String("order.cart.grpc_port")}
+	}
+	cfg.IAM = GRPCConfig{
+		GRPCHost: toolkit.GetEnv("IAM_GRPC_HOST", firstNonEmpty(iam.GRPCHost, "iam-service")),
+		GRPCPort: toolkit.GetEnv("IAM_GRPC_PORT", firstNonEmpty(iam.GRPCPort, "50053")),
+	}
+	cfg.Cart = GRPCConfig{
+		GRPCHost: toolkit.GetEnv("CART_GRPC_HOST", firstNonEmpty(cart.GRPCHost, "cart-service")),
+		GRPCPort: toolkit.GetEnv("CART_GRPC_PORT", firstNonEmpty(cart.GRPCPort, "50055")),
+	}
+	return cfg
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
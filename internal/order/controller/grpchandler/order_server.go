@@ -0,0 +1,241 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ordermapper "github.com/tuannm99/podzone/internal/order/controller/mapper"
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderinputport "github.com/tuannm99/podzone/internal/order/domain/order/inputport"
+	pborderv1 "github.com/tuannm99/podzone/pkg/api/proto/order/v1"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+type OrderServer struct {
+	pborderv1.UnimplementedOrderServiceServer
+	uc   orderinputport.Usecase
+	auth *Authentication
+}
+
+func NewOrderServer(uc orderinputport.Usecase, auth *Authentication) *OrderServer {
+	return &OrderServer{uc: uc, auth: auth}
+}
+
+func (s *OrderServer) CreateOrder(ctx context.Context, req *pborderv1.CreateOrderRequest) (*pborderv1.Order, error) {
+	return s.order(ctx, func(ctx context.Context) (*entity.Order, error) {
+		return s.uc.CreateOrder(ctx, orderinputport.CreateOrderCommand{
+			CartID:           req.GetCartId(),
+			StoreID:          req.GetStoreId(),
+			BillingAddress:   ordermapper.FromProtoBillingAddress(req.GetBillingAddress()),
+			ShippingAddress:  ordermapper.FromProtoShippingAddress(req.GetShippingAddress()),
+			ShippingMethodID: req.GetShippingMethodId(),
+			PaymentMethodID:  req.GetPaymentMethodId(),
+			PaymentMetadata:  req.GetPaymentMetadata(),
+			Notes:            req.GetNotes(),
+			IdempotencyKey:   idempotencyKey(ctx),
+		})
+	})
+}
+
+func (s *OrderServer) GetOrder(ctx context.Context, req *pborderv1.GetOrderRequest) (*pborderv1.Order, error) {
+	return s.order(ctx, func(ctx context.Context) (*entity.Order, error) {
+		return s.uc.GetOrder(ctx, req.GetId())
+	})
+}
+
+func (s *OrderServer) ListOrders(
+	ctx context.Context,
+	req *pborderv1.ListOrdersRequest,
+) (*pborderv1.ListOrdersResponse, error) {
+	return s.orders(ctx, func(ctx context.Context) (collection.Page[entity.Order], error) {
+		return s.uc.ListOrders(ctx, orderinputport.ListOrdersQuery{
+			Status:     ordermapper.FromProtoStatus(req.GetStatus()),
+			Collection: ordermapper.ToCollectionQuery(req.GetPage(), req.GetPageSize(), req.GetSortBy(), req.GetSortDesc()),
+		})
+	})
+}
+
+func (s *OrderServer) CancelOrder(ctx context.Context, req *pborderv1.CancelOrderRequest) (*pborderv1.Order, error) {
+	return s.order(ctx, func(ctx context.Context) (*entity.Order, error) {
+		return s.uc.CancelOrder(ctx, req.GetId(), req.GetReason())
+	})
+}
+
+func (s *OrderServer) UpdateOrderStatus(
+	ctx context.Context,
+	req *pborderv1.UpdateOrderStatusRequest,
+) (*pborderv1.Order, error) {
+	return s.order(ctx, func(ctx context.Context) (*entity.Order, error) {
+		return s.uc.UpdateOrderStatus(ctx, orderinputport.UpdateOrderStatusCommand{
+			ID:             req.GetId(),
+			Status:         ordermapper.FromProtoStatus(req.GetStatus()),
+			Notes:          req.GetNotes(),
+			TrackingNumber: req.GetTrackingNumber(),
+			TrackingURL:    req.GetTrackingUrl(),
+		})
+	})
+}
+
+func (s *OrderServer) AddOrderNote(ctx context.Context, req *pborderv1.AddOrderNoteRequest) (*pborderv1.Order, error) {
+	return s.order(ctx, func(ctx context.Context) (*entity.Order, error) {
+		return s.uc.AddOrderNote(ctx, orderinputport.AddOrderNoteCommand{
+			ID:                req.GetId(),
+			Content:           req.GetContent(),
+			IsCustomerVisible: req.GetIsCustomerVisible(),
+		})
+	})
+}
+
+func (s *OrderServer) ListAllOrders(
+	ctx context.Context,
+	req *pborderv1.ListAllOrdersRequest,
+) (*pborderv1.ListOrdersResponse, error) {
+	return s.orders(ctx, func(ctx context.Context) (collection.Page[entity.Order], error) {
+		return s.uc.ListAllOrders(ctx, orderinputport.ListOrdersQuery{
+			Status:     ordermapper.FromProtoStatus(req.GetStatus()),
+			CustomerID: req.GetCustomerId(),
+			Search:     req.GetSearch(),
+			StartDate:  optionalTime(req.GetStartDate()),
+			EndDate:    optionalTime(req.GetEndDate()),
+			MinTotal:   req.GetMinTotal(),
+			MaxTotal:   req.GetMaxTotal(),
+			Collection: ordermapper.ToCollectionQuery(req.GetPage(), req.GetPageSize(), req.GetSortBy(), req.GetSortDesc()),
+		})
+	})
+}
+
+func (s *OrderServer) GetOrdersByCustomer(
+	ctx context.Context,
+	req *pborderv1.GetOrdersByCustomerRequest,
+) (*pborderv1.ListOrdersResponse, error) {
+	return s.orders(ctx, func(ctx context.Context) (collection.Page[entity.Order], error) {
+		return s.uc.GetOrdersByCustomer(ctx, orderinputport.ListOrdersQuery{
+			CustomerID: req.GetCustomerId(),
+			Status:     ordermapper.FromProtoStatus(req.GetStatus()),
+			Collection: ordermapper.ToCollectionQuery(req.GetPage(), req.GetPageSize(), req.GetSortBy(), req.GetSortDesc()),
+		})
+	})
+}
+
+func (s *OrderServer) CreateRefund(ctx context.Context, req *pborderv1.CreateRefundRequest) (*pborderv1.Refund, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refund, err := s.uc.CreateRefund(ctx, orderinputport.CreateRefundCommand{
+		OrderID: req.GetOrderId(),
+		Amount:  req.GetAmount(),
+		Reason:  req.GetReason(),
+		Items:   ordermapper.FromProtoRefundItems(req.GetItems()),
+		Notes:   req.GetNotes(),
+	})
+	if err != nil {
+		return nil, orderStatusError(err)
+	}
+	return ordermapper.ToProtoRefund(refund), nil
+}
+
+func (s *OrderServer) GetOrderRefunds(
+	ctx context.Context,
+	req *pborderv1.GetOrderRefundsRequest,
+) (*pborderv1.ListRefundsResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refunds, total, err := s.uc.GetOrderRefunds(ctx, req.GetOrderId())
+	if err != nil {
+		return nil, orderStatusError(err)
+	}
+	out := make([]*pborderv1.Refund, 0, len(refunds))
+	for i := range refunds {
+		out = append(out, ordermapper.ToProtoRefund(&refunds[i]))
+	}
+	return &pborderv1.ListRefundsResponse{Refunds: out, TotalRefunded: total}, nil
+}
+
+// order scopes the call and maps the resulting order, which is the shape most order RPCs share.
+func (s *OrderServer) order(
+	ctx context.Context,
+	call func(ctx context.Context) (*entity.Order, error),
+) (*pborderv1.Order, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := call(ctx)
+	if err != nil {
+		return nil, orderStatusError(err)
+	}
+	return ordermapper.ToProtoOrder(out), nil
+}
+
+func (s *OrderServer) orders(
+	ctx context.Context,
+	call func(ctx context.Context) (collection.Page[entity.Order], error),
+) (*pborderv1.ListOrdersResponse, error) {
+	ctx, err := s.auth.Scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := call(ctx)
+	if err != nil {
+		return nil, orderStatusError(err)
+	}
+	return ordermapper.ToProtoOrderPage(page), nil
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	value := ts.AsTime()
+	return &value
+}
+
+func orderStatusError(err error) error {
+	switch {
+	case errors.Is(err, orderdomain.ErrOrderNotFound),
+		errors.Is(err, orderdomain.ErrStoreNotFound),
+		errors.Is(err, orderdomain.ErrRefundItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, orderdomain.ErrAuthenticationRequired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, orderdomain.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, orderdomain.ErrConcurrentUpdate),
+		errors.Is(err, orderdomain.ErrIdempotencyKeyConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, orderdomain.ErrCartEmpty),
+		errors.Is(err, orderdomain.ErrCartItemUnavailable),
+		errors.Is(err, orderdomain.ErrShippingRequired),
+		errors.Is(err, orderdomain.ErrShippingMethodMismatch),
+		errors.Is(err, orderdomain.ErrInvalidTransition),
+		errors.Is(err, orderdomain.ErrOrderNotCancellable),
+		errors.Is(err, orderdomain.ErrRefundQuantityExceeded),
+		errors.Is(err, orderdomain.ErrRefundExceedsBalance),
+		errors.Is(err, orderdomain.ErrIdempotencyKeyReused):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, orderdomain.ErrTenantRequired),
+		errors.Is(err, orderdomain.ErrOrderIDRequired),
+		errors.Is(err, orderdomain.ErrCartIDRequired),
+		errors.Is(err, orderdomain.ErrStoreIDRequired),
+		errors.Is(err, orderdomain.ErrCustomerIDRequired),
+		errors.Is(err, orderdomain.ErrEmailRequired),
+		errors.Is(err, orderdomain.ErrShippingAddressRequired),
+		errors.Is(err, orderdomain.ErrInvalidStatus),
+		errors.Is(err, orderdomain.ErrNoteContentRequired),
+		errors.Is(err, orderdomain.ErrInvalidRefund),
+		errors.Is(err, orderdomain.ErrInvalidTotalRange),
+		errors.Is(err, collection.ErrInvalidQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
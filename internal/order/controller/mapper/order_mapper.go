@@ -0,0 +1,287 @@
+package mapper
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	pborderv1 "github.com/tuannm99/podzone/pkg/api/proto/order/v1"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+func ToProtoOrder(in *entity.Order) *pborderv1.Order {
+	if in == nil {
+		return nil
+	}
+	out := &pborderv1.Order{
+		Id:              in.ID,
+		UserId:          in.UserID,
+		StoreId:         in.StoreID,
+		OrderNumber:     in.OrderNumber,
+		Status:          ToProtoStatus(in.Status),
+		PaymentStatus:   ToProtoPaymentStatus(in.PaymentStatus),
+		Currency:        in.Currency,
+		Items:           toProtoItems(in.Items),
+		Totals:          toProtoTotals(in.Totals),
+		BillingAddress:  toProtoBillingAddress(in.BillingAddress),
+		ShippingAddress: toProtoShippingAddress(in.ShippingAddress),
+		ShippingInfo: &pborderv1.ShippingInfo{
+			Carrier:           in.ShippingInfo.Carrier,
+			Method:            in.ShippingInfo.Method,
+			TrackingNumber:    in.ShippingInfo.TrackingNumber,
+			TrackingUrl:       in.ShippingInfo.TrackingURL,
+			Cost:              in.ShippingInfo.Cost,
+			EstimatedDelivery: in.ShippingInfo.EstimatedDelivery,
+		},
+		PaymentInfo: &pborderv1.PaymentInfo{
+			Provider:      in.PaymentInfo.Provider,
+			Method:        in.PaymentInfo.Method,
+			TransactionId: in.PaymentInfo.TransactionID,
+			Status:        in.PaymentInfo.Status,
+			Amount:        in.PaymentInfo.Amount,
+			Currency:      in.PaymentInfo.Currency,
+			Metadata:      in.PaymentInfo.Metadata,
+		},
+		Coupons: toProtoCoupons(in.Coupons),
+		Notes:   toProtoNotes(in.Notes),
+		Customer: &pborderv1.Customer{
+			Id:        in.Customer.ID,
+			Email:     in.Customer.Email,
+			FirstName: in.Customer.FirstName,
+			LastName:  in.Customer.LastName,
+			Phone:     in.Customer.Phone,
+		},
+		Metadata:  in.Metadata,
+		CreatedAt: timestamppb.New(in.CreatedAt),
+		UpdatedAt: timestamppb.New(in.UpdatedAt),
+	}
+	if in.PaymentInfo.PaidAt != nil {
+		out.PaymentInfo.PaidAt = timestamppb.New(*in.PaymentInfo.PaidAt)
+	}
+	if in.CompletedAt != nil {
+		out.CompletedAt = timestamppb.New(*in.CompletedAt)
+	}
+	return out
+}
+
+func ToProtoOrderPage(page collection.Page[entity.Order]) *pborderv1.ListOrdersResponse {
+	orders := make([]*pborderv1.Order, 0, len(page.Items))
+	for i := range page.Items {
+		orders = append(orders, ToProtoOrder(&page.Items[i]))
+	}
+	return &pborderv1.ListOrdersResponse{
+		Orders:     orders,
+		Total:      int32(page.Total),
+		Page:       int32(page.Page),
+		PageSize:   int32(page.PageSize),
+		TotalPages: int32(page.TotalPages),
+	}
+}
+
+func ToProtoRefund(in *entity.Refund) *pborderv1.Refund {
+	if in == nil {
+		return nil
+	}
+	items := make([]*pborderv1.RefundItem, 0, len(in.Items))
+	for _, item := range in.Items {
+		items = append(items, &pborderv1.RefundItem{
+			OrderItemId: item.OrderItemID,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+			Reason:      item.Reason,
+		})
+	}
+	return &pborderv1.Refund{
+		Id:            in.ID,
+		OrderId:       in.OrderID,
+		Amount:        in.Amount,
+		Reason:        in.Reason,
+		TransactionId: in.TransactionID,
+		Status:        in.Status,
+		IsPartial:     in.IsPartial,
+		Items:         items,
+		Notes:         in.Notes,
+		CreatedBy:     in.CreatedBy,
+		CreatedAt:     timestamppb.New(in.CreatedAt),
+	}
+}
+
+func ToProtoStatus(status string) pborderv1.OrderStatus {
+	value, ok := pborderv1.OrderStatus_value["ORDER_STATUS_"+strings.ToUpper(status)]
+	if !ok {
+		return pborderv1.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+	return pborderv1.OrderStatus(value)
+}
+
+// FromProtoStatus returns "" for UNSPECIFIED so list filters treat it as "any status".
+func FromProtoStatus(status pborderv1.OrderStatus) string {
+	if status == pborderv1.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		return ""
+	}
+	name, ok := pborderv1.OrderStatus_name[int32(status)]
+	if !ok {
+		return "unknown"
+	}
+	return strings.ToLower(strings.TrimPrefix(name, "ORDER_STATUS_"))
+}
+
+func ToProtoPaymentStatus(status string) pborderv1.PaymentStatus {
+	value, ok := pborderv1.PaymentStatus_value["PAYMENT_STATUS_"+strings.ToUpper(status)]
+	if !ok {
+		return pborderv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+	return pborderv1.PaymentStatus(value)
+}
+
+func FromProtoBillingAddress(in *pborderv1.BillingAddress) entity.Address {
+	return entity.Address{
+		FirstName:    in.GetFirstName(),
+		LastName:     in.GetLastName(),
+		Email:        in.GetEmail(),
+		Phone:        in.GetPhone(),
+		AddressLine1: in.GetAddressLine1(),
+		AddressLine2: in.GetAddressLine2(),
+		City:         in.GetCity(),
+		State:        in.GetState(),
+		PostalCode:   in.GetPostalCode(),
+		Country:      in.GetCountry(),
+		Company:      in.GetCompany(),
+	}
+}
+
+func FromProtoShippingAddress(in *pborderv1.ShippingAddress) entity.Address {
+	return entity.Address{
+		FirstName:     in.GetFirstName(),
+		LastName:      in.GetLastName(),
+		Phone:         in.GetPhone(),
+		AddressLine1:  in.GetAddressLine1(),
+		AddressLine2:  in.GetAddressLine2(),
+		City:          in.GetCity(),
+		State:         in.GetState(),
+		PostalCode:    in.GetPostalCode(),
+		Country:       in.GetCountry(),
+		Company:       in.GetCompany(),
+		DeliveryNotes: in.GetDeliveryNotes(),
+	}
+}
+
+func FromProtoRefundItems(items []*pborderv1.RefundItem) []entity.RefundItem {
+	out := make([]entity.RefundItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, entity.RefundItem{
+			OrderItemID: item.GetOrderItemId(),
+			Quantity:    item.GetQuantity(),
+			Amount:      item.GetAmount(),
+			Reason:      item.GetReason(),
+		})
+	}
+	return out
+}
+
+// ToCollectionQuery adapts the order's flat paging fields to the shared collection contract.
+func ToCollectionQuery(page, pageSize int32, sortBy string, sortDesc bool) collection.Query {
+	direction := collection.SortAscending
+	if sortDesc || sortBy == "" {
+		direction = collection.SortDescending
+	}
+	return collection.Query{
+		Page:          int(page),
+		PageSize:      int(pageSize),
+		SortBy:        sortBy,
+		SortDirection: direction,
+	}.Normalize()
+}
+
+func toProtoItems(items []entity.Item) []*pborderv1.OrderItem {
+	out := make([]*pborderv1.OrderItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &pborderv1.OrderItem{
+			Id:         item.ID,
+			ProductId:  item.ProductID,
+			Name:       item.Name,
+			Sku:        item.SKU,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice,
+			Subtotal:   item.Subtotal,
+			Discount:   item.Discount,
+			Total:      item.Total,
+			ImageUrl:   item.ImageURL,
+			Attributes: item.Attributes,
+		})
+	}
+	return out
+}
+
+func toProtoTotals(in entity.Totals) *pborderv1.OrderTotals {
+	return &pborderv1.OrderTotals{
+		Subtotal:      in.Subtotal,
+		DiscountTotal: in.DiscountTotal,
+		TaxTotal:      in.TaxTotal,
+		ShippingTotal: in.ShippingTotal,
+		GrandTotal:    in.GrandTotal,
+		ItemsCount:    in.ItemsCount,
+		ItemsQuantity: in.ItemsQuantity,
+	}
+}
+
+func toProtoBillingAddress(in entity.Address) *pborderv1.BillingAddress {
+	return &pborderv1.BillingAddress{
+		FirstName:    in.FirstName,
+		LastName:     in.LastName,
+		Email:        in.Email,
+		Phone:        in.Phone,
+		AddressLine1: in.AddressLine1,
+		AddressLine2: in.AddressLine2,
+		City:         in.City,
+		State:        in.State,
+		PostalCode:   in.PostalCode,
+		Country:      in.Country,
+		Company:      in.Company,
+	}
+}
+
+func toProtoShippingAddress(in entity.Address) *pborderv1.ShippingAddress {
+	return &pborderv1.ShippingAddress{
+		FirstName:     in.FirstName,
+		LastName:      in.LastName,
+		Phone:         in.Phone,
+		AddressLine1:  in.AddressLine1,
+		AddressLine2:  in.AddressLine2,
+		City:          in.City,
+		State:         in.State,
+		PostalCode:    in.PostalCode,
+		Country:       in.Country,
+		Company:       in.Company,
+		DeliveryNotes: in.DeliveryNotes,
+	}
+}
+
+func toProtoCoupons(coupons []entity.Coupon) []*pborderv1.OrderCoupon {
+	out := make([]*pborderv1.OrderCoupon, 0, len(coupons))
+	for _, coupon := range coupons {
+		out = append(out, &pborderv1.OrderCoupon{
+			Code:           coupon.Code,
+			Type:           coupon.Type,
+			Value:          coupon.Value,
+			DiscountAmount: coupon.DiscountAmount,
+			Description:    coupon.Description,
+		})
+	}
+	return out
+}
+
+func toProtoNotes(notes []entity.Note) []*pborderv1.OrderNote {
+	out := make([]*pborderv1.OrderNote, 0, len(notes))
+	for _, note := range notes {
+		out = append(out, &pborderv1.OrderNote{
+			Id:                note.ID,
+			Content:           note.Content,
+			Author:            note.Author,
+			IsCustomerVisible: note.IsCustomerVisible,
+			CreatedAt:         timestamppb.New(note.CreatedAt),
+		})
+	}
+	return out
+}
@@ -0,0 +1,256 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+	StatusCancelled  = "cancelled"
+	StatusRefunded   = "refunded"
+	StatusFailed     = "failed"
+	StatusOnHold     = "on_hold"
+	StatusShipped    = "shipped"
+	StatusDelivered  = "delivered"
+)
+
+const (
+	PaymentStatusPending           = "pending"
+	PaymentStatusCompleted         = "completed"
+	PaymentStatusFailed            = "failed"
+	PaymentStatusRefunded          = "refunded"
+	PaymentStatusPartiallyRefunded = "partially_refunded"
+)
+
+const RefundStatusCompleted = "completed"
+
+// statusTransitions lists the staff-driven moves out of each status.
+// Refunded is only reached through refunds, never set directly.
+var statusTransitions = map[string][]string{
+	StatusPending:    {StatusProcessing, StatusOnHold, StatusCancelled, StatusFailed},
+	StatusProcessing: {StatusShipped, StatusCompleted, StatusOnHold, StatusCancelled},
+	StatusOnHold:     {StatusProcessing, StatusCancelled},
+	StatusShipped:    {StatusDelivered, StatusCompleted},
+	StatusDelivered:  {StatusCompleted},
+}
+
+type Order struct {
+	ID              string            `json:"id"`
+	TenantID        string            `json:"tenant_id"`
+	StoreID         string            `json:"store_id"`
+	UserID          string            `json:"user_id"`
+	CartID          string            `json:"cart_id"`
+	IdempotencyKey  string            `json:"idempotency_key,omitempty"`
+	OrderNumber     string            `json:"order_number"`
+	Status          string            `json:"status"`
+	PaymentStatus   string            `json:"payment_status"`
+	Currency        string            `json:"currency"`
+	Items           []Item            `json:"items"`
+	Totals          Totals            `json:"totals"`
+	BillingAddress  Address           `json:"billing_address"`
+	ShippingAddress Address           `json:"shipping_address"`
+	ShippingInfo    ShippingInfo      `json:"shipping_info"`
+	PaymentInfo     PaymentInfo       `json:"payment_info"`
+	Coupons         []Coupon          `json:"coupons"`
+	Notes           []Note            `json:"notes"`
+	Customer        Customer          `json:"customer"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	RefundedTotal   float64           `json:"refunded_total"`
+	Version         int64             `json:"version"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	CompletedAt     *time.Time        `json:"completed_at,omitempty"`
+}
+
+type Item struct {
+	ID         string            `json:"id"`
+	ProductID  string            `json:"product_id"`
+	Name       string            `json:"name"`
+	SKU        string            `json:"sku"`
+	Quantity   int32             `json:"quantity"`
+	UnitPrice  float64           `json:"unit_price"`
+	Subtotal   float64           `json:"subtotal"`
+	Discount   float64           `json:"discount"`
+	Total      float64           `json:"total"`
+	ImageURL   string            `json:"image_url"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+type Totals struct {
+	Subtotal      float64 `json:"subtotal"`
+	DiscountTotal float64 `json:"discount_total"`
+	TaxTotal      float64 `json:"tax_total"`
+	ShippingTotal float64 `json:"shipping_total"`
+	GrandTotal    float64 `json:"grand_total"`
+	ItemsCount    int32   `json:"items_count"`
+	ItemsQuantity int32   `json:"items_quantity"`
+}
+
+// Address covers both billing and shipping addresses; Email only applies to billing and
+// DeliveryNotes only to shipping.
+type Address struct {
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Email         string `json:"email,omitempty"`
+	Phone         string `json:"phone"`
+	AddressLine1  string `json:"address_line1"`
+	AddressLine2  string `json:"address_line2"`
+	City          string `json:"city"`
+	State         string `json:"state"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+	Company       string `json:"company"`
+	DeliveryNotes string `json:"delivery_notes,omitempty"`
+}
+
+type ShippingInfo struct {
+	OptionID          string  `json:"option_id"`
+	Carrier           string  `json:"carrier"`
+	Method            string  `json:"method"`
+	TrackingNumber    string  `json:"tracking_number"`
+	TrackingURL       string  `json:"tracking_url"`
+	Cost              float64 `json:"cost"`
+	EstimatedDelivery string  `json:"estimated_delivery"`
+}
+
+type PaymentInfo struct {
+	Provider      string            `json:"provider"`
+	Method        string            `json:"method"`
+	TransactionID string            `json:"transaction_id"`
+	Status        string            `json:"status"`
+	Amount        float64           `json:"amount"`
+	Currency      string            `json:"currency"`
+	PaidAt        *time.Time        `json:"paid_at,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+}
+
+type Coupon struct {
+	Code           string  `json:"code"`
+	Type           string  `json:"type"`
+	Value          float64 `json:"value"`
+	DiscountAmount float64 `json:"discount_amount"`
+	Description    string  `json:"description"`
+}
+
+type Note struct {
+	ID                string    `json:"id"`
+	Content           string    `json:"content"`
+	Author            string    `json:"author"`
+	IsCustomerVisible bool      `json:"is_customer_visible"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type Customer struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+}
+
+type Refund struct {
+	ID            string       `json:"id"`
+	TenantID      string       `json:"tenant_id"`
+	OrderID       string       `json:"order_id"`
+	Amount        float64      `json:"amount"`
+	Reason        string       `json:"reason"`
+	TransactionID string       `json:"transaction_id"`
+	Status        string       `json:"status"`
+	IsPartial     bool         `json:"is_partial"`
+	Items         []RefundItem `json:"items"`
+	Notes         string       `json:"notes"`
+	CreatedBy     string       `json:"created_by"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+type RefundItem struct {
+	OrderItemID string  `json:"order_item_id"`
+	Quantity    int32   `json:"quantity"`
+	Amount      float64 `json:"amount"`
+	Reason      string  `json:"reason"`
+}
+
+// CartSnapshot is the priced cart the order is built from.
+type CartSnapshot struct {
+	ID               string
+	UserID           string
+	Currency         string
+	Items            []CartItem
+	Coupons          []Coupon
+	SelectedShipping *CartShipping
+	Totals           Totals
+}
+
+type CartItem struct {
+	ID         string
+	ProductID  string
+	Name       string
+	SKU        string
+	Quantity   int32
+	UnitPrice  float64
+	ImageURL   string
+	Attributes map[string]string
+	InStock    bool
+}
+
+type CartShipping struct {
+	ID                string
+	Name              string
+	Carrier           string
+	Price             float64
+	EstimatedDelivery string
+}
+
+func (o *Order) IsTerminal() bool {
+	switch o.Status {
+	case StatusCompleted, StatusCancelled, StatusRefunded, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o *Order) CanTransitionTo(next string) bool {
+	for _, allowed := range statusTransitions[o.Status] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomerCanCancel reports whether the shopper may still cancel without staff involvement.
+func (o *Order) CustomerCanCancel() bool {
+	return o.Status == StatusPending || o.Status == StatusOnHold
+}
+
+func (o *Order) RefundableAmount() float64 {
+	return RoundMoney(math.Max(0, o.Totals.GrandTotal-o.RefundedTotal))
+}
+
+func (o *Order) FindItem(id string) (*Item, bool) {
+	for i := range o.Items {
+		if o.Items[i].ID == id {
+			return &o.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// CustomerVisibleNotes drops staff-only notes before an order is shown to the shopper.
+func (o *Order) CustomerVisibleNotes() []Note {
+	notes := make([]Note, 0, len(o.Notes))
+	for _, note := range o.Notes {
+		if note.IsCustomerVisible {
+			notes = append(notes, note)
+		}
+	}
+	return notes
+}
+
+func RoundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,33 @@
+package order
+
+import "errors"
+
+var (
+	ErrTenantRequired          = errors.New("tenant_id is required")
+	ErrAuthenticationRequired  = errors.New("sign in to view orders")
+	ErrAccessDenied            = errors.New("order access denied")
+	ErrOrderIDRequired         = errors.New("order id is required")
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrCartIDRequired          = errors.New("cart_id is required")
+	ErrStoreIDRequired         = errors.New("store_id is required")
+	ErrStoreNotFound           = errors.New("store not found")
+	ErrCustomerIDRequired      = errors.New("customer_id is required")
+	ErrCartEmpty               = errors.New("cart has no items")
+	ErrCartItemUnavailable     = errors.New("cart has items that are no longer available")
+	ErrShippingRequired        = errors.New("select a shipping option before checkout")
+	ErrShippingMethodMismatch  = errors.New("shipping method does not match the cart selection")
+	ErrEmailRequired           = errors.New("billing email is required")
+	ErrShippingAddressRequired = errors.New("shipping address line, city and country are required")
+	ErrInvalidStatus           = errors.New("invalid order status")
+	ErrInvalidTransition       = errors.New("order status transition is not allowed")
+	ErrOrderNotCancellable     = errors.New("order can no longer be cancelled")
+	ErrNoteContentRequired     = errors.New("note content is required")
+	ErrInvalidRefund           = errors.New("refund needs a positive amount or items")
+	ErrRefundItemNotFound      = errors.New("refund item is not part of the order")
+	ErrRefundQuantityExceeded  = errors.New("refund quantity exceeds the purchased quantity")
+	ErrRefundExceedsBalance    = errors.New("refund exceeds the refundable amount")
+	ErrInvalidTotalRange       = errors.New("min_total must not exceed max_total")
+	ErrConcurrentUpdate        = errors.New("order was changed concurrently, retry")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used for a different checkout")
+	ErrIdempotencyKeyConflict  = errors.New("an order with this idempotency key already exists")
+)
@@ -0,0 +1,82 @@
+package order
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+const (
+	EventSource      = "order"
+	EventOrderPlaced = events.TypeOrderPlaced
+)
+
+// OrderPlacedPayload is the order.placed contract consumed by backoffice routing; it lives in
+// pkg/api/events so the consumer decodes the same type.
+type (
+	OrderPlacedPayload = events.OrderPlacedV1
+	OrderPlacedItem    = events.OrderPlacedItemV1
+)
+
+func newOrderPlacedRecord(order entity.Order) (messaging.OutboxRecord, error) {
+	items := make([]OrderPlacedItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, OrderPlacedItem{
+			ItemID:     item.ID,
+			ProductID:  item.ProductID,
+			SKU:        item.SKU,
+			Name:       item.Name,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice,
+			Total:      item.Total,
+			Attributes: item.Attributes,
+		})
+	}
+	payload, err := json.Marshal(OrderPlacedPayload{
+		OrderID:             order.ID,
+		OrderNumber:         order.OrderNumber,
+		TenantID:            order.TenantID,
+		StoreID:             order.StoreID,
+		UserID:              order.UserID,
+		Currency:            order.Currency,
+		CustomerName:        strings.TrimSpace(order.Customer.FirstName + " " + order.Customer.LastName),
+		CustomerEmail:       order.Customer.Email,
+		ShipRegion:          strings.ToLower(order.ShippingAddress.Country),
+		ShippingPartnerID:   order.ShippingInfo.OptionID,
+		ShippingPartnerCode: order.ShippingInfo.Carrier,
+		GrandTotal:          order.Totals.GrandTotal,
+		Items:               items,
+		PlacedAt:            order.CreatedAt,
+	})
+	if err != nil {
+		return messaging.OutboxRecord{}, err
+	}
+	record := messaging.OutboxRecord{
+		ID:            uuid.NewString(),
+		Topic:         messaging.EventTopic(EventSource),
+		MessageKey:    order.ID,
+		Status:        "pending",
+		NextAttemptAt: order.CreatedAt,
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.CreatedAt,
+		Envelope: messaging.Envelope{
+			ID:            uuid.NewString(),
+			Type:          EventOrderPlaced,
+			Source:        EventSource,
+			TenantID:      order.TenantID,
+			EntityID:      order.ID,
+			OccurredAt:    order.CreatedAt,
+			SchemaVersion: 1,
+			Payload:       payload,
+		},
+	}
+	if err := events.Schemas().ValidateRegistered(record.Envelope); err != nil {
+		return messaging.OutboxRecord{}, err
+	}
+	return record, nil
+}
@@ -0,0 +1,69 @@
+package inputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+type CreateOrderCommand struct {
+	CartID           string
+	StoreID          string
+	BillingAddress   entity.Address
+	ShippingAddress  entity.Address
+	ShippingMethodID string
+	PaymentMethodID  string
+	PaymentMetadata  map[string]string
+	Notes            string
+	// IdempotencyKey makes a retried checkout return the order the first attempt placed.
+	IdempotencyKey string
+}
+
+type UpdateOrderStatusCommand struct {
+	ID             string
+	Status         string
+	Notes          string
+	TrackingNumber string
+	TrackingURL    string
+}
+
+type AddOrderNoteCommand struct {
+	ID                string
+	Content           string
+	IsCustomerVisible bool
+}
+
+type ListOrdersQuery struct {
+	Status     string
+	CustomerID string
+	Search     string
+	StartDate  *time.Time
+	EndDate    *time.Time
+	MinTotal   float64
+	MaxTotal   float64
+	Collection collection.Query
+}
+
+type CreateRefundCommand struct {
+	OrderID string
+	Amount  float64
+	Reason  string
+	Items   []entity.RefundItem
+	Notes   string
+}
+
+type Usecase interface {
+	CreateOrder(ctx context.Context, cmd CreateOrderCommand) (*entity.Order, error)
+	GetOrder(ctx context.Context, id string) (*entity.Order, error)
+	// ListOrders lists the signed-in shopper's own orders.
+	ListOrders(ctx context.Context, query ListOrdersQuery) (collection.Page[entity.Order], error)
+	CancelOrder(ctx context.Context, id, reason string) (*entity.Order, error)
+	UpdateOrderStatus(ctx context.Context, cmd UpdateOrderStatusCommand) (*entity.Order, error)
+	AddOrderNote(ctx context.Context, cmd AddOrderNoteCommand) (*entity.Order, error)
+	ListAllOrders(ctx context.Context, query ListOrdersQuery) (collection.Page[entity.Order], error)
+	GetOrdersByCustomer(ctx context.Context, query ListOrdersQuery) (collection.Page[entity.Order], error)
+	CreateRefund(ctx context.Context, cmd CreateRefundCommand) (*entity.Refund, error)
+	GetOrderRefunds(ctx context.Context, orderID string) ([]entity.Refund, float64, error)
+}
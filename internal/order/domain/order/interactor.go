@@ -0,0 +1,706 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderinputport "github.com/tuannm99/podzone/internal/order/domain/order/inputport"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// customerAuthor marks notes the shopper wrote at checkout.
+const customerAuthor = "customer"
+
+var _ orderinputport.Usecase = (*OrderInteractor)(nil)
+
+type OrderInteractor struct {
+	orders     orderoutputport.OrderRepository
+	carts      orderoutputport.CartReader
+	stores     orderoutputport.StoreDirectory
+	stock      orderoutputport.StockReserver
+	authorizer orderoutputport.AccessAuthorizer
+	logger     pdlog.Logger
+	now        func() time.Time
+}
+
+type OrderInteractorParams struct {
+	fx.In
+
+	Orders     orderoutputport.OrderRepository
+	Carts      orderoutputport.CartReader
+	Stores     orderoutputport.StoreDirectory
+	Stock      orderoutputport.StockReserver
+	Authorizer orderoutputport.AccessAuthorizer
+	Logger     pdlog.Logger
+}
+
+func NewOrderInteractor(params OrderInteractorParams) *OrderInteractor {
+	return &OrderInteractor{
+		orders:     params.Orders,
+		carts:      params.Carts,
+		stores:     params.Stores,
+		stock:      params.Stock,
+		authorizer: params.Authorizer,
+		logger:     params.Logger,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *OrderInteractor) CreateOrder(
+	ctx context.Context,
+	cmd orderinputport.CreateOrderCommand,
+) (*entity.Order, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cartID := strings.TrimSpace(cmd.CartID)
+	if cartID == "" {
+		return nil, ErrCartIDRequired
+	}
+	storeID := strings.TrimSpace(cmd.StoreID)
+	if storeID == "" {
+		return nil, ErrStoreIDRequired
+	}
+	billing := normalizeAddress(cmd.BillingAddress)
+	billing.Email = strings.ToLower(billing.Email)
+	if billing.Email == "" {
+		return nil, ErrEmailRequired
+	}
+	shipping := normalizeAddress(cmd.ShippingAddress)
+	shipping.Email = ""
+	shipping.Country = strings.ToUpper(shipping.Country)
+	if shipping.AddressLine1 == "" || shipping.City == "" || shipping.Country == "" {
+		return nil, ErrShippingAddressRequired
+	}
+
+	owned, err := s.stores.TenantOwnsStore(ctx, tenantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, ErrStoreNotFound
+	}
+
+	// A retry of a checkout that already went through finds its order here; the cart is
+	// cleared by then, so this has to run before the cart is read.
+	userID, _ := toolkit.GetUserID(ctx)
+	idempotencyKey := strings.TrimSpace(cmd.IdempotencyKey)
+	if idempotencyKey != "" {
+		existing, err := s.orders.FindByIdempotencyKey(ctx, tenantID, idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return replayedOrder(existing, cartID, userID)
+		}
+	}
+
+	cart, err := s.carts.GetCart(ctx, tenantID, cartID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, ErrCartEmpty
+	}
+	for _, item := range cart.Items {
+		if !item.InStock {
+			return nil, ErrCartItemUnavailable
+		}
+	}
+	if cart.SelectedShipping == nil {
+		return nil, ErrShippingRequired
+	}
+	if methodID := strings.TrimSpace(cmd.ShippingMethodID); methodID != "" && methodID != cart.SelectedShipping.ID {
+		return nil, ErrShippingMethodMismatch
+	}
+
+	now := s.now()
+	order := entity.Order{
+		ID:              uuid.NewString(),
+		TenantID:        tenantID,
+		StoreID:         storeID,
+		UserID:          userID,
+		CartID:          cart.ID,
+		IdempotencyKey:  idempotencyKey,
+		OrderNumber:     newOrderNumber(now),
+		Status:          entity.StatusPending,
+		PaymentStatus:   entity.PaymentStatusPending,
+		Currency:        cart.Currency,
+		Items:           orderItems(cart),
+		Totals:          cart.Totals,
+		BillingAddress:  billing,
+		ShippingAddress: shipping,
+		ShippingInfo: entity.ShippingInfo{
+			OptionID:          cart.SelectedShipping.ID,
+			Carrier:           cart.SelectedShipping.Carrier,
+			Method:            cart.SelectedShipping.Name,
+			Cost:              cart.SelectedShipping.Price,
+			EstimatedDelivery: cart.SelectedShipping.EstimatedDelivery,
+		},
+		PaymentInfo: entity.PaymentInfo{
+			Method:   strings.TrimSpace(cmd.PaymentMethodID),
+			Status:   entity.PaymentStatusPending,
+			Amount:   cart.Totals.GrandTotal,
+			Currency: cart.Currency,
+			Metadata: cmd.PaymentMetadata,
+		},
+		Coupons: cart.Coupons,
+		Customer: entity.Customer{
+			ID:        userID,
+			Email:     billing.Email,
+			FirstName: billing.FirstName,
+			LastName:  billing.LastName,
+			Phone:     billing.Phone,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if note := strings.TrimSpace(cmd.Notes); note != "" {
+		order.Notes = append(order.Notes, newNote(note, customerAuthor, true, now))
+	}
+
+	placed, err := newOrderPlacedRecord(order)
+	if err != nil {
+		return nil, fmt.Errorf("build %s event: %w", EventOrderPlaced, err)
+	}
+	// InStock on the cart is only a snapshot; the reservation is what keeps two checkouts from
+	// selling the same last unit.
+	if err := s.stock.Reserve(ctx, tenantID, cart.Items); err != nil {
+		return nil, err
+	}
+	if err := s.orders.Create(ctx, order, []messaging.OutboxRecord{placed}); err != nil {
+		s.releaseStock(ctx, tenantID, order.ID, cart.Items)
+		if errors.Is(err, ErrIdempotencyKeyConflict) {
+			// A concurrent retry placed the order first.
+			existing, findErr := s.orders.FindByIdempotencyKey(ctx, tenantID, idempotencyKey)
+			if findErr != nil {
+				return nil, findErr
+			}
+			if existing != nil {
+				return replayedOrder(existing, cartID, userID)
+			}
+		}
+		return nil, err
+	}
+	// The order is already committed, so checkout still succeeds; a cart left behind is harmless
+	// and the shopper can still clear it.
+	if err := s.carts.ClearCart(ctx, tenantID, cart.ID); err != nil {
+		s.logger.Warn("Failed to clear cart after checkout",
+			"tenant_id", tenantID, "order_id", order.ID, "cart_id", cart.ID, "err", err)
+	}
+	return &order, nil
+}
+
+// releaseStock returns stock reserved for an order that was not placed. A failed release only
+// under-reports inventory, so it is logged rather than masking the checkout error.
+func (s *OrderInteractor) releaseStock(ctx context.Context, tenantID, orderID string, items []entity.CartItem) {
+	if err := s.stock.Release(ctx, tenantID, items); err != nil {
+		s.logger.Error("Failed to release reserved stock",
+			"tenant_id", tenantID, "order_id", orderID, "err", err)
+	}
+}
+
+// replayedOrder answers a retried checkout with the order its key already placed, provided the
+// retry is for the same cart and shopper.
+func replayedOrder(existing *entity.Order, cartID, userID string) (*entity.Order, error) {
+	if existing.CartID != cartID || existing.UserID != userID {
+		return nil, ErrIdempotencyKeyReused
+	}
+	existing.Notes = existing.CustomerVisibleNotes()
+	return existing, nil
+}
+
+func (s *OrderInteractor) GetOrder(ctx context.Context, id string) (*entity.Order, error) {
+	_, order, err := s.visibleOrder(ctx, id)
+	return order, err
+}
+
+func (s *OrderInteractor) ListOrders(
+	ctx context.Context,
+	query orderinputport.ListOrdersQuery,
+) (collection.Page[entity.Order], error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return collection.Page[entity.Order]{}, ErrAuthenticationRequired
+	}
+	if err := validateStatusFilter(query.Status); err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	page, err := s.orders.List(ctx, orderoutputport.OrderFilter{
+		TenantID: tenantID,
+		UserID:   userID,
+		Status:   query.Status,
+	}, query.Collection.Normalize())
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	for i := range page.Items {
+		page.Items[i].Notes = page.Items[i].CustomerVisibleNotes()
+	}
+	return page, nil
+}
+
+func (s *OrderInteractor) CancelOrder(ctx context.Context, id, reason string) (*entity.Order, error) {
+	tenantID, order, err := s.loadOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return nil, ErrAuthenticationRequired
+	}
+	owner := isOwner(order, userID)
+	if !owner || !order.CustomerCanCancel() {
+		if err := s.authorizer.AuthorizeOrderManage(ctx, tenantID, userID); err != nil {
+			if owner {
+				return nil, ErrOrderNotCancellable
+			}
+			return nil, ErrOrderNotFound
+		}
+		if !order.CanTransitionTo(entity.StatusCancelled) {
+			return nil, ErrOrderNotCancellable
+		}
+	}
+
+	now := s.now()
+	order.Status = entity.StatusCancelled
+	content := "Order cancelled"
+	if reason = strings.TrimSpace(reason); reason != "" {
+		content += ": " + reason
+	}
+	order.Notes = append(order.Notes, newNote(content, userID, true, now))
+	order.UpdatedAt = now
+	updated, err := s.orders.Update(ctx, *order)
+	if err != nil {
+		return nil, err
+	}
+	return s.presentFor(updated, userID), nil
+}
+
+func (s *OrderInteractor) UpdateOrderStatus(
+	ctx context.Context,
+	cmd orderinputport.UpdateOrderStatusCommand,
+) (*entity.Order, error) {
+	tenantID, userID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next := strings.TrimSpace(cmd.Status)
+	if !isKnownStatus(next) || next == entity.StatusRefunded {
+		return nil, ErrInvalidStatus
+	}
+	order, err := s.findOrder(ctx, tenantID, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	if next != order.Status && !order.CanTransitionTo(next) {
+		return nil, ErrInvalidTransition
+	}
+
+	now := s.now()
+	if trackingNumber := strings.TrimSpace(cmd.TrackingNumber); trackingNumber != "" {
+		order.ShippingInfo.TrackingNumber = trackingNumber
+	}
+	if trackingURL := strings.TrimSpace(cmd.TrackingURL); trackingURL != "" {
+		order.ShippingInfo.TrackingURL = trackingURL
+	}
+	if note := strings.TrimSpace(cmd.Notes); note != "" {
+		order.Notes = append(order.Notes, newNote(note, userID, false, now))
+	}
+	order.Status = next
+	if next == entity.StatusCompleted && order.CompletedAt == nil {
+		order.CompletedAt = &now
+	}
+	order.UpdatedAt = now
+	return s.orders.Update(ctx, *order)
+}
+
+func (s *OrderInteractor) AddOrderNote(
+	ctx context.Context,
+	cmd orderinputport.AddOrderNoteCommand,
+) (*entity.Order, error) {
+	content := strings.TrimSpace(cmd.Content)
+	if content == "" {
+		return nil, ErrNoteContentRequired
+	}
+	tenantID, order, err := s.loadOrder(ctx, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return nil, ErrAuthenticationRequired
+	}
+	visible := cmd.IsCustomerVisible
+	if isOwner(order, userID) {
+		// Whatever the shopper writes is, by definition, visible to the shopper.
+		visible = true
+	} else if err := s.authorizer.AuthorizeOrderManage(ctx, tenantID, userID); err != nil {
+		return nil, ErrOrderNotFound
+	}
+
+	now := s.now()
+	order.Notes = append(order.Notes, newNote(content, userID, visible, now))
+	order.UpdatedAt = now
+	updated, err := s.orders.Update(ctx, *order)
+	if err != nil {
+		return nil, err
+	}
+	return s.presentFor(updated, userID), nil
+}
+
+func (s *OrderInteractor) ListAllOrders(
+	ctx context.Context,
+	query orderinputport.ListOrdersQuery,
+) (collection.Page[entity.Order], error) {
+	tenantID, _, err := s.authorizeRead(ctx)
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	if err := validateStatusFilter(query.Status); err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	if query.MinTotal < 0 || query.MaxTotal < 0 ||
+		(query.MaxTotal > 0 && query.MinTotal > query.MaxTotal) {
+		return collection.Page[entity.Order]{}, ErrInvalidTotalRange
+	}
+	return s.orders.List(ctx, orderoutputport.OrderFilter{
+		TenantID:  tenantID,
+		UserID:    strings.TrimSpace(query.CustomerID),
+		Status:    query.Status,
+		Search:    strings.TrimSpace(query.Search),
+		StartDate: query.StartDate,
+		EndDate:   query.EndDate,
+		MinTotal:  query.MinTotal,
+		MaxTotal:  query.MaxTotal,
+	}, query.Collection.Normalize())
+}
+
+func (s *OrderInteractor) GetOrdersByCustomer(
+	ctx context.Context,
+	query orderinputport.ListOrdersQuery,
+) (collection.Page[entity.Order], error) {
+	if strings.TrimSpace(query.CustomerID) == "" {
+		return collection.Page[entity.Order]{}, ErrCustomerIDRequired
+	}
+	return s.ListAllOrders(ctx, orderinputport.ListOrdersQuery{
+		CustomerID: query.CustomerID,
+		Status:     query.Status,
+		Collection: query.Collection,
+	})
+}
+
+func (s *OrderInteractor) CreateRefund(
+	ctx context.Context,
+	cmd orderinputport.CreateRefundCommand,
+) (*entity.Refund, error) {
+	tenantID, userID, err := s.authorizeManage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.findOrder(ctx, tenantID, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Amount < 0 || (cmd.Amount == 0 && len(cmd.Items) == 0) {
+		return nil, ErrInvalidRefund
+	}
+
+	items := make([]entity.RefundItem, 0, len(cmd.Items))
+	amount := entity.RoundMoney(cmd.Amount)
+	if len(cmd.Items) > 0 {
+		previous, err := s.orders.ListRefunds(ctx, tenantID, order.ID)
+		if err != nil {
+			return nil, err
+		}
+		refunded := refundedQuantities(previous)
+		var itemsAmount float64
+		for _, requested := range cmd.Items {
+			item, err := refundItem(order, requested, refunded)
+			if err != nil {
+				return nil, err
+			}
+			refunded[item.OrderItemID] += item.Quantity
+			itemsAmount += item.Amount
+			items = append(items, item)
+		}
+		if amount == 0 {
+			amount = entity.RoundMoney(itemsAmount)
+		}
+	}
+	if amount <= 0 {
+		return nil, ErrInvalidRefund
+	}
+	if amount > order.RefundableAmount() {
+		return nil, ErrRefundExceedsBalance
+	}
+
+	now := s.now()
+	order.RefundedTotal = entity.RoundMoney(order.RefundedTotal + amount)
+	fullyRefunded := order.RefundableAmount() == 0
+	order.PaymentStatus = entity.PaymentStatusPartiallyRefunded
+	if fullyRefunded {
+		order.PaymentStatus = entity.PaymentStatusRefunded
+		order.Status = entity.StatusRefunded
+	}
+	order.UpdatedAt = now
+	refund := entity.Refund{
+		ID:        uuid.NewString(),
+		TenantID:  tenantID,
+		OrderID:   order.ID,
+		Amount:    amount,
+		Reason:    strings.TrimSpace(cmd.Reason),
+		Status:    entity.RefundStatusCompleted,
+		IsPartial: !fullyRefunded,
+		Items:     items,
+		Notes:     strings.TrimSpace(cmd.Notes),
+		CreatedBy: userID,
+		CreatedAt: now,
+	}
+	if _, err := s.orders.CreateRefund(ctx, refund, *order); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+func (s *OrderInteractor) GetOrderRefunds(ctx context.Context, orderID string) ([]entity.Refund, float64, error) {
+	tenantID, order, err := s.visibleOrder(ctx, orderID)
+	if err != nil {
+		return nil, 0, err
+	}
+	refunds, err := s.orders.ListRefunds(ctx, tenantID, order.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return refunds, order.RefundedTotal, nil
+}
+
+// visibleOrder loads an order for its owner, or for staff with order:read.
+// Everyone else gets ErrOrderNotFound so order ids cannot be probed.
+func (s *OrderInteractor) visibleOrder(ctx context.Context, id string) (string, *entity.Order, error) {
+	tenantID, order, err := s.loadOrder(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return "", nil, ErrAuthenticationRequired
+	}
+	if isOwner(order, userID) {
+		return tenantID, s.presentFor(order, userID), nil
+	}
+	if err := s.authorizer.AuthorizeOrderRead(ctx, tenantID, userID); err != nil {
+		return "", nil, ErrOrderNotFound
+	}
+	return tenantID, order, nil
+}
+
+func (s *OrderInteractor) loadOrder(ctx context.Context, id string) (string, *entity.Order, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	order, err := s.findOrder(ctx, tenantID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	return tenantID, order, nil
+}
+
+func (s *OrderInteractor) findOrder(ctx context.Context, tenantID, id string) (*entity.Order, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrOrderIDRequired
+	}
+	return s.orders.FindByID(ctx, tenantID, id)
+}
+
+// presentFor hides staff-only notes when the caller is the shopper who placed the order.
+func (s *OrderInteractor) presentFor(order *entity.Order, userID string) *entity.Order {
+	if isOwner(order, userID) {
+		order.Notes = order.CustomerVisibleNotes()
+	}
+	return order
+}
+
+func (s *OrderInteractor) authorizeRead(ctx context.Context) (string, string, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return "", "", ErrAccessDenied
+	}
+	if err := s.authorizer.AuthorizeOrderRead(ctx, tenantID, userID); err != nil {
+		return "", "", err
+	}
+	return tenantID, userID, nil
+}
+
+func (s *OrderInteractor) authorizeManage(ctx context.Context) (string, string, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	userID, err := toolkit.GetUserID(ctx)
+	if err != nil {
+		return "", "", ErrAccessDenied
+	}
+	if err := s.authorizer.AuthorizeOrderManage(ctx, tenantID, userID); err != nil {
+		return "", "", err
+	}
+	return tenantID, userID, nil
+}
+
+func tenantFromContext(ctx context.Context) (string, error) {
+	tenantID, err := toolkit.GetTenantID(ctx)
+	if err != nil || strings.TrimSpace(tenantID) == "" {
+		return "", ErrTenantRequired
+	}
+	return strings.TrimSpace(tenantID), nil
+}
+
+func isOwner(order *entity.Order, userID string) bool {
+	return order.UserID != "" && order.UserID == userID
+}
+
+func isKnownStatus(status string) bool {
+	switch status {
+	case entity.StatusPending, entity.StatusProcessing, entity.StatusCompleted, entity.StatusCancelled,
+		entity.StatusRefunded, entity.StatusFailed, entity.StatusOnHold, entity.StatusShipped,
+		entity.StatusDelivered:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateStatusFilter(status string) error {
+	if status != "" && !isKnownStatus(status) {
+		return ErrInvalidStatus
+	}
+	return nil
+}
+
+// orderItems copies the cart lines and spreads the cart-level discount across them by subtotal,
+// leaving any rounding remainder on the last line so item totals add up to the order.
+func orderItems(cart *entity.CartSnapshot) []entity.Item {
+	items := make([]entity.Item, 0, len(cart.Items))
+	discount := cart.Totals.DiscountTotal
+	subtotal := cart.Totals.Subtotal
+	var allocated float64
+	for i, line := range cart.Items {
+		item := entity.Item{
+			ID:         uuid.NewString(),
+			ProductID:  line.ProductID,
+			Name:       line.Name,
+			SKU:        line.SKU,
+			Quantity:   line.Quantity,
+			UnitPrice:  line.UnitPrice,
+			Subtotal:   entity.RoundMoney(line.UnitPrice * float64(line.Quantity)),
+			ImageURL:   line.ImageURL,
+			Attributes: line.Attributes,
+		}
+		if discount > 0 && subtotal > 0 {
+			if i == len(cart.Items)-1 {
+				item.Discount = entity.RoundMoney(discount - allocated)
+			} else {
+				item.Discount = entity.RoundMoney(discount * item.Subtotal / subtotal)
+			}
+			allocated += item.Discount
+		}
+		item.Total = entity.RoundMoney(item.Subtotal - item.Discount)
+		items = append(items, item)
+	}
+	return items
+}
+
+func refundedQuantities(refunds []entity.Refund) map[string]int32 {
+	quantities := make(map[string]int32)
+	for _, refund := range refunds {
+		for _, item := range refund.Items {
+			quantities[item.OrderItemID] += item.Quantity
+		}
+	}
+	return quantities
+}
+
+// refundItem validates a requested line against what is still refundable and prices it
+// at the line's discounted unit price when no amount is given.
+func refundItem(
+	order *entity.Order,
+	requested entity.RefundItem,
+	refunded map[string]int32,
+) (entity.RefundItem, error) {
+	itemID := strings.TrimSpace(requested.OrderItemID)
+	item, ok := order.FindItem(itemID)
+	if !ok {
+		return entity.RefundItem{}, ErrRefundItemNotFound
+	}
+	if requested.Quantity <= 0 || requested.Amount < 0 {
+		return entity.RefundItem{}, ErrInvalidRefund
+	}
+	if refunded[itemID]+requested.Quantity > item.Quantity {
+		return entity.RefundItem{}, ErrRefundQuantityExceeded
+	}
+	amount := requested.Amount
+	if amount == 0 {
+		amount = item.Total / float64(item.Quantity) * float64(requested.Quantity)
+	}
+	return entity.RefundItem{
+		OrderItemID: itemID,
+		Quantity:    requested.Quantity,
+		Amount:      entity.RoundMoney(amount),
+		Reason:      strings.TrimSpace(requested.Reason),
+	}, nil
+}
+
+func normalizeAddress(in entity.Address) entity.Address {
+	return entity.Address{
+		FirstName:     strings.TrimSpace(in.FirstName),
+		LastName:      strings.TrimSpace(in.LastName),
+		Email:         strings.TrimSpace(in.Email),
+		Phone:         strings.TrimSpace(in.Phone),
+		AddressLine1:  strings.TrimSpace(in.AddressLine1),
+		AddressLine2:  strings.TrimSpace(in.AddressLine2),
+		City:          strings.TrimSpace(in.City),
+		State:         strings.TrimSpace(in.State),
+		PostalCode:    strings.TrimSpace(in.PostalCode),
+		Country:       strings.TrimSpace(in.Country),
+		Company:       strings.TrimSpace(in.Company),
+		DeliveryNotes: strings.TrimSpace(in.DeliveryNotes),
+	}
+}
+
+func newNote(content, author string, visible bool, now time.Time) entity.Note {
+	return entity.Note{
+		ID:                uuid.NewString(),
+		Content:           content,
+		Author:            author,
+		IsCustomerVisible: visible,
+		CreatedAt:         now,
+	}
+}
+
+// newOrderNumber builds the human-facing number; the repository enforces per-tenant uniqueness.
+func newOrderNumber(now time.Time) string {
+	suffix := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:8])
+	return "PZ-" + now.Format("20060102") + "-" + suffix
+}
@@ -0,0 +1,540 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderinputport "github.com/tuannm99/podzone/internal/order/domain/order/inputport"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	ordermocks "github.com/tuannm99/podzone/internal/order/domain/order/outputport/mocks"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	pdlogmocks "github.com/tuannm99/podzone/pkg/pdlog/mocks"
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+var fixedNow = time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+type orderFixture struct {
+	svc        *OrderInteractor
+	orders     *ordermocks.MockOrderRepository
+	carts      *ordermocks.MockCartReader
+	stores     *ordermocks.MockStoreDirectory
+	stock      *ordermocks.MockStockReserver
+	authorizer *ordermocks.MockAccessAuthorizer
+}
+
+func setupOrderInteractor(t *testing.T) orderFixture {
+	t.Helper()
+	f := orderFixture{
+		orders:     ordermocks.NewMockOrderRepository(t),
+		carts:      ordermocks.NewMockCartReader(t),
+		stores:     ordermocks.NewMockStoreDirectory(t),
+		stock:      ordermocks.NewMockStockReserver(t),
+		authorizer: ordermocks.NewMockAccessAuthorizer(t),
+	}
+	f.svc = NewOrderInteractor(OrderInteractorParams{
+		Orders:     f.orders,
+		Carts:      f.carts,
+		Stores:     f.stores,
+		Stock:      f.stock,
+		Authorizer: f.authorizer,
+		Logger:     pdlog.NopLogger{},
+	})
+	f.svc.now = func() time.Time { return fixedNow }
+	return f
+}
+
+func shopperContext(tenantID, userID string) context.Context {
+	return toolkit.WithUserID(toolkit.WithTenantID(context.Background(), tenantID), userID)
+}
+
+func checkedOutCart() *entity.CartSnapshot {
+	return &entity.CartSnapshot{
+		ID:       "cart-1",
+		UserID:   "42",
+		Currency: "USD",
+		Items: []entity.CartItem{
+			{ID: "line-1", ProductID: "p-1", SKU: "SKU-1", Name: "Shirt", Quantity: 2, UnitPrice: 10, InStock: true},
+			{ID: "line-2", ProductID: "p-2", SKU: "SKU-2", Name: "Mug", Quantity: 1, UnitPrice: 10, InStock: true},
+		},
+		SelectedShipping: &entity.CartShipping{ID: "partner-1", Name: "Standard", Carrier: "GHN", Price: 5},
+		Totals: entity.Totals{
+			Subtotal:      30,
+			DiscountTotal: 10,
+			ShippingTotal: 5,
+			GrandTotal:    25,
+			ItemsCount:    2,
+			ItemsQuantity: 3,
+		},
+	}
+}
+
+func checkoutCommand() orderinputport.CreateOrderCommand {
+	return orderinputport.CreateOrderCommand{
+		CartID:  "cart-1",
+		StoreID: "store-1",
+		BillingAddress: entity.Address{
+			FirstName: "Ada",
+			LastName:  "Lovelace",
+			Email:     " Ada@Example.com ",
+		},
+		ShippingAddress: entity.Address{
+			AddressLine1: "1 Main St",
+			City:         "Hanoi",
+			Country:      "vn",
+		},
+		ShippingMethodID: "partner-1",
+		Notes:            "leave at the door",
+	}
+}
+
+func placedOrder() *entity.Order {
+	return &entity.Order{
+		ID:       "order-1",
+		TenantID: "tenant-1",
+		UserID:   "42",
+		Status:   entity.StatusPending,
+		Items: []entity.Item{
+			{ID: "item-1", Quantity: 2, UnitPrice: 10, Subtotal: 20, Total: 20},
+		},
+		Totals: entity.Totals{GrandTotal: 25},
+		Notes: []entity.Note{
+			{ID: "n-1", Content: "thanks", IsCustomerVisible: true},
+			{ID: "n-2", Content: "fraud check passed"},
+		},
+		Version: 3,
+	}
+}
+
+func TestCreateOrder_PlacesOrderWithOutboxEventAndClearsCart(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(nil)
+
+	var events []messaging.OutboxRecord
+	f.orders.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Order"), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entity.Order, records []messaging.OutboxRecord) error {
+			events = records
+			return nil
+		})
+	f.carts.EXPECT().ClearCart(mock.Anything, "tenant-1", "cart-1").Return(nil)
+
+	order, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusPending, order.Status)
+	require.Equal(t, entity.PaymentStatusPending, order.PaymentStatus)
+	require.Equal(t, "42", order.UserID)
+	require.Equal(t, "store-1", order.StoreID)
+	require.Equal(t, "ada@example.com", order.Customer.Email)
+	require.Equal(t, "VN", order.ShippingAddress.Country)
+	require.Regexp(t, `^PZ-20260304-[0-9A-F]{8}$`, order.OrderNumber)
+	require.Len(t, order.Notes, 1)
+	require.True(t, order.Notes[0].IsCustomerVisible)
+
+	// The cart-level discount is spread across lines so item totals add up to the order.
+	require.Len(t, order.Items, 2)
+	require.InDelta(t, 6.67, order.Items[0].Discount, 0.001)
+	require.InDelta(t, 3.33, order.Items[1].Discount, 0.001)
+	require.InDelta(t, 20, order.Items[0].Total+order.Items[1].Total, 0.001)
+
+	require.Len(t, events, 1)
+	require.Equal(t, messaging.EventTopic("order"), events[0].Topic)
+	require.Equal(t, EventOrderPlaced, events[0].Envelope.Type)
+	require.Equal(t, order.ID, events[0].MessageKey)
+	var payload OrderPlacedPayload
+	require.NoError(t, json.Unmarshal(events[0].Envelope.Payload, &payload))
+	require.Equal(t, "store-1", payload.StoreID)
+	require.Equal(t, "vn", payload.ShipRegion)
+	require.Equal(t, "partner-1", payload.ShippingPartnerID)
+	require.Equal(t, "GHN", payload.ShippingPartnerCode)
+	require.Equal(t, "Ada Lovelace", payload.CustomerName)
+	require.Len(t, payload.Items, 2)
+	require.Equal(t, "SKU-1", payload.Items[0].SKU)
+}
+
+func TestCreateOrder_ClearCartFailureDoesNotFailCheckout(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	logger := pdlogmocks.NewMockLogger(t)
+	f.svc.logger = logger
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(nil)
+	f.orders.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	clearErr := errors.New("redis down")
+	f.carts.EXPECT().ClearCart(mock.Anything, "tenant-1", "cart-1").Return(clearErr)
+	logger.EXPECT().Warn("Failed to clear cart after checkout", mock.Anything).
+		Run(func(_ string, kv ...any) {
+			require.Contains(t, kv, clearErr)
+		}).Once()
+
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.NoError(t, err)
+}
+
+func TestCreateOrder_RejectsStoreOfAnotherTenant(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(false, nil)
+
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.ErrorIs(t, err, ErrStoreNotFound)
+}
+
+func TestCreateOrder_IdempotencyKeyReturnsFirstOrder(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.orders.EXPECT().FindByIdempotencyKey(mock.Anything, "tenant-1", "key-1").Return(nil, nil).Once()
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil).Once()
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(nil).Once()
+
+	var placed entity.Order
+	f.orders.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Order"), mock.Anything).
+		RunAndReturn(func(_ context.Context, order entity.Order, _ []messaging.OutboxRecord) error {
+			placed = order
+			return nil
+		}).Once()
+	f.carts.EXPECT().ClearCart(mock.Anything, "tenant-1", "cart-1").Return(nil).Once()
+
+	cmd := checkoutCommand()
+	cmd.IdempotencyKey = " key-1 "
+	first, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", placed.IdempotencyKey)
+
+	// The retry never reads the now empty cart and places nothing.
+	f.orders.EXPECT().FindByIdempotencyKey(mock.Anything, "tenant-1", "key-1").Return(&placed, nil).Once()
+	retried, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, retried.ID)
+}
+
+func TestCreateOrder_IdempotencyKeyRejectsOtherCheckout(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	existing := placedOrder()
+	existing.CartID = "cart-9"
+	f.orders.EXPECT().FindByIdempotencyKey(mock.Anything, "tenant-1", "key-1").Return(existing, nil)
+
+	cmd := checkoutCommand()
+	cmd.IdempotencyKey = "key-1"
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestCreateOrder_IdempotencyKeyRaceReturnsWinningOrder(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.orders.EXPECT().FindByIdempotencyKey(mock.Anything, "tenant-1", "key-1").Return(nil, nil).Once()
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(nil)
+	f.orders.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(ErrIdempotencyKeyConflict)
+	// The winning checkout holds its own reservation, so this one hands its stock back.
+	f.stock.EXPECT().Release(mock.Anything, "tenant-1", mock.Anything).Return(nil)
+	winner := placedOrder()
+	winner.CartID = "cart-1"
+	f.orders.EXPECT().FindByIdempotencyKey(mock.Anything, "tenant-1", "key-1").Return(winner, nil).Once()
+
+	cmd := checkoutCommand()
+	cmd.IdempotencyKey = "key-1"
+	order, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.NoError(t, err)
+	require.Equal(t, "order-1", order.ID)
+	require.Len(t, order.Notes, 1)
+}
+
+func TestCreateOrder_ReservesCartStockBeforePlacing(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", checkedOutCart().Items).Return(nil)
+	f.orders.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	f.carts.EXPECT().ClearCart(mock.Anything, "tenant-1", "cart-1").Return(nil)
+
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.NoError(t, err)
+}
+
+func TestCreateOrder_SoldOutStockPlacesNothing(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(ErrCartItemUnavailable)
+
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.ErrorIs(t, err, ErrCartItemUnavailable)
+	f.orders.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateOrder_ReleasesStockWhenOrderIsNotStored(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil)
+	f.stock.EXPECT().Reserve(mock.Anything, "tenant-1", mock.Anything).Return(nil)
+	dbErr := errors.New("postgres down")
+	f.orders.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(dbErr)
+	f.stock.EXPECT().Release(mock.Anything, "tenant-1", checkedOutCart().Items).Return(nil)
+
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.ErrorIs(t, err, dbErr)
+	f.carts.AssertNotCalled(t, "ClearCart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateOrder_RejectsUnavailableItemsAndMissingShipping(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+
+	f.stores.EXPECT().TenantOwnsStore(mock.Anything, "tenant-1", "store-1").Return(true, nil)
+	unavailable := checkedOutCart()
+	unavailable.Items[1].InStock = false
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(unavailable, nil).Once()
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.ErrorIs(t, err, ErrCartItemUnavailable)
+
+	unshipped := checkedOutCart()
+	unshipped.SelectedShipping = nil
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(unshipped, nil).Once()
+	_, err = f.svc.CreateOrder(shopperContext("tenant-1", "42"), checkoutCommand())
+	require.ErrorIs(t, err, ErrShippingRequired)
+
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(checkedOutCart(), nil).Once()
+	cmd := checkoutCommand()
+	cmd.ShippingMethodID = "partner-2"
+	_, err = f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.ErrorIs(t, err, ErrShippingMethodMismatch)
+}
+
+func TestCreateOrder_ValidatesAddressesBeforeReadingCart(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+
+	cmd := checkoutCommand()
+	cmd.BillingAddress.Email = " "
+	_, err := f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.ErrorIs(t, err, ErrEmailRequired)
+
+	cmd = checkoutCommand()
+	cmd.ShippingAddress.City = ""
+	_, err = f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.ErrorIs(t, err, ErrShippingAddressRequired)
+
+	cmd = checkoutCommand()
+	cmd.StoreID = ""
+	_, err = f.svc.CreateOrder(shopperContext("tenant-1", "42"), cmd)
+	require.ErrorIs(t, err, ErrStoreIDRequired)
+}
+
+func TestGetOrder_OwnerSeesOnlyCustomerVisibleNotes(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil)
+
+	order, err := f.svc.GetOrder(shopperContext("tenant-1", "42"), "order-1")
+	require.NoError(t, err)
+	require.Len(t, order.Notes, 1)
+	require.Equal(t, "n-1", order.Notes[0].ID)
+}
+
+func TestGetOrder_HidesOtherShoppersOrders(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil)
+	f.authorizer.EXPECT().AuthorizeOrderRead(mock.Anything, "tenant-1", "7").Return(ErrAccessDenied)
+
+	_, err := f.svc.GetOrder(shopperContext("tenant-1", "7"), "order-1")
+	require.ErrorIs(t, err, ErrOrderNotFound)
+}
+
+func TestCancelOrder_OwnerCancelsPendingOrder(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil)
+	f.orders.EXPECT().Update(mock.Anything, mock.MatchedBy(func(order entity.Order) bool {
+		last := order.Notes[len(order.Notes)-1]
+		return order.Status == entity.StatusCancelled && order.Version == 3 &&
+			last.Content == "Order cancelled: changed my mind" && last.IsCustomerVisible
+	})).RunAndReturn(func(_ context.Context, order entity.Order) (*entity.Order, error) {
+		order.Version++
+		return &order, nil
+	})
+
+	order, err := f.svc.CancelOrder(shopperContext("tenant-1", "42"), "order-1", "changed my mind")
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusCancelled, order.Status)
+	for _, note := range order.Notes {
+		require.True(t, note.IsCustomerVisible)
+	}
+}
+
+func TestCancelOrder_OwnerCannotCancelShippedOrder(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	shipped := placedOrder()
+	shipped.Status = entity.StatusShipped
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(shipped, nil)
+	f.authorizer.EXPECT().AuthorizeOrderManage(mock.Anything, "tenant-1", "42").Return(ErrAccessDenied)
+
+	_, err := f.svc.CancelOrder(shopperContext("tenant-1", "42"), "order-1", "")
+	require.ErrorIs(t, err, ErrOrderNotCancellable)
+}
+
+func TestUpdateOrderStatus_EnforcesTransitionsAndStampsCompletion(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.authorizer.EXPECT().AuthorizeOrderManage(mock.Anything, "tenant-1", "staff-1").Return(nil)
+
+	pending := placedOrder()
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(pending, nil).Once()
+	_, err := f.svc.UpdateOrderStatus(shopperContext("tenant-1", "staff-1"), orderinputport.UpdateOrderStatusCommand{
+		ID:     "order-1",
+		Status: entity.StatusDelivered,
+	})
+	require.ErrorIs(t, err, ErrInvalidTransition)
+
+	shipped := placedOrder()
+	shipped.Status = entity.StatusShipped
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(shipped, nil).Once()
+	f.orders.EXPECT().Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, order entity.Order) (*entity.Order, error) {
+			return &order, nil
+		})
+	order, err := f.svc.UpdateOrderStatus(shopperContext("tenant-1", "staff-1"), orderinputport.UpdateOrderStatusCommand{
+		ID:             "order-1",
+		Status:         entity.StatusCompleted,
+		TrackingNumber: "TRK-1",
+		Notes:          "handed over",
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusCompleted, order.Status)
+	require.Equal(t, "TRK-1", order.ShippingInfo.TrackingNumber)
+	require.NotNil(t, order.CompletedAt)
+	require.Equal(t, fixedNow, *order.CompletedAt)
+	require.False(t, order.Notes[len(order.Notes)-1].IsCustomerVisible)
+
+	_, err = f.svc.UpdateOrderStatus(shopperContext("tenant-1", "staff-1"), orderinputport.UpdateOrderStatusCommand{
+		ID:     "order-1",
+		Status: entity.StatusRefunded,
+	})
+	require.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestAddOrderNote_OwnerNotesAreAlwaysCustomerVisible(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil)
+	f.orders.EXPECT().Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, order entity.Order) (*entity.Order, error) {
+			return &order, nil
+		})
+
+	order, err := f.svc.AddOrderNote(shopperContext("tenant-1", "42"), orderinputport.AddOrderNoteCommand{
+		ID:      "order-1",
+		Content: "please gift wrap",
+	})
+	require.NoError(t, err)
+	last := order.Notes[len(order.Notes)-1]
+	require.Equal(t, "please gift wrap", last.Content)
+	require.True(t, last.IsCustomerVisible)
+}
+
+func TestListAllOrders_RequiresReadPermissionAndValidRange(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.authorizer.EXPECT().AuthorizeOrderRead(mock.Anything, "tenant-1", "staff-1").Return(nil)
+
+	_, err := f.svc.ListAllOrders(shopperContext("tenant-1", "staff-1"), orderinputport.ListOrdersQuery{
+		MinTotal: 50,
+		MaxTotal: 10,
+	})
+	require.ErrorIs(t, err, ErrInvalidTotalRange)
+
+	f.orders.EXPECT().List(mock.Anything, orderoutputport.OrderFilter{
+		TenantID: "tenant-1",
+		UserID:   "42",
+		Status:   entity.StatusPending,
+	}, collection.Query{}.Normalize()).Return(collection.NewPage([]entity.Order{*placedOrder()}, 1, collection.Query{}), nil)
+	page, err := f.svc.GetOrdersByCustomer(shopperContext("tenant-1", "staff-1"), orderinputport.ListOrdersQuery{
+		CustomerID: "42",
+		Status:     entity.StatusPending,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	// Staff listings keep internal notes.
+	require.Len(t, page.Items[0].Notes, 2)
+}
+
+func TestCreateRefund_PartialItemRefundThenFullBalance(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.authorizer.EXPECT().AuthorizeOrderManage(mock.Anything, "tenant-1", "staff-1").Return(nil)
+
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil).Once()
+	f.orders.EXPECT().ListRefunds(mock.Anything, "tenant-1", "order-1").Return(nil, nil).Once()
+	f.orders.EXPECT().CreateRefund(mock.Anything, mock.Anything, mock.MatchedBy(func(order entity.Order) bool {
+		return order.RefundedTotal == 10 && order.PaymentStatus == entity.PaymentStatusPartiallyRefunded &&
+			order.Status == entity.StatusPending
+	})).Return(nil, nil).Once()
+
+	refund, err := f.svc.CreateRefund(shopperContext("tenant-1", "staff-1"), orderinputport.CreateRefundCommand{
+		OrderID: "order-1",
+		Items:   []entity.RefundItem{{OrderItemID: "item-1", Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10.0, refund.Amount)
+	require.True(t, refund.IsPartial)
+	require.Equal(t, "staff-1", refund.CreatedBy)
+
+	partiallyRefunded := placedOrder()
+	partiallyRefunded.RefundedTotal = 10
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(partiallyRefunded, nil).Once()
+	f.orders.EXPECT().CreateRefund(mock.Anything, mock.Anything, mock.MatchedBy(func(order entity.Order) bool {
+		return order.RefundedTotal == 25 && order.PaymentStatus == entity.PaymentStatusRefunded &&
+			order.Status == entity.StatusRefunded
+	})).Return(nil, nil).Once()
+
+	refund, err = f.svc.CreateRefund(shopperContext("tenant-1", "staff-1"), orderinputport.CreateRefundCommand{
+		OrderID: "order-1",
+		Amount:  15,
+	})
+	require.NoError(t, err)
+	require.False(t, refund.IsPartial)
+}
+
+func TestCreateRefund_RejectsOverRefunds(t *testing.T) {
+	t.Parallel()
+	f := setupOrderInteractor(t)
+	f.authorizer.EXPECT().AuthorizeOrderManage(mock.Anything, "tenant-1", "staff-1").Return(nil)
+	f.orders.EXPECT().FindByID(mock.Anything, "tenant-1", "order-1").Return(placedOrder(), nil)
+
+	_, err := f.svc.CreateRefund(shopperContext("tenant-1", "staff-1"), orderinputport.CreateRefundCommand{
+		OrderID: "order-1",
+		Amount:  30,
+	})
+	require.ErrorIs(t, err, ErrRefundExceedsBalance)
+
+	f.orders.EXPECT().ListRefunds(mock.Anything, "tenant-1", "order-1").Return([]entity.Refund{
+		{Items: []entity.RefundItem{{OrderItemID: "item-1", Quantity: 2}}},
+	}, nil)
+	_, err = f.svc.CreateRefund(shopperContext("tenant-1", "staff-1"), orderinputport.CreateRefundCommand{
+		OrderID: "order-1",
+		Items:   []entity.RefundItem{{OrderItemID: "item-1", Quantity: 1}},
+	})
+	require.ErrorIs(t, err, ErrRefundQuantityExceeded)
+}
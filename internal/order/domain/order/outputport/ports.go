@@ -0,0 +1,60 @@
+package outputport
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type OrderFilter struct {
+	TenantID  string
+	UserID    string
+	Status    string
+	Search    string
+	StartDate *time.Time
+	EndDate   *time.Time
+	MinTotal  float64
+	MaxTotal  float64
+}
+
+type OrderRepository interface {
+	// Create stores the order and its outbox records in one transaction. It returns
+	// order.ErrIdempotencyKeyConflict when another order already holds order.IdempotencyKey.
+	Create(ctx context.Context, order entity.Order, events []messaging.OutboxRecord) error
+	FindByID(ctx context.Context, tenantID, id string) (*entity.Order, error)
+	// FindByIdempotencyKey returns the order placed with the checkout key, or nil when there is none.
+	FindByIdempotencyKey(ctx context.Context, tenantID, key string) (*entity.Order, error)
+	List(ctx context.Context, filter OrderFilter, query collection.Query) (collection.Page[entity.Order], error)
+	// Update saves the order when its stored version still matches order.Version and bumps the version.
+	Update(ctx context.Context, order entity.Order) (*entity.Order, error)
+	// CreateRefund records the refund and saves the refunded order in one transaction.
+	CreateRefund(ctx context.Context, refund entity.Refund, order entity.Order) (*entity.Order, error)
+	ListRefunds(ctx context.Context, tenantID, orderID string) ([]entity.Refund, error)
+}
+
+type CartReader interface {
+	// GetCart returns the priced cart as the calling shopper sees it.
+	GetCart(ctx context.Context, tenantID, cartID string) (*entity.CartSnapshot, error)
+	ClearCart(ctx context.Context, tenantID, cartID string) error
+}
+
+type AccessAuthorizer interface {
+	AuthorizeOrderRead(ctx context.Context, tenantID string, requestedBy string) error
+	AuthorizeOrderManage(ctx context.Context, tenantID string, requestedBy string) error
+}
+
+type StoreDirectory interface {
+	// TenantOwnsStore reports whether storeID is a provisioned store of the tenant.
+	TenantOwnsStore(ctx context.Context, tenantID, storeID string) (bool, error)
+}
+
+type StockReserver interface {
+	// Reserve takes the items' quantities out of the catalog inventory, all or nothing. It
+	// returns order.ErrCartItemUnavailable when a product is missing or short of stock.
+	Reserve(ctx context.Context, tenantID string, items []entity.CartItem) error
+	// Release puts stock taken by Reserve back.
+	Release(ctx context.Context, tenantID string, items []entity.CartItem) error
+}
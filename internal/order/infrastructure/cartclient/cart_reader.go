@@ -0,0 +1,127 @@
+package cartclient
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	orderconfig "github.com/tuannm99/podzone/internal/order/config"
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	pbcartv1 "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// tenantHeader mirrors the cart service's storefront tenant selector.
+const tenantHeader = "x-tenant-id"
+
+var _ orderoutputport.CartReader = (*CartReader)(nil)
+
+type CartReader struct {
+	client pbcartv1.CartServiceClient
+}
+
+type Params struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    orderconfig.Config
+}
+
+func NewCartReader(params Params) (*CartReader, error) {
+	addr := params.Config.Cart.Addr()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect order cart client %s: %w", addr, err)
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	params.Logger.Info("order cart gRPC client connected", "addr", addr)
+	return &CartReader{client: pbcartv1.NewCartServiceClient(conn)}, nil
+}
+
+func (c *CartReader) GetCart(ctx context.Context, tenantID, cartID string) (*entity.CartSnapshot, error) {
+	cart, err := c.client.GetCartByID(shopperContext(ctx, tenantID), &pbcartv1.GetCartByIDRequest{Id: cartID})
+	if status.Code(err) == codes.NotFound {
+		return nil, orderdomain.ErrCartEmpty
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get cart %s: %w", cartID, err)
+	}
+
+	snapshot := &entity.CartSnapshot{
+		ID:       cart.GetId(),
+		UserID:   cart.GetUserId(),
+		Currency: cart.GetCurrency(),
+		Totals: entity.Totals{
+			Subtotal:      cart.GetTotals().GetSubtotal(),
+			DiscountTotal: cart.GetTotals().GetDiscountTotal(),
+			TaxTotal:      cart.GetTotals().GetTaxTotal(),
+			ShippingTotal: cart.GetTotals().GetShippingTotal(),
+			GrandTotal:    cart.GetTotals().GetGrandTotal(),
+			ItemsCount:    cart.GetTotals().GetItemsCount(),
+			ItemsQuantity: cart.GetTotals().GetItemsQuantity(),
+		},
+	}
+	for _, item := range cart.GetItems() {
+		snapshot.Items = append(snapshot.Items, entity.CartItem{
+			ID:         item.GetId(),
+			ProductID:  item.GetProductId(),
+			Name:       item.GetName(),
+			SKU:        item.GetSku(),
+			Quantity:   item.GetQuantity(),
+			UnitPrice:  item.GetUnitPrice(),
+			ImageURL:   item.GetImageUrl(),
+			Attributes: item.GetAttributes(),
+			InStock:    item.GetInStock(),
+		})
+	}
+	for _, coupon := range cart.GetCoupons() {
+		snapshot.Coupons = append(snapshot.Coupons, entity.Coupon{
+			Code:           coupon.GetCode(),
+			Type:           coupon.GetType(),
+			Value:          coupon.GetValue(),
+			DiscountAmount: coupon.GetDiscountAmount(),
+			Description:    coupon.GetDescription(),
+		})
+	}
+	if shipping := cart.GetSelectedShipping(); shipping != nil {
+		snapshot.SelectedShipping = &entity.CartShipping{
+			ID:                shipping.GetId(),
+			Name:              shipping.GetName(),
+			Carrier:           shipping.GetCarrier(),
+			Price:             shipping.GetPrice(),
+			EstimatedDelivery: shipping.GetEstimatedDelivery(),
+		}
+	}
+	return snapshot, nil
+}
+
+func (c *CartReader) ClearCart(ctx context.Context, tenantID, cartID string) error {
+	if _, err := c.client.ClearCart(shopperContext(ctx, tenantID), &pbcartv1.ClearCartRequest{CartId: cartID}); err != nil {
+		return fmt.Errorf("clear cart %s: %w", cartID, err)
+	}
+	return nil
+}
+
+// shopperContext forwards the caller's bearer token so the cart service applies its own ownership checks.
+func shopperContext(ctx context.Context, tenantID string) context.Context {
+	pairs := []string{tenantHeader, tenantID}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			pairs = append(pairs, "authorization", values[0])
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(pairs...))
+}
@@ -0,0 +1,79 @@
+package iamclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	orderconfig "github.com/tuannm99/podzone/internal/order/config"
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+const (
+	orderReadPermission   = "order:read"
+	orderManagePermission = "order:manage"
+)
+
+var _ orderoutputport.AccessAuthorizer = (*AccessAuthorizer)(nil)
+
+type AccessAuthorizer struct {
+	client pbiamv1.IAMQueryServiceClient
+}
+
+type AccessAuthorizerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    pdlog.Logger
+	Config    orderconfig.Config
+}
+
+func NewAccessAuthorizer(params AccessAuthorizerParams) (*AccessAuthorizer, error) {
+	addr := params.Config.IAM.GRPCHost + ":" + params.Config.IAM.GRPCPort
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect order IAM client %s: %w", addr, err)
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	params.Logger.Info("order IAM gRPC client connected", "addr", addr)
+	return &AccessAuthorizer{client: pbiamv1.NewIAMQueryServiceClient(conn)}, nil
+}
+
+func (a *AccessAuthorizer) AuthorizeOrderRead(ctx context.Context, tenantID string, requestedBy string) error {
+	return a.authorize(ctx, tenantID, requestedBy, orderReadPermission)
+}
+
+func (a *AccessAuthorizer) AuthorizeOrderManage(ctx context.Context, tenantID string, requestedBy string) error {
+	return a.authorize(ctx, tenantID, requestedBy, orderManagePermission)
+}
+
+func (a *AccessAuthorizer) authorize(ctx context.Context, tenantID, requestedBy, permission string) error {
+	userID, err := strconv.ParseUint(requestedBy, 10, 64)
+	if err != nil || userID == 0 {
+		return fmt.Errorf("%w: invalid user_id", orderdomain.ErrAccessDenied)
+	}
+	response, err := a.client.CheckPermission(ctx, &pbiamv1.CheckPermissionRequest{
+		TenantId:   tenantID,
+		UserId:     userID,
+		Permission: permission,
+		Resource:   "tenant/" + tenantID + "/order/*",
+	})
+	if err != nil {
+		return fmt.Errorf("check %s permission: %w", permission, err)
+	}
+	if !response.GetAllowed() {
+		return fmt.Errorf("%w: %s", orderdomain.ErrAccessDenied, permission)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+)
+
+var _ orderoutputport.StockReserver = (*MongoStockReserver)(nil)
+
+// MongoStockReserver takes checkout stock straight from the products catalog owns. Each
+// decrement is conditional on the remaining count, so concurrent checkouts cannot oversell.
+type MongoStockReserver struct {
+	products *mongo.Collection
+}
+
+type Params struct {
+	fx.In
+
+	MongoClient *mongo.Client `name:"mongo-catalog"`
+	DB          string        `name:"mongo-catalog-db"`
+}
+
+func New(p Params) *MongoStockReserver {
+	return &MongoStockReserver{
+		products: p.MongoClient.Database(p.DB).Collection("products"),
+	}
+}
+
+type stockLine struct {
+	productID string
+	quantity  int32
+}
+
+func (r *MongoStockReserver) Reserve(ctx context.Context, tenantID string, items []entity.CartItem) error {
+	lines := stockLines(items)
+	for i, line := range lines {
+		result, err := r.products.UpdateOne(ctx,
+			bson.M{
+				"_id":             line.productID,
+				"tenant_id":       tenantID,
+				"inventory_count": bson.M{"$gte": line.quantity},
+			},
+			bson.M{
+				"$inc":         bson.M{"inventory_count": -line.quantity},
+				"$currentDate": bson.M{"updated_at": true},
+			},
+		)
+		if err != nil {
+			err = fmt.Errorf("reserve product %s: %w", line.productID, err)
+		} else if result.MatchedCount == 0 {
+			err = orderdomain.ErrCartItemUnavailable
+		}
+		if err != nil {
+			if releaseErr := r.release(ctx, tenantID, lines[:i]); releaseErr != nil {
+				return errors.Join(err, releaseErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MongoStockReserver) Release(ctx context.Context, tenantID string, items []entity.CartItem) error {
+	return r.release(ctx, tenantID, stockLines(items))
+}
+
+// release keeps going past a failed product so one bad write does not strand the rest.
+func (r *MongoStockReserver) release(ctx context.Context, tenantID string, lines []stockLine) error {
+	var firstErr error
+	for _, line := range lines {
+		_, err := r.products.UpdateOne(ctx,
+			bson.M{"_id": line.productID, "tenant_id": tenantID},
+			bson.M{
+				"$inc":         bson.M{"inventory_count": line.quantity},
+				"$currentDate": bson.M{"updated_at": true},
+			},
+		)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("release product %s: %w", line.productID, err)
+		}
+	}
+	return firstErr
+}
+
+// stockLines sums quantities per product, since a cart can hold one product under several
+// attribute sets.
+func stockLines(items []entity.CartItem) []stockLine {
+	index := make(map[string]int, len(items))
+	lines := make([]stockLine, 0, len(items))
+	for _, item := range items {
+		if i, ok := index[item.ProductID]; ok {
+			lines[i].quantity += item.Quantity
+			continue
+		}
+		index[item.ProductID] = len(lines)
+		lines = append(lines, stockLine{productID: item.ProductID, quantity: item.Quantity})
+	}
+	return lines
+}
@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	orderrepository "github.com/tuannm99/podzone/internal/order/infrastructure/repository/order"
+	"github.com/tuannm99/podzone/pkg/api/events"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingkafka "github.com/tuannm99/podzone/pkg/messaging/kafka"
+	messagingsql "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+	"github.com/tuannm99/podzone/pkg/pdkafka"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+	"github.com/tuannm99/podzone/pkg/pdworker"
+)
+
+const relayConfigPath = "messaging.order.outbox"
+
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotate(orderrepository.NewOutboxStore, fx.As(new(messaging.OutboxStore))),
+		fx.Annotate(
+			func(producer pdkafka.Producer) messaging.Publisher {
+				return messaging.NewStrictSchemaValidatingPublisher(messagingkafka.NewPublisher(producer), events.Schemas())
+			},
+			fx.ParamTags(`name:"kafka-order-producer"`),
+		),
+		NewRelayConfig,
+		messagingkafka.NewRelayFromConfig,
+		fx.Annotate(
+			func(lc fx.Lifecycle, log pdlog.Logger, sqlCfg *pdsql.Config, cfg messaging.OutboxRelayConfig) (
+				messaging.OutboxWakeup,
+				error,
+			) {
+				return messagingsql.ListenForOutbox(lc, log, sqlCfg.URI, cfg)
+			},
+			fx.ParamTags(``, ``, `name:"sql-order-config"`, ``),
+		),
+		NewOutboxWorker,
+	),
+	fx.Invoke(func(lc fx.Lifecycle, logger pdlog.Logger, w *OutboxWorker) {
+		pdworker.StartWorker(lc, logger, w)
+	}),
+)
+
+func NewRelayConfig(k *koanf.Koanf) messaging.OutboxRelayConfig {
+	return messaging.LoadOutboxRelayConfig(k, relayConfigPath, messaging.DefaultOutboxRelayConfig())
+}
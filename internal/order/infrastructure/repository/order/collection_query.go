@@ -0,0 +1,89 @@
+package order
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+var orderSortColumns = map[string]string{
+	"orderNumber":   "order_number",
+	"status":        "status",
+	"paymentStatus": "payment_status",
+	"grandTotal":    "grand_total",
+	"createdAt":     "created_at",
+	"updatedAt":     "updated_at",
+}
+
+func buildOrderCollectionQuery(
+	filter orderoutputport.OrderFilter,
+	query collection.Query,
+) (collection.Query, []sq.Sqlizer, string, error) {
+	normalized := query.Normalize()
+	where := []sq.Sqlizer{sq.Eq{"tenant_id": filter.TenantID}}
+	if filter.UserID != "" {
+		where = append(where, sq.Eq{"user_id": filter.UserID})
+	}
+	if filter.Status != "" {
+		where = append(where, sq.Eq{"status": filter.Status})
+	}
+	if filter.StartDate != nil {
+		where = append(where, sq.GtOrEq{"created_at": *filter.StartDate})
+	}
+	if filter.EndDate != nil {
+		where = append(where, sq.Lt{"created_at": *filter.EndDate})
+	}
+	if filter.MinTotal > 0 {
+		where = append(where, sq.GtOrEq{"grand_total": filter.MinTotal})
+	}
+	if filter.MaxTotal > 0 {
+		where = append(where, sq.LtOrEq{"grand_total": filter.MaxTotal})
+	}
+	search := strings.TrimSpace(filter.Search)
+	if search == "" {
+		search = strings.TrimSpace(normalized.Search)
+	}
+	if search != "" {
+		pattern := "%" + escapeOrderLike(search) + "%"
+		where = append(where, sq.Or{
+			likeOrderColumn("order_number", pattern),
+			likeOrderColumn("customer_email", pattern),
+			likeOrderColumn("customer_name", pattern),
+		})
+	}
+	if len(normalized.Filters) > 0 {
+		return collection.Query{}, nil, "", fmt.Errorf(
+			"%w: order listings do not support generic filters",
+			collection.ErrInvalidQuery,
+		)
+	}
+	sortColumn := "created_at"
+	if normalized.SortBy != "" {
+		var ok bool
+		sortColumn, ok = orderSortColumns[normalized.SortBy]
+		if !ok {
+			return collection.Query{}, nil, "", fmt.Errorf(
+				"%w: unsupported order sort field %q",
+				collection.ErrInvalidQuery,
+				normalized.SortBy,
+			)
+		}
+	}
+	direction := "DESC"
+	if normalized.SortDirection == collection.SortAscending {
+		direction = "ASC"
+	}
+	return normalized, where, sortColumn + " " + direction, nil
+}
+
+func likeOrderColumn(column, pattern string) sq.Sqlizer {
+	return sq.Expr("LOWER("+column+") LIKE LOWER(?) ESCAPE '\\'", pattern)
+}
+
+func escapeOrderLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,51 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+)
+
+func TestBuildOrderCollectionQuery(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	query, predicates, orderBy, err := buildOrderCollectionQuery(
+		orderoutputport.OrderFilter{
+			TenantID:  "tenant-1",
+			UserID:    "42",
+			Status:    "pending",
+			Search:    "PZ-2026",
+			StartDate: &start,
+			MinTotal:  10,
+		},
+		collection.Query{
+			Page:          3,
+			PageSize:      5,
+			SortBy:        "grandTotal",
+			SortDirection: collection.SortAscending,
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, query.Page)
+	assert.Equal(t, 5, query.PageSize)
+	assert.Len(t, predicates, 6)
+	assert.Equal(t, "grand_total ASC", orderBy)
+}
+
+func TestBuildOrderCollectionQueryRejectsUnknownSort(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, err := buildOrderCollectionQuery(
+		orderoutputport.OrderFilter{TenantID: "tenant-1"},
+		collection.Query{SortBy: "customer_json"},
+	)
+
+	require.ErrorIs(t, err, collection.ErrInvalidQuery)
+}
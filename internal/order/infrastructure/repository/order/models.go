@@ -0,0 +1,199 @@
+package order
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+)
+
+const orderColumns = "id, tenant_id, store_id, user_id, cart_id, idempotency_key, order_number, status, " +
+	"payment_status, currency, customer_email, customer_name, grand_total, refunded_total, items_json, totals_json, " +
+	"billing_address_json, shipping_address_json, shipping_info_json, payment_info_json, coupons_json, notes_json, " +
+	"customer_json, metadata_json, version, created_at, updated_at, completed_at"
+
+const refundColumns = "id, tenant_id, order_id, amount, reason, transaction_id, status, is_partial, items_json, " +
+	"notes, created_by, created_at"
+
+type orderModel struct {
+	ID                  string         `db:"id"`
+	TenantID            string         `db:"tenant_id"`
+	StoreID             string         `db:"store_id"`
+	UserID              string         `db:"user_id"`
+	CartID              string         `db:"cart_id"`
+	IdempotencyKey      sql.NullString `db:"idempotency_key"`
+	OrderNumber         string         `db:"order_number"`
+	Status              string         `db:"status"`
+	PaymentStatus       string         `db:"payment_status"`
+	Currency            string         `db:"currency"`
+	CustomerEmail       string         `db:"customer_email"`
+	CustomerName        string         `db:"customer_name"`
+	GrandTotal          float64        `db:"grand_total"`
+	RefundedTotal       float64        `db:"refunded_total"`
+	ItemsJSON           []byte         `db:"items_json"`
+	TotalsJSON          []byte         `db:"totals_json"`
+	BillingAddressJSON  []byte         `db:"billing_address_json"`
+	ShippingAddressJSON []byte         `db:"shipping_address_json"`
+	ShippingInfoJSON    []byte         `db:"shipping_info_json"`
+	PaymentInfoJSON     []byte         `db:"payment_info_json"`
+	CouponsJSON         []byte         `db:"coupons_json"`
+	NotesJSON           []byte         `db:"notes_json"`
+	CustomerJSON        []byte         `db:"customer_json"`
+	MetadataJSON        []byte         `db:"metadata_json"`
+	Version             int64          `db:"version"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+	CompletedAt         sql.NullTime   `db:"completed_at"`
+}
+
+func newOrderModel(order entity.Order) (orderModel, error) {
+	model := orderModel{
+		ID:            order.ID,
+		TenantID:      order.TenantID,
+		StoreID:       order.StoreID,
+		UserID:        order.UserID,
+		CartID:        order.CartID,
+		OrderNumber:   order.OrderNumber,
+		Status:        order.Status,
+		PaymentStatus: order.PaymentStatus,
+		Currency:      order.Currency,
+		IdempotencyKey: sql.NullString{
+			String: order.IdempotencyKey,
+			Valid:  order.IdempotencyKey != "",
+		},
+		CustomerEmail: strings.ToLower(order.Customer.Email),
+		CustomerName:  strings.TrimSpace(order.Customer.FirstName + " " + order.Customer.LastName),
+		GrandTotal:    order.Totals.GrandTotal,
+		RefundedTotal: order.RefundedTotal,
+		Version:       order.Version,
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.UpdatedAt,
+	}
+	if order.CompletedAt != nil {
+		model.CompletedAt = sql.NullTime{Time: *order.CompletedAt, Valid: true}
+	}
+	documents := []struct {
+		target *[]byte
+		value  any
+	}{
+		{&model.ItemsJSON, nonNilSlice(order.Items)},
+		{&model.TotalsJSON, order.Totals},
+		{&model.BillingAddressJSON, order.BillingAddress},
+		{&model.ShippingAddressJSON, order.ShippingAddress},
+		{&model.ShippingInfoJSON, order.ShippingInfo},
+		{&model.PaymentInfoJSON, order.PaymentInfo},
+		{&model.CouponsJSON, nonNilSlice(order.Coupons)},
+		{&model.NotesJSON, nonNilSlice(order.Notes)},
+		{&model.CustomerJSON, order.Customer},
+		{&model.MetadataJSON, nonNilMap(order.Metadata)},
+	}
+	for _, document := range documents {
+		raw, err := json.Marshal(document.value)
+		if err != nil {
+			return orderModel{}, fmt.Errorf("marshal order %s: %w", order.ID, err)
+		}
+		*document.target = raw
+	}
+	return model, nil
+}
+
+func (m orderModel) toEntity() (*entity.Order, error) {
+	order := &entity.Order{
+		ID:             m.ID,
+		TenantID:       m.TenantID,
+		StoreID:        m.StoreID,
+		UserID:         m.UserID,
+		CartID:         m.CartID,
+		IdempotencyKey: m.IdempotencyKey.String,
+		OrderNumber:    m.OrderNumber,
+		Status:         m.Status,
+		PaymentStatus:  m.PaymentStatus,
+		Currency:       m.Currency,
+		RefundedTotal:  m.RefundedTotal,
+		Version:        m.Version,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+	if m.CompletedAt.Valid {
+		completedAt := m.CompletedAt.Time
+		order.CompletedAt = &completedAt
+	}
+	documents := []struct {
+		raw    []byte
+		target any
+	}{
+		{m.ItemsJSON, &order.Items},
+		{m.TotalsJSON, &order.Totals},
+		{m.BillingAddressJSON, &order.BillingAddress},
+		{m.ShippingAddressJSON, &order.ShippingAddress},
+		{m.ShippingInfoJSON, &order.ShippingInfo},
+		{m.PaymentInfoJSON, &order.PaymentInfo},
+		{m.CouponsJSON, &order.Coupons},
+		{m.NotesJSON, &order.Notes},
+		{m.CustomerJSON, &order.Customer},
+		{m.MetadataJSON, &order.Metadata},
+	}
+	for _, document := range documents {
+		if len(document.raw) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(document.raw, document.target); err != nil {
+			return nil, fmt.Errorf("decode order %s: %w", m.ID, err)
+		}
+	}
+	return order, nil
+}
+
+type refundModel struct {
+	ID            string    `db:"id"`
+	TenantID      string    `db:"tenant_id"`
+	OrderID       string    `db:"order_id"`
+	Amount        float64   `db:"amount"`
+	Reason        string    `db:"reason"`
+	TransactionID string    `db:"transaction_id"`
+	Status        string    `db:"status"`
+	IsPartial     bool      `db:"is_partial"`
+	ItemsJSON     []byte    `db:"items_json"`
+	Notes         string    `db:"notes"`
+	CreatedBy     string    `db:"created_by"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (m refundModel) toEntity() (entity.Refund, error) {
+	refund := entity.Refund{
+		ID:            m.ID,
+		TenantID:      m.TenantID,
+		OrderID:       m.OrderID,
+		Amount:        m.Amount,
+		Reason:        m.Reason,
+		TransactionID: m.TransactionID,
+		Status:        m.Status,
+		IsPartial:     m.IsPartial,
+		Notes:         m.Notes,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+	}
+	if len(m.ItemsJSON) > 0 {
+		if err := json.Unmarshal(m.ItemsJSON, &refund.Items); err != nil {
+			return entity.Refund{}, fmt.Errorf("decode refund %s: %w", m.ID, err)
+		}
+	}
+	return refund, nil
+}
+
+func nonNilSlice[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+func nonNilMap(values map[string]string) map[string]string {
+	if values == nil {
+		return map[string]string{}
+	}
+	return values
+}
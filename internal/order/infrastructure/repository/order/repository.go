@@ -0,0 +1,325 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.uber.org/fx"
+
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	"github.com/tuannm99/podzone/internal/order/domain/order/entity"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	"github.com/tuannm99/podzone/pkg/collection"
+	"github.com/tuannm99/podzone/pkg/messaging"
+	messagingsqlstore "github.com/tuannm99/podzone/pkg/messaging/sqlstore"
+)
+
+const outboxTableName = "message_outbox"
+
+const (
+	uniqueViolation          = "23505"
+	idempotencyKeyConstraint = "uq_orders_tenant_idempotency_key"
+)
+
+var _ orderoutputport.OrderRepository = (*Repository)(nil)
+
+type Repository struct {
+	db     *sqlx.DB
+	outbox *messagingsqlstore.OutboxStore
+}
+
+type Params struct {
+	fx.In
+	DB *sqlx.DB `name:"sql-order"`
+}
+
+func New(p Params) (*Repository, error) {
+	outbox, err := messagingsqlstore.NewOutboxStore(p.DB, outboxTableName)
+	if err != nil {
+		return nil, err
+	}
+	return &Repository{db: p.DB, outbox: outbox}, nil
+}
+
+// NewOutboxStore exposes the order outbox table to the relay worker.
+func NewOutboxStore(p Params) (*messagingsqlstore.OutboxStore, error) {
+	return messagingsqlstore.NewOutboxStore(p.DB, outboxTableName)
+}
+
+func (r *Repository) Create(ctx context.Context, order entity.Order, events []messaging.OutboxRecord) error {
+	order.Version = 1
+	model, err := newOrderModel(order)
+	if err != nil {
+		return err
+	}
+	return r.inTx(ctx, func(tx *sqlx.Tx) error {
+		query, args, err := sq.Insert("orders").
+			SetMap(orderValues(model)).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation &&
+				pqErr.Constraint == idempotencyKeyConstraint {
+				return orderdomain.ErrIdempotencyKeyConflict
+			}
+			return err
+		}
+		for _, event := range events {
+			if err := r.outbox.Append(ctx, tx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) FindByID(ctx context.Context, tenantID, id string) (*entity.Order, error) {
+	query, args, err := sq.Select(orderColumns).
+		From("orders").
+		Where(sq.Eq{"tenant_id": tenantID, "id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out orderModel
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, orderdomain.ErrOrderNotFound
+		}
+		return nil, err
+	}
+	return out.toEntity()
+}
+
+func (r *Repository) FindByIdempotencyKey(ctx context.Context, tenantID, key string) (*entity.Order, error) {
+	query, args, err := sq.Select(orderColumns).
+		From("orders").
+		Where(sq.Eq{"tenant_id": tenantID, "idempotency_key": key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out orderModel
+	if err := r.db.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out.toEntity()
+}
+
+func (r *Repository) List(
+	ctx context.Context,
+	filter orderoutputport.OrderFilter,
+	queryArg collection.Query,
+) (collection.Page[entity.Order], error) {
+	normalized, where, orderBy, err := buildOrderCollectionQuery(filter, queryArg)
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	countBuilder := sq.Select("COUNT(*)").From("orders")
+	for _, predicate := range where {
+		countBuilder = countBuilder.Where(predicate)
+	}
+	countSQL, countArgs, err := countBuilder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	var total int64
+	if err := r.db.GetContext(ctx, &total, countSQL, countArgs...); err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+
+	builder := sq.Select(orderColumns).From("orders")
+	for _, predicate := range where {
+		builder = builder.Where(predicate)
+	}
+	builder = builder.
+		OrderBy(orderBy, "id DESC").
+		Limit(uint64(normalized.PageSize)).
+		Offset(uint64(normalized.Offset()))
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+
+	var rows []orderModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return collection.Page[entity.Order]{}, err
+	}
+	out := make([]entity.Order, 0, len(rows))
+	for _, row := range rows {
+		order, err := row.toEntity()
+		if err != nil {
+			return collection.Page[entity.Order]{}, err
+		}
+		out = append(out, *order)
+	}
+	return collection.NewPage(out, total, normalized), nil
+}
+
+func (r *Repository) Update(ctx context.Context, order entity.Order) (*entity.Order, error) {
+	var updated *entity.Order
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		updated, err = r.update(ctx, tx, order)
+		return err
+	})
+	return updated, err
+}
+
+func (r *Repository) CreateRefund(
+	ctx context.Context,
+	refund entity.Refund,
+	order entity.Order,
+) (*entity.Order, error) {
+	itemsJSON, err := json.Marshal(nonNilSlice(refund.Items))
+	if err != nil {
+		return nil, err
+	}
+	var updated *entity.Order
+	err = r.inTx(ctx, func(tx *sqlx.Tx) error {
+		query, args, err := sq.Insert("order_refunds").
+			SetMap(map[string]any{
+				"id":             refund.ID,
+				"tenant_id":      refund.TenantID,
+				"order_id":       refund.OrderID,
+				"amount":         refund.Amount,
+				"reason":         refund.Reason,
+				"transaction_id": refund.TransactionID,
+				"status":         refund.Status,
+				"is_partial":     refund.IsPartial,
+				"items_json":     itemsJSON,
+				"notes":          refund.Notes,
+				"created_by":     refund.CreatedBy,
+				"created_at":     refund.CreatedAt,
+			}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+		updated, err = r.update(ctx, tx, order)
+		return err
+	})
+	return updated, err
+}
+
+func (r *Repository) ListRefunds(ctx context.Context, tenantID, orderID string) ([]entity.Refund, error) {
+	query, args, err := sq.Select(refundColumns).
+		From("order_refunds").
+		Where(sq.Eq{"tenant_id": tenantID, "order_id": orderID}).
+		OrderBy("created_at ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var rows []refundModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	refunds := make([]entity.Refund, 0, len(rows))
+	for _, row := range rows {
+		refund, err := row.toEntity()
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, refund)
+	}
+	return refunds, nil
+}
+
+// update writes the order only while the stored version still matches, so two staff members
+// editing the same order cannot silently overwrite each other.
+func (r *Repository) update(ctx context.Context, tx *sqlx.Tx, order entity.Order) (*entity.Order, error) {
+	expected := order.Version
+	order.Version++
+	model, err := newOrderModel(order)
+	if err != nil {
+		return nil, err
+	}
+	values := orderValues(model)
+	for _, immutable := range []string{
+		"id", "tenant_id", "user_id", "cart_id", "idempotency_key", "order_number", "created_at",
+	} {
+		delete(values, immutable)
+	}
+	query, args, err := sq.Update("orders").
+		SetMap(values).
+		Where(sq.Eq{"tenant_id": order.TenantID, "id": order.ID, "version": expected}).
+		Suffix("RETURNING " + orderColumns).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var out orderModel
+	if err := tx.GetContext(ctx, &out, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, orderdomain.ErrConcurrentUpdate
+		}
+		return nil, err
+	}
+	return out.toEntity()
+}
+
+func (r *Repository) inTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func orderValues(model orderModel) map[string]any {
+	return map[string]any{
+		"id":                    model.ID,
+		"tenant_id":             model.TenantID,
+		"store_id":              model.StoreID,
+		"user_id":               model.UserID,
+		"cart_id":               model.CartID,
+		"idempotency_key":       model.IdempotencyKey,
+		"order_number":          model.OrderNumber,
+		"status":                model.Status,
+		"payment_status":        model.PaymentStatus,
+		"currency":              model.Currency,
+		"customer_email":        model.CustomerEmail,
+		"customer_name":         model.CustomerName,
+		"grand_total":           model.GrandTotal,
+		"refunded_total":        model.RefundedTotal,
+		"items_json":            model.ItemsJSON,
+		"totals_json":           model.TotalsJSON,
+		"billing_address_json":  model.BillingAddressJSON,
+		"shipping_address_json": model.ShippingAddressJSON,
+		"shipping_info_json":    model.ShippingInfoJSON,
+		"payment_info_json":     model.PaymentInfoJSON,
+		"coupons_json":          model.CouponsJSON,
+		"notes_json":            model.NotesJSON,
+		"customer_json":         model.CustomerJSON,
+		"metadata_json":         model.MetadataJSON,
+		"version":               model.Version,
+		"created_at":            model.CreatedAt,
+		"updated_at":            model.UpdatedAt,
+		"completed_at":          model.CompletedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package storedirectory
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+)
+
+// statusReady is the onboarding request status once the store is provisioned and finalized.
+const statusReady = "ready"
+
+var _ orderoutputport.StoreDirectory = (*MongoStoreDirectory)(nil)
+
+// MongoStoreDirectory reads the store requests onboarding owns to check which tenant a store
+// belongs to.
+type MongoStoreDirectory struct {
+	collection *mongo.Collection
+}
+
+type Params struct {
+	fx.In
+
+	MongoClient *mongo.Client `name:"mongo-onboarding"`
+	DB          string        `name:"mongo-onboarding-db"`
+}
+
+func New(p Params) *MongoStoreDirectory {
+	return &MongoStoreDirectory{
+		collection: p.MongoClient.Database(p.DB).Collection("store_requests"),
+	}
+}
+
+func (d *MongoStoreDirectory) TenantOwnsStore(ctx context.Context, tenantID, storeID string) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(storeID)
+	if err != nil {
+		// Store IDs are onboarding request IDs; anything else cannot name a store.
+		return false, nil
+	}
+	count, err := d.collection.CountDocuments(ctx, bson.M{
+		"_id":          id,
+		"workspace_id": tenantID,
+		"status":       statusReady,
+	})
+	if err != nil {
+		return false, fmt.Errorf("find store %s: %w", storeID, err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS orders (
+  id TEXT PRIMARY KEY,
+  tenant_id TEXT NOT NULL,
+  store_id TEXT NOT NULL DEFAULT '',
+  user_id TEXT NOT NULL DEFAULT '',
+  cart_id TEXT NOT NULL DEFAULT '',
+  order_number TEXT NOT NULL,
+  status TEXT NOT NULL,
+  payment_status TEXT NOT NULL,
+  currency TEXT NOT NULL DEFAULT '',
+  customer_email TEXT NOT NULL DEFAULT '',
+  customer_name TEXT NOT NULL DEFAULT '',
+  grand_total NUMERIC(12, 2) NOT NULL DEFAULT 0,
+  refunded_total NUMERIC(12, 2) NOT NULL DEFAULT 0,
+  items_json JSONB NOT NULL DEFAULT '[]'::jsonb,
+  totals_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  billing_address_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  shipping_address_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  shipping_info_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  payment_info_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  coupons_json JSONB NOT NULL DEFAULT '[]'::jsonb,
+  notes_json JSONB NOT NULL DEFAULT '[]'::jsonb,
+  customer_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  metadata_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+  version BIGINT NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ NULL,
+  CONSTRAINT uq_orders_tenant_order_number UNIQUE (tenant_id, order_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_tenant_created_at
+  ON orders (tenant_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_orders_tenant_user
+  ON orders (tenant_id, user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_orders_tenant_status
+  ON orders (tenant_id, status);
+
+CREATE TABLE IF NOT EXISTS order_refunds (
+  id TEXT PRIMARY KEY,
+  tenant_id TEXT NOT NULL,
+  order_id TEXT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  amount NUMERIC(12, 2) NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  transaction_id TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL,
+  is_partial BOOLEAN NOT NULL DEFAULT FALSE,
+  items_json JSONB NOT NULL DEFAULT '[]'::jsonb,
+  notes TEXT NOT NULL DEFAULT '',
+  created_by TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_refunds_order
+  ON order_refunds (tenant_id, order_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_refunds_order;
+DROP TABLE IF EXISTS order_refunds;
+DROP INDEX IF EXISTS idx_orders_tenant_status;
+DROP INDEX IF EXISTS idx_orders_tenant_user;
+DROP INDEX IF EXISTS idx_orders_tenant_created_at;
+DROP TABLE IF EXISTS orders;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A retried checkout carries the same key, so the second attempt finds the first order instead
+-- of placing another one.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS idempotency_key TEXT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_orders_tenant_idempotency_key
+  ON orders (tenant_id, idempotency_key)
+  WHERE idempotency_key IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_orders_tenant_idempotency_key;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS idempotency_key;
+-- +goose StatementEnd
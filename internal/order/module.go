@@ -0,0 +1,103 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+
+	orderconfig "github.com/tuannm99/podzone/internal/order/config"
+	"github.com/tuannm99/podzone/internal/order/controller/grpchandler"
+	orderdomain "github.com/tuannm99/podzone/internal/order/domain/order"
+	orderinputport "github.com/tuannm99/podzone/internal/order/domain/order/inputport"
+	orderoutputport "github.com/tuannm99/podzone/internal/order/domain/order/outputport"
+	"github.com/tuannm99/podzone/internal/order/infrastructure/cartclient"
+	"github.com/tuannm99/podzone/internal/order/infrastructure/iamclient"
+	"github.com/tuannm99/podzone/internal/order/infrastructure/inventory"
+	orderrepository "github.com/tuannm99/podzone/internal/order/infrastructure/repository/order"
+	"github.com/tuannm99/podzone/internal/order/infrastructure/storedirectory"
+	"github.com/tuannm99/podzone/internal/order/migrations"
+	pborderv1 "github.com/tuannm99/podzone/pkg/api/proto/order/v1"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdmongo"
+	"github.com/tuannm99/podzone/pkg/pdsql"
+)
+
+// Module serves the order API. It expects pdsql.ModuleFor("order") for storage,
+// pdmongo.ModuleFor("onboarding") to check which tenant a store belongs to, and
+// pdmongo.ModuleFor("catalog") to reserve stock at checkout.
+var Module = fx.Options(
+	fx.Provide(
+		orderconfig.NewConfig,
+		fx.Annotate(
+			func(cfg *pdmongo.Config) string {
+				return cfg.Database
+			},
+			fx.ParamTags(`name:"mongo-onboarding-config"`),
+			fx.ResultTags(`name:"mongo-onboarding-db"`),
+		),
+		fx.Annotate(
+			func(cfg *pdmongo.Config) string {
+				return cfg.Database
+			},
+			fx.ParamTags(`name:"mongo-catalog-config"`),
+			fx.ResultTags(`name:"mongo-catalog-db"`),
+		),
+		fx.Annotate(storedirectory.New, fx.As(new(orderoutputport.StoreDirectory))),
+		fx.Annotate(inventory.New, fx.As(new(orderoutputport.StockReserver))),
+		fx.Annotate(orderrepository.New, fx.As(new(orderoutputport.OrderRepository))),
+		fx.Annotate(cartclient.NewCartReader, fx.As(new(orderoutputport.CartReader))),
+		fx.Annotate(iamclient.NewAccessAuthorizer, fx.As(new(orderoutputport.AccessAuthorizer))),
+		fx.Annotate(orderdomain.NewOrderInteractor, fx.As(new(orderinputport.Usecase))),
+		grpchandler.NewAuthentication,
+		grpchandler.NewOrderServer,
+	),
+)
+
+var ServerModule = fx.Options(
+	Module,
+	fx.Invoke(
+		RegisterGRPCServer,
+		RegisterMigration,
+	),
+)
+
+func RegisterGRPCServer(
+	server *grpc.Server,
+	orderServer *grpchandler.OrderServer,
+	logger pdlog.Logger,
+) {
+	logger.Info("Registering Order GRPC handler")
+	pborderv1.RegisterOrderServiceServer(server, orderServer)
+}
+
+type MigrateParams struct {
+	fx.In
+	Logger        pdlog.Logger
+	OrderDBConfig *pdsql.Config `name:"sql-order-config"`
+	OrderDB       *sqlx.DB      `name:"sql-order"`
+}
+
+func RegisterMigration(p MigrateParams) {
+	if !p.OrderDBConfig.ShouldRunMigration {
+		p.Logger.Info("Disabled migration ...")
+		return
+	}
+
+	p.Logger.Info("Migrating order database with goose...")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := migrations.Apply(ctx, p.OrderDB.DB, "postgres"); err != nil {
+		p.Logger.Error("Migration failed", "err", err)
+		return
+	}
+	p.Logger.Info("Migration completed")
+}
+
+func ApplyMigration(ctx context.Context, db *sql.DB, dialect string) error {
+	return migrations.Apply(ctx, db, dialect)
+}
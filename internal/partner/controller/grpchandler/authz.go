@@ -6,11 +6,12 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
 	partnerconfig "github.com/tuannm99/podzone/internal/partner/config"
 	pbauthv1 "github.com/tuannm99/podzone/pkg/api/proto/auth/v1"
 	pbiamv1 "github.com/tuannm99/podzone/pkg/api/proto/iam/v1"
+	"github.com/tuannm99/podzone/pkg/pdauthn"
 	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdproxy"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -23,14 +24,6 @@ type TenantAuthorizer interface {
 	AuthorizeTenant(ctx context.Context, tenantID, permission string) (string, error)
 }
 
-type partnerJWTClaims struct {
-	UserID         uint   `json:"user_id"`
-	ActiveTenantID string `json:"active_tenant_id"`
-	SessionID      string `json:"session_id"`
-	Key            string `json:"key"`
-	jwt.RegisteredClaims
-}
-
 type authzClientParams struct {
 	fx.In
 
@@ -40,7 +33,8 @@ type authzClientParams struct {
 }
 
 type authTenantAuthorizer struct {
-	cfg        partnerconfig.Config
+	verifier   *pdauthn.Verifier
+	proxies    pdproxy.TrustedProxies
 	authClient pbauthv1.AuthServiceClient
 	iamClient  pbiamv1.IAMQueryServiceClient
 }
@@ -73,7 +67,8 @@ func NewTenantAuthorizer(p authzClientParams) (TenantAuthorizer, error) {
 	p.Logger.Info("partner iam gRPC client connected", "addr", iamAddr)
 
 	return &authTenantAuthorizer{
-		cfg:        p.Config,
+		verifier:   pdauthn.NewVerifier(p.Config.Auth.Authn()),
+		proxies:    p.Config.TrustedProxies,
 		authClient: pbauthv1.NewAuthServiceClient(authConn),
 		iamClient:  pbiamv1.NewIAMQueryServiceClient(iamConn),
 	}, nil
@@ -85,6 +80,7 @@ func (a *authTenantAuthorizer) AuthorizeTenant(ctx context.Context, tenantID, pe
 		return "", err
 	}
 	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authHeader)
+	ctx = a.proxies.ForwardIncomingClient(ctx)
 	if strings.TrimSpace(tenantID) == "" {
 		return "", fmt.Errorf("tenant id is required")
 	}
@@ -163,17 +159,8 @@ func (a *authTenantAuthorizer) identityFromContext(ctx context.Context) (string,
 		return "", "", "", "", fmt.Errorf("missing authorization bearer token")
 	}
 
-	claims := &partnerJWTClaims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(tok *jwt.Token) (interface{}, error) {
-		if tok.Method != jwt.SigningMethodHS256 {
-			return nil, fmt.Errorf("unexpected signing method")
-		}
-		return []byte(a.cfg.Auth.JWTSecret), nil
-	})
-	if err != nil || !token.Valid {
-		return "", "", "", "", fmt.Errorf("invalid authorization token")
-	}
-	if a.cfg.Auth.JWTKey != "" && claims.Key != a.cfg.Auth.JWTKey {
+	claims, err := a.verifier.ClaimsFromTokenString(tokenStr)
+	if err != nil {
 		return "", "", "", "", fmt.Errorf("invalid authorization token")
 	}
 	if claims.UserID == 0 {
@@ -143,6 +143,30 @@ func (s *PartnerServer) UpdatePartnerStatus(
 	return toProtoPartner(out)
 }
 
+// ListShippingRates is called by the cart service on behalf of anonymous shoppers,
+// so it is scoped by tenant only and returns just the quoting fields of active partners.
+func (s *PartnerServer) ListShippingRates(
+	ctx context.Context,
+	req *pbpartnerv1.ListShippingRatesRequest,
+) (*pbpartnerv1.ListShippingRatesResponse, error) {
+	rates, err := s.uc.ListShippingRates(ctx, req.TenantId, req.Region)
+	if err != nil {
+		return nil, partnerStatusError(err)
+	}
+	out := make([]*pbpartnerv1.ShippingRate, 0, len(rates))
+	for _, rate := range rates {
+		out = append(out, &pbpartnerv1.ShippingRate{
+			PartnerId:   rate.PartnerID,
+			PartnerCode: rate.PartnerCode,
+			PartnerName: rate.PartnerName,
+			Region:      rate.Region,
+			Cost:        rate.Cost,
+			SlaDays:     rate.SLADays,
+		})
+	}
+	return &pbpartnerv1.ListShippingRatesResponse{Rates: out}, nil
+}
+
 func partnerStatusError(err error) error {
 	switch {
 	case errors.Is(err, partnerdomain.ErrPartnerNotFound):
@@ -53,6 +53,18 @@ type ShippingCostRule struct {
 	Cost   string
 }
 
+// ShippingRegionAny is the catch-all rule region used when a partner has no rule for the requested region.
+const ShippingRegionAny = "*"
+
+type ShippingRate struct {
+	PartnerID   string
+	PartnerCode string
+	PartnerName string
+	Region      string
+	Cost        string
+	SLADays     int32
+}
+
 type CreatePartnerCmd struct {
 	TenantID              string
 	Code                  string
@@ -241,6 +241,80 @@ func (_c *MockPartnerUsecase_ListPartners_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// ListShippingRates provides a mock function for the type MockPartnerUsecase
+func (_mock *MockPartnerUsecase) ListShippingRates(ctx context.Context, tenantID string, region string) ([]domain.ShippingRate, error) {
+	ret := _mock.Called(ctx, tenantID, region)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShippingRates")
+	}
+
+	var r0 []domain.ShippingRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.ShippingRate, error)); ok {
+		return returnFunc(ctx, tenantID, region)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []domain.ShippingRate); ok {
+		r0 = returnFunc(ctx, tenantID, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ShippingRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tenantID, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartnerUsecase_ListShippingRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShippingRates'
+type MockPartnerUsecase_ListShippingRates_Call struct {
+	*mock.Call
+}
+
+// ListShippingRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - region string
+func (_e *MockPartnerUsecase_Expecter) ListShippingRates(ctx interface{}, tenantID interface{}, region interface{}) *MockPartnerUsecase_ListShippingRates_Call {
+	return &MockPartnerUsecase_ListShippingRates_Call{Call: _e.mock.On("ListShippingRates", ctx, tenantID, region)}
+}
+
+func (_c *MockPartnerUsecase_ListShippingRates_Call) Run(run func(ctx context.Context, tenantID string, region string)) *MockPartnerUsecase_ListShippingRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartnerUsecase_ListShippingRates_Call) Return(shippingRates []domain.ShippingRate, err error) *MockPartnerUsecase_ListShippingRates_Call {
+	_c.Call.Return(shippingRates, err)
+	return _c
+}
+
+func (_c *MockPartnerUsecase_ListShippingRates_Call) RunAndReturn(run func(ctx context.Context, tenantID string, region string) ([]domain.ShippingRate, error)) *MockPartnerUsecase_ListShippingRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePartner provides a mock function for the type MockPartnerUsecase
 func (_mock *MockPartnerUsecase) UpdatePartner(ctx context.Context, cmd domain.UpdatePartnerCmd) (*domain.Partner, error) {
 	ret := _mock.Called(ctx, cmd)
@@ -140,6 +140,62 @@ func (s *partnerService) UpdatePartnerStatus(ctx context.Context, id, status str
 	return s.repo.UpdateStatus(ctx, id, status)
 }
 
+func (s *partnerService) ListShippingRates(ctx context.Context, tenantID, region string) ([]ShippingRate, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		return nil, ErrInvalidTenantID
+	}
+	region = strings.TrimSpace(strings.ToLower(region))
+
+	rates := make([]ShippingRate, 0)
+	query := ListPartnersQuery{
+		TenantID:   tenantID,
+		Status:     PartnerStatusActive,
+		Collection: collection.Query{PageSize: collection.MaxPageSize}.Normalize(),
+	}
+	for {
+		page, err := s.repo.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, partner := range page.Items {
+			if rule, ok := matchShippingCostRule(partner.ShippingCostRules, region); ok {
+				rates = append(rates, ShippingRate{
+					PartnerID:   partner.ID,
+					PartnerCode: partner.Code,
+					PartnerName: partner.Name,
+					Region:      rule.Region,
+					Cost:        rule.Cost,
+					SLADays:     partner.SLADays,
+				})
+			}
+		}
+		if query.Collection.Page >= page.TotalPages {
+			return rates, nil
+		}
+		query.Collection.Page++
+	}
+}
+
+// matchShippingCostRule prefers an exact region rule and falls back to the catch-all rule.
+func matchShippingCostRule(rules []ShippingCostRule, region string) (ShippingCostRule, bool) {
+	var fallback *ShippingCostRule
+	for i := range rules {
+		switch rules[i].Region {
+		case region:
+			if region != "" {
+				return rules[i], true
+			}
+		case ShippingRegionAny:
+			fallback = &rules[i]
+		}
+	}
+	if fallback == nil {
+		return ShippingCostRule{}, false
+	}
+	return *fallback, true
+}
+
 func normalizePartnerCode(raw string) string {
 	raw = strings.TrimSpace(strings.ToLower(raw))
 	if raw == "" {
@@ -9,6 +9,7 @@ import (
 
 	domain "github.com/tuannm99/podzone/internal/partner/domain"
 	domainmocks "github.com/tuannm99/podzone/internal/partner/domain/mocks"
+	"github.com/tuannm99/podzone/pkg/collection"
 )
 
 func TestCreatePartner_NormalizesCodeFromName(t *testing.T) {
@@ -57,3 +58,54 @@ func TestCreatePartner_RejectsUnknownPartnerType(t *testing.T) {
 	require.Nil(t, out)
 	require.ErrorIs(t, err, domain.ErrInvalidPartnerType)
 }
+
+func TestListShippingRates_PrefersExactRegionOverCatchAll(t *testing.T) {
+	t.Parallel()
+
+	repo := domainmocks.NewMockPartnerRepository(t)
+	repo.EXPECT().
+		List(mock.Anything, mock.MatchedBy(func(query domain.ListPartnersQuery) bool {
+			return query.TenantID == "tenant-1" && query.Status == domain.PartnerStatusActive
+		})).
+		Return(collection.NewPage([]domain.Partner{
+			{
+				ID:      "prt-1",
+				Code:    "acme",
+				Name:    "Acme",
+				SLADays: 3,
+				ShippingCostRules: []domain.ShippingCostRule{
+					{Region: domain.ShippingRegionAny, Cost: "9.00"},
+					{Region: "vn", Cost: "2.50"},
+				},
+			},
+			{
+				ID:                "prt-2",
+				Code:              "globex",
+				Name:              "Globex",
+				ShippingCostRules: []domain.ShippingCostRule{{Region: "us", Cost: "5.00"}},
+			},
+			{
+				ID:                "prt-3",
+				Code:              "initech",
+				Name:              "Initech",
+				ShippingCostRules: []domain.ShippingCostRule{{Region: domain.ShippingRegionAny, Cost: "12.00"}},
+			},
+		}, 3, collection.Query{}.Normalize()), nil).
+		Once()
+
+	uc := domain.NewPartnerUsecase(repo)
+	rates, err := uc.ListShippingRates(context.Background(), "tenant-1", " VN ")
+	require.NoError(t, err)
+	require.Equal(t, []domain.ShippingRate{
+		{PartnerID: "prt-1", PartnerCode: "acme", PartnerName: "Acme", Region: "vn", Cost: "2.50", SLADays: 3},
+		{PartnerID: "prt-3", PartnerCode: "initech", PartnerName: "Initech", Region: "*", Cost: "12.00"},
+	}, rates)
+}
+
+func TestListShippingRates_RequiresTenant(t *testing.T) {
+	t.Parallel()
+
+	uc := domain.NewPartnerUsecase(domainmocks.NewMockPartnerRepository(t))
+	_, err := uc.ListShippingRates(context.Background(), " ", "vn")
+	require.ErrorIs(t, err, domain.ErrInvalidTenantID)
+}
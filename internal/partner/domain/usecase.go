@@ -12,4 +12,5 @@ type PartnerUsecase interface {
 	ListPartners(ctx context.Context, query ListPartnersQuery) (collection.Page[Partner], error)
 	UpdatePartner(ctx context.Context, cmd UpdatePartnerCmd) (*Partner, error)
 	UpdatePartnerStatus(ctx context.Context, id, status string) (*Partner, error)
+	ListShippingRates(ctx context.Context, tenantID, region string) ([]ShippingRate, error)
 }
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+const (
+	defaultPageSize        = 12
+	defaultCartCookie      = "pz_cart"
+	defaultCartCookieTTL   = 7 * 24 * time.Hour
+	defaultStoreCacheTTL   = 30 * time.Second
+	defaultStoreBaseDomain = "podzone.local"
+)
+
+type Config struct {
+	// BaseDomain is the parent domain storefronts are served under: <subdomain>.<BaseDomain>.
+	BaseDomain string
+	// DefaultSubdomain serves requests whose host carries no store subdomain, e.g. localhost in dev.
+	DefaultSubdomain string
+	PageSize         int32
+	CartCookieName   string
+	CartCookieTTL    time.Duration
+	CartCookieSecure bool
+	StoreCacheTTL    time.Duration
+}
+
+func NewConfig(k *koanf.Koanf) Config {
+	var cfg Config
+	if k != nil {
+		cfg.BaseDomain = k.String("storefront.base_domain")
+		cfg.DefaultSubdomain = k.String("storefront.default_subdomain")
+		cfg.PageSize = int32(k.Int("storefront.page_size"))
+		cfg.CartCookieName = k.String("storefront.cart_cookie.name")
+		cfg.CartCookieTTL = k.Duration("storefront.cart_cookie.ttl")
+		cfg.CartCookieSecure = k.Bool("storefront.cart_cookie.secure")
+		cfg.StoreCacheTTL = k.Duration("storefront.store_cache_ttl")
+	}
+	cfg.BaseDomain = strings.ToLower(strings.Trim(
+		toolkit.GetEnv("STOREFRONT_BASE_DOMAIN", firstNonEmpty(cfg.BaseDomain, defaultStoreBaseDomain)),
+		".",
+	))
+	cfg.DefaultSubdomain = strings.ToLower(toolkit.GetEnv("STOREFRONT_DEFAULT_SUBDOMAIN", cfg.DefaultSubdomain))
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = defaultPageSize
+	}
+	if cfg.CartCookieName == "" {
+		cfg.CartCookieName = defaultCartCookie
+	}
+	if cfg.CartCookieTTL <= 0 {
+		cfg.CartCookieTTL = defaultCartCookieTTL
+	}
+	if cfg.StoreCacheTTL <= 0 {
+		cfg.StoreCacheTTL = defaultStoreCacheTTL
+	}
+	return cfg
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
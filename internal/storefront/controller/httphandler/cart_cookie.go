@@ -0,0 +1,41 @@
+package httphandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The guest cart id lives in a host-only cookie, so carts never leak across store subdomains.
+// SameSite=Lax keeps cross-site form posts from acting on the shopper's cart.
+
+func (c *Controller) cartID(ctx *gin.Context) string {
+	id, err := ctx.Cookie(c.cfg.CartCookieName)
+	if err != nil {
+		return ""
+	}
+	return id
+}
+
+func (c *Controller) setCartID(ctx *gin.Context, id string) {
+	if id == "" || id == c.cartID(ctx) {
+		return
+	}
+	c.writeCartCookie(ctx, id, int(c.cfg.CartCookieTTL.Seconds()))
+}
+
+func (c *Controller) clearCartID(ctx *gin.Context) {
+	c.writeCartCookie(ctx, "", -1)
+}
+
+func (c *Controller) writeCartCookie(ctx *gin.Context, value string, maxAge int) {
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     c.cfg.CartCookieName,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   c.cfg.CartCookieSecure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
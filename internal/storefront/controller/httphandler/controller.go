@@ -0,0 +1,338 @@
+package httphandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontinputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport"
+	"github.com/tuannm99/podzone/internal/storefront/views"
+	pdlog "github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+const storeContextKey = "storefront.store"
+
+type Controller struct {
+	logger  pdlog.Logger
+	service storefrontinputport.Usecase
+	cfg     storefrontconfig.Config
+}
+
+type ControllerParams struct {
+	fx.In
+
+	Logger  pdlog.Logger
+	Usecase storefrontinputport.Usecase
+	Config  storefrontconfig.Config
+}
+
+func NewController(params ControllerParams) *Controller {
+	return &Controller{
+		logger:  params.Logger,
+		service: params.Usecase,
+		cfg:     params.Config,
+	}
+}
+
+func (c *Controller) RegisterRoutes(r gin.IRouter) {
+	shop := r.Group("/", c.resolveStore)
+	{
+		shop.GET("", c.ListProducts)
+		shop.GET("/products/:id", c.GetProduct)
+		shop.GET("/cart", c.GetCart)
+		shop.POST("/cart/items", c.AddToCart)
+		shop.POST("/cart/items/:id", c.UpdateCartItem)
+		shop.POST("/cart/items/:id/remove", c.RemoveCartItem)
+		shop.GET("/checkout", c.GetCheckout)
+		shop.POST("/checkout/shipping", c.SelectShipping)
+		shop.POST("/checkout", c.PlaceOrder)
+	}
+}
+
+// resolveStore picks the tenant from the Host header before any page handler runs.
+func (c *Controller) resolveStore(ctx *gin.Context) {
+	store, err := c.service.ResolveStore(ctx.Request.Context(), ctx.Request.Host)
+	if err != nil {
+		c.renderError(ctx, err)
+		ctx.Abort()
+		return
+	}
+	ctx.Set(storeContextKey, *store)
+	ctx.Next()
+}
+
+func (c *Controller) ListProducts(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	products, err := c.service.ListProducts(ctx.Request.Context(), store, int32(page))
+	if err != nil {
+		c.renderError(ctx, err)
+		return
+	}
+	c.render(ctx, http.StatusOK, views.ProductsPage(store, products, c.cartQuantity(ctx, store)))
+}
+
+func (c *Controller) GetProduct(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	product, err := c.service.GetProduct(ctx.Request.Context(), store, ctx.Param("id"))
+	if err != nil {
+		c.renderError(ctx, err)
+		return
+	}
+	c.render(ctx, http.StatusOK, views.ProductPage(store, *product, c.cartQuantity(ctx, store), ""))
+}
+
+func (c *Controller) GetCart(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	cart, err := c.service.GetCart(ctx.Request.Context(), store, c.cartID(ctx))
+	if err != nil {
+		c.renderError(ctx, err)
+		return
+	}
+	c.render(ctx, http.StatusOK, views.CartPage(store, *cart, ""))
+}
+
+func (c *Controller) AddToCart(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	productID := ctx.PostForm("product_id")
+	cart, err := c.service.AddToCart(ctx.Request.Context(), store, storefrontinputport.AddToCartCommand{
+		CartID:    c.cartID(ctx),
+		ProductID: productID,
+		Quantity:  formQuantity(ctx),
+	})
+	if err != nil {
+		if message, ok := shopperMessage(err); ok {
+			// Re-show the product with the reason so the shopper can adjust the quantity.
+			if product, getErr := c.service.GetProduct(ctx.Request.Context(), store, productID); getErr == nil {
+				c.render(ctx, http.StatusUnprocessableEntity,
+					views.ProductPage(store, *product, c.cartQuantity(ctx, store), message))
+				return
+			}
+		}
+		c.renderError(ctx, err)
+		return
+	}
+	c.setCartID(ctx, cart.ID)
+	ctx.Redirect(http.StatusSeeOther, "/cart")
+}
+
+func (c *Controller) UpdateCartItem(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	_, err := c.service.UpdateCartItem(ctx.Request.Context(), store, storefrontinputport.UpdateCartItemCommand{
+		CartID:   c.cartID(ctx),
+		ItemID:   ctx.Param("id"),
+		Quantity: formQuantity(ctx),
+	})
+	c.afterCartChange(ctx, store, err)
+}
+
+func (c *Controller) RemoveCartItem(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	_, err := c.service.RemoveCartItem(ctx.Request.Context(), store, c.cartID(ctx), ctx.Param("id"))
+	c.afterCartChange(ctx, store, err)
+}
+
+func (c *Controller) GetCheckout(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	view, err := c.checkoutView(ctx, store, entity.Address{
+		Country:    ctx.Query("country"),
+		PostalCode: ctx.Query("postal_code"),
+	})
+	if err != nil {
+		c.renderError(ctx, err)
+		return
+	}
+	if view.Cart.IsEmpty() {
+		ctx.Redirect(http.StatusSeeOther, "/cart")
+		return
+	}
+	c.render(ctx, http.StatusOK, views.CheckoutPage(store, view))
+}
+
+func (c *Controller) SelectShipping(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	_, err := c.service.SelectShipping(ctx.Request.Context(), store, c.cartID(ctx), ctx.PostForm("shipping_option_id"))
+	if err != nil {
+		c.renderCheckoutError(ctx, store, entity.Address{}, "", err)
+		return
+	}
+	ctx.Redirect(http.StatusSeeOther, "/checkout")
+}
+
+func (c *Controller) PlaceOrder(ctx *gin.Context) {
+	store := storeFrom(ctx)
+	address := entity.Address{
+		FirstName:    ctx.PostForm("first_name"),
+		LastName:     ctx.PostForm("last_name"),
+		Email:        ctx.PostForm("email"),
+		Phone:        ctx.PostForm("phone"),
+		AddressLine1: ctx.PostForm("address_line1"),
+		AddressLine2: ctx.PostForm("address_line2"),
+		City:         ctx.PostForm("city"),
+		State:        ctx.PostForm("state"),
+		PostalCode:   ctx.PostForm("postal_code"),
+		Country:      ctx.PostForm("country"),
+	}
+	notes := ctx.PostForm("notes")
+	order, err := c.service.Checkout(ctx.Request.Context(), store, storefrontinputport.CheckoutCommand{
+		CartID:  c.cartID(ctx),
+		Address: address,
+		Notes:   notes,
+	})
+	if err != nil {
+		c.renderCheckoutError(ctx, store, address, notes, err)
+		return
+	}
+	// The order service consumed the cart; the next visit starts a new one.
+	c.clearCartID(ctx)
+	c.render(ctx, http.StatusOK, views.ConfirmationPage(store, *order))
+}
+
+func (c *Controller) afterCartChange(ctx *gin.Context, store entity.Store, err error) {
+	if err == nil {
+		ctx.Redirect(http.StatusSeeOther, "/cart")
+		return
+	}
+	message, ok := shopperMessage(err)
+	if !ok {
+		c.renderError(ctx, err)
+		return
+	}
+	cart, getErr := c.service.GetCart(ctx.Request.Context(), store, c.cartID(ctx))
+	if getErr != nil {
+		c.renderError(ctx, getErr)
+		return
+	}
+	c.render(ctx, http.StatusUnprocessableEntity, views.CartPage(store, *cart, message))
+}
+
+func (c *Controller) checkoutView(ctx *gin.Context, store entity.Store, address entity.Address) (views.CheckoutView, error) {
+	cart, err := c.service.GetCart(ctx.Request.Context(), store, c.cartID(ctx))
+	if err != nil {
+		return views.CheckoutView{}, err
+	}
+	view := views.CheckoutView{Cart: *cart, Address: address}
+	if cart.IsEmpty() || address.Country == "" {
+		return view, nil
+	}
+	options, err := c.service.ShippingOptions(ctx.Request.Context(), store, storefrontinputport.ShippingOptionsQuery{
+		CartID:     cart.ID,
+		Country:    address.Country,
+		PostalCode: address.PostalCode,
+	})
+	if err != nil {
+		message, ok := shopperMessage(err)
+		if !ok {
+			return views.CheckoutView{}, err
+		}
+		view.Message = message
+		return view, nil
+	}
+	view.ShippingOptions = options
+	return view, nil
+}
+
+func (c *Controller) renderCheckoutError(
+	ctx *gin.Context,
+	store entity.Store,
+	address entity.Address,
+	notes string,
+	err error,
+) {
+	message, ok := shopperMessage(err)
+	if !ok {
+		c.renderError(ctx, err)
+		return
+	}
+	view, viewErr := c.checkoutView(ctx, store, address)
+	if viewErr != nil {
+		c.renderError(ctx, viewErr)
+		return
+	}
+	view.Notes = notes
+	view.Message = message
+	c.render(ctx, http.StatusUnprocessableEntity, views.CheckoutPage(store, view))
+}
+
+// cartQuantity feeds the header badge; a failing cart lookup should not break browsing.
+func (c *Controller) cartQuantity(ctx *gin.Context, store entity.Store) int32 {
+	cart, err := c.service.GetCart(ctx.Request.Context(), store, c.cartID(ctx))
+	if err != nil {
+		c.logger.Warn("storefront cart lookup failed", "store", store.Subdomain, "error", err)
+		return 0
+	}
+	return cart.Totals.ItemsQuantity
+}
+
+func (c *Controller) render(ctx *gin.Context, status int, component templ.Component) {
+	ctx.Status(status)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	if err := component.Render(ctx.Request.Context(), ctx.Writer); err != nil {
+		c.logger.Error("storefront render failed", "path", ctx.FullPath(), "error", err)
+	}
+}
+
+func (c *Controller) renderError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, storefrontdomain.ErrStoreNotFound):
+		c.render(ctx, http.StatusNotFound, views.ErrorPage(http.StatusNotFound, "This store does not exist."))
+	case errors.Is(err, storefrontdomain.ErrProductNotFound):
+		c.render(ctx, http.StatusNotFound, views.ErrorPage(http.StatusNotFound, "This product is not available."))
+	default:
+		if message, ok := shopperMessage(err); ok {
+			c.render(ctx, http.StatusUnprocessableEntity, views.ErrorPage(http.StatusUnprocessableEntity, message))
+			return
+		}
+		c.logger.Error("storefront request failed", "path", ctx.FullPath(), "error", err)
+		c.render(ctx, http.StatusBadGateway,
+			views.ErrorPage(http.StatusBadGateway, "Something went wrong. Please try again."))
+	}
+}
+
+// shopperMessage returns the text to show for validation failures; anything else stays internal.
+func shopperMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, storefrontdomain.ErrRequestRejected):
+		return unwrapRejection(err), true
+	case errors.Is(err, storefrontdomain.ErrProductIDRequired),
+		errors.Is(err, storefrontdomain.ErrItemIDRequired),
+		errors.Is(err, storefrontdomain.ErrInvalidQuantity),
+		errors.Is(err, storefrontdomain.ErrCartEmpty),
+		errors.Is(err, storefrontdomain.ErrCartItemUnavailable),
+		errors.Is(err, storefrontdomain.ErrCountryRequired),
+		errors.Is(err, storefrontdomain.ErrShippingOptionRequired),
+		errors.Is(err, storefrontdomain.ErrShippingRequired),
+		errors.Is(err, storefrontdomain.ErrEmailRequired),
+		errors.Is(err, storefrontdomain.ErrShippingAddressRequired):
+		return err.Error(), true
+	default:
+		return "", false
+	}
+}
+
+// unwrapRejection drops the "request rejected: " prefix so the shopper sees the service's reason.
+func unwrapRejection(err error) string {
+	return strings.TrimPrefix(err.Error(), storefrontdomain.ErrRequestRejected.Error()+": ")
+}
+
+func storeFrom(ctx *gin.Context) entity.Store {
+	store, _ := ctx.Get(storeContextKey)
+	s, _ := store.(entity.Store)
+	return s
+}
+
+func formQuantity(ctx *gin.Context) int32 {
+	quantity, err := strconv.Atoi(ctx.PostForm("quantity"))
+	if err != nil {
+		return -1
+	}
+	return int32(quantity)
+}
@@ -0,0 +1,150 @@
+package httphandler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontinputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport"
+	storefrontmocks "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport/mocks"
+	pdlogmocks "github.com/tuannm99/podzone/pkg/pdlog/mocks"
+)
+
+var acme = entity.Store{ID: "store-1", TenantID: "tenant-1", Name: "Acme Goods", Subdomain: "acme"}
+
+func setupRouter(t *testing.T) (*gin.Engine, *storefrontmocks.MockUsecase) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	usecase := storefrontmocks.NewMockUsecase(t)
+	logger := pdlogmocks.NewMockLogger(t)
+	logger.EXPECT().Error(mock.Anything, mock.Anything).Maybe()
+	logger.EXPECT().Warn(mock.Anything, mock.Anything).Maybe()
+	controller := NewController(ControllerParams{
+		Logger:  logger,
+		Usecase: usecase,
+		Config:  storefrontconfig.Config{CartCookieName: "pz_cart", CartCookieTTL: time.Hour},
+	})
+	router := gin.New()
+	controller.RegisterRoutes(router)
+	return router, usecase
+}
+
+func TestController_ListProductsRendersStoreCatalog(t *testing.T) {
+	router, usecase := setupRouter(t)
+	usecase.EXPECT().ResolveStore(mock.Anything, "acme.podzone.local").Return(&acme, nil)
+	usecase.EXPECT().GetCart(mock.Anything, acme, "").Return(&entity.Cart{}, nil)
+	usecase.EXPECT().ListProducts(mock.Anything, acme, int32(2)).Return(entity.ProductPage{
+		Products:   []entity.Product{{ID: "p-1", Name: "Canvas Tote", Price: 20, SalePrice: 15, Stock: 3}},
+		Page:       2,
+		TotalPages: 3,
+	}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/?page=2", nil)
+	request.Host = "acme.podzone.local"
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	require.Equal(t, http.StatusOK, response.Code)
+	body := response.Body.String()
+	require.Contains(t, body, "Acme Goods")
+	require.Contains(t, body, "Canvas Tote")
+	require.Contains(t, body, `href="/products/p-1"`)
+	require.Contains(t, body, "15.00 USD")
+	require.Contains(t, body, "Page 2 of 3")
+}
+
+func TestController_UnknownStoreRendersNotFound(t *testing.T) {
+	router, usecase := setupRouter(t)
+	usecase.EXPECT().ResolveStore(mock.Anything, "ghost.podzone.local").Return(nil, storefrontdomain.ErrStoreNotFound)
+
+	request := httptest.NewRequest(http.MethodGet, "/cart", nil)
+	request.Host = "ghost.podzone.local"
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	require.Equal(t, http.StatusNotFound, response.Code)
+	require.Contains(t, response.Body.String(), "This store does not exist.")
+}
+
+func TestController_AddToCartStoresGuestCartCookie(t *testing.T) {
+	router, usecase := setupRouter(t)
+	usecase.EXPECT().ResolveStore(mock.Anything, mock.Anything).Return(&acme, nil)
+	usecase.EXPECT().
+		AddToCart(mock.Anything, acme, storefrontinputport.AddToCartCommand{ProductID: "p-1", Quantity: 2}).
+		Return(&entity.Cart{ID: "cart-9"}, nil)
+
+	form := url.Values{"product_id": {"p-1"}, "quantity": {"2"}}
+	request := httptest.NewRequest(http.MethodPost, "/cart/items", strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	require.Equal(t, http.StatusSeeOther, response.Code)
+	require.Equal(t, "/cart", response.Header().Get("Location"))
+	cookie := response.Result().Cookies()[0]
+	require.Equal(t, "pz_cart", cookie.Name)
+	require.Equal(t, "cart-9", cookie.Value)
+	require.True(t, cookie.HttpOnly)
+	require.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+}
+
+func TestController_PlaceOrderRendersConfirmationAndClearsCart(t *testing.T) {
+	router, usecase := setupRouter(t)
+	usecase.EXPECT().ResolveStore(mock.Anything, mock.Anything).Return(&acme, nil)
+	usecase.EXPECT().
+		Checkout(mock.Anything, acme, mock.MatchedBy(func(cmd storefrontinputport.CheckoutCommand) bool {
+			return cmd.CartID == "cart-9" && cmd.Address.Email == "ada@example.com" && cmd.Address.City == "Hanoi"
+		})).
+		Return(&entity.Order{OrderNumber: "PZ-100", Status: "PENDING", Email: "ada@example.com", GrandTotal: 42}, nil)
+
+	form := url.Values{
+		"email":         {"ada@example.com"},
+		"address_line1": {"1 Main St"},
+		"city":          {"Hanoi"},
+		"country":       {"VN"},
+	}
+	request := httptest.NewRequest(http.MethodPost, "/checkout", strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.AddCookie(&http.Cookie{Name: "pz_cart", Value: "cart-9"})
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Contains(t, response.Body.String(), "PZ-100")
+	cookie := response.Result().Cookies()[0]
+	require.Equal(t, "pz_cart", cookie.Name)
+	require.Negative(t, cookie.MaxAge)
+}
+
+func TestController_PlaceOrderShowsServiceRejection(t *testing.T) {
+	router, usecase := setupRouter(t)
+	usecase.EXPECT().ResolveStore(mock.Anything, mock.Anything).Return(&acme, nil)
+	usecase.EXPECT().
+		Checkout(mock.Anything, acme, mock.Anything).
+		Return(nil, fmt.Errorf("%w: cart item is out of stock", storefrontdomain.ErrRequestRejected))
+	usecase.EXPECT().GetCart(mock.Anything, acme, "cart-9").Return(&entity.Cart{ID: "cart-9"}, nil)
+
+	form := url.Values{"email": {"ada@example.com"}}
+	request := httptest.NewRequest(http.MethodPost, "/checkout", strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.AddCookie(&http.Cookie{Name: "pz_cart", Value: "cart-9"})
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	body := response.Body.String()
+	require.Contains(t, body, "cart item is out of stock")
+	require.NotContains(t, body, "request rejected")
+	require.Contains(t, body, `value="ada@example.com"`)
+}
@@ -0,0 +1,143 @@
+package entity
+
+import "time"
+
+// Store is a provisioned storefront resolved from the subdomain onboarding recorded.
+type Store struct {
+	ID        string
+	TenantID  string
+	Name      string
+	Subdomain string
+}
+
+type Product struct {
+	ID          string
+	Name        string
+	Description string
+	SKU         string
+	Slug        string
+	Price       float64
+	SalePrice   float64
+	Stock       int32
+	ImageURLs   []string
+	Attributes  map[string]string
+}
+
+// EffectivePrice is what the cart will charge: the sale price when one is set.
+func (p Product) EffectivePrice() float64 {
+	if p.SalePrice > 0 && p.SalePrice < p.Price {
+		return p.SalePrice
+	}
+	return p.Price
+}
+
+func (p Product) OnSale() bool {
+	return p.EffectivePrice() < p.Price
+}
+
+func (p Product) InStock() bool {
+	return p.Stock > 0
+}
+
+type ProductPage struct {
+	Products   []Product
+	Page       int32
+	TotalPages int32
+	Total      int32
+}
+
+type Cart struct {
+	ID               string
+	Items            []CartItem
+	Totals           CartTotals
+	SelectedShipping *ShippingOption
+	Currency         string
+	// UpdatedAt changes whenever the cart does, including when checkout clears it.
+	UpdatedAt time.Time
+}
+
+// CheckoutKey names one checkout of the cart as it is now: a resubmitted or retried checkout
+// shares the key, while a later purchase from the same cart gets a new one.
+func (c *Cart) CheckoutKey() string {
+	return c.ID + ":" + c.UpdatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+func (c *Cart) IsEmpty() bool {
+	return c == nil || len(c.Items) == 0
+}
+
+// Ready reports whether every line can still be bought.
+func (c *Cart) Ready() bool {
+	if c.IsEmpty() {
+		return false
+	}
+	for _, item := range c.Items {
+		if !item.InStock {
+			return false
+		}
+	}
+	return true
+}
+
+type CartItem struct {
+	ID        string
+	ProductID string
+	Name      string
+	SKU       string
+	ImageURL  string
+	Quantity  int32
+	UnitPrice float64
+	Subtotal  float64
+	Discount  float64
+	InStock   bool
+}
+
+type CartTotals struct {
+	Subtotal      float64
+	DiscountTotal float64
+	TaxTotal      float64
+	ShippingTotal float64
+	GrandTotal    float64
+	ItemsQuantity int32
+}
+
+type ShippingOption struct {
+	ID                string
+	Name              string
+	Carrier           string
+	Price             float64
+	EstimatedDelivery string
+}
+
+type Address struct {
+	FirstName    string
+	LastName     string
+	Email        string
+	Phone        string
+	AddressLine1 string
+	AddressLine2 string
+	City         string
+	State        string
+	PostalCode   string
+	Country      string
+}
+
+type Order struct {
+	ID              string
+	OrderNumber     string
+	Status          string
+	Currency        string
+	Email           string
+	ShippingAddress Address
+	Items           []OrderItem
+	ShippingTotal   float64
+	DiscountTotal   float64
+	GrandTotal      float64
+}
+
+type OrderItem struct {
+	Name     string
+	SKU      string
+	Quantity int32
+	Total    float64
+}
@@ -0,0 +1,21 @@
+package storefront
+
+import "errors"
+
+var (
+	ErrStoreNotFound           = errors.New("store not found")
+	ErrProductNotFound         = errors.New("product not found")
+	ErrProductIDRequired       = errors.New("product id is required")
+	ErrItemIDRequired          = errors.New("cart item id is required")
+	ErrInvalidQuantity         = errors.New("invalid quantity")
+	ErrCartEmpty               = errors.New("cart is empty")
+	ErrCartItemUnavailable     = errors.New("remove unavailable items before checkout")
+	ErrCountryRequired         = errors.New("country is required")
+	ErrShippingOptionRequired  = errors.New("choose a shipping option")
+	ErrShippingRequired        = errors.New("choose a shipping option before placing the order")
+	ErrEmailRequired           = errors.New("email is required")
+	ErrShippingAddressRequired = errors.New("shipping address, city and country are required")
+	// ErrRequestRejected wraps a validation failure reported by a backing service;
+	// its message is safe to show the shopper.
+	ErrRequestRejected = errors.New("request rejected")
+)
@@ -0,0 +1,47 @@
+package inputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+)
+
+type AddToCartCommand struct {
+	CartID    string
+	ProductID string
+	Quantity  int32
+}
+
+type UpdateCartItemCommand struct {
+	CartID   string
+	ItemID   string
+	Quantity int32
+}
+
+type ShippingOptionsQuery struct {
+	CartID     string
+	Country    string
+	PostalCode string
+}
+
+type CheckoutCommand struct {
+	CartID  string
+	Address entity.Address
+	Notes   string
+}
+
+// Usecase serves one resolved store. Shoppers are guests: the cart is addressed by the id
+// the storefront keeps in a cookie, and an empty id means the shopper has no cart yet.
+type Usecase interface {
+	ResolveStore(ctx context.Context, host string) (*entity.Store, error)
+	ListProducts(ctx context.Context, store entity.Store, page int32) (entity.ProductPage, error)
+	GetProduct(ctx context.Context, store entity.Store, id string) (*entity.Product, error)
+	GetCart(ctx context.Context, store entity.Store, cartID string) (*entity.Cart, error)
+	AddToCart(ctx context.Context, store entity.Store, cmd AddToCartCommand) (*entity.Cart, error)
+	// UpdateCartItem removes the line when the quantity drops to zero.
+	UpdateCartItem(ctx context.Context, store entity.Store, cmd UpdateCartItemCommand) (*entity.Cart, error)
+	RemoveCartItem(ctx context.Context, store entity.Store, cartID, itemID string) (*entity.Cart, error)
+	ShippingOptions(ctx context.Context, store entity.Store, query ShippingOptionsQuery) ([]entity.ShippingOption, error)
+	SelectShipping(ctx context.Context, store entity.Store, cartID, optionID string) (*entity.Cart, error)
+	Checkout(ctx context.Context, store entity.Store, cmd CheckoutCommand) (*entity.Order, error)
+}
@@ -0,0 +1,246 @@
+package storefront
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"go.uber.org/fx"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontinputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+)
+
+var _ storefrontinputport.Usecase = (*StorefrontInteractor)(nil)
+
+type StorefrontInteractor struct {
+	stores  storefrontoutputport.StoreDirectory
+	catalog storefrontoutputport.Catalog
+	carts   storefrontoutputport.Carts
+	orders  storefrontoutputport.Orders
+	cfg     storefrontconfig.Config
+}
+
+type StorefrontInteractorParams struct {
+	fx.In
+
+	Stores  storefrontoutputport.StoreDirectory
+	Catalog storefrontoutputport.Catalog
+	Carts   storefrontoutputport.Carts
+	Orders  storefrontoutputport.Orders
+	Config  storefrontconfig.Config
+}
+
+func NewStorefrontInteractor(params StorefrontInteractorParams) *StorefrontInteractor {
+	return &StorefrontInteractor{
+		stores:  params.Stores,
+		catalog: params.Catalog,
+		carts:   params.Carts,
+		orders:  params.Orders,
+		cfg:     params.Config,
+	}
+}
+
+func (s *StorefrontInteractor) ResolveStore(ctx context.Context, host string) (*entity.Store, error) {
+	subdomain := s.subdomainFromHost(host)
+	if subdomain == "" {
+		return nil, ErrStoreNotFound
+	}
+	return s.stores.FindBySubdomain(ctx, subdomain)
+}
+
+// subdomainFromHost maps <subdomain>.<base domain> to the subdomain. Hosts outside the
+// base domain (localhost, bare IPs) fall back to the configured default store.
+func (s *StorefrontInteractor) subdomainFromHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(host, ".")
+	if base := s.cfg.BaseDomain; base != "" && strings.HasSuffix(host, "."+base) {
+		subdomain := strings.TrimSuffix(host, "."+base)
+		if subdomain != "" && !strings.Contains(subdomain, ".") {
+			return subdomain
+		}
+		return ""
+	}
+	if host == s.cfg.BaseDomain || host == "localhost" || net.ParseIP(host) != nil {
+		return s.cfg.DefaultSubdomain
+	}
+	return ""
+}
+
+func (s *StorefrontInteractor) ListProducts(
+	ctx context.Context,
+	store entity.Store,
+	page int32,
+) (entity.ProductPage, error) {
+	if page < 1 {
+		page = 1
+	}
+	return s.catalog.ListProducts(ctx, store.TenantID, page, s.cfg.PageSize)
+}
+
+func (s *StorefrontInteractor) GetProduct(ctx context.Context, store entity.Store, id string) (*entity.Product, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, ErrProductNotFound
+	}
+	return s.catalog.GetProduct(ctx, store.TenantID, id)
+}
+
+func (s *StorefrontInteractor) GetCart(ctx context.Context, store entity.Store, cartID string) (*entity.Cart, error) {
+	cartID = strings.TrimSpace(cartID)
+	if cartID == "" {
+		return &entity.Cart{}, nil
+	}
+	return s.carts.GetCart(ctx, store.TenantID, cartID)
+}
+
+func (s *StorefrontInteractor) AddToCart(
+	ctx context.Context,
+	store entity.Store,
+	cmd storefrontinputport.AddToCartCommand,
+) (*entity.Cart, error) {
+	productID := strings.TrimSpace(cmd.ProductID)
+	if productID == "" {
+		return nil, ErrProductIDRequired
+	}
+	if cmd.Quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	// A cookie can outlive the guest cart it points at; start a fresh cart instead of failing.
+	cart, err := s.GetCart(ctx, store, cmd.CartID)
+	if err != nil {
+		return nil, err
+	}
+	return s.carts.AddItem(ctx, store.TenantID, cart.ID, productID, cmd.Quantity)
+}
+
+func (s *StorefrontInteractor) UpdateCartItem(
+	ctx context.Context,
+	store entity.Store,
+	cmd storefrontinputport.UpdateCartItemCommand,
+) (*entity.Cart, error) {
+	if cmd.Quantity < 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if cmd.Quantity == 0 {
+		return s.RemoveCartItem(ctx, store, cmd.CartID, cmd.ItemID)
+	}
+	cartID, itemID, err := cartLine(cmd.CartID, cmd.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	return s.carts.UpdateItem(ctx, store.TenantID, cartID, itemID, cmd.Quantity)
+}
+
+func (s *StorefrontInteractor) RemoveCartItem(
+	ctx context.Context,
+	store entity.Store,
+	cartID, itemID string,
+) (*entity.Cart, error) {
+	cartID, itemID, err := cartLine(cartID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return s.carts.RemoveItem(ctx, store.TenantID, cartID, itemID)
+}
+
+func (s *StorefrontInteractor) ShippingOptions(
+	ctx context.Context,
+	store entity.Store,
+	query storefrontinputport.ShippingOptionsQuery,
+) ([]entity.ShippingOption, error) {
+	cartID := strings.TrimSpace(query.CartID)
+	if cartID == "" {
+		return nil, ErrCartEmpty
+	}
+	country := strings.ToUpper(strings.TrimSpace(query.Country))
+	if country == "" {
+		return nil, ErrCountryRequired
+	}
+	return s.carts.ShippingOptions(ctx, store.TenantID, cartID, country, strings.TrimSpace(query.PostalCode))
+}
+
+func (s *StorefrontInteractor) SelectShipping(
+	ctx context.Context,
+	store entity.Store,
+	cartID, optionID string,
+) (*entity.Cart, error) {
+	cartID = strings.TrimSpace(cartID)
+	if cartID == "" {
+		return nil, ErrCartEmpty
+	}
+	optionID = strings.TrimSpace(optionID)
+	if optionID == "" {
+		return nil, ErrShippingOptionRequired
+	}
+	return s.carts.SetShippingOption(ctx, store.TenantID, cartID, optionID)
+}
+
+// Checkout places the order for the shopper's cart. The order service re-reads and reprices
+// the cart itself; the checks here only turn obvious mistakes into form errors early.
+func (s *StorefrontInteractor) Checkout(
+	ctx context.Context,
+	store entity.Store,
+	cmd storefrontinputport.CheckoutCommand,
+) (*entity.Order, error) {
+	address := normalizeAddress(cmd.Address)
+	if address.Email == "" {
+		return nil, ErrEmailRequired
+	}
+	if address.AddressLine1 == "" || address.City == "" || address.Country == "" {
+		return nil, ErrShippingAddressRequired
+	}
+	cart, err := s.GetCart(ctx, store, cmd.CartID)
+	if err != nil {
+		return nil, err
+	}
+	if cart.IsEmpty() {
+		return nil, ErrCartEmpty
+	}
+	if !cart.Ready() {
+		return nil, ErrCartItemUnavailable
+	}
+	if cart.SelectedShipping == nil {
+		return nil, ErrShippingRequired
+	}
+	return s.orders.PlaceOrder(ctx, store.TenantID, storefrontoutputport.PlaceOrderRequest{
+		StoreID:          store.ID,
+		CartID:           cart.ID,
+		ShippingMethodID: cart.SelectedShipping.ID,
+		Address:          address,
+		Notes:            strings.TrimSpace(cmd.Notes),
+		IdempotencyKey:   cart.CheckoutKey(),
+	})
+}
+
+func cartLine(cartID, itemID string) (string, string, error) {
+	cartID = strings.TrimSpace(cartID)
+	if cartID == "" {
+		return "", "", ErrCartEmpty
+	}
+	itemID = strings.TrimSpace(itemID)
+	if itemID == "" {
+		return "", "", ErrItemIDRequired
+	}
+	return cartID, itemID, nil
+}
+
+func normalizeAddress(address entity.Address) entity.Address {
+	return entity.Address{
+		FirstName:    strings.TrimSpace(address.FirstName),
+		LastName:     strings.TrimSpace(address.LastName),
+		Email:        strings.ToLower(strings.TrimSpace(address.Email)),
+		Phone:        strings.TrimSpace(address.Phone),
+		AddressLine1: strings.TrimSpace(address.AddressLine1),
+		AddressLine2: strings.TrimSpace(address.AddressLine2),
+		City:         strings.TrimSpace(address.City),
+		State:        strings.TrimSpace(address.State),
+		PostalCode:   strings.TrimSpace(address.PostalCode),
+		Country:      strings.ToUpper(strings.TrimSpace(address.Country)),
+	}
+}
@@ -0,0 +1,207 @@
+package storefront
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontinputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+	storefrontmocks "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport/mocks"
+)
+
+var acme = entity.Store{ID: "store-1", TenantID: "tenant-1", Name: "Acme", Subdomain: "acme"}
+
+type storefrontFixture struct {
+	svc     *StorefrontInteractor
+	stores  *storefrontmocks.MockStoreDirectory
+	catalog *storefrontmocks.MockCatalog
+	carts   *storefrontmocks.MockCarts
+	orders  *storefrontmocks.MockOrders
+}
+
+func setupStorefrontInteractor(t *testing.T) storefrontFixture {
+	t.Helper()
+	f := storefrontFixture{
+		stores:  storefrontmocks.NewMockStoreDirectory(t),
+		catalog: storefrontmocks.NewMockCatalog(t),
+		carts:   storefrontmocks.NewMockCarts(t),
+		orders:  storefrontmocks.NewMockOrders(t),
+	}
+	f.svc = NewStorefrontInteractor(StorefrontInteractorParams{
+		Stores:  f.stores,
+		Catalog: f.catalog,
+		Carts:   f.carts,
+		Orders:  f.orders,
+		Config: storefrontconfig.Config{
+			BaseDomain:       "podzone.local",
+			DefaultSubdomain: "demo",
+			PageSize:         12,
+		},
+	})
+	return f
+}
+
+func readyCart() *entity.Cart {
+	return &entity.Cart{
+		ID:               "cart-1",
+		Items:            []entity.CartItem{{ID: "item-1", ProductID: "p-1", Quantity: 1, InStock: true}},
+		SelectedShipping: &entity.ShippingOption{ID: "ghn-standard"},
+		UpdatedAt:        time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC),
+	}
+}
+
+func shopperAddress() entity.Address {
+	return entity.Address{
+		FirstName:    "Ada",
+		Email:        " Ada@Example.com ",
+		AddressLine1: "1 Main St",
+		City:         "Hanoi",
+		Country:      "vn",
+	}
+}
+
+func TestResolveStore_MapsHostToSubdomain(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		host      string
+		subdomain string
+	}{
+		{host: "acme.podzone.local", subdomain: "acme"},
+		{host: "ACME.podzone.local:3000", subdomain: "acme"},
+		{host: "localhost:3000", subdomain: "demo"},
+		{host: "127.0.0.1", subdomain: "demo"},
+	}
+	for _, tc := range cases {
+		f := setupStorefrontInteractor(t)
+		f.stores.EXPECT().FindBySubdomain(mock.Anything, tc.subdomain).Return(&acme, nil)
+
+		store, err := f.svc.ResolveStore(context.Background(), tc.host)
+		require.NoError(t, err, tc.host)
+		require.Equal(t, "tenant-1", store.TenantID)
+	}
+}
+
+func TestResolveStore_RejectsForeignAndNestedHosts(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+
+	for _, host := range []string{"a.b.podzone.local", "shop.example.com", ""} {
+		_, err := f.svc.ResolveStore(context.Background(), host)
+		require.ErrorIs(t, err, ErrStoreNotFound, host)
+	}
+}
+
+func TestListProducts_UsesStoreTenantAndConfiguredPageSize(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	f.catalog.EXPECT().
+		ListProducts(mock.Anything, "tenant-1", int32(1), int32(12)).
+		Return(entity.ProductPage{Page: 1, TotalPages: 1}, nil)
+
+	page, err := f.svc.ListProducts(context.Background(), acme, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), page.Page)
+}
+
+func TestAddToCart_StartsFreshCartWhenCookieCartExpired(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "stale").Return(&entity.Cart{}, nil)
+	f.carts.EXPECT().AddItem(mock.Anything, "tenant-1", "", "p-1", int32(2)).Return(readyCart(), nil)
+
+	cart, err := f.svc.AddToCart(context.Background(), acme, storefrontinputport.AddToCartCommand{
+		CartID:    "stale",
+		ProductID: "p-1",
+		Quantity:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cart-1", cart.ID)
+}
+
+func TestAddToCart_RejectsInvalidQuantity(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+
+	_, err := f.svc.AddToCart(context.Background(), acme, storefrontinputport.AddToCartCommand{
+		ProductID: "p-1",
+	})
+	require.ErrorIs(t, err, ErrInvalidQuantity)
+}
+
+func TestUpdateCartItem_ZeroQuantityRemovesLine(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	f.carts.EXPECT().RemoveItem(mock.Anything, "tenant-1", "cart-1", "item-1").Return(&entity.Cart{ID: "cart-1"}, nil)
+
+	cart, err := f.svc.UpdateCartItem(context.Background(), acme, storefrontinputport.UpdateCartItemCommand{
+		CartID: "cart-1",
+		ItemID: "item-1",
+	})
+	require.NoError(t, err)
+	require.True(t, cart.IsEmpty())
+}
+
+func TestCheckout_PlacesOrderWithSelectedShipping(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(readyCart(), nil)
+	f.orders.EXPECT().
+		PlaceOrder(mock.Anything, "tenant-1", mock.MatchedBy(func(req storefrontoutputport.PlaceOrderRequest) bool {
+			return req.StoreID == "store-1" &&
+				req.CartID == "cart-1" &&
+				req.ShippingMethodID == "ghn-standard" &&
+				req.Address.Email == "ada@example.com" &&
+				req.Address.Country == "VN" &&
+				req.IdempotencyKey == "cart-1:2026-03-04T05:06:07Z"
+		})).
+		Return(&entity.Order{ID: "order-1", OrderNumber: "PZ-1"}, nil)
+
+	order, err := f.svc.Checkout(context.Background(), acme, storefrontinputport.CheckoutCommand{
+		CartID:  "cart-1",
+		Address: shopperAddress(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "PZ-1", order.OrderNumber)
+}
+
+func TestCheckout_RequiresShippingSelection(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	cart := readyCart()
+	cart.SelectedShipping = nil
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(cart, nil)
+
+	_, err := f.svc.Checkout(context.Background(), acme, storefrontinputport.CheckoutCommand{
+		CartID:  "cart-1",
+		Address: shopperAddress(),
+	})
+	require.ErrorIs(t, err, ErrShippingRequired)
+}
+
+func TestCheckout_RejectsUnavailableLinesAndMissingEmail(t *testing.T) {
+	t.Parallel()
+	f := setupStorefrontInteractor(t)
+	cart := readyCart()
+	cart.Items[0].InStock = false
+	f.carts.EXPECT().GetCart(mock.Anything, "tenant-1", "cart-1").Return(cart, nil)
+
+	_, err := f.svc.Checkout(context.Background(), acme, storefrontinputport.CheckoutCommand{
+		CartID:  "cart-1",
+		Address: shopperAddress(),
+	})
+	require.ErrorIs(t, err, ErrCartItemUnavailable)
+
+	address := shopperAddress()
+	address.Email = " "
+	_, err = f.svc.Checkout(context.Background(), acme, storefrontinputport.CheckoutCommand{
+		CartID:  "cart-1",
+		Address: address,
+	})
+	require.ErrorIs(t, err, ErrEmailRequired)
+}
@@ -0,0 +1,41 @@
+package outputport
+
+import (
+	"context"
+
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+)
+
+type StoreDirectory interface {
+	// FindBySubdomain returns the ready store onboarding provisioned for subdomain.
+	FindBySubdomain(ctx context.Context, subdomain string) (*entity.Store, error)
+}
+
+type Catalog interface {
+	ListProducts(ctx context.Context, tenantID string, page, pageSize int32) (entity.ProductPage, error)
+	GetProduct(ctx context.Context, tenantID, id string) (*entity.Product, error)
+}
+
+// Carts operates on guest carts addressed by id; an empty cart id on AddItem starts a new cart.
+type Carts interface {
+	GetCart(ctx context.Context, tenantID, cartID string) (*entity.Cart, error)
+	AddItem(ctx context.Context, tenantID, cartID, productID string, quantity int32) (*entity.Cart, error)
+	UpdateItem(ctx context.Context, tenantID, cartID, itemID string, quantity int32) (*entity.Cart, error)
+	RemoveItem(ctx context.Context, tenantID, cartID, itemID string) (*entity.Cart, error)
+	ShippingOptions(ctx context.Context, tenantID, cartID, country, postalCode string) ([]entity.ShippingOption, error)
+	SetShippingOption(ctx context.Context, tenantID, cartID, optionID string) (*entity.Cart, error)
+}
+
+type PlaceOrderRequest struct {
+	StoreID          string
+	CartID           string
+	ShippingMethodID string
+	Address          entity.Address
+	Notes            string
+	// IdempotencyKey lets the order service answer a retried checkout with the order it already placed.
+	IdempotencyKey string
+}
+
+type Orders interface {
+	PlaceOrder(ctx context.Context, tenantID string, req PlaceOrderRequest) (*entity.Order, error)
+}
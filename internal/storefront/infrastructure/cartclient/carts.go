@@ -0,0 +1,183 @@
+package cartclient
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+	pbcartv1 "github.com/tuannm99/podzone/pkg/api/proto/cart/v1"
+)
+
+// tenantHeader mirrors the cart service's storefront tenant selector.
+const tenantHeader = "x-tenant-id"
+
+var _ storefrontoutputport.Carts = (*Carts)(nil)
+
+type Carts struct {
+	client pbcartv1.CartServiceClient
+}
+
+type Params struct {
+	fx.In
+
+	Conn *grpc.ClientConn `name:"grpc-client-cart"`
+}
+
+func New(params Params) *Carts {
+	return &Carts{client: pbcartv1.NewCartServiceClient(params.Conn)}
+}
+
+// GetCart treats an expired or unknown guest cart as an empty one.
+func (c *Carts) GetCart(ctx context.Context, tenantID, cartID string) (*entity.Cart, error) {
+	cart, err := c.client.GetCartByID(withTenant(ctx, tenantID), &pbcartv1.GetCartByIDRequest{Id: cartID})
+	if status.Code(err) == codes.NotFound {
+		return &entity.Cart{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get cart %s: %w", cartID, err)
+	}
+	return toCart(cart), nil
+}
+
+func (c *Carts) AddItem(
+	ctx context.Context,
+	tenantID, cartID, productID string,
+	quantity int32,
+) (*entity.Cart, error) {
+	cart, err := c.client.AddItem(withTenant(ctx, tenantID), &pbcartv1.AddItemRequest{
+		CartId:    cartID,
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, cartError("add cart item", err)
+	}
+	return toCart(cart), nil
+}
+
+func (c *Carts) UpdateItem(
+	ctx context.Context,
+	tenantID, cartID, itemID string,
+	quantity int32,
+) (*entity.Cart, error) {
+	cart, err := c.client.UpdateItem(withTenant(ctx, tenantID), &pbcartv1.UpdateItemRequest{
+		CartId:   cartID,
+		ItemId:   itemID,
+		Quantity: quantity,
+	})
+	if err != nil {
+		return nil, cartError("update cart item", err)
+	}
+	return toCart(cart), nil
+}
+
+func (c *Carts) RemoveItem(ctx context.Context, tenantID, cartID, itemID string) (*entity.Cart, error) {
+	cart, err := c.client.RemoveItem(withTenant(ctx, tenantID), &pbcartv1.RemoveItemRequest{
+		CartId: cartID,
+		ItemId: itemID,
+	})
+	if err != nil {
+		return nil, cartError("remove cart item", err)
+	}
+	return toCart(cart), nil
+}
+
+func (c *Carts) ShippingOptions(
+	ctx context.Context,
+	tenantID, cartID, country, postalCode string,
+) ([]entity.ShippingOption, error) {
+	resp, err := c.client.GetShippingOptions(withTenant(ctx, tenantID), &pbcartv1.GetShippingOptionsRequest{
+		CartId:     cartID,
+		Country:    country,
+		PostalCode: postalCode,
+	})
+	if err != nil {
+		return nil, cartError("get shipping options", err)
+	}
+	options := make([]entity.ShippingOption, 0, len(resp.GetOptions()))
+	for _, option := range resp.GetOptions() {
+		options = append(options, toShippingOption(option))
+	}
+	return options, nil
+}
+
+func (c *Carts) SetShippingOption(ctx context.Context, tenantID, cartID, optionID string) (*entity.Cart, error) {
+	cart, err := c.client.SetShippingOption(withTenant(ctx, tenantID), &pbcartv1.SetShippingOptionRequest{
+		CartId:           cartID,
+		ShippingOptionId: optionID,
+	})
+	if err != nil {
+		return nil, cartError("set shipping option", err)
+	}
+	return toCart(cart), nil
+}
+
+func withTenant(ctx context.Context, tenantID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, tenantHeader, tenantID)
+}
+
+// cartError surfaces the cart service's validation messages to the shopper and keeps
+// transport failures opaque.
+func cartError(op string, err error) error {
+	switch st := status.Convert(err); st.Code() {
+	case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", storefrontdomain.ErrRequestRejected, st.Message())
+	default:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+}
+
+func toCart(cart *pbcartv1.Cart) *entity.Cart {
+	items := make([]entity.CartItem, 0, len(cart.GetItems()))
+	for _, item := range cart.GetItems() {
+		items = append(items, entity.CartItem{
+			ID:        item.GetId(),
+			ProductID: item.GetProductId(),
+			Name:      item.GetName(),
+			SKU:       item.GetSku(),
+			ImageURL:  item.GetImageUrl(),
+			Quantity:  item.GetQuantity(),
+			UnitPrice: item.GetUnitPrice(),
+			Subtotal:  item.GetSubtotal(),
+			Discount:  item.GetDiscount(),
+			InStock:   item.GetInStock(),
+		})
+	}
+	out := &entity.Cart{
+		ID:        cart.GetId(),
+		Items:     items,
+		Currency:  cart.GetCurrency(),
+		UpdatedAt: cart.GetUpdatedAt().AsTime(),
+		Totals: entity.CartTotals{
+			Subtotal:      cart.GetTotals().GetSubtotal(),
+			DiscountTotal: cart.GetTotals().GetDiscountTotal(),
+			TaxTotal:      cart.GetTotals().GetTaxTotal(),
+			ShippingTotal: cart.GetTotals().GetShippingTotal(),
+			GrandTotal:    cart.GetTotals().GetGrandTotal(),
+			ItemsQuantity: cart.GetTotals().GetItemsQuantity(),
+		},
+	}
+	if selected := cart.GetSelectedShipping(); selected.GetId() != "" {
+		option := toShippingOption(selected)
+		out.SelectedShipping = &option
+	}
+	return out
+}
+
+func toShippingOption(option *pbcartv1.ShippingOption) entity.ShippingOption {
+	return entity.ShippingOption{
+		ID:                option.GetId(),
+		Name:              option.GetName(),
+		Carrier:           option.GetCarrier(),
+		Price:             option.GetPrice(),
+		EstimatedDelivery: option.GetEstimatedDelivery(),
+	}
+}
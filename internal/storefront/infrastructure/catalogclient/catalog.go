@@ -0,0 +1,92 @@
+package catalogclient
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+	pbcatalogv1 "github.com/tuannm99/podzone/pkg/api/proto/catalog/v1"
+)
+
+// tenantHeader mirrors the catalog service's anonymous tenant selector.
+const tenantHeader = "x-tenant-id"
+
+var _ storefrontoutputport.Catalog = (*Catalog)(nil)
+
+type Catalog struct {
+	client pbcatalogv1.CatalogServiceClient
+}
+
+type Params struct {
+	fx.In
+
+	Conn *grpc.ClientConn `name:"grpc-client-catalog"`
+}
+
+func New(params Params) *Catalog {
+	return &Catalog{client: pbcatalogv1.NewCatalogServiceClient(params.Conn)}
+}
+
+// ListProducts reads as an anonymous storefront caller, so only active products are returned.
+func (c *Catalog) ListProducts(
+	ctx context.Context,
+	tenantID string,
+	page, pageSize int32,
+) (entity.ProductPage, error) {
+	resp, err := c.client.ListProducts(withTenant(ctx, tenantID), &pbcatalogv1.ListProductsRequest{
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return entity.ProductPage{}, fmt.Errorf("list catalog products: %w", err)
+	}
+	products := make([]entity.Product, 0, len(resp.GetProducts()))
+	for _, product := range resp.GetProducts() {
+		products = append(products, toProduct(product))
+	}
+	return entity.ProductPage{
+		Products:   products,
+		Page:       resp.GetPage(),
+		TotalPages: resp.GetTotalPages(),
+		Total:      resp.GetTotal(),
+	}, nil
+}
+
+func (c *Catalog) GetProduct(ctx context.Context, tenantID, id string) (*entity.Product, error) {
+	product, err := c.client.GetProduct(withTenant(ctx, tenantID), &pbcatalogv1.GetProductRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, storefrontdomain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get catalog product %s: %w", id, err)
+	}
+	out := toProduct(product)
+	return &out, nil
+}
+
+func withTenant(ctx context.Context, tenantID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, tenantHeader, tenantID)
+}
+
+func toProduct(product *pbcatalogv1.Product) entity.Product {
+	return entity.Product{
+		ID:          product.GetId(),
+		Name:        product.GetName(),
+		Description: product.GetDescription(),
+		SKU:         product.GetSku(),
+		Slug:        product.GetSlug(),
+		Price:       product.GetPrice(),
+		SalePrice:   product.GetSalePrice(),
+		Stock:       product.GetInventoryCount(),
+		ImageURLs:   product.GetImageUrls(),
+		Attributes:  product.GetAttributes(),
+	}
+}
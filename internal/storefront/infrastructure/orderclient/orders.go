@@ -0,0 +1,115 @@
+package orderclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+	pborderv1 "github.com/tuannm99/podzone/pkg/api/proto/order/v1"
+)
+
+// tenantHeader mirrors the order service's storefront tenant selector.
+const tenantHeader = "x-tenant-id"
+
+// idempotencyKeyHeader mirrors the order service's checkout retry key.
+const idempotencyKeyHeader = "idempotency-key"
+
+var _ storefrontoutputport.Orders = (*Orders)(nil)
+
+type Orders struct {
+	client pborderv1.OrderServiceClient
+}
+
+type Params struct {
+	fx.In
+
+	Conn *grpc.ClientConn `name:"grpc-client-order"`
+}
+
+func New(params Params) *Orders {
+	return &Orders{client: pborderv1.NewOrderServiceClient(params.Conn)}
+}
+
+// PlaceOrder creates a guest order; the shipping address doubles as the billing address.
+func (o *Orders) PlaceOrder(
+	ctx context.Context,
+	tenantID string,
+	req storefrontoutputport.PlaceOrderRequest,
+) (*entity.Order, error) {
+	address := req.Address
+	ctx = metadata.AppendToOutgoingContext(ctx, tenantHeader, tenantID)
+	if req.IdempotencyKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyHeader, req.IdempotencyKey)
+	}
+	order, err := o.client.CreateOrder(ctx, &pborderv1.CreateOrderRequest{
+		CartId:           req.CartID,
+		StoreId:          req.StoreID,
+		ShippingMethodId: req.ShippingMethodID,
+		Notes:            req.Notes,
+		BillingAddress: &pborderv1.BillingAddress{
+			FirstName:    address.FirstName,
+			LastName:     address.LastName,
+			Email:        address.Email,
+			Phone:        address.Phone,
+			AddressLine1: address.AddressLine1,
+			AddressLine2: address.AddressLine2,
+			City:         address.City,
+			State:        address.State,
+			PostalCode:   address.PostalCode,
+			Country:      address.Country,
+		},
+		ShippingAddress: &pborderv1.ShippingAddress{
+			FirstName:    address.FirstName,
+			LastName:     address.LastName,
+			Phone:        address.Phone,
+			AddressLine1: address.AddressLine1,
+			AddressLine2: address.AddressLine2,
+			City:         address.City,
+			State:        address.State,
+			PostalCode:   address.PostalCode,
+			Country:      address.Country,
+		},
+	})
+	if err != nil {
+		switch st := status.Convert(err); st.Code() {
+		case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition:
+			return nil, fmt.Errorf("%w: %s", storefrontdomain.ErrRequestRejected, st.Message())
+		default:
+			return nil, fmt.Errorf("create order: %w", err)
+		}
+	}
+	return toOrder(order, address), nil
+}
+
+func toOrder(order *pborderv1.Order, address entity.Address) *entity.Order {
+	items := make([]entity.OrderItem, 0, len(order.GetItems()))
+	for _, item := range order.GetItems() {
+		items = append(items, entity.OrderItem{
+			Name:     item.GetName(),
+			SKU:      item.GetSku(),
+			Quantity: item.GetQuantity(),
+			Total:    item.GetTotal(),
+		})
+	}
+	return &entity.Order{
+		ID:              order.GetId(),
+		OrderNumber:     order.GetOrderNumber(),
+		Status:          strings.TrimPrefix(order.GetStatus().String(), "ORDER_STATUS_"),
+		Currency:        order.GetCurrency(),
+		Email:           address.Email,
+		ShippingAddress: address,
+		Items:           items,
+		ShippingTotal:   order.GetTotals().GetShippingTotal(),
+		DiscountTotal:   order.GetTotals().GetDiscountTotal(),
+		GrandTotal:      order.GetTotals().GetGrandTotal(),
+	}
+}
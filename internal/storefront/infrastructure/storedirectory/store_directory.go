@@ -0,0 +1,130 @@
+package storedirectory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	"github.com/tuannm99/podzone/internal/storefront/domain/storefront/entity"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+)
+
+// maxCachedStores bounds the cache; random subdomains must not grow it without limit.
+const maxCachedStores = 1024
+
+// statusReady is the onboarding request status once the store is provisioned and finalized.
+const statusReady = "ready"
+
+var _ storefrontoutputport.StoreDirectory = (*MongoStoreDirectory)(nil)
+
+// MongoStoreDirectory reads the store requests onboarding owns. Lookups are cached briefly
+// because every storefront request resolves its store.
+type MongoStoreDirectory struct {
+	collection *mongo.Collection
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedStore
+}
+
+type cachedStore struct {
+	store     *entity.Store
+	expiresAt time.Time
+}
+
+type storeRequestDocument struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	WorkspaceID string             `bson:"workspace_id"`
+	Name        string             `bson:"name"`
+	Subdomain   string             `bson:"subdomain"`
+}
+
+type Params struct {
+	fx.In
+
+	MongoClient *mongo.Client `name:"mongo-onboarding"`
+	DB          string        `name:"mongo-onboarding-db"`
+	Config      storefrontconfig.Config
+}
+
+func New(p Params) *MongoStoreDirectory {
+	return &MongoStoreDirectory{
+		collection: p.MongoClient.Database(p.DB).Collection("store_requests"),
+		ttl:        p.Config.StoreCacheTTL,
+		now:        time.Now,
+		cache:      make(map[string]cachedStore),
+	}
+}
+
+func (d *MongoStoreDirectory) FindBySubdomain(ctx context.Context, subdomain string) (*entity.Store, error) {
+	if store, ok := d.cached(subdomain); ok {
+		if store == nil {
+			return nil, storefrontdomain.ErrStoreNotFound
+		}
+		return store, nil
+	}
+
+	var doc storeRequestDocument
+	err := d.collection.FindOne(ctx, bson.M{"subdomain": subdomain, "status": statusReady}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// Unknown subdomains are cached too so probing hosts cannot hammer Mongo.
+		d.remember(subdomain, nil)
+		return nil, storefrontdomain.ErrStoreNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find store by subdomain %s: %w", subdomain, err)
+	}
+
+	store := &entity.Store{
+		ID:        doc.ID.Hex(),
+		TenantID:  doc.WorkspaceID,
+		Name:      doc.Name,
+		Subdomain: doc.Subdomain,
+	}
+	d.remember(subdomain, store)
+	return store, nil
+}
+
+func (d *MongoStoreDirectory) cached(subdomain string) (*entity.Store, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	entry, ok := d.cache[subdomain]
+	if !ok {
+		return nil, false
+	}
+	if d.now().After(entry.expiresAt) {
+		delete(d.cache, subdomain)
+		return nil, false
+	}
+	return entry.store, true
+}
+
+func (d *MongoStoreDirectory) remember(subdomain string, store *entity.Store) {
+	if d.ttl <= 0 {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	if len(d.cache) >= maxCachedStores {
+		for key, entry := range d.cache {
+			if now.After(entry.expiresAt) {
+				delete(d.cache, key)
+			}
+		}
+		if len(d.cache) >= maxCachedStores {
+			clear(d.cache)
+		}
+	}
+	d.cache[subdomain] = cachedStore{store: store, expiresAt: now.Add(d.ttl)}
+}
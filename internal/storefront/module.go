@@ -0,0 +1,48 @@
+package storefront
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	storefrontconfig "github.com/tuannm99/podzone/internal/storefront/config"
+	"github.com/tuannm99/podzone/internal/storefront/controller/httphandler"
+	storefrontdomain "github.com/tuannm99/podzone/internal/storefront/domain/storefront"
+	storefrontinputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/inputport"
+	storefrontoutputport "github.com/tuannm99/podzone/internal/storefront/domain/storefront/outputport"
+	"github.com/tuannm99/podzone/internal/storefront/infrastructure/cartclient"
+	"github.com/tuannm99/podzone/internal/storefront/infrastructure/catalogclient"
+	"github.com/tuannm99/podzone/internal/storefront/infrastructure/orderclient"
+	"github.com/tuannm99/podzone/internal/storefront/infrastructure/storedirectory"
+	"github.com/tuannm99/podzone/pkg/pdhttp"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+	"github.com/tuannm99/podzone/pkg/pdmongo"
+)
+
+// Module serves the storefront pages. It expects pdmongo.ModuleFor("onboarding") for store
+// lookup and pdgrpcclient.ModuleFor("catalog"|"cart"|"order") for the backing services.
+var Module = fx.Options(
+	fx.Provide(
+		storefrontconfig.NewConfig,
+		fx.Annotate(
+			func(cfg *pdmongo.Config) string {
+				return cfg.Database
+			},
+			fx.ParamTags(`name:"mongo-onboarding-config"`),
+			fx.ResultTags(`name:"mongo-onboarding-db"`),
+		),
+		fx.Annotate(storedirectory.New, fx.As(new(storefrontoutputport.StoreDirectory))),
+		fx.Annotate(catalogclient.New, fx.As(new(storefrontoutputport.Catalog))),
+		fx.Annotate(cartclient.New, fx.As(new(storefrontoutputport.Carts))),
+		fx.Annotate(orderclient.New, fx.As(new(storefrontoutputport.Orders))),
+		fx.Annotate(storefrontdomain.NewStorefrontInteractor, fx.As(new(storefrontinputport.Usecase))),
+		httphandler.NewController,
+		fx.Annotate(RegisterHTTPRoutes, fx.ResultTags(`group:"gin-routes"`)),
+	),
+)
+
+func RegisterHTTPRoutes(ctrl *httphandler.Controller, logger pdlog.Logger) pdhttp.RouteRegistrar {
+	logger.Info("Registering Storefront HTTP handler")
+	return func(r *gin.Engine) {
+		ctrl.RegisterRoutes(r)
+	}
+}
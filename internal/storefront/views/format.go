@@ -0,0 +1,36 @@
+package views
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/a-h/templ"
+)
+
+func money(amount float64, currency string) string {
+	if currency == "" {
+		currency = "USD"
+	}
+	return fmt.Sprintf("%.2f %s", amount, currency)
+}
+
+func productURL(id string) templ.SafeURL {
+	return templ.URL("/products/" + url.PathEscape(id))
+}
+
+func cartItemURL(id, action string) templ.SafeURL {
+	u := "/cart/items/" + url.PathEscape(id)
+	if action != "" {
+		u += "/" + action
+	}
+	return templ.URL(u)
+}
+
+func pageURL(page int32) templ.SafeURL {
+	return templ.URL("/?page=" + strconv.Itoa(int(page)))
+}
+
+func itoa(v int32) string {
+	return strconv.Itoa(int(v))
+}
@@ -0,0 +1,28 @@
+package events
+
+import "time"
+
+const (
+	TypeAccessRequestRequested = "access_request.requested"
+	TypeAccessRequestApproved  = "access_request.approved"
+	TypeAccessRequestDenied    = "access_request.denied"
+	TypeAccessRequestCancelled = "access_request.cancelled"
+	TypeAccessRequestExpired   = "access_request.expired"
+)
+
+// AccessRequestV1 is the payload of every access_request.* event: the request as it stands
+// after the step the event names. The decision fields are set once the request was decided.
+type AccessRequestV1 struct {
+	RequestID       string     `json:"request_id"`
+	RequesterUserID uint       `json:"requester_user_id"`
+	RoleID          uint64     `json:"role_id"`
+	RoleName        string     `json:"role_name"`
+	TenantID        string     `json:"tenant_id"`
+	Status          string     `json:"status"`
+	Justification   string     `json:"justification"`
+	StartsAt        time.Time  `json:"starts_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	DecidedByUserID uint       `json:"decided_by_user_id,omitempty"`
+	DecisionReason  string     `json:"decision_reason,omitempty"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+}
@@ -0,0 +1,63 @@
+package events
+
+import "time"
+
+const (
+	TypeWebAuthnCloneDetected = "auth.webauthn.clone_detected"
+	TypeSessionCompromised    = "auth.session.compromised"
+	TypeAPIKeyCreated         = "auth.api_key.created"
+	TypeAPIKeyRevoked         = "auth.api_key.revoked"
+	TypeLoginLocked           = "auth.login.locked"
+	TypeLoginUnlocked         = "auth.login.unlocked"
+)
+
+// WebAuthnCloneDetectedV1 reports a credential whose sign counter went backwards; auth has
+// disabled it.
+type WebAuthnCloneDetectedV1 struct {
+	CredentialID    string `json:"credential_id"`
+	UserID          uint   `json:"user_id"`
+	StoredSignCount uint32 `json:"stored_sign_count"`
+	SignCount       uint32 `json:"sign_count"`
+}
+
+// SessionCompromisedV1 reports a rotated refresh token presented again; auth has revoked the
+// session and every refresh token in it.
+type SessionCompromisedV1 struct {
+	SessionID         string    `json:"session_id"`
+	UserID            uint      `json:"user_id"`
+	RefreshTokenID    string    `json:"refresh_token_id"`
+	ReplacedByTokenID string    `json:"replaced_by_token_id"`
+	ReusedAt          time.Time `json:"reused_at"`
+}
+
+type APIKeyCreatedV1 struct {
+	APIKeyID         string    `json:"api_key_id"`
+	Name             string    `json:"name"`
+	OwnerType        string    `json:"owner_type"`
+	UserID           uint      `json:"user_id"`
+	TenantID         string    `json:"tenant_id"`
+	ServicePrincipal string    `json:"service_principal"`
+	RoleName         string    `json:"role_name"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+type APIKeyRevokedV1 struct {
+	APIKeyID string `json:"api_key_id"`
+	UserID   uint   `json:"user_id"`
+}
+
+// LoginLockedV1 reports a login subject, a username or client IP, locked after repeated
+// failures.
+type LoginLockedV1 struct {
+	Subject     string    `json:"subject"`
+	Value       string    `json:"value"`
+	UserID      uint      `json:"user_id,omitempty"`
+	Failures    int       `json:"failures"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+// LoginUnlockedV1 reports an operator clearing the lock on a login subject.
+type LoginUnlockedV1 struct {
+	Subject string `json:"subject"`
+	Value   string `json:"value"`
+}
@@ -0,0 +1,11 @@
+// Package events holds the payload contracts of integration events that cross service
+// boundaries. Each payload type is registered in Schemas under its event type and schema
+// version; producers validate against it before appending to their outbox and consumers
+// decode through messaging.HandleEvent.
+//
+// Changing a payload in a way old consumers cannot read means adding a new version type,
+// registering it with an upcaster from the previous one, and regenerating the exported JSON
+// Schemas with make event-schemas.
+package events
+
+//go:generate go run export_schemas.go
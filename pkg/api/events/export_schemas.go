@@ -0,0 +1,39 @@
+//go:build ignore
+
+// Writes the JSON Schema of every registered event version to schemas/.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tuannm99/podzone/pkg/api/events"
+)
+
+func main() {
+	if err := run("schemas"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	exported, err := events.Schemas().JSONSchemas()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, schema := range exported {
+		path := filepath.Join(dir, events.SchemaFileName(schema))
+		if err := os.WriteFile(path, append(schema.Schema, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
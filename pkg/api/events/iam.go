@@ -0,0 +1,55 @@
+package events
+
+const (
+	TypeTenantCreated     = "tenant.created"
+	TypeTenantMemberAdded = "tenant.member.added"
+)
+
+type TenantCreatedV1 struct {
+	TenantID    string `json:"tenant_id"`
+	TenantSlug  string `json:"tenant_slug"`
+	TenantName  string `json:"tenant_name"`
+	OwnerUserID uint   `json:"owner_user_id"`
+}
+
+type TenantMemberAddedV1 struct {
+	TenantID string `json:"tenant_id"`
+	UserID   uint   `json:"user_id"`
+	RoleName string `json:"role_name"`
+	Status   string `json:"status"`
+}
+
+const (
+	TypePolicyAttached = "policy.attached"
+	// TypeAuthorizationChanged covers IAM writes that change authorization but have no more
+	// specific event. Consumers drop cached decisions for the envelope's tenant, or for every
+	// tenant when it has none.
+	TypeAuthorizationChanged = "authorization.changed"
+)
+
+// PolicyAttachedV1 reports a policy attached to a tenant user, a platform user or a group.
+// AttachmentType names which; only the fields of that principal are set.
+type PolicyAttachedV1 struct {
+	TenantID        string `json:"tenant_id,omitempty"`
+	UserID          uint   `json:"user_id,omitempty"`
+	GroupID         uint64 `json:"group_id,omitempty"`
+	GroupName       string `json:"group_name,omitempty"`
+	PolicyID        uint64 `json:"policy_id"`
+	PolicyName      string `json:"policy_name"`
+	PolicyScope     string `json:"policy_scope"`
+	AttachmentType  string `json:"attachment_type"`
+	AttachmentScope string `json:"attachment_scope"`
+}
+
+// AuthorizationChangedV1 names the change and identifies what it touched; which of the
+// optional fields are set depends on Change.
+type AuthorizationChangedV1 struct {
+	Change     string `json:"change"`
+	OrgID      string `json:"org_id,omitempty"`
+	UserID     uint   `json:"user_id,omitempty"`
+	GroupID    uint64 `json:"group_id,omitempty"`
+	RoleName   string `json:"role_name,omitempty"`
+	PolicyID   uint64 `json:"policy_id,omitempty"`
+	PolicyName string `json:"policy_name,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
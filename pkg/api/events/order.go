@@ -0,0 +1,35 @@
+package events
+
+import "time"
+
+const TypeOrderPlaced = "order.placed"
+
+// OrderPlacedV1 is the order.placed contract consumed by backoffice routing.
+type OrderPlacedV1 struct {
+	OrderID           string `json:"order_id"`
+	OrderNumber       string `json:"order_number"`
+	TenantID          string `json:"tenant_id"`
+	StoreID           string `json:"store_id"`
+	UserID            string `json:"user_id,omitempty"`
+	Currency          string `json:"currency"`
+	CustomerName      string `json:"customer_name"`
+	CustomerEmail     string `json:"customer_email"`
+	ShipRegion        string `json:"ship_region"`
+	ShippingPartnerID string `json:"shipping_partner_id,omitempty"`
+	// ShippingPartnerCode is the carrier code routing matches as the shopper's preferred partner.
+	ShippingPartnerCode string              `json:"shipping_partner_code,omitempty"`
+	GrandTotal          float64             `json:"grand_total"`
+	Items               []OrderPlacedItemV1 `json:"items"`
+	PlacedAt            time.Time           `json:"placed_at"`
+}
+
+type OrderPlacedItemV1 struct {
+	ItemID     string            `json:"item_id"`
+	ProductID  string            `json:"product_id"`
+	SKU        string            `json:"sku"`
+	Name       string            `json:"name"`
+	Quantity   int32             `json:"quantity"`
+	UnitPrice  float64           `json:"unit_price"`
+	Total      float64           `json:"total"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
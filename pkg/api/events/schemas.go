@@ -0,0 +1,52 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+var schemas = sync.OnceValue(func() *messaging.SchemaRegistry {
+	r := messaging.NewSchemaRegistry()
+	must(messaging.RegisterSchema[TenantCreatedV1](r, TypeTenantCreated, 1))
+	must(messaging.RegisterSchema[TenantMemberAddedV1](r, TypeTenantMemberAdded, 1))
+	must(messaging.RegisterSchema[PolicyAttachedV1](r, TypePolicyAttached, 1))
+	must(messaging.RegisterSchema[AuthorizationChangedV1](r, TypeAuthorizationChanged, 1))
+	for _, eventType := range []string{
+		TypeAccessRequestRequested,
+		TypeAccessRequestApproved,
+		TypeAccessRequestDenied,
+		TypeAccessRequestCancelled,
+		TypeAccessRequestExpired,
+	} {
+		must(messaging.RegisterSchema[AccessRequestV1](r, eventType, 1))
+	}
+	must(messaging.RegisterSchema[WebAuthnCloneDetectedV1](r, TypeWebAuthnCloneDetected, 1))
+	must(messaging.RegisterSchema[SessionCompromisedV1](r, TypeSessionCompromised, 1))
+	must(messaging.RegisterSchema[APIKeyCreatedV1](r, TypeAPIKeyCreated, 1))
+	must(messaging.RegisterSchema[APIKeyRevokedV1](r, TypeAPIKeyRevoked, 1))
+	must(messaging.RegisterSchema[LoginLockedV1](r, TypeLoginLocked, 1))
+	must(messaging.RegisterSchema[LoginUnlockedV1](r, TypeLoginUnlocked, 1))
+	must(messaging.RegisterSchema[OrderPlacedV1](r, TypeOrderPlaced, 1))
+	must(r.Check())
+	return r
+})
+
+// Schemas returns the registry of every event contract in this package. The auth, iam and
+// order relays publish through messaging.NewStrictSchemaValidatingPublisher, so an event type
+// missing here is dead-lettered in their outboxes rather than published unchecked.
+func Schemas() *messaging.SchemaRegistry {
+	return schemas()
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SchemaFileName is the name the exported JSON Schema of one event version is written under.
+func SchemaFileName(schema messaging.EventSchema) string {
+	return fmt.Sprintf("%s.v%d.schema.json", schema.Type, schema.Version)
+}
@@ -0,0 +1,73 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+func TestSchemas_ExportedFilesAreCurrent(t *testing.T) {
+	exported, err := Schemas().JSONSchemas()
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join("schemas", "*.schema.json"))
+	require.NoError(t, err)
+	require.Len(t, files, len(exported), "run make event-schemas")
+	for _, schema := range exported {
+		written, err := os.ReadFile(filepath.Join("schemas", SchemaFileName(schema)))
+		require.NoError(t, err, "run make event-schemas")
+		assert.Equal(t, string(schema.Schema)+"\n", string(written), "run make event-schemas")
+	}
+}
+
+func TestSchemas_AcceptPayloadsProducersWrite(t *testing.T) {
+	tests := []struct {
+		eventType string
+		payload   any
+	}{
+		{TypeTenantCreated, TenantCreatedV1{TenantID: "t1", TenantSlug: "t1", TenantName: "Tenant", OwnerUserID: 7}},
+		{TypeTenantMemberAdded, TenantMemberAddedV1{TenantID: "t1", UserID: 7, RoleName: "admin", Status: "active"}},
+		{TypePolicyAttached, PolicyAttachedV1{UserID: 7, PolicyID: 1, PolicyName: "managed/platform_owner"}},
+		{TypeAuthorizationChanged, AuthorizationChangedV1{Change: "tenant.organization.detached"}},
+		{TypeAuthorizationChanged, AuthorizationChangedV1{Change: "group.member.added", GroupID: 3, UserID: 7}},
+		{TypeAccessRequestRequested, AccessRequestV1{RequestID: "r1", RoleID: 2, StartsAt: time.Now().UTC()}},
+		{TypeAccessRequestApproved, AccessRequestV1{RequestID: "r1", DecidedByUserID: 9, DecidedAt: new(time.Time)}},
+		{TypeWebAuthnCloneDetected, WebAuthnCloneDetectedV1{CredentialID: "c1", UserID: 7, StoredSignCount: 5}},
+		{TypeSessionCompromised, SessionCompromisedV1{SessionID: "s1", UserID: 7, ReusedAt: time.Now().UTC()}},
+		{TypeAPIKeyCreated, APIKeyCreatedV1{APIKeyID: "k1", OwnerType: "user", ExpiresAt: time.Now().UTC()}},
+		{TypeAPIKeyRevoked, APIKeyRevokedV1{APIKeyID: "k1", UserID: 7}},
+		{TypeLoginLocked, LoginLockedV1{Subject: "username", Value: "alice", Failures: 5}},
+		{TypeLoginUnlocked, LoginUnlockedV1{Subject: "ip", Value: "10.0.0.1"}},
+		{TypeOrderPlaced, OrderPlacedV1{OrderID: "o1", PlacedAt: time.Now().UTC()}},
+		{TypeOrderPlaced, OrderPlacedV1{
+			OrderID: "o1",
+			Items:   []OrderPlacedItemV1{{ItemID: "i1", Attributes: map[string]string{"product_type": "mug"}}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			payload, err := json.Marshal(tt.payload)
+			require.NoError(t, err)
+
+			err = Schemas().Validate(messaging.Envelope{Type: tt.eventType, SchemaVersion: 1, Payload: payload})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSchemas_RejectPayloadMissingField(t *testing.T) {
+	err := Schemas().Validate(messaging.Envelope{
+		Type:          TypeTenantCreated,
+		SchemaVersion: 1,
+		Payload:       json.RawMessage(`{"tenant_id":"t1","tenant_slug":"t1","tenant_name":"Tenant"}`),
+	})
+	require.ErrorIs(t, err, messaging.ErrSchemaMismatch)
+	assert.Contains(t, err.Error(), "owner_user_id")
+}
@@ -25,13 +25,34 @@ var File_auth_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aauth/v1/auth_service.proto\x12\x04auth\x1a\x12auth/v1/auth.proto\x1a\x1aauth/v1/auth_session.proto\x1a\x1cgoogle/api/annotations.proto2\xef\x10\n" +
+	"\x1aauth/v1/auth_service.proto\x12\x04auth\x1a\x12auth/v1/auth.proto\x1a\x1aauth/v1/auth_account.proto\x1a\x1aauth/v1/auth_api_key.proto\x1a!auth/v1/auth_login_throttle.proto\x1a\x16auth/v1/auth_mfa.proto\x1a\x17auth/v1/auth_oidc.proto\x1a\x1aauth/v1/auth_session.proto\x1a\x1bauth/v1/auth_webauthn.proto\x1a\x1cgoogle/api/annotations.proto2\xd2'\n" +
 	"\vAuthService\x12a\n" +
 	"\vGoogleLogin\x12\x18.auth.GoogleLoginRequest\x1a\x19.auth.GoogleLoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/auth/v1/google/login\x12m\n" +
 	"\x0eGoogleCallback\x12\x1b.auth.GoogleCallbackRequest\x1a\x1c.auth.GoogleCallbackResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/auth/v1/google/callback\x12q\n" +
-	"\x13ExchangeGoogleLogin\x12 .auth.ExchangeGoogleLoginRequest\x1a\x13.auth.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/v1/google/exchange\x12K\n" +
-	"\x05Login\x12\x12.auth.LoginRequest\x1a\x13.auth.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/v1/login\x12W\n" +
-	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/v1/register\x12b\n" +
+	"\x13ExchangeGoogleLogin\x12 .auth.ExchangeGoogleLoginRequest\x1a\x13.auth.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/v1/google/exchange\x12\x81\x01\n" +
+	"\x15ListIdentityProviders\x12\".auth.ListIdentityProvidersRequest\x1a#.auth.ListIdentityProvidersResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/auth/v1/oidc/providers\x12d\n" +
+	"\tOIDCLogin\x12\x16.auth.OIDCLoginRequest\x1a\x17.auth.OIDCLoginResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/auth/v1/oidc/{provider}/login\x12p\n" +
+	"\fOIDCCallback\x12\x19.auth.OIDCCallbackRequest\x1a\x1a.auth.OIDCCallbackResponse\")\x82\xd3\xe4\x93\x02#\x12!/auth/v1/oidc/{provider}/callback\x12k\n" +
+	"\x11ExchangeOIDCLogin\x12\x1e.auth.ExchangeOIDCLoginRequest\x1a\x13.auth.LoginResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/auth/v1/oidc/exchange\x12K\n" +
+	"\x05Login\x12\x12.auth.LoginRequest\x1a\x13.auth.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/v1/login\x12d\n" +
+	"\vUnlockLogin\x12\x18.auth.UnlockLoginRequest\x1a\x19.auth.UnlockLoginResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/auth/v1/login:unlock\x12^\n" +
+	"\tVerifyMFA\x12\x16.auth.VerifyMFARequest\x1a\x13.auth.LoginResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/auth/v1/login:verify-mfa\x12\\\n" +
+	"\tEnrollMFA\x12\x16.auth.EnrollMFARequest\x1a\x17.auth.EnrollMFAResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/auth/v1/mfa:enroll\x12d\n" +
+	"\vActivateMFA\x12\x18.auth.ActivateMFARequest\x1a\x19.auth.ActivateMFAResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/auth/v1/mfa:activate\x12`\n" +
+	"\n" +
+	"DisableMFA\x12\x17.auth.DisableMFARequest\x1a\x18.auth.DisableMFAResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/auth/v1/mfa:disable\x12\x9c\x01\n" +
+	"\x19BeginWebAuthnRegistration\x12&.auth.BeginWebAuthnRegistrationRequest\x1a'.auth.BeginWebAuthnRegistrationResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/auth/v1/webauthn/credentials:begin\x12\xa0\x01\n" +
+	"\x1aFinishWebAuthnRegistration\x12'.auth.FinishWebAuthnRegistrationRequest\x1a(.auth.FinishWebAuthnRegistrationResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/auth/v1/webauthn/credentials:finish\x12\x8d\x01\n" +
+	"\x17ListWebAuthnCredentials\x12$.auth.ListWebAuthnCredentialsRequest\x1a%.auth.ListWebAuthnCredentialsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/auth/v1/webauthn/credentials\x12\x95\x01\n" +
+	"\x18DeleteWebAuthnCredential\x12%.auth.DeleteWebAuthnCredentialRequest\x1a&.auth.DeleteWebAuthnCredentialResponse\"*\x82\xd3\xe4\x93\x02$*\"/auth/v1/webauthn/credentials/{id}\x12\x81\x01\n" +
+	"\x12BeginWebAuthnLogin\x12\x1f.auth.BeginWebAuthnLoginRequest\x1a .auth.BeginWebAuthnLoginResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/auth/v1/login/webauthn:begin\x12w\n" +
+	"\x13FinishWebAuthnLogin\x12 .auth.FinishWebAuthnLoginRequest\x1a\x13.auth.LoginResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/auth/v1/login/webauthn:finish\x12W\n" +
+	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/v1/register\x12\x89\x01\n" +
+	"\x14RequestPasswordReset\x12!.auth.RequestPasswordResetRequest\x1a\".auth.RequestPasswordResetResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/auth/v1/password:request-reset\x12l\n" +
+	"\rResetPassword\x12\x1a.auth.ResetPasswordRequest\x1a\x1b.auth.ResetPasswordResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/auth/v1/password:reset\x12p\n" +
+	"\x0eChangePassword\x12\x1b.auth.ChangePasswordRequest\x1a\x1c.auth.ChangePasswordResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/v1/password:change\x12\x99\x01\n" +
+	"\x18RequestEmailVerification\x12%.auth.RequestEmailVerificationRequest\x1a&.auth.RequestEmailVerificationResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/auth/v1/email:request-verification\x12d\n" +
+	"\vVerifyEmail\x12\x18.auth.VerifyEmailRequest\x1a\x19.auth.VerifyEmailResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/auth/v1/email:verify\x12b\n" +
 	"\fRefreshToken\x12\x19.auth.RefreshTokenRequest\x1a\x1a.auth.RefreshTokenResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/auth/v1/refresh\x12O\n" +
 	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x14.auth.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/auth/v1/logout\x12\x7f\n" +
 	"\x12SwitchActiveTenant\x12\x1f.auth.SwitchActiveTenantRequest\x1a .auth.SwitchActiveTenantResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/auth/v1/iam/tenants:switch\x12\x86\x01\n" +
@@ -43,97 +64,199 @@ const file_auth_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
 	"GetSession\x12\x17.auth.GetSessionRequest\x1a\x18.auth.GetSessionResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/auth/v1/sessions/{session_id}\x12`\n" +
 	"\fListSessions\x12\x19.auth.ListSessionsRequest\x1a\x1a.auth.ListSessionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/auth/v1/sessions\x12p\n" +
-	"\rRevokeSession\x12\x1a.auth.RevokeSessionRequest\x1a\x1b.auth.RevokeSessionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/auth/v1/sessions/{session_id}\x12e\n" +
+	"\rRevokeSession\x12\x1a.auth.RevokeSessionRequest\x1a\x1b.auth.RevokeSessionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/auth/v1/sessions/{session_id}\x12c\n" +
+	"\fCreateAPIKey\x12\x19.auth.CreateAPIKeyRequest\x1a\x1a.auth.CreateAPIKeyResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/v1/api-keys\x12]\n" +
+	"\vListAPIKeys\x12\x18.auth.ListAPIKeysRequest\x1a\x19.auth.ListAPIKeysResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/auth/v1/api-keys\x12m\n" +
+	"\fRevokeAPIKey\x12\x19.auth.RevokeAPIKeyRequest\x1a\x1a.auth.RevokeAPIKeyResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/auth/v1/api-keys/{api_key_id}\x12e\n" +
 	"\rListAuditLogs\x12\x1a.auth.ListAuditLogsRequest\x1a\x1b.auth.ListAuditLogsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/auth/v1/audit-logs\x12x\n" +
 	"\x11GetUserByIdentity\x12\x1e.auth.GetUserByIdentityRequest\x1a\x1f.auth.GetUserByIdentityResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/auth/v1/users:by-identity\x12\x7f\n" +
 	"\x11EnsureUserByEmail\x12\x1e.auth.EnsureUserByEmailRequest\x1a\x1f.auth.EnsureUserByEmailResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/auth/v1/users:ensure-by-email\x12d\n" +
 	"\vGetUserByID\x12\x18.auth.GetUserByIDRequest\x1a\x19.auth.GetUserByIDResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/auth/v1/users/{user_id}\x12<\n" +
-	"\tListUsers\x12\x16.auth.ListUsersRequest\x1a\x17.auth.ListUsersResponseB<Z:github.com/tuannm99/podzone/pkg/api/proto/auth/v1;pbauthv1b\x06proto3"
+	"\tListUsers\x12\x16.auth.ListUsersRequest\x1a\x17.auth.ListUsersResponse\x12T\n" +
+	"\x11UpdateUserProfile\x12\x1e.auth.UpdateUserProfileRequest\x1a\x1f.auth.UpdateUserProfileResponse\x12N\n" +
+	"\x0fDeprovisionUser\x12\x1c.auth.DeprovisionUserRequest\x1a\x1d.auth.DeprovisionUserResponseB<Z:github.com/tuannm99/podzone/pkg/api/proto/auth/v1;pbauthv1b\x06proto3"
 
 var file_auth_v1_auth_service_proto_goTypes = []any{
-	(*GoogleLoginRequest)(nil),          // 0: auth.GoogleLoginRequest
-	(*GoogleCallbackRequest)(nil),       // 1: auth.GoogleCallbackRequest
-	(*ExchangeGoogleLoginRequest)(nil),  // 2: auth.ExchangeGoogleLoginRequest
-	(*LoginRequest)(nil),                // 3: auth.LoginRequest
-	(*RegisterRequest)(nil),             // 4: auth.RegisterRequest
-	(*RefreshTokenRequest)(nil),         // 5: auth.RefreshTokenRequest
-	(*LogoutRequest)(nil),               // 6: auth.LogoutRequest
-	(*SwitchActiveTenantRequest)(nil),   // 7: auth.SwitchActiveTenantRequest
-	(*AssumeSessionPolicyRequest)(nil),  // 8: auth.AssumeSessionPolicyRequest
-	(*ClearSessionPolicyRequest)(nil),   // 9: auth.ClearSessionPolicyRequest
-	(*AssumeRoleRequest)(nil),           // 10: auth.AssumeRoleRequest
-	(*ClearAssumedRoleRequest)(nil),     // 11: auth.ClearAssumedRoleRequest
-	(*GetSessionRequest)(nil),           // 12: auth.GetSessionRequest
-	(*ListSessionsRequest)(nil),         // 13: auth.ListSessionsRequest
-	(*RevokeSessionRequest)(nil),        // 14: auth.RevokeSessionRequest
-	(*ListAuditLogsRequest)(nil),        // 15: auth.ListAuditLogsRequest
-	(*GetUserByIdentityRequest)(nil),    // 16: auth.GetUserByIdentityRequest
-	(*EnsureUserByEmailRequest)(nil),    // 17: auth.EnsureUserByEmailRequest
-	(*GetUserByIDRequest)(nil),          // 18: auth.GetUserByIDRequest
-	(*ListUsersRequest)(nil),            // 19: auth.ListUsersRequest
-	(*GoogleLoginResponse)(nil),         // 20: auth.GoogleLoginResponse
-	(*GoogleCallbackResponse)(nil),      // 21: auth.GoogleCallbackResponse
-	(*LoginResponse)(nil),               // 22: auth.LoginResponse
-	(*RegisterResponse)(nil),            // 23: auth.RegisterResponse
-	(*RefreshTokenResponse)(nil),        // 24: auth.RefreshTokenResponse
-	(*LogoutResponse)(nil),              // 25: auth.LogoutResponse
-	(*SwitchActiveTenantResponse)(nil),  // 26: auth.SwitchActiveTenantResponse
-	(*AssumeSessionPolicyResponse)(nil), // 27: auth.AssumeSessionPolicyResponse
-	(*ClearSessionPolicyResponse)(nil),  // 28: auth.ClearSessionPolicyResponse
-	(*AssumeRoleResponse)(nil),          // 29: auth.AssumeRoleResponse
-	(*ClearAssumedRoleResponse)(nil),    // 30: auth.ClearAssumedRoleResponse
-	(*GetSessionResponse)(nil),          // 31: auth.GetSessionResponse
-	(*ListSessionsResponse)(nil),        // 32: auth.ListSessionsResponse
-	(*RevokeSessionResponse)(nil),       // 33: auth.RevokeSessionResponse
-	(*ListAuditLogsResponse)(nil),       // 34: auth.ListAuditLogsResponse
-	(*GetUserByIdentityResponse)(nil),   // 35: auth.GetUserByIdentityResponse
-	(*EnsureUserByEmailResponse)(nil),   // 36: auth.EnsureUserByEmailResponse
-	(*GetUserByIDResponse)(nil),         // 37: auth.GetUserByIDResponse
-	(*ListUsersResponse)(nil),           // 38: auth.ListUsersResponse
+	(*GoogleLoginRequest)(nil),                 // 0: auth.GoogleLoginRequest
+	(*GoogleCallbackRequest)(nil),              // 1: auth.GoogleCallbackRequest
+	(*ExchangeGoogleLoginRequest)(nil),         // 2: auth.ExchangeGoogleLoginRequest
+	(*ListIdentityProvidersRequest)(nil),       // 3: auth.ListIdentityProvidersRequest
+	(*OIDCLoginRequest)(nil),                   // 4: auth.OIDCLoginRequest
+	(*OIDCCallbackRequest)(nil),                // 5: auth.OIDCCallbackRequest
+	(*ExchangeOIDCLoginRequest)(nil),           // 6: auth.ExchangeOIDCLoginRequest
+	(*LoginRequest)(nil),                       // 7: auth.LoginRequest
+	(*UnlockLoginRequest)(nil),                 // 8: auth.UnlockLoginRequest
+	(*VerifyMFARequest)(nil),                   // 9: auth.VerifyMFARequest
+	(*EnrollMFARequest)(nil),                   // 10: auth.EnrollMFARequest
+	(*ActivateMFARequest)(nil),                 // 11: auth.ActivateMFARequest
+	(*DisableMFARequest)(nil),                  // 12: auth.DisableMFARequest
+	(*BeginWebAuthnRegistrationRequest)(nil),   // 13: auth.BeginWebAuthnRegistrationRequest
+	(*FinishWebAuthnRegistrationRequest)(nil),  // 14: auth.FinishWebAuthnRegistrationRequest
+	(*ListWebAuthnCredentialsRequest)(nil),     // 15: auth.ListWebAuthnCredentialsRequest
+	(*DeleteWebAuthnCredentialRequest)(nil),    // 16: auth.DeleteWebAuthnCredentialRequest
+	(*BeginWebAuthnLoginRequest)(nil),          // 17: auth.BeginWebAuthnLoginRequest
+	(*FinishWebAuthnLoginRequest)(nil),         // 18: auth.FinishWebAuthnLoginRequest
+	(*RegisterRequest)(nil),                    // 19: auth.RegisterRequest
+	(*RequestPasswordResetRequest)(nil),        // 20: auth.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),               // 21: auth.ResetPasswordRequest
+	(*ChangePasswordRequest)(nil),              // 22: auth.ChangePasswordRequest
+	(*RequestEmailVerificationRequest)(nil),    // 23: auth.RequestEmailVerificationRequest
+	(*VerifyEmailRequest)(nil),                 // 24: auth.VerifyEmailRequest
+	(*RefreshTokenRequest)(nil),                // 25: auth.RefreshTokenRequest
+	(*LogoutRequest)(nil),                      // 26: auth.LogoutRequest
+	(*SwitchActiveTenantRequest)(nil),          // 27: auth.SwitchActiveTenantRequest
+	(*AssumeSessionPolicyRequest)(nil),         // 28: auth.AssumeSessionPolicyRequest
+	(*ClearSessionPolicyRequest)(nil),          // 29: auth.ClearSessionPolicyRequest
+	(*AssumeRoleRequest)(nil),                  // 30: auth.AssumeRoleRequest
+	(*ClearAssumedRoleRequest)(nil),            // 31: auth.ClearAssumedRoleRequest
+	(*GetSessionRequest)(nil),                  // 32: auth.GetSessionRequest
+	(*ListSessionsRequest)(nil),                // 33: auth.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),               // 34: auth.RevokeSessionRequest
+	(*CreateAPIKeyRequest)(nil),                // 35: auth.CreateAPIKeyRequest
+	(*ListAPIKeysRequest)(nil),                 // 36: auth.ListAPIKeysRequest
+	(*RevokeAPIKeyRequest)(nil),                // 37: auth.RevokeAPIKeyRequest
+	(*ListAuditLogsRequest)(nil),               // 38: auth.ListAuditLogsRequest
+	(*GetUserByIdentityRequest)(nil),           // 39: auth.GetUserByIdentityRequest
+	(*EnsureUserByEmailRequest)(nil),           // 40: auth.EnsureUserByEmailRequest
+	(*GetUserByIDRequest)(nil),                 // 41: auth.GetUserByIDRequest
+	(*ListUsersRequest)(nil),                   // 42: auth.ListUsersRequest
+	(*UpdateUserProfileRequest)(nil),           // 43: auth.UpdateUserProfileRequest
+	(*DeprovisionUserRequest)(nil),             // 44: auth.DeprovisionUserRequest
+	(*GoogleLoginResponse)(nil),                // 45: auth.GoogleLoginResponse
+	(*GoogleCallbackResponse)(nil),             // 46: auth.GoogleCallbackResponse
+	(*LoginResponse)(nil),                      // 47: auth.LoginResponse
+	(*ListIdentityProvidersResponse)(nil),      // 48: auth.ListIdentityProvidersResponse
+	(*OIDCLoginResponse)(nil),                  // 49: auth.OIDCLoginResponse
+	(*OIDCCallbackResponse)(nil),               // 50: auth.OIDCCallbackResponse
+	(*UnlockLoginResponse)(nil),                // 51: auth.UnlockLoginResponse
+	(*EnrollMFAResponse)(nil),                  // 52: auth.EnrollMFAResponse
+	(*ActivateMFAResponse)(nil),                // 53: auth.ActivateMFAResponse
+	(*DisableMFAResponse)(nil),                 // 54: auth.DisableMFAResponse
+	(*BeginWebAuthnRegistrationResponse)(nil),  // 55: auth.BeginWebAuthnRegistrationResponse
+	(*FinishWebAuthnRegistrationResponse)(nil), // 56: auth.FinishWebAuthnRegistrationResponse
+	(*ListWebAuthnCredentialsResponse)(nil),    // 57: auth.ListWebAuthnCredentialsResponse
+	(*DeleteWebAuthnCredentialResponse)(nil),   // 58: auth.DeleteWebAuthnCredentialResponse
+	(*BeginWebAuthnLoginResponse)(nil),         // 59: auth.BeginWebAuthnLoginResponse
+	(*RegisterResponse)(nil),                   // 60: auth.RegisterResponse
+	(*RequestPasswordResetResponse)(nil),       // 61: auth.RequestPasswordResetResponse
+	(*ResetPasswordResponse)(nil),              // 62: auth.ResetPasswordResponse
+	(*ChangePasswordResponse)(nil),             // 63: auth.ChangePasswordResponse
+	(*RequestEmailVerificationResponse)(nil),   // 64: auth.RequestEmailVerificationResponse
+	(*VerifyEmailResponse)(nil),                // 65: auth.VerifyEmailResponse
+	(*RefreshTokenResponse)(nil),               // 66: auth.RefreshTokenResponse
+	(*LogoutResponse)(nil),                     // 67: auth.LogoutResponse
+	(*SwitchActiveTenantResponse)(nil),         // 68: auth.SwitchActiveTenantResponse
+	(*AssumeSessionPolicyResponse)(nil),        // 69: auth.AssumeSessionPolicyResponse
+	(*ClearSessionPolicyResponse)(nil),         // 70: auth.ClearSessionPolicyResponse
+	(*AssumeRoleResponse)(nil),                 // 71: auth.AssumeRoleResponse
+	(*ClearAssumedRoleResponse)(nil),           // 72: auth.ClearAssumedRoleResponse
+	(*GetSessionResponse)(nil),                 // 73: auth.GetSessionResponse
+	(*ListSessionsResponse)(nil),               // 74: auth.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),              // 75: auth.RevokeSessionResponse
+	(*CreateAPIKeyResponse)(nil),               // 76: auth.CreateAPIKeyResponse
+	(*ListAPIKeysResponse)(nil),                // 77: auth.ListAPIKeysResponse
+	(*RevokeAPIKeyResponse)(nil),               // 78: auth.RevokeAPIKeyResponse
+	(*ListAuditLogsResponse)(nil),              // 79: auth.ListAuditLogsResponse
+	(*GetUserByIdentityResponse)(nil),          // 80: auth.GetUserByIdentityResponse
+	(*EnsureUserByEmailResponse)(nil),          // 81: auth.EnsureUserByEmailResponse
+	(*GetUserByIDResponse)(nil),                // 82: auth.GetUserByIDResponse
+	(*ListUsersResponse)(nil),                  // 83: auth.ListUsersResponse
+	(*UpdateUserProfileResponse)(nil),          // 84: auth.UpdateUserProfileResponse
+	(*DeprovisionUserResponse)(nil),            // 85: auth.DeprovisionUserResponse
 }
 var file_auth_v1_auth_service_proto_depIdxs = []int32{
 	0,  // 0: auth.AuthService.GoogleLogin:input_type -> auth.GoogleLoginRequest
 	1,  // 1: auth.AuthService.GoogleCallback:input_type -> auth.GoogleCallbackRequest
 	2,  // 2: auth.AuthService.ExchangeGoogleLogin:input_type -> auth.ExchangeGoogleLoginRequest
-	3,  // 3: auth.AuthService.Login:input_type -> auth.LoginRequest
-	4,  // 4: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	5,  // 5: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
-	6,  // 6: auth.AuthService.Logout:input_type -> auth.LogoutRequest
-	7,  // 7: auth.AuthService.SwitchActiveTenant:input_type -> auth.SwitchActiveTenantRequest
-	8,  // 8: auth.AuthService.AssumeSessionPolicy:input_type -> auth.AssumeSessionPolicyRequest
-	9,  // 9: auth.AuthService.ClearSessionPolicy:input_type -> auth.ClearSessionPolicyRequest
-	10, // 10: auth.AuthService.AssumeRole:input_type -> auth.AssumeRoleRequest
-	11, // 11: auth.AuthService.ClearAssumedRole:input_type -> auth.ClearAssumedRoleRequest
-	12, // 12: auth.AuthService.GetSession:input_type -> auth.GetSessionRequest
-	13, // 13: auth.AuthService.ListSessions:input_type -> auth.ListSessionsRequest
-	14, // 14: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
-	15, // 15: auth.AuthService.ListAuditLogs:input_type -> auth.ListAuditLogsRequest
-	16, // 16: auth.AuthService.GetUserByIdentity:input_type -> auth.GetUserByIdentityRequest
-	17, // 17: auth.AuthService.EnsureUserByEmail:input_type -> auth.EnsureUserByEmailRequest
-	18, // 18: auth.AuthService.GetUserByID:input_type -> auth.GetUserByIDRequest
-	19, // 19: auth.AuthService.ListUsers:input_type -> auth.ListUsersRequest
-	20, // 20: auth.AuthService.GoogleLogin:output_type -> auth.GoogleLoginResponse
-	21, // 21: auth.AuthService.GoogleCallback:output_type -> auth.GoogleCallbackResponse
-	22, // 22: auth.AuthService.ExchangeGoogleLogin:output_type -> auth.LoginResponse
-	22, // 23: auth.AuthService.Login:output_type -> auth.LoginResponse
-	23, // 24: auth.AuthService.Register:output_type -> auth.RegisterResponse
-	24, // 25: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
-	25, // 26: auth.AuthService.Logout:output_type -> auth.LogoutResponse
-	26, // 27: auth.AuthService.SwitchActiveTenant:output_type -> auth.SwitchActiveTenantResponse
-	27, // 28: auth.AuthService.AssumeSessionPolicy:output_type -> auth.AssumeSessionPolicyResponse
-	28, // 29: auth.AuthService.ClearSessionPolicy:output_type -> auth.ClearSessionPolicyResponse
-	29, // 30: auth.AuthService.AssumeRole:output_type -> auth.AssumeRoleResponse
-	30, // 31: auth.AuthService.ClearAssumedRole:output_type -> auth.ClearAssumedRoleResponse
-	31, // 32: auth.AuthService.GetSession:output_type -> auth.GetSessionResponse
-	32, // 33: auth.AuthService.ListSessions:output_type -> auth.ListSessionsResponse
-	33, // 34: auth.AuthService.RevokeSession:output_type -> auth.RevokeSessionResponse
-	34, // 35: auth.AuthService.ListAuditLogs:output_type -> auth.ListAuditLogsResponse
-	35, // 36: auth.AuthService.GetUserByIdentity:output_type -> auth.GetUserByIdentityResponse
-	36, // 37: auth.AuthService.EnsureUserByEmail:output_type -> auth.EnsureUserByEmailResponse
-	37, // 38: auth.AuthService.GetUserByID:output_type -> auth.GetUserByIDResponse
-	38, // 39: auth.AuthService.ListUsers:output_type -> auth.ListUsersResponse
-	20, // [20:40] is the sub-list for method output_type
-	0,  // [0:20] is the sub-list for method input_type
+	3,  // 3: auth.AuthService.ListIdentityProviders:input_type -> auth.ListIdentityProvidersRequest
+	4,  // 4: auth.AuthService.OIDCLogin:input_type -> auth.OIDCLoginRequest
+	5,  // 5: auth.AuthService.OIDCCallback:input_type -> auth.OIDCCallbackRequest
+	6,  // 6: auth.AuthService.ExchangeOIDCLogin:input_type -> auth.ExchangeOIDCLoginRequest
+	7,  // 7: auth.AuthService.Login:input_type -> auth.LoginRequest
+	8,  // 8: auth.AuthService.UnlockLogin:input_type -> auth.UnlockLoginRequest
+	9,  // 9: auth.AuthService.VerifyMFA:input_type -> auth.VerifyMFARequest
+	10, // 10: auth.AuthService.EnrollMFA:input_type -> auth.EnrollMFARequest
+	11, // 11: auth.AuthService.ActivateMFA:input_type -> auth.ActivateMFARequest
+	12, // 12: auth.AuthService.DisableMFA:input_type -> auth.DisableMFARequest
+	13, // 13: auth.AuthService.BeginWebAuthnRegistration:input_type -> auth.BeginWebAuthnRegistrationRequest
+	14, // 14: auth.AuthService.FinishWebAuthnRegistration:input_type -> auth.FinishWebAuthnRegistrationRequest
+	15, // 15: auth.AuthService.ListWebAuthnCredentials:input_type -> auth.ListWebAuthnCredentialsRequest
+	16, // 16: auth.AuthService.DeleteWebAuthnCredential:input_type -> auth.DeleteWebAuthnCredentialRequest
+	17, // 17: auth.AuthService.BeginWebAuthnLogin:input_type -> auth.BeginWebAuthnLoginRequest
+	18, // 18: auth.AuthService.FinishWebAuthnLogin:input_type -> auth.FinishWebAuthnLoginRequest
+	19, // 19: auth.AuthService.Register:input_type -> auth.RegisterRequest
+	20, // 20: auth.AuthService.RequestPasswordReset:input_type -> auth.RequestPasswordResetRequest
+	21, // 21: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
+	22, // 22: auth.AuthService.ChangePassword:input_type -> auth.ChangePasswordRequest
+	23, // 23: auth.AuthService.RequestEmailVerification:input_type -> auth.RequestEmailVerificationRequest
+	24, // 24: auth.AuthService.VerifyEmail:input_type -> auth.VerifyEmailRequest
+	25, // 25: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
+	26, // 26: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	27, // 27: auth.AuthService.SwitchActiveTenant:input_type -> auth.SwitchActiveTenantRequest
+	28, // 28: auth.AuthService.AssumeSessionPolicy:input_type -> auth.AssumeSessionPolicyRequest
+	29, // 29: auth.AuthService.ClearSessionPolicy:input_type -> auth.ClearSessionPolicyRequest
+	30, // 30: auth.AuthService.AssumeRole:input_type -> auth.AssumeRoleRequest
+	31, // 31: auth.AuthService.ClearAssumedRole:input_type -> auth.ClearAssumedRoleRequest
+	32, // 32: auth.AuthService.GetSession:input_type -> auth.GetSessionRequest
+	33, // 33: auth.AuthService.ListSessions:input_type -> auth.ListSessionsRequest
+	34, // 34: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
+	35, // 35: auth.AuthService.CreateAPIKey:input_type -> auth.CreateAPIKeyRequest
+	36, // 36: auth.AuthService.ListAPIKeys:input_type -> auth.ListAPIKeysRequest
+	37, // 37: auth.AuthService.RevokeAPIKey:input_type -> auth.RevokeAPIKeyRequest
+	38, // 38: auth.AuthService.ListAuditLogs:input_type -> auth.ListAuditLogsRequest
+	39, // 39: auth.AuthService.GetUserByIdentity:input_type -> auth.GetUserByIdentityRequest
+	40, // 40: auth.AuthService.EnsureUserByEmail:input_type -> auth.EnsureUserByEmailRequest
+	41, // 41: auth.AuthService.GetUserByID:input_type -> auth.GetUserByIDRequest
+	42, // 42: auth.AuthService.ListUsers:input_type -> auth.ListUsersRequest
+	43, // 43: auth.AuthService.UpdateUserProfile:input_type -> auth.UpdateUserProfileRequest
+	44, // 44: auth.AuthService.DeprovisionUser:input_type -> auth.DeprovisionUserRequest
+	45, // 45: auth.AuthService.GoogleLogin:output_type -> auth.GoogleLoginResponse
+	46, // 46: auth.AuthService.GoogleCallback:output_type -> auth.GoogleCallbackResponse
+	47, // 47: auth.AuthService.ExchangeGoogleLogin:output_type -> auth.LoginResponse
+	48, // 48: auth.AuthService.ListIdentityProviders:output_type -> auth.ListIdentityProvidersResponse
+	49, // 49: auth.AuthService.OIDCLogin:output_type -> auth.OIDCLoginResponse
+	50, // 50: auth.AuthService.OIDCCallback:output_type -> auth.OIDCCallbackResponse
+	47, // 51: auth.AuthService.ExchangeOIDCLogin:output_type -> auth.LoginResponse
+	47, // 52: auth.AuthService.Login:output_type -> auth.LoginResponse
+	51, // 53: auth.AuthService.UnlockLogin:output_type -> auth.UnlockLoginResponse
+	47, // 54: auth.AuthService.VerifyMFA:output_type -> auth.LoginResponse
+	52, // 55: auth.AuthService.EnrollMFA:output_type -> auth.EnrollMFAResponse
+	53, // 56: auth.AuthService.ActivateMFA:output_type -> auth.ActivateMFAResponse
+	54, // 57: auth.AuthService.DisableMFA:output_type -> auth.DisableMFAResponse
+	55, // 58: auth.AuthService.BeginWebAuthnRegistration:output_type -> auth.BeginWebAuthnRegistrationResponse
+	56, // 59: auth.AuthService.FinishWebAuthnRegistration:output_type -> auth.FinishWebAuthnRegistrationResponse
+	57, // 60: auth.AuthService.ListWebAuthnCredentials:output_type -> auth.ListWebAuthnCredentialsResponse
+	58, // 61: auth.AuthService.DeleteWebAuthnCredential:output_type -> auth.DeleteWebAuthnCredentialResponse
+	59, // 62: auth.AuthService.BeginWebAuthnLogin:output_type -> auth.BeginWebAuthnLoginResponse
+	47, // 63: auth.AuthService.FinishWebAuthnLogin:output_type -> auth.LoginResponse
+	60, // 64: auth.AuthService.Register:output_type -> auth.RegisterResponse
+	61, // 65: auth.AuthService.RequestPasswordReset:output_type -> auth.RequestPasswordResetResponse
+	62, // 66: auth.AuthService.ResetPassword:output_type -> auth.ResetPasswordResponse
+	63, // 67: auth.AuthService.ChangePassword:output_type -> auth.ChangePasswordResponse
+	64, // 68: auth.AuthService.RequestEmailVerification:output_type -> auth.RequestEmailVerificationResponse
+	65, // 69: auth.AuthService.VerifyEmail:output_type -> auth.VerifyEmailResponse
+	66, // 70: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
+	67, // 71: auth.AuthService.Logout:output_type -> auth.LogoutResponse
+	68, // 72: auth.AuthService.SwitchActiveTenant:output_type -> auth.SwitchActiveTenantResponse
+	69, // 73: auth.AuthService.AssumeSessionPolicy:output_type -> auth.AssumeSessionPolicyResponse
+	70, // 74: auth.AuthService.ClearSessionPolicy:output_type -> auth.ClearSessionPolicyResponse
+	71, // 75: auth.AuthService.AssumeRole:output_type -> auth.AssumeRoleResponse
+	72, // 76: auth.AuthService.ClearAssumedRole:output_type -> auth.ClearAssumedRoleResponse
+	73, // 77: auth.AuthService.GetSession:output_type -> auth.GetSessionResponse
+	74, // 78: auth.AuthService.ListSessions:output_type -> auth.ListSessionsResponse
+	75, // 79: auth.AuthService.RevokeSession:output_type -> auth.RevokeSessionResponse
+	76, // 80: auth.AuthService.CreateAPIKey:output_type -> auth.CreateAPIKeyResponse
+	77, // 81: auth.AuthService.ListAPIKeys:output_type -> auth.ListAPIKeysResponse
+	78, // 82: auth.AuthService.RevokeAPIKey:output_type -> auth.RevokeAPIKeyResponse
+	79, // 83: auth.AuthService.ListAuditLogs:output_type -> auth.ListAuditLogsResponse
+	80, // 84: auth.AuthService.GetUserByIdentity:output_type -> auth.GetUserByIdentityResponse
+	81, // 85: auth.AuthService.EnsureUserByEmail:output_type -> auth.EnsureUserByEmailResponse
+	82, // 86: auth.AuthService.GetUserByID:output_type -> auth.GetUserByIDResponse
+	83, // 87: auth.AuthService.ListUsers:output_type -> auth.ListUsersResponse
+	84, // 88: auth.AuthService.UpdateUserProfile:output_type -> auth.UpdateUserProfileResponse
+	85, // 89: auth.AuthService.DeprovisionUser:output_type -> auth.DeprovisionUserResponse
+	45, // [45:90] is the sub-list for method output_type
+	0,  // [0:45] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -145,7 +268,13 @@ func file_auth_v1_auth_service_proto_init() {
 		return
 	}
 	file_auth_v1_auth_proto_init()
+	file_auth_v1_auth_account_proto_init()
+	file_auth_v1_auth_api_key_proto_init()
+	file_auth_v1_auth_login_throttle_proto_init()
+	file_auth_v1_auth_mfa_proto_init()
+	file_auth_v1_auth_oidc_proto_init()
 	file_auth_v1_auth_session_proto_init()
+	file_auth_v1_auth_webauthn_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
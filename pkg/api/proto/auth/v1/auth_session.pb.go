@@ -42,8 +42,13 @@ type Session struct {
 	AssumedRoleExpiresAt        string                 `protobuf:"bytes,16,opt,name=assumed_role_expires_at,json=assumedRoleExpiresAt,proto3" json:"assumed_role_expires_at,omitempty"`
 	SessionTags                 map[string]string      `protobuf:"bytes,17,rep,name=session_tags,json=sessionTags,proto3" json:"session_tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	AssumedRoleServicePrincipal string                 `protobuf:"bytes,18,opt,name=assumed_role_service_principal,json=assumedRoleServicePrincipal,proto3" json:"assumed_role_service_principal,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	MfaAuthenticatedAt          string                 `protobuf:"bytes,19,opt,name=mfa_authenticated_at,json=mfaAuthenticatedAt,proto3" json:"mfa_authenticated_at,omitempty"`
+	// "podzone" for password logins, otherwise the OIDC provider name.
+	IdentityProvider string `protobuf:"bytes,20,opt,name=identity_provider,json=identityProvider,proto3" json:"identity_provider,omitempty"`
+	// Why the session was revoked, e.g. "logout" or "refresh_token_reuse".
+	RevokedReason string `protobuf:"bytes,21,opt,name=revoked_reason,json=revokedReason,proto3" json:"revoked_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -202,17 +207,40 @@ func (x *Session) GetAssumedRoleServicePrincipal() string {
 	return ""
 }
 
+func (x *Session) GetMfaAuthenticatedAt() string {
+	if x != nil {
+		return x.MfaAuthenticatedAt
+	}
+	return ""
+}
+
+func (x *Session) GetIdentityProvider() string {
+	if x != nil {
+		return x.IdentityProvider
+	}
+	return ""
+}
+
+func (x *Session) GetRevokedReason() string {
+	if x != nil {
+		return x.RevokedReason
+	}
+	return ""
+}
+
 type AuditLog struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActorUserId   uint64                 `protobuf:"varint,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	ResourceType  string                 `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	ResourceId    string                 `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	PayloadJson   string                 `protobuf:"bytes,8,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorUserId  uint64                 `protobuf:"varint,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Action       string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType string                 `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   string                 `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	TenantId     string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Status       string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	PayloadJson  string                 `protobuf:"bytes,8,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	CreatedAt    string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// "info", "warning" or "high".
+	Severity      string `protobuf:"bytes,10,opt,name=severity,proto3" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,6 +338,13 @@ func (x *AuditLog) GetCreatedAt() string {
 	return ""
 }
 
+func (x *AuditLog) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
 type SwitchActiveTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1267,7 +1302,7 @@ var File_auth_v1_auth_session_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_session_proto_rawDesc = "" +
 	"\n" +
-	"\x1aauth/v1/auth_session.proto\x12\x04auth\x1a\x12auth/v1/auth.proto\x1a\x16common/v1/common.proto\x1a\x1acommon/v1/iam_policy.proto\"\xe2\x06\n" +
+	"\x1aauth/v1/auth_session.proto\x12\x04auth\x1a\x12auth/v1/auth.proto\x1a\x16common/v1/common.proto\x1a\x1acommon/v1/iam_policy.proto\"\xe8\a\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12(\n" +
@@ -1291,10 +1326,13 @@ const file_auth_v1_auth_session_proto_rawDesc = "" +
 	"\x1cassumed_role_source_identity\x18\x0f \x01(\tR\x19assumedRoleSourceIdentity\x125\n" +
 	"\x17assumed_role_expires_at\x18\x10 \x01(\tR\x14assumedRoleExpiresAt\x12A\n" +
 	"\fsession_tags\x18\x11 \x03(\v2\x1e.auth.Session.SessionTagsEntryR\vsessionTags\x12C\n" +
-	"\x1eassumed_role_service_principal\x18\x12 \x01(\tR\x1bassumedRoleServicePrincipal\x1a>\n" +
+	"\x1eassumed_role_service_principal\x18\x12 \x01(\tR\x1bassumedRoleServicePrincipal\x120\n" +
+	"\x14mfa_authenticated_at\x18\x13 \x01(\tR\x12mfaAuthenticatedAt\x12+\n" +
+	"\x11identity_provider\x18\x14 \x01(\tR\x10identityProvider\x12%\n" +
+	"\x0erevoked_reason\x18\x15 \x01(\tR\rrevokedReason\x1a>\n" +
 	"\x10SessionTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaf\x02\n" +
 	"\bAuditLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\ractor_user_id\x18\x02 \x01(\x04R\vactorUserId\x12\x16\n" +
@@ -1306,7 +1344,9 @@ const file_auth_v1_auth_session_proto_rawDesc = "" +
 	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
 	"\fpayload_json\x18\b \x01(\tR\vpayloadJson\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\"t\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\bseverity\x18\n" +
+	" \x01(\tR\bseverity\"t\n" +
 	"\x19SwitchActiveTenantRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12!\n" +
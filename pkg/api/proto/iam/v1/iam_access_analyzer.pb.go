@@ -0,0 +1,778 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: iam/v1/iam_access_analyzer.proto
+
+package pbiamv1
+
+import (
+	v1 "github.com/tuannm99/podzone/pkg/api/proto/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UnusedPermission is an action namespace a member's policies allow but the member has not
+// used. last_accessed_at is empty when it was never used.
+type UnusedPermission struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId         uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Namespace      string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LastAccessedAt string                 `protobuf:"bytes,4,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnusedPermission) Reset() {
+	*x = UnusedPermission{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnusedPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnusedPermission) ProtoMessage() {}
+
+func (x *UnusedPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnusedPermission.ProtoReflect.Descriptor instead.
+func (*UnusedPermission) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UnusedPermission) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UnusedPermission) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnusedPermission) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UnusedPermission) GetLastAccessedAt() string {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return ""
+}
+
+type UnusedRole struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId         uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId         uint64                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	RoleName       string                 `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	LastAccessedAt string                 `protobuf:"bytes,5,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnusedRole) Reset() {
+	*x = UnusedRole{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnusedRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnusedRole) ProtoMessage() {}
+
+func (x *UnusedRole) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnusedRole.ProtoReflect.Descriptor instead.
+func (*UnusedRole) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnusedRole) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UnusedRole) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnusedRole) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *UnusedRole) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *UnusedRole) GetLastAccessedAt() string {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return ""
+}
+
+type StaleGroupMembership struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName      string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	TenantId       string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId         uint64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastAccessedAt string                 `protobuf:"bytes,5,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StaleGroupMembership) Reset() {
+	*x = StaleGroupMembership{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleGroupMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleGroupMembership) ProtoMessage() {}
+
+func (x *StaleGroupMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleGroupMembership.ProtoReflect.Descriptor instead.
+func (*StaleGroupMembership) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaleGroupMembership) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *StaleGroupMembership) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *StaleGroupMembership) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *StaleGroupMembership) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StaleGroupMembership) GetLastAccessedAt() string {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return ""
+}
+
+// Exactly one of tenant_id and org_id is set. unused_for_seconds defaults to 90 days.
+type ListUnusedPermissionsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UnusedForSeconds int64                  `protobuf:"varint,3,opt,name=unused_for_seconds,json=unusedForSeconds,proto3" json:"unused_for_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListUnusedPermissionsRequest) Reset() {
+	*x = ListUnusedPermissionsRequest{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnusedPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnusedPermissionsRequest) ProtoMessage() {}
+
+func (x *ListUnusedPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnusedPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUnusedPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListUnusedPermissionsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListUnusedPermissionsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListUnusedPermissionsRequest) GetUnusedForSeconds() int64 {
+	if x != nil {
+		return x.UnusedForSeconds
+	}
+	return 0
+}
+
+type ListUnusedPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*UnusedPermission    `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnusedPermissionsResponse) Reset() {
+	*x = ListUnusedPermissionsResponse{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnusedPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnusedPermissionsResponse) ProtoMessage() {}
+
+func (x *ListUnusedPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnusedPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUnusedPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListUnusedPermissionsResponse) GetPermissions() []*UnusedPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListUnusedRolesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UnusedForSeconds int64                  `protobuf:"varint,3,opt,name=unused_for_seconds,json=unusedForSeconds,proto3" json:"unused_for_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListUnusedRolesRequest) Reset() {
+	*x = ListUnusedRolesRequest{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnusedRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnusedRolesRequest) ProtoMessage() {}
+
+func (x *ListUnusedRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnusedRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListUnusedRolesRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListUnusedRolesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListUnusedRolesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListUnusedRolesRequest) GetUnusedForSeconds() int64 {
+	if x != nil {
+		return x.UnusedForSeconds
+	}
+	return 0
+}
+
+type ListUnusedRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*UnusedRole          `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnusedRolesResponse) Reset() {
+	*x = ListUnusedRolesResponse{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnusedRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnusedRolesResponse) ProtoMessage() {}
+
+func (x *ListUnusedRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnusedRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListUnusedRolesResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUnusedRolesResponse) GetRoles() []*UnusedRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type ListStaleGroupMembershipsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UnusedForSeconds int64                  `protobuf:"varint,3,opt,name=unused_for_seconds,json=unusedForSeconds,proto3" json:"unused_for_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListStaleGroupMembershipsRequest) Reset() {
+	*x = ListStaleGroupMembershipsRequest{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleGroupMembershipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleGroupMembershipsRequest) ProtoMessage() {}
+
+func (x *ListStaleGroupMembershipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleGroupMembershipsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaleGroupMembershipsRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListStaleGroupMembershipsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListStaleGroupMembershipsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListStaleGroupMembershipsRequest) GetUnusedForSeconds() int64 {
+	if x != nil {
+		return x.UnusedForSeconds
+	}
+	return 0
+}
+
+type ListStaleGroupMembershipsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Memberships   []*StaleGroupMembership `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleGroupMembershipsResponse) Reset() {
+	*x = ListStaleGroupMembershipsResponse{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleGroupMembershipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleGroupMembershipsResponse) ProtoMessage() {}
+
+func (x *ListStaleGroupMembershipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleGroupMembershipsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaleGroupMembershipsResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListStaleGroupMembershipsResponse) GetMemberships() []*StaleGroupMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+// GenerateLeastPrivilegePolicyRequest asks for the statements covering what the user did in
+// the tenant during the last since_seconds, 90 days by default.
+type GenerateLeastPrivilegePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SinceSeconds  int64                  `protobuf:"varint,3,opt,name=since_seconds,json=sinceSeconds,proto3" json:"since_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLeastPrivilegePolicyRequest) Reset() {
+	*x = GenerateLeastPrivilegePolicyRequest{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLeastPrivilegePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLeastPrivilegePolicyRequest) ProtoMessage() {}
+
+func (x *GenerateLeastPrivilegePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLeastPrivilegePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLeastPrivilegePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GenerateLeastPrivilegePolicyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GenerateLeastPrivilegePolicyRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GenerateLeastPrivilegePolicyRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
+	}
+	return 0
+}
+
+type GenerateLeastPrivilegePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statements    []*v1.PolicyStatement  `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLeastPrivilegePolicyResponse) Reset() {
+	*x = GenerateLeastPrivilegePolicyResponse{}
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLeastPrivilegePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLeastPrivilegePolicyResponse) ProtoMessage() {}
+
+func (x *GenerateLeastPrivilegePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_analyzer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLeastPrivilegePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLeastPrivilegePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_analyzer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateLeastPrivilegePolicyResponse) GetStatements() []*v1.PolicyStatement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+var File_iam_v1_iam_access_analyzer_proto protoreflect.FileDescriptor
+
+const file_iam_v1_iam_access_analyzer_proto_rawDesc = "" +
+	"\n" +
+	" iam/v1/iam_access_analyzer.proto\x12\x03iam\x1a\x1acommon/v1/iam_policy.proto\"\x90\x01\n" +
+	"\x10UnusedPermission\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12(\n" +
+	"\x10last_accessed_at\x18\x04 \x01(\tR\x0elastAccessedAt\"\xa2\x01\n" +
+	"\n" +
+	"UnusedRole\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\x04R\x06roleId\x12\x1b\n" +
+	"\trole_name\x18\x04 \x01(\tR\broleName\x12(\n" +
+	"\x10last_accessed_at\x18\x05 \x01(\tR\x0elastAccessedAt\"\xb0\x01\n" +
+	"\x14StaleGroupMembership\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12(\n" +
+	"\x10last_accessed_at\x18\x05 \x01(\tR\x0elastAccessedAt\"\x80\x01\n" +
+	"\x1cListUnusedPermissionsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12,\n" +
+	"\x12unused_for_seconds\x18\x03 \x01(\x03R\x10unusedForSeconds\"X\n" +
+	"\x1dListUnusedPermissionsResponse\x127\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x15.iam.UnusedPermissionR\vpermissions\"z\n" +
+	"\x16ListUnusedRolesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12,\n" +
+	"\x12unused_for_seconds\x18\x03 \x01(\x03R\x10unusedForSeconds\"@\n" +
+	"\x17ListUnusedRolesResponse\x12%\n" +
+	"\x05roles\x18\x01 \x03(\v2\x0f.iam.UnusedRoleR\x05roles\"\x84\x01\n" +
+	" ListStaleGroupMembershipsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12,\n" +
+	"\x12unused_for_seconds\x18\x03 \x01(\x03R\x10unusedForSeconds\"`\n" +
+	"!ListStaleGroupMembershipsResponse\x12;\n" +
+	"\vmemberships\x18\x01 \x03(\v2\x19.iam.StaleGroupMembershipR\vmemberships\"\x80\x01\n" +
+	"#GenerateLeastPrivilegePolicyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12#\n" +
+	"\rsince_seconds\x18\x03 \x01(\x03R\fsinceSeconds\"_\n" +
+	"$GenerateLeastPrivilegePolicyResponse\x127\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2\x17.common.PolicyStatementR\n" +
+	"statementsB:Z8github.com/tuannm99/podzone/pkg/api/proto/iam/v1;pbiamv1b\x06proto3"
+
+var (
+	file_iam_v1_iam_access_analyzer_proto_rawDescOnce sync.Once
+	file_iam_v1_iam_access_analyzer_proto_rawDescData []byte
+)
+
+func file_iam_v1_iam_access_analyzer_proto_rawDescGZIP() []byte {
+	file_iam_v1_iam_access_analyzer_proto_rawDescOnce.Do(func() {
+		file_iam_v1_iam_access_analyzer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iam_v1_iam_access_analyzer_proto_rawDesc), len(file_iam_v1_iam_access_analyzer_proto_rawDesc)))
+	})
+	return file_iam_v1_iam_access_analyzer_proto_rawDescData
+}
+
+var file_iam_v1_iam_access_analyzer_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_iam_v1_iam_access_analyzer_proto_goTypes = []any{
+	(*UnusedPermission)(nil),                     // 0: iam.UnusedPermission
+	(*UnusedRole)(nil),                           // 1: iam.UnusedRole
+	(*StaleGroupMembership)(nil),                 // 2: iam.StaleGroupMembership
+	(*ListUnusedPermissionsRequest)(nil),         // 3: iam.ListUnusedPermissionsRequest
+	(*ListUnusedPermissionsResponse)(nil),        // 4: iam.ListUnusedPermissionsResponse
+	(*ListUnusedRolesRequest)(nil),               // 5: iam.ListUnusedRolesRequest
+	(*ListUnusedRolesResponse)(nil),              // 6: iam.ListUnusedRolesResponse
+	(*ListStaleGroupMembershipsRequest)(nil),     // 7: iam.ListStaleGroupMembershipsRequest
+	(*ListStaleGroupMembershipsResponse)(nil),    // 8: iam.ListStaleGroupMembershipsResponse
+	(*GenerateLeastPrivilegePolicyRequest)(nil),  // 9: iam.GenerateLeastPrivilegePolicyRequest
+	(*GenerateLeastPrivilegePolicyResponse)(nil), // 10: iam.GenerateLeastPrivilegePolicyResponse
+	(*v1.PolicyStatement)(nil),                   // 11: common.PolicyStatement
+}
+var file_iam_v1_iam_access_analyzer_proto_depIdxs = []int32{
+	0,  // 0: iam.ListUnusedPermissionsResponse.permissions:type_name -> iam.UnusedPermission
+	1,  // 1: iam.ListUnusedRolesResponse.roles:type_name -> iam.UnusedRole
+	2,  // 2: iam.ListStaleGroupMembershipsResponse.memberships:type_name -> iam.StaleGroupMembership
+	11, // 3: iam.GenerateLeastPrivilegePolicyResponse.statements:type_name -> common.PolicyStatement
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_iam_v1_iam_access_analyzer_proto_init() }
+func file_iam_v1_iam_access_analyzer_proto_init() {
+	if File_iam_v1_iam_access_analyzer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iam_v1_iam_access_analyzer_proto_rawDesc), len(file_iam_v1_iam_access_analyzer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iam_v1_iam_access_analyzer_proto_goTypes,
+		DependencyIndexes: file_iam_v1_iam_access_analyzer_proto_depIdxs,
+		MessageInfos:      file_iam_v1_iam_access_analyzer_proto_msgTypes,
+	}.Build()
+	File_iam_v1_iam_access_analyzer_proto = out.File
+	file_iam_v1_iam_access_analyzer_proto_goTypes = nil
+	file_iam_v1_iam_access_analyzer_proto_depIdxs = nil
+}
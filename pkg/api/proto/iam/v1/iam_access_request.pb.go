@@ -0,0 +1,1292 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: iam/v1/iam_access_request.proto
+
+package pbiamv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RoleAccessPolicy lets users request a role just in time. Exactly one of approver_group_id
+// and approver_platform_role names who may approve.
+type RoleAccessPolicy struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RoleName             string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ApproverGroupId      uint64                 `protobuf:"varint,2,opt,name=approver_group_id,json=approverGroupId,proto3" json:"approver_group_id,omitempty"`
+	ApproverPlatformRole string                 `protobuf:"bytes,3,opt,name=approver_platform_role,json=approverPlatformRole,proto3" json:"approver_platform_role,omitempty"`
+	MaxDurationSeconds   int64                  `protobuf:"varint,4,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RoleAccessPolicy) Reset() {
+	*x = RoleAccessPolicy{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAccessPolicy) ProtoMessage() {}
+
+func (x *RoleAccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAccessPolicy.ProtoReflect.Descriptor instead.
+func (*RoleAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleAccessPolicy) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *RoleAccessPolicy) GetApproverGroupId() uint64 {
+	if x != nil {
+		return x.ApproverGroupId
+	}
+	return 0
+}
+
+func (x *RoleAccessPolicy) GetApproverPlatformRole() string {
+	if x != nil {
+		return x.ApproverPlatformRole
+	}
+	return ""
+}
+
+func (x *RoleAccessPolicy) GetMaxDurationSeconds() int64 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (x *RoleAccessPolicy) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RoleAccessPolicy) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AccessRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequesterUserId uint64                 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	RoleName        string                 `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	RoleScope       string                 `protobuf:"bytes,4,opt,name=role_scope,json=roleScope,proto3" json:"role_scope,omitempty"`
+	TenantId        string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Justification   string                 `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	StartsAt        string                 `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ExpiresAt       string                 `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DecidedByUserId uint64                 `protobuf:"varint,10,opt,name=decided_by_user_id,json=decidedByUserId,proto3" json:"decided_by_user_id,omitempty"`
+	DecisionReason  string                 `protobuf:"bytes,11,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	DecidedAt       string                 `protobuf:"bytes,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequest) ProtoMessage() {}
+
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRequesterUserId() uint64 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRoleScope() string {
+	if x != nil {
+		return x.RoleScope
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetDecidedByUserId() uint64 {
+	if x != nil {
+		return x.DecidedByUserId
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PutRoleAccessPolicyRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RoleName             string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ApproverGroupId      uint64                 `protobuf:"varint,2,opt,name=approver_group_id,json=approverGroupId,proto3" json:"approver_group_id,omitempty"`
+	ApproverPlatformRole string                 `protobuf:"bytes,3,opt,name=approver_platform_role,json=approverPlatformRole,proto3" json:"approver_platform_role,omitempty"`
+	MaxDurationSeconds   int64                  `protobuf:"varint,4,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PutRoleAccessPolicyRequest) Reset() {
+	*x = PutRoleAccessPolicyRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleAccessPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleAccessPolicyRequest) ProtoMessage() {}
+
+func (x *PutRoleAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PutRoleAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PutRoleAccessPolicyRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *PutRoleAccessPolicyRequest) GetApproverGroupId() uint64 {
+	if x != nil {
+		return x.ApproverGroupId
+	}
+	return 0
+}
+
+func (x *PutRoleAccessPolicyRequest) GetApproverPlatformRole() string {
+	if x != nil {
+		return x.ApproverPlatformRole
+	}
+	return ""
+}
+
+func (x *PutRoleAccessPolicyRequest) GetMaxDurationSeconds() int64 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+type PutRoleAccessPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RoleAccessPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRoleAccessPolicyResponse) Reset() {
+	*x = PutRoleAccessPolicyResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleAccessPolicyResponse) ProtoMessage() {}
+
+func (x *PutRoleAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PutRoleAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PutRoleAccessPolicyResponse) GetPolicy() *RoleAccessPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetRoleAccessPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleName      string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleAccessPolicyRequest) Reset() {
+	*x = GetRoleAccessPolicyRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleAccessPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleAccessPolicyRequest) ProtoMessage() {}
+
+func (x *GetRoleAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRoleAccessPolicyRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type GetRoleAccessPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RoleAccessPolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleAccessPolicyResponse) Reset() {
+	*x = GetRoleAccessPolicyResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleAccessPolicyResponse) ProtoMessage() {}
+
+func (x *GetRoleAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRoleAccessPolicyResponse) GetPolicy() *RoleAccessPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteRoleAccessPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleName      string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleAccessPolicyRequest) Reset() {
+	*x = DeleteRoleAccessPolicyRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleAccessPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleAccessPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRoleAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRoleAccessPolicyRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type DeleteRoleAccessPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleAccessPolicyResponse) Reset() {
+	*x = DeleteRoleAccessPolicyResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleAccessPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteRoleAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{7}
+}
+
+type CreateAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleName      string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Justification string                 `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	// starts_at is RFC 3339; empty means now.
+	StartsAt        string `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	DurationSeconds int64  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestRequest) Reset() {
+	*x = CreateAccessRequestRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestRequest) ProtoMessage() {}
+
+func (x *CreateAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateAccessRequestRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CreateAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestResponse) Reset() {
+	*x = CreateAccessRequestResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestResponse) ProtoMessage() {}
+
+func (x *CreateAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequestRequest) Reset() {
+	*x = GetAccessRequestRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequestRequest) ProtoMessage() {}
+
+func (x *GetAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequestResponse) Reset() {
+	*x = GetAccessRequestResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequestResponse) ProtoMessage() {}
+
+func (x *GetAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListAccessRequestsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Status   string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// mine limits the result to the caller's own requests; otherwise requests the caller can
+	// approve are included as well.
+	Mine          bool `protobuf:"varint,3,opt,name=mine,proto3" json:"mine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsRequest) Reset() {
+	*x = ListAccessRequestsRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAccessRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsRequest) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+type ListAccessRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*AccessRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsResponse) Reset() {
+	*x = ListAccessRequestsResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsResponse) ProtoMessage() {}
+
+func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAccessRequestsResponse) GetRequests() []*AccessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ApproveAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestRequest) Reset() {
+	*x = ApproveAccessRequestRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestRequest) ProtoMessage() {}
+
+func (x *ApproveAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApproveAccessRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestResponse) Reset() {
+	*x = ApproveAccessRequestResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestResponse) ProtoMessage() {}
+
+func (x *ApproveAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApproveAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DenyAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyAccessRequestRequest) Reset() {
+	*x = DenyAccessRequestRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAccessRequestRequest) ProtoMessage() {}
+
+func (x *DenyAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*DenyAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DenyAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DenyAccessRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DenyAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyAccessRequestResponse) Reset() {
+	*x = DenyAccessRequestResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAccessRequestResponse) ProtoMessage() {}
+
+func (x *DenyAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*DenyAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DenyAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CancelAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccessRequestRequest) Reset() {
+	*x = CancelAccessRequestRequest{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccessRequestRequest) ProtoMessage() {}
+
+func (x *CancelAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CancelAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccessRequestResponse) Reset() {
+	*x = CancelAccessRequestResponse{}
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccessRequestResponse) ProtoMessage() {}
+
+func (x *CancelAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_access_request_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_access_request_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+var File_iam_v1_iam_access_request_proto protoreflect.FileDescriptor
+
+const file_iam_v1_iam_access_request_proto_rawDesc = "" +
+	"\n" +
+	"\x1fiam/v1/iam_access_request.proto\x12\x03iam\"\x81\x02\n" +
+	"\x10RoleAccessPolicy\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\x12*\n" +
+	"\x11approver_group_id\x18\x02 \x01(\x04R\x0fapproverGroupId\x124\n" +
+	"\x16approver_platform_role\x18\x03 \x01(\tR\x14approverPlatformRole\x120\n" +
+	"\x14max_duration_seconds\x18\x04 \x01(\x03R\x12maxDurationSeconds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xd1\x03\n" +
+	"\rAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11requester_user_id\x18\x02 \x01(\x04R\x0frequesterUserId\x12\x1b\n" +
+	"\trole_name\x18\x03 \x01(\tR\broleName\x12\x1d\n" +
+	"\n" +
+	"role_scope\x18\x04 \x01(\tR\troleScope\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12$\n" +
+	"\rjustification\x18\x06 \x01(\tR\rjustification\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1b\n" +
+	"\tstarts_at\x18\b \x01(\tR\bstartsAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12+\n" +
+	"\x12decided_by_user_id\x18\n" +
+	" \x01(\x04R\x0fdecidedByUserId\x12'\n" +
+	"\x0fdecision_reason\x18\v \x01(\tR\x0edecisionReason\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\f \x01(\tR\tdecidedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"\xcd\x01\n" +
+	"\x1aPutRoleAccessPolicyRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\x12*\n" +
+	"\x11approver_group_id\x18\x02 \x01(\x04R\x0fapproverGroupId\x124\n" +
+	"\x16approver_platform_role\x18\x03 \x01(\tR\x14approverPlatformRole\x120\n" +
+	"\x14max_duration_seconds\x18\x04 \x01(\x03R\x12maxDurationSeconds\"L\n" +
+	"\x1bPutRoleAccessPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.iam.RoleAccessPolicyR\x06policy\"9\n" +
+	"\x1aGetRoleAccessPolicyRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\"L\n" +
+	"\x1bGetRoleAccessPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.iam.RoleAccessPolicyR\x06policy\"<\n" +
+	"\x1dDeleteRoleAccessPolicyRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\" \n" +
+	"\x1eDeleteRoleAccessPolicyResponse\"\xc4\x01\n" +
+	"\x1aCreateAccessRequestRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12$\n" +
+	"\rjustification\x18\x03 \x01(\tR\rjustification\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\"K\n" +
+	"\x1bCreateAccessRequestResponse\x12,\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.iam.AccessRequestR\arequest\"8\n" +
+	"\x17GetAccessRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"H\n" +
+	"\x18GetAccessRequestResponse\x12,\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.iam.AccessRequestR\arequest\"d\n" +
+	"\x19ListAccessRequestsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04mine\x18\x03 \x01(\bR\x04mine\"L\n" +
+	"\x1aListAccessRequestsResponse\x12.\n" +
+	"\brequests\x18\x01 \x03(\v2\x12.iam.AccessRequestR\brequests\"T\n" +
+	"\x1bApproveAccessRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"L\n" +
+	"\x1cApproveAccessRequestResponse\x12,\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.iam.AccessRequestR\arequest\"Q\n" +
+	"\x18DenyAccessRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"I\n" +
+	"\x19DenyAccessRequestResponse\x12,\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.iam.AccessRequestR\arequest\";\n" +
+	"\x1aCancelAccessRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"K\n" +
+	"\x1bCancelAccessRequestResponse\x12,\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.iam.AccessRequestR\arequestB:Z8github.com/tuannm99/podzone/pkg/api/proto/iam/v1;pbiamv1b\x06proto3"
+
+var (
+	file_iam_v1_iam_access_request_proto_rawDescOnce sync.Once
+	file_iam_v1_iam_access_request_proto_rawDescData []byte
+)
+
+func file_iam_v1_iam_access_request_proto_rawDescGZIP() []byte {
+	file_iam_v1_iam_access_request_proto_rawDescOnce.Do(func() {
+		file_iam_v1_iam_access_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iam_v1_iam_access_request_proto_rawDesc), len(file_iam_v1_iam_access_request_proto_rawDesc)))
+	})
+	return file_iam_v1_iam_access_request_proto_rawDescData
+}
+
+var file_iam_v1_iam_access_request_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_iam_v1_iam_access_request_proto_goTypes = []any{
+	(*RoleAccessPolicy)(nil),               // 0: iam.RoleAccessPolicy
+	(*AccessRequest)(nil),                  // 1: iam.AccessRequest
+	(*PutRoleAccessPolicyRequest)(nil),     // 2: iam.PutRoleAccessPolicyRequest
+	(*PutRoleAccessPolicyResponse)(nil),    // 3: iam.PutRoleAccessPolicyResponse
+	(*GetRoleAccessPolicyRequest)(nil),     // 4: iam.GetRoleAccessPolicyRequest
+	(*GetRoleAccessPolicyResponse)(nil),    // 5: iam.GetRoleAccessPolicyResponse
+	(*DeleteRoleAccessPolicyRequest)(nil),  // 6: iam.DeleteRoleAccessPolicyRequest
+	(*DeleteRoleAccessPolicyResponse)(nil), // 7: iam.DeleteRoleAccessPolicyResponse
+	(*CreateAccessRequestRequest)(nil),     // 8: iam.CreateAccessRequestRequest
+	(*CreateAccessRequestResponse)(nil),    // 9: iam.CreateAccessRequestResponse
+	(*GetAccessRequestRequest)(nil),        // 10: iam.GetAccessRequestRequest
+	(*GetAccessRequestResponse)(nil),       // 11: iam.GetAccessRequestResponse
+	(*ListAccessRequestsRequest)(nil),      // 12: iam.ListAccessRequestsRequest
+	(*ListAccessRequestsResponse)(nil),     // 13: iam.ListAccessRequestsResponse
+	(*ApproveAccessRequestRequest)(nil),    // 14: iam.ApproveAccessRequestRequest
+	(*ApproveAccessRequestResponse)(nil),   // 15: iam.ApproveAccessRequestResponse
+	(*DenyAccessRequestRequest)(nil),       // 16: iam.DenyAccessRequestRequest
+	(*DenyAccessRequestResponse)(nil),      // 17: iam.DenyAccessRequestResponse
+	(*CancelAccessRequestRequest)(nil),     // 18: iam.CancelAccessRequestRequest
+	(*CancelAccessRequestResponse)(nil),    // 19: iam.CancelAccessRequestResponse
+}
+var file_iam_v1_iam_access_request_proto_depIdxs = []int32{
+	0, // 0: iam.PutRoleAccessPolicyResponse.policy:type_name -> iam.RoleAccessPolicy
+	0, // 1: iam.GetRoleAccessPolicyResponse.policy:type_name -> iam.RoleAccessPolicy
+	1, // 2: iam.CreateAccessRequestResponse.request:type_name -> iam.AccessRequest
+	1, // 3: iam.GetAccessRequestResponse.request:type_name -> iam.AccessRequest
+	1, // 4: iam.ListAccessRequestsResponse.requests:type_name -> iam.AccessRequest
+	1, // 5: iam.ApproveAccessRequestResponse.request:type_name -> iam.AccessRequest
+	1, // 6: iam.DenyAccessRequestResponse.request:type_name -> iam.AccessRequest
+	1, // 7: iam.CancelAccessRequestResponse.request:type_name -> iam.AccessRequest
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_iam_v1_iam_access_request_proto_init() }
+func file_iam_v1_iam_access_request_proto_init() {
+	if File_iam_v1_iam_access_request_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iam_v1_iam_access_request_proto_rawDesc), len(file_iam_v1_iam_access_request_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iam_v1_iam_access_request_proto_goTypes,
+		DependencyIndexes: file_iam_v1_iam_access_request_proto_depIdxs,
+		MessageInfos:      file_iam_v1_iam_access_request_proto_msgTypes,
+	}.Build()
+	File_iam_v1_iam_access_request_proto = out.File
+	file_iam_v1_iam_access_request_proto_goTypes = nil
+	file_iam_v1_iam_access_request_proto_depIdxs = nil
+}
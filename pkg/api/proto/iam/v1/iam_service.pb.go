@@ -25,7 +25,7 @@ var File_iam_v1_iam_service_proto protoreflect.FileDescriptor
 
 const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18iam/v1/iam_service.proto\x12\x03iam\x1a\x17iam/v1/iam_policy.proto\x1a\x1biam/v1/iam_simulation.proto\x1a\x17iam/v1/iam_tenant.proto\x1a\x1cgoogle/api/annotations.proto2\xbc^\n" +
+	"\x18iam/v1/iam_service.proto\x12\x03iam\x1a iam/v1/iam_access_analyzer.proto\x1a\x1fiam/v1/iam_access_request.proto\x1a\x17iam/v1/iam_policy.proto\x1a\x1biam/v1/iam_simulation.proto\x1a\x17iam/v1/iam_tenant.proto\x1a\x1cgoogle/api/annotations.proto2\xe0u\n" +
 	"\n" +
 	"IAMService\x12h\n" +
 	"\n" +
@@ -35,7 +35,13 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x11ListOrganizations\x12\x1d.iam.ListOrganizationsRequest\x1a\x1e.iam.ListOrganizationsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/auth/v1/iam/organizations\x12\x96\x01\n" +
 	"\x15AddOrganizationMember\x12!.iam.AddOrganizationMemberRequest\x1a\".iam.AddOrganizationMemberResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/auth/v1/iam/organizations/{org_id}/members\x12\xa6\x01\n" +
 	"\x18RemoveOrganizationMember\x12$.iam.RemoveOrganizationMemberRequest\x1a%.iam.RemoveOrganizationMemberResponse\"=\x82\xd3\xe4\x93\x027*5/auth/v1/iam/organizations/{org_id}/members/{user_id}\x12\x99\x01\n" +
-	"\x17ListOrganizationMembers\x12#.iam.ListOrganizationMembersRequest\x1a$.iam.ListOrganizationMembersResponse\"3\x82\xd3\xe4\x93\x02-\x12+/auth/v1/iam/organizations/{org_id}/members\x12\xb1\x01\n" +
+	"\x17ListOrganizationMembers\x12#.iam.ListOrganizationMembersRequest\x1a$.iam.ListOrganizationMembersResponse\"3\x82\xd3\xe4\x93\x02-\x12+/auth/v1/iam/organizations/{org_id}/members\x12\x88\x01\n" +
+	"\x0fCreateSCIMToken\x12\x1b.iam.CreateSCIMTokenRequest\x1a\x1c.iam.CreateSCIMTokenResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//auth/v1/iam/organizations/{org_id}/scim-tokens\x12\x82\x01\n" +
+	"\x0eListSCIMTokens\x12\x1a.iam.ListSCIMTokensRequest\x1a\x1b.iam.ListSCIMTokensResponse\"7\x82\xd3\xe4\x93\x021\x12//auth/v1/iam/organizations/{org_id}/scim-tokens\x12\x90\x01\n" +
+	"\x0fRevokeSCIMToken\x12\x1b.iam.RevokeSCIMTokenRequest\x1a\x1c.iam.RevokeSCIMTokenResponse\"B\x82\xd3\xe4\x93\x02<*:/auth/v1/iam/organizations/{org_id}/scim-tokens/{token_id}\x12\xab\x01\n" +
+	"\x1dPutOrganizationSAMLConnection\x12).iam.PutOrganizationSAMLConnectionRequest\x1a*.iam.PutOrganizationSAMLConnectionResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/auth/v1/iam/organizations/{org_id}/saml\x12\xa8\x01\n" +
+	"\x1dGetOrganizationSAMLConnection\x12).iam.GetOrganizationSAMLConnectionRequest\x1a*.iam.GetOrganizationSAMLConnectionResponse\"0\x82\xd3\xe4\x93\x02*\x12(/auth/v1/iam/organizations/{org_id}/saml\x12\xb1\x01\n" +
+	" DeleteOrganizationSAMLConnection\x12,.iam.DeleteOrganizationSAMLConnectionRequest\x1a-.iam.DeleteOrganizationSAMLConnectionResponse\"0\x82\xd3\xe4\x93\x02**(/auth/v1/iam/organizations/{org_id}/saml\x12\xb1\x01\n" +
 	"\x1aAttachTenantToOrganization\x12&.iam.AttachTenantToOrganizationRequest\x1a'.iam.AttachTenantToOrganizationResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/auth/v1/iam/organizations/{org_id}/tenants/{tenant_id}\x12\xb4\x01\n" +
 	"\x1cDetachTenantFromOrganization\x12(.iam.DetachTenantFromOrganizationRequest\x1a).iam.DetachTenantFromOrganizationResponse\"?\x82\xd3\xe4\x93\x029*7/auth/v1/iam/organizations/{org_id}/tenants/{tenant_id}\x12\xb6\x01\n" +
 	"\x1aAttachServiceControlPolicy\x12&.iam.AttachServiceControlPolicyRequest\x1a'.iam.AttachServiceControlPolicyResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\"</auth/v1/iam/organizations/{org_id}/service-control-policies\x12\xc1\x01\n" +
@@ -63,7 +69,8 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x13DeletePolicyVersion\x12\x1f.iam.DeletePolicyVersionRequest\x1a .iam.DeletePolicyVersionResponse\":\x82\xd3\xe4\x93\x024*2/auth/v1/iam/policies/{name=**}/versions/{version}\x12b\n" +
 	"\fListPolicies\x12\x18.iam.ListPoliciesRequest\x1a\x19.iam.ListPoliciesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/auth/v1/iam/policies\x12\x93\x01\n" +
 	"\x15ListPolicyAttachments\x12!.iam.ListPolicyAttachmentsRequest\x1a\".iam.ListPolicyAttachmentsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/auth/v1/iam/policies/{name=**}/attachments\x12l\n" +
-	"\fDeletePolicy\x12\x18.iam.DeletePolicyRequest\x1a\x19.iam.DeletePolicyResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/auth/v1/iam/policies/{name=**}\x12`\n" +
+	"\fDeletePolicy\x12\x18.iam.DeletePolicyRequest\x1a\x19.iam.DeletePolicyResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/auth/v1/iam/policies/{name=**}\x12t\n" +
+	"\x0eValidatePolicy\x12\x1a.iam.ValidatePolicyRequest\x1a\x1b.iam.ValidatePolicyResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/auth/v1/iam/policies:validate\x12`\n" +
 	"\vCreateGroup\x12\x17.iam.CreateGroupRequest\x1a\x18.iam.CreateGroupResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/auth/v1/iam/groups\x12Z\n" +
 	"\n" +
 	"ListGroups\x12\x16.iam.ListGroupsRequest\x1a\x17.iam.ListGroupsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/auth/v1/iam/groups\x12h\n" +
@@ -106,8 +113,21 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x15DeleteRoleTrustPolicy\x12!.iam.DeleteRoleTrustPolicyRequest\x1a\".iam.DeleteRoleTrustPolicyResponse\"3\x82\xd3\xe4\x93\x02-*+/auth/v1/iam/roles/{role_name}/trust-policy\x12\xa9\x01\n" +
 	"\x19PutRolePermissionBoundary\x12%.iam.PutRolePermissionBoundaryRequest\x1a&.iam.PutRolePermissionBoundaryResponse\"=\x82\xd3\xe4\x93\x027:\x01*\x1a2/auth/v1/iam/roles/{role_name}/permission-boundary\x12\xa6\x01\n" +
 	"\x19GetRolePermissionBoundary\x12%.iam.GetRolePermissionBoundaryRequest\x1a&.iam.GetRolePermissionBoundaryResponse\":\x82\xd3\xe4\x93\x024\x122/auth/v1/iam/roles/{role_name}/permission-boundary\x12\xaf\x01\n" +
-	"\x1cDeleteRolePermissionBoundary\x12(.iam.DeleteRolePermissionBoundaryRequest\x1a).iam.DeleteRolePermissionBoundaryResponse\":\x82\xd3\xe4\x93\x024*2/auth/v1/iam/roles/{role_name}/permission-boundary\x12r\n" +
-	"\x0eSimulateAccess\x12\x1a.iam.SimulateAccessRequest\x1a\x1b.iam.SimulateAccessResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/auth/v1/iam/access:simulate2\xe6#\n" +
+	"\x1cDeleteRolePermissionBoundary\x12(.iam.DeleteRolePermissionBoundaryRequest\x1a).iam.DeleteRolePermissionBoundaryResponse\":\x82\xd3\xe4\x93\x024*2/auth/v1/iam/roles/{role_name}/permission-boundary\x12\x91\x01\n" +
+	"\x13PutRoleAccessPolicy\x12\x1f.iam.PutRoleAccessPolicyRequest\x1a .iam.PutRoleAccessPolicyResponse\"7\x82\xd3\xe4\x93\x021:\x01*\x1a,/auth/v1/iam/roles/{role_name}/access-policy\x12\x8e\x01\n" +
+	"\x13GetRoleAccessPolicy\x12\x1f.iam.GetRoleAccessPolicyRequest\x1a .iam.GetRoleAccessPolicyResponse\"4\x82\xd3\xe4\x93\x02.\x12,/auth/v1/iam/roles/{role_name}/access-policy\x12\x97\x01\n" +
+	"\x16DeleteRoleAccessPolicy\x12\".iam.DeleteRoleAccessPolicyRequest\x1a#.iam.DeleteRoleAccessPolicyResponse\"4\x82\xd3\xe4\x93\x02.*,/auth/v1/iam/roles/{role_name}/access-policy\x12\x81\x01\n" +
+	"\x13CreateAccessRequest\x12\x1f.iam.CreateAccessRequestRequest\x1a .iam.CreateAccessRequestResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/auth/v1/iam/access-requests\x12\x82\x01\n" +
+	"\x10GetAccessRequest\x12\x1c.iam.GetAccessRequestRequest\x1a\x1d.iam.GetAccessRequestResponse\"1\x82\xd3\xe4\x93\x02+\x12)/auth/v1/iam/access-requests/{request_id}\x12{\n" +
+	"\x12ListAccessRequests\x12\x1e.iam.ListAccessRequestsRequest\x1a\x1f.iam.ListAccessRequestsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/auth/v1/iam/access-requests\x12\x99\x01\n" +
+	"\x14ApproveAccessRequest\x12 .iam.ApproveAccessRequestRequest\x1a!.iam.ApproveAccessRequestResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/auth/v1/iam/access-requests/{request_id}:approve\x12\x8d\x01\n" +
+	"\x11DenyAccessRequest\x12\x1d.iam.DenyAccessRequestRequest\x1a\x1e.iam.DenyAccessRequestResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./auth/v1/iam/access-requests/{request_id}:deny\x12\x95\x01\n" +
+	"\x13CancelAccessRequest\x12\x1f.iam.CancelAccessRequestRequest\x1a .iam.CancelAccessRequestResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/auth/v1/iam/access-requests/{request_id}:cancel\x12r\n" +
+	"\x0eSimulateAccess\x12\x1a.iam.SimulateAccessRequest\x1a\x1b.iam.SimulateAccessResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/auth/v1/iam/access:simulate\x12\x97\x01\n" +
+	"\x15ListUnusedPermissions\x12!.iam.ListUnusedPermissionsRequest\x1a\".iam.ListUnusedPermissionsResponse\"7\x82\xd3\xe4\x93\x021\x12//auth/v1/iam/access-analyzer/unused-permissions\x12\x7f\n" +
+	"\x0fListUnusedRoles\x12\x1b.iam.ListUnusedRolesRequest\x1a\x1c.iam.ListUnusedRolesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/auth/v1/iam/access-analyzer/unused-roles\x12\xa8\x01\n" +
+	"\x19ListStaleGroupMemberships\x12%.iam.ListStaleGroupMembershipsRequest\x1a&.iam.ListStaleGroupMembershipsResponse\"<\x82\xd3\xe4\x93\x026\x124/auth/v1/iam/access-analyzer/stale-group-memberships\x12\xac\x01\n" +
+	"\x1cGenerateLeastPrivilegePolicy\x12(.iam.GenerateLeastPrivilegePolicyRequest\x1a).iam.GenerateLeastPrivilegePolicyResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/auth/v1/iam/access-analyzer:generate-policy2\xe8+\n" +
 	"\x11IAMCommandService\x12C\n" +
 	"\n" +
 	"AssumeRole\x12\x19.iam.IAMAssumeRoleRequest\x1a\x1a.iam.IAMAssumeRoleResponse\x12C\n" +
@@ -115,7 +135,12 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x12CreateOrganization\x12\x1e.iam.CreateOrganizationRequest\x1a\x1f.iam.CreateOrganizationResponse\x12a\n" +
 	"\x16EnsureRootOrganization\x12\".iam.EnsureRootOrganizationRequest\x1a#.iam.EnsureRootOrganizationResponse\x12^\n" +
 	"\x15AddOrganizationMember\x12!.iam.AddOrganizationMemberRequest\x1a\".iam.AddOrganizationMemberResponse\x12g\n" +
-	"\x18RemoveOrganizationMember\x12$.iam.RemoveOrganizationMemberRequest\x1a%.iam.RemoveOrganizationMemberResponse\x12m\n" +
+	"\x18RemoveOrganizationMember\x12$.iam.RemoveOrganizationMemberRequest\x1a%.iam.RemoveOrganizationMemberResponse\x12L\n" +
+	"\x0fCreateSCIMToken\x12\x1b.iam.CreateSCIMTokenRequest\x1a\x1c.iam.CreateSCIMTokenResponse\x12L\n" +
+	"\x0fRevokeSCIMToken\x12\x1b.iam.RevokeSCIMTokenRequest\x1a\x1c.iam.RevokeSCIMTokenResponse\x12v\n" +
+	"\x1dPutOrganizationSAMLConnection\x12).iam.PutOrganizationSAMLConnectionRequest\x1a*.iam.PutOrganizationSAMLConnectionResponse\x12\x7f\n" +
+	" DeleteOrganizationSAMLConnection\x12,.iam.DeleteOrganizationSAMLConnectionRequest\x1a-.iam.DeleteOrganizationSAMLConnectionResponse\x12I\n" +
+	"\x0eApplySAMLLogin\x12\x1a.iam.ApplySAMLLoginRequest\x1a\x1b.iam.ApplySAMLLoginResponse\x12m\n" +
 	"\x1aAttachTenantToOrganization\x12&.iam.AttachTenantToOrganizationRequest\x1a'.iam.AttachTenantToOrganizationResponse\x12s\n" +
 	"\x1cDetachTenantFromOrganization\x12(.iam.DetachTenantFromOrganizationRequest\x1a).iam.DetachTenantFromOrganizationResponse\x12m\n" +
 	"\x1aAttachServiceControlPolicy\x12&.iam.AttachServiceControlPolicyRequest\x1a'.iam.AttachServiceControlPolicyResponse\x12m\n" +
@@ -156,10 +181,21 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x12PutRoleTrustPolicy\x12\x1e.iam.PutRoleTrustPolicyRequest\x1a\x1f.iam.PutRoleTrustPolicyResponse\x12^\n" +
 	"\x15DeleteRoleTrustPolicy\x12!.iam.DeleteRoleTrustPolicyRequest\x1a\".iam.DeleteRoleTrustPolicyResponse\x12j\n" +
 	"\x19PutRolePermissionBoundary\x12%.iam.PutRolePermissionBoundaryRequest\x1a&.iam.PutRolePermissionBoundaryResponse\x12s\n" +
-	"\x1cDeleteRolePermissionBoundary\x12(.iam.DeleteRolePermissionBoundaryRequest\x1a).iam.DeleteRolePermissionBoundaryResponse2\xb9\x17\n" +
+	"\x1cDeleteRolePermissionBoundary\x12(.iam.DeleteRolePermissionBoundaryRequest\x1a).iam.DeleteRolePermissionBoundaryResponse\x12X\n" +
+	"\x13PutRoleAccessPolicy\x12\x1f.iam.PutRoleAccessPolicyRequest\x1a .iam.PutRoleAccessPolicyResponse\x12a\n" +
+	"\x16DeleteRoleAccessPolicy\x12\".iam.DeleteRoleAccessPolicyRequest\x1a#.iam.DeleteRoleAccessPolicyResponse\x12X\n" +
+	"\x13CreateAccessRequest\x12\x1f.iam.CreateAccessRequestRequest\x1a .iam.CreateAccessRequestResponse\x12[\n" +
+	"\x14ApproveAccessRequest\x12 .iam.ApproveAccessRequestRequest\x1a!.iam.ApproveAccessRequestResponse\x12R\n" +
+	"\x11DenyAccessRequest\x12\x1d.iam.DenyAccessRequestRequest\x1a\x1e.iam.DenyAccessRequestResponse\x12X\n" +
+	"\x13CancelAccessRequest\x12\x1f.iam.CancelAccessRequestRequest\x1a .iam.CancelAccessRequestResponse2\x99!\n" +
 	"\x0fIAMQueryService\x12R\n" +
 	"\x11ListOrganizations\x12\x1d.iam.ListOrganizationsRequest\x1a\x1e.iam.ListOrganizationsResponse\x12d\n" +
-	"\x17ListOrganizationMembers\x12#.iam.ListOrganizationMembersRequest\x1a$.iam.ListOrganizationMembersResponse\x12m\n" +
+	"\x17ListOrganizationMembers\x12#.iam.ListOrganizationMembersRequest\x1a$.iam.ListOrganizationMembersResponse\x12I\n" +
+	"\x0eListSCIMTokens\x12\x1a.iam.ListSCIMTokensRequest\x1a\x1b.iam.ListSCIMTokensResponse\x12v\n" +
+	"\x1dGetOrganizationSAMLConnection\x12).iam.GetOrganizationSAMLConnectionRequest\x1a*.iam.GetOrganizationSAMLConnectionResponse\x12^\n" +
+	"\x15ResolveSAMLConnection\x12!.iam.ResolveSAMLConnectionRequest\x1a\".iam.ResolveSAMLConnectionResponse\x12s\n" +
+	"\x1cListSSORequiredOrganizations\x12(.iam.ListSSORequiredOrganizationsRequest\x1a).iam.ListSSORequiredOrganizationsResponse\x12j\n" +
+	"\x19GetOrganizationMembership\x12%.iam.GetOrganizationMembershipRequest\x1a&.iam.GetOrganizationMembershipResponse\x12m\n" +
 	"\x1aListServiceControlPolicies\x12&.iam.ListServiceControlPoliciesRequest\x1a'.iam.ListServiceControlPoliciesResponse\x12R\n" +
 	"\x11ListTenantInvites\x12\x1d.iam.ListTenantInvitesRequest\x1a\x1e.iam.ListTenantInvitesResponse\x12X\n" +
 	"\x13GetTenantMembership\x12\x1f.iam.GetTenantMembershipRequest\x1a .iam.GetTenantMembershipResponse\x12L\n" +
@@ -172,7 +208,8 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\tGetPolicy\x12\x15.iam.GetPolicyRequest\x1a\x16.iam.GetPolicyResponse\x12U\n" +
 	"\x12ListPolicyVersions\x12\x1e.iam.ListPolicyVersionsRequest\x1a\x1f.iam.ListPolicyVersionsResponse\x12C\n" +
 	"\fListPolicies\x12\x18.iam.ListPoliciesRequest\x1a\x19.iam.ListPoliciesResponse\x12^\n" +
-	"\x15ListPolicyAttachments\x12!.iam.ListPolicyAttachmentsRequest\x1a\".iam.ListPolicyAttachmentsResponse\x12=\n" +
+	"\x15ListPolicyAttachments\x12!.iam.ListPolicyAttachmentsRequest\x1a\".iam.ListPolicyAttachmentsResponse\x12I\n" +
+	"\x0eValidatePolicy\x12\x1a.iam.ValidatePolicyRequest\x1a\x1b.iam.ValidatePolicyResponse\x12=\n" +
 	"\n" +
 	"ListGroups\x12\x16.iam.ListGroupsRequest\x1a\x17.iam.ListGroupsResponse\x12O\n" +
 	"\x10ListGroupMembers\x12\x1c.iam.ListGroupMembersRequest\x1a\x1d.iam.ListGroupMembersResponse\x12R\n" +
@@ -189,8 +226,15 @@ const file_iam_v1_iam_service_proto_rawDesc = "" +
 	"\x1cListTenantUserInlinePolicies\x12(.iam.ListTenantUserInlinePoliciesRequest\x1a).iam.ListTenantUserInlinePoliciesResponse\x12|\n" +
 	"\x1fGetTenantUserPermissionBoundary\x12+.iam.GetTenantUserPermissionBoundaryRequest\x1a,.iam.GetTenantUserPermissionBoundaryResponse\x12U\n" +
 	"\x12GetRoleTrustPolicy\x12\x1e.iam.GetRoleTrustPolicyRequest\x1a\x1f.iam.GetRoleTrustPolicyResponse\x12j\n" +
-	"\x19GetRolePermissionBoundary\x12%.iam.GetRolePermissionBoundaryRequest\x1a&.iam.GetRolePermissionBoundaryResponse\x12I\n" +
-	"\x0eSimulateAccess\x12\x1a.iam.SimulateAccessRequest\x1a\x1b.iam.SimulateAccessResponseB:Z8github.com/tuannm99/podzone/pkg/api/proto/iam/v1;pbiamv1b\x06proto3"
+	"\x19GetRolePermissionBoundary\x12%.iam.GetRolePermissionBoundaryRequest\x1a&.iam.GetRolePermissionBoundaryResponse\x12X\n" +
+	"\x13GetRoleAccessPolicy\x12\x1f.iam.GetRoleAccessPolicyRequest\x1a .iam.GetRoleAccessPolicyResponse\x12O\n" +
+	"\x10GetAccessRequest\x12\x1c.iam.GetAccessRequestRequest\x1a\x1d.iam.GetAccessRequestResponse\x12U\n" +
+	"\x12ListAccessRequests\x12\x1e.iam.ListAccessRequestsRequest\x1a\x1f.iam.ListAccessRequestsResponse\x12I\n" +
+	"\x0eSimulateAccess\x12\x1a.iam.SimulateAccessRequest\x1a\x1b.iam.SimulateAccessResponse\x12^\n" +
+	"\x15ListUnusedPermissions\x12!.iam.ListUnusedPermissionsRequest\x1a\".iam.ListUnusedPermissionsResponse\x12L\n" +
+	"\x0fListUnusedRoles\x12\x1b.iam.ListUnusedRolesRequest\x1a\x1c.iam.ListUnusedRolesResponse\x12j\n" +
+	"\x19ListStaleGroupMemberships\x12%.iam.ListStaleGroupMembershipsRequest\x1a&.iam.ListStaleGroupMembershipsResponse\x12s\n" +
+	"\x1cGenerateLeastPrivilegePolicy\x12(.iam.GenerateLeastPrivilegePolicyRequest\x1a).iam.GenerateLeastPrivilegePolicyResponseB:Z8github.com/tuannm99/podzone/pkg/api/proto/iam/v1;pbiamv1b\x06proto3"
 
 var file_iam_v1_iam_service_proto_goTypes = []any{
 	(*IAMAssumeRoleRequest)(nil),                         // 0: iam.IAMAssumeRoleRequest
@@ -200,156 +244,204 @@ var file_iam_v1_iam_service_proto_goTypes = []any{
 	(*AddOrganizationMemberRequest)(nil),                 // 4: iam.AddOrganizationMemberRequest
 	(*RemoveOrganizationMemberRequest)(nil),              // 5: iam.RemoveOrganizationMemberRequest
 	(*ListOrganizationMembersRequest)(nil),               // 6: iam.ListOrganizationMembersRequest
-	(*AttachTenantToOrganizationRequest)(nil),            // 7: iam.AttachTenantToOrganizationRequest
-	(*DetachTenantFromOrganizationRequest)(nil),          // 8: iam.DetachTenantFromOrganizationRequest
-	(*AttachServiceControlPolicyRequest)(nil),            // 9: iam.AttachServiceControlPolicyRequest
-	(*DetachServiceControlPolicyRequest)(nil),            // 10: iam.DetachServiceControlPolicyRequest
-	(*ListServiceControlPoliciesRequest)(nil),            // 11: iam.ListServiceControlPoliciesRequest
-	(*AddTenantMemberRequest)(nil),                       // 12: iam.AddTenantMemberRequest
-	(*AddTenantMemberByIdentityRequest)(nil),             // 13: iam.AddTenantMemberByIdentityRequest
-	(*CreateTenantInviteRequest)(nil),                    // 14: iam.CreateTenantInviteRequest
-	(*ListTenantInvitesRequest)(nil),                     // 15: iam.ListTenantInvitesRequest
-	(*RevokeTenantInviteRequest)(nil),                    // 16: iam.RevokeTenantInviteRequest
-	(*AcceptTenantInviteRequest)(nil),                    // 17: iam.AcceptTenantInviteRequest
-	(*GetTenantMembershipRequest)(nil),                   // 18: iam.GetTenantMembershipRequest
-	(*CheckPermissionRequest)(nil),                       // 19: iam.CheckPermissionRequest
-	(*CheckPlatformPermissionRequest)(nil),               // 20: iam.CheckPlatformPermissionRequest
-	(*ListUserTenantsRequest)(nil),                       // 21: iam.ListUserTenantsRequest
-	(*ListPlatformRolesRequest)(nil),                     // 22: iam.ListPlatformRolesRequest
-	(*ListDirectoryUsersRequest)(nil),                    // 23: iam.ListDirectoryUsersRequest
-	(*ListPermissionsRequest)(nil),                       // 24: iam.ListPermissionsRequest
-	(*AddPlatformRoleRequest)(nil),                       // 25: iam.AddPlatformRoleRequest
-	(*CreatePolicyRequest)(nil),                          // 26: iam.CreatePolicyRequest
-	(*CreatePolicyVersionRequest)(nil),                   // 27: iam.CreatePolicyVersionRequest
-	(*GetPolicyRequest)(nil),                             // 28: iam.GetPolicyRequest
-	(*ListPolicyVersionsRequest)(nil),                    // 29: iam.ListPolicyVersionsRequest
-	(*SetDefaultPolicyVersionRequest)(nil),               // 30: iam.SetDefaultPolicyVersionRequest
-	(*DeletePolicyVersionRequest)(nil),                   // 31: iam.DeletePolicyVersionRequest
-	(*ListPoliciesRequest)(nil),                          // 32: iam.ListPoliciesRequest
-	(*ListPolicyAttachmentsRequest)(nil),                 // 33: iam.ListPolicyAttachmentsRequest
-	(*DeletePolicyRequest)(nil),                          // 34: iam.DeletePolicyRequest
-	(*CreateGroupRequest)(nil),                           // 35: iam.CreateGroupRequest
-	(*ListGroupsRequest)(nil),                            // 36: iam.ListGroupsRequest
-	(*DeleteGroupRequest)(nil),                           // 37: iam.DeleteGroupRequest
-	(*AddGroupMemberRequest)(nil),                        // 38: iam.AddGroupMemberRequest
-	(*ListGroupMembersRequest)(nil),                      // 39: iam.ListGroupMembersRequest
-	(*RemoveGroupMemberRequest)(nil),                     // 40: iam.RemoveGroupMemberRequest
-	(*AttachGroupPolicyRequest)(nil),                     // 41: iam.AttachGroupPolicyRequest
-	(*ListGroupPoliciesRequest)(nil),                     // 42: iam.ListGroupPoliciesRequest
-	(*DetachGroupPolicyRequest)(nil),                     // 43: iam.DetachGroupPolicyRequest
-	(*PutGroupInlinePolicyRequest)(nil),                  // 44: iam.PutGroupInlinePolicyRequest
-	(*GetGroupInlinePolicyRequest)(nil),                  // 45: iam.GetGroupInlinePolicyRequest
-	(*ListGroupInlinePoliciesRequest)(nil),               // 46: iam.ListGroupInlinePoliciesRequest
-	(*DeleteGroupInlinePolicyRequest)(nil),               // 47: iam.DeleteGroupInlinePolicyRequest
-	(*PutPlatformUserInlinePolicyRequest)(nil),           // 48: iam.PutPlatformUserInlinePolicyRequest
-	(*GetPlatformUserInlinePolicyRequest)(nil),           // 49: iam.GetPlatformUserInlinePolicyRequest
-	(*ListPlatformUserInlinePoliciesRequest)(nil),        // 50: iam.ListPlatformUserInlinePoliciesRequest
-	(*DeletePlatformUserInlinePolicyRequest)(nil),        // 51: iam.DeletePlatformUserInlinePolicyRequest
-	(*ListPlatformUserPoliciesRequest)(nil),              // 52: iam.ListPlatformUserPoliciesRequest
-	(*AttachPlatformUserPolicyRequest)(nil),              // 53: iam.AttachPlatformUserPolicyRequest
-	(*DetachPlatformUserPolicyRequest)(nil),              // 54: iam.DetachPlatformUserPolicyRequest
-	(*PutPlatformUserPermissionBoundaryRequest)(nil),     // 55: iam.PutPlatformUserPermissionBoundaryRequest
-	(*GetPlatformUserPermissionBoundaryRequest)(nil),     // 56: iam.GetPlatformUserPermissionBoundaryRequest
-	(*DeletePlatformUserPermissionBoundaryRequest)(nil),  // 57: iam.DeletePlatformUserPermissionBoundaryRequest
-	(*RemovePlatformRoleRequest)(nil),                    // 58: iam.RemovePlatformRoleRequest
-	(*ListTenantMembersRequest)(nil),                     // 59: iam.ListTenantMembersRequest
-	(*RemoveTenantMemberRequest)(nil),                    // 60: iam.RemoveTenantMemberRequest
-	(*PutTenantUserInlinePolicyRequest)(nil),             // 61: iam.PutTenantUserInlinePolicyRequest
-	(*GetTenantUserInlinePolicyRequest)(nil),             // 62: iam.GetTenantUserInlinePolicyRequest
-	(*ListTenantUserInlinePoliciesRequest)(nil),          // 63: iam.ListTenantUserInlinePoliciesRequest
-	(*DeleteTenantUserInlinePolicyRequest)(nil),          // 64: iam.DeleteTenantUserInlinePolicyRequest
-	(*ListTenantUserPoliciesRequest)(nil),                // 65: iam.ListTenantUserPoliciesRequest
-	(*AttachTenantUserPolicyRequest)(nil),                // 66: iam.AttachTenantUserPolicyRequest
-	(*DetachTenantUserPolicyRequest)(nil),                // 67: iam.DetachTenantUserPolicyRequest
-	(*PutTenantUserPermissionBoundaryRequest)(nil),       // 68: iam.PutTenantUserPermissionBoundaryRequest
-	(*GetTenantUserPermissionBoundaryRequest)(nil),       // 69: iam.GetTenantUserPermissionBoundaryRequest
-	(*DeleteTenantUserPermissionBoundaryRequest)(nil),    // 70: iam.DeleteTenantUserPermissionBoundaryRequest
-	(*PutRoleTrustPolicyRequest)(nil),                    // 71: iam.PutRoleTrustPolicyRequest
-	(*GetRoleTrustPolicyRequest)(nil),                    // 72: iam.GetRoleTrustPolicyRequest
-	(*DeleteRoleTrustPolicyRequest)(nil),                 // 73: iam.DeleteRoleTrustPolicyRequest
-	(*PutRolePermissionBoundaryRequest)(nil),             // 74: iam.PutRolePermissionBoundaryRequest
-	(*GetRolePermissionBoundaryRequest)(nil),             // 75: iam.GetRolePermissionBoundaryRequest
-	(*DeleteRolePermissionBoundaryRequest)(nil),          // 76: iam.DeleteRolePermissionBoundaryRequest
-	(*SimulateAccessRequest)(nil),                        // 77: iam.SimulateAccessRequest
-	(*EnsureRootOrganizationRequest)(nil),                // 78: iam.EnsureRootOrganizationRequest
-	(*IAMAssumeRoleResponse)(nil),                        // 79: iam.IAMAssumeRoleResponse
-	(*CreateTenantResponse)(nil),                         // 80: iam.CreateTenantResponse
-	(*CreateOrganizationResponse)(nil),                   // 81: iam.CreateOrganizationResponse
-	(*ListOrganizationsResponse)(nil),                    // 82: iam.ListOrganizationsResponse
-	(*AddOrganizationMemberResponse)(nil),                // 83: iam.AddOrganizationMemberResponse
-	(*RemoveOrganizationMemberResponse)(nil),             // 84: iam.RemoveOrganizationMemberResponse
-	(*ListOrganizationMembersResponse)(nil),              // 85: iam.ListOrganizationMembersResponse
-	(*AttachTenantToOrganizationResponse)(nil),           // 86: iam.AttachTenantToOrganizationResponse
-	(*DetachTenantFromOrganizationResponse)(nil),         // 87: iam.DetachTenantFromOrganizationResponse
-	(*AttachServiceControlPolicyResponse)(nil),           // 88: iam.AttachServiceControlPolicyResponse
-	(*DetachServiceControlPolicyResponse)(nil),           // 89: iam.DetachServiceControlPolicyResponse
-	(*ListServiceControlPoliciesResponse)(nil),           // 90: iam.ListServiceControlPoliciesResponse
-	(*AddTenantMemberResponse)(nil),                      // 91: iam.AddTenantMemberResponse
-	(*AddTenantMemberByIdentityResponse)(nil),            // 92: iam.AddTenantMemberByIdentityResponse
-	(*CreateTenantInviteResponse)(nil),                   // 93: iam.CreateTenantInviteResponse
-	(*ListTenantInvitesResponse)(nil),                    // 94: iam.ListTenantInvitesResponse
-	(*RevokeTenantInviteResponse)(nil),                   // 95: iam.RevokeTenantInviteResponse
-	(*AcceptTenantInviteResponse)(nil),                   // 96: iam.AcceptTenantInviteResponse
-	(*GetTenantMembershipResponse)(nil),                  // 97: iam.GetTenantMembershipResponse
-	(*CheckPermissionResponse)(nil),                      // 98: iam.CheckPermissionResponse
-	(*ListUserTenantsResponse)(nil),                      // 99: iam.ListUserTenantsResponse
-	(*ListPlatformRolesResponse)(nil),                    // 100: iam.ListPlatformRolesResponse
-	(*ListDirectoryUsersResponse)(nil),                   // 101: iam.ListDirectoryUsersResponse
-	(*ListPermissionsResponse)(nil),                      // 102: iam.ListPermissionsResponse
-	(*AddPlatformRoleResponse)(nil),                      // 103: iam.AddPlatformRoleResponse
-	(*CreatePolicyResponse)(nil),                         // 104: iam.CreatePolicyResponse
-	(*CreatePolicyVersionResponse)(nil),                  // 105: iam.CreatePolicyVersionResponse
-	(*GetPolicyResponse)(nil),                            // 106: iam.GetPolicyResponse
-	(*ListPolicyVersionsResponse)(nil),                   // 107: iam.ListPolicyVersionsResponse
-	(*SetDefaultPolicyVersionResponse)(nil),              // 108: iam.SetDefaultPolicyVersionResponse
-	(*DeletePolicyVersionResponse)(nil),                  // 109: iam.DeletePolicyVersionResponse
-	(*ListPoliciesResponse)(nil),                         // 110: iam.ListPoliciesResponse
-	(*ListPolicyAttachmentsResponse)(nil),                // 111: iam.ListPolicyAttachmentsResponse
-	(*DeletePolicyResponse)(nil),                         // 112: iam.DeletePolicyResponse
-	(*CreateGroupResponse)(nil),                          // 113: iam.CreateGroupResponse
-	(*ListGroupsResponse)(nil),                           // 114: iam.ListGroupsResponse
-	(*DeleteGroupResponse)(nil),                          // 115: iam.DeleteGroupResponse
-	(*AddGroupMemberResponse)(nil),                       // 116: iam.AddGroupMemberResponse
-	(*ListGroupMembersResponse)(nil),                     // 117: iam.ListGroupMembersResponse
-	(*RemoveGroupMemberResponse)(nil),                    // 118: iam.RemoveGroupMemberResponse
-	(*AttachGroupPolicyResponse)(nil),                    // 119: iam.AttachGroupPolicyResponse
-	(*ListGroupPoliciesResponse)(nil),                    // 120: iam.ListGroupPoliciesResponse
-	(*DetachGroupPolicyResponse)(nil),                    // 121: iam.DetachGroupPolicyResponse
-	(*PutGroupInlinePolicyResponse)(nil),                 // 122: iam.PutGroupInlinePolicyResponse
-	(*GetGroupInlinePolicyResponse)(nil),                 // 123: iam.GetGroupInlinePolicyResponse
-	(*ListGroupInlinePoliciesResponse)(nil),              // 124: iam.ListGroupInlinePoliciesResponse
-	(*DeleteGroupInlinePolicyResponse)(nil),              // 125: iam.DeleteGroupInlinePolicyResponse
-	(*PutPlatformUserInlinePolicyResponse)(nil),          // 126: iam.PutPlatformUserInlinePolicyResponse
-	(*GetPlatformUserInlinePolicyResponse)(nil),          // 127: iam.GetPlatformUserInlinePolicyResponse
-	(*ListPlatformUserInlinePoliciesResponse)(nil),       // 128: iam.ListPlatformUserInlinePoliciesResponse
-	(*DeletePlatformUserInlinePolicyResponse)(nil),       // 129: iam.DeletePlatformUserInlinePolicyResponse
-	(*ListPlatformUserPoliciesResponse)(nil),             // 130: iam.ListPlatformUserPoliciesResponse
-	(*AttachPlatformUserPolicyResponse)(nil),             // 131: iam.AttachPlatformUserPolicyResponse
-	(*DetachPlatformUserPolicyResponse)(nil),             // 132: iam.DetachPlatformUserPolicyResponse
-	(*PutPlatformUserPermissionBoundaryResponse)(nil),    // 133: iam.PutPlatformUserPermissionBoundaryResponse
-	(*GetPlatformUserPermissionBoundaryResponse)(nil),    // 134: iam.GetPlatformUserPermissionBoundaryResponse
-	(*DeletePlatformUserPermissionBoundaryResponse)(nil), // 135: iam.DeletePlatformUserPermissionBoundaryResponse
-	(*RemovePlatformRoleResponse)(nil),                   // 136: iam.RemovePlatformRoleResponse
-	(*ListTenantMembersResponse)(nil),                    // 137: iam.ListTenantMembersResponse
-	(*RemoveTenantMemberResponse)(nil),                   // 138: iam.RemoveTenantMemberResponse
-	(*PutTenantUserInlinePolicyResponse)(nil),            // 139: iam.PutTenantUserInlinePolicyResponse
-	(*GetTenantUserInlinePolicyResponse)(nil),            // 140: iam.GetTenantUserInlinePolicyResponse
-	(*ListTenantUserInlinePoliciesResponse)(nil),         // 141: iam.ListTenantUserInlinePoliciesResponse
-	(*DeleteTenantUserInlinePolicyResponse)(nil),         // 142: iam.DeleteTenantUserInlinePolicyResponse
-	(*ListTenantUserPoliciesResponse)(nil),               // 143: iam.ListTenantUserPoliciesResponse
-	(*AttachTenantUserPolicyResponse)(nil),               // 144: iam.AttachTenantUserPolicyResponse
-	(*DetachTenantUserPolicyResponse)(nil),               // 145: iam.DetachTenantUserPolicyResponse
-	(*PutTenantUserPermissionBoundaryResponse)(nil),      // 146: iam.PutTenantUserPermissionBoundaryResponse
-	(*GetTenantUserPermissionBoundaryResponse)(nil),      // 147: iam.GetTenantUserPermissionBoundaryResponse
-	(*DeleteTenantUserPermissionBoundaryResponse)(nil),   // 148: iam.DeleteTenantUserPermissionBoundaryResponse
-	(*PutRoleTrustPolicyResponse)(nil),                   // 149: iam.PutRoleTrustPolicyResponse
-	(*GetRoleTrustPolicyResponse)(nil),                   // 150: iam.GetRoleTrustPolicyResponse
-	(*DeleteRoleTrustPolicyResponse)(nil),                // 151: iam.DeleteRoleTrustPolicyResponse
-	(*PutRolePermissionBoundaryResponse)(nil),            // 152: iam.PutRolePermissionBoundaryResponse
-	(*GetRolePermissionBoundaryResponse)(nil),            // 153: iam.GetRolePermissionBoundaryResponse
-	(*DeleteRolePermissionBoundaryResponse)(nil),         // 154: iam.DeleteRolePermissionBoundaryResponse
-	(*SimulateAccessResponse)(nil),                       // 155: iam.SimulateAccessResponse
-	(*EnsureRootOrganizationResponse)(nil),               // 156: iam.EnsureRootOrganizationResponse
+	(*CreateSCIMTokenRequest)(nil),                       // 7: iam.CreateSCIMTokenRequest
+	(*ListSCIMTokensRequest)(nil),                        // 8: iam.ListSCIMTokensRequest
+	(*RevokeSCIMTokenRequest)(nil),                       // 9: iam.RevokeSCIMTokenRequest
+	(*PutOrganizationSAMLConnectionRequest)(nil),         // 10: iam.PutOrganizationSAMLConnectionRequest
+	(*GetOrganizationSAMLConnectionRequest)(nil),         // 11: iam.GetOrganizationSAMLConnectionRequest
+	(*DeleteOrganizationSAMLConnectionRequest)(nil),      // 12: iam.DeleteOrganizationSAMLConnectionRequest
+	(*AttachTenantToOrganizationRequest)(nil),            // 13: iam.AttachTenantToOrganizationRequest
+	(*DetachTenantFromOrganizationRequest)(nil),          // 14: iam.DetachTenantFromOrganizationRequest
+	(*AttachServiceControlPolicyRequest)(nil),            // 15: iam.AttachServiceControlPolicyRequest
+	(*DetachServiceControlPolicyRequest)(nil),            // 16: iam.DetachServiceControlPolicyRequest
+	(*ListServiceControlPoliciesRequest)(nil),            // 17: iam.ListServiceControlPoliciesRequest
+	(*AddTenantMemberRequest)(nil),                       // 18: iam.AddTenantMemberRequest
+	(*AddTenantMemberByIdentityRequest)(nil),             // 19: iam.AddTenantMemberByIdentityRequest
+	(*CreateTenantInviteRequest)(nil),                    // 20: iam.CreateTenantInviteRequest
+	(*ListTenantInvitesRequest)(nil),                     // 21: iam.ListTenantInvitesRequest
+	(*RevokeTenantInviteRequest)(nil),                    // 22: iam.RevokeTenantInviteRequest
+	(*AcceptTenantInviteRequest)(nil),                    // 23: iam.AcceptTenantInviteRequest
+	(*GetTenantMembershipRequest)(nil),                   // 24: iam.GetTenantMembershipRequest
+	(*CheckPermissionRequest)(nil),                       // 25: iam.CheckPermissionRequest
+	(*CheckPlatformPermissionRequest)(nil),               // 26: iam.CheckPlatformPermissionRequest
+	(*ListUserTenantsRequest)(nil),                       // 27: iam.ListUserTenantsRequest
+	(*ListPlatformRolesRequest)(nil),                     // 28: iam.ListPlatformRolesRequest
+	(*ListDirectoryUsersRequest)(nil),                    // 29: iam.ListDirectoryUsersRequest
+	(*ListPermissionsRequest)(nil),                       // 30: iam.ListPermissionsRequest
+	(*AddPlatformRoleRequest)(nil),                       // 31: iam.AddPlatformRoleRequest
+	(*CreatePolicyRequest)(nil),                          // 32: iam.CreatePolicyRequest
+	(*CreatePolicyVersionRequest)(nil),                   // 33: iam.CreatePolicyVersionRequest
+	(*GetPolicyRequest)(nil),                             // 34: iam.GetPolicyRequest
+	(*ListPolicyVersionsRequest)(nil),                    // 35: iam.ListPolicyVersionsRequest
+	(*SetDefaultPolicyVersionRequest)(nil),               // 36: iam.SetDefaultPolicyVersionRequest
+	(*DeletePolicyVersionRequest)(nil),                   // 37: iam.DeletePolicyVersionRequest
+	(*ListPoliciesRequest)(nil),                          // 38: iam.ListPoliciesRequest
+	(*ListPolicyAttachmentsRequest)(nil),                 // 39: iam.ListPolicyAttachmentsRequest
+	(*DeletePolicyRequest)(nil),                          // 40: iam.DeletePolicyRequest
+	(*ValidatePolicyRequest)(nil),                        // 41: iam.ValidatePolicyRequest
+	(*CreateGroupRequest)(nil),                           // 42: iam.CreateGroupRequest
+	(*ListGroupsRequest)(nil),                            // 43: iam.ListGroupsRequest
+	(*DeleteGroupRequest)(nil),                           // 44: iam.DeleteGroupRequest
+	(*AddGroupMemberRequest)(nil),                        // 45: iam.AddGroupMemberRequest
+	(*ListGroupMembersRequest)(nil),                      // 46: iam.ListGroupMembersRequest
+	(*RemoveGroupMemberRequest)(nil),                     // 47: iam.RemoveGroupMemberRequest
+	(*AttachGroupPolicyRequest)(nil),                     // 48: iam.AttachGroupPolicyRequest
+	(*ListGroupPoliciesRequest)(nil),                     // 49: iam.ListGroupPoliciesRequest
+	(*DetachGroupPolicyRequest)(nil),                     // 50: iam.DetachGroupPolicyRequest
+	(*PutGroupInlinePolicyRequest)(nil),                  // 51: iam.PutGroupInlinePolicyRequest
+	(*GetGroupInlinePolicyRequest)(nil),                  // 52: iam.GetGroupInlinePolicyRequest
+	(*ListGroupInlinePoliciesRequest)(nil),               // 53: iam.ListGroupInlinePoliciesRequest
+	(*DeleteGroupInlinePolicyRequest)(nil),               // 54: iam.DeleteGroupInlinePolicyRequest
+	(*PutPlatformUserInlinePolicyRequest)(nil),           // 55: iam.PutPlatformUserInlinePolicyRequest
+	(*GetPlatformUserInlinePolicyRequest)(nil),           // 56: iam.GetPlatformUserInlinePolicyRequest
+	(*ListPlatformUserInlinePoliciesRequest)(nil),        // 57: iam.ListPlatformUserInlinePoliciesRequest
+	(*DeletePlatformUserInlinePolicyRequest)(nil),        // 58: iam.DeletePlatformUserInlinePolicyRequest
+	(*ListPlatformUserPoliciesRequest)(nil),              // 59: iam.ListPlatformUserPoliciesRequest
+	(*AttachPlatformUserPolicyRequest)(nil),              // 60: iam.AttachPlatformUserPolicyRequest
+	(*DetachPlatformUserPolicyRequest)(nil),              // 61: iam.DetachPlatformUserPolicyRequest
+	(*PutPlatformUserPermissionBoundaryRequest)(nil),     // 62: iam.PutPlatformUserPermissionBoundaryRequest
+	(*GetPlatformUserPermissionBoundaryRequest)(nil),     // 63: iam.GetPlatformUserPermissionBoundaryRequest
+	(*DeletePlatformUserPermissionBoundaryRequest)(nil),  // 64: iam.DeletePlatformUserPermissionBoundaryRequest
+	(*RemovePlatformRoleRequest)(nil),                    // 65: iam.RemovePlatformRoleRequest
+	(*ListTenantMembersRequest)(nil),                     // 66: iam.ListTenantMembersRequest
+	(*RemoveTenantMemberRequest)(nil),                    // 67: iam.RemoveTenantMemberRequest
+	(*PutTenantUserInlinePolicyRequest)(nil),             // 68: iam.PutTenantUserInlinePolicyRequest
+	(*GetTenantUserInlinePolicyRequest)(nil),             // 69: iam.GetTenantUserInlinePolicyRequest
+	(*ListTenantUserInlinePoliciesRequest)(nil),          // 70: iam.ListTenantUserInlinePoliciesRequest
+	(*DeleteTenantUserInlinePolicyRequest)(nil),          // 71: iam.DeleteTenantUserInlinePolicyRequest
+	(*ListTenantUserPoliciesRequest)(nil),                // 72: iam.ListTenantUserPoliciesRequest
+	(*AttachTenantUserPolicyRequest)(nil),                // 73: iam.AttachTenantUserPolicyRequest
+	(*DetachTenantUserPolicyRequest)(nil),                // 74: iam.DetachTenantUserPolicyRequest
+	(*PutTenantUserPermissionBoundaryRequest)(nil),       // 75: iam.PutTenantUserPermissionBoundaryRequest
+	(*GetTenantUserPermissionBoundaryRequest)(nil),       // 76: iam.GetTenantUserPermissionBoundaryRequest
+	(*DeleteTenantUserPermissionBoundaryRequest)(nil),    // 77: iam.DeleteTenantUserPermissionBoundaryRequest
+	(*PutRoleTrustPolicyRequest)(nil),                    // 78: iam.PutRoleTrustPolicyRequest
+	(*GetRoleTrustPolicyRequest)(nil),                    // 79: iam.GetRoleTrustPolicyRequest
+	(*DeleteRoleTrustPolicyRequest)(nil),                 // 80: iam.DeleteRoleTrustPolicyRequest
+	(*PutRolePermissionBoundaryRequest)(nil),             // 81: iam.PutRolePermissionBoundaryRequest
+	(*GetRolePermissionBoundaryRequest)(nil),             // 82: iam.GetRolePermissionBoundaryRequest
+	(*DeleteRolePermissionBoundaryRequest)(nil),          // 83: iam.DeleteRolePermissionBoundaryRequest
+	(*PutRoleAccessPolicyRequest)(nil),                   // 84: iam.PutRoleAccessPolicyRequest
+	(*GetRoleAccessPolicyRequest)(nil),                   // 85: iam.GetRoleAccessPolicyRequest
+	(*DeleteRoleAccessPolicyRequest)(nil),                // 86: iam.DeleteRoleAccessPolicyRequest
+	(*CreateAccessRequestRequest)(nil),                   // 87: iam.CreateAccessRequestRequest
+	(*GetAccessRequestRequest)(nil),                      // 88: iam.GetAccessRequestRequest
+	(*ListAccessRequestsRequest)(nil),                    // 89: iam.ListAccessRequestsRequest
+	(*ApproveAccessRequestRequest)(nil),                  // 90: iam.ApproveAccessRequestRequest
+	(*DenyAccessRequestRequest)(nil),                     // 91: iam.DenyAccessRequestRequest
+	(*CancelAccessRequestRequest)(nil),                   // 92: iam.CancelAccessRequestRequest
+	(*SimulateAccessRequest)(nil),                        // 93: iam.SimulateAccessRequest
+	(*ListUnusedPermissionsRequest)(nil),                 // 94: iam.ListUnusedPermissionsRequest
+	(*ListUnusedRolesRequest)(nil),                       // 95: iam.ListUnusedRolesRequest
+	(*ListStaleGroupMembershipsRequest)(nil),             // 96: iam.ListStaleGroupMembershipsRequest
+	(*GenerateLeastPrivilegePolicyRequest)(nil),          // 97: iam.GenerateLeastPrivilegePolicyRequest
+	(*EnsureRootOrganizationRequest)(nil),                // 98: iam.EnsureRootOrganizationRequest
+	(*ApplySAMLLoginRequest)(nil),                        // 99: iam.ApplySAMLLoginRequest
+	(*ResolveSAMLConnectionRequest)(nil),                 // 100: iam.ResolveSAMLConnectionRequest
+	(*ListSSORequiredOrganizationsRequest)(nil),          // 101: iam.ListSSORequiredOrganizationsRequest
+	(*GetOrganizationMembershipRequest)(nil),             // 102: iam.GetOrganizationMembershipRequest
+	(*IAMAssumeRoleResponse)(nil),                        // 103: iam.IAMAssumeRoleResponse
+	(*CreateTenantResponse)(nil),                         // 104: iam.CreateTenantResponse
+	(*CreateOrganizationResponse)(nil),                   // 105: iam.CreateOrganizationResponse
+	(*ListOrganizationsResponse)(nil),                    // 106: iam.ListOrganizationsResponse
+	(*AddOrganizationMemberResponse)(nil),                // 107: iam.AddOrganizationMemberResponse
+	(*RemoveOrganizationMemberResponse)(nil),             // 108: iam.RemoveOrganizationMemberResponse
+	(*ListOrganizationMembersResponse)(nil),              // 109: iam.ListOrganizationMembersResponse
+	(*CreateSCIMTokenResponse)(nil),                      // 110: iam.CreateSCIMTokenResponse
+	(*ListSCIMTokensResponse)(nil),                       // 111: iam.ListSCIMTokensResponse
+	(*RevokeSCIMTokenResponse)(nil),                      // 112: iam.RevokeSCIMTokenResponse
+	(*PutOrganizationSAMLConnectionResponse)(nil),        // 113: iam.PutOrganizationSAMLConnectionResponse
+	(*GetOrganizationSAMLConnectionResponse)(nil),        // 114: iam.GetOrganizationSAMLConnectionResponse
+	(*DeleteOrganizationSAMLConnectionResponse)(nil),     // 115: iam.DeleteOrganizationSAMLConnectionResponse
+	(*AttachTenantToOrganizationResponse)(nil),           // 116: iam.AttachTenantToOrganizationResponse
+	(*DetachTenantFromOrganizationResponse)(nil),         // 117: iam.DetachTenantFromOrganizationResponse
+	(*AttachServiceControlPolicyResponse)(nil),           // 118: iam.AttachServiceControlPolicyResponse
+	(*DetachServiceControlPolicyResponse)(nil),           // 119: iam.DetachServiceControlPolicyResponse
+	(*ListServiceControlPoliciesResponse)(nil),           // 120: iam.ListServiceControlPoliciesResponse
+	(*AddTenantMemberResponse)(nil),                      // 121: iam.AddTenantMemberResponse
+	(*AddTenantMemberByIdentityResponse)(nil),            // 122: iam.AddTenantMemberByIdentityResponse
+	(*CreateTenantInviteResponse)(nil),                   // 123: iam.CreateTenantInviteResponse
+	(*ListTenantInvitesResponse)(nil),                    // 124: iam.ListTenantInvitesResponse
+	(*RevokeTenantInviteResponse)(nil),                   // 125: iam.RevokeTenantInviteResponse
+	(*AcceptTenantInviteResponse)(nil),                   // 126: iam.AcceptTenantInviteResponse
+	(*GetTenantMembershipResponse)(nil),                  // 127: iam.GetTenantMembershipResponse
+	(*CheckPermissionResponse)(nil),                      // 128: iam.CheckPermissionResponse
+	(*ListUserTenantsResponse)(nil),                      // 129: iam.ListUserTenantsResponse
+	(*ListPlatformRolesResponse)(nil),                    // 130: iam.ListPlatformRolesResponse
+	(*ListDirectoryUsersResponse)(nil),                   // 131: iam.ListDirectoryUsersResponse
+	(*ListPermissionsResponse)(nil),                      // 132: iam.ListPermissionsResponse
+	(*AddPlatformRoleResponse)(nil),                      // 133: iam.AddPlatformRoleResponse
+	(*CreatePolicyResponse)(nil),                         // 134: iam.CreatePolicyResponse
+	(*CreatePolicyVersionResponse)(nil),                  // 135: iam.CreatePolicyVersionResponse
+	(*GetPolicyResponse)(nil),                            // 136: iam.GetPolicyResponse
+	(*ListPolicyVersionsResponse)(nil),                   // 137: iam.ListPolicyVersionsResponse
+	(*SetDefaultPolicyVersionResponse)(nil),              // 138: iam.SetDefaultPolicyVersionResponse
+	(*DeletePolicyVersionResponse)(nil),                  // 139: iam.DeletePolicyVersionResponse
+	(*ListPoliciesResponse)(nil),                         // 140: iam.ListPoliciesResponse
+	(*ListPolicyAttachmentsResponse)(nil),                // 141: iam.ListPolicyAttachmentsResponse
+	(*DeletePolicyResponse)(nil),                         // 142: iam.DeletePolicyResponse
+	(*ValidatePolicyResponse)(nil),                       // 143: iam.ValidatePolicyResponse
+	(*CreateGroupResponse)(nil),                          // 144: iam.CreateGroupResponse
+	(*ListGroupsResponse)(nil),                           // 145: iam.ListGroupsResponse
+	(*DeleteGroupResponse)(nil),                          // 146: iam.DeleteGroupResponse
+	(*AddGroupMemberResponse)(nil),                       // 147: iam.AddGroupMemberResponse
+	(*ListGroupMembersResponse)(nil),                     // 148: iam.ListGroupMembersResponse
+	(*RemoveGroupMemberResponse)(nil),                    // 149: iam.RemoveGroupMemberResponse
+	(*AttachGroupPolicyResponse)(nil),                    // 150: iam.AttachGroupPolicyResponse
+	(*ListGroupPoliciesResponse)(nil),                    // 151: iam.ListGroupPoliciesResponse
+	(*DetachGroupPolicyResponse)(nil),                    // 152: iam.DetachGroupPolicyResponse
+	(*PutGroupInlinePolicyResponse)(nil),                 // 153: iam.PutGroupInlinePolicyResponse
+	(*GetGroupInlinePolicyResponse)(nil),                 // 154: iam.GetGroupInlinePolicyResponse
+	(*ListGroupInlinePoliciesResponse)(nil),              // 155: iam.ListGroupInlinePoliciesResponse
+	(*DeleteGroupInlinePolicyResponse)(nil),              // 156: iam.DeleteGroupInlinePolicyResponse
+	(*PutPlatformUserInlinePolicyResponse)(nil),          // 157: iam.PutPlatformUserInlinePolicyResponse
+	(*GetPlatformUserInlinePolicyResponse)(nil),          // 158: iam.GetPlatformUserInlinePolicyResponse
+	(*ListPlatformUserInlinePoliciesResponse)(nil),       // 159: iam.ListPlatformUserInlinePoliciesResponse
+	(*DeletePlatformUserInlinePolicyResponse)(nil),       // 160: iam.DeletePlatformUserInlinePolicyResponse
+	(*ListPlatformUserPoliciesResponse)(nil),             // 161: iam.ListPlatformUserPoliciesResponse
+	(*AttachPlatformUserPolicyResponse)(nil),             // 162: iam.AttachPlatformUserPolicyResponse
+	(*DetachPlatformUserPolicyResponse)(nil),             // 163: iam.DetachPlatformUserPolicyResponse
+	(*PutPlatformUserPermissionBoundaryResponse)(nil),    // 164: iam.PutPlatformUserPermissionBoundaryResponse
+	(*GetPlatformUserPermissionBoundaryResponse)(nil),    // 165: iam.GetPlatformUserPermissionBoundaryResponse
+	(*DeletePlatformUserPermissionBoundaryResponse)(nil), // 166: iam.DeletePlatformUserPermissionBoundaryResponse
+	(*RemovePlatformRoleResponse)(nil),                   // 167: iam.RemovePlatformRoleResponse
+	(*ListTenantMembersResponse)(nil),                    // 168: iam.ListTenantMembersResponse
+	(*RemoveTenantMemberResponse)(nil),                   // 169: iam.RemoveTenantMemberResponse
+	(*PutTenantUserInlinePolicyResponse)(nil),            // 170: iam.PutTenantUserInlinePolicyResponse
+	(*GetTenantUserInlinePolicyResponse)(nil),            // 171: iam.GetTenantUserInlinePolicyResponse
+	(*ListTenantUserInlinePoliciesResponse)(nil),         // 172: iam.ListTenantUserInlinePoliciesResponse
+	(*DeleteTenantUserInlinePolicyResponse)(nil),         // 173: iam.DeleteTenantUserInlinePolicyResponse
+	(*ListTenantUserPoliciesResponse)(nil),               // 174: iam.ListTenantUserPoliciesResponse
+	(*AttachTenantUserPolicyResponse)(nil),               // 175: iam.AttachTenantUserPolicyResponse
+	(*DetachTenantUserPolicyResponse)(nil),               // 176: iam.DetachTenantUserPolicyResponse
+	(*PutTenantUserPermissionBoundaryResponse)(nil),      // 177: iam.PutTenantUserPermissionBoundaryResponse
+	(*GetTenantUserPermissionBoundaryResponse)(nil),      // 178: iam.GetTenantUserPermissionBoundaryResponse
+	(*DeleteTenantUserPermissionBoundaryResponse)(nil),   // 179: iam.DeleteTenantUserPermissionBoundaryResponse
+	(*PutRoleTrustPolicyResponse)(nil),                   // 180: iam.PutRoleTrustPolicyResponse
+	(*GetRoleTrustPolicyResponse)(nil),                   // 181: iam.GetRoleTrustPolicyResponse
+	(*DeleteRoleTrustPolicyResponse)(nil),                // 182: iam.DeleteRoleTrustPolicyResponse
+	(*PutRolePermissionBoundaryResponse)(nil),            // 183: iam.PutRolePermissionBoundaryResponse
+	(*GetRolePermissionBoundaryResponse)(nil),            // 184: iam.GetRolePermissionBoundaryResponse
+	(*DeleteRolePermissionBoundaryResponse)(nil),         // 185: iam.DeleteRolePermissionBoundaryResponse
+	(*PutRoleAccessPolicyResponse)(nil),                  // 186: iam.PutRoleAccessPolicyResponse
+	(*GetRoleAccessPolicyResponse)(nil),                  // 187: iam.GetRoleAccessPolicyResponse
+	(*DeleteRoleAccessPolicyResponse)(nil),               // 188: iam.DeleteRoleAccessPolicyResponse
+	(*CreateAccessRequestResponse)(nil),                  // 189: iam.CreateAccessRequestResponse
+	(*GetAccessRequestResponse)(nil),                     // 190: iam.GetAccessRequestResponse
+	(*ListAccessRequestsResponse)(nil),                   // 191: iam.ListAccessRequestsResponse
+	(*ApproveAccessRequestResponse)(nil),                 // 192: iam.ApproveAccessRequestResponse
+	(*DenyAccessRequestResponse)(nil),                    // 193: iam.DenyAccessRequestResponse
+	(*CancelAccessRequestResponse)(nil),                  // 194: iam.CancelAccessRequestResponse
+	(*SimulateAccessResponse)(nil),                       // 195: iam.SimulateAccessResponse
+	(*ListUnusedPermissionsResponse)(nil),                // 196: iam.ListUnusedPermissionsResponse
+	(*ListUnusedRolesResponse)(nil),                      // 197: iam.ListUnusedRolesResponse
+	(*ListStaleGroupMembershipsResponse)(nil),            // 198: iam.ListStaleGroupMembershipsResponse
+	(*GenerateLeastPrivilegePolicyResponse)(nil),         // 199: iam.GenerateLeastPrivilegePolicyResponse
+	(*EnsureRootOrganizationResponse)(nil),               // 200: iam.EnsureRootOrganizationResponse
+	(*ApplySAMLLoginResponse)(nil),                       // 201: iam.ApplySAMLLoginResponse
+	(*ResolveSAMLConnectionResponse)(nil),                // 202: iam.ResolveSAMLConnectionResponse
+	(*ListSSORequiredOrganizationsResponse)(nil),         // 203: iam.ListSSORequiredOrganizationsResponse
+	(*GetOrganizationMembershipResponse)(nil),            // 204: iam.GetOrganizationMembershipResponse
 }
 var file_iam_v1_iam_service_proto_depIdxs = []int32{
 	0,   // 0: iam.IAMService.AssumeRole:input_type -> iam.IAMAssumeRoleRequest
@@ -359,315 +451,403 @@ var file_iam_v1_iam_service_proto_depIdxs = []int32{
 	4,   // 4: iam.IAMService.AddOrganizationMember:input_type -> iam.AddOrganizationMemberRequest
 	5,   // 5: iam.IAMService.RemoveOrganizationMember:input_type -> iam.RemoveOrganizationMemberRequest
 	6,   // 6: iam.IAMService.ListOrganizationMembers:input_type -> iam.ListOrganizationMembersRequest
-	7,   // 7: iam.IAMService.AttachTenantToOrganization:input_type -> iam.AttachTenantToOrganizationRequest
-	8,   // 8: iam.IAMService.DetachTenantFromOrganization:input_type -> iam.DetachTenantFromOrganizationRequest
-	9,   // 9: iam.IAMService.AttachServiceControlPolicy:input_type -> iam.AttachServiceControlPolicyRequest
-	10,  // 10: iam.IAMService.DetachServiceControlPolicy:input_type -> iam.DetachServiceControlPolicyRequest
-	11,  // 11: iam.IAMService.ListServiceControlPolicies:input_type -> iam.ListServiceControlPoliciesRequest
-	12,  // 12: iam.IAMService.AddTenantMember:input_type -> iam.AddTenantMemberRequest
-	13,  // 13: iam.IAMService.AddTenantMemberByIdentity:input_type -> iam.AddTenantMemberByIdentityRequest
-	14,  // 14: iam.IAMService.CreateTenantInvite:input_type -> iam.CreateTenantInviteRequest
-	15,  // 15: iam.IAMService.ListTenantInvites:input_type -> iam.ListTenantInvitesRequest
-	16,  // 16: iam.IAMService.RevokeTenantInvite:input_type -> iam.RevokeTenantInviteRequest
-	17,  // 17: iam.IAMService.AcceptTenantInvite:input_type -> iam.AcceptTenantInviteRequest
-	18,  // 18: iam.IAMService.GetTenantMembership:input_type -> iam.GetTenantMembershipRequest
-	19,  // 19: iam.IAMService.CheckPermission:input_type -> iam.CheckPermissionRequest
-	20,  // 20: iam.IAMService.CheckPlatformPermission:input_type -> iam.CheckPlatformPermissionRequest
-	21,  // 21: iam.IAMService.ListUserTenants:input_type -> iam.ListUserTenantsRequest
-	22,  // 22: iam.IAMService.ListPlatformRoles:input_type -> iam.ListPlatformRolesRequest
-	23,  // 23: iam.IAMService.ListDirectoryUsers:input_type -> iam.ListDirectoryUsersRequest
-	24,  // 24: iam.IAMService.ListPermissions:input_type -> iam.ListPermissionsRequest
-	25,  // 25: iam.IAMService.AddPlatformRole:input_type -> iam.AddPlatformRoleRequest
-	26,  // 26: iam.IAMService.CreatePolicy:input_type -> iam.CreatePolicyRequest
-	27,  // 27: iam.IAMService.CreatePolicyVersion:input_type -> iam.CreatePolicyVersionRequest
-	28,  // 28: iam.IAMService.GetPolicy:input_type -> iam.GetPolicyRequest
-	29,  // 29: iam.IAMService.ListPolicyVersions:input_type -> iam.ListPolicyVersionsRequest
-	30,  // 30: iam.IAMService.SetDefaultPolicyVersion:input_type -> iam.SetDefaultPolicyVersionRequest
-	31,  // 31: iam.IAMService.DeletePolicyVersion:input_type -> iam.DeletePolicyVersionRequest
-	32,  // 32: iam.IAMService.ListPolicies:input_type -> iam.ListPoliciesRequest
-	33,  // 33: iam.IAMService.ListPolicyAttachments:input_type -> iam.ListPolicyAttachmentsRequest
-	34,  // 34: iam.IAMService.DeletePolicy:input_type -> iam.DeletePolicyRequest
-	35,  // 35: iam.IAMService.CreateGroup:input_type -> iam.CreateGroupRequest
-	36,  // 36: iam.IAMService.ListGroups:input_type -> iam.ListGroupsRequest
-	37,  // 37: iam.IAMService.DeleteGroup:input_type -> iam.DeleteGroupRequest
-	38,  // 38: iam.IAMService.AddGroupMember:input_type -> iam.AddGroupMemberRequest
-	39,  // 39: iam.IAMService.ListGroupMembers:input_type -> iam.ListGroupMembersRequest
-	40,  // 40: iam.IAMService.RemoveGroupMember:input_type -> iam.RemoveGroupMemberRequest
-	41,  // 41: iam.IAMService.AttachGroupPolicy:input_type -> iam.AttachGroupPolicyRequest
-	42,  // 42: iam.IAMService.ListGroupPolicies:input_type -> iam.ListGroupPoliciesRequest
-	43,  // 43: iam.IAMService.DetachGroupPolicy:input_type -> iam.DetachGroupPolicyRequest
-	44,  // 44: iam.IAMService.PutGroupInlinePolicy:input_type -> iam.PutGroupInlinePolicyRequest
-	45,  // 45: iam.IAMService.GetGroupInlinePolicy:input_type -> iam.GetGroupInlinePolicyRequest
-	46,  // 46: iam.IAMService.ListGroupInlinePolicies:input_type -> iam.ListGroupInlinePoliciesRequest
-	47,  // 47: iam.IAMService.DeleteGroupInlinePolicy:input_type -> iam.DeleteGroupInlinePolicyRequest
-	48,  // 48: iam.IAMService.PutPlatformUserInlinePolicy:input_type -> iam.PutPlatformUserInlinePolicyRequest
-	49,  // 49: iam.IAMService.GetPlatformUserInlinePolicy:input_type -> iam.GetPlatformUserInlinePolicyRequest
-	50,  // 50: iam.IAMService.ListPlatformUserInlinePolicies:input_type -> iam.ListPlatformUserInlinePoliciesRequest
-	51,  // 51: iam.IAMService.DeletePlatformUserInlinePolicy:input_type -> iam.DeletePlatformUserInlinePolicyRequest
-	52,  // 52: iam.IAMService.ListPlatformUserPolicies:input_type -> iam.ListPlatformUserPoliciesRequest
-	53,  // 53: iam.IAMService.AttachPlatformUserPolicy:input_type -> iam.AttachPlatformUserPolicyRequest
-	54,  // 54: iam.IAMService.DetachPlatformUserPolicy:input_type -> iam.DetachPlatformUserPolicyRequest
-	55,  // 55: iam.IAMService.PutPlatformUserPermissionBoundary:input_type -> iam.PutPlatformUserPermissionBoundaryRequest
-	56,  // 56: iam.IAMService.GetPlatformUserPermissionBoundary:input_type -> iam.GetPlatformUserPermissionBoundaryRequest
-	57,  // 57: iam.IAMService.DeletePlatformUserPermissionBoundary:input_type -> iam.DeletePlatformUserPermissionBoundaryRequest
-	58,  // 58: iam.IAMService.RemovePlatformRole:input_type -> iam.RemovePlatformRoleRequest
-	59,  // 59: iam.IAMService.ListTenantMembers:input_type -> iam.ListTenantMembersRequest
-	60,  // 60: iam.IAMService.RemoveTenantMember:input_type -> iam.RemoveTenantMemberRequest
-	61,  // 61: iam.IAMService.PutTenantUserInlinePolicy:input_type -> iam.PutTenantUserInlinePolicyRequest
-	62,  // 62: iam.IAMService.GetTenantUserInlinePolicy:input_type -> iam.GetTenantUserInlinePolicyRequest
-	63,  // 63: iam.IAMService.ListTenantUserInlinePolicies:input_type -> iam.ListTenantUserInlinePoliciesRequest
-	64,  // 64: iam.IAMService.DeleteTenantUserInlinePolicy:input_type -> iam.DeleteTenantUserInlinePolicyRequest
-	65,  // 65: iam.IAMService.ListTenantUserPolicies:input_type -> iam.ListTenantUserPoliciesRequest
-	66,  // 66: iam.IAMService.AttachTenantUserPolicy:input_type -> iam.AttachTenantUserPolicyRequest
-	67,  // 67: iam.IAMService.DetachTenantUserPolicy:input_type -> iam.DetachTenantUserPolicyRequest
-	68,  // 68: iam.IAMService.PutTenantUserPermissionBoundary:input_type -> iam.PutTenantUserPermissionBoundaryRequest
-	69,  // 69: iam.IAMService.GetTenantUserPermissionBoundary:input_type -> iam.GetTenantUserPermissionBoundaryRequest
-	70,  // 70: iam.IAMService.DeleteTenantUserPermissionBoundary:input_type -> iam.DeleteTenantUserPermissionBoundaryRequest
-	71,  // 71: iam.IAMService.PutRoleTrustPolicy:input_type -> iam.PutRoleTrustPolicyRequest
-	72,  // 72: iam.IAMService.GetRoleTrustPolicy:input_type -> iam.GetRoleTrustPolicyRequest
-	73,  // 73: iam.IAMService.DeleteRoleTrustPolicy:input_type -> iam.DeleteRoleTrustPolicyRequest
-	74,  // 74: iam.IAMService.PutRolePermissionBoundary:input_type -> iam.PutRolePermissionBoundaryRequest
-	75,  // 75: iam.IAMService.GetRolePermissionBoundary:input_type -> iam.GetRolePermissionBoundaryRequest
-	76,  // 76: iam.IAMService.DeleteRolePermissionBoundary:input_type -> iam.DeleteRolePermissionBoundaryRequest
-	77,  // 77: iam.IAMService.SimulateAccess:input_type -> iam.SimulateAccessRequest
-	0,   // 78: iam.IAMCommandService.AssumeRole:input_type -> iam.IAMAssumeRoleRequest
-	1,   // 79: iam.IAMCommandService.CreateTenant:input_type -> iam.CreateTenantRequest
-	2,   // 80: iam.IAMCommandService.CreateOrganization:input_type -> iam.CreateOrganizationRequest
-	78,  // 81: iam.IAMCommandService.EnsureRootOrganization:input_type -> iam.EnsureRootOrganizationRequest
-	4,   // 82: iam.IAMCommandService.AddOrganizationMember:input_type -> iam.AddOrganizationMemberRequest
-	5,   // 83: iam.IAMCommandService.RemoveOrganizationMember:input_type -> iam.RemoveOrganizationMemberRequest
-	7,   // 84: iam.IAMCommandService.AttachTenantToOrganization:input_type -> iam.AttachTenantToOrganizationRequest
-	8,   // 85: iam.IAMCommandService.DetachTenantFromOrganization:input_type -> iam.DetachTenantFromOrganizationRequest
-	9,   // 86: iam.IAMCommandService.AttachServiceControlPolicy:input_type -> iam.AttachServiceControlPolicyRequest
-	10,  // 87: iam.IAMCommandService.DetachServiceControlPolicy:input_type -> iam.DetachServiceControlPolicyRequest
-	12,  // 88: iam.IAMCommandService.AddTenantMember:input_type -> iam.AddTenantMemberRequest
-	13,  // 89: iam.IAMCommandService.AddTenantMemberByIdentity:input_type -> iam.AddTenantMemberByIdentityRequest
-	14,  // 90: iam.IAMCommandService.CreateTenantInvite:input_type -> iam.CreateTenantInviteRequest
-	16,  // 91: iam.IAMCommandService.RevokeTenantInvite:input_type -> iam.RevokeTenantInviteRequest
-	17,  // 92: iam.IAMCommandService.AcceptTenantInvite:input_type -> iam.AcceptTenantInviteRequest
-	25,  // 93: iam.IAMCommandService.AddPlatformRole:input_type -> iam.AddPlatformRoleRequest
-	58,  // 94: iam.IAMCommandService.RemovePlatformRole:input_type -> iam.RemovePlatformRoleRequest
-	26,  // 95: iam.IAMCommandService.CreatePolicy:input_type -> iam.CreatePolicyRequest
-	27,  // 96: iam.IAMCommandService.CreatePolicyVersion:input_type -> iam.CreatePolicyVersionRequest
-	30,  // 97: iam.IAMCommandService.SetDefaultPolicyVersion:input_type -> iam.SetDefaultPolicyVersionRequest
-	31,  // 98: iam.IAMCommandService.DeletePolicyVersion:input_type -> iam.DeletePolicyVersionRequest
-	34,  // 99: iam.IAMCommandService.DeletePolicy:input_type -> iam.DeletePolicyRequest
-	35,  // 100: iam.IAMCommandService.CreateGroup:input_type -> iam.CreateGroupRequest
-	37,  // 101: iam.IAMCommandService.DeleteGroup:input_type -> iam.DeleteGroupRequest
-	38,  // 102: iam.IAMCommandService.AddGroupMember:input_type -> iam.AddGroupMemberRequest
-	40,  // 103: iam.IAMCommandService.RemoveGroupMember:input_type -> iam.RemoveGroupMemberRequest
-	41,  // 104: iam.IAMCommandService.AttachGroupPolicy:input_type -> iam.AttachGroupPolicyRequest
-	43,  // 105: iam.IAMCommandService.DetachGroupPolicy:input_type -> iam.DetachGroupPolicyRequest
-	44,  // 106: iam.IAMCommandService.PutGroupInlinePolicy:input_type -> iam.PutGroupInlinePolicyRequest
-	47,  // 107: iam.IAMCommandService.DeleteGroupInlinePolicy:input_type -> iam.DeleteGroupInlinePolicyRequest
-	48,  // 108: iam.IAMCommandService.PutPlatformUserInlinePolicy:input_type -> iam.PutPlatformUserInlinePolicyRequest
-	51,  // 109: iam.IAMCommandService.DeletePlatformUserInlinePolicy:input_type -> iam.DeletePlatformUserInlinePolicyRequest
-	53,  // 110: iam.IAMCommandService.AttachPlatformUserPolicy:input_type -> iam.AttachPlatformUserPolicyRequest
-	54,  // 111: iam.IAMCommandService.DetachPlatformUserPolicy:input_type -> iam.DetachPlatformUserPolicyRequest
-	55,  // 112: iam.IAMCommandService.PutPlatformUserPermissionBoundary:input_type -> iam.PutPlatformUserPermissionBoundaryRequest
-	57,  // 113: iam.IAMCommandService.DeletePlatformUserPermissionBoundary:input_type -> iam.DeletePlatformUserPermissionBoundaryRequest
-	60,  // 114: iam.IAMCommandService.RemoveTenantMember:input_type -> iam.RemoveTenantMemberRequest
-	61,  // 115: iam.IAMCommandService.PutTenantUserInlinePolicy:input_type -> iam.PutTenantUserInlinePolicyRequest
-	64,  // 116: iam.IAMCommandService.DeleteTenantUserInlinePolicy:input_type -> iam.DeleteTenantUserInlinePolicyRequest
-	66,  // 117: iam.IAMCommandService.AttachTenantUserPolicy:input_type -> iam.AttachTenantUserPolicyRequest
-	67,  // 118: iam.IAMCommandService.DetachTenantUserPolicy:input_type -> iam.DetachTenantUserPolicyRequest
-	68,  // 119: iam.IAMCommandService.PutTenantUserPermissionBoundary:input_type -> iam.PutTenantUserPermissionBoundaryRequest
-	70,  // 120: iam.IAMCommandService.DeleteTenantUserPermissionBoundary:input_type -> iam.DeleteTenantUserPermissionBoundaryRequest
-	71,  // 121: iam.IAMCommandService.PutRoleTrustPolicy:input_type -> iam.PutRoleTrustPolicyRequest
-	73,  // 122: iam.IAMCommandService.DeleteRoleTrustPolicy:input_type -> iam.DeleteRoleTrustPolicyRequest
-	74,  // 123: iam.IAMCommandService.PutRolePermissionBoundary:input_type -> iam.PutRolePermissionBoundaryRequest
-	76,  // 124: iam.IAMCommandService.DeleteRolePermissionBoundary:input_type -> iam.DeleteRolePermissionBoundaryRequest
-	3,   // 125: iam.IAMQueryService.ListOrganizations:input_type -> iam.ListOrganizationsRequest
-	6,   // 126: iam.IAMQueryService.ListOrganizationMembers:input_type -> iam.ListOrganizationMembersRequest
-	11,  // 127: iam.IAMQueryService.ListServiceControlPolicies:input_type -> iam.ListServiceControlPoliciesRequest
-	15,  // 128: iam.IAMQueryService.ListTenantInvites:input_type -> iam.ListTenantInvitesRequest
-	18,  // 129: iam.IAMQueryService.GetTenantMembership:input_type -> iam.GetTenantMembershipRequest
-	19,  // 130: iam.IAMQueryService.CheckPermission:input_type -> iam.CheckPermissionRequest
-	20,  // 131: iam.IAMQueryService.CheckPlatformPermission:input_type -> iam.CheckPlatformPermissionRequest
-	21,  // 132: iam.IAMQueryService.ListUserTenants:input_type -> iam.ListUserTenantsRequest
-	22,  // 133: iam.IAMQueryService.ListPlatformRoles:input_type -> iam.ListPlatformRolesRequest
-	23,  // 134: iam.IAMQueryService.ListDirectoryUsers:input_type -> iam.ListDirectoryUsersRequest
-	24,  // 135: iam.IAMQueryService.ListPermissions:input_type -> iam.ListPermissionsRequest
-	28,  // 136: iam.IAMQueryService.GetPolicy:input_type -> iam.GetPolicyRequest
-	29,  // 137: iam.IAMQueryService.ListPolicyVersions:input_type -> iam.ListPolicyVersionsRequest
-	32,  // 138: iam.IAMQueryService.ListPolicies:input_type -> iam.ListPoliciesRequest
-	33,  // 139: iam.IAMQueryService.ListPolicyAttachments:input_type -> iam.ListPolicyAttachmentsRequest
-	36,  // 140: iam.IAMQueryService.ListGroups:input_type -> iam.ListGroupsRequest
-	39,  // 141: iam.IAMQueryService.ListGroupMembers:input_type -> iam.ListGroupMembersRequest
-	42,  // 142: iam.IAMQueryService.ListGroupPolicies:input_type -> iam.ListGroupPoliciesRequest
-	45,  // 143: iam.IAMQueryService.GetGroupInlinePolicy:input_type -> iam.GetGroupInlinePolicyRequest
-	46,  // 144: iam.IAMQueryService.ListGroupInlinePolicies:input_type -> iam.ListGroupInlinePoliciesRequest
-	52,  // 145: iam.IAMQueryService.ListPlatformUserPolicies:input_type -> iam.ListPlatformUserPoliciesRequest
-	49,  // 146: iam.IAMQueryService.GetPlatformUserInlinePolicy:input_type -> iam.GetPlatformUserInlinePolicyRequest
-	50,  // 147: iam.IAMQueryService.ListPlatformUserInlinePolicies:input_type -> iam.ListPlatformUserInlinePoliciesRequest
-	56,  // 148: iam.IAMQueryService.GetPlatformUserPermissionBoundary:input_type -> iam.GetPlatformUserPermissionBoundaryRequest
-	59,  // 149: iam.IAMQueryService.ListTenantMembers:input_type -> iam.ListTenantMembersRequest
-	65,  // 150: iam.IAMQueryService.ListTenantUserPolicies:input_type -> iam.ListTenantUserPoliciesRequest
-	62,  // 151: iam.IAMQueryService.GetTenantUserInlinePolicy:input_type -> iam.GetTenantUserInlinePolicyRequest
-	63,  // 152: iam.IAMQueryService.ListTenantUserInlinePolicies:input_type -> iam.ListTenantUserInlinePoliciesRequest
-	69,  // 153: iam.IAMQueryService.GetTenantUserPermissionBoundary:input_type -> iam.GetTenantUserPermissionBoundaryRequest
-	72,  // 154: iam.IAMQueryService.GetRoleTrustPolicy:input_type -> iam.GetRoleTrustPolicyRequest
-	75,  // 155: iam.IAMQueryService.GetRolePermissionBoundary:input_type -> iam.GetRolePermissionBoundaryRequest
-	77,  // 156: iam.IAMQueryService.SimulateAccess:input_type -> iam.SimulateAccessRequest
-	79,  // 157: iam.IAMService.AssumeRole:output_type -> iam.IAMAssumeRoleResponse
-	80,  // 158: iam.IAMService.CreateTenant:output_type -> iam.CreateTenantResponse
-	81,  // 159: iam.IAMService.CreateOrganization:output_type -> iam.CreateOrganizationResponse
-	82,  // 160: iam.IAMService.ListOrganizations:output_type -> iam.ListOrganizationsResponse
-	83,  // 161: iam.IAMService.AddOrganizationMember:output_type -> iam.AddOrganizationMemberResponse
-	84,  // 162: iam.IAMService.RemoveOrganizationMember:output_type -> iam.RemoveOrganizationMemberResponse
-	85,  // 163: iam.IAMService.ListOrganizationMembers:output_type -> iam.ListOrganizationMembersResponse
-	86,  // 164: iam.IAMService.AttachTenantToOrganization:output_type -> iam.AttachTenantToOrganizationResponse
-	87,  // 165: iam.IAMService.DetachTenantFromOrganization:output_type -> iam.DetachTenantFromOrganizationResponse
-	88,  // 166: iam.IAMService.AttachServiceControlPolicy:output_type -> iam.AttachServiceControlPolicyResponse
-	89,  // 167: iam.IAMService.DetachServiceControlPolicy:output_type -> iam.DetachServiceControlPolicyResponse
-	90,  // 168: iam.IAMService.ListServiceControlPolicies:output_type -> iam.ListServiceControlPoliciesResponse
-	91,  // 169: iam.IAMService.AddTenantMember:output_type -> iam.AddTenantMemberResponse
-	92,  // 170: iam.IAMService.AddTenantMemberByIdentity:output_type -> iam.AddTenantMemberByIdentityResponse
-	93,  // 171: iam.IAMService.CreateTenantInvite:output_type -> iam.CreateTenantInviteResponse
-	94,  // 172: iam.IAMService.ListTenantInvites:output_type -> iam.ListTenantInvitesResponse
-	95,  // 173: iam.IAMService.RevokeTenantInvite:output_type -> iam.RevokeTenantInviteResponse
-	96,  // 174: iam.IAMService.AcceptTenantInvite:output_type -> iam.AcceptTenantInviteResponse
-	97,  // 175: iam.IAMService.GetTenantMembership:output_type -> iam.GetTenantMembershipResponse
-	98,  // 176: iam.IAMService.CheckPermission:output_type -> iam.CheckPermissionResponse
-	98,  // 177: iam.IAMService.CheckPlatformPermission:output_type -> iam.CheckPermissionResponse
-	99,  // 178: iam.IAMService.ListUserTenants:output_type -> iam.ListUserTenantsResponse
-	100, // 179: iam.IAMService.ListPlatformRoles:output_type -> iam.ListPlatformRolesResponse
-	101, // 180: iam.IAMService.ListDirectoryUsers:output_type -> iam.ListDirectoryUsersResponse
-	102, // 181: iam.IAMService.ListPermissions:output_type -> iam.ListPermissionsResponse
-	103, // 182: iam.IAMService.AddPlatformRole:output_type -> iam.AddPlatformRoleResponse
-	104, // 183: iam.IAMService.CreatePolicy:output_type -> iam.CreatePolicyResponse
-	105, // 184: iam.IAMService.CreatePolicyVersion:output_type -> iam.CreatePolicyVersionResponse
-	106, // 185: iam.IAMService.GetPolicy:output_type -> iam.GetPolicyResponse
-	107, // 186: iam.IAMService.ListPolicyVersions:output_type -> iam.ListPolicyVersionsResponse
-	108, // 187: iam.IAMService.SetDefaultPolicyVersion:output_type -> iam.SetDefaultPolicyVersionResponse
-	109, // 188: iam.IAMService.DeletePolicyVersion:output_type -> iam.DeletePolicyVersionResponse
-	110, // 189: iam.IAMService.ListPolicies:output_type -> iam.ListPoliciesResponse
-	111, // 190: iam.IAMService.ListPolicyAttachments:output_type -> iam.ListPolicyAttachmentsResponse
-	112, // 191: iam.IAMService.DeletePolicy:output_type -> iam.DeletePolicyResponse
-	113, // 192: iam.IAMService.CreateGroup:output_type -> iam.CreateGroupResponse
-	114, // 193: iam.IAMService.ListGroups:output_type -> iam.ListGroupsResponse
-	115, // 194: iam.IAMService.DeleteGroup:output_type -> iam.DeleteGroupResponse
-	116, // 195: iam.IAMService.AddGroupMember:output_type -> iam.AddGroupMemberResponse
-	117, // 196: iam.IAMService.ListGroupMembers:output_type -> iam.ListGroupMembersResponse
-	118, // 197: iam.IAMService.RemoveGroupMember:output_type -> iam.RemoveGroupMemberResponse
-	119, // 198: iam.IAMService.AttachGroupPolicy:output_type -> iam.AttachGroupPolicyResponse
-	120, // 199: iam.IAMService.ListGroupPolicies:output_type -> iam.ListGroupPoliciesResponse
-	121, // 200: iam.IAMService.DetachGroupPolicy:output_type -> iam.DetachGroupPolicyResponse
-	122, // 201: iam.IAMService.PutGroupInlinePolicy:output_type -> iam.PutGroupInlinePolicyResponse
-	123, // 202: iam.IAMService.GetGroupInlinePolicy:output_type -> iam.GetGroupInlinePolicyResponse
-	124, // 203: iam.IAMService.ListGroupInlinePolicies:output_type -> iam.ListGroupInlinePoliciesResponse
-	125, // 204: iam.IAMService.DeleteGroupInlinePolicy:output_type -> iam.DeleteGroupInlinePolicyResponse
-	126, // 205: iam.IAMService.PutPlatformUserInlinePolicy:output_type -> iam.PutPlatformUserInlinePolicyResponse
-	127, // 206: iam.IAMService.GetPlatformUserInlinePolicy:output_type -> iam.GetPlatformUserInlinePolicyResponse
-	128, // 207: iam.IAMService.ListPlatformUserInlinePolicies:output_type -> iam.ListPlatformUserInlinePoliciesResponse
-	129, // 208: iam.IAMService.DeletePlatformUserInlinePolicy:output_type -> iam.DeletePlatformUserInlinePolicyResponse
-	130, // 209: iam.IAMService.ListPlatformUserPolicies:output_type -> iam.ListPlatformUserPoliciesResponse
-	131, // 210: iam.IAMService.AttachPlatformUserPolicy:output_type -> iam.AttachPlatformUserPolicyResponse
-	132, // 211: iam.IAMService.DetachPlatformUserPolicy:output_type -> iam.DetachPlatformUserPolicyResponse
-	133, // 212: iam.IAMService.PutPlatformUserPermissionBoundary:output_type -> iam.PutPlatformUserPermissionBoundaryResponse
-	134, // 213: iam.IAMService.GetPlatformUserPermissionBoundary:output_type -> iam.GetPlatformUserPermissionBoundaryResponse
-	135, // 214: iam.IAMService.DeletePlatformUserPermissionBoundary:output_type -> iam.DeletePlatformUserPermissionBoundaryResponse
-	136, // 215: iam.IAMService.RemovePlatformRole:output_type -> iam.RemovePlatformRoleResponse
-	137, // 216: iam.IAMService.ListTenantMembers:output_type -> iam.ListTenantMembersResponse
-	138, // 217: iam.IAMService.RemoveTenantMember:output_type -> iam.RemoveTenantMemberResponse
-	139, // 218: iam.IAMService.PutTenantUserInlinePolicy:output_type -> iam.PutTenantUserInlinePolicyResponse
-	140, // 219: iam.IAMService.GetTenantUserInlinePolicy:output_type -> iam.GetTenantUserInlinePolicyResponse
-	141, // 220: iam.IAMService.ListTenantUserInlinePolicies:output_type -> iam.ListTenantUserInlinePoliciesResponse
-	142, // 221: iam.IAMService.DeleteTenantUserInlinePolicy:output_type -> iam.DeleteTenantUserInlinePolicyResponse
-	143, // 222: iam.IAMService.ListTenantUserPolicies:output_type -> iam.ListTenantUserPoliciesResponse
-	144, // 223: iam.IAMService.AttachTenantUserPolicy:output_type -> iam.AttachTenantUserPolicyResponse
-	145, // 224: iam.IAMService.DetachTenantUserPolicy:output_type -> iam.DetachTenantUserPolicyResponse
-	146, // 225: iam.IAMService.PutTenantUserPermissionBoundary:output_type -> iam.PutTenantUserPermissionBoundaryResponse
-	147, // 226: iam.IAMService.GetTenantUserPermissionBoundary:output_type -> iam.GetTenantUserPermissionBoundaryResponse
-	148, // 227: iam.IAMService.DeleteTenantUserPermissionBoundary:output_type -> iam.DeleteTenantUserPermissionBoundaryResponse
-	149, // 228: iam.IAMService.PutRoleTrustPolicy:output_type -> iam.PutRoleTrustPolicyResponse
-	150, // 229: iam.IAMService.GetRoleTrustPolicy:output_type -> iam.GetRoleTrustPolicyResponse
-	151, // 230: iam.IAMService.DeleteRoleTrustPolicy:output_type -> iam.DeleteRoleTrustPolicyResponse
-	152, // 231: iam.IAMService.PutRolePermissionBoundary:output_type -> iam.PutRolePermissionBoundaryResponse
-	153, // 232: iam.IAMService.GetRolePermissionBoundary:output_type -> iam.GetRolePermissionBoundaryResponse
-	154, // 233: iam.IAMService.DeleteRolePermissionBoundary:output_type -> iam.DeleteRolePermissionBoundaryResponse
-	155, // 234: iam.IAMService.SimulateAccess:output_type -> iam.SimulateAccessResponse
-	79,  // 235: iam.IAMCommandService.AssumeRole:output_type -> iam.IAMAssumeRoleResponse
-	80,  // 236: iam.IAMCommandService.CreateTenant:output_type -> iam.CreateTenantResponse
-	81,  // 237: iam.IAMCommandService.CreateOrganization:output_type -> iam.CreateOrganizationResponse
-	156, // 238: iam.IAMCommandService.EnsureRootOrganization:output_type -> iam.EnsureRootOrganizationResponse
-	83,  // 239: iam.IAMCommandService.AddOrganizationMember:output_type -> iam.AddOrganizationMemberResponse
-	84,  // 240: iam.IAMCommandService.RemoveOrganizationMember:output_type -> iam.RemoveOrganizationMemberResponse
-	86,  // 241: iam.IAMCommandService.AttachTenantToOrganization:output_type -> iam.AttachTenantToOrganizationResponse
-	87,  // 242: iam.IAMCommandService.DetachTenantFromOrganization:output_type -> iam.DetachTenantFromOrganizationResponse
-	88,  // 243: iam.IAMCommandService.AttachServiceControlPolicy:output_type -> iam.AttachServiceControlPolicyResponse
-	89,  // 244: iam.IAMCommandService.DetachServiceControlPolicy:output_type -> iam.DetachServiceControlPolicyResponse
-	91,  // 245: iam.IAMCommandService.AddTenantMember:output_type -> iam.AddTenantMemberResponse
-	92,  // 246: iam.IAMCommandService.AddTenantMemberByIdentity:output_type -> iam.AddTenantMemberByIdentityResponse
-	93,  // 247: iam.IAMCommandService.CreateTenantInvite:output_type -> iam.CreateTenantInviteResponse
-	95,  // 248: iam.IAMCommandService.RevokeTenantInvite:output_type -> iam.RevokeTenantInviteResponse
-	96,  // 249: iam.IAMCommandService.AcceptTenantInvite:output_type -> iam.AcceptTenantInviteResponse
-	103, // 250: iam.IAMCommandService.AddPlatformRole:output_type -> iam.AddPlatformRoleResponse
-	136, // 251: iam.IAMCommandService.RemovePlatformRole:output_type -> iam.RemovePlatformRoleResponse
-	104, // 252: iam.IAMCommandService.CreatePolicy:output_type -> iam.CreatePolicyResponse
-	105, // 253: iam.IAMCommandService.CreatePolicyVersion:output_type -> iam.CreatePolicyVersionResponse
-	108, // 254: iam.IAMCommandService.SetDefaultPolicyVersion:output_type -> iam.SetDefaultPolicyVersionResponse
-	109, // 255: iam.IAMCommandService.DeletePolicyVersion:output_type -> iam.DeletePolicyVersionResponse
-	112, // 256: iam.IAMCommandService.DeletePolicy:output_type -> iam.DeletePolicyResponse
-	113, // 257: iam.IAMCommandService.CreateGroup:output_type -> iam.CreateGroupResponse
-	115, // 258: iam.IAMCommandService.DeleteGroup:output_type -> iam.DeleteGroupResponse
-	116, // 259: iam.IAMCommandService.AddGroupMember:output_type -> iam.AddGroupMemberResponse
-	118, // 260: iam.IAMCommandService.RemoveGroupMember:output_type -> iam.RemoveGroupMemberResponse
-	119, // 261: iam.IAMCommandService.AttachGroupPolicy:output_type -> iam.AttachGroupPolicyResponse
-	121, // 262: iam.IAMCommandService.DetachGroupPolicy:output_type -> iam.DetachGroupPolicyResponse
-	122, // 263: iam.IAMCommandService.PutGroupInlinePolicy:output_type -> iam.PutGroupInlinePolicyResponse
-	125, // 264: iam.IAMCommandService.DeleteGroupInlinePolicy:output_type -> iam.DeleteGroupInlinePolicyResponse
-	126, // 265: iam.IAMCommandService.PutPlatformUserInlinePolicy:output_type -> iam.PutPlatformUserInlinePolicyResponse
-	129, // 266: iam.IAMCommandService.DeletePlatformUserInlinePolicy:output_type -> iam.DeletePlatformUserInlinePolicyResponse
-	131, // 267: iam.IAMCommandService.AttachPlatformUserPolicy:output_type -> iam.AttachPlatformUserPolicyResponse
-	132, // 268: iam.IAMCommandService.DetachPlatformUserPolicy:output_type -> iam.DetachPlatformUserPolicyResponse
-	133, // 269: iam.IAMCommandService.PutPlatformUserPermissionBoundary:output_type -> iam.PutPlatformUserPermissionBoundaryResponse
-	135, // 270: iam.IAMCommandService.DeletePlatformUserPermissionBoundary:output_type -> iam.DeletePlatformUserPermissionBoundaryResponse
-	138, // 271: iam.IAMCommandService.RemoveTenantMember:output_type -> iam.RemoveTenantMemberResponse
-	139, // 272: iam.IAMCommandService.PutTenantUserInlinePolicy:output_type -> iam.PutTenantUserInlinePolicyResponse
-	142, // 273: iam.IAMCommandService.DeleteTenantUserInlinePolicy:output_type -> iam.DeleteTenantUserInlinePolicyResponse
-	144, // 274: iam.IAMCommandService.AttachTenantUserPolicy:output_type -> iam.AttachTenantUserPolicyResponse
-	145, // 275: iam.IAMCommandService.DetachTenantUserPolicy:output_type -> iam.DetachTenantUserPolicyResponse
-	146, // 276: iam.IAMCommandService.PutTenantUserPermissionBoundary:output_type -> iam.PutTenantUserPermissionBoundaryResponse
-	148, // 277: iam.IAMCommandService.DeleteTenantUserPermissionBoundary:output_type -> iam.DeleteTenantUserPermissionBoundaryResponse
-	149, // 278: iam.IAMCommandService.PutRoleTrustPolicy:output_type -> iam.PutRoleTrustPolicyResponse
-	151, // 279: iam.IAMCommandService.DeleteRoleTrustPolicy:output_type -> iam.DeleteRoleTrustPolicyResponse
-	152, // 280: iam.IAMCommandService.PutRolePermissionBoundary:output_type -> iam.PutRolePermissionBoundaryResponse
-	154, // 281: iam.IAMCommandService.DeleteRolePermissionBoundary:output_type -> iam.DeleteRolePermissionBoundaryResponse
-	82,  // 282: iam.IAMQueryService.ListOrganizations:output_type -> iam.ListOrganizationsResponse
-	85,  // 283: iam.IAMQueryService.ListOrganizationMembers:output_type -> iam.ListOrganizationMembersResponse
-	90,  // 284: iam.IAMQueryService.ListServiceControlPolicies:output_type -> iam.ListServiceControlPoliciesResponse
-	94,  // 285: iam.IAMQueryService.ListTenantInvites:output_type -> iam.ListTenantInvitesResponse
-	97,  // 286: iam.IAMQueryService.GetTenantMembership:output_type -> iam.GetTenantMembershipResponse
-	98,  // 287: iam.IAMQueryService.CheckPermission:output_type -> iam.CheckPermissionResponse
-	98,  // 288: iam.IAMQueryService.CheckPlatformPermission:output_type -> iam.CheckPermissionResponse
-	99,  // 289: iam.IAMQueryService.ListUserTenants:output_type -> iam.ListUserTenantsResponse
-	100, // 290: iam.IAMQueryService.ListPlatformRoles:output_type -> iam.ListPlatformRolesResponse
-	101, // 291: iam.IAMQueryService.ListDirectoryUsers:output_type -> iam.ListDirectoryUsersResponse
-	102, // 292: iam.IAMQueryService.ListPermissions:output_type -> iam.ListPermissionsResponse
-	106, // 293: iam.IAMQueryService.GetPolicy:output_type -> iam.GetPolicyResponse
-	107, // 294: iam.IAMQueryService.ListPolicyVersions:output_type -> iam.ListPolicyVersionsResponse
-	110, // 295: iam.IAMQueryService.ListPolicies:output_type -> iam.ListPoliciesResponse
-	111, // 296: iam.IAMQueryService.ListPolicyAttachments:output_type -> iam.ListPolicyAttachmentsResponse
-	114, // 297: iam.IAMQueryService.ListGroups:output_type -> iam.ListGroupsResponse
-	117, // 298: iam.IAMQueryService.ListGroupMembers:output_type -> iam.ListGroupMembersResponse
-	120, // 299: iam.IAMQueryService.ListGroupPolicies:output_type -> iam.ListGroupPoliciesResponse
-	123, // 300: iam.IAMQueryService.GetGroupInlinePolicy:output_type -> iam.GetGroupInlinePolicyResponse
-	124, // 301: iam.IAMQueryService.ListGroupInlinePolicies:output_type -> iam.ListGroupInlinePoliciesResponse
-	130, // 302: iam.IAMQueryService.ListPlatformUserPolicies:output_type -> iam.ListPlatformUserPoliciesResponse
-	127, // 303: iam.IAMQueryService.GetPlatformUserInlinePolicy:output_type -> iam.GetPlatformUserInlinePolicyResponse
-	128, // 304: iam.IAMQueryService.ListPlatformUserInlinePolicies:output_type -> iam.ListPlatformUserInlinePoliciesResponse
-	134, // 305: iam.IAMQueryService.GetPlatformUserPermissionBoundary:output_type -> iam.GetPlatformUserPermissionBoundaryResponse
-	137, // 306: iam.IAMQueryService.ListTenantMembers:output_type -> iam.ListTenantMembersResponse
-	143, // 307: iam.IAMQueryService.ListTenantUserPolicies:output_type -> iam.ListTenantUserPoliciesResponse
-	140, // 308: iam.IAMQueryService.GetTenantUserInlinePolicy:output_type -> iam.GetTenantUserInlinePolicyResponse
-	141, // 309: iam.IAMQueryService.ListTenantUserInlinePolicies:output_type -> iam.ListTenantUserInlinePoliciesResponse
-	147, // 310: iam.IAMQueryService.GetTenantUserPermissionBoundary:output_type -> iam.GetTenantUserPermissionBoundaryResponse
-	150, // 311: iam.IAMQueryService.GetRoleTrustPolicy:output_type -> iam.GetRoleTrustPolicyResponse
-	153, // 312: iam.IAMQueryService.GetRolePermissionBoundary:output_type -> iam.GetRolePermissionBoundaryResponse
-	155, // 313: iam.IAMQueryService.SimulateAccess:output_type -> iam.SimulateAccessResponse
-	157, // [157:314] is the sub-list for method output_type
-	0,   // [0:157] is the sub-list for method input_type
+	7,   // 7: iam.IAMService.CreateSCIMToken:input_type -> iam.CreateSCIMTokenRequest
+	8,   // 8: iam.IAMService.ListSCIMTokens:input_type -> iam.ListSCIMTokensRequest
+	9,   // 9: iam.IAMService.RevokeSCIMToken:input_type -> iam.RevokeSCIMTokenRequest
+	10,  // 10: iam.IAMService.PutOrganizationSAMLConnection:input_type -> iam.PutOrganizationSAMLConnectionRequest
+	11,  // 11: iam.IAMService.GetOrganizationSAMLConnection:input_type -> iam.GetOrganizationSAMLConnectionRequest
+	12,  // 12: iam.IAMService.DeleteOrganizationSAMLConnection:input_type -> iam.DeleteOrganizationSAMLConnectionRequest
+	13,  // 13: iam.IAMService.AttachTenantToOrganization:input_type -> iam.AttachTenantToOrganizationRequest
+	14,  // 14: iam.IAMService.DetachTenantFromOrganization:input_type -> iam.DetachTenantFromOrganizationRequest
+	15,  // 15: iam.IAMService.AttachServiceControlPolicy:input_type -> iam.AttachServiceControlPolicyRequest
+	16,  // 16: iam.IAMService.DetachServiceControlPolicy:input_type -> iam.DetachServiceControlPolicyRequest
+	17,  // 17: iam.IAMService.ListServiceControlPolicies:input_type -> iam.ListServiceControlPoliciesRequest
+	18,  // 18: iam.IAMService.AddTenantMember:input_type -> iam.AddTenantMemberRequest
+	19,  // 19: iam.IAMService.AddTenantMemberByIdentity:input_type -> iam.AddTenantMemberByIdentityRequest
+	20,  // 20: iam.IAMService.CreateTenantInvite:input_type -> iam.CreateTenantInviteRequest
+	21,  // 21: iam.IAMService.ListTenantInvites:input_type -> iam.ListTenantInvitesRequest
+	22,  // 22: iam.IAMService.RevokeTenantInvite:input_type -> iam.RevokeTenantInviteRequest
+	23,  // 23: iam.IAMService.AcceptTenantInvite:input_type -> iam.AcceptTenantInviteRequest
+	24,  // 24: iam.IAMService.GetTenantMembership:input_type -> iam.GetTenantMembershipRequest
+	25,  // 25: iam.IAMService.CheckPermission:input_type -> iam.CheckPermissionRequest
+	26,  // 26: iam.IAMService.CheckPlatformPermission:input_type -> iam.CheckPlatformPermissionRequest
+	27,  // 27: iam.IAMService.ListUserTenants:input_type -> iam.ListUserTenantsRequest
+	28,  // 28: iam.IAMService.ListPlatformRoles:input_type -> iam.ListPlatformRolesRequest
+	29,  // 29: iam.IAMService.ListDirectoryUsers:input_type -> iam.ListDirectoryUsersRequest
+	30,  // 30: iam.IAMService.ListPermissions:input_type -> iam.ListPermissionsRequest
+	31,  // 31: iam.IAMService.AddPlatformRole:input_type -> iam.AddPlatformRoleRequest
+	32,  // 32: iam.IAMService.CreatePolicy:input_type -> iam.CreatePolicyRequest
+	33,  // 33: iam.IAMService.CreatePolicyVersion:input_type -> iam.CreatePolicyVersionRequest
+	34,  // 34: iam.IAMService.GetPolicy:input_type -> iam.GetPolicyRequest
+	35,  // 35: iam.IAMService.ListPolicyVersions:input_type -> iam.ListPolicyVersionsRequest
+	36,  // 36: iam.IAMService.SetDefaultPolicyVersion:input_type -> iam.SetDefaultPolicyVersionRequest
+	37,  // 37: iam.IAMService.DeletePolicyVersion:input_type -> iam.DeletePolicyVersionRequest
+	38,  // 38: iam.IAMService.ListPolicies:input_type -> iam.ListPoliciesRequest
+	39,  // 39: iam.IAMService.ListPolicyAttachments:input_type -> iam.ListPolicyAttachmentsRequest
+	40,  // 40: iam.IAMService.DeletePolicy:input_type -> iam.DeletePolicyRequest
+	41,  // 41: iam.IAMService.ValidatePolicy:input_type -> iam.ValidatePolicyRequest
+	42,  // 42: iam.IAMService.CreateGroup:input_type -> iam.CreateGroupRequest
+	43,  // 43: iam.IAMService.ListGroups:input_type -> iam.ListGroupsRequest
+	44,  // 44: iam.IAMService.DeleteGroup:input_type -> iam.DeleteGroupRequest
+	45,  // 45: iam.IAMService.AddGroupMember:input_type -> iam.AddGroupMemberRequest
+	46,  // 46: iam.IAMService.ListGroupMembers:input_type -> iam.ListGroupMembersRequest
+	47,  // 47: iam.IAMService.RemoveGroupMember:input_type -> iam.RemoveGroupMemberRequest
+	48,  // 48: iam.IAMService.AttachGroupPolicy:input_type -> iam.AttachGroupPolicyRequest
+	49,  // 49: iam.IAMService.ListGroupPolicies:input_type -> iam.ListGroupPoliciesRequest
+	50,  // 50: iam.IAMService.DetachGroupPolicy:input_type -> iam.DetachGroupPolicyRequest
+	51,  // 51: iam.IAMService.PutGroupInlinePolicy:input_type -> iam.PutGroupInlinePolicyRequest
+	52,  // 52: iam.IAMService.GetGroupInlinePolicy:input_type -> iam.GetGroupInlinePolicyRequest
+	53,  // 53: iam.IAMService.ListGroupInlinePolicies:input_type -> iam.ListGroupInlinePoliciesRequest
+	54,  // 54: iam.IAMService.DeleteGroupInlinePolicy:input_type -> iam.DeleteGroupInlinePolicyRequest
+	55,  // 55: iam.IAMService.PutPlatformUserInlinePolicy:input_type -> iam.PutPlatformUserInlinePolicyRequest
+	56,  // 56: iam.IAMService.GetPlatformUserInlinePolicy:input_type -> iam.GetPlatformUserInlinePolicyRequest
+	57,  // 57: iam.IAMService.ListPlatformUserInlinePolicies:input_type -> iam.ListPlatformUserInlinePoliciesRequest
+	58,  // 58: iam.IAMService.DeletePlatformUserInlinePolicy:input_type -> iam.DeletePlatformUserInlinePolicyRequest
+	59,  // 59: iam.IAMService.ListPlatformUserPolicies:input_type -> iam.ListPlatformUserPoliciesRequest
+	60,  // 60: iam.IAMService.AttachPlatformUserPolicy:input_type -> iam.AttachPlatformUserPolicyRequest
+	61,  // 61: iam.IAMService.DetachPlatformUserPolicy:input_type -> iam.DetachPlatformUserPolicyRequest
+	62,  // 62: iam.IAMService.PutPlatformUserPermissionBoundary:input_type -> iam.PutPlatformUserPermissionBoundaryRequest
+	63,  // 63: iam.IAMService.GetPlatformUserPermissionBoundary:input_type -> iam.GetPlatformUserPermissionBoundaryRequest
+	64,  // 64: iam.IAMService.DeletePlatformUserPermissionBoundary:input_type -> iam.DeletePlatformUserPermissionBoundaryRequest
+	65,  // 65: iam.IAMService.RemovePlatformRole:input_type -> iam.RemovePlatformRoleRequest
+	66,  // 66: iam.IAMService.ListTenantMembers:input_type -> iam.ListTenantMembersRequest
+	67,  // 67: iam.IAMService.RemoveTenantMember:input_type -> iam.RemoveTenantMemberRequest
+	68,  // 68: iam.IAMService.PutTenantUserInlinePolicy:input_type -> iam.PutTenantUserInlinePolicyRequest
+	69,  // 69: iam.IAMService.GetTenantUserInlinePolicy:input_type -> iam.GetTenantUserInlinePolicyRequest
+	70,  // 70: iam.IAMService.ListTenantUserInlinePolicies:input_type -> iam.ListTenantUserInlinePoliciesRequest
+	71,  // 71: iam.IAMService.DeleteTenantUserInlinePolicy:input_type -> iam.DeleteTenantUserInlinePolicyRequest
+	72,  // 72: iam.IAMService.ListTenantUserPolicies:input_type -> iam.ListTenantUserPoliciesRequest
+	73,  // 73: iam.IAMService.AttachTenantUserPolicy:input_type -> iam.AttachTenantUserPolicyRequest
+	74,  // 74: iam.IAMService.DetachTenantUserPolicy:input_type -> iam.DetachTenantUserPolicyRequest
+	75,  // 75: iam.IAMService.PutTenantUserPermissionBoundary:input_type -> iam.PutTenantUserPermissionBoundaryRequest
+	76,  // 76: iam.IAMService.GetTenantUserPermissionBoundary:input_type -> iam.GetTenantUserPermissionBoundaryRequest
+	77,  // 77: iam.IAMService.DeleteTenantUserPermissionBoundary:input_type -> iam.DeleteTenantUserPermissionBoundaryRequest
+	78,  // 78: iam.IAMService.PutRoleTrustPolicy:input_type -> iam.PutRoleTrustPolicyRequest
+	79,  // 79: iam.IAMService.GetRoleTrustPolicy:input_type -> iam.GetRoleTrustPolicyRequest
+	80,  // 80: iam.IAMService.DeleteRoleTrustPolicy:input_type -> iam.DeleteRoleTrustPolicyRequest
+	81,  // 81: iam.IAMService.PutRolePermissionBoundary:input_type -> iam.PutRolePermissionBoundaryRequest
+	82,  // 82: iam.IAMService.GetRolePermissionBoundary:input_type -> iam.GetRolePermissionBoundaryRequest
+	83,  // 83: iam.IAMService.DeleteRolePermissionBoundary:input_type -> iam.DeleteRolePermissionBoundaryRequest
+	84,  // 84: iam.IAMService.PutRoleAccessPolicy:input_type -> iam.PutRoleAccessPolicyRequest
+	85,  // 85: iam.IAMService.GetRoleAccessPolicy:input_type -> iam.GetRoleAccessPolicyRequest
+	86,  // 86: iam.IAMService.DeleteRoleAccessPolicy:input_type -> iam.DeleteRoleAccessPolicyRequest
+	87,  // 87: iam.IAMService.CreateAccessRequest:input_type -> iam.CreateAccessRequestRequest
+	88,  // 88: iam.IAMService.GetAccessRequest:input_type -> iam.GetAccessRequestRequest
+	89,  // 89: iam.IAMService.ListAccessRequests:input_type -> iam.ListAccessRequestsRequest
+	90,  // 90: iam.IAMService.ApproveAccessRequest:input_type -> iam.ApproveAccessRequestRequest
+	91,  // 91: iam.IAMService.DenyAccessRequest:input_type -> iam.DenyAccessRequestRequest
+	92,  // 92: iam.IAMService.CancelAccessRequest:input_type -> iam.CancelAccessRequestRequest
+	93,  // 93: iam.IAMService.SimulateAccess:input_type -> iam.SimulateAccessRequest
+	94,  // 94: iam.IAMService.ListUnusedPermissions:input_type -> iam.ListUnusedPermissionsRequest
+	95,  // 95: iam.IAMService.ListUnusedRoles:input_type -> iam.ListUnusedRolesRequest
+	96,  // 96: iam.IAMService.ListStaleGroupMemberships:input_type -> iam.ListStaleGroupMembershipsRequest
+	97,  // 97: iam.IAMService.GenerateLeastPrivilegePolicy:input_type -> iam.GenerateLeastPrivilegePolicyRequest
+	0,   // 98: iam.IAMCommandService.AssumeRole:input_type -> iam.IAMAssumeRoleRequest
+	1,   // 99: iam.IAMCommandService.CreateTenant:input_type -> iam.CreateTenantRequest
+	2,   // 100: iam.IAMCommandService.CreateOrganization:input_type -> iam.CreateOrganizationRequest
+	98,  // 101: iam.IAMCommandService.EnsureRootOrganization:input_type -> iam.EnsureRootOrganizationRequest
+	4,   // 102: iam.IAMCommandService.AddOrganizationMember:input_type -> iam.AddOrganizationMemberRequest
+	5,   // 103: iam.IAMCommandService.RemoveOrganizationMember:input_type -> iam.RemoveOrganizationMemberRequest
+	7,   // 104: iam.IAMCommandService.CreateSCIMToken:input_type -> iam.CreateSCIMTokenRequest
+	9,   // 105: iam.IAMCommandService.RevokeSCIMToken:input_type -> iam.RevokeSCIMTokenRequest
+	10,  // 106: iam.IAMCommandService.PutOrganizationSAMLConnection:input_type -> iam.PutOrganizationSAMLConnectionRequest
+	12,  // 107: iam.IAMCommandService.DeleteOrganizationSAMLConnection:input_type -> iam.DeleteOrganizationSAMLConnectionRequest
+	99,  // 108: iam.IAMCommandService.ApplySAMLLogin:input_type -> iam.ApplySAMLLoginRequest
+	13,  // 109: iam.IAMCommandService.AttachTenantToOrganization:input_type -> iam.AttachTenantToOrganizationRequest
+	14,  // 110: iam.IAMCommandService.DetachTenantFromOrganization:input_type -> iam.DetachTenantFromOrganizationRequest
+	15,  // 111: iam.IAMCommandService.AttachServiceControlPolicy:input_type -> iam.AttachServiceControlPolicyRequest
+	16,  // 112: iam.IAMCommandService.DetachServiceControlPolicy:input_type -> iam.DetachServiceControlPolicyRequest
+	18,  // 113: iam.IAMCommandService.AddTenantMember:input_type -> iam.AddTenantMemberRequest
+	19,  // 114: iam.IAMCommandService.AddTenantMemberByIdentity:input_type -> iam.AddTenantMemberByIdentityRequest
+	20,  // 115: iam.IAMCommandService.CreateTenantInvite:input_type -> iam.CreateTenantInviteRequest
+	22,  // 116: iam.IAMCommandService.RevokeTenantInvite:input_type -> iam.RevokeTenantInviteRequest
+	23,  // 117: iam.IAMCommandService.AcceptTenantInvite:input_type -> iam.AcceptTenantInviteRequest
+	31,  // 118: iam.IAMCommandService.AddPlatformRole:input_type -> iam.AddPlatformRoleRequest
+	65,  // 119: iam.IAMCommandService.RemovePlatformRole:input_type -> iam.RemovePlatformRoleRequest
+	32,  // 120: iam.IAMCommandService.CreatePolicy:input_type -> iam.CreatePolicyRequest
+	33,  // 121: iam.IAMCommandService.CreatePolicyVersion:input_type -> iam.CreatePolicyVersionRequest
+	36,  // 122: iam.IAMCommandService.SetDefaultPolicyVersion:input_type -> iam.SetDefaultPolicyVersionRequest
+	37,  // 123: iam.IAMCommandService.DeletePolicyVersion:input_type -> iam.DeletePolicyVersionRequest
+	40,  // 124: iam.IAMCommandService.DeletePolicy:input_type -> iam.DeletePolicyRequest
+	42,  // 125: iam.IAMCommandService.CreateGroup:input_type -> iam.CreateGroupRequest
+	44,  // 126: iam.IAMCommandService.DeleteGroup:input_type -> iam.DeleteGroupRequest
+	45,  // 127: iam.IAMCommandService.AddGroupMember:input_type -> iam.AddGroupMemberRequest
+	47,  // 128: iam.IAMCommandService.RemoveGroupMember:input_type -> iam.RemoveGroupMemberRequest
+	48,  // 129: iam.IAMCommandService.AttachGroupPolicy:input_type -> iam.AttachGroupPolicyRequest
+	50,  // 130: iam.IAMCommandService.DetachGroupPolicy:input_type -> iam.DetachGroupPolicyRequest
+	51,  // 131: iam.IAMCommandService.PutGroupInlinePolicy:input_type -> iam.PutGroupInlinePolicyRequest
+	54,  // 132: iam.IAMCommandService.DeleteGroupInlinePolicy:input_type -> iam.DeleteGroupInlinePolicyRequest
+	55,  // 133: iam.IAMCommandService.PutPlatformUserInlinePolicy:input_type -> iam.PutPlatformUserInlinePolicyRequest
+	58,  // 134: iam.IAMCommandService.DeletePlatformUserInlinePolicy:input_type -> iam.DeletePlatformUserInlinePolicyRequest
+	60,  // 135: iam.IAMCommandService.AttachPlatformUserPolicy:input_type -> iam.AttachPlatformUserPolicyRequest
+	61,  // 136: iam.IAMCommandService.DetachPlatformUserPolicy:input_type -> iam.DetachPlatformUserPolicyRequest
+	62,  // 137: iam.IAMCommandService.PutPlatformUserPermissionBoundary:input_type -> iam.PutPlatformUserPermissionBoundaryRequest
+	64,  // 138: iam.IAMCommandService.DeletePlatformUserPermissionBoundary:input_type -> iam.DeletePlatformUserPermissionBoundaryRequest
+	67,  // 139: iam.IAMCommandService.RemoveTenantMember:input_type -> iam.RemoveTenantMemberRequest
+	68,  // 140: iam.IAMCommandService.PutTenantUserInlinePolicy:input_type -> iam.PutTenantUserInlinePolicyRequest
+	71,  // 141: iam.IAMCommandService.DeleteTenantUserInlinePolicy:input_type -> iam.DeleteTenantUserInlinePolicyRequest
+	73,  // 142: iam.IAMCommandService.AttachTenantUserPolicy:input_type -> iam.AttachTenantUserPolicyRequest
+	74,  // 143: iam.IAMCommandService.DetachTenantUserPolicy:input_type -> iam.DetachTenantUserPolicyRequest
+	75,  // 144: iam.IAMCommandService.PutTenantUserPermissionBoundary:input_type -> iam.PutTenantUserPermissionBoundaryRequest
+	77,  // 145: iam.IAMCommandService.DeleteTenantUserPermissionBoundary:input_type -> iam.DeleteTenantUserPermissionBoundaryRequest
+	78,  // 146: iam.IAMCommandService.PutRoleTrustPolicy:input_type -> iam.PutRoleTrustPolicyRequest
+	80,  // 147: iam.IAMCommandService.DeleteRoleTrustPolicy:input_type -> iam.DeleteRoleTrustPolicyRequest
+	81,  // 148: iam.IAMCommandService.PutRolePermissionBoundary:input_type -> iam.PutRolePermissionBoundaryRequest
+	83,  // 149: iam.IAMCommandService.DeleteRolePermissionBoundary:input_type -> iam.DeleteRolePermissionBoundaryRequest
+	84,  // 150: iam.IAMCommandService.PutRoleAccessPolicy:input_type -> iam.PutRoleAccessPolicyRequest
+	86,  // 151: iam.IAMCommandService.DeleteRoleAccessPolicy:input_type -> iam.DeleteRoleAccessPolicyRequest
+	87,  // 152: iam.IAMCommandService.CreateAccessRequest:input_type -> iam.CreateAccessRequestRequest
+	90,  // 153: iam.IAMCommandService.ApproveAccessRequest:input_type -> iam.ApproveAccessRequestRequest
+	91,  // 154: iam.IAMCommandService.DenyAccessRequest:input_type -> iam.DenyAccessRequestRequest
+	92,  // 155: iam.IAMCommandService.CancelAccessRequest:input_type -> iam.CancelAccessRequestRequest
+	3,   // 156: iam.IAMQueryService.ListOrganizations:input_type -> iam.ListOrganizationsRequest
+	6,   // 157: iam.IAMQueryService.ListOrganizationMembers:input_type -> iam.ListOrganizationMembersRequest
+	8,   // 158: iam.IAMQueryService.ListSCIMTokens:input_type -> iam.ListSCIMTokensRequest
+	11,  // 159: iam.IAMQueryService.GetOrganizationSAMLConnection:input_type -> iam.GetOrganizationSAMLConnectionRequest
+	100, // 160: iam.IAMQueryService.ResolveSAMLConnection:input_type -> iam.ResolveSAMLConnectionRequest
+	101, // 161: iam.IAMQueryService.ListSSORequiredOrganizations:input_type -> iam.ListSSORequiredOrganizationsRequest
+	102, // 162: iam.IAMQueryService.GetOrganizationMembership:input_type -> iam.GetOrganizationMembershipRequest
+	17,  // 163: iam.IAMQueryService.ListServiceControlPolicies:input_type -> iam.ListServiceControlPoliciesRequest
+	21,  // 164: iam.IAMQueryService.ListTenantInvites:input_type -> iam.ListTenantInvitesRequest
+	24,  // 165: iam.IAMQueryService.GetTenantMembership:input_type -> iam.GetTenantMembershipRequest
+	25,  // 166: iam.IAMQueryService.CheckPermission:input_type -> iam.CheckPermissionRequest
+	26,  // 167: iam.IAMQueryService.CheckPlatformPermission:input_type -> iam.CheckPlatformPermissionRequest
+	27,  // 168: iam.IAMQueryService.ListUserTenants:input_type -> iam.ListUserTenantsRequest
+	28,  // 169: iam.IAMQueryService.ListPlatformRoles:input_type -> iam.ListPlatformRolesRequest
+	29,  // 170: iam.IAMQueryService.ListDirectoryUsers:input_type -> iam.ListDirectoryUsersRequest
+	30,  // 171: iam.IAMQueryService.ListPermissions:input_type -> iam.ListPermissionsRequest
+	34,  // 172: iam.IAMQueryService.GetPolicy:input_type -> iam.GetPolicyRequest
+	35,  // 173: iam.IAMQueryService.ListPolicyVersions:input_type -> iam.ListPolicyVersionsRequest
+	38,  // 174: iam.IAMQueryService.ListPolicies:input_type -> iam.ListPoliciesRequest
+	39,  // 175: iam.IAMQueryService.ListPolicyAttachments:input_type -> iam.ListPolicyAttachmentsRequest
+	41,  // 176: iam.IAMQueryService.ValidatePolicy:input_type -> iam.ValidatePolicyRequest
+	43,  // 177: iam.IAMQueryService.ListGroups:input_type -> iam.ListGroupsRequest
+	46,  // 178: iam.IAMQueryService.ListGroupMembers:input_type -> iam.ListGroupMembersRequest
+	49,  // 179: iam.IAMQueryService.ListGroupPolicies:input_type -> iam.ListGroupPoliciesRequest
+	52,  // 180: iam.IAMQueryService.GetGroupInlinePolicy:input_type -> iam.GetGroupInlinePolicyRequest
+	53,  // 181: iam.IAMQueryService.ListGroupInlinePolicies:input_type -> iam.ListGroupInlinePoliciesRequest
+	59,  // 182: iam.IAMQueryService.ListPlatformUserPolicies:input_type -> iam.ListPlatformUserPoliciesRequest
+	56,  // 183: iam.IAMQueryService.GetPlatformUserInlinePolicy:input_type -> iam.GetPlatformUserInlinePolicyRequest
+	57,  // 184: iam.IAMQueryService.ListPlatformUserInlinePolicies:input_type -> iam.ListPlatformUserInlinePoliciesRequest
+	63,  // 185: iam.IAMQueryService.GetPlatformUserPermissionBoundary:input_type -> iam.GetPlatformUserPermissionBoundaryRequest
+	66,  // 186: iam.IAMQueryService.ListTenantMembers:input_type -> iam.ListTenantMembersRequest
+	72,  // 187: iam.IAMQueryService.ListTenantUserPolicies:input_type -> iam.ListTenantUserPoliciesRequest
+	69,  // 188: iam.IAMQueryService.GetTenantUserInlinePolicy:input_type -> iam.GetTenantUserInlinePolicyRequest
+	70,  // 189: iam.IAMQueryService.ListTenantUserInlinePolicies:input_type -> iam.ListTenantUserInlinePoliciesRequest
+	76,  // 190: iam.IAMQueryService.GetTenantUserPermissionBoundary:input_type -> iam.GetTenantUserPermissionBoundaryRequest
+	79,  // 191: iam.IAMQueryService.GetRoleTrustPolicy:input_type -> iam.GetRoleTrustPolicyRequest
+	82,  // 192: iam.IAMQueryService.GetRolePermissionBoundary:input_type -> iam.GetRolePermissionBoundaryRequest
+	85,  // 193: iam.IAMQueryService.GetRoleAccessPolicy:input_type -> iam.GetRoleAccessPolicyRequest
+	88,  // 194: iam.IAMQueryService.GetAccessRequest:input_type -> iam.GetAccessRequestRequest
+	89,  // 195: iam.IAMQueryService.ListAccessRequests:input_type -> iam.ListAccessRequestsRequest
+	93,  // 196: iam.IAMQueryService.SimulateAccess:input_type -> iam.SimulateAccessRequest
+	94,  // 197: iam.IAMQueryService.ListUnusedPermissions:input_type -> iam.ListUnusedPermissionsRequest
+	95,  // 198: iam.IAMQueryService.ListUnusedRoles:input_type -> iam.ListUnusedRolesRequest
+	96,  // 199: iam.IAMQueryService.ListStaleGroupMemberships:input_type -> iam.ListStaleGroupMembershipsRequest
+	97,  // 200: iam.IAMQueryService.GenerateLeastPrivilegePolicy:input_type -> iam.GenerateLeastPrivilegePolicyRequest
+	103, // 201: iam.IAMService.AssumeRole:output_type -> iam.IAMAssumeRoleResponse
+	104, // 202: iam.IAMService.CreateTenant:output_type -> iam.CreateTenantResponse
+	105, // 203: iam.IAMService.CreateOrganization:output_type -> iam.CreateOrganizationResponse
+	106, // 204: iam.IAMService.ListOrganizations:output_type -> iam.ListOrganizationsResponse
+	107, // 205: iam.IAMService.AddOrganizationMember:output_type -> iam.AddOrganizationMemberResponse
+	108, // 206: iam.IAMService.RemoveOrganizationMember:output_type -> iam.RemoveOrganizationMemberResponse
+	109, // 207: iam.IAMService.ListOrganizationMembers:output_type -> iam.ListOrganizationMembersResponse
+	110, // 208: iam.IAMService.CreateSCIMToken:output_type -> iam.CreateSCIMTokenResponse
+	111, // 209: iam.IAMService.ListSCIMTokens:output_type -> iam.ListSCIMTokensResponse
+	112, // 210: iam.IAMService.RevokeSCIMToken:output_type -> iam.RevokeSCIMTokenResponse
+	113, // 211: iam.IAMService.PutOrganizationSAMLConnection:output_type -> iam.PutOrganizationSAMLConnectionResponse
+	114, // 212: iam.IAMService.GetOrganizationSAMLConnection:output_type -> iam.GetOrganizationSAMLConnectionResponse
+	115, // 213: iam.IAMService.DeleteOrganizationSAMLConnection:output_type -> iam.DeleteOrganizationSAMLConnectionResponse
+	116, // 214: iam.IAMService.AttachTenantToOrganization:output_type -> iam.AttachTenantToOrganizationResponse
+	117, // 215: iam.IAMService.DetachTenantFromOrganization:output_type -> iam.DetachTenantFromOrganizationResponse
+	118, // 216: iam.IAMService.AttachServiceControlPolicy:output_type -> iam.AttachServiceControlPolicyResponse
+	119, // 217: iam.IAMService.DetachServiceControlPolicy:output_type -> iam.DetachServiceControlPolicyResponse
+	120, // 218: iam.IAMService.ListServiceControlPolicies:output_type -> iam.ListServiceControlPoliciesResponse
+	121, // 219: iam.IAMService.AddTenantMember:output_type -> iam.AddTenantMemberResponse
+	122, // 220: iam.IAMService.AddTenantMemberByIdentity:output_type -> iam.AddTenantMemberByIdentityResponse
+	123, // 221: iam.IAMService.CreateTenantInvite:output_type -> iam.CreateTenantInviteResponse
+	124, // 222: iam.IAMService.ListTenantInvites:output_type -> iam.ListTenantInvitesResponse
+	125, // 223: iam.IAMService.RevokeTenantInvite:output_type -> iam.RevokeTenantInviteResponse
+	126, // 224: iam.IAMService.AcceptTenantInvite:output_type -> iam.AcceptTenantInviteResponse
+	127, // 225: iam.IAMService.GetTenantMembership:output_type -> iam.GetTenantMembershipResponse
+	128, // 226: iam.IAMService.CheckPermission:output_type -> iam.CheckPermissionResponse
+	128, // 227: iam.IAMService.CheckPlatformPermission:output_type -> iam.CheckPermissionResponse
+	129, // 228: iam.IAMService.ListUserTenants:output_type -> iam.ListUserTenantsResponse
+	130, // 229: iam.IAMService.ListPlatformRoles:output_type -> iam.ListPlatformRolesResponse
+	131, // 230: iam.IAMService.ListDirectoryUsers:output_type -> iam.ListDirectoryUsersResponse
+	132, // 231: iam.IAMService.ListPermissions:output_type -> iam.ListPermissionsResponse
+	133, // 232: iam.IAMService.AddPlatformRole:output_type -> iam.AddPlatformRoleResponse
+	134, // 233: iam.IAMService.CreatePolicy:output_type -> iam.CreatePolicyResponse
+	135, // 234: iam.IAMService.CreatePolicyVersion:output_type -> iam.CreatePolicyVersionResponse
+	136, // 235: iam.IAMService.GetPolicy:output_type -> iam.GetPolicyResponse
+	137, // 236: iam.IAMService.ListPolicyVersions:output_type -> iam.ListPolicyVersionsResponse
+	138, // 237: iam.IAMService.SetDefaultPolicyVersion:output_type -> iam.SetDefaultPolicyVersionResponse
+	139, // 238: iam.IAMService.DeletePolicyVersion:output_type -> iam.DeletePolicyVersionResponse
+	140, // 239: iam.IAMService.ListPolicies:output_type -> iam.ListPoliciesResponse
+	141, // 240: iam.IAMService.ListPolicyAttachments:output_type -> iam.ListPolicyAttachmentsResponse
+	142, // 241: iam.IAMService.DeletePolicy:output_type -> iam.DeletePolicyResponse
+	143, // 242: iam.IAMService.ValidatePolicy:output_type -> iam.ValidatePolicyResponse
+	144, // 243: iam.IAMService.CreateGroup:output_type -> iam.CreateGroupResponse
+	145, // 244: iam.IAMService.ListGroups:output_type -> iam.ListGroupsResponse
+	146, // 245: iam.IAMService.DeleteGroup:output_type -> iam.DeleteGroupResponse
+	147, // 246: iam.IAMService.AddGroupMember:output_type -> iam.AddGroupMemberResponse
+	148, // 247: iam.IAMService.ListGroupMembers:output_type -> iam.ListGroupMembersResponse
+	149, // 248: iam.IAMService.RemoveGroupMember:output_type -> iam.RemoveGroupMemberResponse
+	150, // 249: iam.IAMService.AttachGroupPolicy:output_type -> iam.AttachGroupPolicyResponse
+	151, // 250: iam.IAMService.ListGroupPolicies:output_type -> iam.ListGroupPoliciesResponse
+	152, // 251: iam.IAMService.DetachGroupPolicy:output_type -> iam.DetachGroupPolicyResponse
+	153, // 252: iam.IAMService.PutGroupInlinePolicy:output_type -> iam.PutGroupInlinePolicyResponse
+	154, // 253: iam.IAMService.GetGroupInlinePolicy:output_type -> iam.GetGroupInlinePolicyResponse
+	155, // 254: iam.IAMService.ListGroupInlinePolicies:output_type -> iam.ListGroupInlinePoliciesResponse
+	156, // 255: iam.IAMService.DeleteGroupInlinePolicy:output_type -> iam.DeleteGroupInlinePolicyResponse
+	157, // 256: iam.IAMService.PutPlatformUserInlinePolicy:output_type -> iam.PutPlatformUserInlinePolicyResponse
+	158, // 257: iam.IAMService.GetPlatformUserInlinePolicy:output_type -> iam.GetPlatformUserInlinePolicyResponse
+	159, // 258: iam.IAMService.ListPlatformUserInlinePolicies:output_type -> iam.ListPlatformUserInlinePoliciesResponse
+	160, // 259: iam.IAMService.DeletePlatformUserInlinePolicy:output_type -> iam.DeletePlatformUserInlinePolicyResponse
+	161, // 260: iam.IAMService.ListPlatformUserPolicies:output_type -> iam.ListPlatformUserPoliciesResponse
+	162, // 261: iam.IAMService.AttachPlatformUserPolicy:output_type -> iam.AttachPlatformUserPolicyResponse
+	163, // 262: iam.IAMService.DetachPlatformUserPolicy:output_type -> iam.DetachPlatformUserPolicyResponse
+	164, // 263: iam.IAMService.PutPlatformUserPermissionBoundary:output_type -> iam.PutPlatformUserPermissionBoundaryResponse
+	165, // 264: iam.IAMService.GetPlatformUserPermissionBoundary:output_type -> iam.GetPlatformUserPermissionBoundaryResponse
+	166, // 265: iam.IAMService.DeletePlatformUserPermissionBoundary:output_type -> iam.DeletePlatformUserPermissionBoundaryResponse
+	167, // 266: iam.IAMService.RemovePlatformRole:output_type -> iam.RemovePlatformRoleResponse
+	168, // 267: iam.IAMService.ListTenantMembers:output_type -> iam.ListTenantMembersResponse
+	169, // 268: iam.IAMService.RemoveTenantMember:output_type -> iam.RemoveTenantMemberResponse
+	170, // 269: iam.IAMService.PutTenantUserInlinePolicy:output_type -> iam.PutTenantUserInlinePolicyResponse
+	171, // 270: iam.IAMService.GetTenantUserInlinePolicy:output_type -> iam.GetTenantUserInlinePolicyResponse
+	172, // 271: iam.IAMService.ListTenantUserInlinePolicies:output_type -> iam.ListTenantUserInlinePoliciesResponse
+	173, // 272: iam.IAMService.DeleteTenantUserInlinePolicy:output_type -> iam.DeleteTenantUserInlinePolicyResponse
+	174, // 273: iam.IAMService.ListTenantUserPolicies:output_type -> iam.ListTenantUserPoliciesResponse
+	175, // 274: iam.IAMService.AttachTenantUserPolicy:output_type -> iam.AttachTenantUserPolicyResponse
+	176, // 275: iam.IAMService.DetachTenantUserPolicy:output_type -> iam.DetachTenantUserPolicyResponse
+	177, // 276: iam.IAMService.PutTenantUserPermissionBoundary:output_type -> iam.PutTenantUserPermissionBoundaryResponse
+	178, // 277: iam.IAMService.GetTenantUserPermissionBoundary:output_type -> iam.GetTenantUserPermissionBoundaryResponse
+	179, // 278: iam.IAMService.DeleteTenantUserPermissionBoundary:output_type -> iam.DeleteTenantUserPermissionBoundaryResponse
+	180, // 279: iam.IAMService.PutRoleTrustPolicy:output_type -> iam.PutRoleTrustPolicyResponse
+	181, // 280: iam.IAMService.GetRoleTrustPolicy:output_type -> iam.GetRoleTrustPolicyResponse
+	182, // 281: iam.IAMService.DeleteRoleTrustPolicy:output_type -> iam.DeleteRoleTrustPolicyResponse
+	183, // 282: iam.IAMService.PutRolePermissionBoundary:output_type -> iam.PutRolePermissionBoundaryResponse
+	184, // 283: iam.IAMService.GetRolePermissionBoundary:output_type -> iam.GetRolePermissionBoundaryResponse
+	185, // 284: iam.IAMService.DeleteRolePermissionBoundary:output_type -> iam.DeleteRolePermissionBoundaryResponse
+	186, // 285: iam.IAMService.PutRoleAccessPolicy:output_type -> iam.PutRoleAccessPolicyResponse
+	187, // 286: iam.IAMService.GetRoleAccessPolicy:output_type -> iam.GetRoleAccessPolicyResponse
+	188, // 287: iam.IAMService.DeleteRoleAccessPolicy:output_type -> iam.DeleteRoleAccessPolicyResponse
+	189, // 288: iam.IAMService.CreateAccessRequest:output_type -> iam.CreateAccessRequestResponse
+	190, // 289: iam.IAMService.GetAccessRequest:output_type -> iam.GetAccessRequestResponse
+	191, // 290: iam.IAMService.ListAccessRequests:output_type -> iam.ListAccessRequestsResponse
+	192, // 291: iam.IAMService.ApproveAccessRequest:output_type -> iam.ApproveAccessRequestResponse
+	193, // 292: iam.IAMService.DenyAccessRequest:output_type -> iam.DenyAccessRequestResponse
+	194, // 293: iam.IAMService.CancelAccessRequest:output_type -> iam.CancelAccessRequestResponse
+	195, // 294: iam.IAMService.SimulateAccess:output_type -> iam.SimulateAccessResponse
+	196, // 295: iam.IAMService.ListUnusedPermissions:output_type -> iam.ListUnusedPermissionsResponse
+	197, // 296: iam.IAMService.ListUnusedRoles:output_type -> iam.ListUnusedRolesResponse
+	198, // 297: iam.IAMService.ListStaleGroupMemberships:output_type -> iam.ListStaleGroupMembershipsResponse
+	199, // 298: iam.IAMService.GenerateLeastPrivilegePolicy:output_type -> iam.GenerateLeastPrivilegePolicyResponse
+	103, // 299: iam.IAMCommandService.AssumeRole:output_type -> iam.IAMAssumeRoleResponse
+	104, // 300: iam.IAMCommandService.CreateTenant:output_type -> iam.CreateTenantResponse
+	105, // 301: iam.IAMCommandService.CreateOrganization:output_type -> iam.CreateOrganizationResponse
+	200, // 302: iam.IAMCommandService.EnsureRootOrganization:output_type -> iam.EnsureRootOrganizationResponse
+	107, // 303: iam.IAMCommandService.AddOrganizationMember:output_type -> iam.AddOrganizationMemberResponse
+	108, // 304: iam.IAMCommandService.RemoveOrganizationMember:output_type -> iam.RemoveOrganizationMemberResponse
+	110, // 305: iam.IAMCommandService.CreateSCIMToken:output_type -> iam.CreateSCIMTokenResponse
+	112, // 306: iam.IAMCommandService.RevokeSCIMToken:output_type -> iam.RevokeSCIMTokenResponse
+	113, // 307: iam.IAMCommandService.PutOrganizationSAMLConnection:output_type -> iam.PutOrganizationSAMLConnectionResponse
+	115, // 308: iam.IAMCommandService.DeleteOrganizationSAMLConnection:output_type -> iam.DeleteOrganizationSAMLConnectionResponse
+	201, // 309: iam.IAMCommandService.ApplySAMLLogin:output_type -> iam.ApplySAMLLoginResponse
+	116, // 310: iam.IAMCommandService.AttachTenantToOrganization:output_type -> iam.AttachTenantToOrganizationResponse
+	117, // 311: iam.IAMCommandService.DetachTenantFromOrganization:output_type -> iam.DetachTenantFromOrganizationResponse
+	118, // 312: iam.IAMCommandService.AttachServiceControlPolicy:output_type -> iam.AttachServiceControlPolicyResponse
+	119, // 313: iam.IAMCommandService.DetachServiceControlPolicy:output_type -> iam.DetachServiceControlPolicyResponse
+	121, // 314: iam.IAMCommandService.AddTenantMember:output_type -> iam.AddTenantMemberResponse
+	122, // 315: iam.IAMCommandService.AddTenantMemberByIdentity:output_type -> iam.AddTenantMemberByIdentityResponse
+	123, // 316: iam.IAMCommandService.CreateTenantInvite:output_type -> iam.CreateTenantInviteResponse
+	125, // 317: iam.IAMCommandService.RevokeTenantInvite:output_type -> iam.RevokeTenantInviteResponse
+	126, // 318: iam.IAMCommandService.AcceptTenantInvite:output_type -> iam.AcceptTenantInviteResponse
+	133, // 319: iam.IAMCommandService.AddPlatformRole:output_type -> iam.AddPlatformRoleResponse
+	167, // 320: iam.IAMCommandService.RemovePlatformRole:output_type -> iam.RemovePlatformRoleResponse
+	134, // 321: iam.IAMCommandService.CreatePolicy:output_type -> iam.CreatePolicyResponse
+	135, // 322: iam.IAMCommandService.CreatePolicyVersion:output_type -> iam.CreatePolicyVersionResponse
+	138, // 323: iam.IAMCommandService.SetDefaultPolicyVersion:output_type -> iam.SetDefaultPolicyVersionResponse
+	139, // 324: iam.IAMCommandService.DeletePolicyVersion:output_type -> iam.DeletePolicyVersionResponse
+	142, // 325: iam.IAMCommandService.DeletePolicy:output_type -> iam.DeletePolicyResponse
+	144, // 326: iam.IAMCommandService.CreateGroup:output_type -> iam.CreateGroupResponse
+	146, // 327: iam.IAMCommandService.DeleteGroup:output_type -> iam.DeleteGroupResponse
+	147, // 328: iam.IAMCommandService.AddGroupMember:output_type -> iam.AddGroupMemberResponse
+	149, // 329: iam.IAMCommandService.RemoveGroupMember:output_type -> iam.RemoveGroupMemberResponse
+	150, // 330: iam.IAMCommandService.AttachGroupPolicy:output_type -> iam.AttachGroupPolicyResponse
+	152, // 331: iam.IAMCommandService.DetachGroupPolicy:output_type -> iam.DetachGroupPolicyResponse
+	153, // 332: iam.IAMCommandService.PutGroupInlinePolicy:output_type -> iam.PutGroupInlinePolicyResponse
+	156, // 333: iam.IAMCommandService.DeleteGroupInlinePolicy:output_type -> iam.DeleteGroupInlinePolicyResponse
+	157, // 334: iam.IAMCommandService.PutPlatformUserInlinePolicy:output_type -> iam.PutPlatformUserInlinePolicyResponse
+	160, // 335: iam.IAMCommandService.DeletePlatformUserInlinePolicy:output_type -> iam.DeletePlatformUserInlinePolicyResponse
+	162, // 336: iam.IAMCommandService.AttachPlatformUserPolicy:output_type -> iam.AttachPlatformUserPolicyResponse
+	163, // 337: iam.IAMCommandService.DetachPlatformUserPolicy:output_type -> iam.DetachPlatformUserPolicyResponse
+	164, // 338: iam.IAMCommandService.PutPlatformUserPermissionBoundary:output_type -> iam.PutPlatformUserPermissionBoundaryResponse
+	166, // 339: iam.IAMCommandService.DeletePlatformUserPermissionBoundary:output_type -> iam.DeletePlatformUserPermissionBoundaryResponse
+	169, // 340: iam.IAMCommandService.RemoveTenantMember:output_type -> iam.RemoveTenantMemberResponse
+	170, // 341: iam.IAMCommandService.PutTenantUserInlinePolicy:output_type -> iam.PutTenantUserInlinePolicyResponse
+	173, // 342: iam.IAMCommandService.DeleteTenantUserInlinePolicy:output_type -> iam.DeleteTenantUserInlinePolicyResponse
+	175, // 343: iam.IAMCommandService.AttachTenantUserPolicy:output_type -> iam.AttachTenantUserPolicyResponse
+	176, // 344: iam.IAMCommandService.DetachTenantUserPolicy:output_type -> iam.DetachTenantUserPolicyResponse
+	177, // 345: iam.IAMCommandService.PutTenantUserPermissionBoundary:output_type -> iam.PutTenantUserPermissionBoundaryResponse
+	179, // 346: iam.IAMCommandService.DeleteTenantUserPermissionBoundary:output_type -> iam.DeleteTenantUserPermissionBoundaryResponse
+	180, // 347: iam.IAMCommandService.PutRoleTrustPolicy:output_type -> iam.PutRoleTrustPolicyResponse
+	182, // 348: iam.IAMCommandService.DeleteRoleTrustPolicy:output_type -> iam.DeleteRoleTrustPolicyResponse
+	183, // 349: iam.IAMCommandService.PutRolePermissionBoundary:output_type -> iam.PutRolePermissionBoundaryResponse
+	185, // 350: iam.IAMCommandService.DeleteRolePermissionBoundary:output_type -> iam.DeleteRolePermissionBoundaryResponse
+	186, // 351: iam.IAMCommandService.PutRoleAccessPolicy:output_type -> iam.PutRoleAccessPolicyResponse
+	188, // 352: iam.IAMCommandService.DeleteRoleAccessPolicy:output_type -> iam.DeleteRoleAccessPolicyResponse
+	189, // 353: iam.IAMCommandService.CreateAccessRequest:output_type -> iam.CreateAccessRequestResponse
+	192, // 354: iam.IAMCommandService.ApproveAccessRequest:output_type -> iam.ApproveAccessRequestResponse
+	193, // 355: iam.IAMCommandService.DenyAccessRequest:output_type -> iam.DenyAccessRequestResponse
+	194, // 356: iam.IAMCommandService.CancelAccessRequest:output_type -> iam.CancelAccessRequestResponse
+	106, // 357: iam.IAMQueryService.ListOrganizations:output_type -> iam.ListOrganizationsResponse
+	109, // 358: iam.IAMQueryService.ListOrganizationMembers:output_type -> iam.ListOrganizationMembersResponse
+	111, // 359: iam.IAMQueryService.ListSCIMTokens:output_type -> iam.ListSCIMTokensResponse
+	114, // 360: iam.IAMQueryService.GetOrganizationSAMLConnection:output_type -> iam.GetOrganizationSAMLConnectionResponse
+	202, // 361: iam.IAMQueryService.ResolveSAMLConnection:output_type -> iam.ResolveSAMLConnectionResponse
+	203, // 362: iam.IAMQueryService.ListSSORequiredOrganizations:output_type -> iam.ListSSORequiredOrganizationsResponse
+	204, // 363: iam.IAMQueryService.GetOrganizationMembership:output_type -> iam.GetOrganizationMembershipResponse
+	120, // 364: iam.IAMQueryService.ListServiceControlPolicies:output_type -> iam.ListServiceControlPoliciesResponse
+	124, // 365: iam.IAMQueryService.ListTenantInvites:output_type -> iam.ListTenantInvitesResponse
+	127, // 366: iam.IAMQueryService.GetTenantMembership:output_type -> iam.GetTenantMembershipResponse
+	128, // 367: iam.IAMQueryService.CheckPermission:output_type -> iam.CheckPermissionResponse
+	128, // 368: iam.IAMQueryService.CheckPlatformPermission:output_type -> iam.CheckPermissionResponse
+	129, // 369: iam.IAMQueryService.ListUserTenants:output_type -> iam.ListUserTenantsResponse
+	130, // 370: iam.IAMQueryService.ListPlatformRoles:output_type -> iam.ListPlatformRolesResponse
+	131, // 371: iam.IAMQueryService.ListDirectoryUsers:output_type -> iam.ListDirectoryUsersResponse
+	132, // 372: iam.IAMQueryService.ListPermissions:output_type -> iam.ListPermissionsResponse
+	136, // 373: iam.IAMQueryService.GetPolicy:output_type -> iam.GetPolicyResponse
+	137, // 374: iam.IAMQueryService.ListPolicyVersions:output_type -> iam.ListPolicyVersionsResponse
+	140, // 375: iam.IAMQueryService.ListPolicies:output_type -> iam.ListPoliciesResponse
+	141, // 376: iam.IAMQueryService.ListPolicyAttachments:output_type -> iam.ListPolicyAttachmentsResponse
+	143, // 377: iam.IAMQueryService.ValidatePolicy:output_type -> iam.ValidatePolicyResponse
+	145, // 378: iam.IAMQueryService.ListGroups:output_type -> iam.ListGroupsResponse
+	148, // 379: iam.IAMQueryService.ListGroupMembers:output_type -> iam.ListGroupMembersResponse
+	151, // 380: iam.IAMQueryService.ListGroupPolicies:output_type -> iam.ListGroupPoliciesResponse
+	154, // 381: iam.IAMQueryService.GetGroupInlinePolicy:output_type -> iam.GetGroupInlinePolicyResponse
+	155, // 382: iam.IAMQueryService.ListGroupInlinePolicies:output_type -> iam.ListGroupInlinePoliciesResponse
+	161, // 383: iam.IAMQueryService.ListPlatformUserPolicies:output_type -> iam.ListPlatformUserPoliciesResponse
+	158, // 384: iam.IAMQueryService.GetPlatformUserInlinePolicy:output_type -> iam.GetPlatformUserInlinePolicyResponse
+	159, // 385: iam.IAMQueryService.ListPlatformUserInlinePolicies:output_type -> iam.ListPlatformUserInlinePoliciesResponse
+	165, // 386: iam.IAMQueryService.GetPlatformUserPermissionBoundary:output_type -> iam.GetPlatformUserPermissionBoundaryResponse
+	168, // 387: iam.IAMQueryService.ListTenantMembers:output_type -> iam.ListTenantMembersResponse
+	174, // 388: iam.IAMQueryService.ListTenantUserPolicies:output_type -> iam.ListTenantUserPoliciesResponse
+	171, // 389: iam.IAMQueryService.GetTenantUserInlinePolicy:output_type -> iam.GetTenantUserInlinePolicyResponse
+	172, // 390: iam.IAMQueryService.ListTenantUserInlinePolicies:output_type -> iam.ListTenantUserInlinePoliciesResponse
+	178, // 391: iam.IAMQueryService.GetTenantUserPermissionBoundary:output_type -> iam.GetTenantUserPermissionBoundaryResponse
+	181, // 392: iam.IAMQueryService.GetRoleTrustPolicy:output_type -> iam.GetRoleTrustPolicyResponse
+	184, // 393: iam.IAMQueryService.GetRolePermissionBoundary:output_type -> iam.GetRolePermissionBoundaryResponse
+	187, // 394: iam.IAMQueryService.GetRoleAccessPolicy:output_type -> iam.GetRoleAccessPolicyResponse
+	190, // 395: iam.IAMQueryService.GetAccessRequest:output_type -> iam.GetAccessRequestResponse
+	191, // 396: iam.IAMQueryService.ListAccessRequests:output_type -> iam.ListAccessRequestsResponse
+	195, // 397: iam.IAMQueryService.SimulateAccess:output_type -> iam.SimulateAccessResponse
+	196, // 398: iam.IAMQueryService.ListUnusedPermissions:output_type -> iam.ListUnusedPermissionsResponse
+	197, // 399: iam.IAMQueryService.ListUnusedRoles:output_type -> iam.ListUnusedRolesResponse
+	198, // 400: iam.IAMQueryService.ListStaleGroupMemberships:output_type -> iam.ListStaleGroupMembershipsResponse
+	199, // 401: iam.IAMQueryService.GenerateLeastPrivilegePolicy:output_type -> iam.GenerateLeastPrivilegePolicyResponse
+	201, // [201:402] is the sub-list for method output_type
+	0,   // [0:201] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
@@ -678,6 +858,8 @@ func file_iam_v1_iam_service_proto_init() {
 	if File_iam_v1_iam_service_proto != nil {
 		return
 	}
+	file_iam_v1_iam_access_analyzer_proto_init()
+	file_iam_v1_iam_access_request_proto_init()
 	file_iam_v1_iam_policy_proto_init()
 	file_iam_v1_iam_simulation_proto_init()
 	file_iam_v1_iam_tenant_proto_init()
@@ -26,6 +26,12 @@ const (
 	IAMService_AddOrganizationMember_FullMethodName                = "/iam.IAMService/AddOrganizationMember"
 	IAMService_RemoveOrganizationMember_FullMethodName             = "/iam.IAMService/RemoveOrganizationMember"
 	IAMService_ListOrganizationMembers_FullMethodName              = "/iam.IAMService/ListOrganizationMembers"
+	IAMService_CreateSCIMToken_FullMethodName                      = "/iam.IAMService/CreateSCIMToken"
+	IAMService_ListSCIMTokens_FullMethodName                       = "/iam.IAMService/ListSCIMTokens"
+	IAMService_RevokeSCIMToken_FullMethodName                      = "/iam.IAMService/RevokeSCIMToken"
+	IAMService_PutOrganizationSAMLConnection_FullMethodName        = "/iam.IAMService/PutOrganizationSAMLConnection"
+	IAMService_GetOrganizationSAMLConnection_FullMethodName        = "/iam.IAMService/GetOrganizationSAMLConnection"
+	IAMService_DeleteOrganizationSAMLConnection_FullMethodName     = "/iam.IAMService/DeleteOrganizationSAMLConnection"
 	IAMService_AttachTenantToOrganization_FullMethodName           = "/iam.IAMService/AttachTenantToOrganization"
 	IAMService_DetachTenantFromOrganization_FullMethodName         = "/iam.IAMService/DetachTenantFromOrganization"
 	IAMService_AttachServiceControlPolicy_FullMethodName           = "/iam.IAMService/AttachServiceControlPolicy"
@@ -54,6 +60,7 @@ const (
 	IAMService_ListPolicies_FullMethodName                         = "/iam.IAMService/ListPolicies"
 	IAMService_ListPolicyAttachments_FullMethodName                = "/iam.IAMService/ListPolicyAttachments"
 	IAMService_DeletePolicy_FullMethodName                         = "/iam.IAMService/DeletePolicy"
+	IAMService_ValidatePolicy_FullMethodName                       = "/iam.IAMService/ValidatePolicy"
 	IAMService_CreateGroup_FullMethodName                          = "/iam.IAMService/CreateGroup"
 	IAMService_ListGroups_FullMethodName                           = "/iam.IAMService/ListGroups"
 	IAMService_DeleteGroup_FullMethodName                          = "/iam.IAMService/DeleteGroup"
@@ -96,7 +103,20 @@ const (
 	IAMService_PutRolePermissionBoundary_FullMethodName            = "/iam.IAMService/PutRolePermissionBoundary"
 	IAMService_GetRolePermissionBoundary_FullMethodName            = "/iam.IAMService/GetRolePermissionBoundary"
 	IAMService_DeleteRolePermissionBoundary_FullMethodName         = "/iam.IAMService/DeleteRolePermissionBoundary"
+	IAMService_PutRoleAccessPolicy_FullMethodName                  = "/iam.IAMService/PutRoleAccessPolicy"
+	IAMService_GetRoleAccessPolicy_FullMethodName                  = "/iam.IAMService/GetRoleAccessPolicy"
+	IAMService_DeleteRoleAccessPolicy_FullMethodName               = "/iam.IAMService/DeleteRoleAccessPolicy"
+	IAMService_CreateAccessRequest_FullMethodName                  = "/iam.IAMService/CreateAccessRequest"
+	IAMService_GetAccessRequest_FullMethodName                     = "/iam.IAMService/GetAccessRequest"
+	IAMService_ListAccessRequests_FullMethodName                   = "/iam.IAMService/ListAccessRequests"
+	IAMService_ApproveAccessRequest_FullMethodName                 = "/iam.IAMService/ApproveAccessRequest"
+	IAMService_DenyAccessRequest_FullMethodName                    = "/iam.IAMService/DenyAccessRequest"
+	IAMService_CancelAccessRequest_FullMethodName                  = "/iam.IAMService/CancelAccessRequest"
 	IAMService_SimulateAccess_FullMethodName                       = "/iam.IAMService/SimulateAccess"
+	IAMService_ListUnusedPermissions_FullMethodName                = "/iam.IAMService/ListUnusedPermissions"
+	IAMService_ListUnusedRoles_FullMethodName                      = "/iam.IAMService/ListUnusedRoles"
+	IAMService_ListStaleGroupMemberships_FullMethodName            = "/iam.IAMService/ListStaleGroupMemberships"
+	IAMService_GenerateLeastPrivilegePolicy_FullMethodName         = "/iam.IAMService/GenerateLeastPrivilegePolicy"
 )
 
 // IAMServiceClient is the client API for IAMService service.
@@ -113,6 +133,12 @@ type IAMServiceClient interface {
 	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
 	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
 	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+	CreateSCIMToken(ctx context.Context, in *CreateSCIMTokenRequest, opts ...grpc.CallOption) (*CreateSCIMTokenResponse, error)
+	ListSCIMTokens(ctx context.Context, in *ListSCIMTokensRequest, opts ...grpc.CallOption) (*ListSCIMTokensResponse, error)
+	RevokeSCIMToken(ctx context.Context, in *RevokeSCIMTokenRequest, opts ...grpc.CallOption) (*RevokeSCIMTokenResponse, error)
+	PutOrganizationSAMLConnection(ctx context.Context, in *PutOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*PutOrganizationSAMLConnectionResponse, error)
+	GetOrganizationSAMLConnection(ctx context.Context, in *GetOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*GetOrganizationSAMLConnectionResponse, error)
+	DeleteOrganizationSAMLConnection(ctx context.Context, in *DeleteOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*DeleteOrganizationSAMLConnectionResponse, error)
 	AttachTenantToOrganization(ctx context.Context, in *AttachTenantToOrganizationRequest, opts ...grpc.CallOption) (*AttachTenantToOrganizationResponse, error)
 	DetachTenantFromOrganization(ctx context.Context, in *DetachTenantFromOrganizationRequest, opts ...grpc.CallOption) (*DetachTenantFromOrganizationResponse, error)
 	AttachServiceControlPolicy(ctx context.Context, in *AttachServiceControlPolicyRequest, opts ...grpc.CallOption) (*AttachServiceControlPolicyResponse, error)
@@ -141,6 +167,7 @@ type IAMServiceClient interface {
 	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
 	ListPolicyAttachments(ctx context.Context, in *ListPolicyAttachmentsRequest, opts ...grpc.CallOption) (*ListPolicyAttachmentsResponse, error)
 	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	ValidatePolicy(ctx context.Context, in *ValidatePolicyRequest, opts ...grpc.CallOption) (*ValidatePolicyResponse, error)
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
@@ -183,7 +210,20 @@ type IAMServiceClient interface {
 	PutRolePermissionBoundary(ctx context.Context, in *PutRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*PutRolePermissionBoundaryResponse, error)
 	GetRolePermissionBoundary(ctx context.Context, in *GetRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*GetRolePermissionBoundaryResponse, error)
 	DeleteRolePermissionBoundary(ctx context.Context, in *DeleteRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*DeleteRolePermissionBoundaryResponse, error)
+	PutRoleAccessPolicy(ctx context.Context, in *PutRoleAccessPolicyRequest, opts ...grpc.CallOption) (*PutRoleAccessPolicyResponse, error)
+	GetRoleAccessPolicy(ctx context.Context, in *GetRoleAccessPolicyRequest, opts ...grpc.CallOption) (*GetRoleAccessPolicyResponse, error)
+	DeleteRoleAccessPolicy(ctx context.Context, in *DeleteRoleAccessPolicyRequest, opts ...grpc.CallOption) (*DeleteRoleAccessPolicyResponse, error)
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error)
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error)
+	CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*CancelAccessRequestResponse, error)
 	SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error)
+	ListUnusedPermissions(ctx context.Context, in *ListUnusedPermissionsRequest, opts ...grpc.CallOption) (*ListUnusedPermissionsResponse, error)
+	ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error)
+	ListStaleGroupMemberships(ctx context.Context, in *ListStaleGroupMembershipsRequest, opts ...grpc.CallOption) (*ListStaleGroupMembershipsResponse, error)
+	GenerateLeastPrivilegePolicy(ctx context.Context, in *GenerateLeastPrivilegePolicyRequest, opts ...grpc.CallOption) (*GenerateLeastPrivilegePolicyResponse, error)
 }
 
 type iAMServiceClient struct {
@@ -264,6 +304,66 @@ func (c *iAMServiceClient) ListOrganizationMembers(ctx context.Context, in *List
 	return out, nil
 }
 
+func (c *iAMServiceClient) CreateSCIMToken(ctx context.Context, in *CreateSCIMTokenRequest, opts ...grpc.CallOption) (*CreateSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, IAMService_CreateSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListSCIMTokens(ctx context.Context, in *ListSCIMTokensRequest, opts ...grpc.CallOption) (*ListSCIMTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSCIMTokensResponse)
+	err := c.cc.Invoke(ctx, IAMService_ListSCIMTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) RevokeSCIMToken(ctx context.Context, in *RevokeSCIMTokenRequest, opts ...grpc.CallOption) (*RevokeSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, IAMService_RevokeSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) PutOrganizationSAMLConnection(ctx context.Context, in *PutOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*PutOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMService_PutOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) GetOrganizationSAMLConnection(ctx context.Context, in *GetOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*GetOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMService_GetOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DeleteOrganizationSAMLConnection(ctx context.Context, in *DeleteOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*DeleteOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMService_DeleteOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) AttachTenantToOrganization(ctx context.Context, in *AttachTenantToOrganizationRequest, opts ...grpc.CallOption) (*AttachTenantToOrganizationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachTenantToOrganizationResponse)
@@ -544,6 +644,16 @@ func (c *iAMServiceClient) DeletePolicy(ctx context.Context, in *DeletePolicyReq
 	return out, nil
 }
 
+func (c *iAMServiceClient) ValidatePolicy(ctx context.Context, in *ValidatePolicyRequest, opts ...grpc.CallOption) (*ValidatePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMService_ValidatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateGroupResponse)
@@ -964,6 +1074,96 @@ func (c *iAMServiceClient) DeleteRolePermissionBoundary(ctx context.Context, in
 	return out, nil
 }
 
+func (c *iAMServiceClient) PutRoleAccessPolicy(ctx context.Context, in *PutRoleAccessPolicyRequest, opts ...grpc.CallOption) (*PutRoleAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMService_PutRoleAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) GetRoleAccessPolicy(ctx context.Context, in *GetRoleAccessPolicyRequest, opts ...grpc.CallOption) (*GetRoleAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMService_GetRoleAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DeleteRoleAccessPolicy(ctx context.Context, in *DeleteRoleAccessPolicyRequest, opts ...grpc.CallOption) (*DeleteRoleAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMService_DeleteRoleAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMService_CreateAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMService_GetAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, IAMService_ListAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMService_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenyAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMService_DenyAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*CancelAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMService_CancelAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SimulateAccessResponse)
@@ -974,6 +1174,46 @@ func (c *iAMServiceClient) SimulateAccess(ctx context.Context, in *SimulateAcces
 	return out, nil
 }
 
+func (c *iAMServiceClient) ListUnusedPermissions(ctx context.Context, in *ListUnusedPermissionsRequest, opts ...grpc.CallOption) (*ListUnusedPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnusedPermissionsResponse)
+	err := c.cc.Invoke(ctx, IAMService_ListUnusedPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnusedRolesResponse)
+	err := c.cc.Invoke(ctx, IAMService_ListUnusedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListStaleGroupMemberships(ctx context.Context, in *ListStaleGroupMembershipsRequest, opts ...grpc.CallOption) (*ListStaleGroupMembershipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaleGroupMembershipsResponse)
+	err := c.cc.Invoke(ctx, IAMService_ListStaleGroupMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) GenerateLeastPrivilegePolicy(ctx context.Context, in *GenerateLeastPrivilegePolicyRequest, opts ...grpc.CallOption) (*GenerateLeastPrivilegePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateLeastPrivilegePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMService_GenerateLeastPrivilegePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMServiceServer is the server API for IAMService service.
 // All implementations must embed UnimplementedIAMServiceServer
 // for forward compatibility.
@@ -988,6 +1228,12 @@ type IAMServiceServer interface {
 	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
 	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
 	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	CreateSCIMToken(context.Context, *CreateSCIMTokenRequest) (*CreateSCIMTokenResponse, error)
+	ListSCIMTokens(context.Context, *ListSCIMTokensRequest) (*ListSCIMTokensResponse, error)
+	RevokeSCIMToken(context.Context, *RevokeSCIMTokenRequest) (*RevokeSCIMTokenResponse, error)
+	PutOrganizationSAMLConnection(context.Context, *PutOrganizationSAMLConnectionRequest) (*PutOrganizationSAMLConnectionResponse, error)
+	GetOrganizationSAMLConnection(context.Context, *GetOrganizationSAMLConnectionRequest) (*GetOrganizationSAMLConnectionResponse, error)
+	DeleteOrganizationSAMLConnection(context.Context, *DeleteOrganizationSAMLConnectionRequest) (*DeleteOrganizationSAMLConnectionResponse, error)
 	AttachTenantToOrganization(context.Context, *AttachTenantToOrganizationRequest) (*AttachTenantToOrganizationResponse, error)
 	DetachTenantFromOrganization(context.Context, *DetachTenantFromOrganizationRequest) (*DetachTenantFromOrganizationResponse, error)
 	AttachServiceControlPolicy(context.Context, *AttachServiceControlPolicyRequest) (*AttachServiceControlPolicyResponse, error)
@@ -1016,6 +1262,7 @@ type IAMServiceServer interface {
 	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
 	ListPolicyAttachments(context.Context, *ListPolicyAttachmentsRequest) (*ListPolicyAttachmentsResponse, error)
 	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	ValidatePolicy(context.Context, *ValidatePolicyRequest) (*ValidatePolicyResponse, error)
 	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
 	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
 	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
@@ -1058,7 +1305,20 @@ type IAMServiceServer interface {
 	PutRolePermissionBoundary(context.Context, *PutRolePermissionBoundaryRequest) (*PutRolePermissionBoundaryResponse, error)
 	GetRolePermissionBoundary(context.Context, *GetRolePermissionBoundaryRequest) (*GetRolePermissionBoundaryResponse, error)
 	DeleteRolePermissionBoundary(context.Context, *DeleteRolePermissionBoundaryRequest) (*DeleteRolePermissionBoundaryResponse, error)
+	PutRoleAccessPolicy(context.Context, *PutRoleAccessPolicyRequest) (*PutRoleAccessPolicyResponse, error)
+	GetRoleAccessPolicy(context.Context, *GetRoleAccessPolicyRequest) (*GetRoleAccessPolicyResponse, error)
+	DeleteRoleAccessPolicy(context.Context, *DeleteRoleAccessPolicyRequest) (*DeleteRoleAccessPolicyResponse, error)
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error)
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error)
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error)
+	CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*CancelAccessRequestResponse, error)
 	SimulateAccess(context.Context, *SimulateAccessRequest) (*SimulateAccessResponse, error)
+	ListUnusedPermissions(context.Context, *ListUnusedPermissionsRequest) (*ListUnusedPermissionsResponse, error)
+	ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error)
+	ListStaleGroupMemberships(context.Context, *ListStaleGroupMembershipsRequest) (*ListStaleGroupMembershipsResponse, error)
+	GenerateLeastPrivilegePolicy(context.Context, *GenerateLeastPrivilegePolicyRequest) (*GenerateLeastPrivilegePolicyResponse, error)
 	mustEmbedUnimplementedIAMServiceServer()
 }
 
@@ -1090,6 +1350,24 @@ func (UnimplementedIAMServiceServer) RemoveOrganizationMember(context.Context, *
 func (UnimplementedIAMServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
+func (UnimplementedIAMServiceServer) CreateSCIMToken(context.Context, *CreateSCIMTokenRequest) (*CreateSCIMTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMToken not implemented")
+}
+func (UnimplementedIAMServiceServer) ListSCIMTokens(context.Context, *ListSCIMTokensRequest) (*ListSCIMTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMTokens not implemented")
+}
+func (UnimplementedIAMServiceServer) RevokeSCIMToken(context.Context, *RevokeSCIMTokenRequest) (*RevokeSCIMTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSCIMToken not implemented")
+}
+func (UnimplementedIAMServiceServer) PutOrganizationSAMLConnection(context.Context, *PutOrganizationSAMLConnectionRequest) (*PutOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutOrganizationSAMLConnection not implemented")
+}
+func (UnimplementedIAMServiceServer) GetOrganizationSAMLConnection(context.Context, *GetOrganizationSAMLConnectionRequest) (*GetOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationSAMLConnection not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteOrganizationSAMLConnection(context.Context, *DeleteOrganizationSAMLConnectionRequest) (*DeleteOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationSAMLConnection not implemented")
+}
 func (UnimplementedIAMServiceServer) AttachTenantToOrganization(context.Context, *AttachTenantToOrganizationRequest) (*AttachTenantToOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttachTenantToOrganization not implemented")
 }
@@ -1174,6 +1452,9 @@ func (UnimplementedIAMServiceServer) ListPolicyAttachments(context.Context, *Lis
 func (UnimplementedIAMServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
 }
+func (UnimplementedIAMServiceServer) ValidatePolicy(context.Context, *ValidatePolicyRequest) (*ValidatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePolicy not implemented")
+}
 func (UnimplementedIAMServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
@@ -1300,9 +1581,48 @@ func (UnimplementedIAMServiceServer) GetRolePermissionBoundary(context.Context,
 func (UnimplementedIAMServiceServer) DeleteRolePermissionBoundary(context.Context, *DeleteRolePermissionBoundaryRequest) (*DeleteRolePermissionBoundaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRolePermissionBoundary not implemented")
 }
+func (UnimplementedIAMServiceServer) PutRoleAccessPolicy(context.Context, *PutRoleAccessPolicyRequest) (*PutRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) GetRoleAccessPolicy(context.Context, *GetRoleAccessPolicyRequest) (*GetRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteRoleAccessPolicy(context.Context, *DeleteRoleAccessPolicyRequest) (*DeleteRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedIAMServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*CancelAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccessRequest not implemented")
+}
 func (UnimplementedIAMServiceServer) SimulateAccess(context.Context, *SimulateAccessRequest) (*SimulateAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateAccess not implemented")
 }
+func (UnimplementedIAMServiceServer) ListUnusedPermissions(context.Context, *ListUnusedPermissionsRequest) (*ListUnusedPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedPermissions not implemented")
+}
+func (UnimplementedIAMServiceServer) ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedRoles not implemented")
+}
+func (UnimplementedIAMServiceServer) ListStaleGroupMemberships(context.Context, *ListStaleGroupMembershipsRequest) (*ListStaleGroupMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleGroupMemberships not implemented")
+}
+func (UnimplementedIAMServiceServer) GenerateLeastPrivilegePolicy(context.Context, *GenerateLeastPrivilegePolicyRequest) (*GenerateLeastPrivilegePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateLeastPrivilegePolicy not implemented")
+}
 func (UnimplementedIAMServiceServer) mustEmbedUnimplementedIAMServiceServer() {}
 func (UnimplementedIAMServiceServer) testEmbeddedByValue()                    {}
 
@@ -1450,6 +1770,114 @@ func _IAMService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMService_CreateSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_CreateSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateSCIMToken(ctx, req.(*CreateSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListSCIMTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCIMTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListSCIMTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ListSCIMTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListSCIMTokens(ctx, req.(*ListSCIMTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RevokeSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RevokeSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_RevokeSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RevokeSCIMToken(ctx, req.(*RevokeSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_PutOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutOrganizationSAMLConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).PutOrganizationSAMLConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_PutOrganizationSAMLConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).PutOrganizationSAMLConnection(ctx, req.(*PutOrganizationSAMLConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSAMLConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetOrganizationSAMLConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_GetOrganizationSAMLConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetOrganizationSAMLConnection(ctx, req.(*GetOrganizationSAMLConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationSAMLConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteOrganizationSAMLConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_DeleteOrganizationSAMLConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteOrganizationSAMLConnection(ctx, req.(*DeleteOrganizationSAMLConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMService_AttachTenantToOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttachTenantToOrganizationRequest)
 	if err := dec(in); err != nil {
@@ -1954,6 +2382,24 @@ func _IAMService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMService_ValidatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ValidatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ValidatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ValidatePolicy(ctx, req.(*ValidatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGroupRequest)
 	if err := dec(in); err != nil {
@@ -2710,20 +3156,254 @@ func _IAMService_DeleteRolePermissionBoundary_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_SimulateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateAccessRequest)
+func _IAMService_PutRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRoleAccessPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).SimulateAccess(ctx, in)
+		return srv.(IAMServiceServer).PutRoleAccessPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMService_SimulateAccess_FullMethodName,
+		FullMethod: IAMService_PutRoleAccessPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).SimulateAccess(ctx, req.(*SimulateAccessRequest))
+		return srv.(IAMServiceServer).PutRoleAccessPolicy(ctx, req.(*PutRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetRoleAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_GetRoleAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetRoleAccessPolicy(ctx, req.(*GetRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteRoleAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_DeleteRoleAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteRoleAccessPolicy(ctx, req.(*DeleteRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_CreateAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_GetAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DenyAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_DenyAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DenyAccessRequest(ctx, req.(*DenyAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CancelAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CancelAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_CancelAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CancelAccessRequest(ctx, req.(*CancelAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_SimulateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).SimulateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_SimulateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).SimulateAccess(ctx, req.(*SimulateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListUnusedPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListUnusedPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ListUnusedPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListUnusedPermissions(ctx, req.(*ListUnusedPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListUnusedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListUnusedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ListUnusedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListUnusedRoles(ctx, req.(*ListUnusedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListStaleGroupMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleGroupMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListStaleGroupMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ListStaleGroupMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListStaleGroupMemberships(ctx, req.(*ListStaleGroupMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GenerateLeastPrivilegePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateLeastPrivilegePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GenerateLeastPrivilegePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_GenerateLeastPrivilegePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GenerateLeastPrivilegePolicy(ctx, req.(*GenerateLeastPrivilegePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2763,6 +3443,30 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationMembers",
 			Handler:    _IAMService_ListOrganizationMembers_Handler,
 		},
+		{
+			MethodName: "CreateSCIMToken",
+			Handler:    _IAMService_CreateSCIMToken_Handler,
+		},
+		{
+			MethodName: "ListSCIMTokens",
+			Handler:    _IAMService_ListSCIMTokens_Handler,
+		},
+		{
+			MethodName: "RevokeSCIMToken",
+			Handler:    _IAMService_RevokeSCIMToken_Handler,
+		},
+		{
+			MethodName: "PutOrganizationSAMLConnection",
+			Handler:    _IAMService_PutOrganizationSAMLConnection_Handler,
+		},
+		{
+			MethodName: "GetOrganizationSAMLConnection",
+			Handler:    _IAMService_GetOrganizationSAMLConnection_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationSAMLConnection",
+			Handler:    _IAMService_DeleteOrganizationSAMLConnection_Handler,
+		},
 		{
 			MethodName: "AttachTenantToOrganization",
 			Handler:    _IAMService_AttachTenantToOrganization_Handler,
@@ -2875,6 +3579,10 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePolicy",
 			Handler:    _IAMService_DeletePolicy_Handler,
 		},
+		{
+			MethodName: "ValidatePolicy",
+			Handler:    _IAMService_ValidatePolicy_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _IAMService_CreateGroup_Handler,
@@ -3043,10 +3751,62 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRolePermissionBoundary",
 			Handler:    _IAMService_DeleteRolePermissionBoundary_Handler,
 		},
+		{
+			MethodName: "PutRoleAccessPolicy",
+			Handler:    _IAMService_PutRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "GetRoleAccessPolicy",
+			Handler:    _IAMService_GetRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRoleAccessPolicy",
+			Handler:    _IAMService_DeleteRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _IAMService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _IAMService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _IAMService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _IAMService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyAccessRequest",
+			Handler:    _IAMService_DenyAccessRequest_Handler,
+		},
+		{
+			MethodName: "CancelAccessRequest",
+			Handler:    _IAMService_CancelAccessRequest_Handler,
+		},
 		{
 			MethodName: "SimulateAccess",
 			Handler:    _IAMService_SimulateAccess_Handler,
 		},
+		{
+			MethodName: "ListUnusedPermissions",
+			Handler:    _IAMService_ListUnusedPermissions_Handler,
+		},
+		{
+			MethodName: "ListUnusedRoles",
+			Handler:    _IAMService_ListUnusedRoles_Handler,
+		},
+		{
+			MethodName: "ListStaleGroupMemberships",
+			Handler:    _IAMService_ListStaleGroupMemberships_Handler,
+		},
+		{
+			MethodName: "GenerateLeastPrivilegePolicy",
+			Handler:    _IAMService_GenerateLeastPrivilegePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iam/v1/iam_service.proto",
@@ -3059,6 +3819,11 @@ const (
 	IAMCommandService_EnsureRootOrganization_FullMethodName               = "/iam.IAMCommandService/EnsureRootOrganization"
 	IAMCommandService_AddOrganizationMember_FullMethodName                = "/iam.IAMCommandService/AddOrganizationMember"
 	IAMCommandService_RemoveOrganizationMember_FullMethodName             = "/iam.IAMCommandService/RemoveOrganizationMember"
+	IAMCommandService_CreateSCIMToken_FullMethodName                      = "/iam.IAMCommandService/CreateSCIMToken"
+	IAMCommandService_RevokeSCIMToken_FullMethodName                      = "/iam.IAMCommandService/RevokeSCIMToken"
+	IAMCommandService_PutOrganizationSAMLConnection_FullMethodName        = "/iam.IAMCommandService/PutOrganizationSAMLConnection"
+	IAMCommandService_DeleteOrganizationSAMLConnection_FullMethodName     = "/iam.IAMCommandService/DeleteOrganizationSAMLConnection"
+	IAMCommandService_ApplySAMLLogin_FullMethodName                       = "/iam.IAMCommandService/ApplySAMLLogin"
 	IAMCommandService_AttachTenantToOrganization_FullMethodName           = "/iam.IAMCommandService/AttachTenantToOrganization"
 	IAMCommandService_DetachTenantFromOrganization_FullMethodName         = "/iam.IAMCommandService/DetachTenantFromOrganization"
 	IAMCommandService_AttachServiceControlPolicy_FullMethodName           = "/iam.IAMCommandService/AttachServiceControlPolicy"
@@ -3100,6 +3865,12 @@ const (
 	IAMCommandService_DeleteRoleTrustPolicy_FullMethodName                = "/iam.IAMCommandService/DeleteRoleTrustPolicy"
 	IAMCommandService_PutRolePermissionBoundary_FullMethodName            = "/iam.IAMCommandService/PutRolePermissionBoundary"
 	IAMCommandService_DeleteRolePermissionBoundary_FullMethodName         = "/iam.IAMCommandService/DeleteRolePermissionBoundary"
+	IAMCommandService_PutRoleAccessPolicy_FullMethodName                  = "/iam.IAMCommandService/PutRoleAccessPolicy"
+	IAMCommandService_DeleteRoleAccessPolicy_FullMethodName               = "/iam.IAMCommandService/DeleteRoleAccessPolicy"
+	IAMCommandService_CreateAccessRequest_FullMethodName                  = "/iam.IAMCommandService/CreateAccessRequest"
+	IAMCommandService_ApproveAccessRequest_FullMethodName                 = "/iam.IAMCommandService/ApproveAccessRequest"
+	IAMCommandService_DenyAccessRequest_FullMethodName                    = "/iam.IAMCommandService/DenyAccessRequest"
+	IAMCommandService_CancelAccessRequest_FullMethodName                  = "/iam.IAMCommandService/CancelAccessRequest"
 )
 
 // IAMCommandServiceClient is the client API for IAMCommandService service.
@@ -3115,6 +3886,12 @@ type IAMCommandServiceClient interface {
 	EnsureRootOrganization(ctx context.Context, in *EnsureRootOrganizationRequest, opts ...grpc.CallOption) (*EnsureRootOrganizationResponse, error)
 	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
 	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
+	CreateSCIMToken(ctx context.Context, in *CreateSCIMTokenRequest, opts ...grpc.CallOption) (*CreateSCIMTokenResponse, error)
+	RevokeSCIMToken(ctx context.Context, in *RevokeSCIMTokenRequest, opts ...grpc.CallOption) (*RevokeSCIMTokenResponse, error)
+	PutOrganizationSAMLConnection(ctx context.Context, in *PutOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*PutOrganizationSAMLConnectionResponse, error)
+	DeleteOrganizationSAMLConnection(ctx context.Context, in *DeleteOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*DeleteOrganizationSAMLConnectionResponse, error)
+	// ApplySAMLLogin is called by the auth service with the user's SAML-sourced token.
+	ApplySAMLLogin(ctx context.Context, in *ApplySAMLLoginRequest, opts ...grpc.CallOption) (*ApplySAMLLoginResponse, error)
 	AttachTenantToOrganization(ctx context.Context, in *AttachTenantToOrganizationRequest, opts ...grpc.CallOption) (*AttachTenantToOrganizationResponse, error)
 	DetachTenantFromOrganization(ctx context.Context, in *DetachTenantFromOrganizationRequest, opts ...grpc.CallOption) (*DetachTenantFromOrganizationResponse, error)
 	AttachServiceControlPolicy(ctx context.Context, in *AttachServiceControlPolicyRequest, opts ...grpc.CallOption) (*AttachServiceControlPolicyResponse, error)
@@ -3156,6 +3933,12 @@ type IAMCommandServiceClient interface {
 	DeleteRoleTrustPolicy(ctx context.Context, in *DeleteRoleTrustPolicyRequest, opts ...grpc.CallOption) (*DeleteRoleTrustPolicyResponse, error)
 	PutRolePermissionBoundary(ctx context.Context, in *PutRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*PutRolePermissionBoundaryResponse, error)
 	DeleteRolePermissionBoundary(ctx context.Context, in *DeleteRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*DeleteRolePermissionBoundaryResponse, error)
+	PutRoleAccessPolicy(ctx context.Context, in *PutRoleAccessPolicyRequest, opts ...grpc.CallOption) (*PutRoleAccessPolicyResponse, error)
+	DeleteRoleAccessPolicy(ctx context.Context, in *DeleteRoleAccessPolicyRequest, opts ...grpc.CallOption) (*DeleteRoleAccessPolicyResponse, error)
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error)
+	CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*CancelAccessRequestResponse, error)
 }
 
 type iAMCommandServiceClient struct {
@@ -3226,6 +4009,56 @@ func (c *iAMCommandServiceClient) RemoveOrganizationMember(ctx context.Context,
 	return out, nil
 }
 
+func (c *iAMCommandServiceClient) CreateSCIMToken(ctx context.Context, in *CreateSCIMTokenRequest, opts ...grpc.CallOption) (*CreateSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_CreateSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) RevokeSCIMToken(ctx context.Context, in *RevokeSCIMTokenRequest, opts ...grpc.CallOption) (*RevokeSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_RevokeSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) PutOrganizationSAMLConnection(ctx context.Context, in *PutOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*PutOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_PutOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) DeleteOrganizationSAMLConnection(ctx context.Context, in *DeleteOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*DeleteOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_DeleteOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) ApplySAMLLogin(ctx context.Context, in *ApplySAMLLoginRequest, opts ...grpc.CallOption) (*ApplySAMLLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplySAMLLoginResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_ApplySAMLLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMCommandServiceClient) AttachTenantToOrganization(ctx context.Context, in *AttachTenantToOrganizationRequest, opts ...grpc.CallOption) (*AttachTenantToOrganizationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachTenantToOrganizationResponse)
@@ -3636,6 +4469,66 @@ func (c *iAMCommandServiceClient) DeleteRolePermissionBoundary(ctx context.Conte
 	return out, nil
 }
 
+func (c *iAMCommandServiceClient) PutRoleAccessPolicy(ctx context.Context, in *PutRoleAccessPolicyRequest, opts ...grpc.CallOption) (*PutRoleAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_PutRoleAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) DeleteRoleAccessPolicy(ctx context.Context, in *DeleteRoleAccessPolicyRequest, opts ...grpc.CallOption) (*DeleteRoleAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_DeleteRoleAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_CreateAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) DenyAccessRequest(ctx context.Context, in *DenyAccessRequestRequest, opts ...grpc.CallOption) (*DenyAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenyAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_DenyAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMCommandServiceClient) CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*CancelAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMCommandService_CancelAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMCommandServiceServer is the server API for IAMCommandService service.
 // All implementations must embed UnimplementedIAMCommandServiceServer
 // for forward compatibility.
@@ -3649,6 +4542,12 @@ type IAMCommandServiceServer interface {
 	EnsureRootOrganization(context.Context, *EnsureRootOrganizationRequest) (*EnsureRootOrganizationResponse, error)
 	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
 	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	CreateSCIMToken(context.Context, *CreateSCIMTokenRequest) (*CreateSCIMTokenResponse, error)
+	RevokeSCIMToken(context.Context, *RevokeSCIMTokenRequest) (*RevokeSCIMTokenResponse, error)
+	PutOrganizationSAMLConnection(context.Context, *PutOrganizationSAMLConnectionRequest) (*PutOrganizationSAMLConnectionResponse, error)
+	DeleteOrganizationSAMLConnection(context.Context, *DeleteOrganizationSAMLConnectionRequest) (*DeleteOrganizationSAMLConnectionResponse, error)
+	// ApplySAMLLogin is called by the auth service with the user's SAML-sourced token.
+	ApplySAMLLogin(context.Context, *ApplySAMLLoginRequest) (*ApplySAMLLoginResponse, error)
 	AttachTenantToOrganization(context.Context, *AttachTenantToOrganizationRequest) (*AttachTenantToOrganizationResponse, error)
 	DetachTenantFromOrganization(context.Context, *DetachTenantFromOrganizationRequest) (*DetachTenantFromOrganizationResponse, error)
 	AttachServiceControlPolicy(context.Context, *AttachServiceControlPolicyRequest) (*AttachServiceControlPolicyResponse, error)
@@ -3690,6 +4589,12 @@ type IAMCommandServiceServer interface {
 	DeleteRoleTrustPolicy(context.Context, *DeleteRoleTrustPolicyRequest) (*DeleteRoleTrustPolicyResponse, error)
 	PutRolePermissionBoundary(context.Context, *PutRolePermissionBoundaryRequest) (*PutRolePermissionBoundaryResponse, error)
 	DeleteRolePermissionBoundary(context.Context, *DeleteRolePermissionBoundaryRequest) (*DeleteRolePermissionBoundaryResponse, error)
+	PutRoleAccessPolicy(context.Context, *PutRoleAccessPolicyRequest) (*PutRoleAccessPolicyResponse, error)
+	DeleteRoleAccessPolicy(context.Context, *DeleteRoleAccessPolicyRequest) (*DeleteRoleAccessPolicyResponse, error)
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error)
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error)
+	CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*CancelAccessRequestResponse, error)
 	mustEmbedUnimplementedIAMCommandServiceServer()
 }
 
@@ -3718,6 +4623,21 @@ func (UnimplementedIAMCommandServiceServer) AddOrganizationMember(context.Contex
 func (UnimplementedIAMCommandServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
 }
+func (UnimplementedIAMCommandServiceServer) CreateSCIMToken(context.Context, *CreateSCIMTokenRequest) (*CreateSCIMTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMToken not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) RevokeSCIMToken(context.Context, *RevokeSCIMTokenRequest) (*RevokeSCIMTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSCIMToken not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) PutOrganizationSAMLConnection(context.Context, *PutOrganizationSAMLConnectionRequest) (*PutOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutOrganizationSAMLConnection not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) DeleteOrganizationSAMLConnection(context.Context, *DeleteOrganizationSAMLConnectionRequest) (*DeleteOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationSAMLConnection not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) ApplySAMLLogin(context.Context, *ApplySAMLLoginRequest) (*ApplySAMLLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySAMLLogin not implemented")
+}
 func (UnimplementedIAMCommandServiceServer) AttachTenantToOrganization(context.Context, *AttachTenantToOrganizationRequest) (*AttachTenantToOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttachTenantToOrganization not implemented")
 }
@@ -3841,6 +4761,24 @@ func (UnimplementedIAMCommandServiceServer) PutRolePermissionBoundary(context.Co
 func (UnimplementedIAMCommandServiceServer) DeleteRolePermissionBoundary(context.Context, *DeleteRolePermissionBoundaryRequest) (*DeleteRolePermissionBoundaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRolePermissionBoundary not implemented")
 }
+func (UnimplementedIAMCommandServiceServer) PutRoleAccessPolicy(context.Context, *PutRoleAccessPolicyRequest) (*PutRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) DeleteRoleAccessPolicy(context.Context, *DeleteRoleAccessPolicyRequest) (*DeleteRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) DenyAccessRequest(context.Context, *DenyAccessRequestRequest) (*DenyAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
+func (UnimplementedIAMCommandServiceServer) CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*CancelAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccessRequest not implemented")
+}
 func (UnimplementedIAMCommandServiceServer) mustEmbedUnimplementedIAMCommandServiceServer() {}
 func (UnimplementedIAMCommandServiceServer) testEmbeddedByValue()                           {}
 
@@ -3859,113 +4797,203 @@ func RegisterIAMCommandServiceServer(s grpc.ServiceRegistrar, srv IAMCommandServ
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&IAMCommandService_ServiceDesc, srv)
+	s.RegisterService(&IAMCommandService_ServiceDesc, srv)
+}
+
+func _IAMCommandService_AssumeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IAMAssumeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).AssumeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_AssumeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).AssumeRole(ctx, req.(*IAMAssumeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_EnsureRootOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsureRootOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).EnsureRootOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_EnsureRootOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).EnsureRootOrganization(ctx, req.(*EnsureRootOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).AddOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_AddOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_AssumeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IAMAssumeRoleRequest)
+func _IAMCommandService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).AssumeRole(ctx, in)
+		return srv.(IAMCommandServiceServer).RemoveOrganizationMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_AssumeRole_FullMethodName,
+		FullMethod: IAMCommandService_RemoveOrganizationMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).AssumeRole(ctx, req.(*IAMAssumeRoleRequest))
+		return srv.(IAMCommandServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTenantRequest)
+func _IAMCommandService_CreateSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSCIMTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).CreateTenant(ctx, in)
+		return srv.(IAMCommandServiceServer).CreateSCIMToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_CreateTenant_FullMethodName,
+		FullMethod: IAMCommandService_CreateSCIMToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+		return srv.(IAMCommandServiceServer).CreateSCIMToken(ctx, req.(*CreateSCIMTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrganizationRequest)
+func _IAMCommandService_RevokeSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSCIMTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).CreateOrganization(ctx, in)
+		return srv.(IAMCommandServiceServer).RevokeSCIMToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_CreateOrganization_FullMethodName,
+		FullMethod: IAMCommandService_RevokeSCIMToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+		return srv.(IAMCommandServiceServer).RevokeSCIMToken(ctx, req.(*RevokeSCIMTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_EnsureRootOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnsureRootOrganizationRequest)
+func _IAMCommandService_PutOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutOrganizationSAMLConnectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).EnsureRootOrganization(ctx, in)
+		return srv.(IAMCommandServiceServer).PutOrganizationSAMLConnection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_EnsureRootOrganization_FullMethodName,
+		FullMethod: IAMCommandService_PutOrganizationSAMLConnection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).EnsureRootOrganization(ctx, req.(*EnsureRootOrganizationRequest))
+		return srv.(IAMCommandServiceServer).PutOrganizationSAMLConnection(ctx, req.(*PutOrganizationSAMLConnectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddOrganizationMemberRequest)
+func _IAMCommandService_DeleteOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationSAMLConnectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).AddOrganizationMember(ctx, in)
+		return srv.(IAMCommandServiceServer).DeleteOrganizationSAMLConnection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_AddOrganizationMember_FullMethodName,
+		FullMethod: IAMCommandService_DeleteOrganizationSAMLConnection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
+		return srv.(IAMCommandServiceServer).DeleteOrganizationSAMLConnection(ctx, req.(*DeleteOrganizationSAMLConnectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMCommandService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveOrganizationMemberRequest)
+func _IAMCommandService_ApplySAMLLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySAMLLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMCommandServiceServer).RemoveOrganizationMember(ctx, in)
+		return srv.(IAMCommandServiceServer).ApplySAMLLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IAMCommandService_RemoveOrganizationMember_FullMethodName,
+		FullMethod: IAMCommandService_ApplySAMLLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMCommandServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+		return srv.(IAMCommandServiceServer).ApplySAMLLogin(ctx, req.(*ApplySAMLLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4708,6 +5736,114 @@ func _IAMCommandService_DeleteRolePermissionBoundary_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMCommandService_PutRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRoleAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).PutRoleAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_PutRoleAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).PutRoleAccessPolicy(ctx, req.(*PutRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_DeleteRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).DeleteRoleAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_DeleteRoleAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).DeleteRoleAccessPolicy(ctx, req.(*DeleteRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).CreateAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_CreateAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).DenyAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_DenyAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).DenyAccessRequest(ctx, req.(*DenyAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMCommandService_CancelAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMCommandServiceServer).CancelAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMCommandService_CancelAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMCommandServiceServer).CancelAccessRequest(ctx, req.(*CancelAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMCommandService_ServiceDesc is the grpc.ServiceDesc for IAMCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4739,6 +5875,26 @@ var IAMCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveOrganizationMember",
 			Handler:    _IAMCommandService_RemoveOrganizationMember_Handler,
 		},
+		{
+			MethodName: "CreateSCIMToken",
+			Handler:    _IAMCommandService_CreateSCIMToken_Handler,
+		},
+		{
+			MethodName: "RevokeSCIMToken",
+			Handler:    _IAMCommandService_RevokeSCIMToken_Handler,
+		},
+		{
+			MethodName: "PutOrganizationSAMLConnection",
+			Handler:    _IAMCommandService_PutOrganizationSAMLConnection_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationSAMLConnection",
+			Handler:    _IAMCommandService_DeleteOrganizationSAMLConnection_Handler,
+		},
+		{
+			MethodName: "ApplySAMLLogin",
+			Handler:    _IAMCommandService_ApplySAMLLogin_Handler,
+		},
 		{
 			MethodName: "AttachTenantToOrganization",
 			Handler:    _IAMCommandService_AttachTenantToOrganization_Handler,
@@ -4903,6 +6059,30 @@ var IAMCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRolePermissionBoundary",
 			Handler:    _IAMCommandService_DeleteRolePermissionBoundary_Handler,
 		},
+		{
+			MethodName: "PutRoleAccessPolicy",
+			Handler:    _IAMCommandService_PutRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRoleAccessPolicy",
+			Handler:    _IAMCommandService_DeleteRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _IAMCommandService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _IAMCommandService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyAccessRequest",
+			Handler:    _IAMCommandService_DenyAccessRequest_Handler,
+		},
+		{
+			MethodName: "CancelAccessRequest",
+			Handler:    _IAMCommandService_CancelAccessRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iam/v1/iam_service.proto",
@@ -4911,6 +6091,11 @@ var IAMCommandService_ServiceDesc = grpc.ServiceDesc{
 const (
 	IAMQueryService_ListOrganizations_FullMethodName                 = "/iam.IAMQueryService/ListOrganizations"
 	IAMQueryService_ListOrganizationMembers_FullMethodName           = "/iam.IAMQueryService/ListOrganizationMembers"
+	IAMQueryService_ListSCIMTokens_FullMethodName                    = "/iam.IAMQueryService/ListSCIMTokens"
+	IAMQueryService_GetOrganizationSAMLConnection_FullMethodName     = "/iam.IAMQueryService/GetOrganizationSAMLConnection"
+	IAMQueryService_ResolveSAMLConnection_FullMethodName             = "/iam.IAMQueryService/ResolveSAMLConnection"
+	IAMQueryService_ListSSORequiredOrganizations_FullMethodName      = "/iam.IAMQueryService/ListSSORequiredOrganizations"
+	IAMQueryService_GetOrganizationMembership_FullMethodName         = "/iam.IAMQueryService/GetOrganizationMembership"
 	IAMQueryService_ListServiceControlPolicies_FullMethodName        = "/iam.IAMQueryService/ListServiceControlPolicies"
 	IAMQueryService_ListTenantInvites_FullMethodName                 = "/iam.IAMQueryService/ListTenantInvites"
 	IAMQueryService_GetTenantMembership_FullMethodName               = "/iam.IAMQueryService/GetTenantMembership"
@@ -4924,6 +6109,7 @@ const (
 	IAMQueryService_ListPolicyVersions_FullMethodName                = "/iam.IAMQueryService/ListPolicyVersions"
 	IAMQueryService_ListPolicies_FullMethodName                      = "/iam.IAMQueryService/ListPolicies"
 	IAMQueryService_ListPolicyAttachments_FullMethodName             = "/iam.IAMQueryService/ListPolicyAttachments"
+	IAMQueryService_ValidatePolicy_FullMethodName                    = "/iam.IAMQueryService/ValidatePolicy"
 	IAMQueryService_ListGroups_FullMethodName                        = "/iam.IAMQueryService/ListGroups"
 	IAMQueryService_ListGroupMembers_FullMethodName                  = "/iam.IAMQueryService/ListGroupMembers"
 	IAMQueryService_ListGroupPolicies_FullMethodName                 = "/iam.IAMQueryService/ListGroupPolicies"
@@ -4940,7 +6126,14 @@ const (
 	IAMQueryService_GetTenantUserPermissionBoundary_FullMethodName   = "/iam.IAMQueryService/GetTenantUserPermissionBoundary"
 	IAMQueryService_GetRoleTrustPolicy_FullMethodName                = "/iam.IAMQueryService/GetRoleTrustPolicy"
 	IAMQueryService_GetRolePermissionBoundary_FullMethodName         = "/iam.IAMQueryService/GetRolePermissionBoundary"
+	IAMQueryService_GetRoleAccessPolicy_FullMethodName               = "/iam.IAMQueryService/GetRoleAccessPolicy"
+	IAMQueryService_GetAccessRequest_FullMethodName                  = "/iam.IAMQueryService/GetAccessRequest"
+	IAMQueryService_ListAccessRequests_FullMethodName                = "/iam.IAMQueryService/ListAccessRequests"
 	IAMQueryService_SimulateAccess_FullMethodName                    = "/iam.IAMQueryService/SimulateAccess"
+	IAMQueryService_ListUnusedPermissions_FullMethodName             = "/iam.IAMQueryService/ListUnusedPermissions"
+	IAMQueryService_ListUnusedRoles_FullMethodName                   = "/iam.IAMQueryService/ListUnusedRoles"
+	IAMQueryService_ListStaleGroupMemberships_FullMethodName         = "/iam.IAMQueryService/ListStaleGroupMemberships"
+	IAMQueryService_GenerateLeastPrivilegePolicy_FullMethodName      = "/iam.IAMQueryService/GenerateLeastPrivilegePolicy"
 )
 
 // IAMQueryServiceClient is the client API for IAMQueryService service.
@@ -4952,6 +6145,13 @@ const (
 type IAMQueryServiceClient interface {
 	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
 	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+	ListSCIMTokens(ctx context.Context, in *ListSCIMTokensRequest, opts ...grpc.CallOption) (*ListSCIMTokensResponse, error)
+	GetOrganizationSAMLConnection(ctx context.Context, in *GetOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*GetOrganizationSAMLConnectionResponse, error)
+	// ResolveSAMLConnection, ListSSORequiredOrganizations and GetOrganizationMembership serve the
+	// auth service's login flows.
+	ResolveSAMLConnection(ctx context.Context, in *ResolveSAMLConnectionRequest, opts ...grpc.CallOption) (*ResolveSAMLConnectionResponse, error)
+	ListSSORequiredOrganizations(ctx context.Context, in *ListSSORequiredOrganizationsRequest, opts ...grpc.CallOption) (*ListSSORequiredOrganizationsResponse, error)
+	GetOrganizationMembership(ctx context.Context, in *GetOrganizationMembershipRequest, opts ...grpc.CallOption) (*GetOrganizationMembershipResponse, error)
 	ListServiceControlPolicies(ctx context.Context, in *ListServiceControlPoliciesRequest, opts ...grpc.CallOption) (*ListServiceControlPoliciesResponse, error)
 	ListTenantInvites(ctx context.Context, in *ListTenantInvitesRequest, opts ...grpc.CallOption) (*ListTenantInvitesResponse, error)
 	GetTenantMembership(ctx context.Context, in *GetTenantMembershipRequest, opts ...grpc.CallOption) (*GetTenantMembershipResponse, error)
@@ -4965,6 +6165,7 @@ type IAMQueryServiceClient interface {
 	ListPolicyVersions(ctx context.Context, in *ListPolicyVersionsRequest, opts ...grpc.CallOption) (*ListPolicyVersionsResponse, error)
 	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
 	ListPolicyAttachments(ctx context.Context, in *ListPolicyAttachmentsRequest, opts ...grpc.CallOption) (*ListPolicyAttachmentsResponse, error)
+	ValidatePolicy(ctx context.Context, in *ValidatePolicyRequest, opts ...grpc.CallOption) (*ValidatePolicyResponse, error)
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
 	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
 	ListGroupPolicies(ctx context.Context, in *ListGroupPoliciesRequest, opts ...grpc.CallOption) (*ListGroupPoliciesResponse, error)
@@ -4981,7 +6182,14 @@ type IAMQueryServiceClient interface {
 	GetTenantUserPermissionBoundary(ctx context.Context, in *GetTenantUserPermissionBoundaryRequest, opts ...grpc.CallOption) (*GetTenantUserPermissionBoundaryResponse, error)
 	GetRoleTrustPolicy(ctx context.Context, in *GetRoleTrustPolicyRequest, opts ...grpc.CallOption) (*GetRoleTrustPolicyResponse, error)
 	GetRolePermissionBoundary(ctx context.Context, in *GetRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*GetRolePermissionBoundaryResponse, error)
+	GetRoleAccessPolicy(ctx context.Context, in *GetRoleAccessPolicyRequest, opts ...grpc.CallOption) (*GetRoleAccessPolicyResponse, error)
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
 	SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error)
+	ListUnusedPermissions(ctx context.Context, in *ListUnusedPermissionsRequest, opts ...grpc.CallOption) (*ListUnusedPermissionsResponse, error)
+	ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error)
+	ListStaleGroupMemberships(ctx context.Context, in *ListStaleGroupMembershipsRequest, opts ...grpc.CallOption) (*ListStaleGroupMembershipsResponse, error)
+	GenerateLeastPrivilegePolicy(ctx context.Context, in *GenerateLeastPrivilegePolicyRequest, opts ...grpc.CallOption) (*GenerateLeastPrivilegePolicyResponse, error)
 }
 
 type iAMQueryServiceClient struct {
@@ -5012,6 +6220,56 @@ func (c *iAMQueryServiceClient) ListOrganizationMembers(ctx context.Context, in
 	return out, nil
 }
 
+func (c *iAMQueryServiceClient) ListSCIMTokens(ctx context.Context, in *ListSCIMTokensRequest, opts ...grpc.CallOption) (*ListSCIMTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSCIMTokensResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListSCIMTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) GetOrganizationSAMLConnection(ctx context.Context, in *GetOrganizationSAMLConnectionRequest, opts ...grpc.CallOption) (*GetOrganizationSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetOrganizationSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ResolveSAMLConnection(ctx context.Context, in *ResolveSAMLConnectionRequest, opts ...grpc.CallOption) (*ResolveSAMLConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveSAMLConnectionResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ResolveSAMLConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ListSSORequiredOrganizations(ctx context.Context, in *ListSSORequiredOrganizationsRequest, opts ...grpc.CallOption) (*ListSSORequiredOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSSORequiredOrganizationsResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListSSORequiredOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) GetOrganizationMembership(ctx context.Context, in *GetOrganizationMembershipRequest, opts ...grpc.CallOption) (*GetOrganizationMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationMembershipResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetOrganizationMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMQueryServiceClient) ListServiceControlPolicies(ctx context.Context, in *ListServiceControlPoliciesRequest, opts ...grpc.CallOption) (*ListServiceControlPoliciesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListServiceControlPoliciesResponse)
@@ -5142,6 +6400,16 @@ func (c *iAMQueryServiceClient) ListPolicyAttachments(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *iAMQueryServiceClient) ValidatePolicy(ctx context.Context, in *ValidatePolicyRequest, opts ...grpc.CallOption) (*ValidatePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ValidatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMQueryServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGroupsResponse)
@@ -5202,110 +6470,180 @@ func (c *iAMQueryServiceClient) ListPlatformUserPolicies(ctx context.Context, in
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetPlatformUserInlinePolicy(ctx context.Context, in *GetPlatformUserInlinePolicyRequest, opts ...grpc.CallOption) (*GetPlatformUserInlinePolicyResponse, error) {
+func (c *iAMQueryServiceClient) GetPlatformUserInlinePolicy(ctx context.Context, in *GetPlatformUserInlinePolicyRequest, opts ...grpc.CallOption) (*GetPlatformUserInlinePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformUserInlinePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetPlatformUserInlinePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ListPlatformUserInlinePolicies(ctx context.Context, in *ListPlatformUserInlinePoliciesRequest, opts ...grpc.CallOption) (*ListPlatformUserInlinePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlatformUserInlinePoliciesResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListPlatformUserInlinePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) GetPlatformUserPermissionBoundary(ctx context.Context, in *GetPlatformUserPermissionBoundaryRequest, opts ...grpc.CallOption) (*GetPlatformUserPermissionBoundaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformUserPermissionBoundaryResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetPlatformUserPermissionBoundary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantMembersResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ListTenantUserPolicies(ctx context.Context, in *ListTenantUserPoliciesRequest, opts ...grpc.CallOption) (*ListTenantUserPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantUserPoliciesResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantUserPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) GetTenantUserInlinePolicy(ctx context.Context, in *GetTenantUserInlinePolicyRequest, opts ...grpc.CallOption) (*GetTenantUserInlinePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantUserInlinePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetTenantUserInlinePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) ListTenantUserInlinePolicies(ctx context.Context, in *ListTenantUserInlinePoliciesRequest, opts ...grpc.CallOption) (*ListTenantUserInlinePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantUserInlinePoliciesResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantUserInlinePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMQueryServiceClient) GetTenantUserPermissionBoundary(ctx context.Context, in *GetTenantUserPermissionBoundaryRequest, opts ...grpc.CallOption) (*GetTenantUserPermissionBoundaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPlatformUserInlinePolicyResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetPlatformUserInlinePolicy_FullMethodName, in, out, cOpts...)
+	out := new(GetTenantUserPermissionBoundaryResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetTenantUserPermissionBoundary_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) ListPlatformUserInlinePolicies(ctx context.Context, in *ListPlatformUserInlinePoliciesRequest, opts ...grpc.CallOption) (*ListPlatformUserInlinePoliciesResponse, error) {
+func (c *iAMQueryServiceClient) GetRoleTrustPolicy(ctx context.Context, in *GetRoleTrustPolicyRequest, opts ...grpc.CallOption) (*GetRoleTrustPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPlatformUserInlinePoliciesResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_ListPlatformUserInlinePolicies_FullMethodName, in, out, cOpts...)
+	out := new(GetRoleTrustPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetRoleTrustPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetPlatformUserPermissionBoundary(ctx context.Context, in *GetPlatformUserPermissionBoundaryRequest, opts ...grpc.CallOption) (*GetPlatformUserPermissionBoundaryResponse, error) {
+func (c *iAMQueryServiceClient) GetRolePermissionBoundary(ctx context.Context, in *GetRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*GetRolePermissionBoundaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPlatformUserPermissionBoundaryResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetPlatformUserPermissionBoundary_FullMethodName, in, out, cOpts...)
+	out := new(GetRolePermissionBoundaryResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetRolePermissionBoundary_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersResponse, error) {
+func (c *iAMQueryServiceClient) GetRoleAccessPolicy(ctx context.Context, in *GetRoleAccessPolicyRequest, opts ...grpc.CallOption) (*GetRoleAccessPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTenantMembersResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantMembers_FullMethodName, in, out, cOpts...)
+	out := new(GetRoleAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetRoleAccessPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) ListTenantUserPolicies(ctx context.Context, in *ListTenantUserPoliciesRequest, opts ...grpc.CallOption) (*ListTenantUserPoliciesResponse, error) {
+func (c *iAMQueryServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTenantUserPoliciesResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantUserPolicies_FullMethodName, in, out, cOpts...)
+	out := new(GetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GetAccessRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetTenantUserInlinePolicy(ctx context.Context, in *GetTenantUserInlinePolicyRequest, opts ...grpc.CallOption) (*GetTenantUserInlinePolicyResponse, error) {
+func (c *iAMQueryServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTenantUserInlinePolicyResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetTenantUserInlinePolicy_FullMethodName, in, out, cOpts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListAccessRequests_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) ListTenantUserInlinePolicies(ctx context.Context, in *ListTenantUserInlinePoliciesRequest, opts ...grpc.CallOption) (*ListTenantUserInlinePoliciesResponse, error) {
+func (c *iAMQueryServiceClient) SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTenantUserInlinePoliciesResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_ListTenantUserInlinePolicies_FullMethodName, in, out, cOpts...)
+	out := new(SimulateAccessResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_SimulateAccess_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetTenantUserPermissionBoundary(ctx context.Context, in *GetTenantUserPermissionBoundaryRequest, opts ...grpc.CallOption) (*GetTenantUserPermissionBoundaryResponse, error) {
+func (c *iAMQueryServiceClient) ListUnusedPermissions(ctx context.Context, in *ListUnusedPermissionsRequest, opts ...grpc.CallOption) (*ListUnusedPermissionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTenantUserPermissionBoundaryResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetTenantUserPermissionBoundary_FullMethodName, in, out, cOpts...)
+	out := new(ListUnusedPermissionsResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListUnusedPermissions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetRoleTrustPolicy(ctx context.Context, in *GetRoleTrustPolicyRequest, opts ...grpc.CallOption) (*GetRoleTrustPolicyResponse, error) {
+func (c *iAMQueryServiceClient) ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetRoleTrustPolicyResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetRoleTrustPolicy_FullMethodName, in, out, cOpts...)
+	out := new(ListUnusedRolesResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListUnusedRoles_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) GetRolePermissionBoundary(ctx context.Context, in *GetRolePermissionBoundaryRequest, opts ...grpc.CallOption) (*GetRolePermissionBoundaryResponse, error) {
+func (c *iAMQueryServiceClient) ListStaleGroupMemberships(ctx context.Context, in *ListStaleGroupMembershipsRequest, opts ...grpc.CallOption) (*ListStaleGroupMembershipsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetRolePermissionBoundaryResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_GetRolePermissionBoundary_FullMethodName, in, out, cOpts...)
+	out := new(ListStaleGroupMembershipsResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_ListStaleGroupMemberships_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMQueryServiceClient) SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error) {
+func (c *iAMQueryServiceClient) GenerateLeastPrivilegePolicy(ctx context.Context, in *GenerateLeastPrivilegePolicyRequest, opts ...grpc.CallOption) (*GenerateLeastPrivilegePolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SimulateAccessResponse)
-	err := c.cc.Invoke(ctx, IAMQueryService_SimulateAccess_FullMethodName, in, out, cOpts...)
+	out := new(GenerateLeastPrivilegePolicyResponse)
+	err := c.cc.Invoke(ctx, IAMQueryService_GenerateLeastPrivilegePolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5321,6 +6659,13 @@ func (c *iAMQueryServiceClient) SimulateAccess(ctx context.Context, in *Simulate
 type IAMQueryServiceServer interface {
 	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
 	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	ListSCIMTokens(context.Context, *ListSCIMTokensRequest) (*ListSCIMTokensResponse, error)
+	GetOrganizationSAMLConnection(context.Context, *GetOrganizationSAMLConnectionRequest) (*GetOrganizationSAMLConnectionResponse, error)
+	// ResolveSAMLConnection, ListSSORequiredOrganizations and GetOrganizationMembership serve the
+	// auth service's login flows.
+	ResolveSAMLConnection(context.Context, *ResolveSAMLConnectionRequest) (*ResolveSAMLConnectionResponse, error)
+	ListSSORequiredOrganizations(context.Context, *ListSSORequiredOrganizationsRequest) (*ListSSORequiredOrganizationsResponse, error)
+	GetOrganizationMembership(context.Context, *GetOrganizationMembershipRequest) (*GetOrganizationMembershipResponse, error)
 	ListServiceControlPolicies(context.Context, *ListServiceControlPoliciesRequest) (*ListServiceControlPoliciesResponse, error)
 	ListTenantInvites(context.Context, *ListTenantInvitesRequest) (*ListTenantInvitesResponse, error)
 	GetTenantMembership(context.Context, *GetTenantMembershipRequest) (*GetTenantMembershipResponse, error)
@@ -5334,6 +6679,7 @@ type IAMQueryServiceServer interface {
 	ListPolicyVersions(context.Context, *ListPolicyVersionsRequest) (*ListPolicyVersionsResponse, error)
 	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
 	ListPolicyAttachments(context.Context, *ListPolicyAttachmentsRequest) (*ListPolicyAttachmentsResponse, error)
+	ValidatePolicy(context.Context, *ValidatePolicyRequest) (*ValidatePolicyResponse, error)
 	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
 	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
 	ListGroupPolicies(context.Context, *ListGroupPoliciesRequest) (*ListGroupPoliciesResponse, error)
@@ -5350,7 +6696,14 @@ type IAMQueryServiceServer interface {
 	GetTenantUserPermissionBoundary(context.Context, *GetTenantUserPermissionBoundaryRequest) (*GetTenantUserPermissionBoundaryResponse, error)
 	GetRoleTrustPolicy(context.Context, *GetRoleTrustPolicyRequest) (*GetRoleTrustPolicyResponse, error)
 	GetRolePermissionBoundary(context.Context, *GetRolePermissionBoundaryRequest) (*GetRolePermissionBoundaryResponse, error)
+	GetRoleAccessPolicy(context.Context, *GetRoleAccessPolicyRequest) (*GetRoleAccessPolicyResponse, error)
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error)
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
 	SimulateAccess(context.Context, *SimulateAccessRequest) (*SimulateAccessResponse, error)
+	ListUnusedPermissions(context.Context, *ListUnusedPermissionsRequest) (*ListUnusedPermissionsResponse, error)
+	ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error)
+	ListStaleGroupMemberships(context.Context, *ListStaleGroupMembershipsRequest) (*ListStaleGroupMembershipsResponse, error)
+	GenerateLeastPrivilegePolicy(context.Context, *GenerateLeastPrivilegePolicyRequest) (*GenerateLeastPrivilegePolicyResponse, error)
 	mustEmbedUnimplementedIAMQueryServiceServer()
 }
 
@@ -5367,6 +6720,21 @@ func (UnimplementedIAMQueryServiceServer) ListOrganizations(context.Context, *Li
 func (UnimplementedIAMQueryServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
+func (UnimplementedIAMQueryServiceServer) ListSCIMTokens(context.Context, *ListSCIMTokensRequest) (*ListSCIMTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMTokens not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) GetOrganizationSAMLConnection(context.Context, *GetOrganizationSAMLConnectionRequest) (*GetOrganizationSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationSAMLConnection not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) ResolveSAMLConnection(context.Context, *ResolveSAMLConnectionRequest) (*ResolveSAMLConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSAMLConnection not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) ListSSORequiredOrganizations(context.Context, *ListSSORequiredOrganizationsRequest) (*ListSSORequiredOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSSORequiredOrganizations not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) GetOrganizationMembership(context.Context, *GetOrganizationMembershipRequest) (*GetOrganizationMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationMembership not implemented")
+}
 func (UnimplementedIAMQueryServiceServer) ListServiceControlPolicies(context.Context, *ListServiceControlPoliciesRequest) (*ListServiceControlPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServiceControlPolicies not implemented")
 }
@@ -5406,6 +6774,9 @@ func (UnimplementedIAMQueryServiceServer) ListPolicies(context.Context, *ListPol
 func (UnimplementedIAMQueryServiceServer) ListPolicyAttachments(context.Context, *ListPolicyAttachmentsRequest) (*ListPolicyAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyAttachments not implemented")
 }
+func (UnimplementedIAMQueryServiceServer) ValidatePolicy(context.Context, *ValidatePolicyRequest) (*ValidatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePolicy not implemented")
+}
 func (UnimplementedIAMQueryServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
@@ -5454,9 +6825,30 @@ func (UnimplementedIAMQueryServiceServer) GetRoleTrustPolicy(context.Context, *G
 func (UnimplementedIAMQueryServiceServer) GetRolePermissionBoundary(context.Context, *GetRolePermissionBoundaryRequest) (*GetRolePermissionBoundaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermissionBoundary not implemented")
 }
+func (UnimplementedIAMQueryServiceServer) GetRoleAccessPolicy(context.Context, *GetRoleAccessPolicyRequest) (*GetRoleAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleAccessPolicy not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
 func (UnimplementedIAMQueryServiceServer) SimulateAccess(context.Context, *SimulateAccessRequest) (*SimulateAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateAccess not implemented")
 }
+func (UnimplementedIAMQueryServiceServer) ListUnusedPermissions(context.Context, *ListUnusedPermissionsRequest) (*ListUnusedPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedPermissions not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedRoles not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) ListStaleGroupMemberships(context.Context, *ListStaleGroupMembershipsRequest) (*ListStaleGroupMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleGroupMemberships not implemented")
+}
+func (UnimplementedIAMQueryServiceServer) GenerateLeastPrivilegePolicy(context.Context, *GenerateLeastPrivilegePolicyRequest) (*GenerateLeastPrivilegePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateLeastPrivilegePolicy not implemented")
+}
 func (UnimplementedIAMQueryServiceServer) mustEmbedUnimplementedIAMQueryServiceServer() {}
 func (UnimplementedIAMQueryServiceServer) testEmbeddedByValue()                         {}
 
@@ -5514,6 +6906,96 @@ func _IAMQueryService_ListOrganizationMembers_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMQueryService_ListSCIMTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCIMTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListSCIMTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListSCIMTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListSCIMTokens(ctx, req.(*ListSCIMTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_GetOrganizationSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSAMLConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).GetOrganizationSAMLConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_GetOrganizationSAMLConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).GetOrganizationSAMLConnection(ctx, req.(*GetOrganizationSAMLConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_ResolveSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSAMLConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ResolveSAMLConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ResolveSAMLConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ResolveSAMLConnection(ctx, req.(*ResolveSAMLConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_ListSSORequiredOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSSORequiredOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListSSORequiredOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListSSORequiredOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListSSORequiredOrganizations(ctx, req.(*ListSSORequiredOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_GetOrganizationMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).GetOrganizationMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_GetOrganizationMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).GetOrganizationMembership(ctx, req.(*GetOrganizationMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMQueryService_ListServiceControlPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListServiceControlPoliciesRequest)
 	if err := dec(in); err != nil {
@@ -5748,6 +7230,24 @@ func _IAMQueryService_ListPolicyAttachments_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMQueryService_ValidatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ValidatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ValidatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ValidatePolicy(ctx, req.(*ValidatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMQueryService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGroupsRequest)
 	if err := dec(in); err != nil {
@@ -6036,6 +7536,60 @@ func _IAMQueryService_GetRolePermissionBoundary_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMQueryService_GetRoleAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).GetRoleAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_GetRoleAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).GetRoleAccessPolicy(ctx, req.(*GetRoleAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).GetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_GetAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMQueryService_SimulateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimulateAccessRequest)
 	if err := dec(in); err != nil {
@@ -6054,6 +7608,78 @@ func _IAMQueryService_SimulateAccess_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMQueryService_ListUnusedPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListUnusedPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListUnusedPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListUnusedPermissions(ctx, req.(*ListUnusedPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_ListUnusedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListUnusedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListUnusedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListUnusedRoles(ctx, req.(*ListUnusedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_ListStaleGroupMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleGroupMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).ListStaleGroupMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_ListStaleGroupMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).ListStaleGroupMemberships(ctx, req.(*ListStaleGroupMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMQueryService_GenerateLeastPrivilegePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateLeastPrivilegePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMQueryServiceServer).GenerateLeastPrivilegePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMQueryService_GenerateLeastPrivilegePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMQueryServiceServer).GenerateLeastPrivilegePolicy(ctx, req.(*GenerateLeastPrivilegePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMQueryService_ServiceDesc is the grpc.ServiceDesc for IAMQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -6069,6 +7695,26 @@ var IAMQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationMembers",
 			Handler:    _IAMQueryService_ListOrganizationMembers_Handler,
 		},
+		{
+			MethodName: "ListSCIMTokens",
+			Handler:    _IAMQueryService_ListSCIMTokens_Handler,
+		},
+		{
+			MethodName: "GetOrganizationSAMLConnection",
+			Handler:    _IAMQueryService_GetOrganizationSAMLConnection_Handler,
+		},
+		{
+			MethodName: "ResolveSAMLConnection",
+			Handler:    _IAMQueryService_ResolveSAMLConnection_Handler,
+		},
+		{
+			MethodName: "ListSSORequiredOrganizations",
+			Handler:    _IAMQueryService_ListSSORequiredOrganizations_Handler,
+		},
+		{
+			MethodName: "GetOrganizationMembership",
+			Handler:    _IAMQueryService_GetOrganizationMembership_Handler,
+		},
 		{
 			MethodName: "ListServiceControlPolicies",
 			Handler:    _IAMQueryService_ListServiceControlPolicies_Handler,
@@ -6121,6 +7767,10 @@ var IAMQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPolicyAttachments",
 			Handler:    _IAMQueryService_ListPolicyAttachments_Handler,
 		},
+		{
+			MethodName: "ValidatePolicy",
+			Handler:    _IAMQueryService_ValidatePolicy_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _IAMQueryService_ListGroups_Handler,
@@ -6185,10 +7835,38 @@ var IAMQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRolePermissionBoundary",
 			Handler:    _IAMQueryService_GetRolePermissionBoundary_Handler,
 		},
+		{
+			MethodName: "GetRoleAccessPolicy",
+			Handler:    _IAMQueryService_GetRoleAccessPolicy_Handler,
+		},
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _IAMQueryService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _IAMQueryService_ListAccessRequests_Handler,
+		},
 		{
 			MethodName: "SimulateAccess",
 			Handler:    _IAMQueryService_SimulateAccess_Handler,
 		},
+		{
+			MethodName: "ListUnusedPermissions",
+			Handler:    _IAMQueryService_ListUnusedPermissions_Handler,
+		},
+		{
+			MethodName: "ListUnusedRoles",
+			Handler:    _IAMQueryService_ListUnusedRoles_Handler,
+		},
+		{
+			MethodName: "ListStaleGroupMemberships",
+			Handler:    _IAMQueryService_ListStaleGroupMemberships_Handler,
+		},
+		{
+			MethodName: "GenerateLeastPrivilegePolicy",
+			Handler:    _IAMQueryService_GenerateLeastPrivilegePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iam/v1/iam_service.proto",
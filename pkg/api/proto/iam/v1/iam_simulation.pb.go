@@ -494,8 +494,11 @@ type IAMAssumedRole struct {
 	SourceIdentity   string                 `protobuf:"bytes,7,opt,name=source_identity,json=sourceIdentity,proto3" json:"source_identity,omitempty"`
 	SessionTags      map[string]string      `protobuf:"bytes,8,rep,name=session_tags,json=sessionTags,proto3" json:"session_tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ExpiresAt        string                 `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// access_request_id is set when the session was granted by an approved access request
+	// rather than the role's trust policy.
+	AccessRequestId string `protobuf:"bytes,10,opt,name=access_request_id,json=accessRequestId,proto3" json:"access_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *IAMAssumedRole) Reset() {
@@ -591,6 +594,13 @@ func (x *IAMAssumedRole) GetExpiresAt() string {
 	return ""
 }
 
+func (x *IAMAssumedRole) GetAccessRequestId() string {
+	if x != nil {
+		return x.AccessRequestId
+	}
+	return ""
+}
+
 type IAMAssumeRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AssumedRole   *IAMAssumedRole        `protobuf:"bytes,1,opt,name=assumed_role,json=assumedRole,proto3" json:"assumed_role,omitempty"`
@@ -695,7 +705,7 @@ const file_iam_v1_iam_simulation_proto_rawDesc = "" +
 	"\fsession_tags\x18\t \x03(\v2*.iam.IAMAssumeRoleRequest.SessionTagsEntryR\vsessionTags\x1a>\n" +
 	"\x10SessionTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x03\n" +
 	"\x0eIAMAssumedRole\x12\x17\n" +
 	"\arole_id\x18\x01 \x01(\x04R\x06roleId\x12\x1d\n" +
 	"\n" +
@@ -707,7 +717,9 @@ const file_iam_v1_iam_simulation_proto_rawDesc = "" +
 	"\x0fsource_identity\x18\a \x01(\tR\x0esourceIdentity\x12G\n" +
 	"\fsession_tags\x18\b \x03(\v2$.iam.IAMAssumedRole.SessionTagsEntryR\vsessionTags\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\t \x01(\tR\texpiresAt\x1a>\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12*\n" +
+	"\x11access_request_id\x18\n" +
+	" \x01(\tR\x0faccessRequestId\x1a>\n" +
 	"\x10SessionTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n" +
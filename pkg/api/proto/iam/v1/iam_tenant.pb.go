@@ -1398,6 +1398,1171 @@ func (x *ListOrganizationMembersResponse) GetPageInfo() *v1.PageInfo {
 	return nil
 }
 
+type SCIMToken struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId           string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TokenPrefix     string                 `protobuf:"bytes,4,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"`
+	CreatedByUserId uint64                 `protobuf:"varint,5,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt      string                 `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt       string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SCIMToken) Reset() {
+	*x = SCIMToken{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SCIMToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMToken) ProtoMessage() {}
+
+func (x *SCIMToken) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMToken.ProtoReflect.Descriptor instead.
+func (*SCIMToken) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SCIMToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetCreatedByUserId() uint64 {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return 0
+}
+
+func (x *SCIMToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *SCIMToken) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type CreateSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSCIMTokenRequest) Reset() {
+	*x = CreateSCIMTokenRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSCIMTokenRequest) ProtoMessage() {}
+
+func (x *CreateSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateSCIMTokenRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateSCIMTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateSCIMTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *SCIMToken             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The raw bearer token. It is returned only once.
+	ScimToken     string `protobuf:"bytes,2,opt,name=scim_token,json=scimToken,proto3" json:"scim_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSCIMTokenResponse) Reset() {
+	*x = CreateSCIMTokenResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSCIMTokenResponse) ProtoMessage() {}
+
+func (x *CreateSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateSCIMTokenResponse) GetToken() *SCIMToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateSCIMTokenResponse) GetScimToken() string {
+	if x != nil {
+		return x.ScimToken
+	}
+	return ""
+}
+
+type ListSCIMTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSCIMTokensRequest) Reset() {
+	*x = ListSCIMTokensRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSCIMTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCIMTokensRequest) ProtoMessage() {}
+
+func (x *ListSCIMTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCIMTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListSCIMTokensRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSCIMTokensRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListSCIMTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*SCIMToken           `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSCIMTokensResponse) Reset() {
+	*x = ListSCIMTokensResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSCIMTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCIMTokensResponse) ProtoMessage() {}
+
+func (x *ListSCIMTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCIMTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListSCIMTokensResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSCIMTokensResponse) GetTokens() []*SCIMToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSCIMTokenRequest) Reset() {
+	*x = RevokeSCIMTokenRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSCIMTokenRequest) ProtoMessage() {}
+
+func (x *RevokeSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeSCIMTokenRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RevokeSCIMTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type RevokeSCIMTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSCIMTokenResponse) Reset() {
+	*x = RevokeSCIMTokenResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSCIMTokenResponse) ProtoMessage() {}
+
+func (x *RevokeSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{28}
+}
+
+// SAMLConnection is an organization's SAML 2.0 identity provider configuration.
+type SAMLConnection struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrgId             string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	IdpEntityId       string                 `protobuf:"bytes,2,opt,name=idp_entity_id,json=idpEntityId,proto3" json:"idp_entity_id,omitempty"`
+	SsoUrl            string                 `protobuf:"bytes,3,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	MetadataXml       string                 `protobuf:"bytes,4,opt,name=metadata_xml,json=metadataXml,proto3" json:"metadata_xml,omitempty"`
+	UsernameAttribute string                 `protobuf:"bytes,5,opt,name=username_attribute,json=usernameAttribute,proto3" json:"username_attribute,omitempty"`
+	EmailAttribute    string                 `protobuf:"bytes,6,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	GroupsAttribute   string                 `protobuf:"bytes,7,opt,name=groups_attribute,json=groupsAttribute,proto3" json:"groups_attribute,omitempty"`
+	// When set, active members of the organization cannot log in with a password.
+	SsoRequired   bool   `protobuf:"varint,8,opt,name=sso_required,json=ssoRequired,proto3" json:"sso_required,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLConnection) Reset() {
+	*x = SAMLConnection{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLConnection) ProtoMessage() {}
+
+func (x *SAMLConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLConnection.ProtoReflect.Descriptor instead.
+func (*SAMLConnection) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SAMLConnection) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetIdpEntityId() string {
+	if x != nil {
+		return x.IdpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetMetadataXml() string {
+	if x != nil {
+		return x.MetadataXml
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetUsernameAttribute() string {
+	if x != nil {
+		return x.UsernameAttribute
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetGroupsAttribute() string {
+	if x != nil {
+		return x.GroupsAttribute
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetSsoRequired() bool {
+	if x != nil {
+		return x.SsoRequired
+	}
+	return false
+}
+
+func (x *SAMLConnection) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SAMLConnection) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PutOrganizationSAMLConnectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// IdP metadata XML (EntityDescriptor) as downloaded from the identity provider.
+	MetadataXml       string `protobuf:"bytes,2,opt,name=metadata_xml,json=metadataXml,proto3" json:"metadata_xml,omitempty"`
+	UsernameAttribute string `protobuf:"bytes,3,opt,name=username_attribute,json=usernameAttribute,proto3" json:"username_attribute,omitempty"`
+	EmailAttribute    string `protobuf:"bytes,4,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	GroupsAttribute   string `protobuf:"bytes,5,opt,name=groups_attribute,json=groupsAttribute,proto3" json:"groups_attribute,omitempty"`
+	SsoRequired       bool   `protobuf:"varint,6,opt,name=sso_required,json=ssoRequired,proto3" json:"sso_required,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) Reset() {
+	*x = PutOrganizationSAMLConnectionRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutOrganizationSAMLConnectionRequest) ProtoMessage() {}
+
+func (x *PutOrganizationSAMLConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutOrganizationSAMLConnectionRequest.ProtoReflect.Descriptor instead.
+func (*PutOrganizationSAMLConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetMetadataXml() string {
+	if x != nil {
+		return x.MetadataXml
+	}
+	return ""
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetUsernameAttribute() string {
+	if x != nil {
+		return x.UsernameAttribute
+	}
+	return ""
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetGroupsAttribute() string {
+	if x != nil {
+		return x.GroupsAttribute
+	}
+	return ""
+}
+
+func (x *PutOrganizationSAMLConnectionRequest) GetSsoRequired() bool {
+	if x != nil {
+		return x.SsoRequired
+	}
+	return false
+}
+
+type PutOrganizationSAMLConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *SAMLConnection        `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutOrganizationSAMLConnectionResponse) Reset() {
+	*x = PutOrganizationSAMLConnectionResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutOrganizationSAMLConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutOrganizationSAMLConnectionResponse) ProtoMessage() {}
+
+func (x *PutOrganizationSAMLConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutOrganizationSAMLConnectionResponse.ProtoReflect.Descriptor instead.
+func (*PutOrganizationSAMLConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PutOrganizationSAMLConnectionResponse) GetConnection() *SAMLConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type GetOrganizationSAMLConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationSAMLConnectionRequest) Reset() {
+	*x = GetOrganizationSAMLConnectionRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationSAMLConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationSAMLConnectionRequest) ProtoMessage() {}
+
+func (x *GetOrganizationSAMLConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationSAMLConnectionRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationSAMLConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetOrganizationSAMLConnectionRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type GetOrganizationSAMLConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *SAMLConnection        `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationSAMLConnectionResponse) Reset() {
+	*x = GetOrganizationSAMLConnectionResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationSAMLConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationSAMLConnectionResponse) ProtoMessage() {}
+
+func (x *GetOrganizationSAMLConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationSAMLConnectionResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationSAMLConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetOrganizationSAMLConnectionResponse) GetConnection() *SAMLConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type DeleteOrganizationSAMLConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationSAMLConnectionRequest) Reset() {
+	*x = DeleteOrganizationSAMLConnectionRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationSAMLConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationSAMLConnectionRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationSAMLConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationSAMLConnectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationSAMLConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteOrganizationSAMLConnectionRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type DeleteOrganizationSAMLConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationSAMLConnectionResponse) Reset() {
+	*x = DeleteOrganizationSAMLConnectionResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationSAMLConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationSAMLConnectionResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationSAMLConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationSAMLConnectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationSAMLConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{35}
+}
+
+type ResolveSAMLConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSAMLConnectionRequest) Reset() {
+	*x = ResolveSAMLConnectionRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSAMLConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSAMLConnectionRequest) ProtoMessage() {}
+
+func (x *ResolveSAMLConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSAMLConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSAMLConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResolveSAMLConnectionRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ResolveSAMLConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *SAMLConnection        `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSAMLConnectionResponse) Reset() {
+	*x = ResolveSAMLConnectionResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSAMLConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSAMLConnectionResponse) ProtoMessage() {}
+
+func (x *ResolveSAMLConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSAMLConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ResolveSAMLConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResolveSAMLConnectionResponse) GetConnection() *SAMLConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type ListSSORequiredOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSSORequiredOrganizationsRequest) Reset() {
+	*x = ListSSORequiredOrganizationsRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSSORequiredOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSSORequiredOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListSSORequiredOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSSORequiredOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSSORequiredOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSSORequiredOrganizationsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListSSORequiredOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgIds        []string               `protobuf:"bytes,1,rep,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSSORequiredOrganizationsResponse) Reset() {
+	*x = ListSSORequiredOrganizationsResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSSORequiredOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSSORequiredOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListSSORequiredOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSSORequiredOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSSORequiredOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSSORequiredOrganizationsResponse) GetOrgIds() []string {
+	if x != nil {
+		return x.OrgIds
+	}
+	return nil
+}
+
+type GetOrganizationMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationMembershipRequest) Reset() {
+	*x = GetOrganizationMembershipRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationMembershipRequest) ProtoMessage() {}
+
+func (x *GetOrganizationMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationMembershipRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetOrganizationMembershipRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetOrganizationMembershipRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetOrganizationMembershipResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Membership    *OrganizationMembership `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationMembershipResponse) Reset() {
+	*x = GetOrganizationMembershipResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationMembershipResponse) ProtoMessage() {}
+
+func (x *GetOrganizationMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationMembershipResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOrganizationMembershipResponse) GetMembership() *OrganizationMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type ApplySAMLLoginRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Group names from the assertion; ignored unless the connection maps a groups attribute.
+	Groups        []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySAMLLoginRequest) Reset() {
+	*x = ApplySAMLLoginRequest{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySAMLLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySAMLLoginRequest) ProtoMessage() {}
+
+func (x *ApplySAMLLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySAMLLoginRequest.ProtoReflect.Descriptor instead.
+func (*ApplySAMLLoginRequest) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ApplySAMLLoginRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ApplySAMLLoginRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplySAMLLoginRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ApplySAMLLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySAMLLoginResponse) Reset() {
+	*x = ApplySAMLLoginResponse{}
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySAMLLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySAMLLoginResponse) ProtoMessage() {}
+
+func (x *ApplySAMLLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySAMLLoginResponse.ProtoReflect.Descriptor instead.
+func (*ApplySAMLLoginResponse) Descriptor() ([]byte, []int) {
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{43}
+}
+
 type AttachTenantToOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
@@ -1408,7 +2573,7 @@ type AttachTenantToOrganizationRequest struct {
 
 func (x *AttachTenantToOrganizationRequest) Reset() {
 	*x = AttachTenantToOrganizationRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[22]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1420,7 +2585,7 @@ func (x *AttachTenantToOrganizationRequest) String() string {
 func (*AttachTenantToOrganizationRequest) ProtoMessage() {}
 
 func (x *AttachTenantToOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[22]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,7 +2598,7 @@ func (x *AttachTenantToOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AttachTenantToOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*AttachTenantToOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{22}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AttachTenantToOrganizationRequest) GetOrgId() string {
@@ -1458,7 +2623,7 @@ type AttachTenantToOrganizationResponse struct {
 
 func (x *AttachTenantToOrganizationResponse) Reset() {
 	*x = AttachTenantToOrganizationResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[23]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1470,7 +2635,7 @@ func (x *AttachTenantToOrganizationResponse) String() string {
 func (*AttachTenantToOrganizationResponse) ProtoMessage() {}
 
 func (x *AttachTenantToOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[23]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,7 +2648,7 @@ func (x *AttachTenantToOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AttachTenantToOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*AttachTenantToOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{23}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{45}
 }
 
 type DetachTenantFromOrganizationRequest struct {
@@ -1496,7 +2661,7 @@ type DetachTenantFromOrganizationRequest struct {
 
 func (x *DetachTenantFromOrganizationRequest) Reset() {
 	*x = DetachTenantFromOrganizationRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[24]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +2673,7 @@ func (x *DetachTenantFromOrganizationRequest) String() string {
 func (*DetachTenantFromOrganizationRequest) ProtoMessage() {}
 
 func (x *DetachTenantFromOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[24]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +2686,7 @@ func (x *DetachTenantFromOrganizationRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DetachTenantFromOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*DetachTenantFromOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{24}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DetachTenantFromOrganizationRequest) GetOrgId() string {
@@ -1546,7 +2711,7 @@ type DetachTenantFromOrganizationResponse struct {
 
 func (x *DetachTenantFromOrganizationResponse) Reset() {
 	*x = DetachTenantFromOrganizationResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[25]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1558,7 +2723,7 @@ func (x *DetachTenantFromOrganizationResponse) String() string {
 func (*DetachTenantFromOrganizationResponse) ProtoMessage() {}
 
 func (x *DetachTenantFromOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[25]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,7 +2736,7 @@ func (x *DetachTenantFromOrganizationResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DetachTenantFromOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*DetachTenantFromOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{25}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{47}
 }
 
 type AddTenantMemberRequest struct {
@@ -1585,7 +2750,7 @@ type AddTenantMemberRequest struct {
 
 func (x *AddTenantMemberRequest) Reset() {
 	*x = AddTenantMemberRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[26]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1597,7 +2762,7 @@ func (x *AddTenantMemberRequest) String() string {
 func (*AddTenantMemberRequest) ProtoMessage() {}
 
 func (x *AddTenantMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[26]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1610,7 +2775,7 @@ func (x *AddTenantMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTenantMemberRequest.ProtoReflect.Descriptor instead.
 func (*AddTenantMemberRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{26}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AddTenantMemberRequest) GetTenantId() string {
@@ -1642,7 +2807,7 @@ type AddTenantMemberResponse struct {
 
 func (x *AddTenantMemberResponse) Reset() {
 	*x = AddTenantMemberResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[27]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1654,7 +2819,7 @@ func (x *AddTenantMemberResponse) String() string {
 func (*AddTenantMemberResponse) ProtoMessage() {}
 
 func (x *AddTenantMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[27]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,7 +2832,7 @@ func (x *AddTenantMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTenantMemberResponse.ProtoReflect.Descriptor instead.
 func (*AddTenantMemberResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{27}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{49}
 }
 
 type AddTenantMemberByIdentityRequest struct {
@@ -1681,7 +2846,7 @@ type AddTenantMemberByIdentityRequest struct {
 
 func (x *AddTenantMemberByIdentityRequest) Reset() {
 	*x = AddTenantMemberByIdentityRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[28]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1693,7 +2858,7 @@ func (x *AddTenantMemberByIdentityRequest) String() string {
 func (*AddTenantMemberByIdentityRequest) ProtoMessage() {}
 
 func (x *AddTenantMemberByIdentityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[28]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,7 +2871,7 @@ func (x *AddTenantMemberByIdentityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTenantMemberByIdentityRequest.ProtoReflect.Descriptor instead.
 func (*AddTenantMemberByIdentityRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{28}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *AddTenantMemberByIdentityRequest) GetTenantId() string {
@@ -1740,7 +2905,7 @@ type AddTenantMemberByIdentityResponse struct {
 
 func (x *AddTenantMemberByIdentityResponse) Reset() {
 	*x = AddTenantMemberByIdentityResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[29]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1752,7 +2917,7 @@ func (x *AddTenantMemberByIdentityResponse) String() string {
 func (*AddTenantMemberByIdentityResponse) ProtoMessage() {}
 
 func (x *AddTenantMemberByIdentityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[29]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,7 +2930,7 @@ func (x *AddTenantMemberByIdentityResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AddTenantMemberByIdentityResponse.ProtoReflect.Descriptor instead.
 func (*AddTenantMemberByIdentityResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{29}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AddTenantMemberByIdentityResponse) GetUserId() uint64 {
@@ -1793,7 +2958,7 @@ type CreateTenantInviteRequest struct {
 
 func (x *CreateTenantInviteRequest) Reset() {
 	*x = CreateTenantInviteRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[30]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1805,7 +2970,7 @@ func (x *CreateTenantInviteRequest) String() string {
 func (*CreateTenantInviteRequest) ProtoMessage() {}
 
 func (x *CreateTenantInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[30]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +2983,7 @@ func (x *CreateTenantInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTenantInviteRequest.ProtoReflect.Descriptor instead.
 func (*CreateTenantInviteRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{30}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CreateTenantInviteRequest) GetTenantId() string {
@@ -1853,7 +3018,7 @@ type CreateTenantInviteResponse struct {
 
 func (x *CreateTenantInviteResponse) Reset() {
 	*x = CreateTenantInviteResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[31]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1865,7 +3030,7 @@ func (x *CreateTenantInviteResponse) String() string {
 func (*CreateTenantInviteResponse) ProtoMessage() {}
 
 func (x *CreateTenantInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[31]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,7 +3043,7 @@ func (x *CreateTenantInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTenantInviteResponse.ProtoReflect.Descriptor instead.
 func (*CreateTenantInviteResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{31}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CreateTenantInviteResponse) GetInvite() *TenantInvite {
@@ -1912,7 +3077,7 @@ type ListTenantInvitesRequest struct {
 
 func (x *ListTenantInvitesRequest) Reset() {
 	*x = ListTenantInvitesRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[32]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1924,7 +3089,7 @@ func (x *ListTenantInvitesRequest) String() string {
 func (*ListTenantInvitesRequest) ProtoMessage() {}
 
 func (x *ListTenantInvitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[32]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +3102,7 @@ func (x *ListTenantInvitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantInvitesRequest.ProtoReflect.Descriptor instead.
 func (*ListTenantInvitesRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{32}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ListTenantInvitesRequest) GetTenantId() string {
@@ -1964,7 +3129,7 @@ type ListTenantInvitesResponse struct {
 
 func (x *ListTenantInvitesResponse) Reset() {
 	*x = ListTenantInvitesResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[33]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1976,7 +3141,7 @@ func (x *ListTenantInvitesResponse) String() string {
 func (*ListTenantInvitesResponse) ProtoMessage() {}
 
 func (x *ListTenantInvitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[33]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1989,7 +3154,7 @@ func (x *ListTenantInvitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantInvitesResponse.ProtoReflect.Descriptor instead.
 func (*ListTenantInvitesResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{33}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListTenantInvitesResponse) GetInvites() []*TenantInvite {
@@ -2015,7 +3180,7 @@ type RevokeTenantInviteRequest struct {
 
 func (x *RevokeTenantInviteRequest) Reset() {
 	*x = RevokeTenantInviteRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[34]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2027,7 +3192,7 @@ func (x *RevokeTenantInviteRequest) String() string {
 func (*RevokeTenantInviteRequest) ProtoMessage() {}
 
 func (x *RevokeTenantInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[34]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +3205,7 @@ func (x *RevokeTenantInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeTenantInviteRequest.ProtoReflect.Descriptor instead.
 func (*RevokeTenantInviteRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{34}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *RevokeTenantInviteRequest) GetInviteId() string {
@@ -2058,7 +3223,7 @@ type RevokeTenantInviteResponse struct {
 
 func (x *RevokeTenantInviteResponse) Reset() {
 	*x = RevokeTenantInviteResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[35]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2070,7 +3235,7 @@ func (x *RevokeTenantInviteResponse) String() string {
 func (*RevokeTenantInviteResponse) ProtoMessage() {}
 
 func (x *RevokeTenantInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[35]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,7 +3248,7 @@ func (x *RevokeTenantInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeTenantInviteResponse.ProtoReflect.Descriptor instead.
 func (*RevokeTenantInviteResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{35}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{57}
 }
 
 type AcceptTenantInviteRequest struct {
@@ -2095,7 +3260,7 @@ type AcceptTenantInviteRequest struct {
 
 func (x *AcceptTenantInviteRequest) Reset() {
 	*x = AcceptTenantInviteRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[36]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2107,7 +3272,7 @@ func (x *AcceptTenantInviteRequest) String() string {
 func (*AcceptTenantInviteRequest) ProtoMessage() {}
 
 func (x *AcceptTenantInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[36]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +3285,7 @@ func (x *AcceptTenantInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptTenantInviteRequest.ProtoReflect.Descriptor instead.
 func (*AcceptTenantInviteRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{36}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AcceptTenantInviteRequest) GetInviteToken() string {
@@ -2139,7 +3304,7 @@ type AcceptTenantInviteResponse struct {
 
 func (x *AcceptTenantInviteResponse) Reset() {
 	*x = AcceptTenantInviteResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[37]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2151,7 +3316,7 @@ func (x *AcceptTenantInviteResponse) String() string {
 func (*AcceptTenantInviteResponse) ProtoMessage() {}
 
 func (x *AcceptTenantInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[37]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +3329,7 @@ func (x *AcceptTenantInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptTenantInviteResponse.ProtoReflect.Descriptor instead.
 func (*AcceptTenantInviteResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{37}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AcceptTenantInviteResponse) GetMembership() *TenantMembership {
@@ -2184,7 +3349,7 @@ type GetTenantMembershipRequest struct {
 
 func (x *GetTenantMembershipRequest) Reset() {
 	*x = GetTenantMembershipRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[38]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2196,7 +3361,7 @@ func (x *GetTenantMembershipRequest) String() string {
 func (*GetTenantMembershipRequest) ProtoMessage() {}
 
 func (x *GetTenantMembershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[38]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2209,7 +3374,7 @@ func (x *GetTenantMembershipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantMembershipRequest.ProtoReflect.Descriptor instead.
 func (*GetTenantMembershipRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{38}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetTenantMembershipRequest) GetTenantId() string {
@@ -2235,7 +3400,7 @@ type GetTenantMembershipResponse struct {
 
 func (x *GetTenantMembershipResponse) Reset() {
 	*x = GetTenantMembershipResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[39]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2247,7 +3412,7 @@ func (x *GetTenantMembershipResponse) String() string {
 func (*GetTenantMembershipResponse) ProtoMessage() {}
 
 func (x *GetTenantMembershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[39]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2260,7 +3425,7 @@ func (x *GetTenantMembershipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantMembershipResponse.ProtoReflect.Descriptor instead.
 func (*GetTenantMembershipResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{39}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetTenantMembershipResponse) GetMembership() *TenantMembership {
@@ -2279,7 +3444,7 @@ type ListUserTenantsRequest struct {
 
 func (x *ListUserTenantsRequest) Reset() {
 	*x = ListUserTenantsRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[40]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +3456,7 @@ func (x *ListUserTenantsRequest) String() string {
 func (*ListUserTenantsRequest) ProtoMessage() {}
 
 func (x *ListUserTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[40]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +3469,7 @@ func (x *ListUserTenantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTenantsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{40}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListUserTenantsRequest) GetUserId() uint64 {
@@ -2323,7 +3488,7 @@ type ListUserTenantsResponse struct {
 
 func (x *ListUserTenantsResponse) Reset() {
 	*x = ListUserTenantsResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[41]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2335,7 +3500,7 @@ func (x *ListUserTenantsResponse) String() string {
 func (*ListUserTenantsResponse) ProtoMessage() {}
 
 func (x *ListUserTenantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[41]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +3513,7 @@ func (x *ListUserTenantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTenantsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserTenantsResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{41}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ListUserTenantsResponse) GetMemberships() []*TenantMembership {
@@ -2368,7 +3533,7 @@ type ListTenantMembersRequest struct {
 
 func (x *ListTenantMembersRequest) Reset() {
 	*x = ListTenantMembersRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[42]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2380,7 +3545,7 @@ func (x *ListTenantMembersRequest) String() string {
 func (*ListTenantMembersRequest) ProtoMessage() {}
 
 func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[42]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,7 +3558,7 @@ func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListTenantMembersRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{42}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ListTenantMembersRequest) GetTenantId() string {
@@ -2420,7 +3585,7 @@ type ListTenantMembersResponse struct {
 
 func (x *ListTenantMembersResponse) Reset() {
 	*x = ListTenantMembersResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[43]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +3597,7 @@ func (x *ListTenantMembersResponse) String() string {
 func (*ListTenantMembersResponse) ProtoMessage() {}
 
 func (x *ListTenantMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[43]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +3610,7 @@ func (x *ListTenantMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListTenantMembersResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{43}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ListTenantMembersResponse) GetMemberships() []*TenantMembership {
@@ -2472,7 +3637,7 @@ type RemoveTenantMemberRequest struct {
 
 func (x *RemoveTenantMemberRequest) Reset() {
 	*x = RemoveTenantMemberRequest{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[44]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2484,7 +3649,7 @@ func (x *RemoveTenantMemberRequest) String() string {
 func (*RemoveTenantMemberRequest) ProtoMessage() {}
 
 func (x *RemoveTenantMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[44]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2497,7 +3662,7 @@ func (x *RemoveTenantMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTenantMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveTenantMemberRequest) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{44}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RemoveTenantMemberRequest) GetTenantId() string {
@@ -2522,7 +3687,7 @@ type RemoveTenantMemberResponse struct {
 
 func (x *RemoveTenantMemberResponse) Reset() {
 	*x = RemoveTenantMemberResponse{}
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[45]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2534,7 +3699,7 @@ func (x *RemoveTenantMemberResponse) String() string {
 func (*RemoveTenantMemberResponse) ProtoMessage() {}
 
 func (x *RemoveTenantMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iam_v1_iam_tenant_proto_msgTypes[45]
+	mi := &file_iam_v1_iam_tenant_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2547,7 +3712,7 @@ func (x *RemoveTenantMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTenantMemberResponse.ProtoReflect.Descriptor instead.
 func (*RemoveTenantMemberResponse) Descriptor() ([]byte, []int) {
-	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{45}
+	return file_iam_v1_iam_tenant_proto_rawDescGZIP(), []int{67}
 }
 
 var File_iam_v1_iam_tenant_proto protoreflect.FileDescriptor
@@ -2670,7 +3835,90 @@ const file_iam_v1_iam_tenant_proto_rawDesc = "" +
 	"collection\"\x8f\x01\n" +
 	"\x1fListOrganizationMembersResponse\x12=\n" +
 	"\vmemberships\x18\x01 \x03(\v2\x1b.iam.OrganizationMembershipR\vmemberships\x12-\n" +
-	"\tpage_info\x18\x02 \x01(\v2\x10.common.PageInfoR\bpageInfo\"W\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x10.common.PageInfoR\bpageInfo\"\xf6\x01\n" +
+	"\tSCIMToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\ftoken_prefix\x18\x04 \x01(\tR\vtokenPrefix\x12+\n" +
+	"\x12created_by_user_id\x18\x05 \x01(\x04R\x0fcreatedByUserId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\"C\n" +
+	"\x16CreateSCIMTokenRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"^\n" +
+	"\x17CreateSCIMTokenResponse\x12$\n" +
+	"\x05token\x18\x01 \x01(\v2\x0e.iam.SCIMTokenR\x05token\x12\x1d\n" +
+	"\n" +
+	"scim_token\x18\x02 \x01(\tR\tscimToken\".\n" +
+	"\x15ListSCIMTokensRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"@\n" +
+	"\x16ListSCIMTokensResponse\x12&\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x0e.iam.SCIMTokenR\x06tokens\"J\n" +
+	"\x16RevokeSCIMTokenRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x19\n" +
+	"\x17RevokeSCIMTokenResponse\"\xeb\x02\n" +
+	"\x0eSAMLConnection\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\"\n" +
+	"\ridp_entity_id\x18\x02 \x01(\tR\vidpEntityId\x12\x17\n" +
+	"\asso_url\x18\x03 \x01(\tR\x06ssoUrl\x12!\n" +
+	"\fmetadata_xml\x18\x04 \x01(\tR\vmetadataXml\x12-\n" +
+	"\x12username_attribute\x18\x05 \x01(\tR\x11usernameAttribute\x12'\n" +
+	"\x0femail_attribute\x18\x06 \x01(\tR\x0eemailAttribute\x12)\n" +
+	"\x10groups_attribute\x18\a \x01(\tR\x0fgroupsAttribute\x12!\n" +
+	"\fsso_required\x18\b \x01(\bR\vssoRequired\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\x86\x02\n" +
+	"$PutOrganizationSAMLConnectionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12!\n" +
+	"\fmetadata_xml\x18\x02 \x01(\tR\vmetadataXml\x12-\n" +
+	"\x12username_attribute\x18\x03 \x01(\tR\x11usernameAttribute\x12'\n" +
+	"\x0femail_attribute\x18\x04 \x01(\tR\x0eemailAttribute\x12)\n" +
+	"\x10groups_attribute\x18\x05 \x01(\tR\x0fgroupsAttribute\x12!\n" +
+	"\fsso_required\x18\x06 \x01(\bR\vssoRequired\"\\\n" +
+	"%PutOrganizationSAMLConnectionResponse\x123\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2\x13.iam.SAMLConnectionR\n" +
+	"connection\"=\n" +
+	"$GetOrganizationSAMLConnectionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\\\n" +
+	"%GetOrganizationSAMLConnectionResponse\x123\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2\x13.iam.SAMLConnectionR\n" +
+	"connection\"@\n" +
+	"'DeleteOrganizationSAMLConnectionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"*\n" +
+	"(DeleteOrganizationSAMLConnectionResponse\"5\n" +
+	"\x1cResolveSAMLConnectionRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"T\n" +
+	"\x1dResolveSAMLConnectionResponse\x123\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2\x13.iam.SAMLConnectionR\n" +
+	"connection\">\n" +
+	"#ListSSORequiredOrganizationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"?\n" +
+	"$ListSSORequiredOrganizationsResponse\x12\x17\n" +
+	"\aorg_ids\x18\x01 \x03(\tR\x06orgIds\"R\n" +
+	" GetOrganizationMembershipRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"`\n" +
+	"!GetOrganizationMembershipResponse\x12;\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x1b.iam.OrganizationMembershipR\n" +
+	"membership\"_\n" +
+	"\x15ApplySAMLLoginRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06groups\x18\x03 \x03(\tR\x06groups\"\x18\n" +
+	"\x16ApplySAMLLoginResponse\"W\n" +
 	"!AttachTenantToOrganizationRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"$\n" +
@@ -2753,86 +4001,114 @@ func file_iam_v1_iam_tenant_proto_rawDescGZIP() []byte {
 	return file_iam_v1_iam_tenant_proto_rawDescData
 }
 
-var file_iam_v1_iam_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_iam_v1_iam_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_iam_v1_iam_tenant_proto_goTypes = []any{
-	(*Tenant)(nil),                               // 0: iam.Tenant
-	(*Organization)(nil),                         // 1: iam.Organization
-	(*OrganizationMembership)(nil),               // 2: iam.OrganizationMembership
-	(*TenantMembership)(nil),                     // 3: iam.TenantMembership
-	(*TenantInvite)(nil),                         // 4: iam.TenantInvite
-	(*DirectoryUser)(nil),                        // 5: iam.DirectoryUser
-	(*ListDirectoryUsersRequest)(nil),            // 6: iam.ListDirectoryUsersRequest
-	(*ListDirectoryUsersResponse)(nil),           // 7: iam.ListDirectoryUsersResponse
-	(*CreateTenantRequest)(nil),                  // 8: iam.CreateTenantRequest
-	(*CreateTenantResponse)(nil),                 // 9: iam.CreateTenantResponse
-	(*CreateOrganizationRequest)(nil),            // 10: iam.CreateOrganizationRequest
-	(*CreateOrganizationResponse)(nil),           // 11: iam.CreateOrganizationResponse
-	(*EnsureRootOrganizationRequest)(nil),        // 12: iam.EnsureRootOrganizationRequest
-	(*EnsureRootOrganizationResponse)(nil),       // 13: iam.EnsureRootOrganizationResponse
-	(*ListOrganizationsRequest)(nil),             // 14: iam.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),            // 15: iam.ListOrganizationsResponse
-	(*AddOrganizationMemberRequest)(nil),         // 16: iam.AddOrganizationMemberRequest
-	(*AddOrganizationMemberResponse)(nil),        // 17: iam.AddOrganizationMemberResponse
-	(*RemoveOrganizationMemberRequest)(nil),      // 18: iam.RemoveOrganizationMemberRequest
-	(*RemoveOrganizationMemberResponse)(nil),     // 19: iam.RemoveOrganizationMemberResponse
-	(*ListOrganizationMembersRequest)(nil),       // 20: iam.ListOrganizationMembersRequest
-	(*ListOrganizationMembersResponse)(nil),      // 21: iam.ListOrganizationMembersResponse
-	(*AttachTenantToOrganizationRequest)(nil),    // 22: iam.AttachTenantToOrganizationRequest
-	(*AttachTenantToOrganizationResponse)(nil),   // 23: iam.AttachTenantToOrganizationResponse
-	(*DetachTenantFromOrganizationRequest)(nil),  // 24: iam.DetachTenantFromOrganizationRequest
-	(*DetachTenantFromOrganizationResponse)(nil), // 25: iam.DetachTenantFromOrganizationResponse
-	(*AddTenantMemberRequest)(nil),               // 26: iam.AddTenantMemberRequest
-	(*AddTenantMemberResponse)(nil),              // 27: iam.AddTenantMemberResponse
-	(*AddTenantMemberByIdentityRequest)(nil),     // 28: iam.AddTenantMemberByIdentityRequest
-	(*AddTenantMemberByIdentityResponse)(nil),    // 29: iam.AddTenantMemberByIdentityResponse
-	(*CreateTenantInviteRequest)(nil),            // 30: iam.CreateTenantInviteRequest
-	(*CreateTenantInviteResponse)(nil),           // 31: iam.CreateTenantInviteResponse
-	(*ListTenantInvitesRequest)(nil),             // 32: iam.ListTenantInvitesRequest
-	(*ListTenantInvitesResponse)(nil),            // 33: iam.ListTenantInvitesResponse
-	(*RevokeTenantInviteRequest)(nil),            // 34: iam.RevokeTenantInviteRequest
-	(*RevokeTenantInviteResponse)(nil),           // 35: iam.RevokeTenantInviteResponse
-	(*AcceptTenantInviteRequest)(nil),            // 36: iam.AcceptTenantInviteRequest
-	(*AcceptTenantInviteResponse)(nil),           // 37: iam.AcceptTenantInviteResponse
-	(*GetTenantMembershipRequest)(nil),           // 38: iam.GetTenantMembershipRequest
-	(*GetTenantMembershipResponse)(nil),          // 39: iam.GetTenantMembershipResponse
-	(*ListUserTenantsRequest)(nil),               // 40: iam.ListUserTenantsRequest
-	(*ListUserTenantsResponse)(nil),              // 41: iam.ListUserTenantsResponse
-	(*ListTenantMembersRequest)(nil),             // 42: iam.ListTenantMembersRequest
-	(*ListTenantMembersResponse)(nil),            // 43: iam.ListTenantMembersResponse
-	(*RemoveTenantMemberRequest)(nil),            // 44: iam.RemoveTenantMemberRequest
-	(*RemoveTenantMemberResponse)(nil),           // 45: iam.RemoveTenantMemberResponse
-	(*v1.CollectionRequest)(nil),                 // 46: common.CollectionRequest
-	(*v1.PageInfo)(nil),                          // 47: common.PageInfo
+	(*Tenant)(nil),                                   // 0: iam.Tenant
+	(*Organization)(nil),                             // 1: iam.Organization
+	(*OrganizationMembership)(nil),                   // 2: iam.OrganizationMembership
+	(*TenantMembership)(nil),                         // 3: iam.TenantMembership
+	(*TenantInvite)(nil),                             // 4: iam.TenantInvite
+	(*DirectoryUser)(nil),                            // 5: iam.DirectoryUser
+	(*ListDirectoryUsersRequest)(nil),                // 6: iam.ListDirectoryUsersRequest
+	(*ListDirectoryUsersResponse)(nil),               // 7: iam.ListDirectoryUsersResponse
+	(*CreateTenantRequest)(nil),                      // 8: iam.CreateTenantRequest
+	(*CreateTenantResponse)(nil),                     // 9: iam.CreateTenantResponse
+	(*CreateOrganizationRequest)(nil),                // 10: iam.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),               // 11: iam.CreateOrganizationResponse
+	(*EnsureRootOrganizationRequest)(nil),            // 12: iam.EnsureRootOrganizationRequest
+	(*EnsureRootOrganizationResponse)(nil),           // 13: iam.EnsureRootOrganizationResponse
+	(*ListOrganizationsRequest)(nil),                 // 14: iam.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),                // 15: iam.ListOrganizationsResponse
+	(*AddOrganizationMemberRequest)(nil),             // 16: iam.AddOrganizationMemberRequest
+	(*AddOrganizationMemberResponse)(nil),            // 17: iam.AddOrganizationMemberResponse
+	(*RemoveOrganizationMemberRequest)(nil),          // 18: iam.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),         // 19: iam.RemoveOrganizationMemberResponse
+	(*ListOrganizationMembersRequest)(nil),           // 20: iam.ListOrganizationMembersRequest
+	(*ListOrganizationMembersResponse)(nil),          // 21: iam.ListOrganizationMembersResponse
+	(*SCIMToken)(nil),                                // 22: iam.SCIMToken
+	(*CreateSCIMTokenRequest)(nil),                   // 23: iam.CreateSCIMTokenRequest
+	(*CreateSCIMTokenResponse)(nil),                  // 24: iam.CreateSCIMTokenResponse
+	(*ListSCIMTokensRequest)(nil),                    // 25: iam.ListSCIMTokensRequest
+	(*ListSCIMTokensResponse)(nil),                   // 26: iam.ListSCIMTokensResponse
+	(*RevokeSCIMTokenRequest)(nil),                   // 27: iam.RevokeSCIMTokenRequest
+	(*RevokeSCIMTokenResponse)(nil),                  // 28: iam.RevokeSCIMTokenResponse
+	(*SAMLConnection)(nil),                           // 29: iam.SAMLConnection
+	(*PutOrganizationSAMLConnectionRequest)(nil),     // 30: iam.PutOrganizationSAMLConnectionRequest
+	(*PutOrganizationSAMLConnectionResponse)(nil),    // 31: iam.PutOrganizationSAMLConnectionResponse
+	(*GetOrganizationSAMLConnectionRequest)(nil),     // 32: iam.GetOrganizationSAMLConnectionRequest
+	(*GetOrganizationSAMLConnectionResponse)(nil),    // 33: iam.GetOrganizationSAMLConnectionResponse
+	(*DeleteOrganizationSAMLConnectionRequest)(nil),  // 34: iam.DeleteOrganizationSAMLConnectionRequest
+	(*DeleteOrganizationSAMLConnectionResponse)(nil), // 35: iam.DeleteOrganizationSAMLConnectionResponse
+	(*ResolveSAMLConnectionRequest)(nil),             // 36: iam.ResolveSAMLConnectionRequest
+	(*ResolveSAMLConnectionResponse)(nil),            // 37: iam.ResolveSAMLConnectionResponse
+	(*ListSSORequiredOrganizationsRequest)(nil),      // 38: iam.ListSSORequiredOrganizationsRequest
+	(*ListSSORequiredOrganizationsResponse)(nil),     // 39: iam.ListSSORequiredOrganizationsResponse
+	(*GetOrganizationMembershipRequest)(nil),         // 40: iam.GetOrganizationMembershipRequest
+	(*GetOrganizationMembershipResponse)(nil),        // 41: iam.GetOrganizationMembershipResponse
+	(*ApplySAMLLoginRequest)(nil),                    // 42: iam.ApplySAMLLoginRequest
+	(*ApplySAMLLoginResponse)(nil),                   // 43: iam.ApplySAMLLoginResponse
+	(*AttachTenantToOrganizationRequest)(nil),        // 44: iam.AttachTenantToOrganizationRequest
+	(*AttachTenantToOrganizationResponse)(nil),       // 45: iam.AttachTenantToOrganizationResponse
+	(*DetachTenantFromOrganizationRequest)(nil),      // 46: iam.DetachTenantFromOrganizationRequest
+	(*DetachTenantFromOrganizationResponse)(nil),     // 47: iam.DetachTenantFromOrganizationResponse
+	(*AddTenantMemberRequest)(nil),                   // 48: iam.AddTenantMemberRequest
+	(*AddTenantMemberResponse)(nil),                  // 49: iam.AddTenantMemberResponse
+	(*AddTenantMemberByIdentityRequest)(nil),         // 50: iam.AddTenantMemberByIdentityRequest
+	(*AddTenantMemberByIdentityResponse)(nil),        // 51: iam.AddTenantMemberByIdentityResponse
+	(*CreateTenantInviteRequest)(nil),                // 52: iam.CreateTenantInviteRequest
+	(*CreateTenantInviteResponse)(nil),               // 53: iam.CreateTenantInviteResponse
+	(*ListTenantInvitesRequest)(nil),                 // 54: iam.ListTenantInvitesRequest
+	(*ListTenantInvitesResponse)(nil),                // 55: iam.ListTenantInvitesResponse
+	(*RevokeTenantInviteRequest)(nil),                // 56: iam.RevokeTenantInviteRequest
+	(*RevokeTenantInviteResponse)(nil),               // 57: iam.RevokeTenantInviteResponse
+	(*AcceptTenantInviteRequest)(nil),                // 58: iam.AcceptTenantInviteRequest
+	(*AcceptTenantInviteResponse)(nil),               // 59: iam.AcceptTenantInviteResponse
+	(*GetTenantMembershipRequest)(nil),               // 60: iam.GetTenantMembershipRequest
+	(*GetTenantMembershipResponse)(nil),              // 61: iam.GetTenantMembershipResponse
+	(*ListUserTenantsRequest)(nil),                   // 62: iam.ListUserTenantsRequest
+	(*ListUserTenantsResponse)(nil),                  // 63: iam.ListUserTenantsResponse
+	(*ListTenantMembersRequest)(nil),                 // 64: iam.ListTenantMembersRequest
+	(*ListTenantMembersResponse)(nil),                // 65: iam.ListTenantMembersResponse
+	(*RemoveTenantMemberRequest)(nil),                // 66: iam.RemoveTenantMemberRequest
+	(*RemoveTenantMemberResponse)(nil),               // 67: iam.RemoveTenantMemberResponse
+	(*v1.CollectionRequest)(nil),                     // 68: common.CollectionRequest
+	(*v1.PageInfo)(nil),                              // 69: common.PageInfo
 }
 var file_iam_v1_iam_tenant_proto_depIdxs = []int32{
-	46, // 0: iam.ListDirectoryUsersRequest.collection:type_name -> common.CollectionRequest
+	68, // 0: iam.ListDirectoryUsersRequest.collection:type_name -> common.CollectionRequest
 	5,  // 1: iam.ListDirectoryUsersResponse.users:type_name -> iam.DirectoryUser
-	47, // 2: iam.ListDirectoryUsersResponse.page_info:type_name -> common.PageInfo
+	69, // 2: iam.ListDirectoryUsersResponse.page_info:type_name -> common.PageInfo
 	0,  // 3: iam.CreateTenantResponse.tenant:type_name -> iam.Tenant
 	3,  // 4: iam.CreateTenantResponse.owner_membership:type_name -> iam.TenantMembership
 	1,  // 5: iam.CreateOrganizationResponse.organization:type_name -> iam.Organization
 	1,  // 6: iam.EnsureRootOrganizationResponse.organization:type_name -> iam.Organization
-	46, // 7: iam.ListOrganizationsRequest.collection:type_name -> common.CollectionRequest
+	68, // 7: iam.ListOrganizationsRequest.collection:type_name -> common.CollectionRequest
 	1,  // 8: iam.ListOrganizationsResponse.organizations:type_name -> iam.Organization
-	47, // 9: iam.ListOrganizationsResponse.page_info:type_name -> common.PageInfo
-	46, // 10: iam.ListOrganizationMembersRequest.collection:type_name -> common.CollectionRequest
+	69, // 9: iam.ListOrganizationsResponse.page_info:type_name -> common.PageInfo
+	68, // 10: iam.ListOrganizationMembersRequest.collection:type_name -> common.CollectionRequest
 	2,  // 11: iam.ListOrganizationMembersResponse.memberships:type_name -> iam.OrganizationMembership
-	47, // 12: iam.ListOrganizationMembersResponse.page_info:type_name -> common.PageInfo
-	4,  // 13: iam.CreateTenantInviteResponse.invite:type_name -> iam.TenantInvite
-	46, // 14: iam.ListTenantInvitesRequest.collection:type_name -> common.CollectionRequest
-	4,  // 15: iam.ListTenantInvitesResponse.invites:type_name -> iam.TenantInvite
-	47, // 16: iam.ListTenantInvitesResponse.page_info:type_name -> common.PageInfo
-	3,  // 17: iam.AcceptTenantInviteResponse.membership:type_name -> iam.TenantMembership
-	3,  // 18: iam.GetTenantMembershipResponse.membership:type_name -> iam.TenantMembership
-	3,  // 19: iam.ListUserTenantsResponse.memberships:type_name -> iam.TenantMembership
-	46, // 20: iam.ListTenantMembersRequest.collection:type_name -> common.CollectionRequest
-	3,  // 21: iam.ListTenantMembersResponse.memberships:type_name -> iam.TenantMembership
-	47, // 22: iam.ListTenantMembersResponse.page_info:type_name -> common.PageInfo
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	69, // 12: iam.ListOrganizationMembersResponse.page_info:type_name -> common.PageInfo
+	22, // 13: iam.CreateSCIMTokenResponse.token:type_name -> iam.SCIMToken
+	22, // 14: iam.ListSCIMTokensResponse.tokens:type_name -> iam.SCIMToken
+	29, // 15: iam.PutOrganizationSAMLConnectionResponse.connection:type_name -> iam.SAMLConnection
+	29, // 16: iam.GetOrganizationSAMLConnectionResponse.connection:type_name -> iam.SAMLConnection
+	29, // 17: iam.ResolveSAMLConnectionResponse.connection:type_name -> iam.SAMLConnection
+	2,  // 18: iam.GetOrganizationMembershipResponse.membership:type_name -> iam.OrganizationMembership
+	4,  // 19: iam.CreateTenantInviteResponse.invite:type_name -> iam.TenantInvite
+	68, // 20: iam.ListTenantInvitesRequest.collection:type_name -> common.CollectionRequest
+	4,  // 21: iam.ListTenantInvitesResponse.invites:type_name -> iam.TenantInvite
+	69, // 22: iam.ListTenantInvitesResponse.page_info:type_name -> common.PageInfo
+	3,  // 23: iam.AcceptTenantInviteResponse.membership:type_name -> iam.TenantMembership
+	3,  // 24: iam.GetTenantMembershipResponse.membership:type_name -> iam.TenantMembership
+	3,  // 25: iam.ListUserTenantsResponse.memberships:type_name -> iam.TenantMembership
+	68, // 26: iam.ListTenantMembersRequest.collection:type_name -> common.CollectionRequest
+	3,  // 27: iam.ListTenantMembersResponse.memberships:type_name -> iam.TenantMembership
+	69, // 28: iam.ListTenantMembersResponse.page_info:type_name -> common.PageInfo
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_iam_v1_iam_tenant_proto_init() }
@@ -2846,7 +4122,7 @@ func file_iam_v1_iam_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iam_v1_iam_tenant_proto_rawDesc), len(file_iam_v1_iam_tenant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -175,6 +175,7 @@ type Order struct {
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	Metadata        map[string]string      `protobuf:"bytes,19,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StoreId         string                 `protobuf:"bytes,20,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // Backoffice store the order is routed through
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -342,6 +343,13 @@ func (x *Order) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *Order) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
 // OrderItem represents a line item in an order
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1431,6 +1439,7 @@ type CreateOrderRequest struct {
 	PaymentMethodId  string                 `protobuf:"bytes,5,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
 	PaymentMetadata  map[string]string      `protobuf:"bytes,6,rep,name=payment_metadata,json=paymentMetadata,proto3" json:"payment_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Notes            string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	StoreId          string                 `protobuf:"bytes,8,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // Backoffice store the storefront resolved for this checkout
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1514,6 +1523,13 @@ func (x *CreateOrderRequest) GetNotes() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
 // GetOrderRequest is used to retrieve an order by ID
 type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2293,7 +2309,7 @@ var File_order_v1_order_proto protoreflect.FileDescriptor
 
 const file_order_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x14order/v1/order.proto\x12\x05order\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\a\n" +
+	"\x14order/v1/order.proto\x12\x05order\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\a\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
@@ -2316,7 +2332,8 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
 	"\fcompleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x126\n" +
-	"\bmetadata\x18\x13 \x03(\v2\x1a.order.Order.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x13 \x03(\v2\x1a.order.Order.MetadataEntryR\bmetadata\x12\x19\n" +
+	"\bstore_id\x18\x14 \x01(\tR\astoreId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x03\n" +
@@ -2440,7 +2457,7 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xbf\x03\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xda\x03\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12>\n" +
 	"\x0fbilling_address\x18\x02 \x01(\v2\x15.order.BillingAddressR\x0ebillingAddress\x12A\n" +
@@ -2448,7 +2465,8 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x12shipping_method_id\x18\x04 \x01(\tR\x10shippingMethodId\x12*\n" +
 	"\x11payment_method_id\x18\x05 \x01(\tR\x0fpaymentMethodId\x12Y\n" +
 	"\x10payment_metadata\x18\x06 \x03(\v2..order.CreateOrderRequest.PaymentMetadataEntryR\x0fpaymentMetadata\x12\x14\n" +
-	"\x05notes\x18\a \x01(\tR\x05notes\x1aB\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12\x19\n" +
+	"\bstore_id\x18\b \x01(\tR\astoreId\x1aB\n" +
 	"\x14PaymentMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"!\n" +
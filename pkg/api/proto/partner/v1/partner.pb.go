@@ -736,6 +736,186 @@ func (x *UpdatePartnerStatusRequest) GetStatus() string {
 	return ""
 }
 
+type ShippingRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartnerId     string                 `protobuf:"bytes,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	PartnerCode   string                 `protobuf:"bytes,2,opt,name=partner_code,json=partnerCode,proto3" json:"partner_code,omitempty"`
+	PartnerName   string                 `protobuf:"bytes,3,opt,name=partner_name,json=partnerName,proto3" json:"partner_name,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Cost          string                 `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	SlaDays       int32                  `protobuf:"varint,6,opt,name=sla_days,json=slaDays,proto3" json:"sla_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingRate) Reset() {
+	*x = ShippingRate{}
+	mi := &file_partner_v1_partner_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingRate) ProtoMessage() {}
+
+func (x *ShippingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_partner_v1_partner_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingRate.ProtoReflect.Descriptor instead.
+func (*ShippingRate) Descriptor() ([]byte, []int) {
+	return file_partner_v1_partner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShippingRate) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetPartnerCode() string {
+	if x != nil {
+		return x.PartnerCode
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetPartnerName() string {
+	if x != nil {
+		return x.PartnerName
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetCost() string {
+	if x != nil {
+		return x.Cost
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetSlaDays() int32 {
+	if x != nil {
+		return x.SlaDays
+	}
+	return 0
+}
+
+type ListShippingRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingRatesRequest) Reset() {
+	*x = ListShippingRatesRequest{}
+	mi := &file_partner_v1_partner_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingRatesRequest) ProtoMessage() {}
+
+func (x *ListShippingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_partner_v1_partner_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListShippingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_partner_v1_partner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListShippingRatesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListShippingRatesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ListShippingRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ShippingRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingRatesResponse) Reset() {
+	*x = ListShippingRatesResponse{}
+	mi := &file_partner_v1_partner_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingRatesResponse) ProtoMessage() {}
+
+func (x *ListShippingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_partner_v1_partner_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListShippingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_partner_v1_partner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListShippingRatesResponse) GetRates() []*ShippingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
 var File_partner_v1_partner_proto protoreflect.FileDescriptor
 
 const file_partner_v1_partner_proto_rawDesc = "" +
@@ -808,14 +988,28 @@ const file_partner_v1_partner_proto_rawDesc = "" +
 	"\x13shipping_cost_rules\x18\f \x03(\v2\x19.partner.ShippingCostRuleR\x11shippingCostRules\"D\n" +
 	"\x1aUpdatePartnerStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status2\xa0\x04\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xba\x01\n" +
+	"\fShippingRate\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x01 \x01(\tR\tpartnerId\x12!\n" +
+	"\fpartner_code\x18\x02 \x01(\tR\vpartnerCode\x12!\n" +
+	"\fpartner_name\x18\x03 \x01(\tR\vpartnerName\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\tR\x04cost\x12\x19\n" +
+	"\bsla_days\x18\x06 \x01(\x05R\aslaDays\"O\n" +
+	"\x18ListShippingRatesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"H\n" +
+	"\x19ListShippingRatesResponse\x12+\n" +
+	"\x05rates\x18\x01 \x03(\v2\x15.partner.ShippingRateR\x05rates2\xfc\x04\n" +
 	"\x0ePartnerService\x12a\n" +
 	"\rCreatePartner\x12\x1d.partner.CreatePartnerRequest\x1a\x10.partner.Partner\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/partner/v1/partners\x12]\n" +
 	"\n" +
 	"GetPartner\x12\x1a.partner.GetPartnerRequest\x1a\x10.partner.Partner\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/partner/v1/partners/{id}\x12i\n" +
 	"\fListPartners\x12\x1c.partner.ListPartnersRequest\x1a\x1d.partner.ListPartnersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/partner/v1/partners\x12f\n" +
 	"\rUpdatePartner\x12\x1d.partner.UpdatePartnerRequest\x1a\x10.partner.Partner\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/partner/v1/partners/{id}\x12y\n" +
-	"\x13UpdatePartnerStatus\x12#.partner.UpdatePartnerStatusRequest\x1a\x10.partner.Partner\"+\x82\xd3\xe4\x93\x02%:\x01*2 /partner/v1/partners/{id}/statusBBZ@github.com/tuannm99/podzone/pkg/api/proto/partner/v1;pbpartnerv1b\x06proto3"
+	"\x13UpdatePartnerStatus\x12#.partner.UpdatePartnerStatusRequest\x1a\x10.partner.Partner\"+\x82\xd3\xe4\x93\x02%:\x01*2 /partner/v1/partners/{id}/status\x12Z\n" +
+	"\x11ListShippingRates\x12!.partner.ListShippingRatesRequest\x1a\".partner.ListShippingRatesResponseBBZ@github.com/tuannm99/podzone/pkg/api/proto/partner/v1;pbpartnerv1b\x06proto3"
 
 var (
 	file_partner_v1_partner_proto_rawDescOnce sync.Once
@@ -829,7 +1023,7 @@ func file_partner_v1_partner_proto_rawDescGZIP() []byte {
 	return file_partner_v1_partner_proto_rawDescData
 }
 
-var file_partner_v1_partner_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_partner_v1_partner_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_partner_v1_partner_proto_goTypes = []any{
 	(*ShippingCostRule)(nil),           // 0: partner.ShippingCostRule
 	(*Partner)(nil),                    // 1: partner.Partner
@@ -839,34 +1033,40 @@ var file_partner_v1_partner_proto_goTypes = []any{
 	(*ListPartnersResponse)(nil),       // 5: partner.ListPartnersResponse
 	(*UpdatePartnerRequest)(nil),       // 6: partner.UpdatePartnerRequest
 	(*UpdatePartnerStatusRequest)(nil), // 7: partner.UpdatePartnerStatusRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(*v1.CollectionRequest)(nil),       // 9: common.CollectionRequest
-	(*v1.PageInfo)(nil),                // 10: common.PageInfo
+	(*ShippingRate)(nil),               // 8: partner.ShippingRate
+	(*ListShippingRatesRequest)(nil),   // 9: partner.ListShippingRatesRequest
+	(*ListShippingRatesResponse)(nil),  // 10: partner.ListShippingRatesResponse
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
+	(*v1.CollectionRequest)(nil),       // 12: common.CollectionRequest
+	(*v1.PageInfo)(nil),                // 13: common.PageInfo
 }
 var file_partner_v1_partner_proto_depIdxs = []int32{
-	8,  // 0: partner.Partner.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: partner.Partner.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 0: partner.Partner.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: partner.Partner.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: partner.Partner.shipping_cost_rules:type_name -> partner.ShippingCostRule
 	0,  // 3: partner.CreatePartnerRequest.shipping_cost_rules:type_name -> partner.ShippingCostRule
-	9,  // 4: partner.ListPartnersRequest.collection:type_name -> common.CollectionRequest
+	12, // 4: partner.ListPartnersRequest.collection:type_name -> common.CollectionRequest
 	1,  // 5: partner.ListPartnersResponse.partners:type_name -> partner.Partner
-	10, // 6: partner.ListPartnersResponse.page_info:type_name -> common.PageInfo
+	13, // 6: partner.ListPartnersResponse.page_info:type_name -> common.PageInfo
 	0,  // 7: partner.UpdatePartnerRequest.shipping_cost_rules:type_name -> partner.ShippingCostRule
-	2,  // 8: partner.PartnerService.CreatePartner:input_type -> partner.CreatePartnerRequest
-	3,  // 9: partner.PartnerService.GetPartner:input_type -> partner.GetPartnerRequest
-	4,  // 10: partner.PartnerService.ListPartners:input_type -> partner.ListPartnersRequest
-	6,  // 11: partner.PartnerService.UpdatePartner:input_type -> partner.UpdatePartnerRequest
-	7,  // 12: partner.PartnerService.UpdatePartnerStatus:input_type -> partner.UpdatePartnerStatusRequest
-	1,  // 13: partner.PartnerService.CreatePartner:output_type -> partner.Partner
-	1,  // 14: partner.PartnerService.GetPartner:output_type -> partner.Partner
-	5,  // 15: partner.PartnerService.ListPartners:output_type -> partner.ListPartnersResponse
-	1,  // 16: partner.PartnerService.UpdatePartner:output_type -> partner.Partner
-	1,  // 17: partner.PartnerService.UpdatePartnerStatus:output_type -> partner.Partner
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	8,  // 8: partner.ListShippingRatesResponse.rates:type_name -> partner.ShippingRate
+	2,  // 9: partner.PartnerService.CreatePartner:input_type -> partner.CreatePartnerRequest
+	3,  // 10: partner.PartnerService.GetPartner:input_type -> partner.GetPartnerRequest
+	4,  // 11: partner.PartnerService.ListPartners:input_type -> partner.ListPartnersRequest
+	6,  // 12: partner.PartnerService.UpdatePartner:input_type -> partner.UpdatePartnerRequest
+	7,  // 13: partner.PartnerService.UpdatePartnerStatus:input_type -> partner.UpdatePartnerStatusRequest
+	9,  // 14: partner.PartnerService.ListShippingRates:input_type -> partner.ListShippingRatesRequest
+	1,  // 15: partner.PartnerService.CreatePartner:output_type -> partner.Partner
+	1,  // 16: partner.PartnerService.GetPartner:output_type -> partner.Partner
+	5,  // 17: partner.PartnerService.ListPartners:output_type -> partner.ListPartnersResponse
+	1,  // 18: partner.PartnerService.UpdatePartner:output_type -> partner.Partner
+	1,  // 19: partner.PartnerService.UpdatePartnerStatus:output_type -> partner.Partner
+	10, // 20: partner.PartnerService.ListShippingRates:output_type -> partner.ListShippingRatesResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_partner_v1_partner_proto_init() }
@@ -880,7 +1080,7 @@ func file_partner_v1_partner_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_partner_v1_partner_proto_rawDesc), len(file_partner_v1_partner_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	PartnerService_ListPartners_FullMethodName        = "/partner.PartnerService/ListPartners"
 	PartnerService_UpdatePartner_FullMethodName       = "/partner.PartnerService/UpdatePartner"
 	PartnerService_UpdatePartnerStatus_FullMethodName = "/partner.PartnerService/UpdatePartnerStatus"
+	PartnerService_ListShippingRates_FullMethodName   = "/partner.PartnerService/ListShippingRates"
 )
 
 // PartnerServiceClient is the client API for PartnerService service.
@@ -35,6 +36,9 @@ type PartnerServiceClient interface {
 	ListPartners(ctx context.Context, in *ListPartnersRequest, opts ...grpc.CallOption) (*ListPartnersResponse, error)
 	UpdatePartner(ctx context.Context, in *UpdatePartnerRequest, opts ...grpc.CallOption) (*Partner, error)
 	UpdatePartnerStatus(ctx context.Context, in *UpdatePartnerStatusRequest, opts ...grpc.CallOption) (*Partner, error)
+	// ListShippingRates quotes the active partners' shipping cost rules for a region.
+	// It is a service-to-service call for storefront checkout and is not exposed through the gateway.
+	ListShippingRates(ctx context.Context, in *ListShippingRatesRequest, opts ...grpc.CallOption) (*ListShippingRatesResponse, error)
 }
 
 type partnerServiceClient struct {
@@ -95,6 +99,16 @@ func (c *partnerServiceClient) UpdatePartnerStatus(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *partnerServiceClient) ListShippingRates(ctx context.Context, in *ListShippingRatesRequest, opts ...grpc.CallOption) (*ListShippingRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShippingRatesResponse)
+	err := c.cc.Invoke(ctx, PartnerService_ListShippingRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PartnerServiceServer is the server API for PartnerService service.
 // All implementations must embed UnimplementedPartnerServiceServer
 // for forward compatibility.
@@ -104,6 +118,9 @@ type PartnerServiceServer interface {
 	ListPartners(context.Context, *ListPartnersRequest) (*ListPartnersResponse, error)
 	UpdatePartner(context.Context, *UpdatePartnerRequest) (*Partner, error)
 	UpdatePartnerStatus(context.Context, *UpdatePartnerStatusRequest) (*Partner, error)
+	// ListShippingRates quotes the active partners' shipping cost rules for a region.
+	// It is a service-to-service call for storefront checkout and is not exposed through the gateway.
+	ListShippingRates(context.Context, *ListShippingRatesRequest) (*ListShippingRatesResponse, error)
 	mustEmbedUnimplementedPartnerServiceServer()
 }
 
@@ -129,6 +146,9 @@ func (UnimplementedPartnerServiceServer) UpdatePartner(context.Context, *UpdateP
 func (UnimplementedPartnerServiceServer) UpdatePartnerStatus(context.Context, *UpdatePartnerStatusRequest) (*Partner, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePartnerStatus not implemented")
 }
+func (UnimplementedPartnerServiceServer) ListShippingRates(context.Context, *ListShippingRatesRequest) (*ListShippingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShippingRates not implemented")
+}
 func (UnimplementedPartnerServiceServer) mustEmbedUnimplementedPartnerServiceServer() {}
 func (UnimplementedPartnerServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +260,24 @@ func _PartnerService_UpdatePartnerStatus_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartnerService_ListShippingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShippingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).ListShippingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_ListShippingRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).ListShippingRates(ctx, req.(*ListShippingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PartnerService_ServiceDesc is the grpc.ServiceDesc for PartnerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +305,10 @@ var PartnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePartnerStatus",
 			Handler:    _PartnerService_UpdatePartnerStatus_Handler,
 		},
+		{
+			MethodName: "ListShippingRates",
+			Handler:    _PartnerService_ListShippingRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "partner/v1/partner.proto",
@@ -14,10 +14,17 @@ type ObservabilityConfig struct {
 	LogDrops       bool `koanf:"log_drops"        mapstructure:"log_drops"`
 }
 
+// IdempotencyConfig configures the inbox. LeaseDuration bounds how long a crashed handler's
+// claim blocks redelivery; Retention is how long completed rows are kept for deduplication and
+// PruneInterval how often the janitor deletes older ones.
 type IdempotencyConfig struct {
-	Enabled      bool   `koanf:"enabled"       mapstructure:"enabled"`
-	ConsumerName string `koanf:"consumer_name" mapstructure:"consumer_name"`
-	TableName    string `koanf:"table_name"    mapstructure:"table_name"`
+	Enabled           bool          `koanf:"enabled"            mapstructure:"enabled"`
+	ConsumerName      string        `koanf:"consumer_name"      mapstructure:"consumer_name"`
+	TableName         string        `koanf:"table_name"         mapstructure:"table_name"`
+	LeaseDuration     time.Duration `koanf:"lease_duration"     mapstructure:"lease_duration"`
+	HeartbeatInterval time.Duration `koanf:"heartbeat_interval" mapstructure:"heartbeat_interval"`
+	Retention         time.Duration `koanf:"retention"          mapstructure:"retention"`
+	PruneInterval     time.Duration `koanf:"prune_interval"     mapstructure:"prune_interval"`
 }
 
 type ConsumerRuntimeConfig struct {
@@ -28,6 +35,23 @@ type ConsumerRuntimeConfig struct {
 	Idempotency   IdempotencyConfig   `koanf:"idempotency"   mapstructure:"idempotency"`
 }
 
+// OutboxRelayConfig configures an outbox relay. Records failing MaxAttempts times are marked
+// dead. With Notify set, the relay also LISTENs for the outbox insert trigger's notifications,
+// leaving PollInterval as the fallback for missed notifications and retry backoff.
+// ListenURI overrides the service's sql uri for that LISTEN connection; set it to a direct
+// Postgres address when the sql uri goes through a transaction-pooling PgBouncer.
+type OutboxRelayConfig struct {
+	BatchSize     int           `koanf:"batch_size"     mapstructure:"batch_size"`
+	Concurrency   int           `koanf:"concurrency"    mapstructure:"concurrency"`
+	LeaseDuration time.Duration `koanf:"lease_duration" mapstructure:"lease_duration"`
+	PollInterval  time.Duration `koanf:"poll_interval"  mapstructure:"poll_interval"`
+	MaxAttempts   int           `koanf:"max_attempts"   mapstructure:"max_attempts"`
+	BaseDelay     time.Duration `koanf:"base_delay"     mapstructure:"base_delay"`
+	MaxDelay      time.Duration `koanf:"max_delay"      mapstructure:"max_delay"`
+	Notify        bool          `koanf:"notify"         mapstructure:"notify"`
+	ListenURI     string        `koanf:"listen_uri"     mapstructure:"listen_uri"`
+}
+
 type TopicBootstrapConfig struct {
 	Enabled            bool     `koanf:"enabled"             mapstructure:"enabled"`
 	MainTopics         []string `koanf:"main_topics"         mapstructure:"main_topics"`
@@ -48,9 +72,13 @@ func DefaultConsumerRuntimeConfig(consumerName string) ConsumerRuntimeConfig {
 			LogDeadLetters: true,
 		},
 		Idempotency: IdempotencyConfig{
-			Enabled:      false,
-			ConsumerName: consumerName,
-			TableName:    "message_inbox",
+			Enabled:           false,
+			ConsumerName:      consumerName,
+			TableName:         "message_inbox",
+			LeaseDuration:     DefaultInboxLeaseDuration,
+			HeartbeatInterval: DefaultInboxLeaseDuration / 3,
+			Retention:         DefaultInboxRetention,
+			PruneInterval:     time.Hour,
 		},
 	}
 }
@@ -72,9 +100,66 @@ func LoadConsumerRuntimeConfig(k *koanf.Koanf, path string, defaults ConsumerRun
 	if cfg.Idempotency.TableName == "" {
 		cfg.Idempotency.TableName = defaults.Idempotency.TableName
 	}
+	if cfg.Idempotency.LeaseDuration <= 0 {
+		cfg.Idempotency.LeaseDuration = defaults.Idempotency.LeaseDuration
+	}
+	if cfg.Idempotency.HeartbeatInterval <= 0 {
+		cfg.Idempotency.HeartbeatInterval = defaults.Idempotency.HeartbeatInterval
+	}
+	if cfg.Idempotency.Retention <= 0 {
+		cfg.Idempotency.Retention = defaults.Idempotency.Retention
+	}
+	if cfg.Idempotency.PruneInterval <= 0 {
+		cfg.Idempotency.PruneInterval = defaults.Idempotency.PruneInterval
+	}
 	return cfg
 }
 
+func DefaultOutboxRelayConfig() OutboxRelayConfig {
+	return OutboxRelayConfig{
+		BatchSize:     100,
+		Concurrency:   8,
+		LeaseDuration: time.Minute,
+		PollInterval:  5 * time.Second,
+		MaxAttempts:   10,
+		BaseDelay:     time.Second,
+		MaxDelay:      5 * time.Minute,
+	}
+}
+
+func LoadOutboxRelayConfig(k *koanf.Koanf, path string, defaults OutboxRelayConfig) OutboxRelayConfig {
+	cfg := defaults
+	if k != nil && path != "" {
+		_ = k.Unmarshal(path, &cfg)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaults.Concurrency
+	}
+	if cfg.LeaseDuration <= 0 {
+		cfg.LeaseDuration = defaults.LeaseDuration
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaults.BaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaults.MaxDelay
+	}
+	return cfg
+}
+
+func (c OutboxRelayConfig) RetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: c.MaxAttempts, BaseDelay: c.BaseDelay, MaxDelay: c.MaxDelay}
+}
+
 func (c TopicBootstrapConfig) Normalize() TopicBootstrapConfig {
 	cfg := c
 	if cfg.DefaultPartitions <= 0 {
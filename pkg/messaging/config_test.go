@@ -16,6 +16,9 @@ func TestLoadConsumerRuntimeConfig_Defaults(t *testing.T) {
 	assert.Equal(t, time.Second, cfg.BaseDelay)
 	assert.Equal(t, "auth.iam-projection", cfg.Idempotency.ConsumerName)
 	assert.Equal(t, "message_inbox", cfg.Idempotency.TableName)
+	assert.Equal(t, DefaultInboxLeaseDuration, cfg.Idempotency.LeaseDuration)
+	assert.Equal(t, DefaultInboxRetention, cfg.Idempotency.Retention)
+	assert.Equal(t, time.Hour, cfg.Idempotency.PruneInterval)
 }
 
 func TestLoadConsumerRuntimeConfig_FromKoanf(t *testing.T) {
@@ -25,6 +28,7 @@ func TestLoadConsumerRuntimeConfig_FromKoanf(t *testing.T) {
 	k.Set("messaging.auth.consumers.iam_projection.observability.enabled", true)
 	k.Set("messaging.auth.consumers.iam_projection.idempotency.enabled", true)
 	k.Set("messaging.auth.consumers.iam_projection.idempotency.table_name", "auth_inbox")
+	k.Set("messaging.auth.consumers.iam_projection.idempotency.lease_duration", "30s")
 
 	cfg := LoadConsumerRuntimeConfig(
 		k,
@@ -37,4 +41,23 @@ func TestLoadConsumerRuntimeConfig_FromKoanf(t *testing.T) {
 	assert.True(t, cfg.Observability.Enabled)
 	assert.True(t, cfg.Idempotency.Enabled)
 	assert.Equal(t, "auth_inbox", cfg.Idempotency.TableName)
+	assert.Equal(t, 30*time.Second, cfg.Idempotency.LeaseDuration)
+	assert.Equal(t, DefaultInboxRetention, cfg.Idempotency.Retention)
+}
+
+func TestLoadOutboxRelayConfig_FromKoanf(t *testing.T) {
+	k := koanf.New(".")
+	k.Set("messaging.auth.outbox.concurrency", 4)
+	k.Set("messaging.auth.outbox.max_attempts", 3)
+	k.Set("messaging.auth.outbox.max_delay", "1m")
+	k.Set("messaging.auth.outbox.batch_size", 0)
+	k.Set("messaging.auth.outbox.notify", true)
+
+	cfg := LoadOutboxRelayConfig(k, "messaging.auth.outbox", DefaultOutboxRelayConfig())
+
+	assert.Equal(t, 100, cfg.BatchSize)
+	assert.Equal(t, 4, cfg.Concurrency)
+	assert.Equal(t, 5*time.Second, cfg.PollInterval)
+	assert.True(t, cfg.Notify)
+	assert.Equal(t, RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}, cfg.RetryPolicy())
 }
@@ -0,0 +1,301 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type DeadLetterStatus string
+
+const (
+	DeadLetterStatusPending  DeadLetterStatus = "pending"
+	DeadLetterStatusRedriven DeadLetterStatus = "redriven"
+	DeadLetterStatusDropped  DeadLetterStatus = "dropped"
+)
+
+type DeadLetterAction string
+
+const (
+	DeadLetterActionRedrive DeadLetterAction = "redrive"
+	DeadLetterActionDrop    DeadLetterAction = "drop"
+)
+
+var (
+	ErrDeadLetterNotFound = errors.New("messaging: dead letter not found")
+	// ErrDeadLetterResolved means the dead letter was already redriven or dropped.
+	ErrDeadLetterResolved = errors.New("messaging: dead letter already resolved")
+	// ErrDeadLetterNoTarget means the dead letter has no original topic to redrive to.
+	ErrDeadLetterNoTarget = errors.New("messaging: dead letter has no original topic")
+	ErrDeadLetterActor    = errors.New("messaging: dead letter action requires an actor")
+	// ErrDeadLetterUnlocated means a dead letter reached the browser without a ConsumedRecord
+	// in its context, so it cannot be stored under its topic, partition and offset.
+	ErrDeadLetterUnlocated = errors.New("messaging: dead letter has no consumed record")
+)
+
+// DeadLetter is one message read from a dead-letter topic. Type, TenantID, ConsumerName,
+// Reason and LastError are copied out of Envelope and its delivery metadata for filtering.
+type DeadLetter struct {
+	ID            string
+	Topic         string
+	Partition     int32
+	Offset        int64
+	Key           string
+	OriginalTopic string
+	Type          string
+	TenantID      string
+	ConsumerName  string
+	Reason        string
+	LastError     string
+	Attempt       int
+	RedriveCount  int
+	Envelope      Envelope
+	Status        DeadLetterStatus
+	ReceivedAt    time.Time
+	ResolvedAt    *time.Time
+	ResolvedBy    string
+}
+
+// DeadLetterFilter matches every set field. Error matches Reason or LastError case-insensitively
+// as a substring.
+type DeadLetterFilter struct {
+	Topic        string
+	Type         string
+	TenantID     string
+	ConsumerName string
+	Error        string
+	Statuses     []DeadLetterStatus
+	Limit        int
+	Offset       int
+}
+
+// DeadLetterAudit records who redrove or dropped a dead letter.
+type DeadLetterAudit struct {
+	ID           int64
+	DeadLetterID string
+	Action       DeadLetterAction
+	Actor        string
+	Note         string
+	CreatedAt    time.Time
+}
+
+// DeadLetterResult reports the outcome of a bulk action for one dead letter.
+type DeadLetterResult struct {
+	ID  string
+	Err error
+}
+
+// DeadLetterID identifies the dead letter stored at a topic, partition and offset.
+func DeadLetterID(topic string, partition int32, offset int64) string {
+	return fmt.Sprintf("%s:%d:%d", topic, partition, offset)
+}
+
+type DeadLetterBrowserOptions struct {
+	Now func() time.Time
+}
+
+// DeadLetterBrowser collects messages from dead-letter topics into a DeadLetterStore and lets
+// operators redrive them to their original topic through a Redriver or drop them. Both
+// actions are audited.
+type DeadLetterBrowser struct {
+	store    DeadLetterStore
+	redriver *Redriver
+	now      func() time.Time
+}
+
+var _ Handler = (*DeadLetterBrowser)(nil)
+
+func NewDeadLetterBrowser(store DeadLetterStore, redriver *Redriver, opts DeadLetterBrowserOptions) *DeadLetterBrowser {
+	if opts.Now == nil {
+		opts.Now = func() time.Time { return time.Now().UTC() }
+	}
+	return &DeadLetterBrowser{store: store, redriver: redriver, now: opts.Now}
+}
+
+// Handle records a message consumed from a dead-letter topic. Consuming the same record
+// again, for example after a consumer group reset, keeps the stored status.
+func (b *DeadLetterBrowser) Handle(ctx context.Context, msg Envelope) error {
+	record, ok := ConsumedRecordFromContext(ctx)
+	if !ok {
+		return ErrDeadLetterUnlocated
+	}
+	metadata := ReadDeliveryMetadata(msg)
+	return b.store.Record(ctx, DeadLetter{
+		ID:            DeadLetterID(record.Topic, record.Partition, record.Offset),
+		Topic:         record.Topic,
+		Partition:     record.Partition,
+		Offset:        record.Offset,
+		Key:           record.Key,
+		OriginalTopic: metadata.OriginalTopic,
+		Type:          msg.Type,
+		TenantID:      msg.TenantID,
+		ConsumerName:  metadata.ConsumerName,
+		Reason:        metadata.DeadLetterReason,
+		LastError:     metadata.LastError,
+		Attempt:       metadata.Attempt,
+		RedriveCount:  metadata.RedriveCount,
+		Envelope:      msg,
+		Status:        DeadLetterStatusPending,
+		ReceivedAt:    b.now(),
+	})
+}
+
+func (b *DeadLetterBrowser) ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error) {
+	return b.store.List(ctx, filter)
+}
+
+func (b *DeadLetterBrowser) GetDeadLetter(ctx context.Context, id string) (DeadLetter, error) {
+	return b.store.Get(ctx, id)
+}
+
+// ListDeadLetterAudit returns the newest audit records first, for one dead letter or, with an
+// empty id, for all of them.
+func (b *DeadLetterBrowser) ListDeadLetterAudit(
+	ctx context.Context,
+	deadLetterID string,
+	limit int,
+) ([]DeadLetterAudit, error) {
+	return b.store.ListAudit(ctx, deadLetterID, limit)
+}
+
+// RedriveDeadLetters republishes the pending dead letters among ids to their original topics
+// in one batch and marks them redriven. Delivery metadata is reset so each message gets a
+// fresh retry budget; the Redriver bumps its redrive count. If two operators redrive the same
+// message at once it can be published twice, which consumers absorb through their inbox.
+func (b *DeadLetterBrowser) RedriveDeadLetters(
+	ctx context.Context,
+	ids []string,
+	actor string,
+	note string,
+) ([]DeadLetterResult, error) {
+	if strings.TrimSpace(actor) == "" {
+		return nil, ErrDeadLetterActor
+	}
+	ids = uniqueIDs(ids)
+	results := make(map[string]error, len(ids))
+	var (
+		ready    []string
+		requests []RedriveRequest
+	)
+	for _, id := range ids {
+		deadLetter, err := b.pending(ctx, id)
+		if err == nil && deadLetter.OriginalTopic == "" {
+			err = ErrDeadLetterNoTarget
+		}
+		if err != nil {
+			results[id] = err
+			continue
+		}
+		metadata := ReadDeliveryMetadata(deadLetter.Envelope)
+		metadata.Attempt = 0
+		metadata.MaxAttempts = 0
+		metadata.LastError = ""
+		metadata.DeadLetterReason = ""
+		metadata.NextAttemptAt = time.Time{}
+		ready = append(ready, id)
+		requests = append(requests, RedriveRequest{
+			SourceTopic: deadLetter.OriginalTopic,
+			TargetTopic: deadLetter.OriginalTopic,
+			Key:         deadLetter.Key,
+			Envelope:    WithDeliveryMetadata(deadLetter.Envelope, metadata),
+		})
+	}
+	if len(requests) > 0 {
+		if err := b.redriver.RedriveBatch(ctx, requests); err != nil {
+			return nil, fmt.Errorf("redrive dead letters: %w", err)
+		}
+	}
+	if err := b.resolve(ctx, ready, DeadLetterStatusRedriven, DeadLetterActionRedrive, actor, note, results); err != nil {
+		return nil, err
+	}
+	return collectResults(ids, results), nil
+}
+
+// DropDeadLetters marks the pending dead letters among ids dropped without publishing them.
+func (b *DeadLetterBrowser) DropDeadLetters(
+	ctx context.Context,
+	ids []string,
+	actor string,
+	note string,
+) ([]DeadLetterResult, error) {
+	if strings.TrimSpace(actor) == "" {
+		return nil, ErrDeadLetterActor
+	}
+	ids = uniqueIDs(ids)
+	results := make(map[string]error, len(ids))
+	var ready []string
+	for _, id := range ids {
+		if _, err := b.pending(ctx, id); err != nil {
+			results[id] = err
+			continue
+		}
+		ready = append(ready, id)
+	}
+	if err := b.resolve(ctx, ready, DeadLetterStatusDropped, DeadLetterActionDrop, actor, note, results); err != nil {
+		return nil, err
+	}
+	return collectResults(ids, results), nil
+}
+
+func (b *DeadLetterBrowser) pending(ctx context.Context, id string) (DeadLetter, error) {
+	deadLetter, err := b.store.Get(ctx, id)
+	if err != nil {
+		return DeadLetter{}, err
+	}
+	if deadLetter.Status != DeadLetterStatusPending {
+		return DeadLetter{}, ErrDeadLetterResolved
+	}
+	return deadLetter, nil
+}
+
+// resolve marks ids and records ErrDeadLetterResolved for those another operator resolved
+// since they were read.
+func (b *DeadLetterBrowser) resolve(
+	ctx context.Context,
+	ids []string,
+	status DeadLetterStatus,
+	action DeadLetterAction,
+	actor string,
+	note string,
+	results map[string]error,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	resolved, err := b.store.Resolve(ctx, ids, status, DeadLetterAudit{
+		Action:    action,
+		Actor:     strings.TrimSpace(actor),
+		Note:      strings.TrimSpace(note),
+		CreatedAt: b.now(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !slices.Contains(resolved, id) {
+			results[id] = ErrDeadLetterResolved
+		}
+	}
+	return nil
+}
+
+func uniqueIDs(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(out, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func collectResults(ids []string, errs map[string]error) []DeadLetterResult {
+	results := make([]DeadLetterResult, 0, len(ids))
+	for _, id := range ids {
+		results = append(results, DeadLetterResult{ID: id, Err: errs[id]})
+	}
+	return results
+}
@@ -0,0 +1,263 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryDeadLetterStore struct {
+	deadLetters map[string]DeadLetter
+	audits      []DeadLetterAudit
+	// resolvedElsewhere are ids another operator resolves between Get and Resolve.
+	resolvedElsewhere []string
+}
+
+func newMemoryDeadLetterStore() *memoryDeadLetterStore {
+	return &memoryDeadLetterStore{deadLetters: map[string]DeadLetter{}}
+}
+
+func (s *memoryDeadLetterStore) Record(ctx context.Context, deadLetter DeadLetter) error {
+	if _, ok := s.deadLetters[deadLetter.ID]; !ok {
+		s.deadLetters[deadLetter.ID] = deadLetter
+	}
+	return nil
+}
+
+func (s *memoryDeadLetterStore) Get(ctx context.Context, id string) (DeadLetter, error) {
+	deadLetter, ok := s.deadLetters[id]
+	if !ok {
+		return DeadLetter{}, ErrDeadLetterNotFound
+	}
+	return deadLetter, nil
+}
+
+func (s *memoryDeadLetterStore) List(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error) {
+	out := []DeadLetter{}
+	for _, deadLetter := range s.deadLetters {
+		if filter.Type == "" || deadLetter.Type == filter.Type {
+			out = append(out, deadLetter)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryDeadLetterStore) Resolve(
+	ctx context.Context,
+	ids []string,
+	status DeadLetterStatus,
+	audit DeadLetterAudit,
+) ([]string, error) {
+	resolved := []string{}
+	for _, id := range ids {
+		deadLetter := s.deadLetters[id]
+		if deadLetter.Status != DeadLetterStatusPending || slices.Contains(s.resolvedElsewhere, id) {
+			continue
+		}
+		resolvedAt := audit.CreatedAt
+		deadLetter.Status = status
+		deadLetter.ResolvedAt = &resolvedAt
+		deadLetter.ResolvedBy = audit.Actor
+		s.deadLetters[id] = deadLetter
+		audit.DeadLetterID = id
+		s.audits = append(s.audits, audit)
+		resolved = append(resolved, id)
+	}
+	return resolved, nil
+}
+
+func (s *memoryDeadLetterStore) ListAudit(
+	ctx context.Context,
+	deadLetterID string,
+	limit int,
+) ([]DeadLetterAudit, error) {
+	out := []DeadLetterAudit{}
+	for _, audit := range s.audits {
+		if deadLetterID == "" || audit.DeadLetterID == deadLetterID {
+			out = append(out, audit)
+		}
+	}
+	return out, nil
+}
+
+type deadLetterHarness struct {
+	store     *memoryDeadLetterStore
+	publisher *fakeRedrivePublisher
+	browser   *DeadLetterBrowser
+	now       time.Time
+}
+
+func newDeadLetterHarness() *deadLetterHarness {
+	h := &deadLetterHarness{
+		store:     newMemoryDeadLetterStore(),
+		publisher: &fakeRedrivePublisher{},
+		now:       time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	h.browser = NewDeadLetterBrowser(h.store, NewRedriver(h.publisher), DeadLetterBrowserOptions{
+		Now: func() time.Time { return h.now },
+	})
+	return h
+}
+
+// consume feeds the browser a dead letter the way the Kafka consumer does.
+func (h *deadLetterHarness) consume(t *testing.T, offset int64, metadata DeliveryMetadata) string {
+	t.Helper()
+	env := WithDeliveryMetadata(Envelope{
+		ID:            "evt_1",
+		Type:          "order.placed",
+		Source:        "order",
+		TenantID:      "t1",
+		OccurredAt:    h.now,
+		SchemaVersion: 1,
+		Payload:       json.RawMessage(`{"order_id":"o1"}`),
+	}, metadata)
+	ctx := ContextWithConsumedRecord(context.Background(), ConsumedRecord{
+		Topic:     "podzone.order.events.dlt",
+		Partition: 2,
+		Offset:    offset,
+		Key:       "o1",
+	})
+	require.NoError(t, h.browser.Handle(ctx, env))
+	return DeadLetterID("podzone.order.events.dlt", 2, offset)
+}
+
+func deadMetadata() DeliveryMetadata {
+	return DeliveryMetadata{
+		Attempt:          5,
+		MaxAttempts:      5,
+		OriginalTopic:    "podzone.order.events",
+		LastError:        "connection refused",
+		DeadLetterReason: "retry_exhausted",
+		ConsumerName:     "backoffice.order-placed",
+	}
+}
+
+func TestDeadLetterBrowser_HandleRecordsConsumedRecord(t *testing.T) {
+	h := newDeadLetterHarness()
+
+	id := h.consume(t, 7, deadMetadata())
+
+	deadLetter, err := h.browser.GetDeadLetter(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, "podzone.order.events.dlt:2:7", deadLetter.ID)
+	assert.Equal(t, "o1", deadLetter.Key)
+	assert.Equal(t, "podzone.order.events", deadLetter.OriginalTopic)
+	assert.Equal(t, "order.placed", deadLetter.Type)
+	assert.Equal(t, "t1", deadLetter.TenantID)
+	assert.Equal(t, "backoffice.order-placed", deadLetter.ConsumerName)
+	assert.Equal(t, "retry_exhausted", deadLetter.Reason)
+	assert.Equal(t, "connection refused", deadLetter.LastError)
+	assert.Equal(t, 5, deadLetter.Attempt)
+	assert.Equal(t, DeadLetterStatusPending, deadLetter.Status)
+	assert.Equal(t, h.now, deadLetter.ReceivedAt)
+}
+
+func TestDeadLetterBrowser_HandleRequiresConsumedRecord(t *testing.T) {
+	h := newDeadLetterHarness()
+
+	err := h.browser.Handle(context.Background(), Envelope{ID: "evt_1", Type: "order.placed"})
+
+	require.ErrorIs(t, err, ErrDeadLetterUnlocated)
+}
+
+func TestDeadLetterBrowser_RedriveRepublishesToOriginalTopic(t *testing.T) {
+	h := newDeadLetterHarness()
+	id := h.consume(t, 7, deadMetadata())
+
+	results, err := h.browser.RedriveDeadLetters(context.Background(), []string{id, id}, " 42 ", "fixed backoffice")
+	require.NoError(t, err)
+	require.Equal(t, []DeadLetterResult{{ID: id}}, results)
+
+	require.Len(t, h.publisher.requests, 1)
+	published := h.publisher.requests[0]
+	assert.Equal(t, "podzone.order.events", published.Topic)
+	assert.Equal(t, "o1", published.Key)
+	metadata := ReadDeliveryMetadata(published.Msg)
+	assert.Zero(t, metadata.Attempt)
+	assert.Empty(t, metadata.LastError)
+	assert.Empty(t, metadata.DeadLetterReason)
+	assert.Equal(t, 1, metadata.RedriveCount)
+	assert.Equal(t, "podzone.order.events", metadata.OriginalTopic)
+
+	deadLetter, err := h.browser.GetDeadLetter(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, DeadLetterStatusRedriven, deadLetter.Status)
+	assert.Equal(t, "42", deadLetter.ResolvedBy)
+
+	audits, err := h.browser.ListDeadLetterAudit(context.Background(), id, 10)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	assert.Equal(t, DeadLetterActionRedrive, audits[0].Action)
+	assert.Equal(t, "42", audits[0].Actor)
+	assert.Equal(t, "fixed backoffice", audits[0].Note)
+}
+
+func TestDeadLetterBrowser_RedriveReportsUnredrivableIDs(t *testing.T) {
+	h := newDeadLetterHarness()
+	ready := h.consume(t, 1, deadMetadata())
+	noTarget := h.consume(t, 2, DeliveryMetadata{LastError: "boom"})
+	dropped := h.consume(t, 3, deadMetadata())
+	_, err := h.browser.DropDeadLetters(context.Background(), []string{dropped}, "42", "")
+	require.NoError(t, err)
+
+	results, err := h.browser.RedriveDeadLetters(
+		context.Background(),
+		[]string{"missing", noTarget, dropped, ready},
+		"42",
+		"",
+	)
+	require.NoError(t, err)
+
+	require.Len(t, results, 4)
+	assert.ErrorIs(t, results[0].Err, ErrDeadLetterNotFound)
+	assert.ErrorIs(t, results[1].Err, ErrDeadLetterNoTarget)
+	assert.ErrorIs(t, results[2].Err, ErrDeadLetterResolved)
+	assert.NoError(t, results[3].Err)
+	require.Len(t, h.publisher.requests, 1)
+}
+
+func TestDeadLetterBrowser_RedriveReportsConcurrentlyResolved(t *testing.T) {
+	h := newDeadLetterHarness()
+	first := h.consume(t, 1, deadMetadata())
+	second := h.consume(t, 2, deadMetadata())
+	h.store.resolvedElsewhere = []string{second}
+
+	results, err := h.browser.RedriveDeadLetters(context.Background(), []string{first, second}, "42", "")
+	require.NoError(t, err)
+
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, ErrDeadLetterResolved)
+	assert.Len(t, h.store.audits, 1)
+}
+
+func TestDeadLetterBrowser_DropDoesNotPublish(t *testing.T) {
+	h := newDeadLetterHarness()
+	id := h.consume(t, 7, deadMetadata())
+
+	results, err := h.browser.DropDeadLetters(context.Background(), []string{id}, "42", "poison message")
+	require.NoError(t, err)
+
+	require.Equal(t, []DeadLetterResult{{ID: id}}, results)
+	assert.Empty(t, h.publisher.requests)
+	deadLetter, err := h.browser.GetDeadLetter(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, DeadLetterStatusDropped, deadLetter.Status)
+	require.Len(t, h.store.audits, 1)
+	assert.Equal(t, DeadLetterActionDrop, h.store.audits[0].Action)
+}
+
+func TestDeadLetterBrowser_ActionsRequireActor(t *testing.T) {
+	h := newDeadLetterHarness()
+	id := h.consume(t, 7, deadMetadata())
+
+	_, err := h.browser.RedriveDeadLetters(context.Background(), []string{id}, " ", "")
+	require.ErrorIs(t, err, ErrDeadLetterActor)
+	_, err = h.browser.DropDeadLetters(context.Background(), []string{id}, "", "")
+	require.ErrorIs(t, err, ErrDeadLetterActor)
+	assert.Empty(t, h.store.audits)
+}
@@ -0,0 +1,9 @@
+// Package dlqadmin exposes a DeadLetterBrowser over HTTP.
+//
+// Operators use it to search what consumers dead-lettered by topic, type, tenant,
+// consumer and error, inspect each envelope with its delivery metadata, and
+// redrive or drop a selection of messages. Redrives and drops are recorded
+// against the operator returned by the handler's ActorFunc. Like sagaadmin, the
+// handler does no authorization of its own; services mount it behind their
+// admin-only middleware.
+package dlqadmin
@@ -0,0 +1,272 @@
+package dlqadmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// maxBatchSize bounds how many dead letters one redrive or drop request may select.
+const maxBatchSize = 500
+
+// Service is implemented by *messaging.DeadLetterBrowser.
+type Service interface {
+	ListDeadLetters(ctx context.Context, filter messaging.DeadLetterFilter) ([]messaging.DeadLetter, error)
+	GetDeadLetter(ctx context.Context, id string) (messaging.DeadLetter, error)
+	ListDeadLetterAudit(ctx context.Context, deadLetterID string, limit int) ([]messaging.DeadLetterAudit, error)
+	RedriveDeadLetters(ctx context.Context, ids []string, actor string, note string) ([]messaging.DeadLetterResult, error)
+	DropDeadLetters(ctx context.Context, ids []string, actor string, note string) ([]messaging.DeadLetterResult, error)
+}
+
+var _ Service = (*messaging.DeadLetterBrowser)(nil)
+
+// ActorFunc names the operator behind a request, or returns "" when there is none.
+type ActorFunc func(ctx *gin.Context) string
+
+type Handler struct {
+	service Service
+	actor   ActorFunc
+}
+
+func NewHandler(service Service, actor ActorFunc) *Handler {
+	return &Handler{service: service, actor: actor}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	deadLetters := r.Group("/dead-letters")
+	{
+		deadLetters.GET("", h.ListDeadLetters)
+		deadLetters.GET("/:id", h.GetDeadLetter)
+		deadLetters.GET("/:id/audit", h.ListDeadLetterAudit)
+		deadLetters.POST("/redrive", h.RedriveDeadLetters)
+		deadLetters.POST("/drop", h.DropDeadLetters)
+	}
+	r.GET("/dead-letter-audit", h.ListDeadLetterAudit)
+}
+
+type deadLetterResponse struct {
+	ID            string             `json:"id"`
+	Topic         string             `json:"topic"`
+	Partition     int32              `json:"partition"`
+	Offset        int64              `json:"offset"`
+	Key           string             `json:"key,omitempty"`
+	OriginalTopic string             `json:"original_topic"`
+	Type          string             `json:"type"`
+	TenantID      string             `json:"tenant_id,omitempty"`
+	ConsumerName  string             `json:"consumer_name,omitempty"`
+	Reason        string             `json:"reason,omitempty"`
+	LastError     string             `json:"last_error,omitempty"`
+	Attempt       int                `json:"attempt"`
+	RedriveCount  int                `json:"redrive_count"`
+	Envelope      messaging.Envelope `json:"envelope"`
+	Status        string             `json:"status"`
+	ReceivedAt    time.Time          `json:"received_at"`
+	ResolvedAt    *time.Time         `json:"resolved_at,omitempty"`
+	ResolvedBy    string             `json:"resolved_by,omitempty"`
+}
+
+type listDeadLettersResponse struct {
+	Items []deadLetterResponse `json:"items"`
+}
+
+type auditResponse struct {
+	ID           int64     `json:"id"`
+	DeadLetterID string    `json:"dead_letter_id"`
+	Action       string    `json:"action"`
+	Actor        string    `json:"actor"`
+	Note         string    `json:"note,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type listAuditResponse struct {
+	Items []auditResponse `json:"items"`
+}
+
+type bulkRequest struct {
+	IDs  []string `json:"ids"  binding:"required,min=1"`
+	Note string   `json:"note"`
+}
+
+type bulkResult struct {
+	ID    string `json:"id"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+type bulkResponse struct {
+	Results []bulkResult `json:"results"`
+}
+
+// ListDeadLetters filters by topic, type, tenant_id, consumer, error (a substring of the
+// dead-letter reason or last error) and status (repeatable or comma separated).
+func (h *Handler) ListDeadLetters(ctx *gin.Context) {
+	filter := messaging.DeadLetterFilter{
+		Topic:        strings.TrimSpace(ctx.Query("topic")),
+		Type:         strings.TrimSpace(ctx.Query("type")),
+		TenantID:     strings.TrimSpace(ctx.Query("tenant_id")),
+		ConsumerName: strings.TrimSpace(ctx.Query("consumer")),
+		Error:        strings.TrimSpace(ctx.Query("error")),
+	}
+	for _, value := range ctx.QueryArray("status") {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, messaging.DeadLetterStatus(status))
+			}
+		}
+	}
+	var err error
+	if filter.Limit, err = intQuery(ctx, "limit"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.Offset, err = intQuery(ctx, "offset"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadLetters, err := h.service.ListDeadLetters(ctx.Request.Context(), filter)
+	if err != nil {
+		writeDeadLetterError(ctx, err, "Failed to list dead letters")
+		return
+	}
+	items := make([]deadLetterResponse, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		items = append(items, toDeadLetterResponse(deadLetter))
+	}
+	ctx.JSON(http.StatusOK, listDeadLettersResponse{Items: items})
+}
+
+func (h *Handler) GetDeadLetter(ctx *gin.Context) {
+	deadLetter, err := h.service.GetDeadLetter(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		writeDeadLetterError(ctx, err, "Failed to get dead letter")
+		return
+	}
+	ctx.JSON(http.StatusOK, toDeadLetterResponse(deadLetter))
+}
+
+// ListDeadLetterAudit lists the audit trail of the dead letter in the path or, on
+// /dead-letter-audit, of every dead letter.
+func (h *Handler) ListDeadLetterAudit(ctx *gin.Context) {
+	limit, err := intQuery(ctx, "limit")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	audits, err := h.service.ListDeadLetterAudit(ctx.Request.Context(), ctx.Param("id"), limit)
+	if err != nil {
+		writeDeadLetterError(ctx, err, "Failed to list dead letter audit")
+		return
+	}
+	items := make([]auditResponse, 0, len(audits))
+	for _, audit := range audits {
+		items = append(items, auditResponse{
+			ID:           audit.ID,
+			DeadLetterID: audit.DeadLetterID,
+			Action:       string(audit.Action),
+			Actor:        audit.Actor,
+			Note:         audit.Note,
+			CreatedAt:    audit.CreatedAt,
+		})
+	}
+	ctx.JSON(http.StatusOK, listAuditResponse{Items: items})
+}
+
+func (h *Handler) RedriveDeadLetters(ctx *gin.Context) {
+	h.bulk(ctx, h.service.RedriveDeadLetters, "Failed to redrive dead letters")
+}
+
+func (h *Handler) DropDeadLetters(ctx *gin.Context) {
+	h.bulk(ctx, h.service.DropDeadLetters, "Failed to drop dead letters")
+}
+
+func (h *Handler) bulk(
+	ctx *gin.Context,
+	action func(ctx context.Context, ids []string, actor string, note string) ([]messaging.DeadLetterResult, error),
+	fallback string,
+) {
+	actor := ""
+	if h.actor != nil {
+		actor = strings.TrimSpace(h.actor(ctx))
+	}
+	if actor == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "operator identity required"})
+		return
+	}
+	var req bulkRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.IDs) > maxBatchSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at most " + strconv.Itoa(maxBatchSize) + " ids per request"})
+		return
+	}
+	results, err := action(ctx.Request.Context(), req.IDs, actor, req.Note)
+	if err != nil {
+		writeDeadLetterError(ctx, err, fallback)
+		return
+	}
+	response := bulkResponse{Results: make([]bulkResult, 0, len(results))}
+	for _, result := range results {
+		item := bulkResult{ID: result.ID, OK: result.Err == nil}
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+		}
+		response.Results = append(response.Results, item)
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toDeadLetterResponse(deadLetter messaging.DeadLetter) deadLetterResponse {
+	return deadLetterResponse{
+		ID:            deadLetter.ID,
+		Topic:         deadLetter.Topic,
+		Partition:     deadLetter.Partition,
+		Offset:        deadLetter.Offset,
+		Key:           deadLetter.Key,
+		OriginalTopic: deadLetter.OriginalTopic,
+		Type:          deadLetter.Type,
+		TenantID:      deadLetter.TenantID,
+		ConsumerName:  deadLetter.ConsumerName,
+		Reason:        deadLetter.Reason,
+		LastError:     deadLetter.LastError,
+		Attempt:       deadLetter.Attempt,
+		RedriveCount:  deadLetter.RedriveCount,
+		Envelope:      deadLetter.Envelope,
+		Status:        string(deadLetter.Status),
+		ReceivedAt:    deadLetter.ReceivedAt,
+		ResolvedAt:    deadLetter.ResolvedAt,
+		ResolvedBy:    deadLetter.ResolvedBy,
+	}
+}
+
+func intQuery(ctx *gin.Context, name string) (int, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, errors.New(name + " must be a non-negative integer")
+	}
+	return value, nil
+}
+
+func writeDeadLetterError(ctx *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, messaging.ErrDeadLetterNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Dead letter not found"})
+	case errors.Is(err, messaging.ErrDeadLetterActor):
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "operator identity required"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,208 @@
+package dlqadmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type fakeService struct {
+	filter      messaging.DeadLetterFilter
+	deadLetters map[string]messaging.DeadLetter
+	ids         []string
+	actor       string
+	note        string
+}
+
+func (f *fakeService) ListDeadLetters(
+	ctx context.Context,
+	filter messaging.DeadLetterFilter,
+) ([]messaging.DeadLetter, error) {
+	f.filter = filter
+	out := []messaging.DeadLetter{}
+	for _, deadLetter := range f.deadLetters {
+		out = append(out, deadLetter)
+	}
+	return out, nil
+}
+
+func (f *fakeService) GetDeadLetter(ctx context.Context, id string) (messaging.DeadLetter, error) {
+	deadLetter, ok := f.deadLetters[id]
+	if !ok {
+		return messaging.DeadLetter{}, messaging.ErrDeadLetterNotFound
+	}
+	return deadLetter, nil
+}
+
+func (f *fakeService) ListDeadLetterAudit(
+	ctx context.Context,
+	deadLetterID string,
+	limit int,
+) ([]messaging.DeadLetterAudit, error) {
+	return []messaging.DeadLetterAudit{{ID: 1, DeadLetterID: deadLetterID, Action: messaging.DeadLetterActionDrop}}, nil
+}
+
+func (f *fakeService) RedriveDeadLetters(
+	ctx context.Context,
+	ids []string,
+	actor string,
+	note string,
+) ([]messaging.DeadLetterResult, error) {
+	f.ids, f.actor, f.note = ids, actor, note
+	results := make([]messaging.DeadLetterResult, 0, len(ids))
+	for _, id := range ids {
+		var err error
+		if _, ok := f.deadLetters[id]; !ok {
+			err = messaging.ErrDeadLetterNotFound
+		}
+		results = append(results, messaging.DeadLetterResult{ID: id, Err: err})
+	}
+	return results, nil
+}
+
+func (f *fakeService) DropDeadLetters(
+	ctx context.Context,
+	ids []string,
+	actor string,
+	note string,
+) ([]messaging.DeadLetterResult, error) {
+	return f.RedriveDeadLetters(ctx, ids, actor, note)
+}
+
+func newTestRouter(service Service, actor string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewHandler(service, func(*gin.Context) string { return actor }).RegisterRoutes(router.Group("/admin"))
+	return router
+}
+
+func TestHandler_ListDeadLettersParsesFilter(t *testing.T) {
+	service := &fakeService{deadLetters: map[string]messaging.DeadLetter{
+		"t.dlt:0:1": {ID: "t.dlt:0:1", Type: "order.placed", Status: messaging.DeadLetterStatusPending},
+	}}
+	router := newTestRouter(service, "")
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/admin/dead-letters?type=order.placed&tenant_id=t1&consumer=backoffice&error=timeout"+
+			"&status=pending,dropped&status=redriven&limit=10&offset=20",
+		nil,
+	)
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, messaging.DeadLetterFilter{
+		Type:         "order.placed",
+		TenantID:     "t1",
+		ConsumerName: "backoffice",
+		Error:        "timeout",
+		Statuses: []messaging.DeadLetterStatus{
+			messaging.DeadLetterStatusPending,
+			messaging.DeadLetterStatusDropped,
+			messaging.DeadLetterStatusRedriven,
+		},
+		Limit:  10,
+		Offset: 20,
+	}, service.filter)
+
+	var body listDeadLettersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "order.placed", body.Items[0].Type)
+}
+
+func TestHandler_ListDeadLettersRejectsBadLimit(t *testing.T) {
+	router := newTestRouter(&fakeService{}, "")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/dead-letters?limit=-1", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_GetDeadLetterNotFound(t *testing.T) {
+	router := newTestRouter(&fakeService{}, "")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/dead-letters/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_RedriveRequiresActor(t *testing.T) {
+	router := newTestRouter(&fakeService{}, "")
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/dead-letters/redrive", strings.NewReader(`{"ids":["a"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_RedriveReportsPerIDResults(t *testing.T) {
+	service := &fakeService{deadLetters: map[string]messaging.DeadLetter{"a": {ID: "a"}}}
+	router := newTestRouter(service, "42")
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/admin/dead-letters/redrive",
+		strings.NewReader(`{"ids":["a","b"],"note":"fixed schema"}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"a", "b"}, service.ids)
+	assert.Equal(t, "42", service.actor)
+	assert.Equal(t, "fixed schema", service.note)
+
+	var body bulkResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, []bulkResult{
+		{ID: "a", OK: true},
+		{ID: "b", OK: false, Error: messaging.ErrDeadLetterNotFound.Error()},
+	}, body.Results)
+}
+
+func TestHandler_DropRejectsOversizedBatch(t *testing.T) {
+	router := newTestRouter(&fakeService{}, "42")
+	ids := make([]string, maxBatchSize+1)
+	for i := range ids {
+		ids[i] = "id"
+	}
+	payload, err := json.Marshal(bulkRequest{IDs: ids})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/dead-letters/drop", strings.NewReader(string(payload)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_ListAllAudit(t *testing.T) {
+	router := newTestRouter(&fakeService{}, "")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/dead-letter-audit?limit=5", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body listAuditResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "drop", body.Items[0].Action)
+	assert.Empty(t, body.Items[0].DeadLetterID)
+}
@@ -9,9 +9,15 @@
 //   - Envelope: stable message metadata and JSON payload.
 //   - Publisher/Consumer/Handler: runtime contracts.
 //   - Registry: routes Envelope.Type to TypedHandler implementations.
+//   - SchemaRegistry: versioned payload types, upcasters, validation and JSON Schema export;
+//     EventHandler decodes upcast payloads for typed handlers.
 //   - Retry, dead-letter, redrive, idempotency: consumer operational behavior.
+//   - SagaOrchestrator: durable, message-driven sagas persisted through SagaStore.
 //   - kafka: Sarama-backed adapter built on pkg/pdkafka.
-//   - sqlstore: reusable SQL stores for inbox/outbox tables.
+//   - sqlstore: reusable SQL stores for inbox/outbox/saga/dead-letter tables.
+//   - sagaadmin: HTTP admin API for inspecting, resuming and aborting sagas.
+//   - DeadLetterBrowser and dlqadmin: collect dead-letter topics into a DeadLetterStore and
+//     let operators filter, redrive or drop the messages over HTTP.
 //
 // Service-specific event handlers belong under internal/<service>/controller,
 // while workers, Kafka wiring, and persistence adapters stay under
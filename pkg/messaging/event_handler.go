@@ -0,0 +1,49 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// EventHandler decodes an envelope's payload into T before calling its handler function.
+// Registered on a Registry built with NewRegistryWithSchemas, it receives payloads already
+// upcast to the latest schema version, and T must be the type registered for that version.
+type EventHandler[T any] struct {
+	messageType string
+	handle      func(ctx context.Context, msg Envelope, payload T) error
+}
+
+var _ TypedHandler = (*EventHandler[struct{}])(nil)
+
+func HandleEvent[T any](
+	messageType string,
+	handle func(ctx context.Context, msg Envelope, payload T) error,
+) *EventHandler[T] {
+	return &EventHandler[T]{messageType: messageType, handle: handle}
+}
+
+func (h *EventHandler[T]) MessageType() string {
+	return h.messageType
+}
+
+func (h *EventHandler[T]) PayloadType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// Handle dead-letters payloads that do not decode into T, since redelivery cannot fix them.
+func (h *EventHandler[T]) Handle(ctx context.Context, msg Envelope) error {
+	var payload T
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return DeadLetterError(
+			fmt.Errorf("decode %s payload: %w", h.messageType, err),
+			invalidPayloadReason(h.messageType),
+		)
+	}
+	return h.handle(ctx, msg, payload)
+}
+
+func invalidPayloadReason(messageType string) string {
+	return "invalid " + messageType + " payload"
+}
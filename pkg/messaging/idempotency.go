@@ -2,8 +2,11 @@ package messaging
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 )
@@ -16,9 +19,60 @@ const (
 	InboxDecisionInProgress InboxDecision = "in_progress"
 )
 
-var ErrInboxConsumerNameRequired = errors.New("messaging: inbox consumer name is required")
+const DefaultInboxLeaseDuration = 2 * time.Minute
+
+var (
+	ErrInboxConsumerNameRequired = errors.New("messaging: inbox consumer name is required")
+	// ErrInboxLeaseLost means another owner took over the claim after its lease expired.
+	ErrInboxLeaseLost = errors.New("messaging: inbox lease lost")
+	// ErrInboxInProgress means another owner holds an unexpired claim on the message.
+	ErrInboxInProgress = errors.New("messaging: message is being handled by another consumer")
+)
+
+// InboxLease is a time-bounded claim on an inbox row.
+type InboxLease struct {
+	Owner     string
+	ExpiresAt time.Time
+}
+
+type IdempotencyOptions struct {
+	// Owner identifies this process in inbox claims. Defaults to hostname, pid and a random suffix.
+	Owner string
+	// LeaseDuration is how long a claim stays valid without a heartbeat. Defaults to
+	// DefaultInboxLeaseDuration.
+	LeaseDuration time.Duration
+	// HeartbeatInterval is how often a running handler extends its lease. Defaults to a third
+	// of LeaseDuration.
+	HeartbeatInterval time.Duration
+	Now               func() time.Time
+}
+
+func (o IdempotencyOptions) normalize() IdempotencyOptions {
+	if strings.TrimSpace(o.Owner) == "" {
+		o.Owner = DefaultLeaseOwner()
+	}
+	if o.LeaseDuration <= 0 {
+		o.LeaseDuration = DefaultInboxLeaseDuration
+	}
+	if o.HeartbeatInterval <= 0 || o.HeartbeatInterval >= o.LeaseDuration {
+		o.HeartbeatInterval = o.LeaseDuration / 3
+	}
+	if o.Now == nil {
+		o.Now = func() time.Time { return time.Now().UTC() }
+	}
+	return o
+}
 
 func IdempotentConsumer(store InboxStore, consumerName string, now func() time.Time) Middleware {
+	return IdempotentConsumerWithOptions(store, consumerName, IdempotencyOptions{Now: now})
+}
+
+// IdempotentConsumerWithOptions skips messages the consumer already completed and claims the
+// rest under a lease that is extended while the handler runs. A message claimed by another
+// live owner fails with a retryable ErrInboxInProgress, so the delivery is retried instead of
+// acknowledged; once that owner's lease expires the retry takes the claim over.
+func IdempotentConsumerWithOptions(store InboxStore, consumerName string, opts IdempotencyOptions) Middleware {
+	opts = opts.normalize()
 	return func(next Handler) Handler {
 		return HandlerFunc(func(ctx context.Context, msg Envelope) error {
 			if store == nil {
@@ -33,24 +87,88 @@ func IdempotentConsumer(store InboxStore, consumerName string, now func() time.T
 				return ErrInboxConsumerNameRequired
 			}
 
-			clock := time.Now().UTC
-			if now != nil {
-				clock = now
-			}
-
-			decision, err := store.Begin(ctx, name, msg.ID, clock())
+			now := opts.Now()
+			lease := InboxLease{Owner: opts.Owner, ExpiresAt: now.Add(opts.LeaseDuration)}
+			decision, err := store.Begin(ctx, name, msg.ID, lease, now)
 			if err != nil {
 				return err
 			}
-			if decision == InboxDecisionDuplicate || decision == InboxDecisionInProgress {
+			switch decision {
+			case InboxDecisionDuplicate:
 				return nil
+			case InboxDecisionInProgress:
+				return RetryableError(ErrInboxInProgress, "inbox_in_progress")
 			}
 
-			if err := next.Handle(ctx, msg); err != nil {
-				_ = store.Fail(ctx, name, msg.ID, err.Error(), clock())
+			handleCtx, cancel := context.WithCancelCause(ctx)
+			stopHeartbeat := startInboxHeartbeat(handleCtx, cancel, store, name, msg.ID, opts)
+			err = next.Handle(handleCtx, msg)
+			stopHeartbeat()
+			cancel(nil)
+
+			if err != nil {
+				_ = store.Fail(ctx, name, msg.ID, opts.Owner, err.Error(), opts.Now())
 				return err
 			}
-			return store.Complete(ctx, name, msg.ID, clock())
+			if err := store.Complete(ctx, name, msg.ID, opts.Owner, opts.Now()); err != nil {
+				// The handler finished; whoever holds the claim now records the outcome.
+				if errors.Is(err, ErrInboxLeaseLost) {
+					return nil
+				}
+				return err
+			}
+			return nil
 		})
 	}
 }
+
+// startInboxHeartbeat extends the lease every HeartbeatInterval until the returned stop func
+// is called. Losing the lease cancels the handler context with ErrInboxLeaseLost; other
+// heartbeat errors are retried on the next tick while the current lease is still valid.
+func startInboxHeartbeat(
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	store InboxStore,
+	consumerName string,
+	messageID string,
+	opts IdempotencyOptions,
+) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(opts.HeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := opts.Now()
+				lease := InboxLease{Owner: opts.Owner, ExpiresAt: now.Add(opts.LeaseDuration)}
+				if err := store.Heartbeat(ctx, consumerName, messageID, lease, now); errors.Is(err, ErrInboxLeaseLost) {
+					cancel(ErrInboxLeaseLost)
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+// DefaultLeaseOwner names this process in inbox and outbox leases: hostname, pid and a random
+// suffix so restarts on the same host do not inherit the previous process's claims.
+func DefaultLeaseOwner() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
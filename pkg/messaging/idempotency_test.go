@@ -3,6 +3,7 @@ package messaging
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,8 +12,13 @@ import (
 )
 
 type fakeInboxStore struct {
+	mu            sync.Mutex
 	beginDecision InboxDecision
 	beginErr      error
+	beginLease    InboxLease
+	heartbeatErr  error
+	heartbeats    int
+	completeErr   error
 	completed     []string
 	failed        []string
 }
@@ -21,25 +27,48 @@ func (f *fakeInboxStore) Begin(
 	ctx context.Context,
 	consumerName string,
 	messageID string,
+	lease InboxLease,
 	now time.Time,
 ) (InboxDecision, error) {
+	f.beginLease = lease
 	return f.beginDecision, f.beginErr
 }
 
+func (f *fakeInboxStore) Heartbeat(
+	ctx context.Context,
+	consumerName string,
+	messageID string,
+	lease InboxLease,
+	now time.Time,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.heartbeats++
+	return f.heartbeatErr
+}
+
+func (f *fakeInboxStore) heartbeatCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.heartbeats
+}
+
 func (f *fakeInboxStore) Complete(
 	ctx context.Context,
 	consumerName string,
 	messageID string,
+	owner string,
 	processedAt time.Time,
 ) error {
 	f.completed = append(f.completed, consumerName+":"+messageID)
-	return nil
+	return f.completeErr
 }
 
 func (f *fakeInboxStore) Fail(
 	ctx context.Context,
 	consumerName string,
 	messageID string,
+	owner string,
 	errText string,
 	failedAt time.Time,
 ) error {
@@ -73,3 +102,69 @@ func TestIdempotentConsumerCompleteAndFail(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, []string{"proj:evt_2"}, store.failed)
 }
+
+func TestIdempotentConsumerInProgressIsRetryable(t *testing.T) {
+	store := &fakeInboxStore{beginDecision: InboxDecisionInProgress}
+	called := false
+	handler := HandlerFunc(func(ctx context.Context, msg Envelope) error {
+		called = true
+		return nil
+	})
+
+	err := IdempotentConsumer(store, "proj", nil)(handler).Handle(context.Background(), Envelope{ID: "evt_1"})
+	require.ErrorIs(t, err, ErrInboxInProgress)
+	assert.Equal(t, FailureActionRetry, ClassifyError(err).Action)
+	assert.False(t, called)
+}
+
+func TestIdempotentConsumerClaimsWithLease(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeInboxStore{beginDecision: InboxDecisionAcquired}
+	handler := HandlerFunc(func(ctx context.Context, msg Envelope) error { return nil })
+
+	err := IdempotentConsumerWithOptions(store, "proj", IdempotencyOptions{
+		Owner:         "pod-a",
+		LeaseDuration: time.Minute,
+		Now:           func() time.Time { return now },
+	})(handler).Handle(context.Background(), Envelope{ID: "evt_1"})
+	require.NoError(t, err)
+	assert.Equal(t, InboxLease{Owner: "pod-a", ExpiresAt: now.Add(time.Minute)}, store.beginLease)
+}
+
+func TestIdempotentConsumerHeartbeatsLongHandlers(t *testing.T) {
+	store := &fakeInboxStore{beginDecision: InboxDecisionAcquired}
+	handler := HandlerFunc(func(ctx context.Context, msg Envelope) error {
+		require.Eventually(t, func() bool { return store.heartbeatCount() >= 2 }, time.Second, time.Millisecond)
+		return nil
+	})
+
+	err := IdempotentConsumerWithOptions(store, "proj", IdempotencyOptions{
+		LeaseDuration:     30 * time.Millisecond,
+		HeartbeatInterval: 5 * time.Millisecond,
+	})(handler).Handle(context.Background(), Envelope{ID: "evt_1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"proj:evt_1"}, store.completed)
+}
+
+func TestIdempotentConsumerLostLeaseCancelsHandler(t *testing.T) {
+	store := &fakeInboxStore{beginDecision: InboxDecisionAcquired, heartbeatErr: ErrInboxLeaseLost}
+	handler := HandlerFunc(func(ctx context.Context, msg Envelope) error {
+		<-ctx.Done()
+		return context.Cause(ctx)
+	})
+
+	err := IdempotentConsumerWithOptions(store, "proj", IdempotencyOptions{
+		LeaseDuration:     30 * time.Millisecond,
+		HeartbeatInterval: 5 * time.Millisecond,
+	})(handler).Handle(context.Background(), Envelope{ID: "evt_1"})
+	require.ErrorIs(t, err, ErrInboxLeaseLost)
+	assert.Empty(t, store.completed)
+}
+
+func TestIdempotentConsumerIgnoresLostLeaseOnComplete(t *testing.T) {
+	store := &fakeInboxStore{beginDecision: InboxDecisionAcquired, completeErr: ErrInboxLeaseLost}
+	handler := HandlerFunc(func(ctx context.Context, msg Envelope) error { return nil })
+
+	err := IdempotentConsumer(store, "proj", nil)(handler).Handle(context.Background(), Envelope{ID: "evt_1"})
+	require.NoError(t, err)
+}
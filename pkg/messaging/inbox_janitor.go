@@ -0,0 +1,58 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultInboxRetention      = 7 * 24 * time.Hour
+	defaultInboxPruneBatchSize = 1000
+)
+
+var ErrNilInboxPruner = errors.New("messaging: nil inbox pruner")
+
+// InboxJanitor deletes completed inbox rows once they are older than the retention window.
+// Redeliveries older than the window are no longer deduplicated, so retention should exceed
+// the longest time a message can sit in retry topics or be redriven.
+type InboxJanitor struct {
+	pruner    InboxPruner
+	retention time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+func NewInboxJanitor(pruner InboxPruner, retention time.Duration, now func() time.Time) *InboxJanitor {
+	if retention <= 0 {
+		retention = DefaultInboxRetention
+	}
+	if now == nil {
+		now = func() time.Time { return time.Now().UTC() }
+	}
+	return &InboxJanitor{
+		pruner:    pruner,
+		retention: retention,
+		batchSize: defaultInboxPruneBatchSize,
+		now:       now,
+	}
+}
+
+// RunOnce prunes in batches until a batch comes back short and returns the rows deleted.
+func (j *InboxJanitor) RunOnce(ctx context.Context) (int64, error) {
+	if j == nil || j.pruner == nil {
+		return 0, ErrNilInboxPruner
+	}
+	cutoff := j.now().Add(-j.retention)
+	var total int64
+	for {
+		deleted, err := j.pruner.PruneCompleted(ctx, cutoff, j.batchSize)
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted < int64(j.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
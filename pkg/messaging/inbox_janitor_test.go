@@ -0,0 +1,49 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeInboxPruner struct {
+	batches []int64
+	err     error
+	cutoffs []time.Time
+}
+
+func (f *fakeInboxPruner) PruneCompleted(ctx context.Context, completedBefore time.Time, limit int) (int64, error) {
+	f.cutoffs = append(f.cutoffs, completedBefore)
+	if len(f.batches) == 0 {
+		return 0, f.err
+	}
+	deleted := f.batches[0]
+	f.batches = f.batches[1:]
+	return deleted, nil
+}
+
+func TestInboxJanitorRunOncePrunesUntilShortBatch(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	pruner := &fakeInboxPruner{batches: []int64{defaultInboxPruneBatchSize, 12, 99}}
+	janitor := NewInboxJanitor(pruner, 24*time.Hour, func() time.Time { return now })
+
+	deleted, err := janitor.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(defaultInboxPruneBatchSize+12), deleted)
+	require.Len(t, pruner.cutoffs, 2)
+	assert.Equal(t, now.Add(-24*time.Hour), pruner.cutoffs[0])
+}
+
+func TestInboxJanitorRunOnceReturnsPrunerError(t *testing.T) {
+	pruner := &fakeInboxPruner{err: errors.New("db down")}
+
+	_, err := NewInboxJanitor(pruner, 0, nil).RunOnce(context.Background())
+	require.EqualError(t, err, "db down")
+
+	_, err = NewInboxJanitor(nil, 0, nil).RunOnce(context.Background())
+	require.ErrorIs(t, err, ErrNilInboxPruner)
+}
@@ -36,17 +36,70 @@ type Tx interface{}
 
 // OutboxStore is for commit-coupled integration events.
 // Do not use it for every async job; best-effort work should publish through Publisher directly.
+//
+// ClaimPending leases due pending records to lease.Owner until lease.ExpiresAt so concurrent
+// relays never hand out the same record twice; a relay that dies mid-batch loses its claims
+// when the lease expires. It returns records in creation order and never a record whose
+// MessageKey has an earlier record still pending or dead, so one key is published in order and
+// a failing or dead record holds back the ones behind it. MarkPublished, MarkFailed and MarkDead
+// release the claim; they only touch records owner still holds and return ErrOutboxLeaseLost
+// when another relay took one over.
 type OutboxStore interface {
 	Append(ctx context.Context, tx Tx, record OutboxRecord) error
-	ListPending(ctx context.Context, limit int) ([]OutboxRecord, error)
-	MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error
-	MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error
+	ClaimPending(ctx context.Context, lease OutboxLease, limit int, now time.Time) ([]OutboxRecord, error)
+	MarkPublished(ctx context.Context, owner string, ids []string, publishedAt time.Time) error
+	MarkFailed(
+		ctx context.Context,
+		owner string,
+		id string,
+		errText string,
+		failedAt time.Time,
+		nextAttemptAt time.Time,
+	) error
+	MarkDead(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error
 }
 
+// OutboxRedriveStore lets operators release dead outbox records. RedriveDead makes each dead
+// record pending again, due at now with its attempts reset, and returns the ids it redrove;
+// ids of records that are not dead are skipped.
+type OutboxRedriveStore interface {
+	ListDead(ctx context.Context, filter DeadOutboxFilter) ([]OutboxRecord, error)
+	RedriveDead(ctx context.Context, ids []string, now time.Time) ([]string, error)
+}
+
+// OutboxWakeup signals that new outbox records may be ready, letting a relay publish without
+// waiting for its next poll.
+type OutboxWakeup interface {
+	Wakeups() <-chan struct{}
+}
+
+// InboxStore records which messages a consumer has handled. Begin claims a message for
+// lease.Owner until lease.ExpiresAt; a claim whose lease expired (its holder crashed or
+// stalled) can be taken over by the next delivery. Heartbeat, Complete and Fail only touch
+// a row the owner still holds and return ErrInboxLeaseLost otherwise.
 type InboxStore interface {
-	Begin(ctx context.Context, consumerName string, messageID string, now time.Time) (InboxDecision, error)
-	Complete(ctx context.Context, consumerName string, messageID string, processedAt time.Time) error
-	Fail(ctx context.Context, consumerName string, messageID string, errText string, failedAt time.Time) error
+	Begin(
+		ctx context.Context,
+		consumerName string,
+		messageID string,
+		lease InboxLease,
+		now time.Time,
+	) (InboxDecision, error)
+	Heartbeat(ctx context.Context, consumerName string, messageID string, lease InboxLease, now time.Time) error
+	Complete(ctx context.Context, consumerName string, messageID string, owner string, processedAt time.Time) error
+	Fail(
+		ctx context.Context,
+		consumerName string,
+		messageID string,
+		owner string,
+		errText string,
+		failedAt time.Time,
+	) error
+}
+
+// InboxPruner deletes up to limit completed inbox rows processed before completedBefore.
+type InboxPruner interface {
+	PruneCompleted(ctx context.Context, completedBefore time.Time, limit int) (int64, error)
 }
 
 type SagaStep interface {
@@ -54,3 +107,29 @@ type SagaStep interface {
 	Execute(ctx context.Context, msg Envelope) error
 	Compensate(ctx context.Context, msg Envelope) error
 }
+
+// SagaStore persists saga instances for a SagaOrchestrator. Claim leases one attempt of the
+// current step: it only succeeds for an active saga that is due and not leased (or whose lease
+// expired), and it bumps Attempts and Version. Save writes the whole instance, clears the lease
+// and bumps Version, failing with ErrSagaConflict when Version no longer matches, so a holder
+// whose lease was taken over cannot overwrite the newer state.
+type SagaStore interface {
+	Create(ctx context.Context, instance SagaInstance) error
+	Get(ctx context.Context, id string) (SagaInstance, error)
+	List(ctx context.Context, filter SagaFilter) ([]SagaInstance, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]string, error)
+	Claim(ctx context.Context, id string, lease SagaLease, now time.Time) (SagaInstance, error)
+	Save(ctx context.Context, instance SagaInstance) error
+}
+
+// DeadLetterStore keeps the messages a DeadLetterBrowser reads from dead-letter topics. Record
+// ignores a DeadLetter whose ID is already stored. Resolve moves the ids that are still pending
+// to status, sets ResolvedAt and ResolvedBy from audit and appends one audit record per moved
+// id, all or nothing; it returns the ids it moved.
+type DeadLetterStore interface {
+	Record(ctx context.Context, deadLetter DeadLetter) error
+	Get(ctx context.Context, id string) (DeadLetter, error)
+	List(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error)
+	Resolve(ctx context.Context, ids []string, status DeadLetterStatus, audit DeadLetterAudit) ([]string, error)
+	ListAudit(ctx context.Context, deadLetterID string, limit int) ([]DeadLetterAudit, error)
+}
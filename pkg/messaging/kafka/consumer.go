@@ -125,7 +125,13 @@ func (h *consumerGroupHandler) consumeMessage(
 		h.observe(session.Context(), msg, env, messaging.FailureActionReturn, "no_handler", messaging.ErrNilHandler)
 		return messaging.ErrNilHandler
 	}
-	if err := h.handler.Handle(session.Context(), env); err != nil {
+	ctx := messaging.ContextWithConsumedRecord(session.Context(), messaging.ConsumedRecord{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msgKey(msg),
+	})
+	if err := h.handler.Handle(ctx, env); err != nil {
 		return h.handleFailure(session, msg, env, err)
 	}
 	h.observe(session.Context(), msg, env, messaging.FailureActionReturn, "handled", nil)
@@ -44,11 +44,15 @@ func (f *fakeRunner) Close() error { return nil }
 
 type fakeHandler struct {
 	handled []messaging.Envelope
+	records []messaging.ConsumedRecord
 	err     error
 }
 
 func (f *fakeHandler) Handle(ctx context.Context, msg messaging.Envelope) error {
 	f.handled = append(f.handled, msg)
+	if record, ok := messaging.ConsumedRecordFromContext(ctx); ok {
+		f.records = append(f.records, record)
+	}
 	return f.err
 }
 
@@ -135,6 +139,32 @@ func TestConsumerGroupHandlerConsumeClaim(t *testing.T) {
 	assert.Equal(t, 1, session.marked)
 }
 
+func TestConsumerGroupHandlerConsumeClaim_PassesConsumedRecord(t *testing.T) {
+	handler := &fakeHandler{}
+	cgh := &consumerGroupHandler{handler: handler}
+	payload, err := json.Marshal(validConsumerEnvelope())
+	require.NoError(t, err)
+
+	msgCh := make(chan *sarama.ConsumerMessage, 1)
+	msgCh <- &sarama.ConsumerMessage{
+		Topic:     "podzone.iam.events.dlt",
+		Partition: 2,
+		Offset:    41,
+		Key:       []byte("t1"),
+		Value:     payload,
+	}
+	close(msgCh)
+
+	session := &fakeSession{ctx: context.Background()}
+	require.NoError(t, cgh.ConsumeClaim(session, &fakeClaim{messages: msgCh}))
+	assert.Equal(t, []messaging.ConsumedRecord{{
+		Topic:     "podzone.iam.events.dlt",
+		Partition: 2,
+		Offset:    41,
+		Key:       "t1",
+	}}, handler.records)
+}
+
 func TestConsumerGroupHandlerConsumeClaim_HandlerError(t *testing.T) {
 	handler := &fakeHandler{err: errors.New("boom")}
 	cgh := &consumerGroupHandler{handler: handler, opts: ConsumerOptions{}}
@@ -2,51 +2,164 @@ package kafka
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/tuannm99/podzone/pkg/messaging"
 )
 
+type RelayOptions struct {
+	// Limit is the number of records claimed per batch. Defaults to 100.
+	Limit int
+	// Concurrency bounds how many records of a batch are published at once. Defaults to 1.
+	Concurrency int
+	// Owner names this relay in outbox leases. Defaults to messaging.DefaultLeaseOwner.
+	Owner string
+	// LeaseDuration is how long claimed records stay hidden from other relays. Defaults to a minute.
+	LeaseDuration time.Duration
+	// RetryPolicy schedules failed records; a record failing MaxAttempts times is marked dead
+	// and holds back its message key until it is redriven.
+	RetryPolicy messaging.RetryPolicy
+	Now         func() time.Time
+}
+
 type Relay struct {
-	store     messaging.OutboxStore
-	publisher messaging.Publisher
-	limit     int
+	store       messaging.OutboxStore
+	publisher   messaging.Publisher
+	limit       int
+	concurrency int
+	owner       string
+	lease       time.Duration
+	retry       messaging.RetryPolicy
+	now         func() time.Time
 }
 
 // NewRelay builds a bounded fallback relay for transactional outbox records.
 // High-volume deployments should prefer CDC from the service database into Kafka
 // and keep this relay for local development or recovery paths.
 func NewRelay(store messaging.OutboxStore, publisher messaging.Publisher, limit int) *Relay {
-	if limit <= 0 {
-		limit = 100
+	return NewRelayWithOptions(store, publisher, RelayOptions{Limit: limit})
+}
+
+// NewRelayFromConfig builds a relay from a service's messaging.<service>.outbox settings.
+func NewRelayFromConfig(
+	store messaging.OutboxStore,
+	publisher messaging.Publisher,
+	cfg messaging.OutboxRelayConfig,
+) *Relay {
+	return NewRelayWithOptions(store, publisher, RelayOptions{
+		Limit:         cfg.BatchSize,
+		Concurrency:   cfg.Concurrency,
+		LeaseDuration: cfg.LeaseDuration,
+		RetryPolicy:   cfg.RetryPolicy(),
+	})
+}
+
+func NewRelayWithOptions(store messaging.OutboxStore, publisher messaging.Publisher, opts RelayOptions) *Relay {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if strings.TrimSpace(opts.Owner) == "" {
+		opts.Owner = messaging.DefaultLeaseOwner()
+	}
+	if opts.LeaseDuration <= 0 {
+		opts.LeaseDuration = time.Minute
+	}
+	if opts.Now == nil {
+		opts.Now = func() time.Time { return time.Now().UTC() }
 	}
 	return &Relay{
-		store:     store,
-		publisher: publisher,
-		limit:     limit,
+		store:       store,
+		publisher:   publisher,
+		limit:       opts.Limit,
+		concurrency: opts.Concurrency,
+		owner:       opts.Owner,
+		lease:       opts.LeaseDuration,
+		retry:       opts.RetryPolicy.Normalize(),
+		now:         opts.Now,
 	}
 }
 
+// RunOnce claims and publishes one batch. It returns messaging.ErrNoMessages when nothing was
+// due. Publish failures are recorded on the records, not returned; a record whose lease
+// expired mid-publish is left to the relay that took it over and reported as
+// messaging.ErrOutboxLeaseLost.
 func (r *Relay) RunOnce(ctx context.Context) error {
-	items, err := r.store.ListPending(ctx, r.limit)
+	_, err := r.runOnce(ctx)
+	return err
+}
+
+// Drain runs batches until one comes back short, so a wakeup after a burst of writes does not
+// wait for the next poll to publish the rest.
+func (r *Relay) Drain(ctx context.Context) error {
+	for {
+		claimed, err := r.runOnce(ctx)
+		if err != nil {
+			return err
+		}
+		if claimed < r.limit || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (r *Relay) runOnce(ctx context.Context) (int, error) {
+	now := r.now()
+	items, err := r.store.ClaimPending(
+		ctx,
+		messaging.OutboxLease{Owner: r.owner, ExpiresAt: now.Add(r.lease)},
+		r.limit,
+		now,
+	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if len(items) == 0 {
-		return messaging.ErrNoMessages
+		return 0, messaging.ErrNoMessages
+	}
+
+	// ClaimPending returns at most one record per message key, so publishing a batch
+	// concurrently cannot reorder a key.
+	results := make([]error, len(items))
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i] = r.publisher.Publish(ctx, item.Topic, item.MessageKey, item.Envelope)
+		})
 	}
+	wg.Wait()
 
-	now := time.Now().UTC()
-	var published []string
-	for _, item := range items {
-		if err := r.publisher.Publish(ctx, item.Topic, item.MessageKey, item.Envelope); err != nil {
-			_ = r.store.MarkFailed(ctx, item.ID, err.Error(), now.Add(time.Minute))
+	doneAt := r.now()
+	var (
+		published []string
+		errs      []error
+	)
+	for i, item := range items {
+		if results[i] == nil {
+			published = append(published, item.ID)
+			continue
+		}
+		attempt := item.Attempts + 1
+		// A payload that breaks or lacks its schema will not publish on retry either.
+		if r.retry.Exhausted(attempt) || errors.Is(results[i], messaging.ErrSchemaMismatch) ||
+			errors.Is(results[i], messaging.ErrUnknownSchemaVersion) ||
+			errors.Is(results[i], messaging.ErrUnregisteredSchema) {
+			errs = append(errs, r.store.MarkDead(ctx, r.owner, item.ID, results[i].Error(), doneAt))
 			continue
 		}
-		published = append(published, item.ID)
+		next := doneAt.Add(r.retry.NextDelay(attempt))
+		errs = append(errs, r.store.MarkFailed(ctx, r.owner, item.ID, results[i].Error(), doneAt, next))
 	}
-	if len(published) == 0 {
-		return nil
+	if len(published) > 0 {
+		errs = append(errs, r.store.MarkPublished(ctx, r.owner, published, doneAt))
 	}
-	return r.store.MarkPublished(ctx, published, now)
+	return len(items), errors.Join(errs...)
 }
@@ -18,7 +18,12 @@ type markPublishedFailStore struct {
 	markPublishedErr error
 }
 
-func (f *markPublishedFailStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
+func (f *markPublishedFailStore) MarkPublished(
+	ctx context.Context,
+	owner string,
+	ids []string,
+	publishedAt time.Time,
+) error {
 	_ = ctx
 	_ = ids
 	_ = publishedAt
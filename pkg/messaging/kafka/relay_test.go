@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -16,26 +17,70 @@ import (
 type fakeOutboxStore struct {
 	items           []messaging.OutboxRecord
 	listErr         error
+	claimedLease    messaging.OutboxLease
 	markedPublished []string
 	markedFailed    []string
+	failedUntil     map[string]time.Time
+	failedAt        map[string]time.Time
+	markedDead      []string
+	markOwners      []string
+	markErr         error
 }
 
 func (f *fakeOutboxStore) Append(ctx context.Context, tx messaging.Tx, record messaging.OutboxRecord) error {
 	return nil
 }
 
-func (f *fakeOutboxStore) ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
+func (f *fakeOutboxStore) ClaimPending(
+	ctx context.Context,
+	lease messaging.OutboxLease,
+	limit int,
+	now time.Time,
+) ([]messaging.OutboxRecord, error) {
+	f.claimedLease = lease
 	return f.items, f.listErr
 }
 
-func (f *fakeOutboxStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
+func (f *fakeOutboxStore) MarkPublished(
+	ctx context.Context,
+	owner string,
+	ids []string,
+	publishedAt time.Time,
+) error {
 	f.markedPublished = append(f.markedPublished, ids...)
-	return nil
+	f.markOwners = append(f.markOwners, owner)
+	return f.markErr
 }
 
-func (f *fakeOutboxStore) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
+func (f *fakeOutboxStore) MarkFailed(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	failedAt time.Time,
+	nextAttemptAt time.Time,
+) error {
 	f.markedFailed = append(f.markedFailed, id)
-	return nil
+	if f.failedUntil == nil {
+		f.failedUntil = map[string]time.Time{}
+		f.failedAt = map[string]time.Time{}
+	}
+	f.failedUntil[id] = nextAttemptAt
+	f.failedAt[id] = failedAt
+	f.markOwners = append(f.markOwners, owner)
+	return f.markErr
+}
+
+func (f *fakeOutboxStore) MarkDead(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	deadAt time.Time,
+) error {
+	f.markedDead = append(f.markedDead, id)
+	f.markOwners = append(f.markOwners, owner)
+	return f.markErr
 }
 
 type fakePublisher struct {
@@ -100,3 +145,102 @@ func TestRelayRunOnce_MarksPublishedAndFailed(t *testing.T) {
 	assert.Equal(t, []string{"1"}, store.markedPublished)
 	assert.Equal(t, []string{"2"}, store.markedFailed)
 }
+
+func TestRelayRunOnce_BacksOffAndMarksDead(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeOutboxStore{
+		items: []messaging.OutboxRecord{
+			{ID: "1", Topic: "podzone.iam.events", MessageKey: "t1", Attempts: 1, Envelope: messaging.Envelope{ID: "evt_1"}},
+			{ID: "2", Topic: "podzone.iam.events", MessageKey: "t2", Attempts: 2, Envelope: messaging.Envelope{ID: "evt_2"}},
+		},
+	}
+	publisher := &fakePublisher{errFor: map[string]error{
+		"evt_1": errors.New("broker down"),
+		"evt_2": errors.New("broker down"),
+	}}
+	relay := NewRelayWithOptions(store, publisher, RelayOptions{
+		Owner:         "relay-a",
+		LeaseDuration: 30 * time.Second,
+		RetryPolicy:   messaging.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute},
+		Now:           func() time.Time { return now },
+	})
+
+	require.NoError(t, relay.RunOnce(context.Background()))
+	assert.Equal(t, messaging.OutboxLease{Owner: "relay-a", ExpiresAt: now.Add(30 * time.Second)}, store.claimedLease)
+	assert.Equal(t, []string{"1"}, store.markedFailed)
+	assert.Equal(t, now.Add(2*time.Second), store.failedUntil["1"])
+	assert.Equal(t, now, store.failedAt["1"])
+	assert.Equal(t, []string{"2"}, store.markedDead)
+	assert.Empty(t, store.markedPublished)
+	assert.Equal(t, []string{"relay-a", "relay-a"}, store.markOwners)
+}
+
+func TestRelayRunOnce_ReportsLostLease(t *testing.T) {
+	store := &fakeOutboxStore{
+		items: []messaging.OutboxRecord{
+			{ID: "1", Topic: "podzone.iam.events", MessageKey: "t1", Envelope: messaging.Envelope{ID: "evt_1"}},
+		},
+		markErr: fmt.Errorf("%w: 1 of 1 rows", messaging.ErrOutboxLeaseLost),
+	}
+	relay := NewRelayWithOptions(store, &fakePublisher{}, RelayOptions{Owner: "relay-a"})
+
+	err := relay.RunOnce(context.Background())
+	require.ErrorIs(t, err, messaging.ErrOutboxLeaseLost)
+	assert.Equal(t, []string{"1"}, store.markedPublished)
+	assert.Equal(t, []string{"relay-a"}, store.markOwners)
+}
+
+func TestRelayRunOnce_MarksSchemaMismatchDead(t *testing.T) {
+	tests := map[string]error{
+		"mismatch":     fmt.Errorf("%w: tenant.created v1: payload.tenant_slug: required", messaging.ErrSchemaMismatch),
+		"unregistered": fmt.Errorf("%w: tenant.renamed", messaging.ErrUnregisteredSchema),
+	}
+	for name, publishErr := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := &fakeOutboxStore{
+				items: []messaging.OutboxRecord{
+					{ID: "1", Topic: "podzone.iam.events", MessageKey: "t1", Envelope: messaging.Envelope{ID: "evt_1"}},
+				},
+			}
+			publisher := &fakePublisher{errFor: map[string]error{"evt_1": publishErr}}
+			relay := NewRelay(store, publisher, 10)
+
+			require.NoError(t, relay.RunOnce(context.Background()))
+			assert.Equal(t, []string{"1"}, store.markedDead)
+			assert.Empty(t, store.markedFailed)
+		})
+	}
+}
+
+type batchOutboxStore struct {
+	fakeOutboxStore
+	batches [][]messaging.OutboxRecord
+	claims  int
+}
+
+func (f *batchOutboxStore) ClaimPending(
+	ctx context.Context,
+	lease messaging.OutboxLease,
+	limit int,
+	now time.Time,
+) ([]messaging.OutboxRecord, error) {
+	f.claims++
+	if len(f.batches) == 0 {
+		return nil, nil
+	}
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return batch, nil
+}
+
+func TestRelayDrain_StopsAfterShortBatch(t *testing.T) {
+	store := &batchOutboxStore{batches: [][]messaging.OutboxRecord{
+		{{ID: "1", Envelope: messaging.Envelope{ID: "evt_1"}}, {ID: "2", Envelope: messaging.Envelope{ID: "evt_2"}}},
+		{{ID: "3", Envelope: messaging.Envelope{ID: "evt_3"}}},
+	}}
+	relay := NewRelayWithOptions(store, &fakePublisher{}, RelayOptions{Limit: 2, Concurrency: 4})
+
+	require.NoError(t, relay.Drain(context.Background()))
+	assert.Equal(t, 2, store.claims)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, store.markedPublished)
+}
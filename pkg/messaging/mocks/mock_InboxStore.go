@@ -40,8 +40,8 @@ func (_m *MockInboxStore) EXPECT() *MockInboxStore_Expecter {
 }
 
 // Begin provides a mock function for the type MockInboxStore
-func (_mock *MockInboxStore) Begin(ctx context.Context, consumerName string, messageID string, now time.Time) (messaging.InboxDecision, error) {
-	ret := _mock.Called(ctx, consumerName, messageID, now)
+func (_mock *MockInboxStore) Begin(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time) (messaging.InboxDecision, error) {
+	ret := _mock.Called(ctx, consumerName, messageID, lease, now)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Begin")
@@ -49,16 +49,16 @@ func (_mock *MockInboxStore) Begin(ctx context.Context, consumerName string, mes
 
 	var r0 messaging.InboxDecision
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (messaging.InboxDecision, error)); ok {
-		return returnFunc(ctx, consumerName, messageID, now)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, messaging.InboxLease, time.Time) (messaging.InboxDecision, error)); ok {
+		return returnFunc(ctx, consumerName, messageID, lease, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) messaging.InboxDecision); ok {
-		r0 = returnFunc(ctx, consumerName, messageID, now)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, messaging.InboxLease, time.Time) messaging.InboxDecision); ok {
+		r0 = returnFunc(ctx, consumerName, messageID, lease, now)
 	} else {
 		r0 = ret.Get(0).(messaging.InboxDecision)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
-		r1 = returnFunc(ctx, consumerName, messageID, now)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, messaging.InboxLease, time.Time) error); ok {
+		r1 = returnFunc(ctx, consumerName, messageID, lease, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,12 +74,13 @@ type MockInboxStore_Begin_Call struct {
 //   - ctx context.Context
 //   - consumerName string
 //   - messageID string
+//   - lease messaging.InboxLease
 //   - now time.Time
-func (_e *MockInboxStore_Expecter) Begin(ctx interface{}, consumerName interface{}, messageID interface{}, now interface{}) *MockInboxStore_Begin_Call {
-	return &MockInboxStore_Begin_Call{Call: _e.mock.On("Begin", ctx, consumerName, messageID, now)}
+func (_e *MockInboxStore_Expecter) Begin(ctx interface{}, consumerName interface{}, messageID interface{}, lease interface{}, now interface{}) *MockInboxStore_Begin_Call {
+	return &MockInboxStore_Begin_Call{Call: _e.mock.On("Begin", ctx, consumerName, messageID, lease, now)}
 }
 
-func (_c *MockInboxStore_Begin_Call) Run(run func(ctx context.Context, consumerName string, messageID string, now time.Time)) *MockInboxStore_Begin_Call {
+func (_c *MockInboxStore_Begin_Call) Run(run func(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time)) *MockInboxStore_Begin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -93,15 +94,20 @@ func (_c *MockInboxStore_Begin_Call) Run(run func(ctx context.Context, consumerN
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 time.Time
+		var arg3 messaging.InboxLease
 		if args[3] != nil {
-			arg3 = args[3].(time.Time)
+			arg3 = args[3].(messaging.InboxLease)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -112,22 +118,22 @@ func (_c *MockInboxStore_Begin_Call) Return(inboxDecision messaging.InboxDecisio
 	return _c
 }
 
-func (_c *MockInboxStore_Begin_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, now time.Time) (messaging.InboxDecision, error)) *MockInboxStore_Begin_Call {
+func (_c *MockInboxStore_Begin_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time) (messaging.InboxDecision, error)) *MockInboxStore_Begin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Complete provides a mock function for the type MockInboxStore
-func (_mock *MockInboxStore) Complete(ctx context.Context, consumerName string, messageID string, processedAt time.Time) error {
-	ret := _mock.Called(ctx, consumerName, messageID, processedAt)
+func (_mock *MockInboxStore) Complete(ctx context.Context, consumerName string, messageID string, owner string, processedAt time.Time) error {
+	ret := _mock.Called(ctx, consumerName, messageID, owner, processedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Complete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
-		r0 = returnFunc(ctx, consumerName, messageID, processedAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, consumerName, messageID, owner, processedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -143,12 +149,13 @@ type MockInboxStore_Complete_Call struct {
 //   - ctx context.Context
 //   - consumerName string
 //   - messageID string
+//   - owner string
 //   - processedAt time.Time
-func (_e *MockInboxStore_Expecter) Complete(ctx interface{}, consumerName interface{}, messageID interface{}, processedAt interface{}) *MockInboxStore_Complete_Call {
-	return &MockInboxStore_Complete_Call{Call: _e.mock.On("Complete", ctx, consumerName, messageID, processedAt)}
+func (_e *MockInboxStore_Expecter) Complete(ctx interface{}, consumerName interface{}, messageID interface{}, owner interface{}, processedAt interface{}) *MockInboxStore_Complete_Call {
+	return &MockInboxStore_Complete_Call{Call: _e.mock.On("Complete", ctx, consumerName, messageID, owner, processedAt)}
 }
 
-func (_c *MockInboxStore_Complete_Call) Run(run func(ctx context.Context, consumerName string, messageID string, processedAt time.Time)) *MockInboxStore_Complete_Call {
+func (_c *MockInboxStore_Complete_Call) Run(run func(ctx context.Context, consumerName string, messageID string, owner string, processedAt time.Time)) *MockInboxStore_Complete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -162,15 +169,20 @@ func (_c *MockInboxStore_Complete_Call) Run(run func(ctx context.Context, consum
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 time.Time
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(time.Time)
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -181,22 +193,22 @@ func (_c *MockInboxStore_Complete_Call) Return(err error) *MockInboxStore_Comple
 	return _c
 }
 
-func (_c *MockInboxStore_Complete_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, processedAt time.Time) error) *MockInboxStore_Complete_Call {
+func (_c *MockInboxStore_Complete_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, owner string, processedAt time.Time) error) *MockInboxStore_Complete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Fail provides a mock function for the type MockInboxStore
-func (_mock *MockInboxStore) Fail(ctx context.Context, consumerName string, messageID string, errText string, failedAt time.Time) error {
-	ret := _mock.Called(ctx, consumerName, messageID, errText, failedAt)
+func (_mock *MockInboxStore) Fail(ctx context.Context, consumerName string, messageID string, owner string, errText string, failedAt time.Time) error {
+	ret := _mock.Called(ctx, consumerName, messageID, owner, errText, failedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Fail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
-		r0 = returnFunc(ctx, consumerName, messageID, errText, failedAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, consumerName, messageID, owner, errText, failedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -212,13 +224,14 @@ type MockInboxStore_Fail_Call struct {
 //   - ctx context.Context
 //   - consumerName string
 //   - messageID string
+//   - owner string
 //   - errText string
 //   - failedAt time.Time
-func (_e *MockInboxStore_Expecter) Fail(ctx interface{}, consumerName interface{}, messageID interface{}, errText interface{}, failedAt interface{}) *MockInboxStore_Fail_Call {
-	return &MockInboxStore_Fail_Call{Call: _e.mock.On("Fail", ctx, consumerName, messageID, errText, failedAt)}
+func (_e *MockInboxStore_Expecter) Fail(ctx interface{}, consumerName interface{}, messageID interface{}, owner interface{}, errText interface{}, failedAt interface{}) *MockInboxStore_Fail_Call {
+	return &MockInboxStore_Fail_Call{Call: _e.mock.On("Fail", ctx, consumerName, messageID, owner, errText, failedAt)}
 }
 
-func (_c *MockInboxStore_Fail_Call) Run(run func(ctx context.Context, consumerName string, messageID string, errText string, failedAt time.Time)) *MockInboxStore_Fail_Call {
+func (_c *MockInboxStore_Fail_Call) Run(run func(ctx context.Context, consumerName string, messageID string, owner string, errText string, failedAt time.Time)) *MockInboxStore_Fail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -236,6 +249,86 @@ func (_c *MockInboxStore_Fail_Call) Run(run func(ctx context.Context, consumerNa
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInboxStore_Fail_Call) Return(err error) *MockInboxStore_Fail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInboxStore_Fail_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, owner string, errText string, failedAt time.Time) error) *MockInboxStore_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Heartbeat provides a mock function for the type MockInboxStore
+func (_mock *MockInboxStore) Heartbeat(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time) error {
+	ret := _mock.Called(ctx, consumerName, messageID, lease, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heartbeat")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, messaging.InboxLease, time.Time) error); ok {
+		r0 = returnFunc(ctx, consumerName, messageID, lease, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInboxStore_Heartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Heartbeat'
+type MockInboxStore_Heartbeat_Call struct {
+	*mock.Call
+}
+
+// Heartbeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - consumerName string
+//   - messageID string
+//   - lease messaging.InboxLease
+//   - now time.Time
+func (_e *MockInboxStore_Expecter) Heartbeat(ctx interface{}, consumerName interface{}, messageID interface{}, lease interface{}, now interface{}) *MockInboxStore_Heartbeat_Call {
+	return &MockInboxStore_Heartbeat_Call{Call: _e.mock.On("Heartbeat", ctx, consumerName, messageID, lease, now)}
+}
+
+func (_c *MockInboxStore_Heartbeat_Call) Run(run func(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time)) *MockInboxStore_Heartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 messaging.InboxLease
+		if args[3] != nil {
+			arg3 = args[3].(messaging.InboxLease)
+		}
 		var arg4 time.Time
 		if args[4] != nil {
 			arg4 = args[4].(time.Time)
@@ -251,12 +344,12 @@ func (_c *MockInboxStore_Fail_Call) Run(run func(ctx context.Context, consumerNa
 	return _c
 }
 
-func (_c *MockInboxStore_Fail_Call) Return(err error) *MockInboxStore_Fail_Call {
+func (_c *MockInboxStore_Heartbeat_Call) Return(err error) *MockInboxStore_Heartbeat_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockInboxStore_Fail_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, errText string, failedAt time.Time) error) *MockInboxStore_Fail_Call {
+func (_c *MockInboxStore_Heartbeat_Call) RunAndReturn(run func(ctx context.Context, consumerName string, messageID string, lease messaging.InboxLease, now time.Time) error) *MockInboxStore_Heartbeat_Call {
 	_c.Call.Return(run)
 	return _c
 }
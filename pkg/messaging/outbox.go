@@ -1,6 +1,21 @@
 package messaging
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusPublished = "published"
+	// OutboxStatusDead means the relay gave up after RetryPolicy.MaxAttempts. A dead record
+	// holds back later records with its message key until an operator redrives it.
+	OutboxStatusDead = "dead"
+)
+
+// ErrOutboxLeaseLost means another relay took over an outbox record after this relay's lease
+// expired; the record is left to the new owner.
+var ErrOutboxLeaseLost = errors.New("messaging: outbox lease lost")
 
 type OutboxRecord struct {
 	ID            string
@@ -15,3 +30,17 @@ type OutboxRecord struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// OutboxLease is a relay's time-bounded claim on the records ClaimPending returned.
+type OutboxLease struct {
+	Owner     string
+	ExpiresAt time.Time
+}
+
+// DeadOutboxFilter narrows OutboxRedriveStore.ListDead. Empty fields match everything.
+type DeadOutboxFilter struct {
+	Topic      string
+	MessageKey string
+	Limit      int
+	Offset     int
+}
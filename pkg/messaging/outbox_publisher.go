@@ -43,7 +43,7 @@ func (p *TransactionalOutboxPublisher) Publish(
 		Topic:         topic,
 		MessageKey:    key,
 		Envelope:      msg.Clone(),
-		Status:        "pending",
+		Status:        OutboxStatusPending,
 		Attempts:      0,
 		NextAttemptAt: now,
 		CreatedAt:     now,
@@ -19,15 +19,42 @@ func (f *fakeTxOutboxStore) Append(ctx context.Context, tx Tx, record OutboxReco
 	return nil
 }
 
-func (f *fakeTxOutboxStore) ListPending(ctx context.Context, limit int) ([]OutboxRecord, error) {
+func (f *fakeTxOutboxStore) ClaimPending(
+	ctx context.Context,
+	lease OutboxLease,
+	limit int,
+	now time.Time,
+) ([]OutboxRecord, error) {
 	return nil, nil
 }
 
-func (f *fakeTxOutboxStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
+func (f *fakeTxOutboxStore) MarkPublished(
+	ctx context.Context,
+	owner string,
+	ids []string,
+	publishedAt time.Time,
+) error {
 	return nil
 }
 
-func (f *fakeTxOutboxStore) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
+func (f *fakeTxOutboxStore) MarkFailed(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	failedAt time.Time,
+	nextAttemptAt time.Time,
+) error {
+	return nil
+}
+
+func (f *fakeTxOutboxStore) MarkDead(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	deadAt time.Time,
+) error {
 	return nil
 }
 
@@ -0,0 +1,8 @@
+// Package outboxadmin exposes a service's dead outbox records over HTTP.
+//
+// A record the relay gave up on holds back every later record with its message
+// key. Operators use this package to find those records, fix what made them fail,
+// and redrive them so the relay publishes them, and the records behind them, in
+// order. Like sagaadmin, the handler does no authorization of its own; services
+// mount it behind their admin-only middleware.
+package outboxadmin
@@ -0,0 +1,143 @@
+package outboxadmin
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// maxBatchSize bounds how many records one redrive request may select.
+const maxBatchSize = 500
+
+type Handler struct {
+	store messaging.OutboxRedriveStore
+	now   func() time.Time
+}
+
+func NewHandler(store messaging.OutboxRedriveStore) *Handler {
+	return &Handler{
+		store: store,
+		now:   func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	outbox := r.Group("/outbox")
+	{
+		outbox.GET("/dead", h.ListDead)
+		outbox.POST("/redrive", h.RedriveDead)
+	}
+}
+
+type recordResponse struct {
+	ID         string             `json:"id"`
+	Topic      string             `json:"topic"`
+	MessageKey string             `json:"message_key,omitempty"`
+	Envelope   messaging.Envelope `json:"envelope"`
+	Attempts   int                `json:"attempts"`
+	Error      string             `json:"error,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type listDeadResponse struct {
+	Items []recordResponse `json:"items"`
+}
+
+type redriveRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1"`
+}
+
+type redriveResult struct {
+	ID    string `json:"id"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+type redriveResponse struct {
+	Results []redriveResult `json:"results"`
+}
+
+// ListDead filters by topic and key (the record's message key).
+func (h *Handler) ListDead(ctx *gin.Context) {
+	filter := messaging.DeadOutboxFilter{
+		Topic:      strings.TrimSpace(ctx.Query("topic")),
+		MessageKey: strings.TrimSpace(ctx.Query("key")),
+	}
+	var err error
+	if filter.Limit, err = intQuery(ctx, "limit"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.Offset, err = intQuery(ctx, "offset"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	records, err := h.store.ListDead(ctx.Request.Context(), filter)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list dead outbox records"})
+		return
+	}
+	items := make([]recordResponse, 0, len(records))
+	for _, record := range records {
+		items = append(items, recordResponse{
+			ID:         record.ID,
+			Topic:      record.Topic,
+			MessageKey: record.MessageKey,
+			Envelope:   record.Envelope,
+			Attempts:   record.Attempts,
+			Error:      record.ErrorText,
+			CreatedAt:  record.CreatedAt,
+			UpdatedAt:  record.UpdatedAt,
+		})
+	}
+	ctx.JSON(http.StatusOK, listDeadResponse{Items: items})
+}
+
+// RedriveDead makes the selected dead records due now. An id that is not a dead record is
+// reported as not redriven.
+func (h *Handler) RedriveDead(ctx *gin.Context) {
+	var req redriveRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.IDs) > maxBatchSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at most " + strconv.Itoa(maxBatchSize) + " ids per request"})
+		return
+	}
+	redriven, err := h.store.RedriveDead(ctx.Request.Context(), req.IDs, h.now())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to redrive dead outbox records"})
+		return
+	}
+	response := redriveResponse{Results: make([]redriveResult, 0, len(req.IDs))}
+	for _, id := range req.IDs {
+		result := redriveResult{ID: id, OK: slices.Contains(redriven, id)}
+		if !result.OK {
+			result.Error = "not a dead outbox record"
+		}
+		response.Results = append(response.Results, result)
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func intQuery(ctx *gin.Context, name string) (int, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, errors.New(name + " must be a non-negative integer")
+	}
+	return value, nil
+}
@@ -0,0 +1,126 @@
+package outboxadmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type fakeStore struct {
+	filter messaging.DeadOutboxFilter
+	dead   map[string]messaging.OutboxRecord
+	ids    []string
+	now    time.Time
+}
+
+func (f *fakeStore) ListDead(
+	ctx context.Context,
+	filter messaging.DeadOutboxFilter,
+) ([]messaging.OutboxRecord, error) {
+	f.filter = filter
+	out := []messaging.OutboxRecord{}
+	for _, record := range f.dead {
+		out = append(out, record)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) RedriveDead(ctx context.Context, ids []string, now time.Time) ([]string, error) {
+	f.ids, f.now = ids, now
+	redriven := []string{}
+	for _, id := range ids {
+		if _, ok := f.dead[id]; ok {
+			redriven = append(redriven, id)
+		}
+	}
+	return redriven, nil
+}
+
+func newTestRouter(store messaging.OutboxRedriveStore, now time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewHandler(store)
+	handler.now = func() time.Time { return now }
+	handler.RegisterRoutes(router.Group("/admin"))
+	return router
+}
+
+func TestHandler_ListDeadParsesFilter(t *testing.T) {
+	store := &fakeStore{dead: map[string]messaging.OutboxRecord{
+		"a": {ID: "a", Topic: "podzone.iam.events", MessageKey: "t1", ErrorText: "broker down", Attempts: 10},
+	}}
+	router := newTestRouter(store, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(
+		http.MethodGet,
+		"/admin/outbox/dead?topic=podzone.iam.events&key=t1&limit=10&offset=20",
+		nil,
+	))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, messaging.DeadOutboxFilter{
+		Topic:      "podzone.iam.events",
+		MessageKey: "t1",
+		Limit:      10,
+		Offset:     20,
+	}, store.filter)
+
+	var body listDeadResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "broker down", body.Items[0].Error)
+	assert.Equal(t, 10, body.Items[0].Attempts)
+}
+
+func TestHandler_ListDeadRejectsBadOffset(t *testing.T) {
+	router := newTestRouter(&fakeStore{}, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/outbox/dead?offset=x", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_RedriveReportsPerIDResults(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeStore{dead: map[string]messaging.OutboxRecord{"a": {ID: "a"}}}
+	router := newTestRouter(store, now)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/outbox/redrive", strings.NewReader(`{"ids":["a","b"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"a", "b"}, store.ids)
+	assert.Equal(t, now, store.now)
+
+	var body redriveResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, []redriveResult{
+		{ID: "a", OK: true},
+		{ID: "b", OK: false, Error: "not a dead outbox record"},
+	}, body.Results)
+}
+
+func TestHandler_RedriveRequiresIDs(t *testing.T) {
+	router := newTestRouter(&fakeStore{}, time.Now())
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/outbox/redrive", strings.NewReader(`{"ids":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
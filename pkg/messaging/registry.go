@@ -3,16 +3,25 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"reflect"
 )
 
 type Registry struct {
 	handlers map[string]Handler
+	schemas  *SchemaRegistry
 }
 
 var _ Handler = (*Registry)(nil)
 
 func NewRegistry(handlers ...TypedHandler) (*Registry, error) {
-	registry := &Registry{handlers: make(map[string]Handler, len(handlers))}
+	return NewRegistryWithSchemas(nil, handlers...)
+}
+
+// NewRegistryWithSchemas upcasts every message with a registered schema to its latest version
+// before dispatch. Handlers that declare a payload type, such as EventHandler, must use the
+// type registered for that latest version.
+func NewRegistryWithSchemas(schemas *SchemaRegistry, handlers ...TypedHandler) (*Registry, error) {
+	registry := &Registry{handlers: make(map[string]Handler, len(handlers)), schemas: schemas}
 	for _, handler := range handlers {
 		if handler == nil {
 			continue
@@ -24,6 +33,9 @@ func NewRegistry(handlers ...TypedHandler) (*Registry, error) {
 		if _, exists := registry.handlers[messageType]; exists {
 			return nil, fmt.Errorf("messaging: duplicate handler for %q", messageType)
 		}
+		if err := checkPayloadType(schemas, handler); err != nil {
+			return nil, err
+		}
 		registry.handlers[messageType] = handler
 	}
 	return registry, nil
@@ -40,5 +52,29 @@ func (r *Registry) Handle(ctx context.Context, msg Envelope) error {
 	if handler == nil {
 		return ErrNilHandler
 	}
-	return handler.Handle(ctx, msg)
+	upcast, err := r.schemas.Upcast(msg)
+	if err != nil {
+		return DeadLetterError(err, invalidPayloadReason(msg.Type))
+	}
+	return handler.Handle(ctx, upcast)
+}
+
+func checkPayloadType(schemas *SchemaRegistry, handler TypedHandler) error {
+	typed, ok := handler.(interface{ PayloadType() reflect.Type })
+	if !ok {
+		return nil
+	}
+	messageType := handler.MessageType()
+	latest, ok := schemas.Latest(messageType)
+	if !ok {
+		return nil
+	}
+	registered, _ := schemas.PayloadType(messageType, latest)
+	if payloadType := typed.PayloadType(); payloadType != registered {
+		return fmt.Errorf(
+			"messaging: handler for %q decodes %s, but v%d is %s",
+			messageType, payloadType, latest, registered,
+		)
+	}
+	return nil
 }
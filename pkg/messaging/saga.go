@@ -2,9 +2,15 @@ package messaging
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// Saga runs steps in memory and compensates completed steps when one fails. It keeps nothing
+// across restarts; long-running workflows should use a SagaDefinition with a SagaOrchestrator.
 type Saga struct {
 	steps []SagaStep
 }
@@ -13,16 +19,181 @@ func NewSaga(steps ...SagaStep) *Saga {
 	return &Saga{steps: append([]SagaStep(nil), steps...)}
 }
 
+// Run returns the failing step's error joined with any compensation errors, so a rollback
+// that did not complete is visible to the caller.
 func (s *Saga) Run(ctx context.Context, msg Envelope) error {
 	completed := make([]SagaStep, 0, len(s.steps))
 	for _, step := range s.steps {
 		if err := step.Execute(ctx, msg); err != nil {
+			errs := []error{fmt.Errorf("saga step %s failed: %w", step.Name(), err)}
 			for i := len(completed) - 1; i >= 0; i-- {
-				_ = completed[i].Compensate(ctx, msg)
+				if cerr := completed[i].Compensate(ctx, msg); cerr != nil {
+					errs = append(errs, fmt.Errorf("compensate saga step %s: %w", completed[i].Name(), cerr))
+				}
 			}
-			return fmt.Errorf("saga step %s failed: %w", step.Name(), err)
+			return errors.Join(errs...)
 		}
 		completed = append(completed, step)
 	}
 	return nil
 }
+
+type SagaStatus string
+
+const (
+	SagaStatusRunning      SagaStatus = "running"
+	SagaStatusCompensating SagaStatus = "compensating"
+	SagaStatusCompleted    SagaStatus = "completed"
+	SagaStatusCompensated  SagaStatus = "compensated"
+	// SagaStatusFailed means compensation gave up after its retry policy; the saga waits for
+	// an operator to resume or abort it.
+	SagaStatusFailed  SagaStatus = "failed"
+	SagaStatusAborted SagaStatus = "aborted"
+)
+
+// Active reports whether the orchestrator still drives the saga on its own.
+func (s SagaStatus) Active() bool {
+	return s == SagaStatusRunning || s == SagaStatusCompensating
+}
+
+// Finished reports whether the saga reached a terminal state.
+func (s SagaStatus) Finished() bool {
+	return s == SagaStatusCompleted || s == SagaStatusCompensated || s == SagaStatusAborted
+}
+
+var (
+	ErrSagaNotFound      = errors.New("messaging: saga not found")
+	ErrSagaExists        = errors.New("messaging: saga already exists")
+	ErrSagaUnknown       = errors.New("messaging: saga definition not registered")
+	ErrSagaNotClaimable  = errors.New("messaging: saga is not due or is leased")
+	ErrSagaConflict      = errors.New("messaging: saga changed concurrently")
+	ErrSagaBusy          = errors.New("messaging: saga step is in flight")
+	ErrSagaFinished      = errors.New("messaging: saga already finished")
+	ErrInvalidDefinition = errors.New("messaging: invalid saga definition")
+)
+
+// SagaAction runs or undoes one saga step. Changes made through state.Store are persisted
+// only when the action succeeds.
+type SagaAction func(ctx context.Context, state *SagaState) error
+
+type SagaStepDefinition struct {
+	Name    string
+	Execute SagaAction
+	// Compensate undoes Execute. Nil means the step has nothing to undo.
+	Compensate SagaAction
+	// Timeout bounds one attempt of Execute or Compensate. Zero uses the orchestrator default.
+	Timeout time.Duration
+	// Retry schedules failed Execute attempts; once exhausted the saga compensates.
+	// A zero MaxAttempts uses the orchestrator default. An error wrapped with
+	// DeadLetterError is permanent and compensates without further attempts.
+	Retry RetryPolicy
+}
+
+type SagaDefinition struct {
+	Name  string
+	Steps []SagaStepDefinition
+	// Timeout bounds the forward path. A running saga past it compensates instead of
+	// starting its next step. Zero means no saga-level deadline.
+	Timeout time.Duration
+}
+
+func (d SagaDefinition) Validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidDefinition)
+	}
+	if len(d.Steps) == 0 {
+		return fmt.Errorf("%w: %s has no steps", ErrInvalidDefinition, d.Name)
+	}
+	seen := make(map[string]struct{}, len(d.Steps))
+	for _, step := range d.Steps {
+		if strings.TrimSpace(step.Name) == "" {
+			return fmt.Errorf("%w: %s has a step without a name", ErrInvalidDefinition, d.Name)
+		}
+		if step.Execute == nil {
+			return fmt.Errorf("%w: %s step %s has no Execute", ErrInvalidDefinition, d.Name, step.Name)
+		}
+		if _, dup := seen[step.Name]; dup {
+			return fmt.Errorf("%w: %s has duplicate step %s", ErrInvalidDefinition, d.Name, step.Name)
+		}
+		seen[step.Name] = struct{}{}
+	}
+	return nil
+}
+
+// SagaInstance is the persisted state of one saga run. CurrentStep indexes the definition's
+// steps: the next step to execute while running, the next step to undo while compensating.
+// Attempts counts claimed attempts of that step, including ones lost to a crash, and resets
+// when the saga moves on. Version guards every write against concurrent updates.
+type SagaInstance struct {
+	ID            string
+	Definition    string
+	Status        SagaStatus
+	CurrentStep   int
+	Attempts      int
+	Envelope      Envelope
+	Data          json.RawMessage
+	ErrorText     string
+	NextAttemptAt time.Time
+	// DeadlineAt is the saga-level deadline from SagaDefinition.Timeout.
+	DeadlineAt *time.Time
+	// LeaseOwner and LeaseExpiresAt mark an attempt in flight; the lease expiry is the
+	// attempt's deadline.
+	LeaseOwner     string
+	LeaseExpiresAt *time.Time
+	Version        int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	FinishedAt     *time.Time
+}
+
+func (i SagaInstance) leased(now time.Time) bool {
+	return i.LeaseOwner != "" && i.LeaseExpiresAt != nil && i.LeaseExpiresAt.After(now)
+}
+
+// SagaLease is an orchestrator's claim on one attempt of a saga step.
+type SagaLease struct {
+	Owner     string
+	ExpiresAt time.Time
+}
+
+// SagaFilter narrows SagaStore.List. Empty fields match everything.
+type SagaFilter struct {
+	Definition string
+	Statuses   []SagaStatus
+	// StuckBefore keeps sagas not updated since then.
+	StuckBefore *time.Time
+	Limit       int
+	Offset      int
+}
+
+// SagaState is what a SagaAction sees of its saga.
+type SagaState struct {
+	SagaID     string
+	Definition string
+	Step       string
+	Attempt    int
+	// Envelope is the message that started the saga.
+	Envelope Envelope
+	// Failure is the error text that made the saga compensate, or of the last failed
+	// compensation attempt. It is empty while the saga runs forward.
+	Failure string
+	data    json.RawMessage
+}
+
+// Decode unmarshals the saga's data into v. It leaves v untouched when there is no data.
+func (s *SagaState) Decode(v any) error {
+	if len(s.data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(s.data, v)
+}
+
+// Store replaces the saga's data, letting later steps and compensations see what this step did.
+func (s *SagaState) Store(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode saga data: %w", err)
+	}
+	s.data = data
+	return nil
+}
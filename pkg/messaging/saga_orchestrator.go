@@ -0,0 +1,482 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SagaAdvanceMessageType is the command the orchestrator publishes to run a saga's next attempt.
+const SagaAdvanceMessageType = "saga.advance"
+
+// sagaLeaseGrace keeps an attempt's lease a little past its timeout so the result can be saved
+// before another orchestrator may take the saga over.
+const sagaLeaseGrace = 10 * time.Second
+
+var (
+	ErrNilSagaStore       = errors.New("messaging: nil saga store")
+	ErrSagaTopicRequired  = errors.New("messaging: saga topic is required")
+	ErrSagaSourceRequired = errors.New("messaging: saga source is required")
+)
+
+type SagaOrchestratorOptions struct {
+	// Topic carries saga.advance commands. The service consumes it and routes the commands
+	// to the orchestrator, which is a TypedHandler. Required with a Publisher; without one
+	// the service drives sagas with RunDue alone.
+	Topic string
+	// Source is the Envelope.Source of the commands, usually the service name.
+	Source string
+	// Owner names this process in saga leases. Defaults to DefaultLeaseOwner.
+	Owner string
+	// StepTimeout bounds an attempt of a step without its own Timeout. Defaults to 30 seconds.
+	StepTimeout time.Duration
+	// StepRetry schedules failed steps without their own Retry policy.
+	StepRetry RetryPolicy
+	// CompensationRetry schedules failed compensations; once exhausted the saga is marked
+	// failed for an operator. Defaults to 10 attempts backing off up to 5 minutes.
+	CompensationRetry RetryPolicy
+	// BatchSize is how many due sagas RunDue picks up per call. Defaults to 100.
+	BatchSize int
+	Now       func() time.Time
+}
+
+// SagaOrchestrator drives persisted sagas one step attempt at a time. Each attempt is triggered
+// by a saga.advance command published through Publisher, or by RunDue for sagas whose retry
+// backoff elapsed, whose command was lost, or whose orchestrator died mid-attempt.
+type SagaOrchestrator struct {
+	store             SagaStore
+	publisher         Publisher
+	definitions       map[string]SagaDefinition
+	topic             string
+	source            string
+	owner             string
+	stepTimeout       time.Duration
+	stepRetry         RetryPolicy
+	compensationRetry RetryPolicy
+	batchSize         int
+	now               func() time.Time
+}
+
+var _ TypedHandler = (*SagaOrchestrator)(nil)
+
+type sagaAdvancePayload struct {
+	SagaID string `json:"saga_id"`
+}
+
+func NewSagaOrchestrator(
+	store SagaStore,
+	publisher Publisher,
+	opts SagaOrchestratorOptions,
+	definitions ...SagaDefinition,
+) (*SagaOrchestrator, error) {
+	if store == nil {
+		return nil, ErrNilSagaStore
+	}
+	if publisher != nil && strings.TrimSpace(opts.Topic) == "" {
+		return nil, ErrSagaTopicRequired
+	}
+	if strings.TrimSpace(opts.Source) == "" {
+		return nil, ErrSagaSourceRequired
+	}
+	if strings.TrimSpace(opts.Owner) == "" {
+		opts.Owner = DefaultLeaseOwner()
+	}
+	if opts.StepTimeout <= 0 {
+		opts.StepTimeout = 30 * time.Second
+	}
+	if opts.CompensationRetry.MaxAttempts <= 0 {
+		opts.CompensationRetry = RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: 5 * time.Minute}
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Now == nil {
+		opts.Now = func() time.Time { return time.Now().UTC() }
+	}
+
+	registered := make(map[string]SagaDefinition, len(definitions))
+	for _, definition := range definitions {
+		if err := definition.Validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := registered[definition.Name]; exists {
+			return nil, fmt.Errorf("%w: duplicate saga %q", ErrInvalidDefinition, definition.Name)
+		}
+		registered[definition.Name] = definition
+	}
+
+	return &SagaOrchestrator{
+		store:             store,
+		publisher:         publisher,
+		definitions:       registered,
+		topic:             opts.Topic,
+		source:            opts.Source,
+		owner:             opts.Owner,
+		stepTimeout:       opts.StepTimeout,
+		stepRetry:         opts.StepRetry.Normalize(),
+		compensationRetry: opts.CompensationRetry.Normalize(),
+		batchSize:         opts.BatchSize,
+		now:               opts.Now,
+	}, nil
+}
+
+// SagaStart describes a new saga run. ID defaults to the envelope ID, so starting a saga from
+// a redelivered message returns ErrSagaExists instead of running it twice.
+type SagaStart struct {
+	ID       string
+	Envelope Envelope
+	// Data seeds the saga data steps read with SagaState.Decode.
+	Data any
+}
+
+// Start persists a running saga and publishes its first saga.advance command. A publish failure
+// is not returned: the saga is already due, so RunDue starts it.
+func (o *SagaOrchestrator) Start(ctx context.Context, definition string, start SagaStart) (SagaInstance, error) {
+	def, ok := o.definitions[definition]
+	if !ok {
+		return SagaInstance{}, fmt.Errorf("%w: %q", ErrSagaUnknown, definition)
+	}
+	id := strings.TrimSpace(start.ID)
+	if id == "" {
+		id = start.Envelope.ID
+	}
+	if id == "" {
+		return SagaInstance{}, ErrEmptyEnvelopeID
+	}
+
+	var data json.RawMessage
+	if start.Data != nil {
+		encoded, err := json.Marshal(start.Data)
+		if err != nil {
+			return SagaInstance{}, fmt.Errorf("encode saga data: %w", err)
+		}
+		data = encoded
+	}
+
+	now := o.now()
+	instance := SagaInstance{
+		ID:            id,
+		Definition:    def.Name,
+		Status:        SagaStatusRunning,
+		Envelope:      start.Envelope.Clone(),
+		Data:          data,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if def.Timeout > 0 {
+		deadline := now.Add(def.Timeout)
+		instance.DeadlineAt = &deadline
+	}
+	if err := o.store.Create(ctx, instance); err != nil {
+		return SagaInstance{}, err
+	}
+	_ = o.publishAdvance(ctx, instance)
+	return instance, nil
+}
+
+func (o *SagaOrchestrator) MessageType() string {
+	return SagaAdvanceMessageType
+}
+
+// Handle runs one attempt for the saga named in a saga.advance command. Commands for sagas
+// that are finished, leased or not yet due are acknowledged without doing anything; step
+// failures are recorded on the saga and only store and publish errors are returned.
+func (o *SagaOrchestrator) Handle(ctx context.Context, msg Envelope) error {
+	var payload sagaAdvancePayload
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return fmt.Errorf("decode saga.advance payload: %w", err)
+	}
+	err := o.advance(ctx, payload.SagaID)
+	if errors.Is(err, ErrSagaNotFound) {
+		return nil
+	}
+	return err
+}
+
+// RunDue advances sagas that are due and not leased. It returns ErrNoMessages when there
+// were none.
+func (o *SagaOrchestrator) RunDue(ctx context.Context) error {
+	ids, err := o.store.ListDue(ctx, o.now(), o.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return ErrNoMessages
+	}
+	var errs []error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := o.advance(ctx, id); err != nil && !errors.Is(err, ErrSagaNotFound) {
+			errs = append(errs, fmt.Errorf("advance saga %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (o *SagaOrchestrator) ListSagas(ctx context.Context, filter SagaFilter) ([]SagaInstance, error) {
+	return o.store.List(ctx, filter)
+}
+
+func (o *SagaOrchestrator) GetSaga(ctx context.Context, id string) (SagaInstance, error) {
+	return o.store.Get(ctx, id)
+}
+
+// ResumeSaga makes a saga due now with a fresh attempt budget. A failed saga resumes its
+// compensation; a running or compensating saga skips the rest of its backoff.
+func (o *SagaOrchestrator) ResumeSaga(ctx context.Context, id string) (SagaInstance, error) {
+	instance, err := o.idleSaga(ctx, id)
+	if err != nil {
+		return SagaInstance{}, err
+	}
+	if instance.Status == SagaStatusFailed {
+		instance.Status = SagaStatusCompensating
+	}
+	instance.Attempts = 0
+	instance.NextAttemptAt = o.now()
+	if err := o.save(ctx, &instance); err != nil {
+		return SagaInstance{}, err
+	}
+	_ = o.publishAdvance(ctx, instance)
+	return instance, nil
+}
+
+// AbortSaga stops a saga where it is, without compensating. Use it once an operator has dealt
+// with the saga's side effects by hand.
+func (o *SagaOrchestrator) AbortSaga(ctx context.Context, id string, reason string) (SagaInstance, error) {
+	instance, err := o.idleSaga(ctx, id)
+	if err != nil {
+		return SagaInstance{}, err
+	}
+	now := o.now()
+	instance.Status = SagaStatusAborted
+	instance.ErrorText = "aborted: " + strings.TrimSpace(reason)
+	instance.FinishedAt = &now
+	if err := o.save(ctx, &instance); err != nil {
+		return SagaInstance{}, err
+	}
+	return instance, nil
+}
+
+func (o *SagaOrchestrator) idleSaga(ctx context.Context, id string) (SagaInstance, error) {
+	instance, err := o.store.Get(ctx, id)
+	if err != nil {
+		return SagaInstance{}, err
+	}
+	if instance.Status.Finished() {
+		return SagaInstance{}, ErrSagaFinished
+	}
+	if instance.leased(o.now()) {
+		return SagaInstance{}, ErrSagaBusy
+	}
+	return instance, nil
+}
+
+func (o *SagaOrchestrator) advance(ctx context.Context, id string) error {
+	now := o.now()
+	current, err := o.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !current.Status.Active() || current.NextAttemptAt.After(now) || current.leased(now) {
+		return nil
+	}
+	def, ok := o.definitions[current.Definition]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrSagaUnknown, current.Definition)
+	}
+	if current.CurrentStep < 0 || current.CurrentStep >= len(def.Steps) {
+		return fmt.Errorf("saga %s: step %d out of range", id, current.CurrentStep)
+	}
+
+	lease := SagaLease{
+		Owner:     o.owner,
+		ExpiresAt: now.Add(o.timeout(def.Steps[current.CurrentStep]) + sagaLeaseGrace),
+	}
+	instance, err := o.store.Claim(ctx, id, lease, now)
+	if errors.Is(err, ErrSagaNotClaimable) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := o.attempt(ctx, def, &instance); err != nil {
+		return err
+	}
+
+	err = o.save(ctx, &instance)
+	if errors.Is(err, ErrSagaConflict) {
+		// The lease expired and another orchestrator took the saga over.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if instance.Status.Active() && !instance.NextAttemptAt.After(o.now()) {
+		return o.publishAdvance(ctx, instance)
+	}
+	return nil
+}
+
+// attempt runs the claimed step, or its compensation, and records the outcome on instance. It
+// only returns an error when ctx was cancelled; the lease then expires and the attempt is
+// retried elsewhere.
+func (o *SagaOrchestrator) attempt(ctx context.Context, def SagaDefinition, instance *SagaInstance) error {
+	now := o.now()
+	if instance.Status == SagaStatusRunning && instance.DeadlineAt != nil && !now.Before(*instance.DeadlineAt) {
+		o.compensate(def, instance, "saga deadline exceeded", now)
+		return nil
+	}
+
+	step := def.Steps[instance.CurrentStep]
+	action, policy := step.Execute, o.retry(step)
+	if instance.Status == SagaStatusCompensating {
+		action, policy = step.Compensate, o.compensationRetry
+	}
+
+	var err error
+	state := &SagaState{
+		SagaID:     instance.ID,
+		Definition: instance.Definition,
+		Step:       step.Name,
+		Attempt:    instance.Attempts,
+		Envelope:   instance.Envelope.Clone(),
+		data:       instance.Data,
+	}
+	if instance.Status == SagaStatusCompensating {
+		state.Failure = instance.ErrorText
+	}
+	if policy.Exhausted(instance.Attempts - 1) {
+		// Earlier attempts used up the budget without recording a result, so the
+		// orchestrator running them died or overran its lease.
+		err = errors.New("attempts exhausted without a result")
+	} else {
+		err = o.run(ctx, step, action, state)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	now = o.now()
+	if err != nil {
+		instance.ErrorText = fmt.Sprintf("%s: %v", step.Name, err)
+		permanent := ClassifyError(err).Action == FailureActionDeadLetter
+		switch {
+		case !permanent && !policy.Exhausted(instance.Attempts):
+			instance.NextAttemptAt = now.Add(policy.NextDelay(instance.Attempts))
+		case instance.Status == SagaStatusRunning:
+			o.compensate(def, instance, instance.ErrorText, now)
+		default:
+			instance.Status = SagaStatusFailed
+		}
+		return nil
+	}
+
+	instance.Data = state.data
+	instance.Attempts = 0
+	instance.NextAttemptAt = now
+	if instance.Status == SagaStatusRunning {
+		instance.ErrorText = ""
+		instance.CurrentStep++
+		if instance.CurrentStep == len(def.Steps) {
+			instance.CurrentStep = len(def.Steps) - 1
+			instance.Status = SagaStatusCompleted
+			instance.FinishedAt = &now
+		}
+		return nil
+	}
+	o.moveToCompensable(def, instance, instance.CurrentStep-1, now)
+	return nil
+}
+
+// compensate switches a running saga to undoing the steps it completed.
+func (o *SagaOrchestrator) compensate(def SagaDefinition, instance *SagaInstance, reason string, now time.Time) {
+	instance.Status = SagaStatusCompensating
+	instance.ErrorText = reason
+	instance.Attempts = 0
+	instance.NextAttemptAt = now
+	o.moveToCompensable(def, instance, instance.CurrentStep-1, now)
+}
+
+// moveToCompensable points the saga at the nearest step at or before from that has a
+// Compensate, or marks it compensated when none is left.
+func (o *SagaOrchestrator) moveToCompensable(def SagaDefinition, instance *SagaInstance, from int, now time.Time) {
+	for step := from; step >= 0; step-- {
+		if def.Steps[step].Compensate != nil {
+			instance.CurrentStep = step
+			return
+		}
+	}
+	instance.CurrentStep = 0
+	instance.Status = SagaStatusCompensated
+	instance.FinishedAt = &now
+}
+
+func (o *SagaOrchestrator) run(
+	ctx context.Context,
+	step SagaStepDefinition,
+	action SagaAction,
+	state *SagaState,
+) error {
+	timeout := o.timeout(step)
+	stepCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := action(stepCtx, state)
+	if err != nil && ctx.Err() == nil && errors.Is(stepCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s: %w", timeout, err)
+	}
+	return err
+}
+
+func (o *SagaOrchestrator) save(ctx context.Context, instance *SagaInstance) error {
+	instance.LeaseOwner = ""
+	instance.LeaseExpiresAt = nil
+	instance.UpdatedAt = o.now()
+	if err := o.store.Save(ctx, *instance); err != nil {
+		return err
+	}
+	instance.Version++
+	return nil
+}
+
+func (o *SagaOrchestrator) publishAdvance(ctx context.Context, instance SagaInstance) error {
+	if o.publisher == nil {
+		return nil
+	}
+	payload, err := json.Marshal(sagaAdvancePayload{SagaID: instance.ID})
+	if err != nil {
+		return err
+	}
+	return o.publisher.Publish(ctx, o.topic, instance.ID, Envelope{
+		ID:            fmt.Sprintf("%s:%d", instance.ID, instance.Version),
+		Type:          SagaAdvanceMessageType,
+		Source:        o.source,
+		TenantID:      instance.Envelope.TenantID,
+		EntityID:      instance.ID,
+		CorrelationID: instance.Envelope.CorrelationID,
+		CausationID:   instance.Envelope.ID,
+		OccurredAt:    o.now(),
+		SchemaVersion: 1,
+		Payload:       payload,
+	})
+}
+
+func (o *SagaOrchestrator) timeout(step SagaStepDefinition) time.Duration {
+	if step.Timeout > 0 {
+		return step.Timeout
+	}
+	return o.stepTimeout
+}
+
+func (o *SagaOrchestrator) retry(step SagaStepDefinition) RetryPolicy {
+	if step.Retry.MaxAttempts > 0 {
+		return step.Retry.Normalize()
+	}
+	return o.stepRetry
+}
@@ -0,0 +1,490 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memorySagaStore struct {
+	sagas map[string]SagaInstance
+}
+
+func newMemorySagaStore() *memorySagaStore {
+	return &memorySagaStore{sagas: map[string]SagaInstance{}}
+}
+
+func (m *memorySagaStore) Create(ctx context.Context, instance SagaInstance) error {
+	if _, exists := m.sagas[instance.ID]; exists {
+		return ErrSagaExists
+	}
+	m.sagas[instance.ID] = instance
+	return nil
+}
+
+func (m *memorySagaStore) Get(ctx context.Context, id string) (SagaInstance, error) {
+	instance, ok := m.sagas[id]
+	if !ok {
+		return SagaInstance{}, ErrSagaNotFound
+	}
+	return instance, nil
+}
+
+func (m *memorySagaStore) List(ctx context.Context, filter SagaFilter) ([]SagaInstance, error) {
+	out := []SagaInstance{}
+	for _, instance := range m.sagas {
+		out = append(out, instance)
+	}
+	return out, nil
+}
+
+func (m *memorySagaStore) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ids := []string{}
+	for id, instance := range m.sagas {
+		if instance.Status.Active() && !instance.NextAttemptAt.After(now) && !instance.leased(now) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (m *memorySagaStore) Claim(ctx context.Context, id string, lease SagaLease, now time.Time) (SagaInstance, error) {
+	instance, ok := m.sagas[id]
+	if !ok || !instance.Status.Active() || instance.NextAttemptAt.After(now) || instance.leased(now) {
+		return SagaInstance{}, ErrSagaNotClaimable
+	}
+	instance.LeaseOwner = lease.Owner
+	instance.LeaseExpiresAt = &lease.ExpiresAt
+	instance.Attempts++
+	instance.Version++
+	m.sagas[id] = instance
+	return instance, nil
+}
+
+func (m *memorySagaStore) Save(ctx context.Context, instance SagaInstance) error {
+	current, ok := m.sagas[instance.ID]
+	if !ok || current.Version != instance.Version {
+		return ErrSagaConflict
+	}
+	instance.LeaseOwner = ""
+	instance.LeaseExpiresAt = nil
+	instance.Version++
+	m.sagas[instance.ID] = instance
+	return nil
+}
+
+type recordingPublisher struct {
+	published []Envelope
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, topic string, key string, msg Envelope) error {
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *recordingPublisher) PublishBatch(ctx context.Context, topic string, msgs []PublishRequest) error {
+	return nil
+}
+
+type sagaClock struct {
+	now time.Time
+}
+
+func (c *sagaClock) Now() time.Time { return c.now }
+
+type sagaHarness struct {
+	store     *memorySagaStore
+	publisher *recordingPublisher
+	clock     *sagaClock
+	saga      *SagaOrchestrator
+	calls     []string
+}
+
+func newSagaHarness(t *testing.T, steps func(h *sagaHarness) []SagaStepDefinition) *sagaHarness {
+	t.Helper()
+	h := &sagaHarness{
+		store:     newMemorySagaStore(),
+		publisher: &recordingPublisher{},
+		clock:     &sagaClock{now: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	saga, err := NewSagaOrchestrator(h.store, h.publisher, SagaOrchestratorOptions{
+		Topic:             "podzone.onboarding.sagas",
+		Source:            "onboarding",
+		Owner:             "worker-a",
+		StepRetry:         RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second},
+		CompensationRetry: RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second},
+		Now:               h.clock.Now,
+	}, SagaDefinition{Name: "provision-store", Steps: steps(h), Timeout: time.Hour})
+	require.NoError(t, err)
+	h.saga = saga
+	return h
+}
+
+func (h *sagaHarness) action(name string, err error) SagaAction {
+	return func(ctx context.Context, state *SagaState) error {
+		h.calls = append(h.calls, name)
+		return err
+	}
+}
+
+// drain delivers published saga.advance commands until none are left.
+func (h *sagaHarness) drain(t *testing.T) {
+	t.Helper()
+	for len(h.publisher.published) > 0 {
+		msg := h.publisher.published[0]
+		h.publisher.published = h.publisher.published[1:]
+		require.NoError(t, h.saga.Handle(context.Background(), msg))
+	}
+}
+
+func TestSagaOrchestrator_RunsStepsThroughCommands(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{
+				Name: "reserve",
+				Execute: func(ctx context.Context, state *SagaState) error {
+					h.calls = append(h.calls, "reserve")
+					return state.Store(map[string]string{"cluster": "c1"})
+				},
+			},
+			{
+				Name: "route",
+				Execute: func(ctx context.Context, state *SagaState) error {
+					var data map[string]string
+					require.NoError(t, state.Decode(&data))
+					h.calls = append(h.calls, "route:"+data["cluster"])
+					return nil
+				},
+			},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	require.Len(t, h.publisher.published, 1)
+	assert.Equal(t, SagaAdvanceMessageType, h.publisher.published[0].Type)
+
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "evt_1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reserve", "route:c1"}, h.calls)
+	assert.Equal(t, SagaStatusCompleted, instance.Status)
+	assert.NotNil(t, instance.FinishedAt)
+	assert.JSONEq(t, `{"cluster":"c1"}`, string(instance.Data))
+
+	_, err = h.saga.Start(context.Background(), "provision-store", SagaStart{Envelope: Envelope{ID: "evt_1"}})
+	require.ErrorIs(t, err, ErrSagaExists)
+}
+
+func TestSagaOrchestrator_RetriesThenCompensatesInReverse(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{Name: "reserve", Execute: h.action("reserve", nil), Compensate: h.action("release", nil)},
+			{Name: "notify", Execute: h.action("notify", nil)},
+			{Name: "route", Execute: h.action("route", errors.New("router down"))},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusRunning, instance.Status)
+	assert.Equal(t, 2, instance.CurrentStep)
+	assert.Equal(t, 1, instance.Attempts)
+	assert.Equal(t, h.clock.now.Add(time.Second), instance.NextAttemptAt)
+	assert.Equal(t, "route: router down", instance.ErrorText)
+
+	require.ErrorIs(t, h.saga.RunDue(context.Background()), ErrNoMessages)
+	h.clock.now = h.clock.now.Add(time.Second)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err = h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	// notify has no compensation and is skipped.
+	assert.Equal(t, []string{"reserve", "notify", "route", "route", "release"}, h.calls)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+}
+
+func TestSagaOrchestrator_FailedCompensationWaitsForResume(t *testing.T) {
+	releaseErr := errors.New("release failed")
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{
+				Name:    "reserve",
+				Execute: h.action("reserve", nil),
+				Compensate: func(ctx context.Context, state *SagaState) error {
+					h.calls = append(h.calls, "release")
+					return releaseErr
+				},
+			},
+			{Name: "route", Execute: h.action("route", errors.New("boom")), Retry: RetryPolicy{MaxAttempts: 1}},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+	h.clock.now = h.clock.now.Add(time.Second)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusFailed, instance.Status)
+	assert.Equal(t, "reserve: release failed", instance.ErrorText)
+	require.ErrorIs(t, h.saga.RunDue(context.Background()), ErrNoMessages)
+
+	releaseErr = nil
+	resumed, err := h.saga.ResumeSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensating, resumed.Status)
+	h.drain(t)
+
+	instance, err = h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+
+	_, err = h.saga.ResumeSaga(context.Background(), "s1")
+	require.ErrorIs(t, err, ErrSagaFinished)
+}
+
+func TestSagaOrchestrator_StepTimeout(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{{
+			Name:    "slow",
+			Timeout: time.Millisecond,
+			Retry:   RetryPolicy{MaxAttempts: 1},
+			Execute: func(ctx context.Context, state *SagaState) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+	assert.Contains(t, instance.ErrorText, "slow: timed out after 1ms")
+}
+
+func TestSagaOrchestrator_StepTimeoutCompensatesCompletedSteps(t *testing.T) {
+	var failures []string
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{
+				Name:    "reserve",
+				Execute: h.action("reserve", nil),
+				Compensate: func(ctx context.Context, state *SagaState) error {
+					h.calls = append(h.calls, "release")
+					failures = append(failures, state.Failure)
+					return nil
+				},
+			},
+			{Name: "notify", Execute: h.action("notify", nil), Compensate: h.action("unnotify", nil)},
+			{
+				Name:    "route",
+				Timeout: time.Millisecond,
+				Execute: func(ctx context.Context, state *SagaState) error {
+					h.calls = append(h.calls, "route")
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusRunning, instance.Status, "a timed out attempt is retried first")
+	assert.Equal(t, 2, instance.CurrentStep)
+	assert.Contains(t, instance.ErrorText, "route: timed out after 1ms")
+
+	h.clock.now = h.clock.now.Add(time.Second)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err = h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reserve", "notify", "route", "route", "unnotify", "release"}, h.calls)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+	assert.Contains(t, instance.ErrorText, "route: timed out after 1ms", "the failure stays visible once compensated")
+	require.Len(t, failures, 1)
+	assert.Contains(t, failures[0], "route: timed out after 1ms")
+}
+
+func TestSagaOrchestrator_CompensationTimeoutFailsForOperator(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{
+				Name:    "reserve",
+				Timeout: time.Millisecond,
+				Execute: h.action("reserve", nil),
+				Compensate: func(ctx context.Context, state *SagaState) error {
+					h.calls = append(h.calls, "release")
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			{Name: "route", Execute: h.action("route", errors.New("router down")), Retry: RetryPolicy{MaxAttempts: 1}},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+	h.clock.now = h.clock.now.Add(time.Second)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reserve", "route", "release", "release"}, h.calls)
+	assert.Equal(t, SagaStatusFailed, instance.Status)
+	assert.Contains(t, instance.ErrorText, "reserve: timed out after 1ms")
+	assert.Nil(t, instance.FinishedAt, "a failed saga waits for an operator")
+}
+
+func TestSagaOrchestrator_DeadlineCompensatesInsteadOfNextStep(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{Name: "reserve", Execute: h.action("reserve", nil), Compensate: h.action("release", nil)},
+			{Name: "route", Execute: h.action("route", nil)},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.publisher.published = nil
+
+	h.clock.now = h.clock.now.Add(time.Hour)
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reserve", "release"}, h.calls)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+	assert.Equal(t, "saga deadline exceeded", instance.ErrorText)
+}
+
+func TestSagaOrchestrator_PermanentErrorSkipsRetries(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{Name: "reserve", Execute: h.action("reserve", nil), Compensate: h.action("release", nil)},
+			{
+				Name:    "route",
+				Execute: h.action("route", DeadLetterError(errors.New("unsupported runtime"), "invalid placement")),
+				Retry:   RetryPolicy{MaxAttempts: 5, BaseDelay: time.Minute},
+			},
+		}
+	})
+
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reserve", "route", "release"}, h.calls)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+	assert.Equal(t, "route: invalid placement: unsupported runtime", instance.ErrorText)
+}
+
+func TestSagaOrchestrator_LostAttemptCountsAgainstRetries(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{
+			{Name: "reserve", Execute: h.action("reserve", nil), Compensate: h.action("release", nil)},
+			{Name: "route", Execute: h.action("route", nil)},
+		}
+	})
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+	h.publisher.published = nil
+
+	// Two orchestrators die mid-attempt; their leases expire unsaved.
+	for range 2 {
+		_, err = h.store.Claim(context.Background(), "s1", SagaLease{Owner: "dead", ExpiresAt: h.clock.now}, h.clock.now)
+		require.NoError(t, err)
+	}
+	require.NoError(t, h.saga.RunDue(context.Background()))
+	h.drain(t)
+
+	instance, err := h.saga.GetSaga(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Empty(t, h.calls)
+	assert.Equal(t, SagaStatusCompensated, instance.Status)
+	assert.Equal(t, "reserve: attempts exhausted without a result", instance.ErrorText)
+}
+
+func TestSagaOrchestrator_AbortSkipsLeasedSagas(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{{Name: "reserve", Execute: h.action("reserve", nil)}}
+	})
+	_, err := h.saga.Start(context.Background(), "provision-store", SagaStart{ID: "s1", Envelope: Envelope{ID: "evt_1"}})
+	require.NoError(t, err)
+
+	_, err = h.store.Claim(context.Background(), "s1", SagaLease{
+		Owner:     "worker-b",
+		ExpiresAt: h.clock.now.Add(time.Minute),
+	}, h.clock.now)
+	require.NoError(t, err)
+	_, err = h.saga.AbortSaga(context.Background(), "s1", "manual cleanup")
+	require.ErrorIs(t, err, ErrSagaBusy)
+
+	h.clock.now = h.clock.now.Add(2 * time.Minute)
+	aborted, err := h.saga.AbortSaga(context.Background(), "s1", "manual cleanup")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusAborted, aborted.Status)
+	assert.Equal(t, "aborted: manual cleanup", aborted.ErrorText)
+
+	h.drain(t)
+	assert.Empty(t, h.calls)
+}
+
+func TestNewSagaOrchestrator_RejectsInvalidDefinitions(t *testing.T) {
+	opts := SagaOrchestratorOptions{Topic: "podzone.onboarding.sagas", Source: "onboarding"}
+	_, err := NewSagaOrchestrator(newMemorySagaStore(), nil, opts, SagaDefinition{Name: "empty"})
+	require.ErrorIs(t, err, ErrInvalidDefinition)
+
+	step := SagaStepDefinition{Name: "one", Execute: func(context.Context, *SagaState) error { return nil }}
+	_, err = NewSagaOrchestrator(newMemorySagaStore(), nil, opts, SagaDefinition{
+		Name:  "dup",
+		Steps: []SagaStepDefinition{step, step},
+	})
+	require.ErrorIs(t, err, ErrInvalidDefinition)
+
+	publisher := &recordingPublisher{}
+	_, err = NewSagaOrchestrator(newMemorySagaStore(), publisher, SagaOrchestratorOptions{Source: "onboarding"})
+	require.ErrorIs(t, err, ErrSagaTopicRequired)
+	_, err = NewSagaOrchestrator(newMemorySagaStore(), nil, SagaOrchestratorOptions{Source: "onboarding"})
+	require.NoError(t, err, "a topic is only needed to publish saga.advance commands")
+}
+
+func TestSagaOrchestrator_HandleIgnoresUnknownSaga(t *testing.T) {
+	h := newSagaHarness(t, func(h *sagaHarness) []SagaStepDefinition {
+		return []SagaStepDefinition{{Name: "reserve", Execute: h.action("reserve", nil)}}
+	})
+	payload, err := json.Marshal(sagaAdvancePayload{SagaID: "missing"})
+	require.NoError(t, err)
+
+	require.NoError(t, h.saga.Handle(context.Background(), Envelope{Type: SagaAdvanceMessageType, Payload: payload}))
+}
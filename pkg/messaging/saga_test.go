@@ -42,3 +42,31 @@ func TestSagaCompensatesCompletedSteps(t *testing.T) {
 	assert.Equal(t, []string{"one", "two", "three"}, executed)
 	assert.Equal(t, []string{"two", "one"}, compensated)
 }
+
+func TestSagaReturnsCompensationErrors(t *testing.T) {
+	executed := make([]string, 0, 2)
+	compensated := make([]string, 0, 1)
+	undoErr := errors.New("undo failed")
+	saga := NewSaga(
+		&failingCompensateStep{
+			fakeSagaStep: fakeSagaStep{name: "one", executed: &executed, compensated: &compensated},
+			err:          undoErr,
+		},
+		&fakeSagaStep{name: "two", executeErr: errors.New("boom"), executed: &executed, compensated: &compensated},
+	)
+
+	err := saga.Run(context.Background(), Envelope{ID: "evt_1"})
+	require.ErrorIs(t, err, undoErr)
+	assert.Contains(t, err.Error(), "saga step two failed: boom")
+	assert.Equal(t, []string{"one"}, compensated)
+}
+
+type failingCompensateStep struct {
+	fakeSagaStep
+	err error
+}
+
+func (f *failingCompensateStep) Compensate(ctx context.Context, msg Envelope) error {
+	_ = f.fakeSagaStep.Compensate(ctx, msg)
+	return f.err
+}
@@ -0,0 +1,7 @@
+// Package sagaadmin exposes a SagaOrchestrator's admin operations over HTTP.
+//
+// Operators use it to find sagas that are stuck backing off, failed while
+// compensating, or past their deadline, inspect their persisted state, and resume
+// or abort them. The handler does no authorization of its own; services mount it
+// behind their admin-only middleware.
+package sagaadmin
@@ -0,0 +1,194 @@
+package sagaadmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+// Service is implemented by *messaging.SagaOrchestrator.
+type Service interface {
+	ListSagas(ctx context.Context, filter messaging.SagaFilter) ([]messaging.SagaInstance, error)
+	GetSaga(ctx context.Context, id string) (messaging.SagaInstance, error)
+	ResumeSaga(ctx context.Context, id string) (messaging.SagaInstance, error)
+	AbortSaga(ctx context.Context, id string, reason string) (messaging.SagaInstance, error)
+}
+
+var _ Service = (*messaging.SagaOrchestrator)(nil)
+
+type Handler struct {
+	service Service
+	now     func() time.Time
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		service: service,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	sagas := r.Group("/sagas")
+	{
+		sagas.GET("", h.ListSagas)
+		sagas.GET("/:id", h.GetSaga)
+		sagas.POST("/:id/resume", h.ResumeSaga)
+		sagas.POST("/:id/abort", h.AbortSaga)
+	}
+}
+
+type sagaResponse struct {
+	ID             string             `json:"id"`
+	Definition     string             `json:"definition"`
+	Status         string             `json:"status"`
+	CurrentStep    int                `json:"current_step"`
+	Attempts       int                `json:"attempts"`
+	Envelope       messaging.Envelope `json:"envelope"`
+	Data           json.RawMessage    `json:"data,omitempty"`
+	Error          string             `json:"error,omitempty"`
+	NextAttemptAt  time.Time          `json:"next_attempt_at"`
+	DeadlineAt     *time.Time         `json:"deadline_at,omitempty"`
+	LeaseOwner     string             `json:"lease_owner,omitempty"`
+	LeaseExpiresAt *time.Time         `json:"lease_expires_at,omitempty"`
+	Version        int                `json:"version"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	FinishedAt     *time.Time         `json:"finished_at,omitempty"`
+}
+
+type listSagasResponse struct {
+	Items []sagaResponse `json:"items"`
+}
+
+type abortSagaRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// ListSagas filters by definition, status (repeatable or comma separated) and stuck_for, a
+// duration since the saga was last updated.
+func (h *Handler) ListSagas(ctx *gin.Context) {
+	filter := messaging.SagaFilter{Definition: strings.TrimSpace(ctx.Query("definition"))}
+	for _, value := range ctx.QueryArray("status") {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, messaging.SagaStatus(status))
+			}
+		}
+	}
+	if raw := ctx.Query("stuck_for"); raw != "" {
+		stuckFor, err := time.ParseDuration(raw)
+		if err != nil || stuckFor < 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "stuck_for must be a duration such as 30m"})
+			return
+		}
+		stuckBefore := h.now().Add(-stuckFor)
+		filter.StuckBefore = &stuckBefore
+	}
+	var err error
+	if filter.Limit, err = intQuery(ctx, "limit"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.Offset, err = intQuery(ctx, "offset"); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	instances, err := h.service.ListSagas(ctx.Request.Context(), filter)
+	if err != nil {
+		writeSagaError(ctx, err, "Failed to list sagas")
+		return
+	}
+	items := make([]sagaResponse, 0, len(instances))
+	for _, instance := range instances {
+		items = append(items, toSagaResponse(instance))
+	}
+	ctx.JSON(http.StatusOK, listSagasResponse{Items: items})
+}
+
+func (h *Handler) GetSaga(ctx *gin.Context) {
+	instance, err := h.service.GetSaga(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		writeSagaError(ctx, err, "Failed to get saga")
+		return
+	}
+	ctx.JSON(http.StatusOK, toSagaResponse(instance))
+}
+
+func (h *Handler) ResumeSaga(ctx *gin.Context) {
+	instance, err := h.service.ResumeSaga(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		writeSagaError(ctx, err, "Failed to resume saga")
+		return
+	}
+	ctx.JSON(http.StatusOK, toSagaResponse(instance))
+}
+
+func (h *Handler) AbortSaga(ctx *gin.Context) {
+	var req abortSagaRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	instance, err := h.service.AbortSaga(ctx.Request.Context(), ctx.Param("id"), req.Reason)
+	if err != nil {
+		writeSagaError(ctx, err, "Failed to abort saga")
+		return
+	}
+	ctx.JSON(http.StatusOK, toSagaResponse(instance))
+}
+
+func toSagaResponse(instance messaging.SagaInstance) sagaResponse {
+	return sagaResponse{
+		ID:             instance.ID,
+		Definition:     instance.Definition,
+		Status:         string(instance.Status),
+		CurrentStep:    instance.CurrentStep,
+		Attempts:       instance.Attempts,
+		Envelope:       instance.Envelope,
+		Data:           instance.Data,
+		Error:          instance.ErrorText,
+		NextAttemptAt:  instance.NextAttemptAt,
+		DeadlineAt:     instance.DeadlineAt,
+		LeaseOwner:     instance.LeaseOwner,
+		LeaseExpiresAt: instance.LeaseExpiresAt,
+		Version:        instance.Version,
+		CreatedAt:      instance.CreatedAt,
+		UpdatedAt:      instance.UpdatedAt,
+		FinishedAt:     instance.FinishedAt,
+	}
+}
+
+func intQuery(ctx *gin.Context, name string) (int, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, errors.New(name + " must be a non-negative integer")
+	}
+	return value, nil
+}
+
+func writeSagaError(ctx *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, messaging.ErrSagaNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Saga not found"})
+	case errors.Is(err, messaging.ErrSagaBusy),
+		errors.Is(err, messaging.ErrSagaFinished),
+		errors.Is(err, messaging.ErrSagaConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
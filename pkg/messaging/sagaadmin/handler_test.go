@@ -0,0 +1,160 @@
+package sagaadmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+type fakeService struct {
+	filter  messaging.SagaFilter
+	reason  string
+	sagas   map[string]messaging.SagaInstance
+	abortFn func(id string) error
+}
+
+func (f *fakeService) ListSagas(ctx context.Context, filter messaging.SagaFilter) ([]messaging.SagaInstance, error) {
+	f.filter = filter
+	out := []messaging.SagaInstance{}
+	for _, instance := range f.sagas {
+		out = append(out, instance)
+	}
+	return out, nil
+}
+
+func (f *fakeService) GetSaga(ctx context.Context, id string) (messaging.SagaInstance, error) {
+	instance, ok := f.sagas[id]
+	if !ok {
+		return messaging.SagaInstance{}, messaging.ErrSagaNotFound
+	}
+	return instance, nil
+}
+
+func (f *fakeService) ResumeSaga(ctx context.Context, id string) (messaging.SagaInstance, error) {
+	instance, err := f.GetSaga(ctx, id)
+	if err != nil {
+		return messaging.SagaInstance{}, err
+	}
+	instance.Status = messaging.SagaStatusCompensating
+	return instance, nil
+}
+
+func (f *fakeService) AbortSaga(ctx context.Context, id string, reason string) (messaging.SagaInstance, error) {
+	f.reason = reason
+	if f.abortFn != nil {
+		if err := f.abortFn(id); err != nil {
+			return messaging.SagaInstance{}, err
+		}
+	}
+	return f.GetSaga(ctx, id)
+}
+
+func newTestRouter(service Service, now time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewHandler(service)
+	handler.now = func() time.Time { return now }
+	handler.RegisterRoutes(router.Group("/admin"))
+	return router
+}
+
+func TestHandler_ListSagasParsesFilter(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	service := &fakeService{sagas: map[string]messaging.SagaInstance{
+		"s1": {ID: "s1", Definition: "provision-store", Status: messaging.SagaStatusFailed},
+	}}
+	router := newTestRouter(service, now)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/admin/sagas?definition=provision-store&status=failed,running&stuck_for=30m&limit=20",
+		nil,
+	)
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	stuckBefore := now.Add(-30 * time.Minute)
+	assert.Equal(t, messaging.SagaFilter{
+		Definition:  "provision-store",
+		Statuses:    []messaging.SagaStatus{messaging.SagaStatusFailed, messaging.SagaStatusRunning},
+		StuckBefore: &stuckBefore,
+		Limit:       20,
+	}, service.filter)
+
+	var body listSagasResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "failed", body.Items[0].Status)
+}
+
+func TestHandler_ListSagasRejectsBadDuration(t *testing.T) {
+	router := newTestRouter(&fakeService{}, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/sagas?stuck_for=soon", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_GetSagaNotFound(t *testing.T) {
+	router := newTestRouter(&fakeService{}, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/sagas/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_AbortSaga(t *testing.T) {
+	service := &fakeService{sagas: map[string]messaging.SagaInstance{
+		"s1": {ID: "s1", Status: messaging.SagaStatusAborted},
+	}}
+	router := newTestRouter(service, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(
+		http.MethodPost,
+		"/admin/sagas/s1/abort",
+		strings.NewReader(`{"reason":"cleaned up by hand"}`),
+	))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "cleaned up by hand", service.reason)
+}
+
+func TestHandler_AbortSagaRequiresReason(t *testing.T) {
+	router := newTestRouter(&fakeService{}, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/sagas/s1/abort", strings.NewReader(`{}`)))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_AbortBusySagaConflicts(t *testing.T) {
+	service := &fakeService{
+		sagas:   map[string]messaging.SagaInstance{"s1": {ID: "s1"}},
+		abortFn: func(string) error { return messaging.ErrSagaBusy },
+	}
+	router := newTestRouter(service, time.Now())
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(
+		http.MethodPost,
+		"/admin/sagas/s1/abort",
+		strings.NewReader(`{"reason":"stuck"}`),
+	))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
@@ -0,0 +1,471 @@
+package messaging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrSchemaMismatch means a payload does not match the schema registered for its type and version.
+	ErrSchemaMismatch = errors.New("messaging: payload does not match its schema")
+	// ErrUnknownSchemaVersion means the event type is registered but not at the envelope's version.
+	ErrUnknownSchemaVersion = errors.New("messaging: unknown schema version")
+	// ErrUnregisteredSchema means a strict check met an event type with no registered schema.
+	ErrUnregisteredSchema = errors.New("messaging: no schema registered for event type")
+	// ErrInvalidSchema reports a bad RegisterSchema or RegisterUpcaster call.
+	ErrInvalidSchema = errors.New("messaging: invalid schema registration")
+)
+
+// Upcaster converts a payload from one schema version to the next.
+type Upcaster func(payload json.RawMessage) (json.RawMessage, error)
+
+// SchemaRegistry maps event types to the Go payload type of each schema version and to the
+// upcasters between consecutive versions. Register everything before use; lookups are not
+// synchronized with registration.
+type SchemaRegistry struct {
+	events map[string]*eventSchemas
+}
+
+type eventSchemas struct {
+	versions  map[int]reflect.Type
+	upcasters map[int]Upcaster
+	latest    int
+}
+
+// EventSchema is the exported JSON Schema of one event type at one version.
+type EventSchema struct {
+	Type    string
+	Version int
+	Schema  json.RawMessage
+}
+
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{events: map[string]*eventSchemas{}}
+}
+
+// RegisterSchema registers T as the payload of eventType at version.
+func RegisterSchema[T any](r *SchemaRegistry, eventType string, version int) error {
+	if strings.TrimSpace(eventType) == "" {
+		return fmt.Errorf("%w: event type is required", ErrInvalidSchema)
+	}
+	if version <= 0 {
+		return fmt.Errorf("%w: %s version must be positive", ErrInvalidSchema, eventType)
+	}
+	payloadType := reflect.TypeFor[T]()
+	if payloadType.Kind() != reflect.Struct {
+		return fmt.Errorf(
+			"%w: %s v%d payload must be a struct, got %s",
+			ErrInvalidSchema, eventType, version, payloadType,
+		)
+	}
+	schemas, ok := r.events[eventType]
+	if !ok {
+		schemas = &eventSchemas{versions: map[int]reflect.Type{}, upcasters: map[int]Upcaster{}}
+		r.events[eventType] = schemas
+	}
+	if _, exists := schemas.versions[version]; exists {
+		return fmt.Errorf("%w: %s v%d registered twice", ErrInvalidSchema, eventType, version)
+	}
+	schemas.versions[version] = payloadType
+	schemas.latest = max(schemas.latest, version)
+	return nil
+}
+
+// RegisterUpcaster registers fn to turn eventType payloads at fromVersion into fromVersion+1.
+// From and To must be the types registered for those versions.
+func RegisterUpcaster[From, To any](
+	r *SchemaRegistry,
+	eventType string,
+	fromVersion int,
+	fn func(From) (To, error),
+) error {
+	schemas, ok := r.events[eventType]
+	if !ok {
+		return fmt.Errorf("%w: %s is not registered", ErrInvalidSchema, eventType)
+	}
+	for version, payloadType := range map[int]reflect.Type{
+		fromVersion:     reflect.TypeFor[From](),
+		fromVersion + 1: reflect.TypeFor[To](),
+	} {
+		if registered := schemas.versions[version]; registered != payloadType {
+			return fmt.Errorf("%w: %s v%d is %v, not %s", ErrInvalidSchema, eventType, version, registered, payloadType)
+		}
+	}
+	if _, exists := schemas.upcasters[fromVersion]; exists {
+		return fmt.Errorf("%w: %s v%d upcaster registered twice", ErrInvalidSchema, eventType, fromVersion)
+	}
+	schemas.upcasters[fromVersion] = func(payload json.RawMessage) (json.RawMessage, error) {
+		var from From
+		if err := json.Unmarshal(payload, &from); err != nil {
+			return nil, err
+		}
+		to, err := fn(from)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(to)
+	}
+	return nil
+}
+
+// Check verifies that every event type has a contiguous version range with an upcaster
+// between each pair, so any registered version can reach the latest one.
+func (r *SchemaRegistry) Check() error {
+	var errs []error
+	for _, eventType := range r.Types() {
+		schemas := r.events[eventType]
+		for version := 1; version < schemas.latest; version++ {
+			if _, ok := schemas.versions[version]; !ok {
+				errs = append(errs, fmt.Errorf("%w: %s has no v%d", ErrInvalidSchema, eventType, version))
+				continue
+			}
+			if _, ok := schemas.upcasters[version]; !ok {
+				errs = append(errs, fmt.Errorf("%w: %s has no v%d upcaster", ErrInvalidSchema, eventType, version))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Types returns the registered event types in order.
+func (r *SchemaRegistry) Types() []string {
+	types := make([]string, 0, len(r.events))
+	for eventType := range r.events {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Latest returns the newest registered version of eventType.
+func (r *SchemaRegistry) Latest(eventType string) (int, bool) {
+	if r == nil {
+		return 0, false
+	}
+	schemas, ok := r.events[eventType]
+	if !ok {
+		return 0, false
+	}
+	return schemas.latest, true
+}
+
+// PayloadType returns the Go type registered for eventType at version.
+func (r *SchemaRegistry) PayloadType(eventType string, version int) (reflect.Type, bool) {
+	if r == nil {
+		return nil, false
+	}
+	schemas, ok := r.events[eventType]
+	if !ok {
+		return nil, false
+	}
+	payloadType, ok := schemas.versions[version]
+	return payloadType, ok
+}
+
+// Validate checks a payload about to be published against the schema registered for its type
+// and version: no unknown fields, required fields present and values of the right JSON type.
+// Types without a registered schema pass.
+func (r *SchemaRegistry) Validate(msg Envelope) error {
+	if r == nil {
+		return nil
+	}
+	schemas, ok := r.events[msg.Type]
+	if !ok {
+		return nil
+	}
+	payloadType, ok := schemas.versions[msg.SchemaVersion]
+	if !ok {
+		return fmt.Errorf("%w: %s v%d", ErrUnknownSchemaVersion, msg.Type, msg.SchemaVersion)
+	}
+	return validatePayload(msg.Type, msg.SchemaVersion, schemaFor(payloadType), msg.Payload)
+}
+
+// ValidateRegistered is Validate for producers whose every event type must have a contract:
+// a type without a registered schema fails with ErrUnregisteredSchema instead of passing.
+func (r *SchemaRegistry) ValidateRegistered(msg Envelope) error {
+	if r == nil || r.events[msg.Type] == nil {
+		return fmt.Errorf("%w: %s", ErrUnregisteredSchema, msg.Type)
+	}
+	return r.Validate(msg)
+}
+
+// Upcast rewrites msg's payload to the latest registered version of its type. Envelopes
+// written before versioning (SchemaVersion 0) are read as version 1. Types without a
+// registered schema are returned unchanged.
+func (r *SchemaRegistry) Upcast(msg Envelope) (Envelope, error) {
+	if r == nil {
+		return msg, nil
+	}
+	schemas, ok := r.events[msg.Type]
+	if !ok {
+		return msg, nil
+	}
+	version := max(msg.SchemaVersion, 1)
+	if _, ok := schemas.versions[version]; !ok {
+		return msg, fmt.Errorf("%w: %s v%d", ErrUnknownSchemaVersion, msg.Type, version)
+	}
+	payload := msg.Payload
+	for ; version < schemas.latest; version++ {
+		upcast, ok := schemas.upcasters[version]
+		if !ok {
+			return msg, fmt.Errorf("%w: %s has no v%d upcaster", ErrUnknownSchemaVersion, msg.Type, version)
+		}
+		next, err := upcast(payload)
+		if err != nil {
+			return msg, fmt.Errorf("upcast %s v%d: %w", msg.Type, version, err)
+		}
+		payload = next
+	}
+	msg.Payload = payload
+	msg.SchemaVersion = version
+	return msg, nil
+}
+
+// JSONSchema returns the JSON Schema of eventType at version.
+func (r *SchemaRegistry) JSONSchema(eventType string, version int) (json.RawMessage, error) {
+	payloadType, ok := r.PayloadType(eventType, version)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnknownSchemaVersion, eventType, version)
+	}
+	schema := schemaFor(payloadType)
+	schema.Schema = "https://json-schema.org/draft/2020-12/schema"
+	schema.Title = fmt.Sprintf("%s v%d", eventType, version)
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// JSONSchemas exports every registered version of every event type, ordered by type and version.
+func (r *SchemaRegistry) JSONSchemas() ([]EventSchema, error) {
+	var out []EventSchema
+	for _, eventType := range r.Types() {
+		versions := make([]int, 0, len(r.events[eventType].versions))
+		for version := range r.events[eventType].versions {
+			versions = append(versions, version)
+		}
+		slices.Sort(versions)
+		for _, version := range versions {
+			schema, err := r.JSONSchema(eventType, version)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, EventSchema{Type: eventType, Version: version, Schema: schema})
+		}
+	}
+	return out, nil
+}
+
+// jsonSchema is the subset of JSON Schema generated from payload types and enforced by Validate.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+)
+
+func schemaFor(t reflect.Type) *jsonSchema {
+	return buildSchema(t, map[reflect.Type]bool{})
+}
+
+func buildSchema(t reflect.Type, visiting map[reflect.Type]bool) *jsonSchema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+	schema := &jsonSchema{}
+	switch {
+	case t == timeType:
+		schema.Type, schema.Format = "string", "date-time"
+	case t == rawMessageType || t.Kind() == reflect.Interface:
+		// Any JSON value.
+		return schema
+	case t.Kind() == reflect.String:
+		schema.Type = "string"
+	case t.Kind() == reflect.Bool:
+		schema.Type = "boolean"
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		schema.Type = "integer"
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+		zero := 0
+		schema.Type, schema.Minimum = "integer", &zero
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		schema.Type = "number"
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8:
+		schema.Type = "string"
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		// encoding/json writes a nil slice as null.
+		schema.Type, schema.Items = "array", buildSchema(t.Elem(), visiting)
+		nullable = nullable || t.Kind() == reflect.Slice
+	case t.Kind() == reflect.Map:
+		schema.Type, schema.AdditionalProperties = "object", buildSchema(t.Elem(), visiting)
+		nullable = true
+	case t.Kind() == reflect.Struct:
+		if visiting[t] {
+			return schema
+		}
+		visiting[t] = true
+		schema.Type = "object"
+		schema.Properties = map[string]*jsonSchema{}
+		schema.AdditionalProperties = false
+		addStructFields(schema, t, visiting)
+		delete(visiting, t)
+	default:
+		return schema
+	}
+	if nullable {
+		schema.Type = []string{schema.Type.(string), "null"}
+	}
+	return schema
+}
+
+func addStructFields(schema *jsonSchema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for field := range t.Fields() {
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addStructFields(schema, field.Type, visiting)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = buildSchema(field.Type, visiting)
+		if !slices.Contains(strings.Split(options, ","), "omitempty") &&
+			!slices.Contains(strings.Split(options, ","), "omitzero") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func validatePayload(eventType string, version int, schema *jsonSchema, payload json.RawMessage) error {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("%w: %s v%d: %v", ErrSchemaMismatch, eventType, version, err)
+	}
+	if err := schema.validate("payload", value); err != nil {
+		return fmt.Errorf("%w: %s v%d: %v", ErrSchemaMismatch, eventType, version, err)
+	}
+	return nil
+}
+
+func (s *jsonSchema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	default:
+		return nil
+	}
+}
+
+func (s *jsonSchema) validate(path string, value any) error {
+	types := s.types()
+	if len(types) == 0 {
+		return nil
+	}
+	actual := jsonTypeOf(value)
+	if !slices.Contains(types, actual) && (actual != "integer" || !slices.Contains(types, "number")) {
+		return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(types, " or "), actual)
+	}
+
+	switch value := value.(type) {
+	case string:
+		if s.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+				return fmt.Errorf("%s: expected an RFC 3339 date-time", path)
+			}
+		}
+	case json.Number:
+		if s.Minimum != nil && strings.HasPrefix(value.String(), "-") {
+			return fmt.Errorf("%s: must be at least %d", path, *s.Minimum)
+		}
+	case []any:
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range value {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := value[name]; !ok {
+				return fmt.Errorf("%s.%s: required", path, name)
+			}
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := path + "." + key
+			if property, ok := s.Properties[key]; ok {
+				if err := property.validate(fieldPath, value[key]); err != nil {
+					return err
+				}
+				continue
+			}
+			switch additional := s.AdditionalProperties.(type) {
+			case bool:
+				if !additional {
+					return fmt.Errorf("%s: unknown field", fieldPath)
+				}
+			case *jsonSchema:
+				if err := additional.validate(fieldPath, value[key]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func jsonTypeOf(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if strings.ContainsAny(value.String(), ".eE") {
+			return "number"
+		}
+		return "integer"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
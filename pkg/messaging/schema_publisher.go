@@ -0,0 +1,41 @@
+package messaging
+
+import "context"
+
+// SchemaValidatingPublisher rejects envelopes whose payload does not match the schema
+// registered for their type and version before handing them to the next publisher.
+type SchemaValidatingPublisher struct {
+	next     Publisher
+	validate func(Envelope) error
+}
+
+var _ Publisher = (*SchemaValidatingPublisher)(nil)
+
+// NewSchemaValidatingPublisher passes event types without a registered schema through
+// unchecked.
+func NewSchemaValidatingPublisher(next Publisher, schemas *SchemaRegistry) *SchemaValidatingPublisher {
+	return &SchemaValidatingPublisher{next: next, validate: schemas.Validate}
+}
+
+// NewStrictSchemaValidatingPublisher also rejects event types without a registered schema,
+// with ErrUnregisteredSchema.
+func NewStrictSchemaValidatingPublisher(next Publisher, schemas *SchemaRegistry) *SchemaValidatingPublisher {
+	return &SchemaValidatingPublisher{next: next, validate: schemas.ValidateRegistered}
+}
+
+func (p *SchemaValidatingPublisher) Publish(ctx context.Context, topic string, key string, msg Envelope) error {
+	if err := p.validate(msg); err != nil {
+		return err
+	}
+	return p.next.Publish(ctx, topic, key, msg)
+}
+
+// PublishBatch publishes nothing when any envelope in msgs is invalid.
+func (p *SchemaValidatingPublisher) PublishBatch(ctx context.Context, topic string, msgs []PublishRequest) error {
+	for _, msg := range msgs {
+		if err := p.validate(msg.Msg); err != nil {
+			return err
+		}
+	}
+	return p.next.PublishBatch(ctx, topic, msgs)
+}
@@ -0,0 +1,235 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type widgetV1 struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type widgetV2 struct {
+	ID       string            `json:"id"`
+	Title    string            `json:"title"`
+	Quantity uint              `json:"quantity"`
+	Tags     []string          `json:"tags,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	At       *time.Time        `json:"at,omitempty"`
+}
+
+func newWidgetSchemas(t *testing.T) *SchemaRegistry {
+	t.Helper()
+	r := NewSchemaRegistry()
+	require.NoError(t, RegisterSchema[widgetV1](r, "widget.created", 1))
+	require.NoError(t, RegisterSchema[widgetV2](r, "widget.created", 2))
+	require.NoError(t, RegisterUpcaster(r, "widget.created", 1, func(v1 widgetV1) (widgetV2, error) {
+		return widgetV2{ID: v1.ID, Title: v1.Name}, nil
+	}))
+	require.NoError(t, r.Check())
+	return r
+}
+
+func widgetEnvelope(version int, payload string) Envelope {
+	return Envelope{Type: "widget.created", SchemaVersion: version, Payload: json.RawMessage(payload)}
+}
+
+func TestRegisterSchemaRejectsBadRegistrations(t *testing.T) {
+	r := NewSchemaRegistry()
+	require.NoError(t, RegisterSchema[widgetV1](r, "widget.created", 1))
+
+	require.ErrorIs(t, RegisterSchema[widgetV1](r, "widget.created", 1), ErrInvalidSchema)
+	require.ErrorIs(t, RegisterSchema[widgetV1](r, "widget.created", 0), ErrInvalidSchema)
+	require.ErrorIs(t, RegisterSchema[string](r, "widget.renamed", 1), ErrInvalidSchema)
+	require.ErrorIs(t, RegisterUpcaster(r, "widget.created", 1, func(v1 widgetV1) (widgetV2, error) {
+		return widgetV2{}, nil
+	}), ErrInvalidSchema, "v2 is not registered")
+}
+
+func TestSchemaRegistryCheckRequiresUpcasters(t *testing.T) {
+	r := NewSchemaRegistry()
+	require.NoError(t, RegisterSchema[widgetV1](r, "widget.created", 1))
+	require.NoError(t, RegisterSchema[widgetV2](r, "widget.created", 2))
+
+	require.ErrorIs(t, r.Check(), ErrInvalidSchema)
+}
+
+func TestSchemaRegistryValidate(t *testing.T) {
+	r := newWidgetSchemas(t)
+
+	tests := []struct {
+		name    string
+		msg     Envelope
+		wantErr error
+	}{
+		{name: "valid v1", msg: widgetEnvelope(1, `{"id":"w1","name":"Widget"}`)},
+		{
+			name: "valid v2 with optional fields",
+			msg: widgetEnvelope(
+				2,
+				`{"id":"w1","title":"Widget","quantity":3,"tags":null,"at":"2026-10-01T12:00:00Z"}`,
+			),
+		},
+		{name: "unregistered type", msg: Envelope{Type: "gadget.created", Payload: json.RawMessage(`{}`)}},
+		{name: "missing field", msg: widgetEnvelope(1, `{"id":"w1"}`), wantErr: ErrSchemaMismatch},
+		{name: "unknown field", msg: widgetEnvelope(1, `{"id":"w1","name":"W","extra":1}`), wantErr: ErrSchemaMismatch},
+		{name: "wrong type", msg: widgetEnvelope(1, `{"id":1,"name":"W"}`), wantErr: ErrSchemaMismatch},
+		{
+			name:    "negative unsigned",
+			msg:     widgetEnvelope(2, `{"id":"w1","title":"W","quantity":-1}`),
+			wantErr: ErrSchemaMismatch,
+		},
+		{
+			name:    "fractional integer",
+			msg:     widgetEnvelope(2, `{"id":"w1","title":"W","quantity":1.5}`),
+			wantErr: ErrSchemaMismatch,
+		},
+		{
+			name:    "bad date-time",
+			msg:     widgetEnvelope(2, `{"id":"w1","title":"W","quantity":1,"at":"yesterday"}`),
+			wantErr: ErrSchemaMismatch,
+		},
+		{
+			name:    "bad map value",
+			msg:     widgetEnvelope(2, `{"id":"w1","title":"W","quantity":1,"labels":{"a":1}}`),
+			wantErr: ErrSchemaMismatch,
+		},
+		{name: "not json", msg: widgetEnvelope(1, `{`), wantErr: ErrSchemaMismatch},
+		{name: "unknown version", msg: widgetEnvelope(3, `{}`), wantErr: ErrUnknownSchemaVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.Validate(tt.msg)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSchemaRegistryValidateRegisteredRejectsUnknownType(t *testing.T) {
+	r := newWidgetSchemas(t)
+
+	require.NoError(t, r.ValidateRegistered(widgetEnvelope(1, `{"id":"w1","name":"W"}`)))
+	require.ErrorIs(t, r.ValidateRegistered(widgetEnvelope(1, `{"id":"w1"}`)), ErrSchemaMismatch)
+
+	err := r.ValidateRegistered(Envelope{Type: "gadget.created", SchemaVersion: 1, Payload: json.RawMessage(`{}`)})
+	require.ErrorIs(t, err, ErrUnregisteredSchema)
+	require.NoError(t, r.Validate(Envelope{Type: "gadget.created", SchemaVersion: 1, Payload: json.RawMessage(`{}`)}))
+}
+
+func TestSchemaRegistryUpcastsToLatest(t *testing.T) {
+	r := newWidgetSchemas(t)
+
+	for _, version := range []int{0, 1} {
+		msg, err := r.Upcast(widgetEnvelope(version, `{"id":"w1","name":"Widget"}`))
+		require.NoError(t, err)
+		assert.Equal(t, 2, msg.SchemaVersion)
+		assert.JSONEq(t, `{"id":"w1","title":"Widget","quantity":0}`, string(msg.Payload))
+	}
+
+	msg, err := r.Upcast(widgetEnvelope(2, `{"id":"w1","title":"Widget","quantity":1}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"w1","title":"Widget","quantity":1}`, string(msg.Payload))
+
+	_, err = r.Upcast(widgetEnvelope(7, `{}`))
+	require.ErrorIs(t, err, ErrUnknownSchemaVersion)
+}
+
+func TestSchemaRegistryExportsJSONSchemas(t *testing.T) {
+	r := newWidgetSchemas(t)
+
+	exported, err := r.JSONSchemas()
+	require.NoError(t, err)
+	require.Len(t, exported, 2)
+	assert.Equal(t, 1, exported[0].Version)
+	assert.Equal(t, 2, exported[1].Version)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(exported[1].Schema, &schema))
+	assert.Equal(t, "widget.created v2", schema["title"])
+	assert.Equal(t, []any{"id", "title", "quantity"}, schema["required"])
+	assert.Equal(t, false, schema["additionalProperties"])
+	properties := schema["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "integer", "minimum": float64(0)}, properties["quantity"])
+	assert.Equal(t, map[string]any{"type": []any{"string", "null"}, "format": "date-time"}, properties["at"])
+}
+
+func TestRegistryWithSchemasDispatchesUpcastPayload(t *testing.T) {
+	var got widgetV2
+	registry, err := NewRegistryWithSchemas(
+		newWidgetSchemas(t),
+		HandleEvent("widget.created", func(_ context.Context, msg Envelope, payload widgetV2) error {
+			assert.Equal(t, 2, msg.SchemaVersion)
+			got = payload
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, registry.Handle(context.Background(), widgetEnvelope(1, `{"id":"w1","name":"Widget"}`)))
+	assert.Equal(t, widgetV2{ID: "w1", Title: "Widget"}, got)
+}
+
+func TestRegistryWithSchemasRejectsStaleHandlerType(t *testing.T) {
+	_, err := NewRegistryWithSchemas(
+		newWidgetSchemas(t),
+		HandleEvent("widget.created", func(context.Context, Envelope, widgetV1) error { return nil }),
+	)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "widgetV1"), err.Error())
+}
+
+func TestEventHandlerDeadLettersUndecodablePayload(t *testing.T) {
+	handler := HandleEvent("widget.created", func(context.Context, Envelope, widgetV2) error { return nil })
+
+	err := handler.Handle(context.Background(), widgetEnvelope(2, `{"quantity":"many"}`))
+
+	classification := ClassifyError(err)
+	assert.Equal(t, FailureActionDeadLetter, classification.Action)
+	assert.Equal(t, "invalid widget.created payload", classification.Reason)
+}
+
+func TestSchemaValidatingPublisherRejectsMismatchedPayload(t *testing.T) {
+	next := &recordingPublisher{}
+	publisher := NewSchemaValidatingPublisher(next, newWidgetSchemas(t))
+
+	err := publisher.Publish(context.Background(), "widgets", "w1", widgetEnvelope(1, `{"id":"w1"}`))
+	require.ErrorIs(t, err, ErrSchemaMismatch)
+
+	err = publisher.PublishBatch(context.Background(), "widgets", []PublishRequest{
+		{Msg: widgetEnvelope(1, `{"id":"w1","name":"Widget"}`)},
+		{Msg: widgetEnvelope(2, `{"id":"w2"}`)},
+	})
+	require.ErrorIs(t, err, ErrSchemaMismatch)
+	assert.Empty(t, next.published)
+
+	err = publisher.Publish(context.Background(), "widgets", "w1", widgetEnvelope(1, `{"id":"w1","name":"W"}`))
+	require.NoError(t, err)
+	assert.Len(t, next.published, 1)
+}
+
+func TestStrictSchemaValidatingPublisherRejectsUnregisteredType(t *testing.T) {
+	next := &recordingPublisher{}
+	publisher := NewStrictSchemaValidatingPublisher(next, newWidgetSchemas(t))
+	gadget := Envelope{Type: "gadget.created", SchemaVersion: 1, Payload: json.RawMessage(`{}`)}
+
+	err := publisher.Publish(context.Background(), "widgets", "g1", gadget)
+	require.ErrorIs(t, err, ErrUnregisteredSchema)
+
+	err = publisher.PublishBatch(context.Background(), "widgets", []PublishRequest{
+		{Msg: widgetEnvelope(1, `{"id":"w1","name":"Widget"}`)},
+		{Msg: gadget},
+	})
+	require.ErrorIs(t, err, ErrUnregisteredSchema)
+	assert.Empty(t, next.published)
+}
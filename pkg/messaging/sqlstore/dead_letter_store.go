@@ -0,0 +1,287 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+const deadLetterColumns = `id, topic, record_partition, record_offset, message_key, original_topic,
+	message_type, tenant_id, consumer_name, reason, last_error, attempt, redrive_count,
+	envelope_json, status, received_at, resolved_at, resolved_by`
+
+// DeadLetterStore keeps dead letters in tableName and their audit trail in tableName_audit.
+type DeadLetterStore struct {
+	db         *sqlx.DB
+	tableName  string
+	auditTable string
+}
+
+var _ messaging.DeadLetterStore = (*DeadLetterStore)(nil)
+
+func NewDeadLetterStore(db *sqlx.DB, tableName string) (*DeadLetterStore, error) {
+	if tableName == "" {
+		tableName = "dead_letters"
+	}
+	if err := validateQualifiedIdentifier(tableName); err != nil {
+		return nil, err
+	}
+	return &DeadLetterStore{db: db, tableName: tableName, auditTable: tableName + "_audit"}, nil
+}
+
+func (s *DeadLetterStore) Record(ctx context.Context, deadLetter messaging.DeadLetter) error {
+	row, err := newDeadLetterRow(deadLetter)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.NamedExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES (:id, :topic, :record_partition, :record_offset, :message_key, :original_topic,
+		        :message_type, :tenant_id, :consumer_name, :reason, :last_error, :attempt, :redrive_count,
+		        :envelope_json, :status, :received_at, :resolved_at, :resolved_by)
+		ON CONFLICT (id) DO NOTHING
+	`, s.tableName, deadLetterColumns), row)
+	if err != nil {
+		return fmt.Errorf("insert dead letter: %w", err)
+	}
+	return nil
+}
+
+func (s *DeadLetterStore) Get(ctx context.Context, id string) (messaging.DeadLetter, error) {
+	var row deadLetterRow
+	err := s.db.GetContext(ctx, &row, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1
+	`, deadLetterColumns, s.tableName), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return messaging.DeadLetter{}, messaging.ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return messaging.DeadLetter{}, fmt.Errorf("get dead letter: %w", err)
+	}
+	return row.toDeadLetter()
+}
+
+// List returns the most recently received dead letters first.
+func (s *DeadLetterStore) List(ctx context.Context, filter messaging.DeadLetterFilter) ([]messaging.DeadLetter, error) {
+	var (
+		where []string
+		args  []any
+	)
+	for _, match := range []struct{ column, value string }{
+		{"topic", filter.Topic},
+		{"message_type", filter.Type},
+		{"tenant_id", filter.TenantID},
+		{"consumer_name", filter.ConsumerName},
+	} {
+		if match.value != "" {
+			args = append(args, match.value)
+			where = append(where, fmt.Sprintf("%s = $%d", match.column, len(args)))
+		}
+	}
+	if filter.Error != "" {
+		args = append(args, "%"+escapeLike(filter.Error)+"%")
+		where = append(where, fmt.Sprintf("(reason ILIKE $%d OR last_error ILIKE $%d)", len(args), len(args)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		args = append(args, pq.Array(statuses))
+		where = append(where, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+	clause := ""
+	if len(where) > 0 {
+		clause = "WHERE " + strings.Join(where, " AND ")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit, max(filter.Offset, 0))
+
+	rows := []deadLetterRow{}
+	if err := s.db.SelectContext(ctx, &rows, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s
+		ORDER BY received_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, deadLetterColumns, s.tableName, clause, len(args)-1, len(args)), args...); err != nil {
+		return nil, fmt.Errorf("list dead letters: %w", err)
+	}
+	deadLetters := make([]messaging.DeadLetter, 0, len(rows))
+	for _, row := range rows {
+		deadLetter, err := row.toDeadLetter()
+		if err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+// Resolve updates and audits in one statement, so an audit record exists exactly for the rows
+// this call moved out of pending.
+func (s *DeadLetterStore) Resolve(
+	ctx context.Context,
+	ids []string,
+	status messaging.DeadLetterStatus,
+	audit messaging.DeadLetterAudit,
+) ([]string, error) {
+	resolved := []string{}
+	if len(ids) == 0 {
+		return resolved, nil
+	}
+	if err := s.db.SelectContext(ctx, &resolved, fmt.Sprintf(`
+		WITH resolved AS (
+			UPDATE %s
+			SET status = $2,
+			    resolved_at = $3,
+			    resolved_by = $4
+			WHERE id = ANY($1)
+			  AND status = 'pending'
+			RETURNING id
+		)
+		INSERT INTO %s (dead_letter_id, action, actor, note, created_at)
+		SELECT id, $5, $4, $6, $3
+		FROM resolved
+		RETURNING dead_letter_id
+	`, s.tableName, s.auditTable),
+		pq.Array(ids), string(status), audit.CreatedAt, audit.Actor, string(audit.Action), audit.Note,
+	); err != nil {
+		return nil, fmt.Errorf("resolve dead letters: %w", err)
+	}
+	return resolved, nil
+}
+
+func (s *DeadLetterStore) ListAudit(
+	ctx context.Context,
+	deadLetterID string,
+	limit int,
+) ([]messaging.DeadLetterAudit, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	rows := []deadLetterAuditRow{}
+	if err := s.db.SelectContext(ctx, &rows, fmt.Sprintf(`
+		SELECT id, dead_letter_id, action, actor, note, created_at
+		FROM %s
+		WHERE $1 = '' OR dead_letter_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, s.auditTable), deadLetterID, limit); err != nil {
+		return nil, fmt.Errorf("list dead letter audit: %w", err)
+	}
+	audits := make([]messaging.DeadLetterAudit, 0, len(rows))
+	for _, row := range rows {
+		audits = append(audits, messaging.DeadLetterAudit{
+			ID:           row.ID,
+			DeadLetterID: row.DeadLetterID,
+			Action:       messaging.DeadLetterAction(row.Action),
+			Actor:        row.Actor,
+			Note:         row.Note,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+	return audits, nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+type deadLetterRow struct {
+	ID              string     `db:"id"`
+	Topic           string     `db:"topic"`
+	RecordPartition int32      `db:"record_partition"`
+	RecordOffset    int64      `db:"record_offset"`
+	MessageKey      string     `db:"message_key"`
+	OriginalTopic   string     `db:"original_topic"`
+	MessageType     string     `db:"message_type"`
+	TenantID        string     `db:"tenant_id"`
+	ConsumerName    string     `db:"consumer_name"`
+	Reason          string     `db:"reason"`
+	LastError       string     `db:"last_error"`
+	Attempt         int        `db:"attempt"`
+	RedriveCount    int        `db:"redrive_count"`
+	EnvelopeJSON    []byte     `db:"envelope_json"`
+	Status          string     `db:"status"`
+	ReceivedAt      time.Time  `db:"received_at"`
+	ResolvedAt      *time.Time `db:"resolved_at"`
+	ResolvedBy      string     `db:"resolved_by"`
+}
+
+type deadLetterAuditRow struct {
+	ID           int64     `db:"id"`
+	DeadLetterID string    `db:"dead_letter_id"`
+	Action       string    `db:"action"`
+	Actor        string    `db:"actor"`
+	Note         string    `db:"note"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func newDeadLetterRow(deadLetter messaging.DeadLetter) (deadLetterRow, error) {
+	envelopeJSON, err := json.Marshal(deadLetter.Envelope)
+	if err != nil {
+		return deadLetterRow{}, fmt.Errorf("marshal dead letter envelope: %w", err)
+	}
+	return deadLetterRow{
+		ID:              deadLetter.ID,
+		Topic:           deadLetter.Topic,
+		RecordPartition: deadLetter.Partition,
+		RecordOffset:    deadLetter.Offset,
+		MessageKey:      deadLetter.Key,
+		OriginalTopic:   deadLetter.OriginalTopic,
+		MessageType:     deadLetter.Type,
+		TenantID:        deadLetter.TenantID,
+		ConsumerName:    deadLetter.ConsumerName,
+		Reason:          deadLetter.Reason,
+		LastError:       deadLetter.LastError,
+		Attempt:         deadLetter.Attempt,
+		RedriveCount:    deadLetter.RedriveCount,
+		EnvelopeJSON:    envelopeJSON,
+		Status:          string(deadLetter.Status),
+		ReceivedAt:      deadLetter.ReceivedAt,
+		ResolvedAt:      deadLetter.ResolvedAt,
+		ResolvedBy:      deadLetter.ResolvedBy,
+	}, nil
+}
+
+func (r deadLetterRow) toDeadLetter() (messaging.DeadLetter, error) {
+	var envelope messaging.Envelope
+	if err := json.Unmarshal(r.EnvelopeJSON, &envelope); err != nil {
+		return messaging.DeadLetter{}, fmt.Errorf("unmarshal dead letter envelope %q: %w", r.ID, err)
+	}
+	return messaging.DeadLetter{
+		ID:            r.ID,
+		Topic:         r.Topic,
+		Partition:     r.RecordPartition,
+		Offset:        r.RecordOffset,
+		Key:           r.MessageKey,
+		OriginalTopic: r.OriginalTopic,
+		Type:          r.MessageType,
+		TenantID:      r.TenantID,
+		ConsumerName:  r.ConsumerName,
+		Reason:        r.Reason,
+		LastError:     r.LastError,
+		Attempt:       r.Attempt,
+		RedriveCount:  r.RedriveCount,
+		Envelope:      envelope,
+		Status:        messaging.DeadLetterStatus(r.Status),
+		ReceivedAt:    r.ReceivedAt,
+		ResolvedAt:    r.ResolvedAt,
+		ResolvedBy:    r.ResolvedBy,
+	}, nil
+}
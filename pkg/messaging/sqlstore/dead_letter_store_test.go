@@ -0,0 +1,68 @@
+package sqlstore
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+func TestNewDeadLetterStore_DefaultTableNames(t *testing.T) {
+	store, err := NewDeadLetterStore(nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "dead_letters", store.tableName)
+	assert.Equal(t, "dead_letters_audit", store.auditTable)
+}
+
+func TestNewDeadLetterStore_RejectsInvalidIdentifier(t *testing.T) {
+	store, err := NewDeadLetterStore(nil, "dead_letters;drop table users")
+	require.Error(t, err)
+	assert.Nil(t, store)
+}
+
+func TestDeadLetterRow_RoundTrip(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	resolvedAt := now.Add(time.Minute)
+	deadLetter := messaging.DeadLetter{
+		ID:            "podzone.order.events.dlt:2:7",
+		Topic:         "podzone.order.events.dlt",
+		Partition:     2,
+		Offset:        7,
+		Key:           "o1",
+		OriginalTopic: "podzone.order.events",
+		Type:          "order.placed",
+		TenantID:      "t1",
+		ConsumerName:  "backoffice.order-placed",
+		Reason:        "retry_exhausted",
+		LastError:     "connection refused",
+		Attempt:       5,
+		RedriveCount:  1,
+		Envelope: messaging.Envelope{
+			ID:            "evt_1",
+			Type:          "order.placed",
+			Source:        "order",
+			TenantID:      "t1",
+			OccurredAt:    now,
+			SchemaVersion: 1,
+			Payload:       json.RawMessage(`{"order_id":"o1"}`),
+		},
+		Status:     messaging.DeadLetterStatusRedriven,
+		ReceivedAt: now,
+		ResolvedAt: &resolvedAt,
+		ResolvedBy: "42",
+	}
+
+	row, err := newDeadLetterRow(deadLetter)
+	require.NoError(t, err)
+	got, err := row.toDeadLetter()
+	require.NoError(t, err)
+	assert.Equal(t, deadLetter, got)
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `100\% \_done\\`, escapeLike(`100% _done\`))
+}
@@ -1,7 +1,9 @@
 // Package sqlstore provides SQL implementations of messaging durability stores.
 //
 // InboxStore implements idempotent consumer tracking. OutboxStore implements a
-// reusable PostgreSQL-style transactional outbox store. Table names are supplied
+// reusable PostgreSQL-style transactional outbox store. SagaStore persists
+// SagaOrchestrator instances. DeadLetterStore keeps messages collected from
+// dead-letter topics and the audit trail of their redrives. Table names are supplied
 // by each service so tables can follow the owner pattern, for example
 // order_outbox, iam_outbox, or message_outbox for legacy shared usage.
 //
@@ -3,6 +3,7 @@ package sqlstore
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -18,7 +19,10 @@ type InboxStore struct {
 	tableName string
 }
 
-var _ messaging.InboxStore = (*InboxStore)(nil)
+var (
+	_ messaging.InboxStore  = (*InboxStore)(nil)
+	_ messaging.InboxPruner = (*InboxStore)(nil)
+)
 
 var validIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
 
@@ -32,114 +36,171 @@ func NewInboxStore(db *sqlx.DB, tableName string) (*InboxStore, error) {
 	return &InboxStore{db: db, tableName: tableName}, nil
 }
 
+// Begin claims the message with a single upsert: a new row is inserted, and an existing row is
+// taken over when it failed or its processing lease expired. Rows written before leases existed
+// have no expiry and are treated as expired.
 func (s *InboxStore) Begin(
 	ctx context.Context,
 	consumerName string,
 	messageID string,
+	lease messaging.InboxLease,
 	now time.Time,
 ) (messaging.InboxDecision, error) {
-	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET status = 'processing',
-		    error_text = '',
-		    started_at = $3,
-		    updated_at = $3
-		WHERE consumer_name = $1
-		  AND message_id = $2
-		  AND status = 'failed'
-	`, s.tableName), consumerName, messageID, now)
-	if err != nil {
-		return "", fmt.Errorf("reset failed inbox row: %w", err)
-	}
-	if rows, err := result.RowsAffected(); err == nil && rows > 0 {
-		return messaging.InboxDecisionAcquired, nil
-	}
-
-	result, err = s.db.ExecContext(ctx, fmt.Sprintf(`
-		INSERT INTO %s (
+	claim := fmt.Sprintf(`
+		INSERT INTO %s AS inbox (
 			consumer_name,
 			message_id,
 			status,
+			lease_owner,
+			lease_expires_at,
 			started_at,
 			updated_at
-		) VALUES ($1, $2, 'processing', $3, $3)
-		ON CONFLICT DO NOTHING
-	`, s.tableName), consumerName, messageID, now)
-	if err != nil {
-		return "", fmt.Errorf("insert inbox row: %w", err)
-	}
-	rows, err := result.RowsAffected()
-	if err == nil && rows > 0 {
-		return messaging.InboxDecisionAcquired, nil
-	}
+		) VALUES ($1, $2, 'processing', $3, $4, $5, $5)
+		ON CONFLICT (consumer_name, message_id) DO UPDATE
+		SET status = 'processing',
+		    error_text = '',
+		    lease_owner = EXCLUDED.lease_owner,
+		    lease_expires_at = EXCLUDED.lease_expires_at,
+		    started_at = EXCLUDED.started_at,
+		    updated_at = EXCLUDED.updated_at
+		WHERE inbox.status = 'failed'
+		   OR (inbox.status = 'processing' AND (inbox.lease_expires_at IS NULL OR inbox.lease_expires_at <= $5))
+	`, s.tableName)
 
-	var status string
-	if err := s.db.GetContext(ctx, &status, fmt.Sprintf(`
-		SELECT status
-		FROM %s
-		WHERE consumer_name = $1 AND message_id = $2
-	`, s.tableName), consumerName, messageID); err != nil {
-		if err != sql.ErrNoRows {
-			return "", fmt.Errorf("lookup inbox row: %w", err)
-		}
-		// Row disappeared between the INSERT conflict and the SELECT (e.g. cleaned up
-		// by a maintenance job). Retry the INSERT once; the slot is now free.
-		result2, err2 := s.db.ExecContext(ctx, fmt.Sprintf(`
-			INSERT INTO %s (
-				consumer_name,
-				message_id,
-				status,
-				started_at,
-				updated_at
-			) VALUES ($1, $2, 'processing', $3, $3)
-			ON CONFLICT DO NOTHING
-		`, s.tableName), consumerName, messageID, now)
-		if err2 != nil {
-			return "", fmt.Errorf("retry insert inbox row: %w", err2)
+	// A second attempt covers the row being pruned between the conflicting upsert and the lookup.
+	for range 2 {
+		result, err := s.db.ExecContext(ctx, claim, consumerName, messageID, lease.Owner, lease.ExpiresAt, now)
+		if err != nil {
+			return "", fmt.Errorf("claim inbox row: %w", err)
 		}
-		if rows2, err2 := result2.RowsAffected(); err2 == nil && rows2 > 0 {
+		if rows, err := result.RowsAffected(); err == nil && rows > 0 {
 			return messaging.InboxDecisionAcquired, nil
 		}
-		// Another consumer acquired it in the narrow window; treat as in-progress.
+
+		var status string
+		err = s.db.GetContext(ctx, &status, fmt.Sprintf(`
+			SELECT status
+			FROM %s
+			WHERE consumer_name = $1 AND message_id = $2
+		`, s.tableName), consumerName, messageID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("lookup inbox row: %w", err)
+		}
+		if status == "completed" {
+			return messaging.InboxDecisionDuplicate, nil
+		}
 		return messaging.InboxDecisionInProgress, nil
 	}
-	if status == "completed" {
-		return messaging.InboxDecisionDuplicate, nil
-	}
 	return messaging.InboxDecisionInProgress, nil
 }
 
-func (s *InboxStore) Complete(ctx context.Context, consumerName string, messageID string, processedAt time.Time) error {
-	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+func (s *InboxStore) Heartbeat(
+	ctx context.Context,
+	consumerName string,
+	messageID string,
+	lease messaging.InboxLease,
+	now time.Time,
+) error {
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET lease_expires_at = $4,
+		    updated_at = $5
+		WHERE consumer_name = $1
+		  AND message_id = $2
+		  AND status = 'processing'
+		  AND lease_owner = $3
+	`, s.tableName), consumerName, messageID, lease.Owner, lease.ExpiresAt, now)
+	if err != nil {
+		return fmt.Errorf("extend inbox lease: %w", err)
+	}
+	return requireLeaseHeld(result)
+}
+
+func (s *InboxStore) Complete(
+	ctx context.Context,
+	consumerName string,
+	messageID string,
+	owner string,
+	processedAt time.Time,
+) error {
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE %s
 		SET status = 'completed',
-		    processed_at = $3,
-		    updated_at = $3
-		WHERE consumer_name = $1 AND message_id = $2
-	`, s.tableName), consumerName, messageID, processedAt)
+		    lease_expires_at = NULL,
+		    processed_at = $4,
+		    updated_at = $4
+		WHERE consumer_name = $1
+		  AND message_id = $2
+		  AND status = 'processing'
+		  AND lease_owner = $3
+	`, s.tableName), consumerName, messageID, owner, processedAt)
 	if err != nil {
 		return fmt.Errorf("complete inbox row: %w", err)
 	}
-	return nil
+	return requireLeaseHeld(result)
 }
 
 func (s *InboxStore) Fail(
 	ctx context.Context,
 	consumerName string,
 	messageID string,
+	owner string,
 	errText string,
 	failedAt time.Time,
 ) error {
-	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE %s
 		SET status = 'failed',
-		    error_text = $3,
-		    updated_at = $4
-		WHERE consumer_name = $1 AND message_id = $2
-	`, s.tableName), consumerName, messageID, errText, failedAt)
+		    lease_expires_at = NULL,
+		    error_text = $4,
+		    updated_at = $5
+		WHERE consumer_name = $1
+		  AND message_id = $2
+		  AND status = 'processing'
+		  AND lease_owner = $3
+	`, s.tableName), consumerName, messageID, owner, errText, failedAt)
 	if err != nil {
 		return fmt.Errorf("fail inbox row: %w", err)
 	}
+	return requireLeaseHeld(result)
+}
+
+// PruneCompleted deletes the oldest completed rows first, at most limit per call.
+func (s *InboxStore) PruneCompleted(ctx context.Context, completedBefore time.Time, limit int) (int64, error) {
+	if limit <= 0 {
+		limit = 1000
+	}
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE (consumer_name, message_id) IN (
+			SELECT consumer_name, message_id
+			FROM %[1]s
+			WHERE status = 'completed' AND processed_at < $1
+			ORDER BY processed_at
+			LIMIT $2
+		)
+	`, s.tableName), completedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("prune inbox rows: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("prune inbox rows: %w", err)
+	}
+	return rows, nil
+}
+
+func requireLeaseHeld(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("inbox rows affected: %w", err)
+	}
+	if rows == 0 {
+		return messaging.ErrInboxLeaseLost
+	}
 	return nil
 }
 
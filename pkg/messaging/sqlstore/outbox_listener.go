@@ -0,0 +1,116 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/fx"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+// OutboxNotifyChannel is the channel the message_outbox insert trigger notifies; the service
+// migrations that create the trigger hard-code it.
+const OutboxNotifyChannel = "message_outbox"
+
+// OutboxListener LISTENs on the channel the outbox table's insert trigger notifies and turns
+// each notification into a relay wakeup. Wakeups coalesce: a relay busy draining sees one
+// pending wakeup however many rows were inserted meanwhile. Reconnects also wake the relay
+// because notifications sent while disconnected are lost.
+type OutboxListener struct {
+	listener *pq.Listener
+	channel  string
+	onError  func(error)
+	wakeups  chan struct{}
+	done     chan struct{}
+}
+
+var _ messaging.OutboxWakeup = (*OutboxListener)(nil)
+
+// NewOutboxListener connects in the background, so an unreachable database delays wakeups
+// instead of blocking startup; the relay keeps polling meanwhile.
+func NewOutboxListener(dsn string, channel string, onError func(error)) (*OutboxListener, error) {
+	if strings.TrimSpace(channel) == "" {
+		return nil, errors.New("outbox listener channel is required")
+	}
+	l := &OutboxListener{
+		channel: channel,
+		onError: onError,
+		wakeups: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	l.listener = pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			l.reportError(err)
+		}
+	})
+	go l.run()
+	return l, nil
+}
+
+// ListenForOutbox starts an OutboxListener on OutboxNotifyChannel when cfg.Notify is set and
+// closes it with the fx lifecycle. It connects to cfg.ListenURI when set, else dsn. It returns nil when
+// notifications are disabled and the relay only polls.
+func ListenForOutbox(
+	lc fx.Lifecycle,
+	log pdlog.Logger,
+	dsn string,
+	cfg messaging.OutboxRelayConfig,
+) (messaging.OutboxWakeup, error) {
+	if !cfg.Notify {
+		return nil, nil
+	}
+	if strings.TrimSpace(cfg.ListenURI) != "" {
+		dsn = cfg.ListenURI
+	}
+	listener, err := NewOutboxListener(dsn, OutboxNotifyChannel, func(err error) {
+		log.Warn("Outbox listener connection error", "channel", OutboxNotifyChannel, "error", err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error { return listener.Close() },
+	})
+	return listener, nil
+}
+
+func (l *OutboxListener) Wakeups() <-chan struct{} {
+	if l == nil {
+		return nil
+	}
+	return l.wakeups
+}
+
+func (l *OutboxListener) Close() error {
+	if l == nil {
+		return nil
+	}
+	err := l.listener.Close()
+	<-l.done
+	return err
+}
+
+func (l *OutboxListener) run() {
+	defer close(l.done)
+	if err := l.listener.Listen(l.channel); err != nil && !errors.Is(err, net.ErrClosed) {
+		l.reportError(err)
+	}
+	for range l.listener.Notify {
+		select {
+		case l.wakeups <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (l *OutboxListener) reportError(err error) {
+	if l.onError != nil {
+		l.onError(err)
+	}
+}
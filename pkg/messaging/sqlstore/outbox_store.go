@@ -1,9 +1,12 @@
 package sqlstore
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -17,7 +20,10 @@ type OutboxStore struct {
 	tableName string
 }
 
-var _ messaging.OutboxStore = (*OutboxStore)(nil)
+var (
+	_ messaging.OutboxStore        = (*OutboxStore)(nil)
+	_ messaging.OutboxRedriveStore = (*OutboxStore)(nil)
+)
 
 func NewOutboxStore(db *sqlx.DB, tableName string) (*OutboxStore, error) {
 	if tableName == "" {
@@ -66,21 +72,50 @@ func (s *OutboxStore) Append(ctx context.Context, tx messaging.Tx, record messag
 	return nil
 }
 
-func (s *OutboxStore) ListPending(ctx context.Context, limit int) ([]messaging.OutboxRecord, error) {
+// ClaimPending locks candidate rows with FOR UPDATE SKIP LOCKED, so concurrent relays split the
+// due rows between them, and stamps the lease in the same statement. A row is a candidate when
+// it is due, unleased (or its lease expired) and no earlier row with the same message key is
+// still pending or dead; rows with an empty key are unordered.
+func (s *OutboxStore) ClaimPending(
+	ctx context.Context,
+	lease messaging.OutboxLease,
+	limit int,
+	now time.Time,
+) ([]messaging.OutboxRecord, error) {
 	if limit <= 0 {
 		limit = 100
 	}
 
 	rows := []outboxRow{}
 	if err := s.db.SelectContext(ctx, &rows, fmt.Sprintf(`
-		SELECT id, topic, message_key, envelope_json, status, attempts,
-		       next_attempt_at, published_at, error_text, created_at, updated_at
-		FROM %s
-		WHERE status = 'pending' AND next_attempt_at <= now()
-		ORDER BY created_at ASC
-		LIMIT $1
-	`, s.tableName), limit); err != nil {
-		return nil, fmt.Errorf("list pending outbox rows: %w", err)
+		WITH claimable AS (
+			SELECT candidate.id
+			FROM %[1]s candidate
+			WHERE candidate.status = 'pending'
+			  AND candidate.next_attempt_at <= $3
+			  AND (candidate.lease_expires_at IS NULL OR candidate.lease_expires_at <= $3)
+			  AND (candidate.message_key = '' OR NOT EXISTS (
+				SELECT 1
+				FROM %[1]s earlier
+				WHERE earlier.message_key = candidate.message_key
+				  AND earlier.status IN ('pending', 'dead')
+				  AND (earlier.created_at, earlier.id) < (candidate.created_at, candidate.id)
+			  ))
+			ORDER BY candidate.created_at, candidate.id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE %[1]s AS target
+		SET lease_owner = $1,
+		    lease_expires_at = $2,
+		    updated_at = $3
+		FROM claimable
+		WHERE target.id = claimable.id
+		RETURNING target.id, target.topic, target.message_key, target.envelope_json, target.status,
+		          target.attempts, target.next_attempt_at, target.published_at, target.error_text,
+		          target.created_at, target.updated_at
+	`, s.tableName), lease.Owner, lease.ExpiresAt, now, limit); err != nil {
+		return nil, fmt.Errorf("claim pending outbox rows: %w", err)
 	}
 
 	records := make([]messaging.OutboxRecord, 0, len(rows))
@@ -91,39 +126,142 @@ func (s *OutboxStore) ListPending(ctx context.Context, limit int) ([]messaging.O
 		}
 		records = append(records, record)
 	}
+	// RETURNING does not keep the CTE order.
+	slices.SortFunc(records, func(a, b messaging.OutboxRecord) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
 	return records, nil
 }
 
-func (s *OutboxStore) MarkPublished(ctx context.Context, ids []string, publishedAt time.Time) error {
+func (s *OutboxStore) MarkPublished(ctx context.Context, owner string, ids []string, publishedAt time.Time) error {
 	if len(ids) == 0 {
 		return nil
 	}
-	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE %s
 		SET status = 'published',
-		    published_at = $2,
-		    updated_at = $2
+		    lease_owner = '',
+		    lease_expires_at = NULL,
+		    published_at = $3,
+		    updated_at = $3
 		WHERE id = ANY($1)
-	`, s.tableName), pq.Array(ids), publishedAt)
+		  AND lease_owner = $2
+	`, s.tableName), pq.Array(ids), owner, publishedAt)
 	if err != nil {
 		return fmt.Errorf("mark outbox rows published: %w", err)
 	}
-	return nil
+	return requireOutboxRows(result, len(ids))
 }
 
-func (s *OutboxStore) MarkFailed(ctx context.Context, id string, errText string, nextAttemptAt time.Time) error {
-	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+func (s *OutboxStore) MarkFailed(
+	ctx context.Context,
+	owner string,
+	id string,
+	errText string,
+	failedAt time.Time,
+	nextAttemptAt time.Time,
+) error {
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE %s
 		SET status = 'pending',
 		    attempts = attempts + 1,
-		    error_text = $2,
-		    next_attempt_at = $3,
-		    updated_at = now()
+		    lease_owner = '',
+		    lease_expires_at = NULL,
+		    error_text = $3,
+		    next_attempt_at = $4,
+		    updated_at = $5
 		WHERE id = $1
-	`, s.tableName), id, errText, nextAttemptAt)
+		  AND lease_owner = $2
+	`, s.tableName), id, owner, errText, nextAttemptAt, failedAt)
 	if err != nil {
 		return fmt.Errorf("mark outbox row failed: %w", err)
 	}
+	return requireOutboxRows(result, 1)
+}
+
+// MarkDead takes the row out of the pending set. It keeps holding back its message key until
+// RedriveDead returns it to pending.
+func (s *OutboxStore) MarkDead(ctx context.Context, owner string, id string, errText string, deadAt time.Time) error {
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET status = 'dead',
+		    attempts = attempts + 1,
+		    lease_owner = '',
+		    lease_expires_at = NULL,
+		    error_text = $3,
+		    updated_at = $4
+		WHERE id = $1
+		  AND lease_owner = $2
+	`, s.tableName), id, owner, errText, deadAt)
+	if err != nil {
+		return fmt.Errorf("mark outbox row dead: %w", err)
+	}
+	return requireOutboxRows(result, 1)
+}
+
+// ListDead returns dead rows oldest first, the order they block their keys in.
+func (s *OutboxStore) ListDead(
+	ctx context.Context,
+	filter messaging.DeadOutboxFilter,
+) ([]messaging.OutboxRecord, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	rows := []outboxRow{}
+	if err := s.db.SelectContext(ctx, &rows, fmt.Sprintf(`
+		SELECT id, topic, message_key, envelope_json, status, attempts, next_attempt_at,
+		       published_at, error_text, created_at, updated_at
+		FROM %s
+		WHERE status = 'dead'
+		  AND ($1 = '' OR topic = $1)
+		  AND ($2 = '' OR message_key = $2)
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4
+	`, s.tableName), filter.Topic, filter.MessageKey, limit, filter.Offset); err != nil {
+		return nil, fmt.Errorf("list dead outbox rows: %w", err)
+	}
+
+	records := make([]messaging.OutboxRecord, 0, len(rows))
+	for _, row := range rows {
+		record, err := row.toRecord()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// RedriveDead keeps error_text so the last failure stays visible until the row publishes.
+func (s *OutboxStore) RedriveDead(ctx context.Context, ids []string, now time.Time) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	redriven := []string{}
+	if err := s.db.SelectContext(ctx, &redriven, fmt.Sprintf(`
+		UPDATE %s
+		SET status = 'pending',
+		    attempts = 0,
+		    next_attempt_at = $2,
+		    updated_at = $2
+		WHERE id = ANY($1)
+		  AND status = 'dead'
+		RETURNING id
+	`, s.tableName), pq.Array(ids), now); err != nil {
+		return nil, fmt.Errorf("redrive dead outbox rows: %w", err)
+	}
+	return redriven, nil
+}
+
+func requireOutboxRows(result sql.Result, want int) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("read outbox rows affected: %w", err)
+	}
+	if affected < int64(want) {
+		return fmt.Errorf("%w: %d of %d rows", messaging.ErrOutboxLeaseLost, want-int(affected), want)
+	}
 	return nil
 }
 
@@ -62,3 +62,16 @@ func TestOutboxRowToRecord(t *testing.T) {
 	assert.Equal(t, envelope, record.Envelope)
 	assert.Equal(t, 2, record.Attempts)
 }
+
+type rowsAffected int64
+
+func (r rowsAffected) LastInsertId() (int64, error) { return 0, nil }
+func (r rowsAffected) RowsAffected() (int64, error) { return int64(r), nil }
+
+func TestRequireOutboxRows_ReportsLostLease(t *testing.T) {
+	require.NoError(t, requireOutboxRows(rowsAffected(2), 2))
+
+	err := requireOutboxRows(rowsAffected(1), 3)
+	require.ErrorIs(t, err, messaging.ErrOutboxLeaseLost)
+	assert.EqualError(t, err, "messaging: outbox lease lost: 2 of 3 rows")
+}
@@ -0,0 +1,292 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+const sagaColumns = `id, definition, status, current_step, attempts, envelope_json, data_json,
+	error_text, next_attempt_at, deadline_at, lease_owner, lease_expires_at, version,
+	created_at, updated_at, finished_at`
+
+type SagaStore struct {
+	db        *sqlx.DB
+	tableName string
+}
+
+var _ messaging.SagaStore = (*SagaStore)(nil)
+
+func NewSagaStore(db *sqlx.DB, tableName string) (*SagaStore, error) {
+	if tableName == "" {
+		tableName = "saga_instances"
+	}
+	if err := validateQualifiedIdentifier(tableName); err != nil {
+		return nil, err
+	}
+	return &SagaStore{db: db, tableName: tableName}, nil
+}
+
+func (s *SagaStore) Create(ctx context.Context, instance messaging.SagaInstance) error {
+	row, err := newSagaRow(instance)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.NamedExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES (:id, :definition, :status, :current_step, :attempts, :envelope_json, :data_json,
+		        :error_text, :next_attempt_at, :deadline_at, :lease_owner, :lease_expires_at, :version,
+		        :created_at, :updated_at, :finished_at)
+	`, s.tableName, sagaColumns), row)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return messaging.ErrSagaExists
+	}
+	if err != nil {
+		return fmt.Errorf("insert saga: %w", err)
+	}
+	return nil
+}
+
+func (s *SagaStore) Get(ctx context.Context, id string) (messaging.SagaInstance, error) {
+	var row sagaRow
+	err := s.db.GetContext(ctx, &row, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1
+	`, sagaColumns, s.tableName), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return messaging.SagaInstance{}, messaging.ErrSagaNotFound
+	}
+	if err != nil {
+		return messaging.SagaInstance{}, fmt.Errorf("get saga: %w", err)
+	}
+	return row.toInstance()
+}
+
+// List returns the most recently updated sagas first.
+func (s *SagaStore) List(ctx context.Context, filter messaging.SagaFilter) ([]messaging.SagaInstance, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if filter.Definition != "" {
+		args = append(args, filter.Definition)
+		where = append(where, fmt.Sprintf("definition = $%d", len(args)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		args = append(args, pq.Array(statuses))
+		where = append(where, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+	if filter.StuckBefore != nil {
+		args = append(args, *filter.StuckBefore)
+		where = append(where, fmt.Sprintf("updated_at < $%d", len(args)))
+	}
+	clause := ""
+	if len(where) > 0 {
+		clause = "WHERE " + strings.Join(where, " AND ")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit, max(filter.Offset, 0))
+
+	rows := []sagaRow{}
+	if err := s.db.SelectContext(ctx, &rows, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s
+		ORDER BY updated_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, sagaColumns, s.tableName, clause, len(args)-1, len(args)), args...); err != nil {
+		return nil, fmt.Errorf("list sagas: %w", err)
+	}
+	return sagaRowsToInstances(rows)
+}
+
+func (s *SagaStore) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	ids := []string{}
+	if err := s.db.SelectContext(ctx, &ids, fmt.Sprintf(`
+		SELECT id
+		FROM %s
+		WHERE status IN ('running', 'compensating')
+		  AND next_attempt_at <= $1
+		  AND (lease_expires_at IS NULL OR lease_expires_at <= $1)
+		ORDER BY next_attempt_at
+		LIMIT $2
+	`, s.tableName), now, limit); err != nil {
+		return nil, fmt.Errorf("list due sagas: %w", err)
+	}
+	return ids, nil
+}
+
+// Claim leases the saga with a single conditional update, so only one orchestrator wins a
+// given attempt.
+func (s *SagaStore) Claim(
+	ctx context.Context,
+	id string,
+	lease messaging.SagaLease,
+	now time.Time,
+) (messaging.SagaInstance, error) {
+	var row sagaRow
+	err := s.db.GetContext(ctx, &row, fmt.Sprintf(`
+		UPDATE %s
+		SET lease_owner = $2,
+		    lease_expires_at = $3,
+		    attempts = attempts + 1,
+		    version = version + 1,
+		    updated_at = $4
+		WHERE id = $1
+		  AND status IN ('running', 'compensating')
+		  AND next_attempt_at <= $4
+		  AND (lease_expires_at IS NULL OR lease_expires_at <= $4)
+		RETURNING %s
+	`, s.tableName, sagaColumns), id, lease.Owner, lease.ExpiresAt, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return messaging.SagaInstance{}, messaging.ErrSagaNotClaimable
+	}
+	if err != nil {
+		return messaging.SagaInstance{}, fmt.Errorf("claim saga: %w", err)
+	}
+	return row.toInstance()
+}
+
+func (s *SagaStore) Save(ctx context.Context, instance messaging.SagaInstance) error {
+	row, err := newSagaRow(instance)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.NamedExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET status = :status,
+		    current_step = :current_step,
+		    attempts = :attempts,
+		    data_json = :data_json,
+		    error_text = :error_text,
+		    next_attempt_at = :next_attempt_at,
+		    deadline_at = :deadline_at,
+		    lease_owner = '',
+		    lease_expires_at = NULL,
+		    version = version + 1,
+		    updated_at = :updated_at,
+		    finished_at = :finished_at
+		WHERE id = :id AND version = :version
+	`, s.tableName), row)
+	if err != nil {
+		return fmt.Errorf("save saga: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("saga rows affected: %w", err)
+	}
+	if rows == 0 {
+		return messaging.ErrSagaConflict
+	}
+	return nil
+}
+
+type sagaRow struct {
+	ID             string     `db:"id"`
+	Definition     string     `db:"definition"`
+	Status         string     `db:"status"`
+	CurrentStep    int        `db:"current_step"`
+	Attempts       int        `db:"attempts"`
+	EnvelopeJSON   []byte     `db:"envelope_json"`
+	DataJSON       []byte     `db:"data_json"`
+	ErrorText      string     `db:"error_text"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	DeadlineAt     *time.Time `db:"deadline_at"`
+	LeaseOwner     string     `db:"lease_owner"`
+	LeaseExpiresAt *time.Time `db:"lease_expires_at"`
+	Version        int        `db:"version"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	FinishedAt     *time.Time `db:"finished_at"`
+}
+
+func newSagaRow(instance messaging.SagaInstance) (sagaRow, error) {
+	envelopeJSON, err := json.Marshal(instance.Envelope)
+	if err != nil {
+		return sagaRow{}, fmt.Errorf("marshal saga envelope: %w", err)
+	}
+	var dataJSON []byte
+	if len(instance.Data) > 0 {
+		dataJSON = instance.Data
+	}
+	return sagaRow{
+		ID:             instance.ID,
+		Definition:     instance.Definition,
+		Status:         string(instance.Status),
+		CurrentStep:    instance.CurrentStep,
+		Attempts:       instance.Attempts,
+		EnvelopeJSON:   envelopeJSON,
+		DataJSON:       dataJSON,
+		ErrorText:      instance.ErrorText,
+		NextAttemptAt:  instance.NextAttemptAt,
+		DeadlineAt:     instance.DeadlineAt,
+		LeaseOwner:     instance.LeaseOwner,
+		LeaseExpiresAt: instance.LeaseExpiresAt,
+		Version:        instance.Version,
+		CreatedAt:      instance.CreatedAt,
+		UpdatedAt:      instance.UpdatedAt,
+		FinishedAt:     instance.FinishedAt,
+	}, nil
+}
+
+func (r sagaRow) toInstance() (messaging.SagaInstance, error) {
+	var envelope messaging.Envelope
+	if err := json.Unmarshal(r.EnvelopeJSON, &envelope); err != nil {
+		return messaging.SagaInstance{}, fmt.Errorf("unmarshal saga envelope %q: %w", r.ID, err)
+	}
+	var data json.RawMessage
+	if len(r.DataJSON) > 0 {
+		data = json.RawMessage(r.DataJSON)
+	}
+	return messaging.SagaInstance{
+		ID:             r.ID,
+		Definition:     r.Definition,
+		Status:         messaging.SagaStatus(r.Status),
+		CurrentStep:    r.CurrentStep,
+		Attempts:       r.Attempts,
+		Envelope:       envelope,
+		Data:           data,
+		ErrorText:      r.ErrorText,
+		NextAttemptAt:  r.NextAttemptAt,
+		DeadlineAt:     r.DeadlineAt,
+		LeaseOwner:     r.LeaseOwner,
+		LeaseExpiresAt: r.LeaseExpiresAt,
+		Version:        r.Version,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		FinishedAt:     r.FinishedAt,
+	}, nil
+}
+
+func sagaRowsToInstances(rows []sagaRow) ([]messaging.SagaInstance, error) {
+	instances := make([]messaging.SagaInstance, 0, len(rows))
+	for _, row := range rows {
+		instance, err := row.toInstance()
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
@@ -0,0 +1,63 @@
+package sqlstore
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/messaging"
+)
+
+func TestNewSagaStore_DefaultTableName(t *testing.T) {
+	store, err := NewSagaStore(nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "saga_instances", store.tableName)
+}
+
+func TestNewSagaStore_RejectsInvalidIdentifier(t *testing.T) {
+	store, err := NewSagaStore(nil, "saga_instances;drop table users")
+	require.Error(t, err)
+	assert.Nil(t, store)
+}
+
+func TestSagaRow_RoundTrip(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	deadline := now.Add(time.Hour)
+	instance := messaging.SagaInstance{
+		ID:          "s1",
+		Definition:  "provision-store",
+		Status:      messaging.SagaStatusCompensating,
+		CurrentStep: 1,
+		Attempts:    2,
+		Envelope: messaging.Envelope{
+			ID:            "evt_1",
+			Type:          "store.requested",
+			Source:        "onboarding",
+			OccurredAt:    now,
+			SchemaVersion: 1,
+			Payload:       json.RawMessage(`{"store_id":"st1"}`),
+		},
+		Data:          json.RawMessage(`{"cluster":"c1"}`),
+		ErrorText:     "route: router down",
+		NextAttemptAt: now,
+		DeadlineAt:    &deadline,
+		Version:       4,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	row, err := newSagaRow(instance)
+	require.NoError(t, err)
+	got, err := row.toInstance()
+	require.NoError(t, err)
+	assert.Equal(t, instance, got)
+}
+
+func TestSagaRow_EmptyDataIsNull(t *testing.T) {
+	row, err := newSagaRow(messaging.SagaInstance{ID: "s1"})
+	require.NoError(t, err)
+	assert.Nil(t, row.DataJSON)
+}
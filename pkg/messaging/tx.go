@@ -0,0 +1,38 @@
+package messaging
+
+import "context"
+
+type txContextKey struct{}
+
+// ContextWithTx returns a copy of ctx that carries tx, so stores called further down the same
+// unit of work can append to it instead of opening their own connection.
+func ContextWithTx(ctx context.Context, tx Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext returns the transaction set by ContextWithTx, or nil.
+func TxFromContext(ctx context.Context) Tx {
+	return ctx.Value(txContextKey{})
+}
+
+type consumedRecordContextKey struct{}
+
+// ConsumedRecord locates the broker record an Envelope was decoded from.
+type ConsumedRecord struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       string
+}
+
+// ContextWithConsumedRecord returns a copy of ctx that carries record, so a handler can see
+// where the message it is handling came from.
+func ContextWithConsumedRecord(ctx context.Context, record ConsumedRecord) context.Context {
+	return context.WithValue(ctx, consumedRecordContextKey{}, record)
+}
+
+// ConsumedRecordFromContext returns the record set by ContextWithConsumedRecord.
+func ConsumedRecordFromContext(ctx context.Context) (ConsumedRecord, bool) {
+	record, ok := ctx.Value(consumedRecordContextKey{}).(ConsumedRecord)
+	return record, ok
+}
@@ -0,0 +1,16 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxFromContext(t *testing.T) {
+	assert.Nil(t, TxFromContext(context.Background()))
+
+	tx := &struct{ name string }{name: "tx"}
+	ctx := ContextWithTx(context.Background(), tx)
+	assert.Same(t, tx, TxFromContext(ctx))
+}
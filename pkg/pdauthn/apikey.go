@@ -0,0 +1,198 @@
+package pdauthn
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// APIKeyTokenPrefix marks bearer credentials that are API keys rather than JWTs.
+	APIKeyTokenPrefix = "pzk_"
+	// APIKeyIntrospectionPath is served by the auth HTTP port next to the JWKS document.
+	APIKeyIntrospectionPath = "/internal/api-keys/introspect"
+
+	apiKeyLookupIDBytes      = 10
+	apiKeySecretBytes        = 32
+	defaultAPIKeyCacheTTL    = 30 * time.Second
+	apiKeyIntrospectTimeout  = 5 * time.Second
+	maxAPIKeyIntrospectBytes = 64 << 10
+	maxAPIKeyCacheEntries    = 4096
+)
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+var apiKeyLookupEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// APIKeyResolver turns a raw API key into the claims of its owner.
+type APIKeyResolver interface {
+	ResolveAPIKey(ctx context.Context, apiKey string) (*Claims, error)
+}
+
+// APIKeyIntrospectionRequest is the body POSTed to APIKeyIntrospectionPath; the response is
+// the Claims document.
+type APIKeyIntrospectionRequest struct {
+	APIKey string `json:"api_key"`
+}
+
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyTokenPrefix)
+}
+
+// GenerateAPIKey returns a raw key of the form pzk_<lookup id>_<secret>. The lookup id is
+// stored in clear to find the key; only a hash of the whole key is stored.
+func GenerateAPIKey() (raw, lookupID string, err error) {
+	idBytes := make([]byte, apiKeyLookupIDBytes)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", "", err
+	}
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	lookupID = strings.ToLower(apiKeyLookupEncoding.EncodeToString(idBytes))
+	return APIKeyTokenPrefix + lookupID + "_" + base64.RawURLEncoding.EncodeToString(secret), lookupID, nil
+}
+
+// ParseAPIKey returns the lookup id of a well-formed raw key.
+func ParseAPIKey(raw string) (string, bool) {
+	if !IsAPIKey(raw) {
+		return "", false
+	}
+	lookupID, secret, ok := strings.Cut(strings.TrimPrefix(raw, APIKeyTokenPrefix), "_")
+	if !ok || lookupID == "" || secret == "" {
+		return "", false
+	}
+	return lookupID, true
+}
+
+// IntrospectionAPIKeyResolver asks the auth service about API keys and caches valid answers
+// for a short TTL, so a revoked key stops working within that TTL.
+type IntrospectionAPIKeyResolver struct {
+	url    string
+	client *http.Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedClaims
+}
+
+type cachedClaims struct {
+	claims    Claims
+	expiresAt time.Time
+}
+
+func NewIntrospectionAPIKeyResolver(introspectionURL string, cacheTTL time.Duration) *IntrospectionAPIKeyResolver {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultAPIKeyCacheTTL
+	}
+	return &IntrospectionAPIKeyResolver{
+		url:    introspectionURL,
+		client: &http.Client{Timeout: apiKeyIntrospectTimeout},
+		ttl:    cacheTTL,
+		now:    time.Now,
+		cache:  make(map[string]cachedClaims),
+	}
+}
+
+func (r *IntrospectionAPIKeyResolver) ResolveAPIKey(ctx context.Context, apiKey string) (*Claims, error) {
+	sum := sha256.Sum256([]byte(apiKey))
+	cacheKey := hex.EncodeToString(sum[:])
+	now := r.now()
+
+	r.mu.Lock()
+	cached, ok := r.cache[cacheKey]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		claims := cached.claims
+		return &claims, nil
+	}
+
+	claims, err := r.introspect(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := now.Add(r.ttl)
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	r.mu.Lock()
+	if len(r.cache) >= maxAPIKeyCacheEntries {
+		for key, entry := range r.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(r.cache, key)
+			}
+		}
+	}
+	if len(r.cache) < maxAPIKeyCacheEntries {
+		r.cache[cacheKey] = cachedClaims{claims: *claims, expiresAt: expiresAt}
+	}
+	r.mu.Unlock()
+	return claims, nil
+}
+
+func (r *IntrospectionAPIKeyResolver) introspect(ctx context.Context, apiKey string) (*Claims, error) {
+	body, err := json.Marshal(APIKeyIntrospectionRequest{APIKey: apiKey})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, apiKeyIntrospectTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build api key introspection request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspect api key: %w", err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusNotFound:
+		return nil, ErrInvalidAPIKey
+	default:
+		return nil, fmt.Errorf("introspect api key: unexpected status %d", resp.StatusCode)
+	}
+	var claims Claims
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxAPIKeyIntrospectBytes)).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("decode api key claims: %w", err)
+	}
+	return &claims, nil
+}
+
+// apiKeyIntrospectionURL uses the configured URL, or the introspection path on the host
+// that serves the JWKS document.
+func apiKeyIntrospectionURL(cfg Config) string {
+	if cfg.APIKeyIntrospectionURL != "" {
+		return cfg.APIKeyIntrospectionURL
+	}
+	if cfg.JWKSURL == "" {
+		return ""
+	}
+	parsed, err := url.Parse(cfg.JWKSURL)
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	parsed.Path = APIKeyIntrospectionPath
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
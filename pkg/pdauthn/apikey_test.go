@@ -0,0 +1,102 @@
+package pdauthn
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type introspectionServer struct {
+	mu     sync.Mutex
+	claims map[string]Claims
+	calls  int
+}
+
+func (s *introspectionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	var req APIKeyIntrospectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	claims, ok := s.claims[req.APIKey]
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(claims)
+}
+
+func TestGenerateAPIKey_RoundTripsLookupID(t *testing.T) {
+	raw, lookupID, err := GenerateAPIKey()
+	require.NoError(t, err)
+	require.True(t, IsAPIKey(raw))
+
+	parsed, ok := ParseAPIKey(raw)
+	require.True(t, ok)
+	require.Equal(t, lookupID, parsed)
+
+	_, ok = ParseAPIKey("pzk_missing-secret")
+	require.False(t, ok)
+}
+
+func TestVerifier_ResolvesAPIKeysThroughJWKSHost(t *testing.T) {
+	raw, _, err := GenerateAPIKey()
+	require.NoError(t, err)
+	server := &introspectionServer{claims: map[string]Claims{
+		raw: {UserID: 7, APIKeyID: "key-1", ActiveTenantID: "tenant-1"},
+	}}
+	mux := http.NewServeMux()
+	mux.Handle(APIKeyIntrospectionPath, server)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	verifier := NewVerifier(Config{JWKSURL: ts.URL + "/.well-known/jwks.json"})
+	for range 2 {
+		claims, err := verifier.ClaimsFromTokenString(raw)
+		require.NoError(t, err)
+		require.Equal(t, uint(7), claims.UserID)
+		require.Equal(t, "key-1", claims.APIKeyID)
+	}
+	require.Equal(t, 1, server.calls, "valid answers are cached")
+
+	_, err = verifier.ClaimsFromTokenString("pzk_unknown_secret")
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestVerifier_RejectsAPIKeysWithoutResolver(t *testing.T) {
+	verifier := NewVerifier(Config{JWTSecret: "secret"})
+	_, err := verifier.ClaimsFromTokenString("pzk_abc_def")
+	require.Error(t, err)
+}
+
+func TestIntrospectionAPIKeyResolver_CacheEndsAtKeyExpiry(t *testing.T) {
+	now := time.Now()
+	server := &introspectionServer{claims: map[string]Claims{
+		"pzk_a_b": {
+			UserID:           7,
+			APIKeyID:         "key-1",
+			RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Second))},
+		},
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	resolver := NewIntrospectionAPIKeyResolver(ts.URL, time.Minute)
+	resolver.now = func() time.Time { return now }
+	_, err := resolver.ResolveAPIKey(t.Context(), "pzk_a_b")
+	require.NoError(t, err)
+
+	resolver.now = func() time.Time { return now.Add(10 * time.Second) }
+	_, err = resolver.ResolveAPIKey(t.Context(), "pzk_a_b")
+	require.NoError(t, err)
+	require.Equal(t, 2, server.calls)
+}
@@ -0,0 +1,94 @@
+package pdauthn
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// JWKS is the RFC 7517 document the auth service publishes for token verification.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK carries only public key material; RSA keys use n/e and Ed25519 keys use crv/x.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+func NewJWK(kid, algorithm string, public crypto.PublicKey) (JWK, error) {
+	encode := base64.RawURLEncoding.EncodeToString
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		if algorithm != AlgorithmRS256 {
+			return JWK{}, fmt.Errorf("rsa key %s cannot use %s", kid, algorithm)
+		}
+		return JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: algorithm,
+			N:   encode(key.N.Bytes()),
+			E:   encode(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		if algorithm != AlgorithmEdDSA {
+			return JWK{}, fmt.Errorf("ed25519 key %s cannot use %s", kid, algorithm)
+		}
+		return JWK{Kty: "OKP", Kid: kid, Use: "sig", Alg: algorithm, Crv: "Ed25519", X: encode(key)}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", public)
+	}
+}
+
+// VerificationKey decodes the public key and the algorithm tokens signed with it must use.
+func (k JWK) VerificationKey() (VerificationKey, error) {
+	decode := base64.RawURLEncoding.DecodeString
+	switch k.Kty {
+	case "RSA":
+		if k.Alg != "" && k.Alg != AlgorithmRS256 {
+			return VerificationKey{}, fmt.Errorf("jwk %s: unsupported rsa algorithm %q", k.Kid, k.Alg)
+		}
+		n, err := decode(k.N)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("jwk %s: decode n: %w", k.Kid, err)
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("jwk %s: decode e: %w", k.Kid, err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 {
+			return VerificationKey{}, fmt.Errorf("jwk %s: invalid rsa public key", k.Kid)
+		}
+		return VerificationKey{
+			Algorithm: AlgorithmRS256,
+			PublicKey: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())},
+		}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" || (k.Alg != "" && k.Alg != AlgorithmEdDSA) {
+			return VerificationKey{}, fmt.Errorf("jwk %s: unsupported okp key", k.Kid)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("jwk %s: decode x: %w", k.Kid, err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return VerificationKey{}, fmt.Errorf("jwk %s: invalid ed25519 public key", k.Kid)
+		}
+		return VerificationKey{Algorithm: AlgorithmEdDSA, PublicKey: ed25519.PublicKey(x)}, nil
+	default:
+		return VerificationKey{}, errors.New("jwk " + k.Kid + ": unsupported key type " + k.Kty)
+	}
+}
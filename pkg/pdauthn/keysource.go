@@ -0,0 +1,176 @@
+package pdauthn
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSRefreshInterval = 5 * time.Minute
+	// defaultJWKSMinRefreshInterval rate-limits refetches triggered by unknown kids.
+	defaultJWKSMinRefreshInterval = 30 * time.Second
+	// defaultRetiredKeyGrace outlives the longest access token the auth service issues.
+	defaultRetiredKeyGrace = 25 * time.Hour
+	jwksFetchTimeout       = 5 * time.Second
+	maxJWKSBytes           = 1 << 20
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+type VerificationKey struct {
+	Algorithm string
+	PublicKey crypto.PublicKey
+}
+
+// KeySource resolves the public key a token's kid header names.
+type KeySource interface {
+	VerificationKey(kid string) (VerificationKey, error)
+}
+
+// JWKSKeySource fetches and caches the auth service's JWKS document. Keys that drop out of
+// the document stay usable for RetiredKeyGrace so tokens signed just before a rotation verify.
+type JWKSKeySource struct {
+	url                string
+	client             *http.Client
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+	grace              time.Duration
+	now                func() time.Time
+
+	fetchMu sync.Mutex
+
+	mu          sync.Mutex
+	keys        map[string]cachedKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+type cachedKey struct {
+	key       VerificationKey
+	retiredAt time.Time
+}
+
+func NewJWKSKeySource(cfg Config) *JWKSKeySource {
+	refresh := cfg.JWKSRefreshInterval
+	if refresh <= 0 {
+		refresh = defaultJWKSRefreshInterval
+	}
+	grace := cfg.RetiredKeyGrace
+	if grace <= 0 {
+		grace = defaultRetiredKeyGrace
+	}
+	return &JWKSKeySource{
+		url:                cfg.JWKSURL,
+		client:             &http.Client{Timeout: jwksFetchTimeout},
+		refreshInterval:    refresh,
+		minRefreshInterval: min(defaultJWKSMinRefreshInterval, refresh),
+		grace:              grace,
+		now:                time.Now,
+		keys:               make(map[string]cachedKey),
+	}
+}
+
+func (s *JWKSKeySource) VerificationKey(kid string) (VerificationKey, error) {
+	if kid == "" {
+		return VerificationKey{}, ErrUnknownSigningKey
+	}
+	now := s.now()
+	s.mu.Lock()
+	_, known := s.keys[kid]
+	stale := now.Sub(s.fetchedAt) >= s.refreshInterval
+	s.mu.Unlock()
+	if !known || stale {
+		// A failed refresh keeps serving the cached keys; the next miss retries.
+		_ = s.refresh(now, !known)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cached, ok := s.keys[kid]
+	if !ok || (!cached.retiredAt.IsZero() && now.Sub(cached.retiredAt) > s.grace) {
+		return VerificationKey{}, ErrUnknownSigningKey
+	}
+	return cached.key, nil
+}
+
+func (s *JWKSKeySource) refresh(now time.Time, unknownKid bool) error {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	s.mu.Lock()
+	sinceAttempt := now.Sub(s.attemptedAt)
+	fresh := now.Sub(s.fetchedAt) < s.refreshInterval
+	s.mu.Unlock()
+	if sinceAttempt < s.minRefreshInterval || (fresh && !unknownKid) {
+		return nil
+	}
+
+	s.mu.Lock()
+	s.attemptedAt = now
+	s.mu.Unlock()
+
+	doc, err := s.fetch()
+	if err != nil {
+		return err
+	}
+	fetched := make(map[string]VerificationKey, len(doc.Keys))
+	for _, jwk := range doc.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.VerificationKey()
+		if err != nil {
+			continue
+		}
+		fetched[jwk.Kid] = key
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for kid, cached := range s.keys {
+		if _, ok := fetched[kid]; ok {
+			continue
+		}
+		if cached.retiredAt.IsZero() {
+			cached.retiredAt = now
+			s.keys[kid] = cached
+		} else if now.Sub(cached.retiredAt) > s.grace {
+			delete(s.keys, kid)
+		}
+	}
+	for kid, key := range fetched {
+		s.keys[kid] = cachedKey{key: key}
+	}
+	s.fetchedAt = now
+	return nil
+}
+
+func (s *JWKSKeySource) fetch() (JWKS, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return JWKS{}, fmt.Errorf("build jwks request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return JWKS{}, fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return JWKS{}, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+	var doc JWKS
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxJWKSBytes)).Decode(&doc); err != nil {
+		return JWKS{}, fmt.Errorf("decode jwks: %w", err)
+	}
+	return doc, nil
+}
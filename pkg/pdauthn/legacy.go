@@ -0,0 +1,32 @@
+package pdauthn
+
+import (
+	"strings"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+// LegacyHS256UntilEnv overrides every service's legacy_hs256_until setting.
+const LegacyHS256UntilEnv = "JWT_LEGACY_HS256_UNTIL"
+
+// LoadLegacyHS256Until reads the RFC 3339 deadline for accepting HS256 tokens next to a JWKS
+// from LegacyHS256UntilEnv or, when that is unset, from key. Unset or unparsable values
+// return the zero time, which rejects HS256 whenever a JWKS is configured.
+func LoadLegacyHS256Until(k *koanf.Koanf, key string) time.Time {
+	raw := toolkit.GetEnv(LegacyHS256UntilEnv, "")
+	if raw == "" && k != nil {
+		raw = k.String(key)
+	}
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.HasPrefix(raw, "${") {
+		return time.Time{}
+	}
+	until, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return until
+}
@@ -0,0 +1,106 @@
+package pdauthn
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	rsaKeyBits = 2048
+)
+
+// SigningKey is one asymmetric key the auth service signs access tokens with.
+// ID is published as the token's kid header and in the JWKS document.
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+}
+
+func GenerateSigningKey(id, algorithm string) (SigningKey, error) {
+	key := SigningKey{ID: id, Algorithm: algorithm}
+	switch algorithm {
+	case AlgorithmRS256:
+		private, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return SigningKey{}, fmt.Errorf("generate rsa key: %w", err)
+		}
+		key.PrivateKey = private
+	case AlgorithmEdDSA:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return SigningKey{}, fmt.Errorf("generate ed25519 key: %w", err)
+		}
+		key.PrivateKey = private
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+	return key, nil
+}
+
+// Sign serializes claims as a JWT carrying the key id in its header.
+func (k SigningKey) Sign(claims jwt.Claims) (string, error) {
+	method, err := asymmetricMethod(k.Algorithm)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = k.ID
+	return token.SignedString(k.PrivateKey)
+}
+
+func (k SigningKey) PublicJWK() (JWK, error) {
+	return NewJWK(k.ID, k.Algorithm, k.PrivateKey.Public())
+}
+
+// MarshalPrivateKey encodes the private key as PKCS#8 PEM for storage.
+func (k SigningKey) MarshalPrivateKey() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signing key %s: %w", k.ID, err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func ParseSigningKey(id, algorithm string, privatePEM []byte) (SigningKey, error) {
+	block, _ := pem.Decode(privatePEM)
+	if block == nil {
+		return SigningKey{}, errors.New("signing key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("parse signing key %s: %w", id, err)
+	}
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return SigningKey{}, fmt.Errorf("signing key %s cannot sign", id)
+	}
+	key := SigningKey{ID: id, Algorithm: algorithm, PrivateKey: signer}
+	if _, err := key.PublicJWK(); err != nil {
+		return SigningKey{}, err
+	}
+	return key, nil
+}
+
+func asymmetricMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
@@ -1,6 +1,10 @@
 package pdauthn
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type PolicyStatement struct {
 	Effect          string            `json:"effect"`
@@ -31,11 +35,24 @@ type Claims struct {
 	AssumedRoleServicePrincipal string            `json:"assumed_role_service_principal,omitempty"`
 	AssumedRoleSessionName      string            `json:"assumed_role_session_name,omitempty"`
 	AssumedRoleSourceIdentity   string            `json:"assumed_role_source_identity,omitempty"`
+	MultiFactorAuthPresent      bool              `json:"mfa_present,omitempty"`
+	AuthTime                    *jwt.NumericDate  `json:"auth_time,omitempty"`
+	APIKeyID                    string            `json:"api_key_id,omitempty"`
 	Key                         string            `json:"key"`
 	jwt.RegisteredClaims
 }
 
+// Config selects how tokens are verified. JWKSURL enables RS256/EdDSA tokens whose kid is
+// published by the auth service. JWTSecret enables HS256 tokens only when no JWKSURL is set,
+// or until LegacyHS256Until while a deployment migrates off the shared secret. API keys are
+// introspected at APIKeyIntrospectionURL, which defaults to the JWKS host.
 type Config struct {
-	JWTSecret string
-	JWTKey    string
+	JWTSecret              string
+	JWTKey                 string
+	JWKSURL                string
+	LegacyHS256Until       time.Time
+	JWKSRefreshInterval    time.Duration
+	RetiredKeyGrace        time.Duration
+	APIKeyIntrospectionURL string
+	APIKeyCacheTTL         time.Duration
 }
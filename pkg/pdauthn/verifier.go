@@ -3,24 +3,54 @@ package pdauthn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc/metadata"
 )
 
 type Verifier struct {
-	secret string
-	key    string
+	secret           string
+	legacyHS256Until time.Time
+	key              string
+	keys             KeySource
+	apiKeys          APIKeyResolver
+	now              func() time.Time
 }
 
 func NewVerifier(cfg Config) *Verifier {
+	var keys KeySource
+	if cfg.JWKSURL != "" {
+		keys = NewJWKSKeySource(cfg)
+	}
+	v := NewVerifierWithKeySource(cfg, keys)
+	if introspectionURL := apiKeyIntrospectionURL(cfg); introspectionURL != "" {
+		v.apiKeys = NewIntrospectionAPIKeyResolver(introspectionURL, cfg.APIKeyCacheTTL)
+	}
+	return v
+}
+
+// NewVerifierWithKeySource verifies asymmetric tokens against keys, e.g. the auth service's
+// own keyring, instead of fetching a JWKS document.
+func NewVerifierWithKeySource(cfg Config, keys KeySource) *Verifier {
 	return &Verifier{
-		secret: cfg.JWTSecret,
-		key:    cfg.JWTKey,
+		secret:           cfg.JWTSecret,
+		legacyHS256Until: cfg.LegacyHS256Until,
+		key:              cfg.JWTKey,
+		keys:             keys,
+		now:              time.Now,
 	}
 }
 
+// WithAPIKeyResolver returns a copy of v that also accepts API keys resolved by resolver.
+func (v *Verifier) WithAPIKeyResolver(resolver APIKeyResolver) *Verifier {
+	clone := *v
+	clone.apiKeys = resolver
+	return &clone
+}
+
 func (v *Verifier) ClaimsFromContext(ctx context.Context) (*Claims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -34,7 +64,7 @@ func (v *Verifier) ClaimsFromContext(ctx context.Context) (*Claims, error) {
 	if !strings.HasPrefix(strings.ToLower(raw), "bearer ") {
 		return nil, errors.New("invalid authorization header")
 	}
-	return v.ClaimsFromTokenString(strings.TrimSpace(raw[len("Bearer "):]))
+	return v.claimsFromToken(ctx, strings.TrimSpace(raw[len("Bearer "):]))
 }
 
 func (v *Verifier) ClaimsFromAccessToken(accessToken string) (*Claims, error) {
@@ -45,14 +75,47 @@ func (v *Verifier) ClaimsFromAccessToken(accessToken string) (*Claims, error) {
 	return v.ClaimsFromTokenString(tokenString)
 }
 
+// ClaimsFromTokenString accepts a JWT access token or, when a resolver is configured, an
+// API key.
 func (v *Verifier) ClaimsFromTokenString(tokenString string) (*Claims, error) {
-	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(tok *jwt.Token) (interface{}, error) {
-		if tok.Method != jwt.SigningMethodHS256 {
-			return nil, errors.New("unexpected signing method")
+	return v.claimsFromToken(context.Background(), tokenString)
+}
+
+func (v *Verifier) claimsFromToken(ctx context.Context, tokenString string) (*Claims, error) {
+	if IsAPIKey(tokenString) {
+		return v.claimsFromAPIKey(ctx, tokenString)
+	}
+	return v.claimsFromJWT(tokenString)
+}
+
+func (v *Verifier) claimsFromAPIKey(ctx context.Context, apiKey string) (*Claims, error) {
+	if v.apiKeys == nil {
+		return nil, errors.New("api keys are not accepted")
+	}
+	claims, err := v.apiKeys.ResolveAPIKey(ctx, apiKey)
+	if err != nil {
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return nil, err
 		}
-		return []byte(v.secret), nil
-	})
+		return nil, fmt.Errorf("resolve api key: %w", err)
+	}
+	if claims.APIKeyID == "" || claims.UserID == 0 {
+		return nil, ErrInvalidAPIKey
+	}
+	if claims.ExpiresAt != nil && !time.Now().Before(claims.ExpiresAt.Time) {
+		return nil, ErrInvalidAPIKey
+	}
+	return claims, nil
+}
+
+func (v *Verifier) claimsFromJWT(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(
+		tokenString,
+		claims,
+		v.Keyfunc,
+		jwt.WithValidMethods([]string{AlgorithmHS256, AlgorithmRS256, AlgorithmEdDSA}),
+	)
 	if err != nil || !token.Valid {
 		return nil, errors.New("invalid access token")
 	}
@@ -61,3 +124,42 @@ func (v *Verifier) ClaimsFromTokenString(tokenString string) (*Claims, error) {
 	}
 	return claims, nil
 }
+
+// Keyfunc resolves the verification key for tok, for callers that parse their own claims.
+func (v *Verifier) Keyfunc(tok *jwt.Token) (any, error) {
+	switch alg := tok.Method.Alg(); alg {
+	case AlgorithmHS256:
+		if !v.acceptsHS256() {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(v.secret), nil
+	case AlgorithmRS256, AlgorithmEdDSA:
+		if v.keys == nil {
+			return nil, errors.New("unexpected signing method")
+		}
+		kid, _ := tok.Header["kid"].(string)
+		key, err := v.keys.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != alg {
+			return nil, errors.New("signing method does not match key")
+		}
+		return key.PublicKey, nil
+	default:
+		return nil, errors.New("unexpected signing method")
+	}
+}
+
+// acceptsHS256 reports whether shared-secret tokens are trusted. Next to asymmetric keys every
+// holder of the secret could mint tokens, so HS256 is then only accepted until the configured
+// legacy deadline.
+func (v *Verifier) acceptsHS256() bool {
+	if v.secret == "" {
+		return false
+	}
+	if v.keys == nil {
+		return true
+	}
+	return v.now().Before(v.legacyHS256Until)
+}
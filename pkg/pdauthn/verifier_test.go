@@ -0,0 +1,185 @@
+package pdauthn
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type jwksServer struct {
+	mu    sync.Mutex
+	keys  []SigningKey
+	calls int
+}
+
+func (s *jwksServer) publish(keys ...SigningKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	doc := JWKS{Keys: []JWK{}}
+	for _, key := range s.keys {
+		jwk, err := key.PublicJWK()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		doc.Keys = append(doc.Keys, jwk)
+	}
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+func signedClaims(t *testing.T, key SigningKey) string {
+	t.Helper()
+	token, err := key.Sign(Claims{
+		UserID: 7,
+		Key:    "app-key",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+	return token
+}
+
+func TestVerifier_AcceptsAsymmetricTokensFromJWKS(t *testing.T) {
+	rsaKey, err := GenerateSigningKey("rsa-1", AlgorithmRS256)
+	require.NoError(t, err)
+	edKey, err := GenerateSigningKey("ed-1", AlgorithmEdDSA)
+	require.NoError(t, err)
+	server := &jwksServer{}
+	server.publish(rsaKey, edKey)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	verifier := NewVerifier(Config{JWTKey: "app-key", JWKSURL: ts.URL})
+	for _, key := range []SigningKey{rsaKey, edKey} {
+		claims, err := verifier.ClaimsFromTokenString(signedClaims(t, key))
+		require.NoError(t, err, key.Algorithm)
+		require.Equal(t, uint(7), claims.UserID)
+	}
+	require.Equal(t, 1, server.calls, "second key should come from the cached document")
+}
+
+func TestVerifier_RejectsHS256WithoutSharedSecret(t *testing.T) {
+	server := &jwksServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{UserID: 7}).SignedString([]byte("leaked"))
+	require.NoError(t, err)
+
+	_, err = NewVerifier(Config{JWKSURL: ts.URL}).ClaimsFromTokenString(token)
+	require.Error(t, err)
+
+	claims, err := NewVerifier(Config{JWTSecret: "leaked"}).ClaimsFromTokenString(token)
+	require.NoError(t, err)
+	require.Equal(t, uint(7), claims.UserID)
+}
+
+func TestVerifier_AcceptsHS256NextToJWKSOnlyUntilLegacyDeadline(t *testing.T) {
+	server := &jwksServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{UserID: 7}).SignedString([]byte("shared"))
+	require.NoError(t, err)
+
+	_, err = NewVerifier(Config{JWTSecret: "shared", JWKSURL: ts.URL}).ClaimsFromTokenString(token)
+	require.Error(t, err, "a shared secret next to a JWKS is ignored without a legacy deadline")
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	verifier := NewVerifier(Config{JWTSecret: "shared", JWKSURL: ts.URL, LegacyHS256Until: now.Add(time.Hour)})
+	verifier.now = func() time.Time { return now }
+	claims, err := verifier.ClaimsFromTokenString(token)
+	require.NoError(t, err)
+	require.Equal(t, uint(7), claims.UserID)
+
+	now = now.Add(2 * time.Hour)
+	_, err = verifier.ClaimsFromTokenString(token)
+	require.Error(t, err)
+}
+
+func TestVerifier_RejectsAlgorithmMismatchAndUnknownKid(t *testing.T) {
+	rsaKey, err := GenerateSigningKey("shared-kid", AlgorithmRS256)
+	require.NoError(t, err)
+	edKey, err := GenerateSigningKey("shared-kid", AlgorithmEdDSA)
+	require.NoError(t, err)
+	stranger, err := GenerateSigningKey("stranger", AlgorithmRS256)
+	require.NoError(t, err)
+	server := &jwksServer{}
+	server.publish(rsaKey)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	verifier := NewVerifier(Config{JWKSURL: ts.URL})
+	_, err = verifier.ClaimsFromTokenString(signedClaims(t, edKey))
+	require.Error(t, err)
+	_, err = verifier.ClaimsFromTokenString(signedClaims(t, stranger))
+	require.Error(t, err)
+}
+
+func TestJWKSKeySource_KeepsRetiredKeysForGraceWindow(t *testing.T) {
+	oldKey, err := GenerateSigningKey("old", AlgorithmEdDSA)
+	require.NoError(t, err)
+	newKey, err := GenerateSigningKey("new", AlgorithmEdDSA)
+	require.NoError(t, err)
+	server := &jwksServer{}
+	server.publish(oldKey)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := NewJWKSKeySource(Config{
+		JWKSURL:             ts.URL,
+		JWKSRefreshInterval: time.Minute,
+		RetiredKeyGrace:     time.Hour,
+	})
+	source.now = func() time.Time { return now }
+	_, err = source.VerificationKey("old")
+	require.NoError(t, err)
+
+	server.publish(newKey)
+	now = now.Add(2 * time.Minute)
+	_, err = source.VerificationKey("new")
+	require.NoError(t, err)
+	_, err = source.VerificationKey("old")
+	require.NoError(t, err, "retired key stays valid inside the grace window")
+
+	now = now.Add(2 * time.Hour)
+	_, err = source.VerificationKey("old")
+	require.ErrorIs(t, err, ErrUnknownSigningKey)
+}
+
+func TestSigningKey_PrivateKeyRoundTrip(t *testing.T) {
+	key, err := GenerateSigningKey("rsa-1", AlgorithmRS256)
+	require.NoError(t, err)
+	pemBytes, err := key.MarshalPrivateKey()
+	require.NoError(t, err)
+
+	parsed, err := ParseSigningKey("rsa-1", AlgorithmRS256, pemBytes)
+	require.NoError(t, err)
+	token := signedClaims(t, parsed)
+	verifier := NewVerifierWithKeySource(Config{}, staticKeys{"rsa-1": key})
+	_, err = verifier.ClaimsFromTokenString(token)
+	require.NoError(t, err)
+}
+
+type staticKeys map[string]SigningKey
+
+func (s staticKeys) VerificationKey(kid string) (VerificationKey, error) {
+	key, ok := s[kid]
+	if !ok {
+		return VerificationKey{}, ErrUnknownSigningKey
+	}
+	return VerificationKey{Algorithm: key.Algorithm, PublicKey: key.PrivateKey.Public()}, nil
+}
@@ -0,0 +1,37 @@
+package pdgrpcclient
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+type Config struct {
+	Host string `koanf:"host" mapstructure:"host"`
+	Port string `koanf:"port" mapstructure:"port"`
+}
+
+func (c Config) Addr() string {
+	return c.Host + ":" + c.Port
+}
+
+// GetConfigFromKoanf reads grpc_client.<name>. <NAME>_GRPC_HOST and
+// <NAME>_GRPC_PORT override the file so one config works across environments.
+func GetConfigFromKoanf(name string, k *koanf.Koanf) (*Config, error) {
+	base := "grpc_client." + name
+
+	var cfg Config
+	if err := k.Unmarshal(base, &cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal %q: %w", base, err)
+	}
+	envPrefix := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	cfg.Host = toolkit.GetEnv(envPrefix+"_GRPC_HOST", cfg.Host)
+	cfg.Port = toolkit.GetEnv(envPrefix+"_GRPC_PORT", cfg.Port)
+	if cfg.Host == "" || cfg.Port == "" {
+		return nil, fmt.Errorf("missing config: %s.host and %s.port are required", base, base)
+	}
+	return &cfg, nil
+}
@@ -23,20 +23,53 @@ type Params struct {
 }
 
 func newGRPCClient(p Params) (*grpc.ClientConn, error) {
+	return NewClientFromConfig(p.Lifecycle, p.Logger, &Config{Host: p.Host, Port: p.Port})
+}
+
+// ModuleFor provides a *grpc.ClientConn named "grpc-client-<name>" configured
+// from grpc_client.<name>, so one process can hold connections to several services.
+func ModuleFor(name string) fx.Option {
+	if name == "" {
+		name = "default"
+	}
+	nameParamTag := `name:"pdgrpcclient-` + name + `"`
+	configResultTag := `name:"grpc-client-` + name + `-config"`
+	connResultTag := `name:"grpc-client-` + name + `"`
+
+	return fx.Options(
+		fx.Supply(
+			fx.Annotate(name, fx.ResultTags(nameParamTag)),
+		),
+		fx.Provide(
+			fx.Annotate(
+				GetConfigFromKoanf,
+				fx.ParamTags(nameParamTag, ``),
+				fx.ResultTags(configResultTag),
+			),
+			fx.Annotate(
+				NewClientFromConfig,
+				fx.ParamTags(``, ``, configResultTag),
+				fx.ResultTags(connResultTag),
+			),
+		),
+	)
+}
+
+func NewClientFromConfig(lc fx.Lifecycle, log pdlog.Logger, cfg *Config) (*grpc.ClientConn, error) {
 	conn, err := grpc.NewClient(
-		p.Host+":"+p.Port,
+		cfg.Addr(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
-		p.Logger.Error("Failed to connect to gRPC server", "error", err)
+		log.Error("Failed to connect to gRPC server", "error", err)
 		return nil, err
 	}
 
-	p.Logger.Info("gRPC client connected", "host", p.Host, "port", p.Port)
+	log.Info("gRPC client connected", "host", cfg.Host, "port", cfg.Port)
 
-	p.Lifecycle.Append(fx.Hook{
+	lc.Append(fx.Hook{
 		OnStop: func(ctx context.Context) error {
-			p.Logger.Info("Closing gRPC client connection")
+			log.Info("Closing gRPC client connection", "host", cfg.Host, "port", cfg.Port)
 			return conn.Close()
 		},
 	})
@@ -0,0 +1,63 @@
+package pdgrpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"google.golang.org/grpc"
+
+	"github.com/tuannm99/podzone/pkg/pdlog"
+)
+
+func TestGetConfigFromKoanf_EnvOverridesFile(t *testing.T) {
+	k := koanf.New(".")
+	require.NoError(t, k.Set("grpc_client.catalog.host", "catalog-service"))
+	require.NoError(t, k.Set("grpc_client.catalog.port", "50052"))
+	t.Setenv("CATALOG_GRPC_HOST", "localhost")
+
+	cfg, err := GetConfigFromKoanf("catalog", k)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:50052", cfg.Addr())
+}
+
+func TestGetConfigFromKoanf_MissingAddress(t *testing.T) {
+	_, err := GetConfigFromKoanf("cart", koanf.New("."))
+	require.Error(t, err)
+}
+
+func TestModuleFor_ProvidesNamedConnections(t *testing.T) {
+	k := koanf.New(".")
+	require.NoError(t, k.Set("grpc_client.catalog.host", "127.0.0.1"))
+	require.NoError(t, k.Set("grpc_client.catalog.port", "50052"))
+	require.NoError(t, k.Set("grpc_client.cart.host", "127.0.0.1"))
+	require.NoError(t, k.Set("grpc_client.cart.port", "50055"))
+
+	var got struct {
+		fx.In
+
+		Catalog *grpc.ClientConn `name:"grpc-client-catalog"`
+		Cart    *grpc.ClientConn `name:"grpc-client-cart"`
+	}
+	app := fxtest.New(
+		t,
+		ModuleFor("catalog"),
+		ModuleFor("cart"),
+		fx.Supply(k),
+		fx.Supply(fx.Annotate(pdlog.NopLogger{}, fx.As(new(pdlog.Logger)))),
+		fx.Populate(&got),
+		fx.NopLogger,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, app.Start(ctx))
+	assert.Equal(t, "127.0.0.1:50052", got.Catalog.Target())
+	assert.Equal(t, "127.0.0.1:50055", got.Cart.Target())
+	require.NoError(t, app.Stop(ctx))
+}
@@ -0,0 +1,205 @@
+// Package pdproxy decides when forwarded client facts can be believed. The gRPC gateway and
+// the services calling IAM on a user's behalf set x-real-ip and x-forwarded-proto from what
+// they observed themselves; any other caller could set the same metadata freely, so a server
+// reads it only when its peer sits in a configured trusted network.
+package pdproxy
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/knadh/koanf/v2"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/tuannm99/podzone/pkg/toolkit"
+)
+
+const (
+	// TrustedProxiesEnv overrides every service's trusted_proxies setting with a
+	// comma-separated list of CIDRs or addresses.
+	TrustedProxiesEnv = "TRUSTED_PROXY_CIDRS"
+
+	RealIPKey         = "x-real-ip"
+	ForwardedProtoKey = "x-forwarded-proto"
+
+	realIPHeader         = "X-Real-IP"
+	forwardedProtoHeader = "X-Forwarded-Proto"
+)
+
+// TrustedProxies lists the networks whose forwarded client facts are believed. The zero value
+// trusts nobody, so every request is attributed to its direct peer.
+type TrustedProxies struct {
+	prefixes []netip.Prefix
+}
+
+// NewTrustedProxies parses CIDRs and bare addresses. Invalid entries are skipped, so a typo
+// fails closed to the peer address.
+func NewTrustedProxies(entries ...string) TrustedProxies {
+	var proxies TrustedProxies
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "${") {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			proxies.prefixes = append(proxies.prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			addr = addr.Unmap()
+			proxies.prefixes = append(proxies.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+	return proxies
+}
+
+// LoadTrustedProxies reads TrustedProxiesEnv or, when that is unset, the list at key.
+func LoadTrustedProxies(k *koanf.Koanf, key string) TrustedProxies {
+	if raw := toolkit.GetEnv(TrustedProxiesEnv, ""); raw != "" {
+		return NewTrustedProxies(strings.Split(raw, ",")...)
+	}
+	if k == nil {
+		return TrustedProxies{}
+	}
+	return NewTrustedProxies(k.Strings(key)...)
+}
+
+// Trusts reports whether addr, an IP or host:port, is inside a trusted network.
+func (t TrustedProxies) Trusts(addr string) bool {
+	ip, err := netip.ParseAddr(NormalizeIP(addr))
+	if err != nil {
+		return false
+	}
+	for _, prefix := range t.prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns forwardedIP when the peer is trusted and forwarded a valid address,
+// otherwise the peer's own address.
+func (t TrustedProxies) ClientIP(peerAddr, forwardedIP string) string {
+	if t.Trusts(peerAddr) {
+		if ip := NormalizeIP(forwardedIP); ip != "" {
+			return ip
+		}
+	}
+	return NormalizeIP(peerAddr)
+}
+
+// SecureTransport returns the forwarded protocol when the peer is trusted and forwarded one,
+// otherwise whether the connection to the peer itself used TLS.
+func (t TrustedProxies) SecureTransport(peerAddr, forwardedProto string, peerTLS bool) bool {
+	if forwardedProto = strings.TrimSpace(forwardedProto); forwardedProto != "" && t.Trusts(peerAddr) {
+		return strings.EqualFold(forwardedProto, "https")
+	}
+	return peerTLS
+}
+
+// ClientIPFromContext resolves the client address of an incoming gRPC call.
+func (t TrustedProxies) ClientIPFromContext(ctx context.Context) string {
+	peerAddr, _ := peerFromContext(ctx)
+	return t.ClientIP(peerAddr, firstIncoming(ctx, RealIPKey))
+}
+
+// SecureTransportFromContext resolves whether the client of an incoming gRPC call used TLS.
+func (t TrustedProxies) SecureTransportFromContext(ctx context.Context) bool {
+	peerAddr, peerTLS := peerFromContext(ctx)
+	return t.SecureTransport(peerAddr, firstIncoming(ctx, ForwardedProtoKey), peerTLS)
+}
+
+// ClientIPFromRequest resolves the client address of an HTTP request.
+func (t TrustedProxies) ClientIPFromRequest(r *http.Request) string {
+	return t.ClientIP(r.RemoteAddr, r.Header.Get(realIPHeader))
+}
+
+// SecureTransportFromRequest resolves whether the client of an HTTP request used TLS.
+func (t TrustedProxies) SecureTransportFromRequest(r *http.Request) bool {
+	return t.SecureTransport(r.RemoteAddr, r.Header.Get(forwardedProtoHeader), r.TLS != nil)
+}
+
+// ForwardIncomingClient copies the client of an incoming gRPC call, as resolved by t, onto
+// the outgoing metadata so a downstream service trusting this one sees the original client.
+// Call it before any IAM check made on the client's behalf: IAM evaluates source IP and
+// transport policy conditions against the client it is forwarded, not against this hop.
+func (t TrustedProxies) ForwardIncomingClient(ctx context.Context) context.Context {
+	return AppendClientToOutgoingContext(ctx, t.ClientIPFromContext(ctx), t.SecureTransportFromContext(ctx))
+}
+
+// ForwardRequestClient does the same as ForwardIncomingClient for an HTTP request.
+func (t TrustedProxies) ForwardRequestClient(ctx context.Context, r *http.Request) context.Context {
+	return AppendClientToOutgoingContext(ctx, t.ClientIPFromRequest(r), t.SecureTransportFromRequest(r))
+}
+
+// ClientMetadata is the metadata a trusted hop sets for the client it observed.
+func ClientMetadata(clientIP string, secure bool) metadata.MD {
+	md := metadata.MD{}
+	if clientIP != "" {
+		md.Set(RealIPKey, clientIP)
+	}
+	proto := "http"
+	if secure {
+		proto = "https"
+	}
+	md.Set(ForwardedProtoKey, proto)
+	return md
+}
+
+// AppendClientToOutgoingContext replaces any client facts already on the outgoing metadata.
+func AppendClientToOutgoingContext(ctx context.Context, clientIP string, secure bool) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Delete(RealIPKey)
+	md.Delete(ForwardedProtoKey)
+	return metadata.NewOutgoingContext(ctx, metadata.Join(md, ClientMetadata(clientIP, secure)))
+}
+
+// IsClientKey reports whether key names forwarded client metadata, which must never be
+// copied from an untrusted request.
+func IsClientKey(key string) bool {
+	key = strings.ToLower(key)
+	return key == RealIPKey || key == ForwardedProtoKey || key == "x-forwarded-for"
+}
+
+// NormalizeIP returns the canonical form of an IP or host:port, or "" when it is neither.
+func NormalizeIP(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if host, _, err := net.SplitHostPort(raw); err == nil {
+		raw = host
+	}
+	addr, err := netip.ParseAddr(raw)
+	if err != nil {
+		return ""
+	}
+	return addr.Unmap().String()
+}
+
+func firstIncoming(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func peerFromContext(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+	isTLS := false
+	if p.AuthInfo != nil {
+		_, isTLS = p.AuthInfo.(credentials.TLSInfo)
+	}
+	return p.Addr.String(), isTLS
+}
@@ -0,0 +1,50 @@
+package pdproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func incoming(peerIP string, pairs ...string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 4711}})
+}
+
+func TestTrustedProxies_BelievesForwardedFactsOnlyFromTrustedPeers(t *testing.T) {
+	proxies := NewTrustedProxies("10.0.0.0/8", "192.0.2.9", "not-a-cidr", "${TRUSTED_PROXY_CIDRS}")
+
+	trusted := incoming("10.1.2.3", RealIPKey, "203.0.113.7", ForwardedProtoKey, "https")
+	assert.Equal(t, "203.0.113.7", proxies.ClientIPFromContext(trusted))
+	assert.True(t, proxies.SecureTransportFromContext(trusted))
+
+	single := incoming("192.0.2.9", RealIPKey, "203.0.113.8")
+	assert.Equal(t, "203.0.113.8", proxies.ClientIPFromContext(single))
+
+	spoofed := incoming("198.51.100.1", RealIPKey, "203.0.113.7", ForwardedProtoKey, "https")
+	assert.Equal(t, "198.51.100.1", proxies.ClientIPFromContext(spoofed))
+	assert.False(t, proxies.SecureTransportFromContext(spoofed))
+
+	assert.Equal(t, "10.1.2.3", TrustedProxies{}.ClientIPFromContext(trusted), "the zero value trusts nobody")
+	assert.Empty(t, proxies.ClientIPFromContext(context.Background()))
+}
+
+func TestAppendClientToOutgoingContext_ReplacesEarlierFacts(t *testing.T) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"authorization", "Bearer token",
+		RealIPKey, "198.51.100.1",
+	)
+
+	ctx = AppendClientToOutgoingContext(ctx, "203.0.113.7", true)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"Bearer token"}, md.Get("authorization"))
+	assert.Equal(t, []string{"203.0.113.7"}, md.Get(RealIPKey))
+	assert.Equal(t, []string{"https"}, md.Get(ForwardedProtoKey))
+}
@@ -0,0 +1,154 @@
+package pdsaml
+
+import (
+	"sort"
+	"strings"
+)
+
+// Exclusive XML canonicalization (https://www.w3.org/TR/xml-exc-c14n/) without comments.
+// The parser already drops comments, so only elements, text, processing instructions,
+// attributes and namespace declarations are rendered. testdata/c14n holds the W3C examples
+// this is checked against.
+
+type canonicalizer struct {
+	b strings.Builder
+	// inclusive lists prefixes from an InclusiveNamespaces PrefixList; "#default" is "".
+	inclusive map[string]bool
+	// omit is the enveloped signature removed by the enveloped-signature transform.
+	omit *xmlNode
+}
+
+func canonicalize(node *xmlNode, inclusivePrefixes []string, omit *xmlNode) []byte {
+	c := &canonicalizer{inclusive: make(map[string]bool), omit: omit}
+	for _, prefix := range inclusivePrefixes {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		c.inclusive[prefix] = true
+	}
+	c.element(node, map[string]string{})
+	return []byte(c.b.String())
+}
+
+type c14nNamespace struct {
+	prefix string
+	uri    string
+}
+
+type c14nAttr struct {
+	uri   string
+	name  string
+	local string
+	value string
+}
+
+func (c *canonicalizer) element(node *xmlNode, rendered map[string]string) {
+	// Namespaces visibly utilized by the element itself or its attributes, plus the
+	// inclusive ones that are in scope.
+	used := map[string]bool{node.prefix: true}
+	for _, attr := range node.attrs {
+		if attr.prefix != "" && attr.prefix != "xmlns" && attr.prefix != "xml" {
+			used[attr.prefix] = true
+		}
+	}
+	for prefix := range c.inclusive {
+		if _, ok := node.namespaceURI(prefix); ok {
+			used[prefix] = true
+		}
+	}
+
+	var namespaces []c14nNamespace
+	scope := rendered
+	for prefix := range used {
+		uri, _ := node.namespaceURI(prefix)
+		previous, seen := rendered[prefix]
+		if prefix == "" && uri == "" && !seen {
+			// An empty default namespace is only emitted to undo a rendered non-empty one.
+			continue
+		}
+		if seen && previous == uri {
+			continue
+		}
+		if len(namespaces) == 0 {
+			scope = make(map[string]string, len(rendered)+len(used))
+			for k, v := range rendered {
+				scope[k] = v
+			}
+		}
+		scope[prefix] = uri
+		namespaces = append(namespaces, c14nNamespace{prefix: prefix, uri: uri})
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].prefix < namespaces[j].prefix })
+
+	var attrs []c14nAttr
+	for _, attr := range node.attrs {
+		if attr.prefix == "xmlns" || (attr.prefix == "" && attr.local == "xmlns") {
+			continue
+		}
+		name := attr.local
+		uri := ""
+		if attr.prefix != "" {
+			name = attr.prefix + ":" + attr.local
+			uri, _ = node.namespaceURI(attr.prefix)
+		}
+		attrs = append(attrs, c14nAttr{uri: uri, name: name, local: attr.local, value: attr.value})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].uri != attrs[j].uri {
+			return attrs[i].uri < attrs[j].uri
+		}
+		return attrs[i].local < attrs[j].local
+	})
+
+	name := node.local
+	if node.prefix != "" {
+		name = node.prefix + ":" + node.local
+	}
+	c.b.WriteByte('<')
+	c.b.WriteString(name)
+	for _, ns := range namespaces {
+		if ns.prefix == "" {
+			c.b.WriteString(` xmlns="`)
+		} else {
+			c.b.WriteString(` xmlns:` + ns.prefix + `="`)
+		}
+		c.b.WriteString(escapeAttr(ns.uri))
+		c.b.WriteByte('"')
+	}
+	for _, attr := range attrs {
+		c.b.WriteString(" " + attr.name + `="`)
+		c.b.WriteString(escapeAttr(attr.value))
+		c.b.WriteByte('"')
+	}
+	c.b.WriteByte('>')
+	for _, child := range node.children {
+		if child == c.omit {
+			continue
+		}
+		if child.isText {
+			c.b.WriteString(escapeText(child.text))
+			continue
+		}
+		if child.isProcInst {
+			c.b.WriteString("<?" + child.target)
+			if child.text != "" {
+				c.b.WriteString(" " + child.text)
+			}
+			c.b.WriteString("?>")
+			continue
+		}
+		c.element(child, scope)
+	}
+	c.b.WriteString("</" + name + ">")
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;",
+	)
+)
+
+func escapeText(s string) string { return textEscaper.Replace(s) }
+
+func escapeAttr(s string) string { return attrEscaper.Replace(s) }
@@ -0,0 +1,105 @@
+package pdsaml
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The vectors in testdata/c14n are the examples of the Exclusive XML Canonicalization
+// recommendation (section 2.2) and of Canonical XML 1.0 (section 3), the latter rendered
+// exclusively and without the DTD parts, since documents with a DTD are rejected.
+func TestCanonicalizeConformanceVectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		subtree  bool
+		prefixes []string
+		expected string
+	}{
+		{name: "exc 2.2 first document", input: "exc-2.2-a.xml", subtree: true, expected: "exc-2.2.out"},
+		{name: "exc 2.2 second document", input: "exc-2.2-b.xml", subtree: true, expected: "exc-2.2.out"},
+		{
+			name:     "exc 2.2 second document with InclusiveNamespaces",
+			input:    "exc-2.2-b.xml",
+			subtree:  true,
+			prefixes: []string{"n2"},
+			expected: "exc-2.2-b-prefixlist.out",
+		},
+		{name: "c14n 3.1 PIs and comments", input: "c14n-3.1.xml", expected: "c14n-3.1.out"},
+		{name: "c14n 3.2 whitespace in content", input: "c14n-3.2.xml", expected: "c14n-3.2.out"},
+		{name: "c14n 3.3 start and end tags", input: "c14n-3.3.xml", expected: "c14n-3.3.out"},
+		{name: "c14n 3.4 character modifications", input: "c14n-3.4.xml", expected: "c14n-3.4.out"},
+		{name: "c14n 3.6 UTF-8 encoding", input: "c14n-3.6.xml", expected: "c14n-3.6.out"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := os.ReadFile(filepath.Join("testdata", "c14n", tt.input))
+			require.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join("testdata", "c14n", tt.expected))
+			require.NoError(t, err)
+
+			node, err := parseDocument(input)
+			require.NoError(t, err)
+			if tt.subtree {
+				node = firstChildElement(node)
+			}
+			require.Equal(t, string(bytes.TrimSuffix(expected, []byte("\n"))), string(canonicalize(node, tt.prefixes, nil)))
+		})
+	}
+}
+
+func TestCanonicalizeExclusive(t *testing.T) {
+	root, err := parseDocument([]byte(
+		`<root xmlns="urn:a" xmlns:x="urn:x" xmlns:unused="urn:u">` +
+			`<x:child b="2" x:c="3" a="1&#xA;">t&amp;&gt;<empty/><x:same xmlns:x="urn:x"/></x:child></root>`,
+	))
+	require.NoError(t, err)
+
+	require.Equal(t,
+		`<x:child xmlns:x="urn:x" a="1&#xA;" b="2" x:c="3">t&amp;&gt;<empty xmlns="urn:a"></empty><x:same></x:same></x:child>`,
+		string(canonicalize(root.children[0], nil, nil)),
+	)
+	require.Equal(t,
+		`<x:child xmlns="urn:a" xmlns:x="urn:x" a="1&#xA;" b="2" x:c="3">t&amp;&gt;<empty></empty><x:same></x:same></x:child>`,
+		string(canonicalize(root.children[0], []string{"#default"}, nil)),
+	)
+}
+
+func TestCanonicalizeNormalizesAttributeWhitespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "literal whitespace becomes spaces, references are kept",
+			input:    "<doc a=\"x&#9;y\tz&#10;w\nv\r\nu\rt\" b='q\"r'/>",
+			expected: `<doc a="x&#x9;y z&#xA;w v u t" b="q&quot;r"></doc>`,
+		},
+		{
+			name:     "quotes in comments, CDATA and PIs do not open attribute values",
+			input:    "<doc><!-- \" --><![CDATA[ ' ]]><?pi \" ?><e a=\"1&#9;\t2\">\t</e></doc>",
+			expected: "<doc> ' <?pi \" ?><e a=\"1&#x9; 2\">\t</e></doc>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := parseDocument([]byte(tt.input))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(canonicalize(node, nil, nil)))
+		})
+	}
+}
+
+func firstChildElement(node *xmlNode) *xmlNode {
+	for _, child := range node.children {
+		if !child.isText && !child.isProcInst {
+			return child
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package pdsaml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The signature code needs prefixes and namespace declarations exactly as written, which
+// encoding/xml's Token API resolves away, so documents are parsed into this small tree
+// with RawToken instead.
+
+type xmlAttr struct {
+	prefix string
+	local  string
+	value  string
+}
+
+type xmlNode struct {
+	// element fields; text nodes only set text, processing instructions target and text.
+	prefix     string
+	local      string
+	attrs      []xmlAttr
+	children   []*xmlNode
+	parent     *xmlNode
+	isText     bool
+	isProcInst bool
+	target     string
+	text       string
+}
+
+func parseDocument(data []byte) (*xmlNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(normalizeAttrWhitespace(data)))
+	decoder.Strict = true
+	var root, current *xmlNode
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{prefix: t.Name.Space, local: t.Name.Local, parent: current}
+			for _, attr := range t.Attr {
+				node.attrs = append(node.attrs, xmlAttr{prefix: attr.Name.Space, local: attr.Name.Local, value: attr.Value})
+			}
+			if current == nil {
+				if root != nil {
+					return nil, fmt.Errorf("%w: multiple root elements", ErrMalformed)
+				}
+				root = node
+			} else {
+				current.children = append(current.children, node)
+			}
+			current = node
+		case xml.EndElement:
+			if current == nil || current.prefix != t.Name.Space || current.local != t.Name.Local {
+				return nil, fmt.Errorf("%w: unexpected end element %s", ErrMalformed, t.Name.Local)
+			}
+			current = current.parent
+		case xml.CharData:
+			if current == nil {
+				if len(bytes.TrimSpace(t)) > 0 {
+					return nil, fmt.Errorf("%w: text outside the root element", ErrMalformed)
+				}
+				continue
+			}
+			current.children = append(current.children, &xmlNode{isText: true, text: string(t), parent: current})
+		case xml.ProcInst:
+			// The XML declaration and anything else outside the root is never signed.
+			if current != nil {
+				current.children = append(current.children, &xmlNode{
+					isProcInst: true, target: t.Target, text: string(t.Inst), parent: current,
+				})
+			}
+		case xml.Directive:
+			// DTDs have no place in SAML messages and are the usual carrier for entity tricks.
+			return nil, fmt.Errorf("%w: DTDs are not allowed", ErrMalformed)
+		}
+	}
+	if root == nil || current != nil {
+		return nil, fmt.Errorf("%w: incomplete document", ErrMalformed)
+	}
+	return root, nil
+}
+
+// normalizeAttrWhitespace applies the attribute-value normalization XML requires for
+// undeclared attributes: every literal tab, line feed or carriage return becomes a space,
+// a CRLF pair counting once. encoding/xml keeps them as written and resolves character
+// references in the same pass, after which the two can no longer be told apart, so this
+// runs on the raw bytes. Comments, CDATA sections and processing instructions are copied
+// untouched since quotes in them do not start attribute values.
+func normalizeAttrWhitespace(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); {
+		rest := data[i:]
+		switch {
+		case bytes.HasPrefix(rest, []byte("<!--")):
+			i = copyThrough(&out, data, i, "-->")
+		case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+			i = copyThrough(&out, data, i, "]]>")
+		case bytes.HasPrefix(rest, []byte("<?")):
+			i = copyThrough(&out, data, i, "?>")
+		case bytes.HasPrefix(rest, []byte("<!")):
+			i = copyThrough(&out, data, i, ">")
+		case data[i] == '<':
+			i = copyTag(&out, data, i)
+		default:
+			out = append(out, data[i])
+			i++
+		}
+	}
+	return out
+}
+
+// copyThrough appends data from start up to and including the next end marker and returns
+// the index after it.
+func copyThrough(out *[]byte, data []byte, start int, end string) int {
+	stop := bytes.Index(data[start:], []byte(end))
+	if stop < 0 {
+		*out = append(*out, data[start:]...)
+		return len(data)
+	}
+	stop += start + len(end)
+	*out = append(*out, data[start:stop]...)
+	return stop
+}
+
+// copyTag appends a start or end tag, normalizing whitespace inside quoted attribute values.
+func copyTag(out *[]byte, data []byte, start int) int {
+	var quote byte
+	for i := start; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case quote == 0 && b == '>':
+			*out = append(*out, b)
+			return i + 1
+		case quote == 0 && (b == '"' || b == '\''):
+			quote = b
+		case quote != 0 && b == quote:
+			quote = 0
+		case quote != 0 && b == '\r' && i+1 < len(data) && data[i+1] == '\n':
+			i++
+			b = ' '
+		case quote != 0 && (b == '\t' || b == '\n' || b == '\r'):
+			b = ' '
+		}
+		*out = append(*out, b)
+	}
+	return len(data)
+}
+
+// namespaceURI resolves a prefix ("" for the default namespace) in the scope of n.
+func (n *xmlNode) namespaceURI(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return "http://www.w3.org/XML/1998/namespace", true
+	}
+	for el := n; el != nil; el = el.parent {
+		for _, attr := range el.attrs {
+			if prefix == "" && attr.prefix == "" && attr.local == "xmlns" {
+				return attr.value, true
+			}
+			if prefix != "" && attr.prefix == "xmlns" && attr.local == prefix {
+				return attr.value, true
+			}
+		}
+	}
+	return "", prefix == ""
+}
+
+// is reports whether n is the element local in namespace ns.
+func (n *xmlNode) is(ns, local string) bool {
+	if n == nil || n.isText || n.isProcInst || n.local != local {
+		return false
+	}
+	uri, _ := n.namespaceURI(n.prefix)
+	return uri == ns
+}
+
+func (n *xmlNode) attr(local string) (string, bool) {
+	for _, attr := range n.attrs {
+		if attr.prefix == "" && attr.local == local {
+			return attr.value, true
+		}
+	}
+	return "", false
+}
+
+func (n *xmlNode) attrValue(local string) string {
+	value, _ := n.attr(local)
+	return value
+}
+
+func (n *xmlNode) childElements(ns, local string) []*xmlNode {
+	var out []*xmlNode
+	for _, child := range n.children {
+		if child.is(ns, local) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+func (n *xmlNode) child(ns, local string) *xmlNode {
+	for _, child := range n.children {
+		if child.is(ns, local) {
+			return child
+		}
+	}
+	return nil
+}
+
+// textContent concatenates the text of n and its descendants.
+func (n *xmlNode) textContent() string {
+	var b strings.Builder
+	var walk func(*xmlNode)
+	walk = func(node *xmlNode) {
+		for _, child := range node.children {
+			if child.isText {
+				b.WriteString(child.text)
+			} else {
+				walk(child)
+			}
+		}
+	}
+	walk(n)
+	return strings.TrimSpace(b.String())
+}
+
+// collectIDs maps every ID attribute in the tree to its element and fails on duplicates, so
+// a signature reference can never be satisfied by a second element carrying the same ID.
+func collectIDs(root *xmlNode) (map[string]*xmlNode, error) {
+	ids := make(map[string]*xmlNode)
+	var walk func(*xmlNode) error
+	walk = func(node *xmlNode) error {
+		if node.isText || node.isProcInst {
+			return nil
+		}
+		if id, ok := node.attr("ID"); ok {
+			if _, dup := ids[id]; dup {
+				return fmt.Errorf("%w: duplicate ID %q", ErrMalformed, id)
+			}
+			ids[id] = node
+		}
+		for _, child := range node.children {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return ids, walk(root)
+}
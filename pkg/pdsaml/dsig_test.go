@@ -0,0 +1,234 @@
+package pdsaml
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The wrapping cases follow XSW1-XSW8 from Somorovsky et al., "On Breaking SAML: Be Whoever
+// You Want to Be" (USENIX Security 2012): each keeps a genuinely signed element somewhere in
+// the document and tries to get an attacker element read in its place.
+func TestParseResponseRejectsSignatureWrappingPayloads(t *testing.T) {
+	idp := newTestIdP(t)
+	signed := idp.sign(t, idp.assertion(idp.defaultParams()))
+	signedResponse := idp.sign(t, idp.response(signed))
+	signature := signatureElement(signed)
+
+	evilParams := idp.defaultParams()
+	evilParams.id = "_evil"
+	evilParams.email = "mallory@example.com"
+	evil := idp.assertion(evilParams)
+	evilParams.id = "_assertion-1"
+	evilReusingID := idp.assertion(evilParams)
+	evilResponse := strings.Replace(idp.response(evil), `ID="_response-1"`, `ID="_evil-response"`, 1)
+
+	withObject := func(signature, content string) string {
+		return strings.Replace(signature, "</ds:Signature>", "<ds:Object>"+content+"</ds:Object></ds:Signature>", 1)
+	}
+	extensions := func(content string) string {
+		return "<samlp:Extensions>" + content + "</samlp:Extensions>"
+	}
+	wrapping := func(outer, inner string) string {
+		return strings.TrimSuffix(outer, "</saml:Assertion>") + inner + "</saml:Assertion>"
+	}
+
+	tests := []struct {
+		name     string
+		response string
+		err      error
+	}{
+		{
+			name:     "signed response and assertion",
+			response: signedResponse,
+		},
+		{
+			name:     "XSW1 original response inside the copied response signature",
+			response: afterIssuer(evilResponse, withObject(signatureElement(signedResponse), signedResponse)),
+			err:      ErrSignatureInvalid,
+		},
+		{
+			name:     "XSW2 original response detached next to the copied response signature",
+			response: afterIssuer(evilResponse, signatureElement(signedResponse)+signedResponse),
+			err:      ErrSignatureInvalid,
+		},
+		{
+			name:     "XSW3 attacker assertion with the signed ID before the original",
+			response: idp.response(evilReusingID, signed),
+			err:      ErrMalformed,
+		},
+		{
+			name:     "XSW4 attacker assertion wrapping the original",
+			response: idp.response(wrapping(evil, signed)),
+			err:      ErrSignatureMissing,
+		},
+		{
+			name:     "XSW5 copied signature on the attacker assertion, original in Extensions",
+			response: afterIssuer(idp.response(afterIssuer(evilReusingID, signature)), extensions(signed)),
+			err:      ErrMalformed,
+		},
+		{
+			name:     "XSW6 original inside the copied signature of the attacker assertion",
+			response: idp.response(afterIssuer(evilReusingID, withObject(signature, signed))),
+			err:      ErrMalformed,
+		},
+		{
+			name:     "XSW7 original in Extensions, unsigned attacker assertion",
+			response: afterIssuer(idp.response(evil), extensions(signed)),
+			err:      ErrSignatureMissing,
+		},
+		{
+			name: "XSW8 unsigned original inside the copied signature of the attacker assertion",
+			response: idp.response(afterIssuer(evilReusingID,
+				withObject(signature, strings.Replace(signed, signature, "", 1)))),
+			err: ErrMalformed,
+		},
+		{
+			name:     "copied signature on the attacker assertion, original dropped",
+			response: idp.response(afterIssuer(evilReusingID, signature)),
+			err:      ErrSignatureInvalid,
+		},
+		{
+			name:     "copied signature on an attacker assertion with its own ID",
+			response: idp.response(afterIssuer(evil, signature)),
+			err:      ErrSignatureInvalid,
+		},
+		{
+			name:     "second signature on the signed assertion",
+			response: idp.response(afterIssuer(signed, signature)),
+			err:      ErrSignatureInvalid,
+		},
+		{
+			name: "signature moved out of the XML-DSig namespace",
+			response: idp.response(strings.Replace(signed,
+				`<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">`, `<ds:Signature xmlns:ds="urn:evil">`, 1)),
+			err: ErrSignatureMissing,
+		},
+		{
+			name:     "signed response vouching for an unsigned assertion",
+			response: idp.sign(t, idp.response(evil)),
+			err:      ErrSignatureMissing,
+		},
+		{
+			name:     "DTD with entity declarations",
+			response: `<!DOCTYPE r [<!ENTITY e "mallory@example.com">]>` + signedResponse,
+			err:      ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertion, err := idp.parse(t, tt.response)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "alice@example.com", assertion.NameID)
+		})
+	}
+}
+
+func TestParseResponseRejectsSignedInfoItCannotTrust(t *testing.T) {
+	idp := newTestIdP(t)
+	assertion := idp.assertion(idp.defaultParams())
+
+	tests := []struct {
+		name string
+		edit func(signedInfo string) string
+		err  error
+	}{
+		{
+			name: "reference to the whole document",
+			edit: replacing(`URI="#_assertion-1"`, `URI=""`),
+			err:  ErrSignatureInvalid,
+		},
+		{
+			name: "second reference",
+			edit: replacing("</ds:Reference>", `</ds:Reference><ds:Reference URI="#_response-1"/>`),
+			err:  ErrSignatureInvalid,
+		},
+		{
+			name: "XPath transform",
+			edit: replacing("</ds:Transforms>",
+				`<ds:Transform Algorithm="http://www.w3.org/TR/1999/REC-xpath-19991116"/></ds:Transforms>`),
+			err: ErrUnsupportedAlgo,
+		},
+		{
+			name: "XSLT transform",
+			edit: replacing("</ds:Transforms>",
+				`<ds:Transform Algorithm="http://www.w3.org/TR/1999/REC-xslt-19991116"/></ds:Transforms>`),
+			err: ErrUnsupportedAlgo,
+		},
+		{
+			name: "inclusive canonicalization",
+			edit: replacing(`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>`,
+				`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/TR/2001/REC-xml-c14n-20010315"/>`),
+			err: ErrUnsupportedAlgo,
+		},
+		{
+			name: "HMAC keyed with the public certificate",
+			edit: replacing("http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+				"http://www.w3.org/2000/09/xmldsig#hmac-sha1"),
+			err: ErrUnsupportedAlgo,
+		},
+		{
+			name: "RSA-SHA1 signature",
+			edit: replacing("http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+				"http://www.w3.org/2000/09/xmldsig#rsa-sha1"),
+			err: ErrUnsupportedAlgo,
+		},
+		{
+			name: "SHA1 digest",
+			edit: replacing("http://www.w3.org/2001/04/xmlenc#sha256", "http://www.w3.org/2000/09/xmldsig#sha1"),
+			err:  ErrUnsupportedAlgo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := idp.parse(t, idp.response(idp.signEdited(t, assertion, tt.edit)))
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseResponseIgnoresKeyInfoFromTheMessage(t *testing.T) {
+	idp := newTestIdP(t)
+	attacker := newTestIdP(t)
+	keyInfo := `<ds:KeyInfo><ds:X509Data><ds:X509Certificate>` +
+		base64.StdEncoding.EncodeToString(attacker.cert.Raw) +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>`
+	forged := strings.Replace(attacker.sign(t, idp.assertion(idp.defaultParams())), "</ds:Signature>", keyInfo, 1)
+
+	_, err := idp.parse(t, idp.response(forged))
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+}
+
+// A comment splitting a signed text node must not truncate what is read from it
+// (CVE-2017-11427 and the related SAML library advisories): exclusive canonicalization
+// without comments still covers the whole value, so the reader has to return all of it.
+func TestParseResponseReadsTextAcrossInjectedComments(t *testing.T) {
+	idp := newTestIdP(t)
+	params := idp.defaultParams()
+	params.email = "alice@example.com.evil.com"
+	signed := idp.sign(t, idp.assertion(params))
+
+	injected := strings.ReplaceAll(signed, "alice@example.com.evil.com<", "alice@example.com<!---->.evil.com<")
+	require.NotEqual(t, signed, injected)
+	assertion, err := idp.parse(t, idp.response(injected))
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com.evil.com", assertion.NameID)
+	require.Equal(t, "alice@example.com.evil.com", assertion.FirstAttribute("email"))
+
+	// Processing instructions are signed content, unlike comments.
+	withPI := strings.Replace(signed, "alice@example.com.evil.com<", "alice@example.com<?x?>.evil.com<", 1)
+	_, err = idp.parse(t, idp.response(withPI))
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+}
+
+func replacing(old, replacement string) func(string) string {
+	return func(s string) string {
+		return strings.Replace(s, old, replacement, 1)
+	}
+}
@@ -0,0 +1,152 @@
+package pdsaml
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// IdPMetadata is what a service provider needs from an identity provider's metadata.
+type IdPMetadata struct {
+	EntityID string
+	// SSOURL is the SingleSignOnService location for the HTTP-Redirect binding.
+	SSOURL string
+	// Certificates are the signing certificates; there can be several during a key rollover.
+	Certificates []*x509.Certificate
+}
+
+type entityDescriptorXML struct {
+	EntityID         string                `xml:"entityID,attr"`
+	IDPSSODescriptor []idpSSODescriptorXML `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+type entitiesDescriptorXML struct {
+	Entities []entityDescriptorXML `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+}
+
+type idpSSODescriptorXML struct {
+	KeyDescriptors      []keyDescriptorXML `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+	SingleSignOnService []endpointXML      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+type keyDescriptorXML struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo>X509Data>X509Certificate"`
+}
+
+type endpointXML struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseIdPMetadata reads an EntityDescriptor, or an EntitiesDescriptor holding exactly one
+// identity provider, and returns its entity ID, redirect SSO endpoint and signing keys.
+func ParseIdPMetadata(data []byte) (*IdPMetadata, error) {
+	// Reject DTDs up front; encoding/xml would otherwise skip them silently.
+	if _, err := parseDocument(data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	root, err := decodeMetadataRoot(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var idps []entityDescriptorXML
+	for _, entity := range root {
+		if len(entity.IDPSSODescriptor) > 0 {
+			idps = append(idps, entity)
+		}
+	}
+	if len(idps) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one identity provider, found %d", ErrInvalidMetadata, len(idps))
+	}
+	entity := idps[0]
+	if strings.TrimSpace(entity.EntityID) == "" {
+		return nil, fmt.Errorf("%w: missing entityID", ErrInvalidMetadata)
+	}
+
+	metadata := &IdPMetadata{EntityID: strings.TrimSpace(entity.EntityID)}
+	for _, descriptor := range entity.IDPSSODescriptor {
+		for _, endpoint := range descriptor.SingleSignOnService {
+			if endpoint.Binding == BindingHTTPRedirect && metadata.SSOURL == "" {
+				metadata.SSOURL = strings.TrimSpace(endpoint.Location)
+			}
+		}
+		for _, key := range descriptor.KeyDescriptors {
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+			for _, encoded := range key.Certificates {
+				cert, err := parseCertificate(encoded)
+				if err != nil {
+					return nil, err
+				}
+				metadata.Certificates = append(metadata.Certificates, cert)
+			}
+		}
+	}
+	if metadata.SSOURL == "" {
+		return nil, fmt.Errorf("%w: no HTTP-Redirect SingleSignOnService", ErrInvalidMetadata)
+	}
+	if len(metadata.Certificates) == 0 {
+		return nil, fmt.Errorf("%w: no signing certificate", ErrInvalidMetadata)
+	}
+	return metadata, nil
+}
+
+func decodeMetadataRoot(data []byte) ([]entityDescriptorXML, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Space != NamespaceMetadata {
+			return nil, fmt.Errorf("%w: unexpected root element %s", ErrInvalidMetadata, start.Name.Local)
+		}
+		switch start.Name.Local {
+		case "EntityDescriptor":
+			var entity entityDescriptorXML
+			if err := decoder.DecodeElement(&entity, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+			}
+			return []entityDescriptorXML{entity}, nil
+		case "EntitiesDescriptor":
+			var entities entitiesDescriptorXML
+			if err := decoder.DecodeElement(&entities, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+			}
+			return entities.Entities, nil
+		default:
+			return nil, fmt.Errorf("%w: unexpected root element %s", ErrInvalidMetadata, start.Name.Local)
+		}
+	}
+}
+
+// parseCertificate accepts base64 DER as found in metadata, with or without PEM armour.
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	encoded = strings.TrimSpace(encoded)
+	if block, _ := pem.Decode([]byte(encoded)); block != nil {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate: %v", ErrInvalidMetadata, err)
+		}
+		return cert, nil
+	}
+	der, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: certificate: %v", ErrInvalidMetadata, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: certificate: %v", ErrInvalidMetadata, err)
+	}
+	return cert, nil
+}
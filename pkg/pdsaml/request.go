@@ -0,0 +1,136 @@
+package pdsaml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type authnRequestXML struct {
+	XMLName                     xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string          `xml:"ID,attr"`
+	Version                     string          `xml:"Version,attr"`
+	IssueInstant                string          `xml:"IssueInstant,attr"`
+	Destination                 string          `xml:"Destination,attr"`
+	ProtocolBinding             string          `xml:"ProtocolBinding,attr"`
+	AssertionConsumerServiceURL string          `xml:"AssertionConsumerServiceURL,attr"`
+	Issuer                      issuerXML       `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameIDPolicy                nameIDPolicyXML `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+}
+
+type issuerXML struct {
+	Value string `xml:",chardata"`
+}
+
+type nameIDPolicyXML struct {
+	Format      string `xml:"Format,attr"`
+	AllowCreate bool   `xml:"AllowCreate,attr"`
+}
+
+// AuthnRequestURL builds the HTTP-Redirect binding URL that starts SP-initiated SSO at the
+// IdP. The returned request ID must be kept with the relay state and passed back as
+// ValidateOptions.RequestID when the response arrives.
+func (sp ServiceProvider) AuthnRequestURL(idp *IdPMetadata, relayState string, now time.Time) (string, string, error) {
+	requestID, err := newID()
+	if err != nil {
+		return "", "", err
+	}
+	request := authnRequestXML{
+		ID:                          requestID,
+		Version:                     "2.0",
+		IssueInstant:                now.UTC().Format(time.RFC3339),
+		Destination:                 idp.SSOURL,
+		ProtocolBinding:             BindingHTTPPost,
+		AssertionConsumerServiceURL: sp.ACSURL,
+		Issuer:                      issuerXML{Value: sp.EntityID},
+		NameIDPolicy:                nameIDPolicyXML{Format: NameIDFormatUnspecified, AllowCreate: true},
+	}
+	encoded, err := xml.Marshal(request)
+	if err != nil {
+		return "", "", err
+	}
+
+	var deflated bytes.Buffer
+	writer, err := flate.NewWriter(&deflated, flate.BestCompression)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := writer.Write(encoded); err != nil {
+		return "", "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", err
+	}
+
+	target, err := url.Parse(idp.SSOURL)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: sso url: %v", ErrInvalidMetadata, err)
+	}
+	query := target.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+	target.RawQuery = query.Encode()
+	return target.String(), requestID, nil
+}
+
+type spEntityDescriptorXML struct {
+	XMLName         xml.Name           `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string             `xml:"entityID,attr"`
+	SPSSODescriptor spSSODescriptorXML `xml:"urn:oasis:names:tc:SAML:2.0:metadata SPSSODescriptor"`
+}
+
+type spSSODescriptorXML struct {
+	AuthnRequestsSigned        bool               `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool               `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string             `xml:"protocolSupportEnumeration,attr"`
+	NameIDFormats              []string           `xml:"urn:oasis:names:tc:SAML:2.0:metadata NameIDFormat"`
+	AssertionConsumerService   indexedEndpointXML `xml:"urn:oasis:names:tc:SAML:2.0:metadata AssertionConsumerService"`
+}
+
+type indexedEndpointXML struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+// Metadata renders the SP metadata document administrators upload to their IdP.
+func (sp ServiceProvider) Metadata() ([]byte, error) {
+	descriptor := spEntityDescriptorXML{
+		EntityID: sp.EntityID,
+		SPSSODescriptor: spSSODescriptorXML{
+			AuthnRequestsSigned:        false,
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: NamespaceProtocol,
+			NameIDFormats:              []string{NameIDFormatEmail, NameIDFormatUnspecified},
+			AssertionConsumerService: indexedEndpointXML{
+				Binding:   BindingHTTPPost,
+				Location:  sp.ACSURL,
+				Index:     0,
+				IsDefault: true,
+			},
+		},
+	}
+	encoded, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), encoded...), nil
+}
+
+// newID returns an xs:ID value; those may not start with a digit.
+func newID() (string, error) {
+	raw := make([]byte, 20)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(raw), nil
+}
@@ -0,0 +1,263 @@
+package pdsaml
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateOptions is everything a response is checked against.
+type ValidateOptions struct {
+	SP  ServiceProvider
+	IdP *IdPMetadata
+	// RequestID is the ID of the AuthnRequest this response answers. It is required:
+	// unsolicited (IdP-initiated) responses are rejected because they cannot be bound to a
+	// browser session.
+	RequestID string
+	Now       time.Time
+	// ClockSkew defaults to DefaultClockSkew.
+	ClockSkew time.Duration
+}
+
+// Assertion is the verified content of a SAML assertion.
+type Assertion struct {
+	ID           string
+	Issuer       string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	// Attributes holds attribute values keyed by Name and, when the IdP sends one, also by
+	// FriendlyName.
+	Attributes map[string][]string
+}
+
+// Attribute returns the values of an attribute by Name or FriendlyName.
+func (a *Assertion) Attribute(name string) []string {
+	return a.Attributes[name]
+}
+
+// FirstAttribute returns the first value of an attribute, or "".
+func (a *Assertion) FirstAttribute(name string) string {
+	if values := a.Attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ParseResponse decodes a base64 SAMLResponse from the HTTP-POST binding, verifies its
+// signatures against the IdP certificates and checks issuer, audience, recipient, request
+// correlation and validity windows. The assertion itself must be signed; a signature on the
+// enclosing Response is verified as well when present.
+func ParseResponse(encoded string, opts ValidateOptions) (*Assertion, error) {
+	if opts.IdP == nil {
+		return nil, fmt.Errorf("%w: no identity provider configured", ErrInvalidResponse)
+	}
+	if opts.RequestID == "" {
+		return nil, fmt.Errorf("%w: unsolicited responses are not accepted", ErrInvalidResponse)
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	if opts.ClockSkew == 0 {
+		opts.ClockSkew = DefaultClockSkew
+	}
+
+	raw, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	response, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !response.is(NamespaceProtocol, "Response") {
+		return nil, fmt.Errorf("%w: root element is not a Response", ErrMalformed)
+	}
+	if _, err := collectIDs(response); err != nil {
+		return nil, err
+	}
+
+	if signatureOf(response) != nil {
+		if err := verifyEnveloped(response, opts.IdP.Certificates); err != nil {
+			return nil, err
+		}
+	}
+	if destination, ok := response.attr("Destination"); ok && destination != opts.SP.ACSURL {
+		return nil, fmt.Errorf("%w: destination %q", ErrInvalidResponse, destination)
+	}
+	if response.attrValue("InResponseTo") != opts.RequestID {
+		return nil, fmt.Errorf("%w: response does not answer the pending request", ErrInvalidResponse)
+	}
+	if issuer := response.child(NamespaceAssertion, "Issuer"); issuer != nil && issuer.textContent() != opts.IdP.EntityID {
+		return nil, fmt.Errorf("%w: response issuer %q", ErrInvalidResponse, issuer.textContent())
+	}
+	if err := checkStatus(response); err != nil {
+		return nil, err
+	}
+
+	if response.child(NamespaceAssertion, "EncryptedAssertion") != nil {
+		return nil, ErrEncryptedAssertion
+	}
+	assertions := response.childElements(NamespaceAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one assertion, found %d", ErrInvalidResponse, len(assertions))
+	}
+	assertion := assertions[0]
+	if err := verifyEnveloped(assertion, opts.IdP.Certificates); err != nil {
+		return nil, err
+	}
+	// From here on only the verified assertion element is read.
+	return readAssertion(assertion, opts)
+}
+
+func checkStatus(response *xmlNode) error {
+	status := response.child(NamespaceProtocol, "Status")
+	if status == nil {
+		return fmt.Errorf("%w: missing Status", ErrInvalidResponse)
+	}
+	code := status.child(NamespaceProtocol, "StatusCode")
+	if code == nil {
+		return fmt.Errorf("%w: missing StatusCode", ErrInvalidResponse)
+	}
+	if value := code.attrValue("Value"); value != StatusSuccess {
+		detail := value
+		if nested := code.child(NamespaceProtocol, "StatusCode"); nested != nil {
+			detail += " / " + nested.attrValue("Value")
+		}
+		return fmt.Errorf("%w: %s", ErrStatusNotSuccess, detail)
+	}
+	return nil
+}
+
+func readAssertion(node *xmlNode, opts ValidateOptions) (*Assertion, error) {
+	issuer := node.child(NamespaceAssertion, "Issuer")
+	if issuer == nil || issuer.textContent() != opts.IdP.EntityID {
+		return nil, fmt.Errorf("%w: assertion issuer does not match the identity provider", ErrInvalidResponse)
+	}
+	assertion := &Assertion{
+		ID:         node.attrValue("ID"),
+		Issuer:     issuer.textContent(),
+		Attributes: make(map[string][]string),
+	}
+
+	subject := node.child(NamespaceAssertion, "Subject")
+	if subject == nil {
+		return nil, fmt.Errorf("%w: missing Subject", ErrInvalidResponse)
+	}
+	nameID := subject.child(NamespaceAssertion, "NameID")
+	if nameID == nil || nameID.textContent() == "" {
+		return nil, fmt.Errorf("%w: missing NameID", ErrInvalidResponse)
+	}
+	assertion.NameID = nameID.textContent()
+	assertion.NameIDFormat = nameID.attrValue("Format")
+	if err := checkSubjectConfirmation(subject, opts); err != nil {
+		return nil, err
+	}
+	if err := checkConditions(node, opts); err != nil {
+		return nil, err
+	}
+
+	if statement := node.child(NamespaceAssertion, "AuthnStatement"); statement != nil {
+		assertion.SessionIndex = statement.attrValue("SessionIndex")
+		if notOnOrAfter, ok := statement.attr("SessionNotOnOrAfter"); ok {
+			expiry, err := parseTime(notOnOrAfter)
+			if err != nil {
+				return nil, err
+			}
+			if !opts.Now.Before(expiry.Add(opts.ClockSkew)) {
+				return nil, fmt.Errorf("%w: IdP session has ended", ErrExpired)
+			}
+		}
+	}
+	for _, statement := range node.childElements(NamespaceAssertion, "AttributeStatement") {
+		for _, attribute := range statement.childElements(NamespaceAssertion, "Attribute") {
+			var values []string
+			for _, value := range attribute.childElements(NamespaceAssertion, "AttributeValue") {
+				values = append(values, value.textContent())
+			}
+			name := attribute.attrValue("Name")
+			assertion.Attributes[name] = append(assertion.Attributes[name], values...)
+			if friendly := attribute.attrValue("FriendlyName"); friendly != "" && friendly != name {
+				assertion.Attributes[friendly] = append(assertion.Attributes[friendly], values...)
+			}
+		}
+	}
+	return assertion, nil
+}
+
+func checkSubjectConfirmation(subject *xmlNode, opts ValidateOptions) error {
+	for _, confirmation := range subject.childElements(NamespaceAssertion, "SubjectConfirmation") {
+		if confirmation.attrValue("Method") != confirmationMethodBearer {
+			continue
+		}
+		data := confirmation.child(NamespaceAssertion, "SubjectConfirmationData")
+		if data == nil || data.attrValue("Recipient") != opts.SP.ACSURL {
+			continue
+		}
+		if inResponseTo, ok := data.attr("InResponseTo"); ok && inResponseTo != opts.RequestID {
+			continue
+		}
+		notOnOrAfter, ok := data.attr("NotOnOrAfter")
+		if !ok {
+			continue
+		}
+		expiry, err := parseTime(notOnOrAfter)
+		if err != nil {
+			return err
+		}
+		if opts.Now.Before(expiry.Add(opts.ClockSkew)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no valid bearer subject confirmation", ErrInvalidResponse)
+}
+
+func checkConditions(node *xmlNode, opts ValidateOptions) error {
+	conditions := node.child(NamespaceAssertion, "Conditions")
+	if conditions == nil {
+		return fmt.Errorf("%w: missing Conditions", ErrInvalidResponse)
+	}
+	if notBefore, ok := conditions.attr("NotBefore"); ok {
+		start, err := parseTime(notBefore)
+		if err != nil {
+			return err
+		}
+		if opts.Now.Add(opts.ClockSkew).Before(start) {
+			return fmt.Errorf("%w: not yet valid", ErrExpired)
+		}
+	}
+	if notOnOrAfter, ok := conditions.attr("NotOnOrAfter"); ok {
+		expiry, err := parseTime(notOnOrAfter)
+		if err != nil {
+			return err
+		}
+		if !opts.Now.Before(expiry.Add(opts.ClockSkew)) {
+			return fmt.Errorf("%w: expired", ErrExpired)
+		}
+	}
+	restrictions := conditions.childElements(NamespaceAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return fmt.Errorf("%w: missing AudienceRestriction", ErrInvalidResponse)
+	}
+	// Every restriction must be satisfied, not just one of them.
+	for _, restriction := range restrictions {
+		matched := false
+		for _, audience := range restriction.childElements(NamespaceAssertion, "Audience") {
+			if audience.textContent() == opts.SP.EntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%w: audience does not include this service provider", ErrInvalidResponse)
+		}
+	}
+	return nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid timestamp %q", ErrMalformed, value)
+	}
+	return parsed, nil
+}
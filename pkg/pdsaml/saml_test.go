@@ -0,0 +1,300 @@
+package pdsaml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIdPEntityID = "https://idp.example.com/metadata"
+	testSSOURL      = "https://idp.example.com/sso?tenant=acme"
+	testRequestID   = "_req-1"
+)
+
+var testSP = ServiceProvider{
+	EntityID: "https://auth.example.com/auth/v1/saml/org-1/metadata",
+	ACSURL:   "https://auth.example.com/auth/v1/saml/org-1/acs",
+}
+
+type testIdP struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+	now  time.Time
+}
+
+func newTestIdP(t *testing.T) *testIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testIdP{key: key, cert: cert, now: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (idp *testIdP) metadata() string {
+	certificate := base64.StdEncoding.EncodeToString(idp.cert.Raw)
+	return fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>bm90LWEtY2VydA==</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>
+%s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, testIdPEntityID, certificate, strings.ReplaceAll(testSSOURL, "&", "&amp;"))
+}
+
+type assertionParams struct {
+	id        string
+	audience  string
+	recipient string
+	expiry    time.Time
+	email     string
+}
+
+func (idp *testIdP) defaultParams() assertionParams {
+	return assertionParams{
+		id:        "_assertion-1",
+		audience:  testSP.EntityID,
+		recipient: testSP.ACSURL,
+		expiry:    idp.now.Add(5 * time.Minute),
+		email:     "alice@example.com",
+	}
+}
+
+func (idp *testIdP) assertion(p assertionParams) string {
+	expiry := p.expiry.Format(time.RFC3339)
+	return fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="%s" Version="2.0" IssueInstant="%s">`+
+		`<saml:Issuer>%s</saml:Issuer>`+
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">%s</saml:NameID>`+
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">`+
+		`<saml:SubjectConfirmationData InResponseTo="%s" NotOnOrAfter="%s" Recipient="%s"/>`+
+		`</saml:SubjectConfirmation></saml:Subject>`+
+		`<saml:Conditions NotBefore="%s" NotOnOrAfter="%s">`+
+		`<saml:AudienceRestriction><saml:Audience>%s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`+
+		`<saml:AuthnStatement SessionIndex="_session-1"/>`+
+		`<saml:AttributeStatement>`+
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress" FriendlyName="email">`+
+		`<saml:AttributeValue>%s</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute Name="groups"><saml:AttributeValue>engineering</saml:AttributeValue>`+
+		`<saml:AttributeValue>admins &amp; ops</saml:AttributeValue></saml:Attribute>`+
+		`</saml:AttributeStatement></saml:Assertion>`,
+		p.id, idp.now.Format(time.RFC3339), testIdPEntityID, p.email, testRequestID, expiry, p.recipient,
+		idp.now.Add(-time.Minute).Format(time.RFC3339), expiry, p.audience, p.email)
+}
+
+// sign inserts an enveloped signature right after the element's Issuer. element must
+// declare every namespace it uses so it canonicalizes the same standalone and embedded.
+func (idp *testIdP) sign(t *testing.T, element string) string {
+	t.Helper()
+	return idp.signEdited(t, element, nil)
+}
+
+// signEdited is sign with edit applied to the SignedInfo before it is signed, so tests can
+// produce correctly signed references and algorithms the verifier must still refuse.
+func (idp *testIdP) signEdited(t *testing.T, element string, edit func(signedInfo string) string) string {
+	t.Helper()
+	node, err := parseDocument([]byte(element))
+	require.NoError(t, err)
+	digest := sha256.Sum256(canonicalize(node, nil, nil))
+	signedInfo := `<ds:SignedInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+		`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>` +
+		`<ds:Reference URI="#` + node.attrValue("ID") + `"><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/></ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue>` +
+		`</ds:Reference></ds:SignedInfo>`
+	if edit != nil {
+		signedInfo = edit(signedInfo)
+	}
+	signedInfoNode, err := parseDocument([]byte(signedInfo))
+	require.NoError(t, err)
+	hashed := sha256.Sum256(canonicalize(signedInfoNode, nil, nil))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, hashed[:])
+	require.NoError(t, err)
+	signatureXML := `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+		strings.Replace(signedInfo, ` xmlns:ds="http://www.w3.org/2000/09/xmldsig#"`, "", 1) +
+		`<ds:SignatureValue>` + base64.StdEncoding.EncodeToString(signature) + `</ds:SignatureValue></ds:Signature>`
+	return afterIssuer(element, signatureXML)
+}
+
+// afterIssuer inserts content right after the element's first Issuer.
+func afterIssuer(element, content string) string {
+	issuerEnd := strings.Index(element, "</saml:Issuer>") + len("</saml:Issuer>")
+	return element[:issuerEnd] + content + element[issuerEnd:]
+}
+
+// signatureElement cuts the first ds:Signature out of signed XML.
+func signatureElement(signed string) string {
+	start := strings.Index(signed, "<ds:Signature")
+	end := strings.Index(signed, "</ds:Signature>") + len("</ds:Signature>")
+	return signed[start:end]
+}
+
+func (idp *testIdP) response(assertions ...string) string {
+	return `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ` +
+		`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response-1" Version="2.0" ` +
+		`IssueInstant="` + idp.now.Format(time.RFC3339) + `" Destination="` + testSP.ACSURL + `" ` +
+		`InResponseTo="` + testRequestID + `">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+		strings.Join(assertions, "") + `</samlp:Response>`
+}
+
+func (idp *testIdP) parse(t *testing.T, response string) (*Assertion, error) {
+	t.Helper()
+	metadata, err := ParseIdPMetadata([]byte(idp.metadata()))
+	require.NoError(t, err)
+	return ParseResponse(base64.StdEncoding.EncodeToString([]byte(response)), ValidateOptions{
+		SP:        testSP,
+		IdP:       metadata,
+		RequestID: testRequestID,
+		Now:       idp.now,
+	})
+}
+
+func TestParseIdPMetadata(t *testing.T) {
+	idp := newTestIdP(t)
+
+	metadata, err := ParseIdPMetadata([]byte(idp.metadata()))
+	require.NoError(t, err)
+	require.Equal(t, testIdPEntityID, metadata.EntityID)
+	require.Equal(t, testSSOURL, metadata.SSOURL)
+	require.Len(t, metadata.Certificates, 1)
+	require.True(t, metadata.Certificates[0].Equal(idp.cert))
+
+	_, err = ParseIdPMetadata([]byte(`<!DOCTYPE x [<!ENTITY a "b">]><x/>`))
+	require.ErrorIs(t, err, ErrInvalidMetadata)
+	_, err = ParseIdPMetadata([]byte(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="x"/>`))
+	require.ErrorIs(t, err, ErrInvalidMetadata)
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	idp := newTestIdP(t)
+	metadata, err := ParseIdPMetadata([]byte(idp.metadata()))
+	require.NoError(t, err)
+
+	target, requestID, err := testSP.AuthnRequestURL(metadata, "state-1", idp.now)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(requestID, "_"))
+
+	parsed, err := url.Parse(target)
+	require.NoError(t, err)
+	require.Equal(t, "acme", parsed.Query().Get("tenant"))
+	require.Equal(t, "state-1", parsed.Query().Get("RelayState"))
+	deflated, err := base64.StdEncoding.DecodeString(parsed.Query().Get("SAMLRequest"))
+	require.NoError(t, err)
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	require.NoError(t, err)
+
+	request, err := parseDocument(inflated)
+	require.NoError(t, err)
+	require.True(t, request.is(NamespaceProtocol, "AuthnRequest"))
+	require.Equal(t, requestID, request.attrValue("ID"))
+	require.Equal(t, testSP.ACSURL, request.attrValue("AssertionConsumerServiceURL"))
+	require.Equal(t, testSP.EntityID, request.child(NamespaceAssertion, "Issuer").textContent())
+}
+
+func TestParseResponseAcceptsSignedAssertion(t *testing.T) {
+	idp := newTestIdP(t)
+
+	assertion, err := idp.parse(t, idp.response(idp.sign(t, idp.assertion(idp.defaultParams()))))
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", assertion.NameID)
+	require.Equal(t, "_session-1", assertion.SessionIndex)
+	require.Equal(t, "alice@example.com", assertion.FirstAttribute("email"))
+	require.Equal(t, []string{"engineering", "admins & ops"}, assertion.Attribute("groups"))
+}
+
+func TestParseResponseRejectsTampering(t *testing.T) {
+	idp := newTestIdP(t)
+	signed := idp.sign(t, idp.assertion(idp.defaultParams()))
+
+	tampered := strings.Replace(signed, "<saml:AttributeValue>engineering<", "<saml:AttributeValue>root<", 1)
+	_, err := idp.parse(t, idp.response(tampered))
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+
+	_, err = idp.parse(t, idp.response(idp.assertion(idp.defaultParams())))
+	require.ErrorIs(t, err, ErrSignatureMissing)
+
+	other := newTestIdP(t)
+	_, err = idp.parse(t, idp.response(other.sign(t, idp.assertion(idp.defaultParams()))))
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+}
+
+func TestParseResponseRejectsSignatureWrapping(t *testing.T) {
+	idp := newTestIdP(t)
+	signed := idp.sign(t, idp.assertion(idp.defaultParams()))
+
+	evil := idp.defaultParams()
+	evil.id = "_evil"
+	evil.email = "mallory@example.com"
+	// An unsigned assertion next to a genuinely signed one.
+	_, err := idp.parse(t, idp.response(idp.assertion(evil), signed))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	// The signed assertion's signature moved onto an attacker assertion reusing its ID.
+	evil.id = "_assertion-1"
+	moved := idp.assertion(evil)
+	_, err = idp.parse(t, idp.response(afterIssuer(moved, signatureElement(signed))))
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+}
+
+func TestParseResponseChecksConditions(t *testing.T) {
+	idp := newTestIdP(t)
+
+	wrongAudience := idp.defaultParams()
+	wrongAudience.audience = "https://other.example.com"
+	_, err := idp.parse(t, idp.response(idp.sign(t, idp.assertion(wrongAudience))))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	expired := idp.defaultParams()
+	expired.expiry = idp.now.Add(-10 * time.Minute)
+	_, err = idp.parse(t, idp.response(idp.sign(t, idp.assertion(expired))))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	wrongRecipient := idp.defaultParams()
+	wrongRecipient.recipient = "https://evil.example.com/acs"
+	_, err = idp.parse(t, idp.response(idp.sign(t, idp.assertion(wrongRecipient))))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	metadata, err := ParseIdPMetadata([]byte(idp.metadata()))
+	require.NoError(t, err)
+	_, err = ParseResponse(
+		base64.StdEncoding.EncodeToString([]byte(idp.response(idp.sign(t, idp.assertion(idp.defaultParams()))))),
+		ValidateOptions{SP: testSP, IdP: metadata, RequestID: "_another-request", Now: idp.now},
+	)
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
@@ -0,0 +1,483 @@
+package pdscim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed SCIM filter expression (RFC 7644 section 3.4.2.2).
+type Filter struct {
+	root expr
+}
+
+// ParseFilter parses a filter such as `userName eq "bjensen" and active eq true`.
+// Errors are *Error values with scimType invalidFilter.
+func ParseFilter(raw string) (*Filter, error) {
+	tokens, err := tokenize(raw)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, invalidFilter("unexpected %q", p.peek().text)
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match reports whether the resource satisfies the filter. A nil filter matches everything.
+func (f *Filter) Match(resource map[string]any) bool {
+	if f == nil || f.root == nil {
+		return true
+	}
+	return f.root.match(resource)
+}
+
+func invalidFilter(format string, args ...any) *Error {
+	return BadRequest(ScimTypeInvalidFilter, fmt.Sprintf(format, args...))
+}
+
+type expr interface {
+	match(resource map[string]any) bool
+}
+
+type andExpr struct{ left, right expr }
+
+func (e andExpr) match(resource map[string]any) bool {
+	return e.left.match(resource) && e.right.match(resource)
+}
+
+type orExpr struct{ left, right expr }
+
+func (e orExpr) match(resource map[string]any) bool {
+	return e.left.match(resource) || e.right.match(resource)
+}
+
+type notExpr struct{ inner expr }
+
+func (e notExpr) match(resource map[string]any) bool {
+	return !e.inner.match(resource)
+}
+
+// attrPath is an attribute name with an optional sub-attribute, e.g. name.givenName.
+type attrPath struct {
+	attr string
+	sub  string
+}
+
+func parseAttrPath(raw string) (attrPath, bool) {
+	raw = trimSchemaURN(raw)
+	attr, sub, _ := strings.Cut(raw, ".")
+	if !validAttrName(attr) || (sub != "" && !validAttrName(sub)) {
+		return attrPath{}, false
+	}
+	return attrPath{attr: attr, sub: sub}, true
+}
+
+func validAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if unicode.IsLetter(r) || r == '$' || (i > 0 && (unicode.IsDigit(r) || r == '_' || r == '-')) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// values collects the values the path points at. Multi-valued attributes contribute each
+// element; for complex elements without a sub-attribute the "value" sub-attribute is used.
+func (p attrPath) values(resource map[string]any) []any {
+	v, ok := Lookup(resource, p.attr)
+	if !ok || v == nil {
+		return nil
+	}
+	elements, multi := v.([]any)
+	if !multi {
+		elements = []any{v}
+	}
+	out := make([]any, 0, len(elements))
+	for _, element := range elements {
+		complexValue, isComplex := element.(map[string]any)
+		switch {
+		case p.sub != "" && isComplex:
+			if sub, ok := Lookup(complexValue, p.sub); ok && sub != nil {
+				out = append(out, sub)
+			}
+		case p.sub != "":
+		case isComplex && multi:
+			if sub, ok := Lookup(complexValue, "value"); ok && sub != nil {
+				out = append(out, sub)
+			}
+		default:
+			out = append(out, element)
+		}
+	}
+	return out
+}
+
+type presentExpr struct{ path attrPath }
+
+func (e presentExpr) match(resource map[string]any) bool {
+	for _, v := range e.path.values(resource) {
+		if s, ok := v.(string); ok && s == "" {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+type compareExpr struct {
+	path  attrPath
+	op    string
+	value any
+}
+
+func (e compareExpr) match(resource map[string]any) bool {
+	values := e.path.values(resource)
+	if e.op == "ne" {
+		for _, v := range values {
+			if compare("eq", v, e.value) {
+				return false
+			}
+		}
+		return e.value != nil || len(values) > 0
+	}
+	if e.value == nil && e.op == "eq" {
+		return len(values) == 0
+	}
+	for _, v := range values {
+		if compare(e.op, v, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuePathExpr matches when any element of a multi-valued attribute satisfies the inner
+// filter, e.g. emails[type eq "work" and value co "@example.com"].
+type valuePathExpr struct {
+	attr  string
+	inner expr
+}
+
+func (e valuePathExpr) match(resource map[string]any) bool {
+	v, ok := Lookup(resource, e.attr)
+	if !ok {
+		return false
+	}
+	elements, multi := v.([]any)
+	if !multi {
+		elements = []any{v}
+	}
+	for _, element := range elements {
+		if complexValue, ok := element.(map[string]any); ok && e.inner.match(complexValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func compare(op string, actual, expected any) bool {
+	switch want := expected.(type) {
+	case string:
+		got, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		got, want = strings.ToLower(got), strings.ToLower(want)
+		switch op {
+		case "eq":
+			return got == want
+		case "co":
+			return strings.Contains(got, want)
+		case "sw":
+			return strings.HasPrefix(got, want)
+		case "ew":
+			return strings.HasSuffix(got, want)
+		case "gt":
+			return got > want
+		case "ge":
+			return got >= want
+		case "lt":
+			return got < want
+		case "le":
+			return got <= want
+		}
+	case bool:
+		got, ok := actual.(bool)
+		return ok && op == "eq" && got == want
+	case float64:
+		got, ok := toFloat(actual)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return got == want
+		case "gt":
+			return got > want
+		case "ge":
+			return got >= want
+		case "lt":
+			return got < want
+		case "le":
+			return got <= want
+		}
+	}
+	return false
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+var compareOperators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(raw string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(raw); {
+		c := raw[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket, text: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(raw); end++ {
+				if raw[end] == '\\' {
+					end++
+					continue
+				}
+				if raw[end] == '"' {
+					break
+				}
+			}
+			if end >= len(raw) {
+				return nil, invalidFilter("unterminated string")
+			}
+			var s string
+			if err := json.Unmarshal([]byte(raw[i:end+1]), &s); err != nil {
+				return nil, invalidFilter("invalid string %s", raw[i:end+1])
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s})
+			i = end + 1
+		default:
+			end := i
+			for end < len(raw) && !strings.ContainsRune(" \t\n\r()[]\"", rune(raw[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: raw[i:end]})
+			i = end
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, invalidFilter("empty filter")
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *filterParser) peek() token {
+	if p.done() {
+		return token{kind: -1}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (p *filterParser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (expr, error) {
+	if p.peekKeyword("not") {
+		p.next()
+		if p.peek().kind != tokenOpenParen {
+			return nil, invalidFilter("not must be followed by a parenthesized filter")
+		}
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{inner: inner}, nil
+	}
+	if p.peek().kind == tokenOpenParen {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next().kind != tokenCloseParen {
+			return nil, invalidFilter("missing )")
+		}
+		return inner, nil
+	}
+	return p.parseAttrExpr()
+}
+
+func (p *filterParser) parseAttrExpr() (expr, error) {
+	t := p.next()
+	if t.kind != tokenWord {
+		return nil, invalidFilter("expected attribute, got %q", t.text)
+	}
+	path, ok := parseAttrPath(t.text)
+	if !ok {
+		return nil, invalidFilter("invalid attribute %q", t.text)
+	}
+	if p.peek().kind == tokenOpenBracket {
+		if path.sub != "" {
+			return nil, invalidFilter("invalid attribute %q", t.text)
+		}
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next().kind != tokenCloseBracket {
+			return nil, invalidFilter("missing ]")
+		}
+		return valuePathExpr{attr: path.attr, inner: inner}, nil
+	}
+	opToken := p.next()
+	op := strings.ToLower(opToken.text)
+	if opToken.kind == tokenWord && op == "pr" {
+		return presentExpr{path: path}, nil
+	}
+	if opToken.kind != tokenWord || !compareOperators[op] {
+		return nil, invalidFilter("unknown operator %q", opToken.text)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if _, isBool := value.(bool); isBool && op != "eq" && op != "ne" {
+		return nil, invalidFilter("operator %s does not apply to booleans", op)
+	}
+	if _, isNumber := value.(float64); isNumber && (op == "co" || op == "sw" || op == "ew") {
+		return nil, invalidFilter("operator %s does not apply to numbers", op)
+	}
+	if value == nil && op != "eq" && op != "ne" {
+		return nil, invalidFilter("operator %s does not apply to null", op)
+	}
+	return compareExpr{path: path, op: op, value: value}, nil
+}
+
+func (p *filterParser) parseValue() (any, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return t.text, nil
+	case tokenWord:
+		switch strings.ToLower(t.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		if n, err := strconv.ParseFloat(t.text, 64); err == nil {
+			return n, nil
+		}
+	}
+	return nil, invalidFilter("invalid comparison value %q", t.text)
+}
@@ -0,0 +1,91 @@
+package pdscim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/pdscim"
+)
+
+func testUser() map[string]any {
+	return map[string]any{
+		"id":          "42",
+		"externalId":  "00u1abcd",
+		"userName":    "Bjensen@Example.com",
+		"displayName": "Barbara Jensen",
+		"active":      true,
+		"name":        map[string]any{"givenName": "Barbara", "familyName": "Jensen"},
+		"emails": []any{
+			map[string]any{"value": "bjensen@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "babs@home.example", "type": "home"},
+		},
+		"meta": map[string]any{"resourceType": "User"},
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	cases := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "bjensen@example.com"`, true},
+		{`USERNAME Eq "BJENSEN@EXAMPLE.COM"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "bjensen"`, true},
+		{`externalId eq "00u1abcd" and active eq true`, true},
+		{`externalId eq "other" or displayName co "jensen"`, true},
+		{`name.familyName ew "sen"`, true},
+		{`name.givenName eq "Babs"`, false},
+		{`emails co "home.example"`, true},
+		{`emails.value eq "babs@home.example"`, true},
+		{`emails[type eq "work" and value co "@example.com"]`, true},
+		{`emails[type eq "other"]`, false},
+		{`title pr`, false},
+		{`displayName pr and not (active eq false)`, true},
+		{`active ne true`, false},
+		{`nickName ne "x"`, true},
+		{`nickName eq null`, true},
+		{`(userName eq "a" or userName eq "b") and active eq true`, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.filter, func(t *testing.T) {
+			filter, err := pdscim.ParseFilter(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, filter.Match(testUser()))
+		})
+	}
+}
+
+func TestParseFilterRejectsInvalidExpressions(t *testing.T) {
+	for _, raw := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName like "x"`,
+		`userName eq "unterminated`,
+		`(userName eq "x"`,
+		`active gt true`,
+		`userName eq "x" extra`,
+		`emails[type eq "work"`,
+	} {
+		_, err := pdscim.ParseFilter(raw)
+		var scimErr *pdscim.Error
+		require.ErrorAs(t, err, &scimErr, raw)
+		require.Equal(t, pdscim.ScimTypeInvalidFilter, scimErr.ScimType, raw)
+		require.Equal(t, 400, scimErr.Status)
+	}
+}
+
+func TestPage(t *testing.T) {
+	resources := []map[string]any{{"id": "1"}, {"id": "2"}, {"id": "3"}}
+
+	page := pdscim.Page(resources, 2, 5)
+	require.Equal(t, 3, page.TotalResults)
+	require.Equal(t, 2, page.ItemsPerPage)
+	require.Equal(t, "2", page.Resources[0]["id"])
+
+	empty := pdscim.Page(resources, 10, 5)
+	require.Equal(t, 3, empty.TotalResults)
+	require.NotNil(t, empty.Resources)
+	require.Empty(t, empty.Resources)
+}
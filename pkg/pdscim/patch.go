@@ -0,0 +1,297 @@
+package pdscim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// PatchRequest is the body of a SCIM PATCH (RFC 7644 section 3.5.2).
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// ParsePatchRequest decodes and validates a PATCH body. Operation names are normalized to
+// lower case because Entra ID sends "Add"/"Replace"/"Remove".
+func ParsePatchRequest(body []byte) (*PatchRequest, error) {
+	var req PatchRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, BadRequest(ScimTypeInvalidSyntax, "malformed PATCH body")
+	}
+	if !slices.Contains(req.Schemas, SchemaPatchOp) {
+		return nil, BadRequest(ScimTypeInvalidSyntax, "PATCH body must use the PatchOp schema")
+	}
+	if len(req.Operations) == 0 {
+		return nil, BadRequest(ScimTypeInvalidSyntax, "PATCH body has no operations")
+	}
+	for i := range req.Operations {
+		op := &req.Operations[i]
+		op.Op = strings.ToLower(strings.TrimSpace(op.Op))
+		op.Path = strings.TrimSpace(op.Path)
+		switch op.Op {
+		case "add", "replace":
+			if op.Value == nil {
+				return nil, BadRequest(ScimTypeInvalidValue, fmt.Sprintf("%s operation needs a value", op.Op))
+			}
+		case "remove":
+			if op.Path == "" {
+				return nil, BadRequest(ScimTypeNoTarget, "remove operation needs a path")
+			}
+		default:
+			return nil, BadRequest(ScimTypeInvalidSyntax, fmt.Sprintf("unknown operation %q", op.Op))
+		}
+	}
+	return &req, nil
+}
+
+// ApplyPatch applies the operations to resource in order. The resource is modified in
+// place, so callers patch a copy and validate the result before persisting it.
+func ApplyPatch(resource map[string]any, operations []PatchOperation) error {
+	for _, op := range operations {
+		if err := applyOperation(resource, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patchPath is attr, attr.sub, attr[filter] or attr[filter].sub.
+type patchPath struct {
+	attr   string
+	sub    string
+	filter expr
+}
+
+func parsePatchPath(raw string) (patchPath, error) {
+	raw = trimSchemaURN(raw)
+	open := strings.IndexByte(raw, '[')
+	if open < 0 {
+		path, ok := parseAttrPath(raw)
+		if !ok {
+			return patchPath{}, BadRequest(ScimTypeInvalidPath, fmt.Sprintf("invalid path %q", raw))
+		}
+		return patchPath{attr: path.attr, sub: path.sub}, nil
+	}
+	closeIdx := strings.LastIndexByte(raw, ']')
+	if closeIdx < open || !validAttrName(raw[:open]) {
+		return patchPath{}, BadRequest(ScimTypeInvalidPath, fmt.Sprintf("invalid path %q", raw))
+	}
+	filter, err := ParseFilter(raw[open+1 : closeIdx])
+	if err != nil {
+		return patchPath{}, BadRequest(ScimTypeInvalidPath, fmt.Sprintf("invalid path filter in %q", raw))
+	}
+	path := patchPath{attr: raw[:open], filter: filter.root}
+	if rest := raw[closeIdx+1:]; rest != "" {
+		sub, ok := strings.CutPrefix(rest, ".")
+		if !ok || !validAttrName(sub) {
+			return patchPath{}, BadRequest(ScimTypeInvalidPath, fmt.Sprintf("invalid path %q", raw))
+		}
+		path.sub = sub
+	}
+	return path, nil
+}
+
+func applyOperation(resource map[string]any, op PatchOperation) error {
+	if op.Path == "" {
+		values, ok := op.Value.(map[string]any)
+		if !ok {
+			return BadRequest(ScimTypeInvalidValue, "operation without a path needs an object value")
+		}
+		for key, value := range values {
+			if err := applyOperation(resource, PatchOperation{Op: op.Op, Path: key, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if extension := trimSchemaURN(op.Path); strings.HasPrefix(strings.ToLower(extension), "urn:") {
+		// Extension attributes (e.g. the enterprise User schema) are kept opaque under their
+		// full URN; services ignore the ones they do not model.
+		if op.Op == "remove" {
+			deleteKey(resource, extension)
+		} else {
+			setKey(resource, extension, op.Value)
+		}
+		return nil
+	}
+	path, err := parsePatchPath(op.Path)
+	if err != nil {
+		return err
+	}
+	if path.filter != nil {
+		return applyFiltered(resource, op, path)
+	}
+	switch op.Op {
+	case "remove":
+		removeAttribute(resource, path, op.Value)
+		return nil
+	case "add":
+		addAttribute(resource, path, op.Value)
+		return nil
+	default:
+		if path.sub != "" {
+			parent := complexAttribute(resource, path.attr)
+			setKey(parent, path.sub, op.Value)
+			return nil
+		}
+		setKey(resource, path.attr, op.Value)
+		return nil
+	}
+}
+
+func addAttribute(resource map[string]any, path patchPath, value any) {
+	if path.sub != "" {
+		setKey(complexAttribute(resource, path.attr), path.sub, value)
+		return
+	}
+	existing, _ := Lookup(resource, path.attr)
+	switch current := existing.(type) {
+	case []any:
+		additions, ok := value.([]any)
+		if !ok {
+			additions = []any{value}
+		}
+		for _, addition := range additions {
+			if !slices.ContainsFunc(current, func(element any) bool { return sameElement(element, addition) }) {
+				current = append(current, addition)
+			}
+		}
+		setKey(resource, path.attr, current)
+	case map[string]any:
+		if values, ok := value.(map[string]any); ok {
+			for key, v := range values {
+				setKey(current, key, v)
+			}
+			return
+		}
+		setKey(resource, path.attr, value)
+	default:
+		setKey(resource, path.attr, value)
+	}
+}
+
+func removeAttribute(resource map[string]any, path patchPath, value any) {
+	key, ok := findKey(resource, path.attr)
+	if !ok {
+		return
+	}
+	if path.sub != "" {
+		switch current := resource[key].(type) {
+		case map[string]any:
+			deleteKey(current, path.sub)
+		case []any:
+			for _, element := range current {
+				if complexValue, ok := element.(map[string]any); ok {
+					deleteKey(complexValue, path.sub)
+				}
+			}
+		}
+		return
+	}
+	// Entra ID removes group members with path "members" and the members to drop as the
+	// value, instead of a value filter in the path.
+	if current, isList := resource[key].([]any); isList && value != nil {
+		removals, ok := value.([]any)
+		if !ok {
+			removals = []any{value}
+		}
+		resource[key] = slices.DeleteFunc(current, func(element any) bool {
+			return slices.ContainsFunc(removals, func(removal any) bool { return sameElement(element, removal) })
+		})
+		return
+	}
+	delete(resource, key)
+}
+
+func applyFiltered(resource map[string]any, op PatchOperation, path patchPath) error {
+	key, ok := findKey(resource, path.attr)
+	current, isList := resource[key].([]any)
+	if !ok || !isList {
+		if op.Op == "remove" {
+			return nil
+		}
+		return BadRequest(ScimTypeNoTarget, fmt.Sprintf("%s has no values to match", path.attr))
+	}
+	matched := false
+	kept := current[:0]
+	for _, element := range current {
+		complexValue, isComplex := element.(map[string]any)
+		if !isComplex || !path.filter.match(complexValue) {
+			kept = append(kept, element)
+			continue
+		}
+		matched = true
+		switch {
+		case op.Op == "remove" && path.sub == "":
+			continue
+		case op.Op == "remove":
+			deleteKey(complexValue, path.sub)
+		case path.sub != "":
+			setKey(complexValue, path.sub, op.Value)
+		default:
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return BadRequest(ScimTypeInvalidValue, fmt.Sprintf("%s values are objects", path.attr))
+			}
+			for k, v := range values {
+				setKey(complexValue, k, v)
+			}
+		}
+		kept = append(kept, complexValue)
+	}
+	resource[key] = kept
+	if !matched && op.Op != "remove" {
+		return BadRequest(ScimTypeNoTarget, fmt.Sprintf("no %s value matches the path filter", path.attr))
+	}
+	return nil
+}
+
+// complexAttribute returns the object stored under name, creating it when missing.
+func complexAttribute(resource map[string]any, name string) map[string]any {
+	if existing, ok := Lookup(resource, name); ok {
+		if complexValue, ok := existing.(map[string]any); ok {
+			return complexValue
+		}
+	}
+	complexValue := map[string]any{}
+	setKey(resource, name, complexValue)
+	return complexValue
+}
+
+func setKey(resource map[string]any, name string, value any) {
+	if key, ok := findKey(resource, name); ok {
+		resource[key] = value
+		return
+	}
+	resource[name] = value
+}
+
+func deleteKey(resource map[string]any, name string) {
+	if key, ok := findKey(resource, name); ok {
+		delete(resource, key)
+	}
+}
+
+// sameElement compares multi-valued attribute elements by their "value" sub-attribute when
+// both carry one, and structurally otherwise.
+func sameElement(a, b any) bool {
+	left, leftComplex := a.(map[string]any)
+	right, rightComplex := b.(map[string]any)
+	if leftComplex && rightComplex {
+		lv, lok := Lookup(left, "value")
+		rv, rok := Lookup(right, "value")
+		if lok && rok {
+			return fmt.Sprint(lv) == fmt.Sprint(rv)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
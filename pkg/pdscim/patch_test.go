@@ -0,0 +1,105 @@
+package pdscim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/pdscim"
+)
+
+func applyPatch(t *testing.T, resource map[string]any, body string) error {
+	t.Helper()
+	req, err := pdscim.ParsePatchRequest([]byte(body))
+	require.NoError(t, err)
+	return pdscim.ApplyPatch(resource, req.Operations)
+}
+
+func TestApplyPatchOktaAndEntraShapes(t *testing.T) {
+	user := testUser()
+	// Okta deactivates with a path-less replace.
+	require.NoError(t, applyPatch(t, user, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "value": {"active": false, "name": {"givenName": "Babs"}}}]
+	}`))
+	require.Equal(t, false, user["active"])
+	require.Equal(t, map[string]any{"givenName": "Babs"}, user["name"])
+
+	// Entra ID capitalizes ops, sends booleans as strings and uses URN-qualified paths.
+	require.NoError(t, applyPatch(t, user, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "True"},
+			{"op": "Add", "path": "urn:ietf:params:scim:schemas:core:2.0:User:name.familyName", "value": "Jensen"},
+			{"op": "Add", "path": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department", "value": "Ops"},
+			{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "barbara@example.com"}
+		]
+	}`))
+	require.Equal(t, "True", user["active"])
+	require.Equal(t, map[string]any{"givenName": "Babs", "familyName": "Jensen"}, user["name"])
+	require.Equal(t, "Ops", user["urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department"])
+	require.Equal(t, "barbara@example.com", user["emails"].([]any)[0].(map[string]any)["value"])
+}
+
+func TestApplyPatchGroupMembers(t *testing.T) {
+	group := map[string]any{
+		"displayName": "Engineering",
+		"members":     []any{map[string]any{"value": "1"}, map[string]any{"value": "2"}},
+	}
+	require.NoError(t, applyPatch(t, group, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "2"}, {"value": "3"}]},
+			{"op": "remove", "path": "members[value eq \"1\"]"}
+		]
+	}`))
+	require.Equal(t, []any{map[string]any{"value": "2"}, map[string]any{"value": "3"}}, group["members"])
+
+	// Entra ID removes members by value instead of a path filter.
+	require.NoError(t, applyPatch(t, group, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Remove", "path": "members", "value": [{"value": "3"}]}]
+	}`))
+	require.Equal(t, []any{map[string]any{"value": "2"}}, group["members"])
+
+	require.NoError(t, applyPatch(t, group, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "remove", "path": "members"}, {"op": "replace", "path": "displayName", "value": "Platform"}]
+	}`))
+	require.NotContains(t, group, "members")
+	require.Equal(t, "Platform", group["displayName"])
+
+	err := applyPatch(t, group, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "members[value eq \"9\"].display", "value": "x"}]
+	}`)
+	var scimErr *pdscim.Error
+	require.ErrorAs(t, err, &scimErr)
+	require.Equal(t, pdscim.ScimTypeNoTarget, scimErr.ScimType)
+}
+
+func TestParsePatchRequestValidation(t *testing.T) {
+	for body, scimType := range map[string]string{
+		`not json`: pdscim.ScimTypeInvalidSyntax,
+		`{"Operations": [{"op": "add", "path": "x", "value": 1}]}`:                                          pdscim.ScimTypeInvalidSyntax,
+		`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": []}`:                  pdscim.ScimTypeInvalidSyntax,
+		`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "move"}]}`:    pdscim.ScimTypeInvalidSyntax,
+		`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "remove"}]}`:  pdscim.ScimTypeNoTarget,
+		`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "replace"}]}`: pdscim.ScimTypeInvalidValue,
+	} {
+		_, err := pdscim.ParsePatchRequest([]byte(body))
+		var scimErr *pdscim.Error
+		require.ErrorAs(t, err, &scimErr, body)
+		require.Equal(t, scimType, scimErr.ScimType, body)
+	}
+
+	req, err := pdscim.ParsePatchRequest([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "add", "path": "bad path!", "value": 1}]
+	}`))
+	require.NoError(t, err)
+	err = pdscim.ApplyPatch(map[string]any{}, req.Operations)
+	var scimErr *pdscim.Error
+	require.ErrorAs(t, err, &scimErr)
+	require.Equal(t, pdscim.ScimTypeInvalidPath, scimErr.ScimType)
+}
@@ -0,0 +1,172 @@
+// Package pdscim implements the protocol side of SCIM 2.0 (RFC 7643/7644) for service
+// providers: the error and list envelopes, the filter language used by GET queries, and the
+// PATCH operations identity providers such as Okta and Entra ID send.
+//
+// Resources are handled as JSON objects (map[string]any) so filters and patches stay
+// independent of the typed models a service keeps behind them. Attribute names compare
+// case-insensitively, as the RFC requires.
+package pdscim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Schema URNs used by the core resources and protocol messages.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// ContentType is the media type of every SCIM request and response body.
+const ContentType = "application/scim+json"
+
+// scimType values from RFC 7644 section 3.12.
+const (
+	ScimTypeInvalidFilter = "invalidFilter"
+	ScimTypeTooMany       = "tooMany"
+	ScimTypeUniqueness    = "uniqueness"
+	ScimTypeMutability    = "mutability"
+	ScimTypeInvalidSyntax = "invalidSyntax"
+	ScimTypeInvalidPath   = "invalidPath"
+	ScimTypeNoTarget      = "noTarget"
+	ScimTypeInvalidValue  = "invalidValue"
+)
+
+// Error is a SCIM error response. It doubles as a Go error so parsers can return it as-is.
+type Error struct {
+	Status   int
+	ScimType string
+	Detail   string
+}
+
+func (e *Error) Error() string {
+	if e.ScimType == "" {
+		return fmt.Sprintf("scim: %s", e.Detail)
+	}
+	return fmt.Sprintf("scim: %s: %s", e.ScimType, e.Detail)
+}
+
+// Response renders the error body.
+func (e *Error) Response() ErrorResponse {
+	return ErrorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   fmt.Sprintf("%d", e.Status),
+		ScimType: e.ScimType,
+		Detail:   e.Detail,
+	}
+}
+
+// NewError builds an Error; scimType may be empty.
+func NewError(status int, scimType, detail string) *Error {
+	return &Error{Status: status, ScimType: scimType, Detail: detail}
+}
+
+// BadRequest is shorthand for a 400 error with a scimType.
+func BadRequest(scimType, detail string) *Error {
+	return NewError(http.StatusBadRequest, scimType, detail)
+}
+
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string         `json:"schemas"`
+	TotalResults int              `json:"totalResults"`
+	StartIndex   int              `json:"startIndex"`
+	ItemsPerPage int              `json:"itemsPerPage"`
+	Resources    []map[string]any `json:"Resources"`
+}
+
+// Page applies 1-based startIndex/count pagination to already filtered resources.
+// A count of zero asks only for totalResults, as RFC 7644 section 3.4.2.4 allows.
+func Page(resources []map[string]any, startIndex, count int) ListResponse {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	if count < 0 {
+		count = 0
+	}
+	from := min(startIndex-1, len(resources))
+	to := min(from+count, len(resources))
+	items := resources[from:to]
+	if items == nil {
+		items = []map[string]any{}
+	}
+	return ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	}
+}
+
+// ToResource converts a typed resource into the generic JSON form filters and patches
+// operate on.
+func ToResource(v any) (map[string]any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var resource map[string]any
+	if err := json.Unmarshal(raw, &resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// FromResource decodes a generic resource back into a typed one.
+func FromResource(resource map[string]any, v any) error {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Lookup returns the value of a top-level attribute, matching the name case-insensitively.
+func Lookup(resource map[string]any, name string) (any, bool) {
+	key, ok := findKey(resource, name)
+	if !ok {
+		return nil, false
+	}
+	return resource[key], true
+}
+
+func findKey(resource map[string]any, name string) (string, bool) {
+	if _, ok := resource[name]; ok {
+		return name, true
+	}
+	for key := range resource {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// trimSchemaURN strips a schema URN prefix from an attribute path, so
+// "urn:ietf:params:scim:schemas:core:2.0:User:name.givenName" becomes "name.givenName".
+func trimSchemaURN(path string) string {
+	if !strings.HasPrefix(strings.ToLower(path), "urn:") {
+		return path
+	}
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			return path[len(schema)+1:]
+		}
+	}
+	return path
+}
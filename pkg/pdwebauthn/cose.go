@@ -0,0 +1,139 @@
+package pdwebauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSE algorithm identifiers offered to authenticators, in order of preference.
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// SupportedAlgorithms is advertised as pubKeyCredParams at registration.
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+const (
+	coseKeyType   = 1
+	coseAlgorithm = 3
+	coseEC2Curve  = -1
+	coseEC2X      = -2
+	coseEC2Y      = -3
+	coseRSAN      = -1
+	coseRSAE      = -2
+	coseOKPCurve  = -1
+	coseOKPX      = -2
+
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	minRSABits = 2048
+)
+
+var ErrUnsupportedKey = errors.New("webauthn: unsupported credential public key")
+
+// publicKey is a parsed COSE_Key.
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+func parseCOSEKey(raw []byte) (*publicKey, []byte, error) {
+	decoded, rest, err := decodeCBOR(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode credential public key: %w", err)
+	}
+	m, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, nil, ErrUnsupportedKey
+	}
+	kty, _ := intParam(m, coseKeyType)
+	alg, ok := intParam(m, coseAlgorithm)
+	if !ok {
+		return nil, nil, ErrUnsupportedKey
+	}
+	switch {
+	case kty == coseKeyTypeEC2 && alg == AlgES256:
+		crv, _ := intParam(m, coseEC2Curve)
+		x, xok := bytesParam(m, coseEC2X)
+		y, yok := bytesParam(m, coseEC2Y)
+		if crv != coseCurveP256 || !xok || !yok || len(x) != 32 || len(y) != 32 {
+			return nil, nil, ErrUnsupportedKey
+		}
+		uncompressed := append(append([]byte{0x04}, x...), y...)
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), uncompressed)
+		if err != nil {
+			return nil, nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: key}, rest, nil
+	case kty == coseKeyTypeOKP && alg == AlgEdDSA:
+		crv, _ := intParam(m, coseOKPCurve)
+		x, ok := bytesParam(m, coseOKPX)
+		if crv != coseCurveEd25519 || !ok || len(x) != ed25519.PublicKeySize {
+			return nil, nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: ed25519.PublicKey(x)}, rest, nil
+	case kty == coseKeyTypeRSA && alg == AlgRS256:
+		n, nok := bytesParam(m, coseRSAN)
+		e, eok := bytesParam(m, coseRSAE)
+		if !nok || !eok || len(e) == 0 || len(e) > 4 {
+			return nil, nil, ErrUnsupportedKey
+		}
+		modulus := new(big.Int).SetBytes(n)
+		if modulus.BitLen() < minRSABits {
+			return nil, nil, ErrUnsupportedKey
+		}
+		exponent := int(new(big.Int).SetBytes(e).Int64())
+		if exponent < 3 || exponent%2 == 0 {
+			return nil, nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: &rsa.PublicKey{N: modulus, E: exponent}}, rest, nil
+	default:
+		return nil, nil, ErrUnsupportedKey
+	}
+}
+
+func (k *publicKey) verify(signed, signature []byte) bool {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, signed, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
+
+func intParam(m map[any]any, label int64) (int64, bool) {
+	value, ok := cborIntKey(m, label)
+	if !ok {
+		return 0, false
+	}
+	return cborInt(value)
+}
+
+func bytesParam(m map[any]any, label int64) ([]byte, bool) {
+	value, ok := cborIntKey(m, label)
+	if !ok {
+		return nil, false
+	}
+	b, ok := value.([]byte)
+	return b, ok
+}
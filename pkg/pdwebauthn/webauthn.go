@@ -0,0 +1,432 @@
+// Package pdwebauthn implements the relying-party side of WebAuthn Level 3 ceremonies for
+// passkeys and security keys: building the options passed to navigator.credentials and
+// verifying the JSON responses (PublicKeyCredential.toJSON()) that come back.
+//
+// Attestation is requested as "none", so registration trusts the authenticator model the
+// same way as a passkey synced between devices; attestation statements are not verified.
+package pdwebauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	challengeBytes = 32
+	// maxCredentialIDBytes follows the WebAuthn limit on credential ID length.
+	maxCredentialIDBytes = 1023
+
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagBackupState    = 0x10
+	flagAttestedData   = 0x40
+	flagExtensionData  = 0x80
+
+	ceremonyCreate = "webauthn.create"
+	ceremonyGet    = "webauthn.get"
+)
+
+// User verification requirements, as in the WebAuthn options.
+const (
+	UserVerificationRequired  = "required"
+	UserVerificationPreferred = "preferred"
+)
+
+var (
+	ErrVerificationFailed = errors.New("webauthn: verification failed")
+	ErrMalformedResponse  = errors.New("webauthn: malformed response")
+)
+
+// URLEncoding is the base64url alphabet WebAuthn JSON uses for binary fields.
+var URLEncoding = base64.RawURLEncoding
+
+// RelyingParty identifies this service to authenticators. ID is the registrable domain
+// credentials are scoped to; Origins lists the web origins allowed to run ceremonies.
+type RelyingParty struct {
+	ID      string
+	Name    string
+	Origins []string
+	Timeout time.Duration
+}
+
+// NewChallenge returns a random base64url challenge.
+func NewChallenge() (string, error) {
+	buf := make([]byte, challengeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("webauthn: generate challenge: %w", err)
+	}
+	return URLEncoding.EncodeToString(buf), nil
+}
+
+// UserEntity describes the account a credential is created for. ID is an opaque handle
+// (base64url) that the authenticator returns as userHandle on discoverable logins.
+type UserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type rpEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type credentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor names an existing credential in allow and exclude lists.
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type authenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions is PublicKeyCredentialCreationOptionsJSON.
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RP                     rpEntity               `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	PubKeyCredParams       []credentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout,omitempty"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials,omitempty"`
+	AuthenticatorSelection authenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions is PublicKeyCredentialRequestOptionsJSON. An empty AllowCredentials lets the
+// authenticator offer any discoverable credential for the relying party.
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout,omitempty"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// NewCreationOptions builds registration options that ask for a discoverable passkey.
+func (rp RelyingParty) NewCreationOptions(
+	challenge string,
+	user UserEntity,
+	exclude []CredentialDescriptor,
+) CreationOptions {
+	params := make([]credentialParameter, 0, len(SupportedAlgorithms))
+	for _, alg := range SupportedAlgorithms {
+		params = append(params, credentialParameter{Type: "public-key", Alg: alg})
+	}
+	return CreationOptions{
+		Challenge:          challenge,
+		RP:                 rpEntity{ID: rp.ID, Name: rp.Name},
+		User:               user,
+		PubKeyCredParams:   params,
+		Timeout:            rp.Timeout.Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: authenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: UserVerificationPreferred,
+		},
+		Attestation: "none",
+	}
+}
+
+func (rp RelyingParty) NewRequestOptions(
+	challenge string,
+	allow []CredentialDescriptor,
+	userVerification string,
+) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		RPID:             rp.ID,
+		Timeout:          rp.Timeout.Milliseconds(),
+		AllowCredentials: allow,
+		UserVerification: userVerification,
+	}
+}
+
+// RegistrationResponse is RegistrationResponseJSON from PublicKeyCredential.toJSON().
+type RegistrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports"`
+	} `json:"response"`
+}
+
+// AssertionResponse is AuthenticationResponseJSON from PublicKeyCredential.toJSON().
+type AssertionResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// Credential is what registration yields and the relying party stores.
+type Credential struct {
+	// ID is the base64url credential ID.
+	ID             string
+	PublicKey      []byte
+	Algorithm      int64
+	SignCount      uint32
+	AAGUID         []byte
+	Transports     []string
+	UserVerified   bool
+	BackupEligible bool
+	BackupState    bool
+}
+
+// Assertion is the verified result of a login ceremony.
+type Assertion struct {
+	CredentialID string
+	// UserHandle is empty when the authenticator did not return one.
+	UserHandle   string
+	SignCount    uint32
+	UserVerified bool
+	BackupState  bool
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	aaguid    []byte
+	credID    []byte
+	publicKey []byte
+}
+
+// ParseRegistrationResponse decodes the JSON a browser posts after navigator.credentials.create.
+func ParseRegistrationResponse(raw string) (*RegistrationResponse, error) {
+	var resp RegistrationResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if resp.Type != "public-key" || resp.ID == "" {
+		return nil, ErrMalformedResponse
+	}
+	return &resp, nil
+}
+
+// ParseAssertionResponse decodes the JSON a browser posts after navigator.credentials.get.
+func ParseAssertionResponse(raw string) (*AssertionResponse, error) {
+	var resp AssertionResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if resp.Type != "public-key" || resp.ID == "" {
+		return nil, ErrMalformedResponse
+	}
+	return &resp, nil
+}
+
+// VerifyRegistration checks a registration ceremony against the challenge that was issued.
+func (rp RelyingParty) VerifyRegistration(
+	challenge string,
+	resp *RegistrationResponse,
+	requireUserVerification bool,
+) (*Credential, error) {
+	if _, err := rp.verifyClientData(resp.Response.ClientDataJSON, ceremonyCreate, challenge); err != nil {
+		return nil, err
+	}
+	attestationObject, err := URLEncoding.DecodeString(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	decoded, rest, err := decodeCBOR(attestationObject)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrMalformedResponse
+	}
+	attestation, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, ErrMalformedResponse
+	}
+	if _, ok := attestation["fmt"].(string); !ok {
+		return nil, ErrMalformedResponse
+	}
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, ErrMalformedResponse
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.checkAuthenticatorData(authData, requireUserVerification); err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedData == 0 {
+		return nil, fmt.Errorf("%w: no attested credential data", ErrVerificationFailed)
+	}
+	if URLEncoding.EncodeToString(authData.credID) != resp.ID {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrVerificationFailed)
+	}
+	key, _, err := parseCOSEKey(authData.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{
+		ID:             resp.ID,
+		PublicKey:      authData.publicKey,
+		Algorithm:      key.alg,
+		SignCount:      authData.signCount,
+		AAGUID:         authData.aaguid,
+		Transports:     resp.Response.Transports,
+		UserVerified:   authData.flags&flagUserVerified != 0,
+		BackupEligible: authData.flags&flagBackupEligible != 0,
+		BackupState:    authData.flags&flagBackupState != 0,
+	}, nil
+}
+
+// VerifyAssertion checks a login ceremony signed by the stored credential public key.
+// Sign counter comparison is left to the caller, which owns the stored counter.
+func (rp RelyingParty) VerifyAssertion(
+	challenge string,
+	resp *AssertionResponse,
+	credentialPublicKey []byte,
+	requireUserVerification bool,
+) (*Assertion, error) {
+	clientDataJSON, err := rp.verifyClientData(resp.Response.ClientDataJSON, ceremonyGet, challenge)
+	if err != nil {
+		return nil, err
+	}
+	rawAuthData, err := URLEncoding.DecodeString(resp.Response.AuthenticatorData)
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	signature, err := URLEncoding.DecodeString(resp.Response.Signature)
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.checkAuthenticatorData(authData, requireUserVerification); err != nil {
+		return nil, err
+	}
+	key, _, err := parseCOSEKey(credentialPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), rawAuthData...), clientDataHash[:]...)
+	if !key.verify(signed, signature) {
+		return nil, fmt.Errorf("%w: bad signature", ErrVerificationFailed)
+	}
+	return &Assertion{
+		CredentialID: resp.ID,
+		UserHandle:   resp.Response.UserHandle,
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+		BackupState:  authData.flags&flagBackupState != 0,
+	}, nil
+}
+
+func (rp RelyingParty) verifyClientData(encoded, ceremony, challenge string) ([]byte, error) {
+	raw, err := URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	var data clientData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if data.Type != ceremony {
+		return nil, fmt.Errorf("%w: unexpected ceremony %q", ErrVerificationFailed, data.Type)
+	}
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(data.Challenge), []byte(challenge)) != 1 {
+		return nil, fmt.Errorf("%w: challenge mismatch", ErrVerificationFailed)
+	}
+	if !slices.Contains(rp.Origins, strings.TrimSuffix(data.Origin, "/")) {
+		return nil, fmt.Errorf("%w: origin %q not allowed", ErrVerificationFailed, data.Origin)
+	}
+	return raw, nil
+}
+
+func (rp RelyingParty) checkAuthenticatorData(authData *authenticatorData, requireUserVerification bool) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return fmt.Errorf("%w: rp id mismatch", ErrVerificationFailed)
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return fmt.Errorf("%w: user not present", ErrVerificationFailed)
+	}
+	if requireUserVerification && authData.flags&flagUserVerified == 0 {
+		return fmt.Errorf("%w: user not verified", ErrVerificationFailed)
+	}
+	if authData.flags&flagBackupState != 0 && authData.flags&flagBackupEligible == 0 {
+		return fmt.Errorf("%w: invalid backup flags", ErrVerificationFailed)
+	}
+	return nil
+}
+
+func parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, ErrMalformedResponse
+	}
+	out := &authenticatorData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	rest := raw[37:]
+	if out.flags&flagAttestedData != 0 {
+		if len(rest) < 18 {
+			return nil, ErrMalformedResponse
+		}
+		out.aaguid = append([]byte(nil), rest[:16]...)
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLen == 0 || idLen > maxCredentialIDBytes || len(rest) < idLen {
+			return nil, ErrMalformedResponse
+		}
+		out.credID = append([]byte(nil), rest[:idLen]...)
+		rest = rest[idLen:]
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrMalformedResponse
+		}
+		out.publicKey = append([]byte(nil), rest[:len(rest)-len(after)]...)
+		rest = after
+	}
+	if out.flags&flagExtensionData != 0 {
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrMalformedResponse
+		}
+		rest = after
+	}
+	if len(rest) != 0 {
+		return nil, ErrMalformedResponse
+	}
+	return out, nil
+}
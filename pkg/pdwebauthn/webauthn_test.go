@@ -0,0 +1,136 @@
+package pdwebauthn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuannm99/podzone/pkg/pdwebauthn"
+	"github.com/tuannm99/podzone/pkg/pdwebauthn/webauthntest"
+)
+
+var testRP = pdwebauthn.RelyingParty{
+	ID:      "podzone.local",
+	Name:    "Podzone",
+	Origins: []string{"https://app.podzone.local"},
+	Timeout: time.Minute,
+}
+
+func creationOptions(t *testing.T, challenge string) string {
+	t.Helper()
+	options := testRP.NewCreationOptions(challenge, pdwebauthn.UserEntity{ID: "NA", Name: "neo", DisplayName: "neo"}, nil)
+	raw, err := json.Marshal(options)
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func requestOptions(t *testing.T, challenge string) string {
+	t.Helper()
+	raw, err := json.Marshal(testRP.NewRequestOptions(challenge, nil, pdwebauthn.UserVerificationRequired))
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func register(t *testing.T, authenticator *webauthntest.Authenticator) *pdwebauthn.Credential {
+	t.Helper()
+	challenge, err := pdwebauthn.NewChallenge()
+	require.NoError(t, err)
+	raw, err := authenticator.Register(creationOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err := pdwebauthn.ParseRegistrationResponse(raw)
+	require.NoError(t, err)
+	credential, err := testRP.VerifyRegistration(challenge, resp, true)
+	require.NoError(t, err)
+	return credential
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	authenticator, err := webauthntest.New(testRP.ID, "https://app.podzone.local")
+	require.NoError(t, err)
+
+	credential := register(t, authenticator)
+	require.Equal(t, authenticator.ID(), credential.ID)
+	require.Equal(t, pdwebauthn.AlgES256, credential.Algorithm)
+	require.True(t, credential.UserVerified)
+	require.Equal(t, []string{"internal"}, credential.Transports)
+
+	challenge, err := pdwebauthn.NewChallenge()
+	require.NoError(t, err)
+	raw, err := authenticator.Assert(requestOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err := pdwebauthn.ParseAssertionResponse(raw)
+	require.NoError(t, err)
+	assertion, err := testRP.VerifyAssertion(challenge, resp, credential.PublicKey, true)
+	require.NoError(t, err)
+	require.Equal(t, credential.ID, assertion.CredentialID)
+	require.Equal(t, "NA", assertion.UserHandle)
+	require.Equal(t, uint32(1), assertion.SignCount)
+
+	// The same response cannot be replayed against a different challenge.
+	other, err := pdwebauthn.NewChallenge()
+	require.NoError(t, err)
+	_, err = testRP.VerifyAssertion(other, resp, credential.PublicKey, true)
+	require.ErrorIs(t, err, pdwebauthn.ErrVerificationFailed)
+}
+
+func TestVerifyRejectsWrongOriginRPAndKey(t *testing.T) {
+	authenticator, err := webauthntest.New(testRP.ID, "https://app.podzone.local")
+	require.NoError(t, err)
+	credential := register(t, authenticator)
+
+	phishing := authenticator.Clone()
+	phishing.Origin = "https://podzone-login.example"
+	challenge, err := pdwebauthn.NewChallenge()
+	require.NoError(t, err)
+	raw, err := phishing.Assert(requestOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err := pdwebauthn.ParseAssertionResponse(raw)
+	require.NoError(t, err)
+	_, err = testRP.VerifyAssertion(challenge, resp, credential.PublicKey, true)
+	require.ErrorIs(t, err, pdwebauthn.ErrVerificationFailed, "origin is bound to the signature")
+
+	otherRP := authenticator.Clone()
+	otherRP.RPID = "evil.example"
+	raw, err = otherRP.Assert(requestOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err = pdwebauthn.ParseAssertionResponse(raw)
+	require.NoError(t, err)
+	_, err = testRP.VerifyAssertion(challenge, resp, credential.PublicKey, true)
+	require.ErrorIs(t, err, pdwebauthn.ErrVerificationFailed)
+
+	stranger, err := webauthntest.New(testRP.ID, "https://app.podzone.local")
+	require.NoError(t, err)
+	raw, err = stranger.Assert(requestOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err = pdwebauthn.ParseAssertionResponse(raw)
+	require.NoError(t, err)
+	_, err = testRP.VerifyAssertion(challenge, resp, credential.PublicKey, true)
+	require.ErrorIs(t, err, pdwebauthn.ErrVerificationFailed, "signature from another key")
+
+	unverified := authenticator.Clone()
+	unverified.UserVerified = false
+	raw, err = unverified.Assert(requestOptions(t, challenge))
+	require.NoError(t, err)
+	resp, err = pdwebauthn.ParseAssertionResponse(raw)
+	require.NoError(t, err)
+	_, err = testRP.VerifyAssertion(challenge, resp, credential.PublicKey, true)
+	require.ErrorIs(t, err, pdwebauthn.ErrVerificationFailed)
+	_, err = testRP.VerifyAssertion(challenge, resp, credential.PublicKey, false)
+	require.NoError(t, err, "presence alone is enough when verification is not required")
+}
+
+func TestParseRejectsMalformedResponses(t *testing.T) {
+	_, err := pdwebauthn.ParseAssertionResponse(`{"id":"x","type":"password"}`)
+	require.ErrorIs(t, err, pdwebauthn.ErrMalformedResponse)
+	_, err = pdwebauthn.ParseRegistrationResponse(`not json`)
+	require.ErrorIs(t, err, pdwebauthn.ErrMalformedResponse)
+
+	resp, err := pdwebauthn.ParseRegistrationResponse(
+		`{"id":"AA","type":"public-key","response":{"clientDataJSON":"","attestationObject":"oA"}}`,
+	)
+	require.NoError(t, err)
+	_, err = testRP.VerifyRegistration("c", resp, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,216 @@
+// Package webauthntest provides a software authenticator that answers WebAuthn ceremonies
+// the way a browser would, for tests of relying-party code.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/tuannm99/podzone/pkg/pdwebauthn"
+)
+
+// Authenticator holds one ES256 credential. SignCount is incremented before every assertion
+// unless FixedCounter is set, which mimics authenticators that do not implement counters.
+type Authenticator struct {
+	RPID         string
+	Origin       string
+	CredentialID []byte
+	UserHandle   string
+	SignCount    uint32
+	FixedCounter bool
+	// UserVerified sets the UV flag; user presence is always asserted.
+	UserVerified bool
+
+	key *ecdsa.PrivateKey
+}
+
+func New(rpID, origin string) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		return nil, err
+	}
+	return &Authenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		CredentialID: credentialID,
+		UserVerified: true,
+		key:          key,
+	}, nil
+}
+
+// ID is the base64url credential ID.
+func (a *Authenticator) ID() string {
+	return pdwebauthn.URLEncoding.EncodeToString(a.CredentialID)
+}
+
+// Clone returns an authenticator sharing this one's key and counter, as a copied key would.
+func (a *Authenticator) Clone() *Authenticator {
+	clone := *a
+	return &clone
+}
+
+// Register answers creation options (the JSON a relying party returned) with RegistrationResponseJSON.
+func (a *Authenticator) Register(optionsJSON string) (string, error) {
+	var options pdwebauthn.CreationOptions
+	if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+		return "", err
+	}
+	a.UserHandle = options.User.ID
+	clientDataJSON, err := a.clientData("webauthn.create", options.Challenge)
+	if err != nil {
+		return "", err
+	}
+	authData := a.authenticatorData(true)
+	attestationObject := encodeMap(map[string][]byte{
+		"fmt":      encodeText("none"),
+		"attStmt":  {0xa0},
+		"authData": encodeBytes(authData),
+	})
+	return marshalJSON(map[string]any{
+		"id":    a.ID(),
+		"rawId": a.ID(),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    pdwebauthn.URLEncoding.EncodeToString(clientDataJSON),
+			"attestationObject": pdwebauthn.URLEncoding.EncodeToString(attestationObject),
+			"transports":        []string{"internal"},
+		},
+	})
+}
+
+// Assert answers request options with AuthenticationResponseJSON.
+func (a *Authenticator) Assert(optionsJSON string) (string, error) {
+	var options pdwebauthn.RequestOptions
+	if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+		return "", err
+	}
+	if !a.FixedCounter {
+		a.SignCount++
+	}
+	clientDataJSON, err := a.clientData("webauthn.get", options.Challenge)
+	if err != nil {
+		return "", err
+	}
+	authData := a.authenticatorData(false)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return marshalJSON(map[string]any{
+		"id":    a.ID(),
+		"rawId": a.ID(),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    pdwebauthn.URLEncoding.EncodeToString(clientDataJSON),
+			"authenticatorData": pdwebauthn.URLEncoding.EncodeToString(authData),
+			"signature":         pdwebauthn.URLEncoding.EncodeToString(signature),
+			"userHandle":        a.UserHandle,
+		},
+	})
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authenticatorData(withCredential bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	flags := byte(0x01)
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	out := append([]byte(nil), rpIDHash[:]...)
+	if withCredential {
+		flags |= 0x40
+	}
+	out = append(out, flags)
+	out = binary.BigEndian.AppendUint32(out, a.SignCount)
+	if withCredential {
+		out = append(out, make([]byte, 16)...)
+		out = binary.BigEndian.AppendUint16(out, uint16(len(a.CredentialID)))
+		out = append(out, a.CredentialID...)
+		out = append(out, a.coseKey()...)
+	}
+	return out
+}
+
+// coseKey encodes the public key as an EC2 COSE_Key: {1: 2, 3: -7, -1: 1, -2: x, -3: y}.
+func (a *Authenticator) coseKey() []byte {
+	x := leftPad(a.key.X, 32)
+	y := leftPad(a.key.Y, 32)
+	out := []byte{0xa5}
+	out = append(out, 0x01, 0x02)
+	out = append(out, 0x03, 0x26)
+	out = append(out, 0x20, 0x01)
+	out = append(out, 0x21)
+	out = append(out, encodeBytes(x)...)
+	out = append(out, 0x22)
+	out = append(out, encodeBytes(y)...)
+	return out
+}
+
+func leftPad(n *big.Int, size int) []byte {
+	out := make([]byte, size)
+	n.FillBytes(out)
+	return out
+}
+
+func encodeHead(major byte, n int) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n < 1<<8:
+		return []byte{major<<5 | 24, byte(n)}
+	default:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+	}
+}
+
+func encodeBytes(b []byte) []byte {
+	return append(encodeHead(2, len(b)), b...)
+}
+
+func encodeText(s string) []byte {
+	return append(encodeHead(3, len(s)), s...)
+}
+
+// encodeMap encodes text keys mapped to already-encoded values, in sorted key order.
+func encodeMap(items map[string][]byte) []byte {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := encodeHead(5, len(items))
+	for _, key := range keys {
+		out = append(out, encodeText(key)...)
+		out = append(out, items[key]...)
+	}
+	return out
+}
+
+func marshalJSON(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("webauthntest: %w", err)
+	}
+	return string(raw), nil
+}
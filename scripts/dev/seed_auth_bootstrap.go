@@ -20,6 +20,7 @@ import (
 	authconfig "github.com/tuannm99/podzone/internal/auth/config"
 	authdomain "github.com/tuannm99/podzone/internal/auth/domain"
 	authentity "github.com/tuannm99/podzone/internal/auth/domain/entity"
+	authrepository "github.com/tuannm99/podzone/internal/auth/infrastructure/repository"
 	iamdomain "github.com/tuannm99/podzone/internal/iam/domain/entity"
 )
 
@@ -197,10 +198,18 @@ func seedAuth(ctx context.Context, authDB *sqlx.DB, iamDB *sqlx.DB, cfg cfg) (*s
 		Email:    cfg.Email,
 		FullName: cfg.FullName,
 	}
-	tokenUC := authdomain.NewTokenUsecase(authconfig.AuthConfig{
-		JWTSecret: cfg.JWTSecret,
-		JWTKey:    cfg.JWTKey,
-	})
+	// Sign like the auth service does, with its keyring, so JWKS verifiers accept the token.
+	authCfg := authconfig.NewAuthConfig(nil)
+	authCfg.JWTSecret = cfg.JWTSecret
+	authCfg.JWTKey = cfg.JWTKey
+	keyring, err := authdomain.NewSigningKeyring(
+		authrepository.NewSigningKeyRepositoryImpl(authrepository.UserRepoParams{DB: authDB}),
+		authCfg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tokenUC := authdomain.NewTokenUsecaseFor(authCfg, keyring)
 	accessToken, err := tokenUC.CreateJwtTokenForSession(user, cfg.TenantID, sessionID)
 	if err != nil {
 		return nil, err